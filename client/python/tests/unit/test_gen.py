@@ -15,6 +15,7 @@ expected_events = [
     "JobPendingEvent",
     "JobRunningEvent",
     "JobIngressInfoEvent",
+    "JobArtifactsEvent",
     "JobUnableToScheduleEvent",
     "JobFailedEvent",
     "JobPreemptedEvent",
@@ -50,6 +51,7 @@ expected_event_states = [
     "updated",
     "failedCompressed",
     "preempted",
+    "artifacts",
 ]
 
 expected_import_text = """from enum import Enum
@@ -65,6 +67,7 @@ from armada_client.armada.event_pb2 import (
     JobPendingEvent,
     JobRunningEvent,
     JobIngressInfoEvent,
+    JobArtifactsEvent,
     JobUnableToScheduleEvent,
     JobFailedEvent,
     JobPreemptedEvent,
@@ -107,6 +110,7 @@ class EventType(Enum):
     updated = "updated"
     failedCompressed = "failedCompressed"
     preempted = "preempted"
+    artifacts = "artifacts"
 
 '''
 
@@ -122,6 +126,7 @@ OneOfJobEvent = Union[
     JobPendingEvent,
     JobRunningEvent,
     JobIngressInfoEvent,
+    JobArtifactsEvent,
     JobUnableToScheduleEvent,
     JobFailedEvent,
     JobPreemptedEvent,
@@ -149,6 +154,7 @@ class JobState(Enum):
     SUCCEEDED = 3
     FAILED = 4
     UNKNOWN = 5
+    CANCELLED = 6
 
 '''
 
@@ -159,6 +165,7 @@ expected_jobstates = [
     ("SUCCEEDED", 3),
     ("FAILED", 4),
     ("UNKNOWN", 5),
+    ("CANCELLED", 6),
 ]
 
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -8,12 +9,12 @@ import (
 	"syscall"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/armadaproject/armada/internal/armada"
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	armadagateway "github.com/armadaproject/armada/internal/armada/gateway"
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	gateway "github.com/armadaproject/armada/internal/common/grpc"
@@ -45,10 +46,10 @@ func main() {
 	userSpecifiedConfigs := viper.GetStringSlice(CustomConfigLocation)
 	common.LoadConfig(&config, "./config/armada", userSpecifiedConfigs)
 
-	log.Info("Starting...")
+	logging.Info("Starting...")
 
 	// Run services within an errgroup to propagate errors between services.
-	g, ctx := armadacontext.ErrGroup(armadacontext.Background())
+	g, ctx := armadacontext.ErrGroup(armadacontext.New(context.Background(), logging.ForModule("server")))
 
 	// Cancel the errgroup context on SIGINT and SIGTERM,
 	// which shuts everything down gracefully.
@@ -80,6 +81,9 @@ func main() {
 	startupCompleteCheck := health.NewStartupCompleteChecker()
 	healthChecks := health.NewMultiChecker(startupCompleteCheck)
 	health.SetupHttpMux(mux, healthChecks)
+	// /healthz additionally fails if the server lags too far behind the event log.
+	alertingChecks := health.NewMultiChecker(healthChecks)
+	health.SetupHealthzHttpMux(mux, alertingChecks)
 
 	// register gRPC API handlers in mux
 	// TODO: Run in errgroup
@@ -93,6 +97,8 @@ func main() {
 		api.SwaggerJsonTemplate(),
 		api.RegisterSubmitHandler,
 		api.RegisterEventHandler,
+		armadagateway.RegisterSubmitStreamHandler,
+		armadagateway.RegisterPendingCapacityHandler,
 	)
 	defer shutdownGateway()
 
@@ -108,7 +114,7 @@ func main() {
 
 	// Start Armada server
 	g.Go(func() error {
-		return armada.Serve(ctx, &config, healthChecks)
+		return armada.Serve(ctx, &config, healthChecks, alertingChecks)
 	})
 
 	// Assume the server is ready if there are no errors within 10 seconds.
@@ -118,6 +124,6 @@ func main() {
 	}()
 
 	if err := g.Wait(); err != nil {
-		logging.WithStacktrace(log.NewEntry(log.StandardLogger()), err).Error("Armada server shut down")
+		logging.WithStacktrace(ctx, err).Error("Armada server shut down")
 	}
 }
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+// addBulkFlags adds the flags of commands operating on job ids read from a file.
+func addBulkFlags(cmd *cobra.Command, verb string) {
+	cmd.Flags().StringP("file", "f", "", "File listing the ids of the jobs to "+verb+", one per line (requires queue and job set to be specified)")
+	cmd.Flags().Int("batch-size", 1000, "Maximum number of job ids sent per request when reading job ids from a file")
+	cmd.Flags().Float64("rate", 10, "Maximum number of requests sent per second when reading job ids from a file")
+	cmd.Flags().String(
+		"resume-file", "",
+		"File recording the jobs already processed when reading job ids from a file, which are skipped when the command is run again; defaults to the file of job ids suffixed with .done",
+	)
+}
+
+func bulkOptionsFromFlags(cmd *cobra.Command) (armadactl.BulkOptions, error) {
+	batchSize, err := cmd.Flags().GetInt("batch-size")
+	if err != nil {
+		return armadactl.BulkOptions{}, err
+	}
+	requestsPerSecond, err := cmd.Flags().GetFloat64("rate")
+	if err != nil {
+		return armadactl.BulkOptions{}, err
+	}
+	resumeFile, err := cmd.Flags().GetString("resume-file")
+	if err != nil {
+		return armadactl.BulkOptions{}, err
+	}
+	return armadactl.BulkOptions{
+		BatchSize:         batchSize,
+		RequestsPerSecond: requestsPerSecond,
+		ResumeFile:        resumeFile,
+	}, nil
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/armadaproject/armada/internal/armadactl"
@@ -11,8 +13,12 @@ func cancelCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "cancel",
 		Short: "Cancels jobs in armada.",
-		Long:  `Cancels jobs either by jobId or by combination of queue & job set.`,
-		Args:  cobra.ExactArgs(0),
+		Long: `Cancels jobs either by jobId or by combination of queue & job set.
+
+To cancel many jobs of a job set, list their ids in a file, one per line, and pass it via --file.
+Ids are sent in batches at a limited rate, and the ids of cancelled jobs are recorded in a resume file,
+such that an interrupted or partially failed cancellation can be resumed by running the command again.`,
+		Args: cobra.ExactArgs(0),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initParams(cmd, a.Params)
 		},
@@ -20,11 +26,26 @@ func cancelCmd() *cobra.Command {
 			jobId, _ := cmd.Flags().GetString("jobId")
 			queue, _ := cmd.Flags().GetString("queue")
 			jobSetId, _ := cmd.Flags().GetString("jobSet")
+			file, _ := cmd.Flags().GetString("file")
+			if file != "" {
+				if jobId != "" {
+					return fmt.Errorf("jobId and file can't both be specified")
+				}
+				if queue == "" || jobSetId == "" {
+					return fmt.Errorf("queue and job set must be specified when cancelling jobs listed in a file")
+				}
+				options, err := bulkOptionsFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+				return a.CancelFromFile(queue, jobSetId, file, options)
+			}
 			return a.Cancel(queue, jobSetId, jobId)
 		},
 	}
 	cmd.Flags().String("jobId", "", "job to cancel")
 	cmd.Flags().String("queue", "", "queue to cancel jobs from (requires job set to be specified)")
 	cmd.Flags().String("jobSet", "", "jobSet to cancel (requires queue to be specified)")
+	addBulkFlags(cmd, "cancel")
 	return cmd
 }
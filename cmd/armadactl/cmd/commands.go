@@ -9,7 +9,7 @@ import (
 func createCmd(a *armadactl.App) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
-		Short: "Create Armada resource. Supported: queue",
+		Short: "Create Armada resource. Supported: queue, webhook",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initParams(cmd, a.Params)
 		},
@@ -25,16 +25,16 @@ func createCmd(a *armadactl.App) *cobra.Command {
 		panic(err)
 	}
 	cmd.Flags().Bool("dry-run", false, "Validate the input file and exit without making any changes.")
-	cmd.AddCommand(queueCreateCmd())
+	cmd.AddCommand(queueCreateCmd(), webhookCreateCmd())
 	return cmd
 }
 
 func deleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
-		Short: "Delete Armada resource. Supported: queue",
+		Short: "Delete Armada resource. Supported: queue, webhook",
 	}
-	cmd.AddCommand(queueDeleteCmd())
+	cmd.AddCommand(queueDeleteCmd(), webhookDeleteCmd())
 	return cmd
 }
 
@@ -50,17 +50,17 @@ func updateCmd() *cobra.Command {
 func describeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "describe",
-		Short: "Retrieve information about armada resource. Supported: queue",
+		Short: "Retrieve information about armada resource. Supported: queue, job",
 	}
-	cmd.AddCommand(queueDescribeCmd())
+	cmd.AddCommand(queueDescribeCmd(), jobDescribeCmd())
 	return cmd
 }
 
 func getCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get",
-		Short: "Retrieve information about armada resource. Supported: queue",
+		Short: "Retrieve information about armada resource. Supported: queue, webhooks, node-types",
 	}
-	cmd.AddCommand(queueGetCmd())
+	cmd.AddCommand(queueGetCmd(), webhookGetCmd(), nodeTypesGetCmd())
 	return cmd
 }
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func jobDescribeCmd() *cobra.Command {
+	return jobDescribeCmdWithApp(armadactl.New())
+}
+
+// Takes a caller-supplied app struct; useful for testing.
+func jobDescribeCmdWithApp(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "job <jobId>",
+		Short: "Prints out job info.",
+		Long: `Prints out job info, combining the spec the job was submitted with, its current state, the history of its runs,
+its most recent scheduling report, and its most recent events.`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobId := args[0]
+
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+
+			return a.DescribeJob(jobId, output)
+		},
+	}
+	cmd.Flags().StringP("output", "o", "", "Output format; either json or empty for human-readable output.")
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func logsCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "logs <jobId>",
+		Short: "Print the logs of a job.",
+		Long: `Prints the logs of the latest run of a job, read from the Binoculars service of the cluster the job ran on.
+Logs of finished jobs are available until the executor deletes their pods.
+
+The address of the Binoculars service of each cluster must be configured via binocularsUrlPattern,
+in which {CLUSTER_ID} is replaced by the id of the cluster, e.g., "{CLUSTER_ID}.binoculars.example.com:50051".`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobId := args[0]
+
+			follow, err := cmd.Flags().GetBool("follow")
+			if err != nil {
+				return fmt.Errorf("error reading follow: %s", err)
+			}
+
+			container, err := cmd.Flags().GetString("container")
+			if err != nil {
+				return fmt.Errorf("error reading container: %s", err)
+			}
+
+			return a.Logs(jobId, follow, container)
+		},
+	}
+	cmd.Flags().BoolP("follow", "f", false, "Print logs as they're written until the job finishes")
+	cmd.Flags().StringP("container", "c", "", "Container to print the logs of; required if the job has several containers")
+	return cmd
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func nodeTypesGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "node-types",
+		Short: "List the node types of each pool",
+		Long: `Lists the node types of each pool, i.e., the labels and taints the scheduler distinguishes nodes by,
+the number of nodes of each type, and their resources, such that node selectors and tolerations
+can be written without access to the clusters. Only labels and taints indexed by the scheduler are listed.`,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pool, err := cmd.Flags().GetString("pool")
+			if err != nil {
+				return err
+			}
+			return a.GetNodeTypes(strings.TrimSpace(pool))
+		},
+	}
+	cmd.Flags().String("pool", "", "Only list the node types of this pool; all pools if empty.")
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/internal/armadactl/build"
 	"github.com/armadaproject/armada/pkg/client"
 	cq "github.com/armadaproject/armada/pkg/client/queue"
 )
@@ -15,14 +16,25 @@ func initParams(cmd *cobra.Command, params *armadactl.Params) error {
 	if err != nil {
 		return err
 	}
-	params.ApiConnectionDetails = client.ExtractCommandlineArmadaApiConnectionDetails()
+	params.ApiConnectionDetails = armadactlApiConnectionDetails()
 
 	// Setup the armadactl to use pkg/client as its backend for queue-related commands
-	params.QueueAPI.Create = cq.Create(client.ExtractCommandlineArmadaApiConnectionDetails)
-	params.QueueAPI.Delete = cq.Delete(client.ExtractCommandlineArmadaApiConnectionDetails)
-	params.QueueAPI.GetInfo = cq.GetInfo(client.ExtractCommandlineArmadaApiConnectionDetails)
-	params.QueueAPI.Get = cq.Get(client.ExtractCommandlineArmadaApiConnectionDetails)
-	params.QueueAPI.Update = cq.Update(client.ExtractCommandlineArmadaApiConnectionDetails)
+	params.QueueAPI.Create = cq.Create(armadactlApiConnectionDetails)
+	params.QueueAPI.Delete = cq.Delete(armadactlApiConnectionDetails)
+	params.QueueAPI.GetInfo = cq.GetInfo(armadactlApiConnectionDetails)
+	params.QueueAPI.Get = cq.Get(armadactlApiConnectionDetails)
+	params.QueueAPI.Update = cq.Update(armadactlApiConnectionDetails)
 
 	return nil
 }
+
+// armadactlApiConnectionDetails returns the connection details loaded from config,
+// identifying armadactl to the server unless configured otherwise.
+func armadactlApiConnectionDetails() *client.ApiConnectionDetails {
+	apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+	if apiConnectionDetails.ClientName == "" {
+		apiConnectionDetails.ClientName = "armadactl"
+		apiConnectionDetails.ClientVersion = build.ReleaseVersion
+	}
+	return apiConnectionDetails
+}
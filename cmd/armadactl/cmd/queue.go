@@ -11,6 +11,12 @@ import (
 	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
+const queueStateFlagUsage = `Set queue state, one of:
+open: jobs can be submitted to the queue and are scheduled,
+paused: no new jobs can be submitted, but queued jobs are scheduled,
+draining: jobs can be submitted, but no jobs are scheduled,
+closed: no new jobs can be submitted and no jobs are scheduled.`
+
 func queueCreateCmd() *cobra.Command {
 	return queueCreateCmdWithApp(armadactl.New())
 }
@@ -52,12 +58,22 @@ Job priority is evaluated inside queue, queue has its own priority.`,
 				return fmt.Errorf("error reading resourceLimits: %s", err)
 			}
 
+			stateString, err := cmd.Flags().GetString("state")
+			if err != nil {
+				return fmt.Errorf("error reading state: %s", err)
+			}
+			state, err := queue.NewState(stateString)
+			if err != nil {
+				return fmt.Errorf("error reading state: %s", err)
+			}
+
 			queue, err := queue.NewQueue(&api.Queue{
 				Name:           name,
 				PriorityFactor: priorityFactor,
 				UserOwners:     owners,
 				GroupOwners:    groups,
 				ResourceLimits: resourceLimits,
+				State:          state.ToAPI(),
 			})
 			if err != nil {
 				return fmt.Errorf("invalid queue data: %s", err)
@@ -72,6 +88,7 @@ Job priority is evaluated inside queue, queue has its own priority.`,
 	cmd.Flags().StringToString("resourceLimits", map[string]string{},
 		"Command separated list of resource limits pairs, defaults to empty list.\nExample: --resourceLimits cpu=0.3,memory=0.2",
 	)
+	cmd.Flags().String("state", string(queue.StateOpen), queueStateFlagUsage)
 	return cmd
 }
 
@@ -178,12 +195,22 @@ func queueUpdateCmdWithApp(a *armadactl.App) *cobra.Command {
 				return fmt.Errorf("error reading resourceLimits: %s", err)
 			}
 
+			stateString, err := cmd.Flags().GetString("state")
+			if err != nil {
+				return fmt.Errorf("error reading state: %s", err)
+			}
+			state, err := queue.NewState(stateString)
+			if err != nil {
+				return fmt.Errorf("error reading state: %s", err)
+			}
+
 			queue, err := queue.NewQueue(&api.Queue{
 				Name:           name,
 				PriorityFactor: priorityFactor,
 				UserOwners:     owners,
 				GroupOwners:    groups,
 				ResourceLimits: resourceLimits,
+				State:          state.ToAPI(),
 			})
 			if err != nil {
 				return fmt.Errorf("invalid queue data: %s", err)
@@ -199,6 +226,7 @@ func queueUpdateCmdWithApp(a *armadactl.App) *cobra.Command {
 	cmd.Flags().StringToString("resourceLimits", map[string]string{},
 		"Command separated list of resource limits pairs, defaults to empty list. Example: --resourceLimits cpu=0.3,memory=0.2",
 	)
+	cmd.Flags().String("state", string(queue.StateOpen), queueStateFlagUsage)
 	return cmd
 }
 
@@ -208,3 +208,43 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateState(t *testing.T) {
+	tests := map[string]struct {
+		Flags []flag
+		State queue.State
+	}{
+		"default state": {nil, queue.StateOpen},
+		"paused":        {[]flag{{"state", "paused"}}, queue.StatePaused},
+		"draining":      {[]flag{{"state", "draining"}}, queue.StateDraining},
+		"closed":        {[]flag{{"state", "closed"}}, queue.StateClosed},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := armadactl.New()
+			cmd := queueUpdateCmdWithApp(a)
+			cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+				a.Params.QueueAPI.Update = func(q queue.Queue) error {
+					require.Equal(t, test.State, q.State)
+					return nil
+				}
+				return nil
+			}
+			cmd.SetArgs([]string{"arbitrary"})
+			for _, flag := range test.Flags {
+				require.NoError(t, cmd.Flags().Set(flag.name, flag.value))
+			}
+			require.NoError(t, cmd.Execute())
+		})
+	}
+
+	a := armadactl.New()
+	cmd := queueUpdateCmdWithApp(a)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+	cmd.SetArgs([]string{"arbitrary"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	require.NoError(t, cmd.Flags().Set("state", "frozen"))
+	require.Error(t, cmd.Execute())
+}
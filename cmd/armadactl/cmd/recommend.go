@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func recommendCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "recommend",
+		Short: "Recommend resource requests for the jobs of a job set",
+		Long: `Recommend resource requests for the jobs of a job set from the peak usage of its past jobs,
+e.g., the 95th percentile plus some headroom. If a job template is given, only jobs of the job set
+expanded from that template are considered.
+
+Jobs submitted with the annotation armadaproject.io/applyResourceRecommendation: "true" have the
+recommendation applied to them at submission, if the server is configured to do so.`,
+		Args: cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return fmt.Errorf("error reading queue: %s", err)
+			}
+			jobSetId, err := cmd.Flags().GetString("jobSet")
+			if err != nil {
+				return fmt.Errorf("error reading jobSet: %s", err)
+			}
+			templateName, err := cmd.Flags().GetString("template")
+			if err != nil {
+				return fmt.Errorf("error reading template: %s", err)
+			}
+			percentile, err := cmd.Flags().GetFloat64("percentile")
+			if err != nil {
+				return fmt.Errorf("error reading percentile: %s", err)
+			}
+			return a.Recommend(queueName, jobSetId, templateName, percentile)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue of the job set")
+	cmd.Flags().String("jobSet", "", "Job set whose past jobs the recommendation is based on")
+	cmd.Flags().String("template", "", "Only consider jobs expanded from the job template of this name")
+	cmd.Flags().Float64("percentile", 0, "Percentile of the peak usage of past jobs to recommend, or the server's default if zero")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("jobSet"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -14,8 +14,12 @@ func reprioritizeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reprioritize <priority>",
 		Short: "Reprioritize jobs in Armada",
-		Long:  `Change the priority of a single or multiple jobs by specifying either a job id or a combination of queue & job set.`,
-		Args:  cobra.ExactArgs(1),
+		Long: `Change the priority of a single or multiple jobs by specifying either a job id or a combination of queue & job set.
+
+To reprioritize many jobs of a job set, list their ids in a file, one per line, and pass it via --file.
+Ids are sent in batches at a limited rate, and the ids of reprioritized jobs are recorded in a resume file,
+such that an interrupted or partially failed reprioritization can be resumed by running the command again.`,
+		Args: cobra.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initParams(cmd, a.Params)
 		},
@@ -41,11 +45,30 @@ func reprioritizeCmd() *cobra.Command {
 				return fmt.Errorf("error reading jobSet: %s", err)
 			}
 
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return fmt.Errorf("error reading file: %s", err)
+			}
+			if file != "" {
+				if jobId != "" {
+					return fmt.Errorf("jobId and file can't both be specified")
+				}
+				if queueName == "" || jobSetId == "" {
+					return fmt.Errorf("queue and job set must be specified when reprioritizing jobs listed in a file")
+				}
+				options, err := bulkOptionsFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+				return a.ReprioritizeFromFile(queueName, jobSetId, file, priorityFactor, options)
+			}
+
 			return a.Reprioritize(jobId, queueName, jobSetId, priorityFactor)
 		},
 	}
 	cmd.Flags().String("jobId", "", "Job to reprioritize")
 	cmd.Flags().String("queue", "", "Queue including jobs to be reprioritized (requires job set to be specified)")
 	cmd.Flags().String("jobSet", "", "Job set including jobs to be reprioritized (requires queue to be specified)")
+	addBulkFlags(cmd, "reprioritize")
 	return cmd
 }
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func resubmitCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "resubmit <jobId>...",
+		Short: "Resubmit finished jobs to Armada",
+		Long: `Submit a copy of each of the given jobs, which must have succeeded, failed, or been cancelled,
+to the job set they belong to. The copies have the same spec as the original jobs and are annotated
+with the id of the job they were resubmitted from.`,
+		Args: cobra.MinimumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return fmt.Errorf("error reading queue: %s", err)
+			}
+
+			jobSetId, err := cmd.Flags().GetString("jobSet")
+			if err != nil {
+				return fmt.Errorf("error reading jobSet: %s", err)
+			}
+
+			return a.Resubmit(queueName, jobSetId, args)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue of the jobs to resubmit")
+	cmd.Flags().String("jobSet", "", "Job set of the jobs to resubmit")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("jobSet"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -37,14 +37,24 @@ The location of this file can be passed in using --config argument or picked fro
 		describeCmd(),
 		getCmd(),
 		kubeCmd(),
+		logsCmd(),
+		recommendCmd(),
 		reprioritizeCmd(),
+		resubmitCmd(),
+		resumeCmd(),
 		resourcesCmd(),
 		submitCmd(),
+		suspendCmd(),
+		topCmd(),
+		validateCmd(),
 		versionCmd(),
 		watchCmd(),
 		getSchedulingReportCmd(armadactl.New()),
 		getQueueSchedulingReportCmd(armadactl.New()),
 		getJobSchedulingReportCmd(armadactl.New()),
+		getQueueEntitlementCmd(armadactl.New()),
+		getDuplicateJobsReportCmd(armadactl.New()),
+		getSchedulingContextSnapshotsCmd(armadactl.New()),
 	)
 
 	return cmd
@@ -107,3 +107,91 @@ func getJobSchedulingReportCmd(a *armadactl.App) *cobra.Command {
 	cmd.Flags().String("jobId", "", "Id of job to query reports for.")
 	return cmd
 }
+
+func getQueueEntitlementCmd(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "queue-entitlement",
+		Short:        "Get the share, usage, and remaining headroom of a queue",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return err
+			}
+			queueName = strings.TrimSpace(queueName)
+
+			pool, err := cmd.Flags().GetString("pool")
+			if err != nil {
+				return err
+			}
+			pool = strings.TrimSpace(pool)
+
+			return a.GetQueueEntitlement(queueName, pool)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue name to query the entitlement of.")
+	cmd.Flags().String("pool", "", "Only report the entitlement in this pool; all pools if empty.")
+	return cmd
+}
+
+func getSchedulingContextSnapshotsCmd(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "scheduling-snapshot",
+		Short:        "Get a structured snapshot of the most recent scheduling round for each executor",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			executorId, err := cmd.Flags().GetString("executor")
+			if err != nil {
+				return err
+			}
+			executorId = strings.TrimSpace(executorId)
+
+			format, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+
+			return a.GetSchedulingContextSnapshots(executorId, format)
+		},
+	}
+	cmd.Flags().String("executor", "", "Only return the snapshot for this executor; all executors if empty.")
+	cmd.Flags().StringP("output", "o", "json", "Output format; either json or proto (binary-encoded protobuf).")
+	return cmd
+}
+
+func getDuplicateJobsReportCmd(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "duplicate-jobs-report",
+		Short:        "Get groups of jobs with equal scheduling requirements submitted repeatedly to the same queue",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return err
+			}
+			queueName = strings.TrimSpace(queueName)
+
+			minDuplicates, err := cmd.Flags().GetInt32("min-duplicates")
+			if err != nil {
+				return err
+			}
+
+			return a.GetDuplicateJobsReport(queueName, minDuplicates)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue name to query duplicate jobs for; all queues if empty.")
+	cmd.Flags().Int32("min-duplicates", 2, "Only report groups made up of at least this many jobs.")
+	return cmd
+}
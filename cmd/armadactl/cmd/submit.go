@@ -22,7 +22,21 @@ jobs:
 	priority: 0
 	jobSetId: set1
 	podSpec:
-	... kubernetes pod spec ...`,
+	... kubernetes pod spec ...
+
+The file may instead be a Go template, rendered with the values given via --values and --set, which are
+available as .Values. Templates may use the functions default, required, quote, toYaml, toJson, indent,
+nindent, lower, upper, and trim, as in Helm charts. The rendered jobs are validated by the server before any
+is submitted, and can be printed without submitting them via --dry-run.
+
+Example jobs.yaml template:
+
+jobs:
+- queue: {{ .Values.queue }}
+	jobSetId: {{ required "jobSetId must be set" .Values.jobSetId }}
+	priority: {{ .Values.priority | default 0 }}
+	podSpec:
+	... kubernetes pod spec using {{ .Values.image }} ...`,
 		Args: cobra.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initParams(cmd, a.Params)
@@ -33,11 +47,37 @@ jobs:
 				return fmt.Errorf("error reading flag dry-run: %s", err)
 			}
 
+			isTemplate, err := cmd.Flags().GetBool("template")
+			if err != nil {
+				return fmt.Errorf("error reading flag template: %s", err)
+			}
+
+			valuesFiles, err := cmd.Flags().GetStringArray("values")
+			if err != nil {
+				return fmt.Errorf("error reading flag values: %s", err)
+			}
+
+			setValues, err := cmd.Flags().GetStringArray("set")
+			if err != nil {
+				return fmt.Errorf("error reading flag set: %s", err)
+			}
+
+			var templateValues *armadactl.TemplateValues
+			if isTemplate || len(valuesFiles) > 0 || len(setValues) > 0 {
+				templateValues = &armadactl.TemplateValues{
+					ValuesFiles: valuesFiles,
+					SetValues:   setValues,
+				}
+			}
+
 			path := args[0]
 
-			return a.Submit(path, dryRun)
+			return a.Submit(path, templateValues, dryRun)
 		},
 	}
-	cmd.Flags().Bool("dry-run", false, "Performs basic validation on the submitted file. Does no actual submission of jobs to the server.")
+	cmd.Flags().Bool("dry-run", false, "Performs basic validation on the submitted file, and prints the rendered jobs if it's a template. Does no actual submission of jobs to the server.")
+	cmd.Flags().Bool("template", false, "Render the submitted file as a Go template; implied by --values and --set.")
+	cmd.Flags().StringArray("values", nil, "YAML file of values to render the submitted file with; may be given several times, with later files taking precedence.")
+	cmd.Flags().StringArray("set", nil, "Value of the form key=value to render the submitted file with, where key is a dot-separated path; may be given several times and takes precedence over --values.")
 	return cmd
 }
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func suspendCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "suspend",
+		Short: "Suspend a job set",
+		Long: `Stop the queued jobs of a job set from being scheduled until the job set is resumed.
+Jobs that are already running are unaffected. Requires the Pulsar scheduler.`,
+		Args: cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return fmt.Errorf("error reading queue: %s", err)
+			}
+
+			jobSetId, err := cmd.Flags().GetString("jobSet")
+			if err != nil {
+				return fmt.Errorf("error reading jobSet: %s", err)
+			}
+
+			reason, err := cmd.Flags().GetString("reason")
+			if err != nil {
+				return fmt.Errorf("error reading reason: %s", err)
+			}
+
+			return a.SuspendJobSet(queueName, jobSetId, reason)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue of the job set to suspend")
+	cmd.Flags().String("jobSet", "", "Job set to suspend")
+	cmd.Flags().String("reason", "", "Reason for suspending the job set")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("jobSet"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func resumeCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Resume a suspended job set",
+		Long:  `Allow the queued jobs of a suspended job set to be scheduled again.`,
+		Args:  cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return fmt.Errorf("error reading queue: %s", err)
+			}
+
+			jobSetId, err := cmd.Flags().GetString("jobSet")
+			if err != nil {
+				return fmt.Errorf("error reading jobSet: %s", err)
+			}
+
+			return a.ResumeJobSet(queueName, jobSetId)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue of the job set to resume")
+	cmd.Flags().String("jobSet", "", "Job set to resume")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("jobSet"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
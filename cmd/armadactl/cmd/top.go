@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func topCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Display resource usage of armada resources. Supported: queues",
+	}
+	cmd.AddCommand(topQueuesCmd(armadactl.New()))
+	return cmd
+}
+
+func topQueuesCmd(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queues",
+		Short: "Display the resources allocated to, share of, and queued jobs of each active queue",
+		Long: `Displays the resources allocated to each active queue, its fair and actual share, and its number of queued jobs,
+in each pool, as of the most recent scheduling round in that pool.`,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pool, err := cmd.Flags().GetString("pool")
+			if err != nil {
+				return err
+			}
+			pool = strings.TrimSpace(pool)
+
+			sortBy, err := cmd.Flags().GetString("sort")
+			if err != nil {
+				return err
+			}
+			sortBy = strings.TrimSpace(sortBy)
+
+			watch, err := cmd.Flags().GetBool("watch")
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return err
+			}
+			if interval <= 0 {
+				return errors.Errorf("interval must be positive, but got %s", interval)
+			}
+
+			return a.TopQueues(pool, sortBy, watch, interval)
+		},
+	}
+	cmd.Flags().String("pool", "", "Only display queues in this pool; all pools if empty.")
+	cmd.Flags().String(
+		"sort", armadactl.TopQueuesSortByActualShare,
+		"Sort queues by queue, fair-share, actual-share, queued, or the name of a resource to sort by the amount allocated of it.",
+	)
+	cmd.Flags().BoolP("watch", "w", false, "Refresh the display periodically until interrupted.")
+	cmd.Flags().Duration("interval", 5*time.Second, "How often to refresh the display in watch mode.")
+	return cmd
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func validateCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "validate ./path/to/jobs.yaml",
+		Short: "Validate jobs against armada without submitting them",
+		Long: `Validate jobs from file against armada without submitting them.
+
+The server validates the jobs in the same way as on submission, including checking that
+each job could be scheduled onto the nodes currently available, and reports every problem found.
+Exits with a non-zero status if any job is invalid, so that job specs can be checked in CI pipelines.`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.Validate(args[0])
+		},
+	}
+	return cmd
+}
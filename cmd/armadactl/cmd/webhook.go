@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func webhookCreateCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Register a webhook notified of job lifecycle events",
+		Long: `Register a webhook notified of the given events of the jobs of a queue.
+Supported events are job_failed, job_succeeded, job_cancelled, job_set_completed, and digest,
+a periodic summary of the outcomes of the jobs of the queue.
+Webhooks of kind slack are sent Slack messages, suitable for Slack incoming webhooks;
+webhooks of kind generic are sent JSON-encoded lists of events.
+Webhooks of kind email are sent digests by email, e.g., --kind email --url mailto:a@example.com,b@example.com --events digest.
+Requires ownership of the queue or permission to update it.`,
+		Args: cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return fmt.Errorf("error reading queue: %s", err)
+			}
+
+			kind, err := cmd.Flags().GetString("kind")
+			if err != nil {
+				return fmt.Errorf("error reading kind: %s", err)
+			}
+
+			url, err := cmd.Flags().GetString("url")
+			if err != nil {
+				return fmt.Errorf("error reading url: %s", err)
+			}
+
+			events, err := cmd.Flags().GetStringSlice("events")
+			if err != nil {
+				return fmt.Errorf("error reading events: %s", err)
+			}
+
+			return a.CreateWebhook(queueName, kind, url, events)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue whose jobs the webhook is notified of")
+	cmd.Flags().String("kind", "generic", "Kind of webhook, one of: generic, slack, email")
+	cmd.Flags().String("url", "", "URL notifications are sent to")
+	cmd.Flags().StringSlice("events", []string{"job_failed", "job_set_completed"}, "Comma-separated list of events the webhook is notified of")
+	for _, flag := range []string{"queue", "url"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
+
+func webhookGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "webhooks",
+		Short: "List the webhooks of a queue",
+		Args:  cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return fmt.Errorf("error reading queue: %s", err)
+			}
+
+			return a.GetWebhooks(queueName)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue whose webhooks to list")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func webhookDeleteCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "webhook <id>",
+		Short: "Delete a webhook",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return fmt.Errorf("error reading queue: %s", err)
+			}
+
+			return a.DeleteWebhook(queueName, args[0])
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue of the webhook")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
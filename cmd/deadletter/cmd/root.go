@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armada"
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// RootCmd is the root Cobra command that gets called from the main func.
+// All other sub-commands should be registered here.
+func RootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deadletter",
+		Short: "deadletter manages the event log messages that the Armada server failed to process",
+	}
+	cmd.PersistentFlags().StringSlice("config", nil, "Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)")
+	cmd.AddCommand(requeueCmd())
+	return cmd
+}
+
+func requeueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requeue",
+		Short: "Republish all messages in the dead-letter topic to the events topic",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configs, err := cmd.Flags().GetStringSlice("config")
+			if err != nil {
+				return err
+			}
+			idleTimeout, err := cmd.Flags().GetDuration("idle-timeout")
+			if err != nil {
+				return err
+			}
+			var config configuration.ArmadaConfig
+			common.LoadConfig(&config, "./config/armada", configs)
+
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+			return armada.RequeueDeadLetters(armadacontext.FromGrpcCtx(ctx), &config, idleTimeout)
+		},
+	}
+	cmd.Flags().Duration("idle-timeout", 10*time.Second, "Stop once no message has been received for this long.")
+	return cmd
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armada"
+	armadaconfig "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2"
+	lookoutconfig "github.com/armadaproject/armada/internal/lookoutingesterv2/configuration"
+	"github.com/armadaproject/armada/internal/scheduleringester"
+)
+
+// RootCmd is the root Cobra command that gets called from the main func.
+// All other sub-commands should be registered here.
+func RootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eventlogreplay",
+		Short: "eventlogreplay replays the Armada event log into a database, e.g., for disaster recovery or to clone an environment",
+	}
+	cmd.PersistentFlags().StringSlice("config", nil, "Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)")
+	cmd.PersistentFlags().String("from-time", "", "Replay messages published at or after this time (RFC3339).")
+	cmd.PersistentFlags().String("from-message-id", "", "Replay the partition of this message id (ledgerId:entryId:partitionIdx[:batchIdx]) from this message onwards.")
+	cmd.PersistentFlags().String("until", "", "If provided, skip messages published after this time (RFC3339).")
+	cmd.PersistentFlags().Duration("idle-timeout", 30*time.Second, "Stop once no message has been received for this long.")
+	cmd.PersistentFlags().Int("batch-size", 100, "Maximum number of messages processed at a time.")
+
+	cmd.AddCommand(
+		replayCmd("armada", "Replay the event log into the Armada server Redis database", func(ctx *armadacontext.Context, configs []string, opts eventlog.ReplayOptions) error {
+			var config armadaconfig.ArmadaConfig
+			common.LoadConfig(&config, "./config/armada", configs)
+			return armada.Replay(ctx, &config, opts)
+		}),
+		replayCmd("scheduler", "Replay the event log into the scheduler database", func(ctx *armadacontext.Context, configs []string, opts eventlog.ReplayOptions) error {
+			var config scheduleringester.Configuration
+			common.LoadConfig(&config, "./config/scheduleringester", configs)
+			return scheduleringester.Replay(ctx, config, opts)
+		}),
+		replayCmd("lookout", "Replay the event log into the Lookout database", func(ctx *armadacontext.Context, configs []string, opts eventlog.ReplayOptions) error {
+			var config lookoutconfig.LookoutIngesterV2Configuration
+			common.LoadConfig(&config, "./config/lookoutingesterv2", configs)
+			return lookoutingesterv2.Replay(ctx, &config, opts)
+		}),
+	)
+	return cmd
+}
+
+func replayCmd(use string, short string, replay func(ctx *armadacontext.Context, configs []string, opts eventlog.ReplayOptions) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configs, err := cmd.Flags().GetStringSlice("config")
+			if err != nil {
+				return err
+			}
+			opts, err := replayOptionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+			return replay(armadacontext.FromGrpcCtx(ctx), configs, opts)
+		},
+	}
+}
+
+func replayOptionsFromFlags(cmd *cobra.Command) (eventlog.ReplayOptions, error) {
+	var opts eventlog.ReplayOptions
+	fromTime, err := cmd.Flags().GetString("from-time")
+	if err != nil {
+		return opts, err
+	}
+	fromMessageId, err := cmd.Flags().GetString("from-message-id")
+	if err != nil {
+		return opts, err
+	}
+	until, err := cmd.Flags().GetString("until")
+	if err != nil {
+		return opts, err
+	}
+	if opts.IdleTimeout, err = cmd.Flags().GetDuration("idle-timeout"); err != nil {
+		return opts, err
+	}
+	if opts.BatchSize, err = cmd.Flags().GetInt("batch-size"); err != nil {
+		return opts, err
+	}
+
+	if (fromTime == "") == (fromMessageId == "") {
+		return opts, errors.New("exactly one of --from-time and --from-message-id must be provided")
+	}
+	if fromTime != "" {
+		if opts.Position.Time, err = time.Parse(time.RFC3339, fromTime); err != nil {
+			return opts, errors.Wrap(err, "invalid --from-time")
+		}
+	} else {
+		if opts.Position.MessageId, err = eventlog.ParsePulsarMessageId(fromMessageId); err != nil {
+			return opts, err
+		}
+	}
+	if until != "" {
+		if opts.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			return opts, errors.Wrap(err, "invalid --until")
+		}
+	}
+	if opts.IdleTimeout <= 0 {
+		return opts, errors.New("--idle-timeout must be positive")
+	}
+	if opts.BatchSize <= 0 {
+		return opts, errors.New("--batch-size must be positive")
+	}
+	return opts, nil
+}
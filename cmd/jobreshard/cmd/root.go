@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armada"
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// RootCmd is the root Cobra command that gets called from the main func.
+func RootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobreshard",
+		Short: "Move jobs between the shards of a sharded job repository after adding shards or marking shards as draining",
+		Long: "Move each job stored on a shard other than the one it's assigned to by the configured shards to that shard. " +
+			"No Armada server may use the shards while resharding.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configs, err := cmd.Flags().GetStringSlice("config")
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt("batch-size")
+			if err != nil {
+				return err
+			}
+			var config configuration.ArmadaConfig
+			common.LoadConfig(&config, "./config/armada", configs)
+
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+			return armada.ReshardJobs(armadacontext.FromGrpcCtx(ctx), &config, batchSize)
+		},
+	}
+	cmd.Flags().StringSlice("config", nil, "Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)")
+	cmd.Flags().Int("batch-size", 1000, "Maximum number of jobs moved at a time.")
+	return cmd
+}
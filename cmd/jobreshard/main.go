@@ -0,0 +1,18 @@
+package main
+
+import (
+	"os"
+
+	"github.com/armadaproject/armada/cmd/jobreshard/cmd"
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/logging"
+)
+
+func main() {
+	common.ConfigureLogging()
+	root := cmd.RootCmd()
+	if err := root.Execute(); err != nil {
+		logging.Error(err)
+		os.Exit(1)
+	}
+}
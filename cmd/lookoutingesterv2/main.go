@@ -1,11 +1,11 @@
 package main
 
 import (
-	log "github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/lookoutingesterv2"
 	"github.com/armadaproject/armada/internal/lookoutingesterv2/benchmark"
 	"github.com/armadaproject/armada/internal/lookoutingesterv2/configuration"
@@ -37,7 +37,7 @@ func main() {
 
 	runBenchmarks := viper.GetBool(Benchmark)
 	if runBenchmarks {
-		log.Info("Running Lookout Ingester benchmarks")
+		logging.Info("Running Lookout Ingester benchmarks")
 		benchmark.RunBenchmark(config)
 		return
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database"
 	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/profiling"
@@ -20,13 +21,19 @@ import (
 	"github.com/armadaproject/armada/internal/lookoutv2/configuration"
 	"github.com/armadaproject/armada/internal/lookoutv2/gen/restapi"
 	"github.com/armadaproject/armada/internal/lookoutv2/pruner"
+	"github.com/armadaproject/armada/internal/lookoutv2/repository"
 	"github.com/armadaproject/armada/internal/lookoutv2/schema"
 )
 
 const (
-	CustomConfigLocation string = "config"
-	MigrateDatabase             = "migrateDatabase"
-	PruneDatabase               = "pruneDatabase"
+	CustomConfigLocation   string = "config"
+	MigrateDatabase               = "migrateDatabase"
+	PruneDatabase                 = "pruneDatabase"
+	TrainJobSpecDictionary        = "trainJobSpecDictionary"
+
+	// Number of job specs the job spec dictionary is trained on, and the maximum size of the dictionary in bytes.
+	jobSpecDictionarySamples = 10000
+	jobSpecDictionarySize    = 110 * 1024
 )
 
 func init() {
@@ -37,6 +44,7 @@ func init() {
 	)
 	pflag.Bool(MigrateDatabase, false, "Migrate database instead of running server")
 	pflag.Bool(PruneDatabase, false, "Prune database of old jobs instead of running server")
+	pflag.String(TrainJobSpecDictionary, "", "Write a zstd dictionary trained on recently submitted job specs to this path instead of running server")
 	pflag.Parse()
 }
 
@@ -104,6 +112,28 @@ func prune(ctx *armadacontext.Context, config configuration.LookoutV2Config) {
 	}
 }
 
+func trainJobSpecDictionary(ctx *armadacontext.Context, config configuration.LookoutV2Config, path string) {
+	db, err := database.OpenPgxPool(config.Postgres)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	decompressor, err := lookoutv2.NewDecompressor(config.CompressionDictionaryPaths)
+	if err != nil {
+		panic(err)
+	}
+	samples, err := repository.NewSqlGetJobSpecRepository(db, decompressor).GetRecentJobSpecs(ctx, jobSpecDictionarySamples)
+	if err != nil {
+		panic(err)
+	}
+	dictionary := compress.TrainZstdDictionary(samples, jobSpecDictionarySize)
+	if err := os.WriteFile(path, dictionary, 0o644); err != nil {
+		panic(err)
+	}
+	log.Infof("wrote dictionary of %d bytes trained on %d job specs to %s", len(dictionary), len(samples), path)
+}
+
 func main() {
 	common.ConfigureLogging()
 	common.BindCommandlineArguments()
@@ -138,8 +168,23 @@ func main() {
 		return
 	}
 
+	if path := viper.GetString(TrainJobSpecDictionary); path != "" {
+		log.Info("Training job spec dictionary")
+		trainJobSpecDictionary(ctx, config, path)
+		return
+	}
+
 	restapi.UIConfig = config.UIConfig
 
+	// Expose diagnostics if enabled.
+	diagnosticsServer := profiling.NewDiagnosticsServer(config.Diagnostics)
+	diagnosticsServer.SetConfig(config)
+	go func() {
+		if err := diagnosticsServer.Run(ctx); err != nil {
+			logging.WithStacktrace(ctx, err).Error("diagnostics server failure")
+		}
+	}()
+
 	if err := lookoutv2.Serve(config); err != nil {
 		log.Error(err)
 		os.Exit(1)
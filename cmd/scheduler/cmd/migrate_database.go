@@ -4,11 +4,11 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/logging"
 	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
 )
 
@@ -37,7 +37,7 @@ func migrateDatabase(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	log.Info("Beginning scheduler database migration")
+	logging.Info("Beginning scheduler database migration")
 	db, err := database.OpenPgxConn(config.Postgres)
 	if err != nil {
 		return errors.WithMessagef(err, "Failed to connect to database")
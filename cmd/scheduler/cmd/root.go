@@ -5,7 +5,6 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/armadaproject/armada/internal/common"
-	commonconfig "github.com/armadaproject/armada/internal/common/config"
 	schedulerconfig "github.com/armadaproject/armada/internal/scheduler/configuration"
 )
 
@@ -42,11 +41,5 @@ func loadConfig() (schedulerconfig.Configuration, error) {
 	userSpecifiedConfigs := viper.GetStringSlice(CustomConfigLocation)
 
 	common.LoadConfig(&config, "./config/scheduler", userSpecifiedConfigs)
-
-	// TODO: once we're happy with this we can move it to common app startup
-	err := commonconfig.Validate(config)
-	if err != nil {
-		commonconfig.LogValidationErrors(err)
-	}
-	return config, err
+	return config, nil
 }
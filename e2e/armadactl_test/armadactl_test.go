@@ -221,7 +221,7 @@ jobs:
 	buf.Reset()
 
 	// submit
-	err = app.Submit(jobPath, false)
+	err = app.Submit(jobPath, nil, false)
 	require.NoError(t, err)
 
 	out := buf.String()
@@ -0,0 +1,71 @@
+// Package admission contains validators that may reject job submissions on the basis of organisation-specific policy,
+// in addition to the validation performed by Armada itself.
+package admission
+
+import (
+	"fmt"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Review describes a job submission to be validated.
+type Review struct {
+	Queue    string     `json:"queue"`
+	JobSetId string     `json:"jobSetId"`
+	User     string     `json:"user"`
+	Groups   []string   `json:"groups"`
+	Jobs     []*api.Job `json:"jobs"`
+}
+
+// Response is the decision of a validator on a Review.
+type Response struct {
+	Allowed bool `json:"allowed"`
+	// Explanation shown to the user if the submission isn't allowed.
+	Message string `json:"message,omitempty"`
+}
+
+// Validator decides whether job submissions are allowed.
+type Validator interface {
+	// Name identifies the validator in errors returned to users.
+	Name() string
+	// Validate returns an ErrRejected if the submission isn't allowed.
+	// Any other error indicates that the validator failed to reach a decision.
+	Validate(ctx *armadacontext.Context, review *Review) error
+}
+
+// ErrRejected indicates that a validator didn't allow a submission.
+type ErrRejected struct {
+	Validator string
+	Message   string
+	// Optionally, the parts of the submission that caused it to be rejected.
+	Violations []Violation
+}
+
+// Violation describes a part of a submission that isn't allowed.
+type Violation struct {
+	// Id of the job the violation relates to, if any.
+	JobId string
+	// Path of the offending field within the Review, e.g., "jobs[0].podSpec.containers[1].image".
+	Field string
+	// Container image the violation relates to, if any.
+	Image       string
+	Description string
+}
+
+func (err *ErrRejected) Error() string {
+	if err.Message == "" {
+		return fmt.Sprintf("job submission rejected by %s", err.Validator)
+	}
+	return fmt.Sprintf("job submission rejected by %s: %s", err.Validator, err.Message)
+}
+
+// ValidateAll runs each validator in order, returning the first error encountered.
+func ValidateAll(ctx *armadacontext.Context, validators []Validator, review *Review) error {
+	for _, validator := range validators {
+		if err := validator.Validate(ctx, review); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package admission
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// Registry Docker assumes images are pulled from if no registry is given, e.g., for "ubuntu:22.04".
+const defaultRegistry = "docker.io"
+
+// ImagePolicy is a Validator that restricts the registries container images may be pulled from
+// and whether images must be pinned by digest, with rules configured per queue.
+type ImagePolicy struct {
+	defaultRules configuration.ImagePolicyRules
+	queueRules   map[string]configuration.ImagePolicyRules
+}
+
+func NewImagePolicy(config configuration.ImagePolicyConfig) *ImagePolicy {
+	return &ImagePolicy{
+		defaultRules: config.Default,
+		queueRules:   config.Queues,
+	}
+}
+
+func (p *ImagePolicy) Name() string {
+	return "image policy"
+}
+
+func (p *ImagePolicy) Validate(_ *armadacontext.Context, review *Review) error {
+	rules, ok := p.queueRules[review.Queue]
+	if !ok {
+		rules = p.defaultRules
+	}
+	if len(rules.AllowedRegistries) == 0 && !rules.RequireDigest {
+		return nil
+	}
+	var violations []Violation
+	for i, job := range review.Jobs {
+		if job.PodSpec != nil {
+			violations = append(violations, checkPodSpecImages(rules, job.Id, fmt.Sprintf("jobs[%d].podSpec", i), job.PodSpec)...)
+		}
+		for j, podSpec := range job.PodSpecs {
+			if podSpec != nil {
+				violations = append(violations, checkPodSpecImages(rules, job.Id, fmt.Sprintf("jobs[%d].podSpecs[%d]", i, j), podSpec)...)
+			}
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	descriptions := make([]string, len(violations))
+	for i, violation := range violations {
+		descriptions[i] = violation.Description
+	}
+	return &ErrRejected{
+		Validator:  p.Name(),
+		Message:    strings.Join(descriptions, "; "),
+		Violations: violations,
+	}
+}
+
+func checkPodSpecImages(rules configuration.ImagePolicyRules, jobId string, field string, podSpec *v1.PodSpec) []Violation {
+	var violations []Violation
+	for i, container := range podSpec.InitContainers {
+		violations = append(violations, checkImage(rules, jobId, fmt.Sprintf("%s.initContainers[%d].image", field, i), container)...)
+	}
+	for i, container := range podSpec.Containers {
+		violations = append(violations, checkImage(rules, jobId, fmt.Sprintf("%s.containers[%d].image", field, i), container)...)
+	}
+	return violations
+}
+
+func checkImage(rules configuration.ImagePolicyRules, jobId string, field string, container v1.Container) []Violation {
+	var violations []Violation
+	repository, digest := parseImage(container.Image)
+	if len(rules.AllowedRegistries) > 0 && !isAllowedRepository(rules.AllowedRegistries, repository) {
+		violations = append(violations, Violation{
+			JobId: jobId,
+			Field: field,
+			Image: container.Image,
+			Description: fmt.Sprintf(
+				"image %s of container %s of job %s isn't from an allowed registry; allowed registries are %s",
+				container.Image, container.Name, jobId, strings.Join(rules.AllowedRegistries, ", "),
+			),
+		})
+	}
+	if rules.RequireDigest && digest == "" {
+		violations = append(violations, Violation{
+			JobId: jobId,
+			Field: field,
+			Image: container.Image,
+			Description: fmt.Sprintf(
+				"image %s of container %s of job %s must be pinned by digest, e.g., %s@sha256:<digest>",
+				container.Image, container.Name, jobId, repository,
+			),
+		})
+	}
+	return violations
+}
+
+// parseImage splits an image reference into the fully qualified repository, e.g., "docker.io/library/ubuntu",
+// and the digest, which is empty if the image isn't pinned by digest.
+// The first component of the reference is interpreted as a registry in the same way as Docker does, i.e.,
+// if it contains a "." or ":" or is "localhost".
+func parseImage(image string) (string, string) {
+	repository, digest, _ := strings.Cut(image, "@")
+	// Strip the tag, taking care not to confuse it with the port of the registry.
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository = repository[:i]
+	}
+	first, _, found := strings.Cut(repository, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		return repository, digest
+	}
+	if !found {
+		repository = "library/" + repository
+	}
+	return defaultRegistry + "/" + repository, digest
+}
+
+// isAllowedRepository returns true if the repository is in one of the allowed registries or repository prefixes.
+// Prefixes only match whole path components, i.e., "example.com/team" matches "example.com/team/image"
+// but not "example.com/team2/image".
+func isAllowedRepository(allowedRegistries []string, repository string) bool {
+	for _, allowed := range allowedRegistries {
+		allowed = strings.TrimSuffix(allowed, "/")
+		if repository == allowed || strings.HasPrefix(repository, allowed+"/") {
+			return true
+		}
+	}
+	return false
+}
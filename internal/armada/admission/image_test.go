@@ -0,0 +1,120 @@
+package admission
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const testDigest = "sha256:45b23dee08af5e43a7fea6c4cf9c25ccf269ee113168c19722f87876677c5cb2"
+
+func TestParseImage(t *testing.T) {
+	tests := map[string]struct {
+		repository string
+		digest     string
+	}{
+		"ubuntu":                                   {repository: "docker.io/library/ubuntu"},
+		"ubuntu:22.04":                             {repository: "docker.io/library/ubuntu"},
+		"bitnami/redis:7.0":                        {repository: "docker.io/bitnami/redis"},
+		"registry.example.com/team/image:1.0":      {repository: "registry.example.com/team/image"},
+		"registry.example.com:5000/image:1.0":      {repository: "registry.example.com:5000/image"},
+		"registry.example.com:5000/image":          {repository: "registry.example.com:5000/image"},
+		"localhost/image":                          {repository: "localhost/image"},
+		"ubuntu@" + testDigest:                     {repository: "docker.io/library/ubuntu", digest: testDigest},
+		"ubuntu:22.04@" + testDigest:               {repository: "docker.io/library/ubuntu", digest: testDigest},
+		"registry.example.com/image@" + testDigest: {repository: "registry.example.com/image", digest: testDigest},
+	}
+	for image, tc := range tests {
+		t.Run(image, func(t *testing.T) {
+			repository, digest := parseImage(image)
+			assert.Equal(t, tc.repository, repository)
+			assert.Equal(t, tc.digest, digest)
+		})
+	}
+}
+
+func testImageReview(queue string, images ...string) *Review {
+	containers := make([]v1.Container, len(images))
+	for i, image := range images {
+		containers[i] = v1.Container{Name: "container", Image: image}
+	}
+	return &Review{
+		Queue: queue,
+		Jobs:  []*api.Job{{Id: "job", Queue: queue, PodSpecs: []*v1.PodSpec{{Containers: containers}}}},
+	}
+}
+
+func TestImagePolicy_Validate(t *testing.T) {
+	policy := NewImagePolicy(configuration.ImagePolicyConfig{
+		Default: configuration.ImagePolicyRules{
+			AllowedRegistries: []string{"registry.example.com", "docker.io/library"},
+		},
+		Queues: map[string]configuration.ImagePolicyRules{
+			"production": {AllowedRegistries: []string{"registry.example.com/production"}, RequireDigest: true},
+			"sandbox":    {},
+		},
+	})
+	tests := map[string]struct {
+		review       *Review
+		expectFields []string
+	}{
+		"allowed registry": {
+			review: testImageReview("queue", "registry.example.com/team/image:1.0", "ubuntu:22.04"),
+		},
+		"disallowed registry": {
+			review:       testImageReview("queue", "registry.example.com/image", "bitnami/redis", "registry.example.com.evil.com/image"),
+			expectFields: []string{"jobs[0].podSpecs[0].containers[1].image", "jobs[0].podSpecs[0].containers[2].image"},
+		},
+		"queue rules replace default rules": {
+			review:       testImageReview("production", "registry.example.com/team/image@"+testDigest),
+			expectFields: []string{"jobs[0].podSpecs[0].containers[0].image"},
+		},
+		"digest required": {
+			review:       testImageReview("production", "registry.example.com/production/image@"+testDigest, "registry.example.com/production/image:1.0"),
+			expectFields: []string{"jobs[0].podSpecs[0].containers[1].image"},
+		},
+		"no rules": {
+			review: testImageReview("sandbox", "bitnami/redis"),
+		},
+		"init containers": {
+			review: &Review{
+				Queue: "queue",
+				Jobs: []*api.Job{{
+					Id: "job",
+					PodSpec: &v1.PodSpec{
+						InitContainers: []v1.Container{{Name: "init", Image: "quay.io/init"}},
+						Containers:     []v1.Container{{Name: "main", Image: "ubuntu"}},
+					},
+				}},
+			},
+			expectFields: []string{"jobs[0].podSpec.initContainers[0].image"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := policy.Validate(armadacontext.Background(), tc.review)
+			if len(tc.expectFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var rejected *ErrRejected
+			require.True(t, errors.As(err, &rejected))
+			assert.Equal(t, "image policy", rejected.Validator)
+			fields := make([]string, len(rejected.Violations))
+			for i, violation := range rejected.Violations {
+				fields[i] = violation.Field
+				assert.Contains(t, rejected.Message, violation.Description)
+				assert.NotEmpty(t, violation.Image)
+				assert.Contains(t, violation.Description, violation.Image)
+			}
+			assert.Equal(t, tc.expectFields, fields)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package admission
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// OpaPolicy is a Validator that evaluates a Rego rule using the data API of an Open Policy Agent server.
+// The rule is evaluated with the Review as input and must evaluate to a set of messages,
+// each giving a reason the submission isn't allowed.
+// Policies aren't evaluated in-process; OpaPolicy only queries the server, which loads and hot-reloads them.
+type OpaPolicy struct {
+	name          string
+	url           string
+	failurePolicy string
+	client        *http.Client
+}
+
+type opaDataRequest struct {
+	Input *Review `json:"input"`
+}
+
+type opaDataResponse struct {
+	// Undefined if the rule has no value, e.g., because the policy isn't loaded.
+	Result *[]string `json:"result"`
+}
+
+func NewOpaPolicy(config configuration.SubmitPolicyConfig) (*OpaPolicy, error) {
+	if config.Name == "" {
+		return nil, errors.New("policy name must not be empty")
+	}
+	if config.OpaUrl == "" {
+		return nil, errors.Errorf("OPA url of policy %s must not be empty", config.Name)
+	}
+	rule := strings.Trim(config.Rule, "/")
+	if rule == "" {
+		return nil, errors.Errorf("rule of policy %s must not be empty", config.Name)
+	}
+	failurePolicy, err := parseFailurePolicy(config.FailurePolicy)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "invalid config for policy %s", config.Name)
+	}
+	client, err := newHttpClient(config.Timeout, config.CaCertPath)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "invalid config for policy %s", config.Name)
+	}
+	return &OpaPolicy{
+		name:          config.Name,
+		url:           strings.TrimSuffix(config.OpaUrl, "/") + "/v1/data/" + rule,
+		failurePolicy: failurePolicy,
+		client:        client,
+	}, nil
+}
+
+// NewOpaPolicies returns a policy for each of the provided configs.
+func NewOpaPolicies(configs []configuration.SubmitPolicyConfig) ([]Validator, error) {
+	validators := make([]Validator, len(configs))
+	for i, config := range configs {
+		policy, err := NewOpaPolicy(config)
+		if err != nil {
+			return nil, err
+		}
+		validators[i] = policy
+	}
+	return validators, nil
+}
+
+func (p *OpaPolicy) Name() string {
+	return "policy " + p.name
+}
+
+func (p *OpaPolicy) Validate(ctx *armadacontext.Context, review *Review) error {
+	response := &opaDataResponse{}
+	if err := postJson(ctx, p.client, p.url, &opaDataRequest{Input: review}, response); err != nil {
+		return handleFailure(ctx, p.Name(), p.failurePolicy, err)
+	}
+	if response.Result == nil {
+		// OPA returns no result if the rule doesn't exist, which most likely means the policy isn't loaded.
+		// Since the rule of a loaded policy is empty rather than undefined if no messages are generated,
+		// this is treated as a failure rather than as allowing the submission.
+		return handleFailure(ctx, p.Name(), p.failurePolicy, errors.Errorf("rule at %s is undefined", p.url))
+	}
+	if len(*response.Result) > 0 {
+		messages := *response.Result
+		sort.Strings(messages)
+		return &ErrRejected{Validator: p.Name(), Message: strings.Join(messages, "; ")}
+	}
+	return nil
+}
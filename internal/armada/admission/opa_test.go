@@ -0,0 +1,100 @@
+package admission
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+func newTestOpaServer(t *testing.T, status int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/data/armada/submit/deny", r.URL.Path)
+		request := &opaDataRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		assert.Equal(t, testReview, request.Input)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestOpaPolicy_Validate(t *testing.T) {
+	tests := map[string]struct {
+		status        int
+		body          string
+		failurePolicy string
+		expectReject  string
+		expectError   bool
+	}{
+		"allowed": {
+			status: http.StatusOK,
+			body:   `{"result": []}`,
+		},
+		"rejected": {
+			status:       http.StatusOK,
+			body:         `{"result": ["job job must set a project label", "job job requests too many GPUs"]}`,
+			expectReject: "job job must set a project label; job job requests too many GPUs",
+		},
+		"undefined rule": {
+			status:      http.StatusOK,
+			body:        `{}`,
+			expectError: true,
+		},
+		"undefined rule ignored": {
+			status:        http.StatusOK,
+			body:          `{}`,
+			failurePolicy: FailurePolicyIgnore,
+		},
+		"server error": {
+			status:      http.StatusInternalServerError,
+			body:        `{"code": "internal_error"}`,
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newTestOpaServer(t, tc.status, tc.body)
+			defer server.Close()
+
+			policy, err := NewOpaPolicy(configuration.SubmitPolicyConfig{
+				Name:          "projects",
+				OpaUrl:        server.URL + "/",
+				Rule:          "/armada/submit/deny",
+				FailurePolicy: tc.failurePolicy,
+			})
+			require.NoError(t, err)
+
+			err = policy.Validate(armadacontext.Background(), testReview)
+			var rejected *ErrRejected
+			if tc.expectReject != "" {
+				require.True(t, errors.As(err, &rejected))
+				assert.Equal(t, "policy projects", rejected.Validator)
+				assert.Equal(t, tc.expectReject, rejected.Message)
+			} else if tc.expectError {
+				assert.Error(t, err)
+				assert.False(t, errors.As(err, &rejected))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewOpaPolicies_InvalidConfig(t *testing.T) {
+	_, err := NewOpaPolicies([]configuration.SubmitPolicyConfig{{OpaUrl: "http://localhost:8181", Rule: "armada/submit/deny"}})
+	assert.Error(t, err)
+	_, err = NewOpaPolicies([]configuration.SubmitPolicyConfig{{Name: "projects", Rule: "armada/submit/deny"}})
+	assert.Error(t, err)
+	_, err = NewOpaPolicies([]configuration.SubmitPolicyConfig{{Name: "projects", OpaUrl: "http://localhost:8181"}})
+	assert.Error(t, err)
+	_, err = NewOpaPolicies([]configuration.SubmitPolicyConfig{{Name: "projects", OpaUrl: "http://localhost:8181", Rule: "armada/submit/deny", FailurePolicy: "Maybe"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package admission
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+const (
+	FailurePolicyFail   = "Fail"
+	FailurePolicyIgnore = "Ignore"
+
+	defaultWebhookTimeout = 10 * time.Second
+	// Responses larger than this are considered invalid.
+	maxWebhookResponseBytes = 1 << 20
+)
+
+// Webhook is a Validator that delegates decisions to an external HTTP(S) endpoint.
+type Webhook struct {
+	name          string
+	url           string
+	failurePolicy string
+	client        *http.Client
+}
+
+func NewWebhook(config configuration.SubmitWebhookConfig) (*Webhook, error) {
+	if config.Name == "" {
+		return nil, errors.New("webhook name must not be empty")
+	}
+	if config.Url == "" {
+		return nil, errors.Errorf("url of webhook %s must not be empty", config.Name)
+	}
+	failurePolicy, err := parseFailurePolicy(config.FailurePolicy)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "invalid config for webhook %s", config.Name)
+	}
+	client, err := newHttpClient(config.Timeout, config.CaCertPath)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "invalid config for webhook %s", config.Name)
+	}
+	return &Webhook{
+		name:          config.Name,
+		url:           config.Url,
+		failurePolicy: failurePolicy,
+		client:        client,
+	}, nil
+}
+
+func parseFailurePolicy(failurePolicy string) (string, error) {
+	if failurePolicy == "" {
+		return FailurePolicyFail, nil
+	}
+	if failurePolicy != FailurePolicyFail && failurePolicy != FailurePolicyIgnore {
+		return "", errors.Errorf("failure policy must be either %s or %s, but is %s", FailurePolicyFail, FailurePolicyIgnore, failurePolicy)
+	}
+	return failurePolicy, nil
+}
+
+// handleFailure returns the error to be returned by a validator that failed to reach a decision.
+func handleFailure(ctx *armadacontext.Context, validator string, failurePolicy string, err error) error {
+	if failurePolicy == FailurePolicyIgnore {
+		ctx.WithError(err).Warnf("ignoring failure of %s", validator)
+		return nil
+	}
+	return errors.WithMessagef(err, "%s failed", validator)
+}
+
+// newHttpClient returns a client with the provided timeout, or a default timeout if zero,
+// that verifies TLS certificates using the CA certificate at caCertPath, or the system's root certificates if empty.
+func newHttpClient(timeout time.Duration, caCertPath string) (*http.Client, error) {
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caCertPath != "" {
+		caCert, err := os.ReadFile(caCertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read CA certificate")
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("no certificates found in %s", caCertPath)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: certPool, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}, nil
+}
+
+// postJson sends body as JSON to url and decodes the JSON response into v.
+func postJson(ctx *armadacontext.Context, client *http.Client, url string, body interface{}, v interface{}) error {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %s", resp.Status)
+	}
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseBytes))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := json.Unmarshal(respBody, v); err != nil {
+		return errors.Wrap(err, "invalid response")
+	}
+	return nil
+}
+
+// NewWebhooks returns a webhook for each of the provided configs.
+func NewWebhooks(configs []configuration.SubmitWebhookConfig) ([]Validator, error) {
+	validators := make([]Validator, len(configs))
+	for i, config := range configs {
+		webhook, err := NewWebhook(config)
+		if err != nil {
+			return nil, err
+		}
+		validators[i] = webhook
+	}
+	return validators, nil
+}
+
+func (w *Webhook) Name() string {
+	return "webhook " + w.name
+}
+
+func (w *Webhook) Validate(ctx *armadacontext.Context, review *Review) error {
+	response, err := w.call(ctx, review)
+	if err != nil {
+		return handleFailure(ctx, w.Name(), w.failurePolicy, err)
+	}
+	if !response.Allowed {
+		return &ErrRejected{Validator: w.Name(), Message: response.Message}
+	}
+	return nil
+}
+
+func (w *Webhook) call(ctx *armadacontext.Context, review *Review) (*Response, error) {
+	response := &Response{}
+	if err := postJson(ctx, w.client, w.url, review, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,123 @@
+package admission
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var testReview = &Review{
+	Queue:    "queue",
+	JobSetId: "jobSet",
+	User:     "user",
+	Groups:   []string{"group"},
+	Jobs:     []*api.Job{{Id: "job", Queue: "queue", JobSetId: "jobSet"}},
+}
+
+func newTestWebhookServer(t *testing.T, handler func(review *Review) (int, string)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		review := &Review{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(review))
+		status, body := handler(review)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestWebhook_Validate(t *testing.T) {
+	tests := map[string]struct {
+		status        int
+		body          string
+		failurePolicy string
+		expectReject  string
+		expectError   bool
+	}{
+		"allowed": {
+			status: http.StatusOK,
+			body:   `{"allowed": true}`,
+		},
+		"rejected": {
+			status:       http.StatusOK,
+			body:         `{"allowed": false, "message": "images must come from the internal registry"}`,
+			expectReject: "images must come from the internal registry",
+		},
+		"server error": {
+			status:      http.StatusInternalServerError,
+			expectError: true,
+		},
+		"invalid response": {
+			status:      http.StatusOK,
+			body:        `not json`,
+			expectError: true,
+		},
+		"server error ignored": {
+			status:        http.StatusInternalServerError,
+			failurePolicy: FailurePolicyIgnore,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newTestWebhookServer(t, func(review *Review) (int, string) {
+				assert.Equal(t, testReview, review)
+				return tc.status, tc.body
+			})
+			defer server.Close()
+
+			webhook, err := NewWebhook(configuration.SubmitWebhookConfig{
+				Name:          "policy",
+				Url:           server.URL,
+				FailurePolicy: tc.failurePolicy,
+			})
+			require.NoError(t, err)
+
+			err = ValidateAll(armadacontext.Background(), []Validator{webhook}, testReview)
+			var rejected *ErrRejected
+			if tc.expectReject != "" {
+				require.True(t, errors.As(err, &rejected))
+				assert.Equal(t, "webhook policy", rejected.Validator)
+				assert.Equal(t, tc.expectReject, rejected.Message)
+				assert.Contains(t, err.Error(), tc.expectReject)
+			} else if tc.expectError {
+				assert.Error(t, err)
+				assert.False(t, errors.As(err, &rejected))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhook_Timeout(t *testing.T) {
+	server := newTestWebhookServer(t, func(review *Review) (int, string) {
+		time.Sleep(100 * time.Millisecond)
+		return http.StatusOK, `{"allowed": true}`
+	})
+	defer server.Close()
+
+	webhook, err := NewWebhook(configuration.SubmitWebhookConfig{Name: "slow", Url: server.URL, Timeout: 10 * time.Millisecond})
+	require.NoError(t, err)
+	assert.Error(t, webhook.Validate(armadacontext.Background(), testReview))
+}
+
+func TestNewWebhooks_InvalidConfig(t *testing.T) {
+	_, err := NewWebhooks([]configuration.SubmitWebhookConfig{{Url: "http://localhost"}})
+	assert.Error(t, err)
+	_, err = NewWebhooks([]configuration.SubmitWebhookConfig{{Name: "policy"}})
+	assert.Error(t, err)
+	_, err = NewWebhooks([]configuration.SubmitWebhookConfig{{Name: "policy", Url: "http://localhost", FailurePolicy: "Sometimes"}})
+	assert.Error(t, err)
+	_, err = NewWebhooks([]configuration.SubmitWebhookConfig{{Name: "policy", Url: "https://localhost", CaCertPath: "/does/not/exist"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,280 @@
+// Package armadatest runs an Armada server in-process, backed by in-memory Redis and Pulsar,
+// such that tests can exercise the path from submitting jobs to their state being stored without docker-compose.
+//
+// Optionally, the Pulsar-backed scheduler, its ingester and the Lookout ingester run in-process too, backed by
+// embedded Postgres, such that tests can exercise the path from submitting jobs to them being scheduled and shown
+// in Lookout. Embedded Postgres downloads its binaries on first use; tests requiring it are skipped if it can't be
+// started, e.g., because there's no network access or the tests run as root.
+package armadatest
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/server"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/common/chaos"
+	chaosconfig "github.com/armadaproject/armada/internal/common/chaos/configuration"
+	"github.com/armadaproject/armada/internal/common/pulsarutils/pulsartest"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	schedulertypes "github.com/armadaproject/armada/internal/common/types"
+	"github.com/armadaproject/armada/internal/common/util"
+	schedulerconfig "github.com/armadaproject/armada/internal/scheduler/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+const (
+	// JobsetEventsTopic is the topic of the in-memory Pulsar to which job set events are published.
+	JobsetEventsTopic = "events"
+	// SubmitFromLogSubscription is the subscription from which the server reads the events it applies to Redis.
+	SubmitFromLogSubscription = "submit-from-log"
+)
+
+// Options customise the test environment. The zero value is a valid set of options.
+type Options struct {
+	// Faults injected into the server, e.g., to test recovering from Redis errors.
+	FaultInjection chaosconfig.FaultInjectionConfig
+	// Applied to the scheduling config of the server and scheduler before they're started.
+	ConfigureScheduling func(config *configuration.SchedulingConfig)
+	// If set, the Pulsar-backed scheduler, its ingester and the Lookout ingester are started, backed by embedded
+	// Postgres, and all jobs are submitted to the Pulsar-backed scheduler.
+	EnableScheduler bool
+}
+
+// TestArmada is an Armada server running in-process, backed by in-memory Redis and Pulsar.
+type TestArmada struct {
+	// Redis server backing the Armada server; may be used to, e.g., inspect or corrupt state.
+	Redis *miniredis.Miniredis
+	// In-memory Pulsar to which events are published.
+	Pulsar *pulsartest.Broker
+	// Servers implementing the submit API; SubmitServer is embedded in PulsarSubmitServer.
+	PulsarSubmitServer *server.PulsarSubmitServer
+	SubmitServer       *server.SubmitServer
+	JobRepository      repository.JobRepository
+	QueueRepository    repository.QueueRepository
+	// Used to crash the server and inject other faults as configured by Options.FaultInjection.
+	// Nil unless fault injection is enabled.
+	FaultInjector *chaos.FaultInjector
+	// The Pulsar-backed scheduler; nil unless Options.EnableScheduler is set.
+	Scheduler *TestScheduler
+
+	processedEvents repository.ProcessedEventRepository
+	// Stops the submitFromLog service and waits for it to exit.
+	stopSubmitFromLog func()
+	mu                sync.Mutex
+}
+
+// NewTestArmada starts an Armada server with the provided options, which is stopped when the test finishes.
+func NewTestArmada(t *testing.T, opts Options) *TestArmada {
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(redisServer.Close)
+
+	faults := chaos.NewFaultInjector(opts.FaultInjection)
+	db := faults.WrapRedisClient(redis.NewUniversalClient(&redis.UniversalOptions{Addrs: []string{redisServer.Addr()}}))
+	t.Cleanup(func() { _ = db.Close() })
+	broker := pulsartest.NewBroker()
+	producer := faults.WrapProducer(broker.NewProducer(JobsetEventsTopic))
+
+	schedulingConfig := DefaultSchedulingConfig()
+	if opts.ConfigureScheduling != nil {
+		opts.ConfigureScheduling(&schedulingConfig)
+	}
+	queueConfig := configuration.QueueManagementConfig{DefaultPriorityFactor: 1}
+	jobRepository := repository.NewRedisJobRepository(db)
+	queueRepository := repository.NewRedisQueueRepository(db)
+	submitServer := server.NewSubmitServer(
+		allowAllPermissions{},
+		jobRepository,
+		queueRepository,
+		repository.NewEventStore(producer, maxMessageSize),
+		repository.NewRedisSchedulingInfoRepository(db),
+		200,
+		&queueConfig,
+		&schedulingConfig,
+		nil,
+	)
+	pulsarSubmitServer := &server.PulsarSubmitServer{
+		Producer:                          producer,
+		QueueRepository:                   queueRepository,
+		Permissions:                       allowAllPermissions{},
+		SubmitServer:                      submitServer,
+		MaxAllowedMessageSize:             maxMessageSize,
+		Rand:                              util.NewThreadsafeRand(time.Now().UnixNano()),
+		GangIdAnnotation:                  configuration.GangIdAnnotation,
+		IgnoreJobSubmitChecks:             true,
+		JobSetSizeRepository:              repository.NewRedisJobSetSizeRepository(db),
+		PulsarSchedulerEnabled:            opts.EnableScheduler,
+		ProbabilityOfUsingPulsarScheduler: 1,
+	}
+
+	a := &TestArmada{
+		Redis:              redisServer,
+		Pulsar:             broker,
+		PulsarSubmitServer: pulsarSubmitServer,
+		SubmitServer:       submitServer,
+		JobRepository:      jobRepository,
+		QueueRepository:    queueRepository,
+		FaultInjector:      faults,
+		processedEvents:    repository.NewRedisProcessedEventRepository(db),
+	}
+	if opts.EnableScheduler {
+		a.Scheduler = startScheduler(t, broker, redisServer.Addr(), schedulingConfig)
+	}
+	a.startSubmitFromLog()
+	t.Cleanup(a.stop)
+	return a
+}
+
+// maxMessageSize is the maximum size of messages published to the in-memory Pulsar.
+const maxMessageSize = 4 * 1024 * 1024
+
+// DefaultSchedulingConfig returns the scheduling config used by the test environment unless overridden.
+func DefaultSchedulingConfig() configuration.SchedulingConfig {
+	return configuration.SchedulingConfig{
+		DefaultJobLimits: armadaresource.ComputeResources{
+			"cpu":    resource.MustParse("1"),
+			"memory": resource.MustParse("1Gi"),
+		},
+		MaxPodSpecSizeBytes: 65535,
+		Preemption: configuration.PreemptionConfig{
+			DefaultPriorityClass: "default",
+			PriorityClasses:      map[string]schedulertypes.PriorityClass{"default": {Priority: 0}},
+			NodeIdLabel:          schedulerconfig.NodeIdLabel,
+		},
+		MinTerminationGracePeriod: 30 * time.Second,
+		MaxTerminationGracePeriod: 300 * time.Second,
+		// The following are only used by the Pulsar-backed scheduler.
+		MaximumSchedulingRate:          math.Inf(1),
+		MaximumSchedulingBurst:         math.MaxInt,
+		MaximumPerQueueSchedulingRate:  math.Inf(1),
+		MaximumPerQueueSchedulingBurst: math.MaxInt,
+		IndexedResources: []configuration.IndexedResource{
+			{Name: "cpu", Resolution: resource.MustParse("1")},
+			{Name: "memory", Resolution: resource.MustParse("128Mi")},
+		},
+		DominantResourceFairnessResourcesToConsider: []string{"cpu", "memory"},
+		ExecutorTimeout:                  10 * time.Minute,
+		ExecutorUpdateFrequency:          time.Second,
+		MaxUnacknowledgedJobsPerExecutor: math.MaxInt,
+	}
+}
+
+// startSubmitFromLog starts the service applying events published to Pulsar to Redis, with a consumer of its own.
+func (a *TestArmada) startSubmitFromLog() {
+	ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+	submitFromLog := server.SubmitFromLog{
+		Consumer:        a.FaultInjector.WrapConsumer(a.Pulsar.Subscribe(JobsetEventsTopic, SubmitFromLogSubscription)),
+		SubmitServer:    a.SubmitServer,
+		ProcessedEvents: a.processedEvents,
+		BatchSize:       100,
+		FlushInterval:   10 * time.Millisecond,
+		RetryPolicy: configuration.RetryPolicyConfig{
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     100 * time.Millisecond,
+		},
+		FaultInjector: a.FaultInjector,
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = submitFromLog.Run(ctx)
+	}()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.stopSubmitFromLog = func() {
+		cancel()
+		<-done
+	}
+}
+
+// RestartSubmitFromLog stops the service applying events to Redis and starts it again with a new consumer,
+// such that messages not yet acked are re-delivered, as after a crash.
+func (a *TestArmada) RestartSubmitFromLog() {
+	a.stop()
+	a.startSubmitFromLog()
+}
+
+func (a *TestArmada) stop() {
+	a.mu.Lock()
+	stop := a.stopSubmitFromLog
+	a.stopSubmitFromLog = nil
+	a.mu.Unlock()
+	if stop != nil {
+		stop()
+	}
+}
+
+// CreateQueue creates a queue with the provided name.
+func (a *TestArmada) CreateQueue(t *testing.T, name string) {
+	require.NoError(t, a.QueueRepository.CreateQueue(queue.Queue{Name: name, PriorityFactor: 1}))
+}
+
+// SubmitJobs submits the provided number of jobs to the provided queue and job set and returns their ids.
+func (a *TestArmada) SubmitJobs(queueName string, jobSetId string, numJobs int) ([]string, error) {
+	req := &api.JobSubmitRequest{Queue: queueName, JobSetId: jobSetId}
+	for i := 0; i < numJobs; i++ {
+		req.JobRequestItems = append(req.JobRequestItems, &api.JobSubmitRequestItem{
+			Namespace: "default",
+			PodSpec: &v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:  "container",
+					Image: "alpine:latest",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+						Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+					},
+				}},
+			},
+		})
+	}
+	response, err := a.PulsarSubmitServer.SubmitJobs(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	jobIds := make([]string, len(response.JobResponseItems))
+	for i, item := range response.JobResponseItems {
+		jobIds[i] = item.JobId
+	}
+	return jobIds, nil
+}
+
+// WaitForJobs waits until all jobs with the provided ids are stored in Redis and returns them.
+func (a *TestArmada) WaitForJobs(t *testing.T, jobIds []string) []*api.Job {
+	var jobs []*api.Job
+	require.Eventually(t, func() bool {
+		var err error
+		jobs, err = a.JobRepository.GetExistingJobsByIds(jobIds)
+		return err == nil && len(jobs) == len(jobIds)
+	}, 10*time.Second, 10*time.Millisecond)
+	return jobs
+}
+
+// allowAllPermissions is a permission checker granting all permissions to all users.
+type allowAllPermissions struct{}
+
+func (allowAllPermissions) UserHasPermission(context.Context, permission.Permission) bool {
+	return true
+}
+
+func (allowAllPermissions) UserOwns(context.Context, authorization.Owned) (bool, []string) {
+	return true, []string{}
+}
+
+func (allowAllPermissions) UserHasQueueVerb(context.Context, string, string) bool {
+	return true
+}
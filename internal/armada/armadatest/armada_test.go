@@ -0,0 +1,107 @@
+package armadatest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/chaos"
+	chaosconfig "github.com/armadaproject/armada/internal/common/chaos/configuration"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestTestArmada_SubmittedJobsAreStored(t *testing.T) {
+	a := NewTestArmada(t, Options{})
+	a.CreateQueue(t, "queue")
+
+	jobIds, err := a.SubmitJobs("queue", "jobSet", 3)
+	require.NoError(t, err)
+	jobs := a.WaitForJobs(t, jobIds)
+
+	storedJobIds := make([]string, len(jobs))
+	for i, job := range jobs {
+		assert.Equal(t, "queue", job.Queue)
+		assert.Equal(t, "jobSet", job.JobSetId)
+		storedJobIds[i] = job.Id
+	}
+	assert.ElementsMatch(t, jobIds, storedJobIds)
+	assert.Equal(t, 0, a.Pulsar.Backlog(JobsetEventsTopic, SubmitFromLogSubscription))
+}
+
+func TestTestArmada_RecoversFromFaults(t *testing.T) {
+	a := NewTestArmada(t, Options{
+		FaultInjection: chaosconfig.FaultInjectionConfig{
+			Enabled:               true,
+			Seed:                  1,
+			RedisErrorProbability: 0.2,
+			CrashProbabilityByPoint: map[string]float64{
+				chaos.CrashPointSubmitFromLogBeforeRecordingProgress: 0.5,
+			},
+		},
+	})
+	// Redis errors are injected into creating the queue too; retry until it's created.
+	require.Eventually(t, func() bool {
+		return a.QueueRepository.CreateQueue(queue.Queue{Name: "queue", PriorityFactor: 1}) == nil
+	}, time.Second, time.Millisecond)
+	// Crashing is simulated by recording the crash and restarting the service once the message has been processed,
+	// such that messages not yet acked are re-delivered.
+	crashed := make(chan struct{}, 1)
+	a.FaultInjector.SetCrashHandler(func(string) {
+		select {
+		case crashed <- struct{}{}:
+		default:
+		}
+	})
+
+	var jobIds []string
+	for len(jobIds) < 10 {
+		ids, err := a.SubmitJobs("queue", "jobSet", 1)
+		if err == nil {
+			jobIds = append(jobIds, ids...)
+		}
+		select {
+		case <-crashed:
+			a.RestartSubmitFromLog()
+		default:
+		}
+	}
+	// Events applied again after a crash mustn't result in duplicate jobs.
+	jobs := a.WaitForJobs(t, jobIds)
+	storedJobIds := make([]string, len(jobs))
+	for i, job := range jobs {
+		storedJobIds[i] = job.Id
+	}
+	assert.ElementsMatch(t, jobIds, storedJobIds)
+}
+
+func TestTestArmada_SubmittedJobsAreScheduled(t *testing.T) {
+	a := NewTestArmada(t, Options{EnableScheduler: true})
+	a.CreateQueue(t, "queue")
+
+	jobIds, err := a.SubmitJobs("queue", "jobSet", 3)
+	require.NoError(t, err)
+	a.Scheduler.WaitForLookoutJobStates(t, jobIds, lookout.JobQueued)
+	assert.Equal(t, 0, a.Scheduler.NumRuns(t, jobIds))
+
+	// Each job requests 1 cpu, so the executor fits 2 of the 3 jobs.
+	a.Scheduler.AddExecutor(t, "executor", v1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("8Gi")})
+	require.Eventually(t, func() bool {
+		return a.Scheduler.NumRuns(t, jobIds) == 2
+	}, 30*time.Second, 50*time.Millisecond)
+	var leasedJobIds []string
+	rows, err := a.Scheduler.SchedulerDb.Query(armadacontext.Background(), "SELECT job_id FROM runs WHERE job_id = ANY($1)", jobIds)
+	require.NoError(t, err)
+	for rows.Next() {
+		var jobId string
+		require.NoError(t, rows.Scan(&jobId))
+		leasedJobIds = append(leasedJobIds, jobId)
+	}
+	require.NoError(t, rows.Err())
+	a.Scheduler.WaitForLookoutJobStates(t, leasedJobIds, lookout.JobLeased)
+}
@@ -0,0 +1,304 @@
+package armadatest
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/go-redis/redis"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	ingestmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/pulsarutils/pulsartest"
+	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/internal/common/stringinterner"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/lookoutdb"
+	lookoutmetrics "github.com/armadaproject/armada/internal/lookoutingesterv2/metrics"
+	lookoutmodel "github.com/armadaproject/armada/internal/lookoutingesterv2/model"
+	lookoutschema "github.com/armadaproject/armada/internal/lookoutv2/schema"
+	"github.com/armadaproject/armada/internal/scheduler"
+	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/scheduleringester"
+)
+
+const (
+	// SchedulerIngesterSubscription is the subscription from which the scheduler ingester reads events.
+	SchedulerIngesterSubscription = "scheduler-ingester"
+	// LookoutIngesterSubscription is the subscription from which the Lookout ingester reads events.
+	LookoutIngesterSubscription = "lookout-ingester"
+	// Pool of the executors added via TestScheduler.AddExecutor.
+	executorPool = "default"
+)
+
+var (
+	// The metrics of the scheduler and its ingester are registered with the default Prometheus registry,
+	// so they're created once and shared by all test environments.
+	schedulerMetricsOnce     sync.Once
+	schedulerMetrics         *scheduler.SchedulerMetrics
+	schedulerIngesterMetrics *ingestmetrics.Metrics
+)
+
+// TestScheduler is the Pulsar-backed scheduler, its ingester and the Lookout ingester running in-process,
+// reading from and publishing to the in-memory Pulsar of a TestArmada, backed by embedded Postgres.
+type TestScheduler struct {
+	// Scheduler database, populated by the scheduler ingester.
+	SchedulerDb *pgxpool.Pool
+	// Lookout database, populated by the Lookout ingester.
+	LookoutDb          *pgxpool.Pool
+	ExecutorRepository schedulerdb.ExecutorRepository
+
+	schedulingConfig configuration.SchedulingConfig
+}
+
+// startScheduler starts the scheduler, its ingester and the Lookout ingester, which are stopped when the test finishes.
+// Skips the test if Postgres can't be started.
+func startScheduler(t *testing.T, broker *pulsartest.Broker, redisAddr string, schedulingConfig configuration.SchedulingConfig) *TestScheduler {
+	connection := startPostgres(t)
+	ctx := armadacontext.Background()
+	schedulerDb := createDatabase(t, connection, "scheduler")
+	require.NoError(t, schedulerdb.Migrate(ctx, schedulerDb))
+	lookoutDb := createDatabase(t, connection, "lookout")
+	migrations, err := lookoutschema.LookoutMigrations()
+	require.NoError(t, err)
+	require.NoError(t, database.UpdateDatabase(ctx, lookoutDb, migrations))
+
+	schedulerMetricsOnce.Do(func() {
+		histogram := configuration.HistogramConfig{Start: 1, Factor: 1.1, Count: 100}
+		schedulerMetrics = scheduler.NewSchedulerMetrics(configuration.SchedulerMetricsConfig{
+			ScheduleCycleTimeHistogramSettings:  histogram,
+			ReconcileCycleTimeHistogramSettings: histogram,
+			QueueingLatencyHistogramSettings:    histogram,
+		})
+		schedulerIngesterMetrics = ingestmetrics.NewMetrics(ingestmetrics.ArmadaEventIngesterMetricsPrefix + "armada_scheduler_ingester_")
+	})
+
+	pulsarConfig := configuration.PulsarConfig{
+		JobsetEventsTopic: JobsetEventsTopic,
+		ReceiveTimeout:    100 * time.Millisecond,
+		BackoffTime:       10 * time.Millisecond,
+	}
+	schedulerIngesterCompressor, err := compress.NewZlibCompressor(1024)
+	require.NoError(t, err)
+	schedulerIngester := ingest.NewFilteredMsgIngestionPipeline(
+		pulsarConfig,
+		SchedulerIngesterSubscription,
+		100,
+		10*time.Millisecond,
+		pulsar.Failover,
+		schedulers.ForPulsarScheduler,
+		scheduleringester.NewInstructionConverter(schedulerIngesterMetrics, schedulingConfig.Preemption.PriorityClasses, schedulerIngesterCompressor),
+		scheduleringester.NewSchedulerDb(schedulerDb, schedulerIngesterMetrics, 10*time.Millisecond, time.Second, 5*time.Second),
+		configuration.MetricsConfig{},
+		schedulerIngesterMetrics,
+	)
+	schedulerIngester.SetConsumer(broker.SubscribePulsar(JobsetEventsTopic, SchedulerIngesterSubscription))
+
+	lookoutCompressor, err := compress.NewZlibCompressor(1024)
+	require.NoError(t, err)
+	lookoutIngester := ingest.NewIngestionPipeline[*lookoutmodel.InstructionSet](
+		pulsarConfig,
+		LookoutIngesterSubscription,
+		100,
+		10*time.Millisecond,
+		pulsar.KeyShared,
+		instructions.NewInstructionConverter(lookoutmetrics.Get(), "armadaproject.io/", lookoutCompressor, false),
+		lookoutdb.NewLookoutDb(lookoutDb, lookoutmetrics.Get(), 10, 1),
+		configuration.MetricsConfig{},
+		lookoutmetrics.Get(),
+	)
+	lookoutIngester.SetConsumer(broker.SubscribePulsar(JobsetEventsTopic, LookoutIngesterSubscription))
+
+	redisClient := redis.NewUniversalClient(&redis.UniversalOptions{Addrs: []string{redisAddr}})
+	t.Cleanup(func() { _ = redisClient.Close() })
+	jobRepository := schedulerdb.NewPostgresJobRepository(schedulerDb, 1000)
+	executorRepository := schedulerdb.NewPostgresExecutorRepository(schedulerDb)
+	publisher, err := scheduler.NewPulsarPublisher(broker.NewClient(), pulsar.ProducerOptions{
+		Topic:           JobsetEventsTopic,
+		BatchingMaxSize: maxMessageSize,
+	}, 5*time.Second)
+	require.NoError(t, err)
+	stringInterner, err := stringinterner.New(1000)
+	require.NoError(t, err)
+	submitChecker := scheduler.NewSubmitChecker(30*time.Minute, schedulingConfig, executorRepository)
+	schedulingContextRepository, err := scheduler.NewSchedulingContextRepository(1000, schedulingConfig)
+	require.NoError(t, err)
+	dependencyIndex := scheduler.NewDependencyIndex(jobRepository, time.Hour)
+	schedulingAlgo, err := scheduler.NewFairSchedulingAlgo(
+		schedulingConfig,
+		time.Second,
+		executorRepository,
+		schedulerdb.NewLegacyQueueRepository(redisClient),
+		schedulingContextRepository,
+		dependencyIndex,
+		scheduler.NewJobSetSuspensions(jobRepository),
+		schedulerdb.NewPostgresQueueSpendRepository(schedulerDb),
+	)
+	require.NoError(t, err)
+	s, err := scheduler.NewScheduler(
+		jobdb.NewJobDb(schedulingConfig.Preemption.PriorityClasses, schedulingConfig.Preemption.DefaultPriorityClass),
+		jobRepository,
+		executorRepository,
+		schedulingAlgo,
+		scheduler.NewStandaloneLeaderController(),
+		publisher,
+		stringInterner,
+		submitChecker,
+		nil,
+		dependencyIndex,
+		nil,
+		100*time.Millisecond,
+		100*time.Millisecond,
+		schedulingConfig.ExecutorTimeout,
+		0,
+		0,
+		time.Second,
+		schedulingConfig.MaxRetries+1,
+		schedulingConfig.Preemption.NodeIdLabel,
+		schedulerMetrics,
+	)
+	require.NoError(t, err)
+
+	// Registered after the cleanup of Postgres, such that the services are stopped before Postgres.
+	g, ctx := armadacontext.ErrGroup(armadacontext.Background())
+	ctx, cancel := armadacontext.WithCancel(ctx)
+	t.Cleanup(func() {
+		cancel()
+		if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+			t.Errorf("scheduler failed: %s", err)
+		}
+	})
+	g.Go(func() error { return schedulerIngester.Run(ctx) })
+	g.Go(func() error { return lookoutIngester.Run(ctx) })
+	g.Go(func() error { return submitChecker.Run(ctx) })
+	g.Go(func() error { return s.Run(ctx) })
+
+	return &TestScheduler{
+		SchedulerDb:        schedulerDb,
+		LookoutDb:          lookoutDb,
+		ExecutorRepository: executorRepository,
+		schedulingConfig:   schedulingConfig,
+	}
+}
+
+// startPostgres starts embedded Postgres, which is stopped when the test finishes, and returns the connection config
+// of its default database. Skips the test if Postgres can't be started, e.g., because its binaries can't be downloaded.
+func startPostgres(t *testing.T) map[string]string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	var logs bytes.Buffer
+	postgres := embeddedpostgres.NewDatabase(embeddedpostgres.DefaultConfig().
+		Version(embeddedpostgres.V15).
+		Port(uint32(port)).
+		Username("postgres").
+		Password("psw").
+		RuntimePath(filepath.Join(t.TempDir(), "postgres")).
+		StartTimeout(time.Minute).
+		Logger(&logs))
+	if err := postgres.Start(); err != nil {
+		t.Skipf("embedded Postgres failed to start: %s\n%s", err, logs.String())
+	}
+	t.Cleanup(func() { assert.NoError(t, postgres.Stop()) })
+	return map[string]string{
+		"host":     "localhost",
+		"port":     strconv.Itoa(port),
+		"user":     "postgres",
+		"password": "psw",
+		"dbname":   "postgres",
+		"sslmode":  "disable",
+	}
+}
+
+// createDatabase creates a database with the provided name and returns a connection pool to it,
+// which is closed when the test finishes.
+func createDatabase(t *testing.T, connection map[string]string, name string) *pgxpool.Pool {
+	ctx := armadacontext.Background()
+	conn, err := pgx.Connect(ctx, database.CreateConnectionString(connection))
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+	_, err = conn.Exec(ctx, "CREATE DATABASE "+name)
+	require.NoError(t, err)
+
+	databaseConnection := make(map[string]string, len(connection))
+	for k, v := range connection {
+		databaseConnection[k] = v
+	}
+	databaseConnection["dbname"] = name
+	db, err := database.OpenPgxPool(configuration.PostgresConfig{Connection: databaseConnection})
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+	return db
+}
+
+// AddExecutor adds an executor with a single node with the provided resources, onto which the scheduler then
+// schedules jobs. The executor never reports again, so it's considered stale once ExecutorTimeout has passed.
+func (s *TestScheduler) AddExecutor(t *testing.T, executorId string, resources v1.ResourceList) {
+	nodeId := executorId + "-node"
+	total := schedulerobjects.ResourceListFromV1ResourceList(resources)
+	err := s.ExecutorRepository.StoreExecutor(armadacontext.Background(), &schedulerobjects.Executor{
+		Id:   executorId,
+		Pool: executorPool,
+		Nodes: []*schedulerobjects.Node{{
+			Id:             nodeId,
+			Name:           nodeId,
+			Executor:       executorId,
+			TotalResources: total,
+			AllocatableByPriorityAndResource: schedulerobjects.NewAllocatableByPriorityAndResourceType(
+				s.schedulingConfig.Preemption.AllowedPriorities(),
+				total,
+			),
+			StateByJobRunId: map[string]schedulerobjects.JobRunState{},
+			Labels:          map[string]string{s.schedulingConfig.Preemption.NodeIdLabel: nodeId},
+		}},
+		LastUpdateTime: time.Now(),
+	})
+	require.NoError(t, err)
+}
+
+// WaitForLookoutJobStates waits until Lookout records all jobs with the provided ids as being in the provided state.
+func (s *TestScheduler) WaitForLookoutJobStates(t *testing.T, jobIds []string, state lookout.JobState) {
+	require.Eventually(t, func() bool {
+		var numJobs int
+		err := s.LookoutDb.QueryRow(
+			armadacontext.Background(),
+			"SELECT count(*) FROM job WHERE job_id = ANY($1) AND state = $2",
+			jobIds, lookout.JobStateOrdinalMap[state],
+		).Scan(&numJobs)
+		return err == nil && numJobs == len(jobIds)
+	}, 30*time.Second, 50*time.Millisecond)
+}
+
+// NumRuns returns the number of runs of the jobs with the provided ids stored in the scheduler database.
+func (s *TestScheduler) NumRuns(t *testing.T, jobIds []string) int {
+	var numRuns int
+	err := s.SchedulerDb.QueryRow(
+		armadacontext.Background(),
+		"SELECT count(*) FROM runs WHERE job_id = ANY($1)",
+		jobIds,
+	).Scan(&numRuns)
+	require.NoError(t, err)
+	return numRuns
+}
@@ -0,0 +1,75 @@
+// Package audit records decisions of the Armada server that operators may need to review later,
+// e.g., job submissions rejected by admission policies.
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// Types of audit events.
+const (
+	// A job submission rejected by an admission validator, e.g., a webhook or image policy.
+	JobSubmissionRejected = "JobSubmissionRejected"
+)
+
+// TypeProperty is the property of the messages published by PulsarSink holding the type of the event.
+const TypeProperty = "auditEventType"
+
+// Event is a record of a decision of the Armada server.
+type Event struct {
+	Type     string    `json:"type"`
+	Time     time.Time `json:"time"`
+	Queue    string    `json:"queue"`
+	JobSetId string    `json:"jobSetId"`
+	User     string    `json:"user"`
+	NumJobs  int       `json:"numJobs,omitempty"`
+	// Name of the policy that made the decision, e.g., "webhook images".
+	Policy string `json:"policy,omitempty"`
+	// Explanation of the decision given by the policy.
+	Reason string `json:"reason,omitempty"`
+	// Parts of the submission that caused it to be rejected, if known.
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Violation describes a part of a submission that isn't allowed.
+type Violation struct {
+	JobId       string `json:"jobId,omitempty"`
+	Field       string `json:"field,omitempty"`
+	Image       string `json:"image,omitempty"`
+	Description string `json:"description"`
+}
+
+// Sink stores audit events, such that they can be consumed and queried separately from the server log.
+type Sink interface {
+	Record(ctx *armadacontext.Context, event *Event) error
+}
+
+// PulsarSink is a Sink publishing events as JSON to a Pulsar topic.
+// Events are keyed by queue and published with their type as the TypeProperty property.
+type PulsarSink struct {
+	Producer pulsar.Producer
+}
+
+func NewPulsarSink(producer pulsar.Producer) *PulsarSink {
+	return &PulsarSink{Producer: producer}
+}
+
+func (s *PulsarSink) Record(ctx *armadacontext.Context, event *Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = s.Producer.Send(ctx, &pulsar.ProducerMessage{
+		Payload:    payload,
+		Key:        event.Queue,
+		Properties: map[string]string{TypeProperty: event.Type},
+		EventTime:  event.Time,
+	})
+	return errors.WithStack(err)
+}
@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+type fakePulsarProducer struct {
+	pulsar.Producer
+	sent []*pulsar.ProducerMessage
+}
+
+func (p *fakePulsarProducer) Send(_ context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+	p.sent = append(p.sent, msg)
+	return nil, nil
+}
+
+func TestPulsarSink(t *testing.T) {
+	event := &Event{
+		Type:     JobSubmissionRejected,
+		Time:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		User:     "user",
+		NumJobs:  1,
+		Policy:   "webhook images",
+		Reason:   "images must come from the internal registry",
+	}
+	producer := &fakePulsarProducer{}
+	require.NoError(t, NewPulsarSink(producer).Record(armadacontext.Background(), event))
+
+	require.Len(t, producer.sent, 1)
+	assert.Equal(t, "queue", producer.sent[0].Key)
+	assert.Equal(t, map[string]string{TypeProperty: JobSubmissionRejected}, producer.sent[0].Properties)
+	assert.Equal(t, event.Time, producer.sent[0].EventTime)
+	actual := &Event{}
+	require.NoError(t, json.Unmarshal(producer.sent[0].Payload, actual))
+	assert.Equal(t, event, actual)
+}
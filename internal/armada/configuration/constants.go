@@ -18,6 +18,82 @@ const (
 	// Pods for which this annotation has value "true" are not retried.
 	// Instead, the job the pod is part of fails immediately.
 	FailFastAnnotation = "armadaproject.io/failFast"
+	// PoolPreferencesAnnotation Jobs may express weighted preferences over pools via this annotation.
+	// Preferences are expressed as a comma-separated list of pool=weight pairs, e.g., "on-prem=1,cloud=0.2".
+	// Such jobs are only scheduled onto the listed pools, and onto a pool only once all pools with higher weight
+	// have been considered for scheduling since the job was submitted.
+	PoolPreferencesAnnotation = "armadaproject.io/poolPreferences"
+	// JobSetMaxRunningJobsAnnotation Jobs may limit the number of jobs of their job set running concurrently via this annotation.
+	// The limit should be expressed as a positive integer, e.g., "10".
+	// A job with this annotation is only scheduled if fewer than the given number of jobs of its job set are running.
+	// Since the limit is evaluated per job, all jobs in a job set should normally specify the same value.
+	JobSetMaxRunningJobsAnnotation = "armadaproject.io/jobSetMaxRunningJobs"
+	// JobSetMaxPreemptedFractionAnnotation Jobs may limit the fraction of the jobs of their job set that may be preempted
+	// at the same time to balance resources between queues via this annotation, similarly to a PodDisruptionBudget.
+	// The fraction should be expressed as a number between 0 and 1, e.g., "0.25".
+	// Since the budget is evaluated per job, all jobs in a job set should normally specify the same value.
+	JobSetMaxPreemptedFractionAnnotation = "armadaproject.io/jobSetMaxPreemptedFraction"
+	// JobDependenciesAnnotation Jobs may depend on other jobs via this annotation, which is set by the server
+	// from the dependsOn field of job submissions. The value is a comma-separated list of job ids.
+	// A job with this annotation is only scheduled once all listed jobs have succeeded,
+	// and fails if any of them fails or is cancelled.
+	JobDependenciesAnnotation = "armadaproject.io/dependsOn"
+	// CronJobSetAnnotation Jobs submitted on behalf of a cron job set carry the name of that cron job set in this annotation,
+	// such that they can be found, e.g., in Lookout.
+	CronJobSetAnnotation = "armadaproject.io/cronJobSet"
+	// JobTemplateAnnotation Jobs expanded from a stored job template carry the name of that template in this annotation.
+	JobTemplateAnnotation = "armadaproject.io/jobTemplate"
+	// ClientNameAnnotation, ClientVersionAnnotation, and SubmissionSourceAnnotation are set by the server
+	// from the gRPC metadata of submit requests, such that the client jobs were submitted with can be found, e.g., in Lookout.
+	ClientNameAnnotation       = "armadaproject.io/clientName"
+	ClientVersionAnnotation    = "armadaproject.io/clientVersion"
+	SubmissionSourceAnnotation = "armadaproject.io/submissionSource"
+	// ArrayIdAnnotation and ArrayIndexAnnotation are set by the server on each task of an array job,
+	// such that the tasks of an array can be found and grouped, e.g., in Lookout.
+	ArrayIdAnnotation    = "armadaproject.io/arrayId"
+	ArrayIndexAnnotation = "armadaproject.io/arrayIndex"
+	// MultiPodJobIdAnnotation and PodIndexAnnotation are set by the server on the job created for each pod of a
+	// multi-pod job. The jobs of a multi-pod job make up a gang, with gang id equal to the id of the multi-pod job.
+	MultiPodJobIdAnnotation = "armadaproject.io/multiPodJobId"
+	PodIndexAnnotation      = "armadaproject.io/podIndex"
+	// IdempotencyKeyAnnotation is set by the server on jobs submitted in a request with an idempotency key,
+	// to the key followed by the index of the job in the request, e.g., "nightly-2023-10-01/3".
+	// Jobs without a client id are deduplicated on this annotation and their owner when written to the legacy scheduler's database.
+	IdempotencyKeyAnnotation = "armadaproject.io/idempotencyKey"
+	// ParentJobIdAnnotation is set by the server on jobs submitted by resubmitting a finished job,
+	// to the id of that job, such that retries of a job can be traced back to it.
+	ParentJobIdAnnotation = "armadaproject.io/parentJobId"
+	// RetryMaxAttemptsAnnotation, RetryBackoffAnnotation, RetryOnExitCodesAnnotation, and RetryOnFailureCategoriesAnnotation
+	// make up the retry policy of a job, which the server sets from the retryPolicy field of job submissions.
+	// A job that fails is resubmitted as a new job in the same job set if it has been run fewer than the maximum number
+	// of attempts, after waiting for the backoff (e.g., "30s"), which is doubled for each subsequent retry.
+	// If a comma-separated list of exit codes is given, the job is only retried if a container exited with one of them.
+	// If a comma-separated list of failure categories is given (e.g., "OOMKilled,NodeLost"), the job is only retried if
+	// it failed for a reason in one of them; if exit codes are given too, the job is retried if either matches.
+	RetryMaxAttemptsAnnotation         = "armadaproject.io/retryMaxAttempts"
+	RetryBackoffAnnotation             = "armadaproject.io/retryBackoff"
+	RetryOnExitCodesAnnotation         = "armadaproject.io/retryOnExitCodes"
+	RetryOnFailureCategoriesAnnotation = "armadaproject.io/retryOnFailureCategories"
+	// RetryAttemptAnnotation and FirstAttemptJobIdAnnotation are set by the server on jobs resubmitted by their retry policy,
+	// to the attempt number of the job, starting from 2 for the first retry, and to the id of the job originally submitted,
+	// such that all attempts of a job can be found, e.g., in Lookout.
+	RetryAttemptAnnotation      = "armadaproject.io/retryAttempt"
+	FirstAttemptJobIdAnnotation = "armadaproject.io/firstAttemptJobId"
+	// ApplyResourceRecommendationAnnotation If set to "true" on a job, and the server is configured to do so, the requests
+	// and limits of the job are replaced at submission by those recommended from the usage of past jobs of its job set.
+	ApplyResourceRecommendationAnnotation = "armadaproject.io/applyResourceRecommendation"
+	// TraceparentAnnotation is set by the server on each submitted job to the W3C traceparent of the request submitting it,
+	// such that the spans of scheduling, leasing and running the job are part of the trace in which it was submitted.
+	// When leasing the job, the scheduler replaces it with the traceparent of the lease, which the executor continues.
+	TraceparentAnnotation = "armadaproject.io/traceparent"
+	// PodSpecHashAnnotation is set by the scheduler ingester on the scheduling requirements of each job
+	// to a hash of the images, commands, arguments, and environment of its containers.
+	// Jobs with equal scheduling key and hash are reported as duplicates.
+	PodSpecHashAnnotation = "armadaproject.io/podSpecHash"
+	// ArrayIndexEnvVar Each container of a task of an array job has the index of that task in this environment variable.
+	ArrayIndexEnvVar = "ARMADA_ARRAY_INDEX"
+	// PodIndexEnvVar Each container of a pod of a multi-pod job has the index of that pod in this environment variable.
+	PodIndexEnvVar = "ARMADA_POD_INDEX"
 )
 
 var ReturnLeaseRequestTrackedAnnotations = map[string]struct{}{
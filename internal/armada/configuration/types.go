@@ -10,8 +10,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	authconfig "github.com/armadaproject/armada/internal/common/auth/configuration"
+	chaosconfig "github.com/armadaproject/armada/internal/common/chaos/configuration"
 	grpcconfig "github.com/armadaproject/armada/internal/common/grpc/configuration"
+	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
 	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	tracingconfig "github.com/armadaproject/armada/internal/common/tracing/configuration"
 	"github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/pkg/client"
 )
@@ -24,6 +27,13 @@ type ArmadaConfig struct {
 	MetricsPort uint16
 	// If non-nil, net/http/pprof endpoints are exposed on localhost on this port.
 	PprofPort *uint16
+	// Configuration of the diagnostics server, which exposes pprof, a goroutine dump,
+	// and a summary of the most recent scheduling rounds.
+	Diagnostics profilingconfig.DiagnosticsConfig
+	// Faults injected into the server to test its resilience, e.g., in end-to-end tests. Disabled by default.
+	FaultInjection chaosconfig.FaultInjectionConfig
+	// Exporting of spans to an OpenTelemetry collector.
+	Tracing tracingconfig.TracingConfig
 
 	CorsAllowedOrigins []string
 	GrpcGatewayPath    string
@@ -32,25 +42,56 @@ type ArmadaConfig struct {
 
 	SchedulerApiConnection client.ApiConnectionDetails
 
-	PriorityHalfTime                  time.Duration
-	CancelJobsBatchSize               int
-	Redis                             redis.UniversalOptions
-	EventsApiRedis                    redis.UniversalOptions
-	Scheduling                        SchedulingConfig
-	NewScheduler                      NewSchedulerConfig
-	QueueManagement                   QueueManagementConfig
-	Pulsar                            PulsarConfig
-	Postgres                          PostgresConfig // Used for Pulsar submit API deduplication
-	EventApi                          EventApiConfig
-	Metrics                           MetricsConfig
-	IgnoreJobSubmitChecks             bool // Temporary flag to stop us rejecting jobs on switch over
+	PriorityHalfTime           time.Duration
+	CancelJobsBatchSize        int
+	Redis                      redis.UniversalOptions
+	JobRepository              JobRepositoryConfig
+	EventsApiRedis             redis.UniversalOptions
+	Scheduling                 SchedulingConfig
+	NewScheduler               NewSchedulerConfig
+	QueueManagement            QueueManagementConfig
+	CronJobSets                CronJobSetsConfig
+	JobTemplates               JobTemplatesConfig
+	JobRetries                 JobRetriesConfig
+	Notifications              NotificationsConfig
+	ArrayJobs                  ArrayJobsConfig
+	ResourceRecommendations    ResourceRecommendationsConfig
+	SubmissionLimits           SubmissionLimitsConfig
+	ImagePolicy                ImagePolicyConfig     // Restricts the container images jobs may use
+	SubmitPolicies             []SubmitPolicyConfig  // Rego policies evaluated, in order, for each job submission
+	SubmitWebhooks             []SubmitWebhookConfig // Validating webhooks invoked, in order, for each job submission
+	Pulsar                     PulsarConfig
+	EventLog                   EventLogConfig
+	Postgres                   PostgresConfig    // Used for Pulsar submit API deduplication
+	OwnershipGroupsCompression CompressionConfig // How the queue ownership groups stored with each job are compressed
+	Outbox                     OutboxConfig
+	EventApi                   EventApiConfig
+	Metrics                    MetricsConfig
+	IgnoreJobSubmitChecks      bool // Temporary flag to stop us rejecting jobs on switch over
+	// Controls whether jobs that can never be scheduled are rejected at submission with the reason why.
+	SubmitFeasibilityCheck            SubmitFeasibilityCheckConfig
 	PulsarSchedulerEnabled            bool
 	ProbabilityOfUsingPulsarScheduler float64
 }
 
+// SubmitFeasibilityCheckConfig controls whether each submitted job is checked against the nodes of the executors
+// of the scheduler it's assigned to, ignoring the resources allocated to other jobs. Unlike the submit checks,
+// this also applies to jobs explicitly submitted to a scheduler and to jobs with dependencies.
+type SubmitFeasibilityCheckConfig struct {
+	Enabled bool
+	// If true, jobs that can't be scheduled onto any node are accepted with a warning instead of rejected.
+	WarnOnly bool
+}
+
 type PulsarConfig struct {
 	// Pulsar URL
 	URL string `validate:"required"`
+	// URL of the Pulsar admin API, e.g., "http://pulsar:8080". If set, the number of messages not yet acked
+	// by consumers of the event log is reported as a metric.
+	AdminURL string
+	// Maximum time messages of the event log may wait to be processed before the /healthz endpoint reports
+	// the component consuming them as unhealthy. If zero, lag never causes /healthz to fail.
+	MaxConsumerLag time.Duration
 	// Path to the trusted TLS certificate file (must exist)
 	TLSTrustCertsFilePath string
 	// Whether Pulsar client accept untrusted TLS certificate from broker
@@ -67,6 +108,27 @@ type PulsarConfig struct {
 	JwtTokenPath                string
 	JobsetEventsTopic           string
 	RedisFromPulsarSubscription string
+	// Number of messages of different job sets written to Redis concurrently.
+	RedisFromPulsarParallelism int
+	// Maximum number of messages received at a time when writing to Redis,
+	// and the maximum time to wait for a batch of messages to fill up.
+	RedisFromPulsarBatchSize     int
+	RedisFromPulsarFlushInterval time.Duration
+	// If true, messages written to Redis are acked cumulatively every RedisFromPulsarFlushInterval
+	// rather than individually. This requires a failover subscription,
+	// such that each partition of the events topic is consumed by a single server at a time.
+	RedisFromPulsarCumulativeAck bool
+	// Policy for retrying events that fail to be written to Redis because of a transient error.
+	RedisFromPulsarRetry RetryPolicyConfig
+	// Topic to which messages that can't be processed when writing to Redis are published,
+	// together with the id of the original message, the error, and the number of retries.
+	// If empty, such messages are dropped.
+	DeadLetterTopic string
+	// Subscription used when requeueing messages from DeadLetterTopic.
+	DeadLetterRequeueSubscription string
+	// Topic to which audit events, e.g., job submissions rejected by admission validators, are published as JSON.
+	// If empty, such events are only recorded in the server log.
+	AuditTopic string
 	// Compression to use.  Valid values are "None", "LZ4", "Zlib", "Zstd".  Default is "None"
 	CompressionType pulsar.CompressionType
 	// Compression Level to use.  Valid values are "Default", "Better", "Faster".  Default is "Default"
@@ -83,6 +145,10 @@ type PulsarConfig struct {
 	EventsPrinter             bool
 	// Maximum allowed message size in bytes
 	MaxAllowedMessageSize uint
+	// Schema version with which event sequences are published, or the latest version if zero.
+	// During a rolling upgrade that introduces a new schema version, set this to the previous version
+	// until all consumers have been upgraded.
+	EventSchemaWriteVersion uint32
 	// Timeout when polling pulsar for messages
 	ReceiveTimeout time.Duration
 	// Backoff from polling when Pulsar returns an error
@@ -91,6 +157,17 @@ type PulsarConfig struct {
 	ReceiverQueueSize int
 }
 
+// RetryPolicyConfig configures retrying operations that fail because of transient errors with exponential backoff.
+type RetryPolicyConfig struct {
+	// Time waited before the first retry, which doubles with each subsequent retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Maximum number of retries per event sequence, or unlimited if zero.
+	MaxRetries int
+	// Retrying stops once no progress has been made for this long.
+	Timeout time.Duration
+}
+
 // DatabaseConfig represents the configuration of the database connection.
 type DatabaseConfig struct {
 	// MaxOpenConns represents the maximum number of open connections to the database.
@@ -122,9 +199,16 @@ type SchedulingConfig struct {
 	MaxQueueLookback uint
 	// In each invocation of the scheduler, no more jobs are scheduled once this limit has been exceeded.
 	// Note that the total scheduled resources may be greater than this limit.
-	MaximumResourceFractionToSchedule map[string]float64
+	MaximumResourceFractionToSchedule map[string]float64 `validate:"dive,gte=0,lte=1"`
 	// Overrides MaximalClusterFractionToSchedule if set for the current pool.
-	MaximumResourceFractionToScheduleByPool map[string]map[string]float64
+	MaximumResourceFractionToScheduleByPool map[string]map[string]float64 `validate:"dive,dive,gte=0,lte=1"`
+	// Per-resource multipliers applied to the total capacity of a pool when computing
+	// the total resources available in each scheduling round.
+	// E.g., map[string]float64{"cpu": 1.2} allows for scheduling up to 1.2x the nominal CPU capacity.
+	// Resources not included here are not overcommitted.
+	OvercommitFactors map[string]float64
+	// Overrides OvercommitFactors if set for the current pool.
+	OvercommitFactorsByPool map[string]map[string]float64
 	// The rate at which Armada schedules jobs is rate-limited using a token bucket approach.
 	// Specifically, there is a token bucket that persists between scheduling rounds.
 	// The bucket fills up at a rate of MaximumSchedulingRate tokens per second and has capacity MaximumSchedulingBurst.
@@ -166,10 +250,37 @@ type SchedulingConfig struct {
 	DefaultJobTolerationsByResourceRequest map[string][]v1.Toleration
 	// Maximum number of times a job is retried before considered failed.
 	MaxRetries uint
-	// Controls how fairness is calculated. Can be either AssetFairness or DominantResourceFairness.
+	// Controls how quickly queued jobs gain priority the longer they wait to be scheduled,
+	// such that long-waiting jobs are eventually scheduled before newer jobs of higher priority.
+	// Specifically, the priority of a queued job is reduced by this amount for each hour since it was submitted,
+	// where jobs of lower priority are scheduled first. Jobs of different priority classes are unaffected,
+	// i.e., jobs are still scheduled in order of decreasing priority class priority.
+	// Set to zero to disable aging. Applies only to the new scheduler.
+	JobPriorityAgingPerHour float64 `validate:"gte=0"`
+	// Overrides JobPriorityAgingPerHour if set for a queue.
+	JobPriorityAgingPerHourByQueue map[string]float64
+	// Maps queues to the queues they lend quota to. While below its fair share and without queued jobs,
+	// a lending queue lends the part of the per-queue limit of each priority class it doesn't use,
+	// see PriorityClass.MaximumResourceFractionPerQueue, to its borrowers, which may exceed their own limit by that amount.
+	// Only jobs of preemptible priority classes may use borrowed quota. Queues using borrowed quota aren't protected by
+	// Preemption.ProtectedFractionOfFairShare, and jobs using borrowed quota are preempted once the lender stops lending.
+	// Applies only to the new scheduler.
+	QuotaBorrowersByLender map[string][]string
+	// Controls how fairness is calculated. Can be either AssetFairness, DominantResourceFairness, or CostFairness.
 	FairnessModel FairnessModel
 	// List of resource names, e.g., []string{"cpu", "memory"}, to consider when computing DominantResourceFairness.
 	DominantResourceFairnessResourcesToConsider []string
+	// Prices used to compute fair share when using CostFairness, and the spend of queues with a budget.
+	CostModel CostModelConfig
+	// Maximum spend of each queue per calendar month (UTC), as priced by CostModel.
+	// Once a queue has spent its budget, its jobs are no longer scheduled until the start of the next month,
+	// unless of a priority class in BudgetExemptPriorityClasses. Queues without a budget are unlimited.
+	// Spend is accrued by the scheduler from the resources allocated to each queue and stored in the scheduler database,
+	// such that it's retained across restarts and changes of leader.
+	// Applies only to the new scheduler.
+	MonthlyBudgetByQueue map[string]float64
+	// Priority classes the jobs of which are scheduled even if their queue has exhausted its budget.
+	BudgetExemptPriorityClasses []string
 	// Weights used to compute fair share when using AssetFairness.
 	// Overrides dynamic scarcity calculation if provided.
 	// Applies to both the new and old scheduler.
@@ -206,6 +317,23 @@ type SchedulingConfig struct {
 	//
 	// Applies only to the new scheduler.
 	IndexedTaints []string
+	// Nodes with this label set are considered marked for maintenance.
+	// No new jobs are scheduled onto nodes marked for maintenance (i.e., they're treated as if cordoned)
+	// and jobs running on such nodes are preempted, in order of increasing priority, to drain them.
+	//
+	// If empty, nodes are never drained.
+	NodeMaintenanceLabel string
+	// Maximum number of jobs preempted per scheduling round to drain nodes marked for maintenance.
+	// If zero, all jobs on such nodes are preempted in the first round the node is marked for maintenance.
+	MaxJobsToDrainPerRound uint
+	// If true, resources allocated to each queue are divided fairly between the users submitting jobs to that queue,
+	// i.e., within each queue, queued jobs submitted by the user with the smallest allocation are scheduled first.
+	// Evicted jobs are rescheduled in their original order, so jobs are never preempted to rebalance users within a queue.
+	// Only supported by the new scheduler.
+	EnableUserFairShare bool
+	// Number of queued jobs of each queue considered when choosing which user to schedule a job for next.
+	// Larger values improve fairness between users at the cost of scheduling jobs further out of order.
+	UserFairShareLookahead uint
 	// Default value of GangNodeUniformityLabelAnnotation if none is provided.
 	DefaultGangNodeUniformityLabel string
 	// Kubernetes pods may specify a termination grace period.
@@ -227,7 +355,7 @@ type SchedulingConfig struct {
 	// Max allowed grace period.
 	// Should normally not be set greater than single-digit minutes,
 	// since cancellation and preemption may need to wait for this amount of time.
-	MaxTerminationGracePeriod time.Duration
+	MaxTerminationGracePeriod time.Duration `validate:"gtefield=MinTerminationGracePeriod"`
 	// If an executor hasn't heartbeated in this time period, it will be considered stale
 	ExecutorTimeout time.Duration
 	// Default activeDeadline for all pods that don't explicitly set activeDeadlineSeconds.
@@ -262,8 +390,51 @@ const (
 	// DominantResourceFairness set the cost associated with a queue to
 	// max("CPU allocation" / "CPU capacity", "memory allocation" / "mamory capacity", ...).
 	DominantResourceFairness FairnessModel = "DominantResourceFairness"
+	// CostFairness sets the cost associated with a queue to the hourly price of its total allocation,
+	// as given by the CostModel, such that queues are assigned resources in proportion to their spend.
+	CostFairness FairnessModel = "CostFairness"
 )
 
+// CostModelConfig prices resources, such that the resources allocated to a queue can be expressed as spend,
+// e.g., in dollars per hour, for example to bill the teams using Armada for the resources they use.
+type CostModelConfig struct {
+	// Hourly price of each resource per PriceUnits of that resource,
+	// e.g., map[string]float64{"cpu": 0.04, "memory": 0.005, "nvidia.com/gpu": 2.5}. Resources without a price are free.
+	HourlyPrices map[string]float64
+	// Amount of each resource HourlyPrices refers to, e.g., map[string]resource.Quantity{"memory": "1Gi"}.
+	// Defaults to one unit of the resource, e.g., one cpu or one byte of memory.
+	PriceUnits map[string]resource.Quantity
+	// Factor the prices of resources in each pool are multiplied by, e.g., map[string]float64{"spot": 0.3}.
+	// Defaults to 1.
+	PoolMultipliers map[string]float64
+}
+
+// HourlyPrice returns the hourly price of one unit of resourceType in pool, e.g., of one cpu or one byte of memory.
+func (c CostModelConfig) HourlyPrice(pool string, resourceType string) float64 {
+	price := c.HourlyPrices[resourceType]
+	if price == 0 {
+		return 0
+	}
+	if unit, ok := c.PriceUnits[resourceType]; ok && unit.Sign() == 1 {
+		price /= unit.AsApproximateFloat64()
+	}
+	if multiplier, ok := c.PoolMultipliers[pool]; ok {
+		price *= multiplier
+	}
+	return price
+}
+
+// HourlyCost returns the hourly price of the given resources in pool.
+func (c CostModelConfig) HourlyCost(pool string, resources map[string]resource.Quantity) float64 {
+	var cost float64
+	for t, q := range resources {
+		if price := c.HourlyPrice(pool, t); price != 0 {
+			cost += q.AsApproximateFloat64() * price
+		}
+	}
+	return cost
+}
+
 type IndexedResource struct {
 	// Resource name. E.g., "cpu", "memory", or "nvidia.com/gpu".
 	Name string
@@ -275,19 +446,31 @@ type IndexedResource struct {
 // This scheduler will eventually replace the current scheduler.
 type NewSchedulerConfig struct {
 	Enabled bool
+	// Connection to the database of the new scheduler, used to look up its jobs, e.g., when reprioritising jobs by label.
+	// If no connection is provided, jobs submitted to the new scheduler aren't found by such lookups.
+	Postgres PostgresConfig
 }
 
 // TODO: Remove. Move PriorityClasses and DefaultPriorityClass into SchedulingConfig.
 type PreemptionConfig struct {
 	// If using PreemptToFairShare,
 	// the probability of evicting jobs on a node to balance resource usage.
-	NodeEvictionProbability float64
+	NodeEvictionProbability float64 `validate:"gte=0,lte=1"`
 	// If using PreemptToFairShare,
 	// the probability of evicting jobs on oversubscribed nodes, i.e.,
 	// nodes on which the total resource requests are greater than the available resources.
-	NodeOversubscriptionEvictionProbability float64
+	NodeOversubscriptionEvictionProbability float64 `validate:"gte=0,lte=1"`
 	// Only queues allocated more than this fraction of their fair share are considered for preemption.
 	ProtectedFractionOfFairShare float64
+	// Jobs that started running less than this long ago aren't preempted to balance resources between queues,
+	// such that newly scheduled jobs are guaranteed some minimum runtime. Jobs that haven't started running yet,
+	// e.g., since they're pending, aren't preempted for this reason either.
+	// Such jobs may still be preempted by jobs of higher priority classes. Applies only to the new scheduler.
+	MinimumRuntimeBeforeFairSharePreemption time.Duration
+	// Preempted jobs of job sets with a disruption budget, set via the jobSetMaxPreemptedFraction annotation,
+	// count against the budget of their job set for this long, such that the budget is respected across scheduling rounds.
+	// Applies only to the new scheduler.
+	JobSetDisruptionBudgetWindow time.Duration
 	// If true, the Armada scheduler will add to scheduled pods a node selector
 	// NodeIdLabel: <value of label on node selected by scheduler>.
 	// If true, NodeIdLabel must be non-empty.
@@ -300,7 +483,7 @@ type PreemptionConfig struct {
 	// Map from priority class names to priority classes.
 	// Must be consistent with Kubernetes priority classes.
 	// I.e., priority classes defined here must be defined in all executor clusters and should map to the same priority.
-	PriorityClasses map[string]types.PriorityClass
+	PriorityClasses map[string]types.PriorityClass `validate:"dive"`
 	// Priority class assigned to pods that do not specify one.
 	// Must be an entry in PriorityClasses above.
 	DefaultPriorityClass string
@@ -338,6 +521,83 @@ type LeaseSettings struct {
 	ExpiryLoopInterval time.Duration
 }
 
+const (
+	RedisJobRepository        = "redis"
+	ShardedRedisJobRepository = "shardedRedis"
+	PostgresJobRepository     = "postgres"
+)
+
+// JobRepositoryConfig selects the database jobs are stored in. Other state is stored in Redis regardless.
+type JobRepositoryConfig struct {
+	// One of "redis" (the default), "shardedRedis", or "postgres".
+	Type string
+	// Redis databases jobs are distributed across if Type is "shardedRedis".
+	Shards []RedisShardConfig
+	// Database jobs are stored in if Type is "postgres". Must be a database of its own,
+	// i.e., not the one used for Pulsar submit API deduplication or by the scheduler.
+	Postgres PostgresConfig
+	// Maximum number of jobs read or written per query, if Type is "postgres".
+	BatchSize int
+}
+
+// RedisShardConfig configures a Redis database storing part of the jobs.
+type RedisShardConfig struct {
+	// Jobs are assigned to shards by consistent hashing of their id onto shard names,
+	// so a shard can't be renamed without resharding.
+	Name  string
+	Redis redis.UniversalOptions
+	// If true, no jobs are assigned to the shard. Mark a shard as draining and reshard before removing it.
+	Draining bool
+}
+
+// EventLogConfig selects the system from which job set events are read when writing them to Redis.
+// This is the only consumer of the event log that can read from Kafka; Pulsar is required regardless,
+// since events are published to, and all other consumers read from, Pulsar.
+// When reading from Kafka, the events topic is expected to be mirrored into Kafka, e.g., by a Pulsar IO sink.
+type EventLogConfig struct {
+	// Either "pulsar" (the default) or "kafka".
+	Backend string `validate:"omitempty,oneof=pulsar kafka"`
+	Kafka   KafkaConfig
+}
+
+// KafkaConfig configures reading the event log from Kafka.
+type KafkaConfig struct {
+	Brokers []string
+	// Topic from which job set events are read. Each message is a marshalled armadaevents.EventSequence,
+	// with the properties of the corresponding Pulsar message as headers.
+	Topic string
+	// Consumer group of the servers writing events to Redis. Offsets committed to the group take the place of acks;
+	// messages are always acked cumulatively when reading from Kafka, regardless of Pulsar.RedisFromPulsarCumulativeAck.
+	ConsumerGroup string
+}
+
+// OutboxConfig configures the outbox from which events committed together with changes to Redis are published.
+type OutboxConfig struct {
+	// If true, the events reporting that submitted jobs have been queued are written to the outbox atomically with
+	// the jobs and published from there, such that they're published even if the server crashes after writing the jobs.
+	Enabled bool
+	// Name of the Pulsar producer publishing the outbox. Must be the same for all servers sharing a Redis database,
+	// such that, if deduplication is enabled for the events topic, Pulsar discards events published more than once.
+	ProducerName string
+	// Maximum number of events published at a time.
+	BatchSize int
+	// Time waited between checking the outbox for new events.
+	PollInterval time.Duration
+	// Only one server publishes the outbox at a time. If that server stops renewing its lease on the outbox,
+	// another server takes over after this duration.
+	LeaseDuration time.Duration
+}
+
+// CompressionConfig selects how stored data is compressed.
+type CompressionConfig struct {
+	// Compression algorithm; either zlib or zstd. Defaults to zlib.
+	// Data compressed with either algorithm can always be read, so this can be changed at any time.
+	Algorithm string
+	// If non-empty, path of a dictionary zstd compresses with, e.g., one trained on representative data.
+	// Only supported with zstd. Data compressed with a dictionary can only be read while that dictionary is configured.
+	DictionaryPath string
+}
+
 type PostgresConfig struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
@@ -351,16 +611,229 @@ type QueueManagementConfig struct {
 	DefaultQueuedJobsLimit int
 }
 
+type CronJobSetsConfig struct {
+	// If true, the server submits jobs on behalf of registered cron job sets.
+	Enabled bool
+	// How often to check for cron job sets that are due.
+	// Cron expressions have a resolution of one minute, so there's little point in making this any shorter.
+	Interval time.Duration
+}
+
+type JobRetriesConfig struct {
+	// If true, the server resubmits failed jobs according to their retry policy.
+	Enabled bool
+	// How often to check for retries that are due.
+	Interval time.Duration
+	// Maximum number of attempts of any job, regardless of its retry policy, or unlimited if zero.
+	MaxAttempts int
+	// Maximum time waited before retrying a job, regardless of its retry policy, or unlimited if zero.
+	MaxBackoff time.Duration
+	// Name of the Pulsar subscription used to read job set events.
+	Subscription string
+}
+
+// NotificationsConfig configures how webhooks registered by queue owners are notified of job lifecycle events.
+type NotificationsConfig struct {
+	// If true, the server reads job set events from Pulsar and notifies webhooks of the events they're registered for.
+	Enabled bool
+	// Name of the Pulsar subscription used to read job set events.
+	Subscription string
+	// How often notifications are sent. Notifications for the same webhook are batched in between.
+	BatchInterval time.Duration
+	// Maximum number of notifications sent per request to a webhook.
+	MaxBatchSize int
+	// Maximum number of notifications awaiting delivery per webhook. Any further notifications are dropped.
+	MaxPendingNotifications int
+	// Maximum number of requests to webhooks in flight at a time.
+	MaxConcurrentDeliveries int
+	// Number of attempts at delivering a batch of notifications before giving up.
+	MaxAttempts int
+	// Time waited before retrying a failed delivery, doubled on each attempt up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Time after which a request to a webhook is considered failed.
+	Timeout time.Duration
+	// How often webhooks are reloaded from the database, i.e., how long it may take for changes to take effect.
+	WebhookRefreshInterval time.Duration
+	// Hosts webhooks may be registered for, e.g., hooks.slack.com, or patterns of the form *.example.com matching any
+	// subdomain of example.com. Webhooks may be registered for any host if empty.
+	AllowedHosts []string
+	// If true, webhooks may be delivered to loopback, link-local, private, and carrier-grade NAT addresses. Otherwise, webhooks whose
+	// host is, or resolves to, such an address are rejected, such that webhooks can't be used to reach internal services.
+	AllowPrivateAddresses bool
+	// SMTP server used to deliver notifications to email webhooks.
+	Email   EmailConfig
+	Digests DigestsConfig
+}
+
+// EmailConfig configures the SMTP server notifications are sent by email through.
+type EmailConfig struct {
+	// Address of the SMTP server, as host:port. Email webhooks can't be delivered to if empty.
+	SmtpAddress string
+	// Credentials used to authenticate with the SMTP server using PLAIN authentication, unless Username is empty.
+	Username string
+	Password string
+	// Address emails are sent from.
+	From string
+}
+
+// DigestsConfig configures the periodic summaries of the outcomes of jobs sent to the webhooks registered for digests.
+type DigestsConfig struct {
+	// If true, the server reads job set events from Pulsar to record job outcomes, and sends digests on Schedule.
+	Enabled bool
+	// Name of the Pulsar subscription used to read job set events.
+	Subscription string
+	// Maximum number of messages received at a time, and the maximum time to wait for a batch to fill up.
+	BatchSize     int
+	FlushInterval time.Duration
+	// Cron expression determining when digests are sent, in UTC.
+	Schedule string
+	// Period of time summarized by each digest, ending at the time the digest is due.
+	// Job outcomes are recorded by the hour, so digests summarize whole hours.
+	Window time.Duration
+	// How often to check whether digests are due.
+	Interval time.Duration
+	// Number of most common failure reasons included in each digest.
+	TopFailureReasons int
+	// How long job outcomes are kept for. Must be at least Window.
+	Retention time.Duration
+}
+
+type JobTemplatesConfig struct {
+	// Maximum number of jobs a single job template submission may expand into.
+	MaxExpandedJobs int
+}
+
+type ArrayJobsConfig struct {
+	// Maximum number of tasks in a single array job. Array jobs are rejected if zero.
+	MaxSize int
+}
+
+// ResourceRecommendationsConfig controls how resource requests are recommended from the peak usage of past jobs.
+type ResourceRecommendationsConfig struct {
+	// Percentile of the peak usage of past jobs recommended if a request doesn't specify one, e.g., 95.
+	DefaultPercentile float64 `validate:"gt=0,lte=100"`
+	// Fraction of the recommended usage added to it as a safety margin, e.g., 0.1 to recommend 10% more.
+	Headroom float64 `validate:"gte=0"`
+	// Minimum number of past jobs that reported their usage required to make a recommendation.
+	MinJobs int `validate:"gte=1"`
+	// If true, the requests and limits of jobs annotated with armadaproject.io/applyResourceRecommendation: "true"
+	// are replaced at submission by those recommended from the past jobs of the same job set and job template.
+	ApplyOnSubmit bool
+	// Maximum number of job sets whose usage is cached, such that recommendations only read the events published since
+	// the previous recommendation for the same job set.
+	MaxCachedJobSets int `validate:"gte=1"`
+}
+
+// SubmissionLimitsConfig limits the size of submissions and job sets,
+// to protect Redis and Pulsar from pathologically large submissions.
+type SubmissionLimitsConfig struct {
+	// Limits of queues not in LimitsByQueue.
+	Default SubmissionLimits
+	// Limits of specific queues, which replace the default limits entirely for those queues.
+	LimitsByQueue map[string]SubmissionLimits
+}
+
+// SubmissionLimits are the limits on the submissions to a queue. Zero means unlimited.
+type SubmissionLimits struct {
+	// Maximum number of jobs submitted by a single request, counting each task of an array job
+	// and each pod of a multi-pod job as a job.
+	MaxJobsPerSubmission int `validate:"gte=0"`
+	// Maximum number of jobs submitted to a single job set. Job sets no jobs have been submitted to for two weeks
+	// are considered empty.
+	MaxJobsPerJobSet int `validate:"gte=0"`
+	// Maximum size in bytes of the serialized spec of a single job, as submitted.
+	MaxJobSpecSizeBytes int `validate:"gte=0"`
+}
+
+// ForQueue returns the limits of the given queue.
+func (c SubmissionLimitsConfig) ForQueue(queue string) SubmissionLimits {
+	if limits, ok := c.LimitsByQueue[queue]; ok {
+		return limits
+	}
+	return c.Default
+}
+
+// SubmitWebhookConfig configures an external HTTP(S) endpoint that may reject job submissions.
+// For each submission, the webhook is sent a JSON-encoded admission.Review by POST and must respond with
+// a JSON-encoded admission.Response indicating whether the submission is allowed.
+type SubmitWebhookConfig struct {
+	// Name of the webhook, included in errors returned to users.
+	Name string
+	Url  string
+	// Time after which a request to the webhook is considered failed. Defaults to 10s.
+	Timeout time.Duration
+	// What to do if the webhook can't be reached or returns an invalid response:
+	// "Fail" (the default) rejects the submission, "Ignore" allows it.
+	FailurePolicy string
+	// Path to a PEM-encoded CA certificate used to verify the webhook's TLS certificate.
+	// If empty, the system's root certificates are used.
+	CaCertPath string
+}
+
+// SubmitPolicyConfig configures a Rego policy evaluated for each job submission by an Open Policy Agent (OPA) server.
+// The OPA server is typically run as a sidecar of the Armada server and loads policy bundles from a bundle server
+// or from a mounted configmap, reloading them when they change.
+type SubmitPolicyConfig struct {
+	// Name of the policy, included in errors returned to users.
+	Name string
+	// Base URL of the OPA server, e.g., "http://localhost:8181".
+	OpaUrl string
+	// Path of the rule to evaluate, e.g., "armada/submit/deny" for the rule deny in package armada.submit.
+	// The input to the rule is an admission.Review. The rule must evaluate to a set of messages explaining why
+	// the submission isn't allowed; the submission is allowed if the set is empty.
+	// If the rule is undefined, e.g., because the policy hasn't been loaded, the failure policy applies.
+	Rule string
+	// Time after which a request to the OPA server is considered failed. Defaults to 10s.
+	Timeout time.Duration
+	// What to do if the OPA server can't be reached or returns an invalid response:
+	// "Fail" (the default) rejects the submission, "Ignore" allows it.
+	FailurePolicy string
+	// Path to a PEM-encoded CA certificate used to verify the OPA server's TLS certificate.
+	CaCertPath string
+}
+
+// ImagePolicyConfig restricts the container images jobs may use, optionally with different rules per queue.
+type ImagePolicyConfig struct {
+	// Rules applied to queues without an entry in Queues.
+	Default ImagePolicyRules
+	// Rules for specific queues, which replace the default rules for those queues.
+	Queues map[string]ImagePolicyRules
+}
+
+type ImagePolicyRules struct {
+	// Registries, optionally followed by a repository prefix, e.g., "registry.example.com" or "docker.io/library",
+	// images must be pulled from. Images without a registry, e.g., "ubuntu:22.04", are considered to be from docker.io.
+	// Images from any registry are allowed if empty.
+	AllowedRegistries []string
+	// If true, images must be pinned by digest, e.g., "ubuntu@sha256:<digest>".
+	RequireDigest bool
+}
+
 type MetricsConfig struct {
 	Port                    uint16
 	RefreshInterval         time.Duration
 	ExposeSchedulingMetrics bool
 	Metrics                 SchedulerMetricsConfig
+	Clients                 ClientMetricsConfig
+}
+
+// ClientMetricsConfig restricts the clients recorded by the metrics of submissions by client, which are labelled with
+// values chosen by clients, such that clients can't create arbitrarily many series.
+type ClientMetricsConfig struct {
+	// Client names recorded as such; submissions made with other clients are recorded with the client name and
+	// version "other". Any client name is recorded if empty.
+	AllowedClientNames []string
+	// Submission sources recorded as such; submissions from other sources are recorded with the source "other".
+	// Any submission source is recorded if empty.
+	AllowedSubmissionSources []string
 }
 
 type SchedulerMetricsConfig struct {
 	ScheduleCycleTimeHistogramSettings  HistogramConfig
 	ReconcileCycleTimeHistogramSettings HistogramConfig
+	// Buckets, in seconds, of the time from submission of jobs to their first lease and to their first run starting.
+	QueueingLatencyHistogramSettings HistogramConfig
 }
 
 type HistogramConfig struct {
@@ -0,0 +1,40 @@
+package configuration
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	commonconfig "github.com/armadaproject/armada/internal/common/config"
+)
+
+func init() {
+	commonconfig.RegisterStructValidation(validatePreemptionConfig, PreemptionConfig{})
+	commonconfig.RegisterStructValidation(validateEventLogConfig, EventLogConfig{})
+}
+
+// validatePreemptionConfig checks that the default priority class is one of the configured priority classes.
+func validatePreemptionConfig(sl validator.StructLevel) {
+	config := sl.Current().Interface().(PreemptionConfig)
+	if len(config.PriorityClasses) == 0 {
+		return
+	}
+	if _, ok := config.PriorityClasses[config.DefaultPriorityClass]; !ok {
+		sl.ReportError(config.DefaultPriorityClass, "DefaultPriorityClass", "DefaultPriorityClass", "keyof", "PriorityClasses")
+	}
+}
+
+// validateEventLogConfig checks that the brokers, topic, and consumer group are set when reading from Kafka.
+func validateEventLogConfig(sl validator.StructLevel) {
+	config := sl.Current().Interface().(EventLogConfig)
+	if config.Backend != "kafka" {
+		return
+	}
+	if len(config.Kafka.Brokers) == 0 {
+		sl.ReportError(config.Kafka.Brokers, "Kafka.Brokers", "Brokers", "required_if", "Backend kafka")
+	}
+	if config.Kafka.Topic == "" {
+		sl.ReportError(config.Kafka.Topic, "Kafka.Topic", "Topic", "required_if", "Backend kafka")
+	}
+	if config.Kafka.ConsumerGroup == "" {
+		sl.ReportError(config.Kafka.ConsumerGroup, "Kafka.ConsumerGroup", "ConsumerGroup", "required_if", "Backend kafka")
+	}
+}
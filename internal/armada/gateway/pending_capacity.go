@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+// GET /v1/pending-capacity
+var patternGetPendingCapacity = runtime.MustPattern(runtime.NewPattern(
+	1, []int{2, 0, 2, 1}, []string{"v1", "pending-capacity"}, "", runtime.AssumeColonVerbOpt(true),
+))
+
+// RegisterPendingCapacityHandler registers a REST endpoint returning the pending capacity report of the scheduler,
+// i.e., the queued jobs broken down by resource requests and node constraints, for external node provisioners that
+// can't use gRPC. The backlog may be restricted to a single queue via the query parameter queue.
+func RegisterPendingCapacityHandler(_ context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterPendingCapacityHandlerClient(mux, schedulerobjects.NewSchedulerReportingClient(conn))
+}
+
+// RegisterPendingCapacityHandlerClient is like RegisterPendingCapacityHandler, but gets the report via client.
+func RegisterPendingCapacityHandlerClient(mux *runtime.ServeMux, client schedulerobjects.SchedulerReportingClient) error {
+	mux.Handle("GET", patternGetPendingCapacity, func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		var md runtime.ServerMetadata
+		report, err := client.GetPendingCapacity(
+			rctx,
+			&schedulerobjects.PendingCapacityRequest{QueueName: req.URL.Query().Get("queue")},
+			grpc.Header(&md.HeaderMD),
+			grpc.Trailer(&md.TrailerMD),
+		)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, req, report)
+	})
+	return nil
+}
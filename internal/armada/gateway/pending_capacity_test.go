@@ -0,0 +1,79 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	protoutil "github.com/armadaproject/armada/internal/common/grpc/protoutils"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+func TestGetPendingCapacity(t *testing.T) {
+	client := &fakeReportingClient{report: &schedulerobjects.PendingCapacityReport{
+		Pending: []*schedulerobjects.PendingCapacity{{
+			NodeSelector: map[string]string{"gpu": "a100"},
+			NumJobs:      3,
+			Queues:       []string{"queue"},
+		}},
+	}}
+	server := newPendingCapacityTestServer(t, client)
+
+	resp, err := http.Get(server.URL + "/v1/pending-capacity?queue=queue")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "queue", client.request.QueueName)
+
+	var body struct {
+		Pending []struct {
+			NodeSelector map[string]string `json:"nodeSelector"`
+			NumJobs      int               `json:"numJobs"`
+			Queues       []string          `json:"queues"`
+		} `json:"pending"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Pending, 1)
+	assert.Equal(t, map[string]string{"gpu": "a100"}, body.Pending[0].NodeSelector)
+	assert.Equal(t, 3, body.Pending[0].NumJobs)
+	assert.Equal(t, []string{"queue"}, body.Pending[0].Queues)
+}
+
+func TestGetPendingCapacity_Unauthorized(t *testing.T) {
+	server := newPendingCapacityTestServer(t, &fakeReportingClient{err: status.Error(codes.PermissionDenied, "not allowed")})
+
+	resp, err := http.Get(server.URL + "/v1/pending-capacity")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func newPendingCapacityTestServer(t *testing.T, client schedulerobjects.SchedulerReportingClient) *httptest.Server {
+	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, new(protoutil.JSONMarshaller)))
+	require.NoError(t, RegisterPendingCapacityHandlerClient(mux, client))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// fakeReportingClient implements only the methods used by the tests.
+type fakeReportingClient struct {
+	schedulerobjects.SchedulerReportingClient
+	request *schedulerobjects.PendingCapacityRequest
+	report  *schedulerobjects.PendingCapacityReport
+	err     error
+}
+
+func (c *fakeReportingClient) GetPendingCapacity(_ context.Context, req *schedulerobjects.PendingCapacityRequest, _ ...grpc.CallOption) (*schedulerobjects.PendingCapacityReport, error) {
+	c.request = req
+	return c.report, c.err
+}
@@ -0,0 +1,154 @@
+// Package gateway contains REST endpoints of the Armada server that can't be generated from its gRPC API.
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	// Maximum number of jobs submitted per request to the gRPC API.
+	maxJobsPerBatch = 200
+	// Maximum total size of the jobs submitted per request to the gRPC API, well below the default gRPC message size
+	// limit of 4MiB, to leave room for the defaults the server adds to jobs.
+	maxBatchBytes = 1 << 20
+	// Maximum size of a single job.
+	maxJobBytes = maxBatchBytes
+)
+
+const ndjsonContentType = "application/x-ndjson"
+
+// POST /v1/job/submit/stream
+var patternSubmitJobsStream = runtime.MustPattern(runtime.NewPattern(
+	1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v1", "job", "submit", "stream"}, "", runtime.AssumeColonVerbOpt(true),
+))
+
+// SubmitJobsStreamResponseItem is written for each job read from the request body, in the same order, once the batch
+// containing the job has been submitted. If submitting the remaining jobs fails altogether, e.g., since the user isn't
+// allowed to submit to the queue or a line isn't a valid job, a final item is written with only Error set, and no
+// further jobs are read.
+type SubmitJobsStreamResponseItem struct {
+	// Line of the request body the job was read from, starting at 1.
+	Line int `json:"line,omitempty"`
+	*api.JobSubmitResponseItem
+}
+
+// RegisterSubmitStreamHandler registers a REST endpoint for submitting any number of jobs to a job set, which reads
+// jobs as newline-delimited JSON, i.e., one api.JobSubmitRequestItem per line, and submits them in batches to the
+// gRPC API over conn, such that job sets too large for a single request can be submitted by clients that can't use
+// gRPC. The queue and job set are given via the query parameters queue and jobSetId. The response is
+// newline-delimited JSON too, one SubmitJobsStreamResponseItem per job, written as the jobs are submitted.
+func RegisterSubmitStreamHandler(_ context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterSubmitStreamHandlerClient(mux, api.NewSubmitClient(conn))
+}
+
+// RegisterSubmitStreamHandlerClient is like RegisterSubmitStreamHandler, but submits jobs via client.
+func RegisterSubmitStreamHandlerClient(mux *runtime.ServeMux, client api.SubmitClient) error {
+	mux.Handle("POST", patternSubmitJobsStream, func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		queue := req.URL.Query().Get("queue")
+		jobSetId := req.URL.Query().Get("jobSetId")
+		if queue == "" || jobSetId == "" {
+			err := status.Error(codes.InvalidArgument, "[SubmitJobsStream] queue and jobSetId query parameters must be specified")
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		w.Header().Set("Content-Type", ndjsonContentType)
+		submitJobsStream(rctx, client, queue, jobSetId, req.Body, w)
+	})
+	return nil
+}
+
+// submitJobsStream submits the jobs read from body in batches, writing a response item for each to w.
+func submitJobsStream(ctx context.Context, client api.SubmitClient, queue string, jobSetId string, body io.Reader, w http.ResponseWriter) {
+	encoder := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+	writeError := func(err error) {
+		_ = encoder.Encode(&SubmitJobsStreamResponseItem{JobSubmitResponseItem: &api.JobSubmitResponseItem{Error: err.Error()}})
+	}
+
+	var batch []*api.JobSubmitRequestItem
+	var batchLines []int
+	batchBytes := 0
+	submitBatch := func() bool {
+		if len(batch) == 0 {
+			return true
+		}
+		response, err := client.SubmitJobs(ctx, &api.JobSubmitRequest{
+			Queue:           queue,
+			JobSetId:        jobSetId,
+			JobRequestItems: batch,
+		})
+		if err != nil {
+			writeError(err)
+			return false
+		}
+		for i, item := range response.JobResponseItems {
+			responseItem := &SubmitJobsStreamResponseItem{JobSubmitResponseItem: item}
+			if i < len(batchLines) {
+				responseItem.Line = batchLines[i]
+			}
+			if err := encoder.Encode(responseItem); err != nil {
+				// The client has gone away.
+				return false
+			}
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		batch, batchLines, batchBytes = nil, nil, 0
+		return true
+	}
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxJobBytes)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		item := &api.JobSubmitRequestItem{}
+		if err := json.Unmarshal(data, item); err != nil {
+			if submitBatch() {
+				writeError(fmt.Errorf("[SubmitJobsStream] invalid job on line %d: %s", line, err))
+			}
+			return
+		}
+		if batchBytes+len(data) > maxBatchBytes || len(batch) == maxJobsPerBatch {
+			if !submitBatch() {
+				return
+			}
+		}
+		batch = append(batch, item)
+		batchLines = append(batchLines, line)
+		batchBytes += len(data)
+	}
+	if err := scanner.Err(); err != nil {
+		if submitBatch() {
+			writeError(fmt.Errorf("[SubmitJobsStream] error reading line %d: %s", line+1, err))
+		}
+		return
+	}
+	submitBatch()
+}
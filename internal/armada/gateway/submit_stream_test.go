@@ -0,0 +1,130 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmitJobsStream(t *testing.T) {
+	client := &fakeSubmitClient{}
+	server := newTestServer(t, client)
+
+	var body strings.Builder
+	for i := 0; i < maxJobsPerBatch+1; i++ {
+		fmt.Fprintf(&body, "{\"priority\": %d}\n", i)
+		if i == 0 {
+			body.WriteString("\n")
+		}
+	}
+	resp, err := http.Post(server.URL+"/v1/job/submit/stream?queue=queue&jobSetId=set", ndjsonContentType, strings.NewReader(body.String()))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	items := readResponseItems(t, resp)
+	require.Len(t, items, maxJobsPerBatch+1)
+	assert.Equal(t, &SubmitJobsStreamResponseItem{Line: 1, JobSubmitResponseItem: &api.JobSubmitResponseItem{JobId: "job-0"}}, items[0])
+	// Line 2 is blank.
+	assert.Equal(t, &SubmitJobsStreamResponseItem{Line: 3, JobSubmitResponseItem: &api.JobSubmitResponseItem{JobId: "job-1"}}, items[1])
+	assert.Equal(t, maxJobsPerBatch+2, items[maxJobsPerBatch].Line)
+
+	require.Len(t, client.requests, 2)
+	assert.Len(t, client.requests[0].JobRequestItems, maxJobsPerBatch)
+	assert.Len(t, client.requests[1].JobRequestItems, 1)
+	for _, request := range client.requests {
+		assert.Equal(t, "queue", request.Queue)
+		assert.Equal(t, "set", request.JobSetId)
+	}
+}
+
+func TestSubmitJobsStream_InvalidJob(t *testing.T) {
+	client := &fakeSubmitClient{}
+	server := newTestServer(t, client)
+
+	resp, err := http.Post(server.URL+"/v1/job/submit/stream?queue=queue&jobSetId=set", ndjsonContentType, strings.NewReader("{}\nnot json\n{}\n"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	items := readResponseItems(t, resp)
+	require.Len(t, items, 2)
+	assert.Equal(t, "job-0", items[0].JobId)
+	assert.Contains(t, items[1].Error, "invalid job on line 2")
+	require.Len(t, client.requests, 1)
+}
+
+func TestSubmitJobsStream_SubmitFails(t *testing.T) {
+	client := &fakeSubmitClient{err: status.Error(codes.PermissionDenied, "not allowed")}
+	server := newTestServer(t, client)
+
+	resp, err := http.Post(server.URL+"/v1/job/submit/stream?queue=queue&jobSetId=set", ndjsonContentType, strings.NewReader("{}\n{}\n"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	items := readResponseItems(t, resp)
+	require.Len(t, items, 1)
+	assert.Contains(t, items[0].Error, "not allowed")
+}
+
+func TestSubmitJobsStream_MissingQueue(t *testing.T) {
+	server := newTestServer(t, &fakeSubmitClient{})
+
+	resp, err := http.Post(server.URL+"/v1/job/submit/stream?jobSetId=set", ndjsonContentType, strings.NewReader("{}\n"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func newTestServer(t *testing.T, client api.SubmitClient) *httptest.Server {
+	mux := runtime.NewServeMux()
+	require.NoError(t, RegisterSubmitStreamHandlerClient(mux, client))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func readResponseItems(t *testing.T, resp *http.Response) []*SubmitJobsStreamResponseItem {
+	var items []*SubmitJobsStreamResponseItem
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		item := &SubmitJobsStreamResponseItem{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), item))
+		items = append(items, item)
+	}
+	require.NoError(t, scanner.Err())
+	return items
+}
+
+type fakeSubmitClient struct {
+	api.SubmitClient
+	requests []*api.JobSubmitRequest
+	err      error
+	numJobs  int
+}
+
+func (c *fakeSubmitClient) SubmitJobs(_ context.Context, in *api.JobSubmitRequest, _ ...grpc.CallOption) (*api.JobSubmitResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.requests = append(c.requests, in)
+	response := &api.JobSubmitResponse{}
+	for range in.JobRequestItems {
+		response.JobResponseItems = append(response.JobResponseItems, &api.JobSubmitResponseItem{JobId: fmt.Sprintf("job-%d", c.numJobs)})
+		c.numJobs++
+	}
+	return response, nil
+}
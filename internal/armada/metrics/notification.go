@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Outcomes of delivering a batch of notifications to a webhook.
+const (
+	NotificationDeliverySucceeded = "succeeded"
+	// The webhook rejected the notifications, and they weren't retried.
+	NotificationDeliveryRejected = "rejected"
+	// All attempts at delivering the notifications failed.
+	NotificationDeliveryExhausted = "exhausted"
+)
+
+var notifications = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_notifications_total",
+		Help: "Number of notifications of job lifecycle events queued for delivery to webhooks, grouped by event",
+	},
+	[]string{"event"},
+)
+
+var droppedNotifications = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_notifications_dropped_total",
+		Help: "Number of notifications dropped since too many notifications were awaiting delivery to the same webhook",
+	},
+	[]string{"kind"},
+)
+
+var notificationDeliveries = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_notification_deliveries_total",
+		Help: "Number of batches of notifications delivered to webhooks, grouped by webhook kind and outcome",
+	},
+	[]string{"kind", "result"},
+)
+
+var notificationDeliveryAttempts = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_notification_delivery_attempts_total",
+		Help: "Number of requests made to webhooks, including retries, grouped by webhook kind",
+	},
+	[]string{"kind"},
+)
+
+var notificationDeliveryLatency = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "armada_notification_delivery_latency_seconds",
+		Help:    "Time taken to deliver a batch of notifications to a webhook, including retries, grouped by webhook kind and outcome",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 15),
+	},
+	[]string{"kind", "result"},
+)
+
+// RecordNotification records that a notification of the named event was queued for delivery.
+func RecordNotification(event string) {
+	notifications.WithLabelValues(event).Inc()
+}
+
+// RecordNotificationDropped records that a notification for a webhook of the given kind was dropped.
+func RecordNotificationDropped(kind string) {
+	droppedNotifications.WithLabelValues(kind).Inc()
+}
+
+// RecordNotificationDeliveryAttempt records a request made to a webhook of the given kind.
+func RecordNotificationDeliveryAttempt(kind string) {
+	notificationDeliveryAttempts.WithLabelValues(kind).Inc()
+}
+
+// RecordNotificationDelivery records the outcome of delivering a batch of notifications to a webhook of the given
+// kind, and how long it took.
+func RecordNotificationDelivery(kind string, result string, duration time.Duration) {
+	notificationDeliveries.WithLabelValues(kind, result).Inc()
+	notificationDeliveryLatency.WithLabelValues(kind, result).Observe(duration.Seconds())
+}
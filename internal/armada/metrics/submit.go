@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/clientinfo"
+)
+
+var clientLabels = []string{"client_name", "client_version", "submission_source"}
+
+var submitRequestsByClient = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_submit_requests_by_client_total",
+		Help: "Number of successful job submission requests, grouped by the client they were made with",
+	},
+	clientLabels,
+)
+
+var submittedJobsByClient = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_submitted_jobs_by_client_total",
+		Help: "Number of jobs submitted, excluding duplicates, grouped by the client they were submitted with",
+	},
+	clientLabels,
+)
+
+// RecordJobsSubmitted records a successful submit request for numJobs jobs made with the given client.
+// Clients that don't identify themselves are recorded as "unknown", and clients not allowed by config as "other".
+func RecordJobsSubmitted(info clientinfo.ClientInfo, config configuration.ClientMetricsConfig, numJobs int) {
+	labels := prometheus.Labels{
+		"client_name":       valueOrUnknown(info.Name),
+		"client_version":    valueOrUnknown(info.Version),
+		"submission_source": valueOrUnknown(info.Source),
+	}
+	if !isAllowed(info.Name, config.AllowedClientNames) {
+		// Versions of unknown clients are meaningless, and would otherwise be unbounded.
+		labels["client_name"] = clientinfo.Other
+		labels["client_version"] = clientinfo.Other
+	}
+	if !isAllowed(info.Source, config.AllowedSubmissionSources) {
+		labels["submission_source"] = clientinfo.Other
+	}
+	submitRequestsByClient.With(labels).Inc()
+	submittedJobsByClient.With(labels).Add(float64(numJobs))
+}
+
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return clientinfo.Unknown
+	}
+	return value
+}
+
+// isAllowed returns true if value is empty, i.e., recorded as "unknown", or in allowed, or if allowed is empty.
+func isAllowed(value string, allowed []string) bool {
+	return value == "" || len(allowed) == 0 || slices.Contains(allowed, value)
+}
+
+var rejectedSubmissions = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_rejected_submissions_total",
+		Help: "Number of job submission requests rejected by admission validators, e.g., validating webhooks",
+	},
+	[]string{"validator"},
+)
+
+// RecordSubmissionRejected records that a submit request was rejected by the named admission validator.
+func RecordSubmissionRejected(validator string) {
+	rejectedSubmissions.WithLabelValues(validator).Inc()
+}
+
+// Kinds of failures when applying events read from the event log.
+const (
+	// The events couldn't be applied, but may be after retrying.
+	TransientEventProcessingFailure = "transient"
+	// The events can't be applied and are dead-lettered without retrying.
+	PermanentEventProcessingFailure = "permanent"
+	// The events were dead-lettered after exhausting the retry policy.
+	ExhaustedEventProcessingFailure = "exhausted"
+)
+
+var eventProcessingFailures = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_event_processing_failures_total",
+		Help: "Number of failures to apply events read from the event log, grouped by whether the failure is transient, permanent, or exhausted retrying",
+	},
+	[]string{"kind"},
+)
+
+// RecordEventProcessingFailure records a failure of the given kind to apply events read from the event log.
+func RecordEventProcessingFailure(kind string) {
+	eventProcessingFailures.WithLabelValues(kind).Inc()
+}
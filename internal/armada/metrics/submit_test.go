@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/clientinfo"
+)
+
+func TestRecordJobsSubmitted_RecordsClientsNotAllowedAsOther(t *testing.T) {
+	config := configuration.ClientMetricsConfig{
+		AllowedClientNames:       []string{"armadactl"},
+		AllowedSubmissionSources: []string{"airflow"},
+	}
+	submittedJobsByClient.Reset()
+
+	RecordJobsSubmitted(clientinfo.ClientInfo{Name: "armadactl", Version: "v0.3.100", Source: "airflow"}, config, 2)
+	RecordJobsSubmitted(clientinfo.ClientInfo{Name: "my-client", Version: "v1", Source: "my-source"}, config, 3)
+	RecordJobsSubmitted(clientinfo.ClientInfo{Name: "another-client", Version: "v2"}, config, 4)
+	RecordJobsSubmitted(clientinfo.ClientInfo{}, config, 5)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(submittedJobsByClient.WithLabelValues("armadactl", "v0.3.100", "airflow")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(submittedJobsByClient.WithLabelValues("other", "other", "other")))
+	assert.Equal(t, 4.0, testutil.ToFloat64(submittedJobsByClient.WithLabelValues("other", "other", "unknown")))
+	assert.Equal(t, 5.0, testutil.ToFloat64(submittedJobsByClient.WithLabelValues("unknown", "unknown", "unknown")))
+	assert.Equal(t, 4, testutil.CollectAndCount(submittedJobsByClient))
+}
+
+func TestRecordJobsSubmitted_RecordsAnyClientWithoutAllowlist(t *testing.T) {
+	submittedJobsByClient.Reset()
+
+	RecordJobsSubmitted(clientinfo.ClientInfo{Name: "my-client", Version: "v1", Source: "my-source"}, configuration.ClientMetricsConfig{}, 3)
+
+	assert.Equal(t, 3.0, testutil.ToFloat64(submittedJobsByClient.WithLabelValues("my-client", "v1", "my-source")))
+}
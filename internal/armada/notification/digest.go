@@ -0,0 +1,172 @@
+package notification
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Resources reported in GiB-hours, rather than unit-hours, in digests.
+var byteResources = map[string]bool{
+	"memory":            true,
+	"ephemeral-storage": true,
+}
+
+// Digest summarizes the outcomes of the jobs of a queue that finished within some period of time.
+type Digest struct {
+	Queue               string
+	Start               time.Time
+	End                 time.Time
+	JobSets             int64
+	JobSetsWithFailures int64
+	Succeeded           int64
+	Failed              int64
+	Cancelled           int64
+	// Most common failure reasons, most common first.
+	TopFailureReasons []*FailureReason
+	// Resources allocated to the jobs multiplied by the number of hours they ran for, by resource name.
+	ResourceHours map[string]float64
+}
+
+type FailureReason struct {
+	Reason string `json:"reason"`
+	Count  int64  `json:"count"`
+}
+
+// NewDigest returns a digest of totals, including at most topFailureReasons failure reasons.
+func NewDigest(queue string, start time.Time, end time.Time, totals *repository.DigestTotals, topFailureReasons int) *Digest {
+	digest := &Digest{
+		Queue:               queue,
+		Start:               start,
+		End:                 end,
+		JobSets:             totals.JobSets,
+		JobSetsWithFailures: totals.JobSetsWithFailures,
+		Succeeded:           totals.Succeeded,
+		Failed:              totals.Failed,
+		Cancelled:           totals.Cancelled,
+		ResourceHours:       make(map[string]float64, len(totals.ResourceSeconds)),
+	}
+	for reason, count := range totals.FailureReasons {
+		digest.TopFailureReasons = append(digest.TopFailureReasons, &FailureReason{Reason: reason, Count: count})
+	}
+	sort.Slice(digest.TopFailureReasons, func(i, j int) bool {
+		a, b := digest.TopFailureReasons[i], digest.TopFailureReasons[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Reason < b.Reason
+	})
+	if len(digest.TopFailureReasons) > topFailureReasons {
+		digest.TopFailureReasons = digest.TopFailureReasons[:topFailureReasons]
+	}
+	for name, seconds := range totals.ResourceSeconds {
+		digest.ResourceHours[name] = seconds / time.Hour.Seconds()
+	}
+	return digest
+}
+
+// Finished returns the number of jobs that finished.
+func (d *Digest) Finished() int64 {
+	return d.Succeeded + d.Failed + d.Cancelled
+}
+
+// SuccessRate returns the fraction of finished jobs that succeeded, or 0 if no jobs finished.
+func (d *Digest) SuccessRate() float64 {
+	if d.Finished() == 0 {
+		return 0
+	}
+	return float64(d.Succeeded) / float64(d.Finished())
+}
+
+// genericDigest is the JSON representation of a digest sent to generic webhooks.
+type genericDigest struct {
+	Queue               string             `json:"queue"`
+	Start               time.Time          `json:"start"`
+	End                 time.Time          `json:"end"`
+	JobSets             int64              `json:"jobSets"`
+	JobSetsWithFailures int64              `json:"jobSetsWithFailures"`
+	Succeeded           int64              `json:"succeeded"`
+	Failed              int64              `json:"failed"`
+	Cancelled           int64              `json:"cancelled"`
+	SuccessRate         float64            `json:"successRate"`
+	TopFailureReasons   []*FailureReason   `json:"topFailureReasons"`
+	ResourceHours       map[string]float64 `json:"resourceHours"`
+}
+
+type genericDigestPayload struct {
+	Digest *genericDigest `json:"digest"`
+}
+
+// digestPayload returns the body of a request delivering digest to a webhook of the provided kind.
+func digestPayload(kind api.WebhookKind, digest *Digest) interface{} {
+	if kind == api.WebhookKind_WEBHOOK_SLACK {
+		return &slackPayload{Text: digestText(digest, true)}
+	}
+	topFailureReasons := digest.TopFailureReasons
+	if topFailureReasons == nil {
+		topFailureReasons = []*FailureReason{}
+	}
+	return &genericDigestPayload{Digest: &genericDigest{
+		Queue:               digest.Queue,
+		Start:               digest.Start,
+		End:                 digest.End,
+		JobSets:             digest.JobSets,
+		JobSetsWithFailures: digest.JobSetsWithFailures,
+		Succeeded:           digest.Succeeded,
+		Failed:              digest.Failed,
+		Cancelled:           digest.Cancelled,
+		SuccessRate:         digest.SuccessRate(),
+		TopFailureReasons:   topFailureReasons,
+		ResourceHours:       digest.ResourceHours,
+	}}
+}
+
+func digestSubject(digest *Digest) string {
+	return fmt.Sprintf("Armada digest of queue %s for %s", digest.Queue, digest.End.UTC().Format("2006-01-02"))
+}
+
+// digestText returns a human-readable summary of digest, prefixed with an emoji if it's to be sent to Slack.
+func digestText(digest *Digest, slack bool) string {
+	const timeFormat = "2006-01-02 15:04 MST"
+	title := fmt.Sprintf(
+		"Digest of queue `%s` from %s to %s",
+		digest.Queue, digest.Start.UTC().Format(timeFormat), digest.End.UTC().Format(timeFormat),
+	)
+	if slack {
+		title = ":bar_chart: " + title
+	}
+	lines := []string{title, fmt.Sprintf(
+		"%d jobs of %d job sets finished: %d succeeded, %d failed, %d cancelled (%.1f%% success rate)",
+		digest.Finished(), digest.JobSets, digest.Succeeded, digest.Failed, digest.Cancelled, 100*digest.SuccessRate(),
+	)}
+	if digest.JobSetsWithFailures > 0 {
+		lines = append(lines, fmt.Sprintf("%d job sets had failed jobs", digest.JobSetsWithFailures))
+	}
+	if len(digest.TopFailureReasons) > 0 {
+		lines = append(lines, "Top failure reasons:")
+		for _, reason := range digest.TopFailureReasons {
+			lines = append(lines, fmt.Sprintf("• %s: %d", reason.Reason, reason.Count))
+		}
+	}
+	if len(digest.ResourceHours) > 0 {
+		names := make([]string, 0, len(digest.ResourceHours))
+		for name := range digest.ResourceHours {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		usage := make([]string, len(names))
+		for i, name := range names {
+			if byteResources[name] {
+				usage[i] = fmt.Sprintf("%s %.1f GiB-hours", name, digest.ResourceHours[name]/(1<<30))
+			} else {
+				usage[i] = fmt.Sprintf("%s %.1f hours", name, digest.ResourceHours[name])
+			}
+		}
+		lines = append(lines, "Resources allocated: "+strings.Join(usage, ", "))
+	}
+	return strings.Join(lines, "\n")
+}
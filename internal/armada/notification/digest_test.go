@@ -0,0 +1,208 @@
+package notification
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/metrics"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func testDigest() *Digest {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	return NewDigest("queue", start, start.Add(24*time.Hour), &repository.DigestTotals{
+		JobSets:             3,
+		JobSetsWithFailures: 1,
+		Succeeded:           6,
+		Failed:              3,
+		Cancelled:           1,
+		FailureReasons:      map[string]int64{"OOM": 2, "exit code 1": 1, "Evicted": 1},
+		ResourceSeconds:     map[string]float64{"cpu": 5400, "memory": 3600 * (1 << 30)},
+	}, 2)
+}
+
+func TestNewDigest(t *testing.T) {
+	digest := testDigest()
+	assert.Equal(t, []*FailureReason{{Reason: "OOM", Count: 2}, {Reason: "Evicted", Count: 1}}, digest.TopFailureReasons)
+	assert.Equal(t, map[string]float64{"cpu": 1.5, "memory": 1 << 30}, digest.ResourceHours)
+	assert.Equal(t, int64(10), digest.Finished())
+	assert.Equal(t, 0.6, digest.SuccessRate())
+}
+
+func TestDigestPayload_Generic(t *testing.T) {
+	data, err := json.Marshal(digestPayload(api.WebhookKind_WEBHOOK_GENERIC, testDigest()))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"digest": {
+		"queue": "queue",
+		"start": "2023-01-01T00:00:00Z",
+		"end": "2023-01-02T00:00:00Z",
+		"jobSets": 3,
+		"jobSetsWithFailures": 1,
+		"succeeded": 6,
+		"failed": 3,
+		"cancelled": 1,
+		"successRate": 0.6,
+		"topFailureReasons": [{"reason": "OOM", "count": 2}, {"reason": "Evicted", "count": 1}],
+		"resourceHours": {"cpu": 1.5, "memory": 1073741824}
+	}}`, string(data))
+}
+
+func TestDigestPayload_Slack(t *testing.T) {
+	data, err := json.Marshal(digestPayload(api.WebhookKind_WEBHOOK_SLACK, testDigest()))
+	require.NoError(t, err)
+	p := &slackPayload{}
+	require.NoError(t, json.Unmarshal(data, p))
+	assert.Equal(t, strings.Join([]string{
+		":bar_chart: Digest of queue `queue` from 2023-01-01 00:00 UTC to 2023-01-02 00:00 UTC",
+		"10 jobs of 3 job sets finished: 6 succeeded, 3 failed, 1 cancelled (60.0% success rate)",
+		"1 job sets had failed jobs",
+		"Top failure reasons:",
+		"• OOM: 2",
+		"• Evicted: 1",
+		"Resources allocated: cpu 1.5 hours, memory 1.0 GiB-hours",
+	}, "\n"), p.Text)
+}
+
+func TestEmailRecipients(t *testing.T) {
+	recipients, err := EmailRecipients("mailto:a@example.com,Bob%20%3Cb@example.com%3E")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, recipients)
+
+	for _, url := range []string{"", "https://example.com", "mailto:", "mailto:not-an-address"} {
+		_, err := EmailRecipients(url)
+		assert.Error(t, err, url)
+	}
+}
+
+// smtpServer is a minimal SMTP server that records the recipients and contents of the emails it receives,
+// and rejects recipients in rejected with the provided code.
+type smtpServer struct {
+	listener   net.Listener
+	rejected   map[string]string
+	mu         sync.Mutex
+	recipients []string
+	messages   []string
+}
+
+func newSmtpServer(t *testing.T, rejected map[string]string) *smtpServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &smtpServer{listener: listener, rejected: rejected}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			address := strings.Trim(strings.TrimPrefix(command, "RCPT TO:"), "<>")
+			if code, ok := s.rejected[address]; ok {
+				reply(code + " rejected")
+				continue
+			}
+			s.mu.Lock()
+			s.recipients = append(s.recipients, address)
+			s.mu.Unlock()
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 go ahead")
+			var message strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				message.WriteString(line)
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, message.String())
+			s.mu.Unlock()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestDispatcher_DeliverDigest_Email(t *testing.T) {
+	server := newSmtpServer(t, map[string]string{"busy@example.com": "451", "unknown@example.com": "550"})
+	d := testDispatcher(configuration.NotificationsConfig{
+		MaxAttempts: 2,
+		Email:       configuration.EmailConfig{SmtpAddress: server.listener.Addr().String(), From: "armada@example.com"},
+	})
+	ctx := armadacontext.Background()
+
+	webhook := &api.Webhook{Id: "id", Queue: "queue", Kind: api.WebhookKind_WEBHOOK_EMAIL, Url: "mailto:a@example.com,b@example.com"}
+	assert.Equal(t, metrics.NotificationDeliverySucceeded, d.DeliverDigest(ctx, webhook, testDigest()))
+	server.mu.Lock()
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, server.recipients)
+	require.Len(t, server.messages, 1)
+	assert.Contains(t, server.messages[0], "From: armada@example.com\r\n")
+	assert.Contains(t, server.messages[0], "To: a@example.com, b@example.com\r\n")
+	assert.Contains(t, server.messages[0], "Subject: Armada digest of queue queue for 2023-01-02\r\n")
+	assert.Contains(t, server.messages[0], "\r\nDigest of queue `queue` from 2023-01-01 00:00 UTC to 2023-01-02 00:00 UTC\r\n")
+	server.mu.Unlock()
+
+	webhook.Url = "mailto:busy@example.com"
+	assert.Equal(t, metrics.NotificationDeliveryExhausted, d.DeliverDigest(ctx, webhook, testDigest()))
+	webhook.Url = "mailto:unknown@example.com"
+	assert.Equal(t, metrics.NotificationDeliveryRejected, d.DeliverDigest(ctx, webhook, testDigest()))
+}
+
+func TestDispatcher_DeliverDigest_EmailNotConfigured(t *testing.T) {
+	d := testDispatcher(configuration.NotificationsConfig{MaxAttempts: 3})
+	webhook := &api.Webhook{Id: "id", Queue: "queue", Kind: api.WebhookKind_WEBHOOK_EMAIL, Url: "mailto:a@example.com"}
+	assert.Equal(t, metrics.NotificationDeliveryRejected, d.DeliverDigest(armadacontext.Background(), webhook, testDigest()))
+}
+
+func TestDispatcher_DeliverDigest_Generic(t *testing.T) {
+	server := newWebhookServer(t, 503)
+	webhook := &api.Webhook{Id: "id", Queue: "queue", Url: server.URL}
+	d := testDispatcher(configuration.NotificationsConfig{MaxAttempts: 2})
+	assert.Equal(t, metrics.NotificationDeliverySucceeded, d.DeliverDigest(armadacontext.Background(), webhook, testDigest()))
+
+	requests := server.requests()
+	require.Len(t, requests, 2)
+	p := &genericDigestPayload{}
+	require.NoError(t, json.Unmarshal(requests[1], p))
+	assert.Equal(t, "queue", p.Digest.Queue)
+	assert.Equal(t, int64(6), p.Digest.Succeeded)
+}
@@ -0,0 +1,282 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	neturl "net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/metrics"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	defaultBatchInterval = 10 * time.Second
+	defaultTimeout       = 10 * time.Second
+	// Responses are read up to this size, such that connections can be reused, and otherwise ignored.
+	maxResponseBytes = 1 << 16
+	// Maximum number of redirects followed per request.
+	maxRedirects = 10
+)
+
+// Dispatcher delivers notifications to webhooks. Notifications for the same webhook are batched and delivered
+// periodically. Deliveries that fail since the webhook can't be reached, is rate-limiting requests, or returns a
+// server error are retried with exponential backoff. Notifications may be delivered out of order.
+type Dispatcher struct {
+	client *http.Client
+	// Restricts the hosts notifications are delivered to.
+	hostPolicy              HostPolicy
+	batchInterval           time.Duration
+	maxBatchSize            int
+	maxPendingNotifications int
+	maxAttempts             int
+	minBackoff              time.Duration
+	maxBackoff              time.Duration
+	// Used to deliver to email webhooks.
+	email *emailSender
+	// Limits the number of deliveries in flight.
+	deliverySlots chan struct{}
+	mu            sync.Mutex
+	// Notifications awaiting delivery, by webhook.
+	pending map[string]*batch
+}
+
+type batch struct {
+	webhook       *api.Webhook
+	notifications []*Notification
+}
+
+func NewDispatcher(config configuration.NotificationsConfig) *Dispatcher {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	batchInterval := config.BatchInterval
+	if batchInterval <= 0 {
+		batchInterval = defaultBatchInterval
+	}
+	maxConcurrentDeliveries := config.MaxConcurrentDeliveries
+	if maxConcurrentDeliveries <= 0 {
+		maxConcurrentDeliveries = 1
+	}
+	hostPolicy := NewHostPolicy(config)
+	return &Dispatcher{
+		client:                  newWebhookClient(hostPolicy, timeout),
+		hostPolicy:              hostPolicy,
+		batchInterval:           batchInterval,
+		maxBatchSize:            config.MaxBatchSize,
+		maxPendingNotifications: config.MaxPendingNotifications,
+		maxAttempts:             config.MaxAttempts,
+		minBackoff:              config.MinBackoff,
+		maxBackoff:              config.MaxBackoff,
+		email:                   newEmailSender(config.Email, timeout),
+		deliverySlots:           make(chan struct{}, maxConcurrentDeliveries),
+		pending:                 make(map[string]*batch),
+	}
+}
+
+// newWebhookClient returns a client only connecting to, and following redirects to, hosts allowed by hostPolicy.
+// The addresses hostnames resolve to are checked when connecting, such that DNS can't be used to bypass hostPolicy.
+// Proxies aren't used, since the address of the webhook couldn't be checked otherwise.
+func newWebhookClient(hostPolicy HostPolicy, timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   hostPolicy.control,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if !hostPolicy.AllowsHost(req.URL.Hostname()) {
+				return errors.New("redirect to a host that isn't allowed")
+			}
+			return nil
+		},
+	}
+}
+
+// Dispatch queues notification for delivery to webhook with the next batch.
+// If too many notifications are already awaiting delivery to webhook, the notification is dropped.
+func (d *Dispatcher) Dispatch(webhook *api.Webhook, notification *Notification) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := webhook.Queue + "/" + webhook.Id
+	b, ok := d.pending[key]
+	if !ok {
+		b = &batch{}
+		d.pending[key] = b
+	}
+	if d.maxPendingNotifications > 0 && len(b.notifications) >= d.maxPendingNotifications {
+		metrics.RecordNotificationDropped(webhook.Kind.ShortName())
+		return
+	}
+	b.webhook = webhook
+	b.notifications = append(b.notifications, notification)
+	metrics.RecordNotification(notification.Event.ShortName())
+}
+
+// Run delivers queued notifications every batch interval until ctx is cancelled,
+// after which it waits for deliveries in flight to be abandoned.
+func (d *Dispatcher) Run(ctx *armadacontext.Context) error {
+	ctx.Info("notification dispatcher started")
+	ticker := time.NewTicker(d.batchInterval)
+	defer ticker.Stop()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		select {
+		case <-ctx.Done():
+			ctx.Info("notification dispatcher stopped")
+			return nil
+		case <-ticker.C:
+			d.flush(ctx, &wg)
+		}
+	}
+}
+
+// flush starts delivering all queued notifications, in batches of at most maxBatchSize notifications per request.
+// Blocks while the maximum number of deliveries are in flight.
+func (d *Dispatcher) flush(ctx *armadacontext.Context, wg *sync.WaitGroup) {
+	d.mu.Lock()
+	pending := d.pending
+	d.pending = make(map[string]*batch)
+	d.mu.Unlock()
+
+	for _, b := range pending {
+		batchSize := d.maxBatchSize
+		if batchSize <= 0 {
+			batchSize = len(b.notifications)
+		}
+		for start := 0; start < len(b.notifications); start += batchSize {
+			end := start + batchSize
+			if end > len(b.notifications) {
+				end = len(b.notifications)
+			}
+			webhook, notifications := b.webhook, b.notifications[start:end]
+			select {
+			case d.deliverySlots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-d.deliverySlots }()
+				d.Deliver(ctx, webhook, notifications)
+			}()
+		}
+	}
+}
+
+// Deliver sends notifications to webhook in a single request, retrying if the request fails with a retryable error.
+// Returns the outcome, i.e., one of metrics.NotificationDeliverySucceeded, metrics.NotificationDeliveryRejected,
+// and metrics.NotificationDeliveryExhausted.
+func (d *Dispatcher) Deliver(ctx *armadacontext.Context, webhook *api.Webhook, notifications []*Notification) string {
+	what := fmt.Sprintf("%d notifications", len(notifications))
+	return d.deliver(ctx, webhook, what, func() (bool, error) {
+		return d.post(ctx, webhook.Url, payload(webhook.Kind, notifications))
+	})
+}
+
+// DeliverDigest sends digest to webhook, retrying if delivery fails with a retryable error.
+// Returns the outcome, as Deliver does.
+func (d *Dispatcher) DeliverDigest(ctx *armadacontext.Context, webhook *api.Webhook, digest *Digest) string {
+	return d.deliver(ctx, webhook, "digest", func() (bool, error) {
+		if webhook.Kind == api.WebhookKind_WEBHOOK_EMAIL {
+			to, err := EmailRecipients(webhook.Url)
+			if err != nil {
+				return false, err
+			}
+			return d.email.send(ctx, to, digestSubject(digest), digestText(digest, false))
+		}
+		return d.post(ctx, webhook.Url, digestPayload(webhook.Kind, digest))
+	})
+}
+
+// deliver calls send until it succeeds, returns a non-retryable error, or maxAttempts attempts have been made,
+// and records the outcome. what describes what's being delivered in logs.
+func (d *Dispatcher) deliver(ctx *armadacontext.Context, webhook *api.Webhook, what string, send func() (bool, error)) string {
+	start := time.Now()
+	result := d.retry(ctx, webhook, what, send)
+	metrics.RecordNotificationDelivery(webhook.Kind.ShortName(), result, time.Since(start))
+	return result
+}
+
+func (d *Dispatcher) retry(ctx *armadacontext.Context, webhook *api.Webhook, what string, send func() (bool, error)) string {
+	log := ctx.WithField(logging.QueueField, webhook.Queue).WithField("webhook", webhook.Id)
+	backoff := d.minBackoff
+	for attempt := 1; ; attempt++ {
+		metrics.RecordNotificationDeliveryAttempt(webhook.Kind.ShortName())
+		retryable, err := send()
+		if err == nil {
+			return metrics.NotificationDeliverySucceeded
+		} else if !retryable {
+			log.WithError(err).Warnf("webhook rejected %s", what)
+			return metrics.NotificationDeliveryRejected
+		} else if attempt >= d.maxAttempts {
+			log.WithError(err).Warnf("failed to deliver %s after %d attempts", what, attempt)
+			return metrics.NotificationDeliveryExhausted
+		}
+		log.WithError(err).Infof("failed to deliver %s; retrying in %s", what, backoff)
+		select {
+		case <-ctx.Done():
+			return metrics.NotificationDeliveryExhausted
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if d.maxBackoff > 0 && backoff > d.maxBackoff {
+			backoff = d.maxBackoff
+		}
+	}
+}
+
+// post sends payload to url by POST, marshalled as JSON.
+// Returns an error if the request fails, and whether it may succeed if retried.
+func (d *Dispatcher) post(ctx *armadacontext.Context, url string, payload interface{}) (bool, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	// Webhooks registered before their host was disallowed aren't delivered to.
+	if !d.hostPolicy.AllowsHost(req.URL.Hostname()) {
+		return false, errors.New("webhook host isn't allowed")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := d.client.Do(req)
+	if err != nil {
+		// Don't include the URL, which for e.g. Slack webhooks is a secret, in the error.
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return true, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retryable, errors.Errorf("unexpected status %s", resp.Status)
+}
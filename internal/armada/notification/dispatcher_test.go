@@ -0,0 +1,187 @@
+package notification
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/metrics"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// webhookServer records the bodies of requests it receives and responds with the next of statuses,
+// or with 200 once statuses are exhausted.
+type webhookServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	bodies   [][]byte
+}
+
+func newWebhookServer(t *testing.T, statuses ...int) *webhookServer {
+	s := &webhookServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.bodies = append(s.bodies, body)
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status, s.statuses = s.statuses[0], s.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *webhookServer) requests() [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.bodies
+}
+
+func testDispatcher(config configuration.NotificationsConfig) *Dispatcher {
+	config.BatchInterval = 10 * time.Millisecond
+	config.MinBackoff = time.Millisecond
+	config.MaxBackoff = time.Millisecond
+	// Test webhooks listen on the loopback address.
+	config.AllowPrivateAddresses = true
+	return NewDispatcher(config)
+}
+
+func jobFailed(jobId string) *Notification {
+	return &Notification{
+		Event:    api.NotificationEvent_NOTIFICATION_JOB_FAILED,
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		JobId:    jobId,
+		Reason:   "oom",
+		Created:  time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestDispatcher_BatchesNotifications(t *testing.T) {
+	server := newWebhookServer(t)
+	webhook := &api.Webhook{Id: "id", Queue: "queue", Url: server.URL}
+	d := testDispatcher(configuration.NotificationsConfig{MaxBatchSize: 2, MaxAttempts: 1, MaxConcurrentDeliveries: 1})
+	for _, jobId := range []string{"a", "b", "c"} {
+		d.Dispatch(webhook, jobFailed(jobId))
+	}
+
+	var wg sync.WaitGroup
+	d.flush(armadacontext.Background(), &wg)
+	wg.Wait()
+
+	requests := server.requests()
+	require.Len(t, requests, 2)
+	var jobIds []string
+	for _, request := range requests {
+		p := &genericPayload{}
+		require.NoError(t, json.Unmarshal(request, p))
+		assert.LessOrEqual(t, len(p.Notifications), 2)
+		for _, n := range p.Notifications {
+			assert.Equal(t, "JOB_FAILED", n.Event)
+			jobIds = append(jobIds, n.JobId)
+		}
+	}
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, jobIds)
+
+	// Delivered notifications aren't delivered again.
+	d.flush(armadacontext.Background(), &wg)
+	wg.Wait()
+	assert.Len(t, server.requests(), 2)
+}
+
+func TestDispatcher_DropsNotificationsOverLimit(t *testing.T) {
+	server := newWebhookServer(t)
+	webhook := &api.Webhook{Id: "id", Queue: "queue", Url: server.URL}
+	d := testDispatcher(configuration.NotificationsConfig{MaxPendingNotifications: 2, MaxAttempts: 1})
+	for _, jobId := range []string{"a", "b", "c"} {
+		d.Dispatch(webhook, jobFailed(jobId))
+	}
+
+	var wg sync.WaitGroup
+	d.flush(armadacontext.Background(), &wg)
+	wg.Wait()
+
+	requests := server.requests()
+	require.Len(t, requests, 1)
+	p := &genericPayload{}
+	require.NoError(t, json.Unmarshal(requests[0], p))
+	assert.Len(t, p.Notifications, 2)
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	tests := map[string]struct {
+		statuses         []int
+		maxAttempts      int
+		expectedResult   string
+		expectedAttempts int
+	}{
+		"success": {
+			maxAttempts:      3,
+			expectedResult:   metrics.NotificationDeliverySucceeded,
+			expectedAttempts: 1,
+		},
+		"retries server errors": {
+			statuses:         []int{http.StatusInternalServerError, http.StatusTooManyRequests},
+			maxAttempts:      3,
+			expectedResult:   metrics.NotificationDeliverySucceeded,
+			expectedAttempts: 3,
+		},
+		"gives up after max attempts": {
+			statuses:         []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxAttempts:      2,
+			expectedResult:   metrics.NotificationDeliveryExhausted,
+			expectedAttempts: 2,
+		},
+		"doesn't retry client errors": {
+			statuses:         []int{http.StatusNotFound},
+			maxAttempts:      3,
+			expectedResult:   metrics.NotificationDeliveryRejected,
+			expectedAttempts: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newWebhookServer(t, tc.statuses...)
+			webhook := &api.Webhook{Id: "id", Queue: "queue", Url: server.URL}
+			d := testDispatcher(configuration.NotificationsConfig{MaxAttempts: tc.maxAttempts})
+			result := d.Deliver(armadacontext.Background(), webhook, []*Notification{jobFailed("a")})
+			assert.Equal(t, tc.expectedResult, result)
+			assert.Len(t, server.requests(), tc.expectedAttempts)
+		})
+	}
+}
+
+func TestDispatcher_Run(t *testing.T) {
+	server := newWebhookServer(t)
+	webhook := &api.Webhook{Id: "id", Queue: "queue", Kind: api.WebhookKind_WEBHOOK_SLACK, Url: server.URL}
+	d := testDispatcher(configuration.NotificationsConfig{MaxAttempts: 1})
+	ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+	done := make(chan error)
+	go func() {
+		done <- d.Run(ctx)
+	}()
+
+	d.Dispatch(webhook, jobFailed("a"))
+	assert.Eventually(t, func() bool { return len(server.requests()) == 1 }, time.Second, 10*time.Millisecond)
+	cancel()
+	assert.NoError(t, <-done)
+
+	p := &slackPayload{}
+	require.NoError(t, json.Unmarshal(server.requests()[0], p))
+	assert.Equal(t, ":x: Job `a` of job set `jobSet` in queue `queue` failed: oom", p.Text)
+}
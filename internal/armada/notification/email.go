@@ -0,0 +1,145 @@
+package notification
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// EmailRecipients returns the addresses of a mailto URL, e.g., "mailto:a@example.com,b@example.com",
+// as used by email webhooks.
+func EmailRecipients(url string) ([]string, error) {
+	u, err := neturl.Parse(url)
+	if err != nil || u.Scheme != "mailto" || u.Opaque == "" {
+		return nil, errors.New("url must be a mailto url")
+	}
+	list, err := neturl.PathUnescape(u.Opaque)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	addresses, err := mail.ParseAddressList(list)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	recipients := make([]string, len(addresses))
+	for i, address := range addresses {
+		recipients[i] = address.Address
+	}
+	return recipients, nil
+}
+
+// emailSender sends plain-text emails through an SMTP server.
+type emailSender struct {
+	address  string
+	username string
+	password string
+	from     string
+	timeout  time.Duration
+}
+
+func newEmailSender(config configuration.EmailConfig, timeout time.Duration) *emailSender {
+	return &emailSender{
+		address:  config.SmtpAddress,
+		username: config.Username,
+		password: config.Password,
+		from:     config.From,
+		timeout:  timeout,
+	}
+}
+
+// send sends an email to the provided addresses. Returns an error if sending fails, and whether it may succeed if
+// retried. The connection is upgraded to TLS if the server supports it.
+func (s *emailSender) send(ctx *armadacontext.Context, to []string, subject string, body string) (bool, error) {
+	if s.address == "" {
+		return false, errors.New("no smtp server is configured")
+	}
+	dialer := &net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return true, errors.WithStack(err)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		return true, errors.WithStack(err)
+	}
+	host, _, err := net.SplitHostPort(s.address)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return smtpError(err)
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return smtpError(err)
+		}
+	}
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, host)); err != nil {
+			return smtpError(err)
+		}
+	}
+	if err := client.Mail(s.from); err != nil {
+		return smtpError(err)
+	}
+	for _, address := range to {
+		if err := client.Rcpt(address); err != nil {
+			return smtpError(err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return smtpError(err)
+	}
+	if _, err := w.Write(s.message(to, subject, body)); err != nil {
+		return smtpError(err)
+	}
+	if err := w.Close(); err != nil {
+		return smtpError(err)
+	}
+	return smtpError(client.Quit())
+}
+
+// message returns the headers and body of an email.
+func (s *emailSender) message(to []string, subject string, body string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return b.Bytes()
+}
+
+// smtpError returns err and whether the command that failed with it may succeed if retried,
+// i.e., unless the server rejected it permanently.
+func smtpError(err error) (bool, error) {
+	if err == nil {
+		return false, nil
+	}
+	var protocolErr *textproto.Error
+	if errors.As(err, &protocolErr) {
+		return protocolErr.Code < 500, errors.WithStack(err)
+	}
+	return true, errors.WithStack(err)
+}
@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+)
+
+// deniedNetworks are internal networks not covered by the checks of net.IP, which webhooks may not connect to unless
+// private addresses are allowed.
+var deniedNetworks = []*net.IPNet{
+	// "This network"; addresses of which are routed to the local host by some systems.
+	mustParseCIDR("0.0.0.0/8"),
+	// Shared address space used for carrier-grade NAT, and by some cloud providers for internal services.
+	mustParseCIDR("100.64.0.0/10"),
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// HostPolicy restricts the hosts webhooks may be registered for and delivered to, such that webhooks can't be used to
+// send requests to services only reachable from within the network of the server, e.g., cloud metadata endpoints.
+type HostPolicy struct {
+	// Hosts webhooks may target, or patterns of the form *.example.com matching any subdomain of example.com.
+	// Any host may be targeted if empty.
+	allowedHosts []string
+	// If true, webhooks may target loopback, link-local, and private addresses.
+	allowPrivateAddresses bool
+}
+
+func NewHostPolicy(config configuration.NotificationsConfig) HostPolicy {
+	allowedHosts := make([]string, len(config.AllowedHosts))
+	for i, host := range config.AllowedHosts {
+		allowedHosts[i] = strings.ToLower(host)
+	}
+	return HostPolicy{allowedHosts: allowedHosts, allowPrivateAddresses: config.AllowPrivateAddresses}
+}
+
+// AllowsHost returns true if webhooks may target host, which is either a hostname or an IP address.
+// Since hostnames may resolve to any address, addresses are also checked when connecting; see AllowsIP.
+func (p HostPolicy) AllowsHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if ip := net.ParseIP(host); ip != nil && !p.AllowsIP(ip) {
+		return false
+	}
+	if len(p.allowedHosts) == 0 {
+		return true
+	}
+	for _, allowed := range p.allowedHosts {
+		if host == allowed {
+			return true
+		}
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok && strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsIP returns true if webhooks may connect to ip, i.e., if it isn't a loopback, link-local, private,
+// carrier-grade NAT, or unspecified address, unless private addresses are allowed.
+func (p HostPolicy) AllowsIP(ip net.IP) bool {
+	if p.allowPrivateAddresses {
+		return true
+	}
+	if ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range deniedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// control is used as the net.Dialer Control function of connections to webhooks, which is called with the address
+// the hostname of the webhook resolved to, such that hostnames resolving to disallowed addresses are rejected.
+func (p HostPolicy) control(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if ip := net.ParseIP(host); ip == nil || !p.AllowsIP(ip) {
+		return errors.Errorf("connecting to address %s isn't allowed", host)
+	}
+	return nil
+}
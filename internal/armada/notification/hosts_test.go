@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/metrics"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestHostPolicy_AllowsHost(t *testing.T) {
+	tests := map[string]struct {
+		config   configuration.NotificationsConfig
+		host     string
+		expected bool
+	}{
+		"any host":                          {host: "hooks.example.com", expected: true},
+		"public ip":                         {host: "93.184.216.34", expected: true},
+		"loopback ip":                       {host: "127.0.0.1"},
+		"ipv6 loopback ip":                  {host: "::1"},
+		"private ip":                        {host: "10.0.0.1"},
+		"link-local ip":                     {host: "169.254.169.254"},
+		"unspecified ip":                    {host: "0.0.0.0"},
+		"this network ip":                   {host: "0.1.2.3"},
+		"carrier-grade nat ip":              {host: "100.64.0.1"},
+		"last carrier-grade nat ip":         {host: "100.127.255.255"},
+		"public ip after carrier-grade nat": {host: "100.128.0.1", expected: true},
+		"ipv4-mapped carrier-grade nat ip":  {host: "::ffff:100.64.0.1"},
+		"private ip allowed":                {config: configuration.NotificationsConfig{AllowPrivateAddresses: true}, host: "10.0.0.1", expected: true},
+		"allowed host":                      {config: configuration.NotificationsConfig{AllowedHosts: []string{"hooks.slack.com"}}, host: "hooks.slack.com", expected: true},
+		"allowed host is case-insensitive":  {config: configuration.NotificationsConfig{AllowedHosts: []string{"Hooks.Slack.com"}}, host: "hooks.slack.COM.", expected: true},
+		"host not allowed":                  {config: configuration.NotificationsConfig{AllowedHosts: []string{"hooks.slack.com"}}, host: "example.com"},
+		"subdomain allowed":                 {config: configuration.NotificationsConfig{AllowedHosts: []string{"*.example.com"}}, host: "hooks.example.com", expected: true},
+		"domain not matched by pattern":     {config: configuration.NotificationsConfig{AllowedHosts: []string{"*.example.com"}}, host: "badexample.com"},
+		"allowed private ip":                {config: configuration.NotificationsConfig{AllowedHosts: []string{"10.0.0.1"}}, host: "10.0.0.1"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewHostPolicy(tc.config).AllowsHost(tc.host))
+		})
+	}
+}
+
+func TestDispatcher_Deliver_RejectsHostsResolvingToPrivateAddresses(t *testing.T) {
+	server := newWebhookServer(t)
+	serverUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	_, port, err := net.SplitHostPort(serverUrl.Host)
+	require.NoError(t, err)
+	// localhost isn't rejected by name, but resolves to a loopback address.
+	webhook := &api.Webhook{Id: "id", Queue: "queue", Url: "http://localhost:" + port}
+
+	d := NewDispatcher(configuration.NotificationsConfig{MaxAttempts: 1})
+	assert.Equal(t, metrics.NotificationDeliveryExhausted, d.Deliver(armadacontext.Background(), webhook, []*Notification{jobFailed("a")}))
+	assert.Empty(t, server.requests())
+}
+
+func TestDispatcher_Deliver_RejectsRedirectsToHostsNotAllowed(t *testing.T) {
+	target := newWebhookServer(t)
+	targetUrl, err := url.Parse(target.URL)
+	require.NoError(t, err)
+	redirect := httptest.NewServer(http.RedirectHandler("http://localhost:"+targetUrl.Port(), http.StatusTemporaryRedirect))
+	t.Cleanup(redirect.Close)
+	redirectUrl, err := url.Parse(redirect.URL)
+	require.NoError(t, err)
+	webhook := &api.Webhook{Id: "id", Queue: "queue", Url: redirect.URL}
+
+	d := NewDispatcher(configuration.NotificationsConfig{
+		MaxAttempts:           1,
+		AllowedHosts:          []string{redirectUrl.Hostname()},
+		AllowPrivateAddresses: true,
+	})
+	assert.NotEqual(t, metrics.NotificationDeliverySucceeded, d.Deliver(armadacontext.Background(), webhook, []*Notification{jobFailed("a")}))
+	assert.Empty(t, target.requests())
+}
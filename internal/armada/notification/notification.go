@@ -0,0 +1,111 @@
+// Package notification delivers notifications of job lifecycle events to webhooks registered by queue owners.
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Notification describes a single job lifecycle event.
+type Notification struct {
+	Event    api.NotificationEvent
+	Queue    string
+	JobSetId string
+	// Empty for job set completed notifications.
+	JobId string
+	// Why the job failed or was cancelled, if known.
+	Reason  string
+	Created time.Time
+	// Number of jobs of the job set in each terminal state; only set for job set completed notifications.
+	Succeeded int64
+	Failed    int64
+	Cancelled int64
+}
+
+// Subscribes returns true if webhook is registered for the event of notification.
+func Subscribes(webhook *api.Webhook, notification *Notification) bool {
+	for _, event := range webhook.Events {
+		if event == notification.Event {
+			return true
+		}
+	}
+	return false
+}
+
+// genericNotification is the JSON representation of a notification sent to generic webhooks.
+type genericNotification struct {
+	Event     string    `json:"event"`
+	Queue     string    `json:"queue"`
+	JobSetId  string    `json:"jobSetId"`
+	JobId     string    `json:"jobId,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Created   time.Time `json:"created"`
+	Succeeded *int64    `json:"succeeded,omitempty"`
+	Failed    *int64    `json:"failed,omitempty"`
+	Cancelled *int64    `json:"cancelled,omitempty"`
+}
+
+// genericPayload is the body of requests to generic webhooks.
+type genericPayload struct {
+	Notifications []*genericNotification `json:"notifications"`
+}
+
+// slackPayload is the body of requests to Slack incoming webhooks.
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// payload returns the body of a request delivering notifications to a webhook of the provided kind.
+func payload(kind api.WebhookKind, notifications []*Notification) interface{} {
+	if kind == api.WebhookKind_WEBHOOK_SLACK {
+		lines := make([]string, len(notifications))
+		for i, n := range notifications {
+			lines[i] = slackText(n)
+		}
+		return &slackPayload{Text: strings.Join(lines, "\n")}
+	}
+
+	p := &genericPayload{Notifications: make([]*genericNotification, len(notifications))}
+	for i, n := range notifications {
+		g := &genericNotification{
+			Event:    n.Event.ShortName(),
+			Queue:    n.Queue,
+			JobSetId: n.JobSetId,
+			JobId:    n.JobId,
+			Reason:   n.Reason,
+			Created:  n.Created,
+		}
+		if n.Event == api.NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED {
+			succeeded, failed, cancelled := n.Succeeded, n.Failed, n.Cancelled
+			g.Succeeded, g.Failed, g.Cancelled = &succeeded, &failed, &cancelled
+		}
+		p.Notifications[i] = g
+	}
+	return p
+}
+
+func slackText(n *Notification) string {
+	var text string
+	switch n.Event {
+	case api.NotificationEvent_NOTIFICATION_JOB_FAILED:
+		text = fmt.Sprintf(":x: Job `%s` of job set `%s` in queue `%s` failed", n.JobId, n.JobSetId, n.Queue)
+	case api.NotificationEvent_NOTIFICATION_JOB_SUCCEEDED:
+		text = fmt.Sprintf(":white_check_mark: Job `%s` of job set `%s` in queue `%s` succeeded", n.JobId, n.JobSetId, n.Queue)
+	case api.NotificationEvent_NOTIFICATION_JOB_CANCELLED:
+		text = fmt.Sprintf(":no_entry_sign: Job `%s` of job set `%s` in queue `%s` was cancelled", n.JobId, n.JobSetId, n.Queue)
+	case api.NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED:
+		text = fmt.Sprintf(
+			":checkered_flag: Job set `%s` in queue `%s` completed: %d succeeded, %d failed, %d cancelled",
+			n.JobSetId, n.Queue, n.Succeeded, n.Failed, n.Cancelled,
+		)
+	default:
+		text = fmt.Sprintf("%s: job `%s` of job set `%s` in queue `%s`", n.Event.ShortName(), n.JobId, n.JobSetId, n.Queue)
+	}
+	if n.Reason != "" {
+		text += ": " + n.Reason
+	}
+	return text
+}
@@ -0,0 +1,48 @@
+package notification
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestPayload_Generic(t *testing.T) {
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	data, err := json.Marshal(payload(api.WebhookKind_WEBHOOK_GENERIC, []*Notification{
+		{
+			Event:    api.NotificationEvent_NOTIFICATION_JOB_CANCELLED,
+			Queue:    "queue",
+			JobSetId: "jobSet",
+			JobId:    "job",
+			Reason:   "user request",
+			Created:  created,
+		},
+		{
+			Event:     api.NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED,
+			Queue:     "queue",
+			JobSetId:  "jobSet",
+			Created:   created,
+			Succeeded: 2,
+		},
+	}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"notifications": [
+		{"event": "JOB_CANCELLED", "queue": "queue", "jobSetId": "jobSet", "jobId": "job", "reason": "user request", "created": "2023-01-01T00:00:00Z"},
+		{"event": "JOB_SET_COMPLETED", "queue": "queue", "jobSetId": "jobSet", "created": "2023-01-01T00:00:00Z", "succeeded": 2, "failed": 0, "cancelled": 0}
+	]}`, string(data))
+}
+
+func TestPayload_Slack(t *testing.T) {
+	data, err := json.Marshal(payload(api.WebhookKind_WEBHOOK_SLACK, []*Notification{
+		{Event: api.NotificationEvent_NOTIFICATION_JOB_SUCCEEDED, Queue: "queue", JobSetId: "jobSet", JobId: "job"},
+		{Event: api.NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED, Queue: "queue", JobSetId: "jobSet", Succeeded: 1, Failed: 2},
+	}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text": ":white_check_mark: Job `+"`job`"+` of job set `+"`jobSet`"+` in queue `+"`queue`"+` succeeded\n`+
+		`:checkered_flag: Job set `+"`jobSet`"+` in queue `+"`queue`"+` completed: 1 succeeded, 2 failed, 0 cancelled"}`, string(data))
+}
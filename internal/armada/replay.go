@@ -0,0 +1,117 @@
+package armada
+
+import (
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/server"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// Replay replays the event log into the databases of the Armada server,
+// applying each event sequence via SubmitFromLog.ProcessSequence in the same way as the server does.
+func Replay(ctx *armadacontext.Context, config *configuration.ArmadaConfig, opts eventlog.ReplayOptions) error {
+	newOwnershipGroupsCompressor, _, err := server.NewOwnershipGroupsCompression(config.OwnershipGroupsCompression)
+	if err != nil {
+		return err
+	}
+	db := createRedisClient(&config.Redis)
+	defer func() {
+		if err := db.Close(); err != nil {
+			ctx.WithError(err).Error("failed to close Redis client")
+		}
+	}()
+	jobRepository, closeJobRepository, err := createJobRepository(ctx, config, db)
+	if err != nil {
+		return err
+	}
+	defer closeJobRepository()
+
+	submitServer := server.NewSubmitServer(
+		authorization.NewPrincipalPermissionChecker(
+			config.Auth.PermissionGroupMapping,
+			config.Auth.PermissionScopeMapping,
+			config.Auth.PermissionClaimMapping,
+		),
+		jobRepository,
+		repository.NewRedisQueueRepository(db),
+		// Events generated while replaying are already in the log; publishing them again would duplicate them.
+		discardEventStore{},
+		repository.NewRedisSchedulingInfoRepository(db),
+		config.CancelJobsBatchSize,
+		&config.QueueManagement,
+		&config.Scheduling,
+		newOwnershipGroupsCompressor,
+	)
+	submitFromLog := &server.SubmitFromLog{SubmitServer: submitServer}
+
+	return eventlog.ReplayFromPulsar(
+		ctx,
+		config.Pulsar,
+		opts,
+		func(msg eventlog.Message) bool {
+			s, _ := schedulers.SchedulerFromProperties(msg.Properties())
+			return s == schedulers.Legacy || s == schedulers.All
+		},
+		func(ctx *armadacontext.Context, sequences []*armadaevents.EventSequence) error {
+			for _, sequence := range sequences {
+				submitFromLog.ProcessSequence(ctx, sequence)
+			}
+			return nil
+		},
+	)
+}
+
+type discardEventStore struct{}
+
+func (discardEventStore) ReportEvents(*armadacontext.Context, []*api.EventMessage) error {
+	return nil
+}
+
+// RequeueDeadLetters republishes the messages in the dead-letter topic to the events topic, such that they're processed again,
+// until no message has been received for idleTimeout.
+func RequeueDeadLetters(ctx *armadacontext.Context, config *configuration.ArmadaConfig, idleTimeout time.Duration) error {
+	if config.Pulsar.DeadLetterTopic == "" {
+		return errors.New("no dead-letter topic configured")
+	}
+	client, err := pulsarutils.NewPulsarClient(&config.Pulsar)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	consumer, err := client.Subscribe(pulsar.ConsumerOptions{
+		Topic:                       config.Pulsar.DeadLetterTopic,
+		SubscriptionName:            config.Pulsar.DeadLetterRequeueSubscription,
+		Type:                        pulsar.Exclusive,
+		SubscriptionInitialPosition: pulsar.SubscriptionPositionEarliest,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer consumer.Close()
+
+	producer, err := client.CreateProducer(pulsar.ProducerOptions{
+		CompressionType:  config.Pulsar.CompressionType,
+		CompressionLevel: config.Pulsar.CompressionLevel,
+		Topic:            config.Pulsar.JobsetEventsTopic,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer producer.Close()
+
+	numRequeued, err := eventlog.RequeueDeadLetters(ctx, eventlog.NewPulsarConsumer(consumer), producer, idleTimeout)
+	ctx.Infof("requeued %d messages from %s to %s", numRequeued, config.Pulsar.DeadLetterTopic, config.Pulsar.JobsetEventsTopic)
+	return err
+}
@@ -3,13 +3,14 @@ package apimessages
 import (
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
+var log = logging.ForModule("server")
+
 // FromEventSequence Converts internal messages to external api messages
 // Note that some internal api messages can result in multiple api messages so we need to
 // return an array of messages
@@ -51,10 +52,13 @@ func FromEventSequence(es *armadaevents.EventSequence) ([]*api.EventMessage, err
 			convertedEvents, err = FromInternalStandaloneIngressInfo(es.Queue, es.JobSetName, *event.Created, esEvent.StandaloneIngressInfo)
 		case *armadaevents.EventSequence_Event_JobRunPreempted:
 			convertedEvents, err = FromInternalJobRunPreempted(es.Queue, es.JobSetName, *event.Created, esEvent.JobRunPreempted)
+		case *armadaevents.EventSequence_Event_JobRunArtifacts:
+			convertedEvents, err = FromInternalJobRunArtifacts(es.Queue, es.JobSetName, *event.Created, esEvent.JobRunArtifacts)
 		case *armadaevents.EventSequence_Event_ReprioritiseJobSet,
 			*armadaevents.EventSequence_Event_CancelJobSet,
 			*armadaevents.EventSequence_Event_JobRunSucceeded,
 			*armadaevents.EventSequence_Event_JobRequeued,
+			*armadaevents.EventSequence_Event_JobUnschedulable,
 			*armadaevents.EventSequence_Event_PartitionMarker:
 			// These events have no api analog right now, so we ignore
 			log.Debugf("ignoring event type %T", esEvent)
@@ -413,8 +417,21 @@ func FromInternalJobErrors(queueName string, jobSetName string, time time.Time,
 				},
 			}
 			events = append(events, event)
+		case *armadaevents.Error_JobDependencyFailed:
+			event := &api.EventMessage{
+				Events: &api.EventMessage_Failed{
+					Failed: &api.JobFailedEvent{
+						JobId:    jobId,
+						JobSetId: jobSetName,
+						Queue:    queueName,
+						Created:  time,
+						Reason:   reason.JobDependencyFailed.Message,
+					},
+				},
+			}
+			events = append(events, event)
 		default:
-			log.Warnf("unknown error %T for job %s", reason, jobId)
+			log.WithField(logging.JobIdField, jobId).Warnf("unknown error %T", reason)
 			event := &api.EventMessage{
 				Events: &api.EventMessage_Failed{
 					Failed: &api.JobFailedEvent{
@@ -427,6 +444,8 @@ func FromInternalJobErrors(queueName string, jobSetName string, time time.Time,
 			}
 			events = append(events, event)
 		}
+		// Each case above appends a single failed event.
+		events[len(events)-1].GetFailed().FailureCategory = api.FailureCategory(armadaevents.FailureCategoryOf(msgErr))
 	}
 	return events, nil
 }
@@ -452,6 +471,7 @@ func FromInternalJobRunRunning(queueName string, jobSetName string, time time.Ti
 		apiEvent.KubernetesId = ri.GetObjectMeta().GetKubernetesId()
 		apiEvent.NodeName = ri.GetPodInfo().GetNodeName()
 		apiEvent.PodNumber = ri.GetPodInfo().GetPodNumber()
+		apiEvent.NodeLabels = ri.GetPodInfo().GetNodeLabels()
 	}
 
 	return []*api.EventMessage{
@@ -532,6 +552,9 @@ func FromInternalJobRunPreempted(queueName string, jobSetName string, time time.
 		RunId:           runId,
 		PreemptiveJobId: preemptiveJobId,
 		PreemptiveRunId: preemptiveRunId,
+		Reason:          api.PreemptionReason(e.Reason),
+		PreemptiveQueue: e.PreemptiveQueue,
+		NodeName:        e.NodeName,
 	}
 
 	return []*api.EventMessage{
@@ -562,6 +585,7 @@ func FromInternalResourceUtilisation(queueName string, jobSetName string, time t
 		PodName:               e.GetResourceInfo().GetObjectMeta().GetName(),
 		PodNamespace:          e.GetResourceInfo().GetObjectMeta().GetNamespace(),
 		TotalCumulativeUsage:  e.TotalCumulativeUsage,
+		AverageResources:      e.AverageResources,
 	}
 
 	return []*api.EventMessage{
@@ -602,6 +626,41 @@ func FromInternalStandaloneIngressInfo(queueName string, jobSetName string, time
 	}, nil
 }
 
+func FromInternalJobRunArtifacts(queueName string, jobSetName string, time time.Time, e *armadaevents.JobRunArtifacts) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := make([]*api.Artifact, len(e.Artifacts))
+	for i, artifact := range e.Artifacts {
+		artifacts[i] = &api.Artifact{
+			Name:          artifact.Name,
+			ContainerName: artifact.ContainerName,
+			Url:           artifact.Url,
+			SizeBytes:     artifact.SizeBytes,
+		}
+	}
+	apiEvent := &api.JobArtifactsEvent{
+		JobId:        jobId,
+		JobSetId:     jobSetName,
+		Queue:        queueName,
+		Created:      time,
+		ClusterId:    e.GetObjectMeta().GetExecutorId(),
+		KubernetesId: e.GetObjectMeta().GetKubernetesId(),
+		PodNumber:    e.GetPodNumber(),
+		Artifacts:    artifacts,
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Artifacts{
+				Artifacts: apiEvent,
+			},
+		},
+	}, nil
+}
+
 func makeJobFailed(jobId string, queueName string, jobSetName string, time time.Time, podErrorEvent *armadaevents.Error_PodError) *api.JobFailedEvent {
 	podError := podErrorEvent.PodError
 	event := &api.JobFailedEvent{
@@ -581,6 +581,7 @@ func TestConvertJobError(t *testing.T) {
 							Cause:    api.Cause_OOM,
 						},
 					},
+					FailureCategory: api.FailureCategory_Deadline,
 				},
 			},
 		},
@@ -670,8 +671,9 @@ func TestConvertJobRunning(t *testing.T) {
 						},
 						Info: &armadaevents.KubernetesResourceInfo_PodInfo{
 							PodInfo: &armadaevents.PodInfo{
-								NodeName:  nodeName,
-								PodNumber: podNumber,
+								NodeName:   nodeName,
+								PodNumber:  podNumber,
+								NodeLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
 							},
 						},
 					},
@@ -694,6 +696,7 @@ func TestConvertJobRunning(t *testing.T) {
 					PodNumber:    podNumber,
 					PodName:      podName,
 					PodNamespace: namespace,
+					NodeLabels:   map[string]string{"topology.kubernetes.io/zone": "zone-a"},
 				},
 			},
 		},
@@ -827,6 +830,10 @@ func TestConvertResourceUtilisation(t *testing.T) {
 					"cpu": resource.MustParse("3.0"),
 					"mem": resource.MustParse("200Gi"),
 				},
+				AverageResources: map[string]resource.Quantity{
+					"cpu": resource.MustParse("1.5"),
+					"mem": resource.MustParse("80Gi"),
+				},
 			},
 		},
 	}
@@ -853,6 +860,10 @@ func TestConvertResourceUtilisation(t *testing.T) {
 						"cpu": resource.MustParse("3.0"),
 						"mem": resource.MustParse("200Gi"),
 					},
+					AverageResources: map[string]resource.Quantity{
+						"cpu": resource.MustParse("1.5"),
+						"mem": resource.MustParse("80Gi"),
+					},
 				},
 			},
 		},
@@ -923,6 +934,10 @@ func TestConvertJobRunPreempted(t *testing.T) {
 				PreemptedRunId:  runIdProto,
 				PreemptiveJobId: preemptiveJobIdProto,
 				PreemptiveRunId: preemptiveRunIdRunIdProto,
+				Reason:          armadaevents.PreemptionReason_FairShare,
+				PreemptiveQueue: "preemptive-queue",
+				NodeId:          nodeName,
+				NodeName:        nodeName,
 			},
 		},
 	}
@@ -938,6 +953,9 @@ func TestConvertJobRunPreempted(t *testing.T) {
 					RunId:           runIdString,
 					PreemptiveJobId: preemptiveJobIdString,
 					PreemptiveRunId: preemptiveRunIdString,
+					Reason:          api.PreemptionReason_FairShare,
+					PreemptiveQueue: "preemptive-queue",
+					NodeName:        nodeName,
 				},
 			},
 		},
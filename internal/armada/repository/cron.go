@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	cronJobSetHashKey     = "CronJobSet"
+	cronJobSetClaimPrefix = "CronJobSetClaim:"
+	// Claims only need to outlive the window during which several servers may process the same scheduled time.
+	cronJobSetClaimExpiry = 24 * time.Hour
+)
+
+type ErrCronJobSetNotFound struct {
+	Queue string
+	Name  string
+}
+
+func (err *ErrCronJobSetNotFound) Error() string {
+	return fmt.Sprintf("could not find cron job set %q in queue %q", err.Name, err.Queue)
+}
+
+type ErrCronJobSetAlreadyExists struct {
+	Queue string
+	Name  string
+}
+
+func (err *ErrCronJobSetAlreadyExists) Error() string {
+	return fmt.Sprintf("cron job set %s already exists in queue %s", err.Name, err.Queue)
+}
+
+type CronJobSetRepository interface {
+	// GetCronJobSets returns all cron job sets in the provided queue, or across all queues if queue is empty.
+	GetCronJobSets(queue string) ([]*api.CronJobSet, error)
+	GetCronJobSet(queue string, name string) (*api.CronJobSet, error)
+	CreateCronJobSet(cronJobSet *api.CronJobSet) error
+	UpdateCronJobSet(cronJobSet *api.CronJobSet) error
+	DeleteCronJobSet(queue string, name string) error
+	// TryClaim returns true if the caller is the first to claim the provided scheduled time of a cron job set,
+	// such that jobs are submitted only once per scheduled time even if several servers are running.
+	TryClaim(queue string, name string, scheduled time.Time) (bool, error)
+}
+
+type RedisCronJobSetRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisCronJobSetRepository(db redis.UniversalClient) *RedisCronJobSetRepository {
+	return &RedisCronJobSetRepository{db: db}
+}
+
+func (r *RedisCronJobSetRepository) GetCronJobSets(queue string) ([]*api.CronJobSet, error) {
+	result, err := r.db.HGetAll(cronJobSetHashKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[RedisCronJobSetRepository.GetCronJobSets] error reading from database: %s", err)
+	}
+
+	cronJobSets := make([]*api.CronJobSet, 0)
+	for k, v := range result {
+		if queue != "" && !strings.HasPrefix(k, queue+"/") {
+			continue
+		}
+		cronJobSet := &api.CronJobSet{}
+		if err := proto.Unmarshal([]byte(v), cronJobSet); err != nil {
+			return nil, fmt.Errorf("[RedisCronJobSetRepository.GetCronJobSets] error unmarshalling cron job set: %s", err)
+		}
+		cronJobSets = append(cronJobSets, cronJobSet)
+	}
+	return cronJobSets, nil
+}
+
+func (r *RedisCronJobSetRepository) GetCronJobSet(queue string, name string) (*api.CronJobSet, error) {
+	result, err := r.db.HGet(cronJobSetHashKey, cronJobSetKey(queue, name)).Result()
+	if err == redis.Nil {
+		return nil, &ErrCronJobSetNotFound{Queue: queue, Name: name}
+	} else if err != nil {
+		return nil, fmt.Errorf("[RedisCronJobSetRepository.GetCronJobSet] error reading from database: %s", err)
+	}
+
+	cronJobSet := &api.CronJobSet{}
+	if err := proto.Unmarshal([]byte(result), cronJobSet); err != nil {
+		return nil, fmt.Errorf("[RedisCronJobSetRepository.GetCronJobSet] error unmarshalling cron job set: %s", err)
+	}
+	return cronJobSet, nil
+}
+
+func (r *RedisCronJobSetRepository) CreateCronJobSet(cronJobSet *api.CronJobSet) error {
+	data, err := proto.Marshal(cronJobSet)
+	if err != nil {
+		return fmt.Errorf("[RedisCronJobSetRepository.CreateCronJobSet] error marshalling cron job set: %s", err)
+	}
+
+	result, err := r.db.HSetNX(cronJobSetHashKey, cronJobSetKey(cronJobSet.Queue, cronJobSet.Name), data).Result()
+	if err != nil {
+		return fmt.Errorf("[RedisCronJobSetRepository.CreateCronJobSet] error writing to database: %s", err)
+	}
+	if !result {
+		return &ErrCronJobSetAlreadyExists{Queue: cronJobSet.Queue, Name: cronJobSet.Name}
+	}
+	return nil
+}
+
+// TODO As for RedisQueueRepository.UpdateQueue, a cron job set deleted concurrently with an update is re-added.
+func (r *RedisCronJobSetRepository) UpdateCronJobSet(cronJobSet *api.CronJobSet) error {
+	key := cronJobSetKey(cronJobSet.Queue, cronJobSet.Name)
+	existsResult, err := r.db.HExists(cronJobSetHashKey, key).Result()
+	if err != nil {
+		return fmt.Errorf("[RedisCronJobSetRepository.UpdateCronJobSet] error reading from database: %s", err)
+	} else if !existsResult {
+		return &ErrCronJobSetNotFound{Queue: cronJobSet.Queue, Name: cronJobSet.Name}
+	}
+
+	data, err := proto.Marshal(cronJobSet)
+	if err != nil {
+		return fmt.Errorf("[RedisCronJobSetRepository.UpdateCronJobSet] error marshalling cron job set: %s", err)
+	}
+	if err := r.db.HSet(cronJobSetHashKey, key, data).Err(); err != nil {
+		return fmt.Errorf("[RedisCronJobSetRepository.UpdateCronJobSet] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisCronJobSetRepository) DeleteCronJobSet(queue string, name string) error {
+	if err := r.db.HDel(cronJobSetHashKey, cronJobSetKey(queue, name)).Err(); err != nil {
+		return fmt.Errorf("[RedisCronJobSetRepository.DeleteCronJobSet] error deleting cron job set: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisCronJobSetRepository) TryClaim(queue string, name string, scheduled time.Time) (bool, error) {
+	key := fmt.Sprintf("%s%s/%d", cronJobSetClaimPrefix, cronJobSetKey(queue, name), scheduled.Unix())
+	result, err := r.db.SetNX(key, 1, cronJobSetClaimExpiry).Result()
+	if err != nil {
+		return false, fmt.Errorf("[RedisCronJobSetRepository.TryClaim] error writing to database: %s", err)
+	}
+	return result, nil
+}
+
+func cronJobSetKey(queue string, name string) string {
+	return queue + "/" + name
+}
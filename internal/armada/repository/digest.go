@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	digestPrefix                = "Digest:"
+	digestJobSetsPrefix         = "DigestJobSets:"
+	digestFailedJobSetsPrefix   = "DigestFailedJobSets:"
+	digestJobPrefix             = "DigestJob:"
+	digestSentPrefix            = "DigestSent:"
+	digestJobExpiry             = 30 * 24 * time.Hour
+	digestFieldSucceeded        = "succeeded"
+	digestFieldFailed           = "failed"
+	digestFieldCancelled        = "cancelled"
+	digestFieldReasonPrefix     = "reason:"
+	digestFieldResourcePrefix   = "resource:"
+	digestJobFieldStarted       = "started"
+	digestJobFieldRequestPrefix = "request:"
+)
+
+// DigestUpdate describes the jobs of a job set that finished within the same hour.
+type DigestUpdate struct {
+	JobSetId  string
+	Succeeded int64
+	Failed    int64
+	Cancelled int64
+	// Number of failed jobs by failure reason.
+	FailureReasons map[string]int64
+	// Resources allocated to the jobs multiplied by the number of seconds they ran for, by resource name.
+	ResourceSeconds map[string]float64
+}
+
+// DigestTotals summarizes the jobs of a queue that finished within some period of time.
+type DigestTotals struct {
+	// Number of job sets any jobs of which finished.
+	JobSets int64
+	// Number of job sets any jobs of which failed.
+	JobSetsWithFailures int64
+	Succeeded           int64
+	Failed              int64
+	Cancelled           int64
+	FailureReasons      map[string]int64
+	ResourceSeconds     map[string]float64
+}
+
+// DigestJob is what's recorded about an unfinished job to compute its resource usage once it finishes.
+type DigestJob struct {
+	// Resources requested by the job, by resource name.
+	Requests map[string]float64
+	// Time at which the job last started running, or the zero time if it never did.
+	Started time.Time
+}
+
+// DigestRepository stores the outcomes of finished jobs, by queue and hour, from which digests are computed.
+type DigestRepository interface {
+	// RecordJobRequests stores the resources requested by a submitted job.
+	RecordJobRequests(jobId string, requests map[string]float64) error
+	// RecordJobStarted stores the time a job started running.
+	RecordJobStarted(jobId string, started time.Time) error
+	// FinishJob deletes and returns what was recorded about a job, or returns nil if nothing was.
+	// Jobs that haven't finished within 30 days are forgotten.
+	FinishJob(jobId string) (*DigestJob, error)
+	// AddToDigest adds update to the outcomes of the jobs of queue that finished within the hour starting at hour.
+	AddToDigest(queue string, hour time.Time, update *DigestUpdate) error
+	// GetDigestTotals sums the outcomes of the jobs of queue that finished within the hours starting at or after start
+	// and before end.
+	GetDigestTotals(queue string, start time.Time, end time.Time) (*DigestTotals, error)
+	// ClaimDigest returns true if digests due at the provided time haven't been claimed yet,
+	// such that they're sent by a single server.
+	ClaimDigest(due time.Time) (bool, error)
+}
+
+type RedisDigestRepository struct {
+	db redis.UniversalClient
+	// How long the outcomes of jobs are kept for.
+	retention time.Duration
+}
+
+func NewRedisDigestRepository(db redis.UniversalClient, retention time.Duration) *RedisDigestRepository {
+	return &RedisDigestRepository{db: db, retention: retention}
+}
+
+func (r *RedisDigestRepository) RecordJobRequests(jobId string, requests map[string]float64) error {
+	key := digestJobPrefix + jobId
+	fields := make(map[string]interface{}, len(requests))
+	for name, value := range requests {
+		fields[digestJobFieldRequestPrefix+name] = value
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	pipe := r.db.TxPipeline()
+	pipe.HMSet(key, fields)
+	pipe.Expire(key, digestJobExpiry)
+	if _, err := pipe.Exec(); err != nil {
+		return fmt.Errorf("[RedisDigestRepository.RecordJobRequests] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisDigestRepository) RecordJobStarted(jobId string, started time.Time) error {
+	key := digestJobPrefix + jobId
+	pipe := r.db.TxPipeline()
+	pipe.HSet(key, digestJobFieldStarted, started.UnixNano())
+	pipe.Expire(key, digestJobExpiry)
+	if _, err := pipe.Exec(); err != nil {
+		return fmt.Errorf("[RedisDigestRepository.RecordJobStarted] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisDigestRepository) FinishJob(jobId string) (*DigestJob, error) {
+	key := digestJobPrefix + jobId
+	pipe := r.db.TxPipeline()
+	getAll := pipe.HGetAll(key)
+	pipe.Del(key)
+	if _, err := pipe.Exec(); err != nil {
+		return nil, fmt.Errorf("[RedisDigestRepository.FinishJob] error reading from database: %s", err)
+	}
+	if len(getAll.Val()) == 0 {
+		return nil, nil
+	}
+
+	job := &DigestJob{Requests: make(map[string]float64)}
+	for field, value := range getAll.Val() {
+		if field == digestJobFieldStarted {
+			nanos, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("[RedisDigestRepository.FinishJob] error parsing start time: %s", err)
+			}
+			job.Started = time.Unix(0, nanos).UTC()
+		} else if name := strings.TrimPrefix(field, digestJobFieldRequestPrefix); name != field {
+			request, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("[RedisDigestRepository.FinishJob] error parsing request %s: %s", name, err)
+			}
+			job.Requests[name] = request
+		}
+	}
+	return job, nil
+}
+
+func (r *RedisDigestRepository) AddToDigest(queue string, hour time.Time, update *DigestUpdate) error {
+	key := digestKey(digestPrefix, queue, hour)
+	// Keep outcomes for the whole retention period after the end of the hour.
+	expiry := r.retention + time.Hour
+	pipe := r.db.TxPipeline()
+	for field, value := range map[string]int64{
+		digestFieldSucceeded: update.Succeeded,
+		digestFieldFailed:    update.Failed,
+		digestFieldCancelled: update.Cancelled,
+	} {
+		if value != 0 {
+			pipe.HIncrBy(key, field, value)
+		}
+	}
+	for reason, count := range update.FailureReasons {
+		pipe.HIncrBy(key, digestFieldReasonPrefix+reason, count)
+	}
+	for name, value := range update.ResourceSeconds {
+		pipe.HIncrByFloat(key, digestFieldResourcePrefix+name, value)
+	}
+	pipe.Expire(key, expiry)
+
+	jobSetsKey := digestKey(digestJobSetsPrefix, queue, hour)
+	pipe.SAdd(jobSetsKey, update.JobSetId)
+	pipe.Expire(jobSetsKey, expiry)
+	if update.Failed > 0 {
+		failedJobSetsKey := digestKey(digestFailedJobSetsPrefix, queue, hour)
+		pipe.SAdd(failedJobSetsKey, update.JobSetId)
+		pipe.Expire(failedJobSetsKey, expiry)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return fmt.Errorf("[RedisDigestRepository.AddToDigest] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisDigestRepository) GetDigestTotals(queue string, start time.Time, end time.Time) (*DigestTotals, error) {
+	var hours []time.Time
+	for hour := start.UTC().Truncate(time.Hour); hour.Before(end); hour = hour.Add(time.Hour) {
+		hours = append(hours, hour)
+	}
+	totals := &DigestTotals{FailureReasons: make(map[string]int64), ResourceSeconds: make(map[string]float64)}
+	if len(hours) == 0 {
+		return totals, nil
+	}
+
+	pipe := r.db.Pipeline()
+	getAlls := make([]*redis.StringStringMapCmd, len(hours))
+	jobSetsKeys := make([]string, len(hours))
+	failedJobSetsKeys := make([]string, len(hours))
+	for i, hour := range hours {
+		getAlls[i] = pipe.HGetAll(digestKey(digestPrefix, queue, hour))
+		jobSetsKeys[i] = digestKey(digestJobSetsPrefix, queue, hour)
+		failedJobSetsKeys[i] = digestKey(digestFailedJobSetsPrefix, queue, hour)
+	}
+	jobSets := pipe.SUnion(jobSetsKeys...)
+	failedJobSets := pipe.SUnion(failedJobSetsKeys...)
+	if _, err := pipe.Exec(); err != nil {
+		return nil, fmt.Errorf("[RedisDigestRepository.GetDigestTotals] error reading from database: %s", err)
+	}
+
+	totals.JobSets = int64(len(jobSets.Val()))
+	totals.JobSetsWithFailures = int64(len(failedJobSets.Val()))
+	for _, getAll := range getAlls {
+		for field, value := range getAll.Val() {
+			if name := strings.TrimPrefix(field, digestFieldResourcePrefix); name != field {
+				v, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("[RedisDigestRepository.GetDigestTotals] error parsing %s: %s", field, err)
+				}
+				totals.ResourceSeconds[name] += v
+				continue
+			}
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("[RedisDigestRepository.GetDigestTotals] error parsing %s: %s", field, err)
+			}
+			switch field {
+			case digestFieldSucceeded:
+				totals.Succeeded += n
+			case digestFieldFailed:
+				totals.Failed += n
+			case digestFieldCancelled:
+				totals.Cancelled += n
+			default:
+				if reason := strings.TrimPrefix(field, digestFieldReasonPrefix); reason != field {
+					totals.FailureReasons[reason] += n
+				}
+			}
+		}
+	}
+	return totals, nil
+}
+
+func (r *RedisDigestRepository) ClaimDigest(due time.Time) (bool, error) {
+	key := digestSentPrefix + strconv.FormatInt(due.Unix(), 10)
+	claimed, err := r.db.SetNX(key, 1, r.retention).Result()
+	if err != nil {
+		return false, fmt.Errorf("[RedisDigestRepository.ClaimDigest] error writing to database: %s", err)
+	}
+	return claimed, nil
+}
+
+func digestKey(prefix string, queue string, hour time.Time) string {
+	return prefix + queue + ":" + strconv.FormatInt(hour.UTC().Truncate(time.Hour).Unix(), 10)
+}
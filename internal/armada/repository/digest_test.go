@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestJobs(t *testing.T) {
+	withDigestRepository(t, func(r *RedisDigestRepository) {
+		started := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+		require.NoError(t, r.RecordJobRequests("job", map[string]float64{"cpu": 2, "memory": 1024}))
+		require.NoError(t, r.RecordJobStarted("job", started))
+		require.NoError(t, r.RecordJobStarted("unsubmitted-job", started))
+
+		job, err := r.FinishJob("job")
+		require.NoError(t, err)
+		assert.Equal(t, &DigestJob{Requests: map[string]float64{"cpu": 2, "memory": 1024}, Started: started}, job)
+
+		job, err = r.FinishJob("unsubmitted-job")
+		require.NoError(t, err)
+		assert.Equal(t, &DigestJob{Requests: map[string]float64{}, Started: started}, job)
+
+		// Jobs are forgotten once finished.
+		job, err = r.FinishJob("job")
+		require.NoError(t, err)
+		assert.Nil(t, job)
+	})
+}
+
+func TestGetDigestTotals(t *testing.T) {
+	withDigestRepository(t, func(r *RedisDigestRepository) {
+		hour := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+		require.NoError(t, r.AddToDigest("queue", hour, &DigestUpdate{
+			JobSetId:        "job-set",
+			Succeeded:       2,
+			Failed:          1,
+			FailureReasons:  map[string]int64{"OOM": 1},
+			ResourceSeconds: map[string]float64{"cpu": 1.5},
+		}))
+		require.NoError(t, r.AddToDigest("queue", hour.Add(90*time.Minute), &DigestUpdate{
+			JobSetId:        "job-set",
+			Failed:          2,
+			Cancelled:       1,
+			FailureReasons:  map[string]int64{"OOM": 1, "exit code 1": 1},
+			ResourceSeconds: map[string]float64{"cpu": 2, "memory": 10},
+		}))
+		require.NoError(t, r.AddToDigest("queue", hour.Add(2*time.Hour), &DigestUpdate{JobSetId: "other-job-set", Succeeded: 1}))
+		require.NoError(t, r.AddToDigest("other-queue", hour, &DigestUpdate{JobSetId: "job-set", Failed: 5}))
+
+		totals, err := r.GetDigestTotals("queue", hour.Add(30*time.Minute), hour.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, &DigestTotals{
+			JobSets:             1,
+			JobSetsWithFailures: 1,
+			Succeeded:           2,
+			Failed:              3,
+			Cancelled:           1,
+			FailureReasons:      map[string]int64{"OOM": 2, "exit code 1": 1},
+			ResourceSeconds:     map[string]float64{"cpu": 3.5, "memory": 10},
+		}, totals)
+
+		totals, err = r.GetDigestTotals("queue", hour.Add(2*time.Hour), hour.Add(3*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, &DigestTotals{
+			JobSets:         1,
+			Succeeded:       1,
+			FailureReasons:  map[string]int64{},
+			ResourceSeconds: map[string]float64{},
+		}, totals)
+	})
+}
+
+func TestClaimDigest(t *testing.T) {
+	withDigestRepository(t, func(r *RedisDigestRepository) {
+		due := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		claimed, err := r.ClaimDigest(due)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = r.ClaimDigest(due)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+
+		claimed, err = r.ClaimDigest(due.Add(24 * time.Hour))
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+}
+
+func withDigestRepository(t *testing.T, action func(r *RedisDigestRepository)) {
+	db, err := miniredis.Run()
+	require.NoError(t, err)
+	defer db.Close()
+	client := redis.NewClient(&redis.Options{Addr: db.Addr()})
+	defer client.Close()
+
+	action(NewRedisDigestRepository(client, 7*24*time.Hour))
+}
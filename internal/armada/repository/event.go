@@ -10,7 +10,6 @@ import (
 	"github.com/gogo/protobuf/proto"
 	pool "github.com/jolestar/go-commons-pool"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/armada/repository/apimessages"
 	"github.com/armadaproject/armada/internal/armada/repository/sequence"
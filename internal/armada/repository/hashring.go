@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// Number of points each shard is assigned on the hash ring. More points distribute keys more evenly.
+const hashRingPointsPerShard = 256
+
+// hashRing assigns keys to shards by consistent hashing, such that adding or removing a shard only moves
+// the keys assigned to that shard, i.e., about 1/n of all keys, rather than almost all keys.
+type hashRing struct {
+	// Sorted hashes of the points on the ring.
+	points []uint64
+	// Index of the shard each point belongs to.
+	shards []int
+}
+
+// newHashRing returns a ring over the shards with the provided names. Shards are identified by name
+// rather than position, such that the order in which shards are listed doesn't affect the assignment.
+func newHashRing(names []string) *hashRing {
+	type point struct {
+		hash  uint64
+		shard int
+	}
+	points := make([]point, 0, len(names)*hashRingPointsPerShard)
+	for shard, name := range names {
+		for i := 0; i < hashRingPointsPerShard; i++ {
+			points = append(points, point{hash: xxhash.Sum64String(name + "#" + strconv.Itoa(i)), shard: shard})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash != points[j].hash {
+			return points[i].hash < points[j].hash
+		}
+		return names[points[i].shard] < names[points[j].shard]
+	})
+	ring := &hashRing{
+		points: make([]uint64, len(points)),
+		shards: make([]int, len(points)),
+	}
+	for i, p := range points {
+		ring.points[i] = p.hash
+		ring.shards[i] = p.shard
+	}
+	return ring
+}
+
+// get returns the index of the shard key is assigned to, i.e., of the first point at or after the hash of key.
+func (r *hashRing) get(key string) int {
+	hash := xxhash.Sum64String(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= hash })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.shards[i]
+}
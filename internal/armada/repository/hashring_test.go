@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashRing_Distribution(t *testing.T) {
+	ring := newHashRing([]string{"a", "b", "c", "d"})
+	counts := make([]int, 4)
+	for i := 0; i < 10000; i++ {
+		counts[ring.get(fmt.Sprintf("job-%d", i))]++
+	}
+	for _, count := range counts {
+		assert.InDelta(t, 2500, count, 500)
+	}
+}
+
+func TestHashRing_AddingShardOnlyMovesKeysToNewShard(t *testing.T) {
+	before := newHashRing([]string{"a", "b", "c"})
+	after := newHashRing([]string{"a", "b", "c", "d"})
+	moved := 0
+	for i := 0; i < 10000; i++ {
+		key := fmt.Sprintf("job-%d", i)
+		if before.get(key) != after.get(key) {
+			assert.Equal(t, 3, after.get(key))
+			moved++
+		}
+	}
+	assert.InDelta(t, 2500, moved, 500)
+}
+
+func TestHashRing_IndependentOfOrder(t *testing.T) {
+	ring := newHashRing([]string{"a", "b", "c"})
+	reversed := newHashRing([]string{"c", "b", "a"})
+	names := []string{"a", "b", "c"}
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("job-%d", i)
+		assert.Equal(t, names[ring.get(key)], names[2-reversed.get(key)])
+	}
+}
@@ -10,15 +10,17 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/logging"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
+var log = logging.ForModule("server")
+
 const (
 	jobObjectPrefix    = "Job:"          // {jobId}            - job protobuf object
 	jobStartTimePrefix = "Job:StartTime" // {jobId}            - map clusterId -> startTime
@@ -63,6 +65,9 @@ type JobRepository interface {
 	// Returns a map from queue name to ids of successfully leased jobs for that queue.
 	TryLeaseJobs(clusterId string, jobIdsByQueue map[string][]string) (map[string][]string, error)
 	AddJobs(job []*api.Job) ([]*SubmitJobResult, error)
+	// AddJobsWithOutboxEvents adds jobs as AddJobs does and, for each job that's added, atomically adds the event
+	// with the same index to the outbox (see OutboxRepository), from where it's published.
+	AddJobsWithOutboxEvents(jobs []*api.Job, events []*OutboxEvent) ([]*SubmitJobResult, error)
 	GetJobsByIds(ids []string) ([]*JobResult, error)
 	GetExistingJobsByIds(ids []string) ([]*api.Job, error)
 	FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error)
@@ -108,17 +113,32 @@ type SubmitJobResult struct {
 }
 
 func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
+	return repo.addJobs(jobs, nil)
+}
+
+func (repo *RedisJobRepository) AddJobsWithOutboxEvents(jobs []*api.Job, events []*OutboxEvent) ([]*SubmitJobResult, error) {
+	if len(events) != len(jobs) {
+		return nil, errors.Errorf("got %d outbox events for %d jobs", len(events), len(jobs))
+	}
+	return repo.addJobs(jobs, events)
+}
+
+func (repo *RedisJobRepository) addJobs(jobs []*api.Job, events []*OutboxEvent) ([]*SubmitJobResult, error) {
 	pipe := repo.db.Pipeline()
 	addJobScript.Load(pipe)
 
 	saveResults := make([]*redis.Cmd, 0, len(jobs))
-	for _, job := range jobs {
+	for i, job := range jobs {
 		jobData, err := proto.Marshal(job)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
 
-		result := addJob(pipe, job, &jobData)
+		var event *OutboxEvent
+		if events != nil {
+			event = events[i]
+		}
+		result := addJob(pipe, job, &jobData, event)
 		saveResults = append(saveResults, result)
 	}
 
@@ -310,6 +330,11 @@ func (repo *RedisJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job,
 	if err != nil {
 		return nil, err
 	}
+	return existingJobs(jobResults)
+}
+
+// existingJobs returns the jobs of jobResults, omitting jobs that weren't found.
+func existingJobs(jobResults []*JobResult) ([]*api.Job, error) {
 	var result *multierror.Error
 	jobs := make([]*api.Job, 0, len(jobResults))
 	for _, jobResult := range jobResults {
@@ -318,28 +343,32 @@ func (repo *RedisJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job,
 		if errors.As(jobResult.Error, &errJobNotFound) || errors.As(jobResult.Error, &errNotFound) {
 			continue
 		} else if jobResult.Error != nil {
-			err = errors.WithMessagef(jobResult.Error, "error getting job with id %s from database", jobResult.JobId)
+			err := errors.WithMessagef(jobResult.Error, "error getting job with id %s from database", jobResult.JobId)
 			result = multierror.Append(result, err)
 			continue
 		}
-		// Ensure job.GetAnnotations and podSpec.NodeSelector are initialised.
-		// Necessary to mutate these in-place during scheduling.
-		if jobResult.Job.Annotations == nil {
-			jobResult.Job.Annotations = make(map[string]string)
-		}
-		if jobResult.Job.PodSpec != nil && jobResult.Job.PodSpec.NodeSelector == nil {
-			jobResult.Job.PodSpec.NodeSelector = make(map[string]string)
-		}
-		for _, podSpec := range jobResult.Job.PodSpecs {
-			if podSpec != nil && podSpec.NodeSelector == nil {
-				jobResult.Job.PodSpec.NodeSelector = make(map[string]string)
-			}
-		}
+		initialiseJob(jobResult.Job)
 		jobs = append(jobs, jobResult.Job)
 	}
 	return jobs, result.ErrorOrNil()
 }
 
+// initialiseJob ensures job.GetAnnotations and podSpec.NodeSelector are initialised.
+// Necessary to mutate these in-place during scheduling.
+func initialiseJob(job *api.Job) {
+	if job.Annotations == nil {
+		job.Annotations = make(map[string]string)
+	}
+	if job.PodSpec != nil && job.PodSpec.NodeSelector == nil {
+		job.PodSpec.NodeSelector = make(map[string]string)
+	}
+	for _, podSpec := range job.PodSpecs {
+		if podSpec != nil && podSpec.NodeSelector == nil {
+			job.PodSpec.NodeSelector = make(map[string]string)
+		}
+	}
+}
+
 // GetJobsByIds attempts to get all requested jobs from the database.
 // Any error in getting a job is set to the Err field of the corresponding JobResult.
 func (repo *RedisJobRepository) GetJobsByIds(ids []string) ([]*JobResult, error) {
@@ -372,28 +401,36 @@ func (repo *RedisJobRepository) GetJobsByIds(ids []string) ([]*JobResult, error)
 		}
 
 		d, _ := cmd.Bytes() // we already checked the error above
-		result.Job = &api.Job{}
-		err = proto.Unmarshal(d, result.Job)
+		result.Job, err = unmarshalJob(ids[index], d)
 		if err != nil {
-			err = errors.WithMessagef(err, "job id %s", ids[index])
-			return nil, errors.WithStack(err)
-		}
-
-		// TODO This shouldn't be here. We write these when creating the job,
-		// and the getter shouldn't mutate the object read from the database.
-		podSpec := result.Job.GetMainPodSpec()
-		// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
-		for k, v := range result.Job.RequiredNodeLabels {
-			if podSpec.NodeSelector == nil {
-				podSpec.NodeSelector = map[string]string{}
-			}
-			podSpec.NodeSelector[k] = v
+			return nil, err
 		}
 	}
 
 	return results, nil
 }
 
+func unmarshalJob(jobId string, jobData []byte) (*api.Job, error) {
+	job := &api.Job{}
+	err := proto.Unmarshal(jobData, job)
+	if err != nil {
+		err = errors.WithMessagef(err, "job id %s", jobId)
+		return nil, errors.WithStack(err)
+	}
+
+	// TODO This shouldn't be here. We write these when creating the job,
+	// and the getter shouldn't mutate the object read from the database.
+	podSpec := job.GetMainPodSpec()
+	// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
+	for k, v := range job.RequiredNodeLabels {
+		if podSpec.NodeSelector == nil {
+			podSpec.NodeSelector = map[string]string{}
+		}
+		podSpec.NodeSelector[k] = v
+	}
+	return job, nil
+}
+
 func (repo *RedisJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
 	pipe := repo.db.Pipeline()
 	cmds := make(map[*api.Queue]*redis.IntCmd)
@@ -668,7 +705,7 @@ func (repo *RedisJobRepository) updateJobBatchWithRetry(
 		for i, cmd := range commands {
 			err := cmd.Err()
 			if err != nil {
-				log.Warnf("[RedisJobRepository.updateJobBatch]: error updating job %s: %s", jobs[i].Id, err)
+				log.WithField(logging.JobIdField, jobs[i].Id).Warnf("[RedisJobRepository.updateJobBatch]: error updating job: %s", err)
 				result = append(result, UpdateJobResult{JobId: jobs[i].Id, Job: nil, Error: err})
 			} else {
 				result = append(result, UpdateJobResult{JobId: jobs[i].Id, Job: jobs[i], Error: nil})
@@ -759,7 +796,7 @@ func (repo *RedisJobRepository) GetJobRunInfos(jobIds []string) (map[string]*Run
 		if cmd.Val() != "" {
 			i, err := strconv.ParseInt(cmd.Val(), 10, 64)
 			if err != nil {
-				log.Errorf("Failed to parse start time for job %s because %s", jobId, err)
+				log.WithField(logging.JobIdField, jobId).Errorf("Failed to parse start time for job because %s", err)
 				continue
 			}
 			runInfos[jobId] = &RunInfo{
@@ -1058,9 +1095,9 @@ func (repo *RedisJobRepository) leaseJobs(clusterId string, jobIdsByQueue map[st
 			if err != nil {
 				log.Error(err)
 			} else if value == alreadyAllocatedByDifferentCluster {
-				log.WithField("jobId", jobId).Info("job already allocated to different cluster")
+				log.WithField(logging.JobIdField, jobId).Info("job already allocated to different cluster")
 			} else if value == jobCancelled {
-				log.WithField("jobId", jobId).Info("trying to renew cancelled job")
+				log.WithField(logging.JobIdField, jobId).Info("trying to renew cancelled job")
 			} else {
 				leasedJobIdsByQueue[queue] = append(leasedJobIdsByQueue[queue], jobId)
 			}
@@ -1069,16 +1106,20 @@ func (repo *RedisJobRepository) leaseJobs(clusterId string, jobIdsByQueue map[st
 	return leasedJobIdsByQueue, nil
 }
 
-func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte) *redis.Cmd {
-	return addJobScript.Run(db,
-		[]string{
-			jobQueuePrefix + job.Queue,
-			jobObjectPrefix + job.Id,
-			jobSetPrefix + job.JobSetId,
-			jobSetPrefix + job.Queue + keySeparator + job.JobSetId,
-			jobExistsPrefix + job.Id,
-		},
-		job.Id, job.Priority, *jobData)
+func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte, event *OutboxEvent) *redis.Cmd {
+	keys := []string{
+		jobQueuePrefix + job.Queue,
+		jobObjectPrefix + job.Id,
+		jobSetPrefix + job.JobSetId,
+		jobSetPrefix + job.Queue + keySeparator + job.JobSetId,
+		jobExistsPrefix + job.Id,
+	}
+	args := []interface{}{job.Id, job.Priority, *jobData}
+	if event != nil {
+		keys = append(keys, outboxKey)
+		args = append(args, event.Payload, event.Key)
+	}
+	return addJobScript.Run(db, keys, args...)
 }
 
 // This script will create the queue if it doesn't already exist.
@@ -1089,10 +1130,13 @@ local jobKey = KEYS[2]
 local jobSetKey = KEYS[3]
 local jobSetQueueKey = KEYS[4]
 local jobExistsKey = KEYS[5]
+local outboxKey = KEYS[6]
 
 local jobId = ARGV[1]
 local jobPriority = ARGV[2]
 local jobData = ARGV[3]
+local outboxPayload = ARGV[4]
+local outboxEventKey = ARGV[5]
 
 local jobExists = redis.call('EXISTS', jobExistsKey)
 if jobExists == 1 then
@@ -1104,6 +1148,9 @@ redis.call('SET', jobKey, jobData)
 redis.call('SADD', jobSetKey, jobId)
 redis.call('SADD', jobSetQueueKey, jobId)
 redis.call('ZADD', queueKey, jobPriority, jobId)
+if outboxKey then
+	redis.call('XADD', outboxKey, '*', 'payload', outboxPayload, 'key', outboxEventKey)
+end
 
 return jobId
 `)
@@ -0,0 +1,752 @@
+package repository
+
+import (
+	"embed"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/logging"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+//go:embed migrations/*.sql
+var postgresJobRepositoryMigrations embed.FS
+
+// MigratePostgresJobRepository creates or updates the schema used by PostgresJobRepository.
+// Since schema versions are tracked per database, the database must not be shared with other components.
+func MigratePostgresJobRepository(ctx *armadacontext.Context, db database.Querier) error {
+	start := time.Now()
+	migrations, err := database.ReadMigrations(postgresJobRepositoryMigrations, "migrations")
+	if err != nil {
+		return err
+	}
+	err = database.UpdateDatabase(ctx, db, migrations)
+	if err != nil {
+		return err
+	}
+	ctx.Infof("Updated job database in %s", time.Now().Sub(start))
+	return nil
+}
+
+const defaultPostgresJobBatchSize = 1000
+
+// PostgresJobRepository is an implementation of JobRepository that stores jobs in Postgres,
+// for use where operating Redis at scale isn't feasible. It behaves as RedisJobRepository does,
+// except that it doesn't support the outbox.
+type PostgresJobRepository struct {
+	db *pgxpool.Pool
+	// Maximum number of jobs read or written per query.
+	batchSize int
+}
+
+func NewPostgresJobRepository(db *pgxpool.Pool, batchSize int) *PostgresJobRepository {
+	if batchSize <= 0 {
+		batchSize = defaultPostgresJobBatchSize
+	}
+	return &PostgresJobRepository{db: db, batchSize: batchSize}
+}
+
+// A job is only added if no job with the same id has been submitted within the last week.
+const addJobsSql = `
+WITH submitted AS (
+	INSERT INTO job_submissions (job_id, submitted)
+	SELECT unnest($1::text[]), now()
+	ON CONFLICT (job_id) DO UPDATE SET submitted = excluded.submitted
+	WHERE job_submissions.submitted < now() - interval '7 days'
+	RETURNING job_id
+)
+INSERT INTO jobs (job_id, queue, job_set, priority, job)
+SELECT t.job_id, t.queue, t.job_set, t.priority, t.job
+FROM unnest($1::text[], $2::text[], $3::text[], $4::float8[], $5::bytea[]) AS t(job_id, queue, job_set, priority, job)
+JOIN submitted ON submitted.job_id = t.job_id
+ON CONFLICT (job_id) DO NOTHING
+RETURNING job_id`
+
+func (repo *PostgresJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
+	ctx := armadacontext.Background()
+	added := make(map[string]bool, len(jobs))
+	for _, batch := range util.Batch(jobs, repo.batchSize) {
+		ids := make([]string, 0, len(batch))
+		queues := make([]string, 0, len(batch))
+		jobSets := make([]string, 0, len(batch))
+		priorities := make([]float64, 0, len(batch))
+		jobDatas := make([][]byte, 0, len(batch))
+		inBatch := make(map[string]bool, len(batch))
+		for _, job := range batch {
+			// Jobs submitted more than once are added only once, and a row can only be inserted once per statement.
+			if inBatch[job.Id] || added[job.Id] {
+				continue
+			}
+			inBatch[job.Id] = true
+			jobData, err := proto.Marshal(job)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			ids = append(ids, job.Id)
+			queues = append(queues, job.Queue)
+			jobSets = append(jobSets, job.JobSetId)
+			priorities = append(priorities, job.Priority)
+			jobDatas = append(jobDatas, jobData)
+		}
+
+		addedIds, err := repo.queryJobIds(ctx, addJobsSql, ids, queues, jobSets, priorities, jobDatas)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range addedIds {
+			added[id] = true
+		}
+	}
+
+	result := make([]*SubmitJobResult, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, &SubmitJobResult{
+			JobId:            job.Id,
+			SubmittedJob:     job,
+			AlreadyProcessed: !added[job.Id],
+		})
+		// Only the first of several jobs with the same id is added.
+		delete(added, job.Id)
+	}
+	return result, nil
+}
+
+func (repo *PostgresJobRepository) AddJobsWithOutboxEvents(jobs []*api.Job, events []*OutboxEvent) ([]*SubmitJobResult, error) {
+	return nil, errors.New("the outbox isn't supported when storing jobs in Postgres")
+}
+
+func (repo *PostgresJobRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
+	ctx := armadacontext.Background()
+	return repo.queryJobs(ctx, `
+		SELECT job_id, job FROM jobs
+		WHERE queue = $1 AND NOT leased
+		ORDER BY priority, job_id
+		LIMIT $2`, queue, limit)
+}
+
+func (repo *PostgresJobRepository) TryLeaseJobs(clusterId string, jobIdsByQueue map[string][]string) (map[string][]string, error) {
+	ctx := armadacontext.Background()
+	type queuedJob struct{ jobId, queue string }
+	var jobs []queuedJob
+	for queue, jobIds := range jobIdsByQueue {
+		for _, jobId := range jobIds {
+			jobs = append(jobs, queuedJob{jobId: jobId, queue: queue})
+		}
+	}
+	leasedJobIdsByQueue := make(map[string][]string, len(jobIdsByQueue))
+	for _, batch := range util.Batch(jobs, repo.batchSize) {
+		batchIds := make([]string, len(batch))
+		batchQueues := make([]string, len(batch))
+		for i, job := range batch {
+			batchIds[i] = job.jobId
+			batchQueues[i] = job.queue
+		}
+		err := repo.lease(ctx, clusterId, leasedJobIdsByQueue, `
+			UPDATE jobs SET leased = true, cluster_id = $1, lease_time = $2
+			FROM unnest($3::text[], $4::text[]) AS t(job_id, queue)
+			WHERE jobs.job_id = t.job_id AND jobs.queue = t.queue AND (NOT jobs.leased OR jobs.cluster_id = $1)
+			RETURNING jobs.job_id, jobs.queue`, batchIds, batchQueues)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return leasedJobIdsByQueue, nil
+}
+
+func (repo *PostgresJobRepository) RenewLease(clusterId string, jobIds []string) ([]string, error) {
+	ctx := armadacontext.Background()
+	leasedJobIdsByQueue := make(map[string][]string)
+	for _, batch := range util.Batch(jobIds, repo.batchSize) {
+		err := repo.lease(ctx, clusterId, leasedJobIdsByQueue, `
+			UPDATE jobs SET leased = true, cluster_id = $1, lease_time = $2
+			WHERE job_id = ANY($3) AND (NOT leased OR cluster_id = $1)
+			RETURNING job_id, queue`, batch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	leasedJobIds := make([]string, 0, len(jobIds))
+	for _, ids := range leasedJobIdsByQueue {
+		leasedJobIds = append(leasedJobIds, ids...)
+	}
+	return leasedJobIds, nil
+}
+
+// lease runs a statement leasing jobs to clusterId, which returns the id and queue of each leased job,
+// and adds the leased jobs to leasedJobIdsByQueue.
+func (repo *PostgresJobRepository) lease(
+	ctx *armadacontext.Context,
+	clusterId string,
+	leasedJobIdsByQueue map[string][]string,
+	sql string,
+	args ...any,
+) error {
+	args = append([]any{clusterId, time.Now().UnixNano()}, args...)
+	rows, err := repo.db.Query(ctx, sql, args...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var jobId, queue string
+		if err := rows.Scan(&jobId, &queue); err != nil {
+			return errors.WithStack(err)
+		}
+		leasedJobIdsByQueue[queue] = append(leasedJobIdsByQueue[queue], jobId)
+	}
+	return errors.WithStack(rows.Err())
+}
+
+func (repo *PostgresJobRepository) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	ctx := armadacontext.Background()
+	jobs, err := repo.queryJobs(ctx, `
+		UPDATE jobs SET leased = false, cluster_id = NULL, lease_time = NULL
+		WHERE job_id = $1 AND leased AND cluster_id = $2
+		RETURNING job_id, job`, jobId, clusterId)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error returning lease for job %s and cluster %s", jobId, clusterId)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+func (repo *PostgresJobRepository) ExpireLeases(queue string, deadline time.Time) ([]*api.Job, error) {
+	ctx := armadacontext.Background()
+	return repo.queryJobs(ctx, `
+		UPDATE jobs SET leased = false, cluster_id = NULL, lease_time = NULL
+		WHERE queue = $1 AND leased AND lease_time < $2
+		RETURNING job_id, job`, queue, deadline.UnixNano())
+}
+
+func (repo *PostgresJobRepository) ExpireLeasesById(jobIds []string, deadline time.Time) ([]*api.Job, error) {
+	ctx := armadacontext.Background()
+	expired := make([]*api.Job, 0)
+	for _, batch := range util.Batch(jobIds, repo.batchSize) {
+		jobs, err := repo.queryJobs(ctx, `
+			UPDATE jobs SET leased = false, cluster_id = NULL, lease_time = NULL
+			WHERE job_id = ANY($1) AND leased AND lease_time < $2
+			RETURNING job_id, job`, batch, deadline.UnixNano())
+		if err != nil {
+			return nil, err
+		}
+		expired = append(expired, jobs...)
+	}
+	return expired, nil
+}
+
+func (repo *PostgresJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error, error) {
+	ctx := armadacontext.Background()
+	deleted := make(map[string]bool, len(jobs))
+	for _, batch := range util.Batch(jobs, repo.batchSize) {
+		ids := make([]string, len(batch))
+		for i, job := range batch {
+			ids[i] = job.Id
+		}
+		err := pgx.BeginTxFunc(ctx, repo.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+			for _, sql := range []string{
+				`DELETE FROM jobs WHERE job_id = ANY($1) RETURNING job_id`,
+				`DELETE FROM job_start_times WHERE job_id = ANY($1) RETURNING job_id`,
+				`DELETE FROM job_retries WHERE job_id = ANY($1) RETURNING job_id`,
+			} {
+				deletedIds, err := queryJobIds(ctx, tx, sql, ids)
+				if err != nil {
+					return err
+				}
+				for _, id := range deletedIds {
+					deleted[id] = true
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cancelledJobs := map[*api.Job]error{}
+	for _, job := range jobs {
+		if deleted[job.Id] {
+			cancelledJobs[job] = nil
+		}
+	}
+	return cancelledJobs, nil
+}
+
+func (repo *PostgresJobRepository) GetJobsByIds(ids []string) ([]*JobResult, error) {
+	ctx := armadacontext.Background()
+	jobsById := make(map[string]*api.Job, len(ids))
+	for _, batch := range util.Batch(ids, repo.batchSize) {
+		err := repo.scanJobs(ctx, func(job *api.Job) {
+			jobsById[job.Id] = job
+		}, `SELECT job_id, job FROM jobs WHERE job_id = ANY($1)`, batch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*JobResult, len(ids))
+	for i, id := range ids {
+		results[i] = &JobResult{JobId: id}
+		if job, ok := jobsById[id]; ok {
+			results[i].Job = job
+		} else {
+			results[i].Error = &armadaerrors.ErrNotFound{
+				Type:  "job",
+				Value: id,
+			}
+		}
+	}
+	return results, nil
+}
+
+func (repo *PostgresJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	jobResults, err := repo.GetJobsByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	return existingJobs(jobResults)
+}
+
+func (repo *PostgresJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
+	sizes, err := repo.queuedJobCounts(queues)
+	if err != nil {
+		return nil, err
+	}
+	var active []*api.Queue
+	for _, queue := range queues {
+		if sizes[queue.Name] > 0 {
+			active = append(active, queue)
+		}
+	}
+	return active, nil
+}
+
+func (repo *PostgresJobRepository) GetQueueSizes(queues []*api.Queue) ([]int64, error) {
+	counts, err := repo.queuedJobCounts(queues)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make([]int64, len(queues))
+	for i, queue := range queues {
+		sizes[i] = counts[queue.Name]
+	}
+	return sizes, nil
+}
+
+// queuedJobCounts returns the number of queued jobs of each of the provided queues that has any.
+func (repo *PostgresJobRepository) queuedJobCounts(queues []*api.Queue) (map[string]int64, error) {
+	ctx := armadacontext.Background()
+	names := make([]string, len(queues))
+	for i, queue := range queues {
+		names[i] = queue.Name
+	}
+	rows, err := repo.db.Query(ctx, `
+		SELECT queue, count(*) FROM jobs
+		WHERE queue = ANY($1) AND NOT leased
+		GROUP BY queue`, names)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+	counts := make(map[string]int64, len(queues))
+	for rows.Next() {
+		var queue string
+		var count int64
+		if err := rows.Scan(&queue, &count); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		counts[queue] = count
+	}
+	return counts, errors.WithStack(rows.Err())
+}
+
+func (repo *PostgresJobRepository) GetQueueJobIds(queueName string) ([]string, error) {
+	ctx := armadacontext.Background()
+	return repo.queryJobIds(ctx, `
+		SELECT job_id FROM jobs
+		WHERE queue = $1 AND NOT leased
+		ORDER BY priority, job_id`, queueName)
+}
+
+func (repo *PostgresJobRepository) GetLeasedJobIds(queue string) ([]string, error) {
+	ctx := armadacontext.Background()
+	return repo.queryJobIds(ctx, `
+		SELECT job_id FROM jobs
+		WHERE queue = $1 AND leased
+		ORDER BY lease_time, job_id`, queue)
+}
+
+func (repo *PostgresJobRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
+	return repo.GetJobSetJobIds(queue, jobSetId, &JobSetFilter{
+		IncludeLeased: true,
+		IncludeQueued: true,
+	})
+}
+
+func (repo *PostgresJobRepository) GetJobSetJobIds(queue string, jobSetId string, filter *JobSetFilter) ([]string, error) {
+	ctx := armadacontext.Background()
+	includeQueued := filter == nil || filter.IncludeQueued
+	includeLeased := filter == nil || filter.IncludeLeased
+	ids, err := repo.queryJobIds(ctx, `
+		SELECT job_id FROM jobs
+		WHERE queue = $1 AND job_set = $2 AND ((NOT leased AND $3) OR (leased AND $4))`,
+		queue, jobSetId, includeQueued, includeLeased)
+	if err != nil {
+		return nil, err
+	}
+	if ids == nil {
+		ids = []string{}
+	}
+	return ids, nil
+}
+
+func (repo *PostgresJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
+	ctx := armadacontext.Background()
+	rows, err := repo.db.Query(ctx, `
+		SELECT job_set, count(*) FILTER (WHERE NOT leased), count(*) FILTER (WHERE leased)
+		FROM jobs
+		WHERE queue = $1
+		GROUP BY job_set`, queue)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+	result := []*api.JobSetInfo{}
+	for rows.Next() {
+		info := &api.JobSetInfo{}
+		if err := rows.Scan(&info.Name, &info.QueuedJobs, &info.LeasedJobs); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		result = append(result, info)
+	}
+	return result, errors.WithStack(rows.Err())
+}
+
+// The earliest start time reported for each cluster is kept.
+const updateStartTimeSql = `
+INSERT INTO job_start_times (job_id, cluster_id, start_time)
+SELECT t.job_id, t.cluster_id, t.start_time
+FROM unnest($1::text[], $2::text[], $3::bigint[]) AS t(job_id, cluster_id, start_time)
+JOIN jobs ON jobs.job_id = t.job_id
+ON CONFLICT (job_id, cluster_id) DO UPDATE SET start_time = LEAST(job_start_times.start_time, excluded.start_time)
+RETURNING job_id`
+
+func (repo *PostgresJobRepository) UpdateStartTime(jobStartInfos []*JobStartInfo) ([]error, error) {
+	ctx := armadacontext.Background()
+	updated := make(map[string]bool, len(jobStartInfos))
+	for _, batch := range util.Batch(jobStartInfos, repo.batchSize) {
+		// A row can only be updated once per statement, so only the earliest start time per job and cluster is sent.
+		type jobCluster struct{ jobId, clusterId string }
+		earliest := make(map[jobCluster]int64, len(batch))
+		for _, info := range batch {
+			key := jobCluster{info.JobId, info.ClusterId}
+			startTime := info.StartTime.UTC().UnixNano()
+			if current, ok := earliest[key]; !ok || startTime < current {
+				earliest[key] = startTime
+			}
+		}
+		jobIds := make([]string, 0, len(earliest))
+		clusterIds := make([]string, 0, len(earliest))
+		startTimes := make([]int64, 0, len(earliest))
+		for key, startTime := range earliest {
+			jobIds = append(jobIds, key.jobId)
+			clusterIds = append(clusterIds, key.clusterId)
+			startTimes = append(startTimes, startTime)
+		}
+
+		updatedIds, err := repo.queryJobIds(ctx, updateStartTimeSql, jobIds, clusterIds, startTimes)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range updatedIds {
+			updated[id] = true
+		}
+	}
+
+	jobErrors := make([]error, len(jobStartInfos))
+	for i, info := range jobStartInfos {
+		if !updated[info.JobId] {
+			jobErrors[i] = &ErrJobNotFound{JobId: info.JobId, ClusterId: info.ClusterId}
+		}
+	}
+	return jobErrors, nil
+}
+
+// UpdateJobs applies mutator to jobs read from the database and writes them back, while holding a lock on them.
+// Any jobs that can't be found are ignored.
+func (repo *PostgresJobRepository) UpdateJobs(ids []string, mutator func([]*api.Job)) ([]UpdateJobResult, error) {
+	ctx := armadacontext.Background()
+	result := make([]UpdateJobResult, 0, len(ids))
+	for _, batch := range util.Batch(ids, repo.batchSize) {
+		var batchResult []UpdateJobResult
+		err := pgx.BeginTxFunc(ctx, repo.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+			batchResult = nil
+			var jobs []*api.Job
+			// Rows are locked in a consistent order to avoid deadlocks between concurrent updates.
+			err := scanJobs(ctx, tx, func(job *api.Job) {
+				initialiseJob(job)
+				jobs = append(jobs, job)
+			}, `SELECT job_id, job FROM jobs WHERE job_id = ANY($1) ORDER BY job_id FOR UPDATE`, batch)
+			if err != nil {
+				return err
+			}
+
+			mutator(jobs)
+
+			updatedIds := make([]string, len(jobs))
+			priorities := make([]float64, len(jobs))
+			jobDatas := make([][]byte, len(jobs))
+			for i, job := range jobs {
+				jobData, err := proto.Marshal(job)
+				if err != nil {
+					return errors.Wrapf(err, "job id %s", job.Id)
+				}
+				updatedIds[i] = job.Id
+				priorities[i] = job.Priority
+				jobDatas[i] = jobData
+			}
+			_, err = tx.Exec(ctx, `
+				UPDATE jobs SET priority = t.priority, job = t.job
+				FROM unnest($1::text[], $2::float8[], $3::bytea[]) AS t(job_id, priority, job)
+				WHERE jobs.job_id = t.job_id`, updatedIds, priorities, jobDatas)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			for _, job := range jobs {
+				batchResult = append(batchResult, UpdateJobResult{JobId: job.Id, Job: job})
+			}
+			return nil
+		})
+		if err != nil {
+			for _, id := range batch {
+				result = append(result, UpdateJobResult{JobId: id, Error: err})
+			}
+			continue
+		}
+		result = append(result, batchResult...)
+	}
+	return result, nil
+}
+
+func (repo *PostgresJobRepository) GetJobRunInfos(jobIds []string) (map[string]*RunInfo, error) {
+	ctx := armadacontext.Background()
+	runInfos := make(map[string]*RunInfo, len(jobIds))
+	for _, batch := range util.Batch(jobIds, repo.batchSize) {
+		rows, err := repo.db.Query(ctx, `
+			SELECT jobs.job_id, jobs.cluster_id, job_start_times.start_time
+			FROM jobs
+			JOIN job_start_times ON job_start_times.job_id = jobs.job_id AND job_start_times.cluster_id = jobs.cluster_id
+			WHERE jobs.job_id = ANY($1)`, batch)
+		if err != nil {
+			return runInfos, errors.WithStack(err)
+		}
+		for rows.Next() {
+			var jobId, clusterId string
+			var startTime int64
+			if err := rows.Scan(&jobId, &clusterId, &startTime); err != nil {
+				rows.Close()
+				return runInfos, errors.WithStack(err)
+			}
+			runInfos[jobId] = &RunInfo{
+				StartTime:        time.Unix(0, startTime),
+				CurrentClusterId: clusterId,
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return runInfos, errors.WithStack(err)
+		}
+	}
+	return runInfos, nil
+}
+
+func (repo *PostgresJobRepository) AddRetryAttempt(jobId string) error {
+	ctx := armadacontext.Background()
+	_, err := repo.db.Exec(ctx, `
+		INSERT INTO job_retries (job_id, attempts) VALUES ($1, 1)
+		ON CONFLICT (job_id) DO UPDATE SET attempts = job_retries.attempts + 1`, jobId)
+	return errors.WithStack(err)
+}
+
+func (repo *PostgresJobRepository) GetNumberOfRetryAttempts(jobId string) (int, error) {
+	ctx := armadacontext.Background()
+	var retries int
+	err := repo.db.QueryRow(ctx, `SELECT attempts FROM job_retries WHERE job_id = $1`, jobId).Scan(&retries)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return retries, nil
+}
+
+// Pulsar scheduler job details expire after a year, as with Redis.
+const pulsarSchedulerJobDetailsTtl = 375 * 24 * time.Hour
+
+func (repo *PostgresJobRepository) StorePulsarSchedulerJobDetails(jobDetails []*schedulerobjects.PulsarSchedulerJobDetails) error {
+	ctx := armadacontext.Background()
+	expires := time.Now().Add(pulsarSchedulerJobDetailsTtl)
+	for _, batch := range util.Batch(jobDetails, repo.batchSize) {
+		details := make(map[string][]byte, len(batch))
+		for _, job := range batch {
+			jobData, err := proto.Marshal(job)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			details[job.JobId] = jobData
+		}
+		jobIds := make([]string, 0, len(details))
+		jobDatas := make([][]byte, 0, len(details))
+		for jobId, jobData := range details {
+			jobIds = append(jobIds, jobId)
+			jobDatas = append(jobDatas, jobData)
+		}
+		_, err := repo.db.Exec(ctx, `
+			INSERT INTO pulsar_scheduler_job_details (job_id, details, expires)
+			SELECT t.job_id, t.details, $3 FROM unnest($1::text[], $2::bytea[]) AS t(job_id, details)
+			ON CONFLICT (job_id) DO UPDATE SET details = excluded.details, expires = excluded.expires`,
+			jobIds, jobDatas, expires)
+		if err != nil {
+			return errors.Wrapf(err, "error storing pulsar job details in postgres")
+		}
+	}
+	return nil
+}
+
+func (repo *PostgresJobRepository) GetPulsarSchedulerJobDetails(jobId string) (*schedulerobjects.PulsarSchedulerJobDetails, error) {
+	ctx := armadacontext.Background()
+	var jobData []byte
+	err := repo.db.QueryRow(ctx, `
+		SELECT details FROM pulsar_scheduler_job_details
+		WHERE job_id = $1 AND expires > now()`, jobId).Scan(&jobData)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "error retrieving job details for %s in postgres", jobId)
+	}
+	details, err := protoutil.Unmarshall(jobData, &schedulerobjects.PulsarSchedulerJobDetails{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unmarshalling job details for %s in postgres", jobId)
+	}
+	return details, nil
+}
+
+func (repo *PostgresJobRepository) DeletePulsarSchedulerJobDetails(jobIds []string) error {
+	ctx := armadacontext.Background()
+	for _, batch := range util.Batch(jobIds, repo.batchSize) {
+		_, err := repo.db.Exec(ctx, `DELETE FROM pulsar_scheduler_job_details WHERE job_id = ANY($1)`, batch)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete pulsar job details in postgres")
+		}
+	}
+	return nil
+}
+
+// cleanup deletes the records that Redis would have expired, i.e.,
+// records of submissions older than a week and expired pulsar scheduler job details.
+func (repo *PostgresJobRepository) cleanup(ctx *armadacontext.Context) error {
+	_, err := repo.db.Exec(ctx, `DELETE FROM job_submissions WHERE submitted < now() - interval '7 days'`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = repo.db.Exec(ctx, `DELETE FROM pulsar_scheduler_job_details WHERE expires < now()`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// PeriodicCleanup deletes expired records every interval until the provided context is cancelled.
+func (repo *PostgresJobRepository) PeriodicCleanup(ctx *armadacontext.Context, interval time.Duration) error {
+	log := log.WithField("service", "PostgresJobRepositoryCleanup")
+	log.Info("service started")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			start := time.Now()
+			err := repo.cleanup(ctx)
+			if err != nil {
+				logging.WithStacktrace(log, err).WithField("delay", time.Since(start)).Warn("cleanup failed")
+			} else {
+				log.WithField("delay", time.Since(start)).Info("cleanup succeeded")
+			}
+		}
+	}
+}
+
+func (repo *PostgresJobRepository) queryJobs(ctx *armadacontext.Context, sql string, args ...any) ([]*api.Job, error) {
+	jobs := make([]*api.Job, 0)
+	err := repo.scanJobs(ctx, func(job *api.Job) {
+		initialiseJob(job)
+		jobs = append(jobs, job)
+	}, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (repo *PostgresJobRepository) scanJobs(ctx *armadacontext.Context, f func(*api.Job), sql string, args ...any) error {
+	return scanJobs(ctx, repo.db, f, sql, args...)
+}
+
+func (repo *PostgresJobRepository) queryJobIds(ctx *armadacontext.Context, sql string, args ...any) ([]string, error) {
+	return queryJobIds(ctx, repo.db, sql, args...)
+}
+
+// scanJobs runs a query returning the id and data of jobs and calls f for each job returned.
+func scanJobs(ctx *armadacontext.Context, db database.Querier, f func(*api.Job), sql string, args ...any) error {
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var jobId string
+		var jobData []byte
+		if err := rows.Scan(&jobId, &jobData); err != nil {
+			return errors.WithStack(err)
+		}
+		job, err := unmarshalJob(jobId, jobData)
+		if err != nil {
+			return err
+		}
+		f(job)
+	}
+	return errors.WithStack(rows.Err())
+}
+
+// queryJobIds runs a query returning job ids.
+func queryJobIds(ctx *armadacontext.Context, db database.Querier, sql string, args ...any) ([]string, error) {
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, errors.WithStack(rows.Err())
+}
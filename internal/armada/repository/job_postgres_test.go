@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestPostgresJobRepository_AddJobs(t *testing.T) {
+	withPostgresRepository(t, func(r *PostgresJobRepository) {
+		job := postgresTestJob("queue1", "job-set", 1)
+		other := postgresTestJob("queue1", "job-set", 2)
+		results, err := r.AddJobs([]*api.Job{job, job, other})
+		require.NoError(t, err)
+		assert.False(t, results[0].AlreadyProcessed)
+		assert.True(t, results[1].AlreadyProcessed)
+		assert.False(t, results[2].AlreadyProcessed)
+
+		// Jobs submitted again are ignored, even once deleted.
+		_, err = r.DeleteJobs([]*api.Job{job})
+		require.NoError(t, err)
+		results, err = r.AddJobs([]*api.Job{job})
+		require.NoError(t, err)
+		assert.True(t, results[0].AlreadyProcessed)
+
+		jobs, err := r.GetExistingJobsByIds([]string{job.Id, other.Id})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, other.Id, jobs[0].Id)
+		assert.NotNil(t, jobs[0].Annotations)
+
+		jobResults, err := r.GetJobsByIds([]string{job.Id})
+		require.NoError(t, err)
+		assert.Error(t, jobResults[0].Error)
+	})
+}
+
+func TestPostgresJobRepository_PeekQueue(t *testing.T) {
+	withPostgresRepository(t, func(r *PostgresJobRepository) {
+		low := postgresTestJob("queue1", "job-set", 1)
+		high := postgresTestJob("queue1", "job-set", 2)
+		addPostgresJobs(t, r, high, low, postgresTestJob("queue2", "job-set", 0))
+
+		jobs, err := r.PeekQueue("queue1", 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, low.Id, jobs[0].Id)
+		assert.Equal(t, high.Id, jobs[1].Id)
+
+		sizes, err := r.GetQueueSizes([]*api.Queue{{Name: "queue1"}, {Name: "queue3"}})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 0}, sizes)
+	})
+}
+
+func TestPostgresJobRepository_Lease(t *testing.T) {
+	withPostgresRepository(t, func(r *PostgresJobRepository) {
+		job := postgresTestJob("queue1", "job-set", 1)
+		addPostgresJobs(t, r, job)
+
+		leased, err := r.TryLeaseJobs("cluster1", map[string][]string{"queue1": {job.Id}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{"queue1": {job.Id}}, leased)
+
+		// Jobs can only be leased once, but leases can be renewed.
+		leased, err = r.TryLeaseJobs("cluster2", map[string][]string{"queue1": {job.Id}})
+		require.NoError(t, err)
+		assert.Empty(t, leased)
+		renewed, err := r.RenewLease("cluster2", []string{job.Id})
+		require.NoError(t, err)
+		assert.Empty(t, renewed)
+		renewed, err = r.RenewLease("cluster1", []string{job.Id})
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, renewed)
+
+		leasedIds, err := r.GetLeasedJobIds("queue1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, leasedIds)
+		queuedIds, err := r.GetQueueJobIds("queue1")
+		require.NoError(t, err)
+		assert.Empty(t, queuedIds)
+
+		returned, err := r.ReturnLease("cluster2", job.Id)
+		require.NoError(t, err)
+		assert.Nil(t, returned)
+		returned, err = r.ReturnLease("cluster1", job.Id)
+		require.NoError(t, err)
+		require.NotNil(t, returned)
+		assert.Equal(t, job.Id, returned.Id)
+	})
+}
+
+func TestPostgresJobRepository_ExpireLeases(t *testing.T) {
+	withPostgresRepository(t, func(r *PostgresJobRepository) {
+		job := postgresTestJob("queue1", "job-set", 1)
+		addPostgresJobs(t, r, job)
+		_, err := r.TryLeaseJobs("cluster1", map[string][]string{"queue1": {job.Id}})
+		require.NoError(t, err)
+
+		expired, err := r.ExpireLeases("queue1", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Empty(t, expired)
+		expired, err = r.ExpireLeases("queue1", time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		assert.Equal(t, job.Id, expired[0].Id)
+
+		// Expired jobs can be leased by other clusters.
+		leased, err := r.TryLeaseJobs("cluster2", map[string][]string{"queue1": {job.Id}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{"queue1": {job.Id}}, leased)
+	})
+}
+
+func TestPostgresJobRepository_UpdateStartTime(t *testing.T) {
+	withPostgresRepository(t, func(r *PostgresJobRepository) {
+		job := postgresTestJob("queue1", "job-set", 1)
+		addPostgresJobs(t, r, job)
+		_, err := r.TryLeaseJobs("cluster1", map[string][]string{"queue1": {job.Id}})
+		require.NoError(t, err)
+
+		startTime := time.Now()
+		jobErrors, err := r.UpdateStartTime([]*JobStartInfo{
+			{JobId: job.Id, ClusterId: "cluster1", StartTime: startTime},
+			{JobId: job.Id, ClusterId: "cluster1", StartTime: startTime.Add(time.Minute)},
+			{JobId: "missing", ClusterId: "cluster1", StartTime: startTime},
+		})
+		require.NoError(t, err)
+		assert.NoError(t, jobErrors[0])
+		assert.NoError(t, jobErrors[1])
+		assert.Equal(t, &ErrJobNotFound{JobId: "missing", ClusterId: "cluster1"}, jobErrors[2])
+
+		// The earliest start time is kept.
+		_, err = r.UpdateStartTime([]*JobStartInfo{{JobId: job.Id, ClusterId: "cluster1", StartTime: startTime.Add(time.Hour)}})
+		require.NoError(t, err)
+		runInfos, err := r.GetJobRunInfos([]string{job.Id})
+		require.NoError(t, err)
+		require.Contains(t, runInfos, job.Id)
+		assert.Equal(t, startTime.UnixNano(), runInfos[job.Id].StartTime.UnixNano())
+		assert.Equal(t, "cluster1", runInfos[job.Id].CurrentClusterId)
+	})
+}
+
+func TestPostgresJobRepository_UpdateJobs(t *testing.T) {
+	withPostgresRepository(t, func(r *PostgresJobRepository) {
+		first := postgresTestJob("queue1", "job-set", 1)
+		second := postgresTestJob("queue1", "job-set", 2)
+		addPostgresJobs(t, r, first, second)
+
+		results, err := r.UpdateJobs([]string{second.Id, "missing"}, func(jobs []*api.Job) {
+			for _, job := range jobs {
+				job.Priority = 0
+			}
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.NoError(t, results[0].Error)
+
+		queuedIds, err := r.GetQueueJobIds("queue1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{second.Id, first.Id}, queuedIds)
+	})
+}
+
+func TestPostgresJobRepository_JobSets(t *testing.T) {
+	withPostgresRepository(t, func(r *PostgresJobRepository) {
+		queued := postgresTestJob("queue1", "job-set", 1)
+		leased := postgresTestJob("queue1", "job-set", 1)
+		addPostgresJobs(t, r, queued, leased, postgresTestJob("queue1", "other-job-set", 1))
+		_, err := r.TryLeaseJobs("cluster1", map[string][]string{"queue1": {leased.Id}})
+		require.NoError(t, err)
+
+		ids, err := r.GetActiveJobIds("queue1", "job-set")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{queued.Id, leased.Id}, ids)
+		ids, err = r.GetJobSetJobIds("queue1", "job-set", &JobSetFilter{IncludeLeased: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{leased.Id}, ids)
+
+		infos, err := r.GetQueueActiveJobSets("queue1")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*api.JobSetInfo{
+			{Name: "job-set", QueuedJobs: 1, LeasedJobs: 1},
+			{Name: "other-job-set", QueuedJobs: 1},
+		}, infos)
+	})
+}
+
+func TestPostgresJobRepository_RetryAttempts(t *testing.T) {
+	withPostgresRepository(t, func(r *PostgresJobRepository) {
+		retries, err := r.GetNumberOfRetryAttempts("job")
+		require.NoError(t, err)
+		assert.Equal(t, 0, retries)
+		require.NoError(t, r.AddRetryAttempt("job"))
+		require.NoError(t, r.AddRetryAttempt("job"))
+		retries, err = r.GetNumberOfRetryAttempts("job")
+		require.NoError(t, err)
+		assert.Equal(t, 2, retries)
+	})
+}
+
+func postgresTestJob(queue string, jobSetId string, priority float64) *api.Job {
+	return &api.Job{
+		Id:       util.NewULID(),
+		Queue:    queue,
+		JobSetId: jobSetId,
+		Priority: priority,
+		PodSpec:  &v1.PodSpec{},
+	}
+}
+
+func addPostgresJobs(t *testing.T, r *PostgresJobRepository, jobs ...*api.Job) {
+	results, err := r.AddJobs(jobs)
+	require.NoError(t, err)
+	for _, result := range results {
+		require.False(t, result.AlreadyProcessed)
+	}
+}
+
+func withPostgresRepository(t *testing.T, action func(r *PostgresJobRepository)) {
+	migrations, err := database.ReadMigrations(postgresJobRepositoryMigrations, "migrations")
+	require.NoError(t, err)
+	err = database.WithTestDb(migrations, func(db *pgxpool.Pool) error {
+		// A small batch size, such that batching is exercised.
+		action(NewPostgresJobRepository(db, 1))
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	jobSetSizePrefix = "JobSetSize:"
+	// Job sets no jobs have been submitted to for this long are considered empty.
+	jobSetSizeExpiry = 14 * 24 * time.Hour
+)
+
+// JobSetSizeRepository counts the jobs submitted to each job set, such that the number of jobs per job set can be
+// limited. The count of a job set is reset once no jobs have been submitted to it for two weeks.
+type JobSetSizeRepository interface {
+	// ReserveJobs adds n to the number of jobs submitted to a job set, unless the result would exceed max, in which
+	// case the count is left unchanged. Returns the resulting count, or the count that would have resulted if max
+	// would be exceeded, and whether the jobs were reserved.
+	ReserveJobs(queue string, jobSetId string, n int, max int) (int, bool, error)
+	// ReleaseJobs subtracts n from the number of jobs submitted to a job set,
+	// e.g., since submitting jobs reserved by ReserveJobs failed.
+	ReleaseJobs(queue string, jobSetId string, n int) error
+}
+
+type RedisJobSetSizeRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisJobSetSizeRepository(db redis.UniversalClient) *RedisJobSetSizeRepository {
+	return &RedisJobSetSizeRepository{db: db}
+}
+
+func (r *RedisJobSetSizeRepository) ReserveJobs(queue string, jobSetId string, n int, max int) (int, bool, error) {
+	key := jobSetSizeKey(queue, jobSetId)
+	pipe := r.db.TxPipeline()
+	incr := pipe.IncrBy(key, int64(n))
+	pipe.Expire(key, jobSetSizeExpiry)
+	if _, err := pipe.Exec(); err != nil {
+		return 0, false, fmt.Errorf("[RedisJobSetSizeRepository.ReserveJobs] error writing to database: %s", err)
+	}
+
+	// Incrementing first and undoing the increment if the limit is exceeded
+	// ensures concurrent submissions can't together exceed the limit.
+	count := int(incr.Val())
+	if count > max {
+		if err := r.db.DecrBy(key, int64(n)).Err(); err != nil {
+			return 0, false, fmt.Errorf("[RedisJobSetSizeRepository.ReserveJobs] error writing to database: %s", err)
+		}
+		return count, false, nil
+	}
+	return count, true, nil
+}
+
+func (r *RedisJobSetSizeRepository) ReleaseJobs(queue string, jobSetId string, n int) error {
+	if err := r.db.DecrBy(jobSetSizeKey(queue, jobSetId), int64(n)).Err(); err != nil {
+		return fmt.Errorf("[RedisJobSetSizeRepository.ReleaseJobs] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func jobSetSizeKey(queue string, jobSetId string) string {
+	return jobSetSizePrefix + queue + "/" + jobSetId
+}
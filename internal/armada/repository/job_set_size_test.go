@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReserveJobs(t *testing.T) {
+	withJobSetSizeRepository(func(r *RedisJobSetSizeRepository) {
+		count, ok, err := r.ReserveJobs("queue", "job-set", 3, 5)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, 3, count)
+
+		count, ok, err = r.ReserveJobs("queue", "job-set", 3, 5)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, 6, count)
+
+		count, ok, err = r.ReserveJobs("queue", "job-set", 2, 5)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, 5, count)
+
+		count, ok, err = r.ReserveJobs("queue", "other-job-set", 5, 5)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, 5, count)
+	})
+}
+
+func TestReleaseJobs(t *testing.T) {
+	withJobSetSizeRepository(func(r *RedisJobSetSizeRepository) {
+		_, ok, err := r.ReserveJobs("queue", "job-set", 5, 5)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		err = r.ReleaseJobs("queue", "job-set", 2)
+		assert.NoError(t, err)
+
+		count, ok, err := r.ReserveJobs("queue", "job-set", 2, 5)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, 5, count)
+	})
+}
+
+func withJobSetSizeRepository(action func(r *RedisJobSetSizeRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	repo := NewRedisJobSetSizeRepository(client)
+	action(repo)
+}
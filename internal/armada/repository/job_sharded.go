@@ -0,0 +1,617 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// JobIdSharder is implemented by job repositories storing jobs across several databases.
+type JobIdSharder interface {
+	// ShardJobIds partitions ids by the database storing the corresponding jobs,
+	// such that operations on the jobs of each partition only involve a single database.
+	ShardJobIds(ids []string) [][]string
+}
+
+// RedisJobShard is a Redis database storing part of the jobs of a ShardedJobRepository.
+type RedisJobShard struct {
+	// Identifies the shard on the hash ring.
+	Name string
+	Db   redis.UniversalClient
+	// If true, no jobs are assigned to the shard, such that it can be removed once Reshard has moved its jobs.
+	Draining bool
+}
+
+// ShardedJobRepository is an implementation of JobRepository that distributes jobs across several Redis databases,
+// each of which stores jobs as RedisJobRepository does. Jobs are assigned to databases by consistent hashing of their
+// id, such that adding a database only requires moving a fraction of jobs (see Reshard).
+//
+// Operations on jobs are routed to the databases storing those jobs, and operations on queues and job sets are
+// performed on all databases, in parallel. Until Reshard has moved their jobs, draining databases are included
+// in operations on queues and job sets, and are searched for jobs not found on the database they're assigned to.
+type ShardedJobRepository struct {
+	shards []*RedisJobShard
+	repos  []*RedisJobRepository
+	ring   *hashRing
+	// Index of the shard of each shard on the ring, i.e., of each shard that isn't draining.
+	ringShards []int
+	// Index of each draining shard.
+	drainingShards []int
+}
+
+func NewShardedJobRepository(shards []*RedisJobShard) (*ShardedJobRepository, error) {
+	r := &ShardedJobRepository{shards: shards}
+	names := make(map[string]bool, len(shards))
+	var ringNames []string
+	for i, shard := range shards {
+		if names[shard.Name] {
+			return nil, errors.Errorf("shard name %s is used more than once", shard.Name)
+		}
+		names[shard.Name] = true
+		r.repos = append(r.repos, NewRedisJobRepository(shard.Db))
+		if shard.Draining {
+			r.drainingShards = append(r.drainingShards, i)
+		} else {
+			r.ringShards = append(r.ringShards, i)
+			ringNames = append(ringNames, shard.Name)
+		}
+	}
+	if len(r.ringShards) == 0 {
+		return nil, errors.New("at least one shard must not be draining")
+	}
+	r.ring = newHashRing(ringNames)
+	return r, nil
+}
+
+// Shards returns all shards, including those that are draining.
+func (r *ShardedJobRepository) Shards() []*RedisJobShard {
+	return r.shards
+}
+
+// shardOf returns the index of the shard jobId is assigned to.
+func (r *ShardedJobRepository) shardOf(jobId string) int {
+	return r.ringShards[r.ring.get(jobId)]
+}
+
+func (r *ShardedJobRepository) ShardJobIds(ids []string) [][]string {
+	groups := r.groupByShard(len(ids), func(i int) string { return ids[i] })
+	var result [][]string
+	for shard := range r.shards {
+		if indices, ok := groups[shard]; ok {
+			result = append(result, pick(ids, indices))
+		}
+	}
+	return result
+}
+
+// groupByShard returns, for each shard any of n items is assigned to, the indices of the items assigned to it.
+func (r *ShardedJobRepository) groupByShard(n int, jobId func(i int) string) map[int][]int {
+	groups := make(map[int][]int)
+	for i := 0; i < n; i++ {
+		shard := r.shardOf(jobId(i))
+		groups[shard] = append(groups[shard], i)
+	}
+	return groups
+}
+
+// forEachGroup calls f for each group of groupByShard in parallel.
+func (r *ShardedJobRepository) forEachGroup(groups map[int][]int, f func(repo *RedisJobRepository, indices []int) error) error {
+	var g errgroup.Group
+	for shard, indices := range groups {
+		repo, indices := r.repos[shard], indices
+		g.Go(func() error { return f(repo, indices) })
+	}
+	return g.Wait()
+}
+
+// forEachShard calls f for each shard, including those that are draining, in parallel, with the index of the shard.
+func (r *ShardedJobRepository) forEachShard(f func(i int, repo *RedisJobRepository) error) error {
+	var g errgroup.Group
+	for i, repo := range r.repos {
+		i, repo := i, repo
+		g.Go(func() error { return f(i, repo) })
+	}
+	return g.Wait()
+}
+
+func pick[T any](items []T, indices []int) []T {
+	picked := make([]T, len(indices))
+	for j, i := range indices {
+		picked[j] = items[i]
+	}
+	return picked
+}
+
+func (r *ShardedJobRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
+	jobsByShard := make([][]*api.Job, len(r.repos))
+	err := r.forEachShard(func(i int, repo *RedisJobRepository) error {
+		jobs, err := repo.PeekQueue(queue, limit)
+		jobsByShard[i] = jobs
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var jobs []*api.Job
+	for _, shardJobs := range jobsByShard {
+		jobs = append(jobs, shardJobs...)
+	}
+	// Order as a single sorted set would, i.e., by priority and then by id.
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].Priority != jobs[j].Priority {
+			return jobs[i].Priority < jobs[j].Priority
+		}
+		return jobs[i].Id < jobs[j].Id
+	})
+	if int64(len(jobs)) > limit {
+		jobs = jobs[:limit]
+	}
+	return jobs, nil
+}
+
+func (r *ShardedJobRepository) TryLeaseJobs(clusterId string, jobIdsByQueue map[string][]string) (map[string][]string, error) {
+	jobIdsByShardAndQueue := make(map[int]map[string][]string)
+	for queue, jobIds := range jobIdsByQueue {
+		for _, jobId := range jobIds {
+			shard := r.shardOf(jobId)
+			if jobIdsByShardAndQueue[shard] == nil {
+				jobIdsByShardAndQueue[shard] = make(map[string][]string)
+			}
+			jobIdsByShardAndQueue[shard][queue] = append(jobIdsByShardAndQueue[shard][queue], jobId)
+		}
+	}
+	var mu sync.Mutex
+	leasedJobIdsByQueue := make(map[string][]string, len(jobIdsByQueue))
+	var g errgroup.Group
+	for shard, shardJobIdsByQueue := range jobIdsByShardAndQueue {
+		repo, shardJobIdsByQueue := r.repos[shard], shardJobIdsByQueue
+		g.Go(func() error {
+			leased, err := repo.TryLeaseJobs(clusterId, shardJobIdsByQueue)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for queue, jobIds := range leased {
+				leasedJobIdsByQueue[queue] = append(leasedJobIdsByQueue[queue], jobIds...)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return leasedJobIdsByQueue, nil
+}
+
+func (r *ShardedJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
+	return r.addJobs(jobs, nil)
+}
+
+// AddJobsWithOutboxEvents adds the events of the jobs on each shard to the outbox of that shard,
+// so each shard's outbox must be published separately.
+func (r *ShardedJobRepository) AddJobsWithOutboxEvents(jobs []*api.Job, events []*OutboxEvent) ([]*SubmitJobResult, error) {
+	if len(events) != len(jobs) {
+		return nil, errors.Errorf("got %d outbox events for %d jobs", len(events), len(jobs))
+	}
+	return r.addJobs(jobs, events)
+}
+
+func (r *ShardedJobRepository) addJobs(jobs []*api.Job, events []*OutboxEvent) ([]*SubmitJobResult, error) {
+	results := make([]*SubmitJobResult, len(jobs))
+	groups := r.groupByShard(len(jobs), func(i int) string { return jobs[i].Id })
+	err := r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		var shardEvents []*OutboxEvent
+		if events != nil {
+			shardEvents = pick(events, indices)
+		}
+		shardResults, err := repo.addJobs(pick(jobs, indices), shardEvents)
+		if err != nil {
+			return err
+		}
+		for j, i := range indices {
+			results[i] = shardResults[j]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *ShardedJobRepository) GetJobsByIds(ids []string) ([]*JobResult, error) {
+	results := make([]*JobResult, len(ids))
+	groups := r.groupByShard(len(ids), func(i int) string { return ids[i] })
+	err := r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		shardResults, err := repo.GetJobsByIds(pick(ids, indices))
+		if err != nil {
+			return err
+		}
+		for j, i := range indices {
+			results[i] = shardResults[j]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.getJobsFromDrainingShards(results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// getJobsFromDrainingShards looks up the jobs not found on the shard they're assigned to on the draining shards,
+// which store jobs until Reshard has moved them, and replaces the results of the jobs found.
+func (r *ShardedJobRepository) getJobsFromDrainingShards(results []*JobResult) error {
+	for _, shard := range r.drainingShards {
+		var missing []int
+		for i, result := range results {
+			var errNotFound *armadaerrors.ErrNotFound
+			if errors.As(result.Error, &errNotFound) {
+				missing = append(missing, i)
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		shardResults, err := r.repos[shard].GetJobsByIds(util.Map(pick(results, missing), func(result *JobResult) string {
+			return result.JobId
+		}))
+		if err != nil {
+			return err
+		}
+		for j, i := range missing {
+			if shardResults[j].Job != nil {
+				results[i] = shardResults[j]
+			}
+		}
+	}
+	return nil
+}
+
+func (r *ShardedJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	jobResults, err := r.GetJobsByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	return existingJobs(jobResults)
+}
+
+func (r *ShardedJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
+	activeByShard := make([][]*api.Queue, len(r.repos))
+	err := r.forEachShard(func(i int, repo *RedisJobRepository) error {
+		active, err := repo.FilterActiveQueues(queues)
+		activeByShard[i] = active
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	isActive := make(map[*api.Queue]bool, len(queues))
+	for _, active := range activeByShard {
+		for _, queue := range active {
+			isActive[queue] = true
+		}
+	}
+	var active []*api.Queue
+	for _, queue := range queues {
+		if isActive[queue] {
+			active = append(active, queue)
+		}
+	}
+	return active, nil
+}
+
+func (r *ShardedJobRepository) GetQueueSizes(queues []*api.Queue) ([]int64, error) {
+	sizesByShard := make([][]int64, len(r.repos))
+	err := r.forEachShard(func(i int, repo *RedisJobRepository) error {
+		sizes, err := repo.GetQueueSizes(queues)
+		sizesByShard[i] = sizes
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	sizes := make([]int64, len(queues))
+	for _, shardSizes := range sizesByShard {
+		for i, size := range shardSizes {
+			sizes[i] += size
+		}
+	}
+	return sizes, nil
+}
+
+func (r *ShardedJobRepository) GetQueueJobIds(queueName string) ([]string, error) {
+	membersByShard := make([][]redis.Z, len(r.repos))
+	err := r.forEachShard(func(i int, repo *RedisJobRepository) error {
+		members, err := repo.db.ZRangeWithScores(jobQueuePrefix+queueName, 0, -1).Result()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		membersByShard[i] = members
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var members []redis.Z
+	for _, shardMembers := range membersByShard {
+		members = append(members, shardMembers...)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return members[i].Member.(string) < members[j].Member.(string)
+	})
+	ids := make([]string, len(members))
+	for i, member := range members {
+		ids[i] = member.Member.(string)
+	}
+	return ids, nil
+}
+
+func (r *ShardedJobRepository) RenewLease(clusterId string, jobIds []string) ([]string, error) {
+	return r.collectJobIds(jobIds, func(repo *RedisJobRepository, jobIds []string) ([]string, error) {
+		return repo.RenewLease(clusterId, jobIds)
+	})
+}
+
+func (r *ShardedJobRepository) ExpireLeases(queue string, deadline time.Time) ([]*api.Job, error) {
+	expiredByShard := make([][]*api.Job, len(r.repos))
+	err := r.forEachShard(func(i int, repo *RedisJobRepository) error {
+		expired, err := repo.ExpireLeases(queue, deadline)
+		expiredByShard[i] = expired
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	expired := make([]*api.Job, 0)
+	for _, shardExpired := range expiredByShard {
+		expired = append(expired, shardExpired...)
+	}
+	return expired, nil
+}
+
+func (r *ShardedJobRepository) ExpireLeasesById(jobIds []string, deadline time.Time) ([]*api.Job, error) {
+	var mu sync.Mutex
+	expired := make([]*api.Job, 0)
+	groups := r.groupByShard(len(jobIds), func(i int) string { return jobIds[i] })
+	err := r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		shardExpired, err := repo.ExpireLeasesById(pick(jobIds, indices), deadline)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		expired = append(expired, shardExpired...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+func (r *ShardedJobRepository) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	return r.repos[r.shardOf(jobId)].ReturnLease(clusterId, jobId)
+}
+
+func (r *ShardedJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error, error) {
+	var mu sync.Mutex
+	result := make(map[*api.Job]error, len(jobs))
+	groups := r.groupByShard(len(jobs), func(i int) string { return jobs[i].Id })
+	err := r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		shardResult, err := repo.DeleteJobs(pick(jobs, indices))
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for job, err := range shardResult {
+			result[job] = err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Jobs absent from the result weren't found on the shard they're assigned to,
+	// so may not have been moved from a draining shard yet.
+	for _, shard := range r.drainingShards {
+		var missing []*api.Job
+		for _, job := range jobs {
+			if _, ok := result[job]; !ok {
+				missing = append(missing, job)
+			}
+		}
+		if len(missing) == 0 {
+			break
+		}
+		shardResult, err := r.repos[shard].DeleteJobs(missing)
+		if err != nil {
+			return nil, err
+		}
+		for job, err := range shardResult {
+			result[job] = err
+		}
+	}
+	return result, nil
+}
+
+func (r *ShardedJobRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
+	return r.GetJobSetJobIds(queue, jobSetId, &JobSetFilter{
+		IncludeLeased: true,
+		IncludeQueued: true,
+	})
+}
+
+func (r *ShardedJobRepository) GetJobSetJobIds(queue string, jobSetId string, filter *JobSetFilter) ([]string, error) {
+	return r.concatJobIds(func(repo *RedisJobRepository) ([]string, error) {
+		return repo.GetJobSetJobIds(queue, jobSetId, filter)
+	})
+}
+
+func (r *ShardedJobRepository) GetLeasedJobIds(queue string) ([]string, error) {
+	return r.concatJobIds(func(repo *RedisJobRepository) ([]string, error) {
+		return repo.GetLeasedJobIds(queue)
+	})
+}
+
+// concatJobIds concatenates the ids returned by f for each shard.
+func (r *ShardedJobRepository) concatJobIds(f func(repo *RedisJobRepository) ([]string, error)) ([]string, error) {
+	idsByShard := make([][]string, len(r.repos))
+	err := r.forEachShard(func(i int, repo *RedisJobRepository) error {
+		ids, err := f(repo)
+		idsByShard[i] = ids
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, shardIds := range idsByShard {
+		ids = append(ids, shardIds...)
+	}
+	return ids, nil
+}
+
+// collectJobIds groups jobIds by shard, calls f for each group, and concatenates the ids returned.
+func (r *ShardedJobRepository) collectJobIds(jobIds []string, f func(repo *RedisJobRepository, jobIds []string) ([]string, error)) ([]string, error) {
+	var mu sync.Mutex
+	result := make([]string, 0, len(jobIds))
+	groups := r.groupByShard(len(jobIds), func(i int) string { return jobIds[i] })
+	err := r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		ids, err := f(repo, pick(jobIds, indices))
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		result = append(result, ids...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *ShardedJobRepository) UpdateStartTime(jobStartInfos []*JobStartInfo) ([]error, error) {
+	jobErrors := make([]error, len(jobStartInfos))
+	groups := r.groupByShard(len(jobStartInfos), func(i int) string { return jobStartInfos[i].JobId })
+	err := r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		shardErrors, err := repo.UpdateStartTime(pick(jobStartInfos, indices))
+		if err != nil {
+			return err
+		}
+		for j, i := range indices {
+			jobErrors[i] = shardErrors[j]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobErrors, nil
+}
+
+// UpdateJobs updates the jobs on each shard separately, i.e., mutator is called once per shard with the jobs
+// stored on that shard. Shards are updated one at a time, such that mutator isn't called concurrently.
+func (r *ShardedJobRepository) UpdateJobs(ids []string, mutator func([]*api.Job)) ([]UpdateJobResult, error) {
+	result := make([]UpdateJobResult, 0, len(ids))
+	for _, shardIds := range r.ShardJobIds(ids) {
+		shardResult, err := r.repos[r.shardOf(shardIds[0])].UpdateJobs(shardIds, mutator)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, shardResult...)
+	}
+	return result, nil
+}
+
+func (r *ShardedJobRepository) GetJobRunInfos(jobIds []string) (map[string]*RunInfo, error) {
+	var mu sync.Mutex
+	runInfos := make(map[string]*RunInfo, len(jobIds))
+	groups := r.groupByShard(len(jobIds), func(i int) string { return jobIds[i] })
+	err := r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		shardRunInfos, err := repo.GetJobRunInfos(pick(jobIds, indices))
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for jobId, runInfo := range shardRunInfos {
+			runInfos[jobId] = runInfo
+		}
+		return nil
+	})
+	return runInfos, err
+}
+
+func (r *ShardedJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
+	infosByShard := make([][]*api.JobSetInfo, len(r.repos))
+	err := r.forEachShard(func(i int, repo *RedisJobRepository) error {
+		infos, err := repo.GetQueueActiveJobSets(queue)
+		infosByShard[i] = infos
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	jobSets := map[string]*api.JobSetInfo{}
+	for _, infos := range infosByShard {
+		for _, info := range infos {
+			jobSet, ok := jobSets[info.Name]
+			if !ok {
+				jobSet = &api.JobSetInfo{Name: info.Name}
+				jobSets[info.Name] = jobSet
+			}
+			jobSet.QueuedJobs += info.QueuedJobs
+			jobSet.LeasedJobs += info.LeasedJobs
+		}
+	}
+	result := []*api.JobSetInfo{}
+	for _, info := range jobSets {
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+func (r *ShardedJobRepository) AddRetryAttempt(jobId string) error {
+	return r.repos[r.shardOf(jobId)].AddRetryAttempt(jobId)
+}
+
+func (r *ShardedJobRepository) GetNumberOfRetryAttempts(jobId string) (int, error) {
+	return r.repos[r.shardOf(jobId)].GetNumberOfRetryAttempts(jobId)
+}
+
+func (r *ShardedJobRepository) StorePulsarSchedulerJobDetails(jobDetails []*schedulerobjects.PulsarSchedulerJobDetails) error {
+	groups := r.groupByShard(len(jobDetails), func(i int) string { return jobDetails[i].JobId })
+	return r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		return repo.StorePulsarSchedulerJobDetails(pick(jobDetails, indices))
+	})
+}
+
+func (r *ShardedJobRepository) GetPulsarSchedulerJobDetails(jobId string) (*schedulerobjects.PulsarSchedulerJobDetails, error) {
+	return r.repos[r.shardOf(jobId)].GetPulsarSchedulerJobDetails(jobId)
+}
+
+func (r *ShardedJobRepository) DeletePulsarSchedulerJobDetails(jobIds []string) error {
+	groups := r.groupByShard(len(jobIds), func(i int) string { return jobIds[i] })
+	return r.forEachGroup(groups, func(repo *RedisJobRepository, indices []int) error {
+		return repo.DeletePulsarSchedulerJobDetails(pick(jobIds, indices))
+	})
+}
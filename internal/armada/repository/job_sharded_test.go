@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestNewShardedJobRepository(t *testing.T) {
+	_, err := NewShardedJobRepository([]*RedisJobShard{{Name: "a"}, {Name: "a"}})
+	assert.Error(t, err)
+	_, err = NewShardedJobRepository([]*RedisJobShard{{Name: "a", Draining: true}})
+	assert.Error(t, err)
+}
+
+func TestShardedJobRepository_ShardJobIds(t *testing.T) {
+	r, err := NewShardedJobRepository([]*RedisJobShard{{Name: "a"}, {Name: "b"}, {Name: "c", Draining: true}})
+	require.NoError(t, err)
+	ids := make([]string, 100)
+	for i := range ids {
+		ids[i] = util.NewULID()
+	}
+	shardedIds := r.ShardJobIds(ids)
+	require.Len(t, shardedIds, 2)
+	var all []string
+	for _, shardIds := range shardedIds {
+		shard := r.shardOf(shardIds[0])
+		assert.NotEqual(t, 2, shard)
+		for _, id := range shardIds {
+			assert.Equal(t, shard, r.shardOf(id))
+		}
+		all = append(all, shardIds...)
+	}
+	assert.ElementsMatch(t, ids, all)
+}
+
+func TestShardedJobRepository_AddAndGetJobs(t *testing.T) {
+	withShardedRepository(func(r *ShardedJobRepository) {
+		jobs := shardedTestJobs(20)
+		results, err := r.AddJobs(jobs)
+		require.NoError(t, err)
+		for i, result := range results {
+			assert.Equal(t, jobs[i].Id, result.JobId)
+		}
+
+		// Jobs are spread across shards.
+		for _, repo := range r.repos {
+			ids, err := repo.GetQueueJobIds("queue")
+			require.NoError(t, err)
+			assert.NotEmpty(t, ids)
+		}
+
+		ids := util.Map(jobs, func(job *api.Job) string { return job.Id })
+		jobResults, err := r.GetJobsByIds(append([]string{"missing"}, ids...))
+		require.NoError(t, err)
+		assert.Error(t, jobResults[0].Error)
+		for i, job := range jobs {
+			assert.Equal(t, job.Id, jobResults[i+1].Job.Id)
+		}
+
+		sizes, err := r.GetQueueSizes([]*api.Queue{{Name: "queue"}})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{20}, sizes)
+	})
+}
+
+func TestShardedJobRepository_PeekQueue(t *testing.T) {
+	withShardedRepository(func(r *ShardedJobRepository) {
+		jobs := shardedTestJobs(20)
+		for i, job := range jobs {
+			job.Priority = float64(20 - i)
+		}
+		_, err := r.AddJobs(jobs)
+		require.NoError(t, err)
+
+		peeked, err := r.PeekQueue("queue", 5)
+		require.NoError(t, err)
+		require.Len(t, peeked, 5)
+		for i, job := range peeked {
+			assert.Equal(t, jobs[19-i].Id, job.Id)
+		}
+
+		queuedIds, err := r.GetQueueJobIds("queue")
+		require.NoError(t, err)
+		require.Len(t, queuedIds, 20)
+		assert.Equal(t, jobs[19].Id, queuedIds[0])
+		assert.Equal(t, jobs[0].Id, queuedIds[19])
+	})
+}
+
+func TestShardedJobRepository_LeaseAndDelete(t *testing.T) {
+	withShardedRepository(func(r *ShardedJobRepository) {
+		jobs := shardedTestJobs(10)
+		_, err := r.AddJobs(jobs)
+		require.NoError(t, err)
+		ids := util.Map(jobs, func(job *api.Job) string { return job.Id })
+
+		leased, err := r.TryLeaseJobs("cluster", map[string][]string{"queue": ids})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ids, leased["queue"])
+		leasedIds, err := r.GetLeasedJobIds("queue")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ids, leasedIds)
+
+		deleted, err := r.DeleteJobs(jobs)
+		require.NoError(t, err)
+		assert.Len(t, deleted, 10)
+		activeIds, err := r.GetActiveJobIds("queue", "job-set")
+		require.NoError(t, err)
+		assert.Empty(t, activeIds)
+	})
+}
+
+func TestShardedJobRepository_Reshard(t *testing.T) {
+	withShardedRepository(func(r *ShardedJobRepository) {
+		// Store all jobs on the first shard, then add the second shard.
+		single, err := NewShardedJobRepository([]*RedisJobShard{r.shards[0]})
+		require.NoError(t, err)
+		jobs := shardedTestJobs(20)
+		_, err = single.AddJobs(jobs)
+		require.NoError(t, err)
+		_, err = single.TryLeaseJobs("cluster", map[string][]string{"queue": {jobs[0].Id, jobs[1].Id}})
+		require.NoError(t, err)
+		require.NoError(t, single.AddRetryAttempt(jobs[2].Id))
+
+		moved, err := r.Reshard(armadacontext.Background(), 3)
+		require.NoError(t, err)
+		assert.Greater(t, moved, 0)
+		assert.Less(t, moved, 20)
+
+		for _, job := range jobs {
+			jobs, err := r.repos[r.shardOf(job.Id)].GetExistingJobsByIds([]string{job.Id})
+			require.NoError(t, err)
+			assert.Len(t, jobs, 1)
+		}
+		leasedIds, err := r.GetLeasedJobIds("queue")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{jobs[0].Id, jobs[1].Id}, leasedIds)
+		retries, err := r.GetNumberOfRetryAttempts(jobs[2].Id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, retries)
+		sizes, err := r.GetQueueSizes([]*api.Queue{{Name: "queue"}})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{18}, sizes)
+
+		// Moved jobs can't be submitted again.
+		results, err := r.AddJobs(jobs)
+		require.NoError(t, err)
+		for _, result := range results {
+			assert.True(t, result.AlreadyProcessed)
+		}
+
+		moved, err = r.Reshard(armadacontext.Background(), 3)
+		require.NoError(t, err)
+		assert.Equal(t, 0, moved)
+	})
+}
+
+func TestShardedJobRepository_DrainingShard(t *testing.T) {
+	withShardedRepository(func(r *ShardedJobRepository) {
+		// Store all jobs on the second shard, then mark it as draining.
+		single, err := NewShardedJobRepository([]*RedisJobShard{r.shards[1]})
+		require.NoError(t, err)
+		jobs := shardedTestJobs(10)
+		_, err = single.AddJobs(jobs)
+		require.NoError(t, err)
+		draining, err := NewShardedJobRepository([]*RedisJobShard{
+			r.shards[0],
+			{Name: r.shards[1].Name, Db: r.shards[1].Db, Draining: true},
+		})
+		require.NoError(t, err)
+
+		queues := []*api.Queue{{Name: "queue"}, {Name: "empty"}}
+		sizes, err := draining.GetQueueSizes(queues)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{10, 0}, sizes)
+		active, err := draining.FilterActiveQueues(queues)
+		require.NoError(t, err)
+		assert.Equal(t, queues[:1], active)
+		peeked, err := draining.PeekQueue("queue", 20)
+		require.NoError(t, err)
+		assert.Len(t, peeked, 10)
+
+		ids := util.Map(jobs, func(job *api.Job) string { return job.Id })
+		jobResults, err := draining.GetJobsByIds(append(ids, "missing"))
+		require.NoError(t, err)
+		for i, job := range jobs {
+			require.NoError(t, jobResults[i].Error)
+			assert.Equal(t, job.Id, jobResults[i].Job.Id)
+		}
+		assert.Error(t, jobResults[10].Error)
+
+		deleted, err := draining.DeleteJobs(jobs)
+		require.NoError(t, err)
+		assert.Len(t, deleted, 10)
+		sizes, err = draining.GetQueueSizes(queues)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{0, 0}, sizes)
+	})
+}
+
+func shardedTestJobs(n int) []*api.Job {
+	jobs := make([]*api.Job, n)
+	for i := range jobs {
+		jobs[i] = &api.Job{
+			Id:       util.NewULID(),
+			Queue:    "queue",
+			JobSetId: "job-set",
+			Priority: 1,
+		}
+	}
+	return jobs
+}
+
+func withShardedRepository(action func(r *ShardedJobRepository)) {
+	var shards []*RedisJobShard
+	for _, name := range []string{"a", "b"} {
+		db, err := miniredis.Run()
+		if err != nil {
+			panic(err)
+		}
+		defer db.Close()
+		client := redis.NewClient(&redis.Options{Addr: db.Addr()})
+		defer client.Close()
+		shards = append(shards, &RedisJobShard{Name: name, Db: client})
+	}
+	r, err := NewShardedJobRepository(shards)
+	if err != nil {
+		panic(err)
+	}
+	action(r)
+}
@@ -0,0 +1,46 @@
+-- Jobs that are queued or leased, i.e., that haven't been deleted yet.
+CREATE TABLE jobs (
+    job_id text PRIMARY KEY,
+    queue text NOT NULL,
+    job_set text NOT NULL,
+    -- Jobs with lower priority are leased first.
+    priority double precision NOT NULL,
+    -- api.Job message stored as a proto buffer.
+    job bytea NOT NULL,
+    -- Indicates that the job is leased to the cluster cluster_id rather than queued.
+    leased boolean NOT NULL DEFAULT false,
+    cluster_id text,
+    -- Time at which the lease was last renewed, in nanoseconds since the epoch.
+    lease_time bigint
+);
+CREATE INDEX idx_jobs_queued ON jobs (queue, priority, job_id) WHERE NOT leased;
+CREATE INDEX idx_jobs_leased ON jobs (queue, lease_time) WHERE leased;
+CREATE INDEX idx_jobs_job_set ON jobs (queue, job_set);
+
+-- Ids of recently submitted jobs, used to ignore jobs submitted more than once.
+CREATE TABLE job_submissions (
+    job_id text PRIMARY KEY,
+    submitted timestamptz NOT NULL
+);
+CREATE INDEX idx_job_submissions_submitted ON job_submissions (submitted);
+
+-- Earliest time at which each job started on each cluster it was leased to, in nanoseconds since the epoch.
+CREATE TABLE job_start_times (
+    job_id text NOT NULL,
+    cluster_id text NOT NULL,
+    start_time bigint NOT NULL,
+    PRIMARY KEY (job_id, cluster_id)
+);
+
+CREATE TABLE job_retries (
+    job_id text PRIMARY KEY,
+    attempts integer NOT NULL
+);
+
+CREATE TABLE pulsar_scheduler_job_details (
+    job_id text PRIMARY KEY,
+    -- PulsarSchedulerJobDetails message stored as a proto buffer.
+    details bytea NOT NULL,
+    expires timestamptz NOT NULL
+);
+CREATE INDEX idx_pulsar_scheduler_job_details_expires ON pulsar_scheduler_job_details (expires);
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	webhookHashKey            = "NotificationWebhook"
+	notificationJobSetPrefix  = "NotificationJobSet:"
+	notificationJobSetExpiry  = 30 * 24 * time.Hour
+	jobSetCountFieldActive    = "active"
+	jobSetCountFieldSucceeded = "succeeded"
+	jobSetCountFieldFailed    = "failed"
+	jobSetCountFieldCancelled = "cancelled"
+)
+
+type ErrWebhookNotFound struct {
+	Queue string
+	Id    string
+}
+
+func (err *ErrWebhookNotFound) Error() string {
+	return fmt.Sprintf("could not find webhook %q of queue %q", err.Id, err.Queue)
+}
+
+// JobSetCounts is the number of jobs of a job set that are active, i.e., submitted but not yet finished,
+// and the number that have finished in each terminal state.
+type JobSetCounts struct {
+	Active    int64
+	Succeeded int64
+	Failed    int64
+	Cancelled int64
+}
+
+// NotificationRepository stores the webhooks registered for notifications about job lifecycle events,
+// and the number of jobs of each job set in each state, used to notify webhooks when job sets complete.
+type NotificationRepository interface {
+	// GetWebhooks returns all webhooks of the provided queue, or of all queues if queue is empty.
+	GetWebhooks(queue string) ([]*api.Webhook, error)
+	CreateWebhook(webhook *api.Webhook) error
+	DeleteWebhook(queue string, id string) error
+	// UpdateJobSetCounts adds delta to the counts of a job set and returns the resulting counts.
+	// Counts of job sets that haven't been updated for 30 days are reset.
+	UpdateJobSetCounts(queue string, jobSetId string, delta JobSetCounts) (*JobSetCounts, error)
+	DeleteJobSetCounts(queue string, jobSetId string) error
+}
+
+type RedisNotificationRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisNotificationRepository(db redis.UniversalClient) *RedisNotificationRepository {
+	return &RedisNotificationRepository{db: db}
+}
+
+func (r *RedisNotificationRepository) GetWebhooks(queue string) ([]*api.Webhook, error) {
+	result, err := r.db.HGetAll(webhookHashKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[RedisNotificationRepository.GetWebhooks] error reading from database: %s", err)
+	}
+
+	webhooks := make([]*api.Webhook, 0)
+	for k, v := range result {
+		if queue != "" && !strings.HasPrefix(k, queue+"/") {
+			continue
+		}
+		webhook := &api.Webhook{}
+		if err := proto.Unmarshal([]byte(v), webhook); err != nil {
+			return nil, fmt.Errorf("[RedisNotificationRepository.GetWebhooks] error unmarshalling webhook: %s", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, nil
+}
+
+func (r *RedisNotificationRepository) CreateWebhook(webhook *api.Webhook) error {
+	data, err := proto.Marshal(webhook)
+	if err != nil {
+		return fmt.Errorf("[RedisNotificationRepository.CreateWebhook] error marshalling webhook: %s", err)
+	}
+	if err := r.db.HSet(webhookHashKey, webhookKey(webhook.Queue, webhook.Id), data).Err(); err != nil {
+		return fmt.Errorf("[RedisNotificationRepository.CreateWebhook] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisNotificationRepository) DeleteWebhook(queue string, id string) error {
+	result, err := r.db.HDel(webhookHashKey, webhookKey(queue, id)).Result()
+	if err != nil {
+		return fmt.Errorf("[RedisNotificationRepository.DeleteWebhook] error deleting webhook: %s", err)
+	}
+	if result == 0 {
+		return &ErrWebhookNotFound{Queue: queue, Id: id}
+	}
+	return nil
+}
+
+func (r *RedisNotificationRepository) UpdateJobSetCounts(queue string, jobSetId string, delta JobSetCounts) (*JobSetCounts, error) {
+	key := notificationJobSetKey(queue, jobSetId)
+	pipe := r.db.TxPipeline()
+	for field, value := range map[string]int64{
+		jobSetCountFieldActive:    delta.Active,
+		jobSetCountFieldSucceeded: delta.Succeeded,
+		jobSetCountFieldFailed:    delta.Failed,
+		jobSetCountFieldCancelled: delta.Cancelled,
+	} {
+		if value != 0 {
+			pipe.HIncrBy(key, field, value)
+		}
+	}
+	pipe.Expire(key, notificationJobSetExpiry)
+	getAll := pipe.HGetAll(key)
+	if _, err := pipe.Exec(); err != nil {
+		return nil, fmt.Errorf("[RedisNotificationRepository.UpdateJobSetCounts] error writing to database: %s", err)
+	}
+
+	counts := &JobSetCounts{}
+	for field, value := range getAll.Val() {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("[RedisNotificationRepository.UpdateJobSetCounts] error parsing count %s: %s", field, err)
+		}
+		switch field {
+		case jobSetCountFieldActive:
+			counts.Active = n
+		case jobSetCountFieldSucceeded:
+			counts.Succeeded = n
+		case jobSetCountFieldFailed:
+			counts.Failed = n
+		case jobSetCountFieldCancelled:
+			counts.Cancelled = n
+		}
+	}
+	return counts, nil
+}
+
+func (r *RedisNotificationRepository) DeleteJobSetCounts(queue string, jobSetId string) error {
+	if err := r.db.Del(notificationJobSetKey(queue, jobSetId)).Err(); err != nil {
+		return fmt.Errorf("[RedisNotificationRepository.DeleteJobSetCounts] error deleting job set counts: %s", err)
+	}
+	return nil
+}
+
+func webhookKey(queue string, id string) string {
+	return queue + "/" + id
+}
+
+func notificationJobSetKey(queue string, jobSetId string) string {
+	return notificationJobSetPrefix + queue + "/" + jobSetId
+}
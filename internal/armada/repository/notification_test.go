@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestWebhooks(t *testing.T) {
+	withNotificationRepository(t, func(r *RedisNotificationRepository) {
+		webhook := &api.Webhook{
+			Id:     "id",
+			Queue:  "queue",
+			Kind:   api.WebhookKind_WEBHOOK_SLACK,
+			Url:    "https://example.com",
+			Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_JOB_FAILED},
+		}
+		otherWebhook := &api.Webhook{Id: "other-id", Queue: "other-queue", Url: "https://example.com"}
+		require.NoError(t, r.CreateWebhook(webhook))
+		require.NoError(t, r.CreateWebhook(otherWebhook))
+
+		webhooks, err := r.GetWebhooks("queue")
+		require.NoError(t, err)
+		assert.Equal(t, []*api.Webhook{webhook}, webhooks)
+
+		webhooks, err = r.GetWebhooks("")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*api.Webhook{webhook, otherWebhook}, webhooks)
+
+		require.NoError(t, r.DeleteWebhook("queue", "id"))
+		webhooks, err = r.GetWebhooks("queue")
+		require.NoError(t, err)
+		assert.Empty(t, webhooks)
+
+		var notFound *ErrWebhookNotFound
+		assert.ErrorAs(t, r.DeleteWebhook("queue", "id"), &notFound)
+	})
+}
+
+func TestUpdateJobSetCounts(t *testing.T) {
+	withNotificationRepository(t, func(r *RedisNotificationRepository) {
+		counts, err := r.UpdateJobSetCounts("queue", "job-set", JobSetCounts{Active: 3})
+		require.NoError(t, err)
+		assert.Equal(t, &JobSetCounts{Active: 3}, counts)
+
+		counts, err = r.UpdateJobSetCounts("queue", "job-set", JobSetCounts{Active: -1, Failed: 1})
+		require.NoError(t, err)
+		assert.Equal(t, &JobSetCounts{Active: 2, Failed: 1}, counts)
+
+		counts, err = r.UpdateJobSetCounts("queue", "job-set", JobSetCounts{Active: -2, Succeeded: 1, Cancelled: 1})
+		require.NoError(t, err)
+		assert.Equal(t, &JobSetCounts{Active: 0, Succeeded: 1, Failed: 1, Cancelled: 1}, counts)
+
+		counts, err = r.UpdateJobSetCounts("queue", "other-job-set", JobSetCounts{Active: 1})
+		require.NoError(t, err)
+		assert.Equal(t, &JobSetCounts{Active: 1}, counts)
+
+		require.NoError(t, r.DeleteJobSetCounts("queue", "job-set"))
+		counts, err = r.UpdateJobSetCounts("queue", "job-set", JobSetCounts{})
+		require.NoError(t, err)
+		assert.Equal(t, &JobSetCounts{}, counts)
+	})
+}
+
+func withNotificationRepository(t *testing.T, action func(r *RedisNotificationRepository)) {
+	db, err := miniredis.Run()
+	require.NoError(t, err)
+	defer db.Close()
+	client := redis.NewClient(&redis.Options{Addr: db.Addr()})
+	defer client.Close()
+
+	action(NewRedisNotificationRepository(client))
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	outboxKey      = "Outbox:Events"
+	outboxLeaseKey = "Outbox:Lease"
+	// Fields of outbox entries.
+	outboxPayloadField = "payload"
+	outboxKeyField     = "key"
+)
+
+// OutboxEvent is an event to be added to the outbox together with a change to the database.
+type OutboxEvent struct {
+	// Marshalled event sequence to publish.
+	Payload []byte
+	// Key with which to publish the sequence, i.e., the name of its job set.
+	Key string
+}
+
+// OutboxEntry is an event in the outbox waiting to be published.
+type OutboxEntry struct {
+	Id string
+	OutboxEvent
+}
+
+// SequenceId returns a number identifying the entry that's larger than those of all entries added before it,
+// to be published with the entry such that Pulsar can discard entries published more than once.
+func (e *OutboxEntry) SequenceId() (int64, error) {
+	// Stream ids are of the form <milliseconds>-<sequence number within that millisecond>.
+	millis, seq, ok := strings.Cut(e.Id, "-")
+	if !ok {
+		return 0, fmt.Errorf("[OutboxEntry.SequenceId] invalid stream id %s", e.Id)
+	}
+	m, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("[OutboxEntry.SequenceId] invalid stream id %s: %s", e.Id, err)
+	}
+	s, err := strconv.ParseInt(seq, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("[OutboxEntry.SequenceId] invalid stream id %s: %s", e.Id, err)
+	}
+	if s >= 1<<20 {
+		return 0, fmt.Errorf("[OutboxEntry.SequenceId] stream id %s has too large a sequence number", e.Id)
+	}
+	return m<<20 | s, nil
+}
+
+// OutboxRepository stores events that have been committed together with changes to the database but not yet published,
+// such that changes can't be stored without the corresponding events eventually being published.
+// Entries are added by other repositories, e.g., by JobRepository.AddJobsWithOutboxEvents.
+type OutboxRepository interface {
+	// ReadPending returns up to limit entries in the order they were added.
+	ReadPending(limit int64) ([]*OutboxEntry, error)
+	// Remove removes published entries.
+	Remove(ids []string) error
+	// TryAcquireLease makes owner the only party publishing entries for the provided duration and returns true,
+	// or returns false if another party holds the lease. Owners renew their lease by calling this method again.
+	TryAcquireLease(owner string, duration time.Duration) (bool, error)
+	// ReleaseLease releases the lease if it's held by owner.
+	ReleaseLease(owner string) error
+}
+
+type RedisOutboxRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisOutboxRepository(db redis.UniversalClient) *RedisOutboxRepository {
+	return &RedisOutboxRepository{db: db}
+}
+
+func (r *RedisOutboxRepository) ReadPending(limit int64) ([]*OutboxEntry, error) {
+	messages, err := r.db.XRangeN(outboxKey, "-", "+", limit).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[RedisOutboxRepository.ReadPending] error reading from database: %s", err)
+	}
+	entries := make([]*OutboxEntry, len(messages))
+	for i, message := range messages {
+		payload, _ := message.Values[outboxPayloadField].(string)
+		key, _ := message.Values[outboxKeyField].(string)
+		entries[i] = &OutboxEntry{
+			Id:          message.ID,
+			OutboxEvent: OutboxEvent{Payload: []byte(payload), Key: key},
+		}
+	}
+	return entries, nil
+}
+
+func (r *RedisOutboxRepository) Remove(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := r.db.XDel(outboxKey, ids...).Err(); err != nil {
+		return fmt.Errorf("[RedisOutboxRepository.Remove] error deleting from database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisOutboxRepository) TryAcquireLease(owner string, duration time.Duration) (bool, error) {
+	acquired, err := acquireOutboxLeaseScript.Run(r.db, []string{outboxLeaseKey}, owner, duration.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("[RedisOutboxRepository.TryAcquireLease] error writing to database: %s", err)
+	}
+	return acquired == 1, nil
+}
+
+func (r *RedisOutboxRepository) ReleaseLease(owner string) error {
+	if err := releaseOutboxLeaseScript.Run(r.db, []string{outboxLeaseKey}, owner).Err(); err != nil && err != redis.Nil {
+		return fmt.Errorf("[RedisOutboxRepository.ReleaseLease] error writing to database: %s", err)
+	}
+	return nil
+}
+
+var acquireOutboxLeaseScript = redis.NewScript(`
+local leaseKey = KEYS[1]
+local owner = ARGV[1]
+local durationMillis = ARGV[2]
+
+local currentOwner = redis.call('GET', leaseKey)
+if currentOwner and currentOwner ~= owner then
+	return 0
+end
+redis.call('SET', leaseKey, owner, 'PX', durationMillis)
+return 1
+`)
+
+var releaseOutboxLeaseScript = redis.NewScript(`
+local leaseKey = KEYS[1]
+local owner = ARGV[1]
+
+if redis.call('GET', leaseKey) == owner then
+	redis.call('DEL', leaseKey)
+end
+return 0
+`)
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestOutboxEntry_SequenceId(t *testing.T) {
+	ids := []string{"1526919030474-0", "1526919030474-1", "1526919030475-0"}
+	var previous int64
+	for _, id := range ids {
+		sequenceId, err := (&OutboxEntry{Id: id}).SequenceId()
+		require.NoError(t, err)
+		assert.Greater(t, sequenceId, previous)
+		previous = sequenceId
+	}
+
+	for _, id := range []string{"", "1526919030474", "a-0", "1526919030474-1048576"} {
+		_, err := (&OutboxEntry{Id: id}).SequenceId()
+		assert.Error(t, err, id)
+	}
+}
+
+func TestAddJobsWithOutboxEvents(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		outbox := NewRedisOutboxRepository(r.db)
+		job := addTestJob(t, r, "queue1")
+		jobs := []*api.Job{job, {Id: util.NewULID(), Queue: "queue1", JobSetId: "set1", PodSpec: job.PodSpec}}
+		events := []*OutboxEvent{{Payload: []byte("event1"), Key: "jobSet1"}, {Payload: []byte("event2"), Key: "jobSet2"}}
+
+		results, err := r.AddJobsWithOutboxEvents(jobs, events)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.True(t, results[0].AlreadyProcessed)
+		assert.False(t, results[1].AlreadyProcessed)
+
+		// Only the event of the job that was added is in the outbox.
+		entries, err := outbox.ReadPending(10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, *events[1], entries[0].OutboxEvent)
+
+		require.NoError(t, outbox.Remove([]string{entries[0].Id}))
+		entries, err = outbox.ReadPending(10)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestOutboxLease(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		outbox := NewRedisOutboxRepository(r.db)
+
+		acquired, err := outbox.TryAcquireLease("a", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		acquired, err = outbox.TryAcquireLease("b", time.Minute)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		acquired, err = outbox.TryAcquireLease("a", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		require.NoError(t, outbox.ReleaseLease("b"))
+		acquired, err = outbox.TryAcquireLease("b", time.Minute)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		require.NoError(t, outbox.ReleaseLease("a"))
+		acquired, err = outbox.TryAcquireLease("b", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+}
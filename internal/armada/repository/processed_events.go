@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	processedEventsPrefix = "ProcessedEvents:"
+	// Messages are only re-delivered shortly after they're first received,
+	// so records are kept for long enough to cover redeliveries after extended outages.
+	processedEventsExpiry = 7 * 24 * time.Hour
+)
+
+// ProcessedEventRepository records which events of each event log message have been applied,
+// such that re-delivered messages don't apply events more than once.
+// Events within a message are applied in order, so for each message the number of events applied so far is stored.
+type ProcessedEventRepository interface {
+	// GetNumProcessed returns the number of events of the message with the provided id that have been applied,
+	// or 0 if no events of that message have been applied.
+	GetNumProcessed(messageId string) (int, error)
+	// SetNumProcessed records that the first numProcessed events of the message with the provided id have been applied.
+	SetNumProcessed(messageId string, numProcessed int) error
+}
+
+type RedisProcessedEventRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisProcessedEventRepository(db redis.UniversalClient) *RedisProcessedEventRepository {
+	return &RedisProcessedEventRepository{db: db}
+}
+
+func (r *RedisProcessedEventRepository) GetNumProcessed(messageId string) (int, error) {
+	numProcessed, err := r.db.Get(processedEventsPrefix + messageId).Int()
+	if err == redis.Nil {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("[RedisProcessedEventRepository.GetNumProcessed] error reading from database: %s", err)
+	}
+	return numProcessed, nil
+}
+
+func (r *RedisProcessedEventRepository) SetNumProcessed(messageId string, numProcessed int) error {
+	if err := r.db.Set(processedEventsPrefix+messageId, numProcessed, processedEventsExpiry).Err(); err != nil {
+		return fmt.Errorf("[RedisProcessedEventRepository.SetNumProcessed] error writing to database: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Reshard moves the jobs stored on shards other than the one they're assigned to, e.g., after adding shards
+// or marking shards as draining, to the shard they're assigned to, along with their leases, start times,
+// retry counts, and pulsar scheduler job details. Jobs are moved batchSize at a time.
+// Returns the number of jobs moved.
+//
+// Jobs are found via the queues of each shard and moved non-atomically, so no server may use the shards
+// while resharding. Resharding is idempotent, i.e., if it fails, it can be run again.
+func (r *ShardedJobRepository) Reshard(ctx *armadacontext.Context, batchSize int) (int, error) {
+	moved := 0
+	for from, shard := range r.shards {
+		jobIds, err := r.shardJobIds(from)
+		if err != nil {
+			return moved, err
+		}
+		groups := r.groupByShard(len(jobIds), func(i int) string { return jobIds[i] })
+		for to, indices := range groups {
+			if to == from {
+				continue
+			}
+			for _, batch := range util.Batch(pick(jobIds, indices), batchSize) {
+				if err := ctx.Err(); err != nil {
+					return moved, errors.WithStack(err)
+				}
+				if err := moveJobs(r.repos[from], r.repos[to], batch); err != nil {
+					return moved, errors.WithMessagef(err, "error moving jobs from shard %s to shard %s", shard.Name, r.shards[to].Name)
+				}
+				moved += len(batch)
+				ctx.Infof("moved %d jobs from shard %s to shard %s", len(batch), shard.Name, r.shards[to].Name)
+			}
+		}
+
+		detailsIds, err := scanKeySuffixes(shard.Db, pulsarJobPrefix)
+		if err != nil {
+			return moved, err
+		}
+		for _, jobId := range detailsIds {
+			if to := r.shardOf(jobId); to != from {
+				if err := movePulsarSchedulerJobDetails(r.repos[from], r.repos[to], jobId); err != nil {
+					return moved, err
+				}
+			}
+		}
+	}
+	return moved, nil
+}
+
+// shardJobIds returns the ids of all queued and leased jobs stored on a shard.
+func (r *ShardedJobRepository) shardJobIds(shard int) ([]string, error) {
+	db := r.shards[shard].Db
+	var jobIds []string
+	for _, prefix := range []string{jobQueuePrefix, jobLeasedPrefix} {
+		queues, err := scanKeySuffixes(db, prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, queue := range queues {
+			ids, err := db.ZRange(prefix+queue, 0, -1).Result()
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			jobIds = append(jobIds, ids...)
+		}
+	}
+	return jobIds, nil
+}
+
+// scanKeySuffixes returns the part following prefix of all keys starting with prefix.
+func scanKeySuffixes(db redis.UniversalClient, prefix string) ([]string, error) {
+	var suffixes []string
+	iterator := db.Scan(0, prefix+"*", 1000).Iterator()
+	for iterator.Next() {
+		suffixes = append(suffixes, strings.TrimPrefix(iterator.Val(), prefix))
+	}
+	if err := iterator.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return suffixes, nil
+}
+
+// moveJobs copies the jobs with the provided ids, and all state associated with them, from one shard to another,
+// and then deletes them from the first shard.
+func moveJobs(from *RedisJobRepository, to *RedisJobRepository, jobIds []string) error {
+	pipe := from.db.Pipeline()
+	dataCmds := make([]*redis.StringCmd, len(jobIds))
+	for i, jobId := range jobIds {
+		dataCmds[i] = pipe.Get(jobObjectPrefix + jobId)
+	}
+	if _, err := pipe.Exec(); err != nil && err != redis.Nil {
+		return errors.WithStack(err)
+	}
+
+	// The job is copied as stored, rather than as returned by GetJobsByIds, which modifies jobs.
+	type jobState struct {
+		job        *api.Job
+		data       []byte
+		queued     *redis.FloatCmd
+		leased     *redis.FloatCmd
+		clusterId  *redis.StringCmd
+		startTimes *redis.StringStringMapCmd
+		retries    *redis.StringCmd
+	}
+	var states []*jobState
+	pipe = from.db.Pipeline()
+	for i, jobId := range jobIds {
+		data, err := dataCmds[i].Bytes()
+		if err == redis.Nil {
+			// Deleted since listing the queues.
+			continue
+		} else if err != nil {
+			return errors.WithStack(err)
+		}
+		job := &api.Job{}
+		if err := proto.Unmarshal(data, job); err != nil {
+			return errors.Wrapf(err, "job id %s", jobId)
+		}
+		states = append(states, &jobState{
+			job:        job,
+			data:       data,
+			queued:     pipe.ZScore(jobQueuePrefix+job.Queue, jobId),
+			leased:     pipe.ZScore(jobLeasedPrefix+job.Queue, jobId),
+			clusterId:  pipe.HGet(jobClusterMapKey, jobId),
+			startTimes: pipe.HGetAll(jobStartTimePrefix + jobId),
+			retries:    pipe.Get(jobRetriesPrefix + jobId),
+		})
+	}
+	if _, err := pipe.Exec(); err != nil && err != redis.Nil {
+		return errors.WithStack(err)
+	}
+
+	tx := to.db.TxPipeline()
+	jobs := make([]*api.Job, len(states))
+	for i, state := range states {
+		job := state.job
+		jobs[i] = job
+		tx.Set(jobObjectPrefix+job.Id, state.data, 0)
+		tx.Set(jobExistsPrefix+job.Id, "1", 7*24*time.Hour)
+		tx.SAdd(jobSetPrefix+job.JobSetId, job.Id)
+		tx.SAdd(jobSetPrefix+job.Queue+keySeparator+job.JobSetId, job.Id)
+		if score, err := state.queued.Result(); err == nil {
+			tx.ZAdd(jobQueuePrefix+job.Queue, redis.Z{Score: score, Member: job.Id})
+		}
+		if score, err := state.leased.Result(); err == nil {
+			tx.ZAdd(jobLeasedPrefix+job.Queue, redis.Z{Score: score, Member: job.Id})
+		}
+		if clusterId, err := state.clusterId.Result(); err == nil {
+			tx.HSet(jobClusterMapKey, job.Id, clusterId)
+		}
+		if startTimes := state.startTimes.Val(); len(startTimes) > 0 {
+			fields := make(map[string]interface{}, len(startTimes))
+			for clusterId, startTime := range startTimes {
+				fields[clusterId] = startTime
+			}
+			tx.HMSet(jobStartTimePrefix+job.Id, fields)
+		}
+		if retries, err := state.retries.Result(); err == nil {
+			tx.Set(jobRetriesPrefix+job.Id, retries, 0)
+		}
+	}
+	if _, err := tx.Exec(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := from.DeleteJobs(jobs); err != nil {
+		return err
+	}
+	pipe = from.db.Pipeline()
+	for _, job := range jobs {
+		pipe.Del(jobExistsPrefix + job.Id)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// movePulsarSchedulerJobDetails moves the pulsar scheduler job details of a job from one shard to another,
+// keeping their expiry.
+func movePulsarSchedulerJobDetails(from *RedisJobRepository, to *RedisJobRepository, jobId string) error {
+	key := pulsarJobPrefix + jobId
+	pipe := from.db.Pipeline()
+	dataCmd := pipe.Get(key)
+	ttlCmd := pipe.PTTL(key)
+	if _, err := pipe.Exec(); err == redis.Nil {
+		return nil
+	} else if err != nil {
+		return errors.WithStack(err)
+	}
+	ttl := ttlCmd.Val()
+	if ttl < 0 {
+		ttl = 0
+	}
+	if err := to.db.Set(key, dataCmd.Val(), ttl).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := from.db.Del(key).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
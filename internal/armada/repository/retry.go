@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	jobRetryPrefix = "JobRetry:"
+	jobRetryDueKey = "JobRetryDue"
+	// Jobs of job sets that are abandoned before all of their jobs finish are eventually removed.
+	jobRetryExpiry = 14 * 24 * time.Hour
+)
+
+// JobRetryRepository stores the jobs that have a retry policy until they finish,
+// such that they can be resubmitted if they fail.
+type JobRetryRepository interface {
+	StoreJob(job *api.Job) error
+	// GetJob returns a stored job, or nil if there's no such job.
+	GetJob(queue string, jobSetId string, jobId string) (*api.Job, error)
+	DeleteJob(queue string, jobSetId string, jobId string) error
+	// ScheduleRetry marks a stored job as due to be retried at the provided time.
+	ScheduleRetry(queue string, jobSetId string, jobId string, due time.Time) error
+	// ClaimDueRetries removes and returns up to limit stored jobs that are due to be retried at now.
+	// Each job is returned to only one caller, even if several servers are running.
+	ClaimDueRetries(now time.Time, limit int) ([]*api.Job, error)
+}
+
+type RedisJobRetryRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisJobRetryRepository(db redis.UniversalClient) *RedisJobRetryRepository {
+	return &RedisJobRetryRepository{db: db}
+}
+
+func (r *RedisJobRetryRepository) StoreJob(job *api.Job) error {
+	data, err := proto.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("[RedisJobRetryRepository.StoreJob] error marshalling job: %s", err)
+	}
+
+	key := jobRetryKey(job.Queue, job.JobSetId)
+	pipe := r.db.TxPipeline()
+	pipe.HSet(key, job.Id, data)
+	pipe.Expire(key, jobRetryExpiry)
+	if _, err := pipe.Exec(); err != nil {
+		return fmt.Errorf("[RedisJobRetryRepository.StoreJob] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisJobRetryRepository) GetJob(queue string, jobSetId string, jobId string) (*api.Job, error) {
+	data, err := r.db.HGet(jobRetryKey(queue, jobSetId), jobId).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("[RedisJobRetryRepository.GetJob] error reading from database: %s", err)
+	}
+
+	job := &api.Job{}
+	if err := proto.Unmarshal([]byte(data), job); err != nil {
+		return nil, fmt.Errorf("[RedisJobRetryRepository.GetJob] error unmarshalling job: %s", err)
+	}
+	return job, nil
+}
+
+func (r *RedisJobRetryRepository) DeleteJob(queue string, jobSetId string, jobId string) error {
+	key := jobRetryKey(queue, jobSetId)
+	pipe := r.db.TxPipeline()
+	pipe.HDel(key, jobId)
+	pipe.ZRem(jobRetryDueKey, jobRetryDueMember(key, jobId))
+	if _, err := pipe.Exec(); err != nil {
+		return fmt.Errorf("[RedisJobRetryRepository.DeleteJob] error deleting job: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisJobRetryRepository) ScheduleRetry(queue string, jobSetId string, jobId string, due time.Time) error {
+	member := jobRetryDueMember(jobRetryKey(queue, jobSetId), jobId)
+	if err := r.db.ZAdd(jobRetryDueKey, redis.Z{Score: float64(due.Unix()), Member: member}).Err(); err != nil {
+		return fmt.Errorf("[RedisJobRetryRepository.ScheduleRetry] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisJobRetryRepository) ClaimDueRetries(now time.Time, limit int) ([]*api.Job, error) {
+	members, err := r.db.ZRangeByScore(jobRetryDueKey, redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.Unix(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[RedisJobRetryRepository.ClaimDueRetries] error reading from database: %s", err)
+	}
+
+	jobs := make([]*api.Job, 0, len(members))
+	for _, member := range members {
+		// Only the server that manages to remove the member from the set claims the retry.
+		removed, err := r.db.ZRem(jobRetryDueKey, member).Result()
+		if err != nil {
+			return nil, fmt.Errorf("[RedisJobRetryRepository.ClaimDueRetries] error writing to database: %s", err)
+		}
+		if removed == 0 {
+			continue
+		}
+
+		i := strings.LastIndex(member, "/")
+		key, jobId := member[:i], member[i+1:]
+		data, err := r.db.HGet(key, jobId).Result()
+		if err == redis.Nil {
+			// The job was deleted concurrently, e.g., since it expired.
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("[RedisJobRetryRepository.ClaimDueRetries] error reading from database: %s", err)
+		}
+		if err := r.db.HDel(key, jobId).Err(); err != nil {
+			return nil, fmt.Errorf("[RedisJobRetryRepository.ClaimDueRetries] error deleting job: %s", err)
+		}
+
+		job := &api.Job{}
+		if err := proto.Unmarshal([]byte(data), job); err != nil {
+			return nil, fmt.Errorf("[RedisJobRetryRepository.ClaimDueRetries] error unmarshalling job: %s", err)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func jobRetryKey(queue string, jobSetId string) string {
+	return jobRetryPrefix + queue + "/" + jobSetId
+}
+
+// Job ids never contain a "/", so the member can be split at the last one.
+func jobRetryDueMember(key string, jobId string) string {
+	return key + "/" + jobId
+}
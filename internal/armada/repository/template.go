@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const jobTemplateHashKey = "JobTemplate"
+
+type ErrJobTemplateNotFound struct {
+	Queue string
+	Name  string
+}
+
+func (err *ErrJobTemplateNotFound) Error() string {
+	return fmt.Sprintf("could not find job template %q in queue %q", err.Name, err.Queue)
+}
+
+type ErrJobTemplateAlreadyExists struct {
+	Queue string
+	Name  string
+}
+
+func (err *ErrJobTemplateAlreadyExists) Error() string {
+	return fmt.Sprintf("job template %s already exists in queue %s", err.Name, err.Queue)
+}
+
+type JobTemplateRepository interface {
+	// GetJobTemplates returns all job templates in the provided queue, or across all queues if queue is empty.
+	GetJobTemplates(queue string) ([]*api.JobTemplate, error)
+	GetJobTemplate(queue string, name string) (*api.JobTemplate, error)
+	CreateJobTemplate(jobTemplate *api.JobTemplate) error
+	UpdateJobTemplate(jobTemplate *api.JobTemplate) error
+	DeleteJobTemplate(queue string, name string) error
+}
+
+type RedisJobTemplateRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisJobTemplateRepository(db redis.UniversalClient) *RedisJobTemplateRepository {
+	return &RedisJobTemplateRepository{db: db}
+}
+
+func (r *RedisJobTemplateRepository) GetJobTemplates(queue string) ([]*api.JobTemplate, error) {
+	result, err := r.db.HGetAll(jobTemplateHashKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[RedisJobTemplateRepository.GetJobTemplates] error reading from database: %s", err)
+	}
+
+	jobTemplates := make([]*api.JobTemplate, 0)
+	for k, v := range result {
+		if queue != "" && !strings.HasPrefix(k, queue+"/") {
+			continue
+		}
+		jobTemplate := &api.JobTemplate{}
+		if err := proto.Unmarshal([]byte(v), jobTemplate); err != nil {
+			return nil, fmt.Errorf("[RedisJobTemplateRepository.GetJobTemplates] error unmarshalling job template: %s", err)
+		}
+		jobTemplates = append(jobTemplates, jobTemplate)
+	}
+	return jobTemplates, nil
+}
+
+func (r *RedisJobTemplateRepository) GetJobTemplate(queue string, name string) (*api.JobTemplate, error) {
+	result, err := r.db.HGet(jobTemplateHashKey, jobTemplateKey(queue, name)).Result()
+	if err == redis.Nil {
+		return nil, &ErrJobTemplateNotFound{Queue: queue, Name: name}
+	} else if err != nil {
+		return nil, fmt.Errorf("[RedisJobTemplateRepository.GetJobTemplate] error reading from database: %s", err)
+	}
+
+	jobTemplate := &api.JobTemplate{}
+	if err := proto.Unmarshal([]byte(result), jobTemplate); err != nil {
+		return nil, fmt.Errorf("[RedisJobTemplateRepository.GetJobTemplate] error unmarshalling job template: %s", err)
+	}
+	return jobTemplate, nil
+}
+
+func (r *RedisJobTemplateRepository) CreateJobTemplate(jobTemplate *api.JobTemplate) error {
+	data, err := proto.Marshal(jobTemplate)
+	if err != nil {
+		return fmt.Errorf("[RedisJobTemplateRepository.CreateJobTemplate] error marshalling job template: %s", err)
+	}
+
+	result, err := r.db.HSetNX(jobTemplateHashKey, jobTemplateKey(jobTemplate.Queue, jobTemplate.Name), data).Result()
+	if err != nil {
+		return fmt.Errorf("[RedisJobTemplateRepository.CreateJobTemplate] error writing to database: %s", err)
+	}
+	if !result {
+		return &ErrJobTemplateAlreadyExists{Queue: jobTemplate.Queue, Name: jobTemplate.Name}
+	}
+	return nil
+}
+
+// TODO As for RedisQueueRepository.UpdateQueue, a job template deleted concurrently with an update is re-added.
+func (r *RedisJobTemplateRepository) UpdateJobTemplate(jobTemplate *api.JobTemplate) error {
+	key := jobTemplateKey(jobTemplate.Queue, jobTemplate.Name)
+	existsResult, err := r.db.HExists(jobTemplateHashKey, key).Result()
+	if err != nil {
+		return fmt.Errorf("[RedisJobTemplateRepository.UpdateJobTemplate] error reading from database: %s", err)
+	} else if !existsResult {
+		return &ErrJobTemplateNotFound{Queue: jobTemplate.Queue, Name: jobTemplate.Name}
+	}
+
+	data, err := proto.Marshal(jobTemplate)
+	if err != nil {
+		return fmt.Errorf("[RedisJobTemplateRepository.UpdateJobTemplate] error marshalling job template: %s", err)
+	}
+	if err := r.db.HSet(jobTemplateHashKey, key, data).Err(); err != nil {
+		return fmt.Errorf("[RedisJobTemplateRepository.UpdateJobTemplate] error writing to database: %s", err)
+	}
+	return nil
+}
+
+func (r *RedisJobTemplateRepository) DeleteJobTemplate(queue string, name string) error {
+	if err := r.db.HDel(jobTemplateHashKey, jobTemplateKey(queue, name)).Err(); err != nil {
+		return fmt.Errorf("[RedisJobTemplateRepository.DeleteJobTemplate] error deleting job template: %s", err)
+	}
+	return nil
+}
+
+func jobTemplateKey(queue string, name string) string {
+	return queue + "/" + name
+}
@@ -0,0 +1,26 @@
+package armada
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// ReshardJobs moves jobs stored on a shard of the sharded job repository other than the one they're assigned to
+// by config to that shard, batchSize jobs at a time. No server may use the shards while resharding.
+func ReshardJobs(ctx *armadacontext.Context, config *configuration.ArmadaConfig, batchSize int) error {
+	if config.JobRepository.Type != configuration.ShardedRedisJobRepository {
+		return errors.Errorf("job repository type is %q; only %q repositories can be resharded", config.JobRepository.Type, configuration.ShardedRedisJobRepository)
+	}
+	jobRepository, closeJobRepository, err := createJobRepository(ctx, config, nil)
+	if err != nil {
+		return err
+	}
+	defer closeJobRepository()
+
+	moved, err := jobRepository.(*repository.ShardedJobRepository).Reshard(ctx, batchSize)
+	ctx.Infof("moved %d jobs", moved)
+	return err
+}
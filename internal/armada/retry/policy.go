@@ -0,0 +1,173 @@
+package retry
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// Policy determines whether and when a failed job is resubmitted.
+type Policy struct {
+	// Maximum number of times the job is run, including the first attempt.
+	MaxAttempts int
+	// Time waited before the first retry, which is doubled for each subsequent retry.
+	Backoff time.Duration
+	// If non-empty, the job is only retried if a container exited with one of these exit codes.
+	RetryOnExitCodes []int32
+	// If non-empty, the job is only retried if it failed for a reason in one of these categories.
+	// If both RetryOnExitCodes and RetryOnFailureCategories are non-empty, the job is retried if either matches.
+	RetryOnFailureCategories []armadaevents.FailureCategory
+}
+
+// AnnotationsFromApiPolicy returns the annotations representing policy, as stored on jobs.
+func AnnotationsFromApiPolicy(policy *api.RetryPolicy) map[string]string {
+	annotations := map[string]string{
+		configuration.RetryMaxAttemptsAnnotation: strconv.FormatUint(uint64(policy.MaxAttempts), 10),
+	}
+	if policy.BackoffSeconds > 0 {
+		annotations[configuration.RetryBackoffAnnotation] = (time.Duration(policy.BackoffSeconds) * time.Second).String()
+	}
+	if len(policy.RetryOnExitCodes) > 0 {
+		exitCodes := make([]string, len(policy.RetryOnExitCodes))
+		for i, exitCode := range policy.RetryOnExitCodes {
+			exitCodes[i] = strconv.Itoa(int(exitCode))
+		}
+		annotations[configuration.RetryOnExitCodesAnnotation] = strings.Join(exitCodes, ",")
+	}
+	if len(policy.RetryOnFailureCategories) > 0 {
+		categories := make([]string, len(policy.RetryOnFailureCategories))
+		for i, category := range policy.RetryOnFailureCategories {
+			categories[i] = category.String()
+		}
+		annotations[configuration.RetryOnFailureCategoriesAnnotation] = strings.Join(categories, ",")
+	}
+	return annotations
+}
+
+// PolicyFromAnnotations returns a tuple (policy, hasPolicy, error),
+// where policy is parsed from the values of the retry policy annotations.
+// Jobs with a maximum of at most one attempt have no policy.
+func PolicyFromAnnotations(annotations map[string]string) (*Policy, bool, error) {
+	value, ok := annotations[configuration.RetryMaxAttemptsAnnotation]
+	if !ok {
+		return nil, false, nil
+	}
+	maxAttempts, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+	if maxAttempts < 0 {
+		return nil, false, errors.Errorf("max attempts is negative %d", maxAttempts)
+	}
+	policy := &Policy{MaxAttempts: maxAttempts}
+	if value, ok := annotations[configuration.RetryBackoffAnnotation]; ok {
+		backoff, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, false, errors.WithStack(err)
+		}
+		if backoff < 0 {
+			return nil, false, errors.Errorf("backoff is negative %s", backoff)
+		}
+		policy.Backoff = backoff
+	}
+	if value, ok := annotations[configuration.RetryOnExitCodesAnnotation]; ok {
+		for _, s := range strings.Split(value, ",") {
+			exitCode, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+			if err != nil {
+				return nil, false, errors.WithStack(err)
+			}
+			policy.RetryOnExitCodes = append(policy.RetryOnExitCodes, int32(exitCode))
+		}
+	}
+	if value, ok := annotations[configuration.RetryOnFailureCategoriesAnnotation]; ok {
+		for _, s := range strings.Split(value, ",") {
+			category, ok := armadaevents.FailureCategory_value[strings.TrimSpace(s)]
+			if !ok {
+				return nil, false, errors.Errorf("unknown failure category %q", s)
+			}
+			policy.RetryOnFailureCategories = append(policy.RetryOnFailureCategories, armadaevents.FailureCategory(category))
+		}
+	}
+	if policy.MaxAttempts <= 1 {
+		return nil, false, nil
+	}
+	return policy, true, nil
+}
+
+// AttemptFromAnnotations returns the attempt number of a job, which is 1 for jobs that aren't retries.
+func AttemptFromAnnotations(annotations map[string]string) int {
+	attempt, err := strconv.Atoi(annotations[configuration.RetryAttemptAnnotation])
+	if err != nil || attempt < 1 {
+		return 1
+	}
+	return attempt
+}
+
+// ShouldRetry returns true if a job that failed with the given errors on the given attempt
+// should be retried, given that it may be run at most maxAttempts times regardless of the policy.
+func (p *Policy) ShouldRetry(attempt int, maxAttempts int, jobErrors []*armadaevents.Error) bool {
+	if attempt >= p.MaxAttempts || (maxAttempts > 0 && attempt >= maxAttempts) {
+		return false
+	}
+	for _, jobError := range jobErrors {
+		if jobError.GetJobDependencyFailed() != nil {
+			// Retrying won't help, since the dependency has already failed.
+			return false
+		}
+	}
+	if len(p.RetryOnExitCodes) == 0 && len(p.RetryOnFailureCategories) == 0 {
+		return true
+	}
+	for _, exitCode := range exitCodes(jobErrors) {
+		for _, retryOnExitCode := range p.RetryOnExitCodes {
+			if exitCode == retryOnExitCode {
+				return true
+			}
+		}
+	}
+	if len(p.RetryOnFailureCategories) > 0 {
+		category := armadaevents.FailureCategoryFromErrors(jobErrors)
+		for _, retryOnCategory := range p.RetryOnFailureCategories {
+			if category == retryOnCategory {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BackoffBeforeAttempt returns how long to wait before starting the given attempt, at most maxBackoff if positive.
+func (p *Policy) BackoffBeforeAttempt(attempt int, maxBackoff time.Duration) time.Duration {
+	backoff := p.Backoff
+	for i := 2; i < attempt && (maxBackoff <= 0 || backoff < maxBackoff); i++ {
+		backoff *= 2
+	}
+	if maxBackoff > 0 && backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+// exitCodes returns the non-zero exit codes of the containers that terminated with the given errors.
+func exitCodes(jobErrors []*armadaevents.Error) []int32 {
+	var exitCodes []int32
+	for _, jobError := range jobErrors {
+		if containerError := jobError.GetContainerError(); containerError != nil && containerError.ExitCode != 0 {
+			exitCodes = append(exitCodes, containerError.ExitCode)
+		}
+		if podError := jobError.GetPodError(); podError != nil {
+			for _, containerError := range podError.ContainerErrors {
+				if containerError.ExitCode != 0 {
+					exitCodes = append(exitCodes, containerError.ExitCode)
+				}
+			}
+		}
+	}
+	return exitCodes
+}
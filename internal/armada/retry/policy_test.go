@@ -0,0 +1,208 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestPolicyFromAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		annotations       map[string]string
+		expectedPolicy    *Policy
+		expectedHasPolicy bool
+		expectError       bool
+	}{
+		"no annotations": {
+			annotations: nil,
+		},
+		"single attempt": {
+			annotations: map[string]string{configuration.RetryMaxAttemptsAnnotation: "1"},
+		},
+		"full policy": {
+			annotations: map[string]string{
+				configuration.RetryMaxAttemptsAnnotation:         "3",
+				configuration.RetryBackoffAnnotation:             "30s",
+				configuration.RetryOnExitCodesAnnotation:         "1, 137",
+				configuration.RetryOnFailureCategoriesAnnotation: "OOMKilled, NodeLost",
+			},
+			expectedPolicy: &Policy{
+				MaxAttempts:      3,
+				Backoff:          30 * time.Second,
+				RetryOnExitCodes: []int32{1, 137},
+				RetryOnFailureCategories: []armadaevents.FailureCategory{
+					armadaevents.FailureCategory_OOMKilled,
+					armadaevents.FailureCategory_NodeLost,
+				},
+			},
+			expectedHasPolicy: true,
+		},
+		"invalid max attempts": {
+			annotations: map[string]string{configuration.RetryMaxAttemptsAnnotation: "three"},
+			expectError: true,
+		},
+		"negative max attempts": {
+			annotations: map[string]string{configuration.RetryMaxAttemptsAnnotation: "-1"},
+			expectError: true,
+		},
+		"invalid backoff": {
+			annotations: map[string]string{
+				configuration.RetryMaxAttemptsAnnotation: "3",
+				configuration.RetryBackoffAnnotation:     "soon",
+			},
+			expectError: true,
+		},
+		"invalid exit codes": {
+			annotations: map[string]string{
+				configuration.RetryMaxAttemptsAnnotation: "3",
+				configuration.RetryOnExitCodesAnnotation: "1,a",
+			},
+			expectError: true,
+		},
+		"invalid failure categories": {
+			annotations: map[string]string{
+				configuration.RetryMaxAttemptsAnnotation:         "3",
+				configuration.RetryOnFailureCategoriesAnnotation: "OOMKilled,Gremlins",
+			},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy, hasPolicy, err := PolicyFromAnnotations(tc.annotations)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedHasPolicy, hasPolicy)
+			assert.Equal(t, tc.expectedPolicy, policy)
+		})
+	}
+}
+
+func TestAnnotationsFromApiPolicy_RoundTrip(t *testing.T) {
+	annotations := AnnotationsFromApiPolicy(&api.RetryPolicy{
+		MaxAttempts:              4,
+		BackoffSeconds:           90,
+		RetryOnExitCodes:         []int32{2, 3},
+		RetryOnFailureCategories: []api.FailureCategory{api.FailureCategory_ImagePull},
+	})
+	policy, hasPolicy, err := PolicyFromAnnotations(annotations)
+	require.NoError(t, err)
+	assert.True(t, hasPolicy)
+	assert.Equal(
+		t,
+		&Policy{
+			MaxAttempts:              4,
+			Backoff:                  90 * time.Second,
+			RetryOnExitCodes:         []int32{2, 3},
+			RetryOnFailureCategories: []armadaevents.FailureCategory{armadaevents.FailureCategory_ImagePull},
+		},
+		policy,
+	)
+}
+
+func TestAttemptFromAnnotations(t *testing.T) {
+	assert.Equal(t, 1, AttemptFromAnnotations(nil))
+	assert.Equal(t, 1, AttemptFromAnnotations(map[string]string{configuration.RetryAttemptAnnotation: "x"}))
+	assert.Equal(t, 3, AttemptFromAnnotations(map[string]string{configuration.RetryAttemptAnnotation: "3"}))
+}
+
+func TestPolicy_ShouldRetry(t *testing.T) {
+	exitedWith := func(exitCode int32) []*armadaevents.Error {
+		return []*armadaevents.Error{{
+			Terminal: true,
+			Reason: &armadaevents.Error_PodError{PodError: &armadaevents.PodError{
+				ContainerErrors: []*armadaevents.ContainerError{{ExitCode: exitCode}},
+			}},
+		}}
+	}
+	tests := map[string]struct {
+		policy      Policy
+		attempt     int
+		maxAttempts int
+		errors      []*armadaevents.Error
+		expected    bool
+	}{
+		"attempts left": {
+			policy:   Policy{MaxAttempts: 3},
+			attempt:  2,
+			errors:   exitedWith(1),
+			expected: true,
+		},
+		"no attempts left": {
+			policy:  Policy{MaxAttempts: 3},
+			attempt: 3,
+			errors:  exitedWith(1),
+		},
+		"limited by server": {
+			policy:      Policy{MaxAttempts: 3},
+			attempt:     2,
+			maxAttempts: 2,
+			errors:      exitedWith(1),
+		},
+		"matching exit code": {
+			policy:   Policy{MaxAttempts: 3, RetryOnExitCodes: []int32{137}},
+			attempt:  1,
+			errors:   exitedWith(137),
+			expected: true,
+		},
+		"other exit code": {
+			policy:  Policy{MaxAttempts: 3, RetryOnExitCodes: []int32{137}},
+			attempt: 1,
+			errors:  exitedWith(1),
+		},
+		"matching failure category": {
+			policy:  Policy{MaxAttempts: 3, RetryOnFailureCategories: []armadaevents.FailureCategory{armadaevents.FailureCategory_NodeLost}},
+			attempt: 1,
+			errors: []*armadaevents.Error{{
+				Terminal: true,
+				Reason:   &armadaevents.Error_LeaseExpired{LeaseExpired: &armadaevents.LeaseExpired{}},
+			}},
+			expected: true,
+		},
+		"other failure category": {
+			policy:  Policy{MaxAttempts: 3, RetryOnFailureCategories: []armadaevents.FailureCategory{armadaevents.FailureCategory_NodeLost}},
+			attempt: 1,
+			errors:  exitedWith(1),
+		},
+		"matching exit code but other failure category": {
+			policy: Policy{
+				MaxAttempts:              3,
+				RetryOnExitCodes:         []int32{1},
+				RetryOnFailureCategories: []armadaevents.FailureCategory{armadaevents.FailureCategory_NodeLost},
+			},
+			attempt:  1,
+			errors:   exitedWith(1),
+			expected: true,
+		},
+		"failed dependency": {
+			policy:  Policy{MaxAttempts: 3},
+			attempt: 1,
+			errors: []*armadaevents.Error{{
+				Terminal: true,
+				Reason:   &armadaevents.Error_JobDependencyFailed{JobDependencyFailed: &armadaevents.JobDependencyFailed{}},
+			}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.policy.ShouldRetry(tc.attempt, tc.maxAttempts, tc.errors))
+		})
+	}
+}
+
+func TestPolicy_BackoffBeforeAttempt(t *testing.T) {
+	policy := Policy{MaxAttempts: 10, Backoff: time.Minute}
+	assert.Equal(t, time.Minute, policy.BackoffBeforeAttempt(2, 0))
+	assert.Equal(t, 2*time.Minute, policy.BackoffBeforeAttempt(3, 0))
+	assert.Equal(t, 4*time.Minute, policy.BackoffBeforeAttempt(4, 0))
+	assert.Equal(t, 5*time.Minute, policy.BackoffBeforeAttempt(10, 5*time.Minute))
+}
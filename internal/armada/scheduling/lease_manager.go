@@ -3,13 +3,14 @@ package scheduling
 import (
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
+var log = logging.ForModule("server")
+
 type LeaseManager struct {
 	jobRepository       repository.JobRepository
 	queueRepository     repository.QueueRepository
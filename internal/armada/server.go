@@ -11,25 +11,35 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/util/clock"
 
+	"github.com/armadaproject/armada/internal/armada/admission"
+	"github.com/armadaproject/armada/internal/armada/audit"
 	"github.com/armadaproject/armada/internal/armada/cache"
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/armada/metrics"
+	"github.com/armadaproject/armada/internal/armada/notification"
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/armada/scheduling"
 	"github.com/armadaproject/armada/internal/armada/server"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/chaos"
+	"github.com/armadaproject/armada/internal/common/cron"
 	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	grpcCommon "github.com/armadaproject/armada/internal/common/grpc"
 	"github.com/armadaproject/armada/internal/common/health"
+	"github.com/armadaproject/armada/internal/common/logging"
 	commonmetrics "github.com/armadaproject/armada/internal/common/metrics"
 	"github.com/armadaproject/armada/internal/common/pgkeyvalue"
+	"github.com/armadaproject/armada/internal/common/profiling"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	"github.com/armadaproject/armada/internal/common/task"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/scheduler"
 	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
@@ -38,11 +48,11 @@ import (
 	"github.com/armadaproject/armada/pkg/client"
 )
 
-func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healthChecks *health.MultiChecker) error {
-	log.Info("Armada server starting")
-	log.Infof("Armada priority classes: %v", config.Scheduling.Preemption.PriorityClasses)
-	log.Infof("Default priority class: %s", config.Scheduling.Preemption.DefaultPriorityClass)
-	defer log.Info("Armada server shutting down")
+func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healthChecks *health.MultiChecker, alertingChecks *health.MultiChecker) error {
+	ctx.Info("Armada server starting")
+	ctx.Infof("Armada priority classes: %v", config.Scheduling.Preemption.PriorityClasses)
+	ctx.Infof("Default priority class: %s", config.Scheduling.Preemption.DefaultPriorityClass)
+	defer ctx.Info("Armada server shutting down")
 
 	// We call startupCompleteCheck.MarkComplete() when all services have been started.
 	startupCompleteCheck := health.NewStartupCompleteChecker()
@@ -76,7 +86,7 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 	if err != nil {
 		return err
 	}
-	grpcServer := grpcCommon.CreateGrpcServer(config.Grpc.KeepaliveParams, config.Grpc.KeepaliveEnforcementPolicy, authServices, config.Grpc.Tls)
+	grpcServer := grpcCommon.CreateGrpcServer(config.Grpc.KeepaliveParams, config.Grpc.KeepaliveEnforcementPolicy, authServices, config.Grpc.Tls, logging.ForModule("server"))
 
 	// Shut down grpcServer if the context is cancelled.
 	// Give the server 5 seconds to shut down gracefully.
@@ -90,22 +100,49 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		return nil
 	})
 
+	// Faults are only injected if enabled, e.g., in end-to-end tests.
+	faults := chaos.NewFaultInjector(config.FaultInjection)
+
+	// Spans are only exported if an OpenTelemetry collector is configured.
+	runTracing, err := tracing.Setup(ctx, config.Tracing, "armada-server")
+	if err != nil {
+		return errors.WithMessage(err, "error setting up tracing")
+	}
+	services = append(services, runTracing)
+
 	// Setup Redis
-	db := createRedisClient(&config.Redis)
+	db := faults.WrapRedisClient(createRedisClient(&config.Redis))
 	defer func() {
 		if err := db.Close(); err != nil {
-			log.WithError(err).Error("failed to close Redis client")
+			ctx.WithError(err).Error("failed to close Redis client")
 		}
 	}()
 
-	eventDb := createRedisClient(&config.EventsApiRedis)
+	eventDb := faults.WrapRedisClient(createRedisClient(&config.EventsApiRedis))
 	defer func() {
 		if err := eventDb.Close(); err != nil {
-			log.WithError(err).Error("failed to close events api Redis client")
+			ctx.WithError(err).Error("failed to close events api Redis client")
 		}
 	}()
 
-	jobRepository := repository.NewRedisJobRepository(db)
+	if config.Outbox.Enabled && config.JobRepository.Type == configuration.PostgresJobRepository {
+		return errors.New("the outbox can't be enabled when storing jobs in postgres")
+	}
+	jobRepository, closeJobRepository, err := createJobRepository(ctx, config, db)
+	if err != nil {
+		return err
+	}
+	defer closeJobRepository()
+	switch r := jobRepository.(type) {
+	case *repository.PostgresJobRepository:
+		services = append(services, func() error {
+			return r.PeriodicCleanup(ctx, time.Hour)
+		})
+	case *repository.ShardedJobRepository:
+		for _, shard := range r.Shards() {
+			healthChecks.Add(repository.NewRedisHealth(shard.Db))
+		}
+	}
 	usageRepository := repository.NewRedisUsageRepository(db)
 	queueRepository := repository.NewRedisQueueRepository(db)
 	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(db)
@@ -113,11 +150,10 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 
 	eventRepository := repository.NewEventRepository(eventDb)
 
-	permissions := authorization.NewPrincipalPermissionChecker(
-		config.Auth.PermissionGroupMapping,
-		config.Auth.PermissionScopeMapping,
-		config.Auth.PermissionClaimMapping,
-	)
+	permissions, err := authorization.NewPrincipalPermissionCheckerFromConfig(config.Auth)
+	if err != nil {
+		return errors.WithMessage(err, "error configuring permissions")
+	}
 
 	// If pool settings are provided, open a connection pool to be shared by all services.
 	var pool *pgxpool.Pool
@@ -151,6 +187,9 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 	})
 
 	serverId := uuid.New()
+	if err := eventutil.SetWriteSchemaVersion(config.Pulsar.EventSchemaWriteVersion); err != nil {
+		return err
+	}
 	var pulsarClient pulsar.Client
 	// API endpoints that generate Pulsar messages.
 	pulsarClient, err = pulsarutils.NewPulsarClient(&config.Pulsar)
@@ -171,9 +210,14 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		return errors.Wrapf(err, "error creating pulsar producer %s", serverPulsarProducerName)
 	}
 	defer producer.Close()
+	producer = faults.WrapProducer(producer)
 
 	eventStore := repository.NewEventStore(producer, config.Pulsar.MaxAllowedMessageSize)
 
+	newOwnershipGroupsCompressor, newOwnershipGroupsDecompressor, err := server.NewOwnershipGroupsCompression(config.OwnershipGroupsCompression)
+	if err != nil {
+		return err
+	}
 	submitServer := server.NewSubmitServer(
 		permissions,
 		jobRepository,
@@ -183,8 +227,26 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		config.CancelJobsBatchSize,
 		&config.QueueManagement,
 		&config.Scheduling,
+		newOwnershipGroupsCompressor,
 	)
 
+	admissionPolicies, err := admission.NewOpaPolicies(config.SubmitPolicies)
+	if err != nil {
+		return err
+	}
+	admissionWebhooks, err := admission.NewWebhooks(config.SubmitWebhooks)
+	if err != nil {
+		return err
+	}
+	admissionValidators := []admission.Validator{admission.NewImagePolicy(config.ImagePolicy)}
+	admissionValidators = append(admissionValidators, admissionPolicies...)
+	admissionValidators = append(admissionValidators, admissionWebhooks...)
+
+	jobSetUsageCache, err := server.NewJobSetUsageCache(config.ResourceRecommendations.MaxCachedJobSets)
+	if err != nil {
+		return err
+	}
+
 	pulsarSubmitServer := &server.PulsarSubmitServer{
 		Producer:                          producer,
 		QueueRepository:                   queueRepository,
@@ -198,6 +260,31 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		Rand:                              util.NewThreadsafeRand(time.Now().UnixNano()),
 		GangIdAnnotation:                  configuration.GangIdAnnotation,
 		IgnoreJobSubmitChecks:             config.IgnoreJobSubmitChecks,
+		SubmitFeasibilityCheck:            config.SubmitFeasibilityCheck,
+		ClientMetrics:                     config.Metrics.Clients,
+		MaxArrayJobSize:                   config.ArrayJobs.MaxSize,
+		AdmissionValidators:               admissionValidators,
+		EventRepository:                   eventRepository,
+		SubmissionLimits:                  config.SubmissionLimits,
+		ResourceRecommendations:           config.ResourceRecommendations,
+		JobSetUsageCache:                  jobSetUsageCache,
+		JobSetSizeRepository:              repository.NewRedisJobSetSizeRepository(db),
+	}
+	if config.Pulsar.AuditTopic != "" {
+		auditProducerName := fmt.Sprintf("armada-server-audit-%s", serverId)
+		auditProducer, err := pulsarClient.CreateProducer(pulsar.ProducerOptions{
+			Name:             auditProducerName,
+			CompressionType:  config.Pulsar.CompressionType,
+			CompressionLevel: config.Pulsar.CompressionLevel,
+			Topic:            config.Pulsar.AuditTopic,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error creating pulsar producer %s", auditProducerName)
+		}
+		defer auditProducer.Close()
+		pulsarSubmitServer.AuditSink = audit.NewPulsarSink(auditProducer)
+	} else {
+		ctx.Info("Audit events disabled")
 	}
 	submitServerToRegister := pulsarSubmitServer
 
@@ -206,7 +293,7 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		if pool == nil {
 			return errors.New("deduplication is enabled, but no postgres settings are provided")
 		}
-		log.Info("Pulsar submit API deduplication enabled")
+		ctx.Info("Pulsar submit API deduplication enabled")
 
 		store, err := pgkeyvalue.New(ctx, pool, config.Pulsar.DedupTable)
 		if err != nil {
@@ -219,29 +306,139 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 			return store.PeriodicCleanup(ctx, time.Hour, 14*24*time.Hour)
 		})
 	} else {
-		log.Info("Pulsar submit API deduplication disabled")
+		ctx.Info("Pulsar submit API deduplication disabled")
 	}
 
-	// Service that consumes Pulsar messages and writes to Redis
-	consumer, err := pulsarClient.Subscribe(pulsar.ConsumerOptions{
-		Topic:             config.Pulsar.JobsetEventsTopic,
-		SubscriptionName:  config.Pulsar.RedisFromPulsarSubscription,
-		Type:              pulsar.KeyShared,
-		ReceiverQueueSize: config.Pulsar.ReceiverQueueSize,
-	})
-	if err != nil {
-		return errors.WithStack(err)
+	// If the database of the new scheduler is provided, its jobs can be looked up, e.g., to reprioritise them by label.
+	if len(config.NewScheduler.Postgres.Connection) != 0 {
+		schedulerPool, err := database.OpenPgxPool(config.NewScheduler.Postgres)
+		if err != nil {
+			return err
+		}
+		defer schedulerPool.Close()
+		pulsarSubmitServer.SchedulerJobRepository = schedulerdb.NewPostgresJobRepository(schedulerPool, int32(config.CancelJobsBatchSize))
+	}
+
+	// Service that consumes event log messages and writes to Redis
+	var consumer eventlog.Consumer
+	var lagMonitor *eventlog.LagMonitor
+	cumulativeAck := config.Pulsar.RedisFromPulsarCumulativeAck
+	if config.EventLog.Backend == "kafka" {
+		kafkaConsumer := eventlog.NewKafkaConsumer(config.EventLog.Kafka.Brokers, config.EventLog.Kafka.Topic, config.EventLog.Kafka.ConsumerGroup)
+		defer kafkaConsumer.Close()
+		consumer = kafkaConsumer
+		// Committing the offset of a message marks all messages before it in its partition as processed,
+		// so messages must be acked cumulatively.
+		cumulativeAck = true
+		// The message lag isn't reported for Kafka; only the time lag is.
+		lagMonitor = eventlog.NewLagMonitor(config.EventLog.Kafka.ConsumerGroup, config.Pulsar.MaxConsumerLag, nil)
+	} else {
+		// Cumulative acks aren't supported for shared subscriptions.
+		subscriptionType := pulsar.KeyShared
+		if cumulativeAck {
+			subscriptionType = pulsar.Failover
+		}
+		pulsarConsumer, err := pulsarClient.Subscribe(pulsar.ConsumerOptions{
+			Topic:             config.Pulsar.JobsetEventsTopic,
+			SubscriptionName:  config.Pulsar.RedisFromPulsarSubscription,
+			Type:              subscriptionType,
+			ReceiverQueueSize: config.Pulsar.ReceiverQueueSize,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer pulsarConsumer.Close()
+		consumer = eventlog.NewPulsarConsumer(pulsarConsumer)
+
+		// Report how far SubmitFromLog lags behind the event log, and fail /healthz if it lags too far behind.
+		pulsarAdminClient, err := pulsarutils.NewAdminClient(&config.Pulsar)
+		if err != nil {
+			return errors.WithMessage(err, "error creating pulsar admin client")
+		}
+		var backlog eventlog.BacklogFunc
+		if pulsarAdminClient != nil {
+			backlog = func(ctx *armadacontext.Context) (int64, error) {
+				return pulsarAdminClient.SubscriptionBacklog(ctx, config.Pulsar.JobsetEventsTopic, config.Pulsar.RedisFromPulsarSubscription)
+			}
+		}
+		lagMonitor = eventlog.NewLagMonitor(config.Pulsar.RedisFromPulsarSubscription, config.Pulsar.MaxConsumerLag, backlog)
 	}
-	defer consumer.Close()
+	alertingChecks.Add(lagMonitor)
+	services = append(services, func() error {
+		return lagMonitor.Run(ctx)
+	})
 
 	submitFromLog := server.SubmitFromLog{
-		Consumer:     consumer,
-		SubmitServer: submitServer,
+		Consumer:        faults.WrapConsumer(consumer),
+		SubmitServer:    submitServer,
+		ProcessedEvents: repository.NewRedisProcessedEventRepository(db),
+		Parallelism:     config.Pulsar.RedisFromPulsarParallelism,
+		BatchSize:       config.Pulsar.RedisFromPulsarBatchSize,
+		FlushInterval:   config.Pulsar.RedisFromPulsarFlushInterval,
+		CumulativeAck:   cumulativeAck,
+		RetryPolicy:     config.Pulsar.RedisFromPulsarRetry,
+		UseOutbox:       config.Outbox.Enabled,
+		LagMonitor:      lagMonitor,
+		Logger:          logging.ForModule("submitfromlog"),
+		FaultInjector:   faults,
+	}
+	if config.Pulsar.DeadLetterTopic != "" {
+		deadLetterProducerName := fmt.Sprintf("armada-server-dead-letter-%s", serverId)
+		deadLetterProducer, err := pulsarClient.CreateProducer(pulsar.ProducerOptions{
+			Name:             deadLetterProducerName,
+			CompressionType:  config.Pulsar.CompressionType,
+			CompressionLevel: config.Pulsar.CompressionLevel,
+			Topic:            config.Pulsar.DeadLetterTopic,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error creating pulsar producer %s", deadLetterProducerName)
+		}
+		defer deadLetterProducer.Close()
+		submitFromLog.DeadLetterQueue = eventlog.NewPulsarDeadLetterQueue(deadLetterProducer)
+	} else {
+		ctx.Info("Event log dead-letter queue disabled")
 	}
 	services = append(services, func() error {
 		return submitFromLog.Run(ctx)
 	})
 
+	// Service that publishes the events written to the outbox by SubmitFromLog.
+	// With a sharded job repository, each shard has an outbox of its own, published by a producer of its own.
+	if config.Outbox.Enabled {
+		outboxes := map[string]repository.OutboxRepository{
+			config.Outbox.ProducerName: repository.NewRedisOutboxRepository(db),
+		}
+		if sharded, ok := jobRepository.(*repository.ShardedJobRepository); ok {
+			outboxes = make(map[string]repository.OutboxRepository)
+			for _, shard := range sharded.Shards() {
+				outboxes[config.Outbox.ProducerName+"-"+shard.Name] = repository.NewRedisOutboxRepository(shard.Db)
+			}
+		}
+		for producerName, outbox := range outboxes {
+			producerName := producerName
+			outboxDispatcher := &server.OutboxDispatcher{
+				Outbox: outbox,
+				NewProducer: func() (pulsar.Producer, error) {
+					producer, err := pulsarClient.CreateProducer(pulsar.ProducerOptions{
+						Name:             producerName,
+						CompressionType:  config.Pulsar.CompressionType,
+						CompressionLevel: config.Pulsar.CompressionLevel,
+						BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
+						Topic:            config.Pulsar.JobsetEventsTopic,
+					})
+					return producer, errors.Wrapf(err, "error creating pulsar producer %s", producerName)
+				},
+				Id:            serverId.String(),
+				BatchSize:     config.Outbox.BatchSize,
+				PollInterval:  config.Outbox.PollInterval,
+				LeaseDuration: config.Outbox.LeaseDuration,
+			}
+			services = append(services, func() error {
+				return outboxDispatcher.Run(ctx)
+			})
+		}
+	}
+
 	// Service that reads from Pulsar and logs events.
 	if config.Pulsar.EventsPrinter {
 		eventsPrinter := server.EventsPrinter{
@@ -254,6 +451,119 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		})
 	}
 
+	cronJobSetServer := &server.CronJobSetServer{
+		CronJobSetRepository: repository.NewRedisCronJobSetRepository(db),
+		EventRepository:      eventRepository,
+		SubmitServer:         pulsarSubmitServer,
+		Clock:                clock.RealClock{},
+	}
+
+	jobRetrier := &server.JobRetrier{
+		JobRetryRepository: repository.NewRedisJobRetryRepository(db),
+		SubmitServer:       pulsarSubmitServer,
+		MaxAttempts:        config.JobRetries.MaxAttempts,
+		MaxBackoff:         config.JobRetries.MaxBackoff,
+		Clock:              clock.RealClock{},
+	}
+	if config.JobRetries.Enabled {
+		retryConsumer, err := pulsarClient.Subscribe(pulsar.ConsumerOptions{
+			Topic:             config.Pulsar.JobsetEventsTopic,
+			SubscriptionName:  config.JobRetries.Subscription,
+			Type:              pulsar.KeyShared,
+			ReceiverQueueSize: config.Pulsar.ReceiverQueueSize,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer retryConsumer.Close()
+		jobRetrier.Consumer = retryConsumer
+		services = append(services, func() error {
+			return jobRetrier.Run(ctx)
+		})
+	}
+
+	notificationRepository := repository.NewRedisNotificationRepository(db)
+	notificationServer := &server.NotificationServer{
+		NotificationRepository: notificationRepository,
+		QueueRepository:        queueRepository,
+		Permissions:            permissions,
+		Clock:                  clock.RealClock{},
+		HostPolicy:             notification.NewHostPolicy(config.Notifications),
+	}
+	notificationDispatcher := notification.NewDispatcher(config.Notifications)
+	if config.Notifications.Enabled {
+		notificationConsumer, err := pulsarClient.Subscribe(pulsar.ConsumerOptions{
+			Topic:             config.Pulsar.JobsetEventsTopic,
+			SubscriptionName:  config.Notifications.Subscription,
+			Type:              pulsar.KeyShared,
+			ReceiverQueueSize: config.Pulsar.ReceiverQueueSize,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer notificationConsumer.Close()
+		notifier := &server.Notifier{
+			NotificationRepository: notificationRepository,
+			Dispatcher:             notificationDispatcher,
+			Consumer:               notificationConsumer,
+			WebhookRefreshInterval: config.Notifications.WebhookRefreshInterval,
+			Clock:                  clock.RealClock{},
+		}
+		services = append(services, func() error {
+			return notifier.Run(ctx)
+		}, func() error {
+			return notificationDispatcher.Run(ctx)
+		})
+	}
+
+	// Services that record the outcomes of jobs and send digests of them on a schedule.
+	var digester *server.Digester
+	if digestsConfig := config.Notifications.Digests; digestsConfig.Enabled {
+		digestSchedule, err := cron.Parse(digestsConfig.Schedule)
+		if err != nil {
+			return errors.Wrap(err, "invalid digest schedule")
+		}
+		if digestsConfig.Window > digestsConfig.Retention {
+			return errors.Errorf("digest window %s exceeds retention %s", digestsConfig.Window, digestsConfig.Retention)
+		}
+		digestConsumer, err := pulsarClient.Subscribe(pulsar.ConsumerOptions{
+			Topic:             config.Pulsar.JobsetEventsTopic,
+			SubscriptionName:  digestsConfig.Subscription,
+			Type:              pulsar.KeyShared,
+			ReceiverQueueSize: config.Pulsar.ReceiverQueueSize,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer digestConsumer.Close()
+		digestRepository := repository.NewRedisDigestRepository(db, digestsConfig.Retention)
+		digestRecorder := &server.DigestRecorder{
+			DigestRepository: digestRepository,
+			Consumer:         eventlog.NewPulsarConsumer(digestConsumer),
+			BatchSize:        digestsConfig.BatchSize,
+			FlushInterval:    digestsConfig.FlushInterval,
+		}
+		services = append(services, func() error {
+			return digestRecorder.Run(ctx)
+		})
+		digester = &server.Digester{
+			DigestRepository:       digestRepository,
+			NotificationRepository: notificationRepository,
+			Dispatcher:             notificationDispatcher,
+			Schedule:               digestSchedule,
+			Window:                 digestsConfig.Window,
+			TopFailureReasons:      digestsConfig.TopFailureReasons,
+			Clock:                  clock.RealClock{},
+		}
+	}
+
+	jobTemplateServer := &server.JobTemplateServer{
+		JobTemplateRepository: repository.NewRedisJobTemplateRepository(db),
+		SubmitServer:          pulsarSubmitServer,
+		MaxExpandedJobs:       config.JobTemplates.MaxExpandedJobs,
+		Clock:                 clock.RealClock{},
+	}
+
 	usageServer := server.NewUsageServer(permissions, config.PriorityHalfTime, &config.Scheduling, usageRepository, queueRepository)
 
 	aggregatedQueueServer := server.NewAggregatedQueueServer(
@@ -267,14 +577,25 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		producer,
 		config.Pulsar.MaxAllowedMessageSize,
 		legacyExecutorRepo,
+		newOwnershipGroupsDecompressor,
 	)
 
-	schedulingContextRepository, err := scheduler.NewSchedulingContextRepository(config.Scheduling.MaxJobSchedulingContextsPerExecutor)
+	schedulingContextRepository, err := scheduler.NewSchedulingContextRepository(config.Scheduling.MaxJobSchedulingContextsPerExecutor, config.Scheduling)
 	if err != nil {
 		return err
 	}
 	aggregatedQueueServer.SchedulingContextRepository = schedulingContextRepository
 
+	diagnosticsServer := profiling.NewDiagnosticsServer(config.Diagnostics)
+	diagnosticsServer.SetConfig(config)
+	diagnosticsServer.AddState("schedulingContexts", schedulingContextRepository.MostRecentSchedulingContextSummaries)
+	diagnosticsServer.AddState("eventLogLag", func() interface{} {
+		return map[string]string{config.Pulsar.RedisFromPulsarSubscription: lagMonitor.TimeLag().String()}
+	})
+	services = append(services, func() error {
+		return diagnosticsServer.Run(ctx)
+	})
+
 	var schedulingReportsServer schedulerobjects.SchedulerReportingServer
 	if config.PulsarSchedulerEnabled {
 		schedulerApiConnection, err := createApiConnection(config.SchedulerApiConnection)
@@ -284,7 +605,8 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		schedulerApiReportsClient := schedulerobjects.NewSchedulerReportingClient(schedulerApiConnection)
 		schedulingReportsServer = scheduler.NewProxyingSchedulingReportsServer(schedulerApiReportsClient)
 	} else {
-		schedulingReportsServer = schedulingContextRepository
+		// Duplicate job detection and pending capacity reporting are only supported by the Pulsar-backed scheduler.
+		schedulingReportsServer = scheduler.NewSchedulingReportsServer(schedulingContextRepository, nil, nil, nil)
 	}
 
 	eventServer := server.NewEventServer(
@@ -301,6 +623,30 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 	defer taskManager.StopAll(time.Second * 2)
 	taskManager.Register(leaseManager.ExpireLeases, config.Scheduling.Lease.ExpiryLoopInterval, "lease_expiry")
 
+	if config.CronJobSets.Enabled {
+		taskManager.Register(func() {
+			if err := cronJobSetServer.SubmitDueJobs(ctx); err != nil {
+				ctx.WithError(err).Error("failed to submit jobs for cron job sets")
+			}
+		}, config.CronJobSets.Interval, "cron_job_sets")
+	}
+
+	if config.JobRetries.Enabled {
+		taskManager.Register(func() {
+			if err := jobRetrier.SubmitDueRetries(ctx); err != nil {
+				ctx.WithError(err).Error("failed to submit job retries")
+			}
+		}, config.JobRetries.Interval, "job_retries")
+	}
+
+	if digester != nil {
+		taskManager.Register(func() {
+			if err := digester.SendDueDigests(ctx); err != nil {
+				ctx.WithError(err).Error("failed to send digests")
+			}
+		}, config.Notifications.Digests.Interval, "digests")
+	}
+
 	if config.Metrics.ExposeSchedulingMetrics {
 		queueCache := cache.NewQueueCache(&util.UTCClock{}, queueRepository, jobRepository, schedulingInfoRepository)
 		taskManager.Register(queueCache.Refresh, config.Metrics.RefreshInterval, "refresh_queue_cache")
@@ -309,14 +655,20 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 
 	api.RegisterSubmitServer(grpcServer, submitServerToRegister)
 	api.RegisterUsageServer(grpcServer, usageServer)
+	api.RegisterCronJobSetsServer(grpcServer, cronJobSetServer)
+	api.RegisterNotificationsServer(grpcServer, notificationServer)
+	api.RegisterJobTemplatesServer(grpcServer, jobTemplateServer)
 	api.RegisterEventServer(grpcServer, eventServer)
-	schedulerobjects.RegisterSchedulerReportingServer(grpcServer, schedulingReportsServer)
+	schedulerobjects.RegisterSchedulerReportingServer(grpcServer, &server.AuthorizingSchedulingReportsServer{
+		Reports:      schedulingReportsServer,
+		SubmitServer: pulsarSubmitServer,
+	})
 
 	api.RegisterAggregatedQueueServer(grpcServer, aggregatedQueueServer)
 	grpc_prometheus.Register(grpcServer)
 
 	// Cancel the errgroup if grpcServer.Serve returns an error.
-	log.Infof("Armada gRPC server listening on %d", config.GrpcPort)
+	ctx.Infof("Armada gRPC server listening on %d", config.GrpcPort)
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.GrpcPort))
 	if err != nil {
 		return errors.WithStack(err)
@@ -340,6 +692,56 @@ func createRedisClient(config *redis.UniversalOptions) redis.UniversalClient {
 	return redis.NewUniversalClient(config)
 }
 
+// createJobRepository returns the job repository selected by config, along with a function closing its connections.
+// Jobs are stored in db unless config selects another database.
+func createJobRepository(
+	ctx *armadacontext.Context,
+	config *configuration.ArmadaConfig,
+	db redis.UniversalClient,
+) (repository.JobRepository, func(), error) {
+	switch config.JobRepository.Type {
+	case "", configuration.RedisJobRepository:
+		return repository.NewRedisJobRepository(db), func() {}, nil
+	case configuration.ShardedRedisJobRepository:
+		shards := make([]*repository.RedisJobShard, len(config.JobRepository.Shards))
+		closeShards := func() {
+			for _, shard := range shards {
+				if shard == nil {
+					continue
+				}
+				if err := shard.Db.Close(); err != nil {
+					ctx.WithError(err).Errorf("failed to close Redis client of shard %s", shard.Name)
+				}
+			}
+		}
+		for i, shardConfig := range config.JobRepository.Shards {
+			shards[i] = &repository.RedisJobShard{
+				Name:     shardConfig.Name,
+				Db:       createRedisClient(&shardConfig.Redis),
+				Draining: shardConfig.Draining,
+			}
+		}
+		jobRepository, err := repository.NewShardedJobRepository(shards)
+		if err != nil {
+			closeShards()
+			return nil, nil, err
+		}
+		return jobRepository, closeShards, nil
+	case configuration.PostgresJobRepository:
+		pool, err := database.OpenPgxPool(config.JobRepository.Postgres)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := repository.MigratePostgresJobRepository(ctx, pool); err != nil {
+			pool.Close()
+			return nil, nil, err
+		}
+		return repository.NewPostgresJobRepository(pool, config.JobRepository.BatchSize), pool.Close, nil
+	default:
+		return nil, nil, errors.Errorf("unknown job repository type %s", config.JobRepository.Type)
+	}
+}
+
 // TODO: Is this all validation that needs to be done?
 func validateCancelJobsBatchSizeConfig(config *configuration.ArmadaConfig) error {
 	if config.CancelJobsBatchSize <= 0 {
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// arrayJob records where the tasks of an array job are among the items of an expanded submit request.
+type arrayJob struct {
+	id string
+	// Index of the first task among the expanded items.
+	start int
+	size  int
+}
+
+// expandArrayJobs returns a copy of req in which each array job item is replaced by one item per task;
+// req itself isn't modified.
+// The second return value has one entry per item of req, which is nil for items that aren't array jobs.
+//
+// Items may depend on an earlier array job by its client id, in which case they depend on all tasks of the array.
+func expandArrayJobs(req *api.JobSubmitRequest, maxSize int) (*api.JobSubmitRequest, []*arrayJob, error) {
+	isArray := false
+	for _, item := range req.JobRequestItems {
+		if item.ArraySize > 0 {
+			isArray = true
+			break
+		}
+	}
+	if !isArray {
+		return req, nil, nil
+	}
+
+	items := make([]*api.JobSubmitRequestItem, 0, len(req.JobRequestItems))
+	arrayJobs := make([]*arrayJob, len(req.JobRequestItems))
+	taskClientIdsByArrayClientId := make(map[string][]string)
+	for i, item := range req.JobRequestItems {
+		// Rewrite dependencies on a copy of the item, such that the caller's request isn't modified.
+		withDependencies := *item
+		withDependencies.DependsOn = expandArrayDependencies(item.DependsOn, taskClientIdsByArrayClientId)
+		item = &withDependencies
+		if item.ArraySize == 0 {
+			items = append(items, item)
+			continue
+		}
+		if err := validateArraySize(i, item, maxSize); err != nil {
+			return nil, nil, err
+		}
+		a := &arrayJob{id: util.NewULID(), start: len(items), size: int(item.ArraySize)}
+		arrayJobs[i] = a
+		var taskClientIds []string
+		for index := 0; index < a.size; index++ {
+			task := arrayTask(item, a.id, index)
+			if task.ClientId != "" {
+				taskClientIds = append(taskClientIds, task.ClientId)
+			}
+			items = append(items, task)
+		}
+		if item.ClientId != "" {
+			taskClientIdsByArrayClientId[item.ClientId] = taskClientIds
+		}
+	}
+
+	expanded := *req
+	expanded.JobRequestItems = items
+	return &expanded, arrayJobs, nil
+}
+
+func validateArraySize(i int, item *api.JobSubmitRequestItem, maxSize int) error {
+	if int(item.ArraySize) > maxSize {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "ArraySize",
+			Value:   item.ArraySize,
+			Message: fmt.Sprintf("job %d is an array job of more than the maximum of %d tasks", i, maxSize),
+		}
+	}
+	return nil
+}
+
+// arrayTask returns a copy of the provided array job item for the task with the given index.
+func arrayTask(item *api.JobSubmitRequestItem, arrayId string, index int) *api.JobSubmitRequestItem {
+	task := *item
+	task.ArraySize = 0
+	if item.ClientId != "" {
+		task.ClientId = fmt.Sprintf("%s-%d", item.ClientId, index)
+	}
+	task.Labels = util.DeepCopy(item.Labels)
+	task.Annotations = util.DeepCopy(item.Annotations)
+	if task.Annotations == nil {
+		task.Annotations = make(map[string]string)
+	}
+	task.Annotations[configuration.ArrayIdAnnotation] = arrayId
+	task.Annotations[configuration.ArrayIndexAnnotation] = strconv.Itoa(index)
+	task.RequiredNodeLabels = util.DeepCopy(item.RequiredNodeLabels)
+	task.DependsOn = append([]string(nil), item.DependsOn...)
+
+	env := v1.EnvVar{Name: configuration.ArrayIndexEnvVar, Value: strconv.Itoa(index)}
+	if item.PodSpec != nil {
+		task.PodSpec = withEnvVar(item.PodSpec, env)
+	}
+	if item.PodSpecs != nil {
+		task.PodSpecs = make([]*v1.PodSpec, len(item.PodSpecs))
+		for i, podSpec := range item.PodSpecs {
+			task.PodSpecs[i] = withEnvVar(podSpec, env)
+		}
+	}
+	return &task
+}
+
+// withEnvVar returns a copy of podSpec with env added to each container.
+func withEnvVar(podSpec *v1.PodSpec, env v1.EnvVar) *v1.PodSpec {
+	podSpec = podSpec.DeepCopy()
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].Env = append(podSpec.InitContainers[i].Env, env)
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, env)
+	}
+	return podSpec
+}
+
+func expandArrayDependencies(dependsOn []string, taskClientIdsByArrayClientId map[string][]string) []string {
+	if len(dependsOn) == 0 || len(taskClientIdsByArrayClientId) == 0 {
+		return dependsOn
+	}
+	expanded := make([]string, 0, len(dependsOn))
+	for _, dependency := range dependsOn {
+		if taskClientIds, ok := taskClientIdsByArrayClientId[dependency]; ok {
+			expanded = append(expanded, taskClientIds...)
+		} else {
+			expanded = append(expanded, dependency)
+		}
+	}
+	return expanded
+}
+
+// collapseArrayJobResponses returns the responses to the items of an expanded submit request,
+// with the responses to the tasks of each array job replaced by a single response for the array.
+func collapseArrayJobResponses(responses []*api.JobSubmitResponseItem, arrayJobs []*arrayJob) []*api.JobSubmitResponseItem {
+	if arrayJobs == nil {
+		return responses
+	}
+	collapsed := make([]*api.JobSubmitResponseItem, 0, len(arrayJobs))
+	next := 0
+	for _, a := range arrayJobs {
+		if a == nil {
+			collapsed = append(collapsed, responses[next])
+			next++
+			continue
+		}
+		response := &api.JobSubmitResponseItem{
+			ArrayId:     a.id,
+			ArrayJobIds: make([]string, a.size),
+		}
+		for i, taskResponse := range responses[a.start : a.start+a.size] {
+			response.ArrayJobIds[i] = taskResponse.JobId
+			response.Warnings = appendNewWarnings(response.Warnings, taskResponse.Warnings)
+		}
+		collapsed = append(collapsed, response)
+		next = a.start + a.size
+	}
+	return collapsed
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestExpandArrayJobs(t *testing.T) {
+	req := &api.JobSubmitRequest{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		JobRequestItems: []*api.JobSubmitRequestItem{
+			{ClientId: "single"},
+			{
+				ClientId:    "array",
+				ArraySize:   3,
+				Annotations: map[string]string{"foo": "bar"},
+				PodSpecs: []*v1.PodSpec{{
+					InitContainers: []v1.Container{{Name: "init"}},
+					Containers:     []v1.Container{{Name: "main", Env: []v1.EnvVar{{Name: "FOO", Value: "bar"}}}},
+				}},
+			},
+			{ClientId: "after", DependsOn: []string{"array", "single"}},
+		},
+	}
+
+	expanded, arrayJobs, err := expandArrayJobs(req, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "queue", expanded.Queue)
+	assert.Equal(t, "jobSet", expanded.JobSetId)
+	require.Len(t, expanded.JobRequestItems, 5)
+	require.Len(t, arrayJobs, 3)
+	assert.Nil(t, arrayJobs[0])
+	assert.Nil(t, arrayJobs[2])
+	require.NotNil(t, arrayJobs[1])
+	assert.Equal(t, 1, arrayJobs[1].start)
+	assert.Equal(t, 3, arrayJobs[1].size)
+
+	assert.Equal(t, "single", expanded.JobRequestItems[0].ClientId)
+	for i, task := range expanded.JobRequestItems[1:4] {
+		index := []string{"0", "1", "2"}[i]
+		assert.Equal(t, "array-"+index, task.ClientId)
+		assert.Equal(t, uint32(0), task.ArraySize)
+		assert.Equal(t, map[string]string{
+			"foo":                              "bar",
+			configuration.ArrayIdAnnotation:    arrayJobs[1].id,
+			configuration.ArrayIndexAnnotation: index,
+		}, task.Annotations)
+		indexEnv := v1.EnvVar{Name: configuration.ArrayIndexEnvVar, Value: index}
+		assert.Equal(t, []v1.EnvVar{indexEnv}, task.PodSpecs[0].InitContainers[0].Env)
+		assert.Equal(t, []v1.EnvVar{{Name: "FOO", Value: "bar"}, indexEnv}, task.PodSpecs[0].Containers[0].Env)
+	}
+	assert.Equal(t, []string{"array-0", "array-1", "array-2", "single"}, expanded.JobRequestItems[4].DependsOn)
+
+	// The original items are left unchanged.
+	assert.Equal(t, map[string]string{"foo": "bar"}, req.JobRequestItems[1].Annotations)
+	assert.Len(t, req.JobRequestItems[1].PodSpecs[0].Containers[0].Env, 1)
+	assert.Equal(t, []string{"array", "single"}, req.JobRequestItems[2].DependsOn)
+}
+
+func TestExpandArrayJobs_NoArrayJobs(t *testing.T) {
+	req := &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{ClientId: "single"}}}
+	expanded, arrayJobs, err := expandArrayJobs(req, 0)
+	require.NoError(t, err)
+	assert.Same(t, req, expanded)
+	assert.Nil(t, arrayJobs)
+}
+
+func TestExpandArrayJobs_TooLarge(t *testing.T) {
+	req := &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{ArraySize: 4}}}
+	_, _, err := expandArrayJobs(req, 3)
+	assert.Error(t, err)
+}
+
+func TestCollapseArrayJobResponses(t *testing.T) {
+	responses := []*api.JobSubmitResponseItem{
+		{JobId: "a"},
+		{JobId: "b0"},
+		{JobId: "b1"},
+		{JobId: "c"},
+	}
+	collapsed := collapseArrayJobResponses(responses, []*arrayJob{nil, {id: "b", start: 1, size: 2}, nil})
+	assert.Equal(t, []*api.JobSubmitResponseItem{
+		{JobId: "a"},
+		{ArrayId: "b", ArrayJobIds: []string{"b0", "b1"}},
+		{JobId: "c"},
+	}, collapsed)
+
+	assert.Equal(t, responses, collapseArrayJobResponses(responses, nil))
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
@@ -72,6 +75,9 @@ func checkPermission(p authorization.PermissionChecker, ctx *armadacontext.Conte
 	return nil
 }
 
+// checkQueuePermission checks if a client has the permissions required to perform the action specified by verb on q.
+// Clients must have globalPermission and either own q or be granted verb by its permissions,
+// unless verb is granted by a role bound to them on q.
 func checkQueuePermission(
 	p authorization.PermissionChecker,
 	ctx *armadacontext.Context,
@@ -79,6 +85,11 @@ func checkQueuePermission(
 	globalPermission permission.Permission,
 	verb queue.PermissionVerb,
 ) error {
+	// A role bound to the user on the queue grants the verb regardless of any other permissions.
+	if p.UserHasQueueVerb(ctx, q.Name, string(verb)) {
+		return nil
+	}
+
 	err := checkPermission(p, ctx, globalPermission)
 	if err != nil {
 		return err
@@ -117,3 +128,61 @@ func checkQueuePermission(
 		},
 	}
 }
+
+// checkQueueAdminPermission checks if a client may update or delete the named queue, which requires either
+// globalPermission or a role granting authorization.QueueVerbAdminister on the queue.
+func checkQueueAdminPermission(
+	p authorization.PermissionChecker,
+	ctx *armadacontext.Context,
+	queueName string,
+	globalPermission permission.Permission,
+) error {
+	if p.UserHasQueueVerb(ctx, queueName, authorization.QueueVerbAdminister) {
+		return nil
+	}
+	return checkPermission(p, ctx, globalPermission)
+}
+
+// checkQueueUpdatePermission checks if a client may replace current by updated. Clients with globalPermission may
+// change any field of the queue, whereas clients granted authorization.QueueVerbAdminister on the queue by a role may
+// only change fields not affecting fairness, i.e., not its priority factor, resource limits, or permissions, which
+// include its owners.
+func checkQueueUpdatePermission(
+	p authorization.PermissionChecker,
+	ctx *armadacontext.Context,
+	current queue.Queue,
+	updated queue.Queue,
+	globalPermission permission.Permission,
+) error {
+	err := checkPermission(p, ctx, globalPermission)
+	if err == nil || !p.UserHasQueueVerb(ctx, current.Name, authorization.QueueVerbAdminister) {
+		return err
+	}
+	changedFields := changedFairnessFields(current, updated)
+	if len(changedFields) == 0 {
+		return nil
+	}
+	return &ErrUnauthorized{
+		Principal: authorization.GetPrincipal(ctx),
+		Reasons: []string{
+			fmt.Sprintf("does not have permission %s, which is required to change the %s of a queue", globalPermission, strings.Join(changedFields, ", ")),
+		},
+	}
+}
+
+// changedFairnessFields returns the names of the fields affecting fairness that differ between current and updated.
+func changedFairnessFields(current queue.Queue, updated queue.Queue) []string {
+	var changedFields []string
+	if current.PriorityFactor != updated.PriorityFactor {
+		changedFields = append(changedFields, "priority factor")
+	}
+	if !maps.Equal(current.ResourceLimits, updated.ResourceLimits) {
+		changedFields = append(changedFields, "resource limits")
+	}
+	if !slices.EqualFunc(current.Permissions, updated.Permissions, func(a, b queue.Permissions) bool {
+		return slices.Equal(a.Subjects, b.Subjects) && slices.Equal(a.Verbs, b.Verbs)
+	}) {
+		changedFields = append(changedFields, "permissions")
+	}
+	return changedFields
+}
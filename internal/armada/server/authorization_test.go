@@ -2,9 +2,17 @@ package server
 
 import (
 	"context"
+	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
 type FakePermissionChecker struct{}
@@ -17,6 +25,10 @@ func (FakePermissionChecker) UserHasPermission(ctx context.Context, perm permiss
 	return true
 }
 
+func (FakePermissionChecker) UserHasQueueVerb(ctx context.Context, queue string, verb string) bool {
+	return true
+}
+
 type FakeDenyAllPermissionChecker struct{}
 
 func (c FakeDenyAllPermissionChecker) UserOwns(ctx context.Context, obj authorization.Owned) (owned bool, ownershipGroups []string) {
@@ -26,3 +38,79 @@ func (c FakeDenyAllPermissionChecker) UserOwns(ctx context.Context, obj authoriz
 func (FakeDenyAllPermissionChecker) UserHasPermission(ctx context.Context, perm permission.Permission) bool {
 	return false
 }
+
+func (FakeDenyAllPermissionChecker) UserHasQueueVerb(ctx context.Context, queue string, verb string) bool {
+	return false
+}
+
+// fakeQueueAdminPermissionChecker grants authorization.QueueVerbAdminister on every queue, but no global permissions.
+type fakeQueueAdminPermissionChecker struct {
+	FakeDenyAllPermissionChecker
+}
+
+func (fakeQueueAdminPermissionChecker) UserHasQueueVerb(ctx context.Context, queue string, verb string) bool {
+	return verb == authorization.QueueVerbAdminister
+}
+
+func TestCheckQueueUpdatePermission(t *testing.T) {
+	current := queue.Queue{
+		Name:           "queue",
+		PriorityFactor: 1,
+		ResourceLimits: queue.ResourceLimits{"cpu": 0.5},
+		Permissions: []queue.Permissions{
+			queue.NewPermissionsFromOwners([]string{"alice"}, nil),
+		},
+		State: queue.StateOpen,
+	}
+	tests := map[string]struct {
+		update         func(q *queue.Queue)
+		checker        authorization.PermissionChecker
+		expectedReason string
+	}{
+		"admin may change state": {
+			update:  func(q *queue.Queue) { q.State = queue.StatePaused },
+			checker: fakeQueueAdminPermissionChecker{},
+		},
+		"admin may not change priority factor": {
+			update:         func(q *queue.Queue) { q.PriorityFactor = 2 },
+			checker:        fakeQueueAdminPermissionChecker{},
+			expectedReason: "priority factor",
+		},
+		"admin may not change resource limits": {
+			update:         func(q *queue.Queue) { q.ResourceLimits = queue.ResourceLimits{"cpu": 1} },
+			checker:        fakeQueueAdminPermissionChecker{},
+			expectedReason: "resource limits",
+		},
+		"admin may not change owners": {
+			update: func(q *queue.Queue) {
+				q.Permissions = []queue.Permissions{queue.NewPermissionsFromOwners([]string{"alice", "bob"}, nil)}
+			},
+			checker:        fakeQueueAdminPermissionChecker{},
+			expectedReason: "permissions",
+		},
+		"global permission may change priority factor": {
+			update:  func(q *queue.Queue) { q.PriorityFactor = 2 },
+			checker: FakePermissionChecker{},
+		},
+		"no permission": {
+			update:         func(q *queue.Queue) { q.State = queue.StatePaused },
+			checker:        FakeDenyAllPermissionChecker{},
+			expectedReason: "does not have permission create_queue",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			updated := current
+			updated.Permissions = slices.Clone(current.Permissions)
+			tc.update(&updated)
+			err := checkQueueUpdatePermission(tc.checker, armadacontext.Background(), current, updated, permissions.CreateQueue)
+			if tc.expectedReason == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var e *ErrUnauthorized
+			require.ErrorAs(t, err, &e)
+			assert.Contains(t, e.Error(), tc.expectedReason)
+		})
+	}
+}
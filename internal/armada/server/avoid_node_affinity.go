@@ -2,14 +2,15 @@ package server
 
 import (
 	"github.com/gogo/protobuf/proto"
-	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
-func addAvoidNodeAffinity(job *api.Job, avoidNodeLabels *api.OrderedStringMap, validateJobsCanBeScheduled func(jobs []*api.Job) error) bool {
+func addAvoidNodeAffinity(ctx *armadacontext.Context, job *api.Job, avoidNodeLabels *api.OrderedStringMap, validateJobsCanBeScheduled func(jobs []*api.Job) error) bool {
 	changed := false
 	for _, label := range avoidNodeLabels.Entries {
 
@@ -17,17 +18,16 @@ func addAvoidNodeAffinity(job *api.Job, avoidNodeLabels *api.OrderedStringMap, v
 
 		err := validateJobsCanBeScheduled([]*api.Job{candidateNewJob})
 		if err != nil {
-			log.Warnf(
-				"Not adding avoid node affinity for label %s=%s for job %s because doing so would mean the job would not match any nodes due to error: %s",
+			ctx.WithFields(logging.JobFields(job.Queue, job.JobSetId, job.Id)).Warnf(
+				"Not adding avoid node affinity for label %s=%s because doing so would mean the job would not match any nodes due to error: %s",
 				label.Key,
 				label.Value,
-				job.Id,
 				err,
 			)
 			continue
 		}
 
-		log.Infof("Adding avoid node affinity for label %s=%s for job %s", label.Key, label.Value, job.Id)
+		ctx.WithFields(logging.JobFields(job.Queue, job.JobSetId, job.Id)).Infof("Adding avoid node affinity for label %s=%s", label.Key, label.Value)
 		*job = *candidateNewJob
 		changed = true
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 
+	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
@@ -14,7 +15,7 @@ func Test_addAvoidNodeAffinity_WhenCanBeScheduled_AddsAffinities(t *testing.T) {
 	labels := []*api.StringKeyValuePair{{Key: "name1", Value: "val1"}, {Key: "name2", Value: "val2"}}
 
 	job := basicJob()
-	changed := addAvoidNodeAffinity(job, &api.OrderedStringMap{Entries: labels}, func(jobs []*api.Job) error { return nil })
+	changed := addAvoidNodeAffinity(armadacontext.Background(), job, &api.OrderedStringMap{Entries: labels}, func(jobs []*api.Job) error { return nil })
 
 	expectedJob := basicJob()
 	expectedJob.PodSpec.Affinity = vanillaAvoidLabelAffinites(labels)
@@ -28,7 +29,7 @@ func Test_addAvoidNodeAffinity_WhenCannotBeScheduled_DoesNotAddAffinities(t *tes
 	labels := []*api.StringKeyValuePair{{Key: "name1", Value: "val1"}, {Key: "name2", Value: "val2"}}
 
 	job := basicJob()
-	changed := addAvoidNodeAffinity(job, &api.OrderedStringMap{Entries: labels}, func(jobs []*api.Job) error { return errors.New("Can't schedule") })
+	changed := addAvoidNodeAffinity(armadacontext.Background(), job, &api.OrderedStringMap{Entries: labels}, func(jobs []*api.Job) error { return errors.New("Can't schedule") })
 
 	expectedJob := basicJob()
 
@@ -40,7 +41,7 @@ func Test_addAvoidNodeAffinity_WhenFirstAffinityCannotBeScheduled_ButSecondCan_A
 	labels := []*api.StringKeyValuePair{{Key: "name1", Value: "val1"}, {Key: "name2", Value: "val2"}}
 
 	job := basicJob()
-	changed := addAvoidNodeAffinity(job, &api.OrderedStringMap{Entries: labels}, func(jobs []*api.Job) error {
+	changed := addAvoidNodeAffinity(armadacontext.Background(), job, &api.OrderedStringMap{Entries: labels}, func(jobs []*api.Job) error {
 		if jobs[0].PodSpecs[0].Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Key == "name1" {
 			return errors.New("Can't schedule")
 		} else {
@@ -0,0 +1,358 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/cron"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// Number of events read at a time when checking whether jobs submitted previously by a cron job set are still active.
+const cronJobSetEventBatchSize = 500
+
+// CronJobSetServer manages cron job sets, i.e., templates of jobs submitted on a schedule,
+// and submits jobs on their behalf when they're due.
+type CronJobSetServer struct {
+	api.UnimplementedCronJobSetsServer
+	CronJobSetRepository repository.CronJobSetRepository
+	// Used to check whether jobs submitted previously by a cron job set are still active.
+	EventRepository repository.EventRepository
+	// Used to authorize requests, and to submit and cancel jobs on behalf of cron job sets.
+	SubmitServer *PulsarSubmitServer
+	Clock        clock.Clock
+}
+
+func (srv *CronJobSetServer) CreateCronJobSet(grpcCtx context.Context, req *api.CronJobSet) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	schedule, err := validateCronJobSet(req)
+	if err != nil {
+		return nil, err
+	}
+	userId, groups, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := srv.Clock.Now().UTC()
+	cronJobSet := proto.Clone(req).(*api.CronJobSet)
+	cronJobSet.Owner = userId
+	cronJobSet.Groups = groups
+	cronJobSet.Created = now
+	cronJobSet.LastScheduled = nil
+	cronJobSet.NextScheduled = nextScheduled(schedule, now)
+	cronJobSet.LastJobSetId = ""
+	cronJobSet.LastJobIds = nil
+	cronJobSet.LastError = ""
+	err = srv.CronJobSetRepository.CreateCronJobSet(cronJobSet)
+	var alreadyExists *repository.ErrCronJobSetAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		return nil, &armadaerrors.ErrAlreadyExists{Type: "cronJobSet", Value: req.Name, Message: err.Error()}
+	} else if err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// UpdateCronJobSet replaces the template, schedule, and settings of an existing cron job set.
+// Subsequent jobs are submitted on behalf of the user making the update.
+func (srv *CronJobSetServer) UpdateCronJobSet(grpcCtx context.Context, req *api.CronJobSet) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	schedule, err := validateCronJobSet(req)
+	if err != nil {
+		return nil, err
+	}
+	userId, groups, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := srv.getCronJobSet(req.Queue, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := srv.Clock.Now().UTC()
+	cronJobSet := proto.Clone(req).(*api.CronJobSet)
+	cronJobSet.Owner = userId
+	cronJobSet.Groups = groups
+	copyCronJobSetStatus(cronJobSet, existing)
+	cronJobSet.NextScheduled = nextScheduled(schedule, now)
+	if err := srv.CronJobSetRepository.UpdateCronJobSet(cronJobSet); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// DeleteCronJobSet deletes a cron job set. Jobs already submitted by it are unaffected.
+func (srv *CronJobSetServer) DeleteCronJobSet(grpcCtx context.Context, req *api.CronJobSetDeleteRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if _, _, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit); err != nil {
+		return nil, err
+	}
+	if _, err := srv.getCronJobSet(req.Queue, req.Name); err != nil {
+		return nil, err
+	}
+	if err := srv.CronJobSetRepository.DeleteCronJobSet(req.Queue, req.Name); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (srv *CronJobSetServer) GetCronJobSet(grpcCtx context.Context, req *api.CronJobSetGetRequest) (*api.CronJobSet, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if _, _, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.WatchAllEvents, queue.PermissionVerbWatch); err != nil {
+		return nil, err
+	}
+	return srv.getCronJobSet(req.Queue, req.Name)
+}
+
+func (srv *CronJobSetServer) GetCronJobSets(grpcCtx context.Context, req *api.CronJobSetListRequest) (*api.CronJobSetList, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if req.Queue == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "Queue",
+			Value:   req.Queue,
+			Message: "queue cannot be empty",
+		}
+	}
+	if _, _, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.WatchAllEvents, queue.PermissionVerbWatch); err != nil {
+		return nil, err
+	}
+	cronJobSets, err := srv.CronJobSetRepository.GetCronJobSets(req.Queue)
+	if err != nil {
+		return nil, err
+	}
+	return &api.CronJobSetList{CronJobSets: cronJobSets}, nil
+}
+
+// SubmitDueJobs submits jobs for all cron job sets whose schedule has fired since they were last processed.
+// If a schedule fired several times since then, jobs are submitted only once.
+func (srv *CronJobSetServer) SubmitDueJobs(ctx *armadacontext.Context) error {
+	cronJobSets, err := srv.CronJobSetRepository.GetCronJobSets("")
+	if err != nil {
+		return err
+	}
+	now := srv.Clock.Now().UTC()
+	for _, cronJobSet := range cronJobSets {
+		if err := srv.submitIfDue(ctx, cronJobSet, now); err != nil {
+			ctx.WithError(err).Errorf("failed to process cron job set %s of queue %s", cronJobSet.Name, cronJobSet.Queue)
+		}
+	}
+	return nil
+}
+
+func (srv *CronJobSetServer) submitIfDue(ctx *armadacontext.Context, cronJobSet *api.CronJobSet, now time.Time) error {
+	if cronJobSet.Suspended {
+		return nil
+	}
+	schedule, err := cron.Parse(cronJobSet.Schedule)
+	if err != nil {
+		return err
+	}
+	since := cronJobSet.Created
+	if cronJobSet.LastScheduled != nil {
+		since = *cronJobSet.LastScheduled
+	}
+	scheduled := schedule.Prev(now, since)
+	if scheduled.IsZero() {
+		return nil
+	}
+	// Make sure only one server submits jobs for each scheduled time.
+	if ok, err := srv.CronJobSetRepository.TryClaim(cronJobSet.Queue, cronJobSet.Name, scheduled); err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	status := &api.CronJobSet{
+		LastScheduled: &scheduled,
+		LastJobSetId:  cronJobSet.LastJobSetId,
+		LastJobIds:    cronJobSet.LastJobIds,
+	}
+	jobSetId, jobIds, err := srv.submit(ctx, cronJobSet, scheduled)
+	if err != nil {
+		ctx.Infof("not submitting jobs for cron job set %s of queue %s: %s", cronJobSet.Name, cronJobSet.Queue, err)
+		status.LastError = err.Error()
+	} else {
+		ctx.Infof("submitted %d jobs to job set %s for cron job set %s of queue %s", len(jobIds), jobSetId, cronJobSet.Name, cronJobSet.Queue)
+		status.LastJobSetId = jobSetId
+		status.LastJobIds = jobIds
+	}
+
+	// Re-read the cron job set to avoid overwriting any concurrent update.
+	latest, err := srv.CronJobSetRepository.GetCronJobSet(cronJobSet.Queue, cronJobSet.Name)
+	if err != nil {
+		return err
+	}
+	copyCronJobSetStatus(latest, status)
+	if latestSchedule, err := cron.Parse(latest.Schedule); err == nil {
+		latest.NextScheduled = nextScheduled(latestSchedule, now)
+	}
+	return srv.CronJobSetRepository.UpdateCronJobSet(latest)
+}
+
+// submit submits the jobs of a cron job set to a new job set, after applying the overlap policy of the cron job set.
+// Returns the id of the job set jobs were submitted to and the ids of those jobs.
+func (srv *CronJobSetServer) submit(ctx *armadacontext.Context, cronJobSet *api.CronJobSet, scheduled time.Time) (string, []string, error) {
+	// Jobs are submitted and cancelled on behalf of the owner of the cron job set.
+	principalCtx := authorization.WithPrincipal(ctx, authorization.NewStaticPrincipal(cronJobSet.Owner, cronJobSet.Groups))
+
+	if cronJobSet.OverlapPolicy != api.CronOverlapPolicy_CRON_OVERLAP_ALLOW && cronJobSet.LastJobSetId != "" {
+		active, err := srv.hasActiveJobs(cronJobSet)
+		if err != nil {
+			return "", nil, err
+		}
+		if active {
+			switch cronJobSet.OverlapPolicy {
+			case api.CronOverlapPolicy_CRON_OVERLAP_SKIP:
+				return "", nil, errors.Errorf("skipped since jobs in job set %s are still active", cronJobSet.LastJobSetId)
+			case api.CronOverlapPolicy_CRON_OVERLAP_REPLACE:
+				if _, err := srv.SubmitServer.CancelJobSet(principalCtx, &api.JobSetCancelRequest{
+					Queue:    cronJobSet.Queue,
+					JobSetId: cronJobSet.LastJobSetId,
+					Reason:   fmt.Sprintf("replaced by cron job set %s", cronJobSet.Name),
+				}); err != nil {
+					return "", nil, errors.WithMessagef(err, "failed to cancel job set %s", cronJobSet.LastJobSetId)
+				}
+			}
+		}
+	}
+
+	jobSetId := fmt.Sprintf("%s-%d", cronJobSet.JobSetIdPrefix, scheduled.Unix())
+	res, err := srv.SubmitServer.SubmitJobs(principalCtx, &api.JobSubmitRequest{
+		Queue:           cronJobSet.Queue,
+		JobSetId:        jobSetId,
+		JobRequestItems: materializeJobRequestItems(cronJobSet, scheduled),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	jobIds := make([]string, len(res.JobResponseItems))
+	for i, item := range res.JobResponseItems {
+		jobIds[i] = item.JobId
+	}
+	return jobSetId, jobIds, nil
+}
+
+// hasActiveJobs returns true if some job most recently submitted by the cron job set has not yet reached a terminal state.
+func (srv *CronJobSetServer) hasActiveJobs(cronJobSet *api.CronJobSet) (bool, error) {
+	activeJobIds := make(map[string]bool, len(cronJobSet.LastJobIds))
+	for _, jobId := range cronJobSet.LastJobIds {
+		activeJobIds[jobId] = true
+	}
+	fromId := ""
+	for len(activeJobIds) > 0 {
+		messages, _, err := srv.EventRepository.ReadEvents(cronJobSet.Queue, cronJobSet.LastJobSetId, fromId, cronJobSetEventBatchSize, -1)
+		if err != nil {
+			return false, err
+		}
+		for _, msg := range messages {
+			fromId = msg.Id
+			event, err := api.UnwrapEvent(msg.Message)
+			if err != nil {
+				return false, err
+			}
+			switch event.(type) {
+			case *api.JobSucceededEvent, *api.JobFailedEvent, *api.JobCancelledEvent:
+				delete(activeJobIds, event.GetJobId())
+			}
+		}
+		if len(messages) < cronJobSetEventBatchSize {
+			break
+		}
+	}
+	return len(activeJobIds) > 0, nil
+}
+
+func (srv *CronJobSetServer) getCronJobSet(queueName string, name string) (*api.CronJobSet, error) {
+	cronJobSet, err := srv.CronJobSetRepository.GetCronJobSet(queueName, name)
+	var notFound *repository.ErrCronJobSetNotFound
+	if errors.As(err, &notFound) {
+		return nil, &armadaerrors.ErrNotFound{Type: "cronJobSet", Value: name, Message: err.Error()}
+	}
+	return cronJobSet, err
+}
+
+// materializeJobRequestItems returns a copy of the job request items of the cron job set for the provided scheduled time.
+// Client ids are made unique per scheduled time, such that jobs aren't deduplicated against those submitted previously,
+// and each job is annotated with the name of the cron job set.
+func materializeJobRequestItems(cronJobSet *api.CronJobSet, scheduled time.Time) []*api.JobSubmitRequestItem {
+	clientIdSuffix := fmt.Sprintf("-%d", scheduled.Unix())
+	items := make([]*api.JobSubmitRequestItem, len(cronJobSet.JobRequestItems))
+	for i, item := range cronJobSet.JobRequestItems {
+		item = proto.Clone(item).(*api.JobSubmitRequestItem)
+		if item.ClientId != "" {
+			item.ClientId += clientIdSuffix
+		}
+		// Dependencies may refer to other items by client id.
+		for j, dependency := range item.DependsOn {
+			for _, other := range cronJobSet.JobRequestItems {
+				if other.ClientId != "" && other.ClientId == dependency {
+					item.DependsOn[j] = dependency + clientIdSuffix
+					break
+				}
+			}
+		}
+		if item.Annotations == nil {
+			item.Annotations = make(map[string]string)
+		}
+		item.Annotations[armadaconfiguration.CronJobSetAnnotation] = cronJobSet.Name
+		items[i] = item
+	}
+	return items
+}
+
+func validateCronJobSet(cronJobSet *api.CronJobSet) (*cron.Schedule, error) {
+	if cronJobSet.Name == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{Name: "Name", Value: cronJobSet.Name, Message: "name cannot be empty"}
+	}
+	if cronJobSet.Queue == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{Name: "Queue", Value: cronJobSet.Queue, Message: "queue cannot be empty"}
+	}
+	if cronJobSet.JobSetIdPrefix == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{Name: "JobSetIdPrefix", Value: cronJobSet.JobSetIdPrefix, Message: "job set id prefix cannot be empty"}
+	}
+	if len(cronJobSet.JobRequestItems) == 0 {
+		return nil, &armadaerrors.ErrInvalidArgument{Name: "JobRequestItems", Value: cronJobSet.JobRequestItems, Message: "at least one job must be provided"}
+	}
+	if _, ok := api.CronOverlapPolicy_name[int32(cronJobSet.OverlapPolicy)]; !ok {
+		return nil, &armadaerrors.ErrInvalidArgument{Name: "OverlapPolicy", Value: cronJobSet.OverlapPolicy, Message: "unknown overlap policy"}
+	}
+	schedule, err := cron.Parse(cronJobSet.Schedule)
+	if err != nil {
+		return nil, &armadaerrors.ErrInvalidArgument{Name: "Schedule", Value: cronJobSet.Schedule, Message: err.Error()}
+	}
+	return schedule, nil
+}
+
+// copyCronJobSetStatus copies the fields of src maintained by Armada into dst.
+func copyCronJobSetStatus(dst *api.CronJobSet, src *api.CronJobSet) {
+	if !src.Created.IsZero() {
+		dst.Created = src.Created
+	}
+	dst.LastScheduled = src.LastScheduled
+	dst.LastJobSetId = src.LastJobSetId
+	dst.LastJobIds = src.LastJobIds
+	dst.LastError = src.LastError
+}
+
+func nextScheduled(schedule *cron.Schedule, now time.Time) *time.Time {
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
@@ -0,0 +1,242 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/repository/sequence"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type fakeCronJobSetRepository struct {
+	cronJobSets map[string]*api.CronJobSet
+	claims      map[string]bool
+}
+
+func newFakeCronJobSetRepository(cronJobSets ...*api.CronJobSet) *fakeCronJobSetRepository {
+	r := &fakeCronJobSetRepository{cronJobSets: make(map[string]*api.CronJobSet), claims: make(map[string]bool)}
+	for _, cronJobSet := range cronJobSets {
+		r.cronJobSets[cronJobSet.Queue+"/"+cronJobSet.Name] = cronJobSet
+	}
+	return r
+}
+
+func (r *fakeCronJobSetRepository) GetCronJobSets(queue string) ([]*api.CronJobSet, error) {
+	var rv []*api.CronJobSet
+	for _, cronJobSet := range r.cronJobSets {
+		if queue == "" || cronJobSet.Queue == queue {
+			rv = append(rv, cronJobSet)
+		}
+	}
+	return rv, nil
+}
+
+func (r *fakeCronJobSetRepository) GetCronJobSet(queue string, name string) (*api.CronJobSet, error) {
+	if cronJobSet, ok := r.cronJobSets[queue+"/"+name]; ok {
+		return cronJobSet, nil
+	}
+	return nil, &repository.ErrCronJobSetNotFound{Queue: queue, Name: name}
+}
+
+func (r *fakeCronJobSetRepository) CreateCronJobSet(cronJobSet *api.CronJobSet) error {
+	r.cronJobSets[cronJobSet.Queue+"/"+cronJobSet.Name] = cronJobSet
+	return nil
+}
+
+func (r *fakeCronJobSetRepository) UpdateCronJobSet(cronJobSet *api.CronJobSet) error {
+	r.cronJobSets[cronJobSet.Queue+"/"+cronJobSet.Name] = cronJobSet
+	return nil
+}
+
+func (r *fakeCronJobSetRepository) DeleteCronJobSet(queue string, name string) error {
+	delete(r.cronJobSets, queue+"/"+name)
+	return nil
+}
+
+func (r *fakeCronJobSetRepository) TryClaim(queue string, name string, scheduled time.Time) (bool, error) {
+	key := fmt.Sprintf("%s/%s/%d", queue, name, scheduled.Unix())
+	if r.claims[key] {
+		return false, nil
+	}
+	r.claims[key] = true
+	return true, nil
+}
+
+type fakeEventRepository struct {
+	messages []*api.EventStreamMessage
+}
+
+func (r *fakeEventRepository) CheckStreamExists(string, string) (bool, error) {
+	return len(r.messages) > 0, nil
+}
+
+func (r *fakeEventRepository) ReadEvents(_, _ string, lastId string, limit int64, _ time.Duration) ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error) {
+	start := 0
+	for i, msg := range r.messages {
+		if msg.Id == lastId {
+			start = i + 1
+		}
+	}
+	end := start + int(limit)
+	if end > len(r.messages) {
+		end = len(r.messages)
+	}
+	return r.messages[start:end], nil, nil
+}
+
+func (r *fakeEventRepository) GetLastMessageId(string, string) (string, error) {
+	if len(r.messages) == 0 {
+		return "", nil
+	}
+	return r.messages[len(r.messages)-1].Id, nil
+}
+
+func cronJobSetEvents(t *testing.T, events ...api.Event) []*api.EventStreamMessage {
+	messages := make([]*api.EventStreamMessage, len(events))
+	for i, event := range events {
+		msg, err := api.Wrap(event)
+		require.NoError(t, err)
+		messages[i] = &api.EventStreamMessage{Id: fmt.Sprintf("%d", i), Message: msg}
+	}
+	return messages
+}
+
+func TestCronJobSetServer_SubmitDueJobs_SkipsIfActive(t *testing.T) {
+	created := time.Date(2023, 10, 16, 10, 0, 0, 0, time.UTC)
+	cronJobSet := &api.CronJobSet{
+		Name:           "nightly",
+		Queue:          "queue",
+		JobSetIdPrefix: "nightly",
+		Schedule:       "*/10 * * * *",
+		OverlapPolicy:  api.CronOverlapPolicy_CRON_OVERLAP_SKIP,
+		Created:        created,
+		LastJobSetId:   "nightly-0",
+		LastJobIds:     []string{"a", "b"},
+	}
+	cronJobSetRepository := newFakeCronJobSetRepository(cronJobSet)
+	srv := &CronJobSetServer{
+		CronJobSetRepository: cronJobSetRepository,
+		EventRepository: &fakeEventRepository{messages: cronJobSetEvents(t,
+			&api.JobSucceededEvent{JobId: "a"},
+			&api.JobRunningEvent{JobId: "b"},
+		)},
+		Clock: clock.NewFakeClock(created.Add(25 * time.Minute)),
+	}
+
+	require.NoError(t, srv.SubmitDueJobs(armadacontext.Background()))
+	updated, err := cronJobSetRepository.GetCronJobSet("queue", "nightly")
+	require.NoError(t, err)
+	require.NotNil(t, updated.LastScheduled)
+	assert.Equal(t, created.Add(20*time.Minute), *updated.LastScheduled)
+	require.NotNil(t, updated.NextScheduled)
+	assert.Equal(t, created.Add(30*time.Minute), *updated.NextScheduled)
+	assert.Contains(t, updated.LastError, "still active")
+	assert.Equal(t, "nightly-0", updated.LastJobSetId)
+	assert.Equal(t, []string{"a", "b"}, updated.LastJobIds)
+
+	// Nothing more to do until the schedule fires again.
+	updated.LastError = ""
+	require.NoError(t, srv.SubmitDueJobs(armadacontext.Background()))
+	assert.Empty(t, updated.LastError)
+}
+
+func TestCronJobSetServer_SubmitDueJobs_Suspended(t *testing.T) {
+	created := time.Date(2023, 10, 16, 10, 0, 0, 0, time.UTC)
+	cronJobSet := &api.CronJobSet{
+		Name:      "nightly",
+		Queue:     "queue",
+		Schedule:  "*/10 * * * *",
+		Created:   created,
+		Suspended: true,
+	}
+	cronJobSetRepository := newFakeCronJobSetRepository(cronJobSet)
+	srv := &CronJobSetServer{
+		CronJobSetRepository: cronJobSetRepository,
+		EventRepository:      &fakeEventRepository{},
+		Clock:                clock.NewFakeClock(created.Add(25 * time.Minute)),
+	}
+	require.NoError(t, srv.SubmitDueJobs(armadacontext.Background()))
+	assert.Nil(t, cronJobSet.LastScheduled)
+	assert.Empty(t, cronJobSetRepository.claims)
+}
+
+func TestCronJobSetServer_HasActiveJobs(t *testing.T) {
+	cronJobSet := &api.CronJobSet{Queue: "queue", LastJobSetId: "jobSet", LastJobIds: []string{"a", "b", "c"}}
+	events := []api.Event{
+		&api.JobSucceededEvent{JobId: "a"},
+		&api.JobFailedEvent{JobId: "b"},
+	}
+	for i := 0; i < cronJobSetEventBatchSize; i++ {
+		events = append(events, &api.JobRunningEvent{JobId: "c"})
+	}
+	srv := &CronJobSetServer{EventRepository: &fakeEventRepository{messages: cronJobSetEvents(t, events...)}}
+	active, err := srv.hasActiveJobs(cronJobSet)
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	events = append(events, &api.JobCancelledEvent{JobId: "c"})
+	srv = &CronJobSetServer{EventRepository: &fakeEventRepository{messages: cronJobSetEvents(t, events...)}}
+	active, err = srv.hasActiveJobs(cronJobSet)
+	require.NoError(t, err)
+	assert.False(t, active)
+}
+
+func TestMaterializeJobRequestItems(t *testing.T) {
+	cronJobSet := &api.CronJobSet{
+		Name: "nightly",
+		JobRequestItems: []*api.JobSubmitRequestItem{
+			{ClientId: "first"},
+			{ClientId: "second", DependsOn: []string{"first"}, Annotations: map[string]string{"foo": "bar"}},
+		},
+	}
+	items := materializeJobRequestItems(cronJobSet, time.Unix(100, 0))
+	require.Len(t, items, 2)
+	assert.Equal(t, "first-100", items[0].ClientId)
+	assert.Equal(t, map[string]string{configuration.CronJobSetAnnotation: "nightly"}, items[0].Annotations)
+	assert.Equal(t, "second-100", items[1].ClientId)
+	assert.Equal(t, []string{"first-100"}, items[1].DependsOn)
+	assert.Equal(t, map[string]string{"foo": "bar", configuration.CronJobSetAnnotation: "nightly"}, items[1].Annotations)
+
+	// The template itself is unchanged.
+	assert.Equal(t, "first", cronJobSet.JobRequestItems[0].ClientId)
+	assert.Equal(t, []string{"first"}, cronJobSet.JobRequestItems[1].DependsOn)
+	assert.Equal(t, map[string]string{"foo": "bar"}, cronJobSet.JobRequestItems[1].Annotations)
+}
+
+func TestValidateCronJobSet(t *testing.T) {
+	valid := func() *api.CronJobSet {
+		return &api.CronJobSet{
+			Name:            "nightly",
+			Queue:           "queue",
+			JobSetIdPrefix:  "nightly",
+			Schedule:        "@daily",
+			JobRequestItems: []*api.JobSubmitRequestItem{{}},
+		}
+	}
+	_, err := validateCronJobSet(valid())
+	assert.NoError(t, err)
+
+	for name, mutate := range map[string]func(*api.CronJobSet){
+		"no name":           func(c *api.CronJobSet) { c.Name = "" },
+		"no queue":          func(c *api.CronJobSet) { c.Queue = "" },
+		"no job set prefix": func(c *api.CronJobSet) { c.JobSetIdPrefix = "" },
+		"no jobs":           func(c *api.CronJobSet) { c.JobRequestItems = nil },
+		"invalid schedule":  func(c *api.CronJobSet) { c.Schedule = "every day" },
+		"invalid overlap":   func(c *api.CronJobSet) { c.OverlapPolicy = 42 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			cronJobSet := valid()
+			mutate(cronJobSet)
+			_, err := validateCronJobSet(cronJobSet)
+			assert.Error(t, err)
+		})
+	}
+}
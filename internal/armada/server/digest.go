@@ -0,0 +1,281 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/armada/notification"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/repository/apimessages"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/cron"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/logging"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// Failure reasons longer than this are truncated, such that similar reasons are grouped together in digests.
+const maxDigestFailureReasonLength = 100
+
+// DigestRecorder is a service that reads job set events from the event log and records the outcomes of finished jobs,
+// from which a Digester computes digests. Since messages re-delivered after a crash are processed again, and jobs are
+// counted as using resources from the time they last started running, digests are approximate.
+type DigestRecorder struct {
+	DigestRepository repository.DigestRepository
+	Consumer         eventlog.Consumer
+	// Maximum number of messages received at a time, and the maximum time to wait for a batch to fill up.
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+// Run the service that reads from the event log and records job outcomes until the provided context is cancelled.
+func (srv *DigestRecorder) Run(ctx *armadacontext.Context) error {
+	log := ctx.WithField("service", "DigestRecorder")
+	log.Info("service started")
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("service stopped")
+			return nil
+		default:
+			ctxWithTimeout, cancel := armadacontext.WithTimeout(ctx, 10*time.Second)
+			msgs, err := eventlog.ReceiveBatch(ctxWithTimeout, srv.Consumer, srv.BatchSize, srv.FlushInterval)
+			cancel()
+			if errors.Is(err, context.DeadlineExceeded) {
+				break // expected
+			} else if err != nil {
+				logging.WithStacktrace(log, err).Warnf("event log receive failed; backing off")
+				time.Sleep(100 * time.Millisecond)
+				break
+			}
+
+			for _, msg := range msgs {
+				ctxWithLogger := armadacontext.WithLogField(ctx, logging.MessageIdField, msg.ID())
+				sequence, err := eventutil.UnmarshalEventSequence(ctxWithLogger, msg.Payload())
+				if err != nil {
+					logging.WithStacktrace(ctxWithLogger, err).Warnf("processing message failed; ignoring")
+				} else {
+					// Errors are most likely caused by the database being unavailable, so retry until it's back.
+					util.RetryUntilSuccess(
+						ctx,
+						func() error { return srv.ProcessSequence(ctxWithLogger, sequence) },
+						func(err error) {
+							logging.WithStacktrace(ctxWithLogger, err).Warnf("processing message failed; retrying")
+							time.Sleep(time.Second)
+						},
+					)
+				}
+				util.RetryUntilSuccess(
+					ctx,
+					func() error { return srv.Consumer.Ack(msg) },
+					func(err error) {
+						logging.WithStacktrace(log, err).Warnf("acking event log message failed")
+						time.Sleep(time.Second)
+					},
+				)
+			}
+		}
+	}
+}
+
+// ProcessSequence records the resources requested by jobs submitted in sequence, the times at which jobs started
+// running, and the outcomes of jobs that finished.
+func (srv *DigestRecorder) ProcessSequence(ctx *armadacontext.Context, sequence *armadaevents.EventSequence) error {
+	events, err := apimessages.FromEventSequence(sequence)
+	if err != nil {
+		ctx.WithError(err).Warnf("failed to convert events of job set %s of queue %s; ignoring", sequence.JobSetName, sequence.Queue)
+		return nil
+	}
+
+	// Updates by the hour in which jobs finished.
+	updates := make(map[time.Time]*repository.DigestUpdate)
+	// A job may fail with several errors, but is only counted once.
+	finished := make(map[string]bool)
+	for _, event := range events {
+		var jobId string
+		var created time.Time
+		var failureReason string
+		switch e := event.Events.(type) {
+		case *api.EventMessage_Submitted:
+			if err := srv.DigestRepository.RecordJobRequests(e.Submitted.JobId, jobRequests(&e.Submitted.Job)); err != nil {
+				return err
+			}
+			continue
+		case *api.EventMessage_Running:
+			if err := srv.DigestRepository.RecordJobStarted(e.Running.JobId, e.Running.Created); err != nil {
+				return err
+			}
+			continue
+		case *api.EventMessage_Succeeded:
+			jobId, created = e.Succeeded.JobId, e.Succeeded.Created
+		case *api.EventMessage_Failed:
+			jobId, created = e.Failed.JobId, e.Failed.Created
+			failureReason = digestFailureReason(e.Failed)
+		case *api.EventMessage_Cancelled:
+			jobId, created = e.Cancelled.JobId, e.Cancelled.Created
+		default:
+			continue
+		}
+		if finished[jobId] {
+			continue
+		}
+		finished[jobId] = true
+
+		hour := created.UTC().Truncate(time.Hour)
+		update, ok := updates[hour]
+		if !ok {
+			update = &repository.DigestUpdate{
+				JobSetId:        sequence.JobSetName,
+				FailureReasons:  make(map[string]int64),
+				ResourceSeconds: make(map[string]float64),
+			}
+			updates[hour] = update
+		}
+		switch event.Events.(type) {
+		case *api.EventMessage_Succeeded:
+			update.Succeeded++
+		case *api.EventMessage_Failed:
+			update.Failed++
+			update.FailureReasons[failureReason]++
+		case *api.EventMessage_Cancelled:
+			update.Cancelled++
+		}
+
+		job, err := srv.DigestRepository.FinishJob(jobId)
+		if err != nil {
+			return err
+		}
+		if job != nil && !job.Started.IsZero() && created.After(job.Started) {
+			seconds := created.Sub(job.Started).Seconds()
+			for name, request := range job.Requests {
+				update.ResourceSeconds[name] += request * seconds
+			}
+		}
+	}
+
+	for hour, update := range updates {
+		if err := srv.DigestRepository.AddToDigest(sequence.Queue, hour, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jobRequests returns the total resources requested by the pods of job.
+func jobRequests(job *api.Job) map[string]float64 {
+	total := armadaresource.ComputeResources{}
+	if job.PodSpec != nil {
+		total.Add(armadaresource.TotalPodResourceRequest(job.PodSpec))
+	}
+	for _, podSpec := range job.PodSpecs {
+		total.Add(armadaresource.TotalPodResourceRequest(podSpec))
+	}
+	return total.AsFloat()
+}
+
+// digestFailureReason returns the reason a job failed, as grouped in digests.
+// Failures are grouped by cause, e.g., "OOM", if known, and otherwise by exit code or message.
+func digestFailureReason(e *api.JobFailedEvent) string {
+	if e.Cause != api.Cause_Error {
+		return e.Cause.String()
+	}
+	for _, status := range e.ContainerStatuses {
+		if status.Cause != api.Cause_Error {
+			return status.Cause.String()
+		} else if status.ExitCode != 0 {
+			return fmt.Sprintf("exit code %d", status.ExitCode)
+		}
+	}
+	if e.Reason == "" {
+		return "unknown"
+	}
+	return util.Truncate(e.Reason, maxDigestFailureReasonLength)
+}
+
+// DigestDispatcher delivers digests to webhooks.
+type DigestDispatcher interface {
+	DeliverDigest(ctx *armadacontext.Context, webhook *api.Webhook, digest *notification.Digest) string
+}
+
+// Digester sends digests of the outcomes of the jobs of each queue to the webhooks registered for them, on a schedule.
+// If several servers are running, each digest is sent by one of them.
+type Digester struct {
+	DigestRepository       repository.DigestRepository
+	NotificationRepository repository.NotificationRepository
+	Dispatcher             DigestDispatcher
+	Schedule               *cron.Schedule
+	// Period of time summarized by each digest, ending at the time the digest is due.
+	Window time.Duration
+	// Number of most common failure reasons included in each digest.
+	TopFailureReasons int
+	Clock             clock.Clock
+	// Time at which digests are next due.
+	nextDue time.Time
+}
+
+// SendDueDigests sends digests if they've become due since the previous call.
+// Since digests that became due before the first call aren't sent, missed digests aren't sent on restart.
+func (srv *Digester) SendDueDigests(ctx *armadacontext.Context) error {
+	now := srv.Clock.Now().UTC()
+	if srv.nextDue.IsZero() {
+		srv.nextDue = srv.Schedule.Next(now)
+		return nil
+	} else if now.Before(srv.nextDue) {
+		return nil
+	}
+	due := srv.nextDue
+	claimed, err := srv.DigestRepository.ClaimDigest(due)
+	if err != nil {
+		return err
+	}
+	srv.nextDue = srv.Schedule.Next(now)
+	if !claimed {
+		ctx.Infof("digests due at %s are sent by another server", due)
+		return nil
+	}
+
+	webhooks, err := srv.NotificationRepository.GetWebhooks("")
+	if err != nil {
+		return err
+	}
+	webhooksByQueue := make(map[string][]*api.Webhook)
+	for _, webhook := range webhooks {
+		if notification.Subscribes(webhook, &notification.Notification{Event: api.NotificationEvent_NOTIFICATION_DIGEST}) {
+			webhooksByQueue[webhook.Queue] = append(webhooksByQueue[webhook.Queue], webhook)
+		}
+	}
+
+	start := due.Add(-srv.Window)
+	var wg sync.WaitGroup
+	for queue, webhooks := range webhooksByQueue {
+		totals, err := srv.DigestRepository.GetDigestTotals(queue, start, due)
+		if err != nil {
+			wg.Wait()
+			return err
+		}
+		digest := notification.NewDigest(queue, start, due, totals, srv.TopFailureReasons)
+		// Don't send digests of queues no jobs of which finished.
+		if digest.Finished() == 0 {
+			continue
+		}
+		for _, webhook := range webhooks {
+			webhook := webhook
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				srv.Dispatcher.DeliverDigest(ctx, webhook, digest)
+			}()
+		}
+	}
+	wg.Wait()
+	return nil
+}
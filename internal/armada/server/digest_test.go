@@ -0,0 +1,189 @@
+package server
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/armada/notification"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/cron"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+type fakeDigestDispatcher struct {
+	mu      sync.Mutex
+	digests map[string][]*notification.Digest
+}
+
+func (d *fakeDigestDispatcher) DeliverDigest(_ *armadacontext.Context, webhook *api.Webhook, digest *notification.Digest) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.digests[webhook.Id] = append(d.digests[webhook.Id], digest)
+	return ""
+}
+
+func withDigestRepositories(t *testing.T, action func(digestRepo *repository.RedisDigestRepository, notificationRepo *repository.RedisNotificationRepository)) {
+	db, err := miniredis.Run()
+	require.NoError(t, err)
+	defer db.Close()
+	client := redis.NewClient(&redis.Options{Addr: db.Addr()})
+	defer client.Close()
+
+	action(repository.NewRedisDigestRepository(client, 7*24*time.Hour), repository.NewRedisNotificationRepository(client))
+}
+
+func digestSequence(created time.Time, events ...*armadaevents.EventSequence_Event) *armadaevents.EventSequence {
+	for _, event := range events {
+		event.Created = &created
+	}
+	return &armadaevents.EventSequence{Queue: "queue", JobSetName: "jobSet", UserId: "owner", Events: events}
+}
+
+func TestDigestRecorder_ProcessSequence(t *testing.T) {
+	withDigestRepositories(t, func(digestRepo *repository.RedisDigestRepository, _ *repository.RedisNotificationRepository) {
+		recorder := &DigestRecorder{DigestRepository: digestRepo}
+		ctx := armadacontext.Background()
+		start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		_, failedJobId, submitFailedJob := testNotificationJob(t)
+		_, succeededJobId, submitSucceededJob := testNotificationJob(t)
+		_, cancelledJobId, submitCancelledJob := testNotificationJob(t)
+		require.NoError(t, recorder.ProcessSequence(ctx, digestSequence(start, submitFailedJob, submitSucceededJob, submitCancelledJob)))
+		require.NoError(t, recorder.ProcessSequence(ctx, digestSequence(
+			start,
+			&armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_JobRunRunning{JobRunRunning: &armadaevents.JobRunRunning{JobId: failedJobId}}},
+		)))
+
+		// A job failing with several errors is counted once.
+		podError := &armadaevents.Error{
+			Terminal: true,
+			Reason: &armadaevents.Error_PodError{PodError: &armadaevents.PodError{
+				Message:          "oom",
+				KubernetesReason: armadaevents.KubernetesReason_OOM,
+			}},
+		}
+		require.NoError(t, recorder.ProcessSequence(ctx, digestSequence(
+			start.Add(30*time.Minute),
+			&armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_JobErrors{JobErrors: &armadaevents.JobErrors{
+				JobId:  failedJobId,
+				Errors: []*armadaevents.Error{podError, podError},
+			}}},
+			&armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_JobSucceeded{JobSucceeded: &armadaevents.JobSucceeded{JobId: succeededJobId}}},
+		)))
+		require.NoError(t, recorder.ProcessSequence(ctx, digestSequence(
+			start.Add(90*time.Minute),
+			&armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_CancelledJob{CancelledJob: &armadaevents.CancelledJob{JobId: cancelledJobId}}},
+		)))
+
+		totals, err := digestRepo.GetDigestTotals("queue", start, start.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, &repository.DigestTotals{
+			JobSets:             1,
+			JobSetsWithFailures: 1,
+			Succeeded:           1,
+			Failed:              1,
+			Cancelled:           1,
+			FailureReasons:      map[string]int64{"OOM": 1},
+			// Only the failed job ran, requesting 1 cpu and 1Gi of memory for 30 minutes.
+			ResourceSeconds: map[string]float64{"cpu": 1800, "memory": 1800 * (1 << 30)},
+		}, totals)
+	})
+}
+
+func TestDigestFailureReason(t *testing.T) {
+	tests := map[string]struct {
+		event    *api.JobFailedEvent
+		expected string
+	}{
+		"cause": {
+			event:    &api.JobFailedEvent{Cause: api.Cause_DeadlineExceeded, Reason: "deadline exceeded"},
+			expected: "DeadlineExceeded",
+		},
+		"container cause": {
+			event:    &api.JobFailedEvent{ContainerStatuses: []*api.ContainerStatus{{ExitCode: 137, Cause: api.Cause_OOM}}},
+			expected: "OOM",
+		},
+		"exit code": {
+			event:    &api.JobFailedEvent{Reason: "pod foo failed", ContainerStatuses: []*api.ContainerStatus{{}, {ExitCode: 2}}},
+			expected: "exit code 2",
+		},
+		"message": {
+			event:    &api.JobFailedEvent{Reason: "preempted"},
+			expected: "preempted",
+		},
+		"no reason": {
+			event:    &api.JobFailedEvent{},
+			expected: "unknown",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, digestFailureReason(tc.event))
+		})
+	}
+}
+
+func TestDigester_SendDueDigests(t *testing.T) {
+	withDigestRepositories(t, func(digestRepo *repository.RedisDigestRepository, notificationRepo *repository.RedisNotificationRepository) {
+		digestWebhook := &api.Webhook{Id: "digest", Queue: "queue", Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_DIGEST}}
+		failedWebhook := &api.Webhook{Id: "failed", Queue: "queue", Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_JOB_FAILED}}
+		idleQueueWebhook := &api.Webhook{Id: "idle", Queue: "idle", Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_DIGEST}}
+		for _, webhook := range []*api.Webhook{digestWebhook, failedWebhook, idleQueueWebhook} {
+			require.NoError(t, notificationRepo.CreateWebhook(webhook))
+		}
+		require.NoError(t, digestRepo.AddToDigest(
+			"queue",
+			time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+			&repository.DigestUpdate{JobSetId: "jobSet", Succeeded: 3, Failed: 1, FailureReasons: map[string]int64{"OOM": 1}},
+		))
+
+		schedule, err := cron.Parse("@daily")
+		require.NoError(t, err)
+		fakeClock := clock.NewFakeClock(time.Date(2023, 1, 1, 23, 59, 0, 0, time.UTC))
+		newDigester := func(dispatcher DigestDispatcher) *Digester {
+			return &Digester{
+				DigestRepository:       digestRepo,
+				NotificationRepository: notificationRepo,
+				Dispatcher:             dispatcher,
+				Schedule:               schedule,
+				Window:                 24 * time.Hour,
+				TopFailureReasons:      5,
+				Clock:                  fakeClock,
+			}
+		}
+		dispatcher := &fakeDigestDispatcher{digests: make(map[string][]*notification.Digest)}
+		digester := newDigester(dispatcher)
+		otherDispatcher := &fakeDigestDispatcher{digests: make(map[string][]*notification.Digest)}
+		otherDigester := newDigester(otherDispatcher)
+		ctx := armadacontext.Background()
+
+		require.NoError(t, digester.SendDueDigests(ctx))
+		require.NoError(t, otherDigester.SendDueDigests(ctx))
+		assert.Empty(t, dispatcher.digests)
+
+		// Digests are sent once, by one of the servers, and only to webhooks registered for digests of active queues.
+		fakeClock.Step(2 * time.Minute)
+		require.NoError(t, digester.SendDueDigests(ctx))
+		require.NoError(t, otherDigester.SendDueDigests(ctx))
+		require.NoError(t, digester.SendDueDigests(ctx))
+		assert.Empty(t, otherDispatcher.digests)
+		require.Len(t, dispatcher.digests, 1)
+		require.Len(t, dispatcher.digests["digest"], 1)
+		digest := dispatcher.digests["digest"][0]
+		assert.Equal(t, "queue", digest.Queue)
+		assert.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), digest.Start)
+		assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), digest.End)
+		assert.Equal(t, int64(3), digest.Succeeded)
+		assert.Equal(t, int64(1), digest.Failed)
+		assert.Equal(t, []*notification.FailureReason{{Reason: "OOM", Count: 1}}, digest.TopFailureReasons)
+	})
+}
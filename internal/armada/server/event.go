@@ -6,7 +6,6 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -14,7 +13,9 @@ import (
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/armada/repository/sequence"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/client/queue"
 )
@@ -139,11 +140,210 @@ func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Eve
 		if err != nil {
 			return errors.Wrapf(err, "Could not convert legacy message id over to new message id for request for queue %s, jobset %s", request.Queue, request.Id)
 		}
-		log.Warnf("Converted legacy sequene id [%s] for queues %s, jobset %s to new sequenceId [%s]", request.Id, request.Queue, request.Id, convertedSeqId)
+		ctx.WithFields(logging.Fields{logging.QueueField: request.Queue, logging.JobSetField: request.Id}).Warnf("Converted legacy sequene id [%s] to new sequenceId [%s]", request.Id, convertedSeqId)
 		request.FromMessageId = convertedSeqId.String()
 	}
 
-	return s.serveEventsFromRepository(request, s.eventRepository, stream)
+	return s.serveEventsFromRepository(stream.Context(), request, s.eventRepository, stream.Send)
+}
+
+// WatchJobSet streams the state transitions of the jobs in a job set, derived from the events of the job set,
+// until the client disconnects. Clients resume watching after disconnecting by providing the sequence of the last
+// transition received, in which case the state of a job may be repeated.
+func (s *EventServer) WatchJobSet(request *api.WatchJobSetRequest, stream api.Event_WatchJobSetServer) error {
+	ctx := armadacontext.FromGrpcCtx(stream.Context())
+	q, err := s.queueRepository.GetQueue(request.Queue)
+	var expected *repository.ErrQueueNotFound
+	if errors.As(err, &expected) {
+		return status.Errorf(codes.NotFound, "[WatchJobSet] Queue %s does not exist", request.Queue)
+	} else if err != nil {
+		return err
+	}
+
+	err = validateUserHasWatchPermissions(ctx, s.permissions, q, request.JobSetId)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "[WatchJobSet] %s", err)
+	}
+
+	if !sequence.IsValid(request.FromSequence) {
+		return status.Errorf(codes.InvalidArgument, "[WatchJobSet] %s is not a valid sequence", request.FromSequence)
+	}
+
+	jobSetRequest := &api.JobSetRequest{
+		Id:            request.JobSetId,
+		Watch:         true,
+		FromMessageId: request.FromSequence,
+		Queue:         request.Queue,
+	}
+	// The last state sent for each job that hasn't finished, such that only changes of state are sent.
+	states := make(map[string]api.JobState)
+	return s.serveEventsFromRepository(stream.Context(), jobSetRequest, s.eventRepository, func(message *api.EventStreamMessage) error {
+		transition, err := jobStateTransition(message)
+		if err != nil {
+			return err
+		}
+		if transition == nil {
+			return nil
+		}
+		if state, ok := states[transition.JobId]; ok && state == transition.State {
+			return nil
+		}
+		if transition.State.IsTerminal() {
+			delete(states, transition.JobId)
+		} else {
+			states[transition.JobId] = transition.State
+		}
+		return stream.Send(transition)
+	})
+}
+
+// jobStateTransition returns the transition of the job an event is for, or nil if the event doesn't change the
+// state of the job.
+func jobStateTransition(message *api.EventStreamMessage) (*api.JobStateTransition, error) {
+	event, err := api.UnwrapEvent(message.Message)
+	if err != nil {
+		return nil, err
+	}
+	var state api.JobState
+	switch event.(type) {
+	case *api.JobQueuedEvent, *api.JobLeaseReturnedEvent, *api.JobLeaseExpiredEvent:
+		state = api.JobState_QUEUED
+	case *api.JobLeasedEvent, *api.JobPendingEvent:
+		state = api.JobState_PENDING
+	case *api.JobRunningEvent:
+		state = api.JobState_RUNNING
+	case *api.JobSucceededEvent:
+		state = api.JobState_SUCCEEDED
+	case *api.JobFailedEvent:
+		state = api.JobState_FAILED
+	case *api.JobCancelledEvent:
+		state = api.JobState_CANCELLED
+	default:
+		return nil, nil
+	}
+	transition := &api.JobStateTransition{
+		JobId:    event.GetJobId(),
+		JobSetId: event.GetJobSetId(),
+		Queue:    event.GetQueue(),
+		State:    state,
+		Created:  event.GetCreated(),
+		Sequence: message.Id,
+	}
+	if clusterEvent, ok := event.(interface{ GetClusterId() string }); ok {
+		transition.ClusterId = clusterEvent.GetClusterId()
+	}
+	switch e := event.(type) {
+	case *api.JobFailedEvent:
+		transition.Reason = e.Reason
+		transition.ContainerStatuses = e.ContainerStatuses
+	case *api.JobCancelledEvent:
+		transition.Reason = e.Reason
+	}
+	return transition, nil
+}
+
+// GetJobRunDetails returns the state of a job and the cluster, namespace and pod number of its latest run,
+// derived from the events of the job set the job belongs to.
+func (s *EventServer) GetJobRunDetails(grpcCtx context.Context, request *api.JobRunDetailsRequest) (*api.JobRunDetails, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	queueName, jobSetId, err := s.resolveQueueAndJobSetForJob(request.JobId)
+	var notFound *armadaerrors.ErrNotFound
+	if errors.As(err, &notFound) {
+		return nil, status.Errorf(codes.NotFound, "[GetJobRunDetails] Job %s does not exist", request.JobId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	q, err := s.queueRepository.GetQueue(queueName)
+	var expected *repository.ErrQueueNotFound
+	if errors.As(err, &expected) {
+		return nil, status.Errorf(codes.NotFound, "[GetJobRunDetails] Queue %s does not exist", queueName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = validateUserHasWatchPermissions(ctx, s.permissions, q, jobSetId)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[GetJobRunDetails] %s", err)
+	}
+
+	details := &api.JobRunDetails{
+		JobId:    request.JobId,
+		JobSetId: jobSetId,
+		Queue:    queueName,
+		State:    api.JobState_UNKNOWN,
+	}
+	jobSetRequest := &api.JobSetRequest{
+		Id:    jobSetId,
+		Queue: queueName,
+	}
+	err = s.serveEventsFromRepository(ctx, jobSetRequest, s.eventRepository, func(message *api.EventStreamMessage) error {
+		return updateJobRunDetails(details, message)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// updateJobRunDetails updates details with message, if it's an event of the job details are for.
+func updateJobRunDetails(details *api.JobRunDetails, message *api.EventStreamMessage) error {
+	event, err := api.UnwrapEvent(message.Message)
+	if err != nil {
+		return err
+	}
+	if event.GetJobId() != details.JobId {
+		return nil
+	}
+	switch e := event.(type) {
+	case *api.JobSubmittedEvent:
+		details.State = api.JobState_QUEUED
+		details.PodNamespace = e.Job.Namespace
+	case *api.JobLeasedEvent:
+		// Pods of the previous run are no longer relevant.
+		details.PodNumber = 0
+	case api.KubernetesEvent:
+		details.PodNumber = e.GetPodNumber()
+		if e.GetPodNamespace() != "" {
+			details.PodNamespace = e.GetPodNamespace()
+		}
+	}
+	transition, err := jobStateTransition(message)
+	if err != nil {
+		return err
+	}
+	if transition != nil {
+		details.State = transition.State
+		if transition.ClusterId != "" {
+			details.ClusterId = transition.ClusterId
+		}
+	}
+	return nil
+}
+
+// resolveQueueAndJobSetForJob returns the queue and job set of a job submitted to either the legacy or the Pulsar
+// scheduler, or an ErrNotFound if there's no such job.
+func (s *EventServer) resolveQueueAndJobSetForJob(jobId string) (string, string, error) {
+	jobs, err := s.jobRepository.GetJobsByIds([]string{jobId})
+	if err != nil {
+		return "", "", err
+	}
+	if len(jobs) > 0 && jobs[0].Error == nil {
+		return jobs[0].Job.GetQueue(), jobs[0].Job.GetJobSetId(), nil
+	}
+
+	jobDetails, err := s.jobRepository.GetPulsarSchedulerJobDetails(jobId)
+	if err != nil {
+		return "", "", err
+	}
+	if jobDetails != nil {
+		return jobDetails.Queue, jobDetails.JobSet, nil
+	}
+
+	return "", "", &armadaerrors.ErrNotFound{
+		Type:  "job",
+		Value: jobId,
+	}
 }
 
 func (s *EventServer) Health(_ context.Context, _ *types.Empty) (*api.HealthCheckResponse, error) {
@@ -163,8 +363,8 @@ func (s *EventServer) Watch(req *api.WatchRequest, stream api.Event_WatchServer)
 	return s.GetJobSetEvents(request, stream)
 }
 
-func (s *EventServer) serveEventsFromRepository(request *api.JobSetRequest, eventRepository repository.EventRepository,
-	stream api.Event_GetJobSetEventsServer,
+func (s *EventServer) serveEventsFromRepository(ctx context.Context, request *api.JobSetRequest, eventRepository repository.EventRepository,
+	send func(*api.EventStreamMessage) error,
 ) error {
 	if request.ErrorIfMissing {
 		exists, err := eventRepository.CheckStreamExists(request.Queue, request.Id)
@@ -192,7 +392,7 @@ func (s *EventServer) serveEventsFromRepository(request *api.JobSetRequest, even
 
 	for {
 		select {
-		case <-stream.Context().Done():
+		case <-ctx.Done():
 			return nil
 		default:
 		}
@@ -213,7 +413,7 @@ func (s *EventServer) serveEventsFromRepository(request *api.JobSetRequest, even
 				if fromId == stopAfter {
 					stop = true
 				}
-				err = stream.Send(msg)
+				err = send(msg)
 				if err != nil {
 					return status.Errorf(codes.Unavailable, "[GetJobSetEvents] error sending event: %s", err)
 				}
@@ -22,6 +22,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/client/queue"
@@ -83,6 +84,200 @@ func TestEventServer_ForceNew(t *testing.T) {
 	)
 }
 
+func TestEventServer_WatchJobSet(t *testing.T) {
+	withEventServer(
+		t,
+		func(s *EventServer) {
+			jobSetId := "set1"
+			jobIdString := "01f3j0g1md4qx7z5qb148qnh4r"
+			baseTime, _ := time.Parse("2006-01-02T15:04:05.000Z", "2022-03-01T15:04:05.000Z")
+			jobIdProto, _ := armadaevents.ProtoUuidFromUlidString(jobIdString)
+			runIdProto := armadaevents.ProtoUuidFromUuid(uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"))
+
+			err := reportPulsarEvent(&armadaevents.EventSequence{
+				JobSetName: jobSetId,
+				Events: []*armadaevents.EventSequence_Event{{
+					Created: &baseTime,
+					Event: &armadaevents.EventSequence_Event_JobRunAssigned{
+						JobRunAssigned: &armadaevents.JobRunAssigned{RunId: runIdProto, JobId: jobIdProto},
+					},
+				}},
+			})
+			require.NoError(t, err)
+			err = reportPulsarEvent(&armadaevents.EventSequence{
+				JobSetName: jobSetId,
+				Events: []*armadaevents.EventSequence_Event{{
+					Created: &baseTime,
+					Event: &armadaevents.EventSequence_Event_CancelledJob{
+						CancelledJob: &armadaevents.CancelledJob{JobId: jobIdProto},
+					},
+				}},
+			})
+			require.NoError(t, err)
+
+			stream := newWatchJobSetStreamMock(2)
+			err = s.WatchJobSet(&api.WatchJobSetRequest{JobSetId: jobSetId}, stream)
+			require.NoError(t, err)
+			require.Len(t, stream.transitions, 2)
+			assert.Equal(t, api.JobState_PENDING, stream.transitions[0].State)
+			assert.Equal(t, api.JobState_CANCELLED, stream.transitions[1].State)
+			assert.Equal(t, jobIdString, stream.transitions[1].JobId)
+
+			// Watching resumes after the sequence provided.
+			resumed := newWatchJobSetStreamMock(1)
+			err = s.WatchJobSet(&api.WatchJobSetRequest{JobSetId: jobSetId, FromSequence: stream.transitions[0].Sequence}, resumed)
+			require.NoError(t, err)
+			require.Len(t, resumed.transitions, 1)
+			assert.Equal(t, stream.transitions[1], resumed.transitions[0])
+		},
+	)
+}
+
+func TestEventServer_WatchJobSet_InvalidSequence(t *testing.T) {
+	withEventServer(
+		t,
+		func(s *EventServer) {
+			err := s.WatchJobSet(&api.WatchJobSetRequest{JobSetId: "set1", FromSequence: "invalid"}, newWatchJobSetStreamMock(1))
+			e, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, e.Code())
+		},
+	)
+}
+
+func TestJobStateTransition(t *testing.T) {
+	created := time.Now()
+	tests := map[string]struct {
+		event    api.Event
+		expected *api.JobStateTransition
+	}{
+		"queued": {
+			event:    &api.JobQueuedEvent{JobId: "job", JobSetId: "set", Queue: "queue", Created: created},
+			expected: &api.JobStateTransition{JobId: "job", JobSetId: "set", Queue: "queue", State: api.JobState_QUEUED, Created: created, Sequence: "seq"},
+		},
+		"lease returned": {
+			event:    &api.JobLeaseReturnedEvent{JobId: "job", ClusterId: "cluster", Created: created},
+			expected: &api.JobStateTransition{JobId: "job", State: api.JobState_QUEUED, Created: created, ClusterId: "cluster", Sequence: "seq"},
+		},
+		"leased": {
+			event:    &api.JobLeasedEvent{JobId: "job", ClusterId: "cluster", Created: created},
+			expected: &api.JobStateTransition{JobId: "job", State: api.JobState_PENDING, Created: created, ClusterId: "cluster", Sequence: "seq"},
+		},
+		"running": {
+			event:    &api.JobRunningEvent{JobId: "job", ClusterId: "cluster", Created: created},
+			expected: &api.JobStateTransition{JobId: "job", State: api.JobState_RUNNING, Created: created, ClusterId: "cluster", Sequence: "seq"},
+		},
+		"failed": {
+			event: &api.JobFailedEvent{
+				JobId:             "job",
+				Created:           created,
+				Reason:            "oom",
+				ContainerStatuses: []*api.ContainerStatus{{Name: "main", ExitCode: 137}},
+			},
+			expected: &api.JobStateTransition{
+				JobId:             "job",
+				State:             api.JobState_FAILED,
+				Created:           created,
+				Sequence:          "seq",
+				Reason:            "oom",
+				ContainerStatuses: []*api.ContainerStatus{{Name: "main", ExitCode: 137}},
+			},
+		},
+		"cancelled": {
+			event:    &api.JobCancelledEvent{JobId: "job", Created: created, Reason: "no longer needed"},
+			expected: &api.JobStateTransition{JobId: "job", State: api.JobState_CANCELLED, Created: created, Sequence: "seq", Reason: "no longer needed"},
+		},
+		"not a transition": {
+			event: &api.JobUtilisationEvent{JobId: "job", Created: created},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			message, err := api.Wrap(tc.event)
+			require.NoError(t, err)
+			transition, err := jobStateTransition(&api.EventStreamMessage{Id: "seq", Message: message})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, transition)
+		})
+	}
+}
+
+func TestEventServer_GetJobRunDetails(t *testing.T) {
+	withEventServer(
+		t,
+		func(s *EventServer) {
+			jobSetId := "set1"
+			jobIdString := "01f3j0g1md4qx7z5qb148qnh4r"
+			baseTime, _ := time.Parse("2006-01-02T15:04:05.000Z", "2022-03-01T15:04:05.000Z")
+			jobIdProto, _ := armadaevents.ProtoUuidFromUlidString(jobIdString)
+			runIdProto := armadaevents.ProtoUuidFromUuid(uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"))
+
+			err := s.jobRepository.StorePulsarSchedulerJobDetails([]*schedulerobjects.PulsarSchedulerJobDetails{
+				{JobId: jobIdString, Queue: "", JobSet: jobSetId},
+			})
+			require.NoError(t, err)
+			err = reportPulsarEvent(&armadaevents.EventSequence{
+				JobSetName: jobSetId,
+				Events: []*armadaevents.EventSequence_Event{{
+					Created: &baseTime,
+					Event: &armadaevents.EventSequence_Event_JobRunRunning{
+						JobRunRunning: &armadaevents.JobRunRunning{
+							RunId: runIdProto,
+							JobId: jobIdProto,
+							ResourceInfos: []*armadaevents.KubernetesResourceInfo{{
+								ObjectMeta: &armadaevents.ObjectMeta{ExecutorId: "cluster", Namespace: "namespace"},
+								Info: &armadaevents.KubernetesResourceInfo_PodInfo{
+									PodInfo: &armadaevents.PodInfo{NodeName: "node", PodNumber: 1},
+								},
+							}},
+						},
+					},
+				}},
+			})
+			require.NoError(t, err)
+
+			details, err := s.GetJobRunDetails(armadacontext.Background(), &api.JobRunDetailsRequest{JobId: jobIdString})
+			require.NoError(t, err)
+			assert.Equal(t, &api.JobRunDetails{
+				JobId:        jobIdString,
+				JobSetId:     jobSetId,
+				State:        api.JobState_RUNNING,
+				ClusterId:    "cluster",
+				PodNamespace: "namespace",
+				PodNumber:    1,
+			}, details)
+
+			_, err = s.GetJobRunDetails(armadacontext.Background(), &api.JobRunDetailsRequest{JobId: "01f3j0g1md4qx7z5qb148qnh4s"})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		},
+	)
+}
+
+func TestUpdateJobRunDetails(t *testing.T) {
+	events := []api.Event{
+		&api.JobSubmittedEvent{JobId: "job", Job: api.Job{Namespace: "namespace"}},
+		&api.JobLeasedEvent{JobId: "job", ClusterId: "cluster-a"},
+		&api.JobRunningEvent{JobId: "job", ClusterId: "cluster-a", PodNumber: 1},
+		&api.JobLeaseReturnedEvent{JobId: "job", ClusterId: "cluster-a", PodNumber: 1},
+		&api.JobLeasedEvent{JobId: "job", ClusterId: "cluster-b"},
+		&api.JobRunningEvent{JobId: "other-job", ClusterId: "cluster-c", PodNumber: 2},
+		&api.JobFailedEvent{JobId: "job", ClusterId: "cluster-b"},
+	}
+	details := &api.JobRunDetails{JobId: "job"}
+	for _, event := range events {
+		message, err := api.Wrap(event)
+		require.NoError(t, err)
+		require.NoError(t, updateJobRunDetails(details, &api.EventStreamMessage{Id: "seq", Message: message}))
+	}
+	assert.Equal(t, &api.JobRunDetails{
+		JobId:        "job",
+		State:        api.JobState_FAILED,
+		ClusterId:    "cluster-b",
+		PodNamespace: "namespace",
+		PodNumber:    0,
+	}, details)
+}
+
 func TestEventServer_GetJobSetEvents_EmptyStreamShouldNotFail(t *testing.T) {
 	withEventServer(
 		t,
@@ -431,3 +626,29 @@ func (s *eventStreamMock) Context() context.Context {
 	}
 	return s.ctx
 }
+
+// watchJobSetStreamMock records the transitions sent, and cancels the context of the stream once n have been sent.
+type watchJobSetStreamMock struct {
+	grpc.ServerStream
+	ctx         context.Context
+	cancel      context.CancelFunc
+	n           int
+	transitions []*api.JobStateTransition
+}
+
+func newWatchJobSetStreamMock(n int) *watchJobSetStreamMock {
+	ctx, cancel := context.WithCancel(armadacontext.Background())
+	return &watchJobSetStreamMock{ctx: ctx, cancel: cancel, n: n}
+}
+
+func (s *watchJobSetStreamMock) Send(transition *api.JobStateTransition) error {
+	s.transitions = append(s.transitions, transition)
+	if len(s.transitions) >= s.n {
+		s.cancel()
+	}
+	return nil
+}
+
+func (s *watchJobSetStreamMock) Context() context.Context {
+	return s.ctx
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/gogo/protobuf/proto"
-	"github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/eventutil"
@@ -25,18 +24,18 @@ type EventsPrinter struct {
 	Topic            string
 	SubscriptionName string
 	// Logger from which the loggers used by this service are derived
-	// (e.g., using srv.Logger.WithField), or nil, in which case the global logrus logger is used.
-	Logger *logrus.Entry
+	// (e.g., using srv.Logger.WithField), or nil, in which case the logger of the context passed to Run is used.
+	Logger logging.Logger
 }
 
 // Run the service that reads from Pulsar and updates Armada until the provided context is cancelled.
 func (srv *EventsPrinter) Run(ctx *armadacontext.Context) error {
-	// Get the configured logger, or the standard logger if none is provided.
-	var log *logrus.Entry
+	// Get the configured logger, or the logger of ctx if none is provided.
+	var log logging.Logger
 	if srv.Logger != nil {
 		log = srv.Logger.WithField("service", "EventsPrinter")
 	} else {
-		log = logrus.StandardLogger().WithField("service", "EventsPrinter")
+		log = ctx.WithField("service", "EventsPrinter")
 	}
 	log.Info("service started")
 
@@ -100,9 +99,9 @@ func (srv *EventsPrinter) Run(ctx *armadacontext.Context) error {
 				break
 			}
 
-			messageLogger := log.WithFields(logrus.Fields{
-				"Queue":               sequence.Queue,
-				"JobSetName":          sequence.JobSetName,
+			messageLogger := log.WithFields(logging.Fields{
+				logging.QueueField:    sequence.Queue,
+				logging.JobSetField:   sequence.JobSetName,
 				"UserId":              sequence.UserId,
 				"Groups":              sequence.Groups,
 				"NumEvents":           len(sequence.Events),
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"crypto/sha1"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// idempotencyStoreKey returns the key under which the response to a request with the provided idempotency key
+// is stored in the deduplication store. Keys are scoped to a queue and the user submitting the request, such that users
+// can't get the responses to each other's requests, and, like client ids, stored as hashes.
+func idempotencyStoreKey(queue string, userId string, idempotencyKey string) string {
+	combined := fmt.Sprintf("%s:idempotency:%q:%s", queue, userId, idempotencyKey)
+	h := sha1.Sum([]byte(combined))
+	return fmt.Sprintf("%x", h)
+}
+
+// getIdempotentResponse returns the response to an earlier request by the same user with the same idempotency key as req,
+// or nil if there's no such request, req has no idempotency key, or srv.KVStore is nil.
+func (srv *PulsarSubmitServer) getIdempotentResponse(ctx *armadacontext.Context, req *api.JobSubmitRequest, userId string) (*api.JobSubmitResponse, error) {
+	if srv.KVStore == nil || req.IdempotencyKey == "" {
+		return nil, nil
+	}
+	key := idempotencyStoreKey(req.Queue, userId, req.IdempotencyKey)
+	kvs, err := srv.KVStore.Load(ctx, []string{key})
+	if err != nil {
+		return nil, err
+	}
+	value, ok := kvs[key]
+	if !ok {
+		return nil, nil
+	}
+	response := &api.JobSubmitResponse{}
+	if err := proto.Unmarshal(value, response); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return response, nil
+}
+
+// storeIdempotentResponse stores the response to req, such that it's returned if req is retried by the same user.
+func (srv *PulsarSubmitServer) storeIdempotentResponse(ctx *armadacontext.Context, req *api.JobSubmitRequest, userId string, response *api.JobSubmitResponse) error {
+	if srv.KVStore == nil || req.IdempotencyKey == "" {
+		return nil
+	}
+	value, err := proto.Marshal(response)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return srv.KVStore.Store(ctx, map[string][]byte{idempotencyStoreKey(req.Queue, userId, req.IdempotencyKey): value})
+}
+
+// addIdempotencyKeyAnnotations records the idempotency key of a request, and the index of each item in the request,
+// on each item, such that jobs submitted more than once can be discarded when read from the log.
+func addIdempotencyKeyAnnotations(items []*api.JobSubmitRequestItem, idempotencyKey string) {
+	if idempotencyKey == "" {
+		return
+	}
+	for i, item := range items {
+		if item.Annotations == nil {
+			item.Annotations = make(map[string]string)
+		}
+		item.Annotations[configuration.IdempotencyKeyAnnotation] = fmt.Sprintf("%s/%d", idempotencyKey, i)
+	}
+}
+
+// deduplicateOnIdempotencyKeys sets the client id of jobs submitted with an idempotency key but without a client id
+// to the owner of the job followed by the key and the index of the job in the request, such that the jobs of a request
+// submitted more than once, e.g., by concurrent retries, are detected as duplicates in the same way as jobs with the
+// same client id, while jobs of different users submitted with the same key aren't.
+func deduplicateOnIdempotencyKeys(jobs []*api.Job) {
+	for _, job := range jobs {
+		if job.ClientId != "" {
+			continue
+		}
+		if key, ok := job.Annotations[configuration.IdempotencyKeyAnnotation]; ok {
+			job.ClientId = fmt.Sprintf("%s/%s", job.Owner, key)
+		}
+	}
+}
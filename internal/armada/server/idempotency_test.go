@@ -0,0 +1,54 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestIdempotencyStoreKey(t *testing.T) {
+	assert.Equal(t, idempotencyStoreKey("queue", "user", "key"), idempotencyStoreKey("queue", "user", "key"))
+	assert.NotEqual(t, idempotencyStoreKey("queue", "user", "key"), idempotencyStoreKey("other", "user", "key"))
+	assert.NotEqual(t, idempotencyStoreKey("queue", "user", "key"), idempotencyStoreKey("queue", "otherUser", "key"))
+	assert.NotEqual(t, idempotencyStoreKey("queue", "user", "key"), idempotencyStoreKey("queue", "user", "other"))
+	// Must not collide with the keys used to deduplicate jobs by client id.
+	assert.NotEqual(t, idempotencyStoreKey("queue", "user", "key"), jobKey(&api.Job{Queue: "queue", ClientId: "key"}))
+}
+
+func TestIdempotentResponse_NoStore(t *testing.T) {
+	srv := &PulsarSubmitServer{}
+	req := &api.JobSubmitRequest{Queue: "queue", IdempotencyKey: "key"}
+	assert.NoError(t, srv.storeIdempotentResponse(armadacontext.Background(), req, "user", &api.JobSubmitResponse{}))
+	response, err := srv.getIdempotentResponse(armadacontext.Background(), req, "user")
+	assert.NoError(t, err)
+	assert.Nil(t, response)
+}
+
+func TestAddIdempotencyKeyAnnotations(t *testing.T) {
+	items := []*api.JobSubmitRequestItem{{}, {Annotations: map[string]string{"foo": "bar"}}}
+	addIdempotencyKeyAnnotations(items, "key")
+	assert.Equal(t, map[string]string{configuration.IdempotencyKeyAnnotation: "key/0"}, items[0].Annotations)
+	assert.Equal(t, map[string]string{"foo": "bar", configuration.IdempotencyKeyAnnotation: "key/1"}, items[1].Annotations)
+
+	items = []*api.JobSubmitRequestItem{{}}
+	addIdempotencyKeyAnnotations(items, "")
+	assert.Nil(t, items[0].Annotations)
+}
+
+func TestDeduplicateOnIdempotencyKeys(t *testing.T) {
+	jobs := []*api.Job{
+		{Owner: "user", Annotations: map[string]string{configuration.IdempotencyKeyAnnotation: "key/0"}},
+		{Owner: "user", ClientId: "clientId", Annotations: map[string]string{configuration.IdempotencyKeyAnnotation: "key/1"}},
+		{Owner: "user"},
+		{Owner: "otherUser", Annotations: map[string]string{configuration.IdempotencyKeyAnnotation: "key/0"}},
+	}
+	deduplicateOnIdempotencyKeys(jobs)
+	assert.Equal(t, "user/key/0", jobs[0].ClientId)
+	assert.Equal(t, "clientId", jobs[1].ClientId)
+	assert.Equal(t, "", jobs[2].ClientId)
+	assert.Equal(t, "otherUser/key/0", jobs[3].ClientId)
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/go-multierror"
 	pool "github.com/jolestar/go-commons-pool"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
@@ -85,6 +84,7 @@ func NewAggregatedQueueServer(
 	pulsarProducer pulsar.Producer,
 	maxPulsarMessageSize uint,
 	executorRepository database.ExecutorRepository,
+	newOwnershipGroupsDecompressor func() compress.Decompressor,
 ) *AggregatedQueueServer {
 	poolConfig := pool.ObjectPoolConfig{
 		MaxTotal:                 100,
@@ -97,9 +97,14 @@ func NewAggregatedQueueServer(
 		NumTestsPerEvictionRun:   10,
 	}
 
+	if newOwnershipGroupsDecompressor == nil {
+		newOwnershipGroupsDecompressor = func() compress.Decompressor {
+			return compress.NewZlibDecompressor()
+		}
+	}
 	decompressorPool := pool.NewObjectPool(context.Background(), pool.NewPooledObjectFactorySimple(
 		func(context.Context) (interface{}, error) {
-			return compress.NewZlibDecompressor(), nil
+			return newOwnershipGroupsDecompressor(), nil
 		}), &poolConfig)
 	return &AggregatedQueueServer{
 		permissions:      permissions,
@@ -128,7 +133,8 @@ func NewAggregatedQueueServer(
 //
 // This function should be used instead of the LeaseJobs function in most cases.
 func (q *AggregatedQueueServer) StreamingLeaseJobs(stream api.AggregatedQueue_StreamingLeaseJobsServer) error {
-	if err := checkPermission(q.permissions, armadacontext.FromGrpcCtx(stream.Context()), permissions.ExecuteJobs); err != nil {
+	ctx := armadacontext.FromGrpcCtx(stream.Context())
+	if err := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); err != nil {
 		return err
 	}
 
@@ -151,12 +157,12 @@ func (q *AggregatedQueueServer) StreamingLeaseJobs(stream api.AggregatedQueue_St
 	}
 
 	// Get jobs to be leased.
-	jobs, err := q.getJobs(armadacontext.FromGrpcCtx(stream.Context()), req)
+	jobs, err := q.getJobs(ctx, req)
 	if err != nil {
 		return err
 	}
 
-	err = q.decompressJobOwnershipGroups(jobs)
+	err = q.decompressJobOwnershipGroups(ctx, jobs)
 	if err != nil {
 		return err
 	}
@@ -210,7 +216,7 @@ func (q *AggregatedQueueServer) StreamingLeaseJobs(stream api.AggregatedQueue_St
 	// Wait for all jobs to have been sent and all acks to have been received.
 	err = g.Wait()
 	if err != nil {
-		log.WithError(err).Error("error sending/receiving job leases to/from executor")
+		ctx.WithError(err).Error("error sending/receiving job leases to/from executor")
 	}
 
 	// Send one more message with the total number of acks.
@@ -220,12 +226,12 @@ func (q *AggregatedQueueServer) StreamingLeaseJobs(stream api.AggregatedQueue_St
 		NumAcked: numAcked,
 	})
 	if err != nil {
-		log.WithError(err).Error("error sending the number of acks")
+		ctx.WithError(err).Error("error sending the number of acks")
 	}
 
 	// Create job leased events and write a leased report into Redis for all acked jobs.
 	ackedJobs := jobs[:numAcked]
-	reportJobsLeased(q.eventStore, ackedJobs, req.ClusterId)
+	reportJobsLeased(ctx, q.eventStore, ackedJobs, req.ClusterId)
 
 	var result *multierror.Error
 	clusterLeasedReport := scheduling.CreateClusterLeasedReport(req.ClusterLeasedReport.ClusterId, &req.ClusterLeasedReport, ackedJobs)
@@ -244,9 +250,14 @@ func (q *AggregatedQueueServer) StreamingLeaseJobs(stream api.AggregatedQueue_St
 
 type SchedulerJobRepositoryAdapter struct {
 	r repository.JobRepository
+	// Queues whose queued jobs aren't scheduled, e.g., since they're draining.
+	unschedulableQueues map[string]bool
 }
 
 func (repo *SchedulerJobRepositoryAdapter) GetQueueJobIds(queue string) ([]string, error) {
+	if repo.unschedulableQueues[queue] {
+		return nil, nil
+	}
 	return repo.r.GetQueueJobIds(queue)
 }
 
@@ -300,9 +311,13 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	}
 	priorityFactorByQueue := make(map[string]float64, len(queues))
 	apiQueues := make([]*api.Queue, len(queues))
+	unschedulableQueues := make(map[string]bool)
 	for i, queue := range queues {
 		priorityFactorByQueue[queue.Name] = float64(queue.PriorityFactor)
 		apiQueues[i] = &api.Queue{Name: queue.Name}
+		if !queue.State.Schedulable() {
+			unschedulableQueues[queue.Name] = true
+		}
 	}
 
 	// Record which queues are active, i.e., have jobs either queued or running.
@@ -349,6 +364,9 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			)
 			continue
 		}
+		if nodedb.IsMarkedForMaintenance(node.Labels, q.schedulingConfig.NodeMaintenanceLabel) {
+			node.Unschedulable = true
+		}
 		nodes[i] = node
 
 		jobIds := make([]string, 0, len(nodeInfo.RunIdsByState))
@@ -373,7 +391,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			}
 		}
 		if len(missingJobIds) > 0 {
-			log.Infof(
+			ctx.Infof(
 				"could not load %d out of %d jobs from Redis on node %s (jobs may have been cancelled or preempted): %v",
 				len(missingJobIds), len(jobIds), nodeInfo.GetName(), missingJobIds,
 			)
@@ -402,6 +420,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		}
 
 		// Bind pods to nodes, thus ensuring resources are marked as allocated on the node.
+		node = schedulerconstraints.OvercommittedNode(req.Pool, node, q.schedulingConfig)
 		if err := nodeDb.CreateAndInsertWithApiJobsWithTxn(txn, jobs, node); err != nil {
 			return nil, err
 		}
@@ -448,7 +467,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 
 	// Aggregate allocation across all clusters.
 	allocatedByQueueAndPriorityClassForPool := q.aggregateAllocationAcrossExecutor(reportsByExecutor, req.Pool)
-	log.Infof("allocated resources per queue for pool %s before scheduling: %v", req.Pool, allocatedByQueueAndPriorityClassForPool)
+	ctx.Infof("allocated resources per queue before scheduling: %v", allocatedByQueueAndPriorityClassForPool)
 
 	// Store executor details in Redis so they can be used by submit checks and the new scheduler.
 	if err := q.executorRepository.StoreExecutor(ctx, &schedulerobjects.Executor{
@@ -459,13 +478,13 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		LastUpdateTime: q.clock.Now(),
 	}); err != nil {
 		// This is not fatal; we can still schedule if it doesn't happen.
-		log.WithError(err).Warnf("could not store executor details for cluster %s", req.ClusterId)
+		ctx.WithError(err).Warn("could not store executor details")
 	}
 
 	// At this point we've written updated usage information to Redis and are ready to start scheduling.
 	// Exit here if scheduling is disabled.
 	if q.schedulingConfig.DisableScheduling {
-		log.Infof("skipping scheduling on %s - scheduling disabled", req.ClusterId)
+		ctx.Info("skipping scheduling - scheduling disabled")
 		return make([]*api.Job, 0), nil
 	}
 
@@ -477,7 +496,8 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	}
 
 	var fairnessCostProvider fairness.FairnessCostProvider
-	totalResources := schedulerobjects.ResourceList{Resources: totalCapacity}
+	nominalResources := schedulerobjects.ResourceList{Resources: totalCapacity}
+	totalResources := schedulerconstraints.OvercommittedResources(req.Pool, nominalResources, q.schedulingConfig)
 	if q.schedulingConfig.FairnessModel == configuration.DominantResourceFairness {
 		fairnessCostProvider, err = fairness.NewDominantResourceFairness(
 			totalResources,
@@ -486,6 +506,11 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		if err != nil {
 			return nil, err
 		}
+	} else if q.schedulingConfig.FairnessModel == configuration.CostFairness {
+		fairnessCostProvider, err = fairness.NewCostFairness(req.Pool, q.schedulingConfig.CostModel)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		fairnessCostProvider, err = fairness.NewAssetFairness(q.schedulingConfig.ResourceScarcity)
 		if err != nil {
@@ -501,6 +526,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		q.limiter,
 		totalResources,
 	)
+	sctx.NominalResources = nominalResources.DeepCopy()
 	for queue, priorityFactor := range priorityFactorByQueue {
 		if !isActiveByQueueName[queue] {
 			// To ensure fair share is computed only from active queues, i.e., queues with jobs queued or running.
@@ -525,7 +551,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	}
 	constraints := schedulerconstraints.SchedulingConstraintsFromSchedulingConfig(
 		req.Pool,
-		schedulerobjects.ResourceList{Resources: totalCapacity},
+		totalResources,
 		schedulerobjects.ResourceList{Resources: req.MinimumJobSize},
 		q.schedulingConfig,
 	)
@@ -536,7 +562,8 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		q.schedulingConfig.Preemption.NodeOversubscriptionEvictionProbability,
 		q.schedulingConfig.Preemption.ProtectedFractionOfFairShare,
 		&SchedulerJobRepositoryAdapter{
-			r: q.jobRepository,
+			r:                   q.jobRepository,
+			unschedulableQueues: unschedulableQueues,
 		},
 		nodeDb,
 		nodeIdByJobId,
@@ -552,9 +579,12 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	if q.schedulingConfig.EnableNewPreemptionStrategy {
 		sch.EnableNewPreemptionStrategy()
 	}
-	log.Infof(
+	if q.schedulingConfig.NodeMaintenanceLabel != "" {
+		sch.EnableNodeDrain(q.schedulingConfig.NodeMaintenanceLabel, q.schedulingConfig.MaxJobsToDrainPerRound)
+	}
+	ctx.Infof(
 		"starting scheduling with total resources %s",
-		schedulerobjects.ResourceList{Resources: totalCapacity}.CompactString(),
+		totalResources.CompactString(),
 	)
 	result, err := sch.Schedule(ctx)
 	if err != nil {
@@ -577,6 +607,19 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		if err != nil {
 			return nil, err
 		}
+		preemption := result.PreemptionByJobId[job.GetId()]
+		var preemptiveJobId *armadaevents.Uuid
+		if preemption.PreemptingJobId != "" {
+			preemptiveJobId, err = armadaevents.ProtoUuidFromUlidString(preemption.PreemptingJobId)
+			if err != nil {
+				return nil, err
+			}
+		}
+		nodeId := result.NodeIdByJobId[job.GetId()]
+		var nodeName string
+		if node, err := nodeDb.GetNode(nodeId); err == nil && node != nil {
+			nodeName = node.Name
+		}
 		created := q.clock.Now()
 		sequences[i] = &armadaevents.EventSequence{
 			Queue:      job.GetQueue(),
@@ -587,8 +630,13 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 					Event: &armadaevents.EventSequence_Event_JobRunPreempted{
 						JobRunPreempted: &armadaevents.JobRunPreempted{
 							// Until the executor supports runs properly, JobId and RunId are the same.
-							PreemptedJobId: jobId,
-							PreemptedRunId: jobId,
+							PreemptedJobId:  jobId,
+							PreemptedRunId:  jobId,
+							PreemptiveJobId: preemptiveJobId,
+							Reason:          preemption.Reason,
+							PreemptiveQueue: preemption.PreemptingQueue,
+							NodeId:          nodeId,
+							NodeName:        nodeName,
 						},
 					},
 				},
@@ -621,7 +669,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		if apiJob, ok := job.(*api.Job); ok {
 			preemptedApiJobsById[job.GetId()] = apiJob
 		} else {
-			log.Errorf("failed to convert job %s to api job", job.GetId())
+			ctx.WithFields(logging.JobFields(job.GetQueue(), job.GetJobSet(), job.GetId())).Error("failed to convert job to api job")
 		}
 	}
 	scheduledApiJobsById := make(map[string]*api.Job)
@@ -629,7 +677,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		if apiJob, ok := job.(*api.Job); ok {
 			scheduledApiJobsById[job.GetId()] = apiJob
 		} else {
-			log.Errorf("failed to convert job %s to api job", job.GetId())
+			ctx.WithFields(logging.JobFields(job.GetQueue(), job.GetJobSet(), job.GetId())).Error("failed to convert job to api job")
 		}
 	}
 
@@ -639,16 +687,16 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	if len(preemptedApiJobsById) > 0 {
 		jobsToDelete := maps.Values(preemptedApiJobsById)
 		jobIdsToDelete := util.Map(jobsToDelete, func(job *api.Job) string { return job.Id })
-		log.Infof("deleting preempted jobs: %v", jobIdsToDelete)
+		ctx.Infof("deleting preempted jobs: %v", jobIdsToDelete)
 		if deletionResult, err := q.jobRepository.DeleteJobs(jobsToDelete); err != nil {
 			logging.WithStacktrace(ctx, err).Error("failed to delete preempted jobs from Redis")
 		} else {
 			deleteErrorByJobId := armadamaps.MapKeys(deletionResult, func(job *api.Job) string { return job.Id })
 			for jobId := range preemptedApiJobsById {
 				if err, ok := deleteErrorByJobId[jobId]; !ok {
-					log.Errorf("deletion result missing for preempted job %s", jobId)
+					ctx.WithField(logging.JobIdField, jobId).Error("deletion result missing for preempted job")
 				} else if err != nil {
-					log.Errorf("failed to delete preempted job %s: %s", jobId, err.Error())
+					ctx.WithField(logging.JobIdField, jobId).WithError(err).Error("failed to delete preempted job")
 				}
 			}
 		}
@@ -672,7 +720,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			if apiJob, ok := scheduledApiJobsById[jobId]; ok {
 				successfullyLeasedApiJobs = append(successfullyLeasedApiJobs, apiJob)
 			} else {
-				log.Errorf("didn't expect job %s to be leased", jobId)
+				ctx.WithField(logging.JobIdField, jobId).Error("didn't expect job to be leased")
 			}
 		}
 	}
@@ -708,7 +756,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	}
 
 	allocatedByQueueAndPriorityClassForPool = q.aggregateAllocationAcrossExecutor(reportsByExecutor, req.Pool)
-	log.Infof("allocated resources per queue for pool %s after scheduling: %v", req.Pool, allocatedByQueueAndPriorityClassForPool)
+	ctx.Infof("allocated resources per queue after scheduling: %v", allocatedByQueueAndPriorityClassForPool)
 
 	// Optionally set node id selectors on scheduled jobs.
 	if q.schedulingConfig.Preemption.SetNodeIdSelector {
@@ -718,12 +766,12 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			}
 			podSpec := apiJob.GetMainPodSpec()
 			if podSpec == nil {
-				log.Warnf("failed to set node id selector on job %s: missing pod spec", apiJob.Id)
+				ctx.WithFields(logging.JobFields(apiJob.Queue, apiJob.JobSetId, apiJob.Id)).Warn("failed to set node id selector: missing pod spec")
 				continue
 			}
 			nodeId := nodeIdByJobId[apiJob.Id]
 			if nodeId == "" {
-				log.Warnf("failed to set node id selector on job %s: no node assigned to job", apiJob.Id)
+				ctx.WithFields(logging.JobFields(apiJob.Queue, apiJob.JobSetId, apiJob.Id)).Warn("failed to set node id selector: no node assigned to job")
 				continue
 			}
 			node, err := nodeDb.GetNode(nodeId)
@@ -733,9 +781,9 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			}
 			v := node.Labels[q.schedulingConfig.Preemption.NodeIdLabel]
 			if v == "" {
-				log.Warnf(
-					"failed to set node id selector on job %s to target node %s (id %s): nodeIdLabel missing from %s",
-					apiJob.Id, node.Name, node.Id, node.Labels,
+				ctx.WithFields(logging.JobFields(apiJob.Queue, apiJob.JobSetId, apiJob.Id)).Warnf(
+					"failed to set node id selector to target node %s (id %s): nodeIdLabel missing from %s",
+					node.Name, node.Id, node.Labels,
 				)
 				continue
 			}
@@ -754,12 +802,12 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			}
 			podSpec := apiJob.GetMainPodSpec()
 			if podSpec == nil {
-				log.Warnf("failed to set node name on job %s: missing pod spec", apiJob.Id)
+				ctx.WithFields(logging.JobFields(apiJob.Queue, apiJob.JobSetId, apiJob.Id)).Warn("failed to set node name: missing pod spec")
 				continue
 			}
 			nodeId := nodeIdByJobId[apiJob.Id]
 			if nodeId == "" {
-				log.Warnf("failed to set node name on job %s: no node assigned to job", apiJob.Id)
+				ctx.WithFields(logging.JobFields(apiJob.Queue, apiJob.JobSetId, apiJob.Id)).Warn("failed to set node name: no node assigned to job")
 				continue
 			}
 			node, err := nodeDb.GetNode(nodeId)
@@ -780,7 +828,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			}
 			podSpec := apiJob.GetMainPodSpec()
 			if podSpec == nil {
-				log.Warnf("failed to set priorityClassName on job %s: missing pod spec", apiJob.Id)
+				ctx.WithFields(logging.JobFields(apiJob.Queue, apiJob.JobSetId, apiJob.Id)).Warn("failed to set priorityClassName: missing pod spec")
 				continue
 			}
 			podSpec.PriorityClassName = priorityClassName
@@ -855,13 +903,13 @@ func (q *AggregatedQueueServer) aggregateAllocationAcrossExecutor(reportsByExecu
 	return allocatedByQueueAndPriorityClass
 }
 
-func (q *AggregatedQueueServer) decompressJobOwnershipGroups(jobs []*api.Job) error {
+func (q *AggregatedQueueServer) decompressJobOwnershipGroups(ctx *armadacontext.Context, jobs []*api.Job) error {
 	for _, j := range jobs {
 		// No need to decompress, if compressed groups not set
 		if len(j.CompressedQueueOwnershipUserGroups) == 0 {
 			continue
 		}
-		groups, err := q.decompressOwnershipGroups(j.CompressedQueueOwnershipUserGroups)
+		groups, err := q.decompressOwnershipGroups(ctx, j.CompressedQueueOwnershipUserGroups)
 		if err != nil {
 			return fmt.Errorf("failed to decompress ownership groups for job %s because %s", j.Id, err)
 		}
@@ -872,18 +920,18 @@ func (q *AggregatedQueueServer) decompressJobOwnershipGroups(jobs []*api.Job) er
 	return nil
 }
 
-func (q *AggregatedQueueServer) decompressOwnershipGroups(compressedOwnershipGroups []byte) ([]string, error) {
+func (q *AggregatedQueueServer) decompressOwnershipGroups(ctx *armadacontext.Context, compressedOwnershipGroups []byte) ([]string, error) {
 	decompressor, err := q.decompressorPool.BorrowObject(armadacontext.Background())
 	if err != nil {
 		return nil, fmt.Errorf("failed to borrow decompressior because %s", err)
 	}
 
-	defer func(decompressorPool *pool.ObjectPool, ctx *armadacontext.Context, object interface{}) {
-		err := decompressorPool.ReturnObject(ctx, object)
+	defer func(decompressorPool *pool.ObjectPool, object interface{}) {
+		err := decompressorPool.ReturnObject(armadacontext.Background(), object)
 		if err != nil {
-			log.WithError(err).Errorf("Error returning decompressorPool to pool")
+			ctx.WithError(err).Errorf("Error returning decompressorPool to pool")
 		}
-	}(q.decompressorPool, armadacontext.Background(), decompressor)
+	}(q.decompressorPool, decompressor)
 
 	return compress.DecompressStringArray(compressedOwnershipGroups, decompressor.(compress.Decompressor))
 }
@@ -934,9 +982,9 @@ func (q *AggregatedQueueServer) ReturnLease(grpcCtx context.Context, request *ap
 	}
 
 	if request.AvoidNodeLabels != nil && len(request.AvoidNodeLabels.Entries) > 0 {
-		err = q.addAvoidNodeAffinity(request.JobId, request.AvoidNodeLabels, authorization.GetPrincipal(ctx).GetName())
+		err = q.addAvoidNodeAffinity(ctx, request.JobId, request.AvoidNodeLabels, authorization.GetPrincipal(ctx).GetName())
 		if err != nil {
-			log.Warnf("Failed to set avoid node affinity for job %s: %v", request.JobId, err)
+			ctx.WithField(logging.JobIdField, request.JobId).WithError(err).Warn("Failed to set avoid node affinity")
 		}
 	}
 	if _, err := q.jobRepository.ReturnLease(request.ClusterId, request.JobId); err != nil {
@@ -952,6 +1000,7 @@ func (q *AggregatedQueueServer) ReturnLease(grpcCtx context.Context, request *ap
 }
 
 func (q *AggregatedQueueServer) addAvoidNodeAffinity(
+	ctx *armadacontext.Context,
 	jobId string,
 	labels *api.OrderedStringMap,
 	principalName string,
@@ -963,11 +1012,11 @@ func (q *AggregatedQueueServer) addAvoidNodeAffinity(
 
 	res, err := q.jobRepository.UpdateJobs([]string{jobId}, func(jobs []*api.Job) {
 		if len(jobs) < 1 {
-			log.Warnf("[AggregatedQueueServer.addAvoidNodeAffinity] job %s not found", jobId)
+			ctx.WithField(logging.JobIdField, jobId).Warn("[AggregatedQueueServer.addAvoidNodeAffinity] job not found")
 			return
 		}
 
-		changed := addAvoidNodeAffinity(jobs[0], labels, func(jobsToValidate []*api.Job) error {
+		changed := addAvoidNodeAffinity(ctx, jobs[0], labels, func(jobsToValidate []*api.Job) error {
 			if ok, err := validateJobsCanBeScheduled(jobsToValidate, allClusterSchedulingInfo); !ok {
 				if err != nil {
 					return errors.WithMessage(err, "can't schedule at least 1 job")
@@ -981,7 +1030,7 @@ func (q *AggregatedQueueServer) addAvoidNodeAffinity(
 		if changed {
 			err := reportJobsUpdated(q.eventStore, principalName, jobs)
 			if err != nil {
-				log.Warnf("[AggregatedQueueServer.addAvoidNodeAffinity] error reporting job updated event for job %s: %s", jobId, err)
+				ctx.WithField(logging.JobIdField, jobId).WithError(err).Warn("[AggregatedQueueServer.addAvoidNodeAffinity] error reporting job updated event")
 			}
 		}
 	})
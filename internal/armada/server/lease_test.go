@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
@@ -209,6 +210,7 @@ func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobReposito
 		nil,
 		0,
 		fakeExecutorRepository{},
+		nil,
 	)
 }
 
@@ -268,6 +270,10 @@ func (repo *mockJobRepository) AddJobs(job []*api.Job) ([]*repository.SubmitJobR
 	return []*repository.SubmitJobResult{}, nil
 }
 
+func (repo *mockJobRepository) AddJobsWithOutboxEvents(job []*api.Job, _ []*repository.OutboxEvent) ([]*repository.SubmitJobResult, error) {
+	return repo.AddJobs(job)
+}
+
 func (repo *mockJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
 	jobs := make([]*api.Job, 0)
 	for _, id := range ids {
@@ -331,7 +337,14 @@ func (repo *mockJobRepository) GetActiveJobIds(queue string, jobSetId string) ([
 }
 
 func (repo *mockJobRepository) GetJobSetJobIds(queue string, jobSetId string, filter *repository.JobSetFilter) ([]string, error) {
-	return []string{}, nil
+	ids := []string{}
+	for id, job := range repo.jobs {
+		if job.Queue == queue && job.JobSetId == jobSetId {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
 }
 
 func (repo *mockJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// validateJobSpecSize returns an error if the serialized spec of the i-th item of a submission exceeds the limit.
+// Array jobs are checked before they're expanded, such that the limit applies to the spec as submitted.
+func validateJobSpecSize(i int, item *api.JobSubmitRequestItem, limits configuration.SubmissionLimits) error {
+	if limits.MaxJobSpecSizeBytes <= 0 {
+		return nil
+	}
+	if size := item.Size(); size > limits.MaxJobSpecSizeBytes {
+		return &armadaerrors.ErrLimitExceeded{
+			Limit:   "MaxJobSpecSizeBytes",
+			Max:     limits.MaxJobSpecSizeBytes,
+			Value:   size,
+			Message: fmt.Sprintf("job %d has a spec of %d bytes", i, size),
+		}
+	}
+	return nil
+}
+
+// validateSubmissionSize returns an error if a submission of numJobs jobs, counting each task of an array job and
+// each pod of a multi-pod job, exceeds the limit.
+func validateSubmissionSize(numJobs int, limits configuration.SubmissionLimits) error {
+	if limits.MaxJobsPerSubmission > 0 && numJobs > limits.MaxJobsPerSubmission {
+		return &armadaerrors.ErrLimitExceeded{
+			Limit:   "MaxJobsPerSubmission",
+			Max:     limits.MaxJobsPerSubmission,
+			Value:   numJobs,
+			Message: "split the jobs over several submissions",
+		}
+	}
+	return nil
+}
+
+// reserveJobSetSize adds numJobs to the number of jobs submitted to a job set, or returns an error if that would
+// exceed the limit. The returned function releases the reservation of n of the jobs, e.g., if submitting them fails.
+func (srv *PulsarSubmitServer) reserveJobSetSize(queue string, jobSetId string, numJobs int, limits configuration.SubmissionLimits) (func(n int) error, error) {
+	noop := func(int) error { return nil }
+	if limits.MaxJobsPerJobSet <= 0 || srv.JobSetSizeRepository == nil || numJobs == 0 {
+		return noop, nil
+	}
+	count, ok, err := srv.JobSetSizeRepository.ReserveJobs(queue, jobSetId, numJobs, limits.MaxJobsPerJobSet)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, &armadaerrors.ErrLimitExceeded{
+			Limit:   "MaxJobsPerJobSet",
+			Max:     limits.MaxJobsPerJobSet,
+			Value:   count,
+			Message: fmt.Sprintf("counting the jobs already submitted to job set %s", jobSetId),
+		}
+	}
+	return func(n int) error {
+		return srv.JobSetSizeRepository.ReleaseJobs(queue, jobSetId, n)
+	}, nil
+}
+
+// jobsPerItem returns the number of jobs an item of a submission is submitted as.
+func jobsPerItem(item *api.JobSubmitRequestItem) int {
+	n := 1
+	if item.ArraySize > 0 {
+		n = int(item.ArraySize)
+	}
+	if isMultiPodJob(item) {
+		n *= len(item.PodSpecs)
+	}
+	return n
+}
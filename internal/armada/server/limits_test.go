@@ -0,0 +1,107 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmissionLimitsForQueue(t *testing.T) {
+	config := configuration.SubmissionLimitsConfig{
+		Default: configuration.SubmissionLimits{MaxJobsPerSubmission: 10, MaxJobsPerJobSet: 100},
+		LimitsByQueue: map[string]configuration.SubmissionLimits{
+			"big": {MaxJobsPerSubmission: 1000},
+		},
+	}
+	assert.Equal(t, config.Default, config.ForQueue("other"))
+	assert.Equal(t, configuration.SubmissionLimits{MaxJobsPerSubmission: 1000}, config.ForQueue("big"))
+}
+
+func TestValidateJobSpecSize(t *testing.T) {
+	item := &api.JobSubmitRequestItem{
+		PodSpecs: []*v1.PodSpec{{Containers: []v1.Container{{Name: "main", Image: "alpine:3.18"}}}},
+	}
+	size := item.Size()
+
+	assert.NoError(t, validateJobSpecSize(0, item, configuration.SubmissionLimits{}))
+	assert.NoError(t, validateJobSpecSize(0, item, configuration.SubmissionLimits{MaxJobSpecSizeBytes: size}))
+
+	err := validateJobSpecSize(3, item, configuration.SubmissionLimits{MaxJobSpecSizeBytes: size - 1})
+	var limitErr *armadaerrors.ErrLimitExceeded
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "MaxJobSpecSizeBytes", limitErr.Limit)
+	assert.Equal(t, size, limitErr.Value)
+	assert.Contains(t, err.Error(), "job 3")
+}
+
+func TestValidateSubmissionSize(t *testing.T) {
+	assert.NoError(t, validateSubmissionSize(1000, configuration.SubmissionLimits{}))
+	assert.NoError(t, validateSubmissionSize(10, configuration.SubmissionLimits{MaxJobsPerSubmission: 10}))
+
+	err := validateSubmissionSize(11, configuration.SubmissionLimits{MaxJobsPerSubmission: 10})
+	var limitErr *armadaerrors.ErrLimitExceeded
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "MaxJobsPerSubmission", limitErr.Limit)
+	assert.Equal(t, 10, limitErr.Max)
+	assert.Equal(t, 11, limitErr.Value)
+}
+
+func TestJobsPerItem(t *testing.T) {
+	podSpecs := []*v1.PodSpec{{}, {}, {}}
+	assert.Equal(t, 1, jobsPerItem(&api.JobSubmitRequestItem{PodSpec: &v1.PodSpec{}}))
+	assert.Equal(t, 5, jobsPerItem(&api.JobSubmitRequestItem{PodSpec: &v1.PodSpec{}, ArraySize: 5}))
+	assert.Equal(t, 3, jobsPerItem(&api.JobSubmitRequestItem{PodSpecs: podSpecs}))
+	assert.Equal(t, 15, jobsPerItem(&api.JobSubmitRequestItem{PodSpecs: podSpecs, ArraySize: 5}))
+}
+
+func TestReserveJobSetSize(t *testing.T) {
+	repo := &fakeJobSetSizeRepository{counts: make(map[string]int)}
+	srv := &PulsarSubmitServer{JobSetSizeRepository: repo}
+	limits := configuration.SubmissionLimits{MaxJobsPerJobSet: 10}
+
+	release, err := srv.reserveJobSetSize("queue", "jobSet", 8, limits)
+	require.NoError(t, err)
+	assert.Equal(t, 8, repo.counts["queue/jobSet"])
+
+	_, err = srv.reserveJobSetSize("queue", "jobSet", 3, limits)
+	var limitErr *armadaerrors.ErrLimitExceeded
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "MaxJobsPerJobSet", limitErr.Limit)
+	assert.Equal(t, 11, limitErr.Value)
+	assert.Equal(t, 8, repo.counts["queue/jobSet"])
+
+	require.NoError(t, release(8))
+	_, err = srv.reserveJobSetSize("queue", "jobSet", 3, limits)
+	require.NoError(t, err)
+	assert.Equal(t, 3, repo.counts["queue/jobSet"])
+
+	// Unlimited job sets aren't counted.
+	_, err = srv.reserveJobSetSize("queue", "other", 3, configuration.SubmissionLimits{})
+	require.NoError(t, err)
+	assert.NotContains(t, repo.counts, "queue/other")
+}
+
+type fakeJobSetSizeRepository struct {
+	counts map[string]int
+}
+
+func (r *fakeJobSetSizeRepository) ReserveJobs(queue string, jobSetId string, n int, max int) (int, bool, error) {
+	key := queue + "/" + jobSetId
+	count := r.counts[key] + n
+	if count > max {
+		return count, false, nil
+	}
+	r.counts[key] = count
+	return count, true, nil
+}
+
+func (r *fakeJobSetSizeRepository) ReleaseJobs(queue string, jobSetId string, n int) error {
+	r.counts[queue+"/"+jobSetId] -= n
+	return nil
+}
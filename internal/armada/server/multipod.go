@@ -0,0 +1,159 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	"golang.org/x/exp/slices"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// multiPodJob records where the pods of a multi-pod job are among the items of an expanded submit request.
+type multiPodJob struct {
+	id string
+	// Index of the first pod among the expanded items.
+	start int
+	size  int
+}
+
+// isMultiPodJob returns true if item is a job made up of several pods, e.g., a driver and its workers.
+func isMultiPodJob(item *api.JobSubmitRequestItem) bool {
+	return item.PodSpec == nil && len(item.PodSpecs) > 1
+}
+
+// expandMultiPodJobs returns a copy of req in which each multi-pod job item is replaced by one item per pod.
+// The second return value has one entry per item of req, which is nil for items that aren't multi-pod jobs.
+//
+// The jobs created for the pods of a multi-pod job make up a gang, such that the scheduler schedules them atomically
+// and accounts for their resources together. Items may depend on an earlier multi-pod job by its client id,
+// in which case they depend on all of its pods.
+func expandMultiPodJobs(req *api.JobSubmitRequest) (*api.JobSubmitRequest, []*multiPodJob, error) {
+	isMultiPod := false
+	for _, item := range req.JobRequestItems {
+		if isMultiPodJob(item) {
+			isMultiPod = true
+			break
+		}
+	}
+	if !isMultiPod {
+		return req, nil, nil
+	}
+
+	items := make([]*api.JobSubmitRequestItem, 0, len(req.JobRequestItems))
+	multiPodJobs := make([]*multiPodJob, len(req.JobRequestItems))
+	podClientIdsByJobClientId := make(map[string][]string)
+	for i, item := range req.JobRequestItems {
+		item.DependsOn = expandArrayDependencies(item.DependsOn, podClientIdsByJobClientId)
+		if !isMultiPodJob(item) {
+			items = append(items, item)
+			continue
+		}
+		if err := validateMultiPodJob(i, item); err != nil {
+			return nil, nil, err
+		}
+		m := &multiPodJob{id: util.NewULID(), start: len(items), size: len(item.PodSpecs)}
+		multiPodJobs[i] = m
+		var podClientIds []string
+		for index := 0; index < m.size; index++ {
+			pod := multiPodJobPod(item, m.id, index)
+			if pod.ClientId != "" {
+				podClientIds = append(podClientIds, pod.ClientId)
+			}
+			items = append(items, pod)
+		}
+		if item.ClientId != "" {
+			podClientIdsByJobClientId[item.ClientId] = podClientIds
+		}
+	}
+
+	expanded := *req
+	expanded.JobRequestItems = items
+	return &expanded, multiPodJobs, nil
+}
+
+// validateMultiPodJob returns an error if the pods of item can't be made into a gang.
+func validateMultiPodJob(i int, item *api.JobSubmitRequestItem) error {
+	for _, annotation := range []string{
+		configuration.GangIdAnnotation,
+		configuration.GangCardinalityAnnotation,
+		configuration.GangMinimumCardinalityAnnotation,
+	} {
+		if _, ok := item.Annotations[annotation]; ok {
+			return &armadaerrors.ErrInvalidArgument{
+				Name:    "Annotations",
+				Value:   item.Annotations,
+				Message: fmt.Sprintf("job %d has multiple pods, which are scheduled as a gang, and hence can't set annotation %s", i, annotation),
+			}
+		}
+	}
+	return nil
+}
+
+// multiPodJobPod returns a copy of the provided multi-pod job item for the pod with the given index.
+func multiPodJobPod(item *api.JobSubmitRequestItem, multiPodJobId string, index int) *api.JobSubmitRequestItem {
+	pod := *item
+	if item.ClientId != "" {
+		pod.ClientId = fmt.Sprintf("%s-%d", item.ClientId, index)
+	}
+	pod.Labels = util.DeepCopy(item.Labels)
+	pod.Annotations = util.DeepCopy(item.Annotations)
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	pod.Annotations[configuration.GangIdAnnotation] = multiPodJobId
+	pod.Annotations[configuration.GangCardinalityAnnotation] = strconv.Itoa(len(item.PodSpecs))
+	pod.Annotations[configuration.MultiPodJobIdAnnotation] = multiPodJobId
+	pod.Annotations[configuration.PodIndexAnnotation] = strconv.Itoa(index)
+	pod.RequiredNodeLabels = util.DeepCopy(item.RequiredNodeLabels)
+	pod.DependsOn = append([]string(nil), item.DependsOn...)
+	pod.PodSpec = nil
+	pod.PodSpecs = []*v1.PodSpec{
+		withEnvVar(item.PodSpecs[index], v1.EnvVar{Name: configuration.PodIndexEnvVar, Value: strconv.Itoa(index)}),
+	}
+	return &pod
+}
+
+// collapseMultiPodJobResponses returns the responses to the items of an expanded submit request,
+// with the responses to the pods of each multi-pod job replaced by a single response for the job.
+func collapseMultiPodJobResponses(responses []*api.JobSubmitResponseItem, multiPodJobs []*multiPodJob) []*api.JobSubmitResponseItem {
+	if multiPodJobs == nil {
+		return responses
+	}
+	collapsed := make([]*api.JobSubmitResponseItem, 0, len(multiPodJobs))
+	next := 0
+	for _, m := range multiPodJobs {
+		if m == nil {
+			collapsed = append(collapsed, responses[next])
+			next++
+			continue
+		}
+		response := &api.JobSubmitResponseItem{
+			JobId:         responses[m.start].JobId,
+			MultiPodJobId: m.id,
+			PodJobIds:     make([]string, m.size),
+		}
+		for i, podResponse := range responses[m.start : m.start+m.size] {
+			response.PodJobIds[i] = podResponse.JobId
+			response.Warnings = appendNewWarnings(response.Warnings, podResponse.Warnings)
+		}
+		collapsed = append(collapsed, response)
+		next = m.start + m.size
+	}
+	return collapsed
+}
+
+// appendNewWarnings appends to warnings those of added not already in it,
+// such that collapsed responses don't repeat the same warning for each job.
+func appendNewWarnings(warnings []string, added []string) []string {
+	for _, warning := range added {
+		if !slices.Contains(warnings, warning) {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
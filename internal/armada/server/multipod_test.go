@@ -0,0 +1,98 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestExpandMultiPodJobs(t *testing.T) {
+	req := &api.JobSubmitRequest{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		JobRequestItems: []*api.JobSubmitRequestItem{
+			{ClientId: "single", PodSpecs: []*v1.PodSpec{{}}},
+			{
+				ClientId:    "multiPod",
+				Annotations: map[string]string{"foo": "bar"},
+				PodSpecs: []*v1.PodSpec{
+					{Containers: []v1.Container{{Name: "driver"}}},
+					{Containers: []v1.Container{{Name: "worker", Env: []v1.EnvVar{{Name: "FOO", Value: "bar"}}}}},
+				},
+			},
+			{ClientId: "after", DependsOn: []string{"multiPod", "single"}},
+		},
+	}
+
+	expanded, multiPodJobs, err := expandMultiPodJobs(req)
+	require.NoError(t, err)
+	assert.Equal(t, "queue", expanded.Queue)
+	assert.Equal(t, "jobSet", expanded.JobSetId)
+	require.Len(t, expanded.JobRequestItems, 4)
+	require.Len(t, multiPodJobs, 3)
+	assert.Nil(t, multiPodJobs[0])
+	assert.Nil(t, multiPodJobs[2])
+	require.NotNil(t, multiPodJobs[1])
+	assert.Equal(t, 1, multiPodJobs[1].start)
+	assert.Equal(t, 2, multiPodJobs[1].size)
+
+	assert.Same(t, req.JobRequestItems[0], expanded.JobRequestItems[0])
+	for i, pod := range expanded.JobRequestItems[1:3] {
+		index := []string{"0", "1"}[i]
+		assert.Equal(t, "multiPod-"+index, pod.ClientId)
+		assert.Equal(t, map[string]string{
+			"foo":                                   "bar",
+			configuration.GangIdAnnotation:          multiPodJobs[1].id,
+			configuration.GangCardinalityAnnotation: "2",
+			configuration.MultiPodJobIdAnnotation:   multiPodJobs[1].id,
+			configuration.PodIndexAnnotation:        index,
+		}, pod.Annotations)
+		require.Len(t, pod.PodSpecs, 1)
+		assert.Equal(t, []string{"driver", "worker"}[i], pod.PodSpecs[0].Containers[0].Name)
+		assert.Contains(t, pod.PodSpecs[0].Containers[0].Env, v1.EnvVar{Name: configuration.PodIndexEnvVar, Value: index})
+	}
+	assert.Equal(t, []string{"multiPod-0", "multiPod-1", "single"}, expanded.JobRequestItems[3].DependsOn)
+
+	// The original multi-pod item is left unchanged.
+	assert.Equal(t, map[string]string{"foo": "bar"}, req.JobRequestItems[1].Annotations)
+	assert.Len(t, req.JobRequestItems[1].PodSpecs[1].Containers[0].Env, 1)
+}
+
+func TestExpandMultiPodJobs_NoMultiPodJobs(t *testing.T) {
+	req := &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{ClientId: "single", PodSpecs: []*v1.PodSpec{{}}}}}
+	expanded, multiPodJobs, err := expandMultiPodJobs(req)
+	require.NoError(t, err)
+	assert.Same(t, req, expanded)
+	assert.Nil(t, multiPodJobs)
+}
+
+func TestExpandMultiPodJobs_Gang(t *testing.T) {
+	req := &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{
+		Annotations: map[string]string{configuration.GangIdAnnotation: "gang"},
+		PodSpecs:    []*v1.PodSpec{{}, {}},
+	}}}
+	_, _, err := expandMultiPodJobs(req)
+	assert.Error(t, err)
+}
+
+func TestCollapseMultiPodJobResponses(t *testing.T) {
+	responses := []*api.JobSubmitResponseItem{
+		{JobId: "a"},
+		{JobId: "b0", Warnings: []string{"x"}},
+		{JobId: "b1", Warnings: []string{"x", "y"}},
+		{JobId: "c"},
+	}
+	collapsed := collapseMultiPodJobResponses(responses, []*multiPodJob{nil, {id: "b", start: 1, size: 2}, nil})
+	assert.Equal(t, []*api.JobSubmitResponseItem{
+		{JobId: "a"},
+		{JobId: "b0", MultiPodJobId: "b", PodJobIds: []string{"b0", "b1"}, Warnings: []string{"x", "y"}},
+		{JobId: "c"},
+	}, collapsed)
+
+	assert.Equal(t, responses, collapseMultiPodJobResponses(responses, nil))
+}
@@ -0,0 +1,308 @@
+package server
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/armada/notification"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/repository/apimessages"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// NotificationServer manages the webhooks queue owners register to be notified of job lifecycle events.
+// Since webhook URLs may contain secrets, webhooks can only be managed and listed by users that may update the queue.
+type NotificationServer struct {
+	api.UnimplementedNotificationsServer
+	NotificationRepository repository.NotificationRepository
+	QueueRepository        repository.QueueRepository
+	Permissions            authorization.PermissionChecker
+	Clock                  clock.Clock
+	// Restricts the hosts webhooks may be registered for.
+	HostPolicy notification.HostPolicy
+}
+
+func (srv *NotificationServer) CreateWebhook(grpcCtx context.Context, req *api.Webhook) (*api.Webhook, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validateWebhook(req, srv.HostPolicy); err != nil {
+		return nil, err
+	}
+	if err := srv.authorize(ctx, req.Queue); err != nil {
+		return nil, err
+	}
+
+	webhook := proto.Clone(req).(*api.Webhook)
+	webhook.Id = util.NewULID()
+	webhook.Owner = authorization.GetPrincipal(ctx).GetName()
+	webhook.Created = srv.Clock.Now().UTC()
+	if err := srv.NotificationRepository.CreateWebhook(webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (srv *NotificationServer) DeleteWebhook(grpcCtx context.Context, req *api.WebhookDeleteRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := srv.authorize(ctx, req.Queue); err != nil {
+		return nil, err
+	}
+	err := srv.NotificationRepository.DeleteWebhook(req.Queue, req.Id)
+	var notFound *repository.ErrWebhookNotFound
+	if errors.As(err, &notFound) {
+		return nil, &armadaerrors.ErrNotFound{Type: "webhook", Value: req.Id, Message: err.Error()}
+	} else if err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (srv *NotificationServer) GetWebhooks(grpcCtx context.Context, req *api.WebhookListRequest) (*api.WebhookList, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if req.Queue == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{Name: "Queue", Value: req.Queue, Message: "queue cannot be empty"}
+	}
+	if err := srv.authorize(ctx, req.Queue); err != nil {
+		return nil, err
+	}
+	webhooks, err := srv.NotificationRepository.GetWebhooks(req.Queue)
+	if err != nil {
+		return nil, err
+	}
+	return &api.WebhookList{Webhooks: webhooks}, nil
+}
+
+// authorize returns an error unless the user owns the named queue or may update it.
+func (srv *NotificationServer) authorize(ctx *armadacontext.Context, queueName string) error {
+	q, err := srv.QueueRepository.GetQueue(queueName)
+	var queueNotFound *repository.ErrQueueNotFound
+	if errors.As(err, &queueNotFound) {
+		return &armadaerrors.ErrNotFound{Type: "queue", Value: queueName, Message: err.Error()}
+	} else if err != nil {
+		return err
+	}
+	if owned, _ := srv.Permissions.UserOwns(ctx, q.ToAPI()); owned {
+		return nil
+	}
+	err = checkQueueAdminPermission(srv.Permissions, ctx, queueName, permissions.CreateQueue)
+	var unauthorized *ErrUnauthorized
+	if errors.As(err, &unauthorized) {
+		return &armadaerrors.ErrUnauthorized{
+			Principal:  authorization.GetPrincipal(ctx).GetName(),
+			Permission: string(permissions.CreateQueue),
+			Action:     "manage webhooks of queue " + queueName,
+			Message:    "user must own the queue or be allowed to update it",
+		}
+	}
+	return err
+}
+
+func validateWebhook(webhook *api.Webhook, hostPolicy notification.HostPolicy) error {
+	if webhook.Queue == "" {
+		return &armadaerrors.ErrInvalidArgument{Name: "Queue", Value: webhook.Queue, Message: "queue cannot be empty"}
+	}
+	if _, ok := api.WebhookKind_name[int32(webhook.Kind)]; !ok {
+		return &armadaerrors.ErrInvalidArgument{Name: "Kind", Value: webhook.Kind, Message: "unknown webhook kind"}
+	}
+	// The URL isn't included in errors, since it may contain secrets.
+	if webhook.Kind == api.WebhookKind_WEBHOOK_EMAIL {
+		if _, err := notification.EmailRecipients(webhook.Url); err != nil {
+			return &armadaerrors.ErrInvalidArgument{Name: "Url", Message: "url must be a mailto url with a comma-separated list of email addresses"}
+		}
+	} else if u, err := url.Parse(webhook.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &armadaerrors.ErrInvalidArgument{Name: "Url", Message: "url must be an absolute http or https url"}
+	} else if !hostPolicy.AllowsHost(u.Hostname()) {
+		return &armadaerrors.ErrInvalidArgument{Name: "Url", Message: "the host of the url isn't allowed"}
+	}
+	if len(webhook.Events) == 0 {
+		return &armadaerrors.ErrInvalidArgument{Name: "Events", Value: webhook.Events, Message: "at least one event must be provided"}
+	}
+	for _, event := range webhook.Events {
+		if _, ok := api.NotificationEvent_name[int32(event)]; !ok {
+			return &armadaerrors.ErrInvalidArgument{Name: "Events", Value: event, Message: "unknown notification event"}
+		}
+		if webhook.Kind == api.WebhookKind_WEBHOOK_EMAIL && event != api.NotificationEvent_NOTIFICATION_DIGEST {
+			return &armadaerrors.ErrInvalidArgument{Name: "Events", Value: event, Message: "only digests can be sent by email"}
+		}
+	}
+	return nil
+}
+
+// NotificationDispatcher delivers notifications to webhooks.
+type NotificationDispatcher interface {
+	Dispatch(webhook *api.Webhook, notification *notification.Notification)
+}
+
+// Notifier is a service that reads job set events from Pulsar and notifies webhooks registered for the queues of the
+// jobs. To notify webhooks when job sets complete, it counts the jobs of each job set that are active, i.e.,
+// submitted but not yet finished; a job set is complete once none of its jobs are active. Since messages are
+// acknowledged once notifications are queued for delivery, notifications may be lost if the server restarts.
+type Notifier struct {
+	NotificationRepository repository.NotificationRepository
+	Dispatcher             NotificationDispatcher
+	Consumer               pulsar.Consumer
+	// How often webhooks are reloaded from the database.
+	WebhookRefreshInterval time.Duration
+	Clock                  clock.Clock
+	// Webhooks by queue, as of webhooksRefreshed.
+	webhooks          map[string][]*api.Webhook
+	webhooksRefreshed time.Time
+}
+
+// Run the service that reads from Pulsar and notifies webhooks until the provided context is cancelled.
+func (srv *Notifier) Run(ctx *armadacontext.Context) error {
+	log := ctx.WithField("service", "Notifier")
+	log.Info("service started")
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("service stopped")
+			return nil
+		default:
+			ctxWithTimeout, cancel := armadacontext.WithTimeout(ctx, 10*time.Second)
+			msg, err := srv.Consumer.Receive(ctxWithTimeout)
+			cancel()
+			if errors.Is(err, context.DeadlineExceeded) {
+				break // expected
+			} else if err != nil {
+				logging.WithStacktrace(log, err).Warnf("Pulsar receive failed; backing off")
+				time.Sleep(100 * time.Millisecond)
+				break
+			}
+
+			ctxWithLogger := armadacontext.WithLogField(ctx, logging.MessageIdField, msg.ID())
+			sequence, err := eventutil.UnmarshalEventSequence(ctxWithLogger, msg.Payload())
+			if err != nil {
+				logging.WithStacktrace(ctxWithLogger, err).Warnf("processing message failed; ignoring")
+			} else if err := srv.ProcessSequence(ctxWithLogger, sequence); err != nil {
+				// Errors are most likely caused by the database being unavailable, so process the message again later.
+				logging.WithStacktrace(ctxWithLogger, err).Warnf("processing message failed; retrying later")
+				srv.Consumer.Nack(msg)
+				break
+			}
+			util.RetryUntilSuccess(
+				ctx,
+				func() error { return srv.Consumer.Ack(msg) },
+				func(err error) {
+					logging.WithStacktrace(log, err).Warnf("acking pulsar message failed")
+					time.Sleep(time.Second)
+				},
+			)
+		}
+	}
+}
+
+// ProcessSequence updates the number of active jobs of the job set of sequence
+// and queues notifications of the events in sequence for delivery to the webhooks registered for them.
+func (srv *Notifier) ProcessSequence(ctx *armadacontext.Context, sequence *armadaevents.EventSequence) error {
+	events, err := apimessages.FromEventSequence(sequence)
+	if err != nil {
+		ctx.WithError(err).Warnf("failed to convert events of job set %s of queue %s; ignoring", sequence.JobSetName, sequence.Queue)
+		return nil
+	}
+
+	var delta repository.JobSetCounts
+	var notifications []*notification.Notification
+	// A job may fail with several errors, but is only counted and notified about once.
+	finished := make(map[string]bool)
+	for _, event := range events {
+		n := &notification.Notification{Queue: sequence.Queue, JobSetId: sequence.JobSetName}
+		var outcomeCount *int64
+		switch e := event.Events.(type) {
+		case *api.EventMessage_Submitted:
+			delta.Active++
+			continue
+		case *api.EventMessage_Succeeded:
+			n.Event, n.JobId, n.Created = api.NotificationEvent_NOTIFICATION_JOB_SUCCEEDED, e.Succeeded.JobId, e.Succeeded.Created
+			outcomeCount = &delta.Succeeded
+		case *api.EventMessage_Failed:
+			n.Event, n.JobId, n.Created = api.NotificationEvent_NOTIFICATION_JOB_FAILED, e.Failed.JobId, e.Failed.Created
+			n.Reason = e.Failed.Reason
+			outcomeCount = &delta.Failed
+		case *api.EventMessage_Cancelled:
+			n.Event, n.JobId, n.Created = api.NotificationEvent_NOTIFICATION_JOB_CANCELLED, e.Cancelled.JobId, e.Cancelled.Created
+			n.Reason = e.Cancelled.Reason
+			outcomeCount = &delta.Cancelled
+		default:
+			continue
+		}
+		if finished[n.JobId] {
+			continue
+		}
+		finished[n.JobId] = true
+		delta.Active--
+		*outcomeCount++
+		notifications = append(notifications, n)
+	}
+	if delta == (repository.JobSetCounts{}) {
+		return nil
+	}
+
+	// Load webhooks before updating counts, such that counts aren't updated twice if loading fails.
+	webhooks, err := srv.getWebhooks(sequence.Queue)
+	if err != nil {
+		return err
+	}
+	counts, err := srv.NotificationRepository.UpdateJobSetCounts(sequence.Queue, sequence.JobSetName, delta)
+	if err != nil {
+		return err
+	}
+	if len(finished) > 0 && counts.Active <= 0 {
+		// Retrying would count the events of sequence again, and counts expire eventually regardless.
+		if err := srv.NotificationRepository.DeleteJobSetCounts(sequence.Queue, sequence.JobSetName); err != nil {
+			ctx.WithError(err).Warnf("failed to delete counts of job set %s of queue %s", sequence.JobSetName, sequence.Queue)
+		}
+		// A negative count means jobs were submitted before they were counted, e.g., since notifications were
+		// enabled while the job set was running, in which case it's unknown whether the job set is complete.
+		if counts.Active == 0 {
+			notifications = append(notifications, &notification.Notification{
+				Event:     api.NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED,
+				Queue:     sequence.Queue,
+				JobSetId:  sequence.JobSetName,
+				Created:   srv.Clock.Now().UTC(),
+				Succeeded: counts.Succeeded,
+				Failed:    counts.Failed,
+				Cancelled: counts.Cancelled,
+			})
+		}
+	}
+	for _, n := range notifications {
+		for _, webhook := range webhooks {
+			if notification.Subscribes(webhook, n) {
+				srv.Dispatcher.Dispatch(webhook, n)
+			}
+		}
+	}
+	return nil
+}
+
+// getWebhooks returns the webhooks of the named queue, reloading webhooks if they were last loaded too long ago.
+func (srv *Notifier) getWebhooks(queue string) ([]*api.Webhook, error) {
+	now := srv.Clock.Now()
+	if srv.webhooks == nil || now.Sub(srv.webhooksRefreshed) >= srv.WebhookRefreshInterval {
+		webhooks, err := srv.NotificationRepository.GetWebhooks("")
+		if err != nil {
+			return nil, err
+		}
+		srv.webhooks = make(map[string][]*api.Webhook)
+		for _, webhook := range webhooks {
+			srv.webhooks[webhook.Queue] = append(srv.webhooks[webhook.Queue], webhook)
+		}
+		srv.webhooksRefreshed = now
+	}
+	return srv.webhooks[queue], nil
+}
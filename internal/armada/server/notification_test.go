@@ -0,0 +1,256 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/armada/notification"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+type fakeNotificationDispatcher struct {
+	notifications map[string][]*notification.Notification
+}
+
+func (d *fakeNotificationDispatcher) Dispatch(webhook *api.Webhook, n *notification.Notification) {
+	d.notifications[webhook.Id] = append(d.notifications[webhook.Id], n)
+}
+
+func withNotificationRepositories(t *testing.T, action func(notificationRepo *repository.RedisNotificationRepository, queueRepo *repository.RedisQueueRepository)) {
+	db, err := miniredis.Run()
+	require.NoError(t, err)
+	defer db.Close()
+	client := redis.NewClient(&redis.Options{Addr: db.Addr()})
+	defer client.Close()
+
+	queueRepo := repository.NewRedisQueueRepository(client)
+	require.NoError(t, queueRepo.CreateQueue(queue.Queue{Name: "queue", PriorityFactor: 1}))
+	action(repository.NewRedisNotificationRepository(client), queueRepo)
+}
+
+func testWebhook() *api.Webhook {
+	return &api.Webhook{
+		Queue:  "queue",
+		Kind:   api.WebhookKind_WEBHOOK_SLACK,
+		Url:    "https://hooks.example.com/services/secret",
+		Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_JOB_FAILED},
+	}
+}
+
+func TestNotificationServer_Webhooks(t *testing.T) {
+	withNotificationRepositories(t, func(notificationRepo *repository.RedisNotificationRepository, queueRepo *repository.RedisQueueRepository) {
+		now := time.Now().UTC()
+		srv := &NotificationServer{
+			NotificationRepository: notificationRepo,
+			QueueRepository:        queueRepo,
+			Permissions:            FakePermissionChecker{},
+			Clock:                  clock.NewFakeClock(now),
+		}
+		ctx := authorization.WithPrincipal(armadacontext.Background(), authorization.NewStaticPrincipal("alice", nil))
+
+		webhook, err := srv.CreateWebhook(ctx, testWebhook())
+		require.NoError(t, err)
+		assert.NotEmpty(t, webhook.Id)
+		assert.Equal(t, "alice", webhook.Owner)
+		assert.Equal(t, now, webhook.Created)
+
+		list, err := srv.GetWebhooks(ctx, &api.WebhookListRequest{Queue: "queue"})
+		require.NoError(t, err)
+		assert.Equal(t, []*api.Webhook{webhook}, list.Webhooks)
+
+		_, err = srv.DeleteWebhook(ctx, &api.WebhookDeleteRequest{Queue: "queue", Id: webhook.Id})
+		require.NoError(t, err)
+		list, err = srv.GetWebhooks(ctx, &api.WebhookListRequest{Queue: "queue"})
+		require.NoError(t, err)
+		assert.Empty(t, list.Webhooks)
+
+		_, err = srv.DeleteWebhook(ctx, &api.WebhookDeleteRequest{Queue: "queue", Id: webhook.Id})
+		var notFound *armadaerrors.ErrNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+func TestNotificationServer_CreateWebhook_Invalid(t *testing.T) {
+	tests := map[string]func(webhook *api.Webhook){
+		"no queue":      func(webhook *api.Webhook) { webhook.Queue = "" },
+		"unknown kind":  func(webhook *api.Webhook) { webhook.Kind = 42 },
+		"relative url":  func(webhook *api.Webhook) { webhook.Url = "/services/secret" },
+		"non-http url":  func(webhook *api.Webhook) { webhook.Url = "file:///etc/passwd" },
+		"no events":     func(webhook *api.Webhook) { webhook.Events = nil },
+		"unknown event": func(webhook *api.Webhook) { webhook.Events = append(webhook.Events, 42) },
+		"empty url":     func(webhook *api.Webhook) { webhook.Url = "" },
+		"email with http url": func(webhook *api.Webhook) {
+			webhook.Kind = api.WebhookKind_WEBHOOK_EMAIL
+			webhook.Events = []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_DIGEST}
+		},
+		"email with invalid address": func(webhook *api.Webhook) {
+			webhook.Kind, webhook.Url = api.WebhookKind_WEBHOOK_EMAIL, "mailto:secret"
+			webhook.Events = []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_DIGEST}
+		},
+		"email with job events": func(webhook *api.Webhook) {
+			webhook.Kind, webhook.Url = api.WebhookKind_WEBHOOK_EMAIL, "mailto:a@example.com"
+		},
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			withNotificationRepositories(t, func(notificationRepo *repository.RedisNotificationRepository, queueRepo *repository.RedisQueueRepository) {
+				srv := &NotificationServer{
+					NotificationRepository: notificationRepo,
+					QueueRepository:        queueRepo,
+					Permissions:            FakePermissionChecker{},
+					Clock:                  clock.RealClock{},
+				}
+				webhook := testWebhook()
+				mutate(webhook)
+				_, err := srv.CreateWebhook(armadacontext.Background(), webhook)
+				var invalidArgument *armadaerrors.ErrInvalidArgument
+				require.ErrorAs(t, err, &invalidArgument)
+				assert.NotContains(t, err.Error(), "secret")
+			})
+		})
+	}
+}
+
+func TestNotificationServer_Unauthorized(t *testing.T) {
+	withNotificationRepositories(t, func(notificationRepo *repository.RedisNotificationRepository, queueRepo *repository.RedisQueueRepository) {
+		srv := &NotificationServer{
+			NotificationRepository: notificationRepo,
+			QueueRepository:        queueRepo,
+			Permissions:            FakeDenyAllPermissionChecker{},
+			Clock:                  clock.RealClock{},
+		}
+		var unauthorized *armadaerrors.ErrUnauthorized
+		_, err := srv.CreateWebhook(armadacontext.Background(), testWebhook())
+		assert.ErrorAs(t, err, &unauthorized)
+		_, err = srv.GetWebhooks(armadacontext.Background(), &api.WebhookListRequest{Queue: "queue"})
+		assert.ErrorAs(t, err, &unauthorized)
+		_, err = srv.DeleteWebhook(armadacontext.Background(), &api.WebhookDeleteRequest{Queue: "queue", Id: "id"})
+		assert.ErrorAs(t, err, &unauthorized)
+
+		var notFound *armadaerrors.ErrNotFound
+		webhook := testWebhook()
+		webhook.Queue = "nonexistent"
+		_, err = srv.CreateWebhook(armadacontext.Background(), webhook)
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+func testNotificationJob(t *testing.T) (*api.Job, *armadaevents.Uuid, *armadaevents.EventSequence_Event) {
+	job := &api.Job{
+		Id:        util.NewULID(),
+		Queue:     "queue",
+		JobSetId:  "jobSet",
+		Namespace: "namespace",
+		Owner:     "owner",
+		PodSpec:   testValidateRequestItem("", "ubuntu").PodSpec,
+	}
+	submitJob, err := eventutil.LogSubmitJobFromApiJob(job)
+	require.NoError(t, err)
+	return job, submitJob.JobId, &armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_SubmitJob{SubmitJob: submitJob}}
+}
+
+func notificationSequence(events ...*armadaevents.EventSequence_Event) *armadaevents.EventSequence {
+	created := time.Now()
+	for _, event := range events {
+		event.Created = &created
+	}
+	return &armadaevents.EventSequence{Queue: "queue", JobSetName: "jobSet", UserId: "owner", Events: events}
+}
+
+func TestNotifier_ProcessSequence(t *testing.T) {
+	withNotificationRepositories(t, func(notificationRepo *repository.RedisNotificationRepository, _ *repository.RedisQueueRepository) {
+		failedWebhook := &api.Webhook{Id: "failed", Queue: "queue", Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_JOB_FAILED}}
+		completedWebhook := &api.Webhook{Id: "completed", Queue: "queue", Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED}}
+		otherQueueWebhook := &api.Webhook{Id: "other", Queue: "other", Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_JOB_FAILED}}
+		for _, webhook := range []*api.Webhook{failedWebhook, completedWebhook, otherQueueWebhook} {
+			require.NoError(t, notificationRepo.CreateWebhook(webhook))
+		}
+		dispatcher := &fakeNotificationDispatcher{notifications: make(map[string][]*notification.Notification)}
+		now := time.Now().UTC()
+		notifier := &Notifier{
+			NotificationRepository: notificationRepo,
+			Dispatcher:             dispatcher,
+			WebhookRefreshInterval: time.Minute,
+			Clock:                  clock.NewFakeClock(now),
+		}
+		ctx := armadacontext.Background()
+
+		failedJob, failedJobId, submitFailedJob := testNotificationJob(t)
+		_, succeededJobId, submitSucceededJob := testNotificationJob(t)
+		require.NoError(t, notifier.ProcessSequence(ctx, notificationSequence(submitFailedJob, submitSucceededJob)))
+		assert.Empty(t, dispatcher.notifications)
+
+		// A job failing with several errors is notified about once.
+		podError := &armadaevents.Error{
+			Terminal: true,
+			Reason: &armadaevents.Error_PodError{PodError: &armadaevents.PodError{
+				Message:         "oom",
+				ContainerErrors: []*armadaevents.ContainerError{{ExitCode: 137}},
+			}},
+		}
+		require.NoError(t, notifier.ProcessSequence(ctx, notificationSequence(
+			&armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_JobErrors{JobErrors: &armadaevents.JobErrors{
+				JobId:  failedJobId,
+				Errors: []*armadaevents.Error{podError, podError},
+			}}},
+		)))
+		require.Len(t, dispatcher.notifications["failed"], 1)
+		assert.Equal(t, api.NotificationEvent_NOTIFICATION_JOB_FAILED, dispatcher.notifications["failed"][0].Event)
+		assert.Equal(t, failedJob.Id, dispatcher.notifications["failed"][0].JobId)
+		assert.Equal(t, "oom", dispatcher.notifications["failed"][0].Reason)
+		assert.Empty(t, dispatcher.notifications["completed"])
+
+		require.NoError(t, notifier.ProcessSequence(ctx, notificationSequence(
+			&armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_JobSucceeded{JobSucceeded: &armadaevents.JobSucceeded{JobId: succeededJobId}}},
+		)))
+		assert.Len(t, dispatcher.notifications["failed"], 1)
+		assert.Empty(t, dispatcher.notifications["other"])
+		assert.Equal(t, []*notification.Notification{{
+			Event:     api.NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED,
+			Queue:     "queue",
+			JobSetId:  "jobSet",
+			Created:   now,
+			Succeeded: 1,
+			Failed:    1,
+		}}, dispatcher.notifications["completed"])
+
+		// Counts are reset once the job set completes.
+		counts, err := notificationRepo.UpdateJobSetCounts("queue", "jobSet", repository.JobSetCounts{})
+		require.NoError(t, err)
+		assert.Equal(t, &repository.JobSetCounts{}, counts)
+	})
+}
+
+func TestNotifier_ProcessSequence_JobsSubmittedBeforeCounting(t *testing.T) {
+	withNotificationRepositories(t, func(notificationRepo *repository.RedisNotificationRepository, _ *repository.RedisQueueRepository) {
+		completedWebhook := &api.Webhook{Id: "completed", Queue: "queue", Events: []api.NotificationEvent{api.NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED}}
+		require.NoError(t, notificationRepo.CreateWebhook(completedWebhook))
+		dispatcher := &fakeNotificationDispatcher{notifications: make(map[string][]*notification.Notification)}
+		notifier := &Notifier{
+			NotificationRepository: notificationRepo,
+			Dispatcher:             dispatcher,
+			Clock:                  clock.RealClock{},
+		}
+
+		// The job was submitted before its job set was counted, so whether the job set is complete is unknown.
+		_, jobId, _ := testNotificationJob(t)
+		require.NoError(t, notifier.ProcessSequence(armadacontext.Background(), notificationSequence(
+			&armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_JobSucceeded{JobSucceeded: &armadaevents.JobSucceeded{JobId: jobId}}},
+		)))
+		assert.Empty(t, dispatcher.notifications)
+	})
+}
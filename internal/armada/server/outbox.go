@@ -0,0 +1,145 @@
+package server
+
+import (
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/schedulers"
+)
+
+// OutboxDispatcher is a service that publishes the events in the outbox to Pulsar and removes them once published.
+//
+// Each entry is published with a sequence id derived from its position in the outbox, and all dispatchers publish
+// using a producer with the same name, such that Pulsar, if deduplication is enabled for the topic, discards entries
+// published again after a crash between publishing and removing them. The outbox is published from in order
+// by one dispatcher at a time, which is guaranteed by a lease in the outbox repository.
+type OutboxDispatcher struct {
+	Outbox repository.OutboxRepository
+	// Creates the producer with which events are published.
+	// Called when the dispatcher acquires the lease; the producer is closed when the dispatcher loses it.
+	NewProducer func() (pulsar.Producer, error)
+	// Identifies this dispatcher when acquiring the lease. Must be unique among dispatchers.
+	Id string
+	// Maximum number of entries published at a time.
+	BatchSize int
+	// Time waited between checking the outbox for new entries.
+	PollInterval time.Duration
+	// Duration of the lease; must be several times PollInterval.
+	LeaseDuration time.Duration
+}
+
+// Run publishes the outbox until ctx is cancelled.
+func (d *OutboxDispatcher) Run(ctx *armadacontext.Context) error {
+	log := ctx.WithField("service", "OutboxDispatcher")
+	log.Info("service started")
+
+	var producer pulsar.Producer
+	closeProducer := func() {
+		if producer != nil {
+			producer.Close()
+			producer = nil
+		}
+	}
+	defer func() {
+		closeProducer()
+		if err := d.Outbox.ReleaseLease(d.Id); err != nil {
+			logging.WithStacktrace(log, err).Warn("releasing outbox lease failed")
+		}
+		log.Info("service stopped")
+	}()
+
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		leader, err := d.Outbox.TryAcquireLease(d.Id, d.LeaseDuration)
+		if err != nil {
+			logging.WithStacktrace(log, err).Warn("acquiring outbox lease failed")
+			continue
+		}
+		if !leader {
+			if producer != nil {
+				log.Info("outbox lease lost")
+				closeProducer()
+			}
+			continue
+		}
+		if producer == nil {
+			if producer, err = d.NewProducer(); err != nil {
+				logging.WithStacktrace(log, err).Warn("creating outbox producer failed")
+				continue
+			}
+			log.Info("outbox lease acquired")
+		}
+
+		// Drain the outbox, while renewing the lease between batches.
+		for {
+			n, err := d.publishBatch(ctx, producer)
+			if err != nil {
+				logging.WithStacktrace(log, err).Warn("publishing outbox events failed; retrying later")
+				break
+			}
+			if n < d.BatchSize || ctx.Err() != nil {
+				break
+			}
+			if leader, err := d.Outbox.TryAcquireLease(d.Id, d.LeaseDuration); err != nil || !leader {
+				break
+			}
+		}
+	}
+}
+
+// publishBatch publishes and removes up to BatchSize entries, returning the number of entries published.
+// Entries are only removed once all entries of the batch have been received by Pulsar.
+func (d *OutboxDispatcher) publishBatch(ctx *armadacontext.Context, producer pulsar.Producer) (int, error) {
+	entries, err := d.Outbox.ReadPending(int64(d.BatchSize))
+	if err != nil || len(entries) == 0 {
+		return 0, err
+	}
+
+	ids := make([]string, len(entries))
+	messages := make([]*pulsar.ProducerMessage, len(entries))
+	for i, entry := range entries {
+		sequenceId, err := entry.SequenceId()
+		if err != nil {
+			return 0, err
+		}
+		ids[i] = entry.Id
+		messages[i] = &pulsar.ProducerMessage{
+			Payload: entry.Payload,
+			Key:     entry.Key,
+			Properties: map[string]string{
+				schedulers.PropertyName: schedulers.MsgPropertyFromScheduler(schedulers.Legacy),
+			},
+			SequenceID: &sequenceId,
+		}
+	}
+
+	// Errors are collected via a buffered channel, since the callback mustn't block.
+	ch := make(chan error, len(messages))
+	for _, message := range messages {
+		producer.SendAsync(ctx, message, func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+			ch <- err
+		})
+	}
+	for range messages {
+		if err := <-ch; err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+
+	if err := d.Outbox.Remove(ids); err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
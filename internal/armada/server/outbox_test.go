@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/mocks"
+)
+
+type fakeOutboxRepository struct {
+	entries []*repository.OutboxEntry
+	owner   string
+}
+
+func (r *fakeOutboxRepository) ReadPending(limit int64) ([]*repository.OutboxEntry, error) {
+	if int64(len(r.entries)) < limit {
+		limit = int64(len(r.entries))
+	}
+	return r.entries[:limit], nil
+}
+
+func (r *fakeOutboxRepository) Remove(ids []string) error {
+	removed := make(map[string]bool)
+	for _, id := range ids {
+		removed[id] = true
+	}
+	var remaining []*repository.OutboxEntry
+	for _, entry := range r.entries {
+		if !removed[entry.Id] {
+			remaining = append(remaining, entry)
+		}
+	}
+	r.entries = remaining
+	return nil
+}
+
+func (r *fakeOutboxRepository) TryAcquireLease(owner string, _ time.Duration) (bool, error) {
+	if r.owner != "" && r.owner != owner {
+		return false, nil
+	}
+	r.owner = owner
+	return true, nil
+}
+
+func (r *fakeOutboxRepository) ReleaseLease(owner string) error {
+	if r.owner == owner {
+		r.owner = ""
+	}
+	return nil
+}
+
+func testOutboxEntries() []*repository.OutboxEntry {
+	return []*repository.OutboxEntry{
+		{Id: "1000-0", OutboxEvent: repository.OutboxEvent{Payload: []byte("a"), Key: "jobSet1"}},
+		{Id: "1000-1", OutboxEvent: repository.OutboxEvent{Payload: []byte("b"), Key: "jobSet2"}},
+		{Id: "1001-0", OutboxEvent: repository.OutboxEvent{Payload: []byte("c"), Key: "jobSet1"}},
+	}
+}
+
+func TestOutboxDispatcher_PublishBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	producer := mocks.NewMockProducer(ctrl)
+	var published []*pulsar.ProducerMessage
+	producer.EXPECT().SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+			published = append(published, msg)
+			callback(nil, msg, nil)
+		},
+	).AnyTimes()
+	outbox := &fakeOutboxRepository{entries: testOutboxEntries()}
+	dispatcher := &OutboxDispatcher{Outbox: outbox, BatchSize: 2}
+
+	n, err := dispatcher.publishBatch(armadacontext.Background(), producer)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	n, err = dispatcher.publishBatch(armadacontext.Background(), producer)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = dispatcher.publishBatch(armadacontext.Background(), producer)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	assert.Empty(t, outbox.entries)
+	require.Len(t, published, 3)
+	for i, entry := range testOutboxEntries() {
+		assert.Equal(t, entry.Payload, published[i].Payload)
+		assert.Equal(t, entry.Key, published[i].Key)
+		sequenceId, err := entry.SequenceId()
+		require.NoError(t, err)
+		assert.Equal(t, sequenceId, *published[i].SequenceID)
+	}
+	assert.Less(t, *published[0].SequenceID, *published[1].SequenceID)
+	assert.Less(t, *published[1].SequenceID, *published[2].SequenceID)
+}
+
+func TestOutboxDispatcher_PublishBatch_KeepsEntriesOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	producer := mocks.NewMockProducer(ctrl)
+	producer.EXPECT().SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+			if string(msg.Payload) == "b" {
+				callback(nil, msg, errors.New("send failed"))
+			} else {
+				callback(nil, msg, nil)
+			}
+		},
+	).AnyTimes()
+	outbox := &fakeOutboxRepository{entries: testOutboxEntries()}
+	dispatcher := &OutboxDispatcher{Outbox: outbox, BatchSize: 10}
+
+	_, err := dispatcher.publishBatch(armadacontext.Background(), producer)
+	assert.Error(t, err)
+	assert.Equal(t, testOutboxEntries(), outbox.entries)
+}
+
+func TestOutboxDispatcher_Run_OnlyLeaderPublishes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	producer := mocks.NewMockProducer(ctrl)
+	producer.EXPECT().SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+			callback(nil, msg, nil)
+		},
+	).AnyTimes()
+	producer.EXPECT().Close().AnyTimes()
+	numProducers := 0
+	outbox := &fakeOutboxRepository{entries: testOutboxEntries(), owner: "other"}
+	dispatcher := &OutboxDispatcher{
+		Outbox: outbox,
+		NewProducer: func() (pulsar.Producer, error) {
+			numProducers++
+			return producer, nil
+		},
+		Id:            "dispatcher",
+		BatchSize:     10,
+		PollInterval:  time.Millisecond,
+		LeaseDuration: time.Second,
+	}
+
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 20*time.Millisecond)
+	require.NoError(t, dispatcher.Run(ctx))
+	cancel()
+	assert.Equal(t, 0, numProducers)
+	assert.Len(t, outbox.entries, 3)
+
+	outbox.owner = ""
+	ctx, cancel = armadacontext.WithTimeout(armadacontext.Background(), 20*time.Millisecond)
+	require.NoError(t, dispatcher.Run(ctx))
+	cancel()
+	assert.Equal(t, 1, numProducers)
+	assert.Empty(t, outbox.entries)
+	// The lease is released once the dispatcher stops.
+	assert.Equal(t, "", outbox.owner)
+}
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// checkQueueAcceptsSubmissions returns an error if the state of q doesn't allow jobs to be submitted to it.
+func checkQueueAcceptsSubmissions(q queue.Queue) error {
+	if q.State.AcceptsSubmissions() {
+		return nil
+	}
+	return errors.WithStack(&armadaerrors.ErrQueueState{
+		Queue:   q.Name,
+		State:   string(q.State),
+		Message: "no new jobs can be submitted to it",
+	})
+}
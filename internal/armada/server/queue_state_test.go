@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestPulsarSubmitServer_AuthorizeWithQueueState(t *testing.T) {
+	srv := &PulsarSubmitServer{
+		Permissions: FakePermissionChecker{},
+		QueueRepository: &staticQueueRepository{queues: []queue.Queue{
+			{Name: "open", State: queue.StateOpen},
+			{Name: "paused", State: queue.StatePaused},
+			{Name: "draining", State: queue.StateDraining},
+			{Name: "closed", State: queue.StateClosed},
+		}},
+	}
+	ctx := armadacontext.FromGrpcCtx(context.Background())
+	authorize := func(queueName string, verb queue.PermissionVerb) error {
+		_, _, err := srv.Authorize(ctx, queueName, permissions.SubmitAnyJobs, verb)
+		return err
+	}
+
+	assert.NoError(t, authorize("open", queue.PermissionVerbSubmit))
+	assert.NoError(t, authorize("draining", queue.PermissionVerbSubmit))
+	for _, queueName := range []string{"paused", "closed"} {
+		err := authorize(queueName, queue.PermissionVerbSubmit)
+		var queueStateErr *armadaerrors.ErrQueueState
+		assert.ErrorAs(t, err, &queueStateErr, queueName)
+		// Jobs already in the queue can still be cancelled.
+		assert.NoError(t, authorize(queueName, queue.PermissionVerbCancel), queueName)
+	}
+}
+
+func TestSchedulerJobRepositoryAdapter_UnschedulableQueues(t *testing.T) {
+	repo := &SchedulerJobRepositoryAdapter{unschedulableQueues: map[string]bool{"draining": true}}
+	jobIds, err := repo.GetQueueJobIds("draining")
+	assert.NoError(t, err)
+	assert.Empty(t, jobIds)
+}
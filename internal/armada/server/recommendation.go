@@ -0,0 +1,267 @@
+package server
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/logging"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// Number of events read at a time when collecting the usage of the jobs of a job set.
+const recommendationEventBatchSize = 500
+
+// jobUsage is the resources requested by a job and the peak usage it reported while running.
+type jobUsage struct {
+	// Name of the job template the job was expanded from, if any.
+	templateName string
+	requests     armadaresource.ComputeResources
+	peak         armadaresource.ComputeResources
+}
+
+// JobSetUsageCache caches the usage of the jobs of recently used job sets, such that recommendations, e.g., those
+// applied at each submission, only read the events published since the previous recommendation for the same job set.
+type JobSetUsageCache struct {
+	// Guards adding job sets to the cache.
+	mu sync.Mutex
+	// *jobSetUsage by queue and job set.
+	jobSets *lru.Cache
+}
+
+// jobSetUsage is the usage of the jobs of a job set, as of the event with id lastEventId.
+type jobSetUsage struct {
+	mu           sync.Mutex
+	lastEventId  string
+	usageByJobId map[string]*jobUsage
+}
+
+// NewJobSetUsageCache returns a cache of the usage of at most maxJobSets job sets; the least recently used are evicted.
+func NewJobSetUsageCache(maxJobSets int) (*JobSetUsageCache, error) {
+	jobSets, err := lru.New(maxJobSets)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &JobSetUsageCache{jobSets: jobSets}, nil
+}
+
+func (c *JobSetUsageCache) get(queue string, jobSetId string) *jobSetUsage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := queue + "/" + jobSetId
+	if usage, ok := c.jobSets.Get(key); ok {
+		return usage.(*jobSetUsage)
+	}
+	usage := &jobSetUsage{usageByJobId: make(map[string]*jobUsage)}
+	c.jobSets.Add(key, usage)
+	return usage
+}
+
+// GetResourceRecommendation recommends resource requests for the jobs of a job set, and optionally of a job template,
+// from the given percentile of the peak usage reported by its past jobs.
+//
+// The usage of jobs is read from the events of their job set, so only jobs whose events are retained are considered.
+func (srv *PulsarSubmitServer) GetResourceRecommendation(grpcCtx context.Context, req *api.ResourceRecommendationRequest) (*api.ResourceRecommendation, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if req.Queue == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "Queue",
+			Value:   req.Queue,
+			Message: "queue cannot be empty",
+		}
+	}
+	if req.JobSetId == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "JobSetId",
+			Value:   req.JobSetId,
+			Message: "job set cannot be empty",
+		}
+	}
+	if req.Percentile < 0 || req.Percentile > 100 {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "Percentile",
+			Value:   req.Percentile,
+			Message: "percentile must be between 0 and 100",
+		}
+	}
+	if _, _, err := srv.Authorize(ctx, req.Queue, permissions.WatchAllEvents, queue.PermissionVerbWatch); err != nil {
+		return nil, err
+	}
+	return srv.getResourceRecommendation(req.Queue, req.JobSetId, req.TemplateName, req.Percentile)
+}
+
+func (srv *PulsarSubmitServer) getResourceRecommendation(queue string, jobSetId string, templateName string, percentile float64) (*api.ResourceRecommendation, error) {
+	if percentile == 0 {
+		percentile = srv.ResourceRecommendations.DefaultPercentile
+	}
+	usage, err := srv.getJobSetUsage(queue, jobSetId, templateName)
+	if err != nil {
+		return nil, err
+	}
+	return recommendResources(usage, percentile, srv.ResourceRecommendations.Headroom, srv.ResourceRecommendations.MinJobs), nil
+}
+
+// getJobSetUsage returns the requests and peak usage of each job of the job set that reported its usage,
+// only considering jobs expanded from the given template if not empty.
+// If srv.JobSetUsageCache is set, only events published since the job set was last read are read.
+func (srv *PulsarSubmitServer) getJobSetUsage(queue string, jobSetId string, templateName string) ([]*jobUsage, error) {
+	jobSet := &jobSetUsage{usageByJobId: make(map[string]*jobUsage)}
+	if srv.JobSetUsageCache != nil {
+		jobSet = srv.JobSetUsageCache.get(queue, jobSetId)
+	}
+	jobSet.mu.Lock()
+	defer jobSet.mu.Unlock()
+	if err := srv.readJobSetUsage(queue, jobSetId, jobSet); err != nil {
+		return nil, err
+	}
+
+	usage := make([]*jobUsage, 0, len(jobSet.usageByJobId))
+	for _, u := range jobSet.usageByJobId {
+		if len(u.peak) > 0 && (templateName == "" || u.templateName == templateName) {
+			usage = append(usage, &jobUsage{templateName: u.templateName, requests: u.requests, peak: u.peak.DeepCopy()})
+		}
+	}
+	return usage, nil
+}
+
+// readJobSetUsage updates jobSet with the events of the job set published after jobSet.lastEventId.
+func (srv *PulsarSubmitServer) readJobSetUsage(queue string, jobSetId string, jobSet *jobSetUsage) error {
+	for {
+		messages, _, err := srv.EventRepository.ReadEvents(queue, jobSetId, jobSet.lastEventId, recommendationEventBatchSize, -1)
+		if err != nil {
+			return err
+		}
+		for _, msg := range messages {
+			event, err := api.UnwrapEvent(msg.Message)
+			if err != nil {
+				return err
+			}
+			switch e := event.(type) {
+			case *api.JobSubmittedEvent:
+				jobSet.usageByJobId[e.JobId] = &jobUsage{
+					templateName: e.Job.Annotations[armadaconfiguration.JobTemplateAnnotation],
+					requests:     armadaresource.FromResourceList(e.Job.GetResourceRequirements().Requests),
+					peak:         armadaresource.ComputeResources{},
+				}
+			case *api.JobUtilisationEvent:
+				if usage, ok := jobSet.usageByJobId[e.JobId]; ok {
+					usage.peak.Max(e.MaxResourcesForPeriod)
+				}
+			}
+			jobSet.lastEventId = msg.Id
+		}
+		if len(messages) < recommendationEventBatchSize {
+			return nil
+		}
+	}
+}
+
+// recommendResources recommends a request for each resource requested by the jobs and for which they reported usage,
+// equal to the given percentile of their peak usage plus headroom. No requests are recommended for fewer than minJobs jobs.
+func recommendResources(usage []*jobUsage, percentile float64, headroom float64, minJobs int) *api.ResourceRecommendation {
+	recommendation := &api.ResourceRecommendation{
+		Requests:        make(map[string]resource.Quantity),
+		CurrentRequests: make(map[string]resource.Quantity),
+		NumJobs:         int32(len(usage)),
+	}
+	samplesByResource := make(map[string][]resource.Quantity)
+	for _, u := range usage {
+		armadaresource.ComputeResources(recommendation.CurrentRequests).Max(u.requests)
+		for name, q := range u.peak {
+			if _, ok := u.requests[name]; ok {
+				samplesByResource[name] = append(samplesByResource[name], q)
+			}
+		}
+	}
+	if len(usage) < minJobs {
+		return recommendation
+	}
+	for name, samples := range samplesByResource {
+		recommendation.Requests[name] = recommendQuantity(samples, percentile, headroom)
+	}
+	return recommendation
+}
+
+// recommendQuantity returns the given percentile of the samples, using the nearest-rank method, plus headroom.
+// The result is rounded up to whole units, unless any of the samples is fractional, e.g., cpu usage in millicores.
+func recommendQuantity(samples []resource.Quantity, percentile float64, headroom float64) resource.Quantity {
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].Cmp(samples[j]) < 0
+	})
+	rank := int(math.Ceil(percentile / 100 * float64(len(samples))))
+	if rank < 1 {
+		rank = 1
+	}
+	sample := samples[rank-1]
+	milliValue := int64(math.Ceil(float64(sample.MilliValue()) * (1 + headroom)))
+
+	fractional := false
+	for _, q := range samples {
+		if q.MilliValue()%1000 != 0 {
+			fractional = true
+			break
+		}
+	}
+	if fractional {
+		return *resource.NewMilliQuantity(milliValue, sample.Format)
+	}
+	return *resource.NewQuantity(int64(math.Ceil(float64(milliValue)/1000)), sample.Format)
+}
+
+// applyResourceRecommendations replaces the requests and limits of each job annotated with
+// ApplyResourceRecommendationAnnotation by those recommended from the past jobs of its job set and job template.
+// Only the requests and limits the job already specifies are replaced, and only jobs with a single container are
+// modified, since usage is reported per pod rather than per container.
+func (srv *PulsarSubmitServer) applyResourceRecommendations(ctx *armadacontext.Context, req *api.JobSubmitRequest) error {
+	if !srv.ResourceRecommendations.ApplyOnSubmit {
+		return nil
+	}
+	recommendationsByTemplate := make(map[string]*api.ResourceRecommendation)
+	for _, item := range req.JobRequestItems {
+		if item.Annotations[armadaconfiguration.ApplyResourceRecommendationAnnotation] != "true" {
+			continue
+		}
+		podSpec := item.GetMainPodSpec()
+		if podSpec == nil || len(podSpec.Containers) != 1 {
+			ctx.WithFields(logging.Fields{logging.QueueField: req.Queue, logging.JobSetField: req.JobSetId}).Warnf("Not applying resource recommendation to job with client id %q: only jobs with a single container are supported", item.ClientId)
+			continue
+		}
+		templateName := item.Annotations[armadaconfiguration.JobTemplateAnnotation]
+		recommendation, ok := recommendationsByTemplate[templateName]
+		if !ok {
+			var err error
+			recommendation, err = srv.getResourceRecommendation(req.Queue, req.JobSetId, templateName, 0)
+			if err != nil {
+				return err
+			}
+			recommendationsByTemplate[templateName] = recommendation
+		}
+		applyResourceRecommendation(&podSpec.Containers[0], recommendation)
+	}
+	return nil
+}
+
+func applyResourceRecommendation(container *v1.Container, recommendation *api.ResourceRecommendation) {
+	for name, q := range recommendation.Requests {
+		resourceName := v1.ResourceName(name)
+		if _, ok := container.Resources.Requests[resourceName]; !ok {
+			continue
+		}
+		container.Resources.Requests[resourceName] = q.DeepCopy()
+		if _, ok := container.Resources.Limits[resourceName]; ok {
+			container.Resources.Limits[resourceName] = q.DeepCopy()
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository/sequence"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestRecommendQuantity(t *testing.T) {
+	tests := map[string]struct {
+		samples    []string
+		percentile float64
+		headroom   float64
+		expected   string
+	}{
+		"p100 is the maximum": {
+			samples:    []string{"1", "3", "2"},
+			percentile: 100,
+			expected:   "3",
+		},
+		"nearest rank": {
+			samples:    []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+			percentile: 95,
+			expected:   "10",
+		},
+		"median": {
+			samples:    []string{"1", "2", "3", "4"},
+			percentile: 50,
+			expected:   "2",
+		},
+		"headroom is rounded up to whole units": {
+			samples:    []string{"1Gi"},
+			percentile: 95,
+			headroom:   0.1,
+			expected:   fmt.Sprint(1181116007),
+		},
+		"fractional samples are rounded to millis": {
+			samples:    []string{"100m", "1"},
+			percentile: 50,
+			headroom:   0.25,
+			expected:   "125m",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			samples := make([]resource.Quantity, len(tc.samples))
+			for i, s := range tc.samples {
+				samples[i] = resource.MustParse(s)
+			}
+			actual := recommendQuantity(samples, tc.percentile, tc.headroom)
+			assert.True(t, resource.MustParse(tc.expected).Equal(actual), "expected %s, got %s", tc.expected, actual.String())
+		})
+	}
+}
+
+func TestPulsarSubmitServer_GetResourceRecommendation(t *testing.T) {
+	events := make([]api.Event, 0)
+	for i := 0; i < 4; i++ {
+		jobId := fmt.Sprintf("job-%d", i)
+		annotations := map[string]string{}
+		if i < 3 {
+			annotations[armadaconfiguration.JobTemplateAnnotation] = "template"
+		}
+		events = append(events,
+			&api.JobSubmittedEvent{JobId: jobId, Job: api.Job{Id: jobId, Annotations: annotations, PodSpec: testPodSpec("4", "8Gi")}},
+			&api.JobUtilisationEvent{JobId: jobId, MaxResourcesForPeriod: map[string]resource.Quantity{
+				"cpu":    resource.MustParse(fmt.Sprintf("%d", i+1)),
+				"memory": resource.MustParse("1Gi"),
+				// Not requested by the job, so no recommendation is made for it.
+				"armadaproject.io/accelerator-duty-cycle": resource.MustParse("0.5"),
+			}},
+		)
+	}
+	// Jobs that haven't reported their usage are ignored.
+	events = append(events, &api.JobSubmittedEvent{JobId: "pending", Job: api.Job{Id: "pending", PodSpec: testPodSpec("16", "8Gi")}})
+
+	srv := &PulsarSubmitServer{
+		EventRepository: &fakeEventRepository{messages: cronJobSetEvents(t, events...)},
+		ResourceRecommendations: armadaconfiguration.ResourceRecommendationsConfig{
+			DefaultPercentile: 100,
+			MinJobs:           3,
+		},
+	}
+
+	recommendation, err := srv.getResourceRecommendation("queue", "jobSet", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), recommendation.NumJobs)
+	assert.Len(t, recommendation.Requests, 2)
+	assert.True(t, resource.MustParse("4").Equal(recommendation.Requests["cpu"]))
+	assert.True(t, resource.MustParse("1Gi").Equal(recommendation.Requests["memory"]))
+	assert.True(t, resource.MustParse("4").Equal(recommendation.CurrentRequests["cpu"]))
+
+	recommendation, err = srv.getResourceRecommendation("queue", "jobSet", "template", 50)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), recommendation.NumJobs)
+	assert.True(t, resource.MustParse("2").Equal(recommendation.Requests["cpu"]))
+
+	srv.ResourceRecommendations.MinJobs = 5
+	recommendation, err = srv.getResourceRecommendation("queue", "jobSet", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), recommendation.NumJobs)
+	assert.Empty(t, recommendation.Requests)
+}
+
+func TestPulsarSubmitServer_GetResourceRecommendation_Cached(t *testing.T) {
+	usageEvents := func(jobId string, cpu string) []api.Event {
+		return []api.Event{
+			&api.JobSubmittedEvent{JobId: jobId, Job: api.Job{Id: jobId, PodSpec: testPodSpec("4", "8Gi")}},
+			&api.JobUtilisationEvent{JobId: jobId, MaxResourcesForPeriod: map[string]resource.Quantity{"cpu": resource.MustParse(cpu)}},
+		}
+	}
+	eventRepository := &countingEventRepository{fakeEventRepository: &fakeEventRepository{
+		messages: cronJobSetEvents(t, append(usageEvents("job-0", "1"), usageEvents("job-1", "2")...)...),
+	}}
+	cache, err := NewJobSetUsageCache(10)
+	require.NoError(t, err)
+	srv := &PulsarSubmitServer{
+		EventRepository:  eventRepository,
+		JobSetUsageCache: cache,
+		ResourceRecommendations: armadaconfiguration.ResourceRecommendationsConfig{
+			DefaultPercentile: 100,
+			MinJobs:           1,
+		},
+	}
+
+	recommendation, err := srv.getResourceRecommendation("queue", "jobSet", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), recommendation.NumJobs)
+	assert.True(t, resource.MustParse("2").Equal(recommendation.Requests["cpu"]))
+	assert.Equal(t, 4, eventRepository.messagesRead)
+
+	// Only events published since the previous recommendation are read.
+	eventRepository.messages = cronJobSetEvents(t, append(append(usageEvents("job-0", "1"), usageEvents("job-1", "2")...), usageEvents("job-2", "3")...)...)
+	recommendation, err = srv.getResourceRecommendation("queue", "jobSet", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), recommendation.NumJobs)
+	assert.True(t, resource.MustParse("3").Equal(recommendation.Requests["cpu"]))
+	assert.Equal(t, 6, eventRepository.messagesRead)
+}
+
+type countingEventRepository struct {
+	*fakeEventRepository
+	messagesRead int
+}
+
+func (r *countingEventRepository) ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error) {
+	messages, seqNo, err := r.fakeEventRepository.ReadEvents(queue, jobSetId, lastId, limit, block)
+	r.messagesRead += len(messages)
+	return messages, seqNo, err
+}
+
+func TestApplyResourceRecommendation(t *testing.T) {
+	podSpec := testPodSpec("4", "8Gi")
+	applyResourceRecommendation(&podSpec.Containers[0], &api.ResourceRecommendation{
+		Requests: map[string]resource.Quantity{
+			"cpu":            resource.MustParse("2"),
+			"nvidia.com/gpu": resource.MustParse("1"),
+		},
+	})
+	container := podSpec.Containers[0]
+	assert.True(t, resource.MustParse("2").Equal(container.Resources.Requests[v1.ResourceCPU]))
+	assert.True(t, resource.MustParse("2").Equal(container.Resources.Limits[v1.ResourceCPU]))
+	assert.True(t, resource.MustParse("8Gi").Equal(container.Resources.Requests[v1.ResourceMemory]))
+	assert.NotContains(t, container.Resources.Requests, v1.ResourceName("nvidia.com/gpu"))
+}
+
+func TestApplyResourceRecommendation_DoesNotAddLimits(t *testing.T) {
+	podSpec := testPodSpec("4", "8Gi")
+	delete(podSpec.Containers[0].Resources.Limits, v1.ResourceMemory)
+	applyResourceRecommendation(&podSpec.Containers[0], &api.ResourceRecommendation{
+		Requests: map[string]resource.Quantity{
+			"cpu":    resource.MustParse("2"),
+			"memory": resource.MustParse("2Gi"),
+		},
+	})
+	container := podSpec.Containers[0]
+	assert.True(t, resource.MustParse("2Gi").Equal(container.Resources.Requests[v1.ResourceMemory]))
+	assert.True(t, resource.MustParse("2").Equal(container.Resources.Limits[v1.ResourceCPU]))
+	assert.NotContains(t, container.Resources.Limits, v1.ResourceMemory)
+}
+
+func testPodSpec(cpu string, memory string) *v1.PodSpec {
+	resources := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse(cpu),
+		v1.ResourceMemory: resource.MustParse(memory),
+	}
+	return &v1.PodSpec{
+		Containers: []v1.Container{{
+			Name:      "main",
+			Image:     "ubuntu",
+			Resources: v1.ResourceRequirements{Requests: resources, Limits: resources.DeepCopy()},
+		}},
+	}
+}
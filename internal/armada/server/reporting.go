@@ -4,10 +4,9 @@ import (
 	"fmt"
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
@@ -35,6 +34,36 @@ func reportQueued(repository repository.EventStore, jobs []*api.Job) error {
 	return nil
 }
 
+// queuedOutboxEvents returns, for each job, the outbox event reporting that job as queued.
+func queuedOutboxEvents(jobs []*api.Job) ([]*repository.OutboxEvent, error) {
+	events := make([]*repository.OutboxEvent, len(jobs))
+	now := time.Now()
+	for i, job := range jobs {
+		event, err := api.Wrap(&api.JobQueuedEvent{
+			JobId:    job.Id,
+			Queue:    job.Queue,
+			JobSetId: job.JobSetId,
+			Created:  now,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[queuedOutboxEvents] error wrapping event: %w", err)
+		}
+		sequences, err := eventutil.EventSequencesFromApiEvents([]*api.EventMessage{event})
+		if err != nil {
+			return nil, fmt.Errorf("[queuedOutboxEvents] error converting event: %w", err)
+		}
+		if len(sequences) != 1 {
+			return nil, fmt.Errorf("[queuedOutboxEvents] expected 1 sequence, but got %d", len(sequences))
+		}
+		payload, err := eventutil.MarshalEventSequence(sequences[0])
+		if err != nil {
+			return nil, fmt.Errorf("[queuedOutboxEvents] error marshalling sequence: %w", err)
+		}
+		events[i] = &repository.OutboxEvent{Payload: payload, Key: sequences[0].JobSetName}
+	}
+	return events, nil
+}
+
 func reportDuplicateDetected(repository repository.EventStore, results []*repository.SubmitJobResult) error {
 	events := []*api.EventMessage{}
 	now := time.Now()
@@ -87,7 +116,7 @@ func reportSubmitted(repository repository.EventStore, jobs []*api.Job) error {
 
 // TODO This function behaves differently from the rest in this file.
 // We should consolidate so that they all behave in the same way.
-func reportJobsLeased(repository repository.EventStore, jobs []*api.Job, clusterId string) {
+func reportJobsLeased(ctx *armadacontext.Context, repository repository.EventStore, jobs []*api.Job, clusterId string) {
 	events := []*api.EventMessage{}
 	now := time.Now()
 	for _, job := range jobs {
@@ -100,7 +129,7 @@ func reportJobsLeased(repository repository.EventStore, jobs []*api.Job, cluster
 		})
 		if err != nil {
 			err = fmt.Errorf("[reportJobsLeased] error wrapping event: %w", err)
-			log.Error(err)
+			ctx.Error(err)
 		} else {
 			events = append(events, event)
 		}
@@ -109,7 +138,7 @@ func reportJobsLeased(repository repository.EventStore, jobs []*api.Job, cluster
 	err := repository.ReportEvents(armadacontext.Background(), events)
 	if err != nil {
 		err = fmt.Errorf("[reportJobsLeased] error reporting events: %w", err)
-		log.Error(err)
+		ctx.Error(err)
 	}
 }
 
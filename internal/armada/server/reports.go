@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// AuthorizingSchedulingReportsServer checks that users may see the scheduling reports they request, i.e., that they
+// may watch the jobs of the queue a report is about, with the same permissions as are required to watch job sets.
+// Reports about all queues, e.g., the pending capacity of all queues requested by node provisioners or the fairness
+// what-if analysis used by admins, require the watch_all_events permission, except for queue utilisation,
+// which is restricted to the queues the user may watch. Node types aren't about any queue, so any user may see them.
+type AuthorizingSchedulingReportsServer struct {
+	Reports schedulerobjects.SchedulerReportingServer
+	// Used to check permissions and to look up the queue of jobs.
+	SubmitServer *PulsarSubmitServer
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetSchedulingReport(grpcCtx context.Context, req *schedulerobjects.SchedulingReportRequest) (*schedulerobjects.SchedulingReport, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	var err error
+	switch filter := req.Filter.(type) {
+	case *schedulerobjects.SchedulingReportRequest_MostRecentForQueue:
+		err = srv.authorizeQueue(ctx, filter.MostRecentForQueue.GetQueueName())
+	case *schedulerobjects.SchedulingReportRequest_MostRecentForJob:
+		err = srv.authorizeJob(ctx, filter.MostRecentForJob.GetJobId())
+	default:
+		err = srv.authorizeAllQueues(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return srv.Reports.GetSchedulingReport(grpcCtx, req)
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetQueueReport(grpcCtx context.Context, req *schedulerobjects.QueueReportRequest) (*schedulerobjects.QueueReport, error) {
+	if err := srv.authorizeQueue(armadacontext.FromGrpcCtx(grpcCtx), req.QueueName); err != nil {
+		return nil, err
+	}
+	return srv.Reports.GetQueueReport(grpcCtx, req)
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetJobReport(grpcCtx context.Context, req *schedulerobjects.JobReportRequest) (*schedulerobjects.JobReport, error) {
+	if err := srv.authorizeJob(armadacontext.FromGrpcCtx(grpcCtx), req.JobId); err != nil {
+		return nil, err
+	}
+	return srv.Reports.GetJobReport(grpcCtx, req)
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetQueueEntitlement(grpcCtx context.Context, req *schedulerobjects.QueueEntitlementRequest) (*schedulerobjects.QueueEntitlementReport, error) {
+	if err := srv.authorizeQueue(armadacontext.FromGrpcCtx(grpcCtx), req.QueueName); err != nil {
+		return nil, err
+	}
+	return srv.Reports.GetQueueEntitlement(grpcCtx, req)
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetDuplicateJobsReport(grpcCtx context.Context, req *schedulerobjects.DuplicateJobsReportRequest) (*schedulerobjects.DuplicateJobsReport, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	var err error
+	if req.QueueName == "" {
+		err = srv.authorizeAllQueues(ctx)
+	} else {
+		err = srv.authorizeQueue(ctx, req.QueueName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return srv.Reports.GetDuplicateJobsReport(grpcCtx, req)
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetSchedulingContextSnapshots(grpcCtx context.Context, req *schedulerobjects.SchedulingContextSnapshotRequest) (*schedulerobjects.SchedulingContextSnapshots, error) {
+	if err := srv.authorizeAllQueues(armadacontext.FromGrpcCtx(grpcCtx)); err != nil {
+		return nil, err
+	}
+	return srv.Reports.GetSchedulingContextSnapshots(grpcCtx, req)
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetQueueUtilisation(grpcCtx context.Context, req *schedulerobjects.QueueUtilisationRequest) (*schedulerobjects.QueueUtilisationReport, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	report, err := srv.Reports.GetQueueUtilisation(grpcCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	if srv.SubmitServer.Permissions.UserHasPermission(ctx, permissions.WatchAllEvents) {
+		return report, nil
+	}
+
+	queues, err := srv.SubmitServer.QueueRepository.GetAllQueues()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetQueueUtilisation] error getting queues: %s", err)
+	}
+	visible := make(map[string]bool, len(queues))
+	for _, q := range queues {
+		err := checkQueuePermission(srv.SubmitServer.Permissions, ctx, q, permissions.WatchEvents, queue.PermissionVerbWatch)
+		var permErr *ErrUnauthorized
+		if err == nil {
+			visible[q.Name] = true
+		} else if !errors.As(err, &permErr) {
+			return nil, status.Errorf(codes.Unavailable, "[GetQueueUtilisation] error checking permissions: %s", err)
+		}
+	}
+	filtered := &schedulerobjects.QueueUtilisationReport{}
+	for _, utilisation := range report.Queues {
+		if visible[utilisation.QueueName] {
+			filtered.Queues = append(filtered.Queues, utilisation)
+		}
+	}
+	return filtered, nil
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetPendingCapacity(grpcCtx context.Context, req *schedulerobjects.PendingCapacityRequest) (*schedulerobjects.PendingCapacityReport, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	var err error
+	if req.QueueName == "" {
+		err = srv.authorizeAllQueues(ctx)
+	} else {
+		err = srv.authorizeQueue(ctx, req.QueueName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return srv.Reports.GetPendingCapacity(grpcCtx, req)
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetFairnessWhatIf(grpcCtx context.Context, req *schedulerobjects.FairnessWhatIfRequest) (*schedulerobjects.FairnessWhatIfReport, error) {
+	if err := srv.authorizeAllQueues(armadacontext.FromGrpcCtx(grpcCtx)); err != nil {
+		return nil, err
+	}
+	return srv.Reports.GetFairnessWhatIf(grpcCtx, req)
+}
+
+func (srv *AuthorizingSchedulingReportsServer) GetNodeTypes(grpcCtx context.Context, req *schedulerobjects.NodeTypesRequest) (*schedulerobjects.NodeTypesReport, error) {
+	return srv.Reports.GetNodeTypes(grpcCtx, req)
+}
+
+// authorizeQueue returns an error if the user may not watch the jobs of the named queue.
+func (srv *AuthorizingSchedulingReportsServer) authorizeQueue(ctx *armadacontext.Context, queueName string) error {
+	if srv.SubmitServer.Permissions.UserHasPermission(ctx, permissions.WatchAllEvents) {
+		return nil
+	}
+	q, err := srv.SubmitServer.QueueRepository.GetQueue(queueName)
+	var notFoundErr *repository.ErrQueueNotFound
+	if errors.As(err, &notFoundErr) {
+		return status.Errorf(codes.NotFound, "[GetSchedulingReport] queue %s does not exist", queueName)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[GetSchedulingReport] error getting queue %s: %s", queueName, err)
+	}
+	err = checkQueuePermission(srv.SubmitServer.Permissions, ctx, q, permissions.WatchEvents, queue.PermissionVerbWatch)
+	var permErr *ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return status.Errorf(codes.PermissionDenied, "[GetSchedulingReport] error getting scheduling report for queue %s: %s", queueName, permErr)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[GetSchedulingReport] error checking permissions: %s", err)
+	}
+	return nil
+}
+
+// authorizeJob returns an error if the user may not watch the jobs of the queue of the job with the provided id.
+func (srv *AuthorizingSchedulingReportsServer) authorizeJob(ctx *armadacontext.Context, jobId string) error {
+	if srv.SubmitServer.Permissions.UserHasPermission(ctx, permissions.WatchAllEvents) {
+		return nil
+	}
+	queueName, _, err := srv.SubmitServer.resolveQueueAndJobsetForJob(jobId)
+	if err != nil {
+		return err
+	}
+	return srv.authorizeQueue(ctx, queueName)
+}
+
+// authorizeAllQueues returns an error if the user may not watch the jobs of every queue.
+func (srv *AuthorizingSchedulingReportsServer) authorizeAllQueues(ctx *armadacontext.Context) error {
+	err := checkPermission(srv.SubmitServer.Permissions, ctx, permissions.WatchAllEvents)
+	var permErr *ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return status.Errorf(codes.PermissionDenied, "[GetSchedulingReport] error getting scheduling report for all queues: %s", permErr)
+	}
+	return err
+}
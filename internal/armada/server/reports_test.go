@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	authconfig "github.com/armadaproject/armada/internal/common/auth/configuration"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+type staticQueueRepository struct {
+	fakeQueueRepository
+	queues []queue.Queue
+}
+
+func (repo *staticQueueRepository) GetAllQueues() ([]queue.Queue, error) {
+	return repo.queues, nil
+}
+
+func (repo *staticQueueRepository) GetQueue(name string) (queue.Queue, error) {
+	for _, q := range repo.queues {
+		if q.Name == name {
+			return q, nil
+		}
+	}
+	return queue.Queue{Name: name}, nil
+}
+
+// fakeReportsServer implements only the methods used by the tests.
+type fakeReportsServer struct {
+	schedulerobjects.SchedulerReportingServer
+}
+
+func (s *fakeReportsServer) GetQueueReport(_ context.Context, req *schedulerobjects.QueueReportRequest) (*schedulerobjects.QueueReport, error) {
+	return &schedulerobjects.QueueReport{Report: req.QueueName}, nil
+}
+
+func (s *fakeReportsServer) GetSchedulingContextSnapshots(context.Context, *schedulerobjects.SchedulingContextSnapshotRequest) (*schedulerobjects.SchedulingContextSnapshots, error) {
+	return &schedulerobjects.SchedulingContextSnapshots{}, nil
+}
+
+func (s *fakeReportsServer) GetQueueUtilisation(context.Context, *schedulerobjects.QueueUtilisationRequest) (*schedulerobjects.QueueUtilisationReport, error) {
+	return &schedulerobjects.QueueUtilisationReport{Queues: []*schedulerobjects.QueueUtilisation{
+		{QueueName: "queue-a"}, {QueueName: "queue-b"}, {QueueName: "queue-c"},
+	}}, nil
+}
+
+func (s *fakeReportsServer) GetPendingCapacity(context.Context, *schedulerobjects.PendingCapacityRequest) (*schedulerobjects.PendingCapacityReport, error) {
+	return &schedulerobjects.PendingCapacityReport{}, nil
+}
+
+func (s *fakeReportsServer) GetFairnessWhatIf(context.Context, *schedulerobjects.FairnessWhatIfRequest) (*schedulerobjects.FairnessWhatIfReport, error) {
+	return &schedulerobjects.FairnessWhatIfReport{}, nil
+}
+
+func (s *fakeReportsServer) GetNodeTypes(context.Context, *schedulerobjects.NodeTypesRequest) (*schedulerobjects.NodeTypesReport, error) {
+	return &schedulerobjects.NodeTypesReport{}, nil
+}
+
+func newRoleBindingTestServer(t *testing.T) *PulsarSubmitServer {
+	checker, err := authorization.NewPrincipalPermissionCheckerFromConfig(authconfig.AuthConfig{
+		PermissionGroupMapping: map[permission.Permission][]string{
+			permissions.WatchAllEvents: {"admins"},
+			permissions.WatchEvents:    {"owners"},
+		},
+		QueueRoleBindings: []authconfig.QueueRoleBinding{
+			{Role: "viewer", Queues: []string{"queue-a"}, Groups: []string{"team"}},
+			{Role: "submitter", Queues: []string{"queue-b"}, Users: []string{"alice"}},
+		},
+	})
+	require.NoError(t, err)
+	return &PulsarSubmitServer{
+		Permissions: checker,
+		QueueRepository: &staticQueueRepository{queues: []queue.Queue{
+			{Name: "queue-a"},
+			{Name: "queue-b"},
+			{Name: "queue-c", Permissions: []queue.Permissions{queue.NewPermissionsFromOwners(nil, []string{"owners"})}},
+		}},
+	}
+}
+
+func withTestPrincipal(name string, groups ...string) context.Context {
+	return authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal(name, groups))
+}
+
+func TestPulsarSubmitServer_AuthorizeWithQueueRoles(t *testing.T) {
+	srv := newRoleBindingTestServer(t)
+	authorize := func(ctx context.Context, queueName string, verb queue.PermissionVerb) error {
+		_, _, err := srv.Authorize(armadacontext.FromGrpcCtx(ctx), queueName, permissions.SubmitAnyJobs, verb)
+		return err
+	}
+
+	alice := withTestPrincipal("alice", "team")
+	assert.NoError(t, authorize(alice, "queue-a", queue.PermissionVerbWatch))
+	assert.Error(t, authorize(alice, "queue-a", queue.PermissionVerbSubmit))
+	assert.NoError(t, authorize(alice, "queue-b", queue.PermissionVerbSubmit))
+	assert.NoError(t, authorize(alice, "queue-b", queue.PermissionVerbCancel))
+	assert.Error(t, authorize(alice, "queue-c", queue.PermissionVerbSubmit))
+}
+
+func TestAuthorizingSchedulingReportsServer(t *testing.T) {
+	srv := &AuthorizingSchedulingReportsServer{
+		Reports:      &fakeReportsServer{},
+		SubmitServer: newRoleBindingTestServer(t),
+	}
+	team := withTestPrincipal("bob", "team")
+	owner := withTestPrincipal("carol", "owners")
+	admin := withTestPrincipal("dave", "admins")
+
+	_, err := srv.GetQueueReport(team, &schedulerobjects.QueueReportRequest{QueueName: "queue-a"})
+	assert.NoError(t, err)
+	_, err = srv.GetQueueReport(team, &schedulerobjects.QueueReportRequest{QueueName: "queue-b"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.GetQueueReport(owner, &schedulerobjects.QueueReportRequest{QueueName: "queue-c"})
+	assert.NoError(t, err)
+
+	_, err = srv.GetSchedulingContextSnapshots(team, &schedulerobjects.SchedulingContextSnapshotRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.GetSchedulingContextSnapshots(admin, &schedulerobjects.SchedulingContextSnapshotRequest{})
+	assert.NoError(t, err)
+
+	_, err = srv.GetPendingCapacity(team, &schedulerobjects.PendingCapacityRequest{QueueName: "queue-a"})
+	assert.NoError(t, err)
+	_, err = srv.GetPendingCapacity(team, &schedulerobjects.PendingCapacityRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.GetPendingCapacity(admin, &schedulerobjects.PendingCapacityRequest{})
+	assert.NoError(t, err)
+
+	_, err = srv.GetFairnessWhatIf(team, &schedulerobjects.FairnessWhatIfRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.GetFairnessWhatIf(admin, &schedulerobjects.FairnessWhatIfRequest{})
+	assert.NoError(t, err)
+
+	_, err = srv.GetNodeTypes(team, &schedulerobjects.NodeTypesRequest{})
+	assert.NoError(t, err)
+
+	queueNames := func(report *schedulerobjects.QueueUtilisationReport) []string {
+		var names []string
+		for _, utilisation := range report.Queues {
+			names = append(names, utilisation.QueueName)
+		}
+		return names
+	}
+	report, err := srv.GetQueueUtilisation(team, &schedulerobjects.QueueUtilisationRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queue-a"}, queueNames(report))
+	report, err = srv.GetQueueUtilisation(owner, &schedulerobjects.QueueUtilisationRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queue-c"}, queueNames(report))
+	report, err = srv.GetQueueUtilisation(admin, &schedulerobjects.QueueUtilisationRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queue-a", "queue-b", "queue-c"}, queueNames(report))
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// Number of events read at a time when looking up the jobs to resubmit.
+const resubmitEventBatchSize = 500
+
+// Annotations set by the server at submission that describe how the original job was submitted,
+// and so aren't copied onto resubmitted jobs.
+var resubmitDroppedAnnotations = []string{
+	armadaconfiguration.ArrayIdAnnotation,
+	armadaconfiguration.ArrayIndexAnnotation,
+	armadaconfiguration.IdempotencyKeyAnnotation,
+	armadaconfiguration.ClientNameAnnotation,
+	armadaconfiguration.ClientVersionAnnotation,
+	armadaconfiguration.SubmissionSourceAnnotation,
+	armadaconfiguration.JobDependenciesAnnotation,
+	armadaconfiguration.RetryAttemptAnnotation,
+	armadaconfiguration.FirstAttemptJobIdAnnotation,
+}
+
+// ResubmitJobs submits a copy of each of the given jobs, which must have succeeded, failed, or been cancelled,
+// to the job set they were originally submitted to. Each copy is annotated with the id of the job it was copied from.
+//
+// The spec of each job is read from the events of its job set, so jobs can only be resubmitted
+// as long as the events of their job set are retained.
+func (srv *PulsarSubmitServer) ResubmitJobs(grpcCtx context.Context, req *api.JobResubmitRequest) (*api.JobSubmitResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if req.Queue == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "Queue",
+			Value:   req.Queue,
+			Message: "queue cannot be empty",
+		}
+	}
+	if req.JobSetId == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "JobSetId",
+			Value:   req.JobSetId,
+			Message: "job set cannot be empty",
+		}
+	}
+	if len(req.JobIds) == 0 {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "JobIds",
+			Value:   "",
+			Message: "no jobs to resubmit",
+		}
+	}
+	if _, _, err := srv.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit); err != nil {
+		return nil, err
+	}
+
+	jobs, err := srv.getTerminalJobs(req.Queue, req.JobSetId, req.JobIds)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*api.JobSubmitRequestItem, len(jobs))
+	for i, job := range jobs {
+		items[i] = resubmitRequestItem(job)
+	}
+	return srv.SubmitJobs(grpcCtx, &api.JobSubmitRequest{
+		Queue:           req.Queue,
+		JobSetId:        req.JobSetId,
+		JobRequestItems: items,
+	})
+}
+
+// getTerminalJobs returns the jobs with the given ids, in the same order, as submitted to the given job set.
+// Returns an error if any of them wasn't submitted to the job set or hasn't yet reached a terminal state.
+func (srv *PulsarSubmitServer) getTerminalJobs(queue string, jobSetId string, jobIds []string) ([]*api.Job, error) {
+	jobsById := make(map[string]*api.Job, len(jobIds))
+	terminal := make(map[string]bool, len(jobIds))
+	for _, jobId := range jobIds {
+		jobsById[jobId] = nil
+	}
+	fromId := ""
+	for {
+		messages, _, err := srv.EventRepository.ReadEvents(queue, jobSetId, fromId, resubmitEventBatchSize, -1)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range messages {
+			fromId = msg.Id
+			event, err := api.UnwrapEvent(msg.Message)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := jobsById[event.GetJobId()]; !ok {
+				continue
+			}
+			switch e := event.(type) {
+			case *api.JobSubmittedEvent:
+				job := e.Job
+				jobsById[e.JobId] = &job
+			case *api.JobSucceededEvent, *api.JobFailedEvent, *api.JobCancelledEvent:
+				terminal[event.GetJobId()] = true
+			}
+		}
+		if len(messages) < resubmitEventBatchSize {
+			break
+		}
+	}
+
+	jobs := make([]*api.Job, len(jobIds))
+	for i, jobId := range jobIds {
+		job := jobsById[jobId]
+		if job == nil {
+			return nil, &armadaerrors.ErrNotFound{
+				Type:    "job",
+				Value:   jobId,
+				Message: fmt.Sprintf("job not found in job set %s of queue %s", jobSetId, queue),
+			}
+		}
+		if !terminal[jobId] {
+			return nil, &armadaerrors.ErrInvalidArgument{
+				Name:    "JobIds",
+				Value:   jobId,
+				Message: "only jobs that have succeeded, failed, or been cancelled can be resubmitted",
+			}
+		}
+		jobs[i] = job
+	}
+	return jobs, nil
+}
+
+// resubmitRequestItem returns a job request item with the same spec as job, annotated with the id of job.
+// The client id isn't copied, since the new job would otherwise be deduplicated against the original one.
+func resubmitRequestItem(job *api.Job) *api.JobSubmitRequestItem {
+	annotations := make(map[string]string, len(job.Annotations)+1)
+	for k, v := range job.Annotations {
+		annotations[k] = v
+	}
+	for _, k := range resubmitDroppedAnnotations {
+		delete(annotations, k)
+	}
+	annotations[armadaconfiguration.ParentJobIdAnnotation] = job.Id
+	return &api.JobSubmitRequestItem{
+		Priority:           job.Priority,
+		Namespace:          job.Namespace,
+		Labels:             job.Labels,
+		Annotations:        annotations,
+		RequiredNodeLabels: job.RequiredNodeLabels,
+		PodSpec:            job.PodSpec,
+		PodSpecs:           job.PodSpecs,
+		Ingress:            job.Ingress,
+		Services:           job.Services,
+		Scheduler:          job.Scheduler,
+		QueueTtlSeconds:    job.QueueTtlSeconds,
+	}
+}
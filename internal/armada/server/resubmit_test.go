@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/mocks"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestResubmitRequestItem(t *testing.T) {
+	job := &api.Job{
+		Id:       "parent",
+		ClientId: "client",
+		Priority: 2,
+		Labels:   map[string]string{"team": "ml"},
+		Annotations: map[string]string{
+			"user":                                    "annotation",
+			armadaconfiguration.ArrayIdAnnotation:     "array",
+			armadaconfiguration.ClientNameAnnotation:  "armadactl",
+			armadaconfiguration.ParentJobIdAnnotation: "grandparent",
+		},
+		PodSpec: testValidateRequestItem("", "ubuntu").PodSpec,
+	}
+	item := resubmitRequestItem(job)
+	assert.Empty(t, item.ClientId)
+	assert.Equal(t, 2.0, item.Priority)
+	assert.Equal(t, job.Labels, item.Labels)
+	assert.Equal(t, job.PodSpec, item.PodSpec)
+	assert.Equal(t, map[string]string{
+		"user": "annotation",
+		armadaconfiguration.ParentJobIdAnnotation: "parent",
+	}, item.Annotations)
+	// The annotations of the original job are left unchanged.
+	assert.Equal(t, "grandparent", job.Annotations[armadaconfiguration.ParentJobIdAnnotation])
+}
+
+func TestPulsarSubmitServer_GetTerminalJobs(t *testing.T) {
+	srv := &PulsarSubmitServer{EventRepository: &fakeEventRepository{messages: cronJobSetEvents(t,
+		&api.JobSubmittedEvent{JobId: "a", Job: api.Job{Id: "a"}},
+		&api.JobSubmittedEvent{JobId: "b", Job: api.Job{Id: "b"}},
+		&api.JobSubmittedEvent{JobId: "c", Job: api.Job{Id: "c"}},
+		&api.JobFailedEvent{JobId: "a"},
+		&api.JobRunningEvent{JobId: "b"},
+		&api.JobCancelledEvent{JobId: "c"},
+	)}}
+
+	jobs, err := srv.getTerminalJobs("queue", "jobSet", []string{"c", "a"})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "c", jobs[0].Id)
+	assert.Equal(t, "a", jobs[1].Id)
+
+	_, err = srv.getTerminalJobs("queue", "jobSet", []string{"a", "b"})
+	var invalidArgument *armadaerrors.ErrInvalidArgument
+	assert.True(t, errors.As(err, &invalidArgument))
+
+	_, err = srv.getTerminalJobs("queue", "jobSet", []string{"d"})
+	var notFound *armadaerrors.ErrNotFound
+	assert.True(t, errors.As(err, &notFound))
+}
+
+func TestPulsarSubmitServer_ResubmitJobs(t *testing.T) {
+	item := testValidateRequestItem("", "ubuntu")
+	parent := api.Job{
+		Id:          util.NewULID(),
+		Queue:       "queue",
+		JobSetId:    "jobSet",
+		Namespace:   "namespace",
+		Annotations: map[string]string{"user": "annotation"},
+		PodSpec:     item.PodSpec,
+	}
+
+	ctrl := gomock.NewController(t)
+	producer := mocks.NewMockProducer(ctrl)
+	var sequences []*armadaevents.EventSequence
+	producer.EXPECT().SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+			sequence := &armadaevents.EventSequence{}
+			require.NoError(t, proto.Unmarshal(msg.Payload, sequence))
+			sequences = append(sequences, sequence)
+			callback(nil, msg, nil)
+		},
+	).AnyTimes()
+
+	srv := newTestValidatingServer()
+	srv.Producer = producer
+	srv.MaxAllowedMessageSize = 1024 * 1024
+	srv.EventRepository = &fakeEventRepository{messages: cronJobSetEvents(t,
+		&api.JobSubmittedEvent{JobId: parent.Id, Job: parent},
+		&api.JobFailedEvent{JobId: parent.Id},
+	)}
+	response, err := srv.ResubmitJobs(context.Background(), &api.JobResubmitRequest{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		JobIds:   []string{parent.Id},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.JobResponseItems, 1)
+	assert.NotEqual(t, parent.Id, response.JobResponseItems[0].JobId)
+
+	require.Len(t, sequences, 1)
+	assert.Equal(t, "jobSet", sequences[0].JobSetName)
+	require.Len(t, sequences[0].Events, 1)
+	submitJob := sequences[0].Events[0].GetSubmitJob()
+	require.NotNil(t, submitJob)
+	assert.Equal(t, parent.Id, submitJob.ObjectMeta.Annotations[armadaconfiguration.ParentJobIdAnnotation])
+	assert.Equal(t, "annotation", submitJob.ObjectMeta.Annotations["user"])
+}
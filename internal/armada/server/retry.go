@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/retry"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// Maximum number of retries claimed at a time when submitting retries that are due.
+const retryClaimBatchSize = 1000
+
+// JobRetrier is a service that reads job set events from Pulsar and resubmits jobs with a retry policy that failed.
+// Jobs are stored when submitted and deleted once they finish. When a job fails and its policy allows for another
+// attempt, a retry is scheduled after the backoff of the policy and later submitted by SubmitDueRetries.
+type JobRetrier struct {
+	JobRetryRepository repository.JobRetryRepository
+	// Used to submit retries on behalf of the owners of the failed jobs.
+	SubmitServer *PulsarSubmitServer
+	Consumer     pulsar.Consumer
+	// Maximum number of attempts of any job, regardless of its policy, or unlimited if not positive.
+	MaxAttempts int
+	// Maximum time waited before retrying a job, regardless of its policy, or unlimited if not positive.
+	MaxBackoff time.Duration
+	Clock      clock.Clock
+}
+
+// Run the service that reads from Pulsar and schedules retries until the provided context is cancelled.
+func (srv *JobRetrier) Run(ctx *armadacontext.Context) error {
+	log := ctx.WithField("service", "JobRetrier")
+	log.Info("service started")
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("service stopped")
+			return nil
+		default:
+			ctxWithTimeout, cancel := armadacontext.WithTimeout(ctx, 10*time.Second)
+			msg, err := srv.Consumer.Receive(ctxWithTimeout)
+			cancel()
+			if errors.Is(err, context.DeadlineExceeded) {
+				break // expected
+			} else if err != nil {
+				logging.WithStacktrace(log, err).Warnf("Pulsar receive failed; backing off")
+				time.Sleep(100 * time.Millisecond)
+				break
+			}
+
+			ctxWithLogger := armadacontext.WithLogField(ctx, logging.MessageIdField, msg.ID())
+			sequence, err := eventutil.UnmarshalEventSequence(ctxWithLogger, msg.Payload())
+			if err != nil {
+				logging.WithStacktrace(ctxWithLogger, err).Warnf("processing message failed; ignoring")
+			} else if err := srv.ProcessSequence(sequence); err != nil {
+				// Errors are most likely caused by the database being unavailable, so process the message again later.
+				logging.WithStacktrace(ctxWithLogger, err).Warnf("processing message failed; retrying later")
+				srv.Consumer.Nack(msg)
+				break
+			}
+			util.RetryUntilSuccess(
+				ctx,
+				func() error { return srv.Consumer.Ack(msg) },
+				func(err error) {
+					logging.WithStacktrace(log, err).Warnf("acking pulsar message failed")
+					time.Sleep(time.Second)
+				},
+			)
+		}
+	}
+}
+
+// ProcessSequence stores the jobs with a retry policy submitted in sequence,
+// deletes the jobs that finished, and schedules retries for the jobs that failed.
+func (srv *JobRetrier) ProcessSequence(sequence *armadaevents.EventSequence) error {
+	for _, event := range sequence.Events {
+		switch e := event.Event.(type) {
+		case *armadaevents.EventSequence_Event_SubmitJob:
+			if err := srv.storeJob(sequence, e.SubmitJob); err != nil {
+				return err
+			}
+		case *armadaevents.EventSequence_Event_JobSucceeded:
+			if err := srv.deleteJob(sequence, e.JobSucceeded.JobId); err != nil {
+				return err
+			}
+		case *armadaevents.EventSequence_Event_CancelledJob:
+			if err := srv.deleteJob(sequence, e.CancelledJob.JobId); err != nil {
+				return err
+			}
+		case *armadaevents.EventSequence_Event_JobErrors:
+			if err := srv.processJobErrors(sequence, e.JobErrors); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (srv *JobRetrier) storeJob(sequence *armadaevents.EventSequence, e *armadaevents.SubmitJob) error {
+	if e.ObjectMeta == nil {
+		return nil
+	}
+	if _, hasPolicy, err := retry.PolicyFromAnnotations(e.ObjectMeta.Annotations); err != nil || !hasPolicy {
+		// Policies are validated on submission, so invalid policies can be ignored here.
+		return nil
+	}
+	job, err := eventutil.ApiJobFromLogSubmitJob(sequence.UserId, sequence.Groups, sequence.Queue, sequence.JobSetName, srv.Clock.Now(), e)
+	if err != nil {
+		return err
+	}
+	return srv.JobRetryRepository.StoreJob(job)
+}
+
+func (srv *JobRetrier) deleteJob(sequence *armadaevents.EventSequence, protoJobId *armadaevents.Uuid) error {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(protoJobId)
+	if err != nil {
+		return nil
+	}
+	return srv.JobRetryRepository.DeleteJob(sequence.Queue, sequence.JobSetName, jobId)
+}
+
+func (srv *JobRetrier) processJobErrors(sequence *armadaevents.EventSequence, e *armadaevents.JobErrors) error {
+	terminal := false
+	for _, jobError := range e.Errors {
+		terminal = terminal || jobError.Terminal
+	}
+	if !terminal {
+		return nil
+	}
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil
+	}
+	job, err := srv.JobRetryRepository.GetJob(sequence.Queue, sequence.JobSetName, jobId)
+	if err != nil || job == nil {
+		return err
+	}
+	policy, hasPolicy, err := retry.PolicyFromAnnotations(job.Annotations)
+	attempt := retry.AttemptFromAnnotations(job.Annotations)
+	if err != nil || !hasPolicy || !policy.ShouldRetry(attempt, srv.MaxAttempts, e.Errors) {
+		return srv.JobRetryRepository.DeleteJob(job.Queue, job.JobSetId, job.Id)
+	}
+	due := srv.Clock.Now().Add(policy.BackoffBeforeAttempt(attempt+1, srv.MaxBackoff))
+	return srv.JobRetryRepository.ScheduleRetry(job.Queue, job.JobSetId, job.Id, due)
+}
+
+// SubmitDueRetries submits a new attempt of each failed job whose retry is due,
+// on behalf of the owner of the job and to the job set the job was submitted to.
+func (srv *JobRetrier) SubmitDueRetries(ctx *armadacontext.Context) error {
+	for {
+		jobs, err := srv.JobRetryRepository.ClaimDueRetries(srv.Clock.Now(), retryClaimBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, job := range jobs {
+			if err := srv.submitRetry(ctx, job); err != nil {
+				ctx.WithError(err).WithFields(logging.JobFields(job.Queue, job.JobSetId, job.Id)).Error("failed to retry job")
+			}
+		}
+		if len(jobs) < retryClaimBatchSize {
+			return nil
+		}
+	}
+}
+
+func (srv *JobRetrier) submitRetry(ctx *armadacontext.Context, job *api.Job) error {
+	principalCtx := authorization.WithPrincipal(ctx, authorization.NewStaticPrincipal(job.Owner, job.QueueOwnershipUserGroups))
+	res, err := srv.SubmitServer.SubmitJobs(principalCtx, &api.JobSubmitRequest{
+		Queue:           job.Queue,
+		JobSetId:        job.JobSetId,
+		JobRequestItems: []*api.JobSubmitRequestItem{retryRequestItem(job)},
+	})
+	if err != nil {
+		return err
+	}
+	for _, item := range res.JobResponseItems {
+		ctx.WithFields(logging.Fields{logging.JobIdField: job.Id, "retryJobId": item.JobId}).Infof("retried job")
+	}
+	return nil
+}
+
+// retryRequestItem returns a job request item for the next attempt of job,
+// annotated with the attempt number and the id of the first attempt.
+func retryRequestItem(job *api.Job) *api.JobSubmitRequestItem {
+	item := resubmitRequestItem(job)
+	firstAttemptJobId := job.Annotations[armadaconfiguration.FirstAttemptJobIdAnnotation]
+	if firstAttemptJobId == "" {
+		firstAttemptJobId = job.Id
+	}
+	item.Annotations[armadaconfiguration.FirstAttemptJobIdAnnotation] = firstAttemptJobId
+	item.Annotations[armadaconfiguration.RetryAttemptAnnotation] = strconv.Itoa(retry.AttemptFromAnnotations(job.Annotations) + 1)
+	return item
+}
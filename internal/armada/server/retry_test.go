@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/retry"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/mocks"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+type fakeJobRetryRepository struct {
+	jobs map[string]*api.Job
+	due  map[string]time.Time
+}
+
+func newFakeJobRetryRepository() *fakeJobRetryRepository {
+	return &fakeJobRetryRepository{jobs: make(map[string]*api.Job), due: make(map[string]time.Time)}
+}
+
+func (r *fakeJobRetryRepository) StoreJob(job *api.Job) error {
+	r.jobs[job.Id] = job
+	return nil
+}
+
+func (r *fakeJobRetryRepository) GetJob(_ string, _ string, jobId string) (*api.Job, error) {
+	return r.jobs[jobId], nil
+}
+
+func (r *fakeJobRetryRepository) DeleteJob(_ string, _ string, jobId string) error {
+	delete(r.jobs, jobId)
+	delete(r.due, jobId)
+	return nil
+}
+
+func (r *fakeJobRetryRepository) ScheduleRetry(_ string, _ string, jobId string, due time.Time) error {
+	r.due[jobId] = due
+	return nil
+}
+
+func (r *fakeJobRetryRepository) ClaimDueRetries(now time.Time, limit int) ([]*api.Job, error) {
+	var jobs []*api.Job
+	for jobId, due := range r.due {
+		if len(jobs) == limit || due.After(now) {
+			continue
+		}
+		jobs = append(jobs, r.jobs[jobId])
+		delete(r.jobs, jobId)
+		delete(r.due, jobId)
+	}
+	return jobs, nil
+}
+
+func testRetryJob(t *testing.T, policy *api.RetryPolicy) (*api.Job, *armadaevents.EventSequence) {
+	job := &api.Job{
+		Id:          util.NewULID(),
+		Queue:       "queue",
+		JobSetId:    "jobSet",
+		Namespace:   "namespace",
+		Owner:       "owner",
+		Annotations: retry.AnnotationsFromApiPolicy(policy),
+		PodSpec:     testValidateRequestItem("", "ubuntu").PodSpec,
+	}
+	submitJob, err := eventutil.LogSubmitJobFromApiJob(job)
+	require.NoError(t, err)
+	return job, &armadaevents.EventSequence{
+		Queue:      job.Queue,
+		JobSetName: job.JobSetId,
+		UserId:     job.Owner,
+		Events: []*armadaevents.EventSequence_Event{
+			{Event: &armadaevents.EventSequence_Event_SubmitJob{SubmitJob: submitJob}},
+		},
+	}
+}
+
+func jobErrorsSequence(t *testing.T, job *api.Job, exitCode int32) *armadaevents.EventSequence {
+	jobId, err := armadaevents.ProtoUuidFromUlidString(job.Id)
+	require.NoError(t, err)
+	return &armadaevents.EventSequence{
+		Queue:      job.Queue,
+		JobSetName: job.JobSetId,
+		Events: []*armadaevents.EventSequence_Event{
+			{Event: &armadaevents.EventSequence_Event_JobErrors{JobErrors: &armadaevents.JobErrors{
+				JobId: jobId,
+				Errors: []*armadaevents.Error{{
+					Terminal: true,
+					Reason: &armadaevents.Error_PodError{PodError: &armadaevents.PodError{
+						ContainerErrors: []*armadaevents.ContainerError{{ExitCode: exitCode}},
+					}},
+				}},
+			}}},
+		},
+	}
+}
+
+func TestJobRetrier_RetriesFailedJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	producer := mocks.NewMockProducer(ctrl)
+	var sequences []*armadaevents.EventSequence
+	producer.EXPECT().SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+			sequence := &armadaevents.EventSequence{}
+			require.NoError(t, proto.Unmarshal(msg.Payload, sequence))
+			sequences = append(sequences, sequence)
+			callback(nil, msg, nil)
+		},
+	).AnyTimes()
+	submitServer := newTestValidatingServer()
+	submitServer.Producer = producer
+	submitServer.MaxAllowedMessageSize = 1024 * 1024
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFakeClock(now)
+	repository := newFakeJobRetryRepository()
+	retrier := &JobRetrier{
+		JobRetryRepository: repository,
+		SubmitServer:       submitServer,
+		Clock:              fakeClock,
+	}
+
+	job, submitted := testRetryJob(t, &api.RetryPolicy{MaxAttempts: 2, BackoffSeconds: 60})
+	require.NoError(t, retrier.ProcessSequence(submitted))
+	require.Contains(t, repository.jobs, job.Id)
+
+	require.NoError(t, retrier.ProcessSequence(jobErrorsSequence(t, job, 1)))
+	assert.Equal(t, now.Add(time.Minute), repository.due[job.Id])
+
+	// The retry isn't submitted before the backoff has passed.
+	require.NoError(t, retrier.SubmitDueRetries(armadacontext.Background()))
+	assert.Empty(t, sequences)
+
+	fakeClock.Step(time.Minute)
+	require.NoError(t, retrier.SubmitDueRetries(armadacontext.Background()))
+	require.Len(t, sequences, 1)
+	assert.Equal(t, "owner", sequences[0].UserId)
+	require.Len(t, sequences[0].Events, 1)
+	submitJob := sequences[0].Events[0].GetSubmitJob()
+	require.NotNil(t, submitJob)
+	annotations := submitJob.ObjectMeta.Annotations
+	assert.Equal(t, "2", annotations[armadaconfiguration.RetryAttemptAnnotation])
+	assert.Equal(t, job.Id, annotations[armadaconfiguration.FirstAttemptJobIdAnnotation])
+	assert.Equal(t, job.Id, annotations[armadaconfiguration.ParentJobIdAnnotation])
+	assert.Equal(t, "2", annotations[armadaconfiguration.RetryMaxAttemptsAnnotation])
+	assert.Empty(t, repository.jobs)
+
+	// The second attempt is the last one, so it isn't retried.
+	require.NoError(t, retrier.ProcessSequence(&armadaevents.EventSequence{
+		Queue:      sequences[0].Queue,
+		JobSetName: sequences[0].JobSetName,
+		UserId:     sequences[0].UserId,
+		Events:     sequences[0].Events,
+	}))
+	retryJobId, err := armadaevents.UlidStringFromProtoUuid(submitJob.JobId)
+	require.NoError(t, err)
+	require.Contains(t, repository.jobs, retryJobId)
+	require.NoError(t, retrier.ProcessSequence(jobErrorsSequence(t, repository.jobs[retryJobId], 1)))
+	assert.Empty(t, repository.jobs)
+	assert.Empty(t, repository.due)
+}
+
+func TestJobRetrier_ProcessSequence(t *testing.T) {
+	retrier := &JobRetrier{Clock: clock.NewFakeClock(time.Now())}
+
+	// Jobs without a policy aren't stored.
+	retrier.JobRetryRepository = newFakeJobRetryRepository()
+	_, submitted := testRetryJob(t, &api.RetryPolicy{MaxAttempts: 1})
+	require.NoError(t, retrier.ProcessSequence(submitted))
+	assert.Empty(t, retrier.JobRetryRepository.(*fakeJobRetryRepository).jobs)
+
+	// Jobs that succeed are deleted.
+	repository := newFakeJobRetryRepository()
+	retrier.JobRetryRepository = repository
+	job, submitted := testRetryJob(t, &api.RetryPolicy{MaxAttempts: 3})
+	require.NoError(t, retrier.ProcessSequence(submitted))
+	jobId, err := armadaevents.ProtoUuidFromUlidString(job.Id)
+	require.NoError(t, err)
+	require.NoError(t, retrier.ProcessSequence(&armadaevents.EventSequence{
+		Queue:      job.Queue,
+		JobSetName: job.JobSetId,
+		Events: []*armadaevents.EventSequence_Event{
+			{Event: &armadaevents.EventSequence_Event_JobSucceeded{JobSucceeded: &armadaevents.JobSucceeded{JobId: jobId}}},
+		},
+	}))
+	assert.Empty(t, repository.jobs)
+
+	// Jobs that fail with an exit code not covered by the policy are deleted.
+	job, submitted = testRetryJob(t, &api.RetryPolicy{MaxAttempts: 3, RetryOnExitCodes: []int32{137}})
+	require.NoError(t, retrier.ProcessSequence(submitted))
+	require.NoError(t, retrier.ProcessSequence(jobErrorsSequence(t, job, 1)))
+	assert.Empty(t, repository.jobs)
+	assert.Empty(t, repository.due)
+}
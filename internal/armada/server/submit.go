@@ -11,22 +11,25 @@ import (
 	"github.com/gogo/protobuf/types"
 	pool "github.com/jolestar/go-commons-pool"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/strings/slices"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/armada/permissions"
 	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/retry"
 	servervalidation "github.com/armadaproject/armada/internal/armada/validation"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/common/validation"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
@@ -51,6 +54,7 @@ func NewSubmitServer(
 	cancelJobsBatchSize int,
 	queueManagementConfig *configuration.QueueManagementConfig,
 	schedulingConfig *configuration.SchedulingConfig,
+	newOwnershipGroupsCompressor func() (compress.Compressor, error),
 ) *SubmitServer {
 	poolConfig := pool.ObjectPoolConfig{
 		MaxTotal:                 100,
@@ -63,9 +67,14 @@ func NewSubmitServer(
 		NumTestsPerEvictionRun:   10,
 	}
 
+	if newOwnershipGroupsCompressor == nil {
+		newOwnershipGroupsCompressor = func() (compress.Compressor, error) {
+			return compress.NewZlibCompressor(ownershipGroupsMinCompressSize)
+		}
+	}
 	compressorPool := pool.NewObjectPool(armadacontext.Background(), pool.NewPooledObjectFactorySimple(
 		func(context.Context) (interface{}, error) {
-			return compress.NewZlibCompressor(512)
+			return newOwnershipGroupsCompressor()
 		}), &poolConfig)
 
 	return &SubmitServer{
@@ -218,7 +227,7 @@ func (server *SubmitServer) CreateQueues(grpcCtx context.Context, request *api.Q
 
 func (server *SubmitServer) UpdateQueue(grpcCtx context.Context, request *api.Queue) (*types.Empty, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
-	err := checkPermission(server.permissions, ctx, permissions.CreateQueue)
+	err := checkQueueAdminPermission(server.permissions, ctx, request.Name, permissions.CreateQueue)
 	var ep *ErrUnauthorized
 	if errors.As(err, &ep) {
 		return nil, status.Errorf(codes.PermissionDenied, "[UpdateQueue] error updating queue %s: %s", request.Name, ep)
@@ -231,8 +240,24 @@ func (server *SubmitServer) UpdateQueue(grpcCtx context.Context, request *api.Qu
 		return nil, status.Errorf(codes.InvalidArgument, "[UpdateQueue] error: %s", err)
 	}
 
-	err = server.queueRepository.UpdateQueue(queue)
+	// Queue administrators without the global permission may only change the fields of the queue not affecting fairness.
 	var e *repository.ErrQueueNotFound
+	if !server.permissions.UserHasPermission(ctx, permissions.CreateQueue) {
+		current, err := server.queueRepository.GetQueue(queue.Name)
+		if errors.As(err, &e) {
+			return nil, status.Errorf(codes.NotFound, "[UpdateQueue] error: %s", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[UpdateQueue] error getting queue %q: %s", queue.Name, err)
+		}
+		err = checkQueueUpdatePermission(server.permissions, ctx, current, queue, permissions.CreateQueue)
+		if errors.As(err, &ep) {
+			return nil, status.Errorf(codes.PermissionDenied, "[UpdateQueue] error updating queue %s: %s", request.Name, ep)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[UpdateQueue] error checking permissions: %s", err)
+		}
+	}
+
+	err = server.queueRepository.UpdateQueue(queue)
 	if errors.As(err, &e) {
 		return nil, status.Errorf(codes.NotFound, "[UpdateQueue] error: %s", err)
 	} else if err != nil {
@@ -264,7 +289,7 @@ func (server *SubmitServer) UpdateQueues(grpcCtx context.Context, request *api.Q
 
 func (server *SubmitServer) DeleteQueue(grpcCtx context.Context, request *api.QueueDeleteRequest) (*types.Empty, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
-	err := checkPermission(server.permissions, ctx, permissions.DeleteQueue)
+	err := checkQueueAdminPermission(server.permissions, ctx, request.Name, permissions.DeleteQueue)
 	var ep *ErrUnauthorized
 	if errors.As(err, &ep) {
 		return nil, status.Errorf(codes.PermissionDenied, "[DeleteQueue] error deleting queue %s: %s", request.Name, ep)
@@ -328,6 +353,10 @@ func (server *SubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubm
 		return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error checking permissions: %s", err)
 	}
 
+	if err := checkQueueAcceptsSubmissions(*q); err != nil {
+		return nil, status.Errorf(armadaerrors.CodeFromError(err), "[SubmitJobs] %s", err)
+	}
+
 	// Check if the job would fit on any executor,
 	// to avoid having users wait for a job that may never be scheduled
 	allClusterSchedulingInfo, err := server.schedulingInfoRepository.GetClusterSchedulingInfo()
@@ -463,6 +492,33 @@ func (server *SubmitServer) CancelJobSet(grpcCtx context.Context, request *api.J
 	return &types.Empty{}, err
 }
 
+// getJobSetJobIdsMatchingSelector returns the ids of the jobs in a job set passing filter whose labels match selector.
+// To bound memory usage, jobs are loaded in batches of the size used for cancellation.
+func (server *SubmitServer) getJobSetJobIdsMatchingSelector(
+	queue string, jobSetId string, filter *repository.JobSetFilter, selector labels.Selector,
+) ([]string, error) {
+	ids, err := server.jobRepository.GetJobSetJobIds(queue, jobSetId, filter)
+	if err != nil {
+		return nil, err
+	}
+	if selector.Empty() {
+		return ids, nil
+	}
+	matchingIds := make([]string, 0)
+	for _, batch := range util.Batch(ids, server.cancelJobsBatchSize) {
+		jobs, err := server.jobRepository.GetExistingJobsByIds(batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range jobs {
+			if selector.Matches(labels.Set(job.Labels)) {
+				matchingIds = append(matchingIds, job.Id)
+			}
+		}
+	}
+	return matchingIds, nil
+}
+
 func createJobSetFilter(filter *api.JobSetFilter) *repository.JobSetFilter {
 	if filter == nil {
 		return nil
@@ -571,7 +627,7 @@ func (server *SubmitServer) cancelJobs(ctx *armadacontext.Context, jobs []*api.J
 	var cancelledIds []string
 	for job, err := range deletionResult {
 		if err != nil {
-			log.Errorf("[cancelJobs] error cancelling job with ID %s: %s", job.Id, err)
+			ctx.WithFields(logging.JobFields(job.Queue, job.JobSetId, job.Id)).WithError(err).Error("[cancelJobs] error cancelling job")
 		} else {
 			cancelled = append(cancelled, job)
 			cancelledIds = append(cancelledIds, job.Id)
@@ -664,7 +720,7 @@ func (server *SubmitServer) ReprioritizeJobs(grpcCtx context.Context, request *a
 	for _, job := range jobs {
 		jobIds = append(jobIds, job.Id)
 	}
-	results, err := server.reprioritizeJobs(jobIds, request.NewPriority, principalName)
+	results, err := server.reprioritizeJobs(ctx, jobIds, request.NewPriority, principalName)
 	if err != nil {
 		return nil, status.Errorf(codes.Unavailable, "[ReprioritizeJobs] error re-prioritising jobs: %s", err)
 	}
@@ -672,7 +728,7 @@ func (server *SubmitServer) ReprioritizeJobs(grpcCtx context.Context, request *a
 	return &api.JobReprioritizeResponse{ReprioritizationResults: results}, nil
 }
 
-func (server *SubmitServer) reprioritizeJobs(jobIds []string, newPriority float64, principalName string) (map[string]string, error) {
+func (server *SubmitServer) reprioritizeJobs(ctx *armadacontext.Context, jobIds []string, newPriority float64, principalName string) (map[string]string, error) {
 	// TODO There's a bug here.
 	// The function passed to UpdateJobs is called under an optimistic lock.
 	// If the jobs to be updated are mutated by another thread concurrently,
@@ -684,7 +740,7 @@ func (server *SubmitServer) reprioritizeJobs(jobIds []string, newPriority float6
 		}
 		err := server.reportReprioritizedJobEvents(jobs, newPriority, principalName)
 		if err != nil {
-			log.Warnf("Failed to report events for reprioritize of jobs %s: %v", strings.Join(jobIds, ", "), err)
+			ctx.WithError(err).Warnf("Failed to report events for reprioritize of jobs %s", strings.Join(jobIds, ", "))
 		}
 	})
 	if err != nil {
@@ -795,22 +851,13 @@ func (server *SubmitServer) createJobs(request *api.JobSubmitRequest, owner stri
 func (server *SubmitServer) createJobsObjects(request *api.JobSubmitRequest, owner string, ownershipGroups []string,
 	getTime func() time.Time, getUlid func() string,
 ) ([]*api.Job, error) {
-	compressor, err := server.compressorPool.BorrowObject(armadacontext.Background())
-	if err != nil {
-		return nil, err
-	}
-	defer func(compressorPool *pool.ObjectPool, ctx *armadacontext.Context, object interface{}) {
-		err := compressorPool.ReturnObject(ctx, object)
-		if err != nil {
-			log.WithError(err).Errorf("Error returning compressor to pool")
-		}
-	}(server.compressorPool, armadacontext.Background(), compressor)
-	compressedOwnershipGroups, err := compress.CompressStringArray(ownershipGroups, compressor.(compress.Compressor))
+	compressedOwnershipGroups, err := server.compressOwnershipGroups(ownershipGroups)
 	if err != nil {
 		return nil, err
 	}
 
 	jobs := make([]*api.Job, 0, len(request.JobRequestItems))
+	jobIdByClientId := make(map[string]string)
 
 	if request.JobSetId == "" {
 		return nil, errors.Errorf("[createJobs] job set not specified")
@@ -821,67 +868,150 @@ func (server *SubmitServer) createJobsObjects(request *api.JobSubmitRequest, own
 	}
 
 	for i, item := range request.JobRequestItems {
-		if item.PodSpec != nil && len(item.PodSpecs) > 0 {
-			return nil, errors.Errorf("[createJobs] job %d in job set %s contains both podSpec and podSpecs, but may only contain either", i, request.JobSetId)
+		j, err := server.createJob(request, i, item, owner, compressedOwnershipGroups, jobIdByClientId, getTime, getUlid)
+		if err != nil {
+			return nil, err
 		}
-		podSpec := item.GetMainPodSpec()
-		if podSpec == nil {
-			return nil, errors.Errorf("[createJobs] job %d in job set %s contains no podSpec", i, request.JobSetId)
+		jobs = append(jobs, j)
+	}
+
+	return jobs, nil
+}
+
+// Size in bytes above which ownership groups are compressed when compressing with zlib.
+const ownershipGroupsMinCompressSize = 512
+
+// NewOwnershipGroupsCompression returns functions creating the compressors and decompressors
+// of the queue ownership groups stored with each job, as configured.
+// Decompressors read groups compressed with either algorithm.
+func NewOwnershipGroupsCompression(config configuration.CompressionConfig) (func() (compress.Compressor, error), func() compress.Decompressor, error) {
+	dictionary, err := compress.LoadDictionary(config.DictionaryPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	newCompressor, err := compress.NewCompressorFactory(config.Algorithm, ownershipGroupsMinCompressSize, dictionary)
+	if err != nil {
+		return nil, nil, err
+	}
+	zstdDecompressor, err := compress.NewZstdDecompressor(dictionary)
+	if err != nil {
+		return nil, nil, err
+	}
+	newDecompressor := func() compress.Decompressor {
+		return compress.NewAutoDecompressor(compress.NewZlibDecompressor(), zstdDecompressor)
+	}
+	return newCompressor, newDecompressor, nil
+}
+
+func (server *SubmitServer) compressOwnershipGroups(ownershipGroups []string) ([]byte, error) {
+	compressor, err := server.compressorPool.BorrowObject(armadacontext.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer func(compressorPool *pool.ObjectPool, ctx *armadacontext.Context, object interface{}) {
+		err := compressorPool.ReturnObject(ctx, object)
+		if err != nil {
+			logging.ForModule("server").WithError(err).Errorf("Error returning compressor to pool")
 		}
-		if err := validation.ValidateJobSubmitRequestItem(item); err != nil {
-			return nil, errors.Errorf("[createJobs] error validating the %d-th job of job set %s: %v", i, request.JobSetId, err)
+	}(server.compressorPool, armadacontext.Background(), compressor)
+	return compress.CompressStringArray(ownershipGroups, compressor.(compress.Compressor))
+}
+
+// createJob validates, defaults, and converts the i-th item of request into a job.
+// jobIdByClientId maps the client ids of the jobs created so far to their job ids, and is updated with the created job.
+func (server *SubmitServer) createJob(
+	request *api.JobSubmitRequest, i int, item *api.JobSubmitRequestItem, owner string, compressedOwnershipGroups []byte,
+	jobIdByClientId map[string]string, getTime func() time.Time, getUlid func() string,
+) (*api.Job, error) {
+	if item.PodSpec != nil && len(item.PodSpecs) > 0 {
+		return nil, errors.Errorf("[createJobs] job %d in job set %s contains both podSpec and podSpecs, but may only contain either", i, request.JobSetId)
+	}
+	podSpec := item.GetMainPodSpec()
+	if podSpec == nil {
+		return nil, errors.Errorf("[createJobs] job %d in job set %s contains no podSpec", i, request.JobSetId)
+	}
+	if err := validation.ValidateJobSubmitRequestItem(item); err != nil {
+		return nil, errors.Errorf("[createJobs] error validating the %d-th job of job set %s: %v", i, request.JobSetId, err)
+	}
+	namespace := item.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	fillContainerRequestsAndLimits(podSpec.Containers)
+	fillContainerRequestsAndLimits(podSpec.InitContainers)
+	applyDefaultsToAnnotations(item.Annotations, *server.schedulingConfig)
+	applyDefaultsToPodSpec(podSpec, *server.schedulingConfig)
+	if err := validation.ValidatePodSpec(podSpec, server.schedulingConfig); err != nil {
+		return nil, errors.Errorf("[createJobs] error validating the %d-th job of job set %s: %v", i, request.JobSetId, err)
+	}
+
+	// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
+	for k, v := range item.RequiredNodeLabels {
+		if podSpec.NodeSelector == nil {
+			podSpec.NodeSelector = map[string]string{}
 		}
-		namespace := item.Namespace
-		if namespace == "" {
-			namespace = "default"
+		podSpec.NodeSelector[k] = v
+	}
+
+	jobId := getUlid()
+	enrichText(item.Labels, jobId)
+	enrichText(item.Annotations, jobId)
+	if len(item.DependsOn) > 0 {
+		dependencies := make([]string, len(item.DependsOn))
+		for j, dependency := range item.DependsOn {
+			if dependencyJobId, ok := jobIdByClientId[dependency]; ok {
+				dependencies[j] = dependencyJobId
+			} else if _, err := armadaevents.ProtoUuidFromUlidString(dependency); err == nil {
+				dependencies[j] = strings.ToLower(dependency)
+			} else {
+				return nil, errors.Errorf(
+					"[createJobs] job %d in job set %s depends on %s, which is neither a job id nor the client id of an earlier job in this request",
+					i, request.JobSetId, dependency,
+				)
+			}
 		}
-		fillContainerRequestsAndLimits(podSpec.Containers)
-		applyDefaultsToAnnotations(item.Annotations, *server.schedulingConfig)
-		applyDefaultsToPodSpec(podSpec, *server.schedulingConfig)
-		if err := validation.ValidatePodSpec(podSpec, server.schedulingConfig); err != nil {
-			return nil, errors.Errorf("[createJobs] error validating the %d-th job of job set %s: %v", i, request.JobSetId, err)
+		if item.Annotations == nil {
+			item.Annotations = make(map[string]string)
 		}
-
-		// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
-		for k, v := range item.RequiredNodeLabels {
-			if podSpec.NodeSelector == nil {
-				podSpec.NodeSelector = map[string]string{}
-			}
-			podSpec.NodeSelector[k] = v
+		item.Annotations[configuration.JobDependenciesAnnotation] = strings.Join(dependencies, ",")
+	}
+	if item.RetryPolicy != nil {
+		if item.Annotations == nil {
+			item.Annotations = make(map[string]string)
 		}
+		for k, v := range retry.AnnotationsFromApiPolicy(item.RetryPolicy) {
+			item.Annotations[k] = v
+		}
+	}
+	if item.ClientId != "" {
+		jobIdByClientId[item.ClientId] = jobId
+	}
+	j := &api.Job{
+		Id:       jobId,
+		ClientId: item.ClientId,
+		Queue:    request.Queue,
+		JobSetId: request.JobSetId,
 
-		jobId := getUlid()
-		enrichText(item.Labels, jobId)
-		enrichText(item.Annotations, jobId)
-		j := &api.Job{
-			Id:       jobId,
-			ClientId: item.ClientId,
-			Queue:    request.Queue,
-			JobSetId: request.JobSetId,
-
-			Namespace:   namespace,
-			Labels:      item.Labels,
-			Annotations: item.Annotations,
+		Namespace:   namespace,
+		Labels:      item.Labels,
+		Annotations: item.Annotations,
 
-			RequiredNodeLabels: item.RequiredNodeLabels,
-			Ingress:            item.Ingress,
-			Services:           item.Services,
+		RequiredNodeLabels: item.RequiredNodeLabels,
+		Ingress:            item.Ingress,
+		Services:           item.Services,
 
-			Priority: item.Priority,
+		Priority: item.Priority,
 
-			Scheduler:                          item.Scheduler,
-			PodSpec:                            item.PodSpec,
-			PodSpecs:                           item.PodSpecs,
-			Created:                            getTime(), // Replaced with now for mocking unit test
-			Owner:                              owner,
-			QueueOwnershipUserGroups:           nil,
-			CompressedQueueOwnershipUserGroups: compressedOwnershipGroups,
-			QueueTtlSeconds:                    item.QueueTtlSeconds,
-		}
-		jobs = append(jobs, j)
+		Scheduler:                          item.Scheduler,
+		PodSpec:                            item.PodSpec,
+		PodSpecs:                           item.PodSpecs,
+		Created:                            getTime(), // Replaced with now for mocking unit test
+		Owner:                              owner,
+		QueueOwnershipUserGroups:           nil,
+		CompressedQueueOwnershipUserGroups: compressedOwnershipGroups,
+		QueueTtlSeconds:                    item.QueueTtlSeconds,
 	}
-
-	return jobs, nil
+	return j, nil
 }
 
 func enrichText(labels map[string]string, jobId string) {
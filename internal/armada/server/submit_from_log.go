@@ -4,42 +4,83 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync/atomic"
 	"time"
 
-	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/hashicorp/go-multierror"
 	pool "github.com/jolestar/go-commons-pool"
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/metrics"
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/chaos"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/eventlog"
 	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
-// SubmitFromLog is a service that reads messages from Pulsar and updates the state of the Armada server accordingly
+// SubmitFromLog is a service that reads messages from the event log and updates the state of the Armada server accordingly
 // (in particular, it writes to Redis).
 // Calls into an embedded Armada submit server object.
 type SubmitFromLog struct {
 	SubmitServer *SubmitServer
-	Consumer     pulsar.Consumer
+	Consumer     eventlog.Consumer
+	// Messages, or parts of messages, that can't be processed are added to this queue, if provided,
+	// such that they can be requeued later instead of being lost.
+	DeadLetterQueue eventlog.DeadLetterQueue
+	// Records which events of each message have been applied, if provided,
+	// such that events of messages re-delivered after a crash aren't applied more than once.
+	ProcessedEvents repository.ProcessedEventRepository
+	// Number of workers processing messages concurrently. Messages are assigned to workers by queue and job set,
+	// such that the messages of each job set are processed in order. If less than 2, messages are processed one at a time.
+	Parallelism int
+	// Maximum number of messages received at a time, and the maximum time to wait for a batch to fill up.
+	BatchSize     int
+	FlushInterval time.Duration
+	// If true, rather than acking each message once processed, messages are acked cumulatively every FlushInterval,
+	// up to the last message such that all messages received before it have been processed.
+	// Requires that Consumer is an eventlog.CumulativeAcker.
+	CumulativeAck bool
+	// Policy for retrying events that fail with a transient error.
+	// Unset values default to retrying every second until no progress has been made for five minutes.
+	RetryPolicy configuration.RetryPolicyConfig
+	// If true, the events reporting that jobs have been queued are added to the outbox atomically with the jobs,
+	// to be published by an OutboxDispatcher, instead of being published after the jobs have been written.
+	// This ensures those events are published even if the server crashes after writing the jobs.
+	UseOutbox bool
+	// If provided, updated with the publish time of the oldest message received but not yet processed,
+	// such that the lag of this service behind the event log is reported.
+	LagMonitor *eventlog.LagMonitor
 	// Logger from which the loggers used by this service are derived
-	// (e.g., using srv.Logger.WithField), or nil, in which case the global logrus logger is used.
-	Logger *logrus.Entry
+	// (e.g., using srv.Logger.WithField), or nil, in which case the logger of the submitfromlog module is used.
+	Logger logging.Logger
+	// If not nil, used to crash this service at the crash points it reaches, to test recovering from crashes.
+	FaultInjector *chaos.FaultInjector
 }
 
-// Run the service that reads from Pulsar and updates Armada until the provided context is cancelled.
+// messageWarnings deduplicates warnings logged while processing messages, of which thousands may be logged per minute
+// during incidents, e.g., while Redis is unavailable.
+var messageWarnings = logging.NewDeduplicator(time.Minute)
+
+// Run the service that reads from the event log and updates Armada until the provided context is cancelled.
 func (srv *SubmitFromLog) Run(ctx *armadacontext.Context) error {
 	// Get the configured logger, or the standard logger if none is provided.
 	log := srv.getLogger()
 	log.Info("service started")
+	// Messages are logged using ctx, such that they're logged with the fields of log.
+	ctx = armadacontext.New(ctx, log)
+	// Log the warnings suppressed since they were last logged before stopping.
+	defer messageWarnings.Flush()
 
 	// Recover from panics by restarting the service.
 	defer func() {
@@ -61,40 +102,191 @@ func (srv *SubmitFromLog) Run(ctx *armadacontext.Context) error {
 	logInterval := 10 * time.Second
 	lastLogged := time.Now()
 	numReceived := 0
-	numErrored := 0
-	var lastMessageId pulsar.MessageID
-	lastMessageId = nil
-	lastPublishTime := time.Now()
+	var numErrored atomic.Int64
+
+	var cumulativeAcker eventlog.CumulativeAcker
+	if srv.CumulativeAck {
+		var ok bool
+		if cumulativeAcker, ok = srv.Consumer.(eventlog.CumulativeAcker); !ok {
+			return errors.Errorf("cumulative acks are enabled, but consumer of type %T doesn't support them", srv.Consumer)
+		}
+	}
+	tracker := &ackTracker{collectFinished: srv.CumulativeAck}
+	// finish marks a message as processed. Unless acking cumulatively, the message is acked immediately.
+	finish := func(tracked *trackedMessage) {
+		if !srv.CumulativeAck {
+			srv.ack(ctx, tracked.msg)
+		}
+		tracker.done(tracked)
+	}
+	// abandon is called for messages processing of which panicked, which must not be acked.
+	// Unless acking cumulatively, the message is nacked, if the consumer supports it, such that it's redelivered.
+	// Otherwise, the message is left in flight, such that the lag reported by srv.LagMonitor, which includes the message,
+	// eventually exceeds its limit and the service restarts, after which the message is redelivered.
+	//
+	// When acking cumulatively, a message left in flight prevents acking any message received after it.
+	// If a dead-letter queue is configured, the message is dead-lettered and marked as finished,
+	// such that the cumulative ack moves past it. Otherwise, the message is left in flight, since nacking it and
+	// acking cumulatively past it would drop it if the next cumulative ack happened before it was redelivered.
+	// The cumulative ack position then stays before the message until the service restarts and it's redelivered.
+	nacker, canNack := srv.Consumer.(eventlog.Nacker)
+	abandon := func(ctx *armadacontext.Context, tracked *trackedMessage) {
+		if srv.CumulativeAck {
+			if srv.DeadLetterQueue == nil {
+				ctx.Error("processing message panicked; leaving it unacked, which blocks acking the messages after it")
+				return
+			}
+			ctx.Error("processing message panicked; dead-lettering it and acking cumulatively past it")
+			srv.deadLetter(ctx, eventlog.DeadLetter{
+				Message: tracked.msg,
+				Payload: tracked.msg.Payload(),
+				Error:   errors.New("processing message panicked"),
+			})
+			tracker.done(tracked)
+			return
+		}
+		if !canNack {
+			ctx.Error("processing message panicked; leaving it unacked")
+			return
+		}
+		ctx.Error("processing message panicked; nacking it")
+		if err := nacker.Nack(tracked.msg); err != nil {
+			logging.WithStacktrace(ctx, err).Error("failed to nack event log message")
+			return
+		}
+		// The message is tracked again once redelivered.
+		tracker.done(tracked)
+	}
+	lastFlushed := time.Now()
+	if srv.CumulativeAck {
+		// Ack the messages processed before stopping.
+		defer func() {
+			if msgs := tracker.takeFinished(); len(msgs) > 0 {
+				if err := cumulativeAcker.AckCumulative(msgs); err != nil {
+					logging.WithStacktrace(log, err).Warn("failed to ack event log messages on shutdown")
+				}
+			}
+		}()
+	}
+
+	// Messages are processed by a pool of workers, such that messages of different job sets are processed concurrently.
+	// The pool is stopped before returning, such that messages already received finish processing.
+	workers := newPartitionedWorkerPool(srv.Parallelism, log)
+	defer workers.stop()
+
+	// handle dispatches a received message for processing.
+	handle := func(msg eventlog.Message) {
+		tracked := tracker.add(msg)
+
+		// If this message isn't for us we can simply ack it
+		// and go to the next message
+		scheduler, ok := schedulers.SchedulerFromProperties(msg.Properties())
+		if !ok {
+			messageWarnings.Warnf(log.WithField(logging.MessageIdField, msg.ID()), "unknown scheduler associated with message; defaulting to legacy scheduler")
+		}
+		if scheduler != schedulers.Legacy && scheduler != schedulers.All {
+			finish(tracked)
+			return
+		}
+
+		numReceived++
+		ctxWithLogger := armadacontext.WithLogField(ctx, logging.MessageIdField, msg.ID())
+		// Continue the trace of the request that published the message, if any.
+		ctxWithLogger, span := tracing.ContinueFromProperties(ctxWithLogger, "SubmitFromLog.processMessage", msg.Properties())
+
+		// Unmarshal and validate the message.
+		sequence, err := eventutil.UnmarshalEventSequence(ctxWithLogger, msg.Payload())
+		if err != nil {
+			messageWarnings.Warnf(logging.WithStacktrace(ctxWithLogger, err), "processing message failed; dead-lettering")
+			srv.deadLetter(ctxWithLogger, eventlog.DeadLetter{Message: msg, Payload: msg.Payload(), Error: err})
+			numErrored.Add(1)
+			finish(tracked)
+			span.End()
+			return
+		}
+		ctxWithLogger = armadacontext.WithLogFields(ctxWithLogger, logging.Fields{
+			logging.QueueField:  sequence.Queue,
+			logging.JobSetField: sequence.JobSetName,
+		})
+		span.SetAttributes(
+			attribute.String("queue", sequence.Queue),
+			attribute.String("jobSetId", sequence.JobSetName),
+			attribute.Int("numEvents", len(sequence.Events)),
+			// Time spent in the event log, i.e., between the message being published and received.
+			attribute.Int64("logLatencyMs", time.Since(msg.PublishTime()).Milliseconds()),
+		)
+
+		// Messages of the same job set are processed by the same worker, in the order they were received.
+		workers.submit(fmt.Sprintf("%s/%s", sequence.Queue, sequence.JobSetName), func() {
+			defer span.End()
+			processed := false
+			defer func() {
+				if processed {
+					finish(tracked)
+				} else {
+					abandon(ctxWithLogger, tracked)
+				}
+			}()
+			if !srv.processMessage(ctxWithLogger, msg, sequence) {
+				numErrored.Add(1)
+			}
+			processed = true
+		})
+	}
 
 	// Run until ctx is cancelled.
 	for {
 
 		// Periodic logging.
 		if time.Since(lastLogged) > logInterval {
+			// Report the position up to which all messages have been processed.
+			var lastMessageId fmt.Stringer
+			timeLag := time.Duration(0)
+			lastMsg, numInFlight := tracker.position()
+			if lastMsg != nil {
+				lastMessageId = lastMsg.ID()
+				timeLag = time.Since(lastMsg.PublishTime())
+			}
+			errored := int(numErrored.Swap(0))
 			log.WithFields(
-				logrus.Fields{
+				logging.Fields{
 					"received":      numReceived,
-					"succeeded":     numReceived - numErrored,
-					"errored":       numErrored,
+					"succeeded":     numReceived - errored,
+					"errored":       errored,
+					"inFlight":      numInFlight,
 					"interval":      logInterval,
 					"lastMessageId": lastMessageId,
-					"timeLag":       time.Since(lastPublishTime),
+					"timeLag":       timeLag,
 				},
 			).Info("message statistics")
 			numReceived = 0
-			numErrored = 0
 			lastLogged = time.Now()
 		}
 
-		// Exit if the context has been cancelled. Otherwise, get a message from Pulsar.
+		if srv.LagMonitor != nil {
+			var oldestPending time.Time
+			if msg := tracker.oldestInFlight(); msg != nil {
+				oldestPending = msg.PublishTime()
+			}
+			srv.LagMonitor.Update(oldestPending)
+		}
+
+		// Periodically ack the messages processed since the last flush.
+		// Once ctx is cancelled, these are acked when stopping instead, since acking using ctx would fail.
+		if srv.CumulativeAck && time.Since(lastFlushed) > srv.FlushInterval && ctx.Err() == nil {
+			srv.ackCumulative(ctx, cumulativeAcker, tracker.takeFinished())
+			lastFlushed = time.Now()
+		}
+
+		// Exit if the context has been cancelled. Otherwise, get a message from the event log.
 		select {
 		case <-ctx.Done():
 			return nil
 		default:
 
-			// Get a message from Pulsar, which consists of a sequence of events (i.e., state transitions).
+			// Get messages from the event log, each of which consists of a sequence of events (i.e., state transitions).
 			ctxWithTimeout, cancel := armadacontext.WithTimeout(ctx, 10*time.Second)
-			msg, err := srv.Consumer.Receive(ctxWithTimeout)
+			msgs, err := eventlog.ReceiveBatch(ctxWithTimeout, srv.Consumer, srv.BatchSize, srv.FlushInterval)
 			cancel()
 			if errors.Is(err, context.DeadlineExceeded) {
 				break // expected
@@ -103,76 +295,179 @@ func (srv *SubmitFromLog) Run(ctx *armadacontext.Context) error {
 			// If receiving fails, try again in the hope that the problem is transient.
 			// We don't need to distinguish between errors here, since any error means this function can't proceed.
 			if err != nil {
-				logging.WithStacktrace(log, err).WithField("lastMessageId", lastMessageId).Warnf("Pulsar receive failed; backing off")
+				logging.WithStacktrace(log, err).Warnf("event log receive failed; backing off")
 				time.Sleep(100 * time.Millisecond)
 				break
 			}
 
-			// If this message isn't for us we can simply ack it
-			// and go to the next message
-			if !schedulers.ForLegacyScheduler(msg) {
-				srv.ack(ctx, msg)
-				break
+			for _, msg := range msgs {
+				handle(msg)
 			}
+		}
+	}
+}
 
-			lastMessageId = msg.ID()
-			lastPublishTime = msg.PublishTime()
-			numReceived++
-
-			ctxWithLogger := armadacontext.WithLogField(ctx, "messageId", msg.ID())
-
-			// Unmarshal and validate the message.
-			sequence, err := eventutil.UnmarshalEventSequence(ctxWithLogger, msg.Payload())
-			if err != nil {
-				srv.ack(ctx, msg)
-				logging.WithStacktrace(ctxWithLogger, err).Warnf("processing message failed; ignoring")
-				numErrored++
-				break
-			}
+// processMessage applies the events of sequence, which was unmarshalled from msg.
+// Events that can't be applied are dead-lettered. Returns true if all events were applied successfully.
+func (srv *SubmitFromLog) processMessage(ctx *armadacontext.Context, msg eventlog.Message, sequence *armadaevents.EventSequence) bool {
+	// Skip events already applied, e.g., if the message was re-delivered after a crash.
+	messageId := msg.ID().String()
+	numProcessed := srv.getNumProcessed(ctx, messageId)
+	if numProcessed >= len(sequence.Events) && len(sequence.Events) > 0 {
+		ctx.Info("all events of message have already been applied; skipping")
+		return true
+	} else if numProcessed > 0 {
+		ctx.WithField("numSkipped", numProcessed).Info("skipping events that have already been applied")
+	}
 
-			ctxWithLogger.WithField("numEvents", len(sequence.Events)).Info("processing sequence")
-			// TODO: Improve retry logic.
-			srv.ProcessSequence(ctxWithLogger, sequence)
-			srv.ack(ctx, msg)
+	ctx.WithField("numEvents", len(sequence.Events)).Info("processing sequence")
+	ok := true
+	srv.processSequence(ctx, sequence, numProcessed, func(j int, failure *failedEvents) {
+		if failure != nil {
+			ok = false
+			srv.deadLetter(ctx, eventlog.DeadLetter{
+				Message:    msg,
+				Payload:    failedSequencePayload(ctx, sequence, failure.events),
+				Error:      failure.err,
+				RetryCount: failure.retryCount,
+			})
 		}
+		srv.FaultInjector.Crash(chaos.CrashPointSubmitFromLogBeforeRecordingProgress)
+		srv.setNumProcessed(ctx, messageId, j)
+	})
+	return ok
+}
+
+// failedSequencePayload returns the payload of a dead letter containing the provided events of sequence.
+func failedSequencePayload(ctx *armadacontext.Context, sequence *armadaevents.EventSequence, events []*armadaevents.EventSequence_Event) []byte {
+	payload, err := eventutil.MarshalEventSequence(&armadaevents.EventSequence{
+		Queue:      sequence.Queue,
+		JobSetName: sequence.JobSetName,
+		UserId:     sequence.UserId,
+		Groups:     sequence.Groups,
+		Events:     events,
+	})
+	if err != nil {
+		logging.WithStacktrace(ctx, err).Error("failed to marshal dead letter")
 	}
+	return payload
 }
 
 // ProcessSequence processes all events in a particular sequence.
 // For efficiency, we may process several events at a time.
 // To maintain ordering, we only do so for subsequences of consecutive events of equal type.
-// The returned bool indicates if the corresponding Pulsar message should be ack'd or not.
+// The returned bool indicates if all events were applied successfully.
 func (srv *SubmitFromLog) ProcessSequence(ctx *armadacontext.Context, sequence *armadaevents.EventSequence) bool {
+	ok := true
+	srv.processSequence(ctx, sequence, 0, func(_ int, failure *failedEvents) {
+		if failure != nil {
+			ok = false
+		}
+	})
+	return ok
+}
+
+// failedEvents is a subsequence of events that couldn't be applied.
+type failedEvents struct {
+	events     []*armadaevents.EventSequence_Event
+	err        error
+	retryCount int
+}
+
+// processSequence processes the events of sequence from index start onwards as described for ProcessSequence.
+// After each subsequence of events has been handled, progress is called with the index of the next event to be handled,
+// together with the failed events if the subsequence couldn't be applied because of a permanent error.
+// Once the retry policy is exhausted, progress is called a final time with all remaining events as failed.
+// Hence, all events from start onwards have been either applied or reported as failed once processSequence returns.
+func (srv *SubmitFromLog) processSequence(
+	ctx *armadacontext.Context,
+	sequence *armadaevents.EventSequence,
+	start int,
+	progress func(j int, failure *failedEvents),
+) {
 	// Sub-functions should always increment the events index unless they experience a transient error.
 	// However, if a permanent error is mis-categorised as transient, we may get stuck forever.
-	// To avoid that issue, we return immediately if timeout time has passed
-	// (i.e., if processing a sequence takes more than timeout time, some events may be ignored).
-	// If no events were processed, the corresponding Pulsar message won't be ack'd.
-	timeout := 5 * time.Minute
+	// To avoid that issue, we give up once the retry policy is exhausted.
+	policy := srv.retryPolicy()
 	lastProgress := time.Now()
 
-	i := 0
-	for i < len(sequence.Events) && time.Since(lastProgress) < timeout {
+	var lastErr error
+	giveUpReason := ""
+	numRetries := 0 // Across the whole sequence.
+	retryCount := 0 // Of the current subsequence.
+	i := start
+	for i < len(sequence.Events) {
 		j, err := srv.ProcessSubSequence(ctx, i, sequence)
 		if err != nil {
-			logging.WithStacktrace(ctx, err).WithFields(logrus.Fields{"lowerIndex": i, "upperIndex": j}).Warnf("processing subsequence failed; ignoring")
+			messageWarnings.Warnf(logging.WithStacktrace(ctx, err).WithFields(logging.Fields{"lowerIndex": i, "upperIndex": j}), "processing subsequence failed")
+			lastErr = err
 		}
 
 		if j == i {
-			ctx.WithFields(logrus.Fields{"lowerIndex": i, "upperIndex": j}).Info("made no progress")
-
 			// We should only get here if a transient error occurs.
-			// Sleep for a bit before retrying.
-			time.Sleep(time.Second)
+			metrics.RecordEventProcessingFailure(metrics.TransientEventProcessingFailure)
+			if policy.MaxRetries > 0 && numRetries >= policy.MaxRetries {
+				giveUpReason = fmt.Sprintf("gave up after %d retries", numRetries)
+				break
+			}
+			if time.Since(lastProgress) >= policy.Timeout {
+				giveUpReason = fmt.Sprintf("gave up after making no progress for %s", policy.Timeout)
+				break
+			}
+			backoff := retryBackoff(policy, retryCount)
+			ctx.WithFields(logging.Fields{"lowerIndex": i, "upperIndex": j, "backoff": backoff}).Info("made no progress")
+			numRetries++
+			retryCount++
+			time.Sleep(backoff)
 		} else {
+			if err != nil {
+				metrics.RecordEventProcessingFailure(metrics.PermanentEventProcessingFailure)
+				progress(j, &failedEvents{events: sequence.Events[i:j], err: err, retryCount: retryCount})
+			} else {
+				progress(j, nil)
+			}
 			lastProgress = time.Now()
+			lastErr = nil
+			retryCount = 0
 		}
 		i = j
 	}
+	if i < len(sequence.Events) {
+		err := errors.New(giveUpReason)
+		if lastErr != nil {
+			err = errors.Wrap(lastErr, giveUpReason)
+		}
+		metrics.RecordEventProcessingFailure(metrics.ExhaustedEventProcessingFailure)
+		progress(len(sequence.Events), &failedEvents{events: sequence.Events[i:], err: err, retryCount: retryCount})
+	}
+}
 
-	// To avoid applying the same event more than once, ack messages if at least 1 event was applied.
-	// Or if the sequence contained no events.
-	return i > 0 || len(sequence.Events) == 0
+// retryPolicy returns srv.RetryPolicy, with defaults in place of unset values.
+func (srv *SubmitFromLog) retryPolicy() configuration.RetryPolicyConfig {
+	policy := srv.RetryPolicy
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = time.Second
+	}
+	if policy.Timeout <= 0 {
+		policy.Timeout = 5 * time.Minute
+	}
+	if policy.MaxBackoff < policy.InitialBackoff {
+		policy.MaxBackoff = policy.InitialBackoff
+	}
+	return policy
+}
+
+// retryBackoff returns the time to wait before the retry following the provided number of retries,
+// which doubles with each retry up to the maximum backoff of the policy.
+func retryBackoff(policy configuration.RetryPolicyConfig, numRetries int) time.Duration {
+	backoff := policy.InitialBackoff
+	for k := 0; k < numRetries && backoff < policy.MaxBackoff; k++ {
+		backoff *= 2
+	}
+	if backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
+	}
+	return backoff
 }
 
 // ProcessSubSequence processes sequence.Events[i:j-1], where j is the index of the first event in the sequence
@@ -329,12 +624,12 @@ func collectEvents[T any](ctx *armadacontext.Context, i int, sequence *armadaeve
 	return events
 }
 
-func (srv *SubmitFromLog) getLogger() *logrus.Entry {
-	var log *logrus.Entry
+func (srv *SubmitFromLog) getLogger() logging.Logger {
+	var log logging.Logger
 	if srv.Logger != nil {
 		log = srv.Logger.WithField("service", "SubmitFromLog")
 	} else {
-		log = logrus.StandardLogger().WithField("service", "SubmitFromLog")
+		log = logging.ForModule("submitfromlog").WithField("service", "SubmitFromLog")
 	}
 	return log
 }
@@ -359,18 +654,18 @@ func (srv *SubmitFromLog) SubmitJobs(
 	if err != nil {
 		return true, err
 	}
+	deduplicateOnIdempotencyKeys(jobs)
 
-	log := srv.getLogger()
 	compressor, err := srv.SubmitServer.compressorPool.BorrowObject(armadacontext.Background())
 	if err != nil {
 		return false, err
 	}
-	defer func(compressorPool *pool.ObjectPool, ctx *armadacontext.Context, object interface{}) {
-		err := compressorPool.ReturnObject(ctx, object)
+	defer func(compressorPool *pool.ObjectPool, object interface{}) {
+		err := compressorPool.ReturnObject(armadacontext.Background(), object)
 		if err != nil {
-			log.WithError(err).Errorf("Error returning compressor to pool")
+			ctx.WithError(err).Errorf("Error returning compressor to pool")
 		}
-	}(srv.SubmitServer.compressorPool, armadacontext.Background(), compressor)
+	}(srv.SubmitServer.compressorPool, compressor)
 
 	compressedOwnershipGroups, err := compress.CompressStringArray(groups, compressor.(compress.Compressor))
 	if err != nil {
@@ -384,7 +679,17 @@ func (srv *SubmitFromLog) SubmitJobs(
 	// Submit the jobs by writing them to the database.
 	// If an error occurs here, there was a problem writing to the database and we mark all jobs as failed.
 	// Unless the error is network-related, in which case we return an error so that the caller can try again later.
-	submissionResults, err := srv.SubmitServer.jobRepository.AddJobs(jobs)
+	var submissionResults []*repository.SubmitJobResult
+	if srv.UseOutbox {
+		var events []*repository.OutboxEvent
+		events, err = queuedOutboxEvents(jobs)
+		if err != nil {
+			return true, err
+		}
+		submissionResults, err = srv.SubmitServer.jobRepository.AddJobsWithOutboxEvents(jobs, events)
+	} else {
+		submissionResults, err = srv.SubmitServer.jobRepository.AddJobs(jobs)
+	}
 	if armadaerrors.IsNetworkError(err) {
 		return false, err
 	} else if err != nil {
@@ -409,7 +714,7 @@ func (srv *SubmitFromLog) SubmitJobs(
 				reason: fmt.Sprintf("Failed to save job in Armada: %s", submissionResult.Error.Error()),
 			})
 		} else if submissionResult.AlreadyProcessed {
-			log.Warnf("Already Processed job id %s, this job submission will be discarded", submissionResult.JobId)
+			ctx.WithFields(logging.JobFields(queueName, jobSetName, submissionResult.JobId)).Warn("Already Processed job, this job submission will be discarded")
 		} else if submissionResult.DuplicateDetected {
 			doubleSubmits = append(doubleSubmits, submissionResult)
 		} else {
@@ -428,8 +733,10 @@ func (srv *SubmitFromLog) SubmitJobs(
 	err = reportDuplicateDetected(srv.SubmitServer.eventStore, doubleSubmits)
 	result = multierror.Append(result, err)
 
-	err = reportQueued(srv.SubmitServer.eventStore, createdJobs)
-	result = multierror.Append(result, err)
+	if !srv.UseOutbox {
+		err = reportQueued(srv.SubmitServer.eventStore, createdJobs)
+		result = multierror.Append(result, err)
+	}
 
 	return true, result.ErrorOrNil()
 }
@@ -497,7 +804,29 @@ func (srv *SubmitFromLog) BatchedCancelJobsById(ctx *armadacontext.Context, user
 	// In case of network error, we indicate the events were not processed.
 	// Because some batches may have already been processed, retrying may cause jobs to be cancelled multiple times.
 	// However, that should be fine.
-	batches := util.Batch(cancelJobPayloads, srv.SubmitServer.cancelJobsBatchSize)
+	//
+	// With a sharded job repository, each batch is made up of jobs stored on the same shard,
+	// such that each batch is cancelled with a single round trip to a single shard.
+	var batches [][]*CancelJobPayload
+	if sharder, ok := srv.SubmitServer.jobRepository.(repository.JobIdSharder); ok {
+		var jobIds []string
+		payloadsById := make(map[string][]*CancelJobPayload, len(cancelJobPayloads))
+		for _, payload := range cancelJobPayloads {
+			if _, ok := payloadsById[payload.JobId]; !ok {
+				jobIds = append(jobIds, payload.JobId)
+			}
+			payloadsById[payload.JobId] = append(payloadsById[payload.JobId], payload)
+		}
+		for _, jobIds := range sharder.ShardJobIds(jobIds) {
+			var shardPayloads []*CancelJobPayload
+			for _, jobId := range jobIds {
+				shardPayloads = append(shardPayloads, payloadsById[jobId]...)
+			}
+			batches = append(batches, util.Batch(shardPayloads, srv.SubmitServer.cancelJobsBatchSize)...)
+		}
+	} else {
+		batches = util.Batch(cancelJobPayloads, srv.SubmitServer.cancelJobsBatchSize)
+	}
 	for _, batch := range batches {
 		_, err := srv.CancelJobsById(ctx, userId, batch)
 		if armadaerrors.IsNetworkError(err) {
@@ -587,34 +916,39 @@ func (srv *SubmitFromLog) ReprioritizeJobs(ctx *armadacontext.Context, userId st
 		}
 		jobIds[i] = id
 	}
-	jobs, err := srv.SubmitServer.jobRepository.GetExistingJobsByIds(jobIds)
-	if armadaerrors.IsNetworkError(err) {
-		return false, err
-	} else if err != nil {
-		return true, err
-	}
 
 	// The submit API guarantees that all events specify the same priority.
 	newPriority := es[0].Priority
 	for _, e := range es {
 		if e.Priority != newPriority {
-			err = errors.Errorf("all ReprioritiseJob events must have the same priority")
+			err := errors.Errorf("all ReprioritiseJob events must have the same priority")
 			return true, errors.WithStack(err)
 		}
 	}
 
-	err = reportJobsReprioritizing(srv.SubmitServer.eventStore, userId, jobs, float64(newPriority))
-	if armadaerrors.IsNetworkError(err) {
-		return false, err
-	} else if err != nil {
-		return true, err
-	}
+	// Jobs are updated in batches, such that reprioritising many jobs at once, e.g., by filter,
+	// doesn't load all of them into memory at once. Batches are the same size as for cancellation.
+	for _, batch := range util.Batch(jobIds, srv.SubmitServer.cancelJobsBatchSize) {
+		jobs, err := srv.SubmitServer.jobRepository.GetExistingJobsByIds(batch)
+		if armadaerrors.IsNetworkError(err) {
+			return false, err
+		} else if err != nil {
+			return true, err
+		}
 
-	_, err = srv.SubmitServer.reprioritizeJobs(jobIds, float64(newPriority), userId)
-	if armadaerrors.IsNetworkError(err) {
-		return false, err
-	} else if err != nil {
-		return true, err
+		err = reportJobsReprioritizing(srv.SubmitServer.eventStore, userId, jobs, float64(newPriority))
+		if armadaerrors.IsNetworkError(err) {
+			return false, err
+		} else if err != nil {
+			return true, err
+		}
+
+		_, err = srv.SubmitServer.reprioritizeJobs(ctx, batch, float64(newPriority), userId)
+		if armadaerrors.IsNetworkError(err) {
+			return false, err
+		} else if err != nil {
+			return true, err
+		}
 	}
 
 	return true, nil
@@ -658,12 +992,12 @@ func (srv *SubmitFromLog) UpdateJobStartTimes(ctx *armadacontext.Context, es []*
 		}
 		jobId, err := armadaevents.UlidStringFromProtoUuid(jobRun.GetJobId())
 		if err != nil {
-			logrus.WithError(err).Warnf("Invalid job id received when processing jobRunRunning Message")
+			messageWarnings.Warnf(ctx.WithError(err), "Invalid job id received when processing jobRunRunning Message")
 			continue
 		}
 
 		if event.Created == nil {
-			logrus.WithError(err).Warnf("Job run event for job %s has a missing timestamp.  Ignoring.", jobId)
+			messageWarnings.Warnf(ctx.WithField(logging.JobIdField, jobId), "Job run event has a missing timestamp.  Ignoring.")
 			continue
 		}
 		clusterId := ""
@@ -742,7 +1076,7 @@ func (srv *SubmitFromLog) ReprioritizeJobSet(
 		return true, err
 	}
 
-	_, err = srv.SubmitServer.reprioritizeJobs(jobIds, float64(e.Priority), userId)
+	_, err = srv.SubmitServer.reprioritizeJobs(ctx, jobIds, float64(e.Priority), userId)
 	if armadaerrors.IsNetworkError(err) {
 		return false, err
 	} else if err != nil {
@@ -752,14 +1086,90 @@ func (srv *SubmitFromLog) ReprioritizeJobSet(
 	return true, nil
 }
 
-func (srv *SubmitFromLog) ack(ctx *armadacontext.Context, msg pulsar.Message) {
+// getNumProcessed returns the number of events of the message with the provided id that have already been applied,
+// or 0 if no ProcessedEventRepository is configured.
+func (srv *SubmitFromLog) getNumProcessed(ctx *armadacontext.Context, messageId string) int {
+	if srv.ProcessedEvents == nil {
+		return 0
+	}
+	numProcessed := 0
+	util.RetryUntilSuccess(
+		ctx,
+		func() error {
+			var err error
+			numProcessed, err = srv.ProcessedEvents.GetNumProcessed(messageId)
+			return err
+		},
+		func(err error) {
+			messageWarnings.Warnf(ctx.WithError(err), "Error reading processed events of event log message")
+			time.Sleep(time.Second)
+		},
+	)
+	return numProcessed
+}
+
+// setNumProcessed records that the first numProcessed events of the message with the provided id have been applied,
+// if a ProcessedEventRepository is configured.
+func (srv *SubmitFromLog) setNumProcessed(ctx *armadacontext.Context, messageId string, numProcessed int) {
+	if srv.ProcessedEvents == nil {
+		return
+	}
+	util.RetryUntilSuccess(
+		ctx,
+		func() error {
+			return srv.ProcessedEvents.SetNumProcessed(messageId, numProcessed)
+		},
+		func(err error) {
+			messageWarnings.Warnf(ctx.WithError(err), "Error recording processed events of event log message")
+			time.Sleep(time.Second)
+		},
+	)
+}
+
+// deadLetter adds letter to the dead-letter queue, if one is configured.
+func (srv *SubmitFromLog) deadLetter(ctx *armadacontext.Context, letter eventlog.DeadLetter) {
+	if srv.DeadLetterQueue == nil {
+		return
+	}
+	util.RetryUntilSuccess(
+		ctx,
+		func() error {
+			return srv.DeadLetterQueue.Add(ctx, letter)
+		},
+		func(err error) {
+			messageWarnings.Warnf(ctx.WithError(err), "Error adding event log message to dead-letter queue")
+			time.Sleep(time.Second)
+		},
+	)
+}
+
+// ackCumulative acks msgs, and all messages received before them, with a single ack per partition.
+func (srv *SubmitFromLog) ackCumulative(ctx *armadacontext.Context, acker eventlog.CumulativeAcker, msgs []eventlog.Message) {
+	if len(msgs) == 0 {
+		return
+	}
+	srv.FaultInjector.Crash(chaos.CrashPointSubmitFromLogBeforeAck)
+	util.RetryUntilSuccess(
+		ctx,
+		func() error {
+			return acker.AckCumulative(msgs)
+		},
+		func(err error) {
+			messageWarnings.Warnf(ctx.WithError(err), "Error acking event log messages")
+			time.Sleep(time.Second)
+		},
+	)
+}
+
+func (srv *SubmitFromLog) ack(ctx *armadacontext.Context, msg eventlog.Message) {
+	srv.FaultInjector.Crash(chaos.CrashPointSubmitFromLogBeforeAck)
 	util.RetryUntilSuccess(
 		ctx,
 		func() error {
 			return srv.Consumer.Ack(msg)
 		},
 		func(err error) {
-			logrus.WithError(err).Warnf("Error acking pulsar message")
+			messageWarnings.Warnf(ctx.WithError(err), "Error acking event log message")
 			time.Sleep(time.Second)
 		},
 	)
@@ -1,13 +1,23 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/chaos"
+	chaosconfig "github.com/armadaproject/armada/internal/common/chaos/configuration"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/ingest/testfixtures"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
@@ -82,3 +92,366 @@ func TestUpdateJobStartTimes_RedisError(t *testing.T) {
 	_, exists := jobRepo.jobStartTimeInfos[testfixtures.JobIdString]
 	assert.False(t, exists)
 }
+
+func TestProcessSequence_StartsAtProvidedIndex(t *testing.T) {
+	sequence := &armadaevents.EventSequence{
+		Queue:      "queue",
+		JobSetName: "jobSet",
+		Events: []*armadaevents.EventSequence_Event{
+			events[0],
+			{Event: &armadaevents.EventSequence_Event_JobRunLeased{JobRunLeased: &armadaevents.JobRunLeased{}}},
+			events[0],
+		},
+	}
+	tests := map[string]struct {
+		start            int
+		expectedProgress []int
+		expectStartTime  bool
+	}{
+		"from the start": {
+			start:            0,
+			expectedProgress: []int{1, 2, 3},
+			expectStartTime:  true,
+		},
+		"partially processed": {
+			start:            2,
+			expectedProgress: []int{3},
+			expectStartTime:  true,
+		},
+		"fully processed": {
+			start:            3,
+			expectedProgress: nil,
+			expectStartTime:  false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			jobRepo := newMockJobRepository()
+			s := SubmitFromLog{
+				SubmitServer: &SubmitServer{
+					jobRepository: jobRepo,
+				},
+			}
+			var progress []int
+			s.processSequence(armadacontext.Background(), sequence, tc.start, func(j int, failure *failedEvents) {
+				assert.Nil(t, failure)
+				progress = append(progress, j)
+			})
+			assert.Equal(t, tc.expectedProgress, progress)
+			_, exists := jobRepo.jobStartTimeInfos[testfixtures.JobIdString]
+			assert.Equal(t, tc.expectStartTime, exists)
+		})
+	}
+}
+
+type fakeEventLogMessage struct {
+	id         int
+	payload    []byte
+	properties map[string]string
+}
+
+func (msg *fakeEventLogMessage) ID() fmt.Stringer {
+	return stringer(strconv.Itoa(msg.id))
+}
+
+func (msg *fakeEventLogMessage) Payload() []byte {
+	return msg.payload
+}
+
+func (msg *fakeEventLogMessage) PublishTime() time.Time {
+	return time.Time{}
+}
+
+func (msg *fakeEventLogMessage) Properties() map[string]string {
+	return msg.properties
+}
+
+type stringer string
+
+func (s stringer) String() string {
+	return string(s)
+}
+
+type fakeEventLogConsumer struct {
+	mu                sync.Mutex
+	messages          []eventlog.Message
+	acked             []eventlog.Message
+	cumulativelyAcked []eventlog.Message
+}
+
+func (c *fakeEventLogConsumer) Receive(ctx context.Context) (eventlog.Message, error) {
+	c.mu.Lock()
+	if len(c.messages) == 0 {
+		c.mu.Unlock()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	defer c.mu.Unlock()
+	msg := c.messages[0]
+	c.messages = c.messages[1:]
+	return msg, nil
+}
+
+func (c *fakeEventLogConsumer) Ack(msg eventlog.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.acked = append(c.acked, msg)
+	return nil
+}
+
+func (c *fakeEventLogConsumer) AckCumulative(msgs []eventlog.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cumulativelyAcked = append(c.cumulativelyAcked, msgs...)
+	return nil
+}
+
+func TestSubmitFromLog_Run_Acks(t *testing.T) {
+	for _, cumulativeAck := range []bool{false, true} {
+		t.Run(fmt.Sprintf("cumulative ack %t", cumulativeAck), func(t *testing.T) {
+			// Messages for another scheduler are acked without being processed.
+			var messages []eventlog.Message
+			for i := 0; i < 5; i++ {
+				messages = append(messages, &fakeEventLogMessage{id: i, properties: map[string]string{"schedulerName": "pulsar"}})
+			}
+			consumer := &fakeEventLogConsumer{messages: append([]eventlog.Message(nil), messages...)}
+			s := SubmitFromLog{
+				Consumer:      consumer,
+				BatchSize:     2,
+				FlushInterval: 10 * time.Millisecond,
+				CumulativeAck: cumulativeAck,
+			}
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 200*time.Millisecond)
+			defer cancel()
+			require.NoError(t, s.Run(ctx))
+
+			if cumulativeAck {
+				assert.Empty(t, consumer.acked)
+				assert.Equal(t, messages, consumer.cumulativelyAcked)
+			} else {
+				assert.Equal(t, messages, consumer.acked)
+				assert.Empty(t, consumer.cumulativelyAcked)
+			}
+		})
+	}
+}
+
+func TestSubmitFromLog_Run_ReachesCrashPoints(t *testing.T) {
+	var messages []eventlog.Message
+	for i := 0; i < 3; i++ {
+		messages = append(messages, &fakeEventLogMessage{id: i, properties: map[string]string{"schedulerName": "pulsar"}})
+	}
+	faults := chaos.NewFaultInjector(chaosconfig.FaultInjectionConfig{
+		Enabled:                 true,
+		Seed:                    1,
+		CrashProbabilityByPoint: map[string]float64{chaos.CrashPointSubmitFromLogBeforeAck: 1},
+	})
+	var mu sync.Mutex
+	var crashes []string
+	faults.SetCrashHandler(func(point string) {
+		mu.Lock()
+		defer mu.Unlock()
+		crashes = append(crashes, point)
+	})
+	s := SubmitFromLog{
+		Consumer:      faults.WrapConsumer(&fakeEventLogConsumer{messages: messages}),
+		BatchSize:     2,
+		FlushInterval: 10 * time.Millisecond,
+		FaultInjector: faults,
+	}
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 200*time.Millisecond)
+	defer cancel()
+	require.NoError(t, s.Run(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{
+		chaos.CrashPointSubmitFromLogBeforeAck,
+		chaos.CrashPointSubmitFromLogBeforeAck,
+		chaos.CrashPointSubmitFromLogBeforeAck,
+	}, crashes)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := configuration.RetryPolicyConfig{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	var backoffs []time.Duration
+	for i := 0; i < 5; i++ {
+		backoffs = append(backoffs, retryBackoff(policy, i))
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, backoffs)
+}
+
+func TestProcessSequence_GivesUpAfterMaxRetries(t *testing.T) {
+	jobRepo := newMockJobRepository()
+	jobRepo.redisError = fmt.Errorf("redis error")
+	s := SubmitFromLog{
+		SubmitServer: &SubmitServer{
+			jobRepository: jobRepo,
+		},
+		RetryPolicy: configuration.RetryPolicyConfig{
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Millisecond,
+			MaxRetries:     3,
+		},
+	}
+	sequence := &armadaevents.EventSequence{Queue: "queue", JobSetName: "jobSet", Events: events}
+
+	var failures []*failedEvents
+	var progress []int
+	s.processSequence(armadacontext.Background(), sequence, 0, func(j int, failure *failedEvents) {
+		progress = append(progress, j)
+		failures = append(failures, failure)
+	})
+	assert.Equal(t, []int{1}, progress)
+	require.Len(t, failures, 1)
+	require.NotNil(t, failures[0])
+	assert.Equal(t, events, failures[0].events)
+	assert.Equal(t, 3, failures[0].retryCount)
+	assert.ErrorContains(t, failures[0].err, "gave up after 3 retries")
+	assert.ErrorContains(t, failures[0].err, "redis error")
+}
+
+// nackingEventLogConsumer is a fakeEventLogConsumer that also supports nacking messages.
+type nackingEventLogConsumer struct {
+	fakeEventLogConsumer
+	nacked []eventlog.Message
+}
+
+func (c *nackingEventLogConsumer) Nack(msg eventlog.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nacked = append(c.nacked, msg)
+	return nil
+}
+
+// panickingProcessedEventRepository panics when called, such that processing any message panics.
+type panickingProcessedEventRepository struct{}
+
+func (panickingProcessedEventRepository) GetNumProcessed(string) (int, error) {
+	panic("failed to read processed events")
+}
+
+func (panickingProcessedEventRepository) SetNumProcessed(string, int) error {
+	panic("failed to record processed events")
+}
+
+func TestSubmitFromLog_Run_DoesNotAckMessagesWhoseProcessingPanicked(t *testing.T) {
+	for _, cumulativeAck := range []bool{false, true} {
+		t.Run(fmt.Sprintf("cumulative ack %t", cumulativeAck), func(t *testing.T) {
+			payload, err := eventutil.MarshalEventSequence(&armadaevents.EventSequence{Queue: "queue", JobSetName: "jobSet", Events: events})
+			require.NoError(t, err)
+			messages := []eventlog.Message{&fakeEventLogMessage{id: 0, payload: payload}}
+			consumer := &nackingEventLogConsumer{fakeEventLogConsumer: fakeEventLogConsumer{messages: messages}}
+			s := SubmitFromLog{
+				Consumer:        consumer,
+				ProcessedEvents: panickingProcessedEventRepository{},
+				Parallelism:     2,
+				BatchSize:       2,
+				FlushInterval:   10 * time.Millisecond,
+				CumulativeAck:   cumulativeAck,
+			}
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 200*time.Millisecond)
+			defer cancel()
+			require.NoError(t, s.Run(ctx))
+
+			assert.Empty(t, consumer.acked)
+			assert.Empty(t, consumer.cumulativelyAcked)
+			if cumulativeAck {
+				// Without a dead-letter queue, nacking the message would allow acking cumulatively past it,
+				// so it's left unacked instead.
+				assert.Empty(t, consumer.nacked)
+			} else {
+				assert.Equal(t, messages, consumer.nacked)
+			}
+		})
+	}
+}
+
+// fakeDeadLetterQueue records the dead letters added to it.
+type fakeDeadLetterQueue struct {
+	mu      sync.Mutex
+	letters []eventlog.DeadLetter
+}
+
+func (q *fakeDeadLetterQueue) Add(_ *armadacontext.Context, letter eventlog.DeadLetter) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.letters = append(q.letters, letter)
+	return nil
+}
+
+func TestSubmitFromLog_Run_AcksCumulativelyPastMessagesWhoseProcessingPanicked(t *testing.T) {
+	payload, err := eventutil.MarshalEventSequence(&armadaevents.EventSequence{Queue: "queue", JobSetName: "jobSet", Events: events})
+	require.NoError(t, err)
+	// Processing the first message panics; the messages after it are for another scheduler.
+	messages := []eventlog.Message{&fakeEventLogMessage{id: 0, payload: payload}}
+	for i := 1; i < 5; i++ {
+		messages = append(messages, &fakeEventLogMessage{id: i, properties: map[string]string{"schedulerName": "pulsar"}})
+	}
+	consumer := &nackingEventLogConsumer{fakeEventLogConsumer: fakeEventLogConsumer{messages: append([]eventlog.Message(nil), messages...)}}
+	deadLetterQueue := &fakeDeadLetterQueue{}
+	s := SubmitFromLog{
+		Consumer:        consumer,
+		DeadLetterQueue: deadLetterQueue,
+		ProcessedEvents: panickingProcessedEventRepository{},
+		Parallelism:     2,
+		BatchSize:       2,
+		FlushInterval:   10 * time.Millisecond,
+		CumulativeAck:   true,
+	}
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 200*time.Millisecond)
+	defer cancel()
+	require.NoError(t, s.Run(ctx))
+
+	// The message whose processing panicked is dead-lettered, and the messages after it are acked.
+	require.Len(t, deadLetterQueue.letters, 1)
+	assert.Equal(t, messages[0], deadLetterQueue.letters[0].Message)
+	assert.Empty(t, consumer.nacked)
+	assert.Empty(t, consumer.acked)
+	assert.Equal(t, messages, consumer.cumulativelyAcked)
+}
+
+func TestSubmitFromLog_Run_RedeliversMessagesWhoseProcessingPanickedWithoutDeadLetterQueue(t *testing.T) {
+	payload, err := eventutil.MarshalEventSequence(&armadaevents.EventSequence{Queue: "queue", JobSetName: "jobSet", Events: events})
+	require.NoError(t, err)
+	// Processing the first message panics; the messages after it are for another scheduler.
+	messages := []eventlog.Message{&fakeEventLogMessage{id: 0, payload: payload}}
+	for i := 1; i < 5; i++ {
+		messages = append(messages, &fakeEventLogMessage{id: i, properties: map[string]string{"schedulerName": "pulsar"}})
+	}
+	consumer := &nackingEventLogConsumer{fakeEventLogConsumer: fakeEventLogConsumer{messages: append([]eventlog.Message(nil), messages...)}}
+	s := SubmitFromLog{
+		Consumer:        consumer,
+		ProcessedEvents: panickingProcessedEventRepository{},
+		Parallelism:     2,
+		BatchSize:       2,
+		FlushInterval:   10 * time.Millisecond,
+		CumulativeAck:   true,
+	}
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 200*time.Millisecond)
+	defer cancel()
+	require.NoError(t, s.Run(ctx))
+
+	// Nothing is acked past the message whose processing panicked.
+	assert.Empty(t, consumer.acked)
+	assert.Empty(t, consumer.nacked)
+	assert.Empty(t, consumer.cumulativelyAcked)
+
+	// After restarting, the messages that weren't acked are redelivered; this time, processing succeeds.
+	jobRepo := newMockJobRepository()
+	redelivered := &fakeEventLogConsumer{messages: append([]eventlog.Message(nil), messages...)}
+	s = SubmitFromLog{
+		SubmitServer:  &SubmitServer{jobRepository: jobRepo},
+		Consumer:      redelivered,
+		Parallelism:   2,
+		BatchSize:     2,
+		FlushInterval: 10 * time.Millisecond,
+		CumulativeAck: true,
+	}
+	ctx, cancel = armadacontext.WithTimeout(armadacontext.Background(), 200*time.Millisecond)
+	defer cancel()
+	require.NoError(t, s.Run(ctx))
+
+	assert.Equal(t, messages, redelivered.cumulativelyAcked)
+	assert.Contains(t, jobRepo.jobStartTimeInfos, testfixtures.JobIdString)
+}
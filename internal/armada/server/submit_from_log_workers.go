@@ -0,0 +1,144 @@
+package server
+
+import (
+	"hash/fnv"
+	"sync"
+
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/logging"
+)
+
+// Capacity of the queue of each worker; once full, receiving further messages for that worker blocks.
+const workerQueueSize = 100
+
+// partitionedWorkerPool runs tasks concurrently on a fixed number of workers,
+// where all tasks with the same key run on the same worker in the order they were submitted.
+type partitionedWorkerPool struct {
+	queues []chan func()
+	wg     sync.WaitGroup
+	log    logging.Logger
+}
+
+// newPartitionedWorkerPool returns a pool with the provided number of workers.
+// If parallelism is less than 2, no workers are started and tasks run on the submitting goroutine.
+func newPartitionedWorkerPool(parallelism int, log logging.Logger) *partitionedWorkerPool {
+	p := &partitionedWorkerPool{log: log}
+	if parallelism < 2 {
+		return p
+	}
+	p.queues = make([]chan func(), parallelism)
+	for i := range p.queues {
+		queue := make(chan func(), workerQueueSize)
+		p.queues[i] = queue
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for task := range queue {
+				p.run(task)
+			}
+		}()
+	}
+	return p
+}
+
+// submit schedules task to run after all previously submitted tasks with the same key.
+func (p *partitionedWorkerPool) submit(key string, task func()) {
+	if len(p.queues) == 0 {
+		task()
+		return
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	p.queues[h.Sum32()%uint32(len(p.queues))] <- task
+}
+
+// run runs task, logging rather than propagating panics, such that a worker survives a failed task.
+// Tasks are responsible for not acking messages whose processing panicked.
+func (p *partitionedWorkerPool) run(task func()) {
+	defer func() {
+		if err := recover(); err != nil {
+			p.log.WithField("error", err).Error("unexpected panic in worker")
+		}
+	}()
+	task()
+}
+
+// stop waits for all submitted tasks to finish. No tasks may be submitted after calling stop.
+func (p *partitionedWorkerPool) stop() {
+	for _, queue := range p.queues {
+		close(queue)
+	}
+	p.wg.Wait()
+}
+
+// ackTracker tracks messages that may finish processing in a different order than they were received in,
+// to determine the last message such that it and all messages received before it have finished processing.
+// This cumulative position indicates how far processing has caught up with the log,
+// and is the position up to which messages are acked when acking cumulatively.
+type ackTracker struct {
+	mu       sync.Mutex
+	inFlight []*trackedMessage
+	last     eventlog.Message
+	// If true, messages up to the cumulative position are collected, to be returned by takeFinished.
+	collectFinished bool
+	finished        []eventlog.Message
+}
+
+type trackedMessage struct {
+	msg  eventlog.Message
+	done bool
+}
+
+// add records that msg has been received and returns a handle with which to mark it as finished.
+func (t *ackTracker) add(msg eventlog.Message) *trackedMessage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tracked := &trackedMessage{msg: msg}
+	t.inFlight = append(t.inFlight, tracked)
+	return tracked
+}
+
+// done records that a message has finished processing.
+func (t *ackTracker) done(tracked *trackedMessage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tracked.done = true
+	i := 0
+	for i < len(t.inFlight) && t.inFlight[i].done {
+		t.last = t.inFlight[i].msg
+		if t.collectFinished {
+			t.finished = append(t.finished, t.last)
+		}
+		i++
+	}
+	t.inFlight = t.inFlight[i:]
+}
+
+// position returns the last message such that all messages received before it have finished processing,
+// or nil if there's no such message, together with the number of messages still being processed.
+func (t *ackTracker) position() (eventlog.Message, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.last, len(t.inFlight)
+}
+
+// oldestInFlight returns the oldest message received that's still being processed, or nil if there's no such message.
+func (t *ackTracker) oldestInFlight() eventlog.Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// Messages finished processing are removed from the front of inFlight, so the first message isn't finished.
+	if len(t.inFlight) == 0 {
+		return nil
+	}
+	return t.inFlight[0].msg
+}
+
+// takeFinished returns, in the order they were received in, the messages the cumulative position has advanced past
+// since the last call, if collectFinished is set.
+func (t *ackTracker) takeFinished() []eventlog.Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	finished := t.finished
+	t.finished = nil
+	return finished
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/logging"
+)
+
+func TestPartitionedWorkerPool_PreservesOrderPerKey(t *testing.T) {
+	for _, parallelism := range []int{0, 1, 4} {
+		t.Run(fmt.Sprintf("parallelism %d", parallelism), func(t *testing.T) {
+			p := newPartitionedWorkerPool(parallelism, logging.NullLogger)
+			var mu sync.Mutex
+			processed := make(map[string][]int)
+			for i := 0; i < 100; i++ {
+				i := i
+				key := fmt.Sprintf("key-%d", i%7)
+				p.submit(key, func() {
+					mu.Lock()
+					defer mu.Unlock()
+					processed[key] = append(processed[key], i)
+				})
+			}
+			p.stop()
+
+			assert.Len(t, processed, 7)
+			for key, is := range processed {
+				for j := 1; j < len(is); j++ {
+					assert.Less(t, is[j-1], is[j], key)
+				}
+			}
+		})
+	}
+}
+
+func TestPartitionedWorkerPool_SurvivesPanics(t *testing.T) {
+	p := newPartitionedWorkerPool(2, logging.NullLogger)
+	done := make(chan struct{})
+	p.submit("key", func() { panic("failure") })
+	p.submit("key", func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("task submitted after a panic didn't run")
+	}
+	p.stop()
+}
+
+type testMessage struct {
+	eventlog.Message
+	id int
+}
+
+func TestAckTracker(t *testing.T) {
+	tracker := &ackTracker{}
+	var messages []eventlog.Message
+	var tracked []*trackedMessage
+	for i := 0; i < 3; i++ {
+		messages = append(messages, testMessage{id: i})
+		tracked = append(tracked, tracker.add(messages[i]))
+	}
+
+	msg, numInFlight := tracker.position()
+	assert.Nil(t, msg)
+	assert.Equal(t, 3, numInFlight)
+
+	// Finishing a later message doesn't advance the position past earlier unfinished ones.
+	tracker.done(tracked[1])
+	msg, numInFlight = tracker.position()
+	assert.Nil(t, msg)
+	assert.Equal(t, 3, numInFlight)
+
+	tracker.done(tracked[0])
+	msg, numInFlight = tracker.position()
+	assert.Equal(t, messages[1], msg)
+	assert.Equal(t, 1, numInFlight)
+
+	tracker.done(tracked[2])
+	msg, numInFlight = tracker.position()
+	assert.Equal(t, messages[2], msg)
+	assert.Equal(t, 0, numInFlight)
+}
+
+func TestAckTracker_TakeFinished(t *testing.T) {
+	tracker := &ackTracker{collectFinished: true}
+	messages := []eventlog.Message{testMessage{id: 0}, testMessage{id: 1}, testMessage{id: 2}}
+	var tracked []*trackedMessage
+	for _, msg := range messages {
+		tracked = append(tracked, tracker.add(msg))
+	}
+
+	tracker.done(tracked[1])
+	assert.Empty(t, tracker.takeFinished())
+
+	tracker.done(tracked[0])
+	assert.Equal(t, messages[:2], tracker.takeFinished())
+	assert.Empty(t, tracker.takeFinished())
+
+	tracker.done(tracked[2])
+	assert.Equal(t, messages[2:], tracker.takeFinished())
+}
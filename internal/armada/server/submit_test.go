@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"testing/quick"
 	"time"
@@ -1647,7 +1648,8 @@ func withSubmitServerAndRepos(action func(s *SubmitServer, jobRepo repository.Jo
 		schedulingInfoRepository,
 		200,
 		&queueConfig,
-		&schedulingConfig)
+		&schedulingConfig,
+		nil)
 
 	_, _ = client.FlushDB().Result()
 
@@ -1778,3 +1780,53 @@ func TestSubmitServer_CreateJobs_WithJobIdReplacement(t *testing.T) {
 		assert.Equal(t, expected, output)
 	})
 }
+
+func TestSubmitServer_CreateJobs_WithDependencies(t *testing.T) {
+	existingJobId := util.NewULID()
+	ulids := []string{"01h3w2wtdchtc80hgyp782shrv", "01h3w2wtdchtc80hgyp782shrw", "01h3w2wtdchtc80hgyp782shrx"}
+	mockNewULID := func() string {
+		rv := ulids[0]
+		ulids = ulids[1:]
+		return rv
+	}
+	podSpec := &v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Name:  "app",
+				Image: "test:latest",
+				Resources: v1.ResourceRequirements{
+					Limits: v1.ResourceList{
+						"cpu":    resource.MustParse("1"),
+						"memory": resource.MustParse("100Mi"),
+					},
+					Requests: v1.ResourceList{
+						"cpu":    resource.MustParse("1"),
+						"memory": resource.MustParse("100Mi"),
+					},
+				},
+			},
+		},
+	}
+	request := &api.JobSubmitRequest{
+		Queue:    "test",
+		JobSetId: "test-jobsetid",
+		JobRequestItems: []*api.JobSubmitRequestItem{
+			{ClientId: "first", PodSpecs: []*v1.PodSpec{podSpec.DeepCopy()}},
+			{ClientId: "second", DependsOn: []string{"first", strings.ToUpper(existingJobId)}, PodSpecs: []*v1.PodSpec{podSpec.DeepCopy()}},
+			{DependsOn: []string{"second"}, PodSpecs: []*v1.PodSpec{podSpec.DeepCopy()}},
+		},
+	}
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		output, err := s.createJobsObjects(request, "test", nil, time.Now, mockNewULID)
+		require.NoError(t, err)
+		require.Len(t, output, 3)
+		assert.NotContains(t, output[0].Annotations, configuration.JobDependenciesAnnotation)
+		assert.Equal(t, "01h3w2wtdchtc80hgyp782shrv,"+existingJobId, output[1].Annotations[configuration.JobDependenciesAnnotation])
+		assert.Equal(t, "01h3w2wtdchtc80hgyp782shrw", output[2].Annotations[configuration.JobDependenciesAnnotation])
+
+		// Jobs may only refer to jobs earlier in the same request by client id.
+		request.JobRequestItems[0].DependsOn = []string{"second"}
+		_, err = s.createJobsObjects(request, "test", nil, time.Now, util.NewULID)
+		assert.Error(t, err)
+	})
+}
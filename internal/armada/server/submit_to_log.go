@@ -12,11 +12,17 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/armadaproject/armada/internal/armada/admission"
+	"github.com/armadaproject/armada/internal/armada/audit"
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/metrics"
 	"github.com/armadaproject/armada/internal/armada/permissions"
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/armada/validation"
@@ -24,11 +30,14 @@ import (
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/common/clientinfo"
 	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/pgkeyvalue"
 	"github.com/armadaproject/armada/internal/common/pointer"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/internal/common/util"
 	commonvalidation "github.com/armadaproject/armada/internal/common/validation"
 	"github.com/armadaproject/armada/internal/executor/configuration"
@@ -67,6 +76,35 @@ type PulsarSubmitServer struct {
 	GangIdAnnotation string
 	// Temporary flag to stop us rejecting jobs as we switch over to new submit checks
 	IgnoreJobSubmitChecks bool
+	// Controls whether jobs that can never be scheduled are rejected, or accepted with a warning.
+	SubmitFeasibilityCheck armadaconfiguration.SubmitFeasibilityCheckConfig
+	// Restricts the clients recorded by the metrics of submissions by client.
+	ClientMetrics armadaconfiguration.ClientMetricsConfig
+	// Maximum number of tasks in an array job. Array jobs are rejected if zero.
+	MaxArrayJobSize int
+	// Validators that may reject submissions in addition to Armada's own validation, e.g., external webhooks.
+	AdmissionValidators []admission.Validator
+	// If provided, rejected submissions are recorded here as audit events, in addition to being logged.
+	AuditSink audit.Sink
+	// Used to look up the spec of finished jobs to be resubmitted.
+	EventRepository repository.EventRepository
+	// Limits on the size of submissions and job sets.
+	SubmissionLimits armadaconfiguration.SubmissionLimitsConfig
+	// Used to count the jobs submitted to each job set. MaxJobsPerJobSet isn't enforced if nil.
+	JobSetSizeRepository repository.JobSetSizeRepository
+	// Controls the resource requests recommended from the usage of past jobs, and whether they're applied at submission.
+	ResourceRecommendations armadaconfiguration.ResourceRecommendationsConfig
+	// Caches the usage of the jobs of recently used job sets. If nil, usage is read from all events of the job set.
+	JobSetUsageCache *JobSetUsageCache
+	// Used to look up the jobs of the Pulsar scheduler, e.g., when reprioritising jobs by label.
+	// Only jobs of the legacy scheduler are found if nil.
+	SchedulerJobRepository SchedulerJobRepository
+}
+
+// SchedulerJobRepository looks up the jobs stored by the Pulsar scheduler.
+type SchedulerJobRepository interface {
+	// FetchJobSetJobLabels returns the labels of the active jobs of a job set, keyed by job id.
+	FetchJobSetJobLabels(ctx *armadacontext.Context, queue string, jobSet string, includeQueued bool, includeLeased bool) (map[string]map[string]string, error)
 }
 
 func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
@@ -76,6 +114,40 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		return nil, err
 	}
 
+	// A retry of a request that was already submitted gets the response to the original request.
+	// Like deduplication by client id, this is best-effort.
+	if response, err := srv.getIdempotentResponse(ctx, req, userId); err != nil {
+		ctx.WithError(err).Warn("Error fetching response for idempotency key, the request may be submitted more than once.")
+	} else if response != nil {
+		return response, nil
+	}
+
+	limits := srv.SubmissionLimits.ForQueue(req.Queue)
+	for i, item := range req.JobRequestItems {
+		if err := validateJobSpecSize(i, item, limits); err != nil {
+			return nil, err
+		}
+	}
+
+	// Each task of an array job is submitted as a separate job.
+	// The responses to these are collapsed into one response per array job before returning.
+	req, arrayJobs, err := expandArrayJobs(req, srv.MaxArrayJobSize)
+	if err != nil {
+		return nil, err
+	}
+	// Similarly, each pod of a multi-pod job is submitted as a separate job, and the jobs of each multi-pod job make up a gang.
+	// Array jobs are expanded first, such that each task of an array of multi-pod jobs is a separate gang.
+	req, multiPodJobs, err := expandMultiPodJobs(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSubmissionSize(len(req.JobRequestItems), limits); err != nil {
+		return nil, err
+	}
+	if err := srv.applyResourceRecommendations(ctx, req); err != nil {
+		return nil, err
+	}
+
 	// Prepare an event sequence to be submitted to the log
 	pulsarSchedulerEvents := &armadaevents.EventSequence{
 		Queue:      req.Queue,
@@ -93,6 +165,11 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		Events:     make([]*armadaevents.EventSequence_Event, 0, len(req.JobRequestItems)),
 	}
 
+	clientInfo := clientinfo.FromContext(grpcCtx)
+	addClientInfoAnnotations(req.JobRequestItems, clientInfo)
+	addIdempotencyKeyAnnotations(req.JobRequestItems, req.IdempotencyKey)
+	addTraceparentAnnotations(req.JobRequestItems, tracing.Traceparent(grpcCtx))
+
 	// Create legacy API jobs from the requests.
 	// We use the legacy code for the conversion to ensure that behaviour doesn't change.
 	apiJobs, err := srv.SubmitServer.createJobs(req, userId, groups)
@@ -102,11 +179,18 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 	if err := commonvalidation.ValidateApiJobs(apiJobs, *srv.SubmitServer.schedulingConfig); err != nil {
 		return nil, err
 	}
+	if err := srv.admit(ctx, req, userId, groups, apiJobs); err != nil {
+		return nil, err
+	}
 
 	schedulersByJobId, err := srv.assignScheduler(apiJobs)
 	if err != nil {
 		return nil, err
 	}
+	warningsByJobId, err := srv.checkFeasibility(apiJobs, schedulersByJobId)
+	if err != nil {
+		return nil, err
+	}
 
 	jobsSubmitted := make([]*api.Job, 0, len(req.JobRequestItems))
 	responses := make([]*api.JobSubmitResponseItem, len(req.JobRequestItems))
@@ -114,7 +198,7 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 	originalIds, err := srv.getOriginalJobIds(ctx, apiJobs)
 	if err != nil {
 		// Deduplication is best-effort, therefore this is not fatal
-		log.WithError(err).Warn("Error fetching original job ids, deduplication will not occur.")
+		ctx.WithError(err).Warn("Error fetching original job ids, deduplication will not occur.")
 	}
 
 	pulsarJobDetails := make([]*schedulerobjects.PulsarSchedulerJobDetails, 0)
@@ -150,7 +234,8 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		}
 
 		responses[i] = &api.JobSubmitResponseItem{
-			JobId: apiJob.GetId(),
+			JobId:    apiJob.GetId(),
+			Warnings: warningsByJobId[apiJob.GetId()],
 		}
 
 		// The log accept a different type of job.
@@ -197,20 +282,31 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 				// The job shouldn't be submitted twice. Move on to the next job.
 				continue
 			} else {
-				log.Warnf(
-					"ClientId %s was supplied for job %s but no original jobId could be found.  Deduplication will not be applied",
-					apiJob.ClientId,
-					apiJob.GetId())
+				ctx.WithFields(logging.JobFields(apiJob.Queue, apiJob.JobSetId, apiJob.Id)).Warnf(
+					"ClientId %s was supplied but no original jobId could be found.  Deduplication will not be applied",
+					apiJob.ClientId)
 			}
 		} else {
 			jobsSubmitted = append(jobsSubmitted, apiJob)
 		}
 	}
 
+	// Duplicates of jobs submitted earlier don't count towards the size of the job set.
+	releaseJobSetSize, err := srv.reserveJobSetSize(req.Queue, req.JobSetId, len(jobsSubmitted), limits)
+	if err != nil {
+		return nil, err
+	}
+	releaseJobSetSizeOnError := func(n int) {
+		if err := releaseJobSetSize(n); err != nil {
+			ctx.WithError(err).Warn("failed to release job set size reservation")
+		}
+	}
+
 	if len(pulsarJobDetails) > 0 {
 		err = srv.SubmitServer.jobRepository.StorePulsarSchedulerJobDetails(pulsarJobDetails)
 		if err != nil {
-			log.WithError(err).Error("failed store pulsar job details")
+			ctx.WithError(err).Error("failed store pulsar job details")
+			releaseJobSetSizeOnError(len(jobsSubmitted))
 			return nil, status.Error(codes.Internal, "failed store pulsar job details")
 		}
 	}
@@ -218,7 +314,8 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 	if len(pulsarSchedulerEvents.Events) > 0 {
 		err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{pulsarSchedulerEvents}, schedulers.Pulsar)
 		if err != nil {
-			log.WithError(err).Error("failed send pulsar scheduler events to Pulsar")
+			ctx.WithError(err).Error("failed send pulsar scheduler events to Pulsar")
+			releaseJobSetSizeOnError(len(jobsSubmitted))
 			return nil, status.Error(codes.Internal, "Failed to send message")
 		}
 	}
@@ -226,7 +323,15 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 	if len(legacySchedulerEvents.Events) > 0 {
 		err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{legacySchedulerEvents}, schedulers.Legacy)
 		if err != nil {
-			log.WithError(err).Error("failed send legacy scheduler events to Pulsar")
+			ctx.WithError(err).Error("failed send legacy scheduler events to Pulsar")
+			// Jobs assigned to the pulsar scheduler have been submitted by now.
+			numLegacyJobsSubmitted := 0
+			for _, job := range jobsSubmitted {
+				if schedulersByJobId[job.Id] != schedulers.Pulsar {
+					numLegacyJobsSubmitted++
+				}
+			}
+			releaseJobSetSizeOnError(numLegacyJobsSubmitted)
 			return nil, status.Error(codes.Internal, "Failed to send message")
 		}
 	}
@@ -237,9 +342,142 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 	// we could get duplicate events.
 	err = srv.storeOriginalJobIds(ctx, jobsSubmitted)
 	if err != nil {
-		log.WithError(err).Warn("failed to satore deduplicattion ids")
+		ctx.WithError(err).Warn("failed to satore deduplicattion ids")
+	}
+	metrics.RecordJobsSubmitted(clientInfo, srv.ClientMetrics, len(jobsSubmitted))
+	response := &api.JobSubmitResponse{
+		JobResponseItems: collapseArrayJobResponses(collapseMultiPodJobResponses(responses, multiPodJobs), arrayJobs),
+	}
+	if err := srv.storeIdempotentResponse(ctx, req, userId, response); err != nil {
+		ctx.WithError(err).Warn("failed to store response for idempotency key")
+	}
+	return response, nil
+}
+
+// admit runs the admission validators on the jobs of a submission.
+// Rejections are recorded as audit events and returned to the user together with the reason given by the validator.
+func (srv *PulsarSubmitServer) admit(ctx *armadacontext.Context, req *api.JobSubmitRequest, userId string, groups []string, apiJobs []*api.Job) error {
+	if len(srv.AdmissionValidators) == 0 {
+		return nil
+	}
+	err := admission.ValidateAll(ctx, srv.AdmissionValidators, &admission.Review{
+		Queue:    req.Queue,
+		JobSetId: req.JobSetId,
+		User:     userId,
+		Groups:   groups,
+		Jobs:     apiJobs,
+	})
+	var rejected *admission.ErrRejected
+	if errors.As(err, &rejected) {
+		ctx.WithFields(logging.Fields{
+			"audit":             audit.JobSubmissionRejected,
+			logging.QueueField:  req.Queue,
+			logging.JobSetField: req.JobSetId,
+			"user":              userId,
+			"numJobs":           len(apiJobs),
+			"validator":         rejected.Validator,
+			"reason":            rejected.Message,
+		}).Warn("job submission rejected")
+		srv.recordAuditEvent(ctx, &audit.Event{
+			Type:       audit.JobSubmissionRejected,
+			Time:       time.Now(),
+			Queue:      req.Queue,
+			JobSetId:   req.JobSetId,
+			User:       userId,
+			NumJobs:    len(apiJobs),
+			Policy:     rejected.Validator,
+			Reason:     rejected.Message,
+			Violations: auditViolations(rejected.Violations),
+		})
+		metrics.RecordSubmissionRejected(rejected.Validator)
+		return rejectedSubmissionStatus(rejected).Err()
+	} else if err != nil {
+		ctx.WithError(err).Error("failed to validate job submission")
+		return status.Errorf(codes.Unavailable, "failed to validate job submission: %s", err)
+	}
+	return nil
+}
+
+// recordAuditEvent records event in srv.AuditSink, if provided.
+// Failing to do so is logged, but doesn't fail the request.
+func (srv *PulsarSubmitServer) recordAuditEvent(ctx *armadacontext.Context, event *audit.Event) {
+	if srv.AuditSink == nil {
+		return
+	}
+	if err := srv.AuditSink.Record(ctx, event); err != nil {
+		logging.WithStacktrace(ctx, err).WithField("audit", event.Type).Error("failed to record audit event")
+	}
+}
+
+func auditViolations(violations []admission.Violation) []audit.Violation {
+	if len(violations) == 0 {
+		return nil
+	}
+	rv := make([]audit.Violation, len(violations))
+	for i, violation := range violations {
+		rv[i] = audit.Violation{
+			JobId:       violation.JobId,
+			Field:       violation.Field,
+			Image:       violation.Image,
+			Description: violation.Description,
+		}
+	}
+	return rv
+}
+
+// rejectedSubmissionStatus returns an InvalidArgument status for a rejected submission,
+// with details listing the fields that caused the submission to be rejected, if known.
+func rejectedSubmissionStatus(rejected *admission.ErrRejected) *status.Status {
+	st := status.New(codes.InvalidArgument, rejected.Error())
+	if len(rejected.Violations) == 0 {
+		return st
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range rejected.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+	if withDetails, err := st.WithDetails(badRequest); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// addClientInfoAnnotations annotates each item with the client it was submitted with.
+// Values provided by the client take precedence over any annotations of the same name set by the user.
+func addClientInfoAnnotations(items []*api.JobSubmitRequestItem, clientInfo clientinfo.ClientInfo) {
+	annotations := map[string]string{
+		armadaconfiguration.ClientNameAnnotation:       clientInfo.Name,
+		armadaconfiguration.ClientVersionAnnotation:    clientInfo.Version,
+		armadaconfiguration.SubmissionSourceAnnotation: clientInfo.Source,
+	}
+	for _, item := range items {
+		for k, v := range annotations {
+			if v == "" {
+				continue
+			}
+			if item.Annotations == nil {
+				item.Annotations = make(map[string]string)
+			}
+			item.Annotations[k] = v
+		}
+	}
+}
+
+// addTraceparentAnnotations stores the trace context of the request with each job, such that the spans of scheduling
+// and running the job are part of the trace in which it was submitted; see configuration.TraceparentAnnotation.
+func addTraceparentAnnotations(items []*api.JobSubmitRequestItem, traceparent string) {
+	if traceparent == "" {
+		return
+	}
+	for _, item := range items {
+		if item.Annotations == nil {
+			item.Annotations = make(map[string]string)
+		}
+		item.Annotations[armadaconfiguration.TraceparentAnnotation] = traceparent
 	}
-	return &api.JobSubmitResponse{JobResponseItems: responses}, nil
 }
 
 func (srv *PulsarSubmitServer) CancelJobs(grpcCtx context.Context, req *api.JobCancelRequest) (*api.CancellationResult, error) {
@@ -253,7 +491,7 @@ func (srv *PulsarSubmitServer) CancelJobs(grpcCtx context.Context, req *api.JobC
 	// Another separate code path for cancelling an entire job set
 	// TODO: We should deprecate this and move people over to CancelJobSet()
 	if req.JobId == "" {
-		log.Warnf("CancelJobs called for queue=%s and jobset=%s but with empty job id. Redirecting to CancelJobSet()", req.Queue, req.JobSetId)
+		ctx.WithFields(logging.Fields{logging.QueueField: req.Queue, logging.JobSetField: req.JobSetId}).Warn("CancelJobs called with empty job id. Redirecting to CancelJobSet()")
 		_, err := srv.CancelJobSet(ctx, &api.JobSetCancelRequest{
 			Queue:    req.Queue,
 			JobSetId: req.JobSetId,
@@ -322,7 +560,7 @@ func (srv *PulsarSubmitServer) CancelJobs(grpcCtx context.Context, req *api.JobC
 	err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{sequence}, schedulers.All)
 
 	if err != nil {
-		log.WithError(err).Error("failed send to Pulsar")
+		ctx.WithError(err).Error("failed send to Pulsar")
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
 
@@ -353,11 +591,11 @@ func (srv *PulsarSubmitServer) cancelJobsByIdsQueueJobset(grpcCtx context.Contex
 		return nil, err
 	}
 	var cancelledIds []string
-	sequence, cancelledIds := eventSequenceForJobIds(jobIds, q, jobSet, userId, groups, reason)
+	sequence, cancelledIds := eventSequenceForJobIds(ctx, jobIds, q, jobSet, userId, groups, reason)
 	// send the message to both schedulers because jobs may be on either
 	err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{sequence}, schedulers.All)
 	if err != nil {
-		log.WithError(err).Error("failed send to Pulsar")
+		ctx.WithError(err).Error("failed send to Pulsar")
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
 	return &api.CancellationResult{
@@ -366,7 +604,7 @@ func (srv *PulsarSubmitServer) cancelJobsByIdsQueueJobset(grpcCtx context.Contex
 }
 
 // Returns event sequence along with all valid job ids in the sequence
-func eventSequenceForJobIds(jobIds []string, q, jobSet, userId string, groups []string, reason string) (*armadaevents.EventSequence, []string) {
+func eventSequenceForJobIds(ctx *armadacontext.Context, jobIds []string, q, jobSet, userId string, groups []string, reason string) (*armadaevents.EventSequence, []string) {
 	sequence := &armadaevents.EventSequence{
 		Queue:      q,
 		JobSetName: jobSet,
@@ -378,7 +616,7 @@ func eventSequenceForJobIds(jobIds []string, q, jobSet, userId string, groups []
 	for _, jobIdStr := range jobIds {
 		jobId, err := armadaevents.ProtoUuidFromUlidString(jobIdStr)
 		if err != nil {
-			log.WithError(err).Errorf("could not convert job id to uuid: %s", jobIdStr)
+			ctx.WithError(err).WithFields(logging.JobFields(q, jobSet, jobIdStr)).Error("could not convert job id to uuid")
 			continue
 		}
 		validIds = append(validIds, jobIdStr)
@@ -455,7 +693,7 @@ func (srv *PulsarSubmitServer) CancelJobSet(grpcCtx context.Context, req *api.Jo
 
 	err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{legacySchedulerSequence}, schedulers.Legacy)
 	if err != nil {
-		log.WithError(err).Error("failed to send cancel job messages to pulsar")
+		ctx.WithError(err).Error("failed to send cancel job messages to pulsar")
 		return nil, status.Error(codes.Internal, "failed to send cancel job messages to pulsar")
 	}
 
@@ -490,7 +728,7 @@ func (srv *PulsarSubmitServer) CancelJobSet(grpcCtx context.Context, req *api.Jo
 		}
 		err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{pulsarSchedulerSequence}, schedulers.Pulsar)
 		if err != nil {
-			log.WithError(err).Error("failed to send cancel jobset message to pulsar")
+			ctx.WithError(err).Error("failed to send cancel jobset message to pulsar")
 			return nil, status.Error(codes.Internal, "failed to send cancel jobset message to pulsar")
 		}
 	}
@@ -606,7 +844,7 @@ func (srv *PulsarSubmitServer) ReprioritizeJobs(grpcCtx context.Context, req *ap
 	err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{sequence}, schedulers.All)
 
 	if err != nil {
-		log.WithError(err).Error("failed send to Pulsar")
+		ctx.WithError(err).Error("failed send to Pulsar")
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
 
@@ -615,6 +853,122 @@ func (srv *PulsarSubmitServer) ReprioritizeJobs(grpcCtx context.Context, req *ap
 	}, nil
 }
 
+// ReprioritizeJobsByFilter reprioritises all jobs in a job set whose labels match the provided label selector
+// and, optionally, that are in one of the provided states. All matching jobs are reprioritised in a single event sequence,
+// which the legacy scheduler applies in batches, i.e., not atomically.
+func (srv *PulsarSubmitServer) ReprioritizeJobsByFilter(grpcCtx context.Context, req *api.JobReprioritizeByFilterRequest) (*api.JobReprioritizeResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if req.Queue == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "Queue",
+			Value:   req.Queue,
+			Message: "queue cannot be empty when reprioritising jobs by filter",
+		}
+	}
+	if req.JobSetId == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "JobSetId",
+			Value:   req.JobSetId,
+			Message: "jobsetId cannot be empty when reprioritising jobs by filter",
+		}
+	}
+	selector, err := labels.Parse(req.LabelSelector)
+	if err != nil {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "LabelSelector",
+			Value:   req.LabelSelector,
+			Message: err.Error(),
+		}
+	}
+	if err := validation.ValidateJobSetFilter(req.Filter); err != nil {
+		return nil, err
+	}
+
+	userId, groups, err := srv.Authorize(ctx, req.Queue, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := srv.getJobSetJobIdsMatchingSelector(ctx, req.Queue, req.JobSetId, createJobSetFilter(req.Filter), selector)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error getting job IDs: %s", err)
+	}
+
+	priority := eventutil.LogSubmitPriorityFromApiPriority(req.NewPriority)
+	results := make(map[string]string, len(ids))
+	sequence := &armadaevents.EventSequence{
+		Queue:      req.Queue,
+		JobSetName: req.JobSetId,
+		UserId:     userId,
+		Groups:     groups,
+		Events:     make([]*armadaevents.EventSequence_Event, 0, len(ids)),
+	}
+	for _, id := range ids {
+		jobId, err := armadaevents.ProtoUuidFromUlidString(id)
+		if err != nil {
+			results[id] = err.Error()
+			continue
+		}
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: pointer.Now(),
+			Event: &armadaevents.EventSequence_Event_ReprioritiseJob{
+				ReprioritiseJob: &armadaevents.ReprioritiseJob{
+					JobId:    jobId,
+					Priority: priority,
+				},
+			},
+		})
+		results[id] = "" // empty string indicates no error
+	}
+	if len(sequence.Events) == 0 {
+		return &api.JobReprioritizeResponse{ReprioritizationResults: results}, nil
+	}
+
+	// can send the message to both schedulers
+	err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{sequence}, schedulers.All)
+	if err != nil {
+		ctx.WithError(err).Error("failed send to Pulsar")
+		return nil, status.Error(codes.Internal, "Failed to send message")
+	}
+
+	return &api.JobReprioritizeResponse{ReprioritizationResults: results}, nil
+}
+
+// getJobSetJobIdsMatchingSelector returns the ids of the jobs in a job set passing filter whose labels match selector,
+// of both the legacy and, if a SchedulerJobRepository is provided, the Pulsar scheduler.
+func (srv *PulsarSubmitServer) getJobSetJobIdsMatchingSelector(
+	ctx *armadacontext.Context, queue string, jobSetId string, filter *repository.JobSetFilter, selector labels.Selector,
+) ([]string, error) {
+	ids, err := srv.SubmitServer.getJobSetJobIdsMatchingSelector(queue, jobSetId, filter, selector)
+	if err != nil {
+		return nil, err
+	}
+	if srv.SchedulerJobRepository == nil {
+		return ids, nil
+	}
+	includeQueued, includeLeased := true, true
+	if filter != nil {
+		includeQueued, includeLeased = filter.IncludeQueued, filter.IncludeLeased
+	}
+	labelsByJobId, err := srv.SchedulerJobRepository.FetchJobSetJobLabels(ctx, queue, jobSetId, includeQueued, includeLeased)
+	if err != nil {
+		return nil, err
+	}
+	// Jobs submitted to the Pulsar scheduler may also be stored by the legacy scheduler, e.g., while migrating.
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	schedulerIds := make([]string, 0, len(labelsByJobId))
+	for id, jobLabels := range labelsByJobId {
+		if !seen[id] && selector.Matches(labels.Set(jobLabels)) {
+			schedulerIds = append(schedulerIds, id)
+		}
+	}
+	slices.Sort(schedulerIds)
+	return append(ids, schedulerIds...), nil
+}
+
 // Authorize authorises a user request to submit a state transition message to the log.
 // User information used for authorization is extracted from the provided context.
 // Checks that the user has either anyPerm (e.g., permissions.SubmitAnyJobs) or perm (e.g., PermissionVerbSubmit) for this queue.
@@ -633,7 +987,7 @@ func (srv *PulsarSubmitServer) Authorize(
 		return
 	}
 	if !srv.Permissions.UserHasPermission(ctx, anyPerm) {
-		if !principalHasQueuePermissions(principal, q, perm) {
+		if !principalHasQueuePermissions(principal, q, perm) && !srv.Permissions.UserHasQueueVerb(ctx, q.Name, string(perm)) {
 			err = &armadaerrors.ErrUnauthorized{
 				Principal:  principal.GetName(),
 				Permission: string(perm),
@@ -644,6 +998,9 @@ func (srv *PulsarSubmitServer) Authorize(
 			return
 		}
 	}
+	if perm == queue.PermissionVerbSubmit {
+		err = checkQueueAcceptsSubmissions(q)
+	}
 
 	return
 }
@@ -795,79 +1152,98 @@ func (srv *PulsarSubmitServer) assignScheduler(jobs []*api.Job) (map[string]sche
 		if len(gang) == 0 {
 			continue
 		}
-		for i, job := range gang {
-			if job == nil {
-				return nil, &armadaerrors.ErrInvalidArgument{
-					Name:    fmt.Sprintf("gang[%d}", i),
-					Value:   job,
-					Message: fmt.Sprintf("unexpected nil job in gang %s", gangId),
-				}
-			}
-		}
-
-		// If the first job in the gang explicitly targets either scheduler, assign to that scheduler.
-		if jobs[0].Scheduler == "pulsar" {
-			schedulerByGangId[gangId] = schedulers.Pulsar
-			continue
+		scheduler, err := srv.assignGangScheduler(gangId, gang, jobs[0].Scheduler)
+		if err != nil {
+			return nil, err
 		}
-		if jobs[0].Scheduler == "legacy" {
-			schedulerByGangId[gangId] = schedulers.Legacy
-			continue
+		schedulerByGangId[gangId] = scheduler
+	}
+	schedulerByJobId := make(map[string]schedulers.Scheduler, len(jobs))
+	for gangId, gang := range gangs {
+		for _, job := range gang {
+			schedulerByJobId[job.Id] = schedulerByGangId[gangId]
 		}
+	}
+	return schedulerByJobId, nil
+}
 
-		// Select primary scheduler at random.
-		var primaryScheduler schedulers.Scheduler
-		var secondaryScheduler schedulers.Scheduler
-		if srv.Rand.Float64() < srv.ProbabilityOfUsingPulsarScheduler {
-			primaryScheduler = schedulers.Pulsar
-			secondaryScheduler = schedulers.Legacy
-		} else {
-			primaryScheduler = schedulers.Legacy
-			secondaryScheduler = schedulers.Pulsar
+// assignGangScheduler returns the scheduler the provided non-empty gang should be submitted to,
+// or an error if neither scheduler could schedule it.
+// requestedScheduler is the scheduler explicitly targeted by the submit request, if any.
+func (srv *PulsarSubmitServer) assignGangScheduler(gangId string, gang []*api.Job, requestedScheduler string) (schedulers.Scheduler, error) {
+	for i, job := range gang {
+		if job == nil {
+			return 0, &armadaerrors.ErrInvalidArgument{
+				Name:    fmt.Sprintf("gang[%d}", i),
+				Value:   job,
+				Message: fmt.Sprintf("unexpected nil job in gang %s", gangId),
+			}
 		}
+	}
 
-		// Check if the primary scheduler could schedule this gang.
-		unschedulableReasonByScheduler := make(map[schedulers.Scheduler]string, 2)
-		if schedulable, message := srv.schedulableOnScheduler(primaryScheduler, gang); schedulable {
-			schedulerByGangId[gangId] = primaryScheduler
-			continue
-		} else {
-			unschedulableReasonByScheduler[primaryScheduler] = message
+	// Only the Pulsar scheduler honours job dependencies.
+	if _, ok := gang[0].Annotations[armadaconfiguration.JobDependenciesAnnotation]; ok {
+		if !srv.PulsarSchedulerEnabled || gang[0].Scheduler == "legacy" {
+			return 0, &armadaerrors.ErrInvalidArgument{
+				Name:    "DependsOn",
+				Value:   gang[0].Annotations[armadaconfiguration.JobDependenciesAnnotation],
+				Message: fmt.Sprintf("job %s has dependencies, which are only supported by the Pulsar scheduler", gang[0].Id),
+			}
 		}
+		return schedulers.Pulsar, nil
+	}
 
-		// If not schedulable on the primary scheduler, try the secondary scheduler.
-		if schedulable, message := srv.schedulableOnScheduler(secondaryScheduler, gang); schedulable {
-			schedulerByGangId[gangId] = secondaryScheduler
-			continue
+	// If the request explicitly targets either scheduler, assign to that scheduler.
+	if requestedScheduler == "pulsar" {
+		return schedulers.Pulsar, nil
+	}
+	if requestedScheduler == "legacy" {
+		return schedulers.Legacy, nil
+	}
+
+	// Select primary scheduler at random.
+	var primaryScheduler schedulers.Scheduler
+	var secondaryScheduler schedulers.Scheduler
+	if srv.Rand.Float64() < srv.ProbabilityOfUsingPulsarScheduler {
+		primaryScheduler = schedulers.Pulsar
+		secondaryScheduler = schedulers.Legacy
+	} else {
+		primaryScheduler = schedulers.Legacy
+		secondaryScheduler = schedulers.Pulsar
+	}
+
+	// Check if the primary scheduler could schedule this gang.
+	unschedulableReasonByScheduler := make(map[schedulers.Scheduler]string, 2)
+	if schedulable, message := srv.schedulableOnScheduler(primaryScheduler, gang); schedulable {
+		return primaryScheduler, nil
+	} else {
+		unschedulableReasonByScheduler[primaryScheduler] = message
+	}
+
+	// If not schedulable on the primary scheduler, try the secondary scheduler.
+	if schedulable, message := srv.schedulableOnScheduler(secondaryScheduler, gang); schedulable {
+		return secondaryScheduler, nil
+	} else {
+		// Not schedulable by either scheduler; return an error.
+		unschedulableReasonByScheduler[secondaryScheduler] = message
+		var sb strings.Builder
+		if len(gang) == 1 {
+			sb.WriteString(fmt.Sprintf("job %s unschedulable: ", gang[0].Id))
 		} else {
-			// Not schedulable by either scheduler; return an error.
-			unschedulableReasonByScheduler[secondaryScheduler] = message
-			var sb strings.Builder
-			if len(gang) == 1 {
-				sb.WriteString(fmt.Sprintf("job %s unschedulable: ", gang[0].Id))
-			} else {
-				sb.WriteString(fmt.Sprintf("gang %s unschedulable: ", gangId))
-			}
+			sb.WriteString(fmt.Sprintf("gang %s unschedulable: ", gangId))
+		}
+		sb.WriteString(fmt.Sprintf(
+			"failed to schedule onto legacy scheduler because %s",
+			unschedulableReasonByScheduler[schedulers.Legacy],
+		))
+		if srv.PulsarSchedulerEnabled {
 			sb.WriteString(fmt.Sprintf(
-				"failed to schedule onto legacy scheduler because %s",
-				unschedulableReasonByScheduler[schedulers.Legacy],
+				"; failed to schedule onto Pulsar scheduler because %s",
+				unschedulableReasonByScheduler[schedulers.Pulsar],
 			))
-			if srv.PulsarSchedulerEnabled {
-				sb.WriteString(fmt.Sprintf(
-					"; failed to schedule onto Pulsar scheduler because %s",
-					unschedulableReasonByScheduler[schedulers.Pulsar],
-				))
-			}
-			return nil, errors.New(sb.String())
 		}
+		return 0, errors.New(sb.String())
 	}
-	schedulerByJobId := make(map[string]schedulers.Scheduler, len(jobs))
-	for gangId, gang := range gangs {
-		for _, job := range gang {
-			schedulerByJobId[job.Id] = schedulerByGangId[gangId]
-		}
-	}
-	return schedulerByJobId, nil
 }
 
 func (srv *PulsarSubmitServer) schedulableOnScheduler(scheduler schedulers.Scheduler, gang []*api.Job) (bool, string) {
@@ -897,6 +1273,38 @@ func (srv *PulsarSubmitServer) schedulableOnPulsarScheduler(gang []*api.Job) (bo
 	return srv.PulsarSchedulerSubmitChecker.CheckApiJobs(gang)
 }
 
+// checkFeasibility checks each job against the nodes of the executors of the scheduler it's assigned to.
+// Jobs that can't be scheduled onto any node are rejected with the reason why,
+// or, if SubmitFeasibilityCheck.WarnOnly is set, accepted with the reason returned as a warning.
+func (srv *PulsarSubmitServer) checkFeasibility(jobs []*api.Job, schedulersByJobId map[string]schedulers.Scheduler) (map[string][]string, error) {
+	if !srv.SubmitFeasibilityCheck.Enabled {
+		return nil, nil
+	}
+	warningsByJobId := make(map[string][]string)
+	for _, job := range jobs {
+		submitChecker := srv.LegacySchedulerSubmitChecker
+		if schedulersByJobId[job.Id] == schedulers.Pulsar {
+			submitChecker = srv.PulsarSchedulerSubmitChecker
+		}
+		if submitChecker == nil {
+			continue
+		}
+		feasible, reason := submitChecker.CheckApiJobFeasibility(job)
+		if feasible {
+			continue
+		}
+		if !srv.SubmitFeasibilityCheck.WarnOnly {
+			return nil, &armadaerrors.ErrInvalidArgument{
+				Name:    "PodSpec",
+				Value:   job.Id,
+				Message: fmt.Sprintf("job %s can never be scheduled: %s", job.Id, reason),
+			}
+		}
+		warningsByJobId[job.Id] = append(warningsByJobId[job.Id], "job may never be scheduled: "+reason)
+	}
+	return warningsByJobId, nil
+}
+
 // groupJobsByGangId partitions the provided jobs by gang id.
 // Jobs with no gang id are treated as gangs of cardinality 1.
 func (srv *PulsarSubmitServer) groupJobsByGangId(jobs []*api.Job) map[string][]*api.Job {
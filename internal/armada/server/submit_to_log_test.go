@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/admission"
+	"github.com/armadaproject/armada/internal/armada/audit"
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/clientinfo"
+	"github.com/armadaproject/armada/internal/common/mocks"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestAddClientInfoAnnotations(t *testing.T) {
+	items := []*api.JobSubmitRequestItem{
+		{},
+		{Annotations: map[string]string{"foo": "bar", configuration.ClientNameAnnotation: "user-provided"}},
+	}
+	addClientInfoAnnotations(items, clientinfo.ClientInfo{Name: "armadactl", Version: "v0.3.100"})
+	assert.Equal(t, map[string]string{
+		configuration.ClientNameAnnotation:    "armadactl",
+		configuration.ClientVersionAnnotation: "v0.3.100",
+	}, items[0].Annotations)
+	assert.Equal(t, map[string]string{
+		"foo":                                 "bar",
+		configuration.ClientNameAnnotation:    "armadactl",
+		configuration.ClientVersionAnnotation: "v0.3.100",
+	}, items[1].Annotations)
+
+	items = []*api.JobSubmitRequestItem{{}}
+	addClientInfoAnnotations(items, clientinfo.ClientInfo{})
+	assert.Nil(t, items[0].Annotations)
+}
+
+type fakeValidator struct {
+	err     error
+	reviews []*admission.Review
+}
+
+func (v *fakeValidator) Name() string {
+	return "fake"
+}
+
+func (v *fakeValidator) Validate(_ *armadacontext.Context, review *admission.Review) error {
+	v.reviews = append(v.reviews, review)
+	return v.err
+}
+
+func TestPulsarSubmitServer_Admit(t *testing.T) {
+	req := &api.JobSubmitRequest{Queue: "queue", JobSetId: "jobSet"}
+	jobs := []*api.Job{{Id: "job"}}
+
+	allow := &fakeValidator{}
+	srv := &PulsarSubmitServer{AdmissionValidators: []admission.Validator{allow}}
+	assert.NoError(t, srv.admit(armadacontext.Background(), req, "user", []string{"group"}, jobs))
+	assert.Equal(t, []*admission.Review{{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		User:     "user",
+		Groups:   []string{"group"},
+		Jobs:     jobs,
+	}}, allow.reviews)
+
+	// Validators after the one rejecting the submission aren't invoked.
+	reject := &fakeValidator{err: &admission.ErrRejected{Validator: "fake", Message: "no"}}
+	after := &fakeValidator{}
+	srv = &PulsarSubmitServer{AdmissionValidators: []admission.Validator{allow, reject, after}}
+	err := srv.admit(armadacontext.Background(), req, "user", nil, jobs)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "job submission rejected by fake: no")
+	assert.Empty(t, after.reviews)
+
+	srv = &PulsarSubmitServer{AdmissionValidators: []admission.Validator{&fakeValidator{err: errors.New("unreachable")}}}
+	err = srv.admit(armadacontext.Background(), req, "user", nil, jobs)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestPulsarSubmitServer_Admit_Violations(t *testing.T) {
+	req := &api.JobSubmitRequest{Queue: "queue", JobSetId: "jobSet"}
+	rejected := &admission.ErrRejected{
+		Validator: "image policy",
+		Message:   "image ubuntu must be pinned by digest",
+		Violations: []admission.Violation{
+			{Field: "jobs[0].podSpec.containers[0].image", Description: "image ubuntu must be pinned by digest"},
+		},
+	}
+	srv := &PulsarSubmitServer{AdmissionValidators: []admission.Validator{&fakeValidator{err: rejected}}}
+	err := srv.admit(armadacontext.Background(), req, "user", nil, []*api.Job{{Id: "job"}})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, rejected.Error(), st.Message())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "jobs[0].podSpec.containers[0].image", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "image ubuntu must be pinned by digest", badRequest.FieldViolations[0].Description)
+}
+
+type fakeAuditSink struct {
+	events []*audit.Event
+}
+
+func (s *fakeAuditSink) Record(_ *armadacontext.Context, event *audit.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestPulsarSubmitServer_Admit_RecordsAuditEventWhenWebhookRejects(t *testing.T) {
+	webhookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"allowed": false, "message": "images must come from registry.example.com"}`))
+	}))
+	defer webhookServer.Close()
+	webhook, err := admission.NewWebhook(configuration.SubmitWebhookConfig{Name: "images", Url: webhookServer.URL})
+	require.NoError(t, err)
+	sink := &fakeAuditSink{}
+	srv := &PulsarSubmitServer{AdmissionValidators: []admission.Validator{webhook}, AuditSink: sink}
+
+	req := &api.JobSubmitRequest{Queue: "queue", JobSetId: "jobSet"}
+	err = srv.admit(armadacontext.Background(), req, "user", nil, []*api.Job{{Id: "job"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	require.Len(t, sink.events, 1)
+	event := sink.events[0]
+	assert.Equal(t, audit.JobSubmissionRejected, event.Type)
+	assert.False(t, event.Time.IsZero())
+	assert.Equal(t, "queue", event.Queue)
+	assert.Equal(t, "jobSet", event.JobSetId)
+	assert.Equal(t, "user", event.User)
+	assert.Equal(t, 1, event.NumJobs)
+	assert.Equal(t, "webhook images", event.Policy)
+	assert.Equal(t, "images must come from registry.example.com", event.Reason)
+
+	// Allowed submissions aren't recorded.
+	sink.events = nil
+	srv.AdmissionValidators = []admission.Validator{&fakeValidator{}}
+	require.NoError(t, srv.admit(armadacontext.Background(), req, "user", nil, []*api.Job{{Id: "job"}}))
+	assert.Empty(t, sink.events)
+}
+
+func TestPulsarSubmitServer_Admit_RecordsAuditEventWhenImagePolicyRejects(t *testing.T) {
+	policy := admission.NewImagePolicy(configuration.ImagePolicyConfig{
+		Default: configuration.ImagePolicyRules{AllowedRegistries: []string{"registry.example.com"}},
+	})
+	sink := &fakeAuditSink{}
+	srv := &PulsarSubmitServer{AdmissionValidators: []admission.Validator{policy}, AuditSink: sink}
+
+	req := &api.JobSubmitRequest{Queue: "queue", JobSetId: "jobSet"}
+	jobs := []*api.Job{{
+		Id: "job",
+		PodSpec: &v1.PodSpec{Containers: []v1.Container{
+			{Name: "allowed", Image: "registry.example.com/image"},
+			{Name: "offending", Image: "quay.io/image"},
+		}},
+	}}
+	err := srv.admit(armadacontext.Background(), req, "user", nil, jobs)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	require.Len(t, sink.events, 1)
+	event := sink.events[0]
+	assert.Equal(t, audit.JobSubmissionRejected, event.Type)
+	assert.Equal(t, "image policy", event.Policy)
+	assert.Equal(t, "queue", event.Queue)
+	require.Len(t, event.Violations, 1)
+	assert.Equal(t, "job", event.Violations[0].JobId)
+	assert.Equal(t, "jobs[0].podSpec.containers[1].image", event.Violations[0].Field)
+	assert.Equal(t, "quay.io/image", event.Violations[0].Image)
+}
+
+func TestPulsarSubmitServer_ReprioritizeJobsByFilter(t *testing.T) {
+	jobRepo := newMockJobRepository()
+	jobIds := make([]string, 4)
+	for i := range jobIds {
+		jobIds[i] = util.NewULID()
+	}
+	jobRepo.jobs[jobIds[0]] = &api.Job{Id: jobIds[0], Queue: "queue", JobSetId: "jobSet", Labels: map[string]string{"team": "ml"}}
+	jobRepo.jobs[jobIds[1]] = &api.Job{Id: jobIds[1], Queue: "queue", JobSetId: "jobSet", Labels: map[string]string{"team": "ml", "stage": "test"}}
+	jobRepo.jobs[jobIds[2]] = &api.Job{Id: jobIds[2], Queue: "queue", JobSetId: "jobSet", Labels: map[string]string{"team": "infra"}}
+	jobRepo.jobs[jobIds[3]] = &api.Job{Id: jobIds[3], Queue: "queue", JobSetId: "otherJobSet", Labels: map[string]string{"team": "ml"}}
+	// Jobs of the Pulsar scheduler; jobIds[0] is stored by both schedulers.
+	schedulerJobIds := []string{util.NewULID(), util.NewULID()}
+	schedulerJobRepo := &fakeSchedulerJobRepository{
+		labelsByJobId: map[string]map[string]string{
+			jobIds[0]:          {"team": "ml"},
+			schedulerJobIds[0]: {"team": "ml"},
+			schedulerJobIds[1]: {"team": "infra"},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	producer := mocks.NewMockProducer(ctrl)
+	var sequences []*armadaevents.EventSequence
+	producer.EXPECT().SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+			sequence := &armadaevents.EventSequence{}
+			require.NoError(t, proto.Unmarshal(msg.Payload, sequence))
+			sequences = append(sequences, sequence)
+			callback(nil, msg, nil)
+		},
+	).AnyTimes()
+
+	srv := &PulsarSubmitServer{
+		Producer:               producer,
+		QueueRepository:        &fakeQueueRepository{},
+		Permissions:            &FakePermissionChecker{},
+		SubmitServer:           &SubmitServer{jobRepository: jobRepo, cancelJobsBatchSize: 1},
+		MaxAllowedMessageSize:  1024 * 1024,
+		SchedulerJobRepository: schedulerJobRepo,
+	}
+	response, err := srv.ReprioritizeJobsByFilter(context.Background(), &api.JobReprioritizeByFilterRequest{
+		Queue:         "queue",
+		JobSetId:      "jobSet",
+		LabelSelector: "team=ml",
+		NewPriority:   2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{jobIds[0]: "", jobIds[1]: "", schedulerJobIds[0]: ""}, response.ReprioritizationResults)
+	assert.Equal(t, [2]bool{true, true}, [2]bool{schedulerJobRepo.includeQueued, schedulerJobRepo.includeLeased})
+
+	// All matching jobs are reprioritised in a single sequence.
+	require.Len(t, sequences, 1)
+	require.Len(t, sequences[0].Events, 3)
+	reprioritisedIds := make([]string, len(sequences[0].Events))
+	for i, event := range sequences[0].Events {
+		reprioritiseJob := event.GetReprioritiseJob()
+		require.NotNil(t, reprioritiseJob)
+		assert.Equal(t, uint32(2), reprioritiseJob.Priority)
+		reprioritisedIds[i], err = armadaevents.UlidStringFromProtoUuid(reprioritiseJob.JobId)
+		require.NoError(t, err)
+	}
+	assert.ElementsMatch(t, []string{jobIds[0], jobIds[1], schedulerJobIds[0]}, reprioritisedIds)
+}
+
+type fakeSchedulerJobRepository struct {
+	labelsByJobId map[string]map[string]string
+	includeQueued bool
+	includeLeased bool
+}
+
+func (r *fakeSchedulerJobRepository) FetchJobSetJobLabels(
+	_ *armadacontext.Context, _ string, _ string, includeQueued bool, includeLeased bool,
+) (map[string]map[string]string, error) {
+	r.includeQueued, r.includeLeased = includeQueued, includeLeased
+	return r.labelsByJobId, nil
+}
+
+func TestPulsarSubmitServer_ReprioritizeJobsByFilter_InvalidSelector(t *testing.T) {
+	srv := &PulsarSubmitServer{}
+	_, err := srv.ReprioritizeJobsByFilter(context.Background(), &api.JobReprioritizeByFilterRequest{
+		Queue:         "queue",
+		JobSetId:      "jobSet",
+		LabelSelector: "team==ml==",
+	})
+	var invalidArgument *armadaerrors.ErrInvalidArgument
+	assert.True(t, errors.As(err, &invalidArgument))
+}
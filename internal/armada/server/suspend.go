@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/pointer"
+	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// SuspendJobSet stops the queued jobs of a job set from being scheduled until the job set is resumed.
+// Jobs of the job set that are already leased or running are unaffected, and jobs submitted to the job set
+// while it's suspended are queued as usual. Suspension is only supported by the Pulsar scheduler.
+func (srv *PulsarSubmitServer) SuspendJobSet(grpcCtx context.Context, req *api.JobSetSuspendRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := srv.validateJobSetSuspension(req.Queue, req.JobSetId); err != nil {
+		return nil, err
+	}
+	userId, groups, err := srv.Authorize(ctx, req.Queue, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
+	if err != nil {
+		return nil, err
+	}
+	sequence := &armadaevents.EventSequence{
+		Queue:      req.Queue,
+		JobSetName: req.JobSetId,
+		UserId:     userId,
+		Groups:     groups,
+		Events: []*armadaevents.EventSequence_Event{
+			{
+				Created: pointer.Now(),
+				Event: &armadaevents.EventSequence_Event_SuspendJobSet{
+					SuspendJobSet: &armadaevents.SuspendJobSet{
+						Reason: util.Truncate(req.Reason, 512),
+					},
+				},
+			},
+		},
+	}
+	if err := srv.publishToPulsar(ctx, []*armadaevents.EventSequence{sequence}, schedulers.Pulsar); err != nil {
+		ctx.WithError(err).Error("failed to send suspend jobset message to pulsar")
+		return nil, status.Error(codes.Internal, "failed to send suspend jobset message to pulsar")
+	}
+	return &types.Empty{}, nil
+}
+
+// ResumeJobSet allows the queued jobs of a suspended job set to be scheduled again.
+// Resuming a job set that isn't suspended has no effect.
+func (srv *PulsarSubmitServer) ResumeJobSet(grpcCtx context.Context, req *api.JobSetResumeRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := srv.validateJobSetSuspension(req.Queue, req.JobSetId); err != nil {
+		return nil, err
+	}
+	userId, groups, err := srv.Authorize(ctx, req.Queue, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
+	if err != nil {
+		return nil, err
+	}
+	sequence := &armadaevents.EventSequence{
+		Queue:      req.Queue,
+		JobSetName: req.JobSetId,
+		UserId:     userId,
+		Groups:     groups,
+		Events: []*armadaevents.EventSequence_Event{
+			{
+				Created: pointer.Now(),
+				Event: &armadaevents.EventSequence_Event_ResumeJobSet{
+					ResumeJobSet: &armadaevents.ResumeJobSet{},
+				},
+			},
+		},
+	}
+	if err := srv.publishToPulsar(ctx, []*armadaevents.EventSequence{sequence}, schedulers.Pulsar); err != nil {
+		ctx.WithError(err).Error("failed to send resume jobset message to pulsar")
+		return nil, status.Error(codes.Internal, "failed to send resume jobset message to pulsar")
+	}
+	return &types.Empty{}, nil
+}
+
+func (srv *PulsarSubmitServer) validateJobSetSuspension(queueName string, jobSetId string) error {
+	if !srv.PulsarSchedulerEnabled {
+		return status.Error(codes.Unimplemented, "suspending job sets is only supported by the Pulsar scheduler, which isn't enabled")
+	}
+	if queueName == "" {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "Queue",
+			Value:   queueName,
+			Message: "queue cannot be empty",
+		}
+	}
+	if jobSetId == "" {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "JobSetId",
+			Value:   jobSetId,
+			Message: "job set cannot be empty",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/mocks"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestPulsarSubmitServer_SuspendAndResumeJobSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	producer := mocks.NewMockProducer(ctrl)
+	var sequences []*armadaevents.EventSequence
+	producer.EXPECT().SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+			sequence := &armadaevents.EventSequence{}
+			require.NoError(t, proto.Unmarshal(msg.Payload, sequence))
+			sequences = append(sequences, sequence)
+			callback(nil, msg, nil)
+		},
+	).AnyTimes()
+
+	srv := newTestValidatingServer()
+	srv.Producer = producer
+	srv.MaxAllowedMessageSize = 1024 * 1024
+	srv.PulsarSchedulerEnabled = true
+
+	_, err := srv.SuspendJobSet(context.Background(), &api.JobSetSuspendRequest{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		Reason:   "waiting for data",
+	})
+	require.NoError(t, err)
+	_, err = srv.ResumeJobSet(context.Background(), &api.JobSetResumeRequest{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sequences, 2)
+	for _, sequence := range sequences {
+		assert.Equal(t, "queue", sequence.Queue)
+		assert.Equal(t, "jobSet", sequence.JobSetName)
+		require.Len(t, sequence.Events, 1)
+	}
+	suspendJobSet := sequences[0].Events[0].GetSuspendJobSet()
+	require.NotNil(t, suspendJobSet)
+	assert.Equal(t, "waiting for data", suspendJobSet.Reason)
+	assert.NotNil(t, sequences[1].Events[0].GetResumeJobSet())
+}
+
+func TestPulsarSubmitServer_SuspendJobSet_Invalid(t *testing.T) {
+	srv := newTestValidatingServer()
+	_, err := srv.SuspendJobSet(context.Background(), &api.JobSetSuspendRequest{Queue: "queue", JobSetId: "jobSet"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	srv.PulsarSchedulerEnabled = true
+	_, err = srv.SuspendJobSet(context.Background(), &api.JobSetSuspendRequest{Queue: "queue"})
+	var invalidArgument *armadaerrors.ErrInvalidArgument
+	assert.True(t, errors.As(err, &invalidArgument))
+}
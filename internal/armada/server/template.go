@@ -0,0 +1,330 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// Parameters are referred to in templates as "{{name}}", optionally with whitespace around the name.
+var templateParameterRegex = regexp.MustCompile(`{{\s*([A-Za-z_][A-Za-z0-9_]*)\s*}}`)
+
+// JobTemplateServer manages job templates and expands templates into job sets on submission.
+type JobTemplateServer struct {
+	api.UnimplementedJobTemplatesServer
+	JobTemplateRepository repository.JobTemplateRepository
+	// Used to authorize requests and to submit expanded jobs.
+	SubmitServer *PulsarSubmitServer
+	// Maximum number of jobs a single template submission may expand into.
+	MaxExpandedJobs int
+	Clock           clock.Clock
+}
+
+func (srv *JobTemplateServer) CreateJobTemplate(grpcCtx context.Context, req *api.JobTemplate) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validateJobTemplate(req); err != nil {
+		return nil, err
+	}
+	userId, _, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
+	if err != nil {
+		return nil, err
+	}
+
+	jobTemplate := proto.Clone(req).(*api.JobTemplate)
+	jobTemplate.Owner = userId
+	jobTemplate.Created = srv.Clock.Now().UTC()
+	err = srv.JobTemplateRepository.CreateJobTemplate(jobTemplate)
+	var alreadyExists *repository.ErrJobTemplateAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		return nil, &armadaerrors.ErrAlreadyExists{Type: "jobTemplate", Value: req.Name, Message: err.Error()}
+	} else if err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (srv *JobTemplateServer) UpdateJobTemplate(grpcCtx context.Context, req *api.JobTemplate) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validateJobTemplate(req); err != nil {
+		return nil, err
+	}
+	userId, _, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := srv.getJobTemplate(req.Queue, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	jobTemplate := proto.Clone(req).(*api.JobTemplate)
+	jobTemplate.Owner = userId
+	jobTemplate.Created = existing.Created
+	if err := srv.JobTemplateRepository.UpdateJobTemplate(jobTemplate); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (srv *JobTemplateServer) DeleteJobTemplate(grpcCtx context.Context, req *api.JobTemplateDeleteRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if _, _, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit); err != nil {
+		return nil, err
+	}
+	if _, err := srv.getJobTemplate(req.Queue, req.Name); err != nil {
+		return nil, err
+	}
+	if err := srv.JobTemplateRepository.DeleteJobTemplate(req.Queue, req.Name); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (srv *JobTemplateServer) GetJobTemplate(grpcCtx context.Context, req *api.JobTemplateGetRequest) (*api.JobTemplate, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if _, _, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.WatchAllEvents, queue.PermissionVerbWatch); err != nil {
+		return nil, err
+	}
+	return srv.getJobTemplate(req.Queue, req.Name)
+}
+
+func (srv *JobTemplateServer) GetJobTemplates(grpcCtx context.Context, req *api.JobTemplateListRequest) (*api.JobTemplateList, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if req.Queue == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "Queue",
+			Value:   req.Queue,
+			Message: "queue cannot be empty",
+		}
+	}
+	if _, _, err := srv.SubmitServer.Authorize(ctx, req.Queue, permissions.WatchAllEvents, queue.PermissionVerbWatch); err != nil {
+		return nil, err
+	}
+	jobTemplates, err := srv.JobTemplateRepository.GetJobTemplates(req.Queue)
+	if err != nil {
+		return nil, err
+	}
+	return &api.JobTemplateList{JobTemplates: jobTemplates}, nil
+}
+
+// SubmitTemplate expands either a stored or an inline template into one job per combination of parameter values
+// and submits the resulting jobs to a single job set.
+func (srv *JobTemplateServer) SubmitTemplate(grpcCtx context.Context, req *api.JobTemplateSubmitRequest) (*api.JobSubmitResponse, error) {
+	if (req.TemplateName == "") == (req.Template == nil) {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "TemplateName",
+			Value:   req.TemplateName,
+			Message: "exactly one of template name and template must be provided",
+		}
+	}
+	template := req.Template
+	if req.TemplateName != "" {
+		jobTemplate, err := srv.getJobTemplate(req.Queue, req.TemplateName)
+		if err != nil {
+			return nil, err
+		}
+		template = jobTemplate.Template
+	}
+
+	items, err := expandJobTemplate(template, req.Parameters, req.JobSetId, srv.MaxExpandedJobs)
+	if err != nil {
+		return nil, err
+	}
+	if req.TemplateName != "" {
+		for _, item := range items {
+			item.Annotations[armadaconfiguration.JobTemplateAnnotation] = req.TemplateName
+		}
+	}
+	// Authorization and validation of the expanded jobs is left to the submit server.
+	return srv.SubmitServer.SubmitJobs(grpcCtx, &api.JobSubmitRequest{
+		Queue:           req.Queue,
+		JobSetId:        req.JobSetId,
+		JobRequestItems: items,
+	})
+}
+
+func (srv *JobTemplateServer) getJobTemplate(queueName string, name string) (*api.JobTemplate, error) {
+	jobTemplate, err := srv.JobTemplateRepository.GetJobTemplate(queueName, name)
+	var notFound *repository.ErrJobTemplateNotFound
+	if errors.As(err, &notFound) {
+		return nil, &armadaerrors.ErrNotFound{Type: "jobTemplate", Value: name, Message: err.Error()}
+	}
+	return jobTemplate, err
+}
+
+// expandJobTemplate returns one job request item for each combination of parameter values,
+// obtained by replacing each parameter in the template by the corresponding value.
+//
+// Each item is given a client id unique to its combination of values,
+// such that submitting the same combination to the same job set more than once results in a single job.
+// If the client id of the template refers to parameters, the expanded client id is used as-is.
+// Otherwise, a hash of the parameter values is appended to the client id of the template, or to the job set id if empty.
+func expandJobTemplate(
+	template *api.JobSubmitRequestItem,
+	parameters map[string]*api.JobTemplateParameterValues,
+	jobSetId string,
+	maxExpandedJobs int,
+) ([]*api.JobSubmitRequestItem, error) {
+	templateJson, err := json.Marshal(template)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Check that parameters referred to by the template and those provided coincide.
+	referenced := make(map[string]bool)
+	for _, match := range templateParameterRegex.FindAllSubmatch(templateJson, -1) {
+		referenced[string(match[1])] = true
+	}
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		if !referenced[name] {
+			return nil, &armadaerrors.ErrInvalidArgument{
+				Name:    "Parameters",
+				Value:   name,
+				Message: fmt.Sprintf("parameter %s is not referred to by the template", name),
+			}
+		}
+		names = append(names, name)
+	}
+	for name := range referenced {
+		if _, ok := parameters[name]; !ok {
+			return nil, &armadaerrors.ErrInvalidArgument{
+				Name:    "Parameters",
+				Value:   name,
+				Message: fmt.Sprintf("no values provided for parameter %s", name),
+			}
+		}
+	}
+	sort.Strings(names)
+
+	// Check the size of the expansion before expanding.
+	numJobs := 1
+	for _, name := range names {
+		numValues := len(parameters[name].GetValues())
+		if numValues == 0 {
+			return nil, &armadaerrors.ErrInvalidArgument{
+				Name:    "Parameters",
+				Value:   name,
+				Message: fmt.Sprintf("no values provided for parameter %s", name),
+			}
+		}
+		numJobs *= numValues
+		if numJobs > maxExpandedJobs {
+			return nil, &armadaerrors.ErrInvalidArgument{
+				Name:    "Parameters",
+				Value:   names,
+				Message: fmt.Sprintf("template expands into more than the maximum of %d jobs", maxExpandedJobs),
+			}
+		}
+	}
+
+	clientIdIsParameterised := templateParameterRegex.MatchString(template.ClientId)
+	items := make([]*api.JobSubmitRequestItem, 0, numJobs)
+	indices := make([]int, len(names))
+	for {
+		values := make(map[string]string, len(names))
+		for i, name := range names {
+			values[name] = parameters[name].Values[indices[i]]
+		}
+		item, err := expandJobTemplateWithValues(templateJson, values)
+		if err != nil {
+			return nil, err
+		}
+		if !clientIdIsParameterised {
+			clientId := template.ClientId
+			if clientId == "" {
+				clientId = jobSetId
+			}
+			item.ClientId = clientId + "-" + hashTemplateParameterValues(names, values)
+		}
+		if item.Annotations == nil {
+			item.Annotations = make(map[string]string)
+		}
+		items = append(items, item)
+
+		// Advance to the next combination of values, with the last parameter varying fastest.
+		i := len(indices) - 1
+		for ; i >= 0; i-- {
+			indices[i]++
+			if indices[i] < len(parameters[names[i]].Values) {
+				break
+			}
+			indices[i] = 0
+		}
+		if i < 0 {
+			break
+		}
+	}
+	return items, nil
+}
+
+func expandJobTemplateWithValues(templateJson []byte, values map[string]string) (*api.JobSubmitRequestItem, error) {
+	var err error
+	expandedJson := templateParameterRegex.ReplaceAllFunc(templateJson, func(match []byte) []byte {
+		name := string(templateParameterRegex.FindSubmatch(match)[1])
+		// Parameters can only appear within JSON strings; escape values accordingly.
+		valueJson, marshalErr := json.Marshal(values[name])
+		if marshalErr != nil {
+			err = marshalErr
+			return match
+		}
+		return valueJson[1 : len(valueJson)-1]
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	item := &api.JobSubmitRequestItem{}
+	if err := json.Unmarshal(expandedJson, item); err != nil {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "Parameters",
+			Value:   values,
+			Message: fmt.Sprintf("expanded template is invalid: %s", err),
+		}
+	}
+	return item, nil
+}
+
+// hashTemplateParameterValues returns a short hash uniquely identifying a combination of parameter values.
+func hashTemplateParameterValues(names []string, values map[string]string) string {
+	var sb strings.Builder
+	for _, name := range names {
+		valueJson, _ := json.Marshal(values[name])
+		sb.WriteString(name)
+		sb.WriteString("=")
+		sb.Write(valueJson)
+		sb.WriteString("\n")
+	}
+	hash := sha256.Sum256([]byte(sb.String()))
+	return hex.EncodeToString(hash[:8])
+}
+
+func validateJobTemplate(jobTemplate *api.JobTemplate) error {
+	if jobTemplate.Name == "" {
+		return &armadaerrors.ErrInvalidArgument{Name: "Name", Value: jobTemplate.Name, Message: "name cannot be empty"}
+	}
+	if jobTemplate.Queue == "" {
+		return &armadaerrors.ErrInvalidArgument{Name: "Queue", Value: jobTemplate.Queue, Message: "queue cannot be empty"}
+	}
+	if jobTemplate.Template == nil {
+		return &armadaerrors.ErrInvalidArgument{Name: "Template", Value: jobTemplate.Template, Message: "template cannot be empty"}
+	}
+	return nil
+}
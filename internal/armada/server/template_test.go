@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func testJobTemplate() *api.JobSubmitRequestItem {
+	return &api.JobSubmitRequestItem{
+		Priority:    1,
+		Namespace:   "namespace",
+		Annotations: map[string]string{"lr": "{{lr}}"},
+		PodSpecs: []*v1.PodSpec{{
+			Containers: []v1.Container{{
+				Name:  "train",
+				Image: "train:{{ version }}",
+				Args:  []string{"--lr={{lr}}", "--version={{version}}"},
+			}},
+		}},
+	}
+}
+
+func parameterValues(values ...string) *api.JobTemplateParameterValues {
+	return &api.JobTemplateParameterValues{Values: values}
+}
+
+func TestExpandJobTemplate(t *testing.T) {
+	items, err := expandJobTemplate(
+		testJobTemplate(),
+		map[string]*api.JobTemplateParameterValues{
+			"lr":      parameterValues("0.1", "0.01", "0.001"),
+			"version": parameterValues("1", `"2"`),
+		},
+		"jobSet",
+		6,
+	)
+	require.NoError(t, err)
+	require.Len(t, items, 6)
+
+	expected := [][2]string{
+		{"0.1", "1"}, {"0.1", `"2"`},
+		{"0.01", "1"}, {"0.01", `"2"`},
+		{"0.001", "1"}, {"0.001", `"2"`},
+	}
+	clientIds := make(map[string]bool)
+	for i, item := range items {
+		lr, version := expected[i][0], expected[i][1]
+		assert.Equal(t, map[string]string{"lr": lr}, item.Annotations)
+		assert.Equal(t, "train:"+version, item.PodSpecs[0].Containers[0].Image)
+		assert.Equal(t, []string{"--lr=" + lr, "--version=" + version}, item.PodSpecs[0].Containers[0].Args)
+		assert.Equal(t, "namespace", item.Namespace)
+		assert.Equal(t, 1.0, item.Priority)
+		assert.Regexp(t, "^jobSet-[0-9a-f]{16}$", item.ClientId)
+		clientIds[item.ClientId] = true
+	}
+	assert.Len(t, clientIds, 6)
+
+	// Client ids only depend on the parameter values, such that resubmitting is deduplicated.
+	again, err := expandJobTemplate(
+		testJobTemplate(),
+		map[string]*api.JobTemplateParameterValues{
+			"lr":      parameterValues("0.01"),
+			"version": parameterValues("1"),
+		},
+		"jobSet",
+		6,
+	)
+	require.NoError(t, err)
+	require.Len(t, again, 1)
+	assert.Equal(t, items[2].ClientId, again[0].ClientId)
+}
+
+func TestExpandJobTemplate_ClientId(t *testing.T) {
+	template := testJobTemplate()
+	template.ClientId = "run"
+	items, err := expandJobTemplate(
+		template,
+		map[string]*api.JobTemplateParameterValues{"lr": parameterValues("0.1"), "version": parameterValues("1")},
+		"jobSet",
+		10,
+	)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Regexp(t, "^run-[0-9a-f]{16}$", items[0].ClientId)
+
+	template.ClientId = "run-{{version}}-{{lr}}"
+	items, err = expandJobTemplate(
+		template,
+		map[string]*api.JobTemplateParameterValues{"lr": parameterValues("0.1"), "version": parameterValues("1")},
+		"jobSet",
+		10,
+	)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "run-1-0.1", items[0].ClientId)
+}
+
+func TestExpandJobTemplate_NoParameters(t *testing.T) {
+	template := &api.JobSubmitRequestItem{Namespace: "namespace"}
+	items, err := expandJobTemplate(template, nil, "jobSet", 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "namespace", items[0].Namespace)
+}
+
+func TestExpandJobTemplate_Invalid(t *testing.T) {
+	tests := map[string]map[string]*api.JobTemplateParameterValues{
+		"missing parameter": {
+			"lr": parameterValues("0.1"),
+		},
+		"unreferenced parameter": {
+			"lr":      parameterValues("0.1"),
+			"version": parameterValues("1"),
+			"other":   parameterValues("1"),
+		},
+		"no values": {
+			"lr":      parameterValues(),
+			"version": parameterValues("1"),
+		},
+		"too many jobs": {
+			"lr":      parameterValues("0.1", "0.01", "0.001"),
+			"version": parameterValues("1", "2", "3", "4"),
+		},
+	}
+	for name, parameters := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := expandJobTemplate(testJobTemplate(), parameters, "jobSet", 10)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJobTemplateServer_SubmitTemplate_RequiresExactlyOneTemplate(t *testing.T) {
+	srv := &JobTemplateServer{MaxExpandedJobs: 10}
+	_, err := srv.SubmitTemplate(context.Background(), &api.JobTemplateSubmitRequest{Queue: "queue", JobSetId: "jobSet"})
+	assert.Error(t, err)
+	_, err = srv.SubmitTemplate(context.Background(), &api.JobTemplateSubmitRequest{
+		Queue:        "queue",
+		JobSetId:     "jobSet",
+		TemplateName: "template",
+		Template:     testJobTemplate(),
+	})
+	assert.Error(t, err)
+}
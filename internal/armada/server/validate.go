@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/admission"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/clientinfo"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/util"
+	commonvalidation "github.com/armadaproject/armada/internal/common/validation"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// ValidateJobs validates and defaults the jobs of req in the same way as SubmitJobs, runs the admission validators,
+// and checks that each job could be scheduled onto the nodes currently known to the schedulers,
+// but doesn't submit any jobs. Rather than failing on the first invalid job, it returns the reasons each job is invalid.
+//
+// Since the tasks of an array job differ only by their index, only the first task of each array job is validated.
+// The pods of a multi-pod job are each validated, and errors relating to any of them are reported for the job.
+func (srv *PulsarSubmitServer) ValidateJobs(grpcCtx context.Context, req *api.JobSubmitRequest) (*api.JobValidateResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	userId, groups, err := srv.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.JobValidateResponse{
+		JobResponseItems: make([]*api.JobValidateResponseItem, len(req.JobRequestItems)),
+	}
+	for i := range response.JobResponseItems {
+		response.JobResponseItems[i] = &api.JobValidateResponseItem{}
+	}
+	if req.JobSetId == "" {
+		response.Errors = append(response.Errors, "job set not specified")
+		return response, nil
+	}
+	compressedOwnershipGroups, err := srv.SubmitServer.compressOwnershipGroups(groups)
+	if err != nil {
+		return nil, err
+	}
+	addClientInfoAnnotations(req.JobRequestItems, clientinfo.FromContext(grpcCtx))
+
+	// The number of jobs in the job set isn't checked, since it may change before the jobs are submitted.
+	limits := srv.SubmissionLimits.ForQueue(req.Queue)
+	numJobs := 0
+
+	// Jobs that passed validation so far, and the index of the item each was created from.
+	jobs := make([]*api.Job, 0, len(req.JobRequestItems))
+	itemIndexByJobId := make(map[string]int, len(req.JobRequestItems))
+	jobIdByClientId := make(map[string]string)
+	for i, item := range req.JobRequestItems {
+		if err := validateJobSpecSize(i, item, limits); err != nil {
+			addJobError(response, i, err)
+			continue
+		}
+		numJobs += jobsPerItem(item)
+		if item.ArraySize > 0 {
+			if err := validateArraySize(i, item, srv.MaxArrayJobSize); err != nil {
+				addJobError(response, i, err)
+				continue
+			}
+			// Keep the client id of the array so that later items may depend on it.
+			task := arrayTask(item, util.NewULID(), 0)
+			task.ClientId = item.ClientId
+			item = task
+		}
+		// The pods of a multi-pod job are validated as separate jobs making up a gang, as they're submitted.
+		podItems := []*api.JobSubmitRequestItem{item}
+		if isMultiPodJob(item) {
+			if err := validateMultiPodJob(i, item); err != nil {
+				addJobError(response, i, err)
+				continue
+			}
+			multiPodJobId := util.NewULID()
+			podItems = make([]*api.JobSubmitRequestItem, len(item.PodSpecs))
+			for index := range podItems {
+				podItems[index] = multiPodJobPod(item, multiPodJobId, index)
+				// Keep the client id of the job only for the first pod, such that later items may depend on it.
+				podItems[index].ClientId = ""
+			}
+			podItems[0].ClientId = item.ClientId
+		}
+		itemJobs := make([]*api.Job, 0, len(podItems))
+		for _, podItem := range podItems {
+			job, err := srv.validateJob(req, i, podItem, userId, groups, compressedOwnershipGroups, jobIdByClientId)
+			if err != nil {
+				addJobError(response, i, err)
+				break
+			}
+			itemJobs = append(itemJobs, job)
+		}
+		if len(itemJobs) < len(podItems) {
+			continue
+		}
+		for _, job := range itemJobs {
+			jobs = append(jobs, job)
+			itemIndexByJobId[job.Id] = i
+		}
+	}
+
+	// Checks that depend on several jobs, e.g., that the jobs of each gang are consistent.
+	if err := validateSubmissionSize(numJobs, limits); err != nil {
+		response.Errors = append(response.Errors, err.Error())
+	}
+	if err := commonvalidation.ValidateApiJobs(jobs, *srv.SubmitServer.schedulingConfig); err != nil {
+		response.Errors = append(response.Errors, err.Error())
+	}
+
+	if len(srv.AdmissionValidators) > 0 {
+		err := admission.ValidateAll(ctx, srv.AdmissionValidators, &admission.Review{
+			Queue:    req.Queue,
+			JobSetId: req.JobSetId,
+			User:     userId,
+			Groups:   groups,
+			Jobs:     jobs,
+		})
+		var rejected *admission.ErrRejected
+		if errors.As(err, &rejected) {
+			addRejection(response, rejected, itemIndexByJobId)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to validate job submission: %s", err)
+		}
+	}
+
+	for gangId, gang := range srv.groupJobsByGangId(jobs) {
+		if _, err := srv.assignGangScheduler(gangId, gang, req.JobRequestItems[0].Scheduler); err != nil {
+			for _, job := range gang {
+				addJobError(response, itemIndexByJobId[job.Id], err)
+			}
+		}
+	}
+
+	response.Valid = len(response.Errors) == 0
+	for _, item := range response.JobResponseItems {
+		if len(item.Errors) > 0 {
+			response.Valid = false
+		}
+	}
+	return response, nil
+}
+
+// validateJob creates the job for the i-th item of req as is done on submission and validates it.
+func (srv *PulsarSubmitServer) validateJob(
+	req *api.JobSubmitRequest,
+	i int,
+	item *api.JobSubmitRequestItem,
+	userId string,
+	groups []string,
+	compressedOwnershipGroups []byte,
+	jobIdByClientId map[string]string,
+) (*api.Job, error) {
+	job, err := srv.SubmitServer.createJob(req, i, item, userId, compressedOwnershipGroups, jobIdByClientId, time.Now, util.NewULID)
+	if err != nil {
+		return nil, err
+	}
+	if err := commonvalidation.ValidateApiJob(job, *srv.SubmitServer.schedulingConfig); err != nil {
+		return nil, err
+	}
+	if err := validateLogConversion(job, userId, groups); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// validateLogConversion checks that job can be converted into a log job and back, as is done on submission.
+func validateLogConversion(job *api.Job, userId string, groups []string) error {
+	if err := eventutil.PopulateK8sServicesIngresses(job, &configuration.IngressConfiguration{}); err != nil {
+		return err
+	}
+	logJob, err := eventutil.LogSubmitJobFromApiJob(job)
+	if err != nil {
+		return err
+	}
+	_, err = eventutil.ApiJobFromLogSubmitJob(userId, groups, job.Queue, job.JobSetId, time.Now(), logJob)
+	return err
+}
+
+func addJobError(response *api.JobValidateResponse, i int, err error) {
+	response.JobResponseItems[i].Errors = append(response.JobResponseItems[i].Errors, err.Error())
+}
+
+// addRejection adds the reasons an admission validator rejected the submission to the response;
+// to the jobs they relate to where known, and otherwise to the request as a whole.
+func addRejection(response *api.JobValidateResponse, rejected *admission.ErrRejected, itemIndexByJobId map[string]int) {
+	if len(rejected.Violations) == 0 {
+		response.Errors = append(response.Errors, rejected.Error())
+		return
+	}
+	for _, violation := range rejected.Violations {
+		message := fmt.Sprintf("rejected by %s: %s", rejected.Validator, violation.Description)
+		if i, ok := itemIndexByJobId[violation.JobId]; ok {
+			response.JobResponseItems[i].Errors = append(response.JobResponseItems[i].Errors, message)
+		} else {
+			response.Errors = append(response.Errors, message)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/admission"
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	schedulertypes "github.com/armadaproject/armada/internal/common/types"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func newTestValidatingServer(validators ...admission.Validator) *PulsarSubmitServer {
+	schedulingConfig := &configuration.SchedulingConfig{
+		MaxPodSpecSizeBytes: 65535,
+		Preemption: configuration.PreemptionConfig{
+			DefaultPriorityClass: "high",
+			PriorityClasses:      map[string]schedulertypes.PriorityClass{"high": {Priority: 0, Preemptible: false}},
+		},
+		MinTerminationGracePeriod: 30 * time.Second,
+		MaxTerminationGracePeriod: 300 * time.Second,
+	}
+	return &PulsarSubmitServer{
+		SubmitServer:          NewSubmitServer(&FakePermissionChecker{}, nil, nil, nil, nil, 200, &configuration.QueueManagementConfig{}, schedulingConfig, nil),
+		QueueRepository:       &fakeQueueRepository{},
+		Permissions:           &FakePermissionChecker{},
+		AdmissionValidators:   validators,
+		MaxArrayJobSize:       10,
+		Rand:                  rand.New(rand.NewSource(0)),
+		IgnoreJobSubmitChecks: true,
+	}
+}
+
+func testValidateRequestItem(clientId string, image string) *api.JobSubmitRequestItem {
+	return &api.JobSubmitRequestItem{
+		ClientId: clientId,
+		PodSpec: &v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:  "main",
+				Image: image,
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+					Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+				},
+			}},
+		},
+	}
+}
+
+func TestPulsarSubmitServer_ValidateJobs(t *testing.T) {
+	srv := newTestValidatingServer()
+	array := testValidateRequestItem("array", "ubuntu")
+	array.ArraySize = 5
+	dependent := testValidateRequestItem("dependent", "ubuntu")
+	dependent.DependsOn = []string{"array"}
+	multiPod := testValidateRequestItem("multiPod", "ubuntu")
+	multiPod.PodSpecs = []*v1.PodSpec{multiPod.PodSpec, testValidateRequestItem("", "ubuntu").PodSpec}
+	multiPod.PodSpec = nil
+	multiPodDependent := testValidateRequestItem("multiPodDependent", "ubuntu")
+	multiPodDependent.DependsOn = []string{"multiPod"}
+	srv.PulsarSchedulerEnabled = true
+
+	response, err := srv.ValidateJobs(context.Background(), &api.JobSubmitRequest{
+		Queue:           "queue",
+		JobSetId:        "jobSet",
+		JobRequestItems: []*api.JobSubmitRequestItem{testValidateRequestItem("job", "ubuntu"), array, dependent, multiPod, multiPodDependent},
+	})
+	require.NoError(t, err)
+	assert.True(t, response.Valid)
+	assert.Empty(t, response.Errors)
+	require.Len(t, response.JobResponseItems, 5)
+	for _, item := range response.JobResponseItems {
+		assert.Empty(t, item.Errors)
+	}
+}
+
+func TestPulsarSubmitServer_ValidateJobs_Diagnostics(t *testing.T) {
+	srv := newTestValidatingServer(admission.NewImagePolicy(configuration.ImagePolicyConfig{
+		Default: configuration.ImagePolicyRules{AllowedRegistries: []string{"docker.io/library"}},
+	}))
+	tooLarge := testValidateRequestItem("", "ubuntu")
+	tooLarge.ArraySize = 11
+	noPodSpec := &api.JobSubmitRequestItem{}
+	unknownDependency := testValidateRequestItem("", "ubuntu")
+	unknownDependency.DependsOn = []string{"missing"}
+	// The legacy scheduler, which is the only scheduler enabled, doesn't support dependencies.
+	dependent := testValidateRequestItem("", "ubuntu")
+	dependent.DependsOn = []string{"valid"}
+	invalidDisruptionBudget := testValidateRequestItem("", "ubuntu")
+	invalidDisruptionBudget.Annotations = map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "1.5"}
+
+	response, err := srv.ValidateJobs(context.Background(), &api.JobSubmitRequest{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		JobRequestItems: []*api.JobSubmitRequestItem{
+			testValidateRequestItem("valid", "ubuntu"),
+			tooLarge,
+			noPodSpec,
+			unknownDependency,
+			testValidateRequestItem("", "quay.io/image"),
+			dependent,
+			invalidDisruptionBudget,
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, response.Valid)
+	assert.Empty(t, response.Errors)
+	require.Len(t, response.JobResponseItems, 7)
+	assert.Empty(t, response.JobResponseItems[0].Errors)
+	for i, expected := range map[int]string{
+		1: "more than the maximum of 10 tasks",
+		2: "contains no podSpec",
+		3: "depends on missing",
+		4: "rejected by image policy: image quay.io/image of container main",
+		5: "only supported by the Pulsar scheduler",
+		6: "invalid annotation " + configuration.JobSetMaxPreemptedFractionAnnotation,
+	} {
+		require.Len(t, response.JobResponseItems[i].Errors, 1, "item %d", i)
+		assert.Contains(t, response.JobResponseItems[i].Errors[0], expected, "item %d", i)
+	}
+}
+
+func TestPulsarSubmitServer_ValidateJobs_RequestErrors(t *testing.T) {
+	srv := newTestValidatingServer(&fakeValidator{err: &admission.ErrRejected{Validator: "fake", Message: "no"}})
+	response, err := srv.ValidateJobs(context.Background(), &api.JobSubmitRequest{
+		Queue:           "queue",
+		JobSetId:        "jobSet",
+		JobRequestItems: []*api.JobSubmitRequestItem{testValidateRequestItem("", "ubuntu")},
+	})
+	require.NoError(t, err)
+	assert.False(t, response.Valid)
+	assert.Equal(t, []string{"job submission rejected by fake: no"}, response.Errors)
+	assert.Empty(t, response.JobResponseItems[0].Errors)
+}
@@ -0,0 +1,252 @@
+package armadactl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/time/rate"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// resumeFileSuffix is appended to the name of a file of job ids to get the default name of its resume file.
+const resumeFileSuffix = ".done"
+
+// progressBarWidth is the number of characters between the brackets of a progress bar.
+const progressBarWidth = 40
+
+// BulkOptions controls how an operation on job ids read from a file is split into requests.
+type BulkOptions struct {
+	// Maximum number of job ids included in each request.
+	BatchSize int
+	// Maximum number of requests sent per second.
+	RequestsPerSecond float64
+	// File to which the ids of jobs the operation succeeded for are appended. Jobs already listed in it are skipped,
+	// such that an interrupted or partially failed operation can be resumed by running it again.
+	// If empty, the path of the file of job ids suffixed with ".done" is used.
+	ResumeFile string
+}
+
+// CancelFromFile cancels the jobs of a job set whose ids are listed in the file at path, one per line.
+func (a *App) CancelFromFile(queue string, jobSetId string, path string, options BulkOptions) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		return a.cancelFromFile(c, queue, jobSetId, path, options)
+	})
+}
+
+func (a *App) cancelFromFile(c api.SubmitClient, queue string, jobSetId string, path string, options BulkOptions) error {
+	return a.processJobIdsFromFile(path, options, "Cancelled", func(ctx context.Context, jobIds []string) (map[string]string, error) {
+		result, err := c.CancelJobs(ctx, &api.JobCancelRequest{
+			JobIds:   jobIds,
+			JobSetId: jobSetId,
+			Queue:    queue,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error cancelling jobs of queue: %s and job set: %s", queue, jobSetId)
+		}
+		// The server skips, rather than rejects, ids it can't parse.
+		cancelled := util.StringListToSet(result.CancelledIds)
+		failed := make(map[string]string)
+		for _, jobId := range jobIds {
+			if !cancelled[jobId] {
+				failed[jobId] = "invalid job id"
+			}
+		}
+		return failed, nil
+	})
+}
+
+// ReprioritizeFromFile sets the priority of the jobs of a job set whose ids are listed in the file at path,
+// one per line, to priorityFactor.
+func (a *App) ReprioritizeFromFile(queue string, jobSetId string, path string, priorityFactor float64, options BulkOptions) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		return a.reprioritizeFromFile(c, queue, jobSetId, path, priorityFactor, options)
+	})
+}
+
+func (a *App) reprioritizeFromFile(c api.SubmitClient, queue string, jobSetId string, path string, priorityFactor float64, options BulkOptions) error {
+	return a.processJobIdsFromFile(path, options, "Reprioritized", func(ctx context.Context, jobIds []string) (map[string]string, error) {
+		result, err := c.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{
+			JobIds:      jobIds,
+			JobSetId:    jobSetId,
+			Queue:       queue,
+			NewPriority: priorityFactor,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reprioritising jobs of queue: %s and job set: %s", queue, jobSetId)
+		}
+		failed := make(map[string]string)
+		for jobId, errorString := range result.ReprioritizationResults {
+			if errorString != "" {
+				failed[jobId] = errorString
+			}
+		}
+		return failed, nil
+	})
+}
+
+// processJobIdsFromFile calls process with batches of the job ids in the file at path that aren't yet listed in
+// the resume file, at most options.RequestsPerSecond times per second, while displaying progress.
+// process returns the ids it failed for, mapped to why; all other ids are appended to the resume file.
+// Processing stops at the first batch process returns an error for, since later batches would likely fail too.
+func (a *App) processJobIdsFromFile(
+	path string,
+	options BulkOptions,
+	verb string,
+	process func(ctx context.Context, jobIds []string) (map[string]string, error),
+) error {
+	if options.BatchSize <= 0 {
+		return errors.Errorf("batch size must be positive, but got %d", options.BatchSize)
+	}
+	if options.RequestsPerSecond <= 0 {
+		return errors.Errorf("requests per second must be positive, but got %f", options.RequestsPerSecond)
+	}
+	resumeFile := options.ResumeFile
+	if resumeFile == "" {
+		resumeFile = path + resumeFileSuffix
+	}
+
+	jobIds, err := readJobIdsFile(path)
+	if err != nil {
+		return err
+	}
+	doneJobIds, err := readJobIdsFile(resumeFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	done := util.StringListToSet(doneJobIds)
+	remainingJobIds := make([]string, 0, len(jobIds))
+	for _, jobId := range jobIds {
+		if !done[jobId] {
+			remainingJobIds = append(remainingJobIds, jobId)
+		}
+	}
+	if len(remainingJobIds) < len(jobIds) {
+		fmt.Fprintf(a.Out, "Skipping %d jobs listed in %s\n", len(jobIds)-len(remainingJobIds), resumeFile)
+	}
+	if len(remainingJobIds) == 0 {
+		fmt.Fprintf(a.Out, "No jobs left to process\n")
+		return nil
+	}
+
+	f, err := os.OpenFile(resumeFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer f.Close()
+
+	limiter := rate.NewLimiter(rate.Limit(options.RequestsPerSecond), 1)
+	progress := newProgressBar(a.Out, verb, len(remainingJobIds))
+	failed := make(map[string]string)
+	var batchErr error
+	for _, batch := range util.Batch(remainingJobIds, options.BatchSize) {
+		if err := limiter.Wait(armadacontext.Background()); err != nil {
+			return errors.WithStack(err)
+		}
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		batchFailed, err := process(ctx, batch)
+		cancel()
+		if err != nil {
+			batchErr = err
+			break
+		}
+		for _, jobId := range batch {
+			if reason, ok := batchFailed[jobId]; ok {
+				failed[jobId] = reason
+			} else if _, err := fmt.Fprintln(f, jobId); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		progress.add(len(batch), len(batchFailed))
+	}
+	progress.finish()
+
+	if len(failed) > 0 {
+		fmt.Fprintf(a.Out, "\nFailed for jobs with ID:\n")
+		failedIds := maps.Keys(failed)
+		sort.Strings(failedIds)
+		for _, jobId := range failedIds {
+			fmt.Fprintf(a.Out, "%s failed with error %s\n", jobId, failed[jobId])
+		}
+	}
+	if batchErr != nil || len(failed) > 0 {
+		fmt.Fprintf(a.Out, "\nRun the command again to retry the jobs not listed in %s\n", resumeFile)
+	}
+	if batchErr != nil {
+		return batchErr
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("failed for %d of %d jobs", len(failed), len(remainingJobIds))
+	}
+	return nil
+}
+
+// readJobIdsFile returns the distinct job ids in the file at path, in order.
+// Ids are listed one per line; blank lines and lines starting with # are ignored.
+func readJobIdsFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	var jobIds []string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		jobId := strings.TrimSpace(scanner.Text())
+		if jobId == "" || strings.HasPrefix(jobId, "#") || seen[jobId] {
+			continue
+		}
+		seen[jobId] = true
+		jobIds = append(jobIds, jobId)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error reading job ids from %s", path)
+	}
+	return jobIds, nil
+}
+
+// progressBar displays the number of jobs processed out of a total on a single, repeatedly overwritten, line.
+type progressBar struct {
+	out       io.Writer
+	verb      string
+	total     int
+	processed int
+	failed    int
+}
+
+func newProgressBar(out io.Writer, verb string, total int) *progressBar {
+	p := &progressBar{out: out, verb: verb, total: total}
+	p.print()
+	return p
+}
+
+func (p *progressBar) add(processed int, failed int) {
+	p.processed += processed
+	p.failed += failed
+	p.print()
+}
+
+func (p *progressBar) finish() {
+	fmt.Fprintln(p.out)
+}
+
+func (p *progressBar) print() {
+	filled := progressBarWidth * p.processed / p.total
+	fmt.Fprintf(
+		p.out, "\r[%s%s] %s %d/%d jobs (%d failed)",
+		strings.Repeat("=", filled), strings.Repeat(" ", progressBarWidth-filled), p.verb, p.processed-p.failed, p.total, p.failed,
+	)
+}
@@ -0,0 +1,205 @@
+package armadactl
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var bulkTestJobIds = []string{"job-1", "job-2", "job-3", "job-4", "job-5", "job-6", "job-7"}
+
+// fakeBulkSubmitClient records the job ids of each cancel and reprioritize request, failing from request failFrom on.
+type fakeBulkSubmitClient struct {
+	api.SubmitClient
+	requests [][]string
+	// 1-based index of the first request to fail; 0 means no request fails.
+	failFrom int
+	// Ids the server rejects, e.g., because they can't be parsed.
+	invalidIds map[string]bool
+}
+
+func (c *fakeBulkSubmitClient) record(jobIds []string) error {
+	c.requests = append(c.requests, jobIds)
+	if c.failFrom > 0 && len(c.requests) >= c.failFrom {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func (c *fakeBulkSubmitClient) CancelJobs(ctx context.Context, in *api.JobCancelRequest, opts ...grpc.CallOption) (*api.CancellationResult, error) {
+	if err := c.record(in.JobIds); err != nil {
+		return nil, err
+	}
+	result := &api.CancellationResult{}
+	for _, jobId := range in.JobIds {
+		if !c.invalidIds[jobId] {
+			result.CancelledIds = append(result.CancelledIds, jobId)
+		}
+	}
+	return result, nil
+}
+
+func (c *fakeBulkSubmitClient) ReprioritizeJobs(ctx context.Context, in *api.JobReprioritizeRequest, opts ...grpc.CallOption) (*api.JobReprioritizeResponse, error) {
+	if err := c.record(in.JobIds); err != nil {
+		return nil, err
+	}
+	result := &api.JobReprioritizeResponse{ReprioritizationResults: map[string]string{}}
+	for _, jobId := range in.JobIds {
+		if c.invalidIds[jobId] {
+			result.ReprioritizationResults[jobId] = "invalid job id"
+		} else {
+			result.ReprioritizationResults[jobId] = ""
+		}
+	}
+	return result, nil
+}
+
+func TestProcessJobIdsFromFile(t *testing.T) {
+	tests := map[string]struct {
+		batchSize        int
+		alreadyDone      []string
+		failFrom         int
+		invalidIds       []string
+		expectedRequests [][]string
+		expectedDone     []string
+		expectedOutput   []string
+		expectError      bool
+	}{
+		"all succeed": {
+			batchSize:        3,
+			expectedRequests: [][]string{{"job-1", "job-2", "job-3"}, {"job-4", "job-5", "job-6"}, {"job-7"}},
+			expectedDone:     bulkTestJobIds,
+			expectedOutput: []string{
+				"\r[                                        ] %s 0/7 jobs (0 failed)",
+				"\r[=================                       ] %s 3/7 jobs (0 failed)",
+				"\r[==================================      ] %s 6/7 jobs (0 failed)",
+				"\r[========================================] %s 7/7 jobs (0 failed)\n",
+			},
+		},
+		"request fails partway through": {
+			batchSize:        3,
+			failFrom:         2,
+			expectedRequests: [][]string{{"job-1", "job-2", "job-3"}, {"job-4", "job-5", "job-6"}},
+			expectedDone:     []string{"job-1", "job-2", "job-3"},
+			expectedOutput: []string{
+				"\r[=================                       ] %s 3/7 jobs (0 failed)\n",
+				"Run the command again to retry the jobs not listed in",
+			},
+			expectError: true,
+		},
+		"resumes after jobs already done": {
+			batchSize:        3,
+			alreadyDone:      []string{"job-1", "job-2", "job-3"},
+			expectedRequests: [][]string{{"job-4", "job-5", "job-6"}, {"job-7"}},
+			expectedDone:     bulkTestJobIds,
+			expectedOutput: []string{
+				"Skipping 3 jobs listed in",
+				"\r[========================================] %s 4/4 jobs (0 failed)\n",
+			},
+		},
+		"nothing left to do": {
+			batchSize:      3,
+			alreadyDone:    bulkTestJobIds,
+			expectedDone:   bulkTestJobIds,
+			expectedOutput: []string{"No jobs left to process\n"},
+		},
+		"some jobs rejected": {
+			batchSize:        4,
+			invalidIds:       []string{"job-2", "job-6"},
+			expectedRequests: [][]string{{"job-1", "job-2", "job-3", "job-4"}, {"job-5", "job-6", "job-7"}},
+			expectedDone:     []string{"job-1", "job-3", "job-4", "job-5", "job-7"},
+			expectedOutput: []string{
+				"\r[========================================] %s 5/7 jobs (2 failed)\n",
+				"job-2 failed with error invalid job id\njob-6 failed with error invalid job id\n",
+			},
+			expectError: true,
+		},
+	}
+	operations := map[string]func(a *App, c *fakeBulkSubmitClient, path string, options BulkOptions) error{
+		"Cancelled": func(a *App, c *fakeBulkSubmitClient, path string, options BulkOptions) error {
+			return a.cancelFromFile(c, "queue", "job-set", path, options)
+		},
+		"Reprioritized": func(a *App, c *fakeBulkSubmitClient, path string, options BulkOptions) error {
+			return a.reprioritizeFromFile(c, "queue", "job-set", path, 2, options)
+		},
+	}
+	for name, tc := range tests {
+		for verb, operation := range operations {
+			t.Run(name+"/"+verb, func(t *testing.T) {
+				dir := t.TempDir()
+				path := filepath.Join(dir, "jobs.txt")
+				// Blank lines, comments and duplicates are ignored.
+				contents := "# jobs to process\n" + strings.Join(bulkTestJobIds, "\n") + "\n\njob-1\n"
+				require.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+				if tc.alreadyDone != nil {
+					require.NoError(t, os.WriteFile(path+resumeFileSuffix, []byte(strings.Join(tc.alreadyDone, "\n")+"\n"), 0o644))
+				}
+				c := &fakeBulkSubmitClient{failFrom: tc.failFrom, invalidIds: map[string]bool{}}
+				for _, jobId := range tc.invalidIds {
+					c.invalidIds[jobId] = true
+				}
+				var out bytes.Buffer
+				a := &App{Out: &out}
+
+				err := operation(a, c, path, BulkOptions{BatchSize: tc.batchSize, RequestsPerSecond: 1000})
+				if tc.expectError {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+				assert.Equal(t, tc.expectedRequests, c.requests)
+				done, err := readJobIdsFile(path + resumeFileSuffix)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedDone, done)
+				for _, expected := range tc.expectedOutput {
+					assert.Contains(t, out.String(), strings.Replace(expected, "%s", verb, 1))
+				}
+			})
+		}
+	}
+}
+
+func TestProcessJobIdsFromFile_ResumeAfterFailure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "jobs.txt")
+	resumeFile := filepath.Join(dir, "progress.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(bulkTestJobIds, "\n")), 0o644))
+	options := BulkOptions{BatchSize: 2, RequestsPerSecond: 1000, ResumeFile: resumeFile}
+	a := &App{Out: &bytes.Buffer{}}
+
+	failing := &fakeBulkSubmitClient{failFrom: 3}
+	assert.Error(t, a.cancelFromFile(failing, "queue", "job-set", path, options))
+	done, err := readJobIdsFile(resumeFile)
+	require.NoError(t, err)
+	assert.Equal(t, bulkTestJobIds[:4], done)
+
+	// Running again only sends the jobs not yet done.
+	working := &fakeBulkSubmitClient{}
+	assert.NoError(t, a.cancelFromFile(working, "queue", "job-set", path, options))
+	assert.Equal(t, [][]string{{"job-5", "job-6"}, {"job-7"}}, working.requests)
+	done, err = readJobIdsFile(resumeFile)
+	require.NoError(t, err)
+	assert.Equal(t, bulkTestJobIds, done)
+}
+
+func TestProcessJobIdsFromFile_InvalidOptions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.txt")
+	require.NoError(t, os.WriteFile(path, []byte("job-1\n"), 0o644))
+	a := &App{Out: &bytes.Buffer{}}
+	c := &fakeBulkSubmitClient{}
+
+	assert.Error(t, a.cancelFromFile(c, "queue", "job-set", path, BulkOptions{BatchSize: 0, RequestsPerSecond: 1}))
+	assert.Error(t, a.cancelFromFile(c, "queue", "job-set", path, BulkOptions{BatchSize: 1, RequestsPerSecond: 0}))
+	assert.Error(t, a.cancelFromFile(c, "queue", "job-set", filepath.Join(t.TempDir(), "missing.txt"), BulkOptions{BatchSize: 1, RequestsPerSecond: 1}))
+	assert.Empty(t, c.requests)
+}
@@ -0,0 +1,258 @@
+package armadactl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/yaml"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+	"github.com/armadaproject/armada/pkg/client/util"
+)
+
+// maxRecentJobEvents is the number of most recent events of a job included in its description.
+const maxRecentJobEvents = 10
+
+// JobDescription combines what the Armada server and the scheduler know about a job.
+type JobDescription struct {
+	JobId    string `json:"jobId"`
+	Queue    string `json:"queue"`
+	JobSetId string `json:"jobSetId"`
+	State    string `json:"state"`
+	// The job as submitted; nil if its submitted event is no longer available.
+	Job  *api.Job             `json:"job,omitempty"`
+	Runs []*JobRunDescription `json:"runs"`
+	// Most recent scheduling report of the job, or why it isn't available.
+	SchedulingReport      string `json:"schedulingReport,omitempty"`
+	SchedulingReportError string `json:"schedulingReportError,omitempty"`
+	// Most recent events of the job, oldest first.
+	RecentEvents []*JobEventDescription `json:"recentEvents"`
+}
+
+// JobRunDescription describes an attempt at running a job, from the job being leased to a cluster until its pod
+// finished or the lease was returned.
+type JobRunDescription struct {
+	ClusterId string     `json:"clusterId"`
+	NodeName  string     `json:"nodeName,omitempty"`
+	PodNumber int32      `json:"podNumber"`
+	Leased    *time.Time `json:"leased,omitempty"`
+	Started   *time.Time `json:"started,omitempty"`
+	Finished  *time.Time `json:"finished,omitempty"`
+	// How the run ended, e.g., "succeeded" or "preempted"; empty if it hasn't.
+	Outcome string `json:"outcome,omitempty"`
+}
+
+type JobEventDescription struct {
+	Created time.Time `json:"created"`
+	Type    string    `json:"type"`
+	Reason  string    `json:"reason,omitempty"`
+}
+
+// DescribeJob prints the spec, state, runs, most recent scheduling report, and recent events of a job,
+// either in human-readable form or, if output is "json", as a JSON-encoded JobDescription.
+func (a *App) DescribeJob(jobId string, output string) error {
+	if output != "" && output != "json" {
+		return errors.Errorf("unknown output format %s; expected json or no format", output)
+	}
+	return client.WithConnection(a.Params.ApiConnectionDetails, func(cc *grpc.ClientConn) error {
+		description, err := describeJob(api.NewEventClient(cc), schedulerobjects.NewSchedulerReportingClient(cc), jobId)
+		if err != nil {
+			return err
+		}
+		if output == "json" {
+			data, err := json.MarshalIndent(description, "", "  ")
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			fmt.Fprintf(a.Out, "%s\n", data)
+			return nil
+		}
+		return a.printJobDescription(description)
+	})
+}
+
+func describeJob(eventClient api.EventClient, reportingClient schedulerobjects.SchedulerReportingClient, jobId string) (*JobDescription, error) {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+
+	details, err := eventClient.GetJobRunDetails(ctx, &api.JobRunDetailsRequest{JobId: jobId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting details of job %s", jobId)
+	}
+	description := &JobDescription{
+		JobId:    jobId,
+		Queue:    details.Queue,
+		JobSetId: details.JobSetId,
+		State:    details.State.String(),
+		Runs:     []*JobRunDescription{},
+	}
+
+	stream, err := eventClient.GetJobSetEvents(ctx, &api.JobSetRequest{Id: details.JobSetId, Queue: details.Queue})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting events of job set %s", details.JobSetId)
+	}
+	var events []api.Event
+	for {
+		message, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "error getting events of job set %s", details.JobSetId)
+		}
+		event, err := api.UnwrapEvent(message.Message)
+		if err != nil {
+			return nil, err
+		}
+		if event.GetJobId() == jobId {
+			events = append(events, event)
+		}
+	}
+	description.addEvents(events)
+
+	report, err := reportingClient.GetJobReport(ctx, &schedulerobjects.JobReportRequest{JobId: jobId})
+	if err != nil {
+		// The job may not have been considered by the scheduler recently, e.g., if it's finished.
+		description.SchedulingReportError = err.Error()
+	} else {
+		description.SchedulingReport = report.Report
+	}
+	return description, nil
+}
+
+// addEvents derives the spec, runs, and recent events of the job from its events, which must be in order.
+func (d *JobDescription) addEvents(events []api.Event) {
+	var run *JobRunDescription
+	finishRun := func(created time.Time, outcome string) {
+		if run != nil {
+			run.Finished = &created
+			run.Outcome = outcome
+			run = nil
+		}
+	}
+	startRun := func(clusterId string) {
+		if run == nil {
+			run = &JobRunDescription{ClusterId: clusterId}
+			d.Runs = append(d.Runs, run)
+		}
+	}
+	for _, event := range events {
+		created := event.GetCreated()
+		switch e := event.(type) {
+		case *api.JobSubmittedEvent:
+			job := e.Job
+			d.Job = &job
+		case *api.JobLeasedEvent:
+			// Any previous run must have ended, even if no event says how.
+			run = nil
+			startRun(e.ClusterId)
+			run.Leased = &created
+		case *api.JobPendingEvent:
+			startRun(e.ClusterId)
+			run.PodNumber = e.PodNumber
+		case *api.JobRunningEvent:
+			startRun(e.ClusterId)
+			run.Started = &created
+			run.NodeName = e.NodeName
+			run.PodNumber = e.PodNumber
+		case *api.JobSucceededEvent:
+			finishRun(created, "succeeded")
+		case *api.JobFailedEvent:
+			finishRun(created, "failed")
+		case *api.JobPreemptedEvent:
+			finishRun(created, "preempted")
+		case *api.JobLeaseReturnedEvent:
+			finishRun(created, "lease returned")
+		case *api.JobLeaseExpiredEvent:
+			finishRun(created, "lease expired")
+		case *api.JobCancelledEvent:
+			finishRun(created, "cancelled")
+		}
+	}
+
+	if len(events) > maxRecentJobEvents {
+		events = events[len(events)-maxRecentJobEvents:]
+	}
+	d.RecentEvents = make([]*JobEventDescription, len(events))
+	for i, event := range events {
+		d.RecentEvents[i] = &JobEventDescription{
+			Created: event.GetCreated(),
+			Type:    reflect.TypeOf(event).Elem().Name(),
+		}
+		if e, ok := event.(interface{ GetReason() string }); ok {
+			d.RecentEvents[i].Reason = e.GetReason()
+		}
+	}
+}
+
+func (a *App) printJobDescription(d *JobDescription) error {
+	w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+	fmt.Fprintf(w, "Job ID:\t%s\n", d.JobId)
+	fmt.Fprintf(w, "Queue:\t%s\n", d.Queue)
+	fmt.Fprintf(w, "Job set:\t%s\n", d.JobSetId)
+	fmt.Fprintf(w, "State:\t%s\n", d.State)
+	if d.Job != nil {
+		fmt.Fprintf(w, "Owner:\t%s\n", d.Job.Owner)
+		fmt.Fprintf(w, "Namespace:\t%s\n", d.Job.Namespace)
+		fmt.Fprintf(w, "Priority:\t%v\n", d.Job.Priority)
+		fmt.Fprintf(w, "Submitted:\t%s\n", d.Job.Created.Format(time.RFC3339))
+	}
+	w.Flush()
+
+	fmt.Fprintf(a.Out, "\nSpec:\n")
+	if d.Job != nil && d.Job.GetMainPodSpec() != nil {
+		data, err := yaml.Marshal(d.Job.GetMainPodSpec())
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		fmt.Fprintln(a.Out, util.Indent(2, strings.TrimSuffix(string(data), "\n")))
+	} else {
+		fmt.Fprintf(a.Out, "  Not available\n")
+	}
+
+	fmt.Fprintf(a.Out, "\nRuns:\n")
+	if len(d.Runs) == 0 {
+		fmt.Fprintf(a.Out, "  None\n")
+	} else {
+		w = tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "  CLUSTER\tNODE\tPOD\tLEASED\tSTARTED\tFINISHED\tOUTCOME")
+		for _, run := range d.Runs {
+			fmt.Fprintf(
+				w, "  %s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+				run.ClusterId, run.NodeName, run.PodNumber, formatTime(run.Leased), formatTime(run.Started), formatTime(run.Finished), run.Outcome,
+			)
+		}
+		w.Flush()
+	}
+
+	fmt.Fprintf(a.Out, "\nScheduling report:\n")
+	if d.SchedulingReportError != "" {
+		fmt.Fprintf(a.Out, "  Not available: %s\n", d.SchedulingReportError)
+	} else {
+		fmt.Fprintln(a.Out, util.Indent(2, strings.TrimSuffix(d.SchedulingReport, "\n")))
+	}
+
+	fmt.Fprintf(a.Out, "\nRecent events:\n")
+	w = tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+	for _, event := range d.RecentEvents {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", event.Created.Format(time.RFC3339), event.Type, event.Reason)
+	}
+	w.Flush()
+	return nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,228 @@
+package armadactl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var describeBaseTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func describeTime(minutes int) *time.Time {
+	t := describeBaseTime.Add(time.Duration(minutes) * time.Minute)
+	return &t
+}
+
+func TestDescribeJob(t *testing.T) {
+	tests := map[string]struct {
+		events            []api.Event
+		reportErr         error
+		expectedRuns      []*JobRunDescription
+		expectedReport    string
+		expectedReportErr string
+		expectedEvents    int
+	}{
+		"queued": {
+			events: []api.Event{
+				&api.JobSubmittedEvent{JobId: "job", Created: *describeTime(0), Job: api.Job{Id: "job", Owner: "owner"}},
+				&api.JobQueuedEvent{JobId: "job", Created: *describeTime(1)},
+			},
+			expectedRuns:   []*JobRunDescription{},
+			expectedReport: "report",
+			expectedEvents: 2,
+		},
+		"succeeded after preemption": {
+			events: []api.Event{
+				&api.JobSubmittedEvent{JobId: "job", Created: *describeTime(0), Job: api.Job{Id: "job"}},
+				&api.JobLeasedEvent{JobId: "job", Created: *describeTime(1), ClusterId: "cluster-a"},
+				&api.JobRunningEvent{JobId: "job", Created: *describeTime(2), ClusterId: "cluster-a", NodeName: "node-a"},
+				&api.JobPreemptedEvent{JobId: "job", Created: *describeTime(3), ClusterId: "cluster-a"},
+				&api.JobLeasedEvent{JobId: "job", Created: *describeTime(4), ClusterId: "cluster-b"},
+				&api.JobPendingEvent{JobId: "job", Created: *describeTime(5), ClusterId: "cluster-b", PodNumber: 1},
+				&api.JobRunningEvent{JobId: "job", Created: *describeTime(6), ClusterId: "cluster-b", NodeName: "node-b", PodNumber: 1},
+				&api.JobSucceededEvent{JobId: "job", Created: *describeTime(7), ClusterId: "cluster-b"},
+			},
+			expectedRuns: []*JobRunDescription{
+				{ClusterId: "cluster-a", NodeName: "node-a", Leased: describeTime(1), Started: describeTime(2), Finished: describeTime(3), Outcome: "preempted"},
+				{ClusterId: "cluster-b", NodeName: "node-b", PodNumber: 1, Leased: describeTime(4), Started: describeTime(6), Finished: describeTime(7), Outcome: "succeeded"},
+			},
+			expectedReport: "report",
+			expectedEvents: 8,
+		},
+		"lease returned then leased again": {
+			events: []api.Event{
+				&api.JobLeasedEvent{JobId: "job", Created: *describeTime(1), ClusterId: "cluster-a"},
+				&api.JobLeaseReturnedEvent{JobId: "job", Created: *describeTime(2), ClusterId: "cluster-a", Reason: "no capacity"},
+				&api.JobLeasedEvent{JobId: "job", Created: *describeTime(3), ClusterId: "cluster-b"},
+			},
+			expectedRuns: []*JobRunDescription{
+				{ClusterId: "cluster-a", Leased: describeTime(1), Finished: describeTime(2), Outcome: "lease returned"},
+				{ClusterId: "cluster-b", Leased: describeTime(3)},
+			},
+			expectedReport: "report",
+			expectedEvents: 3,
+		},
+		"leased again without an outcome": {
+			events: []api.Event{
+				&api.JobLeasedEvent{JobId: "job", Created: *describeTime(1), ClusterId: "cluster-a"},
+				&api.JobLeasedEvent{JobId: "job", Created: *describeTime(2), ClusterId: "cluster-b"},
+				&api.JobFailedEvent{JobId: "job", Created: *describeTime(3), ClusterId: "cluster-b", Reason: "oom"},
+			},
+			expectedRuns: []*JobRunDescription{
+				{ClusterId: "cluster-a", Leased: describeTime(1)},
+				{ClusterId: "cluster-b", Leased: describeTime(2), Finished: describeTime(3), Outcome: "failed"},
+			},
+			expectedReport: "report",
+			expectedEvents: 3,
+		},
+		"scheduling report unavailable": {
+			events: []api.Event{
+				&api.JobCancelledEvent{JobId: "job", Created: *describeTime(1)},
+			},
+			reportErr:         errors.New("job not found"),
+			expectedRuns:      []*JobRunDescription{},
+			expectedReportErr: "job not found",
+			expectedEvents:    1,
+		},
+		"only most recent events": {
+			events: func() []api.Event {
+				var events []api.Event
+				for i := 0; i < maxRecentJobEvents+5; i++ {
+					events = append(events, &api.JobUtilisationEvent{JobId: "job", Created: *describeTime(i)})
+				}
+				return events
+			}(),
+			expectedRuns:   []*JobRunDescription{},
+			expectedReport: "report",
+			expectedEvents: maxRecentJobEvents,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Events of other jobs in the same job set are ignored.
+			events := append([]api.Event{&api.JobQueuedEvent{JobId: "other", Created: *describeTime(0)}}, tc.events...)
+			eventClient := &fakeDescribeEventClient{
+				details: &api.JobRunDetails{JobId: "job", JobSetId: "job-set", Queue: "queue", State: api.JobState_RUNNING},
+				events:  events,
+			}
+			reportingClient := &fakeJobReportClient{report: "report", err: tc.reportErr}
+
+			description, err := describeJob(eventClient, reportingClient, "job")
+			require.NoError(t, err)
+			assert.Equal(t, "job", description.JobId)
+			assert.Equal(t, "queue", description.Queue)
+			assert.Equal(t, "job-set", description.JobSetId)
+			assert.Equal(t, "RUNNING", description.State)
+			assert.Equal(t, tc.expectedRuns, description.Runs)
+			assert.Equal(t, tc.expectedReport, description.SchedulingReport)
+			assert.Equal(t, tc.expectedReportErr, description.SchedulingReportError)
+			require.Len(t, description.RecentEvents, tc.expectedEvents)
+			lastEvent := tc.events[len(tc.events)-1]
+			assert.Equal(t, lastEvent.GetCreated(), description.RecentEvents[tc.expectedEvents-1].Created)
+		})
+	}
+}
+
+func TestDescribeJob_DetailsError(t *testing.T) {
+	eventClient := &fakeDescribeEventClient{err: errors.New("unavailable")}
+	_, err := describeJob(eventClient, &fakeJobReportClient{}, "job")
+	assert.Error(t, err)
+}
+
+func TestPrintJobDescription_Runs(t *testing.T) {
+	tests := map[string]struct {
+		runs     []*JobRunDescription
+		expected string
+	}{
+		"no runs": {
+			runs:     []*JobRunDescription{},
+			expected: "\nRuns:\n  None\n",
+		},
+		"finished and running": {
+			runs: []*JobRunDescription{
+				{ClusterId: "cluster-a", NodeName: "node-a", Leased: describeTime(1), Started: describeTime(2), Finished: describeTime(3), Outcome: "preempted"},
+				{ClusterId: "cluster-b", PodNumber: 1, Leased: describeTime(4)},
+			},
+			expected: "\nRuns:\n" +
+				"  CLUSTER    NODE    POD  LEASED                STARTED               FINISHED              OUTCOME\n" +
+				"  cluster-a  node-a  0    2023-01-01T00:01:00Z  2023-01-01T00:02:00Z  2023-01-01T00:03:00Z  preempted\n" +
+				"  cluster-b          1    2023-01-01T00:04:00Z  -                     -                     \n",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			app := &App{Out: &out}
+			err := app.printJobDescription(&JobDescription{
+				JobId:            "job",
+				Runs:             tc.runs,
+				SchedulingReport: "report",
+			})
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tc.expected+"\nScheduling report:\n  report\n")
+		})
+	}
+}
+
+type fakeDescribeEventClient struct {
+	api.EventClient
+	details *api.JobRunDetails
+	events  []api.Event
+	err     error
+}
+
+func (c *fakeDescribeEventClient) GetJobRunDetails(ctx context.Context, in *api.JobRunDetailsRequest, opts ...grpc.CallOption) (*api.JobRunDetails, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.details, nil
+}
+
+func (c *fakeDescribeEventClient) GetJobSetEvents(ctx context.Context, in *api.JobSetRequest, opts ...grpc.CallOption) (api.Event_GetJobSetEventsClient, error) {
+	stream := &fakeJobSetEventsStream{}
+	for _, event := range c.events {
+		message, err := api.Wrap(event)
+		if err != nil {
+			return nil, err
+		}
+		stream.messages = append(stream.messages, &api.EventStreamMessage{Message: message})
+	}
+	return stream, nil
+}
+
+type fakeJobSetEventsStream struct {
+	grpc.ClientStream
+	messages []*api.EventStreamMessage
+}
+
+func (s *fakeJobSetEventsStream) Recv() (*api.EventStreamMessage, error) {
+	if len(s.messages) == 0 {
+		return nil, io.EOF
+	}
+	message := s.messages[0]
+	s.messages = s.messages[1:]
+	return message, nil
+}
+
+type fakeJobReportClient struct {
+	schedulerobjects.SchedulerReportingClient
+	report string
+	err    error
+}
+
+func (c *fakeJobReportClient) GetJobReport(ctx context.Context, in *schedulerobjects.JobReportRequest, opts ...grpc.CallOption) (*schedulerobjects.JobReport, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &schedulerobjects.JobReport{Report: c.report}, nil
+}
@@ -0,0 +1,101 @@
+package armadactl
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/binoculars"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// logsPollInterval is how often new logs are fetched when following the logs of a job.
+const logsPollInterval = 2 * time.Second
+
+// Logs prints the logs of the latest run of a job, read from the Binoculars service of the cluster the run was
+// placed on. Logs of finished runs can be read as long as the executor hasn't yet deleted their pods.
+// If follow is true, logs are printed as they're written until the job finishes.
+// If container is empty, the logs of the only container of the pod are printed.
+func (a *App) Logs(jobId string, follow bool, container string) error {
+	return client.WithEventClient(a.Params.ApiConnectionDetails, func(c api.EventClient) error {
+		ctx := armadacontext.Background()
+		details, err := c.GetJobRunDetails(ctx, &api.JobRunDetailsRequest{JobId: jobId})
+		if err != nil {
+			return errors.Wrapf(err, "error getting details of job %s", jobId)
+		}
+		if details.ClusterId == "" {
+			fmt.Fprintf(a.Out, "Job %s has not been assigned to a cluster yet.\n", jobId)
+			return nil
+		}
+
+		return client.WithBinocularsClient(a.Params.ApiConnectionDetails, details.ClusterId, func(bc binoculars.BinocularsClient) error {
+			var lastTimestamp time.Time
+			for {
+				// Read the state of the job before its logs, such that no logs are missed once it's finished.
+				finished := isFinished(details.State)
+				response, err := bc.Logs(ctx, &binoculars.LogRequest{
+					JobId:        jobId,
+					PodNumber:    details.PodNumber,
+					PodNamespace: details.PodNamespace,
+					SinceTime:    formatSinceTime(lastTimestamp),
+					LogOptions:   &v1.PodLogOptions{Container: container},
+				})
+				if err != nil && finished {
+					fmt.Fprintf(a.Out, "Logs of job %s are no longer available on cluster %s: %s\n", jobId, details.ClusterId, err)
+					return nil
+				} else if err != nil {
+					return errors.Wrapf(err, "error getting logs of job %s from cluster %s", jobId, details.ClusterId)
+				}
+				lastTimestamp = a.printLogLines(response.Log, lastTimestamp)
+
+				if !follow || finished {
+					return nil
+				}
+				time.Sleep(logsPollInterval)
+
+				latest, err := c.GetJobRunDetails(ctx, &api.JobRunDetailsRequest{JobId: jobId})
+				if err != nil {
+					return errors.Wrapf(err, "error getting details of job %s", jobId)
+				}
+				if latest.ClusterId != details.ClusterId || latest.PodNumber != details.PodNumber {
+					fmt.Fprintf(a.Out, "Job %s has been placed on cluster %s; run the command again to read the logs of its latest run.\n", jobId, latest.ClusterId)
+					return nil
+				}
+				details = latest
+			}
+		})
+	})
+}
+
+// printLogLines prints the lines written after lastTimestamp and returns the timestamp of the last line printed.
+// Lines are filtered since Kubernetes only returns logs since a time with second precision.
+func (a *App) printLogLines(lines []*binoculars.LogLine, lastTimestamp time.Time) time.Time {
+	for _, line := range lines {
+		timestamp, err := time.Parse(time.RFC3339Nano, line.Timestamp)
+		if err != nil {
+			fmt.Fprintf(a.Out, "%s\n", line.Line)
+			continue
+		}
+		if !timestamp.After(lastTimestamp) {
+			continue
+		}
+		fmt.Fprintf(a.Out, "%s\n", line.Line)
+		lastTimestamp = timestamp
+	}
+	return lastTimestamp
+}
+
+func formatSinceTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func isFinished(state api.JobState) bool {
+	return state == api.JobState_SUCCEEDED || state == api.JobState_FAILED || state == api.JobState_CANCELLED
+}
@@ -0,0 +1,69 @@
+package armadactl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// GetNodeTypes prints the node types of each pool, or only of pool if not empty.
+func (a *App) GetNodeTypes(pool string) error {
+	return client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		report, err := c.GetNodeTypes(ctx, &schedulerobjects.NodeTypesRequest{Pool: pool})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "POOL\tNODES\tUNSCHEDULABLE\tLABELS\tTAINTS\tLARGEST NODE\tTOTAL")
+		for _, nodeType := range report.NodeTypes {
+			fmt.Fprintf(
+				w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\n",
+				nodeType.Pool,
+				nodeType.NumNodes,
+				nodeType.NumUnschedulableNodes,
+				formatNodeTypeLabels(nodeType.Labels),
+				formatNodeTypeTaints(nodeType),
+				nodeType.LargestNodeResources.CompactString(),
+				nodeType.TotalResources.CompactString(),
+			)
+		}
+		return w.Flush()
+	})
+}
+
+// formatNodeTypeLabels formats labels as in node selectors passed to kubectl, e.g., "a=b,c=d", or "<none>" if empty.
+func formatNodeTypeLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "<none>"
+	}
+	keys := maps.Keys(labels)
+	slices.Sort(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + labels[key]
+	}
+	return strings.Join(pairs, ",")
+}
+
+// formatNodeTypeTaints formats taints as passed to kubectl taint, e.g., "a=b:NoSchedule", or "<none>" if empty.
+func formatNodeTypeTaints(nodeType *schedulerobjects.NodeTypeSummary) string {
+	if len(nodeType.Taints) == 0 {
+		return "<none>"
+	}
+	taints := make([]string, len(nodeType.Taints))
+	for i, taint := range nodeType.Taints {
+		taints[i] = taint.ToString()
+	}
+	sort.Strings(taints)
+	return strings.Join(taints, ",")
+}
@@ -0,0 +1,46 @@
+package armadactl
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Recommend prints the resource requests recommended for the jobs of a job set, and optionally of a job template,
+// from the given percentile of the peak usage of its past jobs, along with the largest current request of each resource.
+func (a *App) Recommend(queueName string, jobSetId string, templateName string, percentile float64) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		recommendation, err := client.GetResourceRecommendation(c, &api.ResourceRecommendationRequest{
+			Queue:        queueName,
+			JobSetId:     jobSetId,
+			TemplateName: templateName,
+			Percentile:   percentile,
+		})
+		if err != nil {
+			return errors.WithMessagef(err, "error getting resource recommendation for queue %s, job set %s", queueName, jobSetId)
+		}
+		if len(recommendation.Requests) == 0 {
+			fmt.Fprintf(a.Out, "Not enough jobs reported their usage to make a recommendation (%d jobs)\n", recommendation.NumJobs)
+			return nil
+		}
+		fmt.Fprintf(a.Out, "Recommendation based on the usage of %d jobs:\n", recommendation.NumJobs)
+		names := make([]string, 0, len(recommendation.Requests))
+		for name := range recommendation.Requests {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "RESOURCE\tCURRENT REQUEST\tRECOMMENDED REQUEST")
+		for _, name := range names {
+			current := recommendation.CurrentRequests[name]
+			recommended := recommendation.Requests[name]
+			fmt.Fprintf(w, "%s\t%s\t%s\n", name, current.String(), recommended.String())
+		}
+		return w.Flush()
+	})
+}
@@ -0,0 +1,32 @@
+package armadactl
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Resubmit submits a copy of each of the given finished jobs to the job set they belong to.
+func (a *App) Resubmit(queueName string, jobSetId string, jobIds []string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		response, err := client.ResubmitJobs(c, &api.JobResubmitRequest{
+			Queue:    queueName,
+			JobSetId: jobSetId,
+			JobIds:   jobIds,
+		})
+		if err != nil {
+			return errors.WithMessagef(err, "error resubmitting jobs in queue %s, job set %s", queueName, jobSetId)
+		}
+		for i, jobResponseItem := range response.JobResponseItems {
+			if jobResponseItem.Error != "" {
+				fmt.Fprintf(a.Out, "Error resubmitting job %s: %s\n", jobIds[i], jobResponseItem.Error)
+			} else {
+				fmt.Fprintf(a.Out, "Resubmitted job %s as job with id %s\n", jobIds[i], jobResponseItem.JobId)
+			}
+		}
+		return nil
+	})
+}
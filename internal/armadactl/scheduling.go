@@ -1,7 +1,14 @@
 package armadactl
 
 import (
+	"encoding/json"
 	"fmt"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
@@ -82,3 +89,88 @@ func (a *App) GetJobSchedulingReport(jobId string) error {
 		return nil
 	})
 }
+
+func (a *App) GetQueueEntitlement(queueName string, pool string) error {
+	return client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		report, err := c.GetQueueEntitlement(ctx, &schedulerobjects.QueueEntitlementRequest{QueueName: queueName, Pool: pool})
+		if err != nil {
+			return err
+		}
+		for _, entitlement := range report.Entitlements {
+			printQueueEntitlement(a, entitlement)
+		}
+		return nil
+	})
+}
+
+func (a *App) GetDuplicateJobsReport(queueName string, minDuplicates int32) error {
+	return client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		report, err := c.GetDuplicateJobsReport(ctx, &schedulerobjects.DuplicateJobsReportRequest{QueueName: queueName, MinDuplicates: minDuplicates})
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(a.Out, report.Report)
+		return nil
+	})
+}
+
+// GetSchedulingContextSnapshots writes snapshots of the most recent scheduling round for each executor,
+// or only for executorId if not empty, either as JSON or as binary-encoded protobuf.
+func (a *App) GetSchedulingContextSnapshots(executorId string, format string) error {
+	return client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		snapshots, err := c.GetSchedulingContextSnapshots(ctx, &schedulerobjects.SchedulingContextSnapshotRequest{ExecutorId: executorId})
+		if err != nil {
+			return err
+		}
+		var data []byte
+		switch format {
+		case "json":
+			data, err = json.MarshalIndent(snapshots, "", "  ")
+			data = append(data, '\n')
+		case "proto":
+			data, err = proto.Marshal(snapshots)
+		default:
+			return errors.Errorf("unknown output format %s; expected json or proto", format)
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = a.Out.Write(data)
+		return err
+	})
+}
+
+func printQueueEntitlement(a *App, entitlement *schedulerobjects.QueueEntitlement) {
+	w := tabwriter.NewWriter(a.Out, 1, 1, 1, ' ', 0)
+	fmt.Fprintf(w, "Queue:\t%s\n", entitlement.QueueName)
+	fmt.Fprintf(w, "Pool:\t%s\n", entitlement.Pool)
+	fmt.Fprintf(w, "Time:\t%s\n", entitlement.Time)
+	fmt.Fprintf(w, "Weight:\t%f\n", entitlement.Weight)
+	fmt.Fprintf(w, "Fair share:\t%f\n", entitlement.FairShare)
+	fmt.Fprintf(w, "Actual share:\t%f\n", entitlement.ActualShare)
+	fmt.Fprintf(w, "Total resources:\t%s\n", entitlement.TotalResources.CompactString())
+	fmt.Fprintf(w, "Allocated:\t%s\n", entitlement.Allocated.CompactString())
+	fmt.Fprintf(w, "Maximum resources per round:\t%s\n", entitlement.MaximumResourcesPerRound.CompactString())
+	priorityClassNames := maps.Keys(entitlement.RemainingByPriorityClass)
+	slices.Sort(priorityClassNames)
+	for _, priorityClassName := range priorityClassNames {
+		allocated := entitlement.AllocatedByPriorityClass[priorityClassName]
+		remaining := entitlement.RemainingByPriorityClass[priorityClassName]
+		fmt.Fprintf(w, "Allocated (%s):\t%s\n", priorityClassName, allocated.CompactString())
+		fmt.Fprintf(w, "Remaining (%s):\t%s\n", priorityClassName, remaining.CompactString())
+	}
+	if l := entitlement.GlobalRateLimit; l != nil {
+		fmt.Fprintf(w, "Global rate limit:\trate %f/s, burst %d, %f tokens available\n", l.Rate, l.Burst, l.Tokens)
+	}
+	if l := entitlement.QueueRateLimit; l != nil {
+		fmt.Fprintf(w, "Queue rate limit:\trate %f/s, burst %d, %f tokens available\n", l.Rate, l.Burst, l.Tokens)
+	}
+	fmt.Fprintln(w)
+	w.Flush()
+}
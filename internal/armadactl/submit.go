@@ -12,26 +12,51 @@ import (
 	"github.com/armadaproject/armada/pkg/client/validation"
 )
 
+// TemplateValues are the values a job file is rendered with if it's a Go template.
+type TemplateValues struct {
+	// Paths of YAML files defining values; later files take precedence.
+	ValuesFiles []string
+	// Values of the form key=value, where key is a dot-separated path; these take precedence over values files.
+	SetValues []string
+}
+
 // Submit a job, represented by a file, to the Armada server.
-// If dry-run is true, the job file is validated but not submitted.
-func (a *App) Submit(path string, dryRun bool) error {
-	ok, err := validation.ValidateSubmitFile(path)
-	if !ok {
-		return err
+// If templateValues isn't nil, the file is a Go template that is rendered with those values, and the rendered jobs are
+// validated by the server before any is submitted.
+// If dry-run is true, the job file is validated but not submitted; if it's a template, the rendered jobs are printed.
+func (a *App) Submit(path string, templateValues *TemplateValues, dryRun bool) error {
+	var submitFile *domain.JobSubmitFile
+	var rendered []byte
+	var err error
+	if templateValues != nil {
+		submitFile, rendered, err = renderSubmitFile(path, templateValues)
+	} else {
+		submitFile, err = readSubmitFile(path)
 	}
-
-	submitFile := &domain.JobSubmitFile{}
-	err = util.BindJsonOrYaml(path, submitFile)
 	if err != nil {
 		return err
 	}
 
 	if dryRun {
+		if rendered != nil {
+			fmt.Fprintf(a.Out, "%s", rendered)
+		}
 		return nil
 	}
 
 	requests := client.CreateChunkedSubmitRequests(submitFile.Queue, submitFile.JobSetId, submitFile.Jobs)
 	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		// Templates make it easy to render many jobs wrongly, so check them all before submitting any.
+		if templateValues != nil {
+			valid, err := a.validateRequests(c, requests)
+			if err != nil {
+				return err
+			}
+			if !valid {
+				return errors.Errorf("jobs rendered from %s are invalid; no jobs were submitted", path)
+			}
+		}
+
 		for _, request := range requests {
 			response, err := client.SubmitJobs(c, request)
 			if err != nil {
@@ -41,6 +66,11 @@ func (a *App) Submit(path string, dryRun bool) error {
 			for _, jobResponseItem := range response.JobResponseItems {
 				if jobResponseItem.Error != "" {
 					fmt.Fprintf(a.Out, "Error submitting job: %s\n", jobResponseItem.Error)
+				} else if jobResponseItem.ArrayId != "" {
+					fmt.Fprintf(
+						a.Out, "Submitted array job with id %s and %d tasks to job set %s\n",
+						jobResponseItem.ArrayId, len(jobResponseItem.ArrayJobIds), request.JobSetId,
+					)
 				} else {
 					fmt.Fprintf(a.Out, "Submitted job with id %s to job set %s\n", jobResponseItem.JobId, request.JobSetId)
 				}
@@ -49,3 +79,86 @@ func (a *App) Submit(path string, dryRun bool) error {
 		return nil
 	})
 }
+
+// Validate the jobs represented by a file against the Armada server, without submitting them.
+// Returns an error if any job would fail to be submitted.
+func (a *App) Validate(path string) error {
+	submitFile, err := readSubmitFile(path)
+	if err != nil {
+		return err
+	}
+
+	requests := client.CreateChunkedSubmitRequests(submitFile.Queue, submitFile.JobSetId, submitFile.Jobs)
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		valid, err := a.validateRequests(c, requests)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return errors.Errorf("jobs in %s are invalid", path)
+		}
+		fmt.Fprintf(a.Out, "All %d jobs in %s are valid\n", len(submitFile.Jobs), path)
+		return nil
+	})
+}
+
+// validateRequests validates requests against the Armada server, printing any errors found,
+// and returns whether all jobs are valid.
+func (a *App) validateRequests(c api.SubmitClient, requests []*api.JobSubmitRequest) (bool, error) {
+	valid := true
+	for i, request := range requests {
+		response, err := client.ValidateJobs(c, request)
+		if err != nil {
+			return false, errors.WithMessagef(err, "error validating request %#v", request)
+		}
+		valid = valid && response.Valid
+		for _, e := range response.Errors {
+			fmt.Fprintf(a.Out, "Error: %s\n", e)
+		}
+		for j, jobResponseItem := range response.JobResponseItems {
+			for _, e := range jobResponseItem.Errors {
+				fmt.Fprintf(a.Out, "Error in job %d: %s\n", i*client.MaxJobsPerRequest+j, e)
+			}
+		}
+	}
+	return valid, nil
+}
+
+func readSubmitFile(path string) (*domain.JobSubmitFile, error) {
+	ok, err := validation.ValidateSubmitFile(path)
+	if !ok {
+		return nil, err
+	}
+
+	submitFile := &domain.JobSubmitFile{}
+	err = util.BindJsonOrYaml(path, submitFile)
+	if err != nil {
+		return nil, err
+	}
+	return submitFile, nil
+}
+
+// renderSubmitFile renders the template at path with templateValues, and returns the jobs rendered as well as the
+// rendered file.
+func renderSubmitFile(path string, templateValues *TemplateValues) (*domain.JobSubmitFile, []byte, error) {
+	values, err := util.LoadTemplateValues(templateValues.ValuesFiles, templateValues.SetValues)
+	if err != nil {
+		return nil, nil, err
+	}
+	rendered, err := util.RenderTemplate(path, values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ok, err := validation.ValidateSubmitData(rendered)
+	if !ok {
+		return nil, nil, errors.WithMessagef(err, "jobs rendered from %s are invalid", path)
+	}
+
+	submitFile := &domain.JobSubmitFile{}
+	err = util.BindJsonOrYamlData(rendered, submitFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return submitFile, rendered, nil
+}
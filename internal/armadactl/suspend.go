@@ -0,0 +1,41 @@
+package armadactl
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// SuspendJobSet stops the queued jobs of a job set from being scheduled until the job set is resumed.
+func (a *App) SuspendJobSet(queueName string, jobSetId string, reason string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		err := client.SuspendJobSet(c, &api.JobSetSuspendRequest{
+			Queue:    queueName,
+			JobSetId: jobSetId,
+			Reason:   reason,
+		})
+		if err != nil {
+			return errors.WithMessagef(err, "error suspending job set %s in queue %s", jobSetId, queueName)
+		}
+		fmt.Fprintf(a.Out, "Requested suspension of job set %s in queue %s\n", jobSetId, queueName)
+		return nil
+	})
+}
+
+// ResumeJobSet allows the queued jobs of a suspended job set to be scheduled again.
+func (a *App) ResumeJobSet(queueName string, jobSetId string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		err := client.ResumeJobSet(c, &api.JobSetResumeRequest{
+			Queue:    queueName,
+			JobSetId: jobSetId,
+		})
+		if err != nil {
+			return errors.WithMessagef(err, "error resuming job set %s in queue %s", jobSetId, queueName)
+		}
+		fmt.Fprintf(a.Out, "Requested resumption of job set %s in queue %s\n", jobSetId, queueName)
+		return nil
+	})
+}
@@ -0,0 +1,92 @@
+package armadactl
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Orders in which TopQueues may sort queues. Any other order is interpreted as the name of a resource,
+// in which case queues are sorted by the amount of that resource allocated to them.
+const (
+	TopQueuesSortByQueue       = "queue"
+	TopQueuesSortByFairShare   = "fair-share"
+	TopQueuesSortByActualShare = "actual-share"
+	TopQueuesSortByQueued      = "queued"
+)
+
+// clearScreen moves the cursor to the top-left corner of the terminal and clears it.
+const clearScreen = "\033[H\033[2J"
+
+// TopQueues prints the resources allocated to each active queue, its fair and actual share, and its number of queued
+// jobs, in each pool or only in pool if not empty. Queues are sorted by sortBy, in descending order unless sorted by
+// name. If watch is true, the table is refreshed every interval until an error occurs.
+func (a *App) TopQueues(pool string, sortBy string, watch bool, interval time.Duration) error {
+	return client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
+		for {
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			report, err := c.GetQueueUtilisation(ctx, &schedulerobjects.QueueUtilisationRequest{Pool: pool})
+			cancel()
+			if err != nil {
+				return err
+			}
+			sortQueueUtilisation(report.Queues, sortBy)
+			if watch {
+				fmt.Fprint(a.Out, clearScreen)
+			}
+			a.printQueueUtilisation(report.Queues)
+			if !watch {
+				return nil
+			}
+			time.Sleep(interval)
+		}
+	})
+}
+
+func (a *App) printQueueUtilisation(queues []*schedulerobjects.QueueUtilisation) {
+	w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+	fmt.Fprintln(w, "QUEUE\tPOOL\tFAIR SHARE\tACTUAL SHARE\tQUEUED\tALLOCATED")
+	for _, queue := range queues {
+		fmt.Fprintf(
+			w, "%s\t%s\t%.1f%%\t%.1f%%\t%d\t%s\n",
+			queue.QueueName, queue.Pool, 100*queue.FairShare, 100*queue.ActualShare, queue.NumQueuedJobs, queue.Allocated.CompactString(),
+		)
+	}
+	w.Flush()
+}
+
+// sortQueueUtilisation sorts queues in the given order, breaking ties by queue name and pool.
+func sortQueueUtilisation(queues []*schedulerobjects.QueueUtilisation, sortBy string) {
+	sort.SliceStable(queues, func(i, j int) bool {
+		a, b := queues[i], queues[j]
+		switch sortBy {
+		case TopQueuesSortByQueue:
+		case TopQueuesSortByFairShare:
+			if a.FairShare != b.FairShare {
+				return a.FairShare > b.FairShare
+			}
+		case TopQueuesSortByActualShare:
+			if a.ActualShare != b.ActualShare {
+				return a.ActualShare > b.ActualShare
+			}
+		case TopQueuesSortByQueued:
+			if a.NumQueuedJobs != b.NumQueuedJobs {
+				return a.NumQueuedJobs > b.NumQueuedJobs
+			}
+		default:
+			qa, qb := a.Allocated.Get(sortBy), b.Allocated.Get(sortBy)
+			if c := qa.Cmp(qb); c != 0 {
+				return c > 0
+			}
+		}
+		if a.QueueName != b.QueueName {
+			return a.QueueName < b.QueueName
+		}
+		return a.Pool < b.Pool
+	})
+}
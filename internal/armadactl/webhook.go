@@ -0,0 +1,79 @@
+package armadactl
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// CreateWebhook registers a webhook of the given kind notified of the named events of the jobs of a queue.
+func (a *App) CreateWebhook(queueName string, kind string, url string, events []string) error {
+	webhook := &api.Webhook{Queue: queueName, Url: url}
+	var err error
+	if webhook.Kind, err = api.ParseWebhookKind(kind); err != nil {
+		return err
+	}
+	for _, name := range events {
+		event, err := api.ParseNotificationEvent(name)
+		if err != nil {
+			return err
+		}
+		webhook.Events = append(webhook.Events, event)
+	}
+
+	return client.WithNotificationsClient(a.Params.ApiConnectionDetails, func(c api.NotificationsClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		created, err := c.CreateWebhook(ctx, webhook)
+		if err != nil {
+			return errors.WithMessagef(err, "error creating webhook for queue %s", queueName)
+		}
+		fmt.Fprintf(a.Out, "Created webhook %s for queue %s\n", created.Id, queueName)
+		return nil
+	})
+}
+
+// GetWebhooks prints the webhooks of a queue. URLs aren't printed, since they may contain secrets.
+func (a *App) GetWebhooks(queueName string) error {
+	return client.WithNotificationsClient(a.Params.ApiConnectionDetails, func(c api.NotificationsClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		list, err := c.GetWebhooks(ctx, &api.WebhookListRequest{Queue: queueName})
+		if err != nil {
+			return errors.WithMessagef(err, "error getting webhooks of queue %s", queueName)
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tKIND\tEVENTS\tOWNER\tCREATED")
+		for _, webhook := range list.Webhooks {
+			events := make([]string, len(webhook.Events))
+			for i, event := range webhook.Events {
+				events[i] = event.ShortName()
+			}
+			fmt.Fprintf(
+				w, "%s\t%s\t%s\t%s\t%s\n",
+				webhook.Id, webhook.Kind.ShortName(), strings.Join(events, ","), webhook.Owner, webhook.Created.UTC().Format(time.RFC3339),
+			)
+		}
+		return w.Flush()
+	})
+}
+
+// DeleteWebhook deletes a webhook of a queue, such that it's no longer notified.
+func (a *App) DeleteWebhook(queueName string, id string) error {
+	return client.WithNotificationsClient(a.Params.ApiConnectionDetails, func(c api.NotificationsClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		if _, err := c.DeleteWebhook(ctx, &api.WebhookDeleteRequest{Queue: queueName, Id: id}); err != nil {
+			return errors.WithMessagef(err, "error deleting webhook %s of queue %s", id, queueName)
+		}
+		fmt.Fprintf(a.Out, "Deleted webhook %s of queue %s\n", id, queueName)
+		return nil
+	})
+}
@@ -42,6 +42,22 @@ func (b *BinocularsServer) Logs(ctx context.Context, request *binoculars.LogRequ
 	return &binoculars.LogResponse{Log: logLines}, nil
 }
 
+func (b *BinocularsServer) SearchLogs(ctx context.Context, request *binoculars.LogSearchRequest) (*binoculars.LogSearchResponse, error) {
+	principal := authorization.GetPrincipal(ctx)
+
+	return b.logService.SearchLogs(armadacontext.FromGrpcCtx(ctx), &service.LogSearchParams{
+		Principal:    principal,
+		Namespace:    request.PodNamespace,
+		JobId:        request.JobId,
+		IncludeGang:  request.IncludeGang,
+		Container:    request.Container,
+		Pattern:      request.Pattern,
+		ContextLines: int(request.ContextLines),
+		SinceTime:    request.SinceTime,
+		UntilTime:    request.UntilTime,
+	})
+}
+
 func (b *BinocularsServer) Cordon(ctx context.Context, request *binoculars.CordonRequest) (*types.Empty, error) {
 	err := b.cordonService.CordonNode(armadacontext.FromGrpcCtx(ctx), request)
 	if err != nil {
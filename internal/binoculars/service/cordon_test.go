@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -23,6 +22,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/pkg/api/binoculars"
 )
 
@@ -81,7 +81,7 @@ func TestCordonNode(t *testing.T) {
 			cordonService, client := setupTest(t, cordonConfig, FakePermissionChecker{ReturnValue: true})
 
 			ctx := authorization.WithPrincipal(context.Background(), principal)
-			err := cordonService.CordonNode(armadacontext.New(ctx, logrus.NewEntry(logrus.New())), &binoculars.CordonRequest{
+			err := cordonService.CordonNode(armadacontext.New(ctx, logging.NullLogger), &binoculars.CordonRequest{
 				NodeName: defaultNode.Name,
 			})
 			assert.Nil(t, err)
@@ -153,6 +153,10 @@ func (c FakePermissionChecker) UserHasPermission(ctx context.Context, perm permi
 	return c.ReturnValue
 }
 
+func (c FakePermissionChecker) UserHasQueueVerb(ctx context.Context, queue string, verb string) bool {
+	return c.ReturnValue
+}
+
 type FakeClientProvider struct {
 	FakeClient *fake.Clientset
 	users      []string
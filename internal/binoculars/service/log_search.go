@@ -0,0 +1,209 @@
+package service
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	armadaconfig "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/pkg/api/binoculars"
+)
+
+// MaxSearchLines is the maximum number of lines returned by a log search.
+const MaxSearchLines = 10000
+
+type LogSearchParams struct {
+	Principal    authorization.Principal
+	Namespace    string
+	JobId        string
+	IncludeGang  bool
+	Container    string
+	Pattern      string
+	ContextLines int
+	SinceTime    string
+	UntilTime    string
+}
+
+// timedLogLine is a line of a log search result, along with the time it was logged, by which results are ordered.
+type timedLogLine struct {
+	time time.Time
+	line *binoculars.LogSearchLine
+}
+
+// SearchLogs searches the logs of all containers of all pods of a job, and optionally of all other jobs of its gang,
+// returning the matching lines and their context ordered by time.
+func (l *KubernetesLogService) SearchLogs(ctx *armadacontext.Context, params *LogSearchParams) (*binoculars.LogSearchResponse, error) {
+	pattern, err := regexp.Compile(params.Pattern)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pattern %q: %v", params.Pattern, err)
+	}
+	since, err := parseOptionalTime(params.SinceTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid since time %q: %v", params.SinceTime, err)
+	}
+	until, err := parseOptionalTime(params.UntilTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid until time %q: %v", params.UntilTime, err)
+	}
+	if params.Namespace == "" {
+		params.Namespace = "default"
+	}
+
+	client, err := l.clientProvider.ClientForUser(params.Principal.GetName(), params.Principal.GetGroupNames())
+	if err != nil {
+		return nil, err
+	}
+	pods, err := podsToSearch(ctx, client, params.Namespace, params.JobId, params.IncludeGang)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []*timedLogLine
+	for _, pod := range pods {
+		for _, container := range containersToSearch(pod, params.Container) {
+			limitBytes := int64(MaxLogBytes)
+			logOptions := &v1.PodLogOptions{Container: container, Timestamps: true, LimitBytes: &limitBytes}
+			if since != nil {
+				logOptions.SinceTime = &metav1.Time{Time: *since}
+			}
+			rawLog, err := getRawLogs(ctx, client, params.Namespace, pod.Name, logOptions)
+			if err != nil {
+				// E.g., the container hasn't started yet.
+				log.Warnf("failed to get log of container %s of pod %s: %v", container, pod.Name, err)
+				continue
+			}
+			logLines, errs := ConvertLogs(rawLog)
+			for _, err := range errs {
+				log.Errorf("failed to parse log line for namespace: %q, pod: %q: %v", params.Namespace, pod.Name, err)
+			}
+
+			podNumber, _ := strconv.Atoi(pod.Labels[domain.PodNumber])
+			for _, line := range searchLines(logLines, pattern, params.ContextLines, since, until) {
+				line.line.JobId = pod.Labels[domain.JobId]
+				line.line.PodNumber = int32(podNumber)
+				line.line.Container = container
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].time.Before(lines[j].time)
+	})
+	response := &binoculars.LogSearchResponse{}
+	if len(lines) > MaxSearchLines {
+		lines = lines[:MaxSearchLines]
+		response.Truncated = true
+	}
+	response.Lines = make([]*binoculars.LogSearchLine, len(lines))
+	for i, line := range lines {
+		response.Lines[i] = line.line
+	}
+	return response, nil
+}
+
+// podsToSearch returns the pods of the job, and, if includeGang is true, the pods of all other jobs of its gang.
+func podsToSearch(ctx *armadacontext.Context, client kubernetes.Interface, namespace string, jobId string, includeGang bool) ([]v1.Pod, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: domain.JobId + "=" + jobId})
+	if err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no pods found for job %s in namespace %s", jobId, namespace)
+	}
+	gangId := pods.Items[0].Annotations[armadaconfig.GangIdAnnotation]
+	if !includeGang || gangId == "" {
+		return pods.Items, nil
+	}
+
+	// The gang id is an annotation rather than a label, so all pods of jobs in the namespace are filtered by it.
+	pods, err = client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: domain.JobId})
+	if err != nil {
+		return nil, err
+	}
+	var gangPods []v1.Pod
+	for _, pod := range pods.Items {
+		if pod.Annotations[armadaconfig.GangIdAnnotation] == gangId {
+			gangPods = append(gangPods, pod)
+		}
+	}
+	return gangPods, nil
+}
+
+// containersToSearch returns the names of the containers of the pod whose logs are to be searched,
+// i.e., the given container if the pod has it, or else all containers if no container is given.
+func containersToSearch(pod v1.Pod, container string) []string {
+	var containers []string
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if container == "" || c.Name == container {
+			containers = append(containers, c.Name)
+		}
+	}
+	return containers
+}
+
+// searchLines returns the lines logged between since and until, if given, that match the pattern,
+// along with up to contextLines lines before and after each of them.
+func searchLines(logLines []*binoculars.LogLine, pattern *regexp.Regexp, contextLines int, since *time.Time, until *time.Time) []*timedLogLine {
+	var inRange []*timedLogLine
+	for _, logLine := range logLines {
+		// ConvertLogs already checked the timestamp is valid.
+		t, _ := time.Parse(time.RFC3339Nano, logLine.Timestamp)
+		if (since != nil && t.Before(*since)) || (until != nil && t.After(*until)) {
+			continue
+		}
+		inRange = append(inRange, &timedLogLine{
+			time: t,
+			line: &binoculars.LogSearchLine{
+				Timestamp: logLine.Timestamp,
+				Line:      logLine.Line,
+				Match:     pattern.MatchString(logLine.Line),
+			},
+		})
+	}
+
+	if contextLines < 0 {
+		contextLines = 0
+	}
+	var result []*timedLogLine
+	// Index of the first line not yet considered for inclusion in the result.
+	next := 0
+	for i, line := range inRange {
+		if !line.line.Match {
+			continue
+		}
+		first := i - contextLines
+		if first < next {
+			first = next
+		}
+		last := i + contextLines
+		if last >= len(inRange) {
+			last = len(inRange) - 1
+		}
+		result = append(result, inRange[first:last+1]...)
+		next = last + 1
+	}
+	return result
+}
+
+func parseOptionalTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
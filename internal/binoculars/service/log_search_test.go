@@ -0,0 +1,158 @@
+package service
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	armadaconfig "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/pkg/api/binoculars"
+)
+
+func TestSearchLines(t *testing.T) {
+	logLines := []*binoculars.LogLine{
+		{Timestamp: "2022-02-08T11:32:21.000000000Z", Line: "starting"},
+		{Timestamp: "2022-02-08T11:32:22.000000000Z", Line: "loading data"},
+		{Timestamp: "2022-02-08T11:32:23.000000000Z", Line: "error: out of memory"},
+		{Timestamp: "2022-02-08T11:32:24.000000000Z", Line: "retrying"},
+		{Timestamp: "2022-02-08T11:32:25.000000000Z", Line: "error: out of memory"},
+		{Timestamp: "2022-02-08T11:32:26.000000000Z", Line: "giving up"},
+		{Timestamp: "2022-02-08T11:32:27.000000000Z", Line: "cleaning up"},
+		{Timestamp: "2022-02-08T11:32:28.000000000Z", Line: "done"},
+	}
+	tests := map[string]struct {
+		pattern       string
+		contextLines  int
+		since         string
+		until         string
+		expectedLines []string
+		expectedMatch []bool
+	}{
+		"empty pattern matches all lines": {
+			pattern:       "",
+			since:         "2022-02-08T11:32:26Z",
+			expectedLines: []string{"giving up", "cleaning up", "done"},
+			expectedMatch: []bool{true, true, true},
+		},
+		"no context": {
+			pattern:       "^error",
+			expectedLines: []string{"error: out of memory", "error: out of memory"},
+			expectedMatch: []bool{true, true},
+		},
+		"overlapping context is merged": {
+			pattern:       "^error",
+			contextLines:  1,
+			expectedLines: []string{"loading data", "error: out of memory", "retrying", "error: out of memory", "giving up"},
+			expectedMatch: []bool{false, true, false, true, false},
+		},
+		"context is clipped at the edges of the log": {
+			pattern:       "starting|done",
+			contextLines:  2,
+			expectedLines: []string{"starting", "loading data", "error: out of memory", "giving up", "cleaning up", "done"},
+			expectedMatch: []bool{true, false, false, false, false, true},
+		},
+		"time range": {
+			pattern:       "error",
+			contextLines:  1,
+			since:         "2022-02-08T11:32:24Z",
+			until:         "2022-02-08T11:32:25.5Z",
+			expectedLines: []string{"retrying", "error: out of memory"},
+			expectedMatch: []bool{false, true},
+		},
+		"no matches": {
+			pattern:      "segfault",
+			contextLines: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			since, err := parseOptionalTime(tc.since)
+			require.NoError(t, err)
+			until, err := parseOptionalTime(tc.until)
+			require.NoError(t, err)
+
+			result := searchLines(logLines, regexp.MustCompile(tc.pattern), tc.contextLines, since, until)
+
+			var lines []string
+			var match []bool
+			for _, line := range result {
+				lines = append(lines, line.line.Line)
+				match = append(match, line.line.Match)
+			}
+			assert.Equal(t, tc.expectedLines, lines)
+			assert.Equal(t, tc.expectedMatch, match)
+		})
+	}
+}
+
+func TestContainersToSearch(t *testing.T) {
+	pod := v1.Pod{
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "init"}},
+			Containers:     []v1.Container{{Name: "main"}, {Name: "sidecar"}},
+		},
+	}
+	assert.Equal(t, []string{"init", "main", "sidecar"}, containersToSearch(pod, ""))
+	assert.Equal(t, []string{"sidecar"}, containersToSearch(pod, "sidecar"))
+	assert.Empty(t, containersToSearch(pod, "other"))
+}
+
+func TestPodsToSearch(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		makeJobPod("job-1", 0, "gang"),
+		makeJobPod("job-1", 1, "gang"),
+		makeJobPod("job-2", 0, "gang"),
+		makeJobPod("job-3", 0, "other-gang"),
+		makeJobPod("job-4", 0, ""),
+	)
+	ctx := armadacontext.Background()
+
+	pods, err := podsToSearch(ctx, client, "default", "job-1", false)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"armada-job-1-0", "armada-job-1-1"}, podNames(pods))
+
+	pods, err = podsToSearch(ctx, client, "default", "job-1", true)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"armada-job-1-0", "armada-job-1-1", "armada-job-2-0"}, podNames(pods))
+
+	pods, err = podsToSearch(ctx, client, "default", "job-4", true)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"armada-job-4-0"}, podNames(pods))
+
+	_, err = podsToSearch(ctx, client, "default", "job-5", true)
+	assert.Error(t, err)
+}
+
+func makeJobPod(jobId string, podNumber int, gangId string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "armada-" + jobId + "-" + strconv.Itoa(podNumber),
+			Namespace: "default",
+			Labels: map[string]string{
+				domain.JobId:     jobId,
+				domain.PodNumber: strconv.Itoa(podNumber),
+			},
+			Annotations: map[string]string{},
+		},
+	}
+	if gangId != "" {
+		pod.Annotations[armadaconfig.GangIdAnnotation] = gangId
+	}
+	return pod
+}
+
+func podNames(pods []v1.Pod) []string {
+	names := make([]string, len(pods))
+	for i, pod := range pods {
+		names[i] = pod.Name
+	}
+	return names
+}
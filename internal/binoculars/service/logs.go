@@ -8,6 +8,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
@@ -17,6 +18,7 @@ import (
 
 type LogService interface {
 	GetLogs(ctx *armadacontext.Context, params *LogParams) ([]*binoculars.LogLine, error)
+	SearchLogs(ctx *armadacontext.Context, params *LogSearchParams) (*binoculars.LogSearchResponse, error)
 }
 
 type LogParams struct {
@@ -61,16 +63,7 @@ func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogPa
 		params.Namespace = "default"
 	}
 
-	req := client.CoreV1().
-		Pods(params.Namespace).
-		GetLogs(params.PodName, params.LogOptions)
-
-	result := req.Do(ctx)
-	if result.Error() != nil {
-		return nil, result.Error()
-	}
-
-	rawLog, err := result.Raw()
+	rawLog, err := getRawLogs(ctx, client, params.Namespace, params.PodName, params.LogOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +80,24 @@ func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogPa
 	return logLines, nil
 }
 
+func getRawLogs(
+	ctx *armadacontext.Context,
+	client kubernetes.Interface,
+	namespace string,
+	podName string,
+	logOptions *v1.PodLogOptions,
+) ([]byte, error) {
+	req := client.CoreV1().
+		Pods(namespace).
+		GetLogs(podName, logOptions)
+
+	result := req.Do(ctx)
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+	return result.Raw()
+}
+
 func ConvertLogs(rawLog []byte) ([]*binoculars.LogLine, []error) {
 	lines := strings.Split(string(rawLog), "\n")
 	// If log is larger than MAX_PAYLOAD_SIZE, discard last lines until it is smaller or equal to MAX_PAYLOAD_SIZE
@@ -4,46 +4,47 @@ import (
 	"context"
 	"time"
 
-	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
-	"github.com/sirupsen/logrus"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"golang.org/x/sync/errgroup"
+
+	"github.com/armadaproject/armada/internal/common/logging"
 )
 
 // Context is an extension of Go's context which also includes a logger. This allows us to  pass round a contextual logger
 // while retaining type-safety
 type Context struct {
 	context.Context
-	logrus.FieldLogger
+	logging.Logger
 }
 
 // Background creates an empty context with a default logger.  It is analogous to context.Background()
 func Background() *Context {
 	return &Context{
-		Context:     context.Background(),
-		FieldLogger: logrus.NewEntry(logrus.New()),
+		Context: context.Background(),
+		Logger:  logging.StandardLogger(),
 	}
 }
 
 // TODO creates an empty context with a default logger.  It is analogous to context.TODO()
 func TODO() *Context {
 	return &Context{
-		Context:     context.TODO(),
-		FieldLogger: logrus.NewEntry(logrus.New()),
+		Context: context.TODO(),
+		Logger:  logging.StandardLogger(),
 	}
 }
 
-// FromGrpcCtx creates a context where the logger is extracted via ctxlogrus's Extract() method.
-// Note that this will result in a no-op logger if a logger hasn't already been inserted into the context via ctxlogrus
+// FromGrpcCtx creates a context where the logger is extracted via ctxzap's Extract() method.
+// Note that this will result in a no-op logger if a logger hasn't already been inserted into the context via ctxzap
 func FromGrpcCtx(ctx context.Context) *Context {
-	log := ctxlogrus.Extract(ctx)
+	log := logging.FromZap(ctxzap.Extract(ctx))
 	return New(ctx, log)
 }
 
 // New returns an  armada context that encapsulates both a go context and a logger
-func New(ctx context.Context, log *logrus.Entry) *Context {
+func New(ctx context.Context, log logging.Logger) *Context {
 	return &Context{
-		Context:     ctx,
-		FieldLogger: log,
+		Context: ctx,
+		Logger:  log,
 	}
 }
 
@@ -51,8 +52,8 @@ func New(ctx context.Context, log *logrus.Entry) *Context {
 func WithCancel(parent *Context) (*Context, context.CancelFunc) {
 	c, cancel := context.WithCancel(parent.Context)
 	return &Context{
-		Context:     c,
-		FieldLogger: parent.FieldLogger,
+		Context: c,
+		Logger:  parent.Logger,
 	}, cancel
 }
 
@@ -61,8 +62,8 @@ func WithCancel(parent *Context) (*Context, context.CancelFunc) {
 func WithDeadline(parent *Context, d time.Time) (*Context, context.CancelFunc) {
 	c, cancel := context.WithDeadline(parent.Context, d)
 	return &Context{
-		Context:     c,
-		FieldLogger: parent.FieldLogger,
+		Context: c,
+		Logger:  parent.Logger,
 	}, cancel
 }
 
@@ -71,19 +72,48 @@ func WithTimeout(parent *Context, timeout time.Duration) (*Context, context.Canc
 	return WithDeadline(parent, time.Now().Add(timeout))
 }
 
+// WithoutCancel returns a copy of parent that is not cancelled when parent is cancelled.
+// It is analogous to context.WithoutCancel(), which isn't available in the Go version we target.
+func WithoutCancel(parent *Context) *Context {
+	return &Context{
+		Context: withoutCancelCtx{parent: parent.Context},
+		Logger:  parent.Logger,
+	}
+}
+
+type withoutCancelCtx struct {
+	parent context.Context
+}
+
+func (withoutCancelCtx) Deadline() (deadline time.Time, ok bool) {
+	return
+}
+
+func (withoutCancelCtx) Done() <-chan struct{} {
+	return nil
+}
+
+func (withoutCancelCtx) Err() error {
+	return nil
+}
+
+func (c withoutCancelCtx) Value(key any) any {
+	return c.parent.Value(key)
+}
+
 // WithLogField returns a copy of parent with the supplied key-value added to the logger
 func WithLogField(parent *Context, key string, val interface{}) *Context {
 	return &Context{
-		Context:     parent.Context,
-		FieldLogger: parent.FieldLogger.WithField(key, val),
+		Context: parent.Context,
+		Logger:  parent.Logger.WithField(key, val),
 	}
 }
 
 // WithLogFields returns a copy of parent with the supplied key-values added to the logger
-func WithLogFields(parent *Context, fields logrus.Fields) *Context {
+func WithLogFields(parent *Context, fields logging.Fields) *Context {
 	return &Context{
-		Context:     parent.Context,
-		FieldLogger: parent.FieldLogger.WithFields(fields),
+		Context: parent.Context,
+		Logger:  parent.Logger.WithFields(fields),
 	}
 }
 
@@ -91,8 +121,8 @@ func WithLogFields(parent *Context, fields logrus.Fields) *Context {
 // val. It is analogous to context.WithValue()
 func WithValue(parent *Context, key, val any) *Context {
 	return &Context{
-		Context:     context.WithValue(parent, key, val),
-		FieldLogger: parent.FieldLogger,
+		Context: context.WithValue(parent, key, val),
+		Logger:  parent.Logger,
 	}
 }
 
@@ -101,7 +131,7 @@ func WithValue(parent *Context, key, val any) *Context {
 func ErrGroup(ctx *Context) (*errgroup.Group, *Context) {
 	group, goctx := errgroup.WithContext(ctx)
 	return group, &Context{
-		Context:     goctx,
-		FieldLogger: ctx.FieldLogger,
+		Context: goctx,
+		Logger:  ctx.Logger,
 	}
 }
@@ -5,25 +5,30 @@ import (
 	"testing"
 	"time"
 
-	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
-
-	"github.com/sirupsen/logrus"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/armadaproject/armada/internal/common/logging"
 )
 
-var defaultLogger = logrus.WithField("foo", "bar")
+var defaultLogger = logging.StandardLogger().WithField("foo", "bar")
 
 func TestNew(t *testing.T) {
 	ctx := New(context.Background(), defaultLogger)
-	require.Equal(t, defaultLogger, ctx.FieldLogger)
+	require.Equal(t, defaultLogger, ctx.Logger)
 	require.Equal(t, context.Background(), ctx.Context)
 }
 
 func TestFromGrpcContext(t *testing.T) {
-	grpcCtx := ctxlogrus.ToContext(context.Background(), defaultLogger)
+	core, logs := observer.New(zap.InfoLevel)
+	grpcCtx := ctxzap.ToContext(context.Background(), zap.New(core).With(zap.String("foo", "bar")))
 	ctx := FromGrpcCtx(grpcCtx)
 	require.Equal(t, grpcCtx, ctx.Context)
-	require.Equal(t, defaultLogger, ctx.FieldLogger)
+	ctx.Info("hello")
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, map[string]interface{}{"foo": "bar"}, logs.All()[0].ContextMap())
 }
 
 func TestBackground(t *testing.T) {
@@ -37,15 +42,25 @@ func TestTODO(t *testing.T) {
 }
 
 func TestWithLogField(t *testing.T) {
-	ctx := WithLogField(Background(), "fish", "chips")
+	parent, logs := observedContext()
+	ctx := WithLogField(parent, "fish", "chips")
 	require.Equal(t, context.Background(), ctx.Context)
-	require.Equal(t, logrus.Fields{"fish": "chips"}, ctx.FieldLogger.(*logrus.Entry).Data)
+	ctx.Info("hello")
+	require.Equal(t, map[string]interface{}{"fish": "chips"}, logs.All()[0].ContextMap())
 }
 
 func TestWithLogFields(t *testing.T) {
-	ctx := WithLogFields(Background(), logrus.Fields{"fish": "chips", "salt": "pepper"})
+	parent, logs := observedContext()
+	ctx := WithLogFields(parent, logging.Fields{"fish": "chips", "salt": "pepper"})
 	require.Equal(t, context.Background(), ctx.Context)
-	require.Equal(t, logrus.Fields{"fish": "chips", "salt": "pepper"}, ctx.FieldLogger.(*logrus.Entry).Data)
+	ctx.Info("hello")
+	require.Equal(t, map[string]interface{}{"fish": "chips", "salt": "pepper"}, logs.All()[0].ContextMap())
+}
+
+// observedContext returns a context whose logger records the messages logged.
+func observedContext() (*Context, *observer.ObservedLogs) {
+	core, logs := observer.New(zap.InfoLevel)
+	return New(context.Background(), logging.FromZap(zap.New(core))), logs
 }
 
 func TestWithTimeout(t *testing.T) {
@@ -87,3 +102,14 @@ func quiescent(t *testing.T) time.Duration {
 	const arbitraryCleanupMargin = 1 * time.Second
 	return time.Until(deadline) - arbitraryCleanupMargin
 }
+
+func TestWithoutCancel(t *testing.T) {
+	parent, cancel := WithCancel(WithValue(Background(), "foo", "bar"))
+	ctx := WithoutCancel(parent)
+	cancel()
+	require.Error(t, parent.Err())
+	require.NoError(t, ctx.Err())
+	require.Nil(t, ctx.Done())
+	require.Equal(t, "bar", ctx.Value("foo"))
+	require.Equal(t, parent.Logger, ctx.Logger)
+}
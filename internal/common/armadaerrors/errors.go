@@ -112,6 +112,37 @@ func (err *ErrInvalidArgument) Error() string {
 	return fmt.Sprintf("value %q is invalid for field %q: %s", err.Value, err.Name, err.Message)
 }
 
+// ErrLimitExceeded indicates that a request was rejected since it would exceed a configured limit,
+// e.g., on the number of jobs in a job set.
+type ErrLimitExceeded struct {
+	Limit   string // Name of the limit, e.g., "MaxJobsPerJobSet"
+	Max     int    // Maximum allowed by the limit
+	Value   int    // The value the request would have resulted in
+	Message string // An optional message to include with the error message, e.g., explaining what was counted
+}
+
+func (err *ErrLimitExceeded) Error() string {
+	if err.Message == "" {
+		return fmt.Sprintf("%d exceeds limit %s of %d", err.Value, err.Limit, err.Max)
+	}
+	return fmt.Sprintf("%d exceeds limit %s of %d: %s", err.Value, err.Limit, err.Max, err.Message)
+}
+
+// ErrQueueState indicates that a request was rejected since the state of the queue it refers to doesn't allow it,
+// e.g., submitting jobs to a paused queue.
+type ErrQueueState struct {
+	Queue   string // Name of the queue
+	State   string // State of the queue, e.g., "paused"
+	Message string // An optional message to include with the error message, e.g., explaining what isn't allowed
+}
+
+func (err *ErrQueueState) Error() string {
+	if err.Message == "" {
+		return fmt.Sprintf("queue %q is %s", err.Queue, err.State)
+	}
+	return fmt.Sprintf("queue %q is %s: %s", err.Queue, err.State, err.Message)
+}
+
 // ErrMaxRetriesExceeded is an error that indicates we have retried an operation so many times that we have given up
 // The internal error should contain the last error before giving up
 type ErrMaxRetriesExceeded struct {
@@ -277,6 +308,18 @@ func CodeFromError(err error) codes.Code {
 			return codes.InvalidArgument
 		}
 	}
+	{
+		var e *ErrLimitExceeded
+		if errors.As(err, &e) {
+			return codes.ResourceExhausted
+		}
+	}
+	{
+		var e *ErrQueueState
+		if errors.As(err, &e) {
+			return codes.FailedPrecondition
+		}
+	}
 
 	return codes.Unknown
 }
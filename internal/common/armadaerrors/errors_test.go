@@ -28,6 +28,8 @@ func TestCodeFromError(t *testing.T) {
 		"ErrAlreadyExists":                {&ErrAlreadyExists{}, codes.AlreadyExists},
 		"ErrNotFound":                     {&ErrNotFound{}, codes.NotFound},
 		"ErrInvalidArgument":              {&ErrInvalidArgument{}, codes.InvalidArgument},
+		"ErrLimitExceeded":                {&ErrLimitExceeded{}, codes.ResourceExhausted},
+		"ErrQueueState":                   {&ErrQueueState{}, codes.FailedPrecondition},
 		"pkg.Error => ErrAlreadyExists":   {errors.WithMessage(&ErrAlreadyExists{}, "foo"), codes.AlreadyExists},
 		"pkg.Error => ErrNotFound":        {errors.WithMessage(&ErrNotFound{}, "foo"), codes.NotFound},
 		"pkg.Error => ErrInvalidArgument": {errors.WithMessage(&ErrInvalidArgument{}, "foo"), codes.InvalidArgument},
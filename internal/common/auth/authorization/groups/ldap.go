@@ -7,9 +7,9 @@ import (
 	"time"
 
 	"github.com/go-ldap/ldap/v3"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/auth/configuration"
+	"github.com/armadaproject/armada/internal/common/logging"
 )
 
 type GroupLookup interface {
@@ -98,7 +98,7 @@ func (lookup *LDAPGroupLookup) saveInCache(SIDs []string, groupNames map[string]
 				name:    "",
 				created: time.Now(),
 			}
-			log.Warnf("Could not find group name for %s", sid)
+			logging.Warnf("Could not find group name for %s", sid)
 		}
 	}
 }
@@ -121,19 +121,19 @@ func (lookup *LDAPGroupLookup) getMissingGroups(SIDs []string) []string {
 func (lookup *LDAPGroupLookup) getGroupNames(SIDs []string) (map[string]string, error) {
 	l, err := ldap.DialURL(lookup.config.URL)
 	if err != nil {
-		log.Errorf("LDAP dial error: %s", err)
+		logging.Errorf("LDAP dial error: %s", err)
 		return nil, err
 	}
 	defer l.Close()
 	err = l.Bind(lookup.config.Username, lookup.config.Password)
 	if err != nil {
-		log.Errorf("LDAP bind error %s", err)
+		logging.Errorf("LDAP bind error %s", err)
 		return nil, err
 	}
 	searchRequest := lookup.createGroupSearch(SIDs)
 	sr, err := l.Search(searchRequest)
 	if err != nil {
-		log.Errorf("LDAP search error %s", err)
+		logging.Errorf("LDAP search error %s", err)
 		return nil, err
 	}
 	result := map[string]string{}
@@ -12,7 +12,6 @@ import (
 	"github.com/jcmturner/gokrb5/v8/service"
 	"github.com/jcmturner/gokrb5/v8/spnego"
 	"github.com/jcmturner/gokrb5/v8/types"
-	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -20,6 +19,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/auth/authorization/groups"
 	"github.com/armadaproject/armada/internal/common/auth/configuration"
+	"github.com/armadaproject/armada/internal/common/logging"
 )
 
 // Partly reimplementing github.com/jcmturner/gokrb5/v8/spnego/http.go for GRPC
@@ -91,7 +91,7 @@ func (authService *KerberosAuthService) Authenticate(ctx context.Context) (Princ
 
 	tokenData, err := base64.StdEncoding.DecodeString(encodedToken)
 	if err != nil {
-		log.Errorf("SPNEGO invalid token, could not decode: %v", err)
+		logging.Errorf("SPNEGO invalid token, could not decode: %v", err)
 		return nil, &armadaerrors.ErrInvalidCredentials{
 			Message:     "SPNEGO invalid token, could not decode",
 			AuthService: authService.Name(),
@@ -101,7 +101,7 @@ func (authService *KerberosAuthService) Authenticate(ctx context.Context) (Princ
 	var token spnego.SPNEGOToken
 	err = token.Unmarshal(tokenData)
 	if err != nil {
-		log.Errorf("SPNEGO invalid token, could not unmarshal : %v", err)
+		logging.Errorf("SPNEGO invalid token, could not unmarshal : %v", err)
 		return nil, &armadaerrors.ErrInvalidCredentials{
 			Message:     "SPNEGO invalid token, could not unmarshal",
 			AuthService: authService.Name(),
@@ -120,7 +120,7 @@ func (authService *KerberosAuthService) Authenticate(ctx context.Context) (Princ
 
 	authenticated, credentialsContext, st := svc.AcceptSecContext(&token)
 	if st.Code != gssapi.StatusComplete && st.Code != gssapi.StatusContinueNeeded {
-		log.Errorf("SPNEGO validation error: %v", st)
+		logging.Errorf("SPNEGO validation error: %v", st)
 		return nil, &armadaerrors.ErrInvalidCredentials{
 			Message:     fmt.Sprintf("SPNEGO validation error: %v", st),
 			AuthService: authService.Name(),
@@ -128,7 +128,7 @@ func (authService *KerberosAuthService) Authenticate(ctx context.Context) (Princ
 	}
 	if st.Code == gssapi.StatusContinueNeeded {
 		_ = grpc.SetHeader(ctx, metadata.Pairs(spnego.HTTPHeaderAuthResponse, spnegoNegTokenRespIncompleteKRB5))
-		log.Error("SPNEGO GSS-API continue needed")
+		logging.Error("SPNEGO GSS-API continue needed")
 		return nil, &armadaerrors.ErrInvalidCredentials{
 			Message:     "SPNEGO GSS-API continue needed",
 			AuthService: authService.Name(),
@@ -156,14 +156,14 @@ func (authService *KerberosAuthService) Authenticate(ctx context.Context) (Princ
 			// removing the header as workaround before moving away from kerberos
 			return NewStaticPrincipal(user, userGroups), nil
 		}
-		log.Error("Failed to read ad credentials")
+		logging.Error("Failed to read ad credentials")
 		return nil, &armadaerrors.ErrInvalidCredentials{
 			Message:     "Failed to read ad credentials",
 			AuthService: authService.Name(),
 		}
 
 	} else {
-		log.Error("SPNEGO Kerberos authentication failed")
+		logging.Error("SPNEGO Kerberos authentication failed")
 		_ = grpc.SetHeader(ctx, metadata.Pairs(spnego.HTTPHeaderAuthResponse, spnegoNegTokenRespReject))
 		return nil, &armadaerrors.ErrInvalidCredentials{
 			AuthService: authService.Name(),
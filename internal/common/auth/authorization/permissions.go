@@ -3,6 +3,7 @@ package authorization
 import (
 	"context"
 
+	"github.com/armadaproject/armada/internal/common/auth/configuration"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
 )
 
@@ -14,12 +15,15 @@ type Owned interface {
 type PermissionChecker interface {
 	UserHasPermission(ctx context.Context, perm permission.Permission) bool
 	UserOwns(ctx context.Context, obj Owned) (owned bool, ownershipGroups []string)
+	// UserHasQueueVerb returns true if a role bound to the principal on the named queue grants verb.
+	UserHasQueueVerb(ctx context.Context, queue string, verb string) bool
 }
 
 type PrincipalPermissionChecker struct {
 	permissionGroupMap map[permission.Permission][]string
 	permissionScopeMap map[permission.Permission][]string
 	permissionClaimMap map[permission.Permission][]string
+	queueRoleBindings  *QueueRoleBindings
 }
 
 func NewPrincipalPermissionChecker(
@@ -34,6 +38,21 @@ func NewPrincipalPermissionChecker(
 	}
 }
 
+// NewPrincipalPermissionCheckerFromConfig returns a checker of the permissions and queue role bindings in config.
+func NewPrincipalPermissionCheckerFromConfig(config configuration.AuthConfig) (*PrincipalPermissionChecker, error) {
+	queueRoleBindings, err := NewQueueRoleBindings(config.QueueRoleBindings)
+	if err != nil {
+		return nil, err
+	}
+	checker := NewPrincipalPermissionChecker(
+		config.PermissionGroupMapping,
+		config.PermissionScopeMapping,
+		config.PermissionClaimMapping,
+	)
+	checker.queueRoleBindings = queueRoleBindings
+	return checker, nil
+}
+
 // UserHasPermission returns true if the principal contained in the context has the given permission,
 // which is determined by checking if any of the groups, scopes, or claims associated with the principal
 // has that permission.
@@ -70,6 +89,16 @@ func (checker *PrincipalPermissionChecker) UserOwns(ctx context.Context, obj Own
 	return len(ownershipGroups) > 0, ownershipGroups
 }
 
+func (checker *PrincipalPermissionChecker) UserHasQueueVerb(ctx context.Context, queue string, verb string) bool {
+	return checker.queueRoleBindings.HasVerb(GetPrincipal(ctx), queue, verb)
+}
+
+// QueuesWithVerb returns the queues on which a role bound to the principal contained in the context grants verb,
+// sorted by name, or true if it's granted on every queue.
+func (checker *PrincipalPermissionChecker) QueuesWithVerb(ctx context.Context, verb string) ([]string, bool) {
+	return checker.queueRoleBindings.QueuesWithVerb(GetPrincipal(ctx), verb)
+}
+
 func hasPermission(perm permission.Permission, permMap map[permission.Permission][]string, assert func(string) bool) bool {
 	allowedValues, ok := permMap[perm]
 	if !ok {
@@ -0,0 +1,112 @@
+package authorization
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/common/auth/configuration"
+)
+
+// QueueRole is a named set of verbs that may be granted on queues by a configuration.QueueRoleBinding.
+type QueueRole string
+
+const (
+	// QueueRoleViewer may watch the jobs of a queue and view its scheduling reports.
+	QueueRoleViewer QueueRole = "viewer"
+	// QueueRoleSubmitter may additionally submit, cancel, and reprioritise jobs.
+	QueueRoleSubmitter QueueRole = "submitter"
+	// QueueRoleAdmin may additionally delete the queue and update its fields not affecting fairness,
+	// i.e., not its priority factor, resource limits, or permissions.
+	QueueRoleAdmin QueueRole = "admin"
+)
+
+// Verbs granted by queue roles. Except for QueueVerbAdminister, these are the verbs of queue permissions.
+const (
+	QueueVerbSubmit       = "submit"
+	QueueVerbCancel       = "cancel"
+	QueueVerbReprioritize = "reprioritize"
+	QueueVerbWatch        = "watch"
+	// QueueVerbAdminister is the verb to update and delete a queue.
+	QueueVerbAdminister = "administer"
+)
+
+// allQueues is the queue name matching every queue in a role binding.
+const allQueues = "*"
+
+var queueRoleVerbs = map[QueueRole][]string{
+	QueueRoleViewer:    {QueueVerbWatch},
+	QueueRoleSubmitter: {QueueVerbWatch, QueueVerbSubmit, QueueVerbCancel, QueueVerbReprioritize},
+	QueueRoleAdmin:     {QueueVerbWatch, QueueVerbSubmit, QueueVerbCancel, QueueVerbReprioritize, QueueVerbAdminister},
+}
+
+// QueueRoleBindings resolves the verbs a principal may perform on each queue from the roles bound to it.
+type QueueRoleBindings struct {
+	bindings []configuration.QueueRoleBinding
+}
+
+// NewQueueRoleBindings returns an error if any of the bindings are for an unknown role.
+func NewQueueRoleBindings(bindings []configuration.QueueRoleBinding) (*QueueRoleBindings, error) {
+	for i, binding := range bindings {
+		if _, ok := queueRoleVerbs[QueueRole(binding.Role)]; !ok {
+			return nil, fmt.Errorf("queue role binding %d has unknown role %q; must be one of viewer, submitter, or admin", i, binding.Role)
+		}
+	}
+	return &QueueRoleBindings{bindings: bindings}, nil
+}
+
+// HasVerb returns true if a role bound to principal on queue grants verb.
+func (r *QueueRoleBindings) HasVerb(principal Principal, queue string, verb string) bool {
+	if r == nil {
+		return false
+	}
+	for _, binding := range r.bindings {
+		if r.grants(binding, principal, verb) && (slices.Contains(binding.Queues, queue) || slices.Contains(binding.Queues, allQueues)) {
+			return true
+		}
+	}
+	return false
+}
+
+// QueuesWithVerb returns the queues on which a role bound to principal grants verb, sorted by name,
+// or true if it's granted on every queue.
+func (r *QueueRoleBindings) QueuesWithVerb(principal Principal, verb string) ([]string, bool) {
+	if r == nil {
+		return nil, false
+	}
+	queues := make(map[string]bool)
+	for _, binding := range r.bindings {
+		if !r.grants(binding, principal, verb) {
+			continue
+		}
+		for _, queue := range binding.Queues {
+			if queue == allQueues {
+				return nil, true
+			}
+			queues[queue] = true
+		}
+	}
+	result := make([]string, 0, len(queues))
+	for queue := range queues {
+		result = append(result, queue)
+	}
+	sort.Strings(result)
+	return result, false
+}
+
+// grants returns true if binding binds a role granting verb to principal.
+func (r *QueueRoleBindings) grants(binding configuration.QueueRoleBinding, principal Principal, verb string) bool {
+	if !slices.Contains(queueRoleVerbs[QueueRole(binding.Role)], verb) {
+		return false
+	}
+	if slices.Contains(binding.Users, principal.GetName()) {
+		return true
+	}
+	for _, group := range binding.Groups {
+		if principal.IsInGroup(group) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/auth/configuration"
+)
+
+func TestQueueRoleBindings(t *testing.T) {
+	bindings, err := NewQueueRoleBindings([]configuration.QueueRoleBinding{
+		{Role: "viewer", Queues: []string{"*"}, Groups: []string{"staff"}},
+		{Role: "submitter", Queues: []string{"queue-b", "queue-a"}, Groups: []string{"team"}},
+		{Role: "admin", Queues: []string{"queue-a"}, Users: []string{"alice"}},
+	})
+	require.NoError(t, err)
+
+	alice := NewStaticPrincipal("alice", []string{"staff"})
+	bob := NewStaticPrincipal("bob", []string{"staff", "team"})
+	carol := NewStaticPrincipal("carol", nil)
+
+	assert.True(t, bindings.HasVerb(alice, "queue-c", QueueVerbWatch))
+	assert.False(t, bindings.HasVerb(alice, "queue-c", QueueVerbSubmit))
+	assert.True(t, bindings.HasVerb(alice, "queue-a", QueueVerbAdminister))
+	assert.True(t, bindings.HasVerb(bob, "queue-b", QueueVerbCancel))
+	assert.False(t, bindings.HasVerb(bob, "queue-b", QueueVerbAdminister))
+	assert.False(t, bindings.HasVerb(carol, "queue-a", QueueVerbWatch))
+
+	queues, all := bindings.QueuesWithVerb(bob, QueueVerbSubmit)
+	assert.False(t, all)
+	assert.Equal(t, []string{"queue-a", "queue-b"}, queues)
+	_, all = bindings.QueuesWithVerb(bob, QueueVerbWatch)
+	assert.True(t, all)
+	queues, all = bindings.QueuesWithVerb(carol, QueueVerbWatch)
+	assert.False(t, all)
+	assert.Empty(t, queues)
+}
+
+func TestQueueRoleBindings_UnknownRole(t *testing.T) {
+	_, err := NewQueueRoleBindings([]configuration.QueueRoleBinding{{Role: "owner", Queues: []string{"queue-a"}}})
+	assert.Error(t, err)
+}
+
+func TestPrincipalPermissionChecker_UserHasQueueVerb(t *testing.T) {
+	checker, err := NewPrincipalPermissionCheckerFromConfig(configuration.AuthConfig{
+		QueueRoleBindings: []configuration.QueueRoleBinding{
+			{Role: "submitter", Queues: []string{"queue-a"}, Groups: []string{submitterGroup}},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, checker.UserHasQueueVerb(WithPrincipal(ctx, submitter), "queue-a", QueueVerbSubmit))
+	assert.False(t, checker.UserHasQueueVerb(WithPrincipal(ctx, submitter), "queue-b", QueueVerbSubmit))
+	assert.False(t, checker.UserHasQueueVerb(WithPrincipal(ctx, otherUser), "queue-a", QueueVerbSubmit))
+
+	// Checkers without role bindings grant no verbs.
+	assert.False(t, NewPrincipalPermissionChecker(nil, nil, nil).UserHasQueueVerb(WithPrincipal(ctx, submitter), "queue-a", QueueVerbSubmit))
+}
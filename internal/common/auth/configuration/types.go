@@ -17,6 +17,21 @@ type AuthConfig struct {
 	PermissionGroupMapping map[permission.Permission][]string
 	PermissionScopeMapping map[permission.Permission][]string
 	PermissionClaimMapping map[permission.Permission][]string
+
+	// Roles granted to users and groups on specific queues,
+	// in addition to the permissions configured on each queue and the global permissions above.
+	QueueRoleBindings []QueueRoleBinding
+}
+
+// QueueRoleBinding grants a role on some queues to users and to the members of groups,
+// e.g., the groups read from the groups claim of OpenID tokens.
+type QueueRoleBinding struct {
+	// Role granted; one of viewer, submitter, or admin.
+	Role string
+	// Names of the queues the role is granted on; "*" matches every queue.
+	Queues []string
+	Users  []string
+	Groups []string
 }
 
 type UserInfo struct {
@@ -6,9 +6,8 @@ import (
 	"sync"
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
 )
 
 type CachedCertificateService struct {
@@ -62,7 +61,7 @@ func (c *CachedCertificateService) Run(ctx *armadacontext.Context) {
 		case <-ticker.C:
 			err := c.refresh()
 			if err != nil {
-				log.WithError(err).Errorf("failed refreshing certificate from files cert: %s key: %s", c.certPath, c.keyPath)
+				logging.WithError(err).Errorf("failed refreshing certificate from files cert: %s key: %s", c.certPath, c.keyPath)
 			}
 		}
 	}
@@ -90,7 +89,7 @@ func (c *CachedCertificateService) refresh() error {
 	}
 
 	if modified {
-		log.Infof("refreshing certificate from files cert: %s key: %s", c.certPath, c.keyPath)
+		logging.Infof("refreshing certificate from files cert: %s key: %s", c.certPath, c.keyPath)
 		certFileData, err := os.ReadFile(c.certPath)
 		if err != nil {
 			return err
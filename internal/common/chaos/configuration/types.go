@@ -0,0 +1,24 @@
+package configuration
+
+import "time"
+
+// FaultInjectionConfig configures the faults injected into a component to exercise its resilience to failures,
+// e.g., in end-to-end tests. Fault injection must never be enabled in production.
+// Probabilities are in [0, 1], and each is applied independently to each operation.
+type FaultInjectionConfig struct {
+	// If false, no faults are injected and all other settings are ignored.
+	Enabled bool
+	// Seed of the random number generator deciding when to inject faults, such that test runs are reproducible.
+	// If zero, a seed is chosen at random.
+	Seed int64
+	// Probability of a message published to Pulsar being dropped, i.e., of publishing failing without sending the message.
+	PulsarDropProbability float64
+	// Probability of a message published to or received from Pulsar being delayed by up to PulsarMaxDelay.
+	PulsarDelayProbability float64
+	PulsarMaxDelay         time.Duration
+	// Probability of a Redis command, or pipeline of commands, failing with a transient error without being sent.
+	RedisErrorProbability float64
+	// Probability of the component exiting on reaching each named crash point.
+	// Crash points not listed here are never triggered.
+	CrashProbabilityByPoint map[string]float64
+}
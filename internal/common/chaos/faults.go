@@ -0,0 +1,206 @@
+// Package chaos injects faults into Armada components, e.g., dropped Pulsar messages, transient Redis errors, and crashes,
+// such that the paths handling failures are exercised in end-to-end tests.
+package chaos
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/chaos/configuration"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/logging"
+)
+
+// ErrInjected is the error returned by operations failed by fault injection.
+var ErrInjected = errors.New("injected fault")
+
+// Crash points, i.e., the points at which components may be crashed.
+const (
+	// Reached by SubmitFromLog after applying events to Redis but before recording that they've been applied,
+	// such that the events are applied again once the message is re-delivered.
+	CrashPointSubmitFromLogBeforeRecordingProgress = "submitFromLog.beforeRecordingProgress"
+	// Reached by SubmitFromLog after processing a message but before acking it.
+	CrashPointSubmitFromLogBeforeAck = "submitFromLog.beforeAck"
+)
+
+// FaultInjector decides when to inject faults according to a FaultInjectionConfig.
+// All methods may be called on a nil FaultInjector, in which case no faults are injected,
+// such that components can call these unconditionally.
+type FaultInjector struct {
+	config configuration.FaultInjectionConfig
+	// Protects random, which isn't safe for concurrent use.
+	mu     sync.Mutex
+	random *rand.Rand
+	// Called on reaching a crash point selected for crashing.
+	onCrash func(point string)
+	// Client with which Redis commands selected to fail are processed, such that the error is set on the command.
+	failingRedisClient *redis.Client
+}
+
+// NewFaultInjector returns a FaultInjector configured by config, or nil if fault injection isn't enabled.
+func NewFaultInjector(config configuration.FaultInjectionConfig) *FaultInjector {
+	if !config.Enabled {
+		return nil
+	}
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	logging.Warnf("fault injection is enabled with seed %d; this should never be the case in production", seed)
+	return &FaultInjector{
+		config: config,
+		random: rand.New(rand.NewSource(seed)),
+		onCrash: func(point string) {
+			logging.Errorf("crashing at crash point %s due to fault injection", point)
+			os.Exit(1)
+		},
+		failingRedisClient: redis.NewClient(&redis.Options{
+			Dialer: func() (net.Conn, error) {
+				// Fail as if Redis were unreachable, such that callers treat the error as transient.
+				return nil, errors.WithStack(&net.OpError{Op: "dial", Net: "tcp", Err: ErrInjected})
+			},
+		}),
+	}
+}
+
+// SetCrashHandler replaces the function called on crashing, which by default exits the process.
+// Intended for tests, which may, e.g., cancel the context of the crashed component instead.
+func (f *FaultInjector) SetCrashHandler(onCrash func(point string)) {
+	if f == nil {
+		return
+	}
+	f.onCrash = onCrash
+}
+
+// Crash crashes the component with the probability configured for the provided crash point.
+func (f *FaultInjector) Crash(point string) {
+	if f == nil {
+		return
+	}
+	if f.sample(f.config.CrashProbabilityByPoint[point]) {
+		f.onCrash(point)
+	}
+}
+
+// sample returns true with probability p.
+func (f *FaultInjector) sample(p float64) bool {
+	if f == nil || p <= 0 {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.random.Float64() < p
+}
+
+// maybeDelayPulsar sleeps for up to PulsarMaxDelay with probability PulsarDelayProbability.
+func (f *FaultInjector) maybeDelayPulsar() {
+	if f.config.PulsarMaxDelay <= 0 || !f.sample(f.config.PulsarDelayProbability) {
+		return
+	}
+	f.mu.Lock()
+	delay := time.Duration(f.random.Int63n(int64(f.config.PulsarMaxDelay) + 1))
+	f.mu.Unlock()
+	time.Sleep(delay)
+}
+
+// WrapProducer returns a producer that drops and delays messages published with producer.
+func (f *FaultInjector) WrapProducer(producer pulsar.Producer) pulsar.Producer {
+	if f == nil {
+		return producer
+	}
+	return &faultyProducer{Producer: producer, faults: f}
+}
+
+type faultyProducer struct {
+	pulsar.Producer
+	faults *FaultInjector
+}
+
+func (p *faultyProducer) Send(ctx context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+	p.faults.maybeDelayPulsar()
+	if p.faults.sample(p.faults.config.PulsarDropProbability) {
+		return nil, errors.WithStack(ErrInjected)
+	}
+	return p.Producer.Send(ctx, msg)
+}
+
+func (p *faultyProducer) SendAsync(ctx context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+	p.faults.maybeDelayPulsar()
+	if p.faults.sample(p.faults.config.PulsarDropProbability) {
+		callback(nil, msg, errors.WithStack(ErrInjected))
+		return
+	}
+	p.Producer.SendAsync(ctx, msg, callback)
+}
+
+// WrapConsumer returns a consumer that delays messages received from consumer.
+// Messages are never dropped, since the consumer relies on each message received being processed before later messages
+// are acked. The returned consumer supports cumulative acks if consumer does.
+func (f *FaultInjector) WrapConsumer(consumer eventlog.Consumer) eventlog.Consumer {
+	if f == nil {
+		return consumer
+	}
+	faulty := &faultyConsumer{Consumer: consumer, faults: f}
+	if acker, ok := consumer.(eventlog.CumulativeAcker); ok {
+		return &faultyCumulativeConsumer{faultyConsumer: faulty, CumulativeAcker: acker}
+	}
+	return faulty
+}
+
+type faultyConsumer struct {
+	eventlog.Consumer
+	faults *FaultInjector
+}
+
+func (c *faultyConsumer) Receive(ctx context.Context) (eventlog.Message, error) {
+	msg, err := c.Consumer.Receive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.faults.maybeDelayPulsar()
+	return msg, nil
+}
+
+type faultyCumulativeConsumer struct {
+	*faultyConsumer
+	eventlog.CumulativeAcker
+}
+
+// WrapRedisClient makes commands, and pipelines of commands, processed by client fail with a transient error
+// with probability RedisErrorProbability. Failed commands aren't sent to Redis. Returns client.
+func (f *FaultInjector) WrapRedisClient(client redis.UniversalClient) redis.UniversalClient {
+	if f == nil || f.config.RedisErrorProbability <= 0 {
+		return client
+	}
+	client.WrapProcess(func(process func(cmd redis.Cmder) error) func(cmd redis.Cmder) error {
+		return func(cmd redis.Cmder) error {
+			if f.sample(f.config.RedisErrorProbability) {
+				// Errors can only be set on commands by processing them; the failing client fails to connect.
+				return f.failingRedisClient.Process(cmd)
+			}
+			return process(cmd)
+		}
+	})
+	client.WrapProcessPipeline(func(process func(cmds []redis.Cmder) error) func(cmds []redis.Cmder) error {
+		return func(cmds []redis.Cmder) error {
+			if f.sample(f.config.RedisErrorProbability) {
+				pipe := f.failingRedisClient.Pipeline()
+				for _, cmd := range cmds {
+					_ = pipe.Process(cmd)
+				}
+				_, err := pipe.Exec()
+				return err
+			}
+			return process(cmds)
+		}
+	})
+	return client
+}
@@ -0,0 +1,168 @@
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/chaos/configuration"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+)
+
+func TestFaultInjector_Disabled(t *testing.T) {
+	f := NewFaultInjector(configuration.FaultInjectionConfig{
+		Enabled:                 false,
+		PulsarDropProbability:   1,
+		RedisErrorProbability:   1,
+		CrashProbabilityByPoint: map[string]float64{"point": 1},
+	})
+	require.Nil(t, f)
+
+	// All methods are no-ops on a nil injector.
+	f.SetCrashHandler(func(string) { t.Fatal("unexpected crash") })
+	f.Crash("point")
+	producer := &recordingProducer{}
+	assert.Same(t, producer, f.WrapProducer(producer))
+	consumer := &eventlog.PulsarConsumer{}
+	assert.Same(t, consumer, f.WrapConsumer(consumer))
+}
+
+func TestFaultInjector_Crash(t *testing.T) {
+	f := NewFaultInjector(configuration.FaultInjectionConfig{
+		Enabled:                 true,
+		Seed:                    1,
+		CrashProbabilityByPoint: map[string]float64{"always": 1, "never": 0},
+	})
+	var crashed []string
+	f.SetCrashHandler(func(point string) { crashed = append(crashed, point) })
+
+	f.Crash("always")
+	f.Crash("never")
+	f.Crash("unknown")
+	assert.Equal(t, []string{"always"}, crashed)
+}
+
+func TestFaultInjector_IsReproducible(t *testing.T) {
+	config := configuration.FaultInjectionConfig{
+		Enabled:                 true,
+		Seed:                    42,
+		CrashProbabilityByPoint: map[string]float64{"point": 0.5},
+	}
+	crashes := func() []int {
+		f := NewFaultInjector(config)
+		var rv []int
+		for i := 0; i < 100; i++ {
+			f.SetCrashHandler(func(string) { rv = append(rv, i) })
+			f.Crash("point")
+		}
+		return rv
+	}
+	first := crashes()
+	assert.NotEmpty(t, first)
+	assert.Less(t, len(first), 100)
+	assert.Equal(t, first, crashes())
+}
+
+func TestFaultInjector_WrapProducer(t *testing.T) {
+	ctx := context.Background()
+	msg := &pulsar.ProducerMessage{Payload: []byte("payload")}
+
+	dropAll := NewFaultInjector(configuration.FaultInjectionConfig{Enabled: true, Seed: 1, PulsarDropProbability: 1})
+	producer := &recordingProducer{}
+	_, err := dropAll.WrapProducer(producer).Send(ctx, msg)
+	assert.ErrorIs(t, err, ErrInjected)
+	var asyncErr error
+	dropAll.WrapProducer(producer).SendAsync(ctx, msg, func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+		asyncErr = err
+	})
+	assert.ErrorIs(t, asyncErr, ErrInjected)
+	assert.Empty(t, producer.sent)
+
+	delayAll := NewFaultInjector(configuration.FaultInjectionConfig{
+		Enabled:                true,
+		Seed:                   1,
+		PulsarDelayProbability: 1,
+		PulsarMaxDelay:         time.Millisecond,
+	})
+	_, err = delayAll.WrapProducer(producer).Send(ctx, msg)
+	require.NoError(t, err)
+	assert.Equal(t, []*pulsar.ProducerMessage{msg}, producer.sent)
+}
+
+func TestFaultInjector_WrapConsumerPreservesCumulativeAcks(t *testing.T) {
+	f := NewFaultInjector(configuration.FaultInjectionConfig{Enabled: true, Seed: 1})
+	_, ok := f.WrapConsumer(&eventlog.PulsarConsumer{}).(eventlog.CumulativeAcker)
+	assert.True(t, ok)
+	_, ok = f.WrapConsumer(nonCumulativeConsumer{}).(eventlog.CumulativeAcker)
+	assert.False(t, ok)
+}
+
+func TestFaultInjector_WrapRedisClient(t *testing.T) {
+	server, err := miniredis.Run()
+	require.NoError(t, err)
+	defer server.Close()
+
+	for name, tc := range map[string]struct {
+		probability float64
+		expectError bool
+	}{
+		"never":  {probability: 0, expectError: false},
+		"always": {probability: 1, expectError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server.FlushAll()
+			f := NewFaultInjector(configuration.FaultInjectionConfig{Enabled: true, Seed: 1, RedisErrorProbability: tc.probability})
+			client := f.WrapRedisClient(redis.NewUniversalClient(&redis.UniversalOptions{Addrs: []string{server.Addr()}}))
+			defer client.Close()
+
+			err := client.Set("key", "value", 0).Err()
+			_, pipelineErr := client.Pipelined(func(pipe redis.Pipeliner) error {
+				pipe.Set("key", "value", 0)
+				return nil
+			})
+			if tc.expectError {
+				assert.ErrorIs(t, err, ErrInjected)
+				assert.ErrorIs(t, pipelineErr, ErrInjected)
+				assert.True(t, armadaerrors.IsNetworkError(err))
+				assert.False(t, server.Exists("key"))
+			} else {
+				assert.NoError(t, err)
+				assert.NoError(t, pipelineErr)
+				assert.True(t, server.Exists("key"))
+			}
+		})
+	}
+}
+
+type recordingProducer struct {
+	pulsar.Producer
+	sent []*pulsar.ProducerMessage
+}
+
+func (p *recordingProducer) Send(_ context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+	p.sent = append(p.sent, msg)
+	return nil, nil
+}
+
+func (p *recordingProducer) SendAsync(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+	p.sent = append(p.sent, msg)
+	callback(nil, msg, nil)
+}
+
+type nonCumulativeConsumer struct{}
+
+func (nonCumulativeConsumer) Receive(context.Context) (eventlog.Message, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (nonCumulativeConsumer) Ack(eventlog.Message) error {
+	return nil
+}
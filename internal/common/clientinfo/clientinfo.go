@@ -0,0 +1,137 @@
+package clientinfo
+
+import (
+	"context"
+	"regexp"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Clients identify themselves by embedding these keys in gRPC metadata.
+// Clients using the REST API may set the same values via the headers Grpc-Metadata-Armada-Client-Name etc.
+const (
+	// NameMetadataKey identifies the client library or tool, e.g., "armadactl" or "armada-go-client".
+	NameMetadataKey = "armada-client-name"
+	// VersionMetadataKey is the version of the client library or tool.
+	VersionMetadataKey = "armada-client-version"
+	// SourceMetadataKey identifies the system on behalf of which requests are made, e.g., a workflow engine.
+	SourceMetadataKey = "armada-submission-source"
+)
+
+const (
+	// DefaultName is used by pkg/client if no name is configured.
+	DefaultName = "armada-go-client"
+	// Unknown is reported in place of values not provided by the client.
+	Unknown = "unknown"
+	// Other is reported in place of values that are too long or contain characters other than those in validValue.
+	Other = "other"
+	// MaxValueLength is the maximum length of values provided by clients.
+	MaxValueLength   = 64
+	armadaModulePath = "github.com/armadaproject/armada"
+)
+
+// validValue matches the values clients may provide, e.g., "armadactl", "v0.3.100" or "airflow/my-dag".
+var validValue = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._+/-]*$`)
+
+// ClientInfo describes the client a request originates from.
+// Fields not provided by the client are the empty string.
+type ClientInfo struct {
+	Name    string
+	Version string
+	Source  string
+}
+
+// FromContext returns the client info embedded in the gRPC metadata of an incoming request.
+// Since these values are chosen by the client, values that are too long or contain unexpected characters are replaced
+// with Other, such that they can be used as metric labels and annotations.
+func FromContext(ctx context.Context) ClientInfo {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ClientInfo{}
+	}
+	return ClientInfo{
+		Name:    sanitise(firstValue(md, NameMetadataKey)),
+		Version: sanitise(firstValue(md, VersionMetadataKey)),
+		Source:  sanitise(firstValue(md, SourceMetadataKey)),
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func sanitise(value string) string {
+	if value == "" {
+		return ""
+	}
+	if len(value) > MaxValueLength || !validValue.MatchString(value) {
+		return Other
+	}
+	return value
+}
+
+// AppendToOutgoingContext returns a new context derived from ctx with the non-empty fields of info
+// added to the gRPC metadata of outgoing requests.
+func AppendToOutgoingContext(ctx context.Context, info ClientInfo) context.Context {
+	kv := make([]string, 0, 6)
+	if info.Name != "" {
+		kv = append(kv, NameMetadataKey, info.Name)
+	}
+	if info.Version != "" {
+		kv = append(kv, VersionMetadataKey, info.Version)
+	}
+	if info.Source != "" {
+		kv = append(kv, SourceMetadataKey, info.Source)
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// UnaryClientInterceptor returns an interceptor that embeds info in the metadata of outgoing gRPC requests.
+func UnaryClientInterceptor(info ClientInfo) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(AppendToOutgoingContext(ctx, info), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor returns an interceptor that embeds info in the metadata of outgoing gRPC streams.
+func StreamClientInterceptor(info ClientInfo) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(AppendToOutgoingContext(ctx, info), desc, cc, method, opts...)
+	}
+}
+
+// ModuleVersion returns the version of the Armada Go module compiled into the running binary,
+// or the empty string if it can't be determined, e.g., for development builds.
+func ModuleVersion() string {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	if buildInfo.Main.Path == armadaModulePath {
+		return moduleVersion(&buildInfo.Main)
+	}
+	for _, dep := range buildInfo.Deps {
+		if dep.Path == armadaModulePath {
+			if dep.Replace != nil {
+				return moduleVersion(dep.Replace)
+			}
+			return moduleVersion(dep)
+		}
+	}
+	return ""
+}
+
+func moduleVersion(module *debug.Module) string {
+	if module.Version == "(devel)" {
+		return ""
+	}
+	return module.Version
+}
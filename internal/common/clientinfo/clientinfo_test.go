@@ -0,0 +1,70 @@
+package clientinfo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	info := ClientInfo{Name: "armadactl", Version: "v0.3.100", Source: "airflow"}
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	err := UnaryClientInterceptor(info)(context.Background(), "method", nil, nil, nil, invoker)
+	assert.NoError(t, err)
+
+	// The metadata sent by the client is what the server receives.
+	ctx := metadata.NewIncomingContext(context.Background(), outgoing)
+	assert.Equal(t, info, FromContext(ctx))
+}
+
+func TestAppendToOutgoingContext_OmitsEmptyValues(t *testing.T) {
+	ctx := AppendToOutgoingContext(context.Background(), ClientInfo{Name: "armadactl"})
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, metadata.Pairs(NameMetadataKey, "armadactl"), md)
+
+	ctx = AppendToOutgoingContext(context.Background(), ClientInfo{})
+	_, ok = metadata.FromOutgoingContext(ctx)
+	assert.False(t, ok)
+}
+
+func TestFromContext_NoMetadata(t *testing.T) {
+	assert.Equal(t, ClientInfo{}, FromContext(context.Background()))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(VersionMetadataKey, "v1"))
+	assert.Equal(t, ClientInfo{Version: "v1"}, FromContext(ctx))
+}
+
+func TestFromContext_ReplacesUnexpectedValues(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected string
+	}{
+		"name":                      {value: "armada-go-client", expected: "armada-go-client"},
+		"version":                   {value: "v0.3.100+dirty", expected: "v0.3.100+dirty"},
+		"path":                      {value: "airflow/my-dag", expected: "airflow/my-dag"},
+		"longest allowed value":     {value: strings.Repeat("a", MaxValueLength), expected: strings.Repeat("a", MaxValueLength)},
+		"oversized value":           {value: strings.Repeat("a", MaxValueLength+1), expected: Other},
+		"whitespace":                {value: "my client", expected: Other},
+		"control characters":        {value: "client\n", expected: Other},
+		"non-ascii characters":      {value: "clïent", expected: Other},
+		"leading special character": {value: "-client", expected: Other},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				NameMetadataKey, tc.value,
+				VersionMetadataKey, tc.value,
+				SourceMetadataKey, tc.value,
+			))
+			assert.Equal(t, ClientInfo{Name: tc.expected, Version: tc.expected, Source: tc.expected}, FromContext(ctx))
+		})
+	}
+}
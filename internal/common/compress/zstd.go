@@ -0,0 +1,199 @@
+package compress
+
+import (
+	"bytes"
+	"hash/fnv"
+	"os"
+	"sort"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+const (
+	ZlibAlgorithm = "zlib"
+	ZstdAlgorithm = "zstd"
+)
+
+var (
+	zstdFrameMagic      = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	zstdDictionaryMagic = []byte{0x37, 0xa4, 0x30, 0xec}
+)
+
+// Dictionary ids below this value are reserved by the zstd format.
+const minZstdDictionaryId = 32768
+
+// ZstdCompressor compresses to zstd, optionally using a dictionary, which for small payloads with a lot of content
+// in common, such as job specs, gives a much better compression ratio than zlib.
+// Unlike ZlibCompressor, it's safe for concurrent use.
+type ZstdCompressor struct {
+	encoder *zstd.Encoder
+}
+
+// NewZstdCompressor returns a compressor using the provided dictionary, or no dictionary if dictionary is empty.
+// The dictionary may be either in the format produced by "zstd --train" or a raw content dictionary,
+// such as one produced by TrainZstdDictionary.
+func NewZstdCompressor(dictionary []byte) (*ZstdCompressor, error) {
+	// As with zlib, favour speed. Empty payloads are encoded as frames too, such that their format can be detected.
+	options := []zstd.EOption{zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithZeroFrames(true)}
+	if len(dictionary) > 0 {
+		if bytes.HasPrefix(dictionary, zstdDictionaryMagic) {
+			options = append(options, zstd.WithEncoderDict(dictionary))
+		} else {
+			options = append(options, zstd.WithEncoderDictRaw(zstdDictionaryId(dictionary), dictionary))
+		}
+	}
+	encoder, err := zstd.NewWriter(nil, options...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ZstdCompressor{encoder: encoder}, nil
+}
+
+func (c *ZstdCompressor) Compress(b []byte) ([]byte, error) {
+	return c.encoder.EncodeAll(b, nil), nil
+}
+
+// ZstdDecompressor decompresses zstd. It's safe for concurrent use.
+type ZstdDecompressor struct {
+	decoder *zstd.Decoder
+}
+
+// NewZstdDecompressor returns a decompressor for data compressed without a dictionary or with any of the provided ones.
+func NewZstdDecompressor(dictionaries ...[]byte) (*ZstdDecompressor, error) {
+	var options []zstd.DOption
+	for _, dictionary := range dictionaries {
+		if len(dictionary) == 0 {
+			continue
+		}
+		if bytes.HasPrefix(dictionary, zstdDictionaryMagic) {
+			options = append(options, zstd.WithDecoderDicts(dictionary))
+		} else {
+			options = append(options, zstd.WithDecoderDictRaw(zstdDictionaryId(dictionary), dictionary))
+		}
+	}
+	decoder, err := zstd.NewReader(nil, options...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ZstdDecompressor{decoder: decoder}, nil
+}
+
+func (d *ZstdDecompressor) Decompress(b []byte) ([]byte, error) {
+	decompressed, err := d.decoder.DecodeAll(b, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return decompressed, nil
+}
+
+// zstdDictionaryId returns the id with which a raw dictionary is referred to in compressed frames,
+// which is derived from its content such that compressors and decompressors agree on it without configuration.
+func zstdDictionaryId(dictionary []byte) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write(dictionary)
+	// Ids of 2^31 and above are reserved as well.
+	return minZstdDictionaryId + h.Sum32()%(1<<31-minZstdDictionaryId)
+}
+
+// TrainZstdDictionary returns a raw zstd dictionary of at most maxSize bytes for compressing data similar to samples.
+// The dictionary consists of the distinct samples, where samples occurring more often are preferred and placed
+// towards the end of the dictionary, since zstd encodes references to the end of a dictionary most compactly.
+func TrainZstdDictionary(samples [][]byte, maxSize int) []byte {
+	counts := make(map[string]int)
+	var distinct []string
+	for _, sample := range samples {
+		if len(sample) == 0 || len(sample) > maxSize {
+			continue
+		}
+		if counts[string(sample)] == 0 {
+			distinct = append(distinct, string(sample))
+		}
+		counts[string(sample)]++
+	}
+	sort.SliceStable(distinct, func(i, j int) bool {
+		return counts[distinct[i]] > counts[distinct[j]]
+	})
+
+	var selected []string
+	size := 0
+	for _, sample := range distinct {
+		if size+len(sample) > maxSize {
+			continue
+		}
+		selected = append(selected, sample)
+		size += len(sample)
+	}
+	dictionary := make([]byte, 0, size)
+	for i := len(selected) - 1; i >= 0; i-- {
+		dictionary = append(dictionary, selected[i]...)
+	}
+	return dictionary
+}
+
+// LoadDictionary reads the dictionary at path, returning nil if path is empty.
+func LoadDictionary(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	dictionary, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return dictionary, nil
+}
+
+// NewCompressor returns a compressor for the provided algorithm, which is either ZlibAlgorithm or ZstdAlgorithm.
+// An empty algorithm selects zlib. For zlib, payloads no larger than minCompressSize are stored uncompressed and
+// dictionary must be empty; for zstd, minCompressSize is ignored.
+func NewCompressor(algorithm string, minCompressSize int, dictionary []byte) (Compressor, error) {
+	switch algorithm {
+	case "", ZlibAlgorithm:
+		if len(dictionary) > 0 {
+			return nil, errors.Errorf("dictionaries aren't supported by %s", ZlibAlgorithm)
+		}
+		return NewZlibCompressor(minCompressSize)
+	case ZstdAlgorithm:
+		return NewZstdCompressor(dictionary)
+	default:
+		return nil, errors.Errorf("unknown compression algorithm %s; must be one of %s and %s", algorithm, ZlibAlgorithm, ZstdAlgorithm)
+	}
+}
+
+// NewCompressorFactory returns a function creating compressors for the provided algorithm as by NewCompressor,
+// for use where a compressor is needed per goroutine. With zstd, all compressors share the same underlying encoder.
+func NewCompressorFactory(algorithm string, minCompressSize int, dictionary []byte) (func() (Compressor, error), error) {
+	compressor, err := NewCompressor(algorithm, minCompressSize, dictionary)
+	if err != nil {
+		return nil, err
+	}
+	if zstdCompressor, ok := compressor.(*ZstdCompressor); ok {
+		return func() (Compressor, error) { return zstdCompressor, nil }, nil
+	}
+	return func() (Compressor, error) { return NewCompressor(algorithm, minCompressSize, dictionary) }, nil
+}
+
+// AutoDecompressor decompresses both zlib and zstd, detecting the format of each payload,
+// such that the compression algorithm of stored data can be changed without migrating existing data.
+type AutoDecompressor struct {
+	zlib Decompressor
+	zstd Decompressor
+}
+
+// NewAutoDecompressor returns a decompressor delegating to zlib for zlib data and to zstd for zstd data.
+// It's safe for concurrent use if both of those are.
+func NewAutoDecompressor(zlib Decompressor, zstd Decompressor) *AutoDecompressor {
+	return &AutoDecompressor{zlib: zlib, zstd: zstd}
+}
+
+func (d *AutoDecompressor) Decompress(b []byte) ([]byte, error) {
+	if IsZstd(b) {
+		return d.zstd.Decompress(b)
+	}
+	return d.zlib.Decompress(b)
+}
+
+// IsZstd returns true if b starts with a zstd frame.
+func IsZstd(b []byte) bool {
+	return bytes.HasPrefix(b, zstdFrameMagic)
+}
@@ -0,0 +1,102 @@
+package compress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZstd_RoundTrip(t *testing.T) {
+	compressor, err := NewZstdCompressor(nil)
+	require.NoError(t, err)
+	decompressor, err := NewZstdDecompressor()
+	require.NoError(t, err)
+	for _, input := range []string{"", "hello world", "The quick brown fox jumps over the lazy dog"} {
+		compressed, err := compressor.Compress([]byte(input))
+		require.NoError(t, err)
+		assert.True(t, IsZstd(compressed))
+		decompressed, err := decompressor.Decompress(compressed)
+		require.NoError(t, err)
+		assert.Equal(t, input, string(decompressed))
+	}
+}
+
+func TestZstd_Dictionary(t *testing.T) {
+	var samples [][]byte
+	for i := 0; i < 100; i++ {
+		samples = append(samples, []byte(jobSpec(i)))
+	}
+	dictionary := TrainZstdDictionary(samples, 4*1024)
+	input := []byte(jobSpec(1000))
+
+	withoutDictionary, err := NewZstdCompressor(nil)
+	require.NoError(t, err)
+	withDictionary, err := NewZstdCompressor(dictionary)
+	require.NoError(t, err)
+	compressedWithoutDictionary, err := withoutDictionary.Compress(input)
+	require.NoError(t, err)
+	compressed, err := withDictionary.Compress(input)
+	require.NoError(t, err)
+	assert.Less(t, len(compressed), len(compressedWithoutDictionary))
+
+	decompressor, err := NewZstdDecompressor(dictionary)
+	require.NoError(t, err)
+	decompressed, err := decompressor.Decompress(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, input, decompressed)
+
+	// Data compressed with a dictionary can't be read without it.
+	decompressor, err = NewZstdDecompressor()
+	require.NoError(t, err)
+	_, err = decompressor.Decompress(compressed)
+	assert.Error(t, err)
+}
+
+func TestTrainZstdDictionary(t *testing.T) {
+	samples := [][]byte{[]byte("aaaa"), []byte("bbbb"), []byte("cccc"), []byte("bbbb"), []byte("dddddddd"), []byte("")}
+	// More common samples are placed last; samples that don't fit are skipped.
+	assert.Equal(t, "ccccaaaabbbb", string(TrainZstdDictionary(samples, 14)))
+	assert.Equal(t, "", string(TrainZstdDictionary(nil, 14)))
+}
+
+func TestAutoDecompressor(t *testing.T) {
+	zlibCompressor, err := NewZlibCompressor(0)
+	require.NoError(t, err)
+	zstdCompressor, err := NewZstdCompressor(nil)
+	require.NoError(t, err)
+	zstdDecompressor, err := NewZstdDecompressor()
+	require.NoError(t, err)
+	decompressor := NewAutoDecompressor(NewZlibDecompressor(), zstdDecompressor)
+	for _, compressor := range []Compressor{zlibCompressor, zstdCompressor} {
+		compressed, err := CompressStringArray([]string{"group1", "group2"}, compressor)
+		require.NoError(t, err)
+		decompressed, err := DecompressStringArray(compressed, decompressor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"group1", "group2"}, decompressed)
+	}
+}
+
+func TestNewCompressor(t *testing.T) {
+	compressor, err := NewCompressor("", 0, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &ZlibCompressor{}, compressor)
+	compressor, err = NewCompressor(ZstdAlgorithm, 0, []byte("dictionary"))
+	require.NoError(t, err)
+	assert.IsType(t, &ZstdCompressor{}, compressor)
+
+	_, err = NewCompressor(ZlibAlgorithm, 0, []byte("dictionary"))
+	assert.Error(t, err)
+	_, err = NewCompressor("lz4", 0, nil)
+	assert.Error(t, err)
+}
+
+func jobSpec(i int) string {
+	return fmt.Sprintf(
+		`{"id":"01h%06d","queue":"queue-%d","jobSetId":"job-set","owner":"user","podSpec":{"containers":[{"name":"main",`+
+			`"image":"alpine:3.18","command":["sh","-c","sleep %d"],"resources":{"requests":{"cpu":"1","memory":"1Gi"},`+
+			`"limits":{"cpu":"1","memory":"1Gi"}}}],"restartPolicy":"Never","terminationGracePeriodSeconds":30}}`,
+		i, i%3, i,
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/mitchellh/mapstructure"
@@ -18,6 +19,13 @@ var CustomHooks = []viper.DecoderConfigOption{
 	addDecodeHook(PulsarCompressionTypeHookFunc()),
 	addDecodeHook(PulsarCompressionLevelHookFunc()),
 	addDecodeHook(QuantityDecodeHook()),
+	addDecodeHook(DurationUnitDecodeHook()),
+}
+
+// StrictDecoding makes decoding fail on keys that don't correspond to any config field,
+// e.g., misspelled keys or keys of settings that have been removed, which would otherwise be ignored silently.
+func StrictDecoding(c *mapstructure.DecoderConfig) {
+	c.ErrorUnused = true
 }
 
 func PulsarCompressionTypeHookFunc() mapstructure.DecodeHookFuncType {
@@ -89,6 +97,35 @@ func QuantityDecodeHook() mapstructure.DecodeHookFuncType {
 	}
 }
 
+// DurationUnitDecodeHook rejects durations given as numbers without a unit, e.g., "timeout: 30",
+// which would otherwise be decoded as nanoseconds. Zero is allowed, since it's the same in any unit.
+func DurationUnitDecodeHook() mapstructure.DecodeHookFuncType {
+	return func(
+		f reflect.Type,
+		t reflect.Type,
+		data interface{},
+	) (interface{}, error) {
+		if t != reflect.TypeOf(time.Duration(0)) || f == t {
+			return data, nil
+		}
+		switch f.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if reflect.ValueOf(data).IsZero() {
+				return data, nil
+			}
+			return nil, errors.WithStack(&armadaerrors.ErrInvalidArgument{
+				Name:    "time.Duration",
+				Value:   data,
+				Message: fmt.Sprintf("Duration %v has no unit; use, e.g., %vs for seconds", data, data),
+			})
+		default:
+			return data, nil
+		}
+	}
+}
+
 func addDecodeHook(hook mapstructure.DecodeHookFuncType) viper.DecoderConfigOption {
 	return func(c *mapstructure.DecoderConfig) {
 		c.DecodeHook = mapstructure.ComposeDecodeHookFunc(
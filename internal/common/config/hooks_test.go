@@ -3,6 +3,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/mitchellh/mapstructure"
@@ -114,6 +115,36 @@ func TestQuantityDecodeHook(t *testing.T) {
 	}
 }
 
+func TestDurationUnitDecodeHook(t *testing.T) {
+	tests := map[string]HookTest{
+		"parsed duration": {
+			value:    30 * time.Second,
+			expected: 30 * time.Second,
+		},
+		"string input": {
+			value:    "30s",
+			expected: "30s",
+		},
+		"zero": {
+			value:    0,
+			expected: 0,
+		},
+		"int without unit": {
+			value:       30,
+			expectError: true,
+		},
+		"float without unit": {
+			value:       0.5,
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runHookTest(t, tc, reflect.TypeOf(time.Duration(0)), DurationUnitDecodeHook())
+		})
+	}
+}
+
 func runHookTest(t *testing.T, tc HookTest, convertTo reflect.Type, hookFunc mapstructure.DecodeHookFuncType) {
 	parsed, err := hookFunc(reflect.TypeOf(tc.value), convertTo, tc.value)
 	if tc.expectError {
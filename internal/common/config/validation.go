@@ -1,31 +1,91 @@
 package config
 
 import (
+	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/go-playground/validator/v10"
-	log "github.com/sirupsen/logrus"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/logging"
+)
+
+var (
+	structValidationsMu sync.Mutex
+	// Validations of invariants spanning several fields of a config struct, registered with RegisterStructValidation.
+	structValidations []structValidation
 )
 
+type structValidation struct {
+	fn    validator.StructLevelFunc
+	types []interface{}
+}
+
+// RegisterStructValidation registers fn to be run by Validate on all values of the provided types in a config.
+// Intended to be called from the init function of the package defining the types,
+// to check invariants that can't be expressed with validate tags, e.g., that a field refers to an entry of a map.
+// Errors are reported with validator.StructLevel.ReportError, where the tag describes the violated invariant.
+func RegisterStructValidation(fn validator.StructLevelFunc, types ...interface{}) {
+	structValidationsMu.Lock()
+	defer structValidationsMu.Unlock()
+	structValidations = append(structValidations, structValidation{fn: fn, types: types})
+}
+
 func Validate(config interface{}) error {
 	validate := validator.New()
+	structValidationsMu.Lock()
+	for _, v := range structValidations {
+		validate.RegisterStructValidation(v.fn, v.types...)
+	}
+	structValidationsMu.Unlock()
 	return validate.Struct(config)
 }
 
 func LogValidationErrors(err error) {
-	if err != nil {
-		for _, err := range err.(validator.ValidationErrors) {
-			fieldName := stripPrefix(err.Namespace())
-			switch err.Tag() {
-			case "required":
-				log.Errorf("ConfigError: Field %s is required but was not found", fieldName)
-			default:
-				log.Errorf("ConfigError: %s is not a valid value for %s", err.Value(), fieldName)
-			}
+	if err == nil {
+		return
+	}
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		logging.Errorf("ConfigError: %s", err)
+		return
+	}
+	for _, err := range validationErrors {
+		fieldName := stripPrefix(err.Namespace())
+		switch err.Tag() {
+		case "required":
+			logging.Errorf("ConfigError: Field %s is required but was not found", fieldName)
+		default:
+			logging.Errorf("ConfigError: %v is not a valid value for %s: %s", err.Value(), fieldName, describeTag(err))
 		}
 	}
 }
 
+// describeTag returns a description of the requirement violated by err.
+func describeTag(err validator.FieldError) string {
+	switch err.Tag() {
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", err.Param())
+	case "gte":
+		return fmt.Sprintf("must be at least %s", err.Param())
+	case "lt":
+		return fmt.Sprintf("must be less than %s", err.Param())
+	case "lte":
+		return fmt.Sprintf("must be at most %s", err.Param())
+	case "ltefield":
+		return fmt.Sprintf("must be at most %s", err.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", err.Param())
+	case "keyof":
+		return fmt.Sprintf("must be a key of %s", err.Param())
+	}
+	if err.Param() != "" {
+		return fmt.Sprintf("must satisfy %s=%s", err.Tag(), err.Param())
+	}
+	return fmt.Sprintf("must satisfy %s", err.Tag())
+}
+
 func stripPrefix(s string) string {
 	if idx := strings.Index(s, "."); idx != -1 {
 		return s[idx+1:]
@@ -0,0 +1,202 @@
+// Package cron parses standard five-field cron expressions
+// (minute, hour, day of month, month, day of week) and computes the times at which they fire.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Schedule is a parsed cron expression.
+// Each field is a bitmask, where bit i is set if the expression fires for value i of that field.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// Per cron convention, if both day of month and day of week are restricted (i.e., not "*"),
+	// the expression fires on days matching either of them.
+	dayOfMonthStar bool
+	dayOfWeekStar  bool
+}
+
+type bounds struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteBounds     = bounds{min: 0, max: 59}
+	hourBounds       = bounds{min: 0, max: 23}
+	dayOfMonthBounds = bounds{min: 1, max: 31}
+	monthBounds      = bounds{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dayOfWeekBounds = bounds{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxSearchYears bounds the search for the next time an expression fires,
+// such that expressions that never fire (e.g., "0 0 30 2 *") don't loop forever.
+const maxSearchYears = 5
+
+// Parse parses a cron expression.
+// Supported are the five standard fields, each of which may contain "*", values, ranges ("1-5"),
+// steps ("*/15", "0-30/10"), and comma-separated lists thereof; month and day-of-week names ("jan", "mon");
+// and the descriptors "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", and "@hourly".
+func Parse(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if descriptor, ok := descriptors[strings.ToLower(expr)]; ok {
+		expr = descriptor
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("invalid cron expression %q: expected 5 fields but got %d", expr, len(fields))
+	}
+	schedule := &Schedule{}
+	var err error
+	if schedule.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, errors.WithMessagef(err, "invalid minute field in cron expression %q", expr)
+	}
+	if schedule.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, errors.WithMessagef(err, "invalid hour field in cron expression %q", expr)
+	}
+	if schedule.dayOfMonth, err = parseField(fields[2], dayOfMonthBounds); err != nil {
+		return nil, errors.WithMessagef(err, "invalid day-of-month field in cron expression %q", expr)
+	}
+	if schedule.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, errors.WithMessagef(err, "invalid month field in cron expression %q", expr)
+	}
+	if schedule.dayOfWeek, err = parseField(fields[4], dayOfWeekBounds); err != nil {
+		return nil, errors.WithMessagef(err, "invalid day-of-week field in cron expression %q", expr)
+	}
+	// Both 0 and 7 denote Sunday.
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	schedule.dayOfMonthStar = strings.HasPrefix(fields[2], "*")
+	schedule.dayOfWeekStar = strings.HasPrefix(fields[4], "*")
+	return schedule, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var mask uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangePart = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return 0, errors.Errorf("invalid step in %q", part)
+			}
+		}
+		var lo, hi int
+		switch {
+		case rangePart == "*":
+			lo, hi = b.min, b.max
+		case strings.Contains(rangePart, "-"):
+			i := strings.Index(rangePart, "-")
+			var err error
+			if lo, err = parseValue(rangePart[:i], b); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(rangePart[i+1:], b); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, errors.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			var err error
+			if lo, err = parseValue(rangePart, b); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if step > 1 {
+				// "5/15" is shorthand for "5-max/15".
+				hi = b.max
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			mask |= 1 << uint(v)
+		}
+	}
+	return mask, nil
+}
+
+func parseValue(s string, b bounds) (int, error) {
+	if v, ok := b.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Errorf("invalid value %q", s)
+	}
+	if v < b.min || v > b.max {
+		return 0, errors.Errorf("value %d out of range [%d, %d]", v, b.min, b.max)
+	}
+	return v, nil
+}
+
+// Next returns the earliest time strictly after t at which the schedule fires, in the location of t.
+// Returns the zero time if the schedule doesn't fire within the next few years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// Prev returns the latest time at or before t at which the schedule fires, provided that time is after since.
+// Returns the zero time if there is no such time.
+func (s *Schedule) Prev(t time.Time, since time.Time) time.Time {
+	var rv time.Time
+	for next := s.Next(since); !next.IsZero() && !next.After(t); next = s.Next(next) {
+		rv = next
+	}
+	return rv
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
@@ -0,0 +1,98 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	// A Monday.
+	start := time.Date(2023, 10, 16, 10, 30, 15, 0, time.UTC)
+	tests := map[string]struct {
+		expr     string
+		expected time.Time
+	}{
+		"every minute": {
+			expr:     "* * * * *",
+			expected: time.Date(2023, 10, 16, 10, 31, 0, 0, time.UTC),
+		},
+		"every 15 minutes": {
+			expr:     "*/15 * * * *",
+			expected: time.Date(2023, 10, 16, 10, 45, 0, 0, time.UTC),
+		},
+		"hourly": {
+			expr:     "@hourly",
+			expected: time.Date(2023, 10, 16, 11, 0, 0, 0, time.UTC),
+		},
+		"daily at 9": {
+			expr:     "0 9 * * *",
+			expected: time.Date(2023, 10, 17, 9, 0, 0, 0, time.UTC),
+		},
+		"weekdays only": {
+			expr:     "0 9 * * mon-fri",
+			expected: time.Date(2023, 10, 17, 9, 0, 0, 0, time.UTC),
+		},
+		"sunday as 7": {
+			expr:     "0 0 * * 7",
+			expected: time.Date(2023, 10, 22, 0, 0, 0, 0, time.UTC),
+		},
+		"list": {
+			expr:     "0 8,12 * * *",
+			expected: time.Date(2023, 10, 16, 12, 0, 0, 0, time.UTC),
+		},
+		"monthly": {
+			expr:     "@monthly",
+			expected: time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"day of month or day of week": {
+			expr:     "0 0 1 * fri",
+			expected: time.Date(2023, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"leap day": {
+			expr:     "0 0 29 feb *",
+			expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"never": {
+			expr:     "0 0 30 2 *",
+			expected: time.Time{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			schedule, err := Parse(tc.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, schedule.Next(start))
+		})
+	}
+}
+
+func TestSchedule_Prev(t *testing.T) {
+	schedule, err := Parse("*/10 * * * *")
+	require.NoError(t, err)
+	since := time.Date(2023, 10, 16, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2023, 10, 16, 10, 30, 0, 0, time.UTC), schedule.Prev(since.Add(35*time.Minute), since))
+	assert.True(t, schedule.Prev(since.Add(5*time.Minute), since).IsZero())
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"foo * * * *",
+		"@fortnightly",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
@@ -76,6 +76,13 @@ func ParseNullInt32(nullInt sql.NullInt32) *int32 {
 	return &nullInt.Int32
 }
 
+func ParseNullInt64(nullInt sql.NullInt64) *int64 {
+	if !nullInt.Valid {
+		return nil
+	}
+	return &nullInt.Int64
+}
+
 func ReadInt(rows pgx.Rows) (int, error) {
 	defer rows.Close()
 	var val int
@@ -9,11 +9,16 @@ import (
 	"strings"
 
 	stakikfs "github.com/rakyll/statik/fs"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 )
 
+// NoTransactionDirective marks a migration whose statements must not run in a transaction,
+// e.g., because it creates indexes concurrently. It must be the first line of the migration.
+// The statements of such a migration are executed one at a time, so they should be safe to re-run
+// in case the migration fails part-way through.
+const NoTransactionDirective = "-- armada:no-transaction"
+
 // Migration represents a single, versioned database migration script
 type Migration struct {
 	id   int
@@ -29,20 +34,55 @@ func NewMigration(id int, name string, sql string) Migration {
 	}
 }
 
+// noTransaction returns true if m is marked with NoTransactionDirective.
+func (m Migration) noTransaction() bool {
+	firstLine, _, _ := strings.Cut(m.sql, "\n")
+	return strings.TrimSpace(firstLine) == NoTransactionDirective
+}
+
+// statements returns the statements of m to be executed.
+// Migrations are executed as a single, implicitly transactional, batch of statements,
+// unless marked with NoTransactionDirective, in which case each statement is executed on its own.
+func (m Migration) statements() []string {
+	if !m.noTransaction() {
+		return []string{m.sql}
+	}
+	var statements []string
+	for _, statement := range strings.Split(m.sql, ";") {
+		if isEmptyStatement(statement) {
+			continue
+		}
+		statements = append(statements, statement)
+	}
+	return statements
+}
+
+// isEmptyStatement returns true if statement consists of only whitespace and comments.
+func isEmptyStatement(statement string) bool {
+	for _, line := range strings.Split(statement, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return false
+		}
+	}
+	return true
+}
+
 func UpdateDatabase(ctx *armadacontext.Context, db Querier, migrations []Migration) error {
-	log.Info("Updating postgres...")
+	ctx.Info("Updating postgres...")
 	version, err := readVersion(ctx, db)
 	if err != nil {
 		return err
 	}
-	log.Infof("Current version %v", version)
+	ctx.Infof("Current version %v", version)
 
 	for _, m := range migrations {
 		if m.id > version {
-			log.Debugf("Executing %s", m.name)
-			_, err := db.Exec(ctx, m.sql)
-			if err != nil {
-				return err
+			ctx.Debugf("Executing %s", m.name)
+			for _, statement := range m.statements() {
+				if _, err := db.Exec(ctx, statement); err != nil {
+					return err
+				}
 			}
 
 			version = m.id
@@ -52,7 +92,7 @@ func UpdateDatabase(ctx *armadacontext.Context, db Querier, migrations []Migrati
 			}
 		}
 	}
-	log.Info("Database updated.")
+	ctx.Info("Database updated.")
 	return nil
 }
 
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigration_Statements(t *testing.T) {
+	tests := map[string]struct {
+		sql                   string
+		expectedNoTransaction bool
+		expectedStatements    []string
+	}{
+		"transactional": {
+			sql:                "CREATE TABLE a (id int);\nCREATE INDEX idx_a ON a (id);\n",
+			expectedStatements: []string{"CREATE TABLE a (id int);\nCREATE INDEX idx_a ON a (id);\n"},
+		},
+		"directive not on the first line": {
+			sql:                "CREATE TABLE a (id int);\n-- armada:no-transaction\n",
+			expectedStatements: []string{"CREATE TABLE a (id int);\n-- armada:no-transaction\n"},
+		},
+		"no transaction": {
+			sql:                   "-- armada:no-transaction\n-- Comment.\nDROP INDEX CONCURRENTLY IF EXISTS idx_a;\nCREATE INDEX CONCURRENTLY idx_a ON a (id);\n-- Trailing comment.\n",
+			expectedNoTransaction: true,
+			expectedStatements: []string{
+				"-- armada:no-transaction\n-- Comment.\nDROP INDEX CONCURRENTLY IF EXISTS idx_a",
+				"\nCREATE INDEX CONCURRENTLY idx_a ON a (id)",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := NewMigration(1, name, tc.sql)
+			assert.Equal(t, tc.expectedNoTransaction, m.noTransaction())
+			assert.Equal(t, tc.expectedStatements, m.statements())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package eventlog
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// Properties added to dead-lettered messages, in addition to the properties of the original message.
+const (
+	DeadLetterMessageIdProperty   = "deadLetterMessageId"
+	DeadLetterPublishTimeProperty = "deadLetterPublishTime"
+	DeadLetterErrorProperty       = "deadLetterError"
+	DeadLetterRetryCountProperty  = "deadLetterRetryCount"
+)
+
+var deadLetterProperties = []string{
+	DeadLetterMessageIdProperty,
+	DeadLetterPublishTimeProperty,
+	DeadLetterErrorProperty,
+	DeadLetterRetryCountProperty,
+}
+
+// DeadLetter is a message, or the part of a message, that couldn't be processed.
+type DeadLetter struct {
+	// The message from which the dead letter originates.
+	Message Message
+	// Payload to store, i.e., either the payload of Message or the part of it that couldn't be processed.
+	Payload []byte
+	// The error that caused processing to fail.
+	Error error
+	// Number of times processing was retried before giving up.
+	RetryCount int
+}
+
+// DeadLetterQueue stores messages that couldn't be processed, such that they can be inspected and requeued later
+// instead of being lost.
+type DeadLetterQueue interface {
+	Add(ctx *armadacontext.Context, letter DeadLetter) error
+}
+
+// PulsarDeadLetterQueue is a DeadLetterQueue writing to a Pulsar topic.
+// Dead letters are published with the properties and key of the original message,
+// with the id, publish time, error, and retry count added as properties.
+type PulsarDeadLetterQueue struct {
+	Producer pulsar.Producer
+}
+
+func NewPulsarDeadLetterQueue(producer pulsar.Producer) *PulsarDeadLetterQueue {
+	return &PulsarDeadLetterQueue{Producer: producer}
+}
+
+func (q *PulsarDeadLetterQueue) Add(ctx *armadacontext.Context, letter DeadLetter) error {
+	properties := maps.Clone(letter.Message.Properties())
+	if properties == nil {
+		properties = make(map[string]string)
+	}
+	properties[DeadLetterMessageIdProperty] = letter.Message.ID().String()
+	properties[DeadLetterPublishTimeProperty] = letter.Message.PublishTime().UTC().Format(time.RFC3339Nano)
+	if letter.Error != nil {
+		properties[DeadLetterErrorProperty] = letter.Error.Error()
+	}
+	properties[DeadLetterRetryCountProperty] = strconv.Itoa(letter.RetryCount)
+	_, err := q.Producer.Send(ctx, &pulsar.ProducerMessage{
+		Payload:    letter.Payload,
+		Properties: properties,
+		Key:        messageKey(letter.Message),
+	})
+	return errors.WithStack(err)
+}
+
+// RequeueDeadLetters republishes the dead letters read by consumer to producer, with the properties added when
+// dead-lettering removed, until no message has been received for idleTimeout or ctx is cancelled.
+// Each dead letter is acked once it has been republished. Returns the number of requeued messages.
+func RequeueDeadLetters(ctx *armadacontext.Context, consumer Consumer, producer pulsar.Producer, idleTimeout time.Duration) (int, error) {
+	numRequeued := 0
+	for {
+		ctxWithTimeout, cancel := armadacontext.WithTimeout(ctx, idleTimeout)
+		msg, err := consumer.Receive(ctxWithTimeout)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return numRequeued, nil
+		} else if err != nil {
+			return numRequeued, err
+		}
+		properties := maps.Clone(msg.Properties())
+		for _, property := range deadLetterProperties {
+			delete(properties, property)
+		}
+		if _, err := producer.Send(ctx, &pulsar.ProducerMessage{
+			Payload:    msg.Payload(),
+			Properties: properties,
+			Key:        messageKey(msg),
+		}); err != nil {
+			return numRequeued, errors.WithStack(err)
+		}
+		if err := consumer.Ack(msg); err != nil {
+			return numRequeued, err
+		}
+		numRequeued++
+	}
+}
+
+// messageKey returns the key of msg if it has one, and the empty string otherwise.
+func messageKey(msg Message) string {
+	if keyed, ok := msg.(interface{ Key() string }); ok {
+		return keyed.Key()
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+package eventlog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+type fakePulsarProducer struct {
+	pulsar.Producer
+	sent []*pulsar.ProducerMessage
+}
+
+func (p *fakePulsarProducer) Send(_ context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+	p.sent = append(p.sent, msg)
+	return nil, nil
+}
+
+type fakeMessage struct {
+	id          string
+	payload     []byte
+	publishTime time.Time
+	properties  map[string]string
+	key         string
+}
+
+func (msg *fakeMessage) ID() fmt.Stringer {
+	return stringer(msg.id)
+}
+
+func (msg *fakeMessage) Payload() []byte {
+	return msg.payload
+}
+
+func (msg *fakeMessage) PublishTime() time.Time {
+	return msg.publishTime
+}
+
+func (msg *fakeMessage) Properties() map[string]string {
+	return msg.properties
+}
+
+func (msg *fakeMessage) Key() string {
+	return msg.key
+}
+
+type stringer string
+
+func (s stringer) String() string {
+	return string(s)
+}
+
+type fakeConsumer struct {
+	messages []Message
+	acked    []Message
+}
+
+func (c *fakeConsumer) Receive(ctx context.Context) (Message, error) {
+	if len(c.messages) == 0 {
+		return nil, context.DeadlineExceeded
+	}
+	msg := c.messages[0]
+	c.messages = c.messages[1:]
+	return msg, nil
+}
+
+func (c *fakeConsumer) Ack(msg Message) error {
+	c.acked = append(c.acked, msg)
+	return nil
+}
+
+func TestPulsarDeadLetterQueue(t *testing.T) {
+	msg := &fakeMessage{
+		id:          "1:2:3",
+		payload:     []byte("payload"),
+		publishTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		properties:  map[string]string{"schedulerName": "legacy"},
+		key:         "jobSet",
+	}
+	producer := &fakePulsarProducer{}
+	q := NewPulsarDeadLetterQueue(producer)
+	err := q.Add(armadacontext.Background(), DeadLetter{
+		Message:    msg,
+		Payload:    []byte("failed"),
+		Error:      errors.New("failure"),
+		RetryCount: 3,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, producer.sent, 1)
+	assert.Equal(t, []byte("failed"), producer.sent[0].Payload)
+	assert.Equal(t, "jobSet", producer.sent[0].Key)
+	assert.Equal(
+		t,
+		map[string]string{
+			"schedulerName":               "legacy",
+			DeadLetterMessageIdProperty:   "1:2:3",
+			DeadLetterPublishTimeProperty: "2023-01-01T00:00:00Z",
+			DeadLetterErrorProperty:       "failure",
+			DeadLetterRetryCountProperty:  "3",
+		},
+		producer.sent[0].Properties,
+	)
+	// The properties of the original message are unchanged.
+	assert.Equal(t, map[string]string{"schedulerName": "legacy"}, msg.properties)
+
+	// Requeueing restores the original properties.
+	deadLetter := &fakeMessage{
+		id:         "4:5:6",
+		payload:    producer.sent[0].Payload,
+		properties: producer.sent[0].Properties,
+		key:        producer.sent[0].Key,
+	}
+	consumer := &fakeConsumer{messages: []Message{deadLetter}}
+	eventsProducer := &fakePulsarProducer{}
+	numRequeued, err := RequeueDeadLetters(armadacontext.Background(), consumer, eventsProducer, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 1, numRequeued)
+	assert.Equal(t, []Message{deadLetter}, consumer.acked)
+	assert.Equal(
+		t,
+		[]*pulsar.ProducerMessage{{
+			Payload:    []byte("failed"),
+			Properties: map[string]string{"schedulerName": "legacy"},
+			Key:        "jobSet",
+		}},
+		eventsProducer.sent,
+	)
+}
@@ -0,0 +1,69 @@
+// Package eventlog abstracts over the log from which Armada services read job set events,
+// such that services consuming the log don't depend on the system providing it.
+package eventlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Message is a message read from the event log. Its payload is a marshalled armadaevents.EventSequence.
+type Message interface {
+	// ID returns the position of the message in the log.
+	ID() fmt.Stringer
+	// Payload returns the body of the message.
+	Payload() []byte
+	// PublishTime returns the time at which the message was published to the log.
+	PublishTime() time.Time
+	// Properties returns the properties attached to the message when it was published.
+	Properties() map[string]string
+}
+
+// Consumer reads messages from the event log.
+type Consumer interface {
+	// Receive blocks until a message is available or ctx is cancelled.
+	Receive(ctx context.Context) (Message, error)
+	// Ack marks the provided message as processed, such that it isn't delivered to this consumer again.
+	Ack(msg Message) error
+}
+
+// Nacker is implemented by consumers that can mark individual messages as failed, such that they're redelivered.
+type Nacker interface {
+	// Nack marks msg as failed, such that it's delivered to this consumer again.
+	Nack(msg Message) error
+}
+
+// CumulativeAcker is implemented by consumers that can mark all messages up to a given message as processed at once.
+type CumulativeAcker interface {
+	// AckCumulative marks msgs, which are in the order they were received in, and all messages received before them
+	// as processed, with a single ack per partition of the log.
+	AckCumulative(msgs []Message) error
+}
+
+// ReceiveBatch receives up to maxSize messages from consumer.
+// It blocks until a message is available or ctx is cancelled,
+// and returns once maxSize messages have been received or maxWait has passed since receiving the first one.
+// An error is returned only if no messages were received.
+func ReceiveBatch(ctx context.Context, consumer Consumer, maxSize int, maxWait time.Duration) ([]Message, error) {
+	msg, err := consumer.Receive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs := []Message{msg}
+	if maxSize <= 1 {
+		return msgs, nil
+	}
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, maxWait)
+	defer cancel()
+	for len(msgs) < maxSize {
+		msg, err := consumer.Receive(ctxWithTimeout)
+		if err != nil {
+			// Either maxWait has passed or receiving failed. In the latter case, return the messages received so far;
+			// the error will most likely recur on the next call.
+			break
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
@@ -0,0 +1,30 @@
+package eventlog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiveBatch(t *testing.T) {
+	var messages []Message
+	for i := 0; i < 5; i++ {
+		messages = append(messages, &fakeMessage{id: string(rune('a' + i))})
+	}
+	consumer := &fakeConsumer{messages: messages}
+
+	batch, err := ReceiveBatch(context.Background(), consumer, 3, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, messages[:3], batch)
+
+	// Returns the messages available once no more are received.
+	batch, err = ReceiveBatch(context.Background(), consumer, 3, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, messages[3:], batch)
+
+	_, err = ReceiveBatch(context.Background(), consumer, 3, time.Second)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,122 @@
+package eventlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaReader is the subset of *kafka.Reader used by KafkaConsumer.
+type KafkaReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// KafkaConsumer is a Consumer reading the event log from a Kafka topic as a member of a consumer group.
+// Events are published to Pulsar only, so the topic must be a mirror of the Pulsar events topic.
+// Kafka has no per-message acks; instead, the consumer group stores the offset of each partition
+// up to which messages have been processed. Acking a message commits its offset, which marks all messages
+// received before it from the same partition as processed too. Hence, messages should be acked cumulatively,
+// or otherwise in the order they were received in within each partition.
+type KafkaConsumer struct {
+	Reader KafkaReader
+	// Timeout for committing offsets.
+	CommitTimeout time.Duration
+}
+
+// NewKafkaConsumer returns a consumer reading topic from brokers as a member of the consumer group groupId.
+// Offsets are committed synchronously when messages are acked.
+func NewKafkaConsumer(brokers []string, topic string, groupId string) *KafkaConsumer {
+	return &KafkaConsumer{
+		Reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers: brokers,
+			Topic:   topic,
+			GroupID: groupId,
+		}),
+		CommitTimeout: 10 * time.Second,
+	}
+}
+
+// KafkaMessageId is the position of a message in a Kafka topic.
+type KafkaMessageId struct {
+	Partition int
+	Offset    int64
+}
+
+func (id KafkaMessageId) String() string {
+	return fmt.Sprintf("%d:%d", id.Partition, id.Offset)
+}
+
+type kafkaMessage struct {
+	kafka.Message
+}
+
+func (msg kafkaMessage) ID() fmt.Stringer {
+	return KafkaMessageId{Partition: msg.Message.Partition, Offset: msg.Message.Offset}
+}
+
+func (msg kafkaMessage) Payload() []byte {
+	return msg.Message.Value
+}
+
+func (msg kafkaMessage) PublishTime() time.Time {
+	return msg.Message.Time
+}
+
+// Properties returns the headers of the message, which take the place of Pulsar message properties.
+func (msg kafkaMessage) Properties() map[string]string {
+	properties := make(map[string]string, len(msg.Message.Headers))
+	for _, header := range msg.Message.Headers {
+		properties[header.Key] = string(header.Value)
+	}
+	return properties
+}
+
+func (c *KafkaConsumer) Receive(ctx context.Context) (Message, error) {
+	msg, err := c.Reader.FetchMessage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return kafkaMessage{Message: msg}, nil
+}
+
+// Ack commits the offset of msg, thus marking msg and all messages before it in its partition as processed.
+func (c *KafkaConsumer) Ack(msg Message) error {
+	return c.AckCumulative([]Message{msg})
+}
+
+func (c *KafkaConsumer) AckCumulative(msgs []Message) error {
+	// Committing the offset of a message commits all offsets before it in its partition,
+	// so commit the last message of each partition.
+	lastByPartition := make(map[int]kafka.Message)
+	var partitions []int
+	for _, msg := range msgs {
+		kafkaMsg, ok := msg.(kafkaMessage)
+		if !ok {
+			return errors.Errorf("expected a message received from Kafka, but got %T", msg)
+		}
+		partition := kafkaMsg.Message.Partition
+		if _, ok := lastByPartition[partition]; !ok {
+			partitions = append(partitions, partition)
+		}
+		lastByPartition[partition] = kafkaMsg.Message
+	}
+	if len(partitions) == 0 {
+		return nil
+	}
+	toCommit := make([]kafka.Message, len(partitions))
+	for i, partition := range partitions {
+		toCommit[i] = lastByPartition[partition]
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.CommitTimeout)
+	defer cancel()
+	return errors.WithStack(c.Reader.CommitMessages(ctx, toCommit...))
+}
+
+func (c *KafkaConsumer) Close() error {
+	return c.Reader.Close()
+}
@@ -0,0 +1,83 @@
+package eventlog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeKafkaReader struct {
+	messages  []kafka.Message
+	committed []kafka.Message
+}
+
+func (r *fakeKafkaReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	if len(r.messages) == 0 {
+		return kafka.Message{}, context.DeadlineExceeded
+	}
+	msg := r.messages[0]
+	r.messages = r.messages[1:]
+	return msg, nil
+}
+
+func (r *fakeKafkaReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	r.committed = append(r.committed, msgs...)
+	return nil
+}
+
+func (r *fakeKafkaReader) Close() error {
+	return nil
+}
+
+func TestKafkaConsumer(t *testing.T) {
+	publishTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	kafkaMsg := kafka.Message{
+		Partition: 2,
+		Offset:    10,
+		Value:     []byte("payload"),
+		Time:      publishTime,
+		Headers:   []kafka.Header{{Key: "armadaScheduler", Value: []byte("pulsar")}},
+	}
+	reader := &fakeKafkaReader{messages: []kafka.Message{kafkaMsg}}
+	consumer := &KafkaConsumer{Reader: reader, CommitTimeout: time.Second}
+
+	msg, err := consumer.Receive(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2:10", msg.ID().String())
+	assert.Equal(t, []byte("payload"), msg.Payload())
+	assert.Equal(t, publishTime, msg.PublishTime())
+	assert.Equal(t, map[string]string{"armadaScheduler": "pulsar"}, msg.Properties())
+
+	require.NoError(t, consumer.Ack(msg))
+	assert.Equal(t, []kafka.Message{kafkaMsg}, reader.committed)
+
+	_, err = consumer.Receive(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Error(t, consumer.Ack(otherMessage{}))
+}
+
+func TestKafkaConsumer_AckCumulative(t *testing.T) {
+	var messages []kafka.Message
+	for i, partition := range []int{0, 1, 0, 0, 1} {
+		messages = append(messages, kafka.Message{Partition: partition, Offset: int64(i)})
+	}
+	reader := &fakeKafkaReader{messages: messages}
+	consumer := &KafkaConsumer{Reader: reader, CommitTimeout: time.Second}
+	var received []Message
+	for range messages {
+		msg, err := consumer.Receive(context.Background())
+		require.NoError(t, err)
+		received = append(received, msg)
+	}
+
+	// Only the offset of the last message of each partition is committed.
+	require.NoError(t, consumer.AckCumulative(received))
+	assert.Equal(t, []kafka.Message{messages[3], messages[4]}, reader.committed)
+
+	assert.Error(t, consumer.AckCumulative([]Message{otherMessage{}}))
+}
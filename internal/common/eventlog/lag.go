@@ -0,0 +1,123 @@
+package eventlog
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+)
+
+var consumerTimeLag = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "armada_event_log_consumer_time_lag_seconds",
+		Help: "Time the oldest message received from the event log has been waiting to be processed, grouped by subscription",
+	},
+	[]string{"subscription"},
+)
+
+var consumerMessageLag = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "armada_event_log_consumer_message_lag",
+		Help: "Number of messages of the event log not yet acked, grouped by subscription",
+	},
+	[]string{"subscription"},
+)
+
+// BacklogFunc returns the number of messages of the event log not yet acked by a subscription.
+type BacklogFunc func(ctx *armadacontext.Context) (int64, error)
+
+// LagMonitor tracks how far a consumer of the event log lags behind it and reports the lag as Prometheus metrics.
+// It implements health.Checker, failing when the consumer lags further behind than MaxTimeLag.
+type LagMonitor struct {
+	// Name of the subscription of the consumer, by which metrics are labelled.
+	Subscription string
+	// Maximum time messages may wait to be processed before Check fails. If zero, Check never fails.
+	MaxTimeLag time.Duration
+	// Returns the number of messages not yet acked, if provided; otherwise, the message lag isn't reported.
+	Backlog BacklogFunc
+	// Interval at which metrics are updated by Run.
+	Interval time.Duration
+	Clock    clock.Clock
+	mu       sync.Mutex
+	// Publish time of the oldest message received but not yet processed, or zero if all messages have been processed.
+	oldestPending time.Time
+}
+
+// NewLagMonitor returns a monitor for the consumer of the provided subscription, with metrics updated every 10 seconds.
+func NewLagMonitor(subscription string, maxTimeLag time.Duration, backlog BacklogFunc) *LagMonitor {
+	return &LagMonitor{
+		Subscription: subscription,
+		MaxTimeLag:   maxTimeLag,
+		Backlog:      backlog,
+		Interval:     10 * time.Second,
+		Clock:        clock.RealClock{},
+	}
+}
+
+// Update records the publish time of the oldest message received by the consumer but not yet processed,
+// or the zero time if the consumer has processed all messages received.
+func (m *LagMonitor) Update(oldestPending time.Time) {
+	m.mu.Lock()
+	m.oldestPending = oldestPending
+	m.mu.Unlock()
+	consumerTimeLag.WithLabelValues(m.Subscription).Set(m.TimeLag().Seconds())
+}
+
+// TimeLag returns the time the oldest message received but not yet processed has been waiting for,
+// or zero if there's no such message.
+func (m *LagMonitor) TimeLag() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.oldestPending.IsZero() {
+		return 0
+	}
+	if lag := m.Clock.Since(m.oldestPending); lag > 0 {
+		return lag
+	}
+	return 0
+}
+
+// Check returns an error if the consumer lags further behind the event log than MaxTimeLag.
+func (m *LagMonitor) Check() error {
+	if lag := m.TimeLag(); m.MaxTimeLag > 0 && lag > m.MaxTimeLag {
+		return errors.Errorf(
+			"subscription %s lags behind the event log by %s, which exceeds the maximum of %s",
+			m.Subscription, lag.Round(time.Second), m.MaxTimeLag,
+		)
+	}
+	return nil
+}
+
+// Run updates the metrics every Interval until ctx is cancelled,
+// such that the time lag keeps growing while messages aren't processed.
+func (m *LagMonitor) Run(ctx *armadacontext.Context) error {
+	ticker := m.Clock.NewTicker(m.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			m.update(ctx)
+		}
+	}
+}
+
+func (m *LagMonitor) update(ctx *armadacontext.Context) {
+	consumerTimeLag.WithLabelValues(m.Subscription).Set(m.TimeLag().Seconds())
+	if m.Backlog == nil {
+		return
+	}
+	backlog, err := m.Backlog(ctx)
+	if err != nil {
+		logging.WithStacktrace(ctx, err).WithField("subscription", m.Subscription).Warn("failed to get event log backlog")
+		return
+	}
+	consumerMessageLag.WithLabelValues(m.Subscription).Set(float64(backlog))
+}
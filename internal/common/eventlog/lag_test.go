@@ -0,0 +1,43 @@
+package eventlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+func TestLagMonitor(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFakeClock(now)
+	m := NewLagMonitor("test", time.Minute, nil)
+	m.Clock = fakeClock
+
+	// Nothing has been received yet.
+	assert.Equal(t, time.Duration(0), m.TimeLag())
+	assert.NoError(t, m.Check())
+
+	m.Update(now.Add(-30 * time.Second))
+	assert.Equal(t, 30*time.Second, m.TimeLag())
+	assert.NoError(t, m.Check())
+
+	// The lag grows while the message isn't processed.
+	fakeClock.Step(time.Minute)
+	assert.Equal(t, 90*time.Second, m.TimeLag())
+	assert.Error(t, m.Check())
+
+	// All messages have been processed.
+	m.Update(time.Time{})
+	assert.Equal(t, time.Duration(0), m.TimeLag())
+	assert.NoError(t, m.Check())
+}
+
+func TestLagMonitor_NoMaxTimeLag(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewLagMonitor("test", 0, nil)
+	m.Clock = clock.NewFakeClock(now)
+	m.Update(now.Add(-time.Hour))
+	assert.Equal(t, time.Hour, m.TimeLag())
+	assert.NoError(t, m.Check())
+}
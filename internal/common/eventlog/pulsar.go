@@ -0,0 +1,76 @@
+package eventlog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+)
+
+// PulsarConsumer is a Consumer reading the event log from a Pulsar subscription.
+type PulsarConsumer struct {
+	Consumer pulsar.Consumer
+}
+
+func NewPulsarConsumer(consumer pulsar.Consumer) *PulsarConsumer {
+	return &PulsarConsumer{Consumer: consumer}
+}
+
+type pulsarMessage struct {
+	pulsar.Message
+}
+
+func (msg pulsarMessage) ID() fmt.Stringer {
+	return msg.Message.ID()
+}
+
+func (c *PulsarConsumer) Receive(ctx context.Context) (Message, error) {
+	msg, err := c.Consumer.Receive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pulsarMessage{Message: msg}, nil
+}
+
+func (c *PulsarConsumer) Ack(msg Message) error {
+	pulsarMsg, ok := msg.(pulsarMessage)
+	if !ok {
+		return errors.Errorf("expected a message received from Pulsar, but got %T", msg)
+	}
+	return c.Consumer.Ack(pulsarMsg.Message)
+}
+
+// Nack marks msg as failed, such that Pulsar redelivers it after the negative ack redelivery delay of the consumer.
+func (c *PulsarConsumer) Nack(msg Message) error {
+	pulsarMsg, ok := msg.(pulsarMessage)
+	if !ok {
+		return errors.Errorf("expected a message received from Pulsar, but got %T", msg)
+	}
+	c.Consumer.Nack(pulsarMsg.Message)
+	return nil
+}
+
+func (c *PulsarConsumer) AckCumulative(msgs []Message) error {
+	// Cumulative acks apply to the partition of the acked message only,
+	// so ack the last message of each partition.
+	lastByPartition := make(map[int32]pulsar.Message)
+	var partitions []int32
+	for _, msg := range msgs {
+		pulsarMsg, ok := msg.(pulsarMessage)
+		if !ok {
+			return errors.Errorf("expected a message received from Pulsar, but got %T", msg)
+		}
+		partition := pulsarMsg.Message.ID().PartitionIdx()
+		if _, ok := lastByPartition[partition]; !ok {
+			partitions = append(partitions, partition)
+		}
+		lastByPartition[partition] = pulsarMsg.Message
+	}
+	for _, partition := range partitions {
+		if err := c.Consumer.AckCumulative(lastByPartition[partition]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
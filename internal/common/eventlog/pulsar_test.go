@@ -0,0 +1,92 @@
+package eventlog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+)
+
+type fakePulsarConsumer struct {
+	pulsar.Consumer
+	messages []pulsar.Message
+	acked    []pulsar.Message
+}
+
+func (c *fakePulsarConsumer) Receive(ctx context.Context) (pulsar.Message, error) {
+	if len(c.messages) == 0 {
+		return nil, context.DeadlineExceeded
+	}
+	msg := c.messages[0]
+	c.messages = c.messages[1:]
+	return msg, nil
+}
+
+func (c *fakePulsarConsumer) Ack(msg pulsar.Message) error {
+	c.acked = append(c.acked, msg)
+	return nil
+}
+
+type otherMessage struct {
+	Message
+}
+
+func TestPulsarConsumer(t *testing.T) {
+	publishTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	pulsarMsg := pulsarutils.NewPulsarMessage(1, publishTime, []byte("payload"))
+	pulsarConsumer := &fakePulsarConsumer{messages: []pulsar.Message{pulsarMsg}}
+	consumer := NewPulsarConsumer(pulsarConsumer)
+
+	msg, err := consumer.Receive(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, pulsarMsg.ID(), msg.ID())
+	assert.Equal(t, []byte("payload"), msg.Payload())
+	assert.Equal(t, publishTime, msg.PublishTime())
+
+	require.NoError(t, consumer.Ack(msg))
+	assert.Equal(t, []pulsar.Message{pulsarMsg}, pulsarConsumer.acked)
+
+	_, err = consumer.Receive(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Error(t, consumer.Ack(otherMessage{}))
+}
+
+func (c *fakePulsarConsumer) AckCumulative(msg pulsar.Message) error {
+	c.acked = append(c.acked, msg)
+	return nil
+}
+
+type partitionedPulsarMessage struct {
+	pulsar.Message
+	id pulsar.MessageID
+}
+
+func (msg partitionedPulsarMessage) ID() pulsar.MessageID {
+	return msg.id
+}
+
+func TestPulsarConsumer_AckCumulative(t *testing.T) {
+	var messages []pulsar.Message
+	for i, partition := range []int32{0, 1, 0, 0, 1} {
+		messages = append(messages, partitionedPulsarMessage{id: pulsar.NewMessageID(1, int64(i), -1, partition)})
+	}
+	pulsarConsumer := &fakePulsarConsumer{messages: messages}
+	consumer := NewPulsarConsumer(pulsarConsumer)
+	var received []Message
+	for range messages {
+		msg, err := consumer.Receive(context.Background())
+		require.NoError(t, err)
+		received = append(received, msg)
+	}
+
+	require.NoError(t, consumer.AckCumulative(received))
+	assert.Equal(t, []pulsar.Message{messages[3], messages[4]}, pulsarConsumer.acked)
+
+	assert.Error(t, consumer.AckCumulative([]Message{otherMessage{}}))
+}
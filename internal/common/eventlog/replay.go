@@ -0,0 +1,228 @@
+package eventlog
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// Replayer re-processes the event log from a given position, e.g., to rebuild a database for disaster recovery
+// or to populate the database of a new environment.
+type Replayer struct {
+	Consumer Consumer
+	// Only messages for which Filter returns true are processed. If nil, all messages are processed.
+	Filter func(msg Message) bool
+	// Process is called with batches of at most BatchSize consecutive event sequences, in the order they were read.
+	Process   func(ctx *armadacontext.Context, sequences []*armadaevents.EventSequence) error
+	BatchSize int
+	// If non-zero, messages published after this time are skipped.
+	Until time.Time
+	// The replay stops once no message has been received for this long, i.e., once it has caught up with the log.
+	IdleTimeout time.Duration
+}
+
+// ReplayStats summarises a replay.
+type ReplayStats struct {
+	NumMessages  int
+	NumSequences int
+	// Id and publish time of the last message replayed.
+	LastMessageId   fmt.Stringer
+	LastPublishTime time.Time
+}
+
+// Run replays the event log until it's caught up or ctx is cancelled.
+// Messages are acked once the batch containing them has been processed.
+func (r *Replayer) Run(ctx *armadacontext.Context) (ReplayStats, error) {
+	var stats ReplayStats
+	var batch []*armadaevents.EventSequence
+	var batchMsgs []Message
+	flush := func() error {
+		if len(batch) > 0 {
+			if err := r.Process(ctx, batch); err != nil {
+				return err
+			}
+		}
+		for _, msg := range batchMsgs {
+			if err := r.Consumer.Ack(msg); err != nil {
+				return err
+			}
+		}
+		stats.NumSequences += len(batch)
+		batch = batch[:0]
+		batchMsgs = batchMsgs[:0]
+		return nil
+	}
+	for {
+		ctxWithTimeout, cancel := armadacontext.WithTimeout(ctx, r.IdleTimeout)
+		msg, err := r.Consumer.Receive(ctxWithTimeout)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return stats, flush()
+		} else if err != nil {
+			return stats, err
+		}
+		batchMsgs = append(batchMsgs, msg)
+		if r.Until.IsZero() || !msg.PublishTime().After(r.Until) {
+			stats.NumMessages++
+			stats.LastMessageId = msg.ID()
+			stats.LastPublishTime = msg.PublishTime()
+			if r.Filter == nil || r.Filter(msg) {
+				ctxWithLogger := armadacontext.WithLogField(ctx, logging.MessageIdField, msg.ID())
+				sequence, err := eventutil.UnmarshalEventSequence(ctxWithLogger, msg.Payload())
+				if err != nil {
+					logging.WithStacktrace(ctxWithLogger, err).Warnf("processing message failed; ignoring")
+				} else {
+					batch = append(batch, sequence)
+				}
+			}
+		}
+		if len(batchMsgs) >= r.BatchSize {
+			if err := flush(); err != nil {
+				return stats, err
+			}
+		}
+	}
+}
+
+// ReplayPosition is the position in the event log at which a replay starts.
+// Exactly one of its fields should be set.
+type ReplayPosition struct {
+	// Replay all partitions from the first message published at or after this time.
+	Time time.Time
+	// Replay the partition of this message from this message onwards.
+	MessageId pulsar.MessageID
+}
+
+// ParsePulsarMessageId parses a message id of the form ledgerId:entryId:partitionIdx[:batchIdx],
+// which is the form in which Pulsar message ids are logged.
+func ParsePulsarMessageId(s string) (pulsar.MessageID, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return nil, errors.Errorf("invalid message id %s: expected ledgerId:entryId:partitionIdx[:batchIdx]", s)
+	}
+	ledgerId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid ledger id in message id %s", s)
+	}
+	entryId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid entry id in message id %s", s)
+	}
+	partitionIdx, err := strconv.ParseInt(parts[2], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid partition index in message id %s", s)
+	}
+	batchIdx := int64(-1)
+	if len(parts) == 4 {
+		if batchIdx, err = strconv.ParseInt(parts[3], 10, 32); err != nil {
+			return nil, errors.Wrapf(err, "invalid batch index in message id %s", s)
+		}
+	}
+	return pulsar.NewMessageID(ledgerId, entryId, int32(batchIdx), int32(partitionIdx)), nil
+}
+
+// NewPulsarReplayConsumer returns a consumer reading topic from the provided position via a temporary subscription,
+// such that the subscriptions of running services are unaffected, together with a function that removes the subscription.
+// Since Pulsar only supports seeking to a message id on individual partitions,
+// replaying from a message id only replays the partition of that message.
+func NewPulsarReplayConsumer(client pulsar.Client, topic string, position ReplayPosition) (*PulsarConsumer, func(), error) {
+	if position.MessageId != nil && position.MessageId.PartitionIdx() >= 0 {
+		partitions, err := client.TopicPartitions(topic)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		if len(partitions) > 1 {
+			if int(position.MessageId.PartitionIdx()) >= len(partitions) {
+				return nil, nil, errors.Errorf("message id %s refers to partition %d, but topic %s has %d partitions", position.MessageId, position.MessageId.PartitionIdx(), topic, len(partitions))
+			}
+			topic = partitions[position.MessageId.PartitionIdx()]
+		}
+	}
+	consumer, err := client.Subscribe(pulsar.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            fmt.Sprintf("replay-%s", uuid.NewString()),
+		Type:                        pulsar.Exclusive,
+		SubscriptionInitialPosition: pulsar.SubscriptionPositionEarliest,
+	})
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	cleanup := func() {
+		if err := consumer.Unsubscribe(); err != nil {
+			logging.WithStacktrace(armadacontext.Background(), err).Warn("failed to remove replay subscription")
+		}
+		consumer.Close()
+	}
+	if position.MessageId != nil {
+		// The consumer reads a single partition, which has index 0 from its point of view.
+		id := position.MessageId
+		err = consumer.Seek(pulsar.NewMessageID(id.LedgerID(), id.EntryID(), id.BatchIdx(), 0))
+	} else if !position.Time.IsZero() {
+		err = consumer.SeekByTime(position.Time)
+	}
+	if err != nil {
+		cleanup()
+		return nil, nil, errors.WithStack(err)
+	}
+	return NewPulsarConsumer(consumer), cleanup, nil
+}
+
+// ReplayOptions configures a replay of the event log.
+type ReplayOptions struct {
+	Position    ReplayPosition
+	Until       time.Time
+	IdleTimeout time.Duration
+	BatchSize   int
+}
+
+// ReplayFromPulsar replays the job set events topic configured in pulsarConfig according to opts,
+// passing the event sequences of messages for which filter returns true to process.
+func ReplayFromPulsar(
+	ctx *armadacontext.Context,
+	pulsarConfig configuration.PulsarConfig,
+	opts ReplayOptions,
+	filter func(msg Message) bool,
+	process func(ctx *armadacontext.Context, sequences []*armadaevents.EventSequence) error,
+) error {
+	client, err := pulsarutils.NewPulsarClient(&pulsarConfig)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	consumer, cleanup, err := NewPulsarReplayConsumer(client, pulsarConfig.JobsetEventsTopic, opts.Position)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	ctx.Infof("replaying topic %s", pulsarConfig.JobsetEventsTopic)
+	replayer := &Replayer{
+		Consumer:    consumer,
+		Filter:      filter,
+		Process:     process,
+		BatchSize:   opts.BatchSize,
+		Until:       opts.Until,
+		IdleTimeout: opts.IdleTimeout,
+	}
+	stats, err := replayer.Run(ctx)
+	ctx.WithFields(logging.Fields{
+		"numMessages":     stats.NumMessages,
+		"numSequences":    stats.NumSequences,
+		"lastMessageId":   stats.LastMessageId,
+		"lastPublishTime": stats.LastPublishTime,
+	}).Info("replay finished")
+	return err
+}
@@ -0,0 +1,79 @@
+package eventlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestReplayer_Run(t *testing.T) {
+	baseTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	var messages []pulsar.Message
+	for i := 0; i < 5; i++ {
+		payload, err := proto.Marshal(&armadaevents.EventSequence{
+			Queue:      "queue",
+			JobSetName: string(rune('a' + i)),
+			Events: []*armadaevents.EventSequence_Event{{
+				Event: &armadaevents.EventSequence_Event_CancelJobSet{CancelJobSet: &armadaevents.CancelJobSet{}},
+			}},
+		})
+		require.NoError(t, err)
+		messages = append(messages, pulsarutils.NewPulsarMessage(i, baseTime.Add(time.Duration(i)*time.Minute), payload))
+	}
+	// Messages that can't be unmarshalled are skipped.
+	messages = append(messages, pulsarutils.NewPulsarMessage(5, baseTime, []byte("invalid")))
+	pulsarConsumer := &fakePulsarConsumer{messages: messages}
+
+	var batches [][]string
+	replayer := &Replayer{
+		Consumer: NewPulsarConsumer(pulsarConsumer),
+		Filter: func(msg Message) bool {
+			return msg.ID() != pulsarutils.NewMessageId(1)
+		},
+		Process: func(_ *armadacontext.Context, sequences []*armadaevents.EventSequence) error {
+			var jobSetNames []string
+			for _, sequence := range sequences {
+				jobSetNames = append(jobSetNames, sequence.JobSetName)
+			}
+			batches = append(batches, jobSetNames)
+			return nil
+		},
+		BatchSize:   2,
+		Until:       baseTime.Add(3 * time.Minute),
+		IdleTimeout: time.Second,
+	}
+	stats, err := replayer.Run(armadacontext.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{{"a"}, {"c", "d"}}, batches)
+	assert.Equal(t, messages, pulsarConsumer.acked)
+	assert.Equal(t, 5, stats.NumMessages)
+	assert.Equal(t, 3, stats.NumSequences)
+	assert.Equal(t, pulsarutils.NewMessageId(5), stats.LastMessageId)
+}
+
+func TestParsePulsarMessageId(t *testing.T) {
+	id, err := ParsePulsarMessageId("12:34:5")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), id.LedgerID())
+	assert.Equal(t, int64(34), id.EntryID())
+	assert.Equal(t, int32(5), id.PartitionIdx())
+	assert.Equal(t, int32(-1), id.BatchIdx())
+
+	id, err = ParsePulsarMessageId("12:34:5:6")
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), id.BatchIdx())
+
+	for _, s := range []string{"", "12:34", "a:34:5", "12:34:5:6:7"} {
+		_, err = ParsePulsarMessageId(s)
+		assert.Error(t, err, s)
+	}
+}
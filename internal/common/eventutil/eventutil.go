@@ -7,7 +7,6 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	"github.com/armadaproject/armada/internal/executor/domain"
@@ -57,6 +57,16 @@ func UnmarshalEventSequence(ctx *armadacontext.Context, payload []byte) (*armada
 		sequence.Groups = make([]string, 0)
 	}
 
+	// Translate sequences produced by older versions of Armada to the schema version understood by this build.
+	// Sequences produced by newer versions are processed on a best-effort basis,
+	// relying on protobuf ignoring unknown fields, rather than being rejected.
+	if sequence.SchemaVersion > CurrentSchemaVersion {
+		recordIncompatibleSequence(sequence.SchemaVersion)
+		ctx.Warnf("sequence has schema version %d, but only versions up to %d are supported; processing on a best-effort basis", sequence.SchemaVersion, CurrentSchemaVersion)
+	} else if err := MigrateEventSequence(sequence, CurrentSchemaVersion); err != nil {
+		return nil, err
+	}
+
 	if sequence.Events == nil {
 		err = &armadaerrors.ErrInvalidArgument{
 			Name:    "Events",
@@ -438,7 +448,7 @@ func CompactEventSequences(sequences []*armadaevents.EventSequence) []*armadaeve
 		if jobSetSequences, ok := sequencesFromJobSetName[jobSetName]; ok {
 			sequences = append(sequences, jobSetSequences...)
 		} else {
-			log.Errorf("no sequence found for jobSetName %s; this should never happen", jobSetName)
+			logging.Errorf("no sequence found for jobSetName %s; this should never happen", jobSetName)
 		}
 	}
 
@@ -635,6 +645,7 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 							Reason: &armadaevents.Error_LeaseExpired{
 								LeaseExpired: &armadaevents.LeaseExpired{},
 							},
+							FailureCategory: armadaevents.FailureCategory_NodeLost,
 						},
 					},
 				},
@@ -711,8 +722,9 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 							},
 							Info: &armadaevents.KubernetesResourceInfo_PodInfo{
 								PodInfo: &armadaevents.PodInfo{
-									NodeName:  m.Running.NodeName,
-									PodNumber: m.Running.PodNumber,
+									NodeName:   m.Running.NodeName,
+									PodNumber:  m.Running.PodNumber,
+									NodeLabels: m.Running.NodeLabels,
 								},
 							},
 						},
@@ -805,7 +817,7 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 			case api.Cause_OOM:
 				containerError.KubernetesReason = armadaevents.KubernetesReason_OOM
 			default:
-				log.Warnf("unknown cause %s on container %s", st.Cause, st.Name)
+				logging.Warnf("unknown cause %s on container %s", st.Cause, st.Name)
 			}
 
 			containerErrors = append(containerErrors, containerError)
@@ -834,9 +846,12 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 		case api.Cause_OOM:
 			podError.KubernetesReason = armadaevents.KubernetesReason_OOM
 		default:
-			log.Warnf("Unknown cause %s for job %s", m.Failed.Cause, m.Failed.JobId)
+			logging.Warnf("Unknown cause %s for job %s", m.Failed.Cause, m.Failed.JobId)
 		}
 
+		// The api and armadaevents failure categories have the same values.
+		failureCategory := armadaevents.FailureCategory(m.Failed.FailureCategory)
+
 		// Event indicating the job run failed.
 		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
 			Created: &m.Failed.Created,
@@ -850,6 +865,7 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 							Reason: &armadaevents.Error_PodError{
 								PodError: podError,
 							},
+							FailureCategory: failureCategory,
 						},
 					},
 				},
@@ -868,6 +884,7 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 							Reason: &armadaevents.Error_PodError{
 								PodError: podError,
 							},
+							FailureCategory: failureCategory,
 						},
 					},
 				},
@@ -1055,6 +1072,7 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 					},
 					MaxResourcesForPeriod: m.Utilisation.MaxResourcesForPeriod,
 					TotalCumulativeUsage:  m.Utilisation.TotalCumulativeUsage,
+					AverageResources:      m.Utilisation.AverageResources,
 				},
 			},
 		})
@@ -1094,6 +1112,44 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 				},
 			},
 		})
+	case *api.EventMessage_Artifacts:
+		sequence.Queue = m.Artifacts.Queue
+		sequence.JobSetName = m.Artifacts.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.Artifacts.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		runId, err := armadaevents.ProtoUuidFromUuidString(m.Artifacts.KubernetesId)
+		if err != nil {
+			return nil, err
+		}
+
+		artifacts := make([]*armadaevents.Artifact, len(m.Artifacts.Artifacts))
+		for i, artifact := range m.Artifacts.Artifacts {
+			artifacts[i] = &armadaevents.Artifact{
+				Name:          artifact.Name,
+				ContainerName: artifact.ContainerName,
+				Url:           artifact.Url,
+				SizeBytes:     artifact.SizeBytes,
+			}
+		}
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.Artifacts.Created,
+			Event: &armadaevents.EventSequence_Event_JobRunArtifacts{
+				JobRunArtifacts: &armadaevents.JobRunArtifacts{
+					RunId: runId,
+					JobId: jobId,
+					ObjectMeta: &armadaevents.ObjectMeta{
+						ExecutorId:   m.Artifacts.ClusterId,
+						KubernetesId: m.Artifacts.KubernetesId,
+					},
+					PodNumber: m.Artifacts.PodNumber,
+					Artifacts: artifacts,
+				},
+			},
+		})
 	case *api.EventMessage_Reprioritizing:
 		// Do nothing; there's no corresponding Pulsar message.
 	case *api.EventMessage_Updated:
@@ -264,7 +264,8 @@ func TestEventSequenceFromApiEvent_Failed(t *testing.T) {
 				Cause:    api.Cause_OOM,
 			},
 		},
-		Cause: api.Cause_DeadlineExceeded,
+		Cause:           api.Cause_DeadlineExceeded,
+		FailureCategory: api.FailureCategory_Deadline,
 	}
 	testEventMessage := api.EventMessage{Events: &api.EventMessage_Failed{Failed: &testEvent}}
 
@@ -309,6 +310,7 @@ func TestEventSequenceFromApiEvent_Failed(t *testing.T) {
 					},
 				},
 			},
+			FailureCategory: armadaevents.FailureCategory_Deadline,
 		},
 	}
 	expectedEvents := []*armadaevents.EventSequence_Event{
@@ -0,0 +1,133 @@
+package eventutil
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// CurrentSchemaVersion is the version of the EventSequence schema understood by this build.
+// Sequences produced before versioning was introduced have version 0.
+//
+// To change the schema in a way older consumers can't handle, increment this version and add a migration
+// translating between the previous and the new version to schemaMigrations.
+// During a rolling upgrade, producers should write the previous version (see SetWriteSchemaVersion)
+// until all consumers understand the new one.
+const CurrentSchemaVersion uint32 = 1
+
+// schemaMigration translates sequences between two adjacent schema versions, in place.
+type schemaMigration struct {
+	// upgrade translates a sequence of the previous version into the version of the migration.
+	upgrade func(sequence *armadaevents.EventSequence) error
+	// downgrade translates a sequence of the version of the migration into the previous version.
+	downgrade func(sequence *armadaevents.EventSequence) error
+}
+
+// schemaMigrations[v] translates between versions v-1 and v.
+var schemaMigrations = map[uint32]schemaMigration{
+	// Version 1 introduced the schema version field and is otherwise identical to version 0.
+	1: {
+		upgrade:   func(*armadaevents.EventSequence) error { return nil },
+		downgrade: func(*armadaevents.EventSequence) error { return nil },
+	},
+}
+
+// ErrIncompatibleSchemaVersion indicates that a sequence can't be translated to the requested schema version,
+// e.g., because it was produced by a newer version of Armada.
+type ErrIncompatibleSchemaVersion struct {
+	Version       uint32
+	TargetVersion uint32
+}
+
+func (err *ErrIncompatibleSchemaVersion) Error() string {
+	return fmt.Sprintf("can't translate event sequence of schema version %d to version %d", err.Version, err.TargetVersion)
+}
+
+// MigrateEventSequence translates sequence, in place, from its schema version to targetVersion.
+func MigrateEventSequence(sequence *armadaevents.EventSequence, targetVersion uint32) error {
+	version := sequence.SchemaVersion
+	for sequence.SchemaVersion < targetVersion {
+		migration, ok := schemaMigrations[sequence.SchemaVersion+1]
+		if !ok {
+			return errors.WithStack(&ErrIncompatibleSchemaVersion{Version: version, TargetVersion: targetVersion})
+		}
+		if err := migration.upgrade(sequence); err != nil {
+			return err
+		}
+		sequence.SchemaVersion++
+	}
+	for sequence.SchemaVersion > targetVersion {
+		migration, ok := schemaMigrations[sequence.SchemaVersion]
+		if !ok {
+			return errors.WithStack(&ErrIncompatibleSchemaVersion{Version: version, TargetVersion: targetVersion})
+		}
+		if err := migration.downgrade(sequence); err != nil {
+			return err
+		}
+		sequence.SchemaVersion--
+	}
+	return nil
+}
+
+// writeSchemaVersion is the schema version sequences are written with by MarshalEventSequence.
+var writeSchemaVersion atomic.Uint32
+
+func init() {
+	writeSchemaVersion.Store(CurrentSchemaVersion)
+}
+
+// SetWriteSchemaVersion sets the schema version with which MarshalEventSequence writes sequences,
+// e.g., to keep writing the previous version during a rolling upgrade. Zero resets it to CurrentSchemaVersion.
+func SetWriteSchemaVersion(version uint32) error {
+	if version == 0 {
+		version = CurrentSchemaVersion
+	}
+	if version > CurrentSchemaVersion {
+		return errors.Errorf("can't write schema version %d; the latest supported version is %d", version, CurrentSchemaVersion)
+	}
+	writeSchemaVersion.Store(version)
+	return nil
+}
+
+// MarshalEventSequence marshals sequence, which must be of CurrentSchemaVersion or unversioned,
+// after translating it to the configured write schema version. Sequence itself isn't modified.
+func MarshalEventSequence(sequence *armadaevents.EventSequence) ([]byte, error) {
+	version := writeSchemaVersion.Load()
+	if sequence.SchemaVersion != version {
+		// Shallow copy, such that setting the version doesn't modify the caller's sequence.
+		// Migrations that modify events must copy them rather than modifying them in place.
+		copied := *sequence
+		if copied.SchemaVersion == 0 {
+			copied.SchemaVersion = CurrentSchemaVersion
+		}
+		if err := MigrateEventSequence(&copied, version); err != nil {
+			return nil, err
+		}
+		sequence = &copied
+	}
+	payload, err := proto.Marshal(sequence)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return payload, nil
+}
+
+var incompatibleSequences = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "armada_event_sequence_incompatible_schema_total",
+		Help: "Number of event sequences read with a schema version newer than the version understood by this build, which are processed on a best-effort basis",
+	},
+	[]string{"version"},
+)
+
+// recordIncompatibleSequence records that a sequence with an unsupported schema version was read.
+func recordIncompatibleSequence(version uint32) {
+	incompatibleSequences.WithLabelValues(strconv.FormatUint(uint64(version), 10)).Inc()
+}
@@ -0,0 +1,76 @@
+package eventutil
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func testSequence(version uint32) *armadaevents.EventSequence {
+	return &armadaevents.EventSequence{
+		Queue:         "queue",
+		JobSetName:    "jobSet",
+		Groups:        []string{},
+		SchemaVersion: version,
+		Events: []*armadaevents.EventSequence_Event{
+			{Event: &armadaevents.EventSequence_Event_CancelJobSet{CancelJobSet: &armadaevents.CancelJobSet{}}},
+		},
+	}
+}
+
+func TestMigrateEventSequence(t *testing.T) {
+	sequence := testSequence(0)
+	require.NoError(t, MigrateEventSequence(sequence, CurrentSchemaVersion))
+	assert.Equal(t, testSequence(CurrentSchemaVersion), sequence)
+
+	require.NoError(t, MigrateEventSequence(sequence, 0))
+	assert.Equal(t, testSequence(0), sequence)
+
+	sequence = testSequence(CurrentSchemaVersion + 1)
+	var incompatibleErr *ErrIncompatibleSchemaVersion
+	assert.ErrorAs(t, MigrateEventSequence(sequence, CurrentSchemaVersion), &incompatibleErr)
+	assert.ErrorAs(t, MigrateEventSequence(testSequence(0), CurrentSchemaVersion+1), &incompatibleErr)
+}
+
+func TestMarshalEventSequence(t *testing.T) {
+	defer func() {
+		require.NoError(t, SetWriteSchemaVersion(0))
+	}()
+	ctx := armadacontext.Background()
+
+	// Unversioned sequences are written with the current version.
+	sequence := testSequence(0)
+	payload, err := MarshalEventSequence(sequence)
+	require.NoError(t, err)
+	assert.Equal(t, testSequence(0), sequence)
+	actual, err := UnmarshalEventSequence(ctx, payload)
+	require.NoError(t, err)
+	assert.Equal(t, testSequence(CurrentSchemaVersion), actual)
+
+	// Sequences written with an older version are upgraded when read.
+	// Zero is interpreted as the current version by SetWriteSchemaVersion, so version 0 is set directly.
+	writeSchemaVersion.Store(CurrentSchemaVersion - 1)
+	payload, err = MarshalEventSequence(sequence)
+	require.NoError(t, err)
+	written := &armadaevents.EventSequence{}
+	require.NoError(t, proto.Unmarshal(payload, written))
+	assert.Equal(t, CurrentSchemaVersion-1, written.SchemaVersion)
+	actual, err = UnmarshalEventSequence(ctx, payload)
+	require.NoError(t, err)
+	assert.Equal(t, testSequence(CurrentSchemaVersion), actual)
+
+	assert.Error(t, SetWriteSchemaVersion(CurrentSchemaVersion+1))
+}
+
+func TestUnmarshalEventSequence_NewerSchemaVersion(t *testing.T) {
+	payload, err := proto.Marshal(testSequence(CurrentSchemaVersion + 1))
+	require.NoError(t, err)
+	actual, err := UnmarshalEventSequence(armadacontext.Background(), payload)
+	require.NoError(t, err)
+	assert.Equal(t, testSequence(CurrentSchemaVersion+1), actual)
+}
@@ -10,11 +10,10 @@ import (
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
-	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
-	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -27,16 +26,20 @@ import (
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/certs"
 	"github.com/armadaproject/armada/internal/common/grpc/configuration"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/requestid"
+	"github.com/armadaproject/armada/internal/common/tracing"
 )
 
 // CreateGrpcServer creates a gRPC server (by calling grpc.NewServer) with settings specific to
 // this project, and registers services for, e.g., logging and authentication.
+// Requests are logged using logger, from which the loggers of the contexts passed to handlers are also derived.
 func CreateGrpcServer(
 	keepaliveParams keepalive.ServerParameters,
 	keepaliveEnforcementPolicy keepalive.EnforcementPolicy,
 	authServices []authorization.AuthService,
 	tlsConfig configuration.TlsConfig,
+	logger logging.Logger,
 ) *grpc.Server {
 	// Logging, authentication, etc. are implemented via gRPC interceptors
 	// (i.e., via functions that are called before handling the actual request).
@@ -50,23 +53,28 @@ func CreateGrpcServer(
 	unaryInterceptors = append(unaryInterceptors, grpc_recovery.UnaryServerInterceptor(recovery))
 	streamInterceptors = append(streamInterceptors, grpc_recovery.StreamServerInterceptor(recovery))
 
-	// Logging (using logrus)
+	// Logging (using zap)
 	// By default, information contained in the request context is logged
 	// tagsExtractor pulls information out of the request payload (a protobuf) and stores it in
 	// the context, such that it is logged.
-	messageDefault := log.NewEntry(log.StandardLogger())
 	tagsExtractor := grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)
 	unaryInterceptors = append(unaryInterceptors,
 		grpc_ctxtags.UnaryServerInterceptor(tagsExtractor),
 		requestid.UnaryServerInterceptor(false),
+	)
+	unaryInterceptors = append(unaryInterceptors, tracing.UnaryServerInterceptors()...)
+	unaryInterceptors = append(unaryInterceptors,
 		armadaerrors.UnaryServerInterceptor(2000),
-		grpc_logrus.UnaryServerInterceptor(messageDefault),
+		grpc_zap.UnaryServerInterceptor(logging.Zap(logger)),
 	)
 	streamInterceptors = append(streamInterceptors,
 		grpc_ctxtags.StreamServerInterceptor(tagsExtractor),
 		requestid.StreamServerInterceptor(false),
+	)
+	streamInterceptors = append(streamInterceptors, tracing.StreamServerInterceptors()...)
+	streamInterceptors = append(streamInterceptors,
 		armadaerrors.StreamServerInterceptor(2000),
-		grpc_logrus.StreamServerInterceptor(messageDefault),
+		grpc_zap.StreamServerInterceptor(logging.Zap(logger)),
 	)
 
 	// Authentication
@@ -113,15 +121,15 @@ func CreateGrpcServer(
 func Listen(port uint16, grpcServer *grpc.Server, wg *sync.WaitGroup) {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil { // TODO Don't call fatal, return an error.
-		log.Fatalf("failed to listen: %v", err)
+		logging.Fatalf("failed to listen: %v", err)
 	}
 
 	go func() {
-		defer log.Println("Stopping server.")
+		defer logging.Info("Stopping server.")
 
-		log.Printf("Grpc listening on %d", port)
+		logging.Infof("Grpc listening on %d", port)
 		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatalf("failed to serve: %v", err)
+			logging.Fatalf("failed to serve: %v", err)
 		}
 
 		wg.Done()
@@ -144,6 +152,6 @@ func CreateShutdownHandler(ctx *armadacontext.Context, gracePeriod time.Duration
 
 // This function is called whenever a gRPC handler panics.
 func panicRecoveryHandler(p interface{}) (err error) {
-	log.Errorf("Request triggered panic with cause %v \n%s", p, string(debug.Stack()))
+	logging.Errorf("Request triggered panic with cause %v \n%s", p, string(debug.Stack()))
 	return status.Errorf(codes.Internal, "Internal server error caused by %v", p)
 }
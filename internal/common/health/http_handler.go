@@ -3,7 +3,7 @@ package health
 import (
 	"net/http"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/armadaproject/armada/internal/common/logging"
 )
 
 // TODO Doesn't need to exist. Just give a Checker directly.
@@ -23,14 +23,14 @@ func NewCheckHttpHandler(checker Checker) *CheckHttpHandler {
 func (h *CheckHttpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	err := h.checker.Check()
 	if err == nil {
-		log.Info("Health check passed")
+		logging.Info("Health check passed")
 		w.WriteHeader(http.StatusNoContent)
 	} else {
-		log.Warnf("Health check failed: %v", err)
+		logging.Warnf("Health check failed: %v", err)
 		w.WriteHeader(http.StatusServiceUnavailable)
 		_, err = w.Write([]byte(err.Error()))
 		if err != nil {
-			log.Errorf("Failed to write health check response: %v", err)
+			logging.Errorf("Failed to write health check response: %v", err)
 		}
 	}
 }
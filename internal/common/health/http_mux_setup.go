@@ -11,3 +11,10 @@ func SetupHttpMux(mux *http.ServeMux, checker Checker) {
 	handler := NewCheckHttpHandler(checker)
 	mux.Handle("/health", handler)
 }
+
+// SetupHealthzHttpMux registers checker at /healthz. Unlike /health, which is intended for liveness and readiness probes,
+// /healthz is intended for alerting, and may fail when the component doesn't need restarting,
+// e.g., when it lags too far behind the event log.
+func SetupHealthzHttpMux(mux *http.ServeMux, checker Checker) {
+	mux.Handle("/healthz", NewCheckHttpHandler(checker))
+}
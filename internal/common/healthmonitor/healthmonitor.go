@@ -2,9 +2,9 @@ package healthmonitor
 
 import (
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
 )
 
 const (
@@ -25,5 +25,5 @@ type HealthMonitor interface {
 	// Run initialises and starts the health checker.
 	// Run may be blocking and should be run within a separate goroutine.
 	// Must be called before IsHealthy() or any prometheus.Collector interface methods.
-	Run(*armadacontext.Context, *logrus.Entry) error
+	Run(*armadacontext.Context, logging.Logger) error
 }
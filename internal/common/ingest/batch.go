@@ -4,7 +4,6 @@ import (
 	"sync"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -40,7 +39,7 @@ func (b *Batcher[T]) Run(ctx *armadacontext.Context) {
 		for appendToBatch := true; appendToBatch; {
 			select {
 			case <-ctx.Done():
-				log.Info("Batcher: context is done")
+				ctx.Info("Batcher: context is done")
 				// context is finished
 				return
 			case value, ok := <-b.input:
@@ -3,17 +3,19 @@ package ingest
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventlog"
 	"github.com/armadaproject/armada/internal/common/eventutil"
 	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/pkg/armadaevents"
@@ -65,7 +67,14 @@ type IngestionPipeline[T HasPulsarMessageIds] struct {
 	msgFilter              func(msg pulsar.Message) bool
 	converter              InstructionConverter[T]
 	sink                   Sink[T]
-	consumer               pulsar.Consumer // for test purposes only
+	consumer               pulsar.Consumer // set by SetConsumer for test purposes only
+	// Set once the pipeline is running, to be reported by DiagnosticsState.
+	running atomic.Pointer[runningState]
+}
+
+type runningState struct {
+	lagMonitor *eventlog.LagMonitor
+	pending    *pendingBatches
 }
 
 // NewIngestionPipeline creates an IngestionPipeline that processes all pulsar messages
@@ -122,9 +131,26 @@ func NewFilteredMsgIngestionPipeline[T HasPulsarMessageIds](
 	}
 }
 
+// SetConsumer sets the consumer the pipeline receives messages from, rather than subscribing to the configured
+// Pulsar cluster when run, e.g., to run the pipeline against an in-memory Pulsar in tests.
+func (ingester *IngestionPipeline[T]) SetConsumer(consumer pulsar.Consumer) {
+	ingester.consumer = consumer
+}
+
 // Run will run the ingestion pipeline until the supplied context is shut down
 func (ingester *IngestionPipeline[T]) Run(ctx *armadacontext.Context) error {
-	shutdownMetricServer := common.ServeMetrics(ingester.metricsConfig.Port)
+	// Report how far the pipeline lags behind Pulsar, and fail /healthz if it lags too far behind.
+	lagMonitor, err := ingester.newLagMonitor()
+	if err != nil {
+		return err
+	}
+	go func() {
+		_ = lagMonitor.Run(ctx)
+	}()
+	pending := &pendingBatches{}
+	ingester.running.Store(&runningState{lagMonitor: lagMonitor, pending: pending})
+
+	shutdownMetricServer := common.ServeMetricsAndHealthz(ingester.metricsConfig.Port, lagMonitor)
 	defer shutdownMetricServer()
 
 	// Waitgroup that wil fire when the pipeline has been torn down
@@ -150,14 +176,10 @@ func (ingester *IngestionPipeline[T]) Run(ctx *armadacontext.Context) error {
 	// This gives the rest of the pipeline a chance to flush pending messages
 	pipelineShutdownContext, cancel := armadacontext.WithCancel(armadacontext.Background())
 	go func() {
-		for {
-			select {
-			case <-ctx.Done():
-				time.Sleep(2 * ingester.pulsarBatchDuration)
-				log.Infof("Waited for %v: forcing cancel", 2*ingester.pulsarBatchDuration)
-				cancel()
-			}
-		}
+		<-ctx.Done()
+		time.Sleep(2 * ingester.pulsarBatchDuration)
+		ctx.Infof("Waited for %v: forcing cancel", 2*ingester.pulsarBatchDuration)
+		cancel()
 	}()
 
 	// Batch up messages
@@ -172,7 +194,8 @@ func (ingester *IngestionPipeline[T]) Run(ctx *armadacontext.Context) error {
 	eventSequences := make(chan *EventSequencesWithIds)
 	go func() {
 		for msg := range batchedMsgs {
-			converted := unmarshalEventSequences(msg, ingester.msgFilter, ingester.metrics)
+			lagMonitor.Update(pending.push(msg))
+			converted := unmarshalEventSequences(ctx, msg, ingester.msgFilter, ingester.metrics)
 			eventSequences <- converted
 		}
 		close(eventSequences)
@@ -197,9 +220,9 @@ func (ingester *IngestionPipeline[T]) Run(ctx *armadacontext.Context) error {
 			err := ingester.sink.Store(pipelineShutdownContext, msg)
 			taken := time.Now().Sub(start)
 			if err != nil {
-				log.WithError(err).Warn("Error inserting messages")
+				ctx.WithError(err).Warn("Error inserting messages")
 			} else {
-				log.Infof("Inserted %d pulsar messages in %dms", len(msg.GetMessageIDs()), taken.Milliseconds())
+				ctx.Infof("Inserted %d pulsar messages in %dms", len(msg.GetMessageIDs()), taken.Milliseconds())
 			}
 			if errors.Is(err, context.DeadlineExceeded) {
 				// This occurs when we're shutting down- it's a signal to stop processing immediately
@@ -210,23 +233,107 @@ func (ingester *IngestionPipeline[T]) Run(ctx *armadacontext.Context) error {
 						armadacontext.Background(),
 						func() error { return ingester.consumer.AckID(msgId) },
 						func(err error) {
-							log.WithError(err).Warnf("Pulsar ack failed; backing off for %s", ingester.pulsarConfig.BackoffTime)
+							ctx.WithError(err).Warnf("Pulsar ack failed; backing off for %s", ingester.pulsarConfig.BackoffTime)
 							time.Sleep(ingester.pulsarConfig.BackoffTime)
 						},
 					)
 				}
+				lagMonitor.Update(pending.pop())
 			}
 		}
 		wg.Done()
 	}()
 
-	log.Info("Ingestion pipeline set up. Running until shutdown event received")
+	ctx.Info("Ingestion pipeline set up. Running until shutdown event received")
 	// wait for a shutdown event
 	wg.Wait()
-	log.Info("Shutdown event received - closing")
+	ctx.Info("Shutdown event received - closing")
 	return nil
 }
 
+// DiagnosticsState returns a snapshot of the state of the pipeline, to be registered with the diagnostics server.
+func (ingester *IngestionPipeline[T]) DiagnosticsState() interface{} {
+	type state struct {
+		Topic             string
+		Subscription      string
+		Running           bool
+		TimeLag           string
+		NumPendingBatches int
+	}
+	rv := state{
+		Topic:        ingester.pulsarConfig.JobsetEventsTopic,
+		Subscription: ingester.pulsarSubscriptionName,
+	}
+	if running := ingester.running.Load(); running != nil {
+		rv.Running = true
+		rv.TimeLag = running.lagMonitor.TimeLag().String()
+		rv.NumPendingBatches = running.pending.len()
+	}
+	return rv
+}
+
+func (ingester *IngestionPipeline[T]) newLagMonitor() (*eventlog.LagMonitor, error) {
+	adminClient, err := pulsarutils.NewAdminClient(&ingester.pulsarConfig)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Error creating pulsar admin client")
+	}
+	var backlog eventlog.BacklogFunc
+	if adminClient != nil {
+		backlog = func(ctx *armadacontext.Context) (int64, error) {
+			return adminClient.SubscriptionBacklog(ctx, ingester.pulsarConfig.JobsetEventsTopic, ingester.pulsarSubscriptionName)
+		}
+	}
+	return eventlog.NewLagMonitor(ingester.pulsarSubscriptionName, ingester.pulsarConfig.MaxConsumerLag, backlog), nil
+}
+
+// pendingBatches tracks the batches of messages received but not yet processed, which are processed in order.
+type pendingBatches struct {
+	mu sync.Mutex
+	// Publish time of the oldest message of each pending batch.
+	oldest []time.Time
+}
+
+// push records that batch has been received and returns the publish time of the oldest message pending.
+func (p *pendingBatches) push(batch []pulsar.Message) time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var oldest time.Time
+	for _, msg := range batch {
+		if publishTime := msg.PublishTime(); oldest.IsZero() || publishTime.Before(oldest) {
+			oldest = publishTime
+		}
+	}
+	p.oldest = append(p.oldest, oldest)
+	return p.oldestPending()
+}
+
+// pop records that the oldest pending batch has been processed and returns the publish time of the oldest message
+// still pending, or the zero time if there's no such message.
+func (p *pendingBatches) pop() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.oldest) > 0 {
+		p.oldest = p.oldest[1:]
+	}
+	return p.oldestPending()
+}
+
+func (p *pendingBatches) len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.oldest)
+}
+
+func (p *pendingBatches) oldestPending() time.Time {
+	var oldest time.Time
+	for _, t := range p.oldest {
+		if oldest.IsZero() || (!t.IsZero() && t.Before(oldest)) {
+			oldest = t
+		}
+	}
+	return oldest
+}
+
 func (ingester *IngestionPipeline[T]) subscribe() (pulsar.Consumer, func(), error) {
 	// Subscribe to Pulsar and receive messages
 	pulsarClient, err := pulsarutils.NewPulsarClient(&ingester.pulsarConfig)
@@ -251,7 +358,7 @@ func (ingester *IngestionPipeline[T]) subscribe() (pulsar.Consumer, func(), erro
 	}, nil
 }
 
-func unmarshalEventSequences(batch []pulsar.Message, msgFilter func(msg pulsar.Message) bool, metrics *commonmetrics.Metrics) *EventSequencesWithIds {
+func unmarshalEventSequences(ctx *armadacontext.Context, batch []pulsar.Message, msgFilter func(msg pulsar.Message) bool, metrics *commonmetrics.Metrics) *EventSequencesWithIds {
 	sequences := make([]*armadaevents.EventSequence, 0, len(batch))
 	messageIds := make([]pulsar.MessageID, len(batch))
 	for i, msg := range batch {
@@ -266,10 +373,10 @@ func unmarshalEventSequences(batch []pulsar.Message, msgFilter func(msg pulsar.M
 		}
 
 		// Try and unmarshall the proto
-		es, err := eventutil.UnmarshalEventSequence(armadacontext.Background(), msg.Payload())
+		es, err := eventutil.UnmarshalEventSequence(ctx, msg.Payload())
 		if err != nil {
 			metrics.RecordPulsarMessageError(commonmetrics.PulsarMessageErrorDeserialization)
-			log.WithError(err).Warnf("Could not unmarshal proto for msg %s", msg.ID())
+			ctx.WithError(err).WithField(logging.MessageIdField, msg.ID()).Warn("Could not unmarshal proto for msg")
 			continue
 		}
 
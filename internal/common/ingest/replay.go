@@ -0,0 +1,14 @@
+package ingest
+
+import (
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// ReplayProcessor returns a function that converts batches of event sequences read when replaying the event log
+// and stores them in sink, in the same way as an IngestionPipeline using converter and sink would.
+func ReplayProcessor[T HasPulsarMessageIds](converter InstructionConverter[T], sink Sink[T]) func(*armadacontext.Context, []*armadaevents.EventSequence) error {
+	return func(ctx *armadacontext.Context, sequences []*armadaevents.EventSequence) error {
+		return sink.Store(ctx, converter.Convert(ctx, &EventSequencesWithIds{EventSequences: sequences}))
+	}
+}
@@ -238,6 +238,45 @@ var JobRunSucceeded = &armadaevents.EventSequence_Event{
 	},
 }
 
+var JobRunArtifacts = &armadaevents.EventSequence_Event{
+	Created: &testfixtures.BaseTime,
+	Event: &armadaevents.EventSequence_Event_JobRunArtifacts{
+		JobRunArtifacts: &armadaevents.JobRunArtifacts{
+			RunId: RunIdProto,
+			JobId: JobIdProto,
+			Artifacts: []*armadaevents.Artifact{
+				{
+					Name:          "log",
+					ContainerName: "main",
+					Url:           "https://bucket/main.log",
+					SizeBytes:     1024,
+				},
+			},
+		},
+	},
+}
+
+var ResourceUtilisation = &armadaevents.EventSequence_Event{
+	Created: &testfixtures.BaseTime,
+	Event: &armadaevents.EventSequence_Event_ResourceUtilisation{
+		ResourceUtilisation: &armadaevents.ResourceUtilisation{
+			RunId: RunIdProto,
+			JobId: JobIdProto,
+			MaxResourcesForPeriod: map[string]resource.Quantity{
+				"cpu":    resource.MustParse("1500m"),
+				"memory": resource.MustParse("2Gi"),
+			},
+			TotalCumulativeUsage: map[string]resource.Quantity{
+				"cpu": resource.MustParse("60"),
+			},
+			AverageResources: map[string]resource.Quantity{
+				"cpu":    resource.MustParse("500m"),
+				"memory": resource.MustParse("1Gi"),
+			},
+		},
+	},
+}
+
 var LeaseReturned = &armadaevents.EventSequence_Event{
 	Created: &testfixtures.BaseTime,
 	Event: &armadaevents.EventSequence_Event_JobRunErrors{
@@ -324,6 +363,19 @@ var JobRequeued = &armadaevents.EventSequence_Event{
 	},
 }
 
+var JobUnschedulable = &armadaevents.EventSequence_Event{
+	Created: &testfixtures.BaseTime,
+	Event: &armadaevents.EventSequence_Event_JobUnschedulable{
+		JobUnschedulable: &armadaevents.JobUnschedulable{
+			JobId:                    JobIdProto,
+			Reason:                   "job does not fit on any node",
+			Pool:                     "cpu",
+			NumNodes:                 10,
+			NumExcludedNodesByReason: map[string]uint32{"insufficient cpu": 8, "node is unschedulable": 2},
+		},
+	},
+}
+
 var PartitionMarker = &armadaevents.EventSequence_Event{
 	Created: &testfixtures.BaseTime,
 	Event: &armadaevents.EventSequence_Event_PartitionMarker{
@@ -445,6 +497,27 @@ var JobRunUnschedulable = &armadaevents.EventSequence_Event{
 	},
 }
 
+var JobRunExecutorStale = &armadaevents.EventSequence_Event{
+	Created: &testfixtures.BaseTime,
+	Event: &armadaevents.EventSequence_Event_JobRunErrors{
+		JobRunErrors: &armadaevents.JobRunErrors{
+			JobId: JobIdProto,
+			RunId: RunIdProto,
+			Errors: []*armadaevents.Error{
+				{
+					Terminal: false,
+					Reason: &armadaevents.Error_ExecutorStale{
+						ExecutorStale: &armadaevents.ExecutorStale{
+							ExecutorId:    ExecutorId,
+							LastHeartbeat: &testfixtures.BaseTime,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
 var JobFailed = &armadaevents.EventSequence_Event{
 	Created: &testfixtures.BaseTime,
 	Event: &armadaevents.EventSequence_Event_JobErrors{
@@ -0,0 +1,177 @@
+package logging
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+// Deduplicator limits how often identical messages are logged, for use in hot loops that may otherwise log thousands
+// of identical warnings per minute, e.g., when a dependency is down. Messages are identical if they're logged at the
+// same level with the same format string, regardless of their arguments and fields.
+//
+// The first occurrence of a message is logged immediately. Further occurrences within Interval are counted but not
+// logged. Once Interval has passed, the suppressed occurrences are summarised by logging the last of them, with fields
+// recording the number of occurrences suppressed and the times of the first and last of those.
+type Deduplicator struct {
+	// Minimum time between logging identical messages.
+	Interval time.Duration
+	// Maximum number of distinct messages tracked. Once reached, messages not already tracked are logged as is.
+	MaxMessages int
+	Clock       clock.Clock
+	mu          sync.Mutex
+	byKey       map[dedupKey]*occurrences
+	lastSwept   time.Time
+}
+
+type dedupKey struct {
+	level  Level
+	format string
+}
+
+// occurrences of a message since it was last logged.
+type occurrences struct {
+	logged          time.Time
+	suppressed      int
+	firstSuppressed time.Time
+	lastSuppressed  time.Time
+	// Logger and message of the last suppressed occurrence, which are used to summarise the suppressed occurrences.
+	log Logger
+	msg string
+}
+
+type summary struct {
+	log   Logger
+	level Level
+	msg   string
+}
+
+// NewDeduplicator returns a Deduplicator logging identical messages at most once per interval.
+func NewDeduplicator(interval time.Duration) *Deduplicator {
+	return &Deduplicator{
+		Interval:    interval,
+		MaxMessages: 1000,
+		Clock:       clock.RealClock{},
+		byKey:       make(map[dedupKey]*occurrences),
+	}
+}
+
+// Errorf logs a message at error level, unless an identical message has been logged within the last Interval.
+func (d *Deduplicator) Errorf(log Logger, format string, args ...interface{}) {
+	d.Logf(log, ErrorLevel, format, args...)
+}
+
+// Warnf logs a message at warn level, unless an identical message has been logged within the last Interval.
+func (d *Deduplicator) Warnf(log Logger, format string, args ...interface{}) {
+	d.Logf(log, WarnLevel, format, args...)
+}
+
+// Infof logs a message at info level, unless an identical message has been logged within the last Interval.
+func (d *Deduplicator) Infof(log Logger, format string, args ...interface{}) {
+	d.Logf(log, InfoLevel, format, args...)
+}
+
+// Logf logs a message at the provided level, unless an identical message has been logged within the last Interval.
+func (d *Deduplicator) Logf(log Logger, level Level, format string, args ...interface{}) {
+	now := d.Clock.Now()
+	msg := fmt.Sprintf(format, args...)
+	key := dedupKey{level: level, format: format}
+
+	d.mu.Lock()
+	summaries := d.sweep(now)
+	o, ok := d.byKey[key]
+	if ok && now.Sub(o.logged) < d.Interval {
+		if o.suppressed == 0 {
+			o.firstSuppressed = now
+		}
+		o.suppressed++
+		o.lastSuppressed = now
+		o.log = log
+		o.msg = msg
+		d.mu.Unlock()
+		logSummaries(summaries)
+		return
+	}
+	var fields Fields
+	if ok && o.suppressed > 0 {
+		fields = o.suppressedFields()
+	}
+	if ok || len(d.byKey) < d.MaxMessages {
+		d.byKey[key] = &occurrences{logged: now}
+	}
+	d.mu.Unlock()
+
+	logSummaries(summaries)
+	logAt(log.WithFields(fields), level, msg)
+}
+
+// Flush logs a summary of each message of which occurrences have been suppressed since it was last logged.
+func (d *Deduplicator) Flush() {
+	d.mu.Lock()
+	var summaries []summary
+	for key, o := range d.byKey {
+		if o.suppressed > 0 {
+			summaries = append(summaries, o.summary(key.level))
+		}
+		delete(d.byKey, key)
+	}
+	d.mu.Unlock()
+	logSummaries(summaries)
+}
+
+// sweep stops tracking messages last logged more than Interval ago, and returns summaries of the occurrences of those
+// messages suppressed since. To bound the cost of sweeping, messages are swept at most once per Interval.
+func (d *Deduplicator) sweep(now time.Time) []summary {
+	if now.Sub(d.lastSwept) < d.Interval {
+		return nil
+	}
+	d.lastSwept = now
+	var summaries []summary
+	for key, o := range d.byKey {
+		if now.Sub(o.logged) < d.Interval {
+			continue
+		}
+		if o.suppressed > 0 {
+			summaries = append(summaries, o.summary(key.level))
+		}
+		delete(d.byKey, key)
+	}
+	return summaries
+}
+
+func (o *occurrences) summary(level Level) summary {
+	return summary{
+		log:   o.log.WithFields(o.suppressedFields()),
+		level: level,
+		msg:   o.msg,
+	}
+}
+
+func (o *occurrences) suppressedFields() Fields {
+	return Fields{
+		"suppressed":      o.suppressed,
+		"firstSuppressed": o.firstSuppressed,
+		"lastSuppressed":  o.lastSuppressed,
+	}
+}
+
+func logSummaries(summaries []summary) {
+	for _, s := range summaries {
+		logAt(s.log, s.level, s.msg)
+	}
+}
+
+func logAt(log Logger, level Level, msg string) {
+	switch level {
+	case DebugLevel:
+		log.Debug(msg)
+	case InfoLevel:
+		log.Info(msg)
+	case WarnLevel:
+		log.Warn(msg)
+	default:
+		log.Error(msg)
+	}
+}
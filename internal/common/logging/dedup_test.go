@@ -0,0 +1,133 @@
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+func TestDeduplicator(t *testing.T) {
+	logger, logs := newObservedLogger()
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFakeClock(start)
+	d := NewDeduplicator(time.Minute)
+	d.Clock = fakeClock
+
+	// The first occurrence is logged, and identical messages within the interval suppressed.
+	d.Warnf(logger, "job %s failed", "a")
+	fakeClock.Step(time.Second)
+	d.Warnf(logger, "job %s failed", "b")
+	fakeClock.Step(time.Second)
+	d.Warnf(logger, "job %s failed", "c")
+	require.Len(t, logs.All(), 1)
+	assert.Equal(t, "job a failed", lastEntry(logs).Message)
+	assert.Equal(t, WarnLevel, lastEntry(logs).Level)
+	assert.NotContains(t, lastEntry(logs).ContextMap(), "suppressed")
+
+	// Messages with a different format or level aren't suppressed.
+	d.Warnf(logger, "something else")
+	d.Errorf(logger, "job %s failed", "d")
+	require.Len(t, logs.All(), 3)
+
+	// Once the interval has passed, the suppressed occurrences are summarised by logging the last of them.
+	fakeClock.Step(time.Minute)
+	d.Warnf(logger, "job %s failed", "e")
+	entries := logs.All()[3:]
+	require.Len(t, entries, 2)
+	assert.Equal(t, "job c failed", entries[0].Message)
+	assert.Equal(t, int64(2), entries[0].ContextMap()["suppressed"])
+	assert.Equal(t, start.Add(time.Second), entries[0].ContextMap()["firstSuppressed"])
+	assert.Equal(t, start.Add(2*time.Second), entries[0].ContextMap()["lastSuppressed"])
+	assert.Equal(t, "job e failed", entries[1].Message)
+	assert.NotContains(t, entries[1].ContextMap(), "suppressed")
+}
+
+func TestDeduplicator_SummarisesWithNextOccurrence(t *testing.T) {
+	logger, logs := newObservedLogger()
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFakeClock(start)
+	d := NewDeduplicator(time.Minute)
+	d.Clock = fakeClock
+
+	d.Warnf(logger, "something else")
+	fakeClock.Step(30 * time.Second)
+	d.Warnf(logger, "job %s failed", "a")
+	fakeClock.Step(30 * time.Second)
+	d.Warnf(logger, "job %s failed", "b")
+
+	// The interval has passed since the message was logged, but messages haven't been swept since,
+	// so the suppressed occurrence is recorded by the next occurrence.
+	fakeClock.Step(35 * time.Second)
+	d.Warnf(logger, "job %s failed", "c")
+	require.Len(t, logs.All(), 3)
+	assert.Equal(t, "job c failed", lastEntry(logs).Message)
+	assert.Equal(t, int64(1), lastEntry(logs).ContextMap()["suppressed"])
+	assert.Equal(t, start.Add(time.Minute), lastEntry(logs).ContextMap()["lastSuppressed"])
+}
+
+func TestDeduplicator_SummarisesWhenSwept(t *testing.T) {
+	logger, logs := newObservedLogger()
+	fakeClock := clock.NewFakeClock(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	d := NewDeduplicator(time.Minute)
+	d.Clock = fakeClock
+
+	d.Warnf(logger, "job %s failed", "a")
+	d.Warnf(logger, "job %s failed", "b")
+	fakeClock.Step(2 * time.Minute)
+
+	// Logging any message summarises the occurrences of messages not logged for more than the interval.
+	d.Warnf(logger, "something else")
+	require.Len(t, logs.All(), 3)
+	summary := logs.All()[1]
+	assert.Equal(t, "job b failed", summary.Message)
+	assert.Equal(t, int64(1), summary.ContextMap()["suppressed"])
+}
+
+func TestDeduplicator_Flush(t *testing.T) {
+	logger, logs := newObservedLogger()
+	d := NewDeduplicator(time.Minute)
+
+	d.Warnf(logger, "job %s failed", "a")
+	d.Warnf(logger, "job %s failed", "b")
+	d.Warnf(logger, "job %s failed", "c")
+	d.Flush()
+	require.Len(t, logs.All(), 2)
+	assert.Equal(t, "job c failed", lastEntry(logs).Message)
+	assert.Equal(t, int64(2), lastEntry(logs).ContextMap()["suppressed"])
+
+	// Nothing is suppressed after flushing.
+	d.Flush()
+	require.Len(t, logs.All(), 2)
+	d.Warnf(logger, "job %s failed", "d")
+	require.Len(t, logs.All(), 3)
+}
+
+func TestDeduplicator_MaxMessages(t *testing.T) {
+	logger, logs := newObservedLogger()
+	d := NewDeduplicator(time.Minute)
+	d.MaxMessages = 1
+
+	d.Warnf(logger, "a")
+	d.Warnf(logger, "b")
+	d.Warnf(logger, "b")
+	// Only the first message is tracked; others are logged as is.
+	assert.Len(t, logs.All(), 3)
+	d.Warnf(logger, "a")
+	assert.Len(t, logs.All(), 3)
+}
+
+// newObservedLogger returns a logger recording the messages logged.
+func newObservedLogger() (Logger, *observer.ObservedLogs) {
+	core, logs := observer.New(DebugLevel)
+	return FromZap(zap.New(core)), logs
+}
+
+func lastEntry(logs *observer.ObservedLogs) observer.LoggedEntry {
+	entries := logs.All()
+	return entries[len(entries)-1]
+}
@@ -5,10 +5,11 @@ import (
 	"testing"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/renstrom/shortuuid"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
@@ -21,13 +22,12 @@ func TestUnaryServerInterceptor(t *testing.T) {
 	id := shortuuid.New()
 	ctx, ok := requestid.AddToIncomingContext(ctx, id)
 	require.True(t, ok, "error adding request id to context")
-	logger := logrus.New()
-	entry := logrus.NewEntry(logger)
-	ctx = ctxlogrus.ToContext(ctx, entry)
+	core, logs := observer.New(DebugLevel)
+	ctx = ctxzap.ToContext(ctx, zap.New(core))
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		entry := ctxlogrus.Extract(ctx)
-		for _, field := range entry.Data {
-			if s, ok := field.(string); ok && s == id {
+		ctxzap.Extract(ctx).Info("handling request")
+		for _, field := range logs.All()[len(logs.All())-1].Context {
+			if field.String == id {
 				return nil, nil
 			}
 		}
@@ -47,16 +47,15 @@ func TestStreamServerInterceptor(t *testing.T) {
 	ctx, ok := requestid.AddToIncomingContext(ctx, id)
 	require.True(t, ok, "error adding request id to context")
 
-	logger := logrus.New()
-	entry := logrus.NewEntry(logger)
-	ctx = ctxlogrus.ToContext(ctx, entry)
+	core, logs := observer.New(DebugLevel)
+	ctx = ctxzap.ToContext(ctx, zap.New(core))
 	stream := &grpc_middleware.WrappedServerStream{}
 	stream.WrappedContext = ctx
 	handler := func(srv interface{}, stream grpc.ServerStream) error {
 		ctx := stream.Context()
-		entry := ctxlogrus.Extract(ctx)
-		for _, field := range entry.Data {
-			if s, ok := field.(string); ok && s == id {
+		ctxzap.Extract(ctx).Info("handling request")
+		for _, field := range logs.All()[len(logs.All())-1].Context {
+			if field.String == id {
 				return nil
 			}
 		}
@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// ErrorField is the name of the field WithError adds errors as.
+const ErrorField = "error"
+
+// Logger logs messages with fields, e.g., identifying the job a message is about.
+// Loggers are obtained from ForModule or StandardLogger, or from the context passed to a function.
+type Logger interface {
+	Debug(args ...any)
+	Debugf(format string, args ...any)
+	Info(args ...any)
+	Infof(format string, args ...any)
+	Warn(args ...any)
+	Warnf(format string, args ...any)
+	Error(args ...any)
+	Errorf(format string, args ...any)
+	// WithField returns a logger adding the provided field to each message.
+	WithField(key string, value any) Logger
+	// WithFields returns a logger adding the provided fields to each message.
+	WithFields(fields Fields) Logger
+	// WithError returns a logger adding the provided error to each message.
+	WithError(err error) Logger
+}
+
+// Fields of a message, keyed by name.
+type Fields map[string]any
+
+// NullLogger discards all messages.
+var NullLogger Logger = zapLogger{logger: zap.NewNop().Sugar()}
+
+// FromZap returns a Logger writing to the provided zap logger, e.g., the logger of a gRPC request,
+// or one recording messages in tests.
+func FromZap(logger *zap.Logger) Logger {
+	// Skip the frame of the zapLogger method, such that messages are attributed to the caller of the Logger.
+	return zapLogger{logger: logger.WithOptions(zap.AddCallerSkip(1)).Sugar()}
+}
+
+// Zap returns the zap logger underlying logger, for use with libraries integrating with zap directly.
+// Loggers not created by this package are replaced by a logger discarding all messages.
+func Zap(logger Logger) *zap.Logger {
+	if l, ok := logger.(zapLogger); ok {
+		return l.logger.Desugar().WithOptions(zap.AddCallerSkip(-1))
+	}
+	return zap.NewNop()
+}
+
+// zapLogger is the Logger implementation backed by zap.
+type zapLogger struct {
+	logger *zap.SugaredLogger
+}
+
+func (l zapLogger) Debug(args ...any) {
+	l.logger.Debug(args...)
+}
+
+func (l zapLogger) Debugf(format string, args ...any) {
+	l.logger.Debugf(format, args...)
+}
+
+func (l zapLogger) Info(args ...any) {
+	l.logger.Info(args...)
+}
+
+func (l zapLogger) Infof(format string, args ...any) {
+	l.logger.Infof(format, args...)
+}
+
+func (l zapLogger) Warn(args ...any) {
+	l.logger.Warn(args...)
+}
+
+func (l zapLogger) Warnf(format string, args ...any) {
+	l.logger.Warnf(format, args...)
+}
+
+func (l zapLogger) Error(args ...any) {
+	l.logger.Error(args...)
+}
+
+func (l zapLogger) Errorf(format string, args ...any) {
+	l.logger.Errorf(format, args...)
+}
+
+func (l zapLogger) fatal(args ...any) {
+	l.logger.Fatal(args...)
+}
+
+func (l zapLogger) fatalf(format string, args ...any) {
+	l.logger.Fatalf(format, args...)
+}
+
+func (l zapLogger) WithField(key string, value any) Logger {
+	return zapLogger{logger: l.logger.Desugar().With(field(key, value)).Sugar()}
+}
+
+func (l zapLogger) WithFields(fields Fields) Logger {
+	if len(fields) == 0 {
+		return l
+	}
+	// Sort fields by name, such that they're written in the same order each time.
+	keys := maps.Keys(fields)
+	slices.Sort(keys)
+	zapFields := make([]zap.Field, len(keys))
+	for i, key := range keys {
+		zapFields[i] = field(key, fields[key])
+	}
+	return zapLogger{logger: l.logger.Desugar().With(zapFields...).Sugar()}
+}
+
+func (l zapLogger) WithError(err error) Logger {
+	return l.WithField(ErrorField, err)
+}
+
+func field(key string, value any) zap.Field {
+	// zap writes errors implementing fmt.Formatter, e.g., those of pkg/errors, with their stack trace.
+	// Write only the message instead; stack traces are added explicitly with WithStacktrace.
+	if err, ok := value.(error); ok {
+		return zap.String(key, err.Error())
+	}
+	return zap.Any(key, value)
+}
@@ -0,0 +1,103 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogger_JSON(t *testing.T) {
+	resetModules(t)
+	// Loggers created before the output is set write to the new output.
+	logger := ForModule("scheduler")
+	var buf bytes.Buffer
+	require.NoError(t, SetOutput(&buf, JSONFormat))
+
+	logger.
+		WithFields(JobFields("queue", "jobSet", "jobId")).
+		WithError(errors.New("boom")).
+		Warnf("job %s failed", "jobId")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "job jobId failed", entry["msg"])
+	assert.Equal(t, "warn", entry["level"])
+	assert.Equal(t, "scheduler", entry[ModuleField])
+	assert.Equal(t, "queue", entry[QueueField])
+	assert.Equal(t, "jobSet", entry[JobSetField])
+	assert.Equal(t, "jobId", entry[JobIdField])
+	// Only the message of the error is logged; stack traces are added with WithStacktrace.
+	assert.Equal(t, "boom", entry[ErrorField])
+	assert.NotContains(t, entry, "errorVerbose")
+	// Messages are attributed to the caller of the logger.
+	assert.True(t, strings.HasPrefix(entry["file"].(string), "logging/logger_test.go:"), entry["file"])
+}
+
+func TestLogger_Text(t *testing.T) {
+	resetModules(t)
+	var buf bytes.Buffer
+	require.NoError(t, SetOutput(&buf, TextFormat))
+
+	StandardLogger().WithField(QueueField, "queue").Info("hello")
+	assert.Contains(t, buf.String(), "INFO")
+	assert.Contains(t, buf.String(), "hello")
+	assert.Contains(t, buf.String(), `{"queue": "queue"}`)
+}
+
+func TestLogger_CommandLine(t *testing.T) {
+	resetModules(t)
+	var buf bytes.Buffer
+	require.NoError(t, SetOutput(&buf, CommandLineFormat))
+
+	StandardLogger().WithField(QueueField, "queue").Infof("submitted %d jobs", 2)
+	assert.Equal(t, "submitted 2 jobs\n", buf.String())
+}
+
+func TestSetOutput_UnknownFormat(t *testing.T) {
+	assert.Error(t, SetOutput(&bytes.Buffer{}, "loud"))
+}
+
+func TestAddHook(t *testing.T) {
+	resetModules(t)
+	require.NoError(t, SetOutput(&bytes.Buffer{}, JSONFormat))
+	var levels []Level
+	AddHook(func(entry zapcore.Entry) error {
+		levels = append(levels, entry.Level)
+		return nil
+	})
+
+	logger := ForModule("scheduler")
+	logger.Debug("not logged")
+	logger.Info("logged")
+	logger.Error("logged")
+	assert.Equal(t, []Level{InfoLevel, ErrorLevel}, levels)
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := map[string]Level{
+		"debug":   DebugLevel,
+		"trace":   DebugLevel,
+		"INFO":    InfoLevel,
+		"warn":    WarnLevel,
+		"warning": WarnLevel,
+		"error":   ErrorLevel,
+	}
+	for s, expected := range tests {
+		t.Run(s, func(t *testing.T) {
+			level, err := ParseLevel(s)
+			require.NoError(t, err)
+			assert.Equal(t, expected, level)
+		})
+	}
+
+	_, err := ParseLevel("")
+	assert.Error(t, err)
+	_, err = ParseLevel("loud")
+	assert.Error(t, err)
+}
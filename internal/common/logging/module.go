@@ -0,0 +1,245 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Names of the fields identifying the objects a log message is about. Components should use these,
+// such that the logs of a job, say, can be found across the server, scheduler, and ingesters.
+const (
+	QueueField     = "queue"
+	JobSetField    = "jobSet"
+	JobIdField     = "jobId"
+	RunIdField     = "runId"
+	MessageIdField = "messageId"
+	ModuleField    = "module"
+)
+
+// JobFields returns the fields identifying a job.
+func JobFields(queue string, jobSet string, jobId string) Fields {
+	return Fields{
+		QueueField:  queue,
+		JobSetField: jobSet,
+		JobIdField:  jobId,
+	}
+}
+
+// Level of a message, e.g., InfoLevel.
+type Level = zapcore.Level
+
+const (
+	DebugLevel = zapcore.DebugLevel
+	InfoLevel  = zapcore.InfoLevel
+	WarnLevel  = zapcore.WarnLevel
+	ErrorLevel = zapcore.ErrorLevel
+)
+
+// Module loggers share the output of the standard logger, but each has its own level,
+// such that, e.g., debug logging can be enabled for the scheduler without enabling it for everything else.
+var modules = struct {
+	mu sync.Mutex
+	// Level of the standard logger, which is also the level of each module whose level isn't overridden.
+	level zap.AtomicLevel
+	// Logger of each module, created on first use.
+	loggers map[string]Logger
+	// Level of each module created.
+	levels map[string]zap.AtomicLevel
+	// Level of each module overriding the level of the standard logger.
+	overrides map[string]Level
+}{
+	level:     zap.NewAtomicLevelAt(InfoLevel),
+	loggers:   make(map[string]Logger),
+	levels:    make(map[string]zap.AtomicLevel),
+	overrides: make(map[string]Level),
+}
+
+var standardLogger = newLogger(modules.level)
+
+// StandardLogger returns the logger for messages not belonging to any module, which logs at the standard level.
+func StandardLogger() Logger {
+	return standardLogger
+}
+
+// ForModule returns a logger for the named module, e.g., "scheduler", which adds the module name to each message.
+// Messages are logged at the level set for the module with SetModuleLevel, or at the level of the standard logger.
+func ForModule(module string) Logger {
+	modules.mu.Lock()
+	defer modules.mu.Unlock()
+	logger, ok := modules.loggers[module]
+	if !ok {
+		level := zap.NewAtomicLevelAt(modules.level.Level())
+		if override, ok := modules.overrides[module]; ok {
+			level.SetLevel(override)
+		}
+		logger = newLogger(level).WithField(ModuleField, module)
+		modules.levels[module] = level
+		modules.loggers[module] = logger
+	}
+	return logger
+}
+
+func newLogger(level zapcore.LevelEnabler) Logger {
+	return FromZap(zap.New(&levelCore{LevelEnabler: level}, zap.AddCaller()))
+}
+
+// GetLevel returns the level of the standard logger.
+func GetLevel() Level {
+	return modules.level.Level()
+}
+
+// SetLevel sets the level of the standard logger and of all modules whose level isn't overridden.
+// The level of the logrus standard logger, used by components not yet logging through this package, is set too.
+func SetLevel(level Level) {
+	modules.mu.Lock()
+	defer modules.mu.Unlock()
+	modules.level.SetLevel(level)
+	logrus.SetLevel(LogrusLevel(level))
+	for module, moduleLevel := range modules.levels {
+		if _, ok := modules.overrides[module]; !ok {
+			moduleLevel.SetLevel(level)
+		}
+	}
+}
+
+// SetModuleLevel overrides the level of the named module, which need not have been created yet.
+func SetModuleLevel(module string, level Level) {
+	modules.mu.Lock()
+	defer modules.mu.Unlock()
+	modules.overrides[module] = level
+	if moduleLevel, ok := modules.levels[module]; ok {
+		moduleLevel.SetLevel(level)
+	}
+}
+
+// ResetModuleLevel removes the override of the level of the named module, which reverts to the standard level.
+func ResetModuleLevel(module string) {
+	modules.mu.Lock()
+	defer modules.mu.Unlock()
+	delete(modules.overrides, module)
+	if moduleLevel, ok := modules.levels[module]; ok {
+		moduleLevel.SetLevel(modules.level.Level())
+	}
+}
+
+// ModuleLevels returns the level of each module created or whose level is overridden.
+func ModuleLevels() map[string]Level {
+	modules.mu.Lock()
+	defer modules.mu.Unlock()
+	rv := make(map[string]Level, len(modules.levels)+len(modules.overrides))
+	for module, level := range modules.levels {
+		rv[module] = level.Level()
+	}
+	for module, level := range modules.overrides {
+		rv[module] = level
+	}
+	return rv
+}
+
+// ParseLevel parses the name of a level, e.g., "debug".
+// For compatibility with logrus, "trace" is parsed as debug and "warning" as warn.
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return InfoLevel, errors.New("empty log level")
+	case "trace":
+		return DebugLevel, nil
+	case "warning":
+		return WarnLevel, nil
+	}
+	var level Level
+	if err := level.UnmarshalText([]byte(strings.ToLower(s))); err != nil {
+		return InfoLevel, errors.WithStack(err)
+	}
+	return level, nil
+}
+
+// ParseModuleLevels parses a comma-separated list of module levels, e.g., "scheduler=debug,ingester=warn".
+func ParseModuleLevels(s string) (map[string]Level, error) {
+	rv := make(map[string]Level)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		module, levelStr, ok := strings.Cut(part, "=")
+		if !ok || module == "" {
+			return nil, errors.Errorf("invalid module level %q; expected <module>=<level>", part)
+		}
+		level, err := ParseLevel(levelStr)
+		if err != nil {
+			return nil, err
+		}
+		rv[module] = level
+	}
+	return rv, nil
+}
+
+// LogrusLevel returns the logrus level corresponding to level, for components not yet logging through this package.
+func LogrusLevel(level Level) logrus.Level {
+	switch {
+	case level <= DebugLevel:
+		return logrus.DebugLevel
+	case level == InfoLevel:
+		return logrus.InfoLevel
+	case level == WarnLevel:
+		return logrus.WarnLevel
+	default:
+		return logrus.ErrorLevel
+	}
+}
+
+type levelsResponse struct {
+	Level   string
+	Modules map[string]string
+}
+
+// LevelHandler returns a handler for inspecting and changing log levels at runtime.
+// GET returns the standard level and the level of each module.
+// PUT or POST with query parameters "level" and, optionally, "module" sets the level of the module,
+// or the standard level if no module is provided; level "default" removes the override of the module's level.
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			module := r.URL.Query().Get("module")
+			levelStr := r.URL.Query().Get("level")
+			if module != "" && levelStr == "default" {
+				ResetModuleLevel(module)
+				break
+			}
+			level, err := ParseLevel(levelStr)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if module == "" {
+				SetLevel(level)
+			} else {
+				SetModuleLevel(module, level)
+			}
+			StandardLogger().WithFields(Fields{ModuleField: module, "level": level}).Info("log level changed")
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		response := levelsResponse{Level: GetLevel().String(), Modules: make(map[string]string)}
+		for module, level := range ModuleLevels() {
+			response.Modules[module] = level.String()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
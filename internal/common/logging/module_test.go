@@ -0,0 +1,95 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestForModule_Levels(t *testing.T) {
+	resetModules(t)
+	var buf bytes.Buffer
+	require.NoError(t, SetOutput(&buf, JSONFormat))
+	SetLevel(InfoLevel)
+
+	SetModuleLevel("a", DebugLevel)
+	a := ForModule("a")
+	b := ForModule("b")
+	a.Debug("from a")
+	b.Debug("from b")
+	require.Equal(t, 1, bytes.Count(buf.Bytes(), []byte("\n")))
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "from a", entry["msg"])
+	assert.Equal(t, "a", entry[ModuleField])
+
+	// Changing the standard level applies to modules whose level isn't overridden.
+	buf.Reset()
+	SetLevel(DebugLevel)
+	b.Debug("from b")
+	assert.Contains(t, buf.String(), "from b")
+
+	ResetModuleLevel("a")
+	SetLevel(WarnLevel)
+	buf.Reset()
+	a.Info("from a")
+	assert.Empty(t, buf.String())
+}
+
+func TestParseModuleLevels(t *testing.T) {
+	levels, err := ParseModuleLevels("scheduler=debug, ingester=warn,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Level{"scheduler": DebugLevel, "ingester": WarnLevel}, levels)
+
+	_, err = ParseModuleLevels("scheduler")
+	assert.Error(t, err)
+	_, err = ParseModuleLevels("scheduler=loud")
+	assert.Error(t, err)
+}
+
+func TestLevelHandler(t *testing.T) {
+	resetModules(t)
+	SetLevel(InfoLevel)
+	handler := LevelHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/loglevel?module=scheduler&level=debug", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response levelsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "info", response.Level)
+	assert.Equal(t, "debug", response.Modules["scheduler"])
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/loglevel?module=scheduler&level=default", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, InfoLevel, ModuleLevels()["scheduler"])
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/loglevel?level=loud", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/debug/loglevel", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+// resetModules restores the output and level of the standard logger and removes all module loggers once the test finishes.
+func resetModules(t *testing.T) {
+	output, level := currentOutput.Load(), GetLevel()
+	t.Cleanup(func() {
+		currentOutput.Store(output)
+		modules.mu.Lock()
+		modules.loggers = make(map[string]Logger)
+		modules.levels = make(map[string]zap.AtomicLevel)
+		modules.overrides = make(map[string]Level)
+		modules.mu.Unlock()
+		SetLevel(level)
+	})
+}
@@ -0,0 +1,165 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"go.uber.org/zap/zapcore"
+)
+
+// Formats messages can be written in.
+const (
+	// Human-readable format with coloured levels.
+	ColourfulFormat = "colourful"
+	// Human-readable format.
+	TextFormat = "text"
+	// One JSON object per message.
+	JSONFormat = "json"
+	// Only the message, without time, level, or fields, for command-line tools.
+	CommandLineFormat = "commandline"
+)
+
+// RFC3339Millis
+const timestampFormat = "2006-01-02T15:04:05.999Z07:00"
+
+// output is where the messages of all loggers are written to. Loggers look up the current output for each message,
+// such that loggers created before SetOutput is called, e.g., in package initialisers, write to the new output too.
+type output struct {
+	core  zapcore.Core
+	hooks []func(zapcore.Entry) error
+}
+
+var (
+	// Held while replacing the output, such that concurrent changes aren't lost.
+	outputMu      sync.Mutex
+	currentOutput atomic.Pointer[output]
+)
+
+func init() {
+	core, err := newCore(os.Stderr, TextFormat)
+	if err != nil {
+		panic(err)
+	}
+	currentOutput.Store(&output{core: core})
+}
+
+// SetOutput sets the writer messages are written to and the format they're written in; see the Format constants.
+func SetOutput(w io.Writer, format string) error {
+	core, err := newCore(w, format)
+	if err != nil {
+		return err
+	}
+	outputMu.Lock()
+	defer outputMu.Unlock()
+	currentOutput.Store(&output{core: core, hooks: currentOutput.Load().hooks})
+	return nil
+}
+
+// AddHook adds a function called for each message written, e.g., to count messages by level.
+func AddHook(hook func(zapcore.Entry) error) {
+	outputMu.Lock()
+	defer outputMu.Unlock()
+	current := currentOutput.Load()
+	hooks := append(current.hooks[:len(current.hooks):len(current.hooks)], hook)
+	currentOutput.Store(&output{core: current.core, hooks: hooks})
+}
+
+func newCore(w io.Writer, format string) (zapcore.Core, error) {
+	ws := zapcore.Lock(zapcore.AddSync(w))
+	config := zapcore.EncoderConfig{
+		TimeKey:          "time",
+		LevelKey:         "level",
+		MessageKey:       "msg",
+		CallerKey:        "file",
+		LineEnding:       zapcore.DefaultLineEnding,
+		EncodeTime:       zapcore.TimeEncoderOfLayout(timestampFormat),
+		EncodeLevel:      zapcore.CapitalLevelEncoder,
+		EncodeDuration:   zapcore.StringDurationEncoder,
+		EncodeCaller:     zapcore.ShortCallerEncoder,
+		ConsoleSeparator: " ",
+	}
+	// Levels are enforced by the cores of loggers, such that the output itself writes all messages.
+	switch strings.ToLower(format) {
+	case ColourfulFormat:
+		config.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		return zapcore.NewCore(zapcore.NewConsoleEncoder(config), ws, zapcore.DebugLevel), nil
+	case TextFormat:
+		return zapcore.NewCore(zapcore.NewConsoleEncoder(config), ws, zapcore.DebugLevel), nil
+	case JSONFormat:
+		config.EncodeLevel = zapcore.LowercaseLevelEncoder
+		return zapcore.NewCore(zapcore.NewJSONEncoder(config), ws, zapcore.DebugLevel), nil
+	case CommandLineFormat:
+		return commandLineCore{out: ws}, nil
+	default:
+		return nil, errors.Errorf("unknown log format %q", format)
+	}
+}
+
+// levelCore writes messages at or above its level to the current output.
+type levelCore struct {
+	zapcore.LevelEnabler
+	// Fields added to each message, e.g., the module of the logger.
+	fields []zapcore.Field
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{
+		LevelEnabler: c.LevelEnabler,
+		fields:       append(c.fields[:len(c.fields):len(c.fields)], fields...),
+	}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *levelCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	current := currentOutput.Load()
+	if len(c.fields) > 0 {
+		fields = append(c.fields[:len(c.fields):len(c.fields)], fields...)
+	}
+	err := current.core.Write(entry, fields)
+	for _, hook := range current.hooks {
+		err = multierr.Append(err, hook(entry))
+	}
+	return err
+}
+
+func (c *levelCore) Sync() error {
+	return currentOutput.Load().core.Sync()
+}
+
+// commandLineCore writes only the message of each entry.
+type commandLineCore struct {
+	out zapcore.WriteSyncer
+}
+
+func (c commandLineCore) Enabled(zapcore.Level) bool {
+	return true
+}
+
+func (c commandLineCore) With([]zapcore.Field) zapcore.Core {
+	return c
+}
+
+func (c commandLineCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checked.AddCore(entry, c)
+}
+
+func (c commandLineCore) Write(entry zapcore.Entry, _ []zapcore.Field) error {
+	_, err := fmt.Fprintln(c.out, entry.Message)
+	return err
+}
+
+func (c commandLineCore) Sync() error {
+	return c.out.Sync()
+}
@@ -2,7 +2,6 @@ package logging
 
 import (
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 )
 
 // Unexported but considered part of the stable interface of pkg/errors.
@@ -10,9 +9,9 @@ type stackTracer interface {
 	StackTrace() errors.StackTrace
 }
 
-// WithStacktrace returns a new logrus.FieldLogger obtained by adding error information and, if available, a stack trace
-// as fields to the provided logrus.FieldLogger.
-func WithStacktrace(logger logrus.FieldLogger, err error) logrus.FieldLogger {
+// WithStacktrace returns a new Logger obtained by adding error information and, if available, a stack trace
+// as fields to the provided Logger.
+func WithStacktrace(logger Logger, err error) Logger {
 	logger = logger.WithError(err)
 	if stackErr, ok := err.(stackTracer); ok {
 		return logger.WithField("stacktrace", stackErr.StackTrace())
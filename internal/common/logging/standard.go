@@ -0,0 +1,61 @@
+package logging
+
+import "go.uber.org/zap"
+
+// The functions below log through the standard logger, for code not belonging to any module.
+// Skip their own frame in addition to that of the zapLogger method, such that messages are attributed to their caller.
+var standardLoggerSkip = FromZap(zap.New(&levelCore{LevelEnabler: modules.level}, zap.AddCaller(), zap.AddCallerSkip(1))).(zapLogger)
+
+func Debug(args ...any) {
+	standardLoggerSkip.Debug(args...)
+}
+
+func Debugf(format string, args ...any) {
+	standardLoggerSkip.Debugf(format, args...)
+}
+
+func Info(args ...any) {
+	standardLoggerSkip.Info(args...)
+}
+
+func Infof(format string, args ...any) {
+	standardLoggerSkip.Infof(format, args...)
+}
+
+func Warn(args ...any) {
+	standardLoggerSkip.Warn(args...)
+}
+
+func Warnf(format string, args ...any) {
+	standardLoggerSkip.Warnf(format, args...)
+}
+
+func Error(args ...any) {
+	standardLoggerSkip.Error(args...)
+}
+
+func Errorf(format string, args ...any) {
+	standardLoggerSkip.Errorf(format, args...)
+}
+
+// Fatal logs a message at fatal level and exits with status 1.
+func Fatal(args ...any) {
+	standardLoggerSkip.fatal(args...)
+}
+
+// Fatalf logs a message at fatal level and exits with status 1.
+func Fatalf(format string, args ...any) {
+	standardLoggerSkip.fatalf(format, args...)
+}
+
+func WithField(key string, value any) Logger {
+	return standardLogger.WithField(key, value)
+}
+
+func WithFields(fields Fields) Logger {
+	return standardLogger.WithFields(fields)
+}
+
+func WithError(err error) Logger {
+	return standardLogger.WithError(err)
+}
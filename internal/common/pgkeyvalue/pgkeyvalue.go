@@ -6,7 +6,6 @@ import (
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -113,7 +112,7 @@ func (c *PGKeyValueStore) cleanup(ctx *armadacontext.Context, lifespan time.Dura
 // PeriodicCleanup starts a goroutine that automatically runs the cleanup job
 // every interval until the provided context is cancelled.
 func (c *PGKeyValueStore) PeriodicCleanup(ctx *armadacontext.Context, interval time.Duration, lifespan time.Duration) error {
-	log := logrus.StandardLogger().WithField("service", "PGKeyValueStoreCleanup")
+	log := logging.WithField("service", "PGKeyValueStoreCleanup")
 	log.Info("service started")
 	ticker := c.clock.NewTicker(interval)
 	for {
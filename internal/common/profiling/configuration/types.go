@@ -0,0 +1,19 @@
+package configuration
+
+import (
+	grpcconfig "github.com/armadaproject/armada/internal/common/grpc/configuration"
+)
+
+// DiagnosticsConfig configures the diagnostics server, which exposes net/http/pprof endpoints, a goroutine dump,
+// and snapshots of in-memory state of the component.
+type DiagnosticsConfig struct {
+	// If non-nil, the diagnostics server listens on this port.
+	Port *uint16
+	// Host the diagnostics server listens on. If empty, the server listens on localhost only.
+	Host string
+	// Path of a file containing a token that requests must present as a bearer token.
+	// Required unless the server listens on localhost or a loopback address, e.g., 127.0.0.1 or ::1.
+	BearerTokenPath string
+	// If enabled, the diagnostics server is served over TLS.
+	Tls grpcconfig.TlsConfig
+}
@@ -0,0 +1,390 @@
+package profiling
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"reflect"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/profiling/configuration"
+)
+
+// StateFunc returns a snapshot of some in-memory state of a component, which is served encoded as JSON.
+type StateFunc func() interface{}
+
+// DiagnosticsServer serves endpoints for diagnosing a running component:
+//
+//   - /debug/pprof/: net/http/pprof endpoints.
+//   - /debug/goroutines: stack traces of all goroutines.
+//   - /debug/state: snapshots of the state registered with AddState, along with runtime statistics.
+//     /debug/state/<name> returns only the snapshot registered with the provided name.
+//   - /debug/loglevel: log levels of the standard logger and of each module, which may be changed with PUT requests.
+//   - /debug/config: the effective configuration of the component set with SetConfig, with secrets redacted.
+//
+// Unless the server listens on a loopback address only, requests must present the configured bearer token.
+type DiagnosticsServer struct {
+	config  configuration.DiagnosticsConfig
+	started time.Time
+	mu      sync.Mutex
+	states  map[string]StateFunc
+	// Effective configuration of the component, served by /debug/config.
+	componentConfig interface{}
+}
+
+func NewDiagnosticsServer(config configuration.DiagnosticsConfig) *DiagnosticsServer {
+	s := &DiagnosticsServer{
+		config:  config,
+		started: time.Now(),
+		states:  make(map[string]StateFunc),
+	}
+	s.AddState("runtime", s.runtimeState)
+	return s
+}
+
+// AddState registers a snapshot of state to be served under the provided name, replacing any registered previously.
+// f is called on each request and must be safe to call concurrently with the component updating the state.
+func (s *DiagnosticsServer) AddState(name string, f StateFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[name] = f
+}
+
+// SetConfig sets the configuration served by /debug/config, i.e., the configuration the component was started with
+// after merging all config files and environment variables. Values of fields that may hold secrets,
+// e.g., passwords and tokens, are redacted.
+func (s *DiagnosticsServer) SetConfig(config interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.componentConfig = config
+}
+
+// Run serves the diagnostics endpoints until ctx is cancelled. Returns immediately if no port is configured.
+func (s *DiagnosticsServer) Run(ctx *armadacontext.Context) error {
+	if s.config.Port == nil {
+		return nil
+	}
+	handler, err := s.Handler()
+	if err != nil {
+		return err
+	}
+	host := s.config.Host
+	if host == "" {
+		host = "localhost"
+	}
+	server := &http.Server{
+		Addr:    net.JoinHostPort(host, fmt.Sprintf("%d", *s.config.Port)),
+		Handler: handler,
+	}
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(ctx); err != nil {
+			logging.WithStacktrace(ctx, err).Errorf("failed to shutdown diagnostics server serving %s", server.Addr)
+		}
+	}()
+	ctx.Infof("Diagnostics server listening on %s", server.Addr)
+	if s.config.Tls.Enabled {
+		err = server.ListenAndServeTLS(s.config.Tls.CertPath, s.config.Tls.KeyPath)
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// Handler returns the handler serving the diagnostics endpoints, which authenticates requests as configured.
+func (s *DiagnosticsServer) Handler() (http.Handler, error) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/goroutines", s.serveGoroutines)
+	mux.HandleFunc("/debug/state", s.serveState)
+	mux.HandleFunc("/debug/state/", s.serveState)
+	mux.Handle("/debug/loglevel", logging.LevelHandler())
+	mux.HandleFunc("/debug/config", s.serveConfig)
+
+	if s.config.BearerTokenPath == "" {
+		if !isLoopback(s.config.Host) {
+			return nil, errors.Errorf("diagnostics server listening on %s must be configured with a bearer token", s.config.Host)
+		}
+		return mux, nil
+	}
+	token, err := os.ReadFile(s.config.BearerTokenPath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	expected := []byte("Bearer " + strings.TrimSpace(string(token)))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}), nil
+}
+
+// isLoopback returns true if host, as configured for the diagnostics server, only accepts connections from this machine,
+// i.e., if it's empty, localhost, or a loopback address, e.g., 127.0.0.1 or ::1.
+func isLoopback(host string) bool {
+	if host == "" || host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (s *DiagnosticsServer) serveGoroutines(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	// Debug level 2 prints the stack of each goroutine in the same format as an unrecovered panic.
+	if err := runtimepprof.Lookup("goroutine").WriteTo(w, 2); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *DiagnosticsServer) serveState(w http.ResponseWriter, r *http.Request) {
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, "/debug/state"), "/")
+	s.mu.Lock()
+	states := make(map[string]StateFunc, len(s.states))
+	for k, f := range s.states {
+		states[k] = f
+	}
+	s.mu.Unlock()
+
+	var body interface{}
+	if name == "" {
+		snapshots := make(map[string]interface{}, len(states))
+		for k, f := range states {
+			snapshots[k] = f()
+		}
+		body = snapshots
+	} else if f, ok := states[name]; ok {
+		body = f()
+	} else {
+		names := make([]string, 0, len(states))
+		for k := range states {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		http.Error(w, fmt.Sprintf("unknown state %q; available states are %v", name, names), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(body); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *DiagnosticsServer) serveConfig(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	componentConfig := s.componentConfig
+	s.mu.Unlock()
+	if componentConfig == nil {
+		http.Error(w, "no configuration has been set", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(redactSecrets(toSerializable(reflect.ValueOf(componentConfig)))); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// toSerializable converts v into maps, slices, and values that can be encoded as JSON, such that secrets can be redacted
+// by key regardless of the type of the config. Fields that can't be encoded, e.g., hooks of client libraries,
+// are omitted, and durations are converted into strings with units, e.g., 1m30s, as they're written in config files.
+func toSerializable(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		return time.Duration(v.Int()).String()
+	}
+	if v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface && v.CanInterface() {
+		if marshaler, ok := v.Interface().(json.Marshaler); ok {
+			if encoded, err := marshaler.MarshalJSON(); err == nil {
+				var decoded interface{}
+				if err := json.Unmarshal(encoded, &decoded); err == nil {
+					return decoded
+				}
+			}
+		}
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return toSerializable(v.Elem())
+	case reflect.Struct:
+		rv := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() || !isSerializable(field.Type) {
+				continue
+			}
+			rv[field.Name] = toSerializable(v.Field(i))
+		}
+		return rv
+	case reflect.Map:
+		if v.IsNil() || !isSerializable(v.Type().Elem()) {
+			return nil
+		}
+		rv := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			rv[fmt.Sprintf("%v", iter.Key().Interface())] = toSerializable(iter.Value())
+		}
+		return rv
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		rv := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			rv[i] = toSerializable(v.Index(i))
+		}
+		return rv
+	default:
+		return v.Interface()
+	}
+}
+
+// isSerializable returns false for types that can't be encoded as JSON, e.g., funcs and channels.
+func isSerializable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer, reflect.Complex64, reflect.Complex128:
+		return false
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return isSerializable(t.Elem())
+	default:
+		return true
+	}
+}
+
+// redactedValue replaces the values of config fields that may hold secrets.
+const redactedValue = "<redacted>"
+
+// redactSecrets replaces non-empty values stored under keys that may hold secrets in v, as returned by toSerializable.
+// Objects stored under such keys, e.g., the config of an auth method, are redacted field by field,
+// except for header maps, e.g., OtlpHeaders, every value of which is redacted.
+func redactSecrets(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			object, isObject := value.(map[string]interface{})
+			if isObject && isHeaderKey(key) {
+				for field := range object {
+					object[field] = redactValue(object[field])
+				}
+			} else if !isObject && isSecretKey(key) {
+				v[key] = redactValue(value)
+			} else {
+				v[key] = redactSecrets(value)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactSecrets(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// redactValue returns redactedValue, unless v is empty or a bool, e.g., AnonymousAuth, which can't be a secret.
+func redactValue(v interface{}) interface{} {
+	switch v.(type) {
+	case nil, bool:
+		return v
+	case string:
+		if v == "" {
+			return v
+		}
+	}
+	return redactedValue
+}
+
+// isSecretKey returns true if the value stored under key may be a secret, e.g., Password, ClientSecret or Authorization.
+// Paths to files containing secrets, e.g., BearerTokenPath, aren't secrets themselves.
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	if strings.HasSuffix(key, "path") || strings.HasSuffix(key, "file") {
+		return false
+	}
+	return strings.Contains(key, "password") ||
+		strings.Contains(key, "secret") ||
+		strings.Contains(key, "credential") ||
+		strings.Contains(key, "auth") ||
+		strings.HasSuffix(key, "token") ||
+		strings.HasSuffix(key, "apikey") ||
+		strings.HasSuffix(key, "privatekey") ||
+		isHeaderKey(key)
+}
+
+// isHeaderKey returns true if the value stored under key may be headers or cookies, e.g., OtlpHeaders,
+// which commonly carry credentials under arbitrary names.
+func isHeaderKey(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "header") || strings.Contains(key, "cookie")
+}
+
+type runtimeState struct {
+	Started      time.Time
+	Uptime       string
+	GoVersion    string
+	NumCPU       int
+	GoMaxProcs   int
+	NumGoroutine int
+	HeapAlloc    uint64
+	HeapObjects  uint64
+	Sys          uint64
+	NumGC        uint32
+	LastGC       time.Time
+	PauseTotalNs uint64
+	NumCgoCall   int64
+}
+
+func (s *DiagnosticsServer) runtimeState() interface{} {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	return runtimeState{
+		Started:      s.started,
+		Uptime:       time.Since(s.started).Round(time.Second).String(),
+		GoVersion:    runtime.Version(),
+		NumCPU:       runtime.NumCPU(),
+		GoMaxProcs:   runtime.GOMAXPROCS(0),
+		NumGoroutine: runtime.NumGoroutine(),
+		HeapAlloc:    memStats.HeapAlloc,
+		HeapObjects:  memStats.HeapObjects,
+		Sys:          memStats.Sys,
+		NumGC:        memStats.NumGC,
+		LastGC:       time.Unix(0, int64(memStats.LastGC)),
+		PauseTotalNs: memStats.PauseTotalNs,
+		NumCgoCall:   runtime.NumCgoCall(),
+	}
+}
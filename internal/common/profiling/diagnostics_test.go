@@ -0,0 +1,119 @@
+package profiling
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/profiling/configuration"
+)
+
+func TestDiagnosticsServer_State(t *testing.T) {
+	s := NewDiagnosticsServer(configuration.DiagnosticsConfig{})
+	s.AddState("test", func() interface{} { return map[string]int{"value": 1} })
+	handler, err := s.Handler()
+	require.NoError(t, err)
+
+	rec := get(handler, "/debug/state", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var states map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &states))
+	assert.Contains(t, states, "runtime")
+	assert.JSONEq(t, `{"value": 1}`, string(states["test"]))
+
+	rec = get(handler, "/debug/state/test", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"value": 1}`, rec.Body.String())
+
+	rec = get(handler, "/debug/state/unknown", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDiagnosticsServer_Goroutines(t *testing.T) {
+	handler, err := NewDiagnosticsServer(configuration.DiagnosticsConfig{}).Handler()
+	require.NoError(t, err)
+	rec := get(handler, "/debug/goroutines", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.Contains(rec.Body.String(), "goroutine "))
+}
+
+func TestDiagnosticsServer_BearerToken(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("secret\n"), 0o600))
+	handler, err := NewDiagnosticsServer(configuration.DiagnosticsConfig{Host: "0.0.0.0", BearerTokenPath: tokenPath}).Handler()
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, get(handler, "/debug/state", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, get(handler, "/debug/state", "Bearer wrong").Code)
+	assert.Equal(t, http.StatusOK, get(handler, "/debug/state", "Bearer secret").Code)
+}
+
+func TestDiagnosticsServer_RequiresTokenUnlessLoopback(t *testing.T) {
+	for _, host := range []string{"", "localhost", "127.0.0.1", "127.0.0.2", "::1"} {
+		_, err := NewDiagnosticsServer(configuration.DiagnosticsConfig{Host: host}).Handler()
+		assert.NoError(t, err, host)
+	}
+	for _, host := range []string{"0.0.0.0", "::", "10.0.0.1", "example.com"} {
+		_, err := NewDiagnosticsServer(configuration.DiagnosticsConfig{Host: host}).Handler()
+		assert.Error(t, err, host)
+	}
+}
+
+func TestDiagnosticsServer_Config(t *testing.T) {
+	type redisConfig struct {
+		Addrs     []string
+		Password  string
+		OnConnect func() error
+	}
+	type testConfig struct {
+		Redis           redisConfig
+		Connection      map[string]string
+		OtlpHeaders     map[string]string
+		AnonymousAuth   bool
+		BearerTokenPath string
+		ClientSecret    string
+		Timeout         time.Duration
+	}
+	s := NewDiagnosticsServer(configuration.DiagnosticsConfig{})
+	handler, err := s.Handler()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, get(handler, "/debug/config", "").Code)
+
+	s.SetConfig(testConfig{
+		Redis:           redisConfig{Addrs: []string{"redis:6379"}, Password: "hunter2"},
+		Connection:      map[string]string{"host": "postgres", "password": "psw"},
+		OtlpHeaders:     map[string]string{"Authorization": "Bearer abc", "x-honeycomb-team": "key"},
+		AnonymousAuth:   true,
+		BearerTokenPath: "/etc/token",
+		Timeout:         90 * time.Second,
+	})
+	rec := get(handler, "/debug/config", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{
+		"Redis": {"Addrs": ["redis:6379"], "Password": "<redacted>"},
+		"Connection": {"host": "postgres", "password": "<redacted>"},
+		"OtlpHeaders": {"Authorization": "<redacted>", "x-honeycomb-team": "<redacted>"},
+		"AnonymousAuth": true,
+		"BearerTokenPath": "/etc/token",
+		"ClientSecret": "",
+		"Timeout": "1m30s"
+	}`, rec.Body.String())
+}
+
+func get(handler http.Handler, path string, authorization string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
@@ -0,0 +1,138 @@
+package pulsarutils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+)
+
+// AdminClient queries the Pulsar admin REST API, e.g., for the number of messages of a topic not yet acked.
+type AdminClient struct {
+	// Base URL of the admin API, e.g., "http://pulsar:8080".
+	url string
+	// Path of the file containing the JWT token sent with each request, if any.
+	jwtTokenPath string
+	httpClient   *http.Client
+}
+
+// NewAdminClient returns a client for the admin API at config.AdminURL, or nil if no such URL is configured,
+// using the same authentication and TLS settings as clients of the broker.
+func NewAdminClient(config *configuration.PulsarConfig) (*AdminClient, error) {
+	if config.AdminURL == "" {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.TLSAllowInsecureConnection}
+	if config.TLSTrustCertsFilePath != "" {
+		certs, err := os.ReadFile(config.TLSTrustCertsFilePath)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(certs) {
+			return nil, errors.Errorf("no certificates found in %s", config.TLSTrustCertsFilePath)
+		}
+	}
+	client := &AdminClient{
+		url: strings.TrimSuffix(config.AdminURL, "/"),
+		httpClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+	if config.AuthenticationEnabled {
+		client.jwtTokenPath = config.JwtTokenPath
+	}
+	return client, nil
+}
+
+type topicStats struct {
+	Subscriptions map[string]struct {
+		MsgBacklog int64 `json:"msgBacklog"`
+	} `json:"subscriptions"`
+}
+
+// SubscriptionBacklog returns the number of messages of topic not yet acked by subscription,
+// summed over the partitions of topic.
+func (c *AdminClient) SubscriptionBacklog(ctx context.Context, topic string, subscription string) (int64, error) {
+	path, err := adminTopicPath(topic)
+	if err != nil {
+		return 0, err
+	}
+	stats, err := c.getTopicStats(ctx, path+"/partitioned-stats")
+	if errors.Is(err, errTopicNotFound) {
+		// The topic isn't partitioned.
+		stats, err = c.getTopicStats(ctx, path+"/stats")
+	}
+	if err != nil {
+		return 0, err
+	}
+	subscriptionStats, ok := stats.Subscriptions[subscription]
+	if !ok {
+		return 0, errors.Errorf("subscription %s of topic %s not found", subscription, topic)
+	}
+	return subscriptionStats.MsgBacklog, nil
+}
+
+var errTopicNotFound = errors.New("topic not found")
+
+func (c *AdminClient) getTopicStats(ctx context.Context, path string) (*topicStats, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if c.jwtTokenPath != "" {
+		token, err := os.ReadFile(c.jwtTokenPath)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	// Pulsar returns 404 when asked for the partitioned stats of a topic that isn't partitioned,
+	// or 409 by some versions.
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusConflict {
+		return nil, errors.WithStack(errTopicNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("GET %s returned %s", path, resp.Status)
+	}
+	stats := &topicStats{}
+	if err := json.NewDecoder(resp.Body).Decode(stats); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode response of GET %s", path)
+	}
+	return stats, nil
+}
+
+// adminTopicPath returns the path of topic in the admin API, e.g., "/admin/v2/persistent/public/default/events".
+// Topics not fully qualified are in the namespace public/default, as for clients of the broker.
+func adminTopicPath(topic string) (string, error) {
+	domain := "persistent"
+	name := topic
+	if i := strings.Index(topic, "://"); i >= 0 {
+		domain = topic[:i]
+		name = topic[i+3:]
+	}
+	parts := strings.Split(name, "/")
+	switch len(parts) {
+	case 1:
+		parts = []string{"public", "default", parts[0]}
+	case 3:
+	default:
+		return "", errors.Errorf("invalid topic name %q", topic)
+	}
+	return fmt.Sprintf("/admin/v2/%s/%s", domain, strings.Join(parts, "/")), nil
+}
@@ -0,0 +1,69 @@
+package pulsarutils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+)
+
+func TestNewAdminClient_Disabled(t *testing.T) {
+	client, err := NewAdminClient(&configuration.PulsarConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, client)
+}
+
+func TestSubscriptionBacklog(t *testing.T) {
+	tests := map[string]struct {
+		partitioned bool
+	}{
+		"partitioned":     {partitioned: true},
+		"not partitioned": {partitioned: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/admin/v2/persistent/public/default/events/partitioned-stats":
+					if !tc.partitioned {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+				case "/admin/v2/persistent/public/default/events/stats":
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(`{"subscriptions": {"sub": {"msgBacklog": 42}}}`))
+			}))
+			defer server.Close()
+
+			client, err := NewAdminClient(&configuration.PulsarConfig{AdminURL: server.URL + "/"})
+			require.NoError(t, err)
+			backlog, err := client.SubscriptionBacklog(context.Background(), "events", "sub")
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), backlog)
+
+			_, err = client.SubscriptionBacklog(context.Background(), "events", "unknown")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAdminTopicPath(t *testing.T) {
+	path, err := adminTopicPath("events")
+	require.NoError(t, err)
+	assert.Equal(t, "/admin/v2/persistent/public/default/events", path)
+
+	path, err = adminTopicPath("non-persistent://tenant/namespace/events")
+	require.NoError(t, err)
+	assert.Equal(t, "/admin/v2/non-persistent/tenant/namespace/events", path)
+
+	_, err = adminTopicPath("namespace/events")
+	assert.Error(t, err)
+}
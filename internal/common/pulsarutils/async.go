@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
-	"github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
@@ -33,7 +32,7 @@ type ConsumerMessage struct {
 	ConsumerId int
 }
 
-var msgLogger = logrus.NewEntry(logrus.StandardLogger())
+var msgLogger = logging.StandardLogger()
 
 func Receive(
 	ctx *armadacontext.Context,
@@ -57,7 +56,7 @@ func Receive(
 			// Periodic logging.
 			if time.Since(lastLogged) > logInterval {
 				msgLogger.WithFields(
-					logrus.Fields{
+					logging.Fields{
 						"received":      numReceived,
 						"interval":      logInterval,
 						"lastMessageId": lastMessageId,
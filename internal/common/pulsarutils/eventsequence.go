@@ -4,7 +4,6 @@ import (
 	"sync/atomic"
 
 	"github.com/apache/pulsar-client-go/pulsar"
-	"github.com/gogo/protobuf/proto"
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/requestid"
 	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
@@ -50,9 +50,9 @@ func PublishSequences(ctx *armadacontext.Context, producer pulsar.Producer, sequ
 		if sequence == nil {
 			return errors.Errorf("failed to send sequence %v", sequence)
 		}
-		payload, err := proto.Marshal(sequence)
+		payload, err := eventutil.MarshalEventSequence(sequence)
 		if err != nil {
-			return errors.WithStack(err)
+			return err
 		}
 		payloads[i] = payload
 	}
@@ -64,15 +64,18 @@ func PublishSequences(ctx *armadacontext.Context, producer pulsar.Producer, sequ
 	ch := make(chan error, len(sequences))
 	var numSendCompleted uint32
 	for i := range sequences {
+		properties := map[string]string{
+			requestid.MetadataKey:   requestId,
+			schedulers.PropertyName: schedulers.MsgPropertyFromScheduler(scheduler),
+		}
+		// Likewise, pass the context of the trace of the request, if any, such that consumers can continue the trace.
+		tracing.AddToProperties(ctx, properties)
 		producer.SendAsync(
 			ctx,
 			&pulsar.ProducerMessage{
-				Payload: payloads[i],
-				Properties: map[string]string{
-					requestid.MetadataKey:   requestId,
-					schedulers.PropertyName: schedulers.MsgPropertyFromScheduler(scheduler),
-				},
-				Key: sequences[i].JobSetName,
+				Payload:    payloads[i],
+				Properties: properties,
+				Key:        sequences[i].JobSetName,
 			},
 			// Callback on send.
 			func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
@@ -0,0 +1,243 @@
+// Package pulsartest provides an in-memory stand-in for Pulsar, such that services reading from and writing to
+// the event log can be tested without a Pulsar cluster.
+package pulsartest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/eventlog"
+)
+
+// Broker stores the messages published to each topic in memory.
+//
+// Each subscription of a topic starts at the earliest message and delivers messages in the order they were published.
+// Messages delivered to a consumer but not acked are re-delivered to the next consumer of the same subscription,
+// such that a service can be restarted without losing messages, as with Pulsar.
+// Subscriptions are exclusive, i.e., there should be at most one open consumer per subscription at any time.
+type Broker struct {
+	mu     sync.Mutex
+	topics map[string]*topic
+}
+
+type topic struct {
+	messages      []*Message
+	subscriptions map[string]*subscription
+	// Closed and replaced whenever a message is published, to wake up consumers waiting for messages.
+	published chan struct{}
+}
+
+type subscription struct {
+	// Indices of the messages that have been acked.
+	acked map[int]bool
+}
+
+// Message is a message published to a topic of a Broker.
+type Message struct {
+	index       int
+	payload     []byte
+	properties  map[string]string
+	publishTime time.Time
+}
+
+type messageId int
+
+func (id messageId) String() string {
+	return strconv.Itoa(int(id))
+}
+
+func (msg *Message) ID() fmt.Stringer {
+	return messageId(msg.index)
+}
+
+func (msg *Message) Payload() []byte {
+	return msg.payload
+}
+
+func (msg *Message) PublishTime() time.Time {
+	return msg.publishTime
+}
+
+func (msg *Message) Properties() map[string]string {
+	return msg.properties
+}
+
+func NewBroker() *Broker {
+	return &Broker{topics: make(map[string]*topic)}
+}
+
+// getTopic returns the topic with the provided name, creating it if necessary. Must be called with b.mu held.
+func (b *Broker) getTopic(name string) *topic {
+	t, ok := b.topics[name]
+	if !ok {
+		t = &topic{
+			subscriptions: make(map[string]*subscription),
+			published:     make(chan struct{}),
+		}
+		b.topics[name] = t
+	}
+	return t
+}
+
+// Messages returns all messages published to the provided topic, in the order they were published.
+func (b *Broker) Messages(topicName string) []*Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*Message(nil), b.getTopic(topicName).messages...)
+}
+
+// Backlog returns the number of messages published to the provided topic not yet acked on the provided subscription.
+func (b *Broker) Backlog(topicName string, subscriptionName string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t := b.getTopic(topicName)
+	s, ok := t.subscriptions[subscriptionName]
+	if !ok {
+		return len(t.messages)
+	}
+	return len(t.messages) - len(s.acked)
+}
+
+// NewProducer returns a producer publishing to the provided topic.
+func (b *Broker) NewProducer(topicName string) pulsar.Producer {
+	return &Producer{broker: b, topic: topicName}
+}
+
+// Subscribe returns a consumer reading from the provided subscription of the provided topic.
+func (b *Broker) Subscribe(topicName string, subscriptionName string) *Consumer {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t := b.getTopic(topicName)
+	s, ok := t.subscriptions[subscriptionName]
+	if !ok {
+		s = &subscription{acked: make(map[int]bool)}
+		t.subscriptions[subscriptionName] = s
+	}
+	return &Consumer{broker: b, topic: t, subscription: s}
+}
+
+// Producer is a pulsar.Producer publishing to a topic of a Broker.
+type Producer struct {
+	broker *Broker
+	topic  string
+	closed bool
+}
+
+func (p *Producer) Topic() string {
+	return p.topic
+}
+
+func (p *Producer) Name() string {
+	return "pulsartest"
+}
+
+func (p *Producer) Send(_ context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+	p.broker.mu.Lock()
+	defer p.broker.mu.Unlock()
+	if p.closed {
+		return nil, errors.New("producer is closed")
+	}
+	t := p.broker.getTopic(p.topic)
+	properties := make(map[string]string, len(msg.Properties))
+	for k, v := range msg.Properties {
+		properties[k] = v
+	}
+	t.messages = append(t.messages, &Message{
+		index:       len(t.messages),
+		payload:     append([]byte(nil), msg.Payload...),
+		properties:  properties,
+		publishTime: time.Now(),
+	})
+	close(t.published)
+	t.published = make(chan struct{})
+	return nil, nil
+}
+
+func (p *Producer) SendAsync(ctx context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+	id, err := p.Send(ctx, msg)
+	callback(id, msg, err)
+}
+
+func (p *Producer) LastSequenceID() int64 {
+	p.broker.mu.Lock()
+	defer p.broker.mu.Unlock()
+	return int64(len(p.broker.getTopic(p.topic).messages)) - 1
+}
+
+func (p *Producer) Flush() error {
+	return nil
+}
+
+func (p *Producer) Close() {
+	p.broker.mu.Lock()
+	defer p.broker.mu.Unlock()
+	p.closed = true
+}
+
+// Consumer is an eventlog.Consumer reading from a subscription of a topic of a Broker.
+type Consumer struct {
+	broker       *Broker
+	topic        *topic
+	subscription *subscription
+	// Index of the next message to be considered for delivery.
+	next int
+}
+
+func (c *Consumer) Receive(ctx context.Context) (eventlog.Message, error) {
+	for {
+		c.broker.mu.Lock()
+		for c.next < len(c.topic.messages) {
+			msg := c.topic.messages[c.next]
+			c.next++
+			if !c.subscription.acked[msg.index] {
+				c.broker.mu.Unlock()
+				return msg, nil
+			}
+		}
+		published := c.topic.published
+		c.broker.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-published:
+		}
+	}
+}
+
+func (c *Consumer) Ack(msg eventlog.Message) error {
+	m, ok := msg.(*Message)
+	if !ok {
+		return errors.Errorf("expected a message received from pulsartest, but got %T", msg)
+	}
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	c.subscription.acked[m.index] = true
+	return nil
+}
+
+// AckCumulative acks msgs and all messages published before them.
+func (c *Consumer) AckCumulative(msgs []eventlog.Message) error {
+	last := -1
+	for _, msg := range msgs {
+		m, ok := msg.(*Message)
+		if !ok {
+			return errors.Errorf("expected a message received from pulsartest, but got %T", msg)
+		}
+		if m.index > last {
+			last = m.index
+		}
+	}
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	for i := 0; i <= last; i++ {
+		c.subscription.acked[i] = true
+	}
+	return nil
+}
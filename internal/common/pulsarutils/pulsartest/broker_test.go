@@ -0,0 +1,66 @@
+package pulsartest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/eventlog"
+)
+
+func TestBroker_RedeliversMessagesNotAcked(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	broker := NewBroker()
+	producer := broker.NewProducer("topic")
+	for _, payload := range []string{"a", "b", "c"} {
+		_, err := producer.Send(ctx, &pulsar.ProducerMessage{Payload: []byte(payload)})
+		require.NoError(t, err)
+	}
+
+	consumer := broker.Subscribe("topic", "sub")
+	a := receive(ctx, t, consumer)
+	b := receive(ctx, t, consumer)
+	receive(ctx, t, consumer)
+	require.NoError(t, consumer.Ack(b))
+	assert.Equal(t, 2, broker.Backlog("topic", "sub"))
+	assert.Equal(t, 3, broker.Backlog("topic", "other-sub"))
+
+	// Unacked messages are re-delivered to the next consumer of the subscription, in order.
+	consumer = broker.Subscribe("topic", "sub")
+	assert.Equal(t, "a", string(receive(ctx, t, consumer).Payload()))
+	c := receive(ctx, t, consumer)
+	assert.Equal(t, "c", string(c.Payload()))
+
+	require.NoError(t, consumer.AckCumulative([]eventlog.Message{c}))
+	assert.Equal(t, 0, broker.Backlog("topic", "sub"))
+	assert.Equal(t, []*Message{a.(*Message), b.(*Message), c.(*Message)}, broker.Messages("topic"))
+}
+
+func TestBroker_ReceiveWaitsForMessages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	broker := NewBroker()
+	consumer := broker.Subscribe("topic", "sub")
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_, _ = broker.NewProducer("topic").Send(ctx, &pulsar.ProducerMessage{Payload: []byte("a")})
+	}()
+	assert.Equal(t, "a", string(receive(ctx, t, consumer).Payload()))
+
+	cancelledCtx, cancelReceive := context.WithCancel(ctx)
+	cancelReceive()
+	_, err := consumer.Receive(cancelledCtx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func receive(ctx context.Context, t *testing.T, consumer *Consumer) eventlog.Message {
+	msg, err := consumer.Receive(ctx)
+	require.NoError(t, err)
+	return msg
+}
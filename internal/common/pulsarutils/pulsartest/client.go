@@ -0,0 +1,114 @@
+package pulsartest
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+)
+
+// Client is a pulsar.Client backed by a Broker, such that services creating their producers and consumers from a
+// client, e.g., the scheduler and the ingestion pipelines, can be run against a Broker.
+// Topics have a single partition. Only the methods used by Armada are implemented.
+type Client struct {
+	pulsar.Client
+	broker *Broker
+}
+
+// NewClient returns a client creating producers and consumers of the topics of b.
+func (b *Broker) NewClient() *Client {
+	return &Client{broker: b}
+}
+
+func (c *Client) CreateProducer(options pulsar.ProducerOptions) (pulsar.Producer, error) {
+	return c.broker.NewProducer(options.Topic), nil
+}
+
+// Subscribe returns a consumer reading from the subscription of the topic given by options.
+// The subscription type and initial position are ignored; see Broker.
+func (c *Client) Subscribe(options pulsar.ConsumerOptions) (pulsar.Consumer, error) {
+	return c.broker.SubscribePulsar(options.Topic, options.SubscriptionName), nil
+}
+
+func (c *Client) TopicPartitions(topic string) ([]string, error) {
+	return []string{topic}, nil
+}
+
+func (c *Client) Close() {}
+
+// SubscribePulsar returns a pulsar.Consumer reading from the provided subscription of the provided topic.
+func (b *Broker) SubscribePulsar(topicName string, subscriptionName string) *PulsarConsumer {
+	return &PulsarConsumer{
+		consumer:         b.Subscribe(topicName, subscriptionName),
+		topicName:        topicName,
+		subscriptionName: subscriptionName,
+	}
+}
+
+// PulsarConsumer is a pulsar.Consumer reading from a subscription of a topic of a Broker.
+// Only the methods used by Armada are implemented.
+type PulsarConsumer struct {
+	pulsar.Consumer
+	consumer         *Consumer
+	topicName        string
+	subscriptionName string
+}
+
+func (c *PulsarConsumer) Subscription() string {
+	return c.subscriptionName
+}
+
+func (c *PulsarConsumer) Receive(ctx context.Context) (pulsar.Message, error) {
+	msg, err := c.consumer.Receive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pulsarMessage{msg: msg.(*Message), topicName: c.topicName}, nil
+}
+
+func (c *PulsarConsumer) Ack(msg pulsar.Message) error {
+	return c.AckID(msg.ID())
+}
+
+// AckID acks the message with the provided id, which must be the id of a message received from a PulsarConsumer.
+func (c *PulsarConsumer) AckID(id pulsar.MessageID) error {
+	index := int(id.EntryID())
+	c.consumer.broker.mu.Lock()
+	defer c.consumer.broker.mu.Unlock()
+	if index < 0 || index >= len(c.consumer.topic.messages) {
+		return errors.Errorf("no message with id %s", id)
+	}
+	c.consumer.subscription.acked[index] = true
+	return nil
+}
+
+func (c *PulsarConsumer) Close() {}
+
+// pulsarMessage is a pulsar.Message wrapping a message of a Broker.
+// Its id is that of the entry given by the index of the message in its topic.
+type pulsarMessage struct {
+	pulsar.Message
+	msg       *Message
+	topicName string
+}
+
+func (m *pulsarMessage) Topic() string {
+	return m.topicName
+}
+
+func (m *pulsarMessage) ID() pulsar.MessageID {
+	return pulsar.NewMessageID(0, int64(m.msg.index), 0, 0)
+}
+
+func (m *pulsarMessage) Payload() []byte {
+	return m.msg.Payload()
+}
+
+func (m *pulsarMessage) PublishTime() time.Time {
+	return m.msg.PublishTime()
+}
+
+func (m *pulsarMessage) Properties() map[string]string {
+	return m.msg.Properties()
+}
@@ -0,0 +1,47 @@
+package pulsartest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ProduceAndConsume(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	broker := NewBroker()
+	client := broker.NewClient()
+
+	partitions, err := client.TopicPartitions("topic")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"topic"}, partitions)
+
+	producer, err := client.CreateProducer(pulsar.ProducerOptions{Topic: "topic"})
+	require.NoError(t, err)
+	for _, payload := range []string{"a", "b"} {
+		_, err := producer.Send(ctx, &pulsar.ProducerMessage{Payload: []byte(payload), Properties: map[string]string{"k": payload}})
+		require.NoError(t, err)
+	}
+
+	consumer, err := client.Subscribe(pulsar.ConsumerOptions{Topic: "topic", SubscriptionName: "sub"})
+	require.NoError(t, err)
+	assert.Equal(t, "sub", consumer.Subscription())
+	a, err := consumer.Receive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "a", string(a.Payload()))
+	assert.Equal(t, map[string]string{"k": "a"}, a.Properties())
+	assert.Equal(t, "topic", a.Topic())
+	b, err := consumer.Receive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "b", string(b.Payload()))
+
+	require.NoError(t, consumer.AckID(b.ID()))
+	assert.Equal(t, 1, broker.Backlog("topic", "sub"))
+	require.NoError(t, consumer.Ack(a))
+	assert.Equal(t, 0, broker.Backlog("topic", "sub"))
+	assert.Error(t, consumer.AckID(pulsar.NewMessageID(0, 2, 0, 0)))
+}
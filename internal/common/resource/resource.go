@@ -195,6 +195,16 @@ func (a ComputeResources) DeepCopy() ComputeResources {
 	return targetComputeResource
 }
 
+// Div returns a new ComputeResources in which each value of "a" is divided by the given divisor,
+// rounded down to the nearest milli-unit. The divisor must be positive.
+func (a ComputeResources) Div(divisor int64) ComputeResources {
+	targetComputeResource := make(ComputeResources)
+	for key, value := range a {
+		targetComputeResource[key] = *resource.NewMilliQuantity(value.MilliValue()/divisor, value.Format)
+	}
+	return targetComputeResource
+}
+
 // The Mul function takes a ComputeResources object called "a" and multiplies each value in it with a given "factor".
 // It then stores the result of each computation in a new ComputeResourcesFloat object,
 // where each key in "a" maps to its corresponding value converted to a float64 type and multiplied by "factor"
@@ -354,9 +364,10 @@ func (a ComputeResourcesFloat) Mul(factor float64) ComputeResourcesFloat {
 //   - sum of all containers
 //   - any individual init container
 //
-// This is because:
-//   - containers run in parallel (so need to sum resources)
+// plus the pod overhead, if any. This is because:
+//   - containers, including sidecars, run in parallel (so need to sum resources)
 //   - init containers run sequentially (so only their individual resource need be considered)
+//   - the overhead, e.g., of a sandboxed runtime, is used in addition to the containers
 //
 // So pod resource usage is the max for each resource type (cpu/memory etc.) that could be used at any given time
 func TotalPodResourceRequest(podSpec *v1.PodSpec) ComputeResources {
@@ -369,6 +380,7 @@ func TotalPodResourceRequest(podSpec *v1.PodSpec) ComputeResources {
 		containerResource := FromResourceList(initContainer.Resources.Requests)
 		totalResources.Max(containerResource)
 	}
+	totalResources.Add(FromResourceList(podSpec.Overhead))
 	return totalResources
 }
 
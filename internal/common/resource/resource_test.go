@@ -71,6 +71,19 @@ func TestComputeResources_LimitToZero(t *testing.T) {
 	}
 }
 
+func TestComputeResources_Div(t *testing.T) {
+	input := ComputeResources{
+		"cpu":    resource.MustParse("3"),
+		"memory": resource.MustParse("3Gi"),
+	}
+	result := input.Div(2)
+
+	assert.True(t, resource.MustParse("1500m").Equal(result["cpu"]))
+	assert.True(t, resource.MustParse("1.5Gi").Equal(result["memory"]))
+	// The input is left unchanged.
+	assert.True(t, resource.MustParse("3").Equal(input["cpu"]))
+}
+
 func TestComputeResources_IsZero(t *testing.T) {
 	tests := map[string]struct {
 		input          int64
@@ -165,6 +178,20 @@ func TestTotalResourceRequest_ShouldCombineMaxInitContainerResourcesWithSummedCo
 	assert.Equal(t, result, FromResourceList(expectedResult))
 }
 
+func TestTotalResourceRequest_ShouldAddPodOverhead(t *testing.T) {
+	standardResource := makeContainerResource(100, 50)
+	highCpuResource := makeContainerResource(1000, 50)
+	overhead := makeContainerResource(1, 1)
+
+	pod := makePodWithResource([]*v1.ResourceList{&standardResource, &standardResource}, []*v1.ResourceList{&highCpuResource})
+	pod.Spec.Overhead = overhead
+	// The overhead is added to the max of the summed containers and any given init container.
+	expectedResult := makeContainerResource(1001, 101)
+
+	result := TotalPodResourceRequest(&pod.Spec)
+	assert.Equal(t, result, FromResourceList(expectedResult))
+}
+
 func makeDefaultNodeResource() v1.ResourceList {
 	cpuResource := resource.NewQuantity(100, resource.DecimalSI)
 	memoryResource := resource.NewQuantity(50*1024*1024*1024, resource.DecimalSI)
@@ -2,7 +2,8 @@ package schedulers
 
 import (
 	"github.com/apache/pulsar-client-go/pulsar"
-	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/internal/common/logging"
 )
 
 type Scheduler int
@@ -22,17 +23,25 @@ const (
 
 // SchedulerFromMsg parses the message properties to retrieve the Scheduler associated with the message
 func SchedulerFromMsg(msg pulsar.Message) Scheduler {
-	s := msg.Properties()[PropertyName]
-	switch s {
+	s, ok := SchedulerFromProperties(msg.Properties())
+	if !ok {
+		logging.Warnf("Unknown scheduler [%s] associated with pulsar message [%s]. Defaulting to legacy scheduler", msg.Properties()[PropertyName], msg.ID())
+	}
+	return s
+}
+
+// SchedulerFromProperties parses the provided message properties to retrieve the Scheduler associated with a message.
+// If the properties specify an unknown scheduler, the legacy scheduler and false are returned.
+func SchedulerFromProperties(properties map[string]string) (Scheduler, bool) {
+	switch properties[PropertyName] {
 	case PulsarSchedulerAttribute:
-		return Pulsar
+		return Pulsar, true
 	case LegacySchedulerAttribute, "": // empty string means legacy scheduler for compatibility
-		return Legacy
+		return Legacy, true
 	case AllSchedulersAttribute:
-		return All
+		return All, true
 	}
-	log.Warnf("Unknown scheduler [%s] associated with pulsar message [%s]. Defaulting to legacy scheduler", s, msg.ID())
-	return Legacy
+	return Legacy, false
 }
 
 // MsgPropertyFromScheduler returns the pulsar message property associated with the scheduler
@@ -45,7 +54,7 @@ func MsgPropertyFromScheduler(s Scheduler) string {
 	case All:
 		return AllSchedulersAttribute
 	}
-	log.Warnf("Unknown scheduler [%d]. Defaulting to legacy scheduler", s)
+	logging.Warnf("Unknown scheduler [%d]. Defaulting to legacy scheduler", s)
 	return LegacySchedulerAttribute
 }
 
@@ -43,3 +43,17 @@ func TestSchedulerFromMsg(t *testing.T) {
 		})
 	}
 }
+
+func TestSchedulerFromProperties(t *testing.T) {
+	s, ok := SchedulerFromProperties(map[string]string{PropertyName: PulsarSchedulerAttribute})
+	assert.True(t, ok)
+	assert.Equal(t, Pulsar, s)
+
+	s, ok = SchedulerFromProperties(nil)
+	assert.True(t, ok)
+	assert.Equal(t, Legacy, s)
+
+	s, ok = SchedulerFromProperties(map[string]string{PropertyName: "unknown"})
+	assert.False(t, ok)
+	assert.Equal(t, Legacy, s)
+}
@@ -13,15 +13,18 @@ import (
 
 	"github.com/armadaproject/armada/internal/common/certs"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/weaveworks/promrus"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	commonconfig "github.com/armadaproject/armada/internal/common/config"
+	"github.com/armadaproject/armada/internal/common/health"
 	"github.com/armadaproject/armada/internal/common/logging"
 )
 
@@ -40,12 +43,24 @@ func BindCommandlineArguments() {
 
 // TODO Move code relating to config out of common into a new package internal/serverconfig
 func LoadConfig(config interface{}, defaultPath string, overrideConfigs []string) *viper.Viper {
+	v, err := ReadConfig(config, defaultPath, overrideConfigs)
+	if err != nil {
+		commonconfig.LogValidationErrors(err)
+		os.Exit(-1)
+	}
+	return v
+}
+
+// ReadConfig decodes into config the base config file found in defaultPath, merged with overrideConfigs in order
+// and with environment variables prefixed with ARMADA_, and validates the result.
+// Keys that don't correspond to any field of config are rejected, such that misspelled or obsolete keys are reported
+// rather than silently ignored.
+func ReadConfig(config interface{}, defaultPath string, overrideConfigs []string) (*viper.Viper, error) {
 	v := viper.NewWithOptions(viper.KeyDelimiter("::"))
 	v.SetConfigName(baseConfigFileName)
 	v.AddConfigPath(defaultPath)
 	if err := v.ReadInConfig(); err != nil {
-		log.Errorf("Error reading base config path=%s name=%s: %v", defaultPath, baseConfigFileName, err)
-		os.Exit(-1)
+		return nil, errors.Wrapf(err, "error reading base config path=%s name=%s", defaultPath, baseConfigFileName)
 	}
 	log.Infof("Read base config from %s", v.ConfigFileUsed())
 
@@ -53,8 +68,7 @@ func LoadConfig(config interface{}, defaultPath string, overrideConfigs []string
 		v.SetConfigFile(overrideConfig)
 		err := v.MergeInConfig()
 		if err != nil {
-			log.Errorf("Error reading config from %s: %v", overrideConfig, err)
-			os.Exit(-1)
+			return nil, errors.Wrapf(err, "error reading config from %s", overrideConfig)
 		}
 		log.Infof("Read config from %s", v.ConfigFileUsed())
 	}
@@ -63,13 +77,14 @@ func LoadConfig(config interface{}, defaultPath string, overrideConfigs []string
 	v.SetEnvPrefix("ARMADA")
 	v.AutomaticEnv()
 
-	err := v.Unmarshal(config, commonconfig.CustomHooks...)
-	if err != nil {
-		log.Error(err)
-		os.Exit(-1)
+	decoderOptions := append([]viper.DecoderConfigOption{commonconfig.StrictDecoding}, commonconfig.CustomHooks...)
+	if err := v.Unmarshal(config, decoderOptions...); err != nil {
+		return nil, errors.WithStack(err)
 	}
-
-	return v
+	if err := commonconfig.Validate(config); err != nil {
+		return nil, err
+	}
+	return v, nil
 }
 
 func UnmarshalKey(v *viper.Viper, key string, item interface{}) error {
@@ -81,32 +96,70 @@ func ConfigureCommandLineLogging() {
 	commandLineFormatter := new(logging.CommandLineFormatter)
 	log.SetFormatter(commandLineFormatter)
 	log.SetOutput(os.Stdout)
+	configureOutput(logging.CommandLineFormat)
 }
 
+// ConfigureLogging configures the level and format of logging.StandardLogger and the module loggers, and of the
+// logrus standard logger used by components not yet logging through the logging package.
 func ConfigureLogging() {
-	log.SetLevel(readEnvironmentLogLevel())
-	log.SetFormatter(readEnvironmentLogFormat())
+	format := readEnvironmentLogFormat()
+	logging.SetLevel(readEnvironmentLogLevel())
+	log.SetFormatter(logrusFormatter(format))
 	log.SetReportCaller(true)
 	log.SetOutput(os.Stdout)
+	configureOutput(format)
+	for module, level := range readEnvironmentModuleLogLevels() {
+		logging.SetModuleLevel(module, level)
+	}
+}
+
+func configureOutput(format string) {
+	if err := logging.SetOutput(os.Stdout, format); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to configure log output, ignoring: %s\n", err)
+	}
 }
 
-func readEnvironmentLogLevel() log.Level {
+// readEnvironmentModuleLogLevels reads overrides of the log level of individual modules,
+// e.g., LOG_MODULE_LEVELS=scheduler=debug,ingester=warn.
+func readEnvironmentModuleLogLevels() map[string]logging.Level {
+	levels, ok := os.LookupEnv("LOG_MODULE_LEVELS")
+	if !ok {
+		return nil
+	}
+	rv, err := logging.ParseModuleLevels(levels)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid module log levels %s, ignoring: %s\n", levels, err)
+		return nil
+	}
+	return rv
+}
+
+func readEnvironmentLogLevel() logging.Level {
 	level, ok := os.LookupEnv("LOG_LEVEL")
 	if ok {
-		logLevel, err := log.ParseLevel(level)
+		logLevel, err := logging.ParseLevel(level)
 		if err == nil {
 			return logLevel
 		}
 	}
-	return log.InfoLevel
+	return logging.InfoLevel
 }
 
-func readEnvironmentLogFormat() log.Formatter {
+func readEnvironmentLogFormat() string {
 	formatStr, ok := os.LookupEnv("LOG_FORMAT")
 	if !ok {
-		formatStr = "colourful"
+		return logging.ColourfulFormat
+	}
+	switch format := strings.ToLower(formatStr); format {
+	case logging.JSONFormat, logging.ColourfulFormat, logging.TextFormat:
+		return format
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown log format %s, defaulting to colourful format\n", formatStr)
+		return logging.ColourfulFormat
 	}
+}
 
+func logrusFormatter(format string) log.Formatter {
 	textFormatter := &log.TextFormatter{
 		ForceColors:     true,
 		FullTimestamp:   true,
@@ -117,17 +170,14 @@ func readEnvironmentLogFormat() log.Formatter {
 		},
 	}
 
-	switch strings.ToLower(formatStr) {
-	case "json":
+	switch format {
+	case logging.JSONFormat:
 		return &log.JSONFormatter{TimestampFormat: logTimestampFormat}
-	case "colourful":
-		return textFormatter
-	case "text":
+	case logging.TextFormat:
 		textFormatter.ForceColors = false
 		textFormatter.DisableColors = true
 		return textFormatter
 	default:
-		println(os.Stderr, fmt.Sprintf("Unknown log format %s, defaulting to colourful format", formatStr))
 		return textFormatter
 	}
 }
@@ -137,11 +187,27 @@ func ServeMetrics(port uint16) (shutdown func()) {
 }
 
 func ServeMetricsFor(port uint16, gatherer prometheus.Gatherer) (shutdown func()) {
+	return serveMetrics(port, gatherer, nil)
+}
+
+// ServeMetricsAndHealthz is like ServeMetrics, but additionally serves the result of checker at /healthz.
+func ServeMetricsAndHealthz(port uint16, checker health.Checker) (shutdown func()) {
+	return serveMetrics(port, prometheus.DefaultGatherer, checker)
+}
+
+func serveMetrics(port uint16, gatherer prometheus.Gatherer, checker health.Checker) (shutdown func()) {
 	hook := promrus.MustNewPrometheusHook()
 	log.AddHook(hook)
+	// Count the messages of the logging package by the same metric as those of logrus.
+	logging.AddHook(func(entry zapcore.Entry) error {
+		return hook.Fire(&log.Entry{Level: logging.LogrusLevel(entry.Level)})
+	})
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+	if checker != nil {
+		health.SetupHealthzHttpMux(mux, checker)
+	}
 	return ServeHttp(port, mux)
 }
 
@@ -0,0 +1,71 @@
+// External test package, since the configuration packages of components import this package.
+package common_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	armadaconfig "github.com/armadaproject/armada/internal/armada/configuration"
+	binocularsconfig "github.com/armadaproject/armada/internal/binoculars/configuration"
+	"github.com/armadaproject/armada/internal/common"
+	eventingesterconfig "github.com/armadaproject/armada/internal/eventingester/configuration"
+	executorconfig "github.com/armadaproject/armada/internal/executor/configuration"
+	jobserviceconfig "github.com/armadaproject/armada/internal/jobservice/configuration"
+	lookoutingesterconfig "github.com/armadaproject/armada/internal/lookoutingesterv2/configuration"
+	lookoutconfig "github.com/armadaproject/armada/internal/lookoutv2/configuration"
+	schedulerconfig "github.com/armadaproject/armada/internal/scheduler/configuration"
+	"github.com/armadaproject/armada/internal/scheduleringester"
+)
+
+const repositoryRoot = "../.."
+
+// The configs shipped with Armada must decode strictly and pass validation, since components refuse to start otherwise.
+func TestReadConfig_ShippedConfigsAreValid(t *testing.T) {
+	tests := map[string]struct {
+		config          interface{}
+		overrideConfigs []string
+	}{
+		"armada":            {config: &armadaconfig.ArmadaConfig{}, overrideConfigs: []string{"developer/config/insecure-armada.yaml"}},
+		"binoculars":        {config: &binocularsconfig.BinocularsConfig{}},
+		"eventingester":     {config: &eventingesterconfig.EventIngesterConfiguration{}},
+		"executor":          {config: &executorconfig.ExecutorConfiguration{}},
+		"jobservice":        {config: &jobserviceconfig.JobServiceConfiguration{}, overrideConfigs: []string{"e2e/setup/jobservice.yaml"}},
+		"lookoutingesterv2": {config: &lookoutingesterconfig.LookoutIngesterV2Configuration{}},
+		"lookoutv2":         {config: &lookoutconfig.LookoutV2Config{}},
+		"scheduler":         {config: &schedulerconfig.Configuration{}, overrideConfigs: []string{"developer/config/insecure-armada.yaml"}},
+		"scheduleringester": {config: &scheduleringester.Configuration{}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			overrideConfigs := make([]string, len(tc.overrideConfigs))
+			for i, overrideConfig := range tc.overrideConfigs {
+				overrideConfigs[i] = filepath.Join(repositoryRoot, overrideConfig)
+			}
+			_, err := common.ReadConfig(tc.config, filepath.Join(repositoryRoot, "config", name), overrideConfigs)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestReadConfig_RejectsInvalidConfigs(t *testing.T) {
+	tests := map[string]string{
+		"unknown key":                    "notAField: true\n",
+		"duration without unit":          "scheduling:\n  executorTimeout: 60\n",
+		"fraction out of range":          "scheduling:\n  maximumResourceFractionToSchedule:\n    cpu: 1.5\n",
+		"unknown default priority class": "scheduling:\n  preemption:\n    defaultPriorityClass: does-not-exist\n",
+		"invalid quantity":               "scheduling:\n  defaultJobLimits:\n    cpu: one\n",
+	}
+	for name, override := range tests {
+		t.Run(name, func(t *testing.T) {
+			overrideConfig := filepath.Join(t.TempDir(), "override.yaml")
+			require.NoError(t, os.WriteFile(overrideConfig, []byte(override), 0o600))
+			var config armadaconfig.ArmadaConfig
+			_, err := common.ReadConfig(&config, filepath.Join(repositoryRoot, "config", "armada"), []string{overrideConfig})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package configuration
+
+import "time"
+
+// TracingConfig configures exporting spans to an OpenTelemetry collector.
+type TracingConfig struct {
+	// Address of an OTLP/gRPC endpoint, e.g., otel-collector:4317, to which spans are exported.
+	// If empty, spans aren't exported.
+	OtlpEndpoint string
+	// Headers added to each export request, e.g., to authenticate with the collector.
+	OtlpHeaders map[string]string
+	// If true, spans are exported without TLS.
+	OtlpInsecure bool
+	// How often ended spans are exported. Defaults to 5s.
+	ExportInterval time.Duration
+	// Timeout of each export request. Defaults to 30s.
+	ExportTimeout time.Duration
+	// Maximum number of spans exported per request. Defaults to 512.
+	MaxExportBatchSize int
+	// Maximum number of ended spans waiting to be exported; spans ending while the queue is full are dropped.
+	// Defaults to 2048.
+	MaxQueueSize int
+}
@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptors returns interceptors that start a span for each call, continuing the trace of the client
+// if it sent trace context, and add the ids of the span to the fields logged for the call.
+// Must come after the interceptor adding tags to the context of the call, which are logged.
+func UnaryServerInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		otelgrpc.UnaryServerInterceptor(),
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			addTags(ctx)
+			return handler(ctx, req)
+		},
+	}
+}
+
+// StreamServerInterceptors is like UnaryServerInterceptors, except for streaming calls.
+func StreamServerInterceptors() []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		otelgrpc.StreamServerInterceptor(),
+		func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			addTags(stream.Context())
+			return handler(srv, stream)
+		},
+	}
+}
+
+// addTags adds the ids of the span carried by ctx to the tags of ctx, such that they're logged.
+func addTags(ctx context.Context) {
+	tags := grpc_ctxtags.Extract(ctx)
+	for k, v := range LogFields(trace.SpanContextFromContext(ctx)) {
+		tags.Set(k, v)
+	}
+}
@@ -0,0 +1,180 @@
+// Package tracing traces the work done on behalf of requests, e.g., submitting a job, across Armada components using
+// OpenTelemetry. Trace context is encoded as a W3C traceparent (https://www.w3.org/TR/trace-context/) and propagated
+// via gRPC metadata, by the otelgrpc interceptors, and via the properties of Pulsar messages. The trace context of
+// the request submitting a job is also stored with the job, in an annotation, such that the spans of scheduling,
+// leasing and running the job are part of the trace in which it was submitted.
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/tracing/configuration"
+)
+
+// TraceparentKey is the key of the trace context in Pulsar message properties.
+const TraceparentKey = "traceparent"
+
+// instrumentationName is the name of the tracer by which Armada starts spans.
+const instrumentationName = "github.com/armadaproject/armada"
+
+// How long to wait for remaining spans to be exported on shutdown.
+const shutdownTimeout = 10 * time.Second
+
+// propagator encodes trace context as W3C traceparents.
+var propagator = propagation.TraceContext{}
+
+// Setup configures OpenTelemetry to propagate trace context as W3C traceparents and to start the spans of the service
+// named serviceName, which are exported to the OTLP/gRPC endpoint given by config, if any. Spans are started, and their
+// ids logged and propagated, whether or not they're exported. Returns a function that blocks until ctx is cancelled
+// and then exports any spans not yet exported, to be run alongside the other services of the component.
+func Setup(ctx *armadacontext.Context, config configuration.TracingConfig, serviceName string) (func() error, error) {
+	otel.SetTextMapPropagator(propagator)
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	}
+	if config.OtlpEndpoint != "" {
+		exporterOptions := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(config.OtlpEndpoint),
+			otlptracegrpc.WithHeaders(config.OtlpHeaders),
+		}
+		if config.OtlpInsecure {
+			exporterOptions = append(exporterOptions, otlptracegrpc.WithInsecure())
+		}
+		if config.ExportTimeout > 0 {
+			exporterOptions = append(exporterOptions, otlptracegrpc.WithTimeout(config.ExportTimeout))
+		}
+		exporter, err := otlptracegrpc.New(ctx, exporterOptions...)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, sdktrace.WithBatcher(exporter, batchOptions(config)...))
+		ctx.Infof("exporting spans to %s", config.OtlpEndpoint)
+	}
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	return func() error {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return provider.Shutdown(shutdownCtx)
+	}, nil
+}
+
+// batchOptions returns the options of the span processor batching spans for export.
+// Options not set in config are left at the defaults of the OpenTelemetry SDK.
+func batchOptions(config configuration.TracingConfig) []sdktrace.BatchSpanProcessorOption {
+	var options []sdktrace.BatchSpanProcessorOption
+	if config.ExportInterval > 0 {
+		options = append(options, sdktrace.WithBatchTimeout(config.ExportInterval))
+	}
+	if config.ExportTimeout > 0 {
+		options = append(options, sdktrace.WithExportTimeout(config.ExportTimeout))
+	}
+	if config.MaxExportBatchSize > 0 {
+		options = append(options, sdktrace.WithMaxExportBatchSize(config.MaxExportBatchSize))
+	}
+	if config.MaxQueueSize > 0 {
+		options = append(options, sdktrace.WithMaxQueueSize(config.MaxQueueSize))
+	}
+	return options
+}
+
+// StartSpanWithLogFields starts a span named name, which is the child of the span carried by ctx, if any.
+// Returns a context carrying the new span, the ids of which are added to the fields logged by the context.
+func StartSpanWithLogFields(ctx *armadacontext.Context, name string, opts ...trace.SpanStartOption) (*armadacontext.Context, trace.Span) {
+	spanCtx, span := otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+	return &armadacontext.Context{
+		Context: spanCtx,
+		Logger:  ctx.Logger.WithFields(LogFields(span.SpanContext())),
+	}, span
+}
+
+// ContinueFromProperties starts a span named name continuing the trace whose context was added to properties by
+// AddToProperties, e.g., by the publisher of a Pulsar message. Returns a context carrying the new span, the ids of
+// which are added to the fields logged by the context. If properties carry no trace context, ctx and a span that
+// isn't recorded are returned.
+func ContinueFromProperties(ctx *armadacontext.Context, name string, properties map[string]string) (*armadacontext.Context, trace.Span) {
+	parent := FromProperties(properties)
+	if !parent.IsValid() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return StartSpanWithLogFields(withRemoteParent(ctx, parent), name)
+}
+
+// ContinueFromTraceparent is like ContinueFromProperties, except it continues the trace encoded by traceparent,
+// e.g., one stored with a job by the server via Traceparent. Any span carried by ctx is linked to the new span.
+func ContinueFromTraceparent(ctx *armadacontext.Context, name string, traceparent string, opts ...trace.SpanStartOption) (*armadacontext.Context, trace.Span) {
+	parent := ParseTraceparent(traceparent)
+	if !parent.IsValid() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	if current := trace.SpanContextFromContext(ctx); current.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: current}))
+	}
+	return StartSpanWithLogFields(withRemoteParent(ctx, parent), name, opts...)
+}
+
+// withRemoteParent returns a copy of ctx carrying parent, such that spans started from it are children of parent.
+func withRemoteParent(ctx *armadacontext.Context, parent trace.SpanContext) *armadacontext.Context {
+	return &armadacontext.Context{
+		Context: trace.ContextWithRemoteSpanContext(ctx, parent),
+		Logger:  ctx.Logger,
+	}
+}
+
+// RecordError marks span as failed with err, if err is non-nil.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// LogFields returns fields identifying the span given by sc, to be added to log messages written during its operation.
+func LogFields(sc trace.SpanContext) logging.Fields {
+	if !sc.IsValid() {
+		return logging.Fields{}
+	}
+	return logging.Fields{
+		"trace_id": sc.TraceID().String(),
+		"span_id":  sc.SpanID().String(),
+	}
+}
+
+// AddToProperties adds the context of the span carried by ctx, if any, to properties,
+// e.g., the properties of a Pulsar message, such that consumers of the message can continue the trace.
+func AddToProperties(ctx context.Context, properties map[string]string) {
+	propagator.Inject(ctx, propagation.MapCarrier(properties))
+}
+
+// FromProperties returns the span context added to properties by AddToProperties, if any.
+// The returned span context is invalid if properties carry none.
+func FromProperties(properties map[string]string) trace.SpanContext {
+	return trace.SpanContextFromContext(propagator.Extract(context.Background(), propagation.MapCarrier(properties)))
+}
+
+// Traceparent returns the context of the span carried by ctx encoded as a W3C traceparent,
+// e.g., "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", or the empty string if ctx carries no span.
+func Traceparent(ctx context.Context) string {
+	properties := make(map[string]string, 1)
+	AddToProperties(ctx, properties)
+	return properties[TraceparentKey]
+}
+
+// ParseTraceparent returns the span context encoded by traceparent, which is invalid if traceparent is.
+func ParseTraceparent(traceparent string) trace.SpanContext {
+	return FromProperties(map[string]string{TraceparentKey: traceparent})
+}
@@ -0,0 +1,161 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+const testTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+// withSpanRecorder sets a tracer provider recording the spans ended during the test.
+func withSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestParseTraceparent(t *testing.T) {
+	sc := ParseTraceparent(testTraceparent)
+	require.True(t, sc.IsValid())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID().String())
+	assert.True(t, sc.IsSampled())
+	assert.True(t, sc.IsRemote())
+
+	assert.Equal(t, testTraceparent, Traceparent(trace.ContextWithSpanContext(context.Background(), sc)))
+}
+
+func TestParseTraceparent_Invalid(t *testing.T) {
+	for _, traceparent := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+	} {
+		assert.False(t, ParseTraceparent(traceparent).IsValid(), traceparent)
+	}
+}
+
+func TestTraceparent_NoSpan(t *testing.T) {
+	assert.Equal(t, "", Traceparent(context.Background()))
+}
+
+func TestProperties(t *testing.T) {
+	withSpanRecorder(t)
+	ctx, span := StartSpanWithLogFields(armadacontext.Background(), "publish")
+	defer span.End()
+
+	properties := map[string]string{"other": "value"}
+	AddToProperties(ctx, properties)
+	assert.Equal(t, "value", properties["other"])
+	assert.Equal(t, Traceparent(ctx), properties[TraceparentKey])
+	assert.Equal(t, span.SpanContext().TraceID(), FromProperties(properties).TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), FromProperties(properties).SpanID())
+
+	assert.False(t, FromProperties(map[string]string{}).IsValid())
+}
+
+func TestContinueFromProperties(t *testing.T) {
+	recorder := withSpanRecorder(t)
+	properties := map[string]string{TraceparentKey: testTraceparent}
+
+	_, span := ContinueFromProperties(armadacontext.Background(), "consume", properties)
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "consume", spans[0].Name())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+}
+
+func TestContinueFromProperties_NoParent(t *testing.T) {
+	recorder := withSpanRecorder(t)
+	parent := armadacontext.Background()
+
+	ctx, span := ContinueFromProperties(parent, "consume", map[string]string{})
+	span.End()
+
+	assert.Same(t, parent, ctx)
+	assert.False(t, span.IsRecording())
+	assert.Empty(t, recorder.Ended())
+}
+
+func TestContinueFromTraceparent(t *testing.T) {
+	recorder := withSpanRecorder(t)
+	ctx, current := StartSpanWithLogFields(armadacontext.Background(), "cycle")
+
+	_, span := ContinueFromTraceparent(ctx, "job", testTraceparent)
+	span.End()
+	current.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	job := spans[0]
+	assert.Equal(t, "job", job.Name())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", job.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", job.Parent().SpanID().String())
+	require.Len(t, job.Links(), 1)
+	assert.Equal(t, current.SpanContext(), job.Links()[0].SpanContext)
+}
+
+func TestContinueFromTraceparent_NoParent(t *testing.T) {
+	recorder := withSpanRecorder(t)
+
+	_, span := ContinueFromTraceparent(armadacontext.Background(), "job", "")
+	span.End()
+
+	assert.False(t, span.IsRecording())
+	assert.Empty(t, recorder.Ended())
+}
+
+func TestStartSpanWithLogFields(t *testing.T) {
+	withSpanRecorder(t)
+	parent, root := StartSpanWithLogFields(armadacontext.Background(), "root")
+	defer root.End()
+	ctx, child := StartSpanWithLogFields(parent, "child")
+	defer child.End()
+
+	assert.Equal(t, root.SpanContext().TraceID(), child.SpanContext().TraceID())
+	assert.NotEqual(t, root.SpanContext().SpanID(), child.SpanContext().SpanID())
+	assert.Equal(t, child.SpanContext(), trace.SpanContextFromContext(ctx))
+}
+
+func TestLogFields(t *testing.T) {
+	sc := ParseTraceparent(testTraceparent)
+	fields := LogFields(sc)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", fields["trace_id"])
+	assert.Equal(t, "00f067aa0ba902b7", fields["span_id"])
+
+	assert.Empty(t, LogFields(trace.SpanContext{}))
+}
+
+func TestRecordError(t *testing.T) {
+	recorder := withSpanRecorder(t)
+	_, span := StartSpanWithLogFields(armadacontext.Background(), "failed")
+	RecordError(span, nil)
+	RecordError(span, errors.New("boom"))
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "boom", spans[0].Status().Description)
+	require.Len(t, spans[0].Events(), 1)
+}
@@ -8,10 +8,10 @@ type PriorityClass struct {
 	Preemptible bool
 	// Limits resources assigned to jobs of this priority class.
 	// Specifically, jobs of this priority class are only scheduled if doing so does not exceed this limit.
-	MaximumResourceFractionPerQueue map[string]float64
+	MaximumResourceFractionPerQueue map[string]float64 `validate:"dive,gte=0,lte=1"`
 	// Per-pool override of MaximumResourceFractionPerQueue.
 	// If missing for a particular pool, MaximumResourceFractionPerQueue is used instead for that pool.
-	MaximumResourceFractionPerQueueByPool map[string]map[string]float64
+	MaximumResourceFractionPerQueueByPool map[string]map[string]float64 `validate:"dive,dive,gte=0,lte=1"`
 }
 
 func (priorityClass PriorityClass) Equal(other PriorityClass) bool {
@@ -4,8 +4,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/armadaproject/armada/internal/scheduler"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/retry"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/pkg/api"
@@ -89,6 +91,24 @@ func ValidateApiJob(job *api.Job, config configuration.SchedulingConfig) error {
 	if err := ValidateApiJobPodSpecs(job); err != nil {
 		return err
 	}
+	if _, _, err := jobdb.PoolPreferencesFromAnnotations(job.Annotations); err != nil {
+		return errors.WithMessagef(err, "invalid annotation %s", configuration.PoolPreferencesAnnotation)
+	}
+	if _, _, err := jobdb.JobSetMaxRunningJobsFromAnnotations(job.Annotations); err != nil {
+		return errors.WithMessagef(err, "invalid annotation %s", configuration.JobSetMaxRunningJobsAnnotation)
+	}
+	if _, _, err := jobdb.JobSetMaxPreemptedFractionFromAnnotations(job.Annotations); err != nil {
+		return errors.WithMessagef(err, "invalid annotation %s", configuration.JobSetMaxPreemptedFractionAnnotation)
+	}
+	if _, err := scheduler.JobDependenciesFromAnnotations(job.Annotations); err != nil {
+		return errors.WithMessagef(err, "invalid annotation %s", configuration.JobDependenciesAnnotation)
+	}
+	if _, hasPolicy, err := retry.PolicyFromAnnotations(job.Annotations); err != nil {
+		return errors.WithMessage(err, "invalid retry policy annotations")
+	} else if hasPolicy && job.Annotations[configuration.GangIdAnnotation] != "" {
+		// Retrying a single member of a gang would leave the gang incomplete.
+		return errors.New("retry policies aren't supported for gang jobs")
+	}
 	if err := validatePodSpecPriorityClass(job.PodSpec, true, config.Preemption.PriorityClasses); err != nil {
 		return err
 	}
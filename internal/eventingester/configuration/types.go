@@ -6,6 +6,7 @@ import (
 	"github.com/go-redis/redis"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
 )
 
 type EventIngesterConfiguration struct {
@@ -31,6 +32,8 @@ type EventIngesterConfiguration struct {
 	FatalInsertionErrors []string
 	// If non-nil, net/http/pprof endpoints are exposed on localhost on this port.
 	PprofPort *uint16
+	// Configuration of the diagnostics server, which exposes pprof, a goroutine dump, and the ingestion lag.
+	Diagnostics profilingconfig.DiagnosticsConfig
 }
 
 type EventRetentionPolicy struct {
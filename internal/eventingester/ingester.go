@@ -7,7 +7,6 @@ import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/go-redis/redis"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/app"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -22,6 +21,8 @@ import (
 	"github.com/armadaproject/armada/internal/eventingester/store"
 )
 
+var log = logging.ForModule("eventingester")
+
 // Run will create a pipeline that will take Armada event messages from Pulsar and update the
 // Events database accordingly.  This pipeline will run until a SIGTERM is received
 func Run(config *configuration.EventIngesterConfiguration) {
@@ -75,7 +76,19 @@ func Run(config *configuration.EventIngesterConfiguration) {
 		config.Metrics,
 		metrics,
 	)
-	if err := ingester.Run(app.CreateContextWithShutdown()); err != nil {
+	ctx := armadacontext.New(app.CreateContextWithShutdown(), logging.ForModule("eventingester"))
+
+	// Expose diagnostics if enabled.
+	diagnosticsServer := profiling.NewDiagnosticsServer(config.Diagnostics)
+	diagnosticsServer.SetConfig(config)
+	diagnosticsServer.AddState("ingestion", ingester.DiagnosticsState)
+	go func() {
+		if err := diagnosticsServer.Run(ctx); err != nil {
+			logging.WithStacktrace(ctx, err).Error("diagnostics server failure")
+		}
+	}()
+
+	if err := ingester.Run(ctx); err != nil {
 		panic(errors.WithMessage(err, "Error running ingestion pipeline"))
 	}
 }
@@ -6,14 +6,16 @@ import (
 
 	"github.com/go-redis/redis"
 	"github.com/hashicorp/go-multierror"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/eventingester/configuration"
 	"github.com/armadaproject/armada/internal/eventingester/model"
 )
 
+var log = logging.ForModule("eventingester")
+
 const (
 	eventStreamPrefix = "Events:"
 	dataKey           = "message"
@@ -13,6 +13,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 
@@ -22,7 +23,9 @@ import (
 	"github.com/armadaproject/armada/internal/common/healthmonitor"
 	common_metrics "github.com/armadaproject/armada/internal/common/metrics"
 	"github.com/armadaproject/armada/internal/common/task"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/executor/artifacts"
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	executor_context "github.com/armadaproject/armada/internal/executor/context"
 	"github.com/armadaproject/armada/internal/executor/job"
@@ -60,6 +63,14 @@ func StartUp(ctx *armadacontext.Context, log *logrus.Entry, config configuration
 	// Create an errgroup to run services in.
 	g, ctx := armadacontext.ErrGroup(ctx)
 
+	// Spans are only exported if an OpenTelemetry collector is configured.
+	runTracing, err := tracing.Setup(ctx, config.Tracing, "armada-executor")
+	if err != nil {
+		log.Errorf("Failed to set up tracing because %s", err)
+		os.Exit(-1)
+	}
+	g.Go(runTracing)
+
 	// Setup etcd health monitoring.
 	etcdClusterHealthMonitoringByName := make(map[string]healthmonitor.HealthMonitor, len(config.Kubernetes.Etcd.EtcdClustersHealthMonitoring))
 	for _, etcdClusterHealthMonitoring := range config.Kubernetes.Etcd.EtcdClustersHealthMonitoring {
@@ -95,7 +106,7 @@ func StartUp(ctx *armadacontext.Context, log *logrus.Entry, config configuration
 		).WithMetricsPrefix(
 			metrics.ArmadaExecutorMetricsPrefix,
 		)
-		g.Go(func() error { return etcdClustersHealthMonitoring.Run(ctx, log) })
+		g.Go(func() error { return etcdClustersHealthMonitoring.Run(ctx, ctx.Logger) })
 		prometheus.MustRegister(etcdClustersHealthMonitoring)
 	} else {
 		log.Info("no etcd URLs provided; etcd health isn't monitored")
@@ -201,7 +212,8 @@ func setupExecutorApiComponents(
 	eventReporter, stopReporter := reporter.NewJobEventReporter(
 		clusterContext,
 		jobRunState,
-		eventSender)
+		eventSender,
+		config.Kubernetes.TrackedNodeLabels)
 
 	submitter := job.NewSubmitter(
 		clusterContext,
@@ -223,6 +235,8 @@ func setupExecutorApiComponents(
 		clusterUtilisationService,
 		config.Kubernetes.PodDefaults,
 		config.Application.MaxLeasedJobs,
+		config.Application.JobLeaseRequestMaxAttempts,
+		config.Application.JobLeaseRequestRetryBackoff,
 	)
 	clusterAllocationService := service.NewClusterAllocationService(
 		clusterContext,
@@ -230,6 +244,8 @@ func setupExecutorApiComponents(
 		jobRunState,
 		submitter,
 		clusterHealthMonitor,
+		config.Application.PodCreationRate,
+		config.Application.PodCreationBurst,
 	)
 	podIssueService := service.NewIssueHandler(
 		jobRunState,
@@ -264,6 +280,11 @@ func setupExecutorApiComponents(
 		)
 	}
 
+	if config.Artifacts.Enabled {
+		artifactCollector := artifacts.NewCollector(clusterContext, eventReporter, config.Artifacts, false)
+		taskManager.Register(artifactCollector.CollectArtifacts, config.Task.ArtifactCollectionInterval, "artifact_collection")
+	}
+
 	return func() {
 		stopReporter <- true
 		conn.Close()
@@ -296,7 +317,8 @@ func setupServerApiComponents(
 	eventReporter, stopReporter := reporter.NewJobEventReporter(
 		clusterContext,
 		nil,
-		eventSender)
+		eventSender,
+		config.Kubernetes.TrackedNodeLabels)
 
 	jobContext := job.NewClusterJobContext(
 		clusterContext,
@@ -362,6 +384,11 @@ func setupServerApiComponents(
 		)
 	}
 
+	if config.Artifacts.Enabled {
+		artifactCollector := artifacts.NewCollector(clusterContext, eventReporter, config.Artifacts, true)
+		taskManager.Register(artifactCollector.CollectArtifacts, config.Task.ArtifactCollectionInterval, "artifact_collection_legacy")
+	}
+
 	if !config.Application.UseExecutorApi {
 		taskManager.Register(clusterAllocationService.AllocateSpareClusterCapacity, config.Task.AllocateSpareClusterCapacityInterval, "job_lease_request")
 		pod_metrics.ExposeClusterContextMetrics(clusterContext, clusterUtilisationService, podUtilisationService, nodeInfoService)
@@ -376,11 +403,12 @@ func setupServerApiComponents(
 
 func createConnectionToApi(connectionDetails client.ApiConnectionDetails, maxMessageSizeBytes int, grpcConfig keepalive.ClientParameters) (*grpc.ClientConn, error) {
 	grpc_prometheus.EnableClientHandlingTimeHistogram()
+	// Calls are traced, such that the scheduler continues the traces of, e.g., lease requests.
 	return client.CreateApiConnectionWithCallOptions(
 		&connectionDetails,
 		[]grpc.CallOption{grpc.MaxCallRecvMsgSize(maxMessageSizeBytes)},
-		grpc.WithChainUnaryInterceptor(grpc_prometheus.UnaryClientInterceptor),
-		grpc.WithChainStreamInterceptor(grpc_prometheus.StreamClientInterceptor),
+		grpc.WithChainUnaryInterceptor(grpc_prometheus.UnaryClientInterceptor, otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(grpc_prometheus.StreamClientInterceptor, otelgrpc.StreamClientInterceptor()),
 		grpc.WithKeepaliveParams(grpcConfig),
 	)
 }
@@ -0,0 +1,181 @@
+package artifacts
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	commonUtil "github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	clusterContext "github.com/armadaproject/armada/internal/executor/context"
+	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/internal/executor/reporter"
+	"github.com/armadaproject/armada/internal/executor/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Name of the artifact holding the log of a container, i.e., its stdout and stderr.
+const logArtifactName = "log"
+
+type bucketUploader struct {
+	queues   []string
+	uploader Uploader
+}
+
+// Collector uploads the logs of the containers of finished jobs to object storage,
+// and reports the URLs of the uploaded objects in a JobArtifactsEvent.
+// Pods are annotated once their artifacts have been reported, such that they're only uploaded once.
+type Collector struct {
+	clusterContext clusterContext.ClusterContext
+	eventReporter  reporter.EventReporter
+	uploaders      []bucketUploader
+	maxLogBytes    int64
+	uploadTimeout  time.Duration
+	legacyMode     bool
+	// Pods whose artifacts are being uploaded or reported.
+	inProgress      map[types.UID]bool
+	inProgressMutex sync.Mutex
+}
+
+func NewCollector(
+	clusterContext clusterContext.ClusterContext,
+	eventReporter reporter.EventReporter,
+	config configuration.ArtifactConfiguration,
+	legacyMode bool,
+) *Collector {
+	uploaders := make([]bucketUploader, len(config.Buckets))
+	for i, bucket := range config.Buckets {
+		uploaders[i] = bucketUploader{queues: bucket.Queues, uploader: NewS3Uploader(bucket)}
+	}
+	return newCollector(clusterContext, eventReporter, uploaders, config.MaxLogBytes, config.UploadTimeout, legacyMode)
+}
+
+func newCollector(
+	clusterContext clusterContext.ClusterContext,
+	eventReporter reporter.EventReporter,
+	uploaders []bucketUploader,
+	maxLogBytes int64,
+	uploadTimeout time.Duration,
+	legacyMode bool,
+) *Collector {
+	return &Collector{
+		clusterContext: clusterContext,
+		eventReporter:  eventReporter,
+		uploaders:      uploaders,
+		maxLogBytes:    maxLogBytes,
+		uploadTimeout:  uploadTimeout,
+		legacyMode:     legacyMode,
+		inProgress:     map[types.UID]bool{},
+	}
+}
+
+func (c *Collector) CollectArtifacts() {
+	pods, err := c.clusterContext.GetBatchPods()
+	if err != nil {
+		log.Errorf("Failed to collect artifacts because unable to get pods: %v", err)
+		return
+	}
+	pods = util.FilterPods(pods, func(pod *v1.Pod) bool {
+		return util.IsManagedPod(pod) &&
+			util.IsLegacyManagedPod(pod) == c.legacyMode &&
+			util.IsInTerminalState(pod) &&
+			!util.IsMarkedForDeletion(pod) &&
+			!haveArtifactsBeenReported(pod) &&
+			c.uploaderFor(util.ExtractQueue(pod)) != nil
+	})
+	util.ProcessItemsWithThreadPool(armadacontext.Background(), 10, pods, c.collect)
+}
+
+func (c *Collector) collect(pod *v1.Pod) {
+	if !c.start(pod) {
+		return
+	}
+	uploader := c.uploaderFor(util.ExtractQueue(pod))
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), c.uploadTimeout)
+	defer cancel()
+
+	var artifacts []*api.Artifact
+	containerStatuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+	for _, containerStatus := range containerStatuses {
+		if containerStatus.State.Terminated == nil {
+			continue
+		}
+		logs, err := c.clusterContext.GetPodLogs(ctx, pod, containerStatus.Name, c.maxLogBytes)
+		if err != nil {
+			log.Errorf("Failed to get log of container %s of pod %s: %v", containerStatus.Name, pod.Name, err)
+			c.finish(pod)
+			return
+		}
+		key := fmt.Sprintf(
+			"%s/%s/%s/%s/%s.log",
+			util.ExtractQueue(pod), util.ExtractJobSet(pod), util.ExtractJobId(pod), pod.UID, containerStatus.Name,
+		)
+		url, err := uploader.Upload(ctx, key, logs, "text/plain; charset=utf-8")
+		if err != nil {
+			log.Errorf("Failed to upload log of container %s of pod %s: %v", containerStatus.Name, pod.Name, err)
+			c.finish(pod)
+			return
+		}
+		artifacts = append(artifacts, &api.Artifact{
+			Name:          logArtifactName,
+			ContainerName: containerStatus.Name,
+			Url:           url,
+			SizeBytes:     int64(len(logs)),
+		})
+	}
+
+	event := reporter.CreateJobArtifactsEvent(pod, c.clusterContext.GetClusterId(), artifacts)
+	c.eventReporter.QueueEvent(reporter.EventMessage{Event: event, JobRunId: util.ExtractJobRunId(pod)}, func(err error) {
+		defer c.finish(pod)
+		if err != nil {
+			log.Errorf("Failed to report event JobArtifactsEvent for pod %s: %v", pod.Name, err)
+			return
+		}
+		err = c.clusterContext.AddAnnotation(pod, map[string]string{domain.ArtifactsReported: time.Now().String()})
+		if err != nil {
+			log.Errorf("Failed to add artifacts reported annotation to pod %s: %v", pod.Name, err)
+		}
+	})
+}
+
+// uploaderFor returns the uploader for the first bucket listing the queue, or else the first bucket listing no queues,
+// or nil if there's no such bucket.
+func (c *Collector) uploaderFor(queue string) Uploader {
+	var defaultUploader Uploader
+	for _, u := range c.uploaders {
+		if commonUtil.ContainsString(u.queues, queue) {
+			return u.uploader
+		}
+		if len(u.queues) == 0 && defaultUploader == nil {
+			defaultUploader = u.uploader
+		}
+	}
+	return defaultUploader
+}
+
+// start marks the artifacts of the pod as in progress, and returns false if they already were.
+func (c *Collector) start(pod *v1.Pod) bool {
+	c.inProgressMutex.Lock()
+	defer c.inProgressMutex.Unlock()
+	if c.inProgress[pod.UID] {
+		return false
+	}
+	c.inProgress[pod.UID] = true
+	return true
+}
+
+func (c *Collector) finish(pod *v1.Pod) {
+	c.inProgressMutex.Lock()
+	defer c.inProgressMutex.Unlock()
+	delete(c.inProgress, pod.UID)
+}
+
+func haveArtifactsBeenReported(pod *v1.Pod) bool {
+	_, exists := pod.Annotations[domain.ArtifactsReported]
+	return exists
+}
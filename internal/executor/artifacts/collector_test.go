@@ -0,0 +1,144 @@
+package artifacts
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	fakecontext "github.com/armadaproject/armada/internal/executor/context/fake"
+	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/internal/executor/reporter/mocks"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type fakeUploader struct {
+	uploaded map[string][]byte
+	fail     bool
+}
+
+func (u *fakeUploader) Upload(_ *armadacontext.Context, key string, body []byte, _ string) (string, error) {
+	if u.fail {
+		return "", fmt.Errorf("upload failed")
+	}
+	u.uploaded[key] = body
+	return "https://bucket/" + key, nil
+}
+
+func TestCollector_CollectArtifacts(t *testing.T) {
+	clusterContext, eventReporter, uploader, collector := setupCollectorTest([]string{})
+	pod := makeFinishedPod("queue")
+	clusterContext.Pods[pod.Name] = pod
+	clusterContext.PodLogs[pod.Name] = map[string][]byte{"init": []byte("init log"), "main": []byte("main log")}
+
+	collector.CollectArtifacts()
+
+	assert.Equal(t, map[string][]byte{
+		"queue/job-set/job-id/pod-uid/init.log": []byte("init log"),
+		"queue/job-set/job-id/pod-uid/main.log": []byte("main log"),
+	}, uploader.uploaded)
+
+	require.Len(t, eventReporter.ReceivedEvents, 1)
+	assert.Equal(t, "run-id", eventReporter.ReceivedEvents[0].JobRunId)
+	event, ok := eventReporter.ReceivedEvents[0].Event.(*api.JobArtifactsEvent)
+	require.True(t, ok)
+	assert.Equal(t, "job-id", event.JobId)
+	assert.Equal(t, []*api.Artifact{
+		{Name: "log", ContainerName: "init", Url: "https://bucket/queue/job-set/job-id/pod-uid/init.log", SizeBytes: 8},
+		{Name: "log", ContainerName: "main", Url: "https://bucket/queue/job-set/job-id/pod-uid/main.log", SizeBytes: 8},
+	}, event.Artifacts)
+
+	assert.Contains(t, clusterContext.AnnotationsAdded["job-id"], domain.ArtifactsReported)
+}
+
+func TestCollector_CollectArtifacts_SkipsPods(t *testing.T) {
+	running := makeFinishedPod("queue")
+	running.Status.Phase = v1.PodRunning
+	reported := makeFinishedPod("queue")
+	reported.Annotations[domain.ArtifactsReported] = time.Now().String()
+	otherQueue := makeFinishedPod("other-queue")
+	legacy := makeFinishedPod("queue")
+	delete(legacy.Labels, domain.JobRunId)
+
+	for name, pod := range map[string]*v1.Pod{"running": running, "reported": reported, "otherQueue": otherQueue, "legacy": legacy} {
+		t.Run(name, func(t *testing.T) {
+			clusterContext, eventReporter, uploader, collector := setupCollectorTest([]string{"queue"})
+			clusterContext.Pods[pod.Name] = pod
+
+			collector.CollectArtifacts()
+
+			assert.Empty(t, uploader.uploaded)
+			assert.Empty(t, eventReporter.ReceivedEvents)
+		})
+	}
+}
+
+func TestCollector_CollectArtifacts_UploadFailure(t *testing.T) {
+	clusterContext, eventReporter, uploader, collector := setupCollectorTest([]string{})
+	uploader.fail = true
+	pod := makeFinishedPod("queue")
+	clusterContext.Pods[pod.Name] = pod
+
+	collector.CollectArtifacts()
+
+	assert.Empty(t, eventReporter.ReceivedEvents)
+	assert.NotContains(t, clusterContext.AnnotationsAdded["job-id"], domain.ArtifactsReported)
+
+	// The upload is retried on the next collection.
+	uploader.fail = false
+	collector.CollectArtifacts()
+	assert.Len(t, eventReporter.ReceivedEvents, 1)
+}
+
+func TestCollector_UploaderFor(t *testing.T) {
+	queueUploader := &fakeUploader{}
+	defaultUploader := &fakeUploader{}
+	collector := newCollector(nil, nil, []bucketUploader{
+		{queues: []string{"queue-a"}, uploader: queueUploader},
+		{uploader: defaultUploader},
+	}, 0, time.Minute, false)
+
+	assert.Same(t, queueUploader, collector.uploaderFor("queue-a"))
+	assert.Same(t, defaultUploader, collector.uploaderFor("queue-b"))
+
+	collector = newCollector(nil, nil, []bucketUploader{{queues: []string{"queue-a"}, uploader: queueUploader}}, 0, time.Minute, false)
+	assert.Nil(t, collector.uploaderFor("queue-b"))
+}
+
+func setupCollectorTest(queues []string) (*fakecontext.SyncFakeClusterContext, *mocks.FakeEventReporter, *fakeUploader, *Collector) {
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	eventReporter := mocks.NewFakeEventReporter()
+	uploader := &fakeUploader{uploaded: map[string][]byte{}}
+	collector := newCollector(clusterContext, eventReporter, []bucketUploader{{queues: queues, uploader: uploader}}, 0, time.Minute, false)
+	return clusterContext, eventReporter, uploader, collector
+}
+
+func makeFinishedPod(queue string) *v1.Pod {
+	terminated := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0}}
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "armada-job-id-0",
+			Namespace: "default",
+			UID:       types.UID("pod-uid"),
+			Labels: map[string]string{
+				domain.JobId:    "job-id",
+				domain.JobRunId: "run-id",
+				domain.Queue:    queue,
+			},
+			Annotations: map[string]string{
+				domain.JobSetId: "job-set",
+			},
+		},
+		Status: v1.PodStatus{
+			Phase:                 v1.PodSucceeded,
+			InitContainerStatuses: []v1.ContainerStatus{{Name: "init", State: terminated}},
+			ContainerStatuses:     []v1.ContainerStatus{{Name: "main", State: terminated}},
+		},
+	}
+}
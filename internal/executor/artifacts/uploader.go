@@ -0,0 +1,134 @@
+package artifacts
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+)
+
+// Uploader uploads objects to object storage.
+type Uploader interface {
+	// Upload stores body under key, and returns the URL at which the object can be found.
+	Upload(ctx *armadacontext.Context, key string, body []byte, contentType string) (string, error)
+}
+
+// S3Uploader uploads objects to a bucket via the S3 API, signing requests with AWS signature version 4.
+// Since the S3 API is supported by most object stores, this is also used for, e.g., Google Cloud Storage.
+type S3Uploader struct {
+	bucket     configuration.BucketConfiguration
+	httpClient *http.Client
+	clock      clock.Clock
+}
+
+func NewS3Uploader(bucket configuration.BucketConfiguration) *S3Uploader {
+	return &S3Uploader{
+		bucket:     bucket,
+		httpClient: http.DefaultClient,
+		clock:      clock.RealClock{},
+	}
+}
+
+func (u *S3Uploader) Upload(ctx *armadacontext.Context, key string, body []byte, contentType string) (string, error) {
+	key = strings.TrimPrefix(u.bucket.Prefix+key, "/")
+	objectUrl := strings.TrimSuffix(u.bucket.Endpoint, "/") + "/" + escapePath(u.bucket.Bucket+"/"+key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, objectUrl, bytes.NewReader(body))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	u.sign(req, body)
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", errors.Errorf("uploading %s failed with status %s: %s", objectUrl, resp.Status, respBody)
+	}
+	if u.bucket.LinkBaseUrl != "" {
+		return u.bucket.LinkBaseUrl + escapePath(key), nil
+	}
+	return objectUrl, nil
+}
+
+// sign adds the headers authenticating req, as described at
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (u *S3Uploader) sign(req *http.Request, body []byte) {
+	now := u.clock.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := fmt.Sprintf(
+		"content-type:%s\nhost:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n",
+		req.Header.Get("Content-Type"), req.URL.Host, payloadHash, amzDate,
+	)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, u.bucket.Region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSha256([]byte("AWS4"+u.bucket.SecretAccessKey), date)
+	signingKey = hmacSha256(signingKey, u.bucket.Region)
+	signingKey = hmacSha256(signingKey, "s3")
+	signingKey = hmacSha256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSha256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		u.bucket.AccessKeyId, scope, signedHeaders, signature,
+	))
+}
+
+// escapePath percent-encodes each byte of a slash-separated path other than unreserved characters and slashes,
+// which is how S3 canonicalises paths when verifying signatures.
+func escapePath(path string) string {
+	var sb strings.Builder
+	for _, b := range []byte(path) {
+		if ('A' <= b && b <= 'Z') || ('a' <= b && b <= 'z') || ('0' <= b && b <= '9') ||
+			b == '-' || b == '_' || b == '.' || b == '~' || b == '/' {
+			sb.WriteByte(b)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", b)
+		}
+	}
+	return sb.String()
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,86 @@
+package artifacts
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+)
+
+func TestS3Uploader_Upload(t *testing.T) {
+	var receivedRequest *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedRequest = r
+		receivedBody, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	uploader := NewS3Uploader(configuration.BucketConfiguration{
+		Endpoint:        server.URL + "/",
+		Region:          "eu-west-2",
+		Bucket:          "artifacts",
+		Prefix:          "armada/",
+		AccessKeyId:     "access-key",
+		SecretAccessKey: "secret-key",
+	})
+	uploader.clock = clock.NewFakeClock(time.Date(2023, 5, 1, 12, 30, 0, 0, time.UTC))
+
+	url, err := uploader.Upload(armadacontext.Background(), "queue/job set/job/main.log", []byte("hello"), "text/plain")
+	require.NoError(t, err)
+
+	assert.Equal(t, server.URL+"/artifacts/armada/queue/job%20set/job/main.log", url)
+	assert.Equal(t, http.MethodPut, receivedRequest.Method)
+	assert.Equal(t, "/artifacts/armada/queue/job%20set/job/main.log", receivedRequest.URL.EscapedPath())
+	assert.Equal(t, []byte("hello"), receivedBody)
+	assert.Equal(t, "text/plain", receivedRequest.Header.Get("Content-Type"))
+	assert.Equal(t, "20230501T123000Z", receivedRequest.Header.Get("X-Amz-Date"))
+	assert.Equal(t, sha256Hex([]byte("hello")), receivedRequest.Header.Get("X-Amz-Content-Sha256"))
+	assert.Regexp(
+		t,
+		"^AWS4-HMAC-SHA256 Credential=access-key/20230501/eu-west-2/s3/aws4_request, "+
+			"SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, Signature=[0-9a-f]{64}$",
+		receivedRequest.Header.Get("Authorization"),
+	)
+}
+
+func TestS3Uploader_Upload_LinkBaseUrl(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	uploader := NewS3Uploader(configuration.BucketConfiguration{
+		Endpoint:    server.URL,
+		Region:      "auto",
+		Bucket:      "artifacts",
+		LinkBaseUrl: "https://console.example.com/artifacts/",
+	})
+
+	url, err := uploader.Upload(armadacontext.Background(), "queue/main.log", []byte("hello"), "text/plain")
+	require.NoError(t, err)
+	assert.Equal(t, "https://console.example.com/artifacts/queue/main.log", url)
+}
+
+func TestS3Uploader_Upload_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("SignatureDoesNotMatch"))
+	}))
+	defer server.Close()
+
+	uploader := NewS3Uploader(configuration.BucketConfiguration{Endpoint: server.URL, Region: "auto", Bucket: "artifacts"})
+
+	_, err := uploader.Upload(armadacontext.Background(), "queue/main.log", []byte("hello"), "text/plain")
+	assert.ErrorContains(t, err, "SignatureDoesNotMatch")
+}
+
+func TestEscapePath(t *testing.T) {
+	assert.Equal(t, "a/b-c_d.e~f/%20%2B%3D%C3%A9", escapePath("a/b-c_d.e~f/ +=é"))
+}
@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"google.golang.org/grpc/keepalive"
+	v1 "k8s.io/api/core/v1"
 
 	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	tracingconfig "github.com/armadaproject/armada/internal/common/tracing/configuration"
 	"github.com/armadaproject/armada/internal/executor/configuration/podchecks"
 	"github.com/armadaproject/armada/pkg/client"
 )
@@ -22,11 +24,47 @@ type ApplicationConfiguration struct {
 	// MaxLeasedJobs is the maximum jobs the executor should have in Leased state ay any one time (i.e jobs not submitted to kubernetes)
 	// It is largely used to calculate how many new jobs to request from the scheduler
 	MaxLeasedJobs int
+	// Maximum number of attempts made at each lease request that fails since the scheduler is unavailable or
+	// rejects the request for exceeding its limits on concurrent lease requests. If zero, failed requests aren't retried.
+	JobLeaseRequestMaxAttempts int
+	// Attempts at a lease request are separated by a random duration of up to this value, doubled after each attempt,
+	// such that executors rejected at the same time don't retry in lockstep.
+	JobLeaseRequestRetryBackoff time.Duration
+	// Maximum number of pods created per second; pods for leased runs are created in order of decreasing priority.
+	// Runs exceeding this limit remain leased until their pods can be created. If zero, there's no such limit.
+	PodCreationRate float64
+	// Maximum number of pods that may be created at once, i.e., the burst size of the pod creation rate limit.
+	PodCreationBurst int
 }
 
 type PodDefaults struct {
 	SchedulerName string
 	Ingress       *IngressConfiguration
+	// Containers added to the pods of jobs, e.g., to stage data in before jobs start and artifacts out after they stop.
+	LifecycleHooks []LifecycleHook `validate:"dive"`
+	// Path at which the volume shared between the containers of a job and its lifecycle hooks is mounted.
+	LifecycleHookVolumeMountPath string
+}
+
+type LifecycleHookType string
+
+const (
+	// PreStart hooks run as init containers, before any other container of the job.
+	PreStart LifecycleHookType = "PreStart"
+	// PostStop hooks run as sidecars alongside the containers of the job.
+	// Since the pod is shared, they should wait for the containers of the job to exit before, e.g., uploading artifacts.
+	PostStop LifecycleHookType = "PostStop"
+)
+
+// LifecycleHook is a container the executor adds to the pods of jobs.
+type LifecycleHook struct {
+	// Name of the hook, which is used to name its container and reported if the hook fails.
+	Name string            `validate:"required"`
+	Type LifecycleHookType `validate:"oneof=PreStart PostStop"`
+	// If non-empty, the hook is only added to the pods of jobs in these queues.
+	Queues []string
+	// Template of the container running the hook. Its name is set by the executor.
+	Container v1.Container
 }
 
 type StateChecksConfiguration struct {
@@ -128,6 +166,39 @@ type TaskConfiguration struct {
 	UtilisationEventReportingInterval     time.Duration
 	ResourceCleanupInterval               time.Duration
 	StateProcessorInterval                time.Duration
+	ArtifactCollectionInterval            time.Duration
+}
+
+type ArtifactConfiguration struct {
+	// If true, the logs of the containers of finished jobs are uploaded to object storage,
+	// and the URLs of the uploaded objects are reported in a JobArtifactsEvent.
+	Enabled bool
+	// Buckets artifacts are uploaded to. Jobs are uploaded to the first bucket listing their queue,
+	// or else to the first bucket listing no queues. Artifacts of jobs with no such bucket aren't collected.
+	Buckets []BucketConfiguration `validate:"dive"`
+	// Maximum number of bytes of the log of each container uploaded. Longer logs are truncated. Zero means no limit.
+	MaxLogBytes int64
+	// Maximum time taken to collect and upload the artifacts of a job.
+	UploadTimeout time.Duration
+}
+
+// BucketConfiguration is a bucket of an object store supporting the S3 API, e.g., S3 itself,
+// Google Cloud Storage with HMAC keys, or MinIO.
+type BucketConfiguration struct {
+	// Base URL of the object store, e.g., https://s3.eu-west-2.amazonaws.com or https://storage.googleapis.com.
+	Endpoint string `validate:"required"`
+	// Region requests are signed for, e.g., eu-west-2; "auto" for Google Cloud Storage.
+	Region string `validate:"required"`
+	Bucket string `validate:"required"`
+	// Prefix of the keys of uploaded objects.
+	Prefix string
+	// If non-empty, the bucket is only used for jobs of these queues.
+	Queues          []string
+	AccessKeyId     string
+	SecretAccessKey string
+	// If set, the URLs reported for uploaded objects are this followed by their key, e.g., to link to a web console
+	// instead of the object store API. Otherwise, the URL of the object in the object store is reported.
+	LinkBaseUrl string
 }
 
 type MetricConfiguration struct {
@@ -171,4 +242,7 @@ type ExecutorConfiguration struct {
 
 	Kubernetes KubernetesConfiguration
 	Task       TaskConfiguration
+	Artifacts  ArtifactConfiguration
+	// Exporting of spans to an OpenTelemetry collector.
+	Tracing tracingconfig.TracingConfig
 }
@@ -10,6 +10,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	networking "k8s.io/api/networking/v1"
+	scheduling "k8s.io/api/scheduling/v1"
+	storage "k8s.io/api/storage/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -20,6 +22,8 @@ import (
 	informer "k8s.io/client-go/informers/core/v1"
 	discovery_informer "k8s.io/client-go/informers/discovery/v1"
 	network_informer "k8s.io/client-go/informers/networking/v1"
+	scheduling_informer "k8s.io/client-go/informers/scheduling/v1"
+	storage_informer "k8s.io/client-go/informers/storage/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
@@ -52,9 +56,13 @@ type ClusterContext interface {
 	GetNode(nodeName string) (*v1.Node, error)
 	GetNodeStatsSummary(*armadacontext.Context, *v1.Node) (*v1alpha1.Summary, error)
 	GetPodEvents(pod *v1.Pod) ([]*v1.Event, error)
+	// GetPodLogs returns the log of the named container of the pod, truncated to limitBytes if positive.
+	GetPodLogs(ctx *armadacontext.Context, pod *v1.Pod, containerName string, limitBytes int64) ([]byte, error)
 	GetServices(pod *v1.Pod) ([]*v1.Service, error)
 	GetIngresses(pod *v1.Pod) ([]*networking.Ingress, error)
 	GetEndpointSlices(namespace string, labelName string, labelValue string) ([]*discovery.EndpointSlice, error)
+	GetStorageClasses() ([]*storage.StorageClass, error)
+	GetPriorityClasses() ([]*scheduling.PriorityClass, error)
 
 	SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error)
 	SubmitService(service *v1.Service) (*v1.Service, error)
@@ -81,6 +89,8 @@ type KubernetesClusterContext struct {
 	serviceInformer          informer.ServiceInformer
 	ingressInformer          network_informer.IngressInformer
 	endpointSliceInformer    discovery_informer.EndpointSliceInformer
+	storageClassInformer     storage_informer.StorageClassInformer
+	priorityClassInformer    scheduling_informer.PriorityClassInformer
 	stopper                  chan struct{}
 	kubernetesClient         kubernetes.Interface
 	kubernetesClientProvider cluster.KubernetesClientProvider
@@ -120,6 +130,8 @@ func NewClusterContext(
 		serviceInformer:          factory.Core().V1().Services(),
 		ingressInformer:          factory.Networking().V1().Ingresses(),
 		endpointSliceInformer:    factory.Discovery().V1().EndpointSlices(),
+		storageClassInformer:     factory.Storage().V1().StorageClasses(),
+		priorityClassInformer:    factory.Scheduling().V1().PriorityClasses(),
 		kubernetesClient:         kubernetesClient,
 		kubernetesClientProvider: kubernetesClientProvider,
 		podKillTimeout:           killTimeout,
@@ -142,6 +154,8 @@ func NewClusterContext(
 	context.serviceInformer.Lister()
 	context.ingressInformer.Lister()
 	context.endpointSliceInformer.Lister()
+	context.storageClassInformer.Lister()
+	context.priorityClassInformer.Lister()
 
 	err := context.eventInformer.Informer().AddIndexers(cache.Indexers{podByUIDIndex: indexPodByUID})
 	if err != nil {
@@ -215,6 +229,14 @@ func (c *KubernetesClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error
 	return eventsTyped, nil
 }
 
+func (c *KubernetesClusterContext) GetPodLogs(ctx *armadacontext.Context, pod *v1.Pod, containerName string, limitBytes int64) ([]byte, error) {
+	options := &v1.PodLogOptions{Container: containerName}
+	if limitBytes > 0 {
+		options.LimitBytes = &limitBytes
+	}
+	return c.kubernetesClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, options).DoRaw(ctx)
+}
+
 func (c *KubernetesClusterContext) GetNodes() ([]*v1.Node, error) {
 	return c.nodeInformer.Lister().List(labels.Everything())
 }
@@ -223,6 +245,14 @@ func (c *KubernetesClusterContext) GetNode(nodeName string) (*v1.Node, error) {
 	return c.nodeInformer.Lister().Get(nodeName)
 }
 
+func (c *KubernetesClusterContext) GetStorageClasses() ([]*storage.StorageClass, error) {
+	return c.storageClassInformer.Lister().List(labels.Everything())
+}
+
+func (c *KubernetesClusterContext) GetPriorityClasses() ([]*scheduling.PriorityClass, error) {
+	return c.priorityClassInformer.Lister().List(labels.Everything())
+}
+
 func (c *KubernetesClusterContext) GetNodeStatsSummary(ctx *armadacontext.Context, node *v1.Node) (*v1alpha1.Summary, error) {
 	request := c.kubernetesClient.
 		CoreV1().
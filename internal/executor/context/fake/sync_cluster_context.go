@@ -1,12 +1,13 @@
 package fake
 
 import (
-	"errors"
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	networking "k8s.io/api/networking/v1"
+	scheduling "k8s.io/api/scheduling/v1"
+	storage "k8s.io/api/storage/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
@@ -16,14 +17,18 @@ import (
 )
 
 type SyncFakeClusterContext struct {
-	Pods                 map[string]*v1.Pod
-	AnnotationsAdded     map[string]map[string]string
+	Pods             map[string]*v1.Pod
+	Nodes            map[string]*v1.Node
+	AnnotationsAdded map[string]map[string]string
+	// Logs returned by GetPodLogs, by pod name and container name.
+	PodLogs              map[string]map[string][]byte
+	PriorityClasses      []*scheduling.PriorityClass
 	podEventHandlers     []*cache.ResourceEventHandlerFuncs
 	clusterEventHandlers []*cache.ResourceEventHandlerFuncs
 }
 
 func NewSyncFakeClusterContext() *SyncFakeClusterContext {
-	c := &SyncFakeClusterContext{Pods: map[string]*v1.Pod{}, AnnotationsAdded: map[string]map[string]string{}}
+	c := &SyncFakeClusterContext{Pods: map[string]*v1.Pod{}, Nodes: map[string]*v1.Node{}, AnnotationsAdded: map[string]map[string]string{}, PodLogs: map[string]map[string][]byte{}}
 	return c
 }
 
@@ -54,17 +59,33 @@ func (c *SyncFakeClusterContext) GetActiveBatchPods() ([]*v1.Pod, error) {
 }
 
 func (c *SyncFakeClusterContext) GetNodes() ([]*v1.Node, error) {
-	return make([]*v1.Node, 0), nil
+	nodes := make([]*v1.Node, 0, len(c.Nodes))
+	for _, node := range c.Nodes {
+		nodes = append(nodes, node.DeepCopy())
+	}
+	return nodes, nil
 }
 
 func (c *SyncFakeClusterContext) GetNode(nodeName string) (*v1.Node, error) {
-	return nil, errors.New("GetNode Not implemented")
+	node, ok := c.Nodes[nodeName]
+	if !ok {
+		return nil, fmt.Errorf("node %s not found", nodeName)
+	}
+	return node.DeepCopy(), nil
 }
 
 func (c *SyncFakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
 	return []*v1.Event{}, nil
 }
 
+func (c *SyncFakeClusterContext) GetPodLogs(ctx *armadacontext.Context, pod *v1.Pod, containerName string, limitBytes int64) ([]byte, error) {
+	logs := c.PodLogs[pod.Name][containerName]
+	if limitBytes > 0 && int64(len(logs)) > limitBytes {
+		logs = logs[:limitBytes]
+	}
+	return logs, nil
+}
+
 func (c *SyncFakeClusterContext) SubmitService(service *v1.Service) (*v1.Service, error) {
 	return nil, fmt.Errorf("Services not implemented in SyncFakeClusterContext")
 }
@@ -89,6 +110,14 @@ func (c *SyncFakeClusterContext) GetEndpointSlices(namespace string, labelName s
 	return nil, fmt.Errorf("EndpointSlices not implemented in SyncFakeClusterContext")
 }
 
+func (c *SyncFakeClusterContext) GetStorageClasses() ([]*storage.StorageClass, error) {
+	return nil, nil
+}
+
+func (c *SyncFakeClusterContext) GetPriorityClasses() ([]*scheduling.PriorityClass, error) {
+	return c.PriorityClasses, nil
+}
+
 func (c *SyncFakeClusterContext) DeleteIngress(ingress *networking.Ingress) error {
 	return fmt.Errorf("Ingresses not implemented in SyncFakeClusterContext")
 }
@@ -12,6 +12,7 @@ const (
 	AssociatedIngressesCount = "associated_ingresses_count"
 	AssociatedServicesCount  = "associated_services_count"
 	IngressReported          = "ingress_reported"
+	ArtifactsReported        = "artifacts_reported"
 	MarkedForDeletion        = "deletion_requested"
 	JobDoneAnnotation        = "reported_done"
 	JobPreemptedAnnotation   = "reported_preempted"
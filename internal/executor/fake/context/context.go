@@ -16,6 +16,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	networking "k8s.io/api/networking/v1"
+	scheduling "k8s.io/api/scheduling/v1"
+	storage "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -130,6 +132,10 @@ func (c *FakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
 	return []*v1.Event{}, nil
 }
 
+func (c *FakeClusterContext) GetPodLogs(ctx *armadacontext.Context, pod *v1.Pod, containerName string, limitBytes int64) ([]byte, error) {
+	return []byte{}, nil
+}
+
 func (c *FakeClusterContext) SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error) {
 	saved := c.savePod(pod)
 
@@ -209,6 +215,14 @@ func (c *FakeClusterContext) GetEndpointSlices(namespace string, labelName strin
 	return nil, fmt.Errorf("EndpointSlices not implemented in SyncFakeClusterContext")
 }
 
+func (c *FakeClusterContext) GetStorageClasses() ([]*storage.StorageClass, error) {
+	return nil, nil
+}
+
+func (c *FakeClusterContext) GetPriorityClasses() ([]*scheduling.PriorityClass, error) {
+	return nil, nil
+}
+
 func (c *FakeClusterContext) DeleteIngress(ingress *networking.Ingress) error {
 	return errors.Errorf("Ingresses not implemented in FakeClusterContext")
 }
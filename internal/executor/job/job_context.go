@@ -39,6 +39,8 @@ type PodIssue struct {
 	Reported       bool
 	Type           IssueType
 	Cause          api.Cause
+	// Category of the failure reported if the issue isn't retryable.
+	FailureCategory api.FailureCategory
 }
 
 type jobRecord struct {
@@ -235,11 +237,12 @@ func (c *ClusterJobContext) detectStuckPods(runningJob *RunningJob) {
 			// pod is stuck in terminating phase, this sometimes happen on node failure
 			// it is safer to produce failed event than retrying as the job might have run already
 			issue := &PodIssue{
-				OriginatingPod: pod.DeepCopy(),
-				Pods:           runningJob.ActivePods,
-				Message:        "pod stuck in terminating phase, this might be due to platform problems",
-				Retryable:      false,
-				Type:           StuckTerminating,
+				OriginatingPod:  pod.DeepCopy(),
+				Pods:            runningJob.ActivePods,
+				Message:         "pod stuck in terminating phase, this might be due to platform problems",
+				Retryable:       false,
+				Type:            StuckTerminating,
+				FailureCategory: api.FailureCategory_NodeLost,
 			}
 			runningJob.Issue = issue
 			c.registerIssue(runningJob.JobId, issue)
@@ -271,11 +274,12 @@ func (c *ClusterJobContext) detectStuckPods(runningJob *RunningJob) {
 				log.Warnf("Found issue with pod %s in namespace %s: %s", pod.Name, pod.Namespace, message)
 
 				issue := &PodIssue{
-					OriginatingPod: pod.DeepCopy(),
-					Pods:           runningJob.ActivePods,
-					Message:        message,
-					Retryable:      retryable,
-					Type:           podIssueType,
+					OriginatingPod:  pod.DeepCopy(),
+					Pods:            runningJob.ActivePods,
+					Message:         message,
+					Retryable:       retryable,
+					Type:            podIssueType,
+					FailureCategory: util.ExtractPodFailureCategory(pod),
 				}
 				runningJob.Issue = issue
 				c.registerIssue(runningJob.JobId, issue)
@@ -300,12 +304,13 @@ func (c *ClusterJobContext) handleDeletedPod(pod *v1.Pod) {
 		isUnexpectedDeletion := !util.IsMarkedForDeletion(pod) && !util.IsPodFinishedAndReported(pod)
 		if isUnexpectedDeletion {
 			c.registerIssue(jobId, &PodIssue{
-				OriginatingPod: pod,
-				Pods:           []*v1.Pod{pod},
-				Message:        "Pod of the active job was deleted.",
-				Retryable:      false,
-				Reported:       false,
-				Type:           ExternallyDeleted,
+				OriginatingPod:  pod,
+				Pods:            []*v1.Pod{pod},
+				Message:         "Pod of the active job was deleted.",
+				Retryable:       false,
+				Reported:        false,
+				Type:            ExternallyDeleted,
+				FailureCategory: api.FailureCategory_NodeLost,
 			})
 		}
 	}
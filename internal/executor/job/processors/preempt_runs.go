@@ -75,7 +75,7 @@ func (j *RunPreemptedProcessor) Run() {
 
 func (j *RunPreemptedProcessor) reportPodPreempted(run *job.RunState, pod *v1.Pod) error {
 	preemptedEvent := reporter.CreateSimpleJobPreemptedEvent(pod, j.clusterContext.GetClusterId())
-	failedEvent := reporter.CreateSimpleJobFailedEvent(pod, "Run preempted", j.clusterContext.GetClusterId(), api.Cause_Error)
+	failedEvent := reporter.CreateSimpleJobFailedEvent(pod, "Run preempted", j.clusterContext.GetClusterId(), api.Cause_Error, api.FailureCategory_UnknownFailureCategory)
 	events := []reporter.EventMessage{
 		{Event: preemptedEvent, JobRunId: run.Meta.RunId},
 		{Event: failedEvent, JobRunId: run.Meta.RunId},
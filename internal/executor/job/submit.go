@@ -7,11 +7,16 @@ import (
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	"github.com/armadaproject/armada/internal/executor/context"
@@ -93,7 +98,10 @@ func (submitService *SubmitService) submitWorker(wg *sync.WaitGroup, jobsToSubmi
 
 	for job := range jobsToSubmitChannel {
 		jobPods := []*v1.Pod{}
+		span := traceSubmission(job)
 		pod, err := submitService.submitPod(job)
+		tracing.RecordError(span, err)
+		span.End()
 		jobPods = append(jobPods, pod)
 
 		if err != nil {
@@ -114,6 +122,18 @@ func (submitService *SubmitService) submitWorker(wg *sync.WaitGroup, jobsToSubmi
 	}
 }
 
+// traceSubmission starts a span for submitting the pod of job, as part of the trace of the job continued from its lease;
+// see armadaconfiguration.TraceparentAnnotation. Jobs submitted without trace context aren't traced.
+func traceSubmission(job *SubmitJob) trace.Span {
+	_, span := tracing.ContinueFromTraceparent(
+		armadacontext.Background(),
+		"SubmitService.submitPod",
+		job.Pod.Annotations[armadaconfiguration.TraceparentAnnotation],
+		trace.WithAttributes(attribute.String("jobId", job.Meta.RunMeta.JobId), attribute.String("runId", job.Meta.RunMeta.RunId)),
+	)
+	return span
+}
+
 // submitPod submits a pod to k8s together with any services and ingresses bundled with the Armada job.
 // This function may fail partly, i.e., it may successfully create a subset of the requested objects before failing.
 // In case of failure, any already created objects are not cleaned up.
@@ -10,6 +10,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	"github.com/armadaproject/armada/internal/executor/domain"
 	"github.com/armadaproject/armada/internal/executor/util"
 	"github.com/armadaproject/armada/pkg/api"
@@ -49,6 +50,7 @@ func CreateEventForCurrentState(pod *v1.Pod, clusterId string) (api.Event, error
 			pod,
 			util.ExtractPodFailedReason(pod),
 			util.ExtractPodFailedCause(pod),
+			util.ExtractPodFailureCategory(pod),
 			util.ExtractFailedPodContainerStatuses(pod),
 			util.ExtractPodExitCodes(pod),
 			clusterId), nil
@@ -79,6 +81,19 @@ func getPodNumber(pod *v1.Pod) int32 {
 	return int32(podNumber)
 }
 
+func CreateJobArtifactsEvent(pod *v1.Pod, clusterId string, artifacts []*api.Artifact) *api.JobArtifactsEvent {
+	return &api.JobArtifactsEvent{
+		JobId:        pod.Labels[domain.JobId],
+		JobSetId:     pod.Annotations[domain.JobSetId],
+		Queue:        pod.Labels[domain.Queue],
+		Created:      time.Now(),
+		ClusterId:    clusterId,
+		KubernetesId: string(pod.ObjectMeta.UID),
+		PodNumber:    getPodNumber(pod),
+		Artifacts:    artifacts,
+	}
+}
+
 func CreateJobUnableToScheduleEvent(pod *v1.Pod, reason string, clusterId string) api.Event {
 	return &api.JobUnableToScheduleEvent{
 		JobId:        pod.Labels[domain.JobId],
@@ -225,11 +240,11 @@ func enrichPreemptedEventFromClusterEventMessage(event *api.JobPreemptedEvent, m
 	return nil
 }
 
-func CreateSimpleJobFailedEvent(pod *v1.Pod, reason string, clusterId string, cause api.Cause) api.Event {
-	return CreateJobFailedEvent(pod, reason, cause, []*api.ContainerStatus{}, map[string]int32{}, clusterId)
+func CreateSimpleJobFailedEvent(pod *v1.Pod, reason string, clusterId string, cause api.Cause, category api.FailureCategory) api.Event {
+	return CreateJobFailedEvent(pod, reason, cause, category, []*api.ContainerStatus{}, map[string]int32{}, clusterId)
 }
 
-func CreateJobFailedEvent(pod *v1.Pod, reason string, cause api.Cause, containerStatuses []*api.ContainerStatus,
+func CreateJobFailedEvent(pod *v1.Pod, reason string, cause api.Cause, category api.FailureCategory, containerStatuses []*api.ContainerStatus,
 	exitCodes map[string]int32, clusterId string,
 ) api.Event {
 	return &api.JobFailedEvent{
@@ -247,6 +262,7 @@ func CreateJobFailedEvent(pod *v1.Pod, reason string, cause api.Cause, container
 		NodeName:          pod.Spec.NodeName,
 		ContainerStatuses: containerStatuses,
 		Cause:             cause,
+		FailureCategory:   category,
 	}
 }
 
@@ -264,7 +280,7 @@ func CreateReturnLeaseEvent(pod *v1.Pod, reason string, clusterId string, runAtt
 	}
 }
 
-func CreateJobUtilisationEvent(pod *v1.Pod, utilisationData *domain.UtilisationData, clusterId string) api.Event {
+func CreateJobUtilisationEvent(pod *v1.Pod, utilisationData *domain.UtilisationData, averageUsage armadaresource.ComputeResources, clusterId string) api.Event {
 	return &api.JobUtilisationEvent{
 		JobId:                 pod.Labels[domain.JobId],
 		JobSetId:              pod.Annotations[domain.JobSetId],
@@ -273,6 +289,7 @@ func CreateJobUtilisationEvent(pod *v1.Pod, utilisationData *domain.UtilisationD
 		ClusterId:             clusterId,
 		MaxResourcesForPeriod: utilisationData.CurrentUsage,
 		TotalCumulativeUsage:  utilisationData.CumulativeUsage,
+		AverageResources:      averageUsage,
 		KubernetesId:          string(pod.ObjectMeta.UID),
 		PodNumber:             getPodNumber(pod),
 		PodName:               pod.Name,
@@ -4,10 +4,12 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/executorapi"
@@ -61,9 +63,14 @@ func (eventSender *ExecutorApiEventSender) SendEvents(events []EventMessage) err
 		return err
 	}
 
+	ctx, span := tracing.StartSpanWithLogFields(armadacontext.Background(), "ExecutorApiEventSender.SendEvents")
+	span.SetAttributes(attribute.Int("numEventSequences", len(sequences)), attribute.Int("numRequests", len(eventLists)))
+	defer span.End()
 	for _, eventList := range eventLists {
-		_, err = eventSender.eventClient.ReportEvents(armadacontext.Background(), eventList)
+		// The trace context of ctx is sent with the request, such that the scheduler continues the trace when publishing the events.
+		_, err = eventSender.eventClient.ReportEvents(ctx, eventList)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return err
 		}
 	}
@@ -124,6 +131,8 @@ func filterJobRunEvents(events []*armadaevents.EventSequence_Event) []*armadaeve
 			result = append(result, event)
 		case *armadaevents.EventSequence_Event_ResourceUtilisation:
 			result = append(result, event)
+		case *armadaevents.EventSequence_Event_JobRunArtifacts:
+			result = append(result, event)
 		default:
 			log.Warnf("unexpected event type %T- filtering it out", typed)
 		}
@@ -148,6 +157,8 @@ func populateRunId(eventSequence *armadaevents.EventSequence, jobRunId *armadaev
 			runEvent.StandaloneIngressInfo.RunId = jobRunId
 		case *armadaevents.EventSequence_Event_ResourceUtilisation:
 			runEvent.ResourceUtilisation.RunId = jobRunId
+		case *armadaevents.EventSequence_Event_JobRunArtifacts:
+			runEvent.JobRunArtifacts.RunId = jobRunId
 		default:
 			log.Warnf("unexpected event type %T- failed to populate run id", runEvent)
 		}
@@ -5,13 +5,19 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	clusterContext "github.com/armadaproject/armada/internal/executor/context"
 	domain2 "github.com/armadaproject/armada/internal/executor/domain"
 	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/util"
+	"github.com/armadaproject/armada/pkg/api"
 )
 
 const batchSize = 200
@@ -35,18 +41,26 @@ type JobEventReporter struct {
 	legacyMode       bool
 	jobRunStateStore *job.JobRunStateStore
 	clusterContext   clusterContext.ClusterContext
+	// Labels of the node a job is running on that are included in the running event of the job.
+	trackedNodeLabels []string
 }
 
-func NewJobEventReporter(clusterContext clusterContext.ClusterContext, jobRunState *job.JobRunStateStore, eventSender EventSender) (*JobEventReporter, chan bool) {
+func NewJobEventReporter(
+	clusterContext clusterContext.ClusterContext,
+	jobRunState *job.JobRunStateStore,
+	eventSender EventSender,
+	trackedNodeLabels []string,
+) (*JobEventReporter, chan bool) {
 	stop := make(chan bool)
 	reporter := &JobEventReporter{
-		eventSender:      eventSender,
-		clusterContext:   clusterContext,
-		jobRunStateStore: jobRunState,
-		eventBuffer:      make(chan *queuedEvent, 1000000),
-		eventQueued:      map[string]uint8{},
-		eventQueuedMutex: sync.Mutex{},
-		legacyMode:       jobRunState == nil,
+		eventSender:       eventSender,
+		clusterContext:    clusterContext,
+		jobRunStateStore:  jobRunState,
+		eventBuffer:       make(chan *queuedEvent, 1000000),
+		eventQueued:       map[string]uint8{},
+		eventQueuedMutex:  sync.Mutex{},
+		legacyMode:        jobRunState == nil,
+		trackedNodeLabels: trackedNodeLabels,
 	}
 
 	clusterContext.AddPodEventHandler(reporter.podEventHandler())
@@ -188,8 +202,20 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 		log.Errorf("Failed to report event: %v", err)
 		return
 	}
+	if runningEvent, ok := event.(*api.JobRunningEvent); ok {
+		runningEvent.NodeLabels = eventReporter.getNodeLabels(pod.Spec.NodeName)
+	}
 
+	// Reporting the state of the pod is traced as part of the trace of its job, continued from its lease.
+	_, span := tracing.ContinueFromTraceparent(
+		armadacontext.Background(),
+		"JobEventReporter.reportCurrentStatus",
+		pod.Annotations[configuration.TraceparentAnnotation],
+		trace.WithAttributes(attribute.String("runId", util.ExtractJobRunId(pod)), attribute.String("phase", string(pod.Status.Phase))),
+	)
 	eventReporter.QueueEvent(EventMessage{Event: event, JobRunId: util.ExtractJobRunId(pod)}, func(err error) {
+		tracing.RecordError(span, err)
+		span.End()
 		if err != nil {
 			log.Errorf("Failed to report event: %s", err)
 			return
@@ -209,6 +235,26 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 	}
 }
 
+// getNodeLabels returns a snapshot of the tracked labels of the node with the provided name,
+// such that it's possible to correlate job runs with, e.g., hardware generations after the node is gone.
+func (eventReporter *JobEventReporter) getNodeLabels(nodeName string) map[string]string {
+	if nodeName == "" || len(eventReporter.trackedNodeLabels) == 0 {
+		return nil
+	}
+	node, err := eventReporter.clusterContext.GetNode(nodeName)
+	if err != nil {
+		log.Warnf("Failed to get labels of node %s: %v", nodeName, err)
+		return nil
+	}
+	nodeLabels := make(map[string]string)
+	for _, label := range eventReporter.trackedNodeLabels {
+		if value, ok := node.Labels[label]; ok {
+			nodeLabels[label] = value
+		}
+	}
+	return nodeLabels
+}
+
 func (eventReporter *JobEventReporter) QueueEvent(event EventMessage, callback func(error)) {
 	eventReporter.eventQueuedMutex.Lock()
 	defer eventReporter.eventQueuedMutex.Unlock()
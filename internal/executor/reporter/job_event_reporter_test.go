@@ -104,6 +104,38 @@ func TestJobEventReporter_SendsPreemptionEvent(t *testing.T) {
 	}
 }
 
+func TestJobEventReporter_RunningEventIncludesTrackedNodeLabels(t *testing.T) {
+	pod := createPod(1)
+	pod.Status.Phase = v1.PodRunning
+	_, executorContext, _, eventSender := setupTest(t, []*v1.Pod{pod})
+	executorContext.Nodes["node-1"] = &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+			Labels: map[string]string{
+				"nvidia.com/gpu.product":      "A100",
+				"topology.kubernetes.io/zone": "zone-a",
+				"untracked":                   "value",
+			},
+		},
+	}
+
+	executorContext.SimulatePodAddEvent(pod)
+	// Event processing is async, sleep shortly to give it time to process
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, 1, eventSender.GetNumberOfSendEventCalls())
+	sentMessages := eventSender.GetSentEvents(0)
+	assert.Len(t, sentMessages, 1)
+	event, err := api.Wrap(sentMessages[0].Event)
+	assert.NoError(t, err)
+	assert.NotNil(t, event.GetRunning())
+	assert.Equal(
+		t,
+		map[string]string{"nvidia.com/gpu.product": "A100", "topology.kubernetes.io/zone": "zone-a"},
+		event.GetRunning().NodeLabels,
+	)
+}
+
 func setupTest(t *testing.T, existingPods []*v1.Pod) (EventReporter, *fakecontext.SyncFakeClusterContext, *job.JobRunStateStore, *FakeEventSender) {
 	executorContext := fakecontext.NewSyncFakeClusterContext()
 	for _, pod := range existingPods {
@@ -113,7 +145,7 @@ func setupTest(t *testing.T, existingPods []*v1.Pod) (EventReporter, *fakecontex
 
 	eventSender := NewFakeEventSender()
 	jobRunState := job.NewJobRunStateStore(executorContext)
-	jobEventReporter, _ := NewJobEventReporter(executorContext, jobRunState, eventSender)
+	jobEventReporter, _ := NewJobEventReporter(executorContext, jobRunState, eventSender, []string{"nvidia.com/gpu.product", "topology.kubernetes.io/zone"})
 
 	return jobEventReporter, executorContext, jobRunState, eventSender
 }
@@ -2,56 +2,88 @@ package service
 
 import (
 	"fmt"
+	"time"
 
-	"github.com/sirupsen/logrus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	"golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/armadaproject/armada/internal/common/healthmonitor"
 	"github.com/armadaproject/armada/internal/common/logging"
 	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	util2 "github.com/armadaproject/armada/internal/common/util"
 	executorContext "github.com/armadaproject/armada/internal/executor/context"
 	"github.com/armadaproject/armada/internal/executor/job"
+	"github.com/armadaproject/armada/internal/executor/metrics"
 	"github.com/armadaproject/armada/internal/executor/reporter"
 	"github.com/armadaproject/armada/internal/executor/util"
 	"github.com/armadaproject/armada/internal/executor/utilisation"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
+var (
+	podCreationBacklogGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: metrics.ArmadaExecutorMetricsPrefix + "pod_creation_backlog",
+		Help: "Number of leased runs left waiting for their pods to be created due to the limit on the pod creation rate",
+	})
+	podCreationLatencyHistogram = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    metrics.ArmadaExecutorMetricsPrefix + "pod_creation_latency_seconds",
+		Help:    "Time between a run being leased and its pod being created",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+	})
+)
+
 type ClusterAllocator interface {
 	AllocateSpareClusterCapacity()
 }
 
 type ClusterAllocationService struct {
-	clusterId            executorContext.ClusterIdentity
+	clusterContext       executorContext.ClusterContext
 	jobRunStateStore     job.RunStateStore
 	submitter            job.Submitter
 	eventReporter        reporter.EventReporter
 	clusterHealthMonitor healthmonitor.HealthMonitor
+	// Limits the rate at which pods are created. If nil, pods are created for all leased runs as soon as possible.
+	// Runs for which no pod is created remain leased and are considered again at the next allocation;
+	// the executor doesn't lease more runs while any are waiting.
+	podCreationLimiter *rate.Limiter
 }
 
 func NewClusterAllocationService(
-	clusterId executorContext.ClusterIdentity,
+	clusterContext executorContext.ClusterContext,
 	eventReporter reporter.EventReporter,
 	jobRunStateManager job.RunStateStore,
 	submitter job.Submitter,
 	clusterHealthMonitor healthmonitor.HealthMonitor,
+	podCreationRate float64,
+	podCreationBurst int,
 ) *ClusterAllocationService {
+	var podCreationLimiter *rate.Limiter
+	if podCreationRate > 0 {
+		if podCreationBurst < 1 {
+			podCreationBurst = 1
+		}
+		podCreationLimiter = rate.NewLimiter(rate.Limit(podCreationRate), podCreationBurst)
+	}
 	return &ClusterAllocationService{
 		eventReporter:        eventReporter,
-		clusterId:            clusterId,
+		clusterContext:       clusterContext,
 		submitter:            submitter,
 		jobRunStateStore:     jobRunStateManager,
 		clusterHealthMonitor: clusterHealthMonitor,
+		podCreationLimiter:   podCreationLimiter,
 	}
 }
 
 func (allocationService *ClusterAllocationService) AllocateSpareClusterCapacity() {
 	// If a health monitor is provided, avoid leasing jobs when the cluster is unhealthy.
 	if allocationService.clusterHealthMonitor != nil {
-		log := logrus.NewEntry(logrus.New())
+		log := logging.StandardLogger()
 		if ok, reason, err := allocationService.clusterHealthMonitor.IsHealthy(); err != nil {
 			logging.WithStacktrace(log, err).Error("failed to check cluster health")
 			return
@@ -64,19 +96,93 @@ func (allocationService *ClusterAllocationService) AllocateSpareClusterCapacity(
 	jobRuns := allocationService.jobRunStateStore.GetAllWithFilter(func(state *job.RunState) bool {
 		return state.Phase == job.Leased
 	})
-	jobs := make([]*job.SubmitJob, 0, len(jobRuns))
-	for _, run := range jobRuns {
+	jobRuns = armadaslices.Filter(jobRuns, func(run *job.RunState) bool {
 		if run.Job == nil {
 			// TODO report invalid - when we drive events off state
 			log.Errorf("Job for job %s run %s unexpectedly nil", run.Meta.JobId, run.Meta.RunId)
-			continue
+			return false
+		}
+		return true
+	})
+
+	// Create pods in order of decreasing priority and, within each priority, in the order runs were leased.
+	podPriority, err := allocationService.podPriorityResolver()
+	if err != nil {
+		logging.WithStacktrace(logging.StandardLogger(), err).Error("failed to get priority classes; creating pods in the order runs were leased")
+		podPriority = func(*v1.Pod) int32 { return 0 }
+	}
+	slices.SortStableFunc(jobRuns, func(a, b *job.RunState) bool {
+		if aPriority, bPriority := podPriority(a.Job.Pod), podPriority(b.Job.Pod); aPriority != bPriority {
+			return aPriority > bPriority
+		}
+		return a.LastPhaseTransitionTime.Before(b.LastPhaseTransitionTime)
+	})
+	numToSubmit := len(jobRuns)
+	if allocationService.podCreationLimiter != nil {
+		now := time.Now()
+		for numToSubmit = 0; numToSubmit < len(jobRuns); numToSubmit++ {
+			if !allocationService.podCreationLimiter.AllowN(now, 1) {
+				break
+			}
 		}
-		jobs = append(jobs, run.Job)
+	}
+	podCreationBacklogGauge.Set(float64(len(jobRuns) - numToSubmit))
+	if numToSubmit < len(jobRuns) {
+		log.Infof("Pod creation rate limit reached; %d leased runs will be submitted later", len(jobRuns)-numToSubmit)
+	}
+	jobRuns = jobRuns[:numToSubmit]
+
+	jobs := make([]*job.SubmitJob, len(jobRuns))
+	for i, run := range jobRuns {
+		jobs[i] = run.Job
 	}
 
 	failedJobSubmissions := allocationService.submitter.SubmitJobs(jobs)
 	allocationService.processSuccessfulSubmissions(jobs, failedJobSubmissions)
 	allocationService.processFailedJobSubmissions(failedJobSubmissions)
+	allocationService.reportPodCreationLatency(jobRuns, failedJobSubmissions)
+}
+
+func (allocationService *ClusterAllocationService) reportPodCreationLatency(jobRuns []*job.RunState, failedSubmissions []*job.FailedSubmissionDetails) {
+	failedSubmissionSet := make(map[string]bool, len(failedSubmissions))
+	for _, failedSubmission := range failedSubmissions {
+		failedSubmissionSet[failedSubmission.JobRunMeta.RunId] = true
+	}
+	for _, run := range jobRuns {
+		if !failedSubmissionSet[run.Meta.RunId] && !run.LastPhaseTransitionTime.IsZero() {
+			podCreationLatencyHistogram.Observe(time.Since(run.LastPhaseTransitionTime).Seconds())
+		}
+	}
+}
+
+// podPriorityResolver returns a function returning the priority Kubernetes assigns to a pod on admission.
+// Pods created by the executor specify a priority class name only, so their priority is resolved from the priority
+// classes of the cluster; pods without a priority class get that of the global default class, if any.
+func (allocationService *ClusterAllocationService) podPriorityResolver() (func(pod *v1.Pod) int32, error) {
+	priorityClasses, err := allocationService.clusterContext.GetPriorityClasses()
+	if err != nil {
+		return nil, err
+	}
+	priorityByName := make(map[string]int32, len(priorityClasses))
+	defaultPriority := int32(0)
+	for _, priorityClass := range priorityClasses {
+		priorityByName[priorityClass.Name] = priorityClass.Value
+		if priorityClass.GlobalDefault {
+			defaultPriority = priorityClass.Value
+		}
+	}
+	return func(pod *v1.Pod) int32 {
+		if pod == nil {
+			return 0
+		}
+		if pod.Spec.Priority != nil {
+			return *pod.Spec.Priority
+		}
+		if pod.Spec.PriorityClassName == "" {
+			return defaultPriority
+		}
+		return priorityByName[pod.Spec.PriorityClassName]
+	}, nil
 }
 
 func (allocationService *ClusterAllocationService) processSuccessfulSubmissions(jobs []*job.SubmitJob, failedSubmissions []*job.FailedSubmissionDetails) {
@@ -99,7 +205,7 @@ func (allocationService *ClusterAllocationService) processFailedJobSubmissions(f
 		}
 
 		if details.Recoverable {
-			returnLeaseEvent := reporter.CreateReturnLeaseEvent(details.Pod, message, allocationService.clusterId.GetClusterId(), true)
+			returnLeaseEvent := reporter.CreateReturnLeaseEvent(details.Pod, message, allocationService.clusterContext.GetClusterId(), true)
 			err := allocationService.eventReporter.Report([]reporter.EventMessage{{Event: returnLeaseEvent, JobRunId: details.JobRunMeta.RunId}})
 			if err == nil {
 				allocationService.jobRunStateStore.ReportFailedSubmission(details.JobRunMeta.RunId)
@@ -110,7 +216,7 @@ func (allocationService *ClusterAllocationService) processFailedJobSubmissions(f
 				log.Errorf("Failed to return lease for job %s because %s", details.JobRunMeta.JobId, err)
 			}
 		} else {
-			failEvent := reporter.CreateSimpleJobFailedEvent(details.Pod, message, allocationService.clusterId.GetClusterId(), api.Cause_Error)
+			failEvent := reporter.CreateSimpleJobFailedEvent(details.Pod, message, allocationService.clusterContext.GetClusterId(), api.Cause_Error, api.FailureCategory_UnknownFailureCategory)
 			err := allocationService.eventReporter.Report([]reporter.EventMessage{{Event: failEvent, JobRunId: details.JobRunMeta.RunId}})
 			if err == nil {
 				allocationService.jobRunStateStore.ReportFailedSubmission(details.JobRunMeta.RunId)
@@ -154,7 +260,7 @@ func NewLegacyClusterAllocationService(
 func (allocationService *LegacyClusterAllocationService) AllocateSpareClusterCapacity() {
 	// If a health monitor is provided, avoid leasing jobs when the cluster is unhealthy.
 	if allocationService.clusterHealthMonitor != nil {
-		log := logrus.NewEntry(logrus.New())
+		log := logging.StandardLogger()
 		if ok, reason, err := allocationService.clusterHealthMonitor.IsHealthy(); err != nil {
 			logging.WithStacktrace(log, err).Error("failed to check cluster health")
 			return
@@ -233,7 +339,7 @@ func (allocationService *LegacyClusterAllocationService) processFailedJobs(faile
 		if details.Recoverable {
 			allocationService.returnLease(details.Pod, fmt.Sprintf("Failed to submit pod because %s", message))
 		} else {
-			failEvent := reporter.CreateSimpleJobFailedEvent(details.Pod, message, allocationService.clusterContext.GetClusterId(), api.Cause_Error)
+			failEvent := reporter.CreateSimpleJobFailedEvent(details.Pod, message, allocationService.clusterContext.GetClusterId(), api.Cause_Error, api.FailureCategory_UnknownFailureCategory)
 			err := allocationService.eventReporter.Report([]reporter.EventMessage{{Event: failEvent, JobRunId: util.ExtractJobRunId(details.Pod)}})
 
 			if err == nil {
@@ -3,8 +3,12 @@ package service
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	scheduling "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/armadaproject/armada/internal/common/healthmonitor"
 	fakecontext "github.com/armadaproject/armada/internal/executor/context/fake"
@@ -27,6 +31,49 @@ func TestAllocateSpareClusterCapacity(t *testing.T) {
 	assert.Equal(t, runState.Phase, job.SuccessfulSubmission)
 }
 
+func TestAllocateSpareClusterCapacity_SubmitsInPriorityOrder(t *testing.T) {
+	now := time.Now()
+	lowPriorityRun := createRun("low-priority", job.Leased)
+	lowPriorityRun.LastPhaseTransitionTime = now.Add(-2 * time.Minute)
+	lowPriorityRun.Job.Pod.Spec.PriorityClassName = "armada-low"
+	highPriorityRun := createRun("high-priority", job.Leased)
+	highPriorityRun.Job.Pod.Spec.PriorityClassName = "armada-high"
+	highPriorityRun.LastPhaseTransitionTime = now
+	olderHighPriorityRun := createRun("older-high-priority", job.Leased)
+	olderHighPriorityRun.Job.Pod.Spec.PriorityClassName = "armada-high"
+	olderHighPriorityRun.LastPhaseTransitionTime = now.Add(-time.Minute)
+	defaultPriorityRun := createRun("default-priority", job.Leased)
+	defaultPriorityRun.LastPhaseTransitionTime = now.Add(-3 * time.Minute)
+	clusterAllocationService, _, _, submitter, _ := setupClusterAllocationServiceTest(
+		[]*job.RunState{lowPriorityRun, highPriorityRun, olderHighPriorityRun, defaultPriorityRun},
+	)
+
+	clusterAllocationService.AllocateSpareClusterCapacity()
+
+	assert.Equal(
+		t,
+		[]*job.SubmitJob{olderHighPriorityRun.Job, highPriorityRun.Job, defaultPriorityRun.Job, lowPriorityRun.Job},
+		submitter.ReceivedSubmitJobs,
+	)
+}
+
+func TestAllocateSpareClusterCapacity_LimitsPodCreationRate(t *testing.T) {
+	lowPriorityRun := createRun("low-priority", job.Leased)
+	highPriorityRun := createRun("high-priority", job.Leased)
+	highPriorityRun.Job.Pod.Spec.PriorityClassName = "armada-high"
+	clusterAllocationService, _, _, submitter, runStore := setupClusterAllocationServiceTest(
+		[]*job.RunState{lowPriorityRun, highPriorityRun},
+	)
+	// Allow creating a single pod, after which no further pods may be created for the duration of the test.
+	clusterAllocationService.podCreationLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+
+	clusterAllocationService.AllocateSpareClusterCapacity()
+
+	assert.Equal(t, []*job.SubmitJob{highPriorityRun.Job}, submitter.ReceivedSubmitJobs)
+	assert.Equal(t, job.SuccessfulSubmission, runStore.Get(highPriorityRun.Meta.RunId).Phase)
+	assert.Equal(t, job.Leased, runStore.Get(lowPriorityRun.Meta.RunId).Phase)
+}
+
 func TestAllocateSpareClusterCapacity_SkipsLeaseRunsWhereJobIsNil(t *testing.T) {
 	invalidLeaseRun := createRun("invalid", job.Leased)
 	invalidLeaseRun.Job = nil
@@ -140,7 +187,12 @@ func setupClusterAllocationServiceTest(initialJobRuns []*job.RunState) (
 	*mocks.FakeSubmitter,
 	*job.JobRunStateStore,
 ) {
-	clusterId := fakecontext.NewFakeClusterIdentity("cluster-1", "pool-1")
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	clusterContext.PriorityClasses = []*scheduling.PriorityClass{
+		{ObjectMeta: metav1.ObjectMeta{Name: "armada-low"}, Value: 1},
+		{ObjectMeta: metav1.ObjectMeta{Name: "armada-default"}, Value: 5, GlobalDefault: true},
+		{ObjectMeta: metav1.ObjectMeta{Name: "armada-high"}, Value: 10},
+	}
 	eventReporter := mocks2.NewFakeEventReporter()
 	submitter := &mocks.FakeSubmitter{}
 	jobRunStateManager := job.NewJobRunStateStoreWithInitialState(initialJobRuns)
@@ -148,11 +200,13 @@ func setupClusterAllocationServiceTest(initialJobRuns []*job.RunState) (
 	healthMonitor.SetHealthStatus(true)
 
 	return NewClusterAllocationService(
-		clusterId,
+		clusterContext,
 		eventReporter,
 		jobRunStateManager,
 		submitter,
 		healthMonitor,
+		0,
+		0,
 	), healthMonitor, eventReporter, submitter, jobRunStateManager
 }
 
@@ -149,7 +149,7 @@ func (m *JobManager) handleNonRetryableJobIssue(runningJob *job.RunningJob) {
 				unableToScheduleEvent := reporter.CreateJobUnableToScheduleEvent(pod, message, m.clusterIdentity.GetClusterId())
 				events = append(events, reporter.EventMessage{Event: unableToScheduleEvent, JobRunId: util.ExtractJobRunId(pod)})
 			}
-			failedEvent := reporter.CreateSimpleJobFailedEvent(pod, message, m.clusterIdentity.GetClusterId(), runningJob.Issue.Cause)
+			failedEvent := reporter.CreateSimpleJobFailedEvent(pod, message, m.clusterIdentity.GetClusterId(), runningJob.Issue.Cause, runningJob.Issue.FailureCategory)
 			events = append(events, reporter.EventMessage{Event: failedEvent, JobRunId: util.ExtractJobRunId(pod)})
 
 			err := m.eventReporter.Report(events)
@@ -1,13 +1,19 @@
 package service
 
 import (
+	"math/rand"
 	"time"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/slices"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	util2 "github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	executorContext "github.com/armadaproject/armada/internal/executor/context"
@@ -28,6 +34,10 @@ type JobRequester struct {
 	podDefaults        *configuration.PodDefaults
 	jobRunStateStore   job.RunStateStore
 	maxLeasedJobs      int
+	// Maximum number of attempts at lease requests that fail with a retryable error.
+	maxLeaseRequestAttempts int
+	// Attempts are separated by a random duration of up to this value, doubled after each attempt.
+	leaseRequestRetryBackoff time.Duration
 }
 
 func NewJobRequester(
@@ -38,15 +48,19 @@ func NewJobRequester(
 	utilisationService utilisation.UtilisationService,
 	podDefaults *configuration.PodDefaults,
 	maxLeasedJobs int,
+	maxLeaseRequestAttempts int,
+	leaseRequestRetryBackoff time.Duration,
 ) *JobRequester {
 	return &JobRequester{
-		leaseRequester:     leaseRequester,
-		eventReporter:      eventReporter,
-		utilisationService: utilisationService,
-		jobRunStateStore:   jobRunStateStore,
-		clusterId:          clusterId,
-		podDefaults:        podDefaults,
-		maxLeasedJobs:      maxLeasedJobs,
+		leaseRequester:           leaseRequester,
+		eventReporter:            eventReporter,
+		utilisationService:       utilisationService,
+		jobRunStateStore:         jobRunStateStore,
+		clusterId:                clusterId,
+		podDefaults:              podDefaults,
+		maxLeasedJobs:            maxLeasedJobs,
+		maxLeaseRequestAttempts:  maxLeaseRequestAttempts,
+		leaseRequestRetryBackoff: leaseRequestRetryBackoff,
 	}
 }
 
@@ -58,11 +72,21 @@ func (r *JobRequester) RequestJobsRuns() {
 	}
 	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 30*time.Second)
 	defer cancel()
-	leaseResponse, err := r.leaseRequester.LeaseJobRuns(ctx, leaseRequest)
+	ctx, span := tracing.StartSpanWithLogFields(ctx, "JobRequester.leaseJobRuns")
+	span.SetAttributes(attribute.Int("maxJobsToLease", int(leaseRequest.MaxJobsToLease)))
+	leaseResponse, err := r.leaseJobRuns(ctx, leaseRequest)
 	if err != nil {
+		tracing.RecordError(span, err)
+		span.End()
 		log.Errorf("Failed to request new jobs leases as because %s", err)
 		return
 	}
+	span.SetAttributes(
+		attribute.Int("leasedRuns", len(leaseResponse.LeasedRuns)),
+		attribute.Int("runsToCancel", len(leaseResponse.RunIdsToCancel)),
+		attribute.Int("runsToPreempt", len(leaseResponse.RunIdsToPreempt)),
+	)
+	span.End()
 	log.Infof("Reporting current free resource %s. Requesting %d new jobs. Received %d new jobs.",
 		formatResources(leaseRequest.AvailableResource), leaseRequest.MaxJobsToLease, len(leaseResponse.LeasedRuns))
 
@@ -73,6 +97,35 @@ func (r *JobRequester) RequestJobsRuns() {
 	r.handleFailedJobCreation(failedJobCreations)
 }
 
+// leaseJobRuns sends the lease request to the scheduler, retrying it if it fails with a retryable error.
+// Attempts are separated by a random duration, such that executors rejected at the same time don't retry in lockstep.
+func (r *JobRequester) leaseJobRuns(ctx *armadacontext.Context, leaseRequest *LeaseRequest) (*LeaseResponse, error) {
+	for attempt := 1; ; attempt++ {
+		leaseResponse, err := r.leaseRequester.LeaseJobRuns(ctx, leaseRequest)
+		if err == nil || attempt >= r.maxLeaseRequestAttempts || !isRetryableLeaseRequestError(err) {
+			return leaseResponse, err
+		}
+		backoff := time.Duration(rand.Int63n(int64(r.leaseRequestRetryBackoff)<<(attempt-1) + 1))
+		log.Warnf("Lease request attempt %d failed because %s; retrying in %s", attempt, err, backoff)
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// isRetryableLeaseRequestError returns true if err indicates the scheduler is unavailable
+// or rejected the request for exceeding its limits on concurrent lease requests.
+func isRetryableLeaseRequestError(err error) bool {
+	switch status.Code(errors.Cause(err)) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
 func (r *JobRequester) createLeaseRequest() (*LeaseRequest, error) {
 	capacityReport, err := r.utilisationService.GetAvailableClusterCapacity(false)
 	if err != nil {
@@ -3,10 +3,13 @@ package service
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
@@ -24,7 +27,10 @@ import (
 	"github.com/armadaproject/armada/pkg/executorapi"
 )
 
-const defaultMaxLeasedJobs int = 5
+const (
+	defaultMaxLeasedJobs           int = 5
+	defaultMaxLeaseRequestAttempts int = 3
+)
 
 func TestRequestJobsRuns_HandlesLeaseRequestError(t *testing.T) {
 	jobRequester, eventReporter, leaseRequester, stateStore, _ := setupJobRequesterTest([]*job.RunState{})
@@ -36,6 +42,40 @@ func TestRequestJobsRuns_HandlesLeaseRequestError(t *testing.T) {
 	assert.Len(t, allJobRuns, 0)
 }
 
+func TestRequestJobsRuns_RetriesRetryableLeaseRequestErrors(t *testing.T) {
+	tests := map[string]struct {
+		err                 error
+		numErrors           int
+		expectedNumRequests int
+	}{
+		"retries until successful": {
+			err:                 status.Error(codes.ResourceExhausted, "too many concurrent lease requests"),
+			numErrors:           2,
+			expectedNumRequests: 3,
+		},
+		"gives up after max attempts": {
+			err:                 status.Error(codes.Unavailable, "scheduler unavailable"),
+			numErrors:           defaultMaxLeaseRequestAttempts,
+			expectedNumRequests: defaultMaxLeaseRequestAttempts,
+		},
+		"does not retry other errors": {
+			err:                 status.Error(codes.PermissionDenied, "permission denied"),
+			numErrors:           1,
+			expectedNumRequests: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			jobRequester, _, leaseRequester, _, _ := setupJobRequesterTest([]*job.RunState{})
+			leaseRequester.LeaseJobRunError = tc.err
+			leaseRequester.NumLeaseJobRunErrors = tc.numErrors
+
+			jobRequester.RequestJobsRuns()
+			assert.Len(t, leaseRequester.ReceivedLeaseRequests, tc.expectedNumRequests)
+		})
+	}
+}
+
 func TestRequestJobsRuns_HandlesGetClusterCapacityError(t *testing.T) {
 	jobRequester, eventReporter, leaseRequester, stateStore, utilisationService := setupJobRequesterTest([]*job.RunState{})
 	utilisationService.GetClusterAvailableCapacityError = fmt.Errorf("capacity report error")
@@ -265,7 +305,7 @@ func setupJobRequesterTest(initialJobRuns []*job.RunState) (*JobRequester, *mock
 	utilisationService.ClusterAvailableCapacityReport = &utilisation.ClusterAvailableCapacityReport{
 		AvailableCapacity: &armadaresource.ComputeResources{},
 	}
-	jobRequester := NewJobRequester(clusterId, eventReporter, leaseRequester, stateStore, utilisationService, podDefaults, defaultMaxLeasedJobs)
+	jobRequester := NewJobRequester(clusterId, eventReporter, leaseRequester, stateStore, utilisationService, podDefaults, defaultMaxLeasedJobs, defaultMaxLeaseRequestAttempts, time.Millisecond)
 	return jobRequester, eventReporter, leaseRequester, stateStore, utilisationService
 }
 
@@ -273,9 +313,14 @@ type StubLeaseRequester struct {
 	ReceivedLeaseRequests    []*LeaseRequest
 	LeaseJobRunError         error
 	LeaseJobRunLeaseResponse *LeaseResponse
+	// If non-zero, LeaseJobRunError is only returned by this many calls, after which calls succeed.
+	NumLeaseJobRunErrors int
 }
 
 func (s *StubLeaseRequester) LeaseJobRuns(_ *armadacontext.Context, request *LeaseRequest) (*LeaseResponse, error) {
 	s.ReceivedLeaseRequests = append(s.ReceivedLeaseRequests, request)
+	if s.NumLeaseJobRunErrors > 0 && len(s.ReceivedLeaseRequests) > s.NumLeaseJobRunErrors {
+		return s.LeaseJobRunLeaseResponse, nil
+	}
 	return s.LeaseJobRunLeaseResponse, s.LeaseJobRunError
 }
@@ -54,6 +54,7 @@ func NewJobLeaseRequester(
 }
 
 func (requester *JobLeaseRequester) LeaseJobRuns(ctx *armadacontext.Context, request *LeaseRequest) (*LeaseResponse, error) {
+	// The trace context of ctx, if any, is sent with the request, such that the scheduler continues the trace of the request.
 	stream, err := requester.executorApiClient.LeaseJobRuns(ctx, grpcretry.Disable(), grpc.UseCompressor(gzip.Name))
 	if err != nil {
 		return nil, err
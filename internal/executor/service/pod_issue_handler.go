@@ -38,6 +38,7 @@ type podIssue struct {
 	DeletionRequested bool
 	Type              podIssueType
 	Cause             api.Cause
+	FailureCategory   api.FailureCategory
 }
 
 type reconciliationIssue struct {
@@ -177,6 +178,7 @@ func (p *IssueHandler) detectPodIssues(allManagedPods []*v1.Pod) {
 				Message:          "pod stuck in terminating phase, this might be due to platform problems",
 				Retryable:        false,
 				Type:             StuckTerminating,
+				FailureCategory:  api.FailureCategory_NodeLost,
 			}
 
 			p.registerIssue(&runIssue{
@@ -214,6 +216,7 @@ func (p *IssueHandler) detectPodIssues(allManagedPods []*v1.Pod) {
 					Message:          message,
 					Retryable:        retryable,
 					Type:             podIssueType,
+					FailureCategory:  util.ExtractPodFailureCategory(pod),
 				}
 				p.registerIssue(&runIssue{
 					JobId:    util.ExtractJobId(pod),
@@ -298,7 +301,7 @@ func (p *IssueHandler) handleNonRetryableJobIssue(issue *issue) {
 			unableToScheduleEvent := reporter.CreateJobUnableToScheduleEvent(issue.RunIssue.PodIssue.OriginalPodState, message, p.clusterContext.GetClusterId())
 			events = append(events, reporter.EventMessage{Event: unableToScheduleEvent, JobRunId: issue.RunIssue.RunId})
 		}
-		failedEvent := reporter.CreateSimpleJobFailedEvent(issue.RunIssue.PodIssue.OriginalPodState, message, p.clusterContext.GetClusterId(), issue.RunIssue.PodIssue.Cause)
+		failedEvent := reporter.CreateSimpleJobFailedEvent(issue.RunIssue.PodIssue.OriginalPodState, message, p.clusterContext.GetClusterId(), issue.RunIssue.PodIssue.Cause, issue.RunIssue.PodIssue.FailureCategory)
 		events = append(events, reporter.EventMessage{Event: failedEvent, JobRunId: issue.RunIssue.RunId})
 
 		err := p.eventReporter.Report(events)
@@ -422,6 +425,7 @@ func (p *IssueHandler) handleDeletedPod(pod *v1.Pod) {
 					Message:          "Pod was unexpectedly deleted",
 					Retryable:        false,
 					Type:             ExternallyDeleted,
+					FailureCategory:  api.FailureCategory_NodeLost,
 				},
 			})
 		}
@@ -461,13 +465,14 @@ func (p *IssueHandler) handleReconciliationIssue(issue *issue) {
 		log.Infof("Pod missing for active run  detected for job %s run %s", issue.RunIssue.JobId, issue.RunIssue.RunId)
 
 		event := &api.JobFailedEvent{
-			JobId:     currentRunState.Meta.JobId,
-			JobSetId:  currentRunState.Meta.JobSet,
-			Queue:     currentRunState.Meta.Queue,
-			Created:   p.clock.Now(),
-			ClusterId: p.clusterContext.GetClusterId(),
-			Reason:    fmt.Sprintf("Pod is unexpectedly missing in Kubernetes"),
-			Cause:     api.Cause_Error,
+			JobId:           currentRunState.Meta.JobId,
+			JobSetId:        currentRunState.Meta.JobSet,
+			Queue:           currentRunState.Meta.Queue,
+			Created:         p.clock.Now(),
+			ClusterId:       p.clusterContext.GetClusterId(),
+			Reason:          fmt.Sprintf("Pod is unexpectedly missing in Kubernetes"),
+			Cause:           api.Cause_Error,
+			FailureCategory: api.FailureCategory_NodeLost,
 		}
 
 		err := p.eventReporter.Report([]reporter.EventMessage{{Event: event, JobRunId: issue.RunIssue.RunId}})
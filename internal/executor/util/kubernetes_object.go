@@ -273,6 +273,7 @@ func CreatePodFromExecutorApiJob(job *executorapi.JobRunLease, defaults *configu
 		},
 		Spec: *podSpec,
 	}
+	applyLifecycleHooks(pod, defaults)
 
 	return pod, nil
 }
@@ -313,6 +314,7 @@ func CreatePod(job *api.Job, defaults *configuration.PodDefaults) *v1.Pod {
 		},
 		Spec: *podSpec,
 	}
+	applyLifecycleHooks(pod, defaults)
 
 	return pod
 }
@@ -0,0 +1,124 @@
+package util
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/domain"
+)
+
+const (
+	// Hook containers are named by prefixing the name of the hook, such that they can be recognised when reporting failures.
+	preStartHookContainerPrefix = "armada-pre-start-"
+	postStopHookContainerPrefix = "armada-post-stop-"
+	lifecycleHookVolumeName     = "armada-lifecycle-hooks"
+	defaultLifecycleHookPath    = "/armada/hooks"
+)
+
+// Environment variables describing the job, which are set on the containers of lifecycle hooks.
+const (
+	HookJobIdEnvVar         = "ARMADA_JOB_ID"
+	HookRunIdEnvVar         = "ARMADA_RUN_ID"
+	HookQueueEnvVar         = "ARMADA_QUEUE"
+	HookJobSetIdEnvVar      = "ARMADA_JOB_SET_ID"
+	HookJobContainersEnvVar = "ARMADA_JOB_CONTAINERS"
+	HookDirEnvVar           = "ARMADA_HOOK_DIR"
+)
+
+// applyLifecycleHooks adds the containers of the lifecycle hooks applying to the queue of the pod.
+// Pre-start hooks are added as init containers before those of the job, and post-stop hooks as sidecars.
+// All containers of the pod share a volume, via which hooks may, e.g., pass staged data to the job.
+// Post-stop hooks share the process namespace of the job, such that they can wait for its containers to exit.
+func applyLifecycleHooks(pod *v1.Pod, defaults *configuration.PodDefaults) {
+	if defaults == nil || len(defaults.LifecycleHooks) == 0 {
+		return
+	}
+	queue := pod.Labels[domain.Queue]
+	var preStart, postStop []v1.Container
+	for _, hook := range defaults.LifecycleHooks {
+		if len(hook.Queues) > 0 && !util.ContainsString(hook.Queues, queue) {
+			continue
+		}
+		container := *hook.Container.DeepCopy()
+		switch hook.Type {
+		case configuration.PreStart:
+			container.Name = preStartHookContainerPrefix + hook.Name
+			preStart = append(preStart, container)
+		case configuration.PostStop:
+			container.Name = postStopHookContainerPrefix + hook.Name
+			postStop = append(postStop, container)
+		}
+	}
+	if len(preStart) == 0 && len(postStop) == 0 {
+		return
+	}
+
+	mountPath := defaults.LifecycleHookVolumeMountPath
+	if mountPath == "" {
+		mountPath = defaultLifecycleHookPath
+	}
+	jobContainerNames := make([]string, len(pod.Spec.Containers))
+	for i, container := range pod.Spec.Containers {
+		jobContainerNames[i] = container.Name
+	}
+	env := []v1.EnvVar{
+		{Name: HookJobIdEnvVar, Value: pod.Labels[domain.JobId]},
+		{Name: HookRunIdEnvVar, Value: pod.Labels[domain.JobRunId]},
+		{Name: HookQueueEnvVar, Value: queue},
+		{Name: HookJobSetIdEnvVar, Value: pod.Annotations[domain.JobSetId]},
+		{Name: HookJobContainersEnvVar, Value: strings.Join(jobContainerNames, ",")},
+		{Name: HookDirEnvVar, Value: mountPath},
+	}
+	for _, containers := range [][]v1.Container{preStart, postStop} {
+		for i := range containers {
+			containers[i].Env = append(containers[i].Env, env...)
+		}
+	}
+
+	pod.Spec.InitContainers = append(preStart, pod.Spec.InitContainers...)
+	pod.Spec.Containers = append(pod.Spec.Containers, postStop...)
+	if len(postStop) > 0 {
+		pod.Spec.ShareProcessNamespace = pointer.Bool(true)
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name:         lifecycleHookVolumeName,
+		VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+	})
+	mountHookVolume(pod.Spec.InitContainers, mountPath)
+	mountHookVolume(pod.Spec.Containers, mountPath)
+}
+
+// mountHookVolume mounts the volume shared with lifecycle hooks into each container not already mounting something at mountPath.
+func mountHookVolume(containers []v1.Container, mountPath string) {
+	for i := range containers {
+		alreadyMounted := false
+		for _, mount := range containers[i].VolumeMounts {
+			if mount.MountPath == mountPath {
+				alreadyMounted = true
+				break
+			}
+		}
+		if !alreadyMounted {
+			containers[i].VolumeMounts = append(containers[i].VolumeMounts, v1.VolumeMount{
+				Name:      lifecycleHookVolumeName,
+				MountPath: mountPath,
+			})
+		}
+	}
+}
+
+// lifecycleHookOf returns a description of the lifecycle hook run by the named container, e.g., "pre-start hook stage-in",
+// or false if the container doesn't run a hook.
+func lifecycleHookOf(containerName string) (string, bool) {
+	if name, ok := strings.CutPrefix(containerName, preStartHookContainerPrefix); ok {
+		return "pre-start hook " + name, true
+	}
+	if name, ok := strings.CutPrefix(containerName, postStopHookContainerPrefix); ok {
+		return "post-stop hook " + name, true
+	}
+	return "", false
+}
@@ -0,0 +1,113 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/domain"
+)
+
+func TestApplyLifecycleHooks(t *testing.T) {
+	defaults := &configuration.PodDefaults{
+		LifecycleHooks: []configuration.LifecycleHook{
+			{
+				Name:      "stage-in",
+				Type:      configuration.PreStart,
+				Container: v1.Container{Image: "stage-in:latest"},
+			},
+			{
+				Name:      "upload",
+				Type:      configuration.PostStop,
+				Queues:    []string{"queue"},
+				Container: v1.Container{Image: "upload:latest"},
+			},
+			{
+				Name:      "other-queue",
+				Type:      configuration.PreStart,
+				Queues:    []string{"other"},
+				Container: v1.Container{Image: "other:latest"},
+			},
+		},
+		LifecycleHookVolumeMountPath: "/hooks",
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				domain.JobId:    "job-id",
+				domain.JobRunId: "run-id",
+				domain.Queue:    "queue",
+			},
+			Annotations: map[string]string{domain.JobSetId: "job-set"},
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "init"}},
+			Containers: []v1.Container{
+				{Name: "main"},
+				{Name: "mounts-hooks-path", VolumeMounts: []v1.VolumeMount{{Name: "data", MountPath: "/hooks"}}},
+			},
+		},
+	}
+
+	applyLifecycleHooks(pod, defaults)
+
+	env := []v1.EnvVar{
+		{Name: HookJobIdEnvVar, Value: "job-id"},
+		{Name: HookRunIdEnvVar, Value: "run-id"},
+		{Name: HookQueueEnvVar, Value: "queue"},
+		{Name: HookJobSetIdEnvVar, Value: "job-set"},
+		{Name: HookJobContainersEnvVar, Value: "main,mounts-hooks-path"},
+		{Name: HookDirEnvVar, Value: "/hooks"},
+	}
+	hookMount := []v1.VolumeMount{{Name: lifecycleHookVolumeName, MountPath: "/hooks"}}
+	assert.Equal(t, []v1.Container{
+		{Name: "armada-pre-start-stage-in", Image: "stage-in:latest", Env: env, VolumeMounts: hookMount},
+		{Name: "init", VolumeMounts: hookMount},
+	}, pod.Spec.InitContainers)
+	assert.Equal(t, []v1.Container{
+		{Name: "main", VolumeMounts: hookMount},
+		{Name: "mounts-hooks-path", VolumeMounts: []v1.VolumeMount{{Name: "data", MountPath: "/hooks"}}},
+		{Name: "armada-post-stop-upload", Image: "upload:latest", Env: env, VolumeMounts: hookMount},
+	}, pod.Spec.Containers)
+	assert.Equal(t, []v1.Volume{
+		{Name: lifecycleHookVolumeName, VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+	}, pod.Spec.Volumes)
+	assert.Equal(t, pointer.Bool(true), pod.Spec.ShareProcessNamespace)
+
+	// The templates aren't modified.
+	assert.Empty(t, defaults.LifecycleHooks[0].Container.Env)
+}
+
+func TestApplyLifecycleHooks_NoHooksForQueue(t *testing.T) {
+	defaults := &configuration.PodDefaults{
+		LifecycleHooks: []configuration.LifecycleHook{
+			{Name: "stage-in", Type: configuration.PreStart, Queues: []string{"other"}},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{domain.Queue: "queue"}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "main"}}},
+	}
+	expected := pod.DeepCopy()
+
+	applyLifecycleHooks(pod, defaults)
+
+	assert.Equal(t, expected, pod)
+}
+
+func TestLifecycleHookOf(t *testing.T) {
+	hook, ok := lifecycleHookOf("armada-pre-start-stage-in")
+	assert.True(t, ok)
+	assert.Equal(t, "pre-start hook stage-in", hook)
+
+	hook, ok = lifecycleHookOf("armada-post-stop-upload")
+	assert.True(t, ok)
+	assert.Equal(t, "post-stop hook upload", hook)
+
+	_, ok = lifecycleHookOf("main")
+	assert.False(t, ok)
+}
@@ -15,6 +15,7 @@ const (
 	oomKilledReason  = "OOMKilled"
 	evictedReason    = "Evicted"
 	deadlineExceeded = "DeadlineExceeded"
+	nodeLostReason   = "NodeLost"
 )
 
 // TODO: Need to detect pod preemption. So that job failed events can include a string indicating a pod was preempted.
@@ -32,6 +33,16 @@ func ExtractPodFailedReason(pod *v1.Pod) string {
 	for _, containerStatus := range containerStatuses {
 		if containerStatus.State.Terminated != nil && containerStatus.State.Terminated.ExitCode != 0 {
 			terminatedState := containerStatus.State.Terminated
+			if hook, ok := lifecycleHookOf(containerStatus.Name); ok {
+				failedMessage += fmt.Sprintf(
+					"Lifecycle %s failed with exit code %d because %s: %s\n",
+					hook,
+					terminatedState.ExitCode,
+					terminatedState.Reason,
+					terminatedState.Message,
+				)
+				continue
+			}
 			failedMessage += fmt.Sprintf(
 				"Container %s failed with exit code %d because %s: %s\n",
 				containerStatus.Name,
@@ -64,6 +75,41 @@ func ExtractPodFailedCause(pod *v1.Pod) api.Cause {
 	return api.Cause_Error
 }
 
+// ExtractPodFailureCategory returns the category of the failure of a pod,
+// which may be a failed pod or a pod that failed to start, e.g., because its image couldn't be pulled.
+func ExtractPodFailureCategory(pod *v1.Pod) api.FailureCategory {
+	if pod.Status.Reason == nodeLostReason {
+		return api.FailureCategory_NodeLost
+	}
+	switch ExtractPodFailedCause(pod) {
+	case api.Cause_OOM:
+		return api.FailureCategory_OOMKilled
+	case api.Cause_Evicted:
+		return api.FailureCategory_NodeLost
+	case api.Cause_DeadlineExceeded:
+		return api.FailureCategory_Deadline
+	}
+	if HasImagePullFailure(pod) {
+		return api.FailureCategory_ImagePull
+	}
+	for _, containerStatus := range GetPodContainerStatuses(pod) {
+		if containerStatus.State.Terminated != nil && containerStatus.State.Terminated.ExitCode != 0 {
+			return api.FailureCategory_UserError
+		}
+	}
+	return api.FailureCategory_UnknownFailureCategory
+}
+
+// HasImagePullFailure returns true if a container of the pod is waiting because its image couldn't be pulled.
+func HasImagePullFailure(pod *v1.Pod) bool {
+	for _, containerStatus := range GetPodContainerStatuses(pod) {
+		if containerStatus.State.Waiting != nil && imagePullBackOffStatesSet[containerStatus.State.Waiting.Reason] {
+			return true
+		}
+	}
+	return false
+}
+
 func ExtractPodExitCodes(pod *v1.Pod) map[string]int32 {
 	containerStatuses := pod.Status.ContainerStatuses
 	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
@@ -114,13 +160,5 @@ func isOom(containerStatus v1.ContainerStatus) bool {
 type PodStartupStatus int
 
 func hasUnstableContainerStates(pod *v1.Pod) bool {
-	for _, containerStatus := range GetPodContainerStatuses(pod) {
-		if containerStatus.State.Waiting != nil {
-			waitingReason := containerStatus.State.Waiting.Reason
-			if imagePullBackOffStatesSet[waitingReason] {
-				return true
-			}
-		}
-	}
-	return false
+	return HasImagePullFailure(pod)
 }
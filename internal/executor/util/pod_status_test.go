@@ -114,6 +114,20 @@ func TestExtractPodFailedReason(t *testing.T) {
 	assert.True(t, strings.Contains(failedReason, customErrorPod.Status.ContainerStatuses[0].State.Terminated.Message))
 }
 
+func TestExtractPodFailedReason_LifecycleHookFailed(t *testing.T) {
+	pod := createFailedPod(v1.ContainerStatus{
+		Name: preStartHookContainerPrefix + "stage-in",
+		State: v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{
+				ExitCode: 2,
+				Reason:   "Error",
+				Message:  "bucket not found",
+			},
+		},
+	})
+	assert.Equal(t, "Lifecycle pre-start hook stage-in failed with exit code 2 because Error: bucket not found\n", ExtractPodFailedReason(pod))
+}
+
 func TestExtractPodFailedCause(t *testing.T) {
 	failedCause := ExtractPodFailedCause(evictedPod)
 	assert.Equal(t, failedCause, api.Cause_Evicted)
@@ -128,6 +142,26 @@ func TestExtractPodFailedCause(t *testing.T) {
 	assert.Equal(t, failedCause, api.Cause_Error)
 }
 
+func TestExtractPodFailureCategory(t *testing.T) {
+	assert.Equal(t, api.FailureCategory_NodeLost, ExtractPodFailureCategory(evictedPod))
+	assert.Equal(t, api.FailureCategory_Deadline, ExtractPodFailureCategory(deadlineExceededPod))
+	assert.Equal(t, api.FailureCategory_OOMKilled, ExtractPodFailureCategory(oomPod))
+	assert.Equal(t, api.FailureCategory_UserError, ExtractPodFailureCategory(customErrorPod))
+
+	nodeLostPod := createFailedPod(v1.ContainerStatus{})
+	nodeLostPod.Status.Reason = "NodeLost"
+	assert.Equal(t, api.FailureCategory_NodeLost, ExtractPodFailureCategory(nodeLostPod))
+
+	imagePullPod := makePodWithContainerStatuses(
+		[]v1.ContainerState{{Waiting: &v1.ContainerStateWaiting{Reason: "ErrImagePull"}}},
+		[]v1.ContainerState{},
+	)
+	assert.Equal(t, api.FailureCategory_ImagePull, ExtractPodFailureCategory(imagePullPod))
+
+	runningPod := makePodWithContainerStatuses([]v1.ContainerState{{Running: &v1.ContainerStateRunning{}}}, []v1.ContainerState{})
+	assert.Equal(t, api.FailureCategory_UnknownFailureCategory, ExtractPodFailureCategory(runningPod))
+}
+
 func TestExtractFailedPodContainerStatuses(t *testing.T) {
 	containerStatuses := ExtractFailedPodContainerStatuses(evictedPod)
 	assert.Equal(t, len(containerStatuses), 0)
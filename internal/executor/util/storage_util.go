@@ -0,0 +1,51 @@
+package util
+
+import (
+	"golang.org/x/exp/slices"
+	v1 "k8s.io/api/core/v1"
+	storage "k8s.io/api/storage/v1"
+)
+
+// Annotation by which Kubernetes marks the default storage class of a cluster.
+const defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+// GetNodeStorageClasses returns the names of the storage classes of which volumes can be attached to node,
+// i.e., those without allowed topologies and those with an allowed topology matching the labels of the node.
+// If the default storage class of the cluster can be attached to the node, the empty string is included too,
+// since claims that don't specify a storage class are provisioned using the default one.
+func GetNodeStorageClasses(node *v1.Node, storageClasses []*storage.StorageClass) []string {
+	var rv []string
+	for _, storageClass := range storageClasses {
+		if !storageClassAllowedOnNode(node, storageClass) {
+			continue
+		}
+		rv = append(rv, storageClass.Name)
+		if storageClass.Annotations[defaultStorageClassAnnotation] == "true" {
+			rv = append(rv, "")
+		}
+	}
+	slices.Sort(rv)
+	return slices.Compact(rv)
+}
+
+func storageClassAllowedOnNode(node *v1.Node, storageClass *storage.StorageClass) bool {
+	if len(storageClass.AllowedTopologies) == 0 {
+		return true
+	}
+	for _, term := range storageClass.AllowedTopologies {
+		if topologySelectorTermMatches(node.Labels, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func topologySelectorTermMatches(nodeLabels map[string]string, term v1.TopologySelectorTerm) bool {
+	for _, expression := range term.MatchLabelExpressions {
+		value, ok := nodeLabels[expression.Key]
+		if !ok || !slices.Contains(expression.Values, value) {
+			return false
+		}
+	}
+	return true
+}
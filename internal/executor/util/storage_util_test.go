@@ -0,0 +1,46 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	storage "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetNodeStorageClasses(t *testing.T) {
+	standard := &storage.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "standard",
+			Annotations: map[string]string{defaultStorageClassAnnotation: "true"},
+		},
+	}
+	zonal := &storage.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "zonal"},
+		AllowedTopologies: []v1.TopologySelectorTerm{{
+			MatchLabelExpressions: []v1.TopologySelectorLabelRequirement{{
+				Key:    "topology.kubernetes.io/zone",
+				Values: []string{"zone-a", "zone-b"},
+			}},
+		}},
+	}
+	storageClasses := []*storage.StorageClass{zonal, standard}
+
+	inZone := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node1",
+		Labels: map[string]string{"topology.kubernetes.io/zone": "zone-b"},
+	}}
+	assert.Equal(t, []string{"", "standard", "zonal"}, GetNodeStorageClasses(inZone, storageClasses))
+
+	outOfZone := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node2",
+		Labels: map[string]string{"topology.kubernetes.io/zone": "zone-c"},
+	}}
+	assert.Equal(t, []string{"", "standard"}, GetNodeStorageClasses(outOfZone, storageClasses))
+
+	unlabelled := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node3"}}
+	assert.Equal(t, []string{"", "standard"}, GetNodeStorageClasses(unlabelled, storageClasses))
+
+	assert.Empty(t, GetNodeStorageClasses(inZone, nil))
+}
@@ -129,6 +129,11 @@ func (cls *ClusterUtilisationService) GetAvailableClusterCapacity(legacy bool) (
 		return nil, errors.Errorf("Failed getting available cluster capacity due to: %s", err)
 	}
 
+	storageClasses, err := cls.clusterContext.GetStorageClasses()
+	if err != nil {
+		return nil, errors.Errorf("Failed getting available cluster capacity due to: %s", err)
+	}
+
 	allPodsRequiringResource := getAllPodsRequiringResourceOnNodes(allPods, allNodes)
 	allNonCompletePodsRequiringResource := util.FilterNonCompletedPods(allPodsRequiringResource)
 	nodesUsage := getAllocatedResourceByNodeName(allNonCompletePodsRequiringResource)
@@ -188,6 +193,7 @@ func (cls *ClusterUtilisationService) GetAvailableClusterCapacity(legacy bool) (
 			Unschedulable:               !isSchedulable,
 			ResourceUsageByQueue:        resourceUsageByQueue,
 			NodeType:                    cls.nodeInfoService.GetType(node).Id,
+			StorageClasses:              util.GetNodeStorageClasses(node, storageClasses),
 		})
 	}
 
@@ -8,6 +8,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	clusterContext "github.com/armadaproject/armada/internal/executor/context"
 	"github.com/armadaproject/armada/internal/executor/domain"
 	"github.com/armadaproject/armada/internal/executor/reporter"
@@ -29,6 +30,24 @@ type podUtilisationInfo struct {
 	lastReported   time.Time
 	pod            *v1.Pod
 	utilisationMax *domain.UtilisationData
+	// Sum of all usage samples taken since the pod started running, from which the average usage is computed.
+	usageTotal armadaresource.ComputeResources
+	samples    int64
+}
+
+func (info *podUtilisationInfo) addSample(utilisation *domain.UtilisationData) {
+	if len(utilisation.CurrentUsage) == 0 {
+		return
+	}
+	info.usageTotal.Add(utilisation.CurrentUsage)
+	info.samples++
+}
+
+func (info *podUtilisationInfo) averageUsage() armadaresource.ComputeResources {
+	if info.samples == 0 {
+		return armadaresource.ComputeResources{}
+	}
+	return info.usageTotal.Div(info.samples)
 }
 
 func NewUtilisationEventReporter(
@@ -94,6 +113,7 @@ func (r *UtilisationEventReporter) ReportUtilisationEvents() {
 	for _, info := range r.podInfo {
 		currentUtilisation := r.podUtilisation.GetPodUtilisation(info.pod)
 		info.utilisationMax.Max(currentUtilisation)
+		info.addSample(currentUtilisation)
 		if info.lastReported.Before(reportingTime) {
 			reported := r.reportUsage(info)
 			if reported {
@@ -115,11 +135,15 @@ func (r *UtilisationEventReporter) updatePod(pod *v1.Pod) {
 	if pod.Status.Phase == v1.PodRunning {
 		_, exists := r.podInfo[pod.Name]
 		if !exists {
-			r.podInfo[pod.Name] = &podUtilisationInfo{
+			currentUtilisation := r.podUtilisation.GetPodUtilisation(pod)
+			info := &podUtilisationInfo{
 				lastReported:   time.Now(),
 				pod:            pod,
-				utilisationMax: r.podUtilisation.GetPodUtilisation(pod),
+				utilisationMax: currentUtilisation.DeepCopy(),
+				usageTotal:     armadaresource.ComputeResources{},
 			}
+			info.addSample(currentUtilisation)
+			r.podInfo[pod.Name] = info
 		}
 	}
 	if util.IsInTerminalState(pod) {
@@ -144,7 +168,7 @@ func (r *UtilisationEventReporter) reportUsage(info *podUtilisationInfo) bool {
 	if info.utilisationMax.IsEmpty() {
 		return false
 	}
-	event := reporter.CreateJobUtilisationEvent(info.pod, info.utilisationMax, r.clusterContext.GetClusterId())
+	event := reporter.CreateJobUtilisationEvent(info.pod, info.utilisationMax, info.averageUsage(), r.clusterContext.GetClusterId())
 	r.queueEventWithRetry(reporter.EventMessage{Event: event, JobRunId: util.ExtractJobRunId(info.pod)}, 3)
 	return true
 }
@@ -55,6 +55,8 @@ func TestUtilisationEventReporter_ReportUtilisationEvents(t *testing.T) {
 
 	assert.Equal(t, testPodResources.CurrentUsage, armadaresource.ComputeResources(event1.MaxResourcesForPeriod))
 	assert.Equal(t, testPodResources.CumulativeUsage, armadaresource.ComputeResources(event1.TotalCumulativeUsage))
+	assert.True(t, testPodResources.CurrentUsage["cpu"].Equal(event1.AverageResources["cpu"]))
+	assert.True(t, testPodResources.CurrentUsage["memory"].Equal(event1.AverageResources["memory"]))
 
 	period := event2.Created.Sub(event1.Created)
 
@@ -87,6 +89,18 @@ func TestUtilisationEventReporter_ReportUtilisationEvents_WhenNoUtilisationData(
 	assert.True(t, count > 0)
 }
 
+func TestPodUtilisationInfo_AverageUsage(t *testing.T) {
+	info := &podUtilisationInfo{usageTotal: armadaresource.ComputeResources{}}
+	assert.Empty(t, info.averageUsage())
+
+	info.addSample(&domain.UtilisationData{CurrentUsage: armadaresource.ComputeResources{"cpu": resource.MustParse("1")}})
+	info.addSample(&domain.UtilisationData{CurrentUsage: armadaresource.ComputeResources{"cpu": resource.MustParse("2")}})
+	// Samples without usage data are ignored.
+	info.addSample(domain.EmptyUtilisationData())
+
+	assert.True(t, resource.MustParse("1500m").Equal(info.averageUsage()["cpu"]))
+}
+
 func submitPod(clusterContext context.ClusterContext) (*v1.Pod, error) {
 	podResources := map[v1.ResourceName]resource.Quantity{
 		"cpu":    resource.MustParse("1"),
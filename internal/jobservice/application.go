@@ -15,6 +15,7 @@ import (
 	grpcCommon "github.com/armadaproject/armada/internal/common/grpc"
 	grpcconfig "github.com/armadaproject/armada/internal/common/grpc/configuration"
 	"github.com/armadaproject/armada/internal/common/grpc/grpcpool"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/jobservice/configuration"
 	"github.com/armadaproject/armada/internal/jobservice/events"
 	"github.com/armadaproject/armada/internal/jobservice/eventstojobs"
@@ -106,13 +107,14 @@ func (a *App) StartUp(ctx context.Context, config *configuration.JobServiceConfi
 		log.Debugf("Subscription expiry time: %d", config.SubscriptionExpirySecs)
 	}
 
-	log := log.WithField("JobService", "Startup")
 	grpcServer := grpcCommon.CreateGrpcServer(
 		config.Grpc.KeepaliveParams,
 		config.Grpc.KeepaliveEnforcementPolicy,
 		[]authorization.AuthService{&authorization.AnonymousAuthService{}},
 		config.Grpc.Tls,
+		logging.StandardLogger(),
 	)
+	log := log.WithField("JobService", "Startup")
 
 	err, sqlJobRepo, dbCallbackFn := repository.NewSQLJobService(config, log)
 	if err != nil {
@@ -39,6 +39,14 @@ func (des *DummyEventServer) Watch(req *api.WatchRequest, stream api.Event_Watch
 	return nil
 }
 
+func (des *DummyEventServer) WatchJobSet(req *api.WatchJobSetRequest, stream api.Event_WatchJobSetServer) error {
+	return nil
+}
+
+func (des *DummyEventServer) GetJobRunDetails(ctx context.Context, req *api.JobRunDetailsRequest) (*api.JobRunDetails, error) {
+	return &api.JobRunDetails{}, nil
+}
+
 func startTestGrpcServer(t *testing.T) *grpc.Server {
 	grpcServer := grpc.NewServer()
 	dummyEventServer := DummyEventServer{}
@@ -18,6 +18,7 @@ import JobSetsContainer from "./containers/JobSetsContainer"
 import { UserManagerContext, useUserManager } from "./oidc"
 import { ICordonService } from "./services/lookoutV2/CordonService"
 import { IGetJobSpecService } from "./services/lookoutV2/GetJobSpecService"
+import { IGetQueueService } from "./services/lookoutV2/GetQueueService"
 import { IGetRunErrorService } from "./services/lookoutV2/GetRunErrorService"
 import { ILogService } from "./services/lookoutV2/LogService"
 import { OidcConfig } from "./utils"
@@ -73,6 +74,7 @@ type AppProps = {
   v2UpdateJobsService: UpdateJobsService
   v2UpdateJobSetsService: UpdateJobSetsService
   v2CordonService: ICordonService
+  v2QueueService: IGetQueueService
   jobSetsAutoRefreshMs: number | undefined
   jobsAutoRefreshMs: number | undefined
   debugEnabled: boolean
@@ -128,6 +130,7 @@ export function App(props: AppProps): JSX.Element {
                           jobSpecService={props.v2JobSpecService}
                           logService={props.v2LogService}
                           cordonService={props.v2CordonService}
+                          queueService={props.v2QueueService}
                           debug={props.debugEnabled}
                           autoRefreshMs={props.jobsAutoRefreshMs}
                         />
@@ -14,6 +14,7 @@ import { CancelDialog } from "./CancelDialog"
 import { CustomViewPicker } from "./CustomViewPicker"
 import styles from "./JobsTableActionBar.module.css"
 import { ReprioritiseDialog } from "./ReprioritiseDialog"
+import { ResubmitDialog } from "./ResubmitDialog"
 import { useCustomSnackbar } from "../../hooks/useCustomSnackbar"
 
 export interface JobsTableActionBarProps {
@@ -68,6 +69,7 @@ export const JobsTableActionBar = memo(
   }: JobsTableActionBarProps) => {
     const [cancelDialogOpen, setCancelDialogOpen] = useState(false)
     const [reprioritiseDialogOpen, setReprioritiseDialogOpen] = useState(false)
+    const [resubmitDialogOpen, setResubmitDialogOpen] = useState(false)
     const openSnackbar = useCustomSnackbar()
 
     const selectableColumns = useMemo(() => allColumns.filter((col) => col.enableHiding !== false), [allColumns])
@@ -76,6 +78,7 @@ export const JobsTableActionBar = memo(
 
     const cancelDialogOnClose = useCallback(() => setCancelDialogOpen(false), [])
     const reprioritiseDialogOnClose = useCallback(() => setReprioritiseDialogOpen(false), [])
+    const resubmitDialogOnClose = useCallback(() => setResubmitDialogOpen(false), [])
     return (
       <div className={styles.actionBar}>
         {cancelDialogOpen && (
@@ -94,6 +97,14 @@ export const JobsTableActionBar = memo(
             updateJobsService={updateJobsService}
           />
         )}
+        {resubmitDialogOpen && (
+          <ResubmitDialog
+            onClose={resubmitDialogOnClose}
+            selectedItemFilters={selectedItemFilters}
+            getJobsService={getJobsService}
+            updateJobsService={updateJobsService}
+          />
+        )}
         <div className={styles.actionGroup}>
           <GroupBySelect columns={allColumns} groups={groupedColumns} onGroupsChanged={onGroupsChanged} />
         </div>
@@ -169,6 +180,9 @@ export const JobsTableActionBar = memo(
           <Button variant="contained" disabled={numSelectedItems === 0} onClick={() => setReprioritiseDialogOpen(true)}>
             Reprioritize selected
           </Button>
+          <Button variant="contained" disabled={numSelectedItems === 0} onClick={() => setResubmitDialogOpen(true)}>
+            Resubmit selected
+          </Button>
         </div>
       </div>
     )
@@ -0,0 +1,112 @@
+import { render, waitFor } from "@testing-library/react"
+import userEvent from "@testing-library/user-event"
+import { Job, JobFilter, JobState, Match } from "models/lookoutV2Models"
+import { SnackbarProvider } from "notistack"
+import { IGetJobsService } from "services/lookoutV2/GetJobsService"
+import { UpdateJobsResponse, UpdateJobsService } from "services/lookoutV2/UpdateJobsService"
+import FakeGetJobsService from "services/lookoutV2/mocks/FakeGetJobsService"
+import { makeManyTestJobs } from "utils/fakeJobsUtils"
+
+import { ResubmitDialog } from "./ResubmitDialog"
+
+describe("ResubmitDialog", () => {
+  const numJobs = 5
+  const numFinishedJobs = 0
+  let jobs: Job[],
+    selectedItemFilters: JobFilter[][],
+    getJobsService: IGetJobsService,
+    updateJobsService: UpdateJobsService,
+    onClose: () => void
+
+  beforeEach(() => {
+    jobs = makeManyTestJobs(numJobs, numFinishedJobs)
+    jobs[0].state = JobState.Failed
+    selectedItemFilters = [
+      [
+        {
+          field: "jobId",
+          value: "job-id-0",
+          match: Match.Exact,
+        },
+      ],
+    ]
+    getJobsService = new FakeGetJobsService(jobs)
+    updateJobsService = {
+      resubmitJobs: jest.fn(),
+    } as any
+    onClose = jest.fn()
+  })
+
+  const renderComponent = () =>
+    render(
+      <SnackbarProvider>
+        <ResubmitDialog
+          onClose={onClose}
+          selectedItemFilters={selectedItemFilters}
+          getJobsService={getJobsService}
+          updateJobsService={updateJobsService}
+        />
+      </SnackbarProvider>,
+    )
+
+  it("displays job information", async () => {
+    const { getByRole, findByRole, getByText } = renderComponent()
+
+    // Initial render
+    getByRole("heading", { name: "Resubmit jobs" })
+
+    // Once job details are fetched
+    await findByRole("heading", { name: "Resubmit 1 job" })
+
+    // Check basic job information is displayed
+    getByText("job-id-0")
+    getByText("queue-0")
+    getByText("job-set-0")
+  })
+
+  it("shows an alert if no jobs are in a terminated state", async () => {
+    jobs[0].state = JobState.Running
+    const { findByText } = renderComponent()
+
+    // Once job details are fetched
+    await findByText(/None of the selected jobs are in a terminated state/i)
+  })
+
+  it("allows the user to resubmit jobs", async () => {
+    const { getByRole, findByText } = renderComponent()
+
+    updateJobsService.resubmitJobs = jest.fn((): Promise<UpdateJobsResponse> => {
+      return Promise.resolve({
+        successfulJobIds: [jobs[0].jobId],
+        failedJobIds: [],
+      })
+    })
+
+    const resubmitButton = await waitFor(() => getByRole("button", { name: /Resubmit 1 job/i }))
+    await userEvent.click(resubmitButton)
+
+    await findByText(/Successfully resubmitted jobs/i)
+
+    expect(updateJobsService.resubmitJobs).toHaveBeenCalled()
+  })
+
+  it("shows error reasons if resubmission fails", async () => {
+    const { getByRole, findByText } = renderComponent()
+
+    updateJobsService.resubmitJobs = jest.fn((): Promise<UpdateJobsResponse> => {
+      return Promise.resolve({
+        successfulJobIds: [],
+        failedJobIds: [{ jobId: jobs[0].jobId, errorReason: "This is a test" }],
+      })
+    })
+
+    const resubmitButton = await waitFor(() => getByRole("button", { name: /Resubmit 1 job/i }))
+    await userEvent.click(resubmitButton)
+
+    // Snackbar popup
+    await findByText(/All jobs failed to resubmit/i)
+
+    // Verify reason is shown in table
+    await findByText("This is a test")
+  })
+})
@@ -0,0 +1,170 @@
+import { useCallback, useEffect, useMemo, useRef, useState } from "react"
+
+import { Refresh, Replay } from "@mui/icons-material"
+import { LoadingButton } from "@mui/lab"
+import { Button, CircularProgress, Dialog, DialogActions, DialogContent, DialogTitle, Alert } from "@mui/material"
+import _ from "lodash"
+import { isTerminatedJobState, Job, JobFilter, JobId } from "models/lookoutV2Models"
+import { IGetJobsService } from "services/lookoutV2/GetJobsService"
+import { UpdateJobsService } from "services/lookoutV2/UpdateJobsService"
+import { pl } from "utils"
+import { getUniqueJobsMatchingFilters } from "utils/jobsDialogUtils"
+import { formatJobState } from "utils/jobsTableFormatters"
+
+import dialogStyles from "./DialogStyles.module.css"
+import { JobStatusTable } from "./JobStatusTable"
+import { useCustomSnackbar } from "../../hooks/useCustomSnackbar"
+import { getAccessToken, useUserManager } from "../../oidc"
+
+interface ResubmitDialogProps {
+  onClose: () => void
+  selectedItemFilters: JobFilter[][]
+  getJobsService: IGetJobsService
+  updateJobsService: UpdateJobsService
+}
+
+export const ResubmitDialog = ({
+  onClose,
+  selectedItemFilters,
+  getJobsService,
+  updateJobsService,
+}: ResubmitDialogProps) => {
+  const mounted = useRef(false)
+  // State
+  const [isLoadingJobs, setIsLoadingJobs] = useState(true)
+  const [selectedJobs, setSelectedJobs] = useState<Job[]>([])
+  const [jobIdsToResubmitResponses, setJobIdsToResubmitResponses] = useState<Record<JobId, string>>({})
+  const resubmittableJobs = useMemo(() => selectedJobs.filter((job) => isTerminatedJobState(job.state)), [selectedJobs])
+  const [isResubmitting, setIsResubmitting] = useState(false)
+  const [hasAttemptedResubmit, setHasAttemptedResubmit] = useState(false)
+  const openSnackbar = useCustomSnackbar()
+
+  const userManager = useUserManager()
+
+  // Actions
+  const fetchSelectedJobs = useCallback(async () => {
+    if (!mounted.current) {
+      return
+    }
+
+    setIsLoadingJobs(true)
+
+    const uniqueJobsToResubmit = await getUniqueJobsMatchingFilters(selectedItemFilters, false, getJobsService)
+    const sortedJobs = _.orderBy(uniqueJobsToResubmit, (job) => job.jobId, "desc")
+
+    if (!mounted.current) {
+      return
+    }
+
+    setSelectedJobs(sortedJobs)
+    setIsLoadingJobs(false)
+    setHasAttemptedResubmit(false)
+  }, [selectedItemFilters, getJobsService])
+
+  const resubmitSelectedJobs = useCallback(async () => {
+    setIsResubmitting(true)
+
+    const accessToken = userManager && (await getAccessToken(userManager))
+    const response = await updateJobsService.resubmitJobs(resubmittableJobs, accessToken)
+
+    if (response.failedJobIds.length === 0) {
+      openSnackbar("Successfully resubmitted jobs. The new jobs are in the same job sets.", "success")
+    } else if (response.successfulJobIds.length === 0) {
+      openSnackbar("All jobs failed to resubmit. See table for error responses.", "error")
+    } else {
+      openSnackbar("Some jobs failed to resubmit. See table for error responses.", "warning")
+    }
+
+    const newResponseStatus = { ...jobIdsToResubmitResponses }
+    response.successfulJobIds.map((jobId) => (newResponseStatus[jobId] = "Success"))
+    response.failedJobIds.map(({ jobId, errorReason }) => (newResponseStatus[jobId] = errorReason))
+
+    setJobIdsToResubmitResponses(newResponseStatus)
+    setIsResubmitting(false)
+    setHasAttemptedResubmit(true)
+  }, [resubmittableJobs, jobIdsToResubmitResponses])
+
+  // On dialog open
+  useEffect(() => {
+    mounted.current = true
+    fetchSelectedJobs().catch(console.error)
+    return () => {
+      mounted.current = false
+    }
+  }, [])
+
+  // Event handlers
+  const handleRefetch = useCallback(() => {
+    setJobIdsToResubmitResponses({})
+    fetchSelectedJobs().catch(console.error)
+  }, [fetchSelectedJobs])
+
+  const jobsToRender = useMemo(() => resubmittableJobs.slice(0, 1000), [resubmittableJobs])
+  const formatState = useCallback((job) => formatJobState(job.state), [])
+  const formatSubmittedTime = useCallback((job) => job.submitted, [])
+  return (
+    <Dialog open={true} onClose={onClose} fullWidth maxWidth="xl">
+      <DialogTitle>Resubmit {isLoadingJobs ? "jobs" : pl(resubmittableJobs, "job")}</DialogTitle>
+
+      <DialogContent>
+        {isLoadingJobs && (
+          <div className={dialogStyles.loadingInfo}>
+            Fetching info on selected jobs...
+            <CircularProgress variant="indeterminate" />
+          </div>
+        )}
+
+        {!isLoadingJobs && (
+          <>
+            {resubmittableJobs.length > 0 && resubmittableJobs.length < selectedJobs.length && (
+              <Alert severity="info" sx={{ marginBottom: "0.5em" }}>
+                {pl(selectedJobs.length, "job is", "jobs are")} selected, but only{" "}
+                {pl(resubmittableJobs.length, "job is", "jobs are")} in a resubmittable (terminated) state.
+              </Alert>
+            )}
+
+            {resubmittableJobs.length === 0 && (
+              <Alert severity="info">
+                None of the selected jobs are in a terminated state, therefore there is nothing to resubmit.
+              </Alert>
+            )}
+
+            {resubmittableJobs.length > 0 && (
+              <JobStatusTable
+                jobsToRender={jobsToRender}
+                jobStatus={jobIdsToResubmitResponses}
+                totalJobCount={resubmittableJobs.length}
+                additionalColumnsToDisplay={[
+                  { displayName: "State", formatter: formatState },
+                  { displayName: "Submitted Time", formatter: formatSubmittedTime },
+                ]}
+                showStatus={Object.keys(jobIdsToResubmitResponses).length > 0}
+              />
+            )}
+          </>
+        )}
+      </DialogContent>
+
+      <DialogActions>
+        <Button onClick={onClose}>Close</Button>
+        <Button
+          onClick={handleRefetch}
+          disabled={isLoadingJobs || isResubmitting}
+          variant="outlined"
+          endIcon={<Refresh />}
+        >
+          Refetch jobs
+        </Button>
+        <LoadingButton
+          onClick={resubmitSelectedJobs}
+          loading={isResubmitting}
+          disabled={isLoadingJobs || hasAttemptedResubmit || resubmittableJobs.length === 0}
+          variant="contained"
+          endIcon={<Replay />}
+        >
+          Resubmit {isLoadingJobs ? "jobs" : pl(resubmittableJobs, "job")}
+        </LoadingButton>
+      </DialogActions>
+    </Dialog>
+  )
+}
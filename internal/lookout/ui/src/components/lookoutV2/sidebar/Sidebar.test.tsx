@@ -7,6 +7,7 @@ import { makeTestJob } from "utils/fakeJobsUtils"
 import { Sidebar } from "./Sidebar"
 import { FakeCordonService } from "../../../services/lookoutV2/mocks/FakeCordonService"
 import FakeGetJobSpecService from "../../../services/lookoutV2/mocks/FakeGetJobSpecService"
+import { FakeGetQueueService } from "../../../services/lookoutV2/mocks/FakeGetQueueService"
 import { FakeGetRunErrorService } from "../../../services/lookoutV2/mocks/FakeGetRunErrorService"
 import { FakeLogService } from "../../../services/lookoutV2/mocks/FakeLogService"
 
@@ -47,6 +48,7 @@ describe("Sidebar", () => {
           jobSpecService={new FakeGetJobSpecService()}
           logService={new FakeLogService()}
           cordonService={new FakeCordonService()}
+          queueService={new FakeGetQueueService(false, "PAUSED")}
           sidebarWidth={600}
           onClose={onClose}
           onWidthChange={() => undefined}
@@ -57,7 +59,7 @@ describe("Sidebar", () => {
   it("should show job details by default", () => {
     const { getByRole } = renderComponent()
 
-    within(getByRole("row", { name: /Queue/ })).getByText(job.queue)
+    within(getByRole("row", { name: /^Queue(?! State)/ })).getByText(job.queue)
     within(getByRole("row", { name: /Job Set/ })).getByText(job.jobSet)
     within(getByRole("row", { name: /CPU/ })).getByText("3.9")
     within(getByRole("row", { name: /Memory/ })).getByText("38Mi")
@@ -65,6 +67,12 @@ describe("Sidebar", () => {
     within(getByRole("row", { name: /GPU/ })).getByText("4")
   })
 
+  it("should show the state of the queue", async () => {
+    const { findByRole } = renderComponent()
+
+    within(await findByRole("row", { name: /Queue State/ })).getByText("Paused")
+  })
+
   it("should allow users to view run details", async () => {
     const { getByRole } = renderComponent()
     const run = job.runs[0]
@@ -12,6 +12,7 @@ import { SidebarTabJobRuns } from "./SidebarTabJobRuns"
 import { SidebarTabJobYaml } from "./SidebarTabJobYaml"
 import { ICordonService } from "../../../services/lookoutV2/CordonService"
 import { IGetJobSpecService } from "../../../services/lookoutV2/GetJobSpecService"
+import { IGetQueueService } from "../../../services/lookoutV2/GetQueueService"
 import { IGetRunErrorService } from "../../../services/lookoutV2/GetRunErrorService"
 import { ILogService } from "../../../services/lookoutV2/LogService"
 
@@ -34,6 +35,7 @@ export interface SidebarProps {
   jobSpecService: IGetJobSpecService
   logService: ILogService
   cordonService: ICordonService
+  queueService: IGetQueueService
   sidebarWidth: number
   onClose: () => void
   onWidthChange: (width: number) => void
@@ -46,6 +48,7 @@ export const Sidebar = memo(
     jobSpecService,
     logService,
     cordonService,
+    queueService,
     sidebarWidth,
     onClose,
     onWidthChange,
@@ -171,7 +174,7 @@ export const Sidebar = memo(
                 </Tabs>
 
                 <TabPanel value={SidebarTab.JobDetails} className={styles.sidebarTabPanel}>
-                  <SidebarTabJobDetails job={job} jobSpecService={jobSpecService} />
+                  <SidebarTabJobDetails job={job} jobSpecService={jobSpecService} queueService={queueService} />
                 </TabPanel>
 
                 <TabPanel value={SidebarTab.JobRuns} className={styles.sidebarTabPanel}>
@@ -1,19 +1,50 @@
+import { useEffect, useState } from "react"
+
 import { Typography } from "@mui/material"
 import { Job } from "models/lookoutV2Models"
 
 import { ContainerDetails } from "./ContainerDetails"
 import { KeyValuePairTable } from "./KeyValuePairTable"
+import { getAccessToken, useUserManager } from "../../../oidc"
 import { IGetJobSpecService } from "../../../services/lookoutV2/GetJobSpecService"
+import { IGetQueueService } from "../../../services/lookoutV2/GetQueueService"
 import { formatBytes, formatCpu } from "../../../utils/resourceUtils"
 
 export interface SidebarTabJobDetailsProps {
   job: Job
   jobSpecService: IGetJobSpecService
+  queueService: IGetQueueService
 }
 
-export const SidebarTabJobDetails = ({ job, jobSpecService }: SidebarTabJobDetailsProps) => {
+export const SidebarTabJobDetails = ({ job, jobSpecService, queueService }: SidebarTabJobDetailsProps) => {
+  const userManager = useUserManager()
+  const [queueState, setQueueState] = useState<string | undefined>(undefined)
+
+  useEffect(() => {
+    let cancelled = false
+    setQueueState(undefined)
+    const fetchQueueState = async () => {
+      try {
+        const accessToken = userManager && (await getAccessToken(userManager))
+        const state = await queueService.getQueueState(job.queue, accessToken)
+        if (!cancelled) {
+          setQueueState(state)
+        }
+      } catch (e) {
+        // The user may not be allowed to see the queue, in which case its state isn't shown.
+        console.error(e)
+      }
+    }
+    fetchQueueState()
+    return () => {
+      cancelled = true
+    }
+  }, [job.queue])
+
   const details = [
+    ...(job.region ? [{ key: "Region", value: job.region }] : []),
     { key: "Queue", value: job.queue },
+    ...(queueState ? [{ key: "Queue State", value: formatQueueState(queueState) }] : []),
     { key: "Job Set", value: job.jobSet },
     { key: "Owner", value: job.owner },
     ...(job.namespace ? [{ key: "Namespace", value: job.namespace }] : []),
@@ -50,3 +81,6 @@ export const SidebarTabJobDetails = ({ job, jobSpecService }: SidebarTabJobDetai
     </>
   )
 }
+
+// formatQueueState formats a queue state, e.g., "PAUSED", for display, e.g., "Paused".
+const formatQueueState = (state: string): string => state.charAt(0) + state.slice(1).toLowerCase()
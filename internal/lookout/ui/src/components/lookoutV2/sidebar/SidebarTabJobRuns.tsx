@@ -15,6 +15,7 @@ import {
 import { Button, Tooltip } from "@mui/material"
 import { Job, JobRun } from "models/lookoutV2Models"
 import { formatJobRunState, formatUtcDate } from "utils/jobsTableFormatters"
+import { formatBytes, formatCpu } from "utils/resourceUtils"
 
 import { CodeBlock } from "./CodeBlock"
 import { KeyValuePairTable } from "./KeyValuePairTable"
@@ -33,6 +34,17 @@ export interface SidebarTabJobRunsProps {
 
 type LoadState = "Idle" | "Loading"
 
+// Formats resource usage along with the share of the job's request it amounts to, to help right-size requests
+const formatUsage = (usage: number | undefined, request: number, format: (n: number) => string): string => {
+  if (usage === undefined) {
+    return ""
+  }
+  if (request <= 0) {
+    return format(usage)
+  }
+  return `${format(usage)} (${Math.round((100 * usage) / request)}% of request)`
+}
+
 export const SidebarTabJobRuns = ({ job, runErrorService, cordonService }: SidebarTabJobRunsProps) => {
   const mounted = useRef(false)
   const openSnackbar = useCustomSnackbar()
@@ -138,7 +150,20 @@ export const SidebarTabJobRuns = ({ job, runErrorService, cordonService }: Sideb
                   { key: "Finished (UTC)", value: formatUtcDate(run.finished) },
                   { key: "Cluster", value: run.cluster },
                   { key: "Node", value: run.node ?? "" },
+                  ...Object.entries(run.nodeLabels ?? {}).map(([key, value]) => ({
+                    key: `Node label ${key}`,
+                    value: value,
+                  })),
                   { key: "Exit code", value: run.exitCode?.toString() ?? "" },
+                  { key: "Peak CPU usage", value: formatUsage(run.cpuUsagePeak, job.cpu, formatCpu) },
+                  { key: "Average CPU usage", value: formatUsage(run.cpuUsageAverage, job.cpu, formatCpu) },
+                  { key: "Peak memory usage", value: formatUsage(run.memoryUsagePeak, job.memory, formatBytes) },
+                  { key: "Average memory usage", value: formatUsage(run.memoryUsageAverage, job.memory, formatBytes) },
+                  ...(run.artifacts ?? []).map((artifact) => ({
+                    key: `Artifact ${artifact.containerName}/${artifact.name}`,
+                    value: artifact.url,
+                    isAnnotation: true,
+                  })),
                 ].filter((pair) => pair.value !== "")}
               />
             </AccordionDetails>
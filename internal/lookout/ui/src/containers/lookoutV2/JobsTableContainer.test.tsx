@@ -16,6 +16,7 @@ import { IGetRunErrorService } from "../../services/lookoutV2/GetRunErrorService
 import { ILogService } from "../../services/lookoutV2/LogService"
 import { FakeCordonService } from "../../services/lookoutV2/mocks/FakeCordonService"
 import FakeGetJobSpecService from "../../services/lookoutV2/mocks/FakeGetJobSpecService"
+import { FakeGetQueueService } from "../../services/lookoutV2/mocks/FakeGetQueueService"
 import { FakeGetRunErrorService } from "../../services/lookoutV2/mocks/FakeGetRunErrorService"
 import { FakeLogService } from "../../services/lookoutV2/mocks/FakeLogService"
 
@@ -89,6 +90,7 @@ describe("JobsTableContainer", () => {
           jobSpecService={jobSpecService}
           logService={logService}
           cordonService={new FakeCordonService()}
+          queueService={new FakeGetQueueService(false)}
           debug={false}
           autoRefreshMs={30000}
         />
@@ -73,6 +73,7 @@ import { useCustomSnackbar } from "../../hooks/useCustomSnackbar"
 import { ICordonService } from "../../services/lookoutV2/CordonService"
 import { CustomViewsService } from "../../services/lookoutV2/CustomViewsService"
 import { IGetJobSpecService } from "../../services/lookoutV2/GetJobSpecService"
+import { IGetQueueService } from "../../services/lookoutV2/GetQueueService"
 import { ILogService } from "../../services/lookoutV2/LogService"
 import { getErrorMessage, waitMillis } from "../../utils"
 import { EmptyInputError, ParseError } from "../../utils/resourceUtils"
@@ -87,6 +88,7 @@ interface JobsTableContainerProps {
   jobSpecService: IGetJobSpecService
   logService: ILogService
   cordonService: ICordonService
+  queueService: IGetQueueService
   debug: boolean
   autoRefreshMs: number | undefined
 }
@@ -131,6 +133,7 @@ export const JobsTableContainer = ({
   jobSpecService,
   logService,
   cordonService,
+  queueService,
   debug,
   autoRefreshMs,
 }: JobsTableContainerProps) => {
@@ -816,6 +819,7 @@ export const JobsTableContainer = ({
           jobSpecService={jobSpecService}
           logService={logService}
           cordonService={cordonService}
+          queueService={queueService}
           sidebarWidth={sidebarWidth}
           onClose={sideBarClose}
           onWidthChange={setSidebarWidth}
@@ -12,10 +12,12 @@ import { SubmitApi, Configuration as SubmitConfiguration } from "./openapi/armad
 import reportWebVitals from "./reportWebVitals"
 import { CordonService } from "./services/lookoutV2/CordonService"
 import { GetJobSpecService } from "./services/lookoutV2/GetJobSpecService"
+import { GetQueueService } from "./services/lookoutV2/GetQueueService"
 import { GetRunErrorService } from "./services/lookoutV2/GetRunErrorService"
 import { LogService as V2LogService } from "./services/lookoutV2/LogService"
 import { FakeCordonService } from "./services/lookoutV2/mocks/FakeCordonService"
 import FakeGetJobSpecService from "./services/lookoutV2/mocks/FakeGetJobSpecService"
+import { FakeGetQueueService } from "./services/lookoutV2/mocks/FakeGetQueueService"
 import { FakeGetRunErrorService } from "./services/lookoutV2/mocks/FakeGetRunErrorService"
 import { FakeLogService } from "./services/lookoutV2/mocks/FakeLogService"
 import { getUIConfig } from "./utils"
@@ -47,6 +49,7 @@ import "./index.css"
   const v2CordonService = fakeDataEnabled
     ? new FakeCordonService()
     : new CordonService({ credentials: "include" }, uiConfig.binocularsBaseUrlPattern)
+  const v2QueueService = fakeDataEnabled ? new FakeGetQueueService() : new GetQueueService(submitApi)
 
   ReactDOM.render(
     <App
@@ -60,6 +63,7 @@ import "./index.css"
       v2JobSpecService={v2JobSpecService}
       v2LogService={v2LogService}
       v2CordonService={v2CordonService}
+      v2QueueService={v2QueueService}
       jobSetsAutoRefreshMs={uiConfig.jobSetsAutoRefreshMs}
       jobsAutoRefreshMs={uiConfig.jobsAutoRefreshMs}
       debugEnabled={uiConfig.debugEnabled}
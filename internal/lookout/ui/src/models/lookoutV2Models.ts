@@ -78,6 +78,7 @@ export type Job = {
   lastActiveRunId?: string
   lastTransitionTime: string
   cancelReason?: string
+  region?: string
 }
 
 export type JobKey = keyof Job
@@ -87,12 +88,25 @@ export type JobRun = {
   jobId: string
   cluster: string
   node?: string
+  nodeLabels?: Record<string, string>
   leased?: string
   pending?: string
   started?: string
   finished?: string
   jobRunState: JobRunState
   exitCode?: number
+  artifacts?: JobRunArtifact[]
+  cpuUsagePeak?: number // millicores
+  cpuUsageAverage?: number // millicores
+  memoryUsagePeak?: number // bytes
+  memoryUsageAverage?: number // bytes
+}
+
+export type JobRunArtifact = {
+  name: string
+  containerName: string
+  url: string
+  sizeBytes: number
 }
 
 export enum Match {
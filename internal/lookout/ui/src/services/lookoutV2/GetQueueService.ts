@@ -0,0 +1,20 @@
+import { getAuthorizationHeaders } from "../../oidc"
+import { SubmitApi } from "../../openapi/armada"
+
+export interface IGetQueueService {
+  // Returns the state of the queue, e.g., "OPEN" or "PAUSED".
+  getQueueState(queue: string, accessToken?: string): Promise<string>
+}
+
+export class GetQueueService implements IGetQueueService {
+  constructor(private submitApi: SubmitApi) {}
+
+  async getQueueState(queue: string, accessToken?: string): Promise<string> {
+    const response = await this.submitApi.getQueue(
+      { name: queue },
+      accessToken === undefined ? undefined : { headers: getAuthorizationHeaders(accessToken) },
+    )
+    // The state of open queues is omitted, since it's the default.
+    return response.state ?? "OPEN"
+  }
+}
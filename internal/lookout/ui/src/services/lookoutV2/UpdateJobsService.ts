@@ -134,6 +134,59 @@ export class UpdateJobsService {
 
     return response
   }
+
+  resubmitJobs = async (jobs: Job[], accessToken?: string): Promise<UpdateJobsResponse> => {
+    const response: UpdateJobsResponse = { successfulJobIds: [], failedJobIds: [] }
+
+    // Resubmitted jobs are submitted in a single request per batch, so batches are kept to the size of a submit request
+    const maxJobsPerRequest = 200
+    const chunks = createJobBatches(jobs, maxJobsPerRequest)
+
+    // Start all requests to allow them to fire off in parallel
+    const apiResponsePromises = []
+    for (const [queue, jobSetMap] of chunks) {
+      for (const [jobSet, batches] of jobSetMap) {
+        for (const batch of batches) {
+          apiResponsePromises.push({
+            promise: this.submitApi.resubmitJobs(
+              {
+                body: {
+                  jobIds: batch,
+                  queue: queue,
+                  jobSetId: jobSet,
+                },
+              },
+              accessToken === undefined ? undefined : { headers: getAuthorizationHeaders(accessToken) },
+            ),
+            jobIds: batch,
+          })
+        }
+      }
+    }
+
+    for (const apiResponsePromise of apiResponsePromises) {
+      try {
+        // Response items are in the same order as the job ids in the request
+        const items = (await apiResponsePromise.promise)?.jobResponseItems ?? []
+        apiResponsePromise.jobIds.forEach((jobId, i) => {
+          const error = items[i]?.error
+          if (i >= items.length) {
+            response.failedJobIds.push({ jobId, errorReason: "No resubmission result found in response body" })
+          } else if (error) {
+            response.failedJobIds.push({ jobId, errorReason: error })
+          } else {
+            response.successfulJobIds.push(jobId)
+          }
+        })
+      } catch (e) {
+        console.error(e)
+        const text = await getErrorMessage(e)
+        apiResponsePromise.jobIds.forEach((jobId) => response.failedJobIds.push({ jobId, errorReason: text }))
+      }
+    }
+
+    return response
+  }
 }
 
 export function createJobBatches(jobs: Job[], batchSize: number): Map<string, Map<string, JobId[][]>> {
@@ -0,0 +1,13 @@
+import { simulateApiWait } from "../../../utils/fakeJobsUtils"
+import { IGetQueueService } from "../GetQueueService"
+
+export class FakeGetQueueService implements IGetQueueService {
+  constructor(private simulateApiWait = true, private state = "OPEN") {}
+
+  async getQueueState(queue: string, accessToken?: string): Promise<string> {
+    if (this.simulateApiWait) {
+      await simulateApiWait()
+    }
+    return this.state
+  }
+}
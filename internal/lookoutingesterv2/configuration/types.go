@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
 )
 
 type LookoutIngesterV2Configuration struct {
@@ -17,6 +18,9 @@ type LookoutIngesterV2Configuration struct {
 	SubscriptionName string
 	// Size in bytes above which job specs will be compressed when inserting in the database
 	MinJobSpecCompressionSize int
+	// How job specs and errors are compressed when inserting in the database.
+	// MinJobSpecCompressionSize only applies when compressing with zlib.
+	Compression configuration.CompressionConfig
 	// Number of messages that will be batched together before being inserted into the database
 	BatchSize int
 	// Maximum time since the last batch before a batch will be inserted into the database
@@ -39,4 +43,6 @@ type LookoutIngesterV2Configuration struct {
 	UseLegacyEventConversion bool
 	// If non-nil, net/http/pprof endpoints are exposed on localhost on this port.
 	PprofPort *uint16
+	// Configuration of the diagnostics server, which exposes pprof, a goroutine dump, and the ingestion lag.
+	Diagnostics profilingconfig.DiagnosticsConfig
 }
@@ -3,7 +3,6 @@ package lookoutingesterv2
 import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/app"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -20,6 +19,8 @@ import (
 	"github.com/armadaproject/armada/internal/lookoutingesterv2/model"
 )
 
+var log = logging.ForModule("lookoutingester")
+
 // Run will create a pipeline that will take Armada event messages from Pulsar and update the
 // Lookout database accordingly.  This pipeline will run until a SIGTERM is received
 func Run(config *configuration.LookoutIngesterV2Configuration) {
@@ -31,7 +32,7 @@ func Run(config *configuration.LookoutIngesterV2Configuration) {
 	}
 	lookoutDb := lookoutdb.NewLookoutDb(db, m, config.MaxAttempts, config.MaxBackoff)
 
-	compressor, err := compress.NewZlibCompressor(config.MinJobSpecCompressionSize)
+	compressor, err := newCompressor(config)
 	if err != nil {
 		panic(errors.WithMessage(err, "Error creating compressor"))
 	}
@@ -58,8 +59,28 @@ func Run(config *configuration.LookoutIngesterV2Configuration) {
 		config.Metrics,
 		m,
 	)
+	ctx := armadacontext.New(app.CreateContextWithShutdown(), logging.ForModule("lookoutingester"))
+
+	// Expose diagnostics if enabled.
+	diagnosticsServer := profiling.NewDiagnosticsServer(config.Diagnostics)
+	diagnosticsServer.SetConfig(config)
+	diagnosticsServer.AddState("ingestion", ingester.DiagnosticsState)
+	go func() {
+		if err := diagnosticsServer.Run(ctx); err != nil {
+			logging.WithStacktrace(ctx, err).Error("diagnostics server failure")
+		}
+	}()
 
-	if err := ingester.Run(app.CreateContextWithShutdown()); err != nil {
+	if err := ingester.Run(ctx); err != nil {
 		panic(errors.WithMessage(err, "Error running ingestion pipeline"))
 	}
 }
+
+// newCompressor returns the compressor for job specs and errors configured by config.
+func newCompressor(config *configuration.LookoutIngesterV2Configuration) (compress.Compressor, error) {
+	dictionary, err := compress.LoadDictionary(config.Compression.DictionaryPath)
+	if err != nil {
+		return nil, err
+	}
+	return compress.NewCompressor(config.Compression.Algorithm, config.MinJobSpecCompressionSize, dictionary)
+}
@@ -1,6 +1,7 @@
 package instructions
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -9,7 +10,6 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
 
@@ -19,12 +19,15 @@ import (
 	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/ingest"
 	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/lookoutingesterv2/model"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
+var log = logging.ForModule("lookoutingester")
+
 const (
 	maxQueueLen         = 512
 	maxOwnerLen         = 512
@@ -32,8 +35,12 @@ const (
 	maxPriorityClassLen = 63
 	maxClusterLen       = 512
 	maxNodeLen          = 512
+	maxPoolLen          = 512
 )
 
+// Events beyond this index within a sequence share an event sequence number; sequences are far shorter in practice.
+const maxEventSequenceIndex = 999
+
 type HasNodeName interface {
 	GetNodeName() string
 }
@@ -52,6 +59,14 @@ type jobResources struct {
 	Gpu              int64
 }
 
+// jobRunArtifact is how an artifact is stored in the artifacts column of the job_run table.
+type jobRunArtifact struct {
+	Name          string `json:"name"`
+	ContainerName string `json:"containerName"`
+	Url           string `json:"url"`
+	SizeBytes     int64  `json:"sizeBytes"`
+}
+
 func NewInstructionConverter(m *metrics.Metrics, userAnnotationPrefix string, compressor compress.Compressor, useLegacyEventConversion bool) *InstructionConverter {
 	return &InstructionConverter{
 		metrics:                  m,
@@ -92,6 +107,7 @@ func (c *InstructionConverter) convertSequence(
 			continue
 		}
 		ts := *event.Created
+		jobUpdatesBefore, jobRunUpdatesBefore := len(update.JobsToUpdate), len(update.JobRunsToUpdate)
 		switch event.GetEvent().(type) {
 		case *armadaevents.EventSequence_Event_SubmitJob:
 			err = c.handleSubmitJob(queue, owner, jobset, ts, event.GetSubmitJob(), update)
@@ -125,10 +141,22 @@ func (c *InstructionConverter) convertSequence(
 			if !c.useLegacyEventConversion {
 				err = c.handleJobRunLeased(ts, event.GetJobRunLeased(), update)
 			}
+		case *armadaevents.EventSequence_Event_JobUnschedulable:
+			err = c.handleJobUnschedulable(ts, event.GetJobUnschedulable(), update)
+		case *armadaevents.EventSequence_Event_JobRunArtifacts:
+			err = c.handleJobRunArtifacts(event.GetJobRunArtifacts(), update)
+		case *armadaevents.EventSequence_Event_ResourceUtilisation:
+			err = c.handleResourceUtilisation(event.GetResourceUtilisation(), update)
+			// Utilisation is reported concurrently with state transitions, so its event sequence says nothing about
+			// the order of the run's other events. The instruction is left unsequenced, so it never causes them to be discarded.
+			if err != nil {
+				c.metrics.RecordPulsarMessageError(metrics.PulsarMessageErrorProcessing)
+				log.WithError(err).Warnf("Could not convert event at index %d.", idx)
+			}
+			continue
 		case *armadaevents.EventSequence_Event_ReprioritiseJobSet:
 		case *armadaevents.EventSequence_Event_CancelJob:
 		case *armadaevents.EventSequence_Event_CancelJobSet:
-		case *armadaevents.EventSequence_Event_ResourceUtilisation:
 		case *armadaevents.EventSequence_Event_StandaloneIngressInfo:
 		case *armadaevents.EventSequence_Event_PartitionMarker:
 			log.Debugf("Ignoring event type %T", event.GetEvent())
@@ -139,9 +167,26 @@ func (c *InstructionConverter) convertSequence(
 			c.metrics.RecordPulsarMessageError(metrics.PulsarMessageErrorProcessing)
 			log.WithError(err).Warnf("Could not convert event at index %d.", idx)
 		}
+		seq := eventSequence(ts, idx)
+		for _, jobUpdate := range update.JobsToUpdate[jobUpdatesBefore:] {
+			jobUpdate.EventSequence = seq
+		}
+		for _, jobRunUpdate := range update.JobRunsToUpdate[jobRunUpdatesBefore:] {
+			jobRunUpdate.EventSequence = seq
+		}
 	}
 }
 
+// eventSequence orders the events of a job by their creation time, with ties between events of the same sequence broken
+// by their position in it. The result is stable across redeliveries of the same event, so it can be used to recognise
+// events that have already been applied.
+func eventSequence(ts time.Time, idx int) int64 {
+	if idx > maxEventSequenceIndex {
+		idx = maxEventSequenceIndex
+	}
+	return ts.UnixMicro()*(maxEventSequenceIndex+1) + int64(idx)
+}
+
 func (c *InstructionConverter) handleSubmitJob(
 	queue string,
 	owner string,
@@ -156,7 +201,7 @@ func (c *InstructionConverter) handleSubmitJob(
 		return err
 	}
 	if event.IsDuplicate {
-		log.Debugf("job %s is a duplicate, ignoring", jobId)
+		log.WithField(logging.JobIdField, jobId).Debug("job is a duplicate, ignoring")
 		return nil
 	}
 
@@ -233,7 +278,7 @@ func extractAnnotations(jobId string, queue string, jobset string, jobAnnotation
 				Jobset: jobset,
 			})
 		} else {
-			log.WithField("JobId", jobId).Warnf("Ignoring annotation with empty key")
+			log.WithField(logging.JobIdField, jobId).Warnf("Ignoring annotation with empty key")
 		}
 	}
 
@@ -371,6 +416,7 @@ func (c *InstructionConverter) handleJobRunRunning(ts time.Time, event *armadaev
 	jobRun := model.UpdateJobRunInstruction{
 		RunId:       runId,
 		Node:        node,
+		NodeLabels:  getNodeLabels(event.ResourceInfos),
 		Started:     &ts,
 		JobRunState: pointer.Int32(lookout.JobRunRunningOrdinal),
 	}
@@ -395,6 +441,32 @@ func (c *InstructionConverter) handleJobRequeued(ts time.Time, event *armadaeven
 	return nil
 }
 
+func (c *InstructionConverter) handleJobUnschedulable(ts time.Time, event *armadaevents.JobUnschedulable, update *model.InstructionSet) error {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(event.GetJobId())
+	if err != nil {
+		c.metrics.RecordPulsarMessageError(metrics.PulsarMessageErrorProcessing)
+		return err
+	}
+
+	numExcludedNodesByReason := event.GetNumExcludedNodesByReason()
+	if numExcludedNodesByReason == nil {
+		numExcludedNodesByReason = map[string]uint32{}
+	}
+	numExcludedNodesByReasonJson, err := json.Marshal(numExcludedNodesByReason)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	update.SchedulingReportsToUpsert = append(update.SchedulingReportsToUpsert, &model.UpsertJobSchedulingReportInstruction{
+		JobId:                    jobId,
+		Reason:                   event.GetReason(),
+		Pool:                     util.Truncate(event.GetPool(), maxPoolLen),
+		NumNodes:                 int32(event.GetNumNodes()),
+		NumExcludedNodesByReason: numExcludedNodesByReasonJson,
+		Reported:                 ts,
+	})
+	return nil
+}
+
 func (c *InstructionConverter) handleJobRunLeased(ts time.Time, event *armadaevents.JobRunLeased, update *model.InstructionSet) error {
 	jobId, err := armadaevents.UlidStringFromProtoUuid(event.GetJobId())
 	if err != nil {
@@ -520,6 +592,62 @@ func (c *InstructionConverter) handleJobRunSucceeded(ts time.Time, event *armada
 	return nil
 }
 
+func (c *InstructionConverter) handleJobRunArtifacts(event *armadaevents.JobRunArtifacts, update *model.InstructionSet) error {
+	runId, err := armadaevents.UuidStringFromProtoUuid(event.RunId)
+	if err != nil {
+		c.metrics.RecordPulsarMessageError(metrics.PulsarMessageErrorProcessing)
+		return errors.WithStack(err)
+	}
+
+	artifacts := make([]jobRunArtifact, len(event.Artifacts))
+	for i, artifact := range event.Artifacts {
+		artifacts[i] = jobRunArtifact{
+			Name:          artifact.Name,
+			ContainerName: artifact.ContainerName,
+			Url:           artifact.Url,
+			SizeBytes:     artifact.SizeBytes,
+		}
+	}
+	artifactsJson, err := json.Marshal(artifacts)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	jobRun := model.UpdateJobRunInstruction{
+		RunId:     runId,
+		Artifacts: artifactsJson,
+	}
+	update.JobRunsToUpdate = append(update.JobRunsToUpdate, &jobRun)
+	return nil
+}
+
+func (c *InstructionConverter) handleResourceUtilisation(event *armadaevents.ResourceUtilisation, update *model.InstructionSet) error {
+	runId, err := armadaevents.UuidStringFromProtoUuid(event.RunId)
+	if err != nil {
+		c.metrics.RecordPulsarMessageError(metrics.PulsarMessageErrorProcessing)
+		return errors.WithStack(err)
+	}
+
+	jobRun := model.UpdateJobRunInstruction{RunId: runId}
+	if cpu, ok := event.MaxResourcesForPeriod[v1.ResourceCPU.String()]; ok {
+		jobRun.CpuUsagePeak = pointer.Int64(cpu.MilliValue())
+	}
+	if memory, ok := event.MaxResourcesForPeriod[v1.ResourceMemory.String()]; ok {
+		jobRun.MemoryUsagePeak = pointer.Int64(memory.Value())
+	}
+	if cpu, ok := event.AverageResources[v1.ResourceCPU.String()]; ok {
+		jobRun.CpuUsageAverage = pointer.Int64(cpu.MilliValue())
+	}
+	if memory, ok := event.AverageResources[v1.ResourceMemory.String()]; ok {
+		jobRun.MemoryUsageAverage = pointer.Int64(memory.Value())
+	}
+	if jobRun.CpuUsagePeak == nil && jobRun.MemoryUsagePeak == nil && jobRun.CpuUsageAverage == nil && jobRun.MemoryUsageAverage == nil {
+		return nil
+	}
+	update.JobRunsToUpdate = append(update.JobRunsToUpdate, &jobRun)
+	return nil
+}
+
 func (c *InstructionConverter) handleJobRunErrors(ts time.Time, event *armadaevents.JobRunErrors, update *model.InstructionSet) error {
 	jobId, err := armadaevents.UlidStringFromProtoUuid(event.GetJobId())
 	if err != nil {
@@ -581,11 +709,23 @@ func (c *InstructionConverter) handleJobRunErrors(ts time.Time, event *armadaeve
 		case *armadaevents.Error_LeaseExpired:
 			jobRunUpdate.JobRunState = pointer.Int32(lookout.JobRunLeaseExpiredOrdinal)
 			jobRunUpdate.Error = tryCompressError(jobId, "Lease expired", c.compressor)
+		case *armadaevents.Error_ExecutorStale:
+			jobRunUpdate.Error = tryCompressError(
+				jobId,
+				fmt.Sprintf(
+					"Executor %s has not reported since %s",
+					reason.ExecutorStale.GetExecutorId(), reason.ExecutorStale.GetLastHeartbeat(),
+				),
+				c.compressor,
+			)
 		default:
 			jobRunUpdate.JobRunState = pointer.Int32(lookout.JobRunFailedOrdinal)
 			jobRunUpdate.Error = tryCompressError(jobId, "Unknown error", c.compressor)
 			log.Debugf("Ignoring event %T", reason)
 		}
+		if category := armadaevents.FailureCategoryOf(e); category != armadaevents.FailureCategory_UnknownFailureCategory {
+			jobRunUpdate.FailureCategory = pointer.String(category.String())
+		}
 		update.JobRunsToUpdate = append(update.JobRunsToUpdate, jobRunUpdate)
 		break
 	}
@@ -617,24 +757,56 @@ func (c *InstructionConverter) handleJobRunPreempted(ts time.Time, event *armada
 	update.JobsToUpdate = append(update.JobsToUpdate, &job)
 
 	// Update job run
-	errorString := "preempted by non armada pod"
-	preemptiveJobId, err := parseUlidString(event.PreemptiveJobId)
-	if err != nil {
-		log.WithError(err).Debug("failed to convert preemptive job id")
-	} else {
-		errorString = fmt.Sprintf("preempted by job %s", preemptiveJobId)
-	}
+	errorString := preemptionReasonString(event)
 
 	jobRun := model.UpdateJobRunInstruction{
-		RunId:       runId,
-		JobRunState: pointer.Int32(lookout.JobRunPreemptedOrdinal),
-		Finished:    &ts,
-		Error:       tryCompressError(jobId, errorString, c.compressor),
+		RunId:            runId,
+		JobRunState:      pointer.Int32(lookout.JobRunPreemptedOrdinal),
+		Finished:         &ts,
+		Error:            tryCompressError(jobId, errorString, c.compressor),
+		PreemptionReason: &errorString,
 	}
 	update.JobRunsToUpdate = append(update.JobRunsToUpdate, &jobRun)
 	return nil
 }
 
+// preemptionReasonString returns a human-readable description of why a job run was preempted.
+func preemptionReasonString(event *armadaevents.JobRunPreempted) string {
+	preemptiveJobId, err := parseUlidString(event.PreemptiveJobId)
+	if err != nil {
+		log.WithError(err).Debug("failed to convert preemptive job id")
+	}
+	var reason string
+	switch event.Reason {
+	case armadaevents.PreemptionReason_NodeDrain:
+		reason = "preempted to drain node"
+		if event.NodeName != "" {
+			return fmt.Sprintf("%s %s", reason, event.NodeName)
+		}
+		return reason
+	case armadaevents.PreemptionReason_FairShare:
+		reason = "preempted to balance fair share"
+	case armadaevents.PreemptionReason_Urgency:
+		reason = "preempted by a higher priority job"
+	default:
+		// Preempted by a scheduler not reporting preemption reasons.
+		if preemptiveJobId == "" {
+			return "preempted by non armada pod"
+		}
+		return fmt.Sprintf("preempted by job %s", preemptiveJobId)
+	}
+	if preemptiveJobId != "" {
+		reason = fmt.Sprintf("%s; preempting job %s", reason, preemptiveJobId)
+		if event.PreemptiveQueue != "" {
+			reason = fmt.Sprintf("%s of queue %s", reason, event.PreemptiveQueue)
+		}
+	}
+	if event.NodeName != "" {
+		reason = fmt.Sprintf("%s; node %s", reason, event.NodeName)
+	}
+	return reason
+}
+
 func parseUlidString(id *armadaevents.Uuid) (string, error) {
 	if id == nil {
 		return "", errors.New("uuid is nil")
@@ -682,6 +854,23 @@ func getNode(resources []*armadaevents.KubernetesResourceInfo) *string {
 	return pointer.String("UNKNOWN")
 }
 
+// getNodeLabels returns the JSON-encoded labels of the node the job is running on, or nil if the executor didn't report any.
+func getNodeLabels(resources []*armadaevents.KubernetesResourceInfo) []byte {
+	for _, r := range resources {
+		nodeLabels := r.GetPodInfo().GetNodeLabels()
+		if len(nodeLabels) == 0 {
+			continue
+		}
+		nodeLabelsJson, err := json.Marshal(nodeLabels)
+		if err != nil {
+			log.WithError(err).Warn("failed to marshal node labels")
+			return nil
+		}
+		return nodeLabelsJson
+	}
+	return nil
+}
+
 func createFakeJobRun(jobId string, ts time.Time) *model.CreateJobRunInstruction {
 	runId := uuid.New().String()
 	return &model.CreateJobRunInstruction{
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/pointer"
@@ -111,6 +113,15 @@ var expectedJobRequeued = model.UpdateJobInstruction{
 	LastTransitionTimeSeconds: pointer.Int64(testfixtures.BaseTime.Unix()),
 }
 
+var expectedSchedulingReport = model.UpsertJobSchedulingReportInstruction{
+	JobId:                    testfixtures.JobIdString,
+	Reason:                   "job does not fit on any node",
+	Pool:                     "cpu",
+	NumNodes:                 10,
+	NumExcludedNodesByReason: []byte(`{"insufficient cpu":8,"node is unschedulable":2}`),
+	Reported:                 testfixtures.BaseTime,
+}
+
 var expectedJobCancelled = model.UpdateJobInstruction{
 	JobId:                     testfixtures.JobIdString,
 	State:                     pointer.Int32(lookout.JobCancelledOrdinal),
@@ -132,12 +143,13 @@ var expectedFailed = model.UpdateJobInstruction{
 }
 
 var expectedFailedRun = model.UpdateJobRunInstruction{
-	RunId:       testfixtures.RunIdString,
-	Node:        pointer.String(testfixtures.NodeName),
-	Finished:    &testfixtures.BaseTime,
-	JobRunState: pointer.Int32(lookout.JobRunFailedOrdinal),
-	Error:       []byte(testfixtures.ErrMsg),
-	ExitCode:    pointer.Int32(testfixtures.ExitCode),
+	RunId:           testfixtures.RunIdString,
+	Node:            pointer.String(testfixtures.NodeName),
+	Finished:        &testfixtures.BaseTime,
+	JobRunState:     pointer.Int32(lookout.JobRunFailedOrdinal),
+	Error:           []byte(testfixtures.ErrMsg),
+	ExitCode:        pointer.Int32(testfixtures.ExitCode),
+	FailureCategory: pointer.String(armadaevents.FailureCategory_UserError.String()),
 }
 
 var expectedUnschedulable = model.UpdateJobRunInstruction{
@@ -145,6 +157,12 @@ var expectedUnschedulable = model.UpdateJobRunInstruction{
 	Node:  pointer.String(testfixtures.NodeName),
 }
 
+var expectedExecutorStaleRun = model.UpdateJobRunInstruction{
+	RunId:           testfixtures.RunIdString,
+	Error:           []byte(fmt.Sprintf("Executor %s has not reported since %s", testfixtures.ExecutorId, &testfixtures.BaseTime)),
+	FailureCategory: pointer.String(armadaevents.FailureCategory_NodeLost.String()),
+}
+
 var expectedPreempted = model.UpdateJobInstruction{
 	JobId:                     testfixtures.JobIdString,
 	State:                     pointer.Int32(lookout.JobPreemptedOrdinal),
@@ -154,10 +172,11 @@ var expectedPreempted = model.UpdateJobInstruction{
 }
 
 var expectedPreemptedRun = model.UpdateJobRunInstruction{
-	RunId:       testfixtures.RunIdString,
-	Finished:    &testfixtures.BaseTime,
-	JobRunState: pointer.Int32(lookout.JobRunPreemptedOrdinal),
-	Error:       []byte("preempted by non armada pod"),
+	RunId:            testfixtures.RunIdString,
+	Finished:         &testfixtures.BaseTime,
+	JobRunState:      pointer.Int32(lookout.JobRunPreemptedOrdinal),
+	Error:            []byte("preempted by non armada pod"),
+	PreemptionReason: pointer.String("preempted by non armada pod"),
 }
 
 func TestConvert(t *testing.T) {
@@ -215,6 +234,17 @@ func TestConvert(t *testing.T) {
 	preemptedWithPrempteeWithZeroId.GetJobRunPreempted().PreemptiveJobId = &armadaevents.Uuid{}
 	preemptedWithPrempteeWithZeroId.GetJobRunPreempted().PreemptiveRunId = &armadaevents.Uuid{}
 
+	preemptedForFairShare, err := testfixtures.DeepCopy(preempted)
+	assert.NoError(t, err)
+	preemptedForFairShare.GetJobRunPreempted().Reason = armadaevents.PreemptionReason_FairShare
+	preemptedForFairShare.GetJobRunPreempted().PreemptiveQueue = "other-queue"
+	preemptedForFairShare.GetJobRunPreempted().NodeName = testfixtures.NodeName
+
+	preemptedForNodeDrain, err := testfixtures.DeepCopy(testfixtures.JobPreempted)
+	assert.NoError(t, err)
+	preemptedForNodeDrain.GetJobRunPreempted().Reason = armadaevents.PreemptionReason_NodeDrain
+	preemptedForNodeDrain.GetJobRunPreempted().NodeName = testfixtures.NodeName
+
 	cancelledWithReason, err := testfixtures.DeepCopy(testfixtures.JobCancelled)
 	assert.NoError(t, err)
 	cancelledWithReason.GetCancelledJob().Reason = "some reason"
@@ -356,6 +386,17 @@ func TestConvert(t *testing.T) {
 			},
 			useLegacyEventConversion: false,
 		},
+		"scheduling report": {
+			events: &ingest.EventSequencesWithIds{
+				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobUnschedulable)},
+				MessageIds:     []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				SchedulingReportsToUpsert: []*model.UpsertJobSchedulingReportInstruction{&expectedSchedulingReport},
+				MessageIds:                []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			useLegacyEventConversion: false,
+		},
 		"cancelled": {
 			events: &ingest.EventSequencesWithIds{
 				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobCancelled)},
@@ -439,6 +480,17 @@ func TestConvert(t *testing.T) {
 			},
 			useLegacyEventConversion: true,
 		},
+		"executor stale": {
+			events: &ingest.EventSequencesWithIds{
+				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobRunExecutorStale)},
+				MessageIds:     []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobRunsToUpdate: []*model.UpdateJobRunInstruction{&expectedExecutorStaleRun},
+				MessageIds:      []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			useLegacyEventConversion: true,
+		},
 		"duplicate submit is ignored": {
 			events: &ingest.EventSequencesWithIds{
 				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.SubmitDuplicate)},
@@ -449,6 +501,35 @@ func TestConvert(t *testing.T) {
 			},
 			useLegacyEventConversion: true,
 		},
+		"artifacts": {
+			events: &ingest.EventSequencesWithIds{
+				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobRunArtifacts)},
+				MessageIds:     []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
+					RunId:     testfixtures.RunIdString,
+					Artifacts: []byte(`[{"name":"log","containerName":"main","url":"https://bucket/main.log","sizeBytes":1024}]`),
+				}},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+		},
+		"resource utilisation": {
+			events: &ingest.EventSequencesWithIds{
+				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.ResourceUtilisation)},
+				MessageIds:     []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
+					RunId:              testfixtures.RunIdString,
+					CpuUsagePeak:       pointer.Int64(1500),
+					CpuUsageAverage:    pointer.Int64(500),
+					MemoryUsagePeak:    pointer.Int64(2 * 1024 * 1024 * 1024),
+					MemoryUsageAverage: pointer.Int64(1024 * 1024 * 1024),
+				}},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+		},
 		"preempted": {
 			events: &ingest.EventSequencesWithIds{
 				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobPreempted)},
@@ -469,10 +550,11 @@ func TestConvert(t *testing.T) {
 			expected: &model.InstructionSet{
 				JobsToUpdate: []*model.UpdateJobInstruction{&expectedPreempted},
 				JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
-					RunId:       testfixtures.RunIdString,
-					Finished:    &testfixtures.BaseTime,
-					JobRunState: pointer.Int32(lookout.JobRunPreemptedOrdinal),
-					Error:       []byte(fmt.Sprintf("preempted by job %s", otherJobId)),
+					RunId:            testfixtures.RunIdString,
+					Finished:         &testfixtures.BaseTime,
+					JobRunState:      pointer.Int32(lookout.JobRunPreemptedOrdinal),
+					Error:            []byte(fmt.Sprintf("preempted by job %s", otherJobId)),
+					PreemptionReason: pointer.String(fmt.Sprintf("preempted by job %s", otherJobId)),
 				}},
 				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
 			},
@@ -483,13 +565,87 @@ func TestConvert(t *testing.T) {
 				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(preemptedWithPrempteeWithZeroId)},
 				MessageIds:     []pulsar.MessageID{pulsarutils.NewMessageId(1)},
 			},
+			expected: &model.InstructionSet{
+				JobsToUpdate:    []*model.UpdateJobInstruction{&expectedPreempted},
+				JobRunsToUpdate: []*model.UpdateJobRunInstruction{&expectedPreemptedRun},
+				MessageIds:      []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			useLegacyEventConversion: true,
+		},
+		"preempted for fair share": {
+			events: &ingest.EventSequencesWithIds{
+				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(preemptedForFairShare)},
+				MessageIds:     []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
 			expected: &model.InstructionSet{
 				JobsToUpdate: []*model.UpdateJobInstruction{&expectedPreempted},
 				JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
 					RunId:       testfixtures.RunIdString,
 					Finished:    &testfixtures.BaseTime,
 					JobRunState: pointer.Int32(lookout.JobRunPreemptedOrdinal),
-					Error:       []byte("preempted by non armada pod"),
+					Error: []byte(fmt.Sprintf(
+						"preempted to balance fair share; preempting job %s of queue other-queue; node %s", otherJobId, testfixtures.NodeName,
+					)),
+					PreemptionReason: pointer.String(fmt.Sprintf(
+						"preempted to balance fair share; preempting job %s of queue other-queue; node %s", otherJobId, testfixtures.NodeName,
+					)),
+				}},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			useLegacyEventConversion: true,
+		},
+		"preempted for node drain": {
+			events: &ingest.EventSequencesWithIds{
+				EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(preemptedForNodeDrain)},
+				MessageIds:     []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobsToUpdate: []*model.UpdateJobInstruction{&expectedPreempted},
+				JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
+					RunId:            testfixtures.RunIdString,
+					Finished:         &testfixtures.BaseTime,
+					JobRunState:      pointer.Int32(lookout.JobRunPreemptedOrdinal),
+					Error:            []byte(fmt.Sprintf("preempted to drain node %s", testfixtures.NodeName)),
+					PreemptionReason: pointer.String(fmt.Sprintf("preempted to drain node %s", testfixtures.NodeName)),
+				}},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			useLegacyEventConversion: true,
+		},
+		"running with node labels": {
+			events: &ingest.EventSequencesWithIds{
+				EventSequences: []*armadaevents.EventSequence{
+					testfixtures.NewEventSequence(&armadaevents.EventSequence_Event{
+						Created: &testfixtures.BaseTime,
+						Event: &armadaevents.EventSequence_Event_JobRunRunning{
+							JobRunRunning: &armadaevents.JobRunRunning{
+								RunId: testfixtures.RunIdProto,
+								JobId: testfixtures.JobIdProto,
+								ResourceInfos: []*armadaevents.KubernetesResourceInfo{
+									{
+										Info: &armadaevents.KubernetesResourceInfo_PodInfo{
+											PodInfo: &armadaevents.PodInfo{
+												NodeName:   testfixtures.NodeName,
+												PodNumber:  testfixtures.PodNumber,
+												NodeLabels: map[string]string{"nvidia.com/gpu.product": "A100"},
+											},
+										},
+									},
+								},
+							},
+						},
+					}),
+				},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobsToUpdate: []*model.UpdateJobInstruction{&expectedRunning},
+				JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
+					RunId:       testfixtures.RunIdString,
+					Node:        pointer.String(testfixtures.NodeName),
+					NodeLabels:  []byte(`{"nvidia.com/gpu.product":"A100"}`),
+					Started:     &testfixtures.BaseTime,
+					JobRunState: pointer.Int32(lookout.JobRunRunningOrdinal),
 				}},
 				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
 			},
@@ -562,6 +718,7 @@ func TestConvert(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			converter := NewInstructionConverter(metrics.Get(), userAnnotationPrefix, &compress.NoOpCompressor{}, tc.useLegacyEventConversion)
 			instructionSet := converter.Convert(armadacontext.TODO(), tc.events)
+			clearEventSequences(instructionSet)
 			assert.Equal(t, tc.expected.JobsToCreate, instructionSet.JobsToCreate)
 			assert.Equal(t, tc.expected.JobsToUpdate, instructionSet.JobsToUpdate)
 			assert.Equal(t, tc.expected.JobRunsToCreate, instructionSet.JobRunsToCreate)
@@ -576,6 +733,7 @@ func TestFailedWithMissingRunId(t *testing.T) {
 		EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobLeaseReturned)},
 		MessageIds:     []pulsar.MessageID{pulsarutils.NewMessageId(1)},
 	})
+	clearEventSequences(instructions)
 	jobRun := instructions.JobRunsToCreate[0]
 	assert.NotEqual(t, eventutil.LEGACY_RUN_ID, jobRun.RunId)
 	expected := &model.InstructionSet{
@@ -602,6 +760,46 @@ func TestFailedWithMissingRunId(t *testing.T) {
 	assert.Equal(t, expected.JobRunsToUpdate, instructions.JobRunsToUpdate)
 }
 
+func TestEventSequence(t *testing.T) {
+	submit, err := testfixtures.DeepCopy(testfixtures.Submit)
+	assert.NoError(t, err)
+	running, err := testfixtures.DeepCopy(testfixtures.Running)
+	assert.NoError(t, err)
+	later := testfixtures.BaseTime.Add(time.Second)
+	running.Created = &later
+	runSucceeded, err := testfixtures.DeepCopy(testfixtures.JobRunSucceeded)
+	assert.NoError(t, err)
+	runSucceeded.Created = &later
+
+	converter := NewInstructionConverter(metrics.Get(), userAnnotationPrefix, &compress.NoOpCompressor{}, false)
+	instructions := converter.Convert(armadacontext.TODO(), &ingest.EventSequencesWithIds{
+		EventSequences: []*armadaevents.EventSequence{
+			testfixtures.NewEventSequence(submit, testfixtures.Leased),
+			testfixtures.NewEventSequence(running, runSucceeded),
+		},
+		MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1), pulsarutils.NewMessageId(2)},
+	})
+
+	baseSequence := testfixtures.BaseTime.UnixMicro() * 1000
+	laterSequence := later.UnixMicro() * 1000
+
+	// Leased, then running
+	require.Len(t, instructions.JobsToUpdate, 2)
+	assert.Equal(t, baseSequence+1, instructions.JobsToUpdate[0].EventSequence)
+	assert.Equal(t, laterSequence, instructions.JobsToUpdate[1].EventSequence)
+
+	// Running, then run succeeded
+	require.Len(t, instructions.JobRunsToUpdate, 2)
+	assert.Equal(t, laterSequence, instructions.JobRunsToUpdate[0].EventSequence)
+	assert.Equal(t, laterSequence+1, instructions.JobRunsToUpdate[1].EventSequence)
+
+	// Converting the same events again yields the same sequences
+	replayed := converter.Convert(armadacontext.TODO(), &ingest.EventSequencesWithIds{
+		EventSequences: []*armadaevents.EventSequence{testfixtures.NewEventSequence(running, runSucceeded)},
+	})
+	assert.Equal(t, instructions.JobRunsToUpdate, replayed.JobRunsToUpdate)
+}
+
 func TestTruncatesStringsThatAreTooLong(t *testing.T) {
 	longString := strings.Repeat("x", 4000)
 
@@ -671,3 +869,14 @@ func TestExtractNodeName(t *testing.T) {
 	podError.NodeName = testfixtures.NodeName
 	assert.Equal(t, pointer.String(testfixtures.NodeName), extractNodeName(&podError))
 }
+
+// clearEventSequences zeroes the event sequences of all update instructions, so that expectations don't depend on
+// them; they are checked by TestEventSequence.
+func clearEventSequences(instructions *model.InstructionSet) {
+	for _, instruction := range instructions.JobsToUpdate {
+		instruction.EventSequence = 0
+	}
+	for _, instruction := range instructions.JobRunsToUpdate {
+		instruction.EventSequence = 0
+	}
+}
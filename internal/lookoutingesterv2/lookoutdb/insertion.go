@@ -8,16 +8,18 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/database"
 	"github.com/armadaproject/armada/internal/common/database/lookout"
 	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/lookoutingesterv2/model"
 )
 
+var log = logging.ForModule("lookoutingester")
+
 type LookoutDb struct {
 	db          *pgxpool.Pool
 	metrics     *metrics.Metrics
@@ -32,22 +34,26 @@ func NewLookoutDb(db *pgxpool.Pool, metrics *metrics.Metrics, maxAttempts int, m
 // Store updates the lookout database according to the supplied InstructionSet.
 // The updates are applied in the following order:
 // * New Job Creations
-// * Job Updates, New Job Creations, New User Annotations
+// * Job Updates, New Job Creations, New User Annotations, Scheduling Reports
 // * Job Run Updates
 // In each case we first try to bach insert the rows using the postgres copy protocol.  If this fails then we try a
 // slower, serial insert and discard any rows that cannot be inserted.
 func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.InstructionSet) error {
+	// Updates from events that are older than those already applied come from a replay of the event topic (or a
+	// redelivery) and must be dropped before conflation so they can't overwrite newer state
+	jobsToUpdate, jobRunsToUpdate := l.filterReplayedUpdates(ctx, instructions.JobsToUpdate, instructions.JobRunsToUpdate)
+
 	// We might have multiple updates for the same job or job run
 	// These can be conflated to help performance
-	jobsToUpdate := conflateJobUpdates(instructions.JobsToUpdate)
-	jobRunsToUpdate := conflateJobRunUpdates(instructions.JobRunsToUpdate)
+	jobsToUpdate = conflateJobUpdates(jobsToUpdate)
+	jobRunsToUpdate = conflateJobRunUpdates(jobRunsToUpdate)
 
 	// Jobs need to be ingested first as other updates may reference these
 	l.CreateJobs(ctx, instructions.JobsToCreate)
 
 	// Now we can job updates, annotations and new job runs
 	wg := sync.WaitGroup{}
-	wg.Add(3)
+	wg.Add(4)
 	go func() {
 		defer wg.Done()
 		l.UpdateJobs(ctx, jobsToUpdate)
@@ -60,6 +66,10 @@ func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.Instru
 		defer wg.Done()
 		l.CreateUserAnnotations(ctx, instructions.UserAnnotationsToCreate)
 	}()
+	go func() {
+		defer wg.Done()
+		l.UpsertSchedulingReports(ctx, conflateSchedulingReports(instructions.SchedulingReportsToUpsert))
+	}()
 
 	wg.Wait()
 
@@ -74,7 +84,7 @@ func (l *LookoutDb) CreateJobs(ctx *armadacontext.Context, instructions []*model
 	}
 	err := l.CreateJobsBatch(ctx, instructions)
 	if err != nil {
-		log.WithError(err).Warn("Creating jobs via batch failed, will attempt to insert serially (this might be slow).")
+		ctx.WithError(err).Warn("Creating jobs via batch failed, will attempt to insert serially (this might be slow).")
 		l.CreateJobsScalar(ctx, instructions)
 	}
 }
@@ -86,7 +96,7 @@ func (l *LookoutDb) UpdateJobs(ctx *armadacontext.Context, instructions []*model
 	instructions = l.filterEventsForTerminalJobs(ctx, l.db, instructions, l.metrics)
 	err := l.UpdateJobsBatch(ctx, instructions)
 	if err != nil {
-		log.WithError(err).Warn("Updating jobs via batch failed, will attempt to insert serially (this might be slow).")
+		ctx.WithError(err).Warn("Updating jobs via batch failed, will attempt to insert serially (this might be slow).")
 		l.UpdateJobsScalar(ctx, instructions)
 	}
 }
@@ -97,7 +107,7 @@ func (l *LookoutDb) CreateJobRuns(ctx *armadacontext.Context, instructions []*mo
 	}
 	err := l.CreateJobRunsBatch(ctx, instructions)
 	if err != nil {
-		log.WithError(err).Warn("Creating job runs via batch failed, will attempt to insert serially (this might be slow).")
+		ctx.WithError(err).Warn("Creating job runs via batch failed, will attempt to insert serially (this might be slow).")
 		l.CreateJobRunsScalar(ctx, instructions)
 	}
 }
@@ -108,7 +118,7 @@ func (l *LookoutDb) UpdateJobRuns(ctx *armadacontext.Context, instructions []*mo
 	}
 	err := l.UpdateJobRunsBatch(ctx, instructions)
 	if err != nil {
-		log.WithError(err).Warn("Updating job runs via batch failed, will attempt to insert serially (this might be slow).")
+		ctx.WithError(err).Warn("Updating job runs via batch failed, will attempt to insert serially (this might be slow).")
 		l.UpdateJobRunsScalar(ctx, instructions)
 	}
 }
@@ -119,11 +129,22 @@ func (l *LookoutDb) CreateUserAnnotations(ctx *armadacontext.Context, instructio
 	}
 	err := l.CreateUserAnnotationsBatch(ctx, instructions)
 	if err != nil {
-		log.WithError(err).Warn("Creating user annotations via batch failed, will attempt to insert serially (this might be slow).")
+		ctx.WithError(err).Warn("Creating user annotations via batch failed, will attempt to insert serially (this might be slow).")
 		l.CreateUserAnnotationsScalar(ctx, instructions)
 	}
 }
 
+func (l *LookoutDb) UpsertSchedulingReports(ctx *armadacontext.Context, instructions []*model.UpsertJobSchedulingReportInstruction) {
+	if len(instructions) == 0 {
+		return
+	}
+	err := l.UpsertSchedulingReportsBatch(ctx, instructions)
+	if err != nil {
+		ctx.WithError(err).Warn("Upserting scheduling reports via batch failed, will attempt to insert serially (this might be slow).")
+		l.UpsertSchedulingReportsScalar(ctx, instructions)
+	}
+}
+
 func (l *LookoutDb) CreateJobsBatch(ctx *armadacontext.Context, instructions []*model.CreateJobInstruction) error {
 	return l.withDatabaseRetryInsert(func() error {
 		tmpTable := database.UniqueTableName("job")
@@ -280,7 +301,7 @@ func (l *LookoutDb) CreateJobsScalar(ctx *armadacontext.Context, instructions []
 			return err
 		})
 		if err != nil {
-			log.WithError(err).Warnf("Create job for job %s, jobset %s failed", i.JobId, i.JobSet)
+			ctx.WithError(err).Warnf("Create job for job %s, jobset %s failed", i.JobId, i.JobSet)
 		}
 	}
 }
@@ -300,7 +321,8 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 					last_transition_time_seconds bigint,
 					duplicate                    bool,
 					latest_run_id                varchar(36),
-					cancel_reason                varchar(512)
+					cancel_reason                varchar(512),
+					event_sequence               bigint
 				) ON COMMIT DROP;`, tmpTable))
 			if err != nil {
 				l.metrics.RecordDBError(metrics.DBOperationCreateTempTable)
@@ -321,6 +343,7 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 					"duplicate",
 					"latest_run_id",
 					"cancel_reason",
+					"event_sequence",
 				},
 				pgx.CopyFromSlice(len(instructions), func(i int) ([]interface{}, error) {
 					return []interface{}{
@@ -333,6 +356,7 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 						instructions[i].Duplicate,
 						instructions[i].LatestRunId,
 						instructions[i].CancelReason,
+						nullableEventSequence(instructions[i].EventSequence),
 					}, nil
 				}),
 			)
@@ -342,7 +366,8 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 		copyToDest := func(tx pgx.Tx) error {
 			_, err := tx.Exec(
 				ctx,
-				fmt.Sprintf(`UPDATE job
+				fmt.Sprintf(`WITH updated AS (
+					UPDATE job
 					SET
 						priority                     = coalesce(tmp.priority, job.priority),
 						state                        = coalesce(tmp.state, job.state),
@@ -352,7 +377,15 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 						duplicate                    = coalesce(tmp.duplicate, job.duplicate),
 						latest_run_id                = coalesce(tmp.latest_run_id, job.latest_run_id),
 						cancel_reason                = coalesce(tmp.cancel_reason, job.cancel_reason)
-					FROM %s as tmp WHERE tmp.job_id = job.job_id`, tmpTable),
+					FROM %s as tmp
+					LEFT JOIN event_watermark w ON w.job_id = tmp.job_id AND w.run_id = ''
+					WHERE tmp.job_id = job.job_id
+					AND (tmp.event_sequence IS NULL OR w.event_sequence IS NULL OR tmp.event_sequence >= w.event_sequence)
+					RETURNING job.job_id, tmp.event_sequence
+				)
+				INSERT INTO event_watermark (job_id, run_id, event_sequence)
+				SELECT job_id, '', max(event_sequence) FROM updated WHERE event_sequence IS NOT NULL GROUP BY job_id
+				ON CONFLICT (job_id, run_id) DO UPDATE SET event_sequence = greatest(event_watermark.event_sequence, excluded.event_sequence)`, tmpTable),
 			)
 			if err != nil {
 				l.metrics.RecordDBError(metrics.DBOperationUpdate)
@@ -365,17 +398,26 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 }
 
 func (l *LookoutDb) UpdateJobsScalar(ctx *armadacontext.Context, instructions []*model.UpdateJobInstruction) {
-	sqlStatement := `UPDATE job
-		SET
-			priority                     = coalesce($2, priority),
-			state                        = coalesce($3, state),
-			cancelled                    = coalesce($4, cancelled),
-			last_transition_time         = coalesce($5, job.last_transition_time),
-			last_transition_time_seconds = coalesce($6, job.last_transition_time_seconds),
-			duplicate                    = coalesce($7, duplicate),
-			latest_run_id                = coalesce($8, job.latest_run_id),
-			cancel_reason                = coalesce($9, job.cancel_reason)
-		WHERE job_id = $1`
+	sqlStatement := `WITH updated AS (
+			UPDATE job
+			SET
+				priority                     = coalesce($2, priority),
+				state                        = coalesce($3, state),
+				cancelled                    = coalesce($4, cancelled),
+				last_transition_time         = coalesce($5, job.last_transition_time),
+				last_transition_time_seconds = coalesce($6, job.last_transition_time_seconds),
+				duplicate                    = coalesce($7, duplicate),
+				latest_run_id                = coalesce($8, job.latest_run_id),
+				cancel_reason                = coalesce($9, job.cancel_reason)
+			WHERE job_id = $1
+			AND ($10::bigint IS NULL OR NOT EXISTS (
+				SELECT 1 FROM event_watermark w WHERE w.job_id = $1 AND w.run_id = '' AND w.event_sequence > $10
+			))
+			RETURNING job_id
+		)
+		INSERT INTO event_watermark (job_id, run_id, event_sequence)
+		SELECT job_id, '', $10 FROM updated WHERE $10::bigint IS NOT NULL
+		ON CONFLICT (job_id, run_id) DO UPDATE SET event_sequence = greatest(event_watermark.event_sequence, excluded.event_sequence)`
 	for _, i := range instructions {
 		err := l.withDatabaseRetryInsert(func() error {
 			_, err := l.db.Exec(ctx, sqlStatement,
@@ -387,14 +429,15 @@ func (l *LookoutDb) UpdateJobsScalar(ctx *armadacontext.Context, instructions []
 				i.LastTransitionTimeSeconds,
 				i.Duplicate,
 				i.LatestRunId,
-				i.CancelReason)
+				i.CancelReason,
+				nullableEventSequence(i.EventSequence))
 			if err != nil {
 				l.metrics.RecordDBError(metrics.DBOperationUpdate)
 			}
 			return err
 		})
 		if err != nil {
-			log.WithError(err).Warnf("Updating job %s failed", i.JobId)
+			ctx.WithError(err).Warnf("Updating job %s failed", i.JobId)
 		}
 	}
 }
@@ -497,7 +540,7 @@ func (l *LookoutDb) CreateJobRunsScalar(ctx *armadacontext.Context, instructions
 			return err
 		})
 		if err != nil {
-			log.WithError(err).Warnf("Create job run for job %s, run %s failed", i.JobId, i.RunId)
+			ctx.WithError(err).Warnf("Create job run for job %s, run %s failed", i.JobId, i.RunId)
 		}
 	}
 }
@@ -511,12 +554,21 @@ func (l *LookoutDb) UpdateJobRunsBatch(ctx *armadacontext.Context, instructions
 				CREATE TEMPORARY TABLE %s (
 					run_id        varchar(36),
 					node          varchar(512),
+					node_labels   jsonb,
 				    pending       timestamp,
 					started       timestamp,
 					finished      timestamp,
 				    job_run_state smallint,
 					error         bytea,
-				    exit_code     int
+				    exit_code     int,
+					preemption_reason varchar(512),
+					failure_category varchar(63),
+					artifacts     jsonb,
+					cpu_usage_peak bigint,
+					cpu_usage_average bigint,
+					memory_usage_peak bigint,
+					memory_usage_average bigint,
+					event_sequence bigint
 				) ON COMMIT DROP;`, tmpTable))
 			if err != nil {
 				l.metrics.RecordDBError(metrics.DBOperationCreateTempTable)
@@ -530,23 +582,41 @@ func (l *LookoutDb) UpdateJobRunsBatch(ctx *armadacontext.Context, instructions
 				[]string{
 					"run_id",
 					"node",
+					"node_labels",
 					"pending",
 					"started",
 					"finished",
 					"job_run_state",
 					"error",
 					"exit_code",
+					"preemption_reason",
+					"failure_category",
+					"artifacts",
+					"cpu_usage_peak",
+					"cpu_usage_average",
+					"memory_usage_peak",
+					"memory_usage_average",
+					"event_sequence",
 				},
 				pgx.CopyFromSlice(len(instructions), func(i int) ([]interface{}, error) {
 					return []interface{}{
 						instructions[i].RunId,
 						instructions[i].Node,
+						instructions[i].NodeLabels,
 						instructions[i].Pending,
 						instructions[i].Started,
 						instructions[i].Finished,
 						instructions[i].JobRunState,
 						instructions[i].Error,
 						instructions[i].ExitCode,
+						instructions[i].PreemptionReason,
+						instructions[i].FailureCategory,
+						instructions[i].Artifacts,
+						instructions[i].CpuUsagePeak,
+						instructions[i].CpuUsageAverage,
+						instructions[i].MemoryUsagePeak,
+						instructions[i].MemoryUsageAverage,
+						nullableEventSequence(instructions[i].EventSequence),
 					}, nil
 				}),
 			)
@@ -556,16 +626,33 @@ func (l *LookoutDb) UpdateJobRunsBatch(ctx *armadacontext.Context, instructions
 		copyToDest := func(tx pgx.Tx) error {
 			_, err := tx.Exec(
 				ctx,
-				fmt.Sprintf(`UPDATE job_run
+				fmt.Sprintf(`WITH updated AS (
+					UPDATE job_run
 					SET
 						node          = coalesce(tmp.node, job_run.node),
+						node_labels   = coalesce(tmp.node_labels, job_run.node_labels),
 						pending       = coalesce(tmp.pending, job_run.pending),
 						started       = coalesce(tmp.started, job_run.started),
 						finished      = coalesce(tmp.finished, job_run.finished),
 						job_run_state = coalesce(tmp.job_run_state, job_run.job_run_state),
 						error         = coalesce(tmp.error, job_run.error),
-						exit_code     = coalesce(tmp.exit_code, job_run.exit_code)
-					FROM %s as tmp where tmp.run_id = job_run.run_id`, tmpTable),
+						exit_code     = coalesce(tmp.exit_code, job_run.exit_code),
+						preemption_reason = coalesce(tmp.preemption_reason, job_run.preemption_reason),
+						failure_category = coalesce(tmp.failure_category, job_run.failure_category),
+						artifacts     = coalesce(tmp.artifacts, job_run.artifacts),
+						cpu_usage_peak = greatest(tmp.cpu_usage_peak, job_run.cpu_usage_peak),
+						cpu_usage_average = coalesce(tmp.cpu_usage_average, job_run.cpu_usage_average),
+						memory_usage_peak = greatest(tmp.memory_usage_peak, job_run.memory_usage_peak),
+						memory_usage_average = coalesce(tmp.memory_usage_average, job_run.memory_usage_average)
+					FROM %s as tmp
+					LEFT JOIN event_watermark w ON w.run_id = tmp.run_id
+					WHERE tmp.run_id = job_run.run_id
+					AND (tmp.event_sequence IS NULL OR w.event_sequence IS NULL OR tmp.event_sequence >= w.event_sequence)
+					RETURNING job_run.job_id, job_run.run_id, tmp.event_sequence
+				)
+				INSERT INTO event_watermark (job_id, run_id, event_sequence)
+				SELECT job_id, run_id, max(event_sequence) FROM updated WHERE event_sequence IS NOT NULL GROUP BY job_id, run_id
+				ON CONFLICT (job_id, run_id) DO UPDATE SET event_sequence = greatest(event_watermark.event_sequence, excluded.event_sequence)`, tmpTable),
 			)
 			if err != nil {
 				l.metrics.RecordDBError(metrics.DBOperationUpdate)
@@ -578,16 +665,33 @@ func (l *LookoutDb) UpdateJobRunsBatch(ctx *armadacontext.Context, instructions
 }
 
 func (l *LookoutDb) UpdateJobRunsScalar(ctx *armadacontext.Context, instructions []*model.UpdateJobRunInstruction) {
-	sqlStatement := `UPDATE job_run
-		SET
-			node          = coalesce($2, node),
-			started       = coalesce($3, started),
-			finished      = coalesce($4, finished),
-			job_run_state = coalesce($5, job_run_state),
-			error         = coalesce($6, error),
-			exit_code     = coalesce($7, exit_code),
-			pending       = coalesce($8, pending)
-		WHERE run_id = $1`
+	sqlStatement := `WITH updated AS (
+			UPDATE job_run
+			SET
+				node          = coalesce($2, node),
+				started       = coalesce($3, started),
+				finished      = coalesce($4, finished),
+				job_run_state = coalesce($5, job_run_state),
+				error         = coalesce($6, error),
+				exit_code     = coalesce($7, exit_code),
+				pending       = coalesce($8, pending),
+				node_labels   = coalesce($9, node_labels),
+				preemption_reason = coalesce($10, preemption_reason),
+				failure_category = coalesce($11, failure_category),
+				artifacts     = coalesce($12, artifacts),
+				cpu_usage_peak = greatest($13, cpu_usage_peak),
+				cpu_usage_average = coalesce($14, cpu_usage_average),
+				memory_usage_peak = greatest($15, memory_usage_peak),
+				memory_usage_average = coalesce($16, memory_usage_average)
+			WHERE run_id = $1
+			AND ($17::bigint IS NULL OR NOT EXISTS (
+				SELECT 1 FROM event_watermark w WHERE w.run_id = $1 AND w.event_sequence > $17
+			))
+			RETURNING job_id, run_id
+		)
+		INSERT INTO event_watermark (job_id, run_id, event_sequence)
+		SELECT job_id, run_id, $17 FROM updated WHERE $17::bigint IS NOT NULL
+		ON CONFLICT (job_id, run_id) DO UPDATE SET event_sequence = greatest(event_watermark.event_sequence, excluded.event_sequence)`
 	for _, i := range instructions {
 		err := l.withDatabaseRetryInsert(func() error {
 			_, err := l.db.Exec(ctx, sqlStatement,
@@ -598,14 +702,23 @@ func (l *LookoutDb) UpdateJobRunsScalar(ctx *armadacontext.Context, instructions
 				i.JobRunState,
 				i.Error,
 				i.ExitCode,
-				i.Pending)
+				i.Pending,
+				i.NodeLabels,
+				i.PreemptionReason,
+				i.FailureCategory,
+				i.Artifacts,
+				i.CpuUsagePeak,
+				i.CpuUsageAverage,
+				i.MemoryUsagePeak,
+				i.MemoryUsageAverage,
+				nullableEventSequence(i.EventSequence))
 			if err != nil {
 				l.metrics.RecordDBError(metrics.DBOperationUpdate)
 			}
 			return err
 		})
 		if err != nil {
-			log.WithError(err).Warnf("Updating job run %s failed", i.RunId)
+			ctx.WithError(err).Warnf("Updating job run %s failed", i.RunId)
 		}
 	}
 }
@@ -697,7 +810,116 @@ func (l *LookoutDb) CreateUserAnnotationsScalar(ctx *armadacontext.Context, inst
 		})
 		// TODO- work out what is a retryable error
 		if err != nil {
-			log.WithError(err).Warnf("Create annotation run for job %s, key %s failed", i.JobId, i.Key)
+			ctx.WithError(err).Warnf("Create annotation run for job %s, key %s failed", i.JobId, i.Key)
+		}
+	}
+}
+
+func (l *LookoutDb) UpsertSchedulingReportsBatch(ctx *armadacontext.Context, instructions []*model.UpsertJobSchedulingReportInstruction) error {
+	return l.withDatabaseRetryInsert(func() error {
+		tmpTable := database.UniqueTableName("job_scheduling_report")
+
+		createTmp := func(tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, fmt.Sprintf(`
+				CREATE TEMPORARY TABLE %s (
+					job_id                       varchar(32),
+					reason                       text,
+					pool                         varchar(512),
+					num_nodes                    integer,
+					num_excluded_nodes_by_reason jsonb,
+					reported                     timestamp
+				) ON COMMIT DROP;`, tmpTable))
+			if err != nil {
+				l.metrics.RecordDBError(metrics.DBOperationCreateTempTable)
+			}
+			return err
+		}
+
+		insertTmp := func(tx pgx.Tx) error {
+			_, err := tx.CopyFrom(ctx,
+				pgx.Identifier{tmpTable},
+				[]string{
+					"job_id",
+					"reason",
+					"pool",
+					"num_nodes",
+					"num_excluded_nodes_by_reason",
+					"reported",
+				},
+				pgx.CopyFromSlice(len(instructions), func(i int) ([]interface{}, error) {
+					return []interface{}{
+						instructions[i].JobId,
+						instructions[i].Reason,
+						instructions[i].Pool,
+						instructions[i].NumNodes,
+						instructions[i].NumExcludedNodesByReason,
+						instructions[i].Reported,
+					}, nil
+				}),
+			)
+			return err
+		}
+
+		copyToDest := func(tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				fmt.Sprintf(`
+					INSERT INTO job_scheduling_report (
+						job_id,
+						reason,
+						pool,
+						num_nodes,
+						num_excluded_nodes_by_reason,
+						reported
+					) SELECT * from %s
+					ON CONFLICT (job_id) DO UPDATE SET
+						reason = EXCLUDED.reason,
+						pool = EXCLUDED.pool,
+						num_nodes = EXCLUDED.num_nodes,
+						num_excluded_nodes_by_reason = EXCLUDED.num_excluded_nodes_by_reason,
+						reported = EXCLUDED.reported
+					WHERE job_scheduling_report.reported <= EXCLUDED.reported`, tmpTable))
+			if err != nil {
+				l.metrics.RecordDBError(metrics.DBOperationInsert)
+			}
+			return err
+		}
+		return batchInsert(ctx, l.db, createTmp, insertTmp, copyToDest)
+	})
+}
+
+func (l *LookoutDb) UpsertSchedulingReportsScalar(ctx *armadacontext.Context, instructions []*model.UpsertJobSchedulingReportInstruction) {
+	sqlStatement := `INSERT INTO job_scheduling_report (
+			job_id,
+			reason,
+			pool,
+			num_nodes,
+			num_excluded_nodes_by_reason,
+			reported)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (job_id) DO UPDATE SET
+			reason = EXCLUDED.reason,
+			pool = EXCLUDED.pool,
+			num_nodes = EXCLUDED.num_nodes,
+			num_excluded_nodes_by_reason = EXCLUDED.num_excluded_nodes_by_reason,
+			reported = EXCLUDED.reported
+		WHERE job_scheduling_report.reported <= EXCLUDED.reported`
+	for _, i := range instructions {
+		err := l.withDatabaseRetryInsert(func() error {
+			_, err := l.db.Exec(ctx, sqlStatement,
+				i.JobId,
+				i.Reason,
+				i.Pool,
+				i.NumNodes,
+				i.NumExcludedNodesByReason,
+				i.Reported)
+			if err != nil {
+				l.metrics.RecordDBError(metrics.DBOperationInsert)
+			}
+			return err
+		})
+		if err != nil {
+			ctx.WithError(err).Warnf("Upserting scheduling report for job %s failed", i.JobId)
 		}
 	}
 }
@@ -777,6 +999,9 @@ func conflateJobUpdates(updates []*model.UpdateJobInstruction) []*model.UpdateJo
 			if update.LatestRunId != nil {
 				existing.LatestRunId = update.LatestRunId
 			}
+			if update.EventSequence > existing.EventSequence {
+				existing.EventSequence = update.EventSequence
+			}
 		}
 	}
 
@@ -790,6 +1015,25 @@ func conflateJobUpdates(updates []*model.UpdateJobInstruction) []*model.UpdateJo
 	return conflated
 }
 
+// conflateSchedulingReports keeps only the most recent report of each job, since a batch can't update a row twice.
+func conflateSchedulingReports(reports []*model.UpsertJobSchedulingReportInstruction) []*model.UpsertJobSchedulingReportInstruction {
+	latestByJobId := make(map[string]*model.UpsertJobSchedulingReportInstruction, len(reports))
+	conflated := make([]*model.UpsertJobSchedulingReportInstruction, 0, len(reports))
+	for _, report := range reports {
+		latest, ok := latestByJobId[report.JobId]
+		if !ok {
+			conflated = append(conflated, report)
+		} else if latest.Reported.After(report.Reported) {
+			continue
+		}
+		latestByJobId[report.JobId] = report
+	}
+	for i, report := range conflated {
+		conflated[i] = latestByJobId[report.JobId]
+	}
+	return conflated
+}
+
 func conflateJobRunUpdates(updates []*model.UpdateJobRunInstruction) []*model.UpdateJobRunInstruction {
 	updatesById := make(map[string]*model.UpdateJobRunInstruction)
 	for _, update := range updates {
@@ -798,6 +1042,9 @@ func conflateJobRunUpdates(updates []*model.UpdateJobRunInstruction) []*model.Up
 			if update.Node != nil {
 				existing.Node = update.Node
 			}
+			if update.NodeLabels != nil {
+				existing.NodeLabels = update.NodeLabels
+			}
 			if update.Started != nil {
 				existing.Started = update.Started
 			}
@@ -813,6 +1060,26 @@ func conflateJobRunUpdates(updates []*model.UpdateJobRunInstruction) []*model.Up
 			if update.ExitCode != nil {
 				existing.ExitCode = update.ExitCode
 			}
+			if update.PreemptionReason != nil {
+				existing.PreemptionReason = update.PreemptionReason
+			}
+			if update.FailureCategory != nil {
+				existing.FailureCategory = update.FailureCategory
+			}
+			if update.Artifacts != nil {
+				existing.Artifacts = update.Artifacts
+			}
+			existing.CpuUsagePeak = maxInt64Ptr(existing.CpuUsagePeak, update.CpuUsagePeak)
+			if update.CpuUsageAverage != nil {
+				existing.CpuUsageAverage = update.CpuUsageAverage
+			}
+			existing.MemoryUsagePeak = maxInt64Ptr(existing.MemoryUsagePeak, update.MemoryUsagePeak)
+			if update.MemoryUsageAverage != nil {
+				existing.MemoryUsageAverage = update.MemoryUsageAverage
+			}
+			if update.EventSequence > existing.EventSequence {
+				existing.EventSequence = update.EventSequence
+			}
 		} else {
 			updatesById[update.RunId] = update
 		}
@@ -861,7 +1128,7 @@ func (l *LookoutDb) filterEventsForTerminalJobs(
 	})
 	if err != nil {
 		m.RecordDBError(metrics.DBOperationRead)
-		log.WithError(err).Warnf("Cannot retrieve job state from the database- Cancelled jobs may not be filtered out")
+		ctx.WithError(err).Warnf("Cannot retrieve job state from the database- Cancelled jobs may not be filtered out")
 		return instructions
 	}
 	rows := rowsRaw.(pgx.Rows)
@@ -872,7 +1139,7 @@ func (l *LookoutDb) filterEventsForTerminalJobs(
 		var state int16
 		err := rows.Scan(&jobId, &state)
 		if err != nil {
-			log.WithError(err).Warnf("Cannot retrieve jobId from row. Terminal jobs will not be filtered out")
+			ctx.WithError(err).Warnf("Cannot retrieve jobId from row. Terminal jobs will not be filtered out")
 		} else {
 			terminalJobs[jobId] = int(state)
 		}
@@ -911,6 +1178,96 @@ func (l *LookoutDb) filterEventsForTerminalJobs(
 	}
 }
 
+// filterReplayedUpdates queries the database for the highest event sequence applied to each job and job run, and
+// removes any updates produced by older events. Such events can only be seen again when the event topic is replayed or
+// a message is redelivered, and applying them would regress the job to an earlier state.
+// Updates without an event sequence are always kept, as are all updates if the watermarks can't be retrieved; the
+// update statements themselves also refuse to apply older events, so this only guards against conflating them with
+// newer ones.
+func (l *LookoutDb) filterReplayedUpdates(
+	ctx *armadacontext.Context,
+	jobUpdates []*model.UpdateJobInstruction,
+	jobRunUpdates []*model.UpdateJobRunInstruction,
+) ([]*model.UpdateJobInstruction, []*model.UpdateJobRunInstruction) {
+	if len(jobUpdates) == 0 && len(jobRunUpdates) == 0 {
+		return jobUpdates, jobRunUpdates
+	}
+	jobIds := make([]string, len(jobUpdates))
+	for i, instruction := range jobUpdates {
+		jobIds[i] = instruction.JobId
+	}
+	runIds := make([]string, len(jobRunUpdates))
+	for i, instruction := range jobRunUpdates {
+		runIds[i] = instruction.RunId
+	}
+
+	rowsRaw, err := l.withDatabaseRetryQuery(func() (interface{}, error) {
+		return l.db.Query(
+			ctx,
+			"SELECT job_id, run_id, event_sequence FROM event_watermark WHERE (run_id = '' AND job_id = any($1)) OR run_id = any($2)",
+			jobIds, runIds,
+		)
+	})
+	if err != nil {
+		l.metrics.RecordDBError(metrics.DBOperationRead)
+		ctx.WithError(err).Warn("Cannot retrieve event watermarks from the database- replayed events may not be filtered out")
+		return jobUpdates, jobRunUpdates
+	}
+	rows := rowsRaw.(pgx.Rows)
+	defer rows.Close()
+
+	jobWatermarks := make(map[string]int64)
+	runWatermarks := make(map[string]int64)
+	for rows.Next() {
+		var jobId, runId string
+		var sequence int64
+		if err := rows.Scan(&jobId, &runId, &sequence); err != nil {
+			ctx.WithError(err).Warn("Cannot retrieve event watermark from row- replayed events may not be filtered out")
+			continue
+		}
+		if runId == "" {
+			jobWatermarks[jobId] = sequence
+		} else {
+			runWatermarks[runId] = sequence
+		}
+	}
+	if len(jobWatermarks) == 0 && len(runWatermarks) == 0 {
+		return jobUpdates, jobRunUpdates
+	}
+
+	filteredJobUpdates := make([]*model.UpdateJobInstruction, 0, len(jobUpdates))
+	for _, instruction := range jobUpdates {
+		if watermark, ok := jobWatermarks[instruction.JobId]; !ok || !isReplayed(instruction.EventSequence, watermark) {
+			filteredJobUpdates = append(filteredJobUpdates, instruction)
+		}
+	}
+	filteredJobRunUpdates := make([]*model.UpdateJobRunInstruction, 0, len(jobRunUpdates))
+	for _, instruction := range jobRunUpdates {
+		if watermark, ok := runWatermarks[instruction.RunId]; !ok || !isReplayed(instruction.EventSequence, watermark) {
+			filteredJobRunUpdates = append(filteredJobRunUpdates, instruction)
+		}
+	}
+	if dropped := len(jobUpdates) - len(filteredJobUpdates) + len(jobRunUpdates) - len(filteredJobRunUpdates); dropped > 0 {
+		ctx.Infof("Discarded %d updates from events that were already superseded", dropped)
+	}
+	return filteredJobUpdates, filteredJobRunUpdates
+}
+
+// isReplayed returns true if an event with the given sequence predates the last one applied.
+// Events with the same sequence as the watermark are applied again; this is harmless if it is the same event, and
+// two distinct events with the same sequence can't be ordered.
+func isReplayed(eventSequence int64, watermark int64) bool {
+	return eventSequence != 0 && eventSequence < watermark
+}
+
+// nullableEventSequence maps an unknown event sequence to NULL so that the instruction is applied unconditionally.
+func nullableEventSequence(eventSequence int64) *int64 {
+	if eventSequence == 0 {
+		return nil
+	}
+	return &eventSequence
+}
+
 func (l *LookoutDb) withDatabaseRetryInsert(executeDb func() error) error {
 	_, err := l.withDatabaseRetryQuery(func() (interface{}, error) {
 		return nil, executeDb()
@@ -955,3 +1312,11 @@ func min(a int, b int) int {
 	}
 	return b
 }
+
+// maxInt64Ptr returns the greater of a and b, ignoring nil values as Postgres' greatest does.
+func maxInt64Ptr(a *int64, b *int64) *int64 {
+	if a == nil || (b != nil && *b > *a) {
+		return b
+	}
+	return a
+}
@@ -79,6 +79,7 @@ type JobRunRow struct {
 	JobId       string
 	Cluster     string
 	Node        *string
+	NodeLabels  map[string]string
 	Pending     time.Time
 	Started     *time.Time
 	Finished    *time.Time
@@ -116,6 +117,7 @@ func defaultInstructionSet() *model.InstructionSet {
 		JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
 			RunId:       runIdString,
 			Node:        pointer.String(nodeName),
+			NodeLabels:  []byte(`{"topology.kubernetes.io/zone": "zone-a"}`),
 			Started:     &startTime,
 			Finished:    &finishedTime,
 			JobRunState: pointer.Int32(lookout.JobRunSucceededOrdinal),
@@ -183,6 +185,7 @@ var expectedJobRunAfterUpdate = JobRunRow{
 	JobId:       jobIdString,
 	Cluster:     executorId,
 	Node:        pointer.String(nodeName),
+	NodeLabels:  map[string]string{"topology.kubernetes.io/zone": "zone-a"},
 	Pending:     updateTime,
 	Started:     &startTime,
 	Finished:    &finishedTime,
@@ -624,6 +627,47 @@ func TestCreateUserAnnotationsBatch(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUpsertSchedulingReports(t *testing.T) {
+	report := func(reason string, reported time.Time) *model.UpsertJobSchedulingReportInstruction {
+		return &model.UpsertJobSchedulingReportInstruction{
+			JobId:                    jobIdString,
+			Reason:                   reason,
+			Pool:                     "cpu",
+			NumNodes:                 10,
+			NumExcludedNodesByReason: []byte(`{"insufficient cpu": 10}`),
+			Reported:                 reported,
+		}
+	}
+	upserts := map[string]func(ldb *LookoutDb, instructions []*model.UpsertJobSchedulingReportInstruction){
+		"batch": func(ldb *LookoutDb, instructions []*model.UpsertJobSchedulingReportInstruction) {
+			assert.NoError(t, ldb.UpsertSchedulingReportsBatch(armadacontext.Background(), instructions))
+		},
+		"scalar": func(ldb *LookoutDb, instructions []*model.UpsertJobSchedulingReportInstruction) {
+			ldb.UpsertSchedulingReportsScalar(armadacontext.Background(), instructions)
+		},
+	}
+	for name, upsert := range upserts {
+		t.Run(name, func(t *testing.T) {
+			err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+				ldb := NewLookoutDb(db, m, 2, 10)
+
+				upsert(ldb, []*model.UpsertJobSchedulingReportInstruction{report("job does not fit on any node", baseTime)})
+				assert.Equal(t, "job does not fit on any node", getSchedulingReportReason(t, db, jobIdString))
+
+				// Newer reports replace older ones
+				upsert(ldb, []*model.UpsertJobSchedulingReportInstruction{report("job set suspended", updateTime)})
+				assert.Equal(t, "job set suspended", getSchedulingReportReason(t, db, jobIdString))
+
+				// Replayed reports don't
+				upsert(ldb, []*model.UpsertJobSchedulingReportInstruction{report("job does not fit on any node", baseTime)})
+				assert.Equal(t, "job set suspended", getSchedulingReportReason(t, db, jobIdString))
+				return nil
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestStoreWithEmptyInstructionSet(t *testing.T) {
 	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, m, 2, 10)
@@ -833,6 +877,19 @@ func TestConflateJobRunUpdates(t *testing.T) {
 	assert.Equal(t, expected, updates)
 }
 
+func TestConflateSchedulingReports(t *testing.T) {
+	reports := conflateSchedulingReports([]*model.UpsertJobSchedulingReportInstruction{
+		{JobId: jobIdString, Reason: "first", Reported: baseTime},
+		{JobId: "someOtherJob", Reason: "other", Reported: baseTime},
+		{JobId: jobIdString, Reason: "second", Reported: updateTime},
+		{JobId: jobIdString, Reason: "delayed", Reported: baseTime},
+	})
+	assert.Equal(t, []*model.UpsertJobSchedulingReportInstruction{
+		{JobId: jobIdString, Reason: "second", Reported: updateTime},
+		{JobId: "someOtherJob", Reason: "other", Reported: baseTime},
+	}, reports)
+}
+
 func TestStoreNullValue(t *testing.T) {
 	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		jobProto := []byte("hello \000 world \000")
@@ -908,6 +965,113 @@ func TestStoreEventsForAlreadyTerminalJobs(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestConflateUpdatesKeepsLatestEventSequence(t *testing.T) {
+	jobUpdates := conflateJobUpdates([]*model.UpdateJobInstruction{
+		{JobId: jobIdString, State: pointer.Int32(lookout.JobRunningOrdinal), EventSequence: 2},
+		{JobId: jobIdString, Priority: pointer.Int64(3), EventSequence: 5},
+		{JobId: jobIdString, State: pointer.Int32(lookout.JobSucceededOrdinal), EventSequence: 4},
+	})
+	assert.Equal(t, []*model.UpdateJobInstruction{
+		{JobId: jobIdString, Priority: pointer.Int64(3), State: pointer.Int32(lookout.JobSucceededOrdinal), EventSequence: 5},
+	}, jobUpdates)
+
+	jobRunUpdates := conflateJobRunUpdates([]*model.UpdateJobRunInstruction{
+		{RunId: runIdString, Started: &baseTime, EventSequence: 7},
+		{RunId: runIdString, Node: pointer.String(nodeName)},
+	})
+	assert.Equal(t, []*model.UpdateJobRunInstruction{
+		{RunId: runIdString, Started: &baseTime, Node: pointer.String(nodeName), EventSequence: 7},
+	}, jobRunUpdates)
+}
+
+func TestConflateJobRunUpdatesKeepsPeakUsage(t *testing.T) {
+	updates := conflateJobRunUpdates([]*model.UpdateJobRunInstruction{
+		{RunId: runIdString, CpuUsagePeak: pointer.Int64(2000), CpuUsageAverage: pointer.Int64(500)},
+		{RunId: runIdString, CpuUsagePeak: pointer.Int64(1000), CpuUsageAverage: pointer.Int64(700), MemoryUsagePeak: pointer.Int64(1024)},
+	})
+	assert.Equal(t, []*model.UpdateJobRunInstruction{
+		{RunId: runIdString, CpuUsagePeak: pointer.Int64(2000), CpuUsageAverage: pointer.Int64(700), MemoryUsagePeak: pointer.Int64(1024)},
+	}, updates)
+}
+
+func TestStoreReplayedEvents(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, m, 2, 10)
+
+		running := func() *model.InstructionSet {
+			return &model.InstructionSet{
+				JobsToCreate: []*model.CreateJobInstruction{makeCreateJobInstruction(jobIdString)},
+				JobsToUpdate: []*model.UpdateJobInstruction{{
+					JobId:         jobIdString,
+					State:         pointer.Int32(lookout.JobRunningOrdinal),
+					LatestRunId:   pointer.String(runIdString),
+					EventSequence: 1,
+				}},
+				JobRunsToCreate: []*model.CreateJobRunInstruction{{
+					RunId:       runIdString,
+					JobId:       jobIdString,
+					Cluster:     executorId,
+					Pending:     &baseTime,
+					JobRunState: lookout.JobRunPendingOrdinal,
+				}},
+				JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
+					RunId:         runIdString,
+					Node:          pointer.String(nodeName),
+					Started:       &startTime,
+					JobRunState:   pointer.Int32(lookout.JobRunRunningOrdinal),
+					EventSequence: 1,
+				}},
+			}
+		}
+		succeeded := &model.InstructionSet{
+			JobsToUpdate: []*model.UpdateJobInstruction{{
+				JobId:         jobIdString,
+				State:         pointer.Int32(lookout.JobSucceededOrdinal),
+				EventSequence: 2,
+			}},
+			JobRunsToUpdate: []*model.UpdateJobRunInstruction{{
+				RunId:         runIdString,
+				Finished:      &finishedTime,
+				JobRunState:   pointer.Int32(lookout.JobRunSucceededOrdinal),
+				EventSequence: 2,
+			}},
+		}
+
+		assert.NoError(t, ldb.Store(armadacontext.Background(), running()))
+		assert.NoError(t, ldb.Store(armadacontext.Background(), succeeded))
+
+		// Replaying the earlier events must neither duplicate rows nor move the job back to running
+		assert.NoError(t, ldb.Store(armadacontext.Background(), running()))
+
+		job := getJob(t, db, jobIdString)
+		assert.Equal(t, lookout.JobSucceededOrdinal, int(job.State))
+		jobRun := getJobRun(t, db, runIdString)
+		assert.Equal(t, lookout.JobRunSucceededOrdinal, int(jobRun.JobRunState))
+		assert.Equal(t, 1, countRows(t, db, "SELECT count(*) FROM job_run WHERE job_id = $1", jobIdString))
+
+		// The serial fallback respects the watermarks in the same way
+		ldb.UpdateJobsScalar(armadacontext.Background(), running().JobsToUpdate)
+		ldb.UpdateJobRunsScalar(armadacontext.Background(), running().JobRunsToUpdate)
+		job = getJob(t, db, jobIdString)
+		assert.Equal(t, lookout.JobSucceededOrdinal, int(job.State))
+		jobRun = getJobRun(t, db, runIdString)
+		assert.Equal(t, lookout.JobRunSucceededOrdinal, int(jobRun.JobRunState))
+
+		assert.Equal(t, 2, countRows(
+			t, db, "SELECT count(*) FROM event_watermark WHERE job_id = $1 AND event_sequence = 2", jobIdString,
+		))
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func countRows(t *testing.T, db *pgxpool.Pool, query string, args ...interface{}) int {
+	var count int
+	err := db.QueryRow(armadacontext.Background(), query, args...).Scan(&count)
+	assert.NoError(t, err)
+	return count
+}
+
 func makeCreateJobInstruction(jobId string) *model.CreateJobInstruction {
 	return &model.CreateJobInstruction{
 		JobId:                     jobId,
@@ -998,6 +1162,7 @@ func getJobRun(t *testing.T, db *pgxpool.Pool, runId string) JobRunRow {
 			job_id,
 			cluster,
 			node,
+			node_labels,
 			pending,
 			started,
 			finished,
@@ -1011,6 +1176,7 @@ func getJobRun(t *testing.T, db *pgxpool.Pool, runId string) JobRunRow {
 		&run.JobId,
 		&run.Cluster,
 		&run.Node,
+		&run.NodeLabels,
 		&run.Pending,
 		&run.Started,
 		&run.Finished,
@@ -1033,6 +1199,13 @@ func getUserAnnotationLookup(t *testing.T, db *pgxpool.Pool, jobId string) UserA
 	return annotation
 }
 
+func getSchedulingReportReason(t *testing.T, db *pgxpool.Pool, jobId string) string {
+	var reason string
+	err := db.QueryRow(armadacontext.Background(), `SELECT reason FROM job_scheduling_report WHERE job_id = $1`, jobId).Scan(&reason)
+	assert.NoError(t, err)
+	return reason
+}
+
 func assertNoRows(t *testing.T, db *pgxpool.Pool, table string) {
 	t.Helper()
 	var count int
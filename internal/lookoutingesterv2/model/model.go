@@ -37,6 +37,9 @@ type UpdateJobInstruction struct {
 	LastTransitionTimeSeconds *int64
 	Duplicate                 *bool
 	LatestRunId               *string
+	// Position of the event that produced this instruction within the job's history, used to discard replayed events.
+	// Zero if unknown, in which case the instruction is always applied.
+	EventSequence int64
 }
 
 // CreateUserAnnotationInstruction is an instruction to create a new entry in the UserAnnotationInstruction table
@@ -63,24 +66,52 @@ type CreateJobRunInstruction struct {
 type UpdateJobRunInstruction struct {
 	RunId       string
 	Node        *string
+	NodeLabels  []byte // JSON-encoded
 	Pending     *time.Time
 	Started     *time.Time
 	Finished    *time.Time
 	JobRunState *int32
 	Error       []byte
 	ExitCode    *int32
+	// Why the run was preempted, e.g., by which job. Only set for preempted runs.
+	PreemptionReason *string
+	// Category of the failure, e.g., "OOMKilled". Only set for failed runs.
+	FailureCategory *string
+	// Objects uploaded to object storage once the run finished, e.g., container logs.
+	Artifacts []byte // JSON-encoded
+	// Peak and average resource usage of the run, in millicores and bytes respectively.
+	// Peaks only ever increase, whereas averages replace any previous value.
+	CpuUsagePeak       *int64
+	CpuUsageAverage    *int64
+	MemoryUsagePeak    *int64
+	MemoryUsageAverage *int64
+	// Position of the event that produced this instruction within the run's history, used to discard replayed events.
+	// Zero if unknown, in which case the instruction is always applied.
+	EventSequence int64
+}
+
+// UpsertJobSchedulingReportInstruction is an instruction to replace the scheduling report of a job, unless the
+// existing report is more recent
+type UpsertJobSchedulingReportInstruction struct {
+	JobId                    string
+	Reason                   string
+	Pool                     string
+	NumNodes                 int32
+	NumExcludedNodesByReason []byte // JSON-encoded
+	Reported                 time.Time
 }
 
 // InstructionSet represents a set of instructions to apply to the database.  Each type of instruction is stored in its
 // own ordered list representign the order it was received.  We also store the original message ids corresponding to
 // these instructions so that when they are saved to the database, we can ACK the corresponding messages.
 type InstructionSet struct {
-	JobsToCreate            []*CreateJobInstruction
-	JobsToUpdate            []*UpdateJobInstruction
-	JobRunsToCreate         []*CreateJobRunInstruction
-	JobRunsToUpdate         []*UpdateJobRunInstruction
-	UserAnnotationsToCreate []*CreateUserAnnotationInstruction
-	MessageIds              []pulsar.MessageID
+	JobsToCreate              []*CreateJobInstruction
+	JobsToUpdate              []*UpdateJobInstruction
+	JobRunsToCreate           []*CreateJobRunInstruction
+	JobRunsToUpdate           []*UpdateJobRunInstruction
+	UserAnnotationsToCreate   []*CreateUserAnnotationInstruction
+	SchedulingReportsToUpsert []*UpsertJobSchedulingReportInstruction
+	MessageIds                []pulsar.MessageID
 }
 
 func (i *InstructionSet) GetMessageIDs() []pulsar.MessageID {
@@ -0,0 +1,40 @@
+package lookoutingesterv2
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/configuration"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/metrics"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/model"
+)
+
+// Replay replays the event log into the Lookout database, processing events in the same way as Run.
+func Replay(ctx *armadacontext.Context, config *configuration.LookoutIngesterV2Configuration, opts eventlog.ReplayOptions) error {
+	m := metrics.Get()
+	db, err := database.OpenPgxPool(config.Postgres)
+	if err != nil {
+		return errors.WithMessage(err, "Error opening connection to postgres")
+	}
+	defer db.Close()
+	lookoutDb := lookoutdb.NewLookoutDb(db, m, config.MaxAttempts, config.MaxBackoff)
+
+	compressor, err := newCompressor(config)
+	if err != nil {
+		return errors.WithMessage(err, "Error creating compressor")
+	}
+	converter := instructions.NewInstructionConverter(m, config.UserAnnotationPrefix, compressor, config.UseLegacyEventConversion)
+
+	return eventlog.ReplayFromPulsar(
+		ctx,
+		config.Pulsar,
+		opts,
+		nil,
+		ingest.ReplayProcessor[*model.InstructionSet](converter, lookoutDb),
+	)
+}
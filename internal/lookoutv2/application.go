@@ -3,20 +3,30 @@
 package lookoutv2
 
 import (
+	"io"
+	"net/http"
+	"time"
+
 	"github.com/caarlos0/log"
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/jessevdk/go-flags"
-	"github.com/sirupsen/logrus"
+	"github.com/pkg/errors"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/lookoutv2/configuration"
 	"github.com/armadaproject/armada/internal/lookoutv2/conversions"
 	"github.com/armadaproject/armada/internal/lookoutv2/gen/restapi"
 	"github.com/armadaproject/armada/internal/lookoutv2/gen/restapi/operations"
+	"github.com/armadaproject/armada/internal/lookoutv2/graphql"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
 	"github.com/armadaproject/armada/internal/lookoutv2/repository"
 )
 
@@ -27,21 +37,81 @@ func Serve(configuration configuration.LookoutV2Config) error {
 		return err
 	}
 
+	var getJobsRepo repository.GetJobsRepository
+	var groupJobsRepo repository.GroupJobsRepository
+	var getJobRunErrorRepo repository.GetJobRunErrorRepository
+	var getJobSpecRepo repository.GetJobSpecRepository
+	var getJobRunsRepo repository.GetJobRunsRepository
+	var getArrayJobRepo repository.GetArrayJobRepository
+	var searchJobsRepo repository.SearchJobsRepository
+	var getJobStatsRepo repository.GetJobStatsRepository
+	var getQueueUsageRepo repository.GetQueueUsageRepository
+	var getJobQueueRepo repository.GetJobQueueRepository
+	var getJobSchedulingReportRepo repository.GetJobSchedulingReportRepository
 	db, err := database.OpenPgxPool(configuration.Postgres)
 	if err != nil {
 		return err
 	}
+	// Saved views aren't specific to a region, so they're always stored in the database of this lookout.
+	savedViewRepo := repository.NewSqlSavedViewRepository(db)
+	decompressor, err := NewDecompressor(configuration.CompressionDictionaryPaths)
+	if err != nil {
+		return err
+	}
+	if len(configuration.Regions) > 0 {
+		regions := make([]*repository.Region, len(configuration.Regions))
+		for i, regionConfig := range configuration.Regions {
+			db, err := database.OpenPgxPool(regionConfig.Postgres)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to connect to database of region %s", regionConfig.Name)
+			}
+			regions[i] = repository.NewSqlRegion(regionConfig.Name, db, decompressor, configuration.UIConfig.UserAnnotationPrefix, configuration.SearchAnnotationKeys)
+		}
+		multiRegionRepo, err := repository.NewMultiRegionRepository(regions)
+		if err != nil {
+			return err
+		}
+		getJobsRepo = multiRegionRepo
+		groupJobsRepo = multiRegionRepo
+		getJobRunErrorRepo = multiRegionRepo
+		getJobSpecRepo = multiRegionRepo
+		getJobRunsRepo = multiRegionRepo
+		getArrayJobRepo = multiRegionRepo
+		searchJobsRepo = multiRegionRepo
+		getJobStatsRepo = multiRegionRepo
+		getQueueUsageRepo = multiRegionRepo
+		getJobQueueRepo = multiRegionRepo
+		getJobSchedulingReportRepo = multiRegionRepo
+	} else {
+		getJobsRepo = repository.NewSqlGetJobsRepository(db)
+		groupJobsRepo = repository.NewSqlGroupJobsRepository(db)
+		getJobRunErrorRepo = repository.NewSqlGetJobRunErrorRepository(db, decompressor)
+		getJobSpecRepo = repository.NewSqlGetJobSpecRepository(db, decompressor)
+		getJobRunsRepo = repository.NewSqlGetJobRunsRepository(db)
+		getArrayJobRepo = repository.NewSqlGetArrayJobRepository(db, configuration.UIConfig.UserAnnotationPrefix)
+		searchJobsRepo = repository.NewSqlSearchJobsRepository(db, configuration.SearchAnnotationKeys)
+		getJobStatsRepo = repository.NewSqlGetJobStatsRepository(db)
+		getQueueUsageRepo = repository.NewSqlGetQueueUsageRepository(db)
+		getJobQueueRepo = repository.NewSqlGetJobQueueRepository(db)
+		getJobSchedulingReportRepo = repository.NewSqlGetJobSchedulingReportRepository(db)
+	}
 
-	getJobsRepo := repository.NewSqlGetJobsRepository(db)
-	groupJobsRepo := repository.NewSqlGroupJobsRepository(db)
-	decompressor := compress.NewThreadSafeZlibDecompressor()
-	getJobRunErrorRepo := repository.NewSqlGetJobRunErrorRepository(db, decompressor)
-	getJobSpecRepo := repository.NewSqlGetJobSpecRepository(db, decompressor)
+	if configuration.Auth.Enabled {
+		authServices, err := auth.ConfigureAuth(configuration.Auth.Authentication)
+		if err != nil {
+			return errors.WithMessage(err, "failed to configure authentication")
+		}
+		restapi.SetAuthMiddleware(NewAuthMiddleware(authServices, configuration.Auth.AnonymousReadOnly)) // This needs to happen before ConfigureAPI
+	}
+	queueAuthorizer, err := NewQueueAuthorizer(configuration.Auth, getJobQueueRepo)
+	if err != nil {
+		return errors.WithMessage(err, "failed to configure queue permissions")
+	}
 
 	// create new service API
 	api := operations.NewLookoutAPI(swaggerSpec)
 
-	logger := logrus.NewEntry(logrus.New())
+	logger := logging.StandardLogger()
 
 	api.GetHealthHandler = operations.GetHealthHandlerFunc(
 		func(params operations.GetHealthParams) middleware.Responder {
@@ -51,10 +121,11 @@ func Serve(configuration configuration.LookoutV2Config) error {
 
 	api.GetJobsHandler = operations.GetJobsHandlerFunc(
 		func(params operations.GetJobsParams) middleware.Responder {
-			filters := util.Map(params.GetJobsRequest.Filters, conversions.FromSwaggerFilter)
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			filters := queueAuthorizer.RestrictFilters(ctx, util.Map(params.GetJobsRequest.Filters, conversions.FromSwaggerFilter))
 			order := conversions.FromSwaggerOrder(params.GetJobsRequest.Order)
 			result, err := getJobsRepo.GetJobs(
-				armadacontext.New(params.HTTPRequest.Context(), logger),
+				ctx,
 				filters,
 				params.GetJobsRequest.ActiveJobSets,
 				order,
@@ -71,12 +142,140 @@ func Serve(configuration configuration.LookoutV2Config) error {
 		},
 	)
 
+	api.SearchJobsHandler = operations.SearchJobsHandlerFunc(
+		func(params operations.SearchJobsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			// Jobs are restricted to the visible queues before the number of results is limited,
+			// such that jobs of other queues don't take the place of visible ones.
+			var queues []string
+			if visibleQueues, all := queueAuthorizer.visibleQueues(ctx); !all {
+				queues = visibleQueues
+				if queues == nil {
+					queues = []string{}
+				}
+			}
+			results, err := searchJobsRepo.SearchJobs(ctx, params.SearchJobsRequest.Query, queues, int(params.SearchJobsRequest.Take))
+			if err != nil {
+				return operations.NewSearchJobsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewSearchJobsOK().WithPayload(&operations.SearchJobsOKBody{
+				Results: util.Map(results, conversions.ToSwaggerSearchResult),
+			})
+		},
+	)
+
+	jobWatcher := NewJobWatcher(getJobsRepo, configuration.WatchPollInterval, configuration.WatchBatchSize)
+	api.RegisterProducer("text/event-stream", runtime.TextProducer())
+	api.WatchJobsHandler = operations.WatchJobsHandlerFunc(
+		func(params operations.WatchJobsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			filters := queueAuthorizer.RestrictFilters(ctx, util.Map(params.WatchJobsRequest.Filters, conversions.FromSwaggerFilter))
+			since := time.Now()
+			if params.WatchJobsRequest.Since != nil {
+				since = time.Time(*params.WatchJobsRequest.Since)
+			}
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+				started := false
+				err := jobWatcher.Watch(ctx, filters, params.WatchJobsRequest.ActiveJobSets, since, func(jobs []*model.Job, resumeFrom time.Time) error {
+					if !started {
+						rw.Header().Set("Content-Type", "text/event-stream")
+						rw.Header().Set("Cache-Control", "no-cache")
+						rw.WriteHeader(http.StatusOK)
+						started = true
+					}
+					return writeJobsEvent(rw, jobs, resumeFrom)
+				})
+				if err != nil && !started {
+					// Errors before streaming has started, e.g., due to invalid filters, are returned as usual.
+					rw.Header().Set("Content-Type", "application/json")
+					operations.NewWatchJobsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error())).WriteResponse(rw, runtime.JSONProducer())
+				} else if err != nil {
+					ctx.WithError(err).Warn("stopped watching jobs")
+				}
+			})
+		},
+	)
+
+	jobExporter := NewJobExporter(getJobsRepo, configuration.Export)
+	api.RegisterProducer("text/csv", runtime.TextProducer())
+	api.RegisterProducer("application/vnd.apache.parquet", runtime.ByteStreamProducer())
+	api.ExportJobsHandler = operations.ExportJobsHandlerFunc(
+		func(params operations.ExportJobsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			request := &ExportRequest{
+				Filters:       queueAuthorizer.RestrictFilters(ctx, util.Map(params.ExportJobsRequest.Filters, conversions.FromSwaggerFilter)),
+				ActiveJobSets: params.ExportJobsRequest.ActiveJobSets,
+				Format:        params.ExportJobsRequest.Format,
+				Limit:         int(params.ExportJobsRequest.Limit),
+			}
+			if params.ExportJobsRequest.Order != nil {
+				request.Order = conversions.FromSwaggerOrder(params.ExportJobsRequest.Order)
+			}
+			if params.ExportJobsRequest.Async {
+				// Asynchronous exports are written to disk, so anonymous users can't start them.
+				if err := queueAuthorizer.AuthorizeWrite(ctx); err != nil {
+					return operations.NewExportJobsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+				}
+				status, err := jobExporter.StartExport(ctx, request)
+				if err != nil {
+					return operations.NewExportJobsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+				}
+				return operations.NewExportJobsAccepted().WithPayload(toSwaggerExportStatus(status))
+			}
+			if err := jobExporter.Validate(request); err != nil {
+				return operations.NewExportJobsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+				rw.Header().Set("Content-Type", ContentTypeForExportFormat(request.Format))
+				rw.Header().Set("Content-Disposition", "attachment; filename=jobs."+request.Format)
+				rw.WriteHeader(http.StatusOK)
+				if err := jobExporter.Export(ctx, request, rw, nil); err != nil {
+					// The status has already been sent, so all that can be done is to truncate the response.
+					ctx.WithError(err).Warn("failed to export jobs")
+				}
+			})
+		},
+	)
+
+	api.GetJobExportHandler = operations.GetJobExportHandlerFunc(
+		func(params operations.GetJobExportParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			status, err := jobExporter.GetExport(params.GetJobExportRequest.ExportID)
+			if err != nil {
+				return operations.NewGetJobExportBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			if err := AuthorizeExport(ctx, status); err != nil {
+				return operations.NewGetJobExportDefault(http.StatusForbidden).WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			switch status.State {
+			case ExportStateRunning:
+				return operations.NewGetJobExportAccepted().WithPayload(toSwaggerExportStatus(status))
+			case ExportStateFailed:
+				return operations.NewGetJobExportBadRequest().WithPayload(conversions.ToSwaggerError(status.Error))
+			}
+			result, err := jobExporter.OpenExport(status.Id)
+			if err != nil {
+				return operations.NewGetJobExportBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+				defer result.Close()
+				rw.Header().Set("Content-Type", ContentTypeForExportFormat(status.Format))
+				rw.Header().Set("Content-Disposition", "attachment; filename=jobs."+status.Format)
+				rw.WriteHeader(http.StatusOK)
+				if _, err := io.Copy(rw, result); err != nil {
+					ctx.WithError(err).Warn("failed to send export")
+				}
+			})
+		},
+	)
+
 	api.GroupJobsHandler = operations.GroupJobsHandlerFunc(
 		func(params operations.GroupJobsParams) middleware.Responder {
-			filters := util.Map(params.GroupJobsRequest.Filters, conversions.FromSwaggerFilter)
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			filters := queueAuthorizer.RestrictFilters(ctx, util.Map(params.GroupJobsRequest.Filters, conversions.FromSwaggerFilter))
 			order := conversions.FromSwaggerOrder(params.GroupJobsRequest.Order)
 			result, err := groupJobsRepo.GroupBy(
-				armadacontext.New(params.HTTPRequest.Context(), logger),
+				ctx,
 				filters,
 				params.GroupJobsRequest.ActiveJobSets,
 				order,
@@ -95,9 +294,64 @@ func Serve(configuration configuration.LookoutV2Config) error {
 		},
 	)
 
+	api.GetJobStatsHandler = operations.GetJobStatsHandlerFunc(
+		func(params operations.GetJobStatsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			filters := queueAuthorizer.RestrictFilters(ctx, util.Map(params.GetJobStatsRequest.Filters, conversions.FromSwaggerFilter))
+			end := time.Now()
+			if params.GetJobStatsRequest.End != nil {
+				end = time.Time(*params.GetJobStatsRequest.End)
+			}
+			result, err := getJobStatsRepo.GetJobStats(
+				ctx,
+				filters,
+				params.GetJobStatsRequest.ActiveJobSets,
+				params.GetJobStatsRequest.GroupedField,
+				time.Time(*params.GetJobStatsRequest.Start),
+				end,
+				time.Duration(*params.GetJobStatsRequest.BucketSeconds)*time.Second,
+			)
+			if err != nil {
+				return operations.NewGetJobStatsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetJobStatsOK().WithPayload(&operations.GetJobStatsOKBody{
+				Buckets: util.Map(result, conversions.ToSwaggerJobStatsBucket),
+			})
+		},
+	)
+
+	api.GetChargebackHandler = operations.GetChargebackHandlerFunc(
+		func(params operations.GetChargebackParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			filters := queueAuthorizer.RestrictFilters(ctx, util.Map(params.GetChargebackRequest.Filters, conversions.FromSwaggerFilter))
+			end := time.Now()
+			if params.GetChargebackRequest.End != nil {
+				end = time.Time(*params.GetChargebackRequest.End)
+			}
+			usage, err := getQueueUsageRepo.GetQueueUsage(
+				ctx,
+				filters,
+				params.GetChargebackRequest.ActiveJobSets,
+				time.Time(*params.GetChargebackRequest.Start),
+				end,
+				time.Duration(*params.GetChargebackRequest.BucketSeconds)*time.Second,
+			)
+			if err != nil {
+				return operations.NewGetChargebackBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			result := Chargeback(usage, configuration.CostModel, configuration.PoolByCluster)
+			return operations.NewGetChargebackOK().WithPayload(&operations.GetChargebackOKBody{
+				Buckets: util.Map(result, conversions.ToSwaggerChargebackBucket),
+			})
+		},
+	)
+
 	api.GetJobRunErrorHandler = operations.GetJobRunErrorHandlerFunc(
 		func(params operations.GetJobRunErrorParams) middleware.Responder {
 			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			if err := queueAuthorizer.AuthorizeRun(ctx, params.GetJobRunErrorRequest.RunID); err != nil {
+				return operations.NewGetJobRunErrorBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
 			result, err := getJobRunErrorRepo.GetJobRunError(ctx, params.GetJobRunErrorRequest.RunID)
 			if err != nil {
 				return operations.NewGetJobRunErrorBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
@@ -108,6 +362,20 @@ func Serve(configuration configuration.LookoutV2Config) error {
 		},
 	)
 
+	api.GetJobSchedulingReportHandler = operations.GetJobSchedulingReportHandlerFunc(
+		func(params operations.GetJobSchedulingReportParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			if err := queueAuthorizer.AuthorizeJob(ctx, params.GetJobSchedulingReportRequest.JobID); err != nil {
+				return operations.NewGetJobSchedulingReportBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			result, err := getJobSchedulingReportRepo.GetJobSchedulingReport(ctx, params.GetJobSchedulingReportRequest.JobID)
+			if err != nil {
+				return operations.NewGetJobSchedulingReportBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetJobSchedulingReportOK().WithPayload(conversions.ToSwaggerSchedulingReport(result))
+		},
+	)
+
 	api.GetJobSpecHandler = operations.GetJobSpecHandlerFunc(
 		func(params operations.GetJobSpecParams) middleware.Responder {
 			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
@@ -115,12 +383,136 @@ func Serve(configuration configuration.LookoutV2Config) error {
 			if err != nil {
 				return operations.NewGetJobSpecBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
 			}
+			if err := queueAuthorizer.AuthorizeQueue(ctx, result.Queue); err != nil {
+				return operations.NewGetJobSpecBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
 			return operations.NewGetJobSpecOK().WithPayload(&operations.GetJobSpecOKBody{
 				Job: result,
 			})
 		},
 	)
 
+	api.GetJobRunsHandler = operations.GetJobRunsHandlerFunc(
+		func(params operations.GetJobRunsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			if err := queueAuthorizer.AuthorizeJob(ctx, params.GetJobRunsRequest.JobID); err != nil {
+				return operations.NewGetJobRunsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			result, err := getJobRunsRepo.GetJobRuns(ctx, params.GetJobRunsRequest.JobID)
+			if err != nil {
+				return operations.NewGetJobRunsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetJobRunsOK().WithPayload(&operations.GetJobRunsOKBody{
+				Runs: util.Map(result, conversions.ToSwaggerRun),
+			})
+		},
+	)
+
+	api.GetArrayJobHandler = operations.GetArrayJobHandlerFunc(
+		func(params operations.GetArrayJobParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			result, err := getArrayJobRepo.GetArrayJob(ctx, params.GetArrayJobRequest.ArrayID)
+			if err != nil {
+				return operations.NewGetArrayJobBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			// All tasks of an array job are submitted to the same queue.
+			if err := queueAuthorizer.AuthorizeJob(ctx, result.Tasks[0].JobId); err != nil {
+				return operations.NewGetArrayJobBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetArrayJobOK().WithPayload(&operations.GetArrayJobOKBody{
+				ArrayID:     result.ArrayId,
+				StateCounts: result.StateCounts,
+				Tasks:       util.Map(result.Tasks, conversions.ToSwaggerArrayTask),
+			})
+		},
+	)
+
+	api.ListSavedViewsHandler = operations.ListSavedViewsHandlerFunc(
+		func(params operations.ListSavedViewsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			result, err := savedViewRepo.ListSavedViews(ctx, params.ListSavedViewsRequest.Owner)
+			if err != nil {
+				return operations.NewListSavedViewsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewListSavedViewsOK().WithPayload(&operations.ListSavedViewsOKBody{
+				Views: util.Map(result, conversions.ToSwaggerSavedView),
+			})
+		},
+	)
+
+	api.GetSavedViewHandler = operations.GetSavedViewHandlerFunc(
+		func(params operations.GetSavedViewParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			result, err := savedViewRepo.GetSavedView(ctx, params.GetSavedViewRequest.ViewID)
+			if err != nil {
+				return operations.NewGetSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetSavedViewOK().WithPayload(conversions.ToSwaggerSavedView(result))
+		},
+	)
+
+	api.CreateSavedViewHandler = operations.CreateSavedViewHandlerFunc(
+		func(params operations.CreateSavedViewParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			if err := queueAuthorizer.AuthorizeWrite(ctx); err != nil {
+				return operations.NewCreateSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			view := conversions.FromSwaggerSavedView(params.CreateSavedViewRequest)
+			view.Owner = authorization.GetPrincipal(ctx).GetName()
+			result, err := savedViewRepo.CreateSavedView(ctx, view)
+			if err != nil {
+				return operations.NewCreateSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewCreateSavedViewOK().WithPayload(conversions.ToSwaggerSavedView(result))
+		},
+	)
+
+	api.UpdateSavedViewHandler = operations.UpdateSavedViewHandlerFunc(
+		func(params operations.UpdateSavedViewParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			if err := queueAuthorizer.AuthorizeWrite(ctx); err != nil {
+				return operations.NewUpdateSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			view := conversions.FromSwaggerSavedView(params.UpdateSavedViewRequest)
+			if view.ViewId == "" {
+				return operations.NewUpdateSavedViewBadRequest().WithPayload(conversions.ToSwaggerError("viewId must be set"))
+			}
+			view.Owner = authorization.GetPrincipal(ctx).GetName()
+			result, err := savedViewRepo.UpdateSavedView(ctx, view)
+			if err != nil {
+				return operations.NewUpdateSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewUpdateSavedViewOK().WithPayload(conversions.ToSwaggerSavedView(result))
+		},
+	)
+
+	api.DeleteSavedViewHandler = operations.DeleteSavedViewHandlerFunc(
+		func(params operations.DeleteSavedViewParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			if err := queueAuthorizer.AuthorizeWrite(ctx); err != nil {
+				return operations.NewDeleteSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			owner := authorization.GetPrincipal(ctx).GetName()
+			if err := savedViewRepo.DeleteSavedView(ctx, params.DeleteSavedViewRequest.ViewID, owner); err != nil {
+				return operations.NewDeleteSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewDeleteSavedViewOK()
+		},
+	)
+
+	graphqlHandler, err := graphql.NewHandler(graphql.NewResolver(
+		getJobsRepo,
+		groupJobsRepo,
+		getJobSpecRepo,
+		getJobRunErrorRepo,
+		queueAuthorizer.RestrictFilters,
+		logger,
+	))
+	if err != nil {
+		return errors.WithMessage(err, "failed to create GraphQL API")
+	}
+	restapi.SetGraphqlHandler(graphqlHandler) // This needs to happen before ConfigureAPI
+
 	server := restapi.NewServer(api)
 	defer func() {
 		shutdownErr := server.Shutdown()
@@ -146,3 +538,21 @@ func Serve(configuration configuration.LookoutV2Config) error {
 
 	return err
 }
+
+// NewDecompressor returns a decompressor for job specs and errors compressed by the lookout ingester,
+// which may have compressed them with zlib, or with zstd and any of the dictionaries at dictionaryPaths.
+func NewDecompressor(dictionaryPaths []string) (compress.Decompressor, error) {
+	dictionaries := make([][]byte, len(dictionaryPaths))
+	for i, path := range dictionaryPaths {
+		dictionary, err := compress.LoadDictionary(path)
+		if err != nil {
+			return nil, err
+		}
+		dictionaries[i] = dictionary
+	}
+	zstdDecompressor, err := compress.NewZstdDecompressor(dictionaries...)
+	if err != nil {
+		return nil, err
+	}
+	return compress.NewAutoDecompressor(compress.NewThreadSafeZlibDecompressor(), zstdDecompressor), nil
+}
@@ -0,0 +1,174 @@
+package lookoutv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/utils/strings/slices"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
+	"github.com/armadaproject/armada/internal/lookoutv2/configuration"
+	"github.com/armadaproject/armada/internal/lookoutv2/conversions"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/internal/lookoutv2/repository"
+)
+
+// ViewAllJobs is the permission to see the jobs of every queue, regardless of the configured queue permissions.
+const ViewAllJobs permission.Permission = "view_all_jobs"
+
+const queueField = "queue"
+
+// readOnlyKey marks the context of a request that may read but not modify anything.
+type readOnlyKey struct{}
+
+// NewAuthMiddleware returns middleware that authenticates each API request with the first of authServices that
+// accepts its credentials, and stores the resulting principal in the request context.
+// Requests without credentials are rejected, unless anonymousReadOnly is set, in which case they're served read-only
+// as the anonymous principal. The health check is never authenticated.
+func NewAuthMiddleware(authServices []authorization.AuthService, anonymousReadOnly bool) func(http.Handler) http.Handler {
+	authenticate := authorization.CreateMiddlewareAuthFunction(authServices)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/health" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// The auth services read credentials from gRPC metadata.
+			md := metadata.MD{}
+			if header := r.Header.Get("Authorization"); header != "" {
+				md.Set("authorization", header)
+			}
+			ctx, err := authenticate(metadata.NewIncomingContext(r.Context(), md))
+			if err != nil {
+				var unauthenticated *armadaerrors.ErrUnauthenticated
+				if !anonymousReadOnly || !errors.As(err, &unauthenticated) {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusUnauthorized)
+					_ = json.NewEncoder(w).Encode(conversions.ToSwaggerError(err.Error()))
+					return
+				}
+				// Without a principal in the context, the anonymous principal is used.
+				ctx = context.WithValue(r.Context(), readOnlyKey{}, true)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// QueueAuthorizer decides which queues' jobs the principal of a request may see.
+type QueueAuthorizer struct {
+	enabled           bool
+	permissionChecker *authorization.PrincipalPermissionChecker
+	queuePermissions  []configuration.QueuePermissionsConfig
+	getJobQueueRepo   repository.GetJobQueueRepository
+}
+
+func NewQueueAuthorizer(config configuration.AuthConfig, getJobQueueRepo repository.GetJobQueueRepository) (*QueueAuthorizer, error) {
+	permissionChecker, err := authorization.NewPrincipalPermissionCheckerFromConfig(config.Authentication)
+	if err != nil {
+		return nil, err
+	}
+	return &QueueAuthorizer{
+		enabled:           config.Enabled,
+		permissionChecker: permissionChecker,
+		queuePermissions:  config.QueuePermissions,
+		getJobQueueRepo:   getJobQueueRepo,
+	}, nil
+}
+
+// visibleQueues returns the queues whose jobs the principal in ctx may see, sorted by name,
+// or true if it may see the jobs of every queue. Principals may see the jobs of the queues listed for them in the
+// queue permissions, and of the queues on which they're bound a role, as the Armada server lets them watch those.
+func (a *QueueAuthorizer) visibleQueues(ctx context.Context) ([]string, bool) {
+	if !a.enabled || a.permissionChecker.UserHasPermission(ctx, ViewAllJobs) {
+		return nil, true
+	}
+	roleQueues, all := a.permissionChecker.QueuesWithVerb(ctx, authorization.QueueVerbWatch)
+	if all {
+		return nil, true
+	}
+	principal := authorization.GetPrincipal(ctx)
+	queues := append([]string{}, roleQueues...)
+	for _, permissions := range a.queuePermissions {
+		if slices.Contains(permissions.Users, principal.GetName()) || containsGroup(permissions.Groups, principal) {
+			queues = append(queues, permissions.Queue)
+		}
+	}
+	sort.Strings(queues)
+	return armadaslices.Unique(queues), false
+}
+
+func containsGroup(groups []string, principal authorization.Principal) bool {
+	for _, group := range groups {
+		if principal.IsInGroup(group) {
+			return true
+		}
+	}
+	return false
+}
+
+// RestrictFilters returns filters that, in addition to the provided ones, only match jobs of the queues visible to the
+// principal in ctx.
+func (a *QueueAuthorizer) RestrictFilters(ctx context.Context, filters []*model.Filter) []*model.Filter {
+	queues, all := a.visibleQueues(ctx)
+	if all {
+		return filters
+	}
+	restricted := make([]*model.Filter, len(filters), len(filters)+1)
+	copy(restricted, filters)
+	return append(restricted, &model.Filter{
+		Field: queueField,
+		Match: model.MatchAnyOf,
+		Value: queues,
+	})
+}
+
+// AuthorizeQueue returns an error if the principal in ctx may not see the jobs of queue.
+func (a *QueueAuthorizer) AuthorizeQueue(ctx context.Context, queue string) error {
+	queues, all := a.visibleQueues(ctx)
+	if all || slices.Contains(queues, queue) {
+		return nil
+	}
+	return errors.Errorf("user %s is not allowed to view the jobs of queue %s", authorization.GetPrincipal(ctx).GetName(), queue)
+}
+
+// AuthorizeJob returns an error if the principal in ctx may not see the job with the provided id.
+func (a *QueueAuthorizer) AuthorizeJob(ctx *armadacontext.Context, jobId string) error {
+	if _, all := a.visibleQueues(ctx); all {
+		return nil
+	}
+	queue, err := a.getJobQueueRepo.GetJobQueue(ctx, jobId)
+	if err != nil {
+		return err
+	}
+	return a.AuthorizeQueue(ctx, queue)
+}
+
+// AuthorizeRun returns an error if the principal in ctx may not see the job run with the provided id.
+func (a *QueueAuthorizer) AuthorizeRun(ctx *armadacontext.Context, runId string) error {
+	if _, all := a.visibleQueues(ctx); all {
+		return nil
+	}
+	queue, err := a.getJobQueueRepo.GetRunQueue(ctx, runId)
+	if err != nil {
+		return err
+	}
+	return a.AuthorizeQueue(ctx, queue)
+}
+
+// AuthorizeWrite returns an error if the request with context ctx may only read.
+func (a *QueueAuthorizer) AuthorizeWrite(ctx context.Context) error {
+	if readOnly, _ := ctx.Value(readOnlyKey{}).(bool); readOnly {
+		return errors.New("anonymous users may not modify anything; please log in")
+	}
+	return nil
+}
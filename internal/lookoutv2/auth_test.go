@@ -0,0 +1,157 @@
+package lookoutv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	authconfig "github.com/armadaproject/armada/internal/common/auth/configuration"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/lookoutv2/configuration"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+type fakeGetJobQueueRepository struct {
+	jobQueues map[string]string
+	runQueues map[string]string
+}
+
+func (r *fakeGetJobQueueRepository) GetJobQueue(_ *armadacontext.Context, jobId string) (string, error) {
+	if queue, ok := r.jobQueues[jobId]; ok {
+		return queue, nil
+	}
+	return "", errors.Errorf("job with id %s not found", jobId)
+}
+
+func (r *fakeGetJobQueueRepository) GetRunQueue(_ *armadacontext.Context, runId string) (string, error) {
+	if queue, ok := r.runQueues[runId]; ok {
+		return queue, nil
+	}
+	return "", errors.Errorf("run with id %s not found", runId)
+}
+
+var testAuthConfig = configuration.AuthConfig{
+	Enabled: true,
+	Authentication: authconfig.AuthConfig{
+		PermissionGroupMapping: map[permission.Permission][]string{ViewAllJobs: {"admins"}},
+		QueueRoleBindings: []authconfig.QueueRoleBinding{
+			{Role: "viewer", Queues: []string{"queue-c", "queue-a"}, Groups: []string{"team"}},
+			{Role: "admin", Queues: []string{"*"}, Users: []string{"dave"}},
+		},
+	},
+	QueuePermissions: []configuration.QueuePermissionsConfig{
+		{Queue: "queue-b", Users: []string{"alice"}},
+		{Queue: "queue-a", Groups: []string{"team"}},
+		{Queue: "public", Groups: []string{authorization.EveryoneGroup}},
+	},
+}
+
+func withPrincipal(name string, groups ...string) *armadacontext.Context {
+	return armadacontext.FromGrpcCtx(authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal(name, groups)))
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	users := map[string]authconfig.UserInfo{"alice": {Password: "secret", Groups: []string{"team"}}}
+	var principal authorization.Principal
+	var readOnly error
+	handler := func(anonymousReadOnly bool) http.Handler {
+		return NewAuthMiddleware(
+			[]authorization.AuthService{authorization.NewBasicAuthService(users)},
+			anonymousReadOnly,
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal = authorization.GetPrincipal(r.Context())
+			authorizer, err := NewQueueAuthorizer(testAuthConfig, nil)
+			require.NoError(t, err)
+			readOnly = authorizer.AuthorizeWrite(r.Context())
+		}))
+	}
+	serve := func(anonymousReadOnly bool, path string, username string, password string) int {
+		principal, readOnly = nil, nil
+		r := httptest.NewRequest(http.MethodPost, path, nil)
+		if username != "" {
+			r.SetBasicAuth(username, password)
+		}
+		w := httptest.NewRecorder()
+		handler(anonymousReadOnly).ServeHTTP(w, r)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve(false, "/api/v1/jobs", "alice", "secret"))
+	assert.Equal(t, "alice", principal.GetName())
+	assert.NoError(t, readOnly)
+
+	assert.Equal(t, http.StatusUnauthorized, serve(false, "/api/v1/jobs", "", ""))
+	assert.Equal(t, http.StatusUnauthorized, serve(false, "/api/v1/jobs", "alice", "wrong"))
+	assert.Equal(t, http.StatusOK, serve(false, "/health", "", ""))
+
+	// Anonymous users may only read, and only if they provide no credentials at all
+	require.Equal(t, http.StatusOK, serve(true, "/api/v1/jobs", "", ""))
+	assert.Equal(t, "anonymous", principal.GetName())
+	assert.Error(t, readOnly)
+	assert.Equal(t, http.StatusUnauthorized, serve(true, "/api/v1/jobs", "alice", "wrong"))
+}
+
+func TestQueueAuthorizer_RestrictFilters(t *testing.T) {
+	authorizer, err := NewQueueAuthorizer(testAuthConfig, nil)
+	require.NoError(t, err)
+	filters := []*model.Filter{{Field: "jobSet", Match: model.MatchExact, Value: "job-set"}}
+
+	assert.Equal(t, append(filters, &model.Filter{
+		Field: "queue",
+		Match: model.MatchAnyOf,
+		Value: []string{"public", "queue-a", "queue-b", "queue-c"},
+	}), authorizer.RestrictFilters(withPrincipal("alice", "team"), filters))
+	assert.Len(t, filters, 1)
+
+	assert.Equal(t, append(filters, &model.Filter{
+		Field: "queue",
+		Match: model.MatchAnyOf,
+		Value: []string{"public"},
+	}), authorizer.RestrictFilters(withPrincipal("bob"), filters))
+
+	assert.Equal(t, filters, authorizer.RestrictFilters(withPrincipal("carol", "admins"), filters))
+	assert.Equal(t, filters, authorizer.RestrictFilters(withPrincipal("dave"), filters))
+
+	disabled, err := NewQueueAuthorizer(configuration.AuthConfig{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, filters, disabled.RestrictFilters(withPrincipal("bob"), filters))
+}
+
+func TestQueueAuthorizer_Authorize(t *testing.T) {
+	authorizer, err := NewQueueAuthorizer(testAuthConfig, &fakeGetJobQueueRepository{
+		jobQueues: map[string]string{"job-a": "queue-a", "job-b": "queue-b"},
+		runQueues: map[string]string{"run-a": "queue-a", "run-b": "queue-b"},
+	})
+	require.NoError(t, err)
+	ctx := withPrincipal("bob", "team")
+
+	assert.NoError(t, authorizer.AuthorizeQueue(ctx, "queue-a"))
+	assert.Error(t, authorizer.AuthorizeQueue(ctx, "queue-b"))
+	assert.NoError(t, authorizer.AuthorizeQueue(ctx, "queue-c"))
+	assert.NoError(t, authorizer.AuthorizeJob(ctx, "job-a"))
+	assert.Error(t, authorizer.AuthorizeJob(ctx, "job-b"))
+	assert.Error(t, authorizer.AuthorizeJob(ctx, "unknown"))
+	assert.NoError(t, authorizer.AuthorizeRun(ctx, "run-a"))
+	assert.Error(t, authorizer.AuthorizeRun(ctx, "run-b"))
+
+	admin := withPrincipal("carol", "admins")
+	assert.NoError(t, authorizer.AuthorizeQueue(admin, "queue-b"))
+	assert.NoError(t, authorizer.AuthorizeRun(admin, "run-b"))
+}
+
+func TestNewQueueAuthorizer_UnknownRole(t *testing.T) {
+	_, err := NewQueueAuthorizer(configuration.AuthConfig{
+		Enabled: true,
+		Authentication: authconfig.AuthConfig{
+			QueueRoleBindings: []authconfig.QueueRoleBinding{{Role: "owner", Queues: []string{"queue-a"}}},
+		},
+	}, nil)
+	assert.Error(t, err)
+}
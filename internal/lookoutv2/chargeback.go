@@ -0,0 +1,48 @@
+package lookoutv2
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+const (
+	secondsPerHour = 3600
+	bytesPerGiB    = 1 << 30
+	gpuResource    = "nvidia.com/gpu"
+)
+
+// Chargeback computes the spend of each queue per time bucket from the resources used by its job runs,
+// summing usage across clusters. Usage on each cluster is priced according to costModel,
+// using the pool of the cluster given by poolByCluster, or the name of the cluster if not listed.
+func Chargeback(usage []*model.QueueUsageBucket, costModel configuration.CostModelConfig, poolByCluster map[string]string) []*model.ChargebackBucket {
+	type bucketKey struct {
+		start int64
+		queue string
+	}
+	buckets := []*model.ChargebackBucket{}
+	bucketsByKey := make(map[bucketKey]*model.ChargebackBucket)
+	for _, u := range usage {
+		key := bucketKey{start: u.Start.UnixNano(), queue: u.Queue}
+		b, ok := bucketsByKey[key]
+		if !ok {
+			b = &model.ChargebackBucket{Start: u.Start, Queue: u.Queue}
+			bucketsByKey[key] = b
+			buckets = append(buckets, b)
+		}
+		pool, ok := poolByCluster[u.Cluster]
+		if !ok {
+			pool = u.Cluster
+		}
+		b.CpuHours += u.CpuSeconds / secondsPerHour
+		b.MemoryGibibyteHours += u.MemorySeconds / secondsPerHour / bytesPerGiB
+		b.EphemeralStorageGibibyteHours += u.EphemeralStorageSeconds / secondsPerHour / bytesPerGiB
+		b.GpuHours += u.GpuSeconds / secondsPerHour
+		b.Cost += (u.CpuSeconds*costModel.HourlyPrice(pool, v1.ResourceCPU.String()) +
+			u.MemorySeconds*costModel.HourlyPrice(pool, v1.ResourceMemory.String()) +
+			u.EphemeralStorageSeconds*costModel.HourlyPrice(pool, v1.ResourceEphemeralStorage.String()) +
+			u.GpuSeconds*costModel.HourlyPrice(pool, gpuResource)) / secondsPerHour
+	}
+	return buckets
+}
@@ -0,0 +1,56 @@
+package lookoutv2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+func TestChargeback(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	costModel := configuration.CostModelConfig{
+		HourlyPrices: map[string]float64{
+			"cpu":            0.04,
+			"memory":         0.005,
+			"nvidia.com/gpu": 2.5,
+		},
+		PriceUnits: map[string]resource.Quantity{
+			"memory": resource.MustParse("1Gi"),
+		},
+		PoolMultipliers: map[string]float64{
+			"spot": 0.5,
+		},
+	}
+	usage := []*model.QueueUsageBucket{
+		// 10 cpus and 20Gi of memory for an hour.
+		{Start: t0, Queue: "queue-1", Cluster: "cluster-1", CpuSeconds: 36000, MemorySeconds: 20 * 3600 * (1 << 30)},
+		// 1 gpu for half an hour on a spot cluster.
+		{Start: t0, Queue: "queue-1", Cluster: "spot-cluster", GpuSeconds: 1800},
+		{Start: t0, Queue: "queue-2", Cluster: "cluster-1", CpuSeconds: 3600},
+		{Start: t1, Queue: "queue-1", Cluster: "spot", CpuSeconds: 3600},
+	}
+
+	result := Chargeback(usage, costModel, map[string]string{"spot-cluster": "spot"})
+	require.Len(t, result, 3)
+
+	assert.Equal(t, t0, result[0].Start)
+	assert.Equal(t, "queue-1", result[0].Queue)
+	assert.InDelta(t, 10, result[0].CpuHours, 1e-9)
+	assert.InDelta(t, 20, result[0].MemoryGibibyteHours, 1e-9)
+	assert.InDelta(t, 0.5, result[0].GpuHours, 1e-9)
+	assert.InDelta(t, 0.4+0.1+0.625, result[0].Cost, 1e-9)
+
+	assert.Equal(t, "queue-2", result[1].Queue)
+	assert.InDelta(t, 0.04, result[1].Cost, 1e-9)
+
+	// Clusters not listed are assumed to be in the pool of the same name.
+	assert.Equal(t, t1, result[2].Start)
+	assert.InDelta(t, 0.02, result[2].Cost, 1e-9)
+}
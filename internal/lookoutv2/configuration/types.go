@@ -4,20 +4,51 @@ import (
 	"time"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	authconfig "github.com/armadaproject/armada/internal/common/auth/configuration"
+	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
 )
 
 type LookoutV2Config struct {
 	ApiPort int
 	// If non-nil, net/http/pprof endpoints are exposed on localhost on this port.
 	PprofPort *uint16
+	// Configuration of the diagnostics server, which exposes pprof and a goroutine dump.
+	Diagnostics profilingconfig.DiagnosticsConfig
 
 	CorsAllowedOrigins []string
 	Tls                TlsConfig
+	Auth               AuthConfig
 
 	Postgres configuration.PostgresConfig
+	// If non-empty, lookout runs in multi-region mode: jobs are read from each of these regional lookout databases
+	// and merged into a single view, with each job labelled by the name of the region it was read from.
+	// Postgres is then only used for migrations, by the pruner, and to store saved views;
+	// each regional database is expected to be migrated and pruned by the lookout of that region.
+	Regions []RegionConfig
 
 	PrunerConfig PrunerConfig
 
+	// Interval at which the jobs watched by clients of the watch endpoint are polled for updates.
+	WatchPollInterval time.Duration
+	// Maximum number of updated jobs sent to a client of the watch endpoint per poll.
+	WatchBatchSize int
+
+	// Keys of the annotations, without the user annotation prefix, whose values are matched by the search endpoint
+	// in addition to job id, job set, and owner.
+	SearchAnnotationKeys []string
+
+	Export ExportConfig
+
+	// Prices of resources, used to compute the spend of each queue returned by the chargeback endpoint.
+	CostModel configuration.CostModelConfig
+	// Pool of each cluster, used to apply CostModel.PoolMultipliers, since lookout records the cluster each job ran on
+	// rather than its pool. Clusters not listed are assumed to be in a pool with the same name as the cluster.
+	PoolByCluster map[string]string
+
+	// Paths of the zstd dictionaries the lookout ingester may have compressed job specs and errors with,
+	// i.e., its current dictionary and any previous ones still in use by jobs in the database.
+	CompressionDictionaryPaths []string
+
 	UIConfig
 }
 
@@ -27,12 +58,48 @@ type TlsConfig struct {
 	CertPath string
 }
 
+type AuthConfig struct {
+	// If false, requests aren't authenticated and the jobs of every queue are visible to everyone.
+	Enabled bool
+	// How requests are authenticated, and the permissions of authenticated users.
+	// Users with the view_all_jobs permission see the jobs of every queue.
+	Authentication authconfig.AuthConfig
+	// If true, requests without credentials are served as an anonymous user, who is part of the "everyone" group only,
+	// and who may read but not modify anything.
+	AnonymousReadOnly bool
+	// The users and groups allowed to see the jobs of each queue.
+	QueuePermissions []QueuePermissionsConfig
+}
+
+type QueuePermissionsConfig struct {
+	Queue  string
+	Users  []string
+	Groups []string
+}
+
+type RegionConfig struct {
+	// Name of the region, e.g., "eu-west". Must be unique.
+	Name     string
+	Postgres configuration.PostgresConfig
+}
+
 type PrunerConfig struct {
 	ExpireAfter time.Duration
 	Timeout     time.Duration
 	BatchSize   int
 }
 
+type ExportConfig struct {
+	// Number of jobs read from the database at a time while exporting.
+	ChunkSize int
+	// Maximum number of jobs in a single export. If zero, exports aren't limited.
+	MaxRows int
+	// Directory asynchronous exports are written to. If empty, asynchronous exports are disabled.
+	Directory string
+	// Time for which the result of an asynchronous export is retained after the export has finished.
+	RetainFor time.Duration
+}
+
 type UIConfig struct {
 	CustomTitle string
 
@@ -32,6 +32,7 @@ func ToSwaggerJob(job *model.Job) *models.Job {
 		Priority:           job.Priority,
 		PriorityClass:      job.PriorityClass,
 		Queue:              job.Queue,
+		Region:             job.Region,
 		Runs:               runs,
 		State:              job.State,
 		Submitted:          strfmt.DateTime(job.Submitted),
@@ -41,15 +42,97 @@ func ToSwaggerJob(job *model.Job) *models.Job {
 
 func ToSwaggerRun(run *model.Run) *models.Run {
 	return &models.Run{
-		Cluster:     run.Cluster,
-		ExitCode:    run.ExitCode,
-		Finished:    toSwaggerTimePtr(run.Finished),
-		JobRunState: run.JobRunState,
-		Node:        run.Node,
-		Leased:      toSwaggerTimePtr(run.Leased),
-		Pending:     toSwaggerTimePtr(run.Pending),
-		RunID:       run.RunId,
-		Started:     toSwaggerTimePtr(run.Started),
+		Cluster:            run.Cluster,
+		ExitCode:           run.ExitCode,
+		Finished:           toSwaggerTimePtr(run.Finished),
+		JobRunState:        run.JobRunState,
+		Node:               run.Node,
+		NodeLabels:         run.NodeLabels,
+		Leased:             toSwaggerTimePtr(run.Leased),
+		Pending:            toSwaggerTimePtr(run.Pending),
+		RunID:              run.RunId,
+		Started:            toSwaggerTimePtr(run.Started),
+		PreemptionReason:   run.PreemptionReason,
+		FailureCategory:    run.FailureCategory,
+		Artifacts:          toSwaggerArtifacts(run.Artifacts),
+		CPUUsagePeak:       run.CpuUsagePeak,
+		CPUUsageAverage:    run.CpuUsageAverage,
+		MemoryUsagePeak:    run.MemoryUsagePeak,
+		MemoryUsageAverage: run.MemoryUsageAverage,
+	}
+}
+
+func toSwaggerArtifacts(artifacts []model.Artifact) []*models.Artifact {
+	if artifacts == nil {
+		return nil
+	}
+	result := make([]*models.Artifact, len(artifacts))
+	for i, artifact := range artifacts {
+		result[i] = &models.Artifact{
+			Name:          artifact.Name,
+			ContainerName: artifact.ContainerName,
+			URL:           artifact.Url,
+			SizeBytes:     artifact.SizeBytes,
+		}
+	}
+	return result
+}
+
+func ToSwaggerSchedulingReport(report *model.JobSchedulingReport) *models.SchedulingReport {
+	numExcludedNodesByReason := make(map[string]int64, len(report.NumExcludedNodesByReason))
+	for reason, n := range report.NumExcludedNodesByReason {
+		numExcludedNodesByReason[reason] = int64(n)
+	}
+	return &models.SchedulingReport{
+		JobID:                    report.JobId,
+		NumExcludedNodesByReason: numExcludedNodesByReason,
+		NumNodes:                 int64(report.NumNodes),
+		Pool:                     report.Pool,
+		Reason:                   report.Reason,
+		Reported:                 strfmt.DateTime(report.Reported),
+	}
+}
+
+func ToSwaggerArrayTask(task *model.ArrayTask) *models.ArrayTask {
+	return &models.ArrayTask{
+		Index: task.Index,
+		JobID: task.JobId,
+		State: task.State,
+	}
+}
+
+func ToSwaggerSearchResult(result *model.SearchResult) *models.SearchResult {
+	return &models.SearchResult{
+		Job:          ToSwaggerJob(result.Job),
+		MatchedField: result.MatchedField,
+		Score:        result.Score,
+	}
+}
+
+func ToSwaggerJobStatsBucket(bucket *model.JobStatsBucket) *models.JobStatsBucket {
+	return &models.JobStatsBucket{
+		Start:            strfmt.DateTime(bucket.Start),
+		Group:            bucket.Group,
+		Submitted:        bucket.Submitted,
+		Running:          bucket.Running,
+		Succeeded:        bucket.Succeeded,
+		Failed:           bucket.Failed,
+		CPU:              bucket.Cpu,
+		Memory:           bucket.Memory,
+		EphemeralStorage: bucket.EphemeralStorage,
+		Gpu:              bucket.Gpu,
+	}
+}
+
+func ToSwaggerChargebackBucket(bucket *model.ChargebackBucket) *models.ChargebackBucket {
+	return &models.ChargebackBucket{
+		Start:                         strfmt.DateTime(bucket.Start),
+		Queue:                         bucket.Queue,
+		CPUHours:                      bucket.CpuHours,
+		MemoryGibibyteHours:           bucket.MemoryGibibyteHours,
+		EphemeralStorageGibibyteHours: bucket.EphemeralStorageGibibyteHours,
+		GpuHours:                      bucket.GpuHours,
+		Cost:                          bucket.Cost,
 	}
 }
 
@@ -61,6 +144,44 @@ func ToSwaggerGroup(group *model.JobGroup) *models.Group {
 	}
 }
 
+func ToSwaggerSavedView(view *model.SavedView) *models.SavedView {
+	swaggerView := &models.SavedView{
+		ActiveJobSets: view.ActiveJobSets,
+		Columns:       view.Columns,
+		Created:       strfmt.DateTime(view.Created),
+		Description:   view.Description,
+		Filters:       make([]*models.Filter, len(view.Filters)),
+		GroupedFields: view.GroupedFields,
+		Name:          view.Name,
+		Owner:         view.Owner,
+		Updated:       strfmt.DateTime(view.Updated),
+		ViewID:        view.ViewId,
+	}
+	for i, filter := range view.Filters {
+		swaggerView.Filters[i] = ToSwaggerFilter(filter)
+	}
+	if view.Order != nil {
+		swaggerView.Order = ToSwaggerOrder(view.Order)
+	}
+	return swaggerView
+}
+
+func ToSwaggerFilter(filter *model.Filter) *models.Filter {
+	return &models.Filter{
+		Field:        filter.Field,
+		Match:        filter.Match,
+		Value:        filter.Value,
+		IsAnnotation: filter.IsAnnotation,
+	}
+}
+
+func ToSwaggerOrder(order *model.Order) *models.Order {
+	return &models.Order{
+		Direction: order.Direction,
+		Field:     order.Field,
+	}
+}
+
 func ToSwaggerError(err string) *models.Error {
 	return &models.Error{
 		Error: err,
@@ -83,6 +204,26 @@ func FromSwaggerOrder(order *models.Order) *model.Order {
 	}
 }
 
+// FromSwaggerSavedView converts a view sent by a client, ignoring the fields assigned by the server.
+func FromSwaggerSavedView(view *models.SavedView) *model.SavedView {
+	result := &model.SavedView{
+		ViewId:        view.ViewID,
+		Name:          view.Name,
+		Description:   view.Description,
+		Filters:       make([]*model.Filter, len(view.Filters)),
+		ActiveJobSets: view.ActiveJobSets,
+		GroupedFields: view.GroupedFields,
+		Columns:       view.Columns,
+	}
+	for i, filter := range view.Filters {
+		result.Filters[i] = FromSwaggerFilter(filter)
+	}
+	if view.Order != nil {
+		result.Order = FromSwaggerOrder(view.Order)
+	}
+	return result
+}
+
 func FromSwaggerGroupedField(groupedField *operations.GroupJobsParamsBodyGroupedField) *model.GroupedField {
 	return &model.GroupedField{
 		Field:        groupedField.Field,
@@ -135,6 +135,34 @@ var (
 		Direction: "ASC",
 		Field:     "lastTransitionTime",
 	}
+
+	swaggerSavedView = &models.SavedView{
+		ActiveJobSets: true,
+		Columns:       []string{"jobId", "state"},
+		Created:       baseTimeSwagger,
+		Description:   "description",
+		Filters:       []*models.Filter{swaggerFilter},
+		GroupedFields: []string{"queue"},
+		Name:          "view",
+		Order:         swaggerOrder,
+		Owner:         "owner",
+		Updated:       baseTimeSwagger,
+		ViewID:        "view-id",
+	}
+
+	savedView = &model.SavedView{
+		ViewId:        "view-id",
+		Name:          "view",
+		Description:   "description",
+		Owner:         "owner",
+		Filters:       []*model.Filter{filter},
+		Order:         order,
+		ActiveJobSets: true,
+		GroupedFields: []string{"queue"},
+		Columns:       []string{"jobId", "state"},
+		Created:       baseTime,
+		Updated:       baseTime,
+	}
 )
 
 func TestToSwaggerJob(t *testing.T) {
@@ -162,3 +190,17 @@ func TestFromSwaggerOrder(t *testing.T) {
 	actual := FromSwaggerOrder(swaggerOrder)
 	assert.Equal(t, order, actual)
 }
+
+func TestToSwaggerSavedView(t *testing.T) {
+	actual := ToSwaggerSavedView(savedView)
+	assert.Equal(t, swaggerSavedView, actual)
+}
+
+func TestFromSwaggerSavedView(t *testing.T) {
+	actual := FromSwaggerSavedView(swaggerSavedView)
+	expected := *savedView
+	expected.Owner = ""
+	expected.Created = time.Time{}
+	expected.Updated = time.Time{}
+	assert.Equal(t, &expected, actual)
+}
@@ -0,0 +1,441 @@
+package lookoutv2
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	parquetWriter "github.com/xitongsys/parquet-go/writer"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/lookoutv2/configuration"
+	"github.com/armadaproject/armada/internal/lookoutv2/gen/models"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/internal/lookoutv2/repository"
+)
+
+// defaultExportChunkSize is the number of jobs read at a time if no chunk size is configured.
+const defaultExportChunkSize = 1000
+
+const (
+	ExportFormatCsv     = "csv"
+	ExportFormatParquet = "parquet"
+
+	ExportStateRunning   = "RUNNING"
+	ExportStateSucceeded = "SUCCEEDED"
+	ExportStateFailed    = "FAILED"
+)
+
+// ContentTypeForExportFormat returns the content type of exports in format.
+func ContentTypeForExportFormat(format string) string {
+	if format == ExportFormatParquet {
+		return "application/vnd.apache.parquet"
+	}
+	return "text/csv"
+}
+
+// exportRow is a job as exported, flattened such that it can be written as a row of a CSV or Parquet file.
+// Fields of the latest run of the job are included; annotations are exported as a JSON object.
+type exportRow struct {
+	JobId              string `parquet:"name=job_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Queue              string `parquet:"name=queue, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	JobSet             string `parquet:"name=job_set, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Owner              string `parquet:"name=owner, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Namespace          string `parquet:"name=namespace, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	State              string `parquet:"name=state, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Cpu                int64  `parquet:"name=cpu, type=INT64"`
+	Memory             int64  `parquet:"name=memory, type=INT64"`
+	EphemeralStorage   int64  `parquet:"name=ephemeral_storage, type=INT64"`
+	Gpu                int64  `parquet:"name=gpu, type=INT64"`
+	Priority           int64  `parquet:"name=priority, type=INT64"`
+	PriorityClass      string `parquet:"name=priority_class, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Submitted          int64  `parquet:"name=submitted, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	LastTransitionTime int64  `parquet:"name=last_transition_time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Cancelled          *int64 `parquet:"name=cancelled, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	CancelReason       string `parquet:"name=cancel_reason, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Duplicate          bool   `parquet:"name=duplicate, type=BOOLEAN"`
+	Region             string `parquet:"name=region, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Runs               int32  `parquet:"name=runs, type=INT32"`
+	LatestRunId        string `parquet:"name=latest_run_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Cluster            string `parquet:"name=cluster, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Node               string `parquet:"name=node, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	ExitCode           *int32 `parquet:"name=exit_code, type=INT32, repetitiontype=OPTIONAL"`
+	Annotations        string `parquet:"name=annotations, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+var exportCsvHeader = []string{
+	"job_id", "queue", "job_set", "owner", "namespace", "state", "cpu", "memory", "ephemeral_storage", "gpu",
+	"priority", "priority_class", "submitted", "last_transition_time", "cancelled", "cancel_reason", "duplicate",
+	"region", "runs", "latest_run_id", "cluster", "node", "exit_code", "annotations",
+}
+
+func toExportRow(job *model.Job) (*exportRow, error) {
+	annotations, err := json.Marshal(job.Annotations)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	row := &exportRow{
+		JobId:              job.JobId,
+		Queue:              job.Queue,
+		JobSet:             job.JobSet,
+		Owner:              job.Owner,
+		Namespace:          stringOrEmpty(job.Namespace),
+		State:              job.State,
+		Cpu:                job.Cpu,
+		Memory:             job.Memory,
+		EphemeralStorage:   job.EphemeralStorage,
+		Gpu:                job.Gpu,
+		Priority:           job.Priority,
+		PriorityClass:      stringOrEmpty(job.PriorityClass),
+		Submitted:          job.Submitted.UnixMilli(),
+		LastTransitionTime: job.LastTransitionTime.UnixMilli(),
+		CancelReason:       stringOrEmpty(job.CancelReason),
+		Duplicate:          job.Duplicate,
+		Region:             job.Region,
+		Runs:               int32(len(job.Runs)),
+		Annotations:        string(annotations),
+	}
+	if job.Cancelled != nil {
+		cancelled := job.Cancelled.UnixMilli()
+		row.Cancelled = &cancelled
+	}
+	if len(job.Runs) > 0 {
+		run := job.Runs[len(job.Runs)-1]
+		row.LatestRunId = run.RunId
+		row.Cluster = run.Cluster
+		row.Node = stringOrEmpty(run.Node)
+		row.ExitCode = run.ExitCode
+	}
+	return row, nil
+}
+
+func (r *exportRow) csvRecord() []string {
+	cancelled := ""
+	if r.Cancelled != nil {
+		cancelled = formatExportTime(*r.Cancelled)
+	}
+	exitCode := ""
+	if r.ExitCode != nil {
+		exitCode = strconv.Itoa(int(*r.ExitCode))
+	}
+	return []string{
+		r.JobId, r.Queue, r.JobSet, r.Owner, r.Namespace, r.State,
+		strconv.FormatInt(r.Cpu, 10), strconv.FormatInt(r.Memory, 10),
+		strconv.FormatInt(r.EphemeralStorage, 10), strconv.FormatInt(r.Gpu, 10),
+		strconv.FormatInt(r.Priority, 10), r.PriorityClass,
+		formatExportTime(r.Submitted), formatExportTime(r.LastTransitionTime), cancelled, r.CancelReason,
+		strconv.FormatBool(r.Duplicate), r.Region, strconv.Itoa(int(r.Runs)), r.LatestRunId, r.Cluster, r.Node,
+		exitCode, r.Annotations,
+	}
+}
+
+func formatExportTime(unixMilli int64) string {
+	return time.UnixMilli(unixMilli).UTC().Format(time.RFC3339Nano)
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// exportWriter writes rows to a CSV or Parquet file.
+type exportWriter interface {
+	Write(row *exportRow) error
+	// Close flushes any buffered rows. It doesn't close the underlying writer.
+	Close() error
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func newCsvExportWriter(w io.Writer) (*csvExportWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportCsvHeader); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &csvExportWriter{writer: writer}, nil
+}
+
+func (w *csvExportWriter) Write(row *exportRow) error {
+	return errors.WithStack(w.writer.Write(row.csvRecord()))
+}
+
+func (w *csvExportWriter) Close() error {
+	w.writer.Flush()
+	return errors.WithStack(w.writer.Error())
+}
+
+type parquetExportWriter struct {
+	writer *parquetWriter.ParquetWriter
+}
+
+func newParquetExportWriter(w io.Writer) (*parquetExportWriter, error) {
+	writer, err := parquetWriter.NewParquetWriterFromWriter(w, new(exportRow), 1)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &parquetExportWriter{writer: writer}, nil
+}
+
+func (w *parquetExportWriter) Write(row *exportRow) error {
+	return errors.WithStack(w.writer.Write(row))
+}
+
+func (w *parquetExportWriter) Close() error {
+	return errors.WithStack(w.writer.WriteStop())
+}
+
+func newExportWriter(w io.Writer, format string) (exportWriter, error) {
+	switch format {
+	case ExportFormatCsv:
+		return newCsvExportWriter(w)
+	case ExportFormatParquet:
+		return newParquetExportWriter(w)
+	default:
+		return nil, errors.Errorf("unknown export format %s, must be %s or %s", format, ExportFormatCsv, ExportFormatParquet)
+	}
+}
+
+// ExportRequest is the set of jobs to export and how to export them.
+type ExportRequest struct {
+	Filters       []*model.Filter
+	ActiveJobSets bool
+	Order         *model.Order
+	Format        string
+	// Maximum number of jobs to export. Bounded by the configured maximum; if zero, the configured maximum is used.
+	Limit int
+}
+
+// ExportStatus is the progress of an asynchronous export.
+type ExportStatus struct {
+	Id     string
+	Format string
+	State  string
+	// Number of jobs written so far.
+	Rows  int
+	Error string
+	// Name of the principal that started the export, which is the only one that may query or download it,
+	// as the export was restricted to the queues visible to that principal.
+	owner string
+	path  string
+	// Time at which the export finished, or zero if it's still running.
+	finished time.Time
+}
+
+// JobExporter exports the jobs matching a set of filters as CSV or Parquet.
+// Jobs are read in chunks, such that exports of many jobs don't require all of them to be held in memory.
+// Exports can either be streamed directly to the client, or run asynchronously, in which case the result is written
+// to a file in the export directory and retained for a configurable time after the export has finished.
+type JobExporter struct {
+	getJobsRepo repository.GetJobsRepository
+	config      configuration.ExportConfig
+
+	mu      sync.Mutex
+	exports map[string]*ExportStatus
+}
+
+func NewJobExporter(getJobsRepo repository.GetJobsRepository, config configuration.ExportConfig) *JobExporter {
+	if config.ChunkSize <= 0 {
+		config.ChunkSize = defaultExportChunkSize
+	}
+	return &JobExporter{
+		getJobsRepo: getJobsRepo,
+		config:      config,
+		exports:     make(map[string]*ExportStatus),
+	}
+}
+
+// Validate returns an error if request can't be exported.
+func (e *JobExporter) Validate(request *ExportRequest) error {
+	if request.Format != ExportFormatCsv && request.Format != ExportFormatParquet {
+		return errors.Errorf("unknown export format %s, must be %s or %s", request.Format, ExportFormatCsv, ExportFormatParquet)
+	}
+	if request.Limit < 0 {
+		return errors.Errorf("limit must not be negative, got %d", request.Limit)
+	}
+	return nil
+}
+
+// Export writes the jobs matching request to w. onRow, if non-nil, is called with the number of jobs written so far
+// after each chunk.
+func (e *JobExporter) Export(ctx *armadacontext.Context, request *ExportRequest, w io.Writer, onRow func(rows int)) error {
+	if err := e.Validate(request); err != nil {
+		return err
+	}
+	writer, err := newExportWriter(w, request.Format)
+	if err != nil {
+		return err
+	}
+	limit := e.config.MaxRows
+	if request.Limit > 0 && (limit <= 0 || request.Limit < limit) {
+		limit = request.Limit
+	}
+	order := request.Order
+	if order == nil || order.Field == "" {
+		// A total order is required for chunks not to overlap.
+		order = &model.Order{Field: "jobId", Direction: model.DirectionAsc}
+	}
+	rows := 0
+	for limit <= 0 || rows < limit {
+		take := e.config.ChunkSize
+		if limit > 0 && limit-rows < take {
+			take = limit - rows
+		}
+		result, err := e.getJobsRepo.GetJobs(ctx, request.Filters, request.ActiveJobSets, order, rows, take)
+		if err != nil {
+			return err
+		}
+		for _, job := range result.Jobs {
+			row, err := toExportRow(job)
+			if err != nil {
+				return err
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		rows += len(result.Jobs)
+		if onRow != nil {
+			onRow(rows)
+		}
+		if len(result.Jobs) < take {
+			break
+		}
+	}
+	return writer.Close()
+}
+
+// StartExport starts exporting the jobs matching request to a file in the export directory,
+// and returns the status of the export, by the id of which its progress can be queried.
+// The export isn't cancelled when ctx is, such that it can outlive the request that started it.
+func (e *JobExporter) StartExport(ctx *armadacontext.Context, request *ExportRequest) (*ExportStatus, error) {
+	if e.config.Directory == "" {
+		return nil, errors.New("asynchronous exports are disabled, since no export directory is configured")
+	}
+	if err := e.Validate(request); err != nil {
+		return nil, err
+	}
+	e.removeExpired()
+
+	if err := os.MkdirAll(e.config.Directory, 0o755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	id := uuid.NewString()
+	path := filepath.Join(e.config.Directory, id+"."+request.Format)
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	status := &ExportStatus{
+		Id:     id,
+		Format: request.Format,
+		State:  ExportStateRunning,
+		owner:  authorization.GetPrincipal(ctx).GetName(),
+		path:   path,
+	}
+	e.mu.Lock()
+	e.exports[id] = status
+	e.mu.Unlock()
+	started := e.copyStatus(status)
+
+	exportCtx := armadacontext.WithLogField(armadacontext.New(context.Background(), ctx.Logger), "exportId", id)
+	go func() {
+		err := e.Export(exportCtx, request, file, func(rows int) {
+			e.mu.Lock()
+			status.Rows = rows
+			e.mu.Unlock()
+		})
+		if closeErr := file.Close(); err == nil {
+			err = errors.WithStack(closeErr)
+		}
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		status.finished = time.Now()
+		if err != nil {
+			exportCtx.WithError(err).Warn("export failed")
+			status.State = ExportStateFailed
+			status.Error = err.Error()
+			_ = os.Remove(path)
+			return
+		}
+		status.State = ExportStateSucceeded
+	}()
+	return started, nil
+}
+
+// GetExport returns the status of the export with the provided id.
+func (e *JobExporter) GetExport(id string) (*ExportStatus, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	status, ok := e.exports[id]
+	if !ok {
+		return nil, errors.Errorf("export %s not found", id)
+	}
+	return e.copyStatus(status), nil
+}
+
+// OpenExport opens the result of the succeeded export with the provided id.
+func (e *JobExporter) OpenExport(id string) (io.ReadCloser, error) {
+	status, err := e.GetExport(id)
+	if err != nil {
+		return nil, err
+	}
+	if status.State != ExportStateSucceeded {
+		return nil, errors.Errorf("export %s is %s", id, status.State)
+	}
+	file, err := os.Open(status.path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return file, nil
+}
+
+// removeExpired removes the exports that finished longer than the retention period ago.
+func (e *JobExporter) removeExpired() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for id, status := range e.exports {
+		if !status.finished.IsZero() && time.Since(status.finished) > e.config.RetainFor {
+			_ = os.Remove(status.path)
+			delete(e.exports, id)
+		}
+	}
+}
+
+// AuthorizeExport returns an error if the principal in ctx didn't start the export with the provided status.
+func AuthorizeExport(ctx context.Context, status *ExportStatus) error {
+	if principal := authorization.GetPrincipal(ctx).GetName(); principal != status.owner {
+		return errors.Errorf("user %s is not allowed to access export %s", principal, status.Id)
+	}
+	return nil
+}
+
+func (e *JobExporter) copyStatus(status *ExportStatus) *ExportStatus {
+	statusCopy := *status
+	return &statusCopy
+}
+
+func toSwaggerExportStatus(status *ExportStatus) *models.ExportStatus {
+	swaggerStatus := &models.ExportStatus{
+		ExportID: status.Id,
+		Format:   status.Format,
+		Rows:     int64(status.Rows),
+		State:    status.State,
+	}
+	if status.Error != "" {
+		swaggerStatus.Error = &status.Error
+	}
+	return swaggerStatus
+}
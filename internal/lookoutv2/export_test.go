@@ -0,0 +1,196 @@
+package lookoutv2
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookoutv2/configuration"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/internal/lookoutv2/repository"
+)
+
+// fakeExportJobsRepository returns its jobs in order, recording the number of jobs requested by each call.
+type fakeExportJobsRepository struct {
+	jobs  []*model.Job
+	takes []int
+	err   error
+}
+
+func (r *fakeExportJobsRepository) GetJobs(_ *armadacontext.Context, _ []*model.Filter, _ bool, _ *model.Order, skip int, take int) (*repository.GetJobsResult, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.takes = append(r.takes, take)
+	end := skip + take
+	if end > len(r.jobs) {
+		end = len(r.jobs)
+	}
+	jobs := r.jobs[skip:end]
+	return &repository.GetJobsResult{Jobs: jobs, Count: len(jobs)}, nil
+}
+
+func makeExportJobs(n int) []*model.Job {
+	jobs := make([]*model.Job, n)
+	for i := range jobs {
+		node := "node-1"
+		exitCode := int32(i)
+		jobs[i] = &model.Job{
+			JobId:              fmt.Sprintf("job-%d", i),
+			Queue:              "queue",
+			JobSet:             "job-set",
+			Owner:              "owner",
+			State:              "SUCCEEDED",
+			Cpu:                1000,
+			Memory:             1024,
+			Annotations:        map[string]string{"a": "b"},
+			Submitted:          baseTime,
+			LastTransitionTime: baseTime.Add(time.Minute),
+			Runs: []*model.Run{
+				{RunId: "run-0", Cluster: "cluster"},
+				{RunId: fmt.Sprintf("run-%d", i), Cluster: "cluster", Node: &node, ExitCode: &exitCode},
+			},
+		}
+	}
+	return jobs
+}
+
+func readExportCsv(t *testing.T, b []byte) [][]string {
+	records, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	require.NoError(t, err)
+	require.NotEmpty(t, records)
+	assert.Equal(t, exportCsvHeader, records[0])
+	return records[1:]
+}
+
+func TestJobExporter_Csv(t *testing.T) {
+	repo := &fakeExportJobsRepository{jobs: makeExportJobs(5)}
+	exporter := NewJobExporter(repo, configuration.ExportConfig{ChunkSize: 2})
+
+	var out bytes.Buffer
+	var progress []int
+	err := exporter.Export(armadacontext.TODO(), &ExportRequest{Format: ExportFormatCsv}, &out, func(rows int) {
+		progress = append(progress, rows)
+	})
+	require.NoError(t, err)
+
+	records := readExportCsv(t, out.Bytes())
+	require.Len(t, records, 5)
+	assert.Equal(t, []string{
+		"job-3", "queue", "job-set", "owner", "", "SUCCEEDED", "1000", "1024", "0", "0", "0", "",
+		"2023-01-01T12:00:00Z", "2023-01-01T12:01:00Z", "", "", "false", "", "2", "run-3", "cluster", "node-1", "3",
+		`{"a":"b"}`,
+	}, records[3])
+	assert.Equal(t, []int{2, 2, 2}, repo.takes)
+	assert.Equal(t, []int{2, 4, 5}, progress)
+}
+
+func TestJobExporter_Limit(t *testing.T) {
+	repo := &fakeExportJobsRepository{jobs: makeExportJobs(10)}
+	exporter := NewJobExporter(repo, configuration.ExportConfig{ChunkSize: 4, MaxRows: 7})
+
+	var out bytes.Buffer
+	require.NoError(t, exporter.Export(armadacontext.TODO(), &ExportRequest{Format: ExportFormatCsv}, &out, nil))
+	assert.Len(t, readExportCsv(t, out.Bytes()), 7)
+	assert.Equal(t, []int{4, 3}, repo.takes)
+
+	// A limit in the request can only lower the configured maximum.
+	repo.takes = nil
+	out.Reset()
+	require.NoError(t, exporter.Export(armadacontext.TODO(), &ExportRequest{Format: ExportFormatCsv, Limit: 5}, &out, nil))
+	assert.Len(t, readExportCsv(t, out.Bytes()), 5)
+
+	out.Reset()
+	require.NoError(t, exporter.Export(armadacontext.TODO(), &ExportRequest{Format: ExportFormatCsv, Limit: 100}, &out, nil))
+	assert.Len(t, readExportCsv(t, out.Bytes()), 7)
+}
+
+func TestJobExporter_Parquet(t *testing.T) {
+	exporter := NewJobExporter(&fakeExportJobsRepository{jobs: makeExportJobs(3)}, configuration.ExportConfig{})
+
+	var out bytes.Buffer
+	require.NoError(t, exporter.Export(armadacontext.TODO(), &ExportRequest{Format: ExportFormatParquet}, &out, nil))
+	assert.True(t, bytes.HasPrefix(out.Bytes(), []byte("PAR1")))
+	assert.True(t, bytes.HasSuffix(out.Bytes(), []byte("PAR1")))
+}
+
+func TestJobExporter_InvalidFormat(t *testing.T) {
+	exporter := NewJobExporter(&fakeExportJobsRepository{}, configuration.ExportConfig{})
+	err := exporter.Export(armadacontext.TODO(), &ExportRequest{Format: "xlsx"}, io.Discard, nil)
+	assert.Error(t, err)
+}
+
+func TestJobExporter_Async(t *testing.T) {
+	exporter := NewJobExporter(
+		&fakeExportJobsRepository{jobs: makeExportJobs(3)},
+		configuration.ExportConfig{Directory: t.TempDir(), RetainFor: time.Hour},
+	)
+
+	status, err := exporter.StartExport(armadacontext.TODO(), &ExportRequest{Format: ExportFormatCsv})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		status, err = exporter.GetExport(status.Id)
+		require.NoError(t, err)
+		return status.State != ExportStateRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, ExportStateSucceeded, status.State)
+	assert.Equal(t, 3, status.Rows)
+
+	result, err := exporter.OpenExport(status.Id)
+	require.NoError(t, err)
+	defer result.Close()
+	b, err := io.ReadAll(result)
+	require.NoError(t, err)
+	assert.Len(t, readExportCsv(t, b), 3)
+
+	_, err = exporter.GetExport("unknown")
+	assert.Error(t, err)
+}
+
+func TestJobExporter_AsyncFailed(t *testing.T) {
+	exporter := NewJobExporter(
+		&fakeExportJobsRepository{err: errors.New("database unavailable")},
+		configuration.ExportConfig{Directory: t.TempDir()},
+	)
+
+	status, err := exporter.StartExport(armadacontext.TODO(), &ExportRequest{Format: ExportFormatCsv})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		status, err = exporter.GetExport(status.Id)
+		require.NoError(t, err)
+		return status.State != ExportStateRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, ExportStateFailed, status.State)
+	assert.Contains(t, status.Error, "database unavailable")
+	_, err = exporter.OpenExport(status.Id)
+	assert.Error(t, err)
+}
+
+func TestJobExporter_AsyncOnlyAccessibleToOwner(t *testing.T) {
+	exporter := NewJobExporter(
+		&fakeExportJobsRepository{jobs: makeExportJobs(3)},
+		configuration.ExportConfig{Directory: t.TempDir(), RetainFor: time.Hour},
+	)
+
+	status, err := exporter.StartExport(withPrincipal("alice"), &ExportRequest{Format: ExportFormatCsv})
+	require.NoError(t, err)
+	status, err = exporter.GetExport(status.Id)
+	require.NoError(t, err)
+	assert.NoError(t, AuthorizeExport(withPrincipal("alice"), status))
+	assert.Error(t, AuthorizeExport(withPrincipal("bob"), status))
+	assert.Error(t, AuthorizeExport(armadacontext.TODO(), status))
+}
+
+func TestJobExporter_AsyncDisabled(t *testing.T) {
+	exporter := NewJobExporter(&fakeExportJobsRepository{}, configuration.ExportConfig{})
+	_, err := exporter.StartExport(armadacontext.TODO(), &ExportRequest{Format: ExportFormatCsv})
+	assert.Error(t, err)
+}
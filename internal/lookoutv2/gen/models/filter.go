@@ -30,12 +30,11 @@ type Filter struct {
 
 	// match
 	// Required: true
-	// Enum: [exact anyOf startsWith contains greaterThan lessThan greaterThanOrEqualTo lessThanOrEqualTo exists]
+	// Enum: [exact anyOf startsWith prefix contains greaterThan lessThan greaterThanOrEqualTo lessThanOrEqualTo exists]
 	Match string `json:"match"`
 
-	// value
-	// Required: true
-	Value interface{} `json:"value"`
+	// Value to match. Not required for the exists match, which only checks that an annotation is set.
+	Value interface{} `json:"value,omitempty"`
 }
 
 // Validate validates this filter
@@ -50,10 +49,6 @@ func (m *Filter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateValue(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -77,7 +72,7 @@ var filterTypeMatchPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["exact","anyOf","startsWith","contains","greaterThan","lessThan","greaterThanOrEqualTo","lessThanOrEqualTo","exists"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["exact","anyOf","startsWith","prefix","contains","greaterThan","lessThan","greaterThanOrEqualTo","lessThanOrEqualTo","exists"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -96,6 +91,9 @@ const (
 	// FilterMatchStartsWith captures enum value "startsWith"
 	FilterMatchStartsWith string = "startsWith"
 
+	// FilterMatchPrefix captures enum value "prefix"
+	FilterMatchPrefix string = "prefix"
+
 	// FilterMatchContains captures enum value "contains"
 	FilterMatchContains string = "contains"
 
@@ -137,15 +135,6 @@ func (m *Filter) validateMatch(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *Filter) validateValue(formats strfmt.Registry) error {
-
-	if m.Value == nil {
-		return errors.Required("value", "body", nil)
-	}
-
-	return nil
-}
-
 // ContextValidate validates this filter based on context it is used
 func (m *Filter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
@@ -91,6 +91,9 @@ type Job struct {
 	// Min Length: 1
 	Queue string `json:"queue"`
 
+	// Name of the regional lookout database the job was read from. Empty unless lookout aggregates multiple regions.
+	Region string `json:"region,omitempty"`
+
 	// runs
 	// Required: true
 	Runs []*Run `json:"runs"`
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -20,14 +21,26 @@ import (
 // swagger:model run
 type Run struct {
 
+	// Objects uploaded to object storage once the run finished, e.g., container logs
+	Artifacts []*Artifact `json:"artifacts"`
+
 	// cluster
 	// Required: true
 	// Min Length: 1
 	Cluster string `json:"cluster"`
 
+	// Average CPU usage of the run in millicores, if reported by the executor
+	CPUUsageAverage *int64 `json:"cpuUsageAverage,omitempty"`
+
+	// Peak CPU usage of the run in millicores, if reported by the executor
+	CPUUsagePeak *int64 `json:"cpuUsagePeak,omitempty"`
+
 	// exit code
 	ExitCode *int32 `json:"exitCode,omitempty"`
 
+	// Category of the failure, e.g., OOMKilled or UserError. Only set for failed runs.
+	FailureCategory *string `json:"failureCategory,omitempty"`
+
 	// finished
 	// Format: date-time
 	Finished *strfmt.DateTime `json:"finished,omitempty"`
@@ -42,14 +55,26 @@ type Run struct {
 	// Format: date-time
 	Leased *strfmt.DateTime `json:"leased,omitempty"`
 
+	// Average memory usage of the run in bytes, if reported by the executor
+	MemoryUsageAverage *int64 `json:"memoryUsageAverage,omitempty"`
+
+	// Peak memory usage of the run in bytes, if reported by the executor
+	MemoryUsagePeak *int64 `json:"memoryUsagePeak,omitempty"`
+
 	// node
 	Node *string `json:"node,omitempty"`
 
+	// Snapshot of the tracked labels of the node the run was assigned to, e.g., GPU model and zone
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
 	// pending
 	// Min Length: 1
 	// Format: date-time
 	Pending *strfmt.DateTime `json:"pending,omitempty"`
 
+	// Why the run was preempted, e.g., by which job. Only set for preempted runs.
+	PreemptionReason *string `json:"preemptionReason,omitempty"`
+
 	// run Id
 	// Required: true
 	// Min Length: 1
@@ -64,6 +89,10 @@ type Run struct {
 func (m *Run) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateArtifacts(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCluster(formats); err != nil {
 		res = append(res, err)
 	}
@@ -98,6 +127,32 @@ func (m *Run) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Run) validateArtifacts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Artifacts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Artifacts); i++ {
+		if swag.IsZero(m.Artifacts[i]) { // not required
+			continue
+		}
+
+		if m.Artifacts[i] != nil {
+			if err := m.Artifacts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("artifacts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("artifacts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Run) validateCluster(formats strfmt.Registry) error {
 
 	if err := validate.RequiredString("cluster", "body", m.Cluster); err != nil {
@@ -250,8 +305,37 @@ func (m *Run) validateStarted(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this run based on context it is used
+// ContextValidate validate this run based on the context it is used
 func (m *Run) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateArtifacts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Run) contextValidateArtifacts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Artifacts); i++ {
+
+		if m.Artifacts[i] != nil {
+			if err := m.Artifacts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("artifacts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("artifacts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -26,6 +26,20 @@ func SetCorsAllowedOrigins(allowedOrigins []string) {
 	corsAllowedOrigins = allowedOrigins
 }
 
+var authMiddleware func(http.Handler) http.Handler
+
+// SetAuthMiddleware sets the middleware authenticating API requests. If not set, requests aren't authenticated.
+func SetAuthMiddleware(middleware func(http.Handler) http.Handler) {
+	authMiddleware = middleware
+}
+
+var graphqlHandler http.Handler
+
+// SetGraphqlHandler sets the handler serving the GraphQL API at /graphql, alongside the REST API.
+func SetGraphqlHandler(handler http.Handler) {
+	graphqlHandler = handler
+}
+
 func configureFlags(api *operations.LookoutAPI) {
 	// api.CommandLineOptionsGroups = []swag.CommandLineOptionsGroup{ ... }
 }
@@ -81,6 +95,9 @@ func configureServer(s *http.Server, scheme, addr string) {
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation.
 func setupMiddlewares(handler http.Handler) http.Handler {
+	if authMiddleware != nil {
+		return authMiddleware(handler)
+	}
 	return handler
 }
 
@@ -106,6 +123,9 @@ func uiHandler(apiHandler http.Handler) http.Handler {
 
 	mux.Handle("/api/", apiHandler)
 	mux.Handle("/health", apiHandler)
+	if graphqlHandler != nil {
+		mux.Handle("/graphql", setupMiddlewares(graphqlHandler))
+	}
 
 	return mux
 }
@@ -27,6 +27,162 @@ func init() {
     "version": "2.0.0"
   },
   "paths": {
+    "/api/v1/arrayJob": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getArrayJob",
+        "parameters": [
+          {
+            "name": "getArrayJobRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "arrayId"
+              ],
+              "properties": {
+                "arrayId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the status of each task of an array job",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "arrayId": {
+                  "type": "string",
+                  "x-nullable": false
+                },
+                "stateCounts": {
+                  "description": "Number of tasks in each state",
+                  "type": "object",
+                  "additionalProperties": {
+                    "type": "integer"
+                  }
+                },
+                "tasks": {
+                  "description": "Tasks of the array job, ordered by index",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/arrayTask"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/chargeback": {
+      "post": {
+        "description": "Returns the resources used by the job runs of each queue and their price according to the configured cost model, per queue and time bucket.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getChargeback",
+        "parameters": [
+          {
+            "name": "getChargebackRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "start",
+                "bucketSeconds"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "bucketSeconds": {
+                  "description": "Width of each time bucket in seconds.",
+                  "type": "integer",
+                  "minimum": 1
+                },
+                "end": {
+                  "description": "End of the time range. Defaults to the current time.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": true
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "start": {
+                  "description": "Start of the time range.",
+                  "type": "string",
+                  "format": "date-time"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the spend per queue and time bucket, ordered by bucket",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "buckets": {
+                  "description": "Spend per queue and time bucket. Buckets in which a queue had no running jobs are omitted.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/chargebackBucket"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/api/v1/jobGroups": {
       "post": {
         "consumes": [
@@ -201,6 +357,115 @@ func init() {
         }
       }
     },
+    "/api/v1/jobRuns": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobRuns",
+        "parameters": [
+          {
+            "name": "getJobRunsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "jobId"
+              ],
+              "properties": {
+                "jobId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the runs of a job, including those of retries, in the order they were leased",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "runs": {
+                  "description": "Runs of the job, in the order they were leased",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/run"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobSchedulingReport": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobSchedulingReport",
+        "parameters": [
+          {
+            "name": "getJobSchedulingReportRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "jobId"
+              ],
+              "properties": {
+                "jobId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns why the scheduler last failed to schedule the job, such that users can see why it's pending",
+            "schema": {
+              "$ref": "#/definitions/schedulingReport"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/api/v1/jobSpec": {
       "post": {
         "consumes": [
@@ -258,31 +523,45 @@ func init() {
         }
       }
     },
-    "/api/v1/jobs": {
+    "/api/v1/jobStats": {
       "post": {
+        "description": "Returns the number of jobs submitted, started running, succeeded, and failed, and the resources requested by the jobs submitted, per queue or job set and time bucket.",
         "consumes": [
           "application/json"
         ],
         "produces": [
           "application/json"
         ],
-        "operationId": "getJobs",
+        "operationId": "getJobStats",
         "parameters": [
           {
-            "name": "getJobsRequest",
+            "name": "getJobStatsRequest",
             "in": "body",
             "required": true,
             "schema": {
               "type": "object",
               "required": [
                 "filters",
-                "order"
+                "groupedField",
+                "start",
+                "bucketSeconds"
               ],
               "properties": {
                 "activeJobSets": {
                   "description": "Only include jobs in active job sets",
                   "type": "boolean"
                 },
+                "bucketSeconds": {
+                  "description": "Width of each time bucket in seconds.",
+                  "type": "integer",
+                  "minimum": 1
+                },
+                "end": {
+                  "description": "End of the time range. Defaults to the current time.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": true
+                },
                 "filters": {
                   "description": "Filters to apply to jobs.",
                   "type": "array",
@@ -291,18 +570,16 @@ func init() {
                   },
                   "x-nullable": true
                 },
-                "order": {
-                  "description": "Ordering to apply to jobs.",
-                  "x-nullable": true,
-                  "$ref": "#/definitions/order"
-                },
-                "skip": {
-                  "description": "First elements to ignore from the full set of results. Used for pagination.",
-                  "type": "integer"
+                "groupedField": {
+                  "description": "Field to compute statistics per, either queue or jobSet.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
                 },
-                "take": {
-                  "description": "Number of jobs to fetch.",
-                  "type": "integer"
+                "start": {
+                  "description": "Start of the time range.",
+                  "type": "string",
+                  "format": "date-time"
                 }
               }
             }
@@ -310,20 +587,15 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Returns jobs from API",
+            "description": "Returns statistics per group and time bucket, ordered by bucket",
             "schema": {
               "type": "object",
               "properties": {
-                "count": {
-                  "description": "Total number of jobs",
-                  "type": "integer",
-                  "x-nullable": false
-                },
-                "jobs": {
-                  "description": "List of jobs found",
+                "buckets": {
+                  "description": "Statistics per group and time bucket. Buckets without any activity are omitted.",
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/job"
+                    "$ref": "#/definitions/jobStatsBucket"
                   }
                 }
               }
@@ -344,332 +616,1323 @@ func init() {
         }
       }
     },
-    "/health": {
-      "get": {
+    "/api/v1/jobs": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
-          "text/plain"
+          "application/json"
+        ],
+        "operationId": "getJobs",
+        "parameters": [
+          {
+            "name": "getJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "order"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                },
+                "skip": {
+                  "description": "First elements to ignore from the full set of results. Used for pagination.",
+                  "type": "integer"
+                },
+                "take": {
+                  "description": "Number of jobs to fetch.",
+                  "type": "integer"
+                }
+              }
+            }
+          }
         ],
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "Returns jobs from API",
             "schema": {
-              "type": "string"
+              "type": "object",
+              "properties": {
+                "count": {
+                  "description": "Total number of jobs",
+                  "type": "integer",
+                  "x-nullable": false
+                },
+                "jobs": {
+                  "description": "List of jobs found",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/job"
+                  }
+                }
+              }
             }
           },
           "400": {
             "description": "Error response",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "error": {
-      "type": "object",
-      "required": [
-        "error"
-      ],
-      "properties": {
-        "error": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        }
-      }
     },
-    "filter": {
-      "type": "object",
-      "required": [
-        "field",
-        "value",
-        "match"
-      ],
-      "properties": {
-        "field": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "isAnnotation": {
-          "type": "boolean",
-          "x-nullable": false
-        },
-        "match": {
-          "type": "string",
-          "enum": [
-            "exact",
-            "anyOf",
-            "startsWith",
-            "contains",
-            "greaterThan",
-            "lessThan",
-            "greaterThanOrEqualTo",
-            "lessThanOrEqualTo",
-            "exists"
-          ],
-          "x-nullable": false
-        },
-        "value": {
-          "type": "object"
+    "/api/v1/jobs/export": {
+      "post": {
+        "description": "Exports the jobs matching filters as CSV or Parquet. Jobs are streamed as they are read, up to a limit. Large exports can instead be run asynchronously, in which case the status of the export is returned and its result can be requested once it has finished.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "text/csv",
+          "application/vnd.apache.parquet",
+          "application/json"
+        ],
+        "operationId": "exportJobs",
+        "parameters": [
+          {
+            "name": "exportJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "format"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "async": {
+                  "description": "Run the export asynchronously, instead of streaming its result.",
+                  "type": "boolean"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "format": {
+                  "description": "Format to export jobs in, either csv or parquet.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                },
+                "limit": {
+                  "description": "Maximum number of jobs to export. Bounded by, and defaults to, the maximum configured for the server.",
+                  "type": "integer"
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs. Defaults to ordering by job id.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The exported jobs",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "202": {
+            "description": "The export has been started",
+            "schema": {
+              "$ref": "#/definitions/exportStatus"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "group": {
-      "type": "object",
-      "required": [
-        "name",
-        "count",
-        "aggregates"
-      ],
-      "properties": {
-        "aggregates": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/api/v1/jobs/export/result": {
+      "post": {
+        "description": "Returns the result of an asynchronous export if it has succeeded, and otherwise its status.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "text/csv",
+          "application/vnd.apache.parquet",
+          "application/json"
+        ],
+        "operationId": "getJobExport",
+        "parameters": [
+          {
+            "name": "getJobExportRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "exportId"
+              ],
+              "properties": {
+                "exportId": {
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The exported jobs",
+            "schema": {
+              "type": "string"
+            }
           },
-          "x-nullable": false
-        },
-        "count": {
-          "type": "integer",
-          "x-nullable": false
-        },
-        "name": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
+          "202": {
+            "description": "The export is still running",
+            "schema": {
+              "$ref": "#/definitions/exportStatus"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "job": {
-      "type": "object",
-      "required": [
-        "jobId",
-        "queue",
-        "owner",
-        "jobSet",
-        "cpu",
-        "memory",
-        "ephemeralStorage",
-        "gpu",
-        "priority",
-        "submitted",
-        "state",
-        "lastTransitionTime",
-        "duplicate",
-        "annotations",
-        "runs"
-      ],
-      "properties": {
-        "annotations": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/api/v1/jobs/search": {
+      "post": {
+        "description": "Searches jobs by job id, job set, owner, and the annotations configured for search. Values starting with the query are ranked above values similar to it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "searchJobs",
+        "parameters": [
+          {
+            "name": "searchJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "query"
+              ],
+              "properties": {
+                "query": {
+                  "description": "Text to search for.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                },
+                "take": {
+                  "description": "Maximum number of jobs to return.",
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the jobs matching the query, best matches first",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "results": {
+                  "description": "Jobs matching the query, best matches first",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/searchResult"
+                  }
+                }
+              }
+            }
           },
-          "x-nullable": false
-        },
-        "cancelReason": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "cancelled": {
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
-        },
-        "cpu": {
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": false
-        },
-        "duplicate": {
-          "type": "boolean",
-          "x-nullable": false
-        },
-        "ephemeralStorage": {
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": false
-        },
-        "gpu": {
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": false
-        },
-        "jobId": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "jobSet": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "lastActiveRunId": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "lastTransitionTime": {
-          "type": "string",
-          "format": "date-time",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "memory": {
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": false
-        },
-        "namespace": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "owner": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "priority": {
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": false
-        },
-        "priorityClass": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "queue": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "runs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/run"
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-nullable": false
-        },
-        "state": {
-          "type": "string",
-          "enum": [
-            "QUEUED",
-            "PENDING",
-            "RUNNING",
-            "SUCCEEDED",
-            "FAILED",
-            "CANCELLED",
-            "PREEMPTED",
-            "LEASED"
-          ],
-          "x-nullable": false
-        },
-        "submitted": {
-          "type": "string",
-          "format": "date-time",
-          "minLength": 1,
-          "x-nullable": false
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "order": {
-      "type": "object",
-      "required": [
-        "field",
-        "direction"
-      ],
-      "properties": {
-        "direction": {
-          "type": "string",
-          "enum": [
-            "ASC",
-            "DESC"
-          ],
-          "x-nullable": false
-        },
-        "field": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
+    "/api/v1/jobs/watch": {
+      "post": {
+        "description": "Streams updates of the jobs matching filters as server-sent events, as the jobs transition between states. Each event contains the updated jobs, and has as id the time from which updates can be requested again to resume watching.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "text/event-stream"
+        ],
+        "operationId": "watchJobs",
+        "parameters": [
+          {
+            "name": "watchJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "since": {
+                  "description": "Only send jobs that transitioned at or after this time. Defaults to the current time.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": true
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of server-sent events, with the updated jobs as data",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "run": {
-      "type": "object",
-      "required": [
-        "runId",
-        "cluster",
-        "jobRunState"
-      ],
-      "properties": {
-        "cluster": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "exitCode": {
+    "/api/v1/savedViews": {
+      "post": {
+        "description": "Lists saved views, most recently updated first.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "listSavedViews",
+        "parameters": [
+          {
+            "name": "listSavedViewsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "owner": {
+                  "description": "Only list the views saved by this user.",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved views",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "views": {
+                  "description": "Saved views, most recently updated first",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/savedView"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/create": {
+      "post": {
+        "description": "Saves a view, owned by the user saving it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "createSavedView",
+        "parameters": [
+          {
+            "name": "createSavedViewRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved view, including its id",
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/delete": {
+      "post": {
+        "description": "Deletes a saved view. Only its owner may delete it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "deleteSavedView",
+        "parameters": [
+          {
+            "name": "deleteSavedViewRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "viewId"
+              ],
+              "properties": {
+                "viewId": {
+                  "description": "Id of the view.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The view was deleted"
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/get": {
+      "post": {
+        "description": "Gets a saved view by id, e.g., to open a link to it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getSavedView",
+        "parameters": [
+          {
+            "name": "getSavedViewRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "viewId"
+              ],
+              "properties": {
+                "viewId": {
+                  "description": "Id of the view.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved view",
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/update": {
+      "post": {
+        "description": "Replaces a saved view. Only its owner may update it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "updateSavedView",
+        "parameters": [
+          {
+            "name": "updateSavedViewRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the updated view",
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "produces": [
+          "text/plain"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "arrayTask": {
+      "type": "object",
+      "properties": {
+        "index": {
           "type": "integer",
-          "format": "int32",
-          "x-nullable": true
+          "x-nullable": false
         },
-        "finished": {
+        "jobId": {
           "type": "string",
-          "format": "date-time",
-          "x-nullable": true
+          "x-nullable": false
         },
-        "jobRunState": {
+        "state": {
           "type": "string",
-          "enum": [
-            "RUN_PENDING",
-            "RUN_RUNNING",
-            "RUN_SUCCEEDED",
-            "RUN_FAILED",
-            "RUN_TERMINATED",
-            "RUN_PREEMPTED",
-            "RUN_UNABLE_TO_SCHEDULE",
-            "RUN_LEASE_RETURNED",
-            "RUN_LEASE_EXPIRED",
-            "RUN_MAX_RUNS_EXCEEDED",
-            "RUN_LEASED"
-          ],
           "x-nullable": false
+        }
+      }
+    },
+    "artifact": {
+      "description": "Object uploaded to object storage once a run finished",
+      "type": "object",
+      "properties": {
+        "containerName": {
+          "description": "Name of the container the artifact was collected from",
+          "type": "string"
         },
-        "leased": {
-          "type": "string",
+        "name": {
+          "description": "Name of the artifact, e.g., log",
+          "type": "string"
+        },
+        "sizeBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "url": {
+          "description": "URL of the uploaded object",
+          "type": "string"
+        }
+      }
+    },
+    "chargebackBucket": {
+      "type": "object",
+      "required": [
+        "start",
+        "queue",
+        "cpuHours",
+        "memoryGibibyteHours",
+        "ephemeralStorageGibibyteHours",
+        "gpuHours",
+        "cost"
+      ],
+      "properties": {
+        "cost": {
+          "description": "Price of the resources used by the job runs of the queue in the bucket",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "cpuHours": {
+          "description": "Cpu used by the job runs of the queue in the bucket, in cpu-hours",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "ephemeralStorageGibibyteHours": {
+          "description": "Ephemeral storage used by the job runs of the queue in the bucket, in GiB-hours",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "gpuHours": {
+          "description": "Gpus used by the job runs of the queue in the bucket, in gpu-hours",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "memoryGibibyteHours": {
+          "description": "Memory used by the job runs of the queue in the bucket, in GiB-hours",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "queue": {
+          "description": "Queue the spend is for",
+          "type": "string",
+          "x-nullable": false
+        },
+        "start": {
+          "description": "Start of the time bucket",
+          "type": "string",
           "format": "date-time",
+          "x-nullable": false
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "error"
+      ],
+      "properties": {
+        "error": {
+          "type": "string",
           "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "exportStatus": {
+      "type": "object",
+      "required": [
+        "exportId",
+        "format",
+        "state",
+        "rows"
+      ],
+      "properties": {
+        "error": {
+          "description": "Reason the export failed, if it failed",
+          "type": "string",
           "x-nullable": true
         },
-        "node": {
+        "exportId": {
+          "description": "Id of the export, by which its result can be requested",
+          "type": "string",
+          "x-nullable": false
+        },
+        "format": {
+          "description": "Format of the export, either csv or parquet",
+          "type": "string",
+          "x-nullable": false
+        },
+        "rows": {
+          "description": "Number of jobs exported so far",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "state": {
+          "description": "State of the export, one of RUNNING, SUCCEEDED, or FAILED",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "filter": {
+      "type": "object",
+      "required": [
+        "field",
+        "match"
+      ],
+      "properties": {
+        "field": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "isAnnotation": {
+          "type": "boolean",
+          "x-nullable": false
+        },
+        "match": {
+          "type": "string",
+          "enum": [
+            "exact",
+            "anyOf",
+            "startsWith",
+            "prefix",
+            "contains",
+            "greaterThan",
+            "lessThan",
+            "greaterThanOrEqualTo",
+            "lessThanOrEqualTo",
+            "exists"
+          ],
+          "x-nullable": false
+        },
+        "value": {
+          "description": "Value to match. Not required for the exists match, which only checks that an annotation is set.",
+          "type": "object"
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "required": [
+        "name",
+        "count",
+        "aggregates"
+      ],
+      "properties": {
+        "aggregates": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          },
+          "x-nullable": false
+        },
+        "count": {
+          "type": "integer",
+          "x-nullable": false
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "job": {
+      "type": "object",
+      "required": [
+        "jobId",
+        "queue",
+        "owner",
+        "jobSet",
+        "cpu",
+        "memory",
+        "ephemeralStorage",
+        "gpu",
+        "priority",
+        "submitted",
+        "state",
+        "lastTransitionTime",
+        "duplicate",
+        "annotations",
+        "runs"
+      ],
+      "properties": {
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "x-nullable": false
+        },
+        "cancelReason": {
           "type": "string",
           "x-nullable": true
         },
-        "pending": {
+        "cancelled": {
           "type": "string",
           "format": "date-time",
-          "minLength": 1,
           "x-nullable": true
         },
-        "runId": {
+        "cpu": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "duplicate": {
+          "type": "boolean",
+          "x-nullable": false
+        },
+        "ephemeralStorage": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "gpu": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "jobId": {
           "type": "string",
           "minLength": 1,
           "x-nullable": false
         },
-        "started": {
+        "jobSet": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "lastActiveRunId": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "lastTransitionTime": {
           "type": "string",
           "format": "date-time",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "memory": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "namespace": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "owner": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "priorityClass": {
+          "type": "string",
           "x-nullable": true
+        },
+        "queue": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "region": {
+          "description": "Name of the regional lookout database the job was read from. Empty unless lookout aggregates multiple regions.",
+          "type": "string",
+          "x-nullable": false
+        },
+        "runs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/run"
+          },
+          "x-nullable": false
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "QUEUED",
+            "PENDING",
+            "RUNNING",
+            "SUCCEEDED",
+            "FAILED",
+            "CANCELLED",
+            "PREEMPTED",
+            "LEASED"
+          ],
+          "x-nullable": false
+        },
+        "submitted": {
+          "type": "string",
+          "format": "date-time",
+          "minLength": 1,
+          "x-nullable": false
         }
       }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
+    },
+    "jobStatsBucket": {
+      "type": "object",
+      "required": [
+        "start",
+        "group",
+        "submitted",
+        "running",
+        "succeeded",
+        "failed",
+        "cpu",
+        "memory",
+        "ephemeralStorage",
+        "gpu"
+      ],
+      "properties": {
+        "cpu": {
+          "description": "Total cpu requested by the jobs submitted in the bucket, in millicpu",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "ephemeralStorage": {
+          "description": "Total ephemeral storage requested by the jobs submitted in the bucket, in bytes",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "failed": {
+          "description": "Number of jobs that failed in the bucket",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "gpu": {
+          "description": "Total gpus requested by the jobs submitted in the bucket",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "group": {
+          "description": "Queue or job set the statistics are for",
+          "type": "string",
+          "x-nullable": false
+        },
+        "memory": {
+          "description": "Total memory requested by the jobs submitted in the bucket, in bytes",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "running": {
+          "description": "Number of job runs that started running in the bucket",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "start": {
+          "description": "Start of the time bucket",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        },
+        "submitted": {
+          "description": "Number of jobs submitted in the bucket",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "succeeded": {
+          "description": "Number of jobs that succeeded in the bucket",
+          "type": "integer",
+          "x-nullable": false
+        }
+      }
+    },
+    "order": {
+      "type": "object",
+      "required": [
+        "field",
+        "direction"
+      ],
+      "properties": {
+        "direction": {
+          "type": "string",
+          "enum": [
+            "ASC",
+            "DESC"
+          ],
+          "x-nullable": false
+        },
+        "field": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "run": {
+      "type": "object",
+      "required": [
+        "runId",
+        "cluster",
+        "jobRunState"
+      ],
+      "properties": {
+        "artifacts": {
+          "description": "Objects uploaded to object storage once the run finished, e.g., container logs",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/artifact"
+          }
+        },
+        "cluster": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "cpuUsageAverage": {
+          "description": "Average CPU usage of the run in millicores, if reported by the executor",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "cpuUsagePeak": {
+          "description": "Peak CPU usage of the run in millicores, if reported by the executor",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "exitCode": {
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true
+        },
+        "failureCategory": {
+          "description": "Category of the failure, e.g., OOMKilled or UserError. Only set for failed runs.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "finished": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "jobRunState": {
+          "type": "string",
+          "enum": [
+            "RUN_PENDING",
+            "RUN_RUNNING",
+            "RUN_SUCCEEDED",
+            "RUN_FAILED",
+            "RUN_TERMINATED",
+            "RUN_PREEMPTED",
+            "RUN_UNABLE_TO_SCHEDULE",
+            "RUN_LEASE_RETURNED",
+            "RUN_LEASE_EXPIRED",
+            "RUN_MAX_RUNS_EXCEEDED",
+            "RUN_LEASED"
+          ],
+          "x-nullable": false
+        },
+        "leased": {
+          "type": "string",
+          "format": "date-time",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "memoryUsageAverage": {
+          "description": "Average memory usage of the run in bytes, if reported by the executor",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "memoryUsagePeak": {
+          "description": "Peak memory usage of the run in bytes, if reported by the executor",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "node": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "nodeLabels": {
+          "description": "Snapshot of the tracked labels of the node the run was assigned to, e.g., GPU model and zone",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "pending": {
+          "type": "string",
+          "format": "date-time",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "preemptionReason": {
+          "description": "Why the run was preempted, e.g., by which job. Only set for preempted runs.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "runId": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "started": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "savedView": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "activeJobSets": {
+          "description": "Only include jobs in active job sets",
+          "type": "boolean",
+          "x-nullable": false
+        },
+        "columns": {
+          "description": "Columns shown in the view, in order",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "created": {
+          "description": "Time the view was created. Assigned by the server.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "filters": {
+          "description": "Filters applied to jobs in the view",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/filter"
+          }
+        },
+        "groupedFields": {
+          "description": "Fields or annotation keys the jobs in the view are grouped by, outermost first",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the view",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "order": {
+          "description": "Ordering applied to jobs in the view",
+          "x-nullable": true,
+          "$ref": "#/definitions/order"
+        },
+        "owner": {
+          "description": "User who saved the view. Assigned by the server.",
+          "type": "string",
+          "x-nullable": false
+        },
+        "updated": {
+          "description": "Time the view was last updated. Assigned by the server.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        },
+        "viewId": {
+          "description": "Id of the view, by which it can be linked to. Assigned by the server.",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "schedulingReport": {
+      "description": "Most recent reason the scheduler gave for not scheduling a queued job",
+      "type": "object",
+      "properties": {
+        "jobId": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "numExcludedNodesByReason": {
+          "description": "Number of nodes the job could not be scheduled on, by the reason why",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        },
+        "numNodes": {
+          "description": "Number of nodes considered for the job; zero if the job was rejected before considering any nodes",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "pool": {
+          "description": "Pool in which the scheduler last tried to schedule the job",
+          "type": "string",
+          "x-nullable": false
+        },
+        "reason": {
+          "description": "Why the job could not be scheduled",
+          "type": "string",
+          "x-nullable": false
+        },
+        "reported": {
+          "description": "Time the reason was reported. The job may have been scheduled since.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        }
+      }
+    },
+    "searchResult": {
+      "type": "object",
+      "properties": {
+        "job": {
+          "$ref": "#/definitions/job"
+        },
+        "matchedField": {
+          "description": "Field the query matched best, i.e., jobId, jobSet, owner, or the key of an annotation",
+          "type": "string",
+          "x-nullable": false
+        },
+        "score": {
+          "description": "Relevance of the job to the query. Higher is more relevant.",
+          "type": "number",
+          "x-nullable": false
+        }
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http"
   ],
   "swagger": "2.0",
   "info": {
@@ -677,6 +1940,162 @@ func init() {
     "version": "2.0.0"
   },
   "paths": {
+    "/api/v1/arrayJob": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getArrayJob",
+        "parameters": [
+          {
+            "name": "getArrayJobRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "arrayId"
+              ],
+              "properties": {
+                "arrayId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the status of each task of an array job",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "arrayId": {
+                  "type": "string",
+                  "x-nullable": false
+                },
+                "stateCounts": {
+                  "description": "Number of tasks in each state",
+                  "type": "object",
+                  "additionalProperties": {
+                    "type": "integer"
+                  }
+                },
+                "tasks": {
+                  "description": "Tasks of the array job, ordered by index",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/arrayTask"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/chargeback": {
+      "post": {
+        "description": "Returns the resources used by the job runs of each queue and their price according to the configured cost model, per queue and time bucket.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getChargeback",
+        "parameters": [
+          {
+            "name": "getChargebackRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "start",
+                "bucketSeconds"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "bucketSeconds": {
+                  "description": "Width of each time bucket in seconds.",
+                  "type": "integer",
+                  "minimum": 1
+                },
+                "end": {
+                  "description": "End of the time range. Defaults to the current time.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": true
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "start": {
+                  "description": "Start of the time range.",
+                  "type": "string",
+                  "format": "date-time"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the spend per queue and time bucket, ordered by bucket",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "buckets": {
+                  "description": "Spend per queue and time bucket. Buckets in which a queue had no running jobs are omitted.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/chargebackBucket"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/api/v1/jobGroups": {
       "post": {
         "consumes": [
@@ -685,70 +2104,693 @@ func init() {
         "produces": [
           "application/json"
         ],
-        "operationId": "groupJobs",
+        "operationId": "groupJobs",
+        "parameters": [
+          {
+            "name": "groupJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "order",
+                "groupedField",
+                "aggregates"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "aggregates": {
+                  "description": "Additional fields to compute aggregates on",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "x-nullable": false
+                  },
+                  "x-nullable": true
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs before grouping.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "groupedField": {
+                  "type": "object",
+                  "required": [
+                    "field"
+                  ],
+                  "properties": {
+                    "field": {
+                      "description": "Field or annotation key to group by",
+                      "type": "string",
+                      "x-nullable": false
+                    },
+                    "isAnnotation": {
+                      "type": "boolean",
+                      "x-nullable": false
+                    }
+                  }
+                },
+                "order": {
+                  "description": "Ordering to apply to job groups.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                },
+                "skip": {
+                  "description": "First elements to ignore from the full set of results. Used for pagination.",
+                  "type": "integer"
+                },
+                "take": {
+                  "description": "Number of job groups to fetch.",
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns job groups from API",
+            "schema": {
+              "type": "object",
+              "required": [
+                "groups"
+              ],
+              "properties": {
+                "count": {
+                  "description": "Total number of groups",
+                  "type": "integer",
+                  "x-nullable": false
+                },
+                "groups": {
+                  "description": "List of Job groups",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/group"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobRunError": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobRunError",
+        "parameters": [
+          {
+            "name": "getJobRunErrorRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "runId"
+              ],
+              "properties": {
+                "runId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns error for specific job run (if present)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "errorString": {
+                  "description": "Error for individual job run",
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobRuns": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobRuns",
+        "parameters": [
+          {
+            "name": "getJobRunsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "jobId"
+              ],
+              "properties": {
+                "jobId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the runs of a job, including those of retries, in the order they were leased",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "runs": {
+                  "description": "Runs of the job, in the order they were leased",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/run"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobSchedulingReport": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobSchedulingReport",
+        "parameters": [
+          {
+            "name": "getJobSchedulingReportRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "jobId"
+              ],
+              "properties": {
+                "jobId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns why the scheduler last failed to schedule the job, such that users can see why it's pending",
+            "schema": {
+              "$ref": "#/definitions/schedulingReport"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobSpec": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobSpec",
+        "parameters": [
+          {
+            "name": "getJobSpecRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "jobId"
+              ],
+              "properties": {
+                "jobId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns raw Job spec",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "job": {
+                  "description": "Job Spec object",
+                  "type": "object",
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobStats": {
+      "post": {
+        "description": "Returns the number of jobs submitted, started running, succeeded, and failed, and the resources requested by the jobs submitted, per queue or job set and time bucket.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobStats",
+        "parameters": [
+          {
+            "name": "getJobStatsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "groupedField",
+                "start",
+                "bucketSeconds"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "bucketSeconds": {
+                  "description": "Width of each time bucket in seconds.",
+                  "type": "integer",
+                  "minimum": 1
+                },
+                "end": {
+                  "description": "End of the time range. Defaults to the current time.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": true
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "groupedField": {
+                  "description": "Field to compute statistics per, either queue or jobSet.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                },
+                "start": {
+                  "description": "Start of the time range.",
+                  "type": "string",
+                  "format": "date-time"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns statistics per group and time bucket, ordered by bucket",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "buckets": {
+                  "description": "Statistics per group and time bucket. Buckets without any activity are omitted.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/jobStatsBucket"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobs": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobs",
+        "parameters": [
+          {
+            "name": "getJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "order"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                },
+                "skip": {
+                  "description": "First elements to ignore from the full set of results. Used for pagination.",
+                  "type": "integer"
+                },
+                "take": {
+                  "description": "Number of jobs to fetch.",
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns jobs from API",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "count": {
+                  "description": "Total number of jobs",
+                  "type": "integer",
+                  "x-nullable": false
+                },
+                "jobs": {
+                  "description": "List of jobs found",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/job"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobs/export": {
+      "post": {
+        "description": "Exports the jobs matching filters as CSV or Parquet. Jobs are streamed as they are read, up to a limit. Large exports can instead be run asynchronously, in which case the status of the export is returned and its result can be requested once it has finished.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "text/csv",
+          "application/vnd.apache.parquet",
+          "application/json"
+        ],
+        "operationId": "exportJobs",
+        "parameters": [
+          {
+            "name": "exportJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "format"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "async": {
+                  "description": "Run the export asynchronously, instead of streaming its result.",
+                  "type": "boolean"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "format": {
+                  "description": "Format to export jobs in, either csv or parquet.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                },
+                "limit": {
+                  "description": "Maximum number of jobs to export. Bounded by, and defaults to, the maximum configured for the server.",
+                  "type": "integer"
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs. Defaults to ordering by job id.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The exported jobs",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "202": {
+            "description": "The export has been started",
+            "schema": {
+              "$ref": "#/definitions/exportStatus"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobs/export/result": {
+      "post": {
+        "description": "Returns the result of an asynchronous export if it has succeeded, and otherwise its status.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "text/csv",
+          "application/vnd.apache.parquet",
+          "application/json"
+        ],
+        "operationId": "getJobExport",
+        "parameters": [
+          {
+            "name": "getJobExportRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "exportId"
+              ],
+              "properties": {
+                "exportId": {
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The exported jobs",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "202": {
+            "description": "The export is still running",
+            "schema": {
+              "$ref": "#/definitions/exportStatus"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobs/search": {
+      "post": {
+        "description": "Searches jobs by job id, job set, owner, and the annotations configured for search. Values starting with the query are ranked above values similar to it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "searchJobs",
         "parameters": [
           {
-            "name": "groupJobsRequest",
+            "name": "searchJobsRequest",
             "in": "body",
             "required": true,
             "schema": {
               "type": "object",
               "required": [
-                "filters",
-                "order",
-                "groupedField",
-                "aggregates"
+                "query"
               ],
               "properties": {
-                "activeJobSets": {
-                  "description": "Only include jobs in active job sets",
-                  "type": "boolean"
-                },
-                "aggregates": {
-                  "description": "Additional fields to compute aggregates on",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "x-nullable": false
-                  },
-                  "x-nullable": true
-                },
-                "filters": {
-                  "description": "Filters to apply to jobs before grouping.",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/filter"
-                  },
-                  "x-nullable": true
-                },
-                "groupedField": {
-                  "type": "object",
-                  "required": [
-                    "field"
-                  ],
-                  "properties": {
-                    "field": {
-                      "description": "Field or annotation key to group by",
-                      "type": "string",
-                      "x-nullable": false
-                    },
-                    "isAnnotation": {
-                      "type": "boolean",
-                      "x-nullable": false
-                    }
-                  }
-                },
-                "order": {
-                  "description": "Ordering to apply to job groups.",
-                  "x-nullable": true,
-                  "$ref": "#/definitions/order"
-                },
-                "skip": {
-                  "description": "First elements to ignore from the full set of results. Used for pagination.",
-                  "type": "integer"
+                "query": {
+                  "description": "Text to search for.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
                 },
                 "take": {
-                  "description": "Number of job groups to fetch.",
+                  "description": "Maximum number of jobs to return.",
                   "type": "integer"
                 }
               }
@@ -757,23 +2799,15 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Returns job groups from API",
+            "description": "Returns the jobs matching the query, best matches first",
             "schema": {
               "type": "object",
-              "required": [
-                "groups"
-              ],
               "properties": {
-                "count": {
-                  "description": "Total number of groups",
-                  "type": "integer",
-                  "x-nullable": false
-                },
-                "groups": {
-                  "description": "List of Job groups",
+                "results": {
+                  "description": "Jobs matching the query, best matches first",
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/group"
+                    "$ref": "#/definitions/searchResult"
                   }
                 }
               }
@@ -794,29 +2828,44 @@ func init() {
         }
       }
     },
-    "/api/v1/jobRunError": {
+    "/api/v1/jobs/watch": {
       "post": {
+        "description": "Streams updates of the jobs matching filters as server-sent events, as the jobs transition between states. Each event contains the updated jobs, and has as id the time from which updates can be requested again to resume watching.",
         "consumes": [
           "application/json"
         ],
         "produces": [
-          "application/json"
+          "text/event-stream"
         ],
-        "operationId": "getJobRunError",
+        "operationId": "watchJobs",
         "parameters": [
           {
-            "name": "getJobRunErrorRequest",
+            "name": "watchJobsRequest",
             "in": "body",
             "required": true,
             "schema": {
               "type": "object",
               "required": [
-                "runId"
+                "filters"
               ],
               "properties": {
-                "runId": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "since": {
+                  "description": "Only send jobs that transitioned at or after this time. Defaults to the current time.",
                   "type": "string",
-                  "x-nullable": false
+                  "format": "date-time",
+                  "x-nullable": true
                 }
               }
             }
@@ -824,14 +2873,64 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Returns error for specific job run (if present)",
+            "description": "Stream of server-sent events, with the updated jobs as data",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews": {
+      "post": {
+        "description": "Lists saved views, most recently updated first.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "listSavedViews",
+        "parameters": [
+          {
+            "name": "listSavedViewsRequest",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
               "properties": {
-                "errorString": {
-                  "description": "Error for individual job run",
-                  "type": "string",
-                  "x-nullable": false
+                "owner": {
+                  "description": "Only list the views saved by this user.",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved views",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "views": {
+                  "description": "Saved views, most recently updated first",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/savedView"
+                  }
                 }
               }
             }
@@ -851,28 +2950,73 @@ func init() {
         }
       }
     },
-    "/api/v1/jobSpec": {
+    "/api/v1/savedViews/create": {
       "post": {
+        "description": "Saves a view, owned by the user saving it.",
         "consumes": [
           "application/json"
         ],
         "produces": [
           "application/json"
         ],
-        "operationId": "getJobSpec",
+        "operationId": "createSavedView",
         "parameters": [
           {
-            "name": "getJobSpecRequest",
+            "name": "createSavedViewRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved view, including its id",
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/delete": {
+      "post": {
+        "description": "Deletes a saved view. Only its owner may delete it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "deleteSavedView",
+        "parameters": [
+          {
+            "name": "deleteSavedViewRequest",
             "in": "body",
             "required": true,
             "schema": {
               "type": "object",
               "required": [
-                "jobId"
+                "viewId"
               ],
               "properties": {
-                "jobId": {
+                "viewId": {
+                  "description": "Id of the view.",
                   "type": "string",
+                  "minLength": 1,
                   "x-nullable": false
                 }
               }
@@ -881,17 +3025,60 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Returns raw Job spec",
+            "description": "The view was deleted"
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/get": {
+      "post": {
+        "description": "Gets a saved view by id, e.g., to open a link to it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getSavedView",
+        "parameters": [
+          {
+            "name": "getSavedViewRequest",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
+              "required": [
+                "viewId"
+              ],
               "properties": {
-                "job": {
-                  "description": "Job Spec object",
-                  "type": "object",
+                "viewId": {
+                  "description": "Id of the view.",
+                  "type": "string",
+                  "minLength": 1,
                   "x-nullable": false
                 }
               }
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved view",
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
           },
           "400": {
             "description": "Error response",
@@ -908,75 +3095,31 @@ func init() {
         }
       }
     },
-    "/api/v1/jobs": {
+    "/api/v1/savedViews/update": {
       "post": {
+        "description": "Replaces a saved view. Only its owner may update it.",
         "consumes": [
           "application/json"
         ],
         "produces": [
           "application/json"
         ],
-        "operationId": "getJobs",
+        "operationId": "updateSavedView",
         "parameters": [
           {
-            "name": "getJobsRequest",
+            "name": "updateSavedViewRequest",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "filters",
-                "order"
-              ],
-              "properties": {
-                "activeJobSets": {
-                  "description": "Only include jobs in active job sets",
-                  "type": "boolean"
-                },
-                "filters": {
-                  "description": "Filters to apply to jobs.",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/filter"
-                  },
-                  "x-nullable": true
-                },
-                "order": {
-                  "description": "Ordering to apply to jobs.",
-                  "x-nullable": true,
-                  "$ref": "#/definitions/order"
-                },
-                "skip": {
-                  "description": "First elements to ignore from the full set of results. Used for pagination.",
-                  "type": "integer"
-                },
-                "take": {
-                  "description": "Number of jobs to fetch.",
-                  "type": "integer"
-                }
-              }
+              "$ref": "#/definitions/savedView"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Returns jobs from API",
+            "description": "Returns the updated view",
             "schema": {
-              "type": "object",
-              "properties": {
-                "count": {
-                  "description": "Total number of jobs",
-                  "type": "integer",
-                  "x-nullable": false
-                },
-                "jobs": {
-                  "description": "List of jobs found",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/job"
-                  }
-                }
-              }
+              "$ref": "#/definitions/savedView"
             }
           },
           "400": {
@@ -1034,6 +3177,100 @@ func init() {
         }
       }
     },
+    "arrayTask": {
+      "type": "object",
+      "properties": {
+        "index": {
+          "type": "integer",
+          "x-nullable": false
+        },
+        "jobId": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "state": {
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "artifact": {
+      "description": "Object uploaded to object storage once a run finished",
+      "type": "object",
+      "properties": {
+        "containerName": {
+          "description": "Name of the container the artifact was collected from",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the artifact, e.g., log",
+          "type": "string"
+        },
+        "sizeBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "url": {
+          "description": "URL of the uploaded object",
+          "type": "string"
+        }
+      }
+    },
+    "chargebackBucket": {
+      "type": "object",
+      "required": [
+        "start",
+        "queue",
+        "cpuHours",
+        "memoryGibibyteHours",
+        "ephemeralStorageGibibyteHours",
+        "gpuHours",
+        "cost"
+      ],
+      "properties": {
+        "cost": {
+          "description": "Price of the resources used by the job runs of the queue in the bucket",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "cpuHours": {
+          "description": "Cpu used by the job runs of the queue in the bucket, in cpu-hours",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "ephemeralStorageGibibyteHours": {
+          "description": "Ephemeral storage used by the job runs of the queue in the bucket, in GiB-hours",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "gpuHours": {
+          "description": "Gpus used by the job runs of the queue in the bucket, in gpu-hours",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "memoryGibibyteHours": {
+          "description": "Memory used by the job runs of the queue in the bucket, in GiB-hours",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "queue": {
+          "description": "Queue the spend is for",
+          "type": "string",
+          "x-nullable": false
+        },
+        "start": {
+          "description": "Start of the time bucket",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        }
+      }
+    },
     "error": {
       "type": "object",
       "required": [
@@ -1047,11 +3284,46 @@ func init() {
         }
       }
     },
+    "exportStatus": {
+      "type": "object",
+      "required": [
+        "exportId",
+        "format",
+        "state",
+        "rows"
+      ],
+      "properties": {
+        "error": {
+          "description": "Reason the export failed, if it failed",
+          "type": "string",
+          "x-nullable": true
+        },
+        "exportId": {
+          "description": "Id of the export, by which its result can be requested",
+          "type": "string",
+          "x-nullable": false
+        },
+        "format": {
+          "description": "Format of the export, either csv or parquet",
+          "type": "string",
+          "x-nullable": false
+        },
+        "rows": {
+          "description": "Number of jobs exported so far",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "state": {
+          "description": "State of the export, one of RUNNING, SUCCEEDED, or FAILED",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
     "filter": {
       "type": "object",
       "required": [
         "field",
-        "value",
         "match"
       ],
       "properties": {
@@ -1070,6 +3342,7 @@ func init() {
             "exact",
             "anyOf",
             "startsWith",
+            "prefix",
             "contains",
             "greaterThan",
             "lessThan",
@@ -1080,6 +3353,7 @@ func init() {
           "x-nullable": false
         },
         "value": {
+          "description": "Value to match. Not required for the exists match, which only checks that an annotation is set.",
           "type": "object"
         }
       }
@@ -1213,6 +3487,11 @@ func init() {
           "minLength": 1,
           "x-nullable": false
         },
+        "region": {
+          "description": "Name of the regional lookout database the job was read from. Empty unless lookout aggregates multiple regions.",
+          "type": "string",
+          "x-nullable": false
+        },
         "runs": {
           "type": "array",
           "items": {
@@ -1242,6 +3521,78 @@ func init() {
         }
       }
     },
+    "jobStatsBucket": {
+      "type": "object",
+      "required": [
+        "start",
+        "group",
+        "submitted",
+        "running",
+        "succeeded",
+        "failed",
+        "cpu",
+        "memory",
+        "ephemeralStorage",
+        "gpu"
+      ],
+      "properties": {
+        "cpu": {
+          "description": "Total cpu requested by the jobs submitted in the bucket, in millicpu",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "ephemeralStorage": {
+          "description": "Total ephemeral storage requested by the jobs submitted in the bucket, in bytes",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "failed": {
+          "description": "Number of jobs that failed in the bucket",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "gpu": {
+          "description": "Total gpus requested by the jobs submitted in the bucket",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "group": {
+          "description": "Queue or job set the statistics are for",
+          "type": "string",
+          "x-nullable": false
+        },
+        "memory": {
+          "description": "Total memory requested by the jobs submitted in the bucket, in bytes",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "running": {
+          "description": "Number of job runs that started running in the bucket",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "start": {
+          "description": "Start of the time bucket",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        },
+        "submitted": {
+          "description": "Number of jobs submitted in the bucket",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "succeeded": {
+          "description": "Number of jobs that succeeded in the bucket",
+          "type": "integer",
+          "x-nullable": false
+        }
+      }
+    },
     "order": {
       "type": "object",
       "required": [
@@ -1272,16 +3623,40 @@ func init() {
         "jobRunState"
       ],
       "properties": {
+        "artifacts": {
+          "description": "Objects uploaded to object storage once the run finished, e.g., container logs",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/artifact"
+          }
+        },
         "cluster": {
           "type": "string",
           "minLength": 1,
           "x-nullable": false
         },
+        "cpuUsageAverage": {
+          "description": "Average CPU usage of the run in millicores, if reported by the executor",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "cpuUsagePeak": {
+          "description": "Peak CPU usage of the run in millicores, if reported by the executor",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
         "exitCode": {
           "type": "integer",
           "format": "int32",
           "x-nullable": true
         },
+        "failureCategory": {
+          "description": "Category of the failure, e.g., OOMKilled or UserError. Only set for failed runs.",
+          "type": "string",
+          "x-nullable": true
+        },
         "finished": {
           "type": "string",
           "format": "date-time",
@@ -1310,16 +3685,40 @@ func init() {
           "minLength": 1,
           "x-nullable": true
         },
+        "memoryUsageAverage": {
+          "description": "Average memory usage of the run in bytes, if reported by the executor",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "memoryUsagePeak": {
+          "description": "Peak memory usage of the run in bytes, if reported by the executor",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
         "node": {
           "type": "string",
           "x-nullable": true
         },
+        "nodeLabels": {
+          "description": "Snapshot of the tracked labels of the node the run was assigned to, e.g., GPU model and zone",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "pending": {
           "type": "string",
           "format": "date-time",
           "minLength": 1,
           "x-nullable": true
         },
+        "preemptionReason": {
+          "description": "Why the run was preempted, e.g., by which job. Only set for preempted runs.",
+          "type": "string",
+          "x-nullable": true
+        },
         "runId": {
           "type": "string",
           "minLength": 1,
@@ -1331,6 +3730,133 @@ func init() {
           "x-nullable": true
         }
       }
+    },
+    "savedView": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "activeJobSets": {
+          "description": "Only include jobs in active job sets",
+          "type": "boolean",
+          "x-nullable": false
+        },
+        "columns": {
+          "description": "Columns shown in the view, in order",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "created": {
+          "description": "Time the view was created. Assigned by the server.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "filters": {
+          "description": "Filters applied to jobs in the view",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/filter"
+          }
+        },
+        "groupedFields": {
+          "description": "Fields or annotation keys the jobs in the view are grouped by, outermost first",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the view",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "order": {
+          "description": "Ordering applied to jobs in the view",
+          "x-nullable": true,
+          "$ref": "#/definitions/order"
+        },
+        "owner": {
+          "description": "User who saved the view. Assigned by the server.",
+          "type": "string",
+          "x-nullable": false
+        },
+        "updated": {
+          "description": "Time the view was last updated. Assigned by the server.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        },
+        "viewId": {
+          "description": "Id of the view, by which it can be linked to. Assigned by the server.",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "schedulingReport": {
+      "description": "Most recent reason the scheduler gave for not scheduling a queued job",
+      "type": "object",
+      "properties": {
+        "jobId": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "numExcludedNodesByReason": {
+          "description": "Number of nodes the job could not be scheduled on, by the reason why",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        },
+        "numNodes": {
+          "description": "Number of nodes considered for the job; zero if the job was rejected before considering any nodes",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "pool": {
+          "description": "Pool in which the scheduler last tried to schedule the job",
+          "type": "string",
+          "x-nullable": false
+        },
+        "reason": {
+          "description": "Why the job could not be scheduled",
+          "type": "string",
+          "x-nullable": false
+        },
+        "reported": {
+          "description": "Time the reason was reported. The job may have been scheduled since.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        }
+      }
+    },
+    "searchResult": {
+      "type": "object",
+      "properties": {
+        "job": {
+          "$ref": "#/definitions/job"
+        },
+        "matchedField": {
+          "description": "Field the query matched best, i.e., jobId, jobSet, owner, or the key of an annotation",
+          "type": "string",
+          "x-nullable": false
+        },
+        "score": {
+          "description": "Relevance of the job to the query. Higher is more relevant.",
+          "type": "number",
+          "x-nullable": false
+        }
+      }
     }
   }
 }`))
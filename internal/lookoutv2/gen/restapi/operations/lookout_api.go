@@ -43,21 +43,63 @@ func NewLookoutAPI(spec *loads.Document) *LookoutAPI {
 		JSONProducer: runtime.JSONProducer(),
 		TxtProducer:  runtime.TextProducer(),
 
+		CreateSavedViewHandler: CreateSavedViewHandlerFunc(func(params CreateSavedViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation CreateSavedView has not yet been implemented")
+		}),
+		DeleteSavedViewHandler: DeleteSavedViewHandlerFunc(func(params DeleteSavedViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteSavedView has not yet been implemented")
+		}),
+		ExportJobsHandler: ExportJobsHandlerFunc(func(params ExportJobsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ExportJobs has not yet been implemented")
+		}),
+		GetArrayJobHandler: GetArrayJobHandlerFunc(func(params GetArrayJobParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetArrayJob has not yet been implemented")
+		}),
+		GetChargebackHandler: GetChargebackHandlerFunc(func(params GetChargebackParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetChargeback has not yet been implemented")
+		}),
 		GetHealthHandler: GetHealthHandlerFunc(func(params GetHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetHealth has not yet been implemented")
 		}),
+		GetJobExportHandler: GetJobExportHandlerFunc(func(params GetJobExportParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetJobExport has not yet been implemented")
+		}),
 		GetJobRunErrorHandler: GetJobRunErrorHandlerFunc(func(params GetJobRunErrorParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetJobRunError has not yet been implemented")
 		}),
+		GetJobRunsHandler: GetJobRunsHandlerFunc(func(params GetJobRunsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetJobRuns has not yet been implemented")
+		}),
+		GetJobSchedulingReportHandler: GetJobSchedulingReportHandlerFunc(func(params GetJobSchedulingReportParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetJobSchedulingReport has not yet been implemented")
+		}),
 		GetJobSpecHandler: GetJobSpecHandlerFunc(func(params GetJobSpecParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetJobSpec has not yet been implemented")
 		}),
+		GetJobStatsHandler: GetJobStatsHandlerFunc(func(params GetJobStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetJobStats has not yet been implemented")
+		}),
 		GetJobsHandler: GetJobsHandlerFunc(func(params GetJobsParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetJobs has not yet been implemented")
 		}),
+		GetSavedViewHandler: GetSavedViewHandlerFunc(func(params GetSavedViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetSavedView has not yet been implemented")
+		}),
 		GroupJobsHandler: GroupJobsHandlerFunc(func(params GroupJobsParams) middleware.Responder {
 			return middleware.NotImplemented("operation GroupJobs has not yet been implemented")
 		}),
+		ListSavedViewsHandler: ListSavedViewsHandlerFunc(func(params ListSavedViewsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListSavedViews has not yet been implemented")
+		}),
+		SearchJobsHandler: SearchJobsHandlerFunc(func(params SearchJobsParams) middleware.Responder {
+			return middleware.NotImplemented("operation SearchJobs has not yet been implemented")
+		}),
+		UpdateSavedViewHandler: UpdateSavedViewHandlerFunc(func(params UpdateSavedViewParams) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateSavedView has not yet been implemented")
+		}),
+		WatchJobsHandler: WatchJobsHandlerFunc(func(params WatchJobsParams) middleware.Responder {
+			return middleware.NotImplemented("operation WatchJobs has not yet been implemented")
+		}),
 	}
 }
 
@@ -97,16 +139,44 @@ type LookoutAPI struct {
 	//   - text/plain
 	TxtProducer runtime.Producer
 
+	// CreateSavedViewHandler sets the operation handler for the create saved view operation
+	CreateSavedViewHandler CreateSavedViewHandler
+	// DeleteSavedViewHandler sets the operation handler for the delete saved view operation
+	DeleteSavedViewHandler DeleteSavedViewHandler
+	// ExportJobsHandler sets the operation handler for the export jobs operation
+	ExportJobsHandler ExportJobsHandler
+	// GetArrayJobHandler sets the operation handler for the get array job operation
+	GetArrayJobHandler GetArrayJobHandler
+	// GetChargebackHandler sets the operation handler for the get chargeback operation
+	GetChargebackHandler GetChargebackHandler
 	// GetHealthHandler sets the operation handler for the get health operation
 	GetHealthHandler GetHealthHandler
+	// GetJobExportHandler sets the operation handler for the get job export operation
+	GetJobExportHandler GetJobExportHandler
 	// GetJobRunErrorHandler sets the operation handler for the get job run error operation
 	GetJobRunErrorHandler GetJobRunErrorHandler
+	// GetJobRunsHandler sets the operation handler for the get job runs operation
+	GetJobRunsHandler GetJobRunsHandler
+	// GetJobSchedulingReportHandler sets the operation handler for the get job scheduling report operation
+	GetJobSchedulingReportHandler GetJobSchedulingReportHandler
 	// GetJobSpecHandler sets the operation handler for the get job spec operation
 	GetJobSpecHandler GetJobSpecHandler
+	// GetJobStatsHandler sets the operation handler for the get job stats operation
+	GetJobStatsHandler GetJobStatsHandler
 	// GetJobsHandler sets the operation handler for the get jobs operation
 	GetJobsHandler GetJobsHandler
+	// GetSavedViewHandler sets the operation handler for the get saved view operation
+	GetSavedViewHandler GetSavedViewHandler
 	// GroupJobsHandler sets the operation handler for the group jobs operation
 	GroupJobsHandler GroupJobsHandler
+	// ListSavedViewsHandler sets the operation handler for the list saved views operation
+	ListSavedViewsHandler ListSavedViewsHandler
+	// SearchJobsHandler sets the operation handler for the search jobs operation
+	SearchJobsHandler SearchJobsHandler
+	// UpdateSavedViewHandler sets the operation handler for the update saved view operation
+	UpdateSavedViewHandler UpdateSavedViewHandler
+	// WatchJobsHandler sets the operation handler for the watch jobs operation
+	WatchJobsHandler WatchJobsHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -187,21 +257,63 @@ func (o *LookoutAPI) Validate() error {
 		unregistered = append(unregistered, "TxtProducer")
 	}
 
+	if o.CreateSavedViewHandler == nil {
+		unregistered = append(unregistered, "CreateSavedViewHandler")
+	}
+	if o.DeleteSavedViewHandler == nil {
+		unregistered = append(unregistered, "DeleteSavedViewHandler")
+	}
+	if o.ExportJobsHandler == nil {
+		unregistered = append(unregistered, "ExportJobsHandler")
+	}
+	if o.GetArrayJobHandler == nil {
+		unregistered = append(unregistered, "GetArrayJobHandler")
+	}
+	if o.GetChargebackHandler == nil {
+		unregistered = append(unregistered, "GetChargebackHandler")
+	}
 	if o.GetHealthHandler == nil {
 		unregistered = append(unregistered, "GetHealthHandler")
 	}
+	if o.GetJobExportHandler == nil {
+		unregistered = append(unregistered, "GetJobExportHandler")
+	}
 	if o.GetJobRunErrorHandler == nil {
 		unregistered = append(unregistered, "GetJobRunErrorHandler")
 	}
+	if o.GetJobRunsHandler == nil {
+		unregistered = append(unregistered, "GetJobRunsHandler")
+	}
+	if o.GetJobSchedulingReportHandler == nil {
+		unregistered = append(unregistered, "GetJobSchedulingReportHandler")
+	}
 	if o.GetJobSpecHandler == nil {
 		unregistered = append(unregistered, "GetJobSpecHandler")
 	}
+	if o.GetJobStatsHandler == nil {
+		unregistered = append(unregistered, "GetJobStatsHandler")
+	}
 	if o.GetJobsHandler == nil {
 		unregistered = append(unregistered, "GetJobsHandler")
 	}
+	if o.GetSavedViewHandler == nil {
+		unregistered = append(unregistered, "GetSavedViewHandler")
+	}
 	if o.GroupJobsHandler == nil {
 		unregistered = append(unregistered, "GroupJobsHandler")
 	}
+	if o.ListSavedViewsHandler == nil {
+		unregistered = append(unregistered, "ListSavedViewsHandler")
+	}
+	if o.SearchJobsHandler == nil {
+		unregistered = append(unregistered, "SearchJobsHandler")
+	}
+	if o.UpdateSavedViewHandler == nil {
+		unregistered = append(unregistered, "UpdateSavedViewHandler")
+	}
+	if o.WatchJobsHandler == nil {
+		unregistered = append(unregistered, "WatchJobsHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -292,6 +404,26 @@ func (o *LookoutAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/savedViews/create"] = NewCreateSavedView(o.context, o.CreateSavedViewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/savedViews/delete"] = NewDeleteSavedView(o.context, o.DeleteSavedViewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/jobs/export"] = NewExportJobs(o.context, o.ExportJobsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/arrayJob"] = NewGetArrayJob(o.context, o.GetArrayJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/chargeback"] = NewGetChargeback(o.context, o.GetChargebackHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -299,19 +431,55 @@ func (o *LookoutAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/api/v1/jobs/export/result"] = NewGetJobExport(o.context, o.GetJobExportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/api/v1/jobRunError"] = NewGetJobRunError(o.context, o.GetJobRunErrorHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/api/v1/jobRuns"] = NewGetJobRuns(o.context, o.GetJobRunsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/jobSchedulingReport"] = NewGetJobSchedulingReport(o.context, o.GetJobSchedulingReportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/api/v1/jobSpec"] = NewGetJobSpec(o.context, o.GetJobSpecHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/api/v1/jobStats"] = NewGetJobStats(o.context, o.GetJobStatsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/api/v1/jobs"] = NewGetJobs(o.context, o.GetJobsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/api/v1/savedViews/get"] = NewGetSavedView(o.context, o.GetSavedViewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/api/v1/jobGroups"] = NewGroupJobs(o.context, o.GroupJobsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/savedViews"] = NewListSavedViews(o.context, o.ListSavedViewsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/jobs/search"] = NewSearchJobs(o.context, o.SearchJobsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/savedViews/update"] = NewUpdateSavedView(o.context, o.UpdateSavedViewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/jobs/watch"] = NewWatchJobs(o.context, o.WatchJobsHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,605 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/internal/lookoutv2/repository"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+
+	cursorPrefix = "offset:"
+)
+
+// Resolver resolves the queries of the GraphQL API. Fields that require further queries, e.g., job specs and run
+// errors, are only resolved if they're selected.
+type Resolver struct {
+	getJobsRepo        repository.GetJobsRepository
+	groupJobsRepo      repository.GroupJobsRepository
+	getJobSpecRepo     repository.GetJobSpecRepository
+	getJobRunErrorRepo repository.GetJobRunErrorRepository
+	// restrictFilters adds filters to the provided ones such that only the jobs visible to the requesting user match.
+	restrictFilters func(ctx context.Context, filters []*model.Filter) []*model.Filter
+	logger          logging.Logger
+}
+
+func NewResolver(
+	getJobsRepo repository.GetJobsRepository,
+	groupJobsRepo repository.GroupJobsRepository,
+	getJobSpecRepo repository.GetJobSpecRepository,
+	getJobRunErrorRepo repository.GetJobRunErrorRepository,
+	restrictFilters func(ctx context.Context, filters []*model.Filter) []*model.Filter,
+	logger logging.Logger,
+) *Resolver {
+	return &Resolver{
+		getJobsRepo:        getJobsRepo,
+		groupJobsRepo:      groupJobsRepo,
+		getJobSpecRepo:     getJobSpecRepo,
+		getJobRunErrorRepo: getJobRunErrorRepo,
+		restrictFilters:    restrictFilters,
+		logger:             logger,
+	}
+}
+
+type filterInput struct {
+	Field        string
+	Match        string
+	Value        *jsonValue
+	IsAnnotation *bool
+}
+
+type orderInput struct {
+	Field     string
+	Direction string
+}
+
+type groupedFieldInput struct {
+	Field        string
+	IsAnnotation *bool
+}
+
+type jobsArgs struct {
+	Filters       *[]filterInput
+	ActiveJobSets *bool
+	Order         *orderInput
+	First         *int32
+	After         *string
+}
+
+type groupsArgs struct {
+	Filters       *[]filterInput
+	ActiveJobSets *bool
+	Order         *orderInput
+	GroupedField  groupedFieldInput
+	Aggregates    *[]string
+	First         *int32
+	After         *string
+}
+
+func (r *Resolver) Jobs(ctx context.Context, args jobsArgs) (*jobConnectionResolver, error) {
+	skip, take, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+	order := &model.Order{Field: "jobId", Direction: model.DirectionAsc}
+	if args.Order != nil {
+		order = toOrder(args.Order)
+	}
+	result, err := r.getJobsRepo.GetJobs(
+		r.context(ctx),
+		r.restrictFilters(ctx, toFilters(args.Filters)),
+		toBool(args.ActiveJobSets),
+		order,
+		skip,
+		take,
+	)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*jobEdgeResolver, len(result.Jobs))
+	for i, job := range result.Jobs {
+		edges[i] = &jobEdgeResolver{cursor: encodeCursor(skip + i), node: &jobResolver{root: r, job: job}}
+	}
+	return &jobConnectionResolver{
+		totalCount: result.Count,
+		edges:      edges,
+		pageInfo:   newPageInfo(skip, len(edges), result.Count),
+	}, nil
+}
+
+func (r *Resolver) Job(ctx context.Context, args struct{ JobId string }) (*jobResolver, error) {
+	filters := []*model.Filter{{Field: "jobId", Match: model.MatchExact, Value: args.JobId}}
+	result, err := r.getJobsRepo.GetJobs(
+		r.context(ctx),
+		r.restrictFilters(ctx, filters),
+		false,
+		&model.Order{Field: "jobId", Direction: model.DirectionAsc},
+		0,
+		1,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Jobs) == 0 {
+		return nil, nil
+	}
+	return &jobResolver{root: r, job: result.Jobs[0]}, nil
+}
+
+func (r *Resolver) Groups(ctx context.Context, args groupsArgs) (*groupConnectionResolver, error) {
+	skip, take, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+	order := &model.Order{Field: "count", Direction: model.DirectionDesc}
+	if args.Order != nil {
+		order = toOrder(args.Order)
+	}
+	aggregates := []string{}
+	if args.Aggregates != nil {
+		aggregates = *args.Aggregates
+	}
+	result, err := r.groupJobsRepo.GroupBy(
+		r.context(ctx),
+		r.restrictFilters(ctx, toFilters(args.Filters)),
+		toBool(args.ActiveJobSets),
+		order,
+		&model.GroupedField{Field: args.GroupedField.Field, IsAnnotation: toBool(args.GroupedField.IsAnnotation)},
+		aggregates,
+		skip,
+		take,
+	)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*groupEdgeResolver, len(result.Groups))
+	for i, group := range result.Groups {
+		edges[i] = &groupEdgeResolver{cursor: encodeCursor(skip + i), node: &groupResolver{group: group}}
+	}
+	return &groupConnectionResolver{
+		totalCount: result.Count,
+		edges:      edges,
+		pageInfo:   newPageInfo(skip, len(edges), result.Count),
+	}, nil
+}
+
+func (r *Resolver) context(ctx context.Context) *armadacontext.Context {
+	return armadacontext.New(ctx, r.logger)
+}
+
+// page returns the number of items to skip and to take to get the page of size first after the cursor after.
+func page(first *int32, after *string) (int, int, error) {
+	take := defaultPageSize
+	if first != nil {
+		if *first < 0 || *first > maxPageSize {
+			return 0, 0, errors.Errorf("first must be between 0 and %d, but was %d", maxPageSize, *first)
+		}
+		take = int(*first)
+	}
+	skip := 0
+	if after != nil {
+		offset, err := decodeCursor(*after)
+		if err != nil {
+			return 0, 0, err
+		}
+		skip = offset + 1
+	}
+	return skip, take, nil
+}
+
+// Cursors identify an item by its offset in the ordered results. Clients should treat them as opaque.
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	b, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(b), cursorPrefix) {
+		return 0, errors.Errorf("invalid cursor %s", cursor)
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(b), cursorPrefix))
+	if err != nil || offset < 0 {
+		return 0, errors.Errorf("invalid cursor %s", cursor)
+	}
+	return offset, nil
+}
+
+func toFilters(filters *[]filterInput) []*model.Filter {
+	if filters == nil {
+		return []*model.Filter{}
+	}
+	result := make([]*model.Filter, len(*filters))
+	for i, filter := range *filters {
+		result[i] = &model.Filter{
+			Field:        filter.Field,
+			Match:        filter.Match,
+			IsAnnotation: toBool(filter.IsAnnotation),
+		}
+		if filter.Value != nil {
+			result[i].Value = filter.Value.value
+		}
+	}
+	return result
+}
+
+func toOrder(order *orderInput) *model.Order {
+	return &model.Order{Field: order.Field, Direction: order.Direction}
+}
+
+func toBool(b *bool) bool {
+	return b != nil && *b
+}
+
+// toFloatPtr converts an optional integer to a GraphQL Float, which unlike Int can hold 64-bit values.
+func toFloatPtr(i *int64) *float64 {
+	if i == nil {
+		return nil
+	}
+	f := float64(*i)
+	return &f
+}
+
+type pageInfoResolver struct {
+	hasNextPage bool
+	endCursor   *string
+}
+
+func newPageInfo(skip int, n int, totalCount int) *pageInfoResolver {
+	pageInfo := &pageInfoResolver{hasNextPage: skip+n < totalCount}
+	if n > 0 {
+		endCursor := encodeCursor(skip + n - 1)
+		pageInfo.endCursor = &endCursor
+	}
+	return pageInfo
+}
+
+func (r *pageInfoResolver) HasNextPage() bool {
+	return r.hasNextPage
+}
+
+func (r *pageInfoResolver) EndCursor() *string {
+	return r.endCursor
+}
+
+type jobConnectionResolver struct {
+	totalCount int
+	edges      []*jobEdgeResolver
+	pageInfo   *pageInfoResolver
+}
+
+func (r *jobConnectionResolver) TotalCount() int32 {
+	return int32(r.totalCount)
+}
+
+func (r *jobConnectionResolver) Edges() []*jobEdgeResolver {
+	return r.edges
+}
+
+func (r *jobConnectionResolver) PageInfo() *pageInfoResolver {
+	return r.pageInfo
+}
+
+type jobEdgeResolver struct {
+	cursor string
+	node   *jobResolver
+}
+
+func (r *jobEdgeResolver) Cursor() string {
+	return r.cursor
+}
+
+func (r *jobEdgeResolver) Node() *jobResolver {
+	return r.node
+}
+
+type groupConnectionResolver struct {
+	totalCount int
+	edges      []*groupEdgeResolver
+	pageInfo   *pageInfoResolver
+}
+
+func (r *groupConnectionResolver) TotalCount() int32 {
+	return int32(r.totalCount)
+}
+
+func (r *groupConnectionResolver) Edges() []*groupEdgeResolver {
+	return r.edges
+}
+
+func (r *groupConnectionResolver) PageInfo() *pageInfoResolver {
+	return r.pageInfo
+}
+
+type groupEdgeResolver struct {
+	cursor string
+	node   *groupResolver
+}
+
+func (r *groupEdgeResolver) Cursor() string {
+	return r.cursor
+}
+
+func (r *groupEdgeResolver) Node() *groupResolver {
+	return r.node
+}
+
+type jobResolver struct {
+	root *Resolver
+	job  *model.Job
+}
+
+func (r *jobResolver) JobId() string {
+	return r.job.JobId
+}
+
+func (r *jobResolver) Queue() string {
+	return r.job.Queue
+}
+
+func (r *jobResolver) JobSet() string {
+	return r.job.JobSet
+}
+
+func (r *jobResolver) Owner() string {
+	return r.job.Owner
+}
+
+func (r *jobResolver) Namespace() *string {
+	return r.job.Namespace
+}
+
+func (r *jobResolver) Priority() float64 {
+	return float64(r.job.Priority)
+}
+
+func (r *jobResolver) PriorityClass() *string {
+	return r.job.PriorityClass
+}
+
+func (r *jobResolver) Cpu() float64 {
+	return float64(r.job.Cpu)
+}
+
+func (r *jobResolver) Memory() float64 {
+	return float64(r.job.Memory)
+}
+
+func (r *jobResolver) EphemeralStorage() float64 {
+	return float64(r.job.EphemeralStorage)
+}
+
+func (r *jobResolver) Gpu() float64 {
+	return float64(r.job.Gpu)
+}
+
+func (r *jobResolver) State() string {
+	return r.job.State
+}
+
+func (r *jobResolver) Submitted() graphqlgo.Time {
+	return graphqlgo.Time{Time: r.job.Submitted}
+}
+
+func (r *jobResolver) LastTransitionTime() graphqlgo.Time {
+	return graphqlgo.Time{Time: r.job.LastTransitionTime}
+}
+
+func (r *jobResolver) Cancelled() *graphqlgo.Time {
+	return toTime(r.job.Cancelled)
+}
+
+func (r *jobResolver) CancelReason() *string {
+	return r.job.CancelReason
+}
+
+func (r *jobResolver) Duplicate() bool {
+	return r.job.Duplicate
+}
+
+func (r *jobResolver) Region() *string {
+	if r.job.Region == "" {
+		return nil
+	}
+	return &r.job.Region
+}
+
+func (r *jobResolver) Annotations() []*keyValueResolver {
+	return toKeyValues(r.job.Annotations)
+}
+
+func (r *jobResolver) LastActiveRunId() *string {
+	return r.job.LastActiveRunId
+}
+
+func (r *jobResolver) Runs() []*runResolver {
+	runs := make([]*runResolver, len(r.job.Runs))
+	for i, run := range r.job.Runs {
+		runs[i] = &runResolver{root: r.root, run: run}
+	}
+	return runs
+}
+
+func (r *jobResolver) Spec(ctx context.Context) (*jsonValue, error) {
+	spec, err := r.root.getJobSpecRepo.GetJobSpec(r.root.context(ctx), r.job.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonValue{value: spec}, nil
+}
+
+type runResolver struct {
+	root *Resolver
+	run  *model.Run
+}
+
+func (r *runResolver) RunId() string {
+	return r.run.RunId
+}
+
+func (r *runResolver) Cluster() string {
+	return r.run.Cluster
+}
+
+func (r *runResolver) Node() *string {
+	return r.run.Node
+}
+
+func (r *runResolver) NodeLabels() []*keyValueResolver {
+	return toKeyValues(r.run.NodeLabels)
+}
+
+func (r *runResolver) JobRunState() string {
+	return r.run.JobRunState
+}
+
+func (r *runResolver) Leased() *graphqlgo.Time {
+	return toTime(r.run.Leased)
+}
+
+func (r *runResolver) Pending() *graphqlgo.Time {
+	return toTime(r.run.Pending)
+}
+
+func (r *runResolver) Started() *graphqlgo.Time {
+	return toTime(r.run.Started)
+}
+
+func (r *runResolver) Finished() *graphqlgo.Time {
+	return toTime(r.run.Finished)
+}
+
+func (r *runResolver) ExitCode() *int32 {
+	return r.run.ExitCode
+}
+
+func (r *runResolver) PreemptionReason() *string {
+	return r.run.PreemptionReason
+}
+
+func (r *runResolver) FailureCategory() *string {
+	return r.run.FailureCategory
+}
+
+func (r *runResolver) Artifacts() []*artifactResolver {
+	artifacts := make([]*artifactResolver, len(r.run.Artifacts))
+	for i := range r.run.Artifacts {
+		artifacts[i] = &artifactResolver{artifact: &r.run.Artifacts[i]}
+	}
+	return artifacts
+}
+
+func (r *runResolver) CpuUsagePeak() *float64 {
+	return toFloatPtr(r.run.CpuUsagePeak)
+}
+
+func (r *runResolver) CpuUsageAverage() *float64 {
+	return toFloatPtr(r.run.CpuUsageAverage)
+}
+
+func (r *runResolver) MemoryUsagePeak() *float64 {
+	return toFloatPtr(r.run.MemoryUsagePeak)
+}
+
+func (r *runResolver) MemoryUsageAverage() *float64 {
+	return toFloatPtr(r.run.MemoryUsageAverage)
+}
+
+func (r *runResolver) Error(ctx context.Context) (*string, error) {
+	runError, err := r.root.getJobRunErrorRepo.GetJobRunError(r.root.context(ctx), r.run.RunId)
+	if err != nil || runError == "" {
+		return nil, err
+	}
+	return &runError, nil
+}
+
+type artifactResolver struct {
+	artifact *model.Artifact
+}
+
+func (r *artifactResolver) Name() string {
+	return r.artifact.Name
+}
+
+func (r *artifactResolver) ContainerName() string {
+	return r.artifact.ContainerName
+}
+
+func (r *artifactResolver) Url() string {
+	return r.artifact.Url
+}
+
+func (r *artifactResolver) SizeBytes() float64 {
+	return float64(r.artifact.SizeBytes)
+}
+
+type groupResolver struct {
+	group *model.JobGroup
+}
+
+func (r *groupResolver) Name() string {
+	return r.group.Name
+}
+
+func (r *groupResolver) Count() int32 {
+	return int32(r.group.Count)
+}
+
+func (r *groupResolver) Aggregates() []*aggregateResolver {
+	aggregates := make([]*aggregateResolver, 0, len(r.group.Aggregates))
+	for field, value := range r.group.Aggregates {
+		aggregates = append(aggregates, &aggregateResolver{field: field, value: &jsonValue{value: value}})
+	}
+	sort.Slice(aggregates, func(i, j int) bool {
+		return aggregates[i].field < aggregates[j].field
+	})
+	return aggregates
+}
+
+type aggregateResolver struct {
+	field string
+	value *jsonValue
+}
+
+func (r *aggregateResolver) Field() string {
+	return r.field
+}
+
+func (r *aggregateResolver) Value() *jsonValue {
+	return r.value
+}
+
+type keyValueResolver struct {
+	key   string
+	value string
+}
+
+func toKeyValues(m map[string]string) []*keyValueResolver {
+	keyValues := make([]*keyValueResolver, 0, len(m))
+	for key, value := range m {
+		keyValues = append(keyValues, &keyValueResolver{key: key, value: value})
+	}
+	sort.Slice(keyValues, func(i, j int) bool {
+		return keyValues[i].key < keyValues[j].key
+	})
+	return keyValues
+}
+
+func (r *keyValueResolver) Key() string {
+	return r.key
+}
+
+func (r *keyValueResolver) Value() string {
+	return r.value
+}
@@ -0,0 +1,216 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/internal/lookoutv2/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var baseTime = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type fakeRepository struct {
+	jobs         []*model.Job
+	groups       []*model.JobGroup
+	filters      []*model.Filter
+	order        *model.Order
+	specRequests int
+}
+
+func (r *fakeRepository) GetJobs(_ *armadacontext.Context, filters []*model.Filter, _ bool, order *model.Order, skip int, take int) (*repository.GetJobsResult, error) {
+	r.filters = filters
+	r.order = order
+	jobs := r.jobs
+	for _, filter := range filters {
+		if filter.Field == "jobId" {
+			jobs = nil
+			for _, job := range r.jobs {
+				if job.JobId == filter.Value {
+					jobs = append(jobs, job)
+				}
+			}
+		}
+	}
+	count := len(jobs)
+	jobs = jobs[minInt(skip, len(jobs)):minInt(skip+take, len(jobs))]
+	return &repository.GetJobsResult{Jobs: jobs, Count: count}, nil
+}
+
+func (r *fakeRepository) GroupBy(_ *armadacontext.Context, filters []*model.Filter, _ bool, order *model.Order, _ *model.GroupedField, _ []string, skip int, take int) (*repository.GroupByResult, error) {
+	r.filters = filters
+	r.order = order
+	return &repository.GroupByResult{Groups: r.groups[skip:minInt(skip+take, len(r.groups))], Count: len(r.groups)}, nil
+}
+
+func (r *fakeRepository) GetJobSpec(_ *armadacontext.Context, jobId string) (*api.Job, error) {
+	r.specRequests++
+	return &api.Job{Id: jobId, Queue: "queue"}, nil
+}
+
+func (r *fakeRepository) GetJobRunError(_ *armadacontext.Context, runId string) (string, error) {
+	return "error of " + runId, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func newTestHandler(t *testing.T, repo *fakeRepository) http.Handler {
+	restrictFilters := func(_ context.Context, filters []*model.Filter) []*model.Filter {
+		return append(filters, &model.Filter{Field: "queue", Match: model.MatchExact, Value: "queue"})
+	}
+	handler, err := NewHandler(NewResolver(repo, repo, repo, repo, restrictFilters, logging.NullLogger))
+	require.NoError(t, err)
+	return handler
+}
+
+// query executes query against handler, and returns the data of the response, or its errors.
+func query(t *testing.T, handler http.Handler, query string, variables map[string]interface{}) (map[string]interface{}, []interface{}) {
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+	var response struct {
+		Data   map[string]interface{}
+		Errors []interface{}
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Data, response.Errors
+}
+
+func makeJobs(n int) []*model.Job {
+	jobs := make([]*model.Job, n)
+	for i := range jobs {
+		exitCode := int32(1)
+		jobs[i] = &model.Job{
+			JobId:              fmt.Sprintf("job-%d", i),
+			Queue:              "queue",
+			JobSet:             "job-set",
+			Owner:              "owner",
+			State:              "FAILED",
+			Memory:             8 * 1024 * 1024 * 1024,
+			Annotations:        map[string]string{"b": "2", "a": "1"},
+			Submitted:          baseTime,
+			LastTransitionTime: baseTime,
+			Runs:               []*model.Run{{RunId: fmt.Sprintf("run-%d", i), Cluster: "cluster", ExitCode: &exitCode}},
+		}
+	}
+	return jobs
+}
+
+func TestJobs(t *testing.T) {
+	repo := &fakeRepository{jobs: makeJobs(5)}
+	handler := newTestHandler(t, repo)
+	jobsQuery := `
+		query($after: String) {
+			jobs(filters: [{field: "state", match: "anyOf", value: ["FAILED"]}], first: 2, after: $after) {
+				totalCount
+				edges { node { jobId memory submitted annotations { key value } runs { runId exitCode } } }
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+
+	data, errs := query(t, handler, jobsQuery, nil)
+	require.Empty(t, errs)
+	jobs := data["jobs"].(map[string]interface{})
+	assert.Equal(t, float64(5), jobs["totalCount"])
+	edges := jobs["edges"].([]interface{})
+	require.Len(t, edges, 2)
+	assert.Equal(t, map[string]interface{}{
+		"jobId":       "job-0",
+		"memory":      float64(8 * 1024 * 1024 * 1024),
+		"submitted":   "2023-01-01T12:00:00Z",
+		"annotations": []interface{}{map[string]interface{}{"key": "a", "value": "1"}, map[string]interface{}{"key": "b", "value": "2"}},
+		"runs":        []interface{}{map[string]interface{}{"runId": "run-0", "exitCode": float64(1)}},
+	}, edges[0].(map[string]interface{})["node"])
+	assert.Equal(t, []*model.Filter{
+		{Field: "state", Match: model.MatchAnyOf, Value: []interface{}{"FAILED"}},
+		{Field: "queue", Match: model.MatchExact, Value: "queue"},
+	}, repo.filters)
+	assert.Equal(t, &model.Order{Field: "jobId", Direction: model.DirectionAsc}, repo.order)
+
+	// Follow the cursors to the last page
+	pageInfo := jobs["pageInfo"].(map[string]interface{})
+	assert.Equal(t, true, pageInfo["hasNextPage"])
+	data, errs = query(t, handler, jobsQuery, map[string]interface{}{"after": pageInfo["endCursor"]})
+	require.Empty(t, errs)
+	pageInfo = data["jobs"].(map[string]interface{})["pageInfo"].(map[string]interface{})
+	data, errs = query(t, handler, jobsQuery, map[string]interface{}{"after": pageInfo["endCursor"]})
+	require.Empty(t, errs)
+	jobs = data["jobs"].(map[string]interface{})
+	edges = jobs["edges"].([]interface{})
+	require.Len(t, edges, 1)
+	assert.Equal(t, "job-4", edges[0].(map[string]interface{})["node"].(map[string]interface{})["jobId"])
+	assert.Equal(t, false, jobs["pageInfo"].(map[string]interface{})["hasNextPage"])
+
+	assert.Zero(t, repo.specRequests)
+}
+
+func TestJobs_InvalidPage(t *testing.T) {
+	handler := newTestHandler(t, &fakeRepository{})
+	_, errs := query(t, handler, `{ jobs(first: 5000) { totalCount } }`, nil)
+	assert.NotEmpty(t, errs)
+	_, errs = query(t, handler, `{ jobs(after: "not a cursor") { totalCount } }`, nil)
+	assert.NotEmpty(t, errs)
+}
+
+func TestJob(t *testing.T) {
+	repo := &fakeRepository{jobs: makeJobs(3)}
+	handler := newTestHandler(t, repo)
+
+	data, errs := query(t, handler, `{ job(jobId: "job-1") { jobId spec runs { error } } }`, nil)
+	require.Empty(t, errs)
+	job := data["job"].(map[string]interface{})
+	assert.Equal(t, "job-1", job["jobId"])
+	assert.Equal(t, "queue", job["spec"].(map[string]interface{})["queue"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"error": "error of run-1"}}, job["runs"])
+	assert.Equal(t, 1, repo.specRequests)
+
+	data, errs = query(t, handler, `{ job(jobId: "unknown") { jobId } }`, nil)
+	require.Empty(t, errs)
+	assert.Nil(t, data["job"])
+}
+
+func TestGroups(t *testing.T) {
+	repo := &fakeRepository{groups: []*model.JobGroup{
+		{Name: "queue-a", Count: 10, Aggregates: map[string]interface{}{"submitted": "2023-01-01T12:00:00Z"}},
+		{Name: "queue-b", Count: 5, Aggregates: map[string]interface{}{"submitted": "2023-01-02T12:00:00Z"}},
+	}}
+	handler := newTestHandler(t, repo)
+
+	data, errs := query(t, handler, `{
+		groups(groupedField: {field: "queue"}, aggregates: ["submitted"], first: 1) {
+			totalCount
+			edges { node { name count aggregates { field value } } }
+			pageInfo { hasNextPage }
+		}
+	}`, nil)
+	require.Empty(t, errs)
+	assert.Equal(t, map[string]interface{}{
+		"totalCount": float64(2),
+		"edges": []interface{}{map[string]interface{}{"node": map[string]interface{}{
+			"name":       "queue-a",
+			"count":      float64(10),
+			"aggregates": []interface{}{map[string]interface{}{"field": "submitted", "value": "2023-01-01T12:00:00Z"}},
+		}}},
+		"pageInfo": map[string]interface{}{"hasNextPage": true},
+	}, data["groups"])
+	assert.Equal(t, &model.Order{Field: "count", Direction: model.DirectionDesc}, repo.order)
+}
@@ -0,0 +1,51 @@
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+)
+
+// maxDepth bounds the nesting of queries, such that a single query can't request an unbounded amount of data.
+const maxDepth = 10
+
+//go:embed schema.graphql
+var schema string
+
+// NewHandler returns a handler serving the GraphQL API, which accepts queries POSTed as json.
+func NewHandler(resolver *Resolver) (http.Handler, error) {
+	parsedSchema, err := graphqlgo.ParseSchema(schema, resolver, graphqlgo.MaxDepth(maxDepth))
+	if err != nil {
+		return nil, err
+	}
+	return &relay.Handler{Schema: parsedSchema}, nil
+}
+
+// jsonValue is the JSON scalar: any json value, such as the value of a filter.
+type jsonValue struct {
+	value interface{}
+}
+
+func (jsonValue) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+func (v *jsonValue) UnmarshalGraphQL(input interface{}) error {
+	v.value = input
+	return nil
+}
+
+func (v jsonValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+func toTime(t *time.Time) *graphqlgo.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphqlgo.Time{Time: *t}
+}
@@ -8,6 +8,7 @@ const (
 	MatchExact                = "exact"
 	MatchAnyOf                = "anyOf"
 	MatchStartsWith           = "startsWith"
+	MatchPrefix               = "prefix"
 	MatchContains             = "contains"
 	MatchGreaterThan          = "greaterThan"
 	MatchLessThan             = "lessThan"
@@ -36,6 +37,7 @@ type Job struct {
 	Priority           int64
 	PriorityClass      *string
 	Queue              string
+	Region             string
 	Runs               []*Run
 	State              string
 	Submitted          time.Time
@@ -48,10 +50,107 @@ type Run struct {
 	Finished    *time.Time
 	JobRunState string
 	Node        *string
+	NodeLabels  map[string]string
 	Leased      *time.Time
 	Pending     *time.Time
 	RunId       string
 	Started     *time.Time
+	// Why the run was preempted, e.g., by which job. Only set for preempted runs.
+	PreemptionReason *string
+	// Category of the failure, e.g., "OOMKilled". Only set for failed runs.
+	FailureCategory *string
+	// Objects uploaded to object storage once the run finished, e.g., container logs.
+	Artifacts []Artifact
+	// Peak and average resource usage of the run, in millicores and bytes respectively, if reported by the executor.
+	CpuUsagePeak       *int64
+	CpuUsageAverage    *int64
+	MemoryUsagePeak    *int64
+	MemoryUsageAverage *int64
+}
+
+// Artifact is an object uploaded to object storage once a run finished.
+type Artifact struct {
+	// Name of the artifact, e.g., "log".
+	Name string `json:"name"`
+	// Name of the container the artifact was collected from.
+	ContainerName string `json:"containerName"`
+	Url           string `json:"url"`
+	SizeBytes     int64  `json:"sizeBytes"`
+}
+
+// JobSchedulingReport is the most recent reason the scheduler gave for not scheduling a queued job.
+type JobSchedulingReport struct {
+	JobId                    string
+	Reason                   string
+	Pool                     string
+	NumNodes                 int
+	NumExcludedNodesByReason map[string]int
+	Reported                 time.Time
+}
+
+// ArrayJob is the status of each task of an array job.
+type ArrayJob struct {
+	ArrayId     string
+	StateCounts map[string]int64
+	Tasks       []*ArrayTask
+}
+
+type ArrayTask struct {
+	Index int64
+	JobId string
+	State string
+}
+
+// SearchResult is a job matching a free-text search, together with how well it matched.
+type SearchResult struct {
+	Job *Job
+	// Field the query matched best: jobId, jobSet, owner, or an annotation key.
+	MatchedField string
+	Score        float64
+}
+
+// JobStatsBucket is the activity of the jobs of a queue or job set in a time bucket.
+type JobStatsBucket struct {
+	Start     time.Time
+	Group     string
+	Submitted int64
+	// Number of runs that started running in the bucket.
+	Running   int64
+	Succeeded int64
+	Failed    int64
+	// Resources requested by the jobs submitted in the bucket.
+	Cpu              int64
+	Memory           int64
+	EphemeralStorage int64
+	Gpu              int64
+}
+
+// QueueUsageBucket is the resources used by the job runs of a queue on a cluster in a time bucket,
+// i.e., the resources requested by each run multiplied by the time it was running in the bucket.
+type QueueUsageBucket struct {
+	Start   time.Time
+	Queue   string
+	Cluster string
+	// In cpu-seconds.
+	CpuSeconds float64
+	// In byte-seconds.
+	MemorySeconds float64
+	// In byte-seconds.
+	EphemeralStorageSeconds float64
+	// In gpu-seconds.
+	GpuSeconds float64
+}
+
+// ChargebackBucket is the spend of a queue in a time bucket.
+type ChargebackBucket struct {
+	Start                         time.Time
+	Queue                         string
+	CpuHours                      float64
+	MemoryGibibyteHours           float64
+	EphemeralStorageGibibyteHours float64
+	GpuHours                      float64
+	// Price of the resources used, according to the cost model.
+	Cost float64
 }
 
 type JobGroup struct {
@@ -76,3 +175,20 @@ type GroupedField struct {
 	Field        string
 	IsAnnotation bool
 }
+
+// SavedView is a named configuration of the jobs table, which users can share by linking to its id.
+type SavedView struct {
+	ViewId      string
+	Name        string
+	Description string
+	// User who saved the view, and who alone may update or delete it.
+	Owner         string
+	Filters       []*Filter
+	Order         *Order
+	ActiveJobSets bool
+	// Fields or annotation keys the jobs are grouped by, outermost first.
+	GroupedFields []string
+	Columns       []string
+	Created       time.Time
+	Updated       time.Time
+}
@@ -139,6 +139,7 @@ func TestPruneDb(t *testing.T) {
 					selectStringSet(t, db, "SELECT job_id FROM job"),
 					selectStringSet(t, db, "SELECT DISTINCT job_id FROM job_run"),
 					selectStringSet(t, db, "SELECT DISTINCT job_id FROM user_annotation_lookup"),
+					selectStringSet(t, db, "SELECT DISTINCT job_id FROM event_watermark"),
 				}
 				for _, queriedJobs := range queriedJobIdsPerTable {
 					assert.Equal(t, len(tc.jobIdsLeft), len(queriedJobs))
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+// GetArrayJobRepository returns the status of each task of an array job.
+// The tasks of an array job are the jobs annotated with the id of the array by the Armada server.
+type GetArrayJobRepository interface {
+	GetArrayJob(ctx *armadacontext.Context, arrayId string) (*model.ArrayJob, error)
+}
+
+type SqlGetArrayJobRepository struct {
+	db *pgxpool.Pool
+	// Keys of the array id and index annotations as stored by the lookout ingester,
+	// i.e., with the user annotation prefix removed.
+	arrayIdKey    string
+	arrayIndexKey string
+}
+
+func NewSqlGetArrayJobRepository(db *pgxpool.Pool, userAnnotationPrefix string) *SqlGetArrayJobRepository {
+	return &SqlGetArrayJobRepository{
+		db:            db,
+		arrayIdKey:    strings.TrimPrefix(configuration.ArrayIdAnnotation, userAnnotationPrefix),
+		arrayIndexKey: strings.TrimPrefix(configuration.ArrayIndexAnnotation, userAnnotationPrefix),
+	}
+}
+
+func (r *SqlGetArrayJobRepository) GetArrayJob(ctx *armadacontext.Context, arrayId string) (*model.ArrayJob, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT
+			j.job_id,
+			j.state,
+			coalesce(idx.value, '')
+		FROM user_annotation_lookup AS a
+		JOIN job AS j ON j.job_id = a.job_id
+		LEFT JOIN user_annotation_lookup AS idx ON idx.job_id = a.job_id AND idx.key = $2
+		WHERE a.key = $1 AND a.value = $3`, r.arrayIdKey, r.arrayIndexKey, arrayId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	arrayJob := &model.ArrayJob{
+		ArrayId:     arrayId,
+		StateCounts: map[string]int64{},
+		Tasks:       []*model.ArrayTask{},
+	}
+	for rows.Next() {
+		var jobId, index string
+		var state int
+		if err := rows.Scan(&jobId, &state, &index); err != nil {
+			return nil, err
+		}
+		parsedIndex, err := strconv.ParseInt(index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "job %s of array job %s has invalid index %q", jobId, arrayId, index)
+		}
+		task := &model.ArrayTask{
+			Index: parsedIndex,
+			JobId: jobId,
+			State: string(lookout.JobStateMap[state]),
+		}
+		arrayJob.Tasks = append(arrayJob.Tasks, task)
+		arrayJob.StateCounts[task.State]++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(arrayJob.Tasks) == 0 {
+		return nil, errors.Errorf("array job with id %s not found", arrayId)
+	}
+	sort.Slice(arrayJob.Tasks, func(i, j int) bool {
+		return arrayJob.Tasks[i].Index < arrayJob.Tasks[j].Index
+	})
+	return arrayJob, nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/metrics"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+func TestGetArrayJob(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get(), userAnnotationPrefix, &compress.NoOpCompressor{}, true)
+		store := lookoutdb.NewLookoutDb(db, metrics.Get(), 3, 10)
+
+		arrayId := util.NewULID()
+		taskOpts := func(index int) *JobOptions {
+			return &JobOptions{
+				Annotations: map[string]string{
+					configuration.ArrayIdAnnotation:    arrayId,
+					configuration.ArrayIndexAnnotation: strconv.Itoa(index),
+				},
+			}
+		}
+		// Submit the tasks out of order to check they're returned ordered by index.
+		second := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, taskOpts(1)).
+			Build().
+			Job()
+		runId := uuid.NewString()
+		first := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, taskOpts(0)).
+			Pending(runId, cluster, baseTime).
+			Running(runId, node, baseTime).
+			RunSucceeded(runId, baseTime).
+			Succeeded(baseTime).
+			Build().
+			Job()
+		third := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, taskOpts(2)).
+			Build().
+			Job()
+		// A job that isn't part of the array.
+		NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Build()
+
+		repo := NewSqlGetArrayJobRepository(db, userAnnotationPrefix)
+		result, err := repo.GetArrayJob(armadacontext.TODO(), arrayId)
+		assert.NoError(t, err)
+		assert.Equal(t, &model.ArrayJob{
+			ArrayId:     arrayId,
+			StateCounts: map[string]int64{string(lookout.JobSucceeded): 1, string(lookout.JobQueued): 2},
+			Tasks: []*model.ArrayTask{
+				{Index: 0, JobId: first.JobId, State: string(lookout.JobSucceeded)},
+				{Index: 1, JobId: second.JobId, State: string(lookout.JobQueued)},
+				{Index: 2, JobId: third.JobId, State: string(lookout.JobQueued)},
+			},
+		}, result)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetArrayJobNotFound(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		repo := NewSqlGetArrayJobRepository(db, userAnnotationPrefix)
+		_, err := repo.GetArrayJob(armadacontext.TODO(), util.NewULID())
+		assert.Error(t, err)
+		return nil
+	})
+	assert.NoError(t, err)
+}
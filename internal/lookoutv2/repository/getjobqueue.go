@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// GetJobQueueRepository looks up the queue a job or job run belongs to, such that access to it can be authorised.
+type GetJobQueueRepository interface {
+	GetJobQueue(ctx *armadacontext.Context, jobId string) (string, error)
+	GetRunQueue(ctx *armadacontext.Context, runId string) (string, error)
+}
+
+type SqlGetJobQueueRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSqlGetJobQueueRepository(db *pgxpool.Pool) *SqlGetJobQueueRepository {
+	return &SqlGetJobQueueRepository{
+		db: db,
+	}
+}
+
+func (r *SqlGetJobQueueRepository) GetJobQueue(ctx *armadacontext.Context, jobId string) (string, error) {
+	var queue string
+	err := r.db.QueryRow(ctx, "SELECT queue FROM job WHERE job_id = $1", jobId).Scan(&queue)
+	if err == pgx.ErrNoRows {
+		return "", errors.Errorf("job with id %s not found", jobId)
+	}
+	return queue, err
+}
+
+func (r *SqlGetJobQueueRepository) GetRunQueue(ctx *armadacontext.Context, runId string) (string, error) {
+	var queue string
+	err := r.db.QueryRow(ctx, `
+		SELECT j.queue
+		FROM job_run AS jr
+		INNER JOIN job AS j ON j.job_id = jr.job_id
+		WHERE jr.run_id = $1`, runId).Scan(&queue)
+	if err == pgx.ErrNoRows {
+		return "", errors.Errorf("run with id %s not found", runId)
+	}
+	return queue, err
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/metrics"
+)
+
+func TestGetJobQueue(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get(), userAnnotationPrefix, &compress.NoOpCompressor{}, true)
+		store := lookoutdb.NewLookoutDb(db, metrics.Get(), 3, 10)
+
+		job := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Pending(runId, cluster, baseTime).
+			Build().
+			Job()
+
+		repo := NewSqlGetJobQueueRepository(db)
+		result, err := repo.GetJobQueue(armadacontext.TODO(), job.JobId)
+		assert.NoError(t, err)
+		assert.Equal(t, queue, result)
+
+		result, err = repo.GetRunQueue(armadacontext.TODO(), runId)
+		assert.NoError(t, err)
+		assert.Equal(t, queue, result)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetJobQueueNotFound(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		repo := NewSqlGetJobQueueRepository(db)
+		_, err := repo.GetJobQueue(armadacontext.TODO(), jobId)
+		assert.Error(t, err)
+		_, err = repo.GetRunQueue(armadacontext.TODO(), runId)
+		assert.Error(t, err)
+		return nil
+	})
+	assert.NoError(t, err)
+}
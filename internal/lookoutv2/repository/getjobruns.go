@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+// GetJobRunsRepository returns the placement history of a job, i.e., all runs of the job in the order they were leased,
+// including those of retries, together with the node each run was assigned to, a snapshot of the labels of that node,
+// and, for preempted runs, why the run was preempted.
+type GetJobRunsRepository interface {
+	GetJobRuns(ctx *armadacontext.Context, jobId string) ([]*model.Run, error)
+}
+
+type SqlGetJobRunsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSqlGetJobRunsRepository(db *pgxpool.Pool) *SqlGetJobRunsRepository {
+	return &SqlGetJobRunsRepository{
+		db: db,
+	}
+}
+
+func (r *SqlGetJobRunsRepository) GetJobRuns(ctx *armadacontext.Context, jobId string) ([]*model.Run, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM job WHERE job_id = $1)", jobId).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("job with id %s not found", jobId)
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT
+			run_id,
+			cluster,
+			node,
+			node_labels,
+			leased,
+			pending,
+			started,
+			finished,
+			job_run_state,
+			exit_code,
+			preemption_reason,
+			failure_category,
+			artifacts,
+			cpu_usage_peak,
+			cpu_usage_average,
+			memory_usage_peak,
+			memory_usage_average
+		FROM job_run
+		WHERE job_id = $1
+		ORDER BY coalesce(leased, pending), run_id`, jobId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := []*model.Run{}
+	for rows.Next() {
+		var row runRow
+		if err := rows.Scan(
+			&row.runId,
+			&row.cluster,
+			&row.node,
+			&row.nodeLabels,
+			&row.leased,
+			&row.pending,
+			&row.started,
+			&row.finished,
+			&row.jobRunState,
+			&row.exitCode,
+			&row.preemptionReason,
+			&row.failureCategory,
+			&row.artifacts,
+			&row.cpuUsagePeak,
+			&row.cpuUsageAverage,
+			&row.memoryUsagePeak,
+			&row.memoryUsageAverage,
+		); err != nil {
+			return nil, err
+		}
+		runs = append(runs, &model.Run{
+			Cluster:            row.cluster,
+			ExitCode:           database.ParseNullInt32(row.exitCode),
+			Finished:           database.ParseNullTime(row.finished),
+			JobRunState:        string(lookout.JobRunStateMap[row.jobRunState]),
+			Node:               database.ParseNullString(row.node),
+			NodeLabels:         row.nodeLabels,
+			Leased:             database.ParseNullTime(row.leased),
+			Pending:            database.ParseNullTime(row.pending),
+			RunId:              row.runId,
+			Started:            database.ParseNullTime(row.started),
+			PreemptionReason:   database.ParseNullString(row.preemptionReason),
+			FailureCategory:    database.ParseNullString(row.failureCategory),
+			Artifacts:          row.artifacts,
+			CpuUsagePeak:       database.ParseNullInt64(row.cpuUsagePeak),
+			CpuUsageAverage:    database.ParseNullInt64(row.cpuUsageAverage),
+			MemoryUsagePeak:    database.ParseNullInt64(row.memoryUsagePeak),
+			MemoryUsageAverage: database.ParseNullInt64(row.memoryUsageAverage),
+		})
+	}
+	return runs, rows.Err()
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/metrics"
+)
+
+func TestGetJobRuns(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get(), userAnnotationPrefix, &compress.NoOpCompressor{}, true)
+		store := lookoutdb.NewLookoutDb(db, metrics.Get(), 3, 10)
+
+		firstRunId := uuid.NewString()
+		secondRunId := uuid.NewString()
+		job := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Pending(firstRunId, cluster, baseTime).
+			RunningWithNodeLabels(firstRunId, "node-1", map[string]string{"nvidia.com/gpu.product": "V100"}, baseTime).
+			RunFailed(firstRunId, "node-1", 137, "oom", baseTime.Add(time.Minute)).
+			Pending(secondRunId, cluster, baseTime.Add(2*time.Minute)).
+			RunningWithNodeLabels(secondRunId, "node-2", map[string]string{"nvidia.com/gpu.product": "A100"}, baseTime.Add(3*time.Minute)).
+			RunSucceeded(secondRunId, baseTime.Add(4*time.Minute)).
+			Succeeded(baseTime.Add(4 * time.Minute)).
+			Build().
+			Job()
+
+		repo := NewSqlGetJobRunsRepository(db)
+		result, err := repo.GetJobRuns(armadacontext.TODO(), job.JobId)
+		assert.NoError(t, err)
+		assert.Equal(t, job.Runs, result)
+		assert.Equal(t, map[string]string{"nvidia.com/gpu.product": "A100"}, result[1].NodeLabels)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetJobRunsPreempted(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get(), userAnnotationPrefix, &compress.NoOpCompressor{}, true)
+		store := lookoutdb.NewLookoutDb(db, metrics.Get(), 3, 10)
+
+		runId := uuid.NewString()
+		preemptiveJobId := util.NewULID()
+		job := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Pending(runId, cluster, baseTime).
+			Running(runId, node, baseTime.Add(time.Minute)).
+			RunPreempted(runId, preemptiveJobId, baseTime.Add(2*time.Minute)).
+			Build().
+			Job()
+
+		repo := NewSqlGetJobRunsRepository(db)
+		result, err := repo.GetJobRuns(armadacontext.TODO(), job.JobId)
+		assert.NoError(t, err)
+		assert.Equal(t, job.Runs, result)
+		if assert.Len(t, result, 1) {
+			assert.Equal(t, string(lookout.JobRunPreempted), result[0].JobRunState)
+			assert.Equal(t, pointer.String("preempted by job "+preemptiveJobId), result[0].PreemptionReason)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetJobRunsJobNotFound(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		repo := NewSqlGetJobRunsRepository(db)
+		_, err := repo.GetJobRuns(armadacontext.TODO(), jobId)
+		assert.Error(t, err)
+		return nil
+	})
+	assert.NoError(t, err)
+}
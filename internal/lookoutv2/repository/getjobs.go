@@ -18,7 +18,7 @@ import (
 )
 
 type GetJobsRepository interface {
-	GetJobs(ctx *armadacontext.Context, filters []*model.Filter, order *model.Order, skip int, take int) (*GetJobsResult, error)
+	GetJobs(ctx *armadacontext.Context, filters []*model.Filter, activeJobSets bool, order *model.Order, skip int, take int) (*GetJobsResult, error)
 }
 
 type SqlGetJobsRepository struct {
@@ -53,16 +53,24 @@ type jobRow struct {
 }
 
 type runRow struct {
-	jobId       string
-	runId       string
-	cluster     string
-	node        sql.NullString
-	leased      sql.NullTime
-	pending     sql.NullTime
-	started     sql.NullTime
-	finished    sql.NullTime
-	jobRunState int
-	exitCode    sql.NullInt32
+	jobId              string
+	runId              string
+	cluster            string
+	node               sql.NullString
+	nodeLabels         map[string]string
+	leased             sql.NullTime
+	pending            sql.NullTime
+	started            sql.NullTime
+	finished           sql.NullTime
+	jobRunState        int
+	exitCode           sql.NullInt32
+	preemptionReason   sql.NullString
+	failureCategory    sql.NullString
+	artifacts          []model.Artifact
+	cpuUsagePeak       sql.NullInt64
+	cpuUsageAverage    sql.NullInt64
+	memoryUsagePeak    sql.NullInt64
+	memoryUsageAverage sql.NullInt64
 }
 
 type annotationRow struct {
@@ -184,15 +192,23 @@ func rowsToJobs(jobRows []*jobRow, runRows []*runRow, annotationRows []*annotati
 
 	for _, row := range runRows {
 		run := &model.Run{
-			Cluster:     row.cluster,
-			ExitCode:    database.ParseNullInt32(row.exitCode),
-			Finished:    database.ParseNullTime(row.finished),
-			JobRunState: string(lookout.JobRunStateMap[row.jobRunState]),
-			Node:        database.ParseNullString(row.node),
-			Leased:      database.ParseNullTime(row.leased),
-			Pending:     database.ParseNullTime(row.pending),
-			RunId:       row.runId,
-			Started:     database.ParseNullTime(row.started),
+			Cluster:            row.cluster,
+			ExitCode:           database.ParseNullInt32(row.exitCode),
+			Finished:           database.ParseNullTime(row.finished),
+			JobRunState:        string(lookout.JobRunStateMap[row.jobRunState]),
+			Node:               database.ParseNullString(row.node),
+			NodeLabels:         row.nodeLabels,
+			Leased:             database.ParseNullTime(row.leased),
+			Pending:            database.ParseNullTime(row.pending),
+			RunId:              row.runId,
+			Started:            database.ParseNullTime(row.started),
+			PreemptionReason:   database.ParseNullString(row.preemptionReason),
+			FailureCategory:    database.ParseNullString(row.failureCategory),
+			Artifacts:          row.artifacts,
+			CpuUsagePeak:       database.ParseNullInt64(row.cpuUsagePeak),
+			CpuUsageAverage:    database.ParseNullInt64(row.cpuUsageAverage),
+			MemoryUsagePeak:    database.ParseNullInt64(row.memoryUsagePeak),
+			MemoryUsageAverage: database.ParseNullInt64(row.memoryUsageAverage),
 		}
 		job, ok := jobMap[row.jobId]
 		if !ok {
@@ -313,12 +329,20 @@ func makeRunRows(ctx *armadacontext.Context, tx pgx.Tx, tmpTableName string) ([]
 			jr.run_id,
 			jr.cluster,
 			jr.node,
+			jr.node_labels,
 			jr.leased,
 			jr.pending,
 			jr.started,
 			jr.finished,
 			jr.job_run_state,
-			jr.exit_code
+			jr.exit_code,
+			jr.preemption_reason,
+			jr.failure_category,
+			jr.artifacts,
+			jr.cpu_usage_peak,
+			jr.cpu_usage_average,
+			jr.memory_usage_peak,
+			jr.memory_usage_average
 		FROM %s AS t
 		INNER JOIN job_run AS jr ON t.job_id = jr.job_id
 	`, tmpTableName)
@@ -336,12 +360,20 @@ func makeRunRows(ctx *armadacontext.Context, tx pgx.Tx, tmpTableName string) ([]
 			&row.runId,
 			&row.cluster,
 			&row.node,
+			&row.nodeLabels,
 			&row.leased,
 			&row.pending,
 			&row.started,
 			&row.finished,
 			&row.jobRunState,
 			&row.exitCode,
+			&row.preemptionReason,
+			&row.failureCategory,
+			&row.artifacts,
+			&row.cpuUsagePeak,
+			&row.cpuUsageAverage,
+			&row.memoryUsagePeak,
+			&row.memoryUsageAverage,
 		)
 		if err != nil {
 			log.WithError(err).Errorf("failed to scan run row at index %d", len(rows))
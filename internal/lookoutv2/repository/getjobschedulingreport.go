@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+type GetJobSchedulingReportRepository interface {
+	GetJobSchedulingReport(ctx *armadacontext.Context, jobId string) (*model.JobSchedulingReport, error)
+}
+
+type SqlGetJobSchedulingReportRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSqlGetJobSchedulingReportRepository(db *pgxpool.Pool) *SqlGetJobSchedulingReportRepository {
+	return &SqlGetJobSchedulingReportRepository{
+		db: db,
+	}
+}
+
+func (r *SqlGetJobSchedulingReportRepository) GetJobSchedulingReport(ctx *armadacontext.Context, jobId string) (*model.JobSchedulingReport, error) {
+	report := &model.JobSchedulingReport{JobId: jobId}
+	var numExcludedNodesByReasonJson []byte
+	err := r.db.QueryRow(ctx, `
+		SELECT reason, pool, num_nodes, num_excluded_nodes_by_reason, reported
+		FROM job_scheduling_report
+		WHERE job_id = $1`, jobId).Scan(
+		&report.Reason,
+		&report.Pool,
+		&report.NumNodes,
+		&numExcludedNodesByReasonJson,
+		&report.Reported,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.Errorf("no scheduling report found for job with id %s", jobId)
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(numExcludedNodesByReasonJson, &report.NumExcludedNodesByReason); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal excluded nodes of scheduling report for job with id %s", jobId)
+	}
+	return report, nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/metrics"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+func TestGetJobSchedulingReport(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get(), userAnnotationPrefix, &compress.NoOpCompressor{}, true)
+		store := lookoutdb.NewLookoutDb(db, metrics.Get(), 3, 10)
+
+		job := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Unschedulable("job does not fit on any node", "cpu", 10, map[string]uint32{"insufficient cpu": 10}, baseTime).
+			Build().
+			Job()
+
+		repo := NewSqlGetJobSchedulingReportRepository(db)
+		result, err := repo.GetJobSchedulingReport(armadacontext.TODO(), job.JobId)
+		require.NoError(t, err)
+		assert.Equal(t, &model.JobSchedulingReport{
+			JobId:                    job.JobId,
+			Reason:                   "job does not fit on any node",
+			Pool:                     "cpu",
+			NumNodes:                 10,
+			NumExcludedNodesByReason: map[string]int{"insufficient cpu": 10},
+			Reported:                 baseTime,
+		}, result)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetJobSchedulingReportNotFound(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		repo := NewSqlGetJobSchedulingReportRepository(db)
+		_, err := repo.GetJobSchedulingReport(armadacontext.TODO(), jobId)
+		assert.Error(t, err)
+		return nil
+	})
+	assert.NoError(t, err)
+}
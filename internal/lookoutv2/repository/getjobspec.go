@@ -50,3 +50,30 @@ func (r *SqlGetJobSpecRepository) GetJobSpec(ctx *armadacontext.Context, jobId s
 	}
 	return &job, nil
 }
+
+// GetRecentJobSpecs returns the marshalled, uncompressed specs of the n most recently submitted jobs,
+// e.g., to train a compression dictionary on.
+func (r *SqlGetJobSpecRepository) GetRecentJobSpecs(ctx *armadacontext.Context, n int) ([][]byte, error) {
+	rows, err := r.db.Query(ctx, "SELECT job_spec FROM job ORDER BY submitted DESC LIMIT $1", n)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+	var specs [][]byte
+	for rows.Next() {
+		var rawBytes []byte
+		if err := rows.Scan(&rawBytes); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		decompressed, err := r.decompressor.Decompress(rawBytes)
+		if err != nil {
+			return nil, err
+		}
+		// Decompressors may reuse their output buffer.
+		specs = append(specs, append([]byte(nil), decompressed...))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return specs, nil
+}
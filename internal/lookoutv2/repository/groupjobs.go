@@ -24,8 +24,9 @@ type GroupJobsRepository interface {
 	GroupBy(
 		ctx *armadacontext.Context,
 		filters []*model.Filter,
+		activeJobSets bool,
 		order *model.Order,
-		groupedField string,
+		groupedField *model.GroupedField,
 		aggregates []string,
 		skip int,
 		take int,
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+// maxJobStatsBuckets bounds the number of time buckets a single request for job statistics may span.
+const maxJobStatsBuckets = 10000
+
+// GetJobStatsRepository computes time-bucketed job statistics per queue or job set.
+type GetJobStatsRepository interface {
+	GetJobStats(
+		ctx *armadacontext.Context,
+		filters []*model.Filter,
+		activeJobSets bool,
+		groupedField string,
+		start time.Time,
+		end time.Time,
+		bucket time.Duration,
+	) ([]*model.JobStatsBucket, error)
+}
+
+type SqlGetJobStatsRepository struct {
+	db            *pgxpool.Pool
+	lookoutTables *LookoutTables
+}
+
+func NewSqlGetJobStatsRepository(db *pgxpool.Pool) *SqlGetJobStatsRepository {
+	return &SqlGetJobStatsRepository{
+		db:            db,
+		lookoutTables: NewTables(),
+	}
+}
+
+func (r *SqlGetJobStatsRepository) GetJobStats(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	groupedField string,
+	start time.Time,
+	end time.Time,
+	bucket time.Duration,
+) ([]*model.JobStatsBucket, error) {
+	if err := validateJobStatsBuckets(start, end, bucket); err != nil {
+		return nil, err
+	}
+	query, err := NewQueryBuilder(r.lookoutTables).JobStats(filters, activeJobSets, groupedField, start.UTC(), end.UTC(), bucket)
+	if err != nil {
+		return nil, err
+	}
+	logQuery(query)
+	rows, err := r.db.Query(ctx, query.Sql, query.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := []*model.JobStatsBucket{}
+	for rows.Next() {
+		var b model.JobStatsBucket
+		if err := rows.Scan(
+			&b.Group,
+			&b.Start,
+			&b.Submitted,
+			&b.Running,
+			&b.Succeeded,
+			&b.Failed,
+			&b.Cpu,
+			&b.Memory,
+			&b.EphemeralStorage,
+			&b.Gpu,
+		); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, &b)
+	}
+	return buckets, rows.Err()
+}
+
+func validateJobStatsBuckets(start, end time.Time, bucket time.Duration) error {
+	if bucket >= time.Second && end.After(start) && end.Sub(start)/bucket > maxJobStatsBuckets {
+		return errors.Errorf("time range from %s to %s spans more than %d buckets of %s", start, end, maxJobStatsBuckets, bucket)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJobStatsBuckets(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, validateJobStatsBuckets(start, start.Add(24*time.Hour), time.Minute))
+	assert.Error(t, validateJobStatsBuckets(start, start.Add(30*24*time.Hour), time.Minute))
+}
@@ -0,0 +1,632 @@
+package repository
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// regionField is the name of the pseudo-field that jobs can be filtered on in multi-region mode.
+const regionField = "region"
+
+// Region is a single regional lookout that jobs can be read from.
+type Region struct {
+	Name                       string
+	GetJobsRepo                GetJobsRepository
+	GroupJobsRepo              GroupJobsRepository
+	GetJobRunErrorRepo         GetJobRunErrorRepository
+	GetJobSpecRepo             GetJobSpecRepository
+	GetJobRunsRepo             GetJobRunsRepository
+	GetArrayJobRepo            GetArrayJobRepository
+	SearchJobsRepo             SearchJobsRepository
+	GetJobStatsRepo            GetJobStatsRepository
+	GetQueueUsageRepo          GetQueueUsageRepository
+	GetJobQueueRepo            GetJobQueueRepository
+	GetJobSchedulingReportRepo GetJobSchedulingReportRepository
+}
+
+func NewSqlRegion(name string, db *pgxpool.Pool, decompressor compress.Decompressor, userAnnotationPrefix string, searchAnnotationKeys []string) *Region {
+	return &Region{
+		Name:                       name,
+		GetJobsRepo:                NewSqlGetJobsRepository(db),
+		GroupJobsRepo:              NewSqlGroupJobsRepository(db),
+		GetJobRunErrorRepo:         NewSqlGetJobRunErrorRepository(db, decompressor),
+		GetJobSpecRepo:             NewSqlGetJobSpecRepository(db, decompressor),
+		GetJobRunsRepo:             NewSqlGetJobRunsRepository(db),
+		GetArrayJobRepo:            NewSqlGetArrayJobRepository(db, userAnnotationPrefix),
+		SearchJobsRepo:             NewSqlSearchJobsRepository(db, searchAnnotationKeys),
+		GetJobStatsRepo:            NewSqlGetJobStatsRepository(db),
+		GetQueueUsageRepo:          NewSqlGetQueueUsageRepository(db),
+		GetJobQueueRepo:            NewSqlGetJobQueueRepository(db),
+		GetJobSchedulingReportRepo: NewSqlGetJobSchedulingReportRepository(db),
+	}
+}
+
+// MultiRegionRepository queries several regional lookouts and merges the results,
+// such that jobs spread across regional Armada instances can be viewed together.
+// Each job returned is labelled with the name of the region it was read from,
+// and jobs can be filtered by region using the "region" field.
+type MultiRegionRepository struct {
+	regions []*Region
+}
+
+func NewMultiRegionRepository(regions []*Region) (*MultiRegionRepository, error) {
+	if len(regions) == 0 {
+		return nil, errors.New("at least one region must be provided")
+	}
+	names := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		if region.Name == "" {
+			return nil, errors.New("region name must not be empty")
+		}
+		if names[region.Name] {
+			return nil, errors.Errorf("duplicate region %s", region.Name)
+		}
+		names[region.Name] = true
+	}
+	return &MultiRegionRepository{regions: regions}, nil
+}
+
+// GetJobs returns jobs from all regions matching the filters.
+// Since each region orders jobs independently, the first skip+take jobs are read from each region,
+// after which the results are merged according to order.
+func (r *MultiRegionRepository) GetJobs(ctx *armadacontext.Context, filters []*model.Filter, activeJobSets bool, order *model.Order, skip int, take int) (*GetJobsResult, error) {
+	regions, filters, err := r.regionsForFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMultiRegionJobOrder(order); err != nil {
+		return nil, err
+	}
+	regionTake := 0
+	if take > 0 {
+		regionTake = skip + take
+	}
+	results := make([]*GetJobsResult, len(regions))
+	g, ctx := armadacontext.ErrGroup(ctx)
+	for i, region := range regions {
+		i, region := i, region
+		g.Go(func() error {
+			result, err := region.GetJobsRepo.GetJobs(ctx, filters, activeJobSets, order, 0, regionTake)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to get jobs from region %s", region.Name)
+			}
+			for _, job := range result.Jobs {
+				job.Region = region.Name
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	count := 0
+	var jobs []*model.Job
+	for _, result := range results {
+		count += result.Count
+		jobs = append(jobs, result.Jobs...)
+	}
+	if !orderIsNull(order) {
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return jobLess(jobs[i], jobs[j], order)
+		})
+	}
+	return &GetJobsResult{
+		Jobs:  paginate(jobs, skip, take),
+		Count: count,
+	}, nil
+}
+
+// GroupBy groups jobs from all regions matching the filters.
+// Groups with the same name in different regions are merged into a single group.
+// Since merging changes the counts and aggregates of groups, all groups are read from each region.
+func (r *MultiRegionRepository) GroupBy(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	order *model.Order,
+	groupedField *model.GroupedField,
+	aggregates []string,
+	skip int,
+	take int,
+) (*GroupByResult, error) {
+	regions, filters, err := r.regionsForFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*GroupByResult, len(regions))
+	g, ctx := armadacontext.ErrGroup(ctx)
+	for i, region := range regions {
+		i, region := i, region
+		g.Go(func() error {
+			result, err := region.GroupJobsRepo.GroupBy(ctx, filters, activeJobSets, order, groupedField, aggregates, 0, 0)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to group jobs from region %s", region.Name)
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var groups []*model.JobGroup
+	groupsByName := make(map[string]*model.JobGroup)
+	for _, result := range results {
+		for _, group := range result.Groups {
+			existing, ok := groupsByName[group.Name]
+			if !ok {
+				groupsByName[group.Name] = group
+				groups = append(groups, group)
+				continue
+			}
+			if err := mergeGroup(existing, group); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groupLess(groups[i], groups[j], order)
+	})
+	return &GroupByResult{
+		Count:  len(groups),
+		Groups: paginate(groups, skip, take),
+	}, nil
+}
+
+// GetJobRunError returns the error of the run with the provided id from the first region that has it.
+func (r *MultiRegionRepository) GetJobRunError(ctx *armadacontext.Context, runId string) (string, error) {
+	var err error
+	for _, region := range r.regions {
+		var result string
+		result, err = region.GetJobRunErrorRepo.GetJobRunError(ctx, runId)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return "", err
+}
+
+// GetJobSpec returns the spec of the job with the provided id from the first region that has it.
+func (r *MultiRegionRepository) GetJobSpec(ctx *armadacontext.Context, jobId string) (*api.Job, error) {
+	var err error
+	for _, region := range r.regions {
+		var result *api.Job
+		result, err = region.GetJobSpecRepo.GetJobSpec(ctx, jobId)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return nil, err
+}
+
+// GetJobSchedulingReport returns the scheduling report of the job with the provided id from the first region that has it.
+func (r *MultiRegionRepository) GetJobSchedulingReport(ctx *armadacontext.Context, jobId string) (*model.JobSchedulingReport, error) {
+	var err error
+	for _, region := range r.regions {
+		var result *model.JobSchedulingReport
+		result, err = region.GetJobSchedulingReportRepo.GetJobSchedulingReport(ctx, jobId)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return nil, err
+}
+
+// GetJobRuns returns the runs of the job with the provided id from the first region that has it.
+func (r *MultiRegionRepository) GetJobRuns(ctx *armadacontext.Context, jobId string) ([]*model.Run, error) {
+	var err error
+	for _, region := range r.regions {
+		var result []*model.Run
+		result, err = region.GetJobRunsRepo.GetJobRuns(ctx, jobId)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return nil, err
+}
+
+// GetArrayJob returns the status of the array job with the provided id from the first region that has it.
+// All tasks of an array job are submitted to the same region.
+func (r *MultiRegionRepository) GetArrayJob(ctx *armadacontext.Context, arrayId string) (*model.ArrayJob, error) {
+	var err error
+	for _, region := range r.regions {
+		var result *model.ArrayJob
+		result, err = region.GetArrayJobRepo.GetArrayJob(ctx, arrayId)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return nil, err
+}
+
+// GetJobQueue returns the queue of the job with the provided id from the first region that has it.
+func (r *MultiRegionRepository) GetJobQueue(ctx *armadacontext.Context, jobId string) (string, error) {
+	var err error
+	for _, region := range r.regions {
+		var result string
+		result, err = region.GetJobQueueRepo.GetJobQueue(ctx, jobId)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return "", err
+}
+
+// GetRunQueue returns the queue of the job the run with the provided id belongs to, from the first region that has it.
+func (r *MultiRegionRepository) GetRunQueue(ctx *armadacontext.Context, runId string) (string, error) {
+	var err error
+	for _, region := range r.regions {
+		var result string
+		result, err = region.GetJobQueueRepo.GetRunQueue(ctx, runId)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return "", err
+}
+
+// SearchJobs searches jobs in all regions and merges the results by score.
+func (r *MultiRegionRepository) SearchJobs(ctx *armadacontext.Context, query string, queues []string, take int) ([]*model.SearchResult, error) {
+	results := make([][]*model.SearchResult, len(r.regions))
+	g, ctx := armadacontext.ErrGroup(ctx)
+	for i, region := range r.regions {
+		i, region := i, region
+		g.Go(func() error {
+			result, err := region.SearchJobsRepo.SearchJobs(ctx, query, queues, take)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to search jobs in region %s", region.Name)
+			}
+			for _, searchResult := range result {
+				searchResult.Job.Region = region.Name
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var merged []*model.SearchResult
+	for _, result := range results {
+		merged = append(merged, result...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Score > merged[j].Score
+	})
+	return paginate(merged, 0, searchTake(take)), nil
+}
+
+// GetJobStats computes job statistics in all regions matching the filters.
+// Statistics of the same group and time bucket in different regions are summed.
+func (r *MultiRegionRepository) GetJobStats(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	groupedField string,
+	start time.Time,
+	end time.Time,
+	bucket time.Duration,
+) ([]*model.JobStatsBucket, error) {
+	regions, filters, err := r.regionsForFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+	results := make([][]*model.JobStatsBucket, len(regions))
+	g, ctx := armadacontext.ErrGroup(ctx)
+	for i, region := range regions {
+		i, region := i, region
+		g.Go(func() error {
+			result, err := region.GetJobStatsRepo.GetJobStats(ctx, filters, activeJobSets, groupedField, start, end, bucket)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to get job stats from region %s", region.Name)
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	type bucketKey struct {
+		start time.Time
+		group string
+	}
+	buckets := []*model.JobStatsBucket{}
+	bucketsByKey := make(map[bucketKey]*model.JobStatsBucket)
+	for _, result := range results {
+		for _, b := range result {
+			key := bucketKey{start: b.Start, group: b.Group}
+			existing, ok := bucketsByKey[key]
+			if !ok {
+				bucketsByKey[key] = b
+				buckets = append(buckets, b)
+				continue
+			}
+			existing.Submitted += b.Submitted
+			existing.Running += b.Running
+			existing.Succeeded += b.Succeeded
+			existing.Failed += b.Failed
+			existing.Cpu += b.Cpu
+			existing.Memory += b.Memory
+			existing.EphemeralStorage += b.EphemeralStorage
+			existing.Gpu += b.Gpu
+		}
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if !buckets[i].Start.Equal(buckets[j].Start) {
+			return buckets[i].Start.Before(buckets[j].Start)
+		}
+		return buckets[i].Group < buckets[j].Group
+	})
+	return buckets, nil
+}
+
+// GetQueueUsage computes the resource usage of each queue in all regions matching the filters.
+// Usage of the same queue, cluster, and time bucket in different regions is summed.
+func (r *MultiRegionRepository) GetQueueUsage(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	start time.Time,
+	end time.Time,
+	bucket time.Duration,
+) ([]*model.QueueUsageBucket, error) {
+	regions, filters, err := r.regionsForFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+	results := make([][]*model.QueueUsageBucket, len(regions))
+	g, ctx := armadacontext.ErrGroup(ctx)
+	for i, region := range regions {
+		i, region := i, region
+		g.Go(func() error {
+			result, err := region.GetQueueUsageRepo.GetQueueUsage(ctx, filters, activeJobSets, start, end, bucket)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to get queue usage from region %s", region.Name)
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	type bucketKey struct {
+		start   time.Time
+		queue   string
+		cluster string
+	}
+	buckets := []*model.QueueUsageBucket{}
+	bucketsByKey := make(map[bucketKey]*model.QueueUsageBucket)
+	for _, result := range results {
+		for _, b := range result {
+			key := bucketKey{start: b.Start, queue: b.Queue, cluster: b.Cluster}
+			existing, ok := bucketsByKey[key]
+			if !ok {
+				bucketsByKey[key] = b
+				buckets = append(buckets, b)
+				continue
+			}
+			existing.CpuSeconds += b.CpuSeconds
+			existing.MemorySeconds += b.MemorySeconds
+			existing.EphemeralStorageSeconds += b.EphemeralStorageSeconds
+			existing.GpuSeconds += b.GpuSeconds
+		}
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if !buckets[i].Start.Equal(buckets[j].Start) {
+			return buckets[i].Start.Before(buckets[j].Start)
+		}
+		if buckets[i].Queue != buckets[j].Queue {
+			return buckets[i].Queue < buckets[j].Queue
+		}
+		return buckets[i].Cluster < buckets[j].Cluster
+	})
+	return buckets, nil
+}
+
+// regionsForFilters returns the regions selected by any region filters,
+// together with the remaining filters to be passed on to each region.
+func (r *MultiRegionRepository) regionsForFilters(filters []*model.Filter) ([]*Region, []*model.Filter, error) {
+	regions := r.regions
+	var remaining []*model.Filter
+	for _, filter := range filters {
+		if filter.IsAnnotation || filter.Field != regionField {
+			remaining = append(remaining, filter)
+			continue
+		}
+		var names []string
+		switch filter.Match {
+		case model.MatchExact:
+			name, ok := filter.Value.(string)
+			if !ok {
+				return nil, nil, errors.Errorf("invalid value for field %s: %v", regionField, filter.Value)
+			}
+			names = []string{name}
+		case model.MatchAnyOf:
+			var err error
+			names, err = toStringSlice(filter.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, errors.Errorf("match %s is not supported for field %s", filter.Match, regionField)
+		}
+		var selected []*Region
+		for _, region := range regions {
+			for _, name := range names {
+				if region.Name == name {
+					selected = append(selected, region)
+					break
+				}
+			}
+		}
+		regions = selected
+	}
+	if remaining == nil {
+		remaining = []*model.Filter{}
+	}
+	return regions, remaining, nil
+}
+
+func validateMultiRegionJobOrder(order *model.Order) error {
+	if orderIsNull(order) {
+		return nil
+	}
+	switch order.Field {
+	case "jobId", submittedField, lastTransitionTimeField:
+		return nil
+	default:
+		return errors.Errorf("cannot order by field %s", order.Field)
+	}
+}
+
+func jobLess(a, b *model.Job, order *model.Order) bool {
+	var cmp int
+	switch order.Field {
+	case "jobId":
+		cmp = strings.Compare(a.JobId, b.JobId)
+	case submittedField:
+		cmp = compareTimes(a.Submitted, b.Submitted)
+	case lastTransitionTimeField:
+		cmp = compareTimes(a.LastTransitionTime, b.LastTransitionTime)
+	}
+	if order.Direction == model.DirectionDesc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// groupLess orders groups by order, breaking ties by name.
+// If no order is provided, groups are ordered by name.
+func groupLess(a, b *model.JobGroup, order *model.Order) bool {
+	cmp := 0
+	if !orderIsNull(order) {
+		switch order.Field {
+		case countCol:
+			if a.Count < b.Count {
+				cmp = -1
+			} else if a.Count > b.Count {
+				cmp = 1
+			}
+		default:
+			cmp = compareTimes(aggregateTime(a, order.Field), aggregateTime(b, order.Field))
+		}
+		if order.Direction == model.DirectionDesc {
+			cmp = -cmp
+		}
+	}
+	if cmp != 0 {
+		return cmp < 0
+	}
+	return a.Name < b.Name
+}
+
+// mergeGroup merges the counts and aggregates of src into dst.
+func mergeGroup(dst, src *model.JobGroup) error {
+	for field, srcValue := range src.Aggregates {
+		dstValue, ok := dst.Aggregates[field]
+		if !ok {
+			dst.Aggregates[field] = srcValue
+			continue
+		}
+		switch field {
+		case submittedField:
+			dstTime, err := parseAggregateTime(dstValue)
+			if err != nil {
+				return err
+			}
+			srcTime, err := parseAggregateTime(srcValue)
+			if err != nil {
+				return err
+			}
+			if srcTime.After(dstTime) {
+				dst.Aggregates[field] = srcValue
+			}
+		case lastTransitionTimeField:
+			dstTime, err := parseAggregateTime(dstValue)
+			if err != nil {
+				return err
+			}
+			srcTime, err := parseAggregateTime(srcValue)
+			if err != nil {
+				return err
+			}
+			// Average weighted by the number of jobs in each group.
+			total := dst.Count + src.Count
+			if total == 0 {
+				continue
+			}
+			avg := (float64(dstTime.Unix())*float64(dst.Count) + float64(srcTime.Unix())*float64(src.Count)) / float64(total)
+			dst.Aggregates[field] = time.Unix(int64(math.Round(avg)), 0).Format(time.RFC3339)
+		case stateField:
+			dstCounts, ok := dstValue.(map[string]int)
+			if !ok {
+				return errors.Errorf("failed to merge state aggregate: unexpected type %T", dstValue)
+			}
+			srcCounts, ok := srcValue.(map[string]int)
+			if !ok {
+				return errors.Errorf("failed to merge state aggregate: unexpected type %T", srcValue)
+			}
+			for state, n := range srcCounts {
+				dstCounts[state] += n
+			}
+		default:
+			return errors.Errorf("cannot merge aggregate for field %s", field)
+		}
+	}
+	dst.Count += src.Count
+	return nil
+}
+
+func aggregateTime(group *model.JobGroup, field string) time.Time {
+	t, err := parseAggregateTime(group.Aggregates[field])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func parseAggregateTime(value interface{}) (time.Time, error) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, errors.Errorf("failed to parse time aggregate: unexpected type %T", value)
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func compareTimes(a, b time.Time) int {
+	if a.Before(b) {
+		return -1
+	} else if a.After(b) {
+		return 1
+	}
+	return 0
+}
+
+func paginate[T any](items []T, skip, take int) []T {
+	if skip >= len(items) {
+		return []T{}
+	}
+	items = items[skip:]
+	if take > 0 && take < len(items) {
+		items = items[:take]
+	}
+	return items
+}
@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type fakeRegionRepository struct {
+	jobs      []*model.Job
+	groups    []*model.JobGroup
+	specs     map[string]*api.Job
+	runs      map[string][]*model.Run
+	arrayJobs map[string]*model.ArrayJob
+	results   []*model.SearchResult
+	stats     []*model.JobStatsBucket
+	usage     []*model.QueueUsageBucket
+}
+
+func (r *fakeRegionRepository) GetJobs(_ *armadacontext.Context, _ []*model.Filter, _ bool, _ *model.Order, skip int, take int) (*GetJobsResult, error) {
+	return &GetJobsResult{Jobs: paginate(r.jobs, skip, take), Count: len(r.jobs)}, nil
+}
+
+func (r *fakeRegionRepository) GroupBy(_ *armadacontext.Context, _ []*model.Filter, _ bool, _ *model.Order, _ *model.GroupedField, _ []string, skip int, take int) (*GroupByResult, error) {
+	return &GroupByResult{Groups: paginate(r.groups, skip, take), Count: len(r.groups)}, nil
+}
+
+func (r *fakeRegionRepository) GetJobRunError(_ *armadacontext.Context, runId string) (string, error) {
+	return "", errors.Errorf("no error found for run with id %s", runId)
+}
+
+func (r *fakeRegionRepository) GetJobSpec(_ *armadacontext.Context, jobId string) (*api.Job, error) {
+	if job, ok := r.specs[jobId]; ok {
+		return job, nil
+	}
+	return nil, errors.Errorf("job with id %s not found", jobId)
+}
+
+func (r *fakeRegionRepository) GetJobRuns(_ *armadacontext.Context, jobId string) ([]*model.Run, error) {
+	if runs, ok := r.runs[jobId]; ok {
+		return runs, nil
+	}
+	return nil, errors.Errorf("job with id %s not found", jobId)
+}
+
+func (r *fakeRegionRepository) GetArrayJob(_ *armadacontext.Context, arrayId string) (*model.ArrayJob, error) {
+	if arrayJob, ok := r.arrayJobs[arrayId]; ok {
+		return arrayJob, nil
+	}
+	return nil, errors.Errorf("array job with id %s not found", arrayId)
+}
+
+func (r *fakeRegionRepository) SearchJobs(_ *armadacontext.Context, _ string, _ []string, take int) ([]*model.SearchResult, error) {
+	return paginate(r.results, 0, take), nil
+}
+
+func (r *fakeRegionRepository) GetJobStats(_ *armadacontext.Context, _ []*model.Filter, _ bool, _ string, _ time.Time, _ time.Time, _ time.Duration) ([]*model.JobStatsBucket, error) {
+	return r.stats, nil
+}
+
+func (r *fakeRegionRepository) GetQueueUsage(_ *armadacontext.Context, _ []*model.Filter, _ bool, _ time.Time, _ time.Time, _ time.Duration) ([]*model.QueueUsageBucket, error) {
+	return r.usage, nil
+}
+
+func (r *fakeRegionRepository) GetJobQueue(_ *armadacontext.Context, jobId string) (string, error) {
+	for _, job := range r.jobs {
+		if job.JobId == jobId {
+			return job.Queue, nil
+		}
+	}
+	return "", errors.Errorf("job with id %s not found", jobId)
+}
+
+func (r *fakeRegionRepository) GetRunQueue(_ *armadacontext.Context, runId string) (string, error) {
+	for _, job := range r.jobs {
+		for _, run := range job.Runs {
+			if run.RunId == runId {
+				return job.Queue, nil
+			}
+		}
+	}
+	return "", errors.Errorf("run with id %s not found", runId)
+}
+
+func newFakeRegion(name string, repo *fakeRegionRepository) *Region {
+	return &Region{
+		Name:               name,
+		GetJobsRepo:        repo,
+		GroupJobsRepo:      repo,
+		GetJobRunErrorRepo: repo,
+		GetJobSpecRepo:     repo,
+		GetJobRunsRepo:     repo,
+		GetArrayJobRepo:    repo,
+		SearchJobsRepo:     repo,
+		GetJobStatsRepo:    repo,
+		GetQueueUsageRepo:  repo,
+		GetJobQueueRepo:    repo,
+	}
+}
+
+func TestMultiRegionRepository_GetJobs(t *testing.T) {
+	baseTime := time.Unix(1000, 0)
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{jobs: []*model.Job{
+			{JobId: "a1", Submitted: baseTime.Add(4 * time.Second)},
+			{JobId: "a2", Submitted: baseTime.Add(2 * time.Second)},
+		}}),
+		newFakeRegion("b", &fakeRegionRepository{jobs: []*model.Job{
+			{JobId: "b1", Submitted: baseTime.Add(3 * time.Second)},
+			{JobId: "b2", Submitted: baseTime.Add(1 * time.Second)},
+		}}),
+	})
+	require.NoError(t, err)
+
+	result, err := repo.GetJobs(
+		armadacontext.TODO(),
+		[]*model.Filter{},
+		false,
+		&model.Order{Field: "submitted", Direction: model.DirectionDesc},
+		1,
+		2,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Count)
+	require.Len(t, result.Jobs, 2)
+	assert.Equal(t, "b1", result.Jobs[0].JobId)
+	assert.Equal(t, "b", result.Jobs[0].Region)
+	assert.Equal(t, "a2", result.Jobs[1].JobId)
+	assert.Equal(t, "a", result.Jobs[1].Region)
+
+	result, err = repo.GetJobs(
+		armadacontext.TODO(),
+		[]*model.Filter{{Field: "region", Match: model.MatchExact, Value: "b"}},
+		false,
+		&model.Order{Field: "jobId", Direction: model.DirectionAsc},
+		0,
+		10,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Count)
+	require.Len(t, result.Jobs, 2)
+	assert.Equal(t, "b1", result.Jobs[0].JobId)
+	assert.Equal(t, "b2", result.Jobs[1].JobId)
+
+	_, err = repo.GetJobs(
+		armadacontext.TODO(),
+		[]*model.Filter{{Field: "region", Match: model.MatchContains, Value: "b"}},
+		false,
+		nil,
+		0,
+		10,
+	)
+	assert.Error(t, err)
+}
+
+func TestMultiRegionRepository_GroupBy(t *testing.T) {
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{groups: []*model.JobGroup{
+			{
+				Name:  "queue-1",
+				Count: 1,
+				Aggregates: map[string]interface{}{
+					"submitted":          time.Unix(100, 0).Format(time.RFC3339),
+					"lastTransitionTime": time.Unix(100, 0).Format(time.RFC3339),
+					"state":              map[string]int{"QUEUED": 1},
+				},
+			},
+			{
+				Name:  "queue-2",
+				Count: 3,
+				Aggregates: map[string]interface{}{
+					"submitted":          time.Unix(100, 0).Format(time.RFC3339),
+					"lastTransitionTime": time.Unix(100, 0).Format(time.RFC3339),
+					"state":              map[string]int{"QUEUED": 3},
+				},
+			},
+		}}),
+		newFakeRegion("b", &fakeRegionRepository{groups: []*model.JobGroup{
+			{
+				Name:  "queue-1",
+				Count: 3,
+				Aggregates: map[string]interface{}{
+					"submitted":          time.Unix(200, 0).Format(time.RFC3339),
+					"lastTransitionTime": time.Unix(200, 0).Format(time.RFC3339),
+					"state":              map[string]int{"QUEUED": 1, "RUNNING": 2},
+				},
+			},
+		}}),
+	})
+	require.NoError(t, err)
+
+	result, err := repo.GroupBy(
+		armadacontext.TODO(),
+		[]*model.Filter{},
+		false,
+		&model.Order{Field: "count", Direction: model.DirectionDesc},
+		&model.GroupedField{Field: "queue"},
+		[]string{"submitted", "lastTransitionTime", "state"},
+		0,
+		10,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Count)
+	assert.Equal(t, []*model.JobGroup{
+		{
+			Name:  "queue-1",
+			Count: 4,
+			Aggregates: map[string]interface{}{
+				"submitted":          time.Unix(200, 0).Format(time.RFC3339),
+				"lastTransitionTime": time.Unix(175, 0).Format(time.RFC3339),
+				"state":              map[string]int{"QUEUED": 2, "RUNNING": 2},
+			},
+		},
+		{
+			Name:  "queue-2",
+			Count: 3,
+			Aggregates: map[string]interface{}{
+				"submitted":          time.Unix(100, 0).Format(time.RFC3339),
+				"lastTransitionTime": time.Unix(100, 0).Format(time.RFC3339),
+				"state":              map[string]int{"QUEUED": 3},
+			},
+		},
+	}, result.Groups)
+}
+
+func TestMultiRegionRepository_GetJobSpec(t *testing.T) {
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{}),
+		newFakeRegion("b", &fakeRegionRepository{specs: map[string]*api.Job{"job": {Id: "job"}}}),
+	})
+	require.NoError(t, err)
+
+	job, err := repo.GetJobSpec(armadacontext.TODO(), "job")
+	require.NoError(t, err)
+	assert.Equal(t, "job", job.Id)
+
+	_, err = repo.GetJobSpec(armadacontext.TODO(), "other")
+	assert.Error(t, err)
+}
+
+func TestMultiRegionRepository_GetJobRuns(t *testing.T) {
+	runs := []*model.Run{{RunId: "run", Node: pointer.String("node"), NodeLabels: map[string]string{"zone": "b"}}}
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{}),
+		newFakeRegion("b", &fakeRegionRepository{runs: map[string][]*model.Run{"job": runs}}),
+	})
+	require.NoError(t, err)
+
+	result, err := repo.GetJobRuns(armadacontext.TODO(), "job")
+	require.NoError(t, err)
+	assert.Equal(t, runs, result)
+
+	_, err = repo.GetJobRuns(armadacontext.TODO(), "other")
+	assert.Error(t, err)
+}
+
+func TestMultiRegionRepository_GetArrayJob(t *testing.T) {
+	arrayJob := &model.ArrayJob{ArrayId: "array", StateCounts: map[string]int64{"QUEUED": 1}}
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{}),
+		newFakeRegion("b", &fakeRegionRepository{arrayJobs: map[string]*model.ArrayJob{"array": arrayJob}}),
+	})
+	require.NoError(t, err)
+
+	result, err := repo.GetArrayJob(armadacontext.TODO(), "array")
+	require.NoError(t, err)
+	assert.Equal(t, arrayJob, result)
+
+	_, err = repo.GetArrayJob(armadacontext.TODO(), "other")
+	assert.Error(t, err)
+}
+
+func TestMultiRegionRepository_GetJobQueue(t *testing.T) {
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{}),
+		newFakeRegion("b", &fakeRegionRepository{jobs: []*model.Job{
+			{JobId: "job", Queue: "queue", Runs: []*model.Run{{RunId: "run"}}},
+		}}),
+	})
+	require.NoError(t, err)
+
+	queue, err := repo.GetJobQueue(armadacontext.TODO(), "job")
+	require.NoError(t, err)
+	assert.Equal(t, "queue", queue)
+
+	queue, err = repo.GetRunQueue(armadacontext.TODO(), "run")
+	require.NoError(t, err)
+	assert.Equal(t, "queue", queue)
+
+	_, err = repo.GetJobQueue(armadacontext.TODO(), "other")
+	assert.Error(t, err)
+}
+
+func TestMultiRegionRepository_SearchJobs(t *testing.T) {
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{results: []*model.SearchResult{
+			{Job: &model.Job{JobId: "a1"}, MatchedField: "jobId", Score: 2},
+			{Job: &model.Job{JobId: "a2"}, MatchedField: "owner", Score: 0.5},
+		}}),
+		newFakeRegion("b", &fakeRegionRepository{results: []*model.SearchResult{
+			{Job: &model.Job{JobId: "b1"}, MatchedField: "jobSet", Score: 1.5},
+		}}),
+	})
+	require.NoError(t, err)
+
+	results, err := repo.SearchJobs(armadacontext.TODO(), "query", nil, 2)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "a1", results[0].Job.JobId)
+	assert.Equal(t, "a", results[0].Job.Region)
+	assert.Equal(t, "b1", results[1].Job.JobId)
+	assert.Equal(t, "b", results[1].Job.Region)
+	assert.Equal(t, "jobSet", results[1].MatchedField)
+}
+
+func TestMultiRegionRepository_GetJobStats(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{stats: []*model.JobStatsBucket{
+			{Start: t0, Group: "queue-1", Submitted: 1, Cpu: 1000},
+			{Start: t1, Group: "queue-1", Succeeded: 1},
+		}}),
+		newFakeRegion("b", &fakeRegionRepository{stats: []*model.JobStatsBucket{
+			{Start: t0, Group: "queue-2", Submitted: 3},
+			{Start: t0, Group: "queue-1", Submitted: 2, Running: 1, Cpu: 500},
+		}}),
+	})
+	require.NoError(t, err)
+
+	result, err := repo.GetJobStats(armadacontext.TODO(), nil, false, "queue", t0, t1.Add(time.Hour), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.JobStatsBucket{
+		{Start: t0, Group: "queue-1", Submitted: 3, Running: 1, Cpu: 1500},
+		{Start: t0, Group: "queue-2", Submitted: 3},
+		{Start: t1, Group: "queue-1", Succeeded: 1},
+	}, result)
+}
+
+func TestMultiRegionRepository_GetQueueUsage(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	repo, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{usage: []*model.QueueUsageBucket{
+			{Start: t0, Queue: "queue-1", Cluster: "cluster-1", CpuSeconds: 3600},
+			{Start: t1, Queue: "queue-1", Cluster: "cluster-1", GpuSeconds: 60},
+		}}),
+		newFakeRegion("b", &fakeRegionRepository{usage: []*model.QueueUsageBucket{
+			{Start: t0, Queue: "queue-1", Cluster: "cluster-2", CpuSeconds: 10},
+			{Start: t0, Queue: "queue-1", Cluster: "cluster-1", CpuSeconds: 1800, MemorySeconds: 100},
+		}}),
+	})
+	require.NoError(t, err)
+
+	result, err := repo.GetQueueUsage(armadacontext.TODO(), nil, false, t0, t1.Add(time.Hour), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.QueueUsageBucket{
+		{Start: t0, Queue: "queue-1", Cluster: "cluster-1", CpuSeconds: 5400, MemorySeconds: 100},
+		{Start: t0, Queue: "queue-1", Cluster: "cluster-2", CpuSeconds: 10},
+		{Start: t1, Queue: "queue-1", Cluster: "cluster-1", GpuSeconds: 60},
+	}, result)
+}
+
+func TestNewMultiRegionRepository_DuplicateRegion(t *testing.T) {
+	_, err := NewMultiRegionRepository([]*Region{
+		newFakeRegion("a", &fakeRegionRepository{}),
+		newFakeRegion("a", &fakeRegionRepository{}),
+	})
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -389,6 +390,213 @@ func (qb *QueryBuilder) GroupBy(
 	}, nil
 }
 
+// JobStats returns Query that counts the jobs submitted, runs started, and jobs succeeded and failed, and sums the
+// resources requested by the jobs submitted, per value of groupedField and time bucket in [start, end).
+// Buckets are bucket wide and aligned to start. Only jobs that transitioned at or after start are considered,
+// since jobs that last transitioned before start can't have been submitted, started, or finished in the range.
+func (qb *QueryBuilder) JobStats(
+	filters []*model.Filter,
+	activeJobSets bool,
+	groupedField string,
+	start time.Time,
+	end time.Time,
+	bucket time.Duration,
+) (*Query, error) {
+	err := qb.validateFilters(filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "filters are invalid")
+	}
+	if groupedField != "queue" && groupedField != "jobSet" {
+		return nil, errors.Errorf("cannot compute job statistics per field %s, must be queue or jobSet", groupedField)
+	}
+	if !end.After(start) {
+		return nil, errors.Errorf("end %s must be after start %s", end, start)
+	}
+	if bucket < time.Second {
+		return nil, errors.Errorf("bucket %s must be at least one second", bucket)
+	}
+
+	normalFilters, annotationFilters := splitFilters(filters)
+	normalFilters = append(normalFilters, &model.Filter{
+		Field: lastTransitionTimeField,
+		Match: model.MatchGreaterThanOrEqualTo,
+		Value: start.Unix(),
+	})
+	fields := append(
+		util.Map(normalFilters, func(filter *model.Filter) string { return filter.Field }),
+		groupedField, submittedField, stateField,
+	)
+	allCols, err := qb.fieldsToCols(fields)
+	if err != nil {
+		return nil, err
+	}
+	tablesFromColumns, err := qb.tablesForCols(allCols)
+	if err != nil {
+		return nil, err
+	}
+	queryTables, err := qb.determineTablesForQuery(tablesFromColumns)
+	if err != nil {
+		return nil, err
+	}
+	queryFilters, err := qb.makeQueryFilters(normalFilters, queryTables)
+	if err != nil {
+		return nil, err
+	}
+	fromBuilder, err := qb.makeFromSql(queryTables, normalFilters, annotationFilters, activeJobSets)
+	if err != nil {
+		return nil, err
+	}
+	whereSql, err := qb.queryFiltersToSql(queryFilters, true)
+	if err != nil {
+		return nil, err
+	}
+	groupCol, err := qb.lookoutTables.ColumnFromField(groupedField)
+	if err != nil {
+		return nil, err
+	}
+
+	startValue := qb.recordValue(start)
+	endValue := qb.recordValue(end)
+	template := fmt.Sprintf(`
+		WITH filtered AS (
+			SELECT j.job_id, j.%[1]s AS stats_group, j.submitted, j.state, j.last_transition_time, j.cpu, j.memory, j.ephemeral_storage, j.gpu
+			%[2]s
+			%[3]s
+		), events AS (
+			SELECT stats_group, submitted AS event_time, 'submitted' AS kind, cpu, memory, ephemeral_storage, gpu
+			FROM filtered
+			WHERE submitted >= %[4]s AND submitted < %[5]s
+			UNION ALL
+			SELECT f.stats_group, jr.started, 'running', 0, 0, 0, 0
+			FROM filtered AS f
+			INNER JOIN job_run AS jr ON f.job_id = jr.job_id
+			WHERE jr.started >= %[4]s AND jr.started < %[5]s
+			UNION ALL
+			SELECT stats_group, last_transition_time, CASE WHEN state = %[6]d THEN 'succeeded' ELSE 'failed' END, 0, 0, 0, 0
+			FROM filtered
+			WHERE state IN (%[6]d, %[7]d) AND last_transition_time >= %[4]s AND last_transition_time < %[5]s
+		)
+		SELECT
+			stats_group,
+			%[4]s::timestamp + floor(extract(epoch FROM event_time - %[4]s::timestamp) / %[8]d) * %[8]d * interval '1 second' AS bucket_start,
+			count(*) FILTER (WHERE kind = 'submitted'),
+			count(*) FILTER (WHERE kind = 'running'),
+			count(*) FILTER (WHERE kind = 'succeeded'),
+			count(*) FILTER (WHERE kind = 'failed'),
+			coalesce(sum(cpu), 0)::bigint,
+			coalesce(sum(memory), 0)::bigint,
+			coalesce(sum(ephemeral_storage), 0)::bigint,
+			coalesce(sum(gpu), 0)::bigint
+		FROM events
+		GROUP BY stats_group, bucket_start
+		ORDER BY bucket_start, stats_group`,
+		groupCol, fromBuilder.Build(), whereSql, startValue, endValue,
+		lookout.JobSucceededOrdinal, lookout.JobFailedOrdinal, int64(bucket/time.Second))
+	templated, args := templateSql(template, qb.queryValues)
+	return &Query{
+		Sql:  templated,
+		Args: args,
+	}, nil
+}
+
+// QueueUsage returns Query that sums the resources used by the runs of the jobs matching the filters, i.e., the resources
+// requested by each job multiplied by the number of seconds its runs were running, per queue, cluster, and time bucket
+// in [start, end). Buckets are bucket wide and aligned to start. Runs that haven't finished are considered running until now.
+// Cpu is summed in cpu-seconds, memory and ephemeral storage in byte-seconds, and gpu in gpu-seconds.
+func (qb *QueryBuilder) QueueUsage(
+	filters []*model.Filter,
+	activeJobSets bool,
+	start time.Time,
+	end time.Time,
+	now time.Time,
+	bucket time.Duration,
+) (*Query, error) {
+	err := qb.validateFilters(filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "filters are invalid")
+	}
+	if !end.After(start) {
+		return nil, errors.Errorf("end %s must be after start %s", end, start)
+	}
+	if bucket < time.Second {
+		return nil, errors.Errorf("bucket %s must be at least one second", bucket)
+	}
+
+	normalFilters, annotationFilters := splitFilters(filters)
+	fields := append(
+		util.Map(normalFilters, func(filter *model.Filter) string { return filter.Field }),
+		"queue",
+	)
+	allCols, err := qb.fieldsToCols(fields)
+	if err != nil {
+		return nil, err
+	}
+	tablesFromColumns, err := qb.tablesForCols(allCols)
+	if err != nil {
+		return nil, err
+	}
+	queryTables, err := qb.determineTablesForQuery(tablesFromColumns)
+	if err != nil {
+		return nil, err
+	}
+	queryFilters, err := qb.makeQueryFilters(normalFilters, queryTables)
+	if err != nil {
+		return nil, err
+	}
+	fromBuilder, err := qb.makeFromSql(queryTables, normalFilters, annotationFilters, activeJobSets)
+	if err != nil {
+		return nil, err
+	}
+	whereSql, err := qb.queryFiltersToSql(queryFilters, true)
+	if err != nil {
+		return nil, err
+	}
+
+	startValue := qb.recordValue(start)
+	endValue := qb.recordValue(end)
+	nowValue := qb.recordValue(now)
+	template := fmt.Sprintf(`
+		WITH filtered AS (
+			SELECT j.job_id, j.queue, j.cpu, j.memory, j.ephemeral_storage, j.gpu
+			%[1]s
+			%[2]s
+		), runs AS (
+			SELECT
+				f.queue,
+				jr.cluster,
+				f.cpu,
+				f.memory,
+				f.ephemeral_storage,
+				f.gpu,
+				greatest(jr.started, %[3]s::timestamp) AS run_start,
+				least(coalesce(jr.finished, %[5]s::timestamp), %[4]s::timestamp) AS run_end
+			FROM filtered AS f
+			INNER JOIN job_run AS jr ON f.job_id = jr.job_id
+			WHERE jr.started IS NOT NULL AND jr.started < %[4]s AND (jr.finished IS NULL OR jr.finished > %[3]s)
+		), buckets AS (
+			SELECT bucket_start, bucket_start + %[6]d * interval '1 second' AS bucket_end
+			FROM generate_series(%[3]s::timestamp, %[4]s::timestamp - interval '1 microsecond', %[6]d * interval '1 second') AS bucket_start
+		)
+		SELECT
+			r.queue,
+			r.cluster,
+			b.bucket_start,
+			coalesce(sum(extract(epoch FROM least(r.run_end, b.bucket_end) - greatest(r.run_start, b.bucket_start)) * r.cpu / 1000), 0)::double precision,
+			coalesce(sum(extract(epoch FROM least(r.run_end, b.bucket_end) - greatest(r.run_start, b.bucket_start)) * r.memory), 0)::double precision,
+			coalesce(sum(extract(epoch FROM least(r.run_end, b.bucket_end) - greatest(r.run_start, b.bucket_start)) * r.ephemeral_storage), 0)::double precision,
+			coalesce(sum(extract(epoch FROM least(r.run_end, b.bucket_end) - greatest(r.run_start, b.bucket_start)) * r.gpu), 0)::double precision
+		FROM runs AS r
+		INNER JOIN buckets AS b ON r.run_start < b.bucket_end AND r.run_end > b.bucket_start
+		GROUP BY r.queue, r.cluster, b.bucket_start
+		ORDER BY b.bucket_start, r.queue, r.cluster`,
+		fromBuilder.Build(), whereSql, startValue, endValue, nowValue, int64(bucket/time.Second))
+	templated, args := templateSql(template, qb.queryValues)
+	return &Query{
+		Sql:  templated,
+		Args: args,
+	}, nil
+}
+
 func (qb *QueryBuilder) fieldsToCols(fields []string) ([]string, error) {
 	var cols []string
 	for _, field := range fields {
@@ -661,7 +869,14 @@ func (qb *QueryBuilder) annotationFilterCondition(annotationFilter *model.Filter
 	if err != nil {
 		return "", err
 	}
-	value, err := qb.valueForMatch(annotationFilter.Value, annotationFilter.Match)
+	filterValue := annotationFilter.Value
+	if annotationFilter.Match == model.MatchAnyOf {
+		filterValue, err = toStringSlice(filterValue)
+		if err != nil {
+			return "", err
+		}
+	}
+	value, err := qb.valueForMatch(filterValue, annotationFilter.Match)
 	if err != nil {
 		return "", err
 	}
@@ -705,6 +920,11 @@ func (qb *QueryBuilder) makeQueryFilters(filters []*model.Filter, queryTables ma
 			if err != nil {
 				return nil, err
 			}
+		} else if filter.Match == model.MatchAnyOf {
+			value, err = toStringSlice(filter.Value)
+			if err != nil {
+				return nil, err
+			}
 		}
 		result[i] = &queryFilter{
 			column: &queryColumn{
@@ -796,7 +1016,7 @@ func (qb *QueryBuilder) comparatorForMatch(match string) (string, error) {
 	switch match {
 	case model.MatchExact:
 		return "=", nil
-	case model.MatchStartsWith:
+	case model.MatchStartsWith, model.MatchPrefix:
 		return "LIKE", nil
 	case model.MatchContains:
 		return "LIKE", nil
@@ -820,7 +1040,7 @@ func (qb *QueryBuilder) comparatorForMatch(match string) (string, error) {
 // Returns string to render in SQL, updates valuesMap with corresponding value(s)
 func (qb *QueryBuilder) valueForMatch(value interface{}, match string) (string, error) {
 	switch match {
-	case model.MatchStartsWith:
+	case model.MatchStartsWith, model.MatchPrefix:
 		s := parseStringForLike(value)
 		v := fmt.Sprintf("%s%%", s)
 		return qb.recordValue(v), nil
@@ -829,16 +1049,26 @@ func (qb *QueryBuilder) valueForMatch(value interface{}, match string) (string,
 		v := fmt.Sprintf("%%%s%%", s)
 		return qb.recordValue(v), nil
 	case model.MatchAnyOf:
+		var values []string
 		switch v := value.(type) {
 		case []int:
-			ids := make([]string, len(v))
+			values = make([]string, len(v))
+			for i, val := range v {
+				values[i] = qb.recordValue(val)
+			}
+		case []string:
+			values = make([]string, len(v))
 			for i, val := range v {
-				ids[i] = qb.recordValue(val)
+				values[i] = qb.recordValue(val)
 			}
-			return fmt.Sprintf("(%s)", strings.Join(ids, ", ")), nil
 		default:
 			return "", errors.Errorf("unsupported type for anyOf: %T", v)
 		}
+		if len(values) == 0 {
+			// Nothing is equal to NULL, so an empty list matches nothing, as expected.
+			return "(NULL)", nil
+		}
+		return fmt.Sprintf("(%s)", strings.Join(values, ", ")), nil
 	default:
 		return qb.recordValue(value), nil
 	}
@@ -1011,6 +1241,9 @@ func (qb *QueryBuilder) validateFilters(filters []*model.Filter) error {
 }
 
 func (qb *QueryBuilder) validateFilter(filter *model.Filter) error {
+	if filter.Value == nil && filter.Match != model.MatchExists {
+		return errors.Errorf("no value specified for match %s on field %s", filter.Match, filter.Field)
+	}
 	if filter.IsAnnotation {
 		return validateAnnotationFilter(filter)
 	}
@@ -1030,7 +1263,9 @@ func (qb *QueryBuilder) validateFilter(filter *model.Filter) error {
 func validateAnnotationFilter(filter *model.Filter) error {
 	if !slices.Contains([]string{
 		model.MatchExact,
+		model.MatchAnyOf,
 		model.MatchStartsWith,
+		model.MatchPrefix,
 		model.MatchContains,
 		model.MatchExists,
 	}, filter.Match) {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -718,6 +719,220 @@ func TestQueryBuilder_GroupBy_ActiveJobSets(t *testing.T) {
 	assert.Equal(t, []interface{}{"test\\queue", "1234", "abcd", "test\\queue", "5678", "efgh%", "test\\queue", "anon\\\\one%"}, query.Args)
 }
 
+func TestQueryBuilder_JobCountAnnotationAnyOfAndExists(t *testing.T) {
+	query, err := NewQueryBuilder(NewTables()).JobCount([]*model.Filter{
+		{
+			Field:        "experiment",
+			Match:        model.MatchAnyOf,
+			Value:        []interface{}{"exp-1", "exp-2"},
+			IsAnnotation: true,
+		},
+		{
+			Field:        "owner-team",
+			Match:        model.MatchExists,
+			IsAnnotation: true,
+		},
+	}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, splitByWhitespace(`
+			SELECT COUNT(DISTINCT j.job_id) FROM job AS j
+			INNER JOIN (
+				SELECT job_id
+				FROM user_annotation_lookup
+				WHERE key = $1 AND value IN ($2, $3)
+			) AS ual0 ON j.job_id = ual0.job_id
+			INNER JOIN (
+				SELECT job_id
+				FROM user_annotation_lookup
+				WHERE key = $4
+			) AS ual1 ON j.job_id = ual1.job_id
+		`),
+		splitByWhitespace(query.Sql))
+	assert.Equal(t, []interface{}{"experiment", "exp-1", "exp-2", "owner-team"}, query.Args)
+}
+
+func TestQueryBuilder_JobCountAnnotationPrefixAndContains(t *testing.T) {
+	query, err := NewQueryBuilder(NewTables()).JobCount([]*model.Filter{
+		{
+			Field:        "experiment",
+			Match:        model.MatchPrefix,
+			Value:        "exp-",
+			IsAnnotation: true,
+		},
+		{
+			Field:        "owner-team",
+			Match:        model.MatchContains,
+			Value:        "ml",
+			IsAnnotation: true,
+		},
+	}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, splitByWhitespace(`
+			SELECT COUNT(DISTINCT j.job_id) FROM job AS j
+			INNER JOIN (
+				SELECT job_id
+				FROM user_annotation_lookup
+				WHERE key = $1 AND value LIKE $2
+			) AS ual0 ON j.job_id = ual0.job_id
+			INNER JOIN (
+				SELECT job_id
+				FROM user_annotation_lookup
+				WHERE key = $3 AND value LIKE $4
+			) AS ual1 ON j.job_id = ual1.job_id
+		`),
+		splitByWhitespace(query.Sql))
+	assert.Equal(t, []interface{}{"experiment", "exp-%", "owner-team", "%ml%"}, query.Args)
+}
+
+func TestQueryBuilder_QueueAnyOf(t *testing.T) {
+	query, err := NewQueryBuilder(NewTables()).JobCount([]*model.Filter{
+		{
+			Field: "queue",
+			Match: model.MatchAnyOf,
+			Value: []interface{}{"queue-a", "queue-b"},
+		},
+	}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, splitByWhitespace("SELECT COUNT(*) FROM job AS j WHERE j.queue IN ($1, $2)"),
+		splitByWhitespace(query.Sql))
+	assert.Equal(t, []interface{}{"queue-a", "queue-b"}, query.Args)
+
+	// An empty list matches no jobs
+	query, err = NewQueryBuilder(NewTables()).JobCount([]*model.Filter{
+		{
+			Field: "queue",
+			Match: model.MatchAnyOf,
+			Value: []string{},
+		},
+	}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, splitByWhitespace("SELECT COUNT(*) FROM job AS j WHERE j.queue IN (NULL)"),
+		splitByWhitespace(query.Sql))
+}
+
+func TestQueryBuilder_FilterWithoutValue(t *testing.T) {
+	_, err := NewQueryBuilder(NewTables()).JobCount([]*model.Filter{
+		{
+			Field:        "experiment",
+			Match:        model.MatchExact,
+			IsAnnotation: true,
+		},
+	}, false)
+	assert.Error(t, err)
+}
+
+func TestQueryBuilder_JobStats(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	query, err := NewQueryBuilder(NewTables()).JobStats(
+		[]*model.Filter{{Field: "queue", Match: model.MatchExact, Value: "queue-1"}},
+		false,
+		"jobSet",
+		start,
+		end,
+		time.Hour,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, splitByWhitespace(`
+			WITH filtered AS (
+				SELECT j.job_id, j.jobset AS stats_group, j.submitted, j.state, j.last_transition_time, j.cpu, j.memory, j.ephemeral_storage, j.gpu
+				FROM job AS j
+				WHERE j.queue = $1 AND j.last_transition_time_seconds >= $2
+			), events AS (
+				SELECT stats_group, submitted AS event_time, 'submitted' AS kind, cpu, memory, ephemeral_storage, gpu
+				FROM filtered
+				WHERE submitted >= $3 AND submitted < $4
+				UNION ALL
+				SELECT f.stats_group, jr.started, 'running', 0, 0, 0, 0
+				FROM filtered AS f
+				INNER JOIN job_run AS jr ON f.job_id = jr.job_id
+				WHERE jr.started >= $3 AND jr.started < $4
+				UNION ALL
+				SELECT stats_group, last_transition_time, CASE WHEN state = 4 THEN 'succeeded' ELSE 'failed' END, 0, 0, 0, 0
+				FROM filtered
+				WHERE state IN (4, 5) AND last_transition_time >= $3 AND last_transition_time < $4
+			)
+			SELECT
+				stats_group,
+				$3::timestamp + floor(extract(epoch FROM event_time - $3::timestamp) / 3600) * 3600 * interval '1 second' AS bucket_start,
+				count(*) FILTER (WHERE kind = 'submitted'),
+				count(*) FILTER (WHERE kind = 'running'),
+				count(*) FILTER (WHERE kind = 'succeeded'),
+				count(*) FILTER (WHERE kind = 'failed'),
+				coalesce(sum(cpu), 0)::bigint,
+				coalesce(sum(memory), 0)::bigint,
+				coalesce(sum(ephemeral_storage), 0)::bigint,
+				coalesce(sum(gpu), 0)::bigint
+			FROM events
+			GROUP BY stats_group, bucket_start
+			ORDER BY bucket_start, stats_group
+		`),
+		splitByWhitespace(query.Sql))
+	assert.Equal(t, []interface{}{"queue-1", start.Unix(), start, end}, query.Args)
+}
+
+func TestQueryBuilder_QueueUsage(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	now := end.Add(time.Hour)
+	query, err := NewQueryBuilder(NewTables()).QueueUsage(
+		[]*model.Filter{{Field: "queue", Match: model.MatchExact, Value: "queue-1"}},
+		false,
+		start,
+		end,
+		now,
+		time.Hour,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, splitByWhitespace(`
+			WITH filtered AS (
+				SELECT j.job_id, j.queue, j.cpu, j.memory, j.ephemeral_storage, j.gpu
+				FROM job AS j
+				WHERE j.queue = $1
+			), runs AS (
+				SELECT
+					f.queue,
+					jr.cluster,
+					f.cpu,
+					f.memory,
+					f.ephemeral_storage,
+					f.gpu,
+					greatest(jr.started, $2::timestamp) AS run_start,
+					least(coalesce(jr.finished, $3::timestamp), $4::timestamp) AS run_end
+				FROM filtered AS f
+				INNER JOIN job_run AS jr ON f.job_id = jr.job_id
+				WHERE jr.started IS NOT NULL AND jr.started < $4 AND (jr.finished IS NULL OR jr.finished > $2)
+			), buckets AS (
+				SELECT bucket_start, bucket_start + 3600 * interval '1 second' AS bucket_end
+				FROM generate_series($2::timestamp, $4::timestamp - interval '1 microsecond', 3600 * interval '1 second') AS bucket_start
+			)
+			SELECT
+				r.queue,
+				r.cluster,
+				b.bucket_start,
+				coalesce(sum(extract(epoch FROM least(r.run_end, b.bucket_end) - greatest(r.run_start, b.bucket_start)) * r.cpu / 1000), 0)::double precision,
+				coalesce(sum(extract(epoch FROM least(r.run_end, b.bucket_end) - greatest(r.run_start, b.bucket_start)) * r.memory), 0)::double precision,
+				coalesce(sum(extract(epoch FROM least(r.run_end, b.bucket_end) - greatest(r.run_start, b.bucket_start)) * r.ephemeral_storage), 0)::double precision,
+				coalesce(sum(extract(epoch FROM least(r.run_end, b.bucket_end) - greatest(r.run_start, b.bucket_start)) * r.gpu), 0)::double precision
+			FROM runs AS r
+			INNER JOIN buckets AS b ON r.run_start < b.bucket_end AND r.run_end > b.bucket_start
+			GROUP BY r.queue, r.cluster, b.bucket_start
+			ORDER BY b.bucket_start, r.queue, r.cluster
+		`),
+		splitByWhitespace(query.Sql))
+	assert.Equal(t, []interface{}{"queue-1", start, now, end}, query.Args)
+}
+
+func TestQueryBuilder_JobStatsInvalid(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := NewQueryBuilder(NewTables()).JobStats(nil, false, "owner", start, start.Add(time.Hour), time.Minute)
+	assert.Error(t, err)
+	_, err = NewQueryBuilder(NewTables()).JobStats(nil, false, "queue", start, start, time.Minute)
+	assert.Error(t, err)
+	_, err = NewQueryBuilder(NewTables()).JobStats(nil, false, "queue", start, start.Add(time.Hour), time.Millisecond)
+	assert.Error(t, err)
+}
+
 func splitByWhitespace(s string) []string {
 	return strings.FieldsFunc(s, splitFn)
 }
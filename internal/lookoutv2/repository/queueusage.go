@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+// GetQueueUsageRepository computes the time-bucketed resource usage of the job runs of each queue.
+type GetQueueUsageRepository interface {
+	GetQueueUsage(
+		ctx *armadacontext.Context,
+		filters []*model.Filter,
+		activeJobSets bool,
+		start time.Time,
+		end time.Time,
+		bucket time.Duration,
+	) ([]*model.QueueUsageBucket, error)
+}
+
+type SqlGetQueueUsageRepository struct {
+	db            *pgxpool.Pool
+	lookoutTables *LookoutTables
+}
+
+func NewSqlGetQueueUsageRepository(db *pgxpool.Pool) *SqlGetQueueUsageRepository {
+	return &SqlGetQueueUsageRepository{
+		db:            db,
+		lookoutTables: NewTables(),
+	}
+}
+
+func (r *SqlGetQueueUsageRepository) GetQueueUsage(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	start time.Time,
+	end time.Time,
+	bucket time.Duration,
+) ([]*model.QueueUsageBucket, error) {
+	if err := validateJobStatsBuckets(start, end, bucket); err != nil {
+		return nil, err
+	}
+	query, err := NewQueryBuilder(r.lookoutTables).QueueUsage(filters, activeJobSets, start.UTC(), end.UTC(), time.Now().UTC(), bucket)
+	if err != nil {
+		return nil, err
+	}
+	logQuery(query)
+	rows, err := r.db.Query(ctx, query.Sql, query.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := []*model.QueueUsageBucket{}
+	for rows.Next() {
+		var b model.QueueUsageBucket
+		if err := rows.Scan(
+			&b.Queue,
+			&b.Cluster,
+			&b.Start,
+			&b.CpuSeconds,
+			&b.MemorySeconds,
+			&b.EphemeralStorageSeconds,
+			&b.GpuSeconds,
+		); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, &b)
+	}
+	return buckets, rows.Err()
+}
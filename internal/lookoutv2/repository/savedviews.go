@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+// SavedViewRepository stores the views users have saved.
+// Any user may read any view, but only its owner may update or delete it.
+type SavedViewRepository interface {
+	CreateSavedView(ctx *armadacontext.Context, view *model.SavedView) (*model.SavedView, error)
+	GetSavedView(ctx *armadacontext.Context, viewId string) (*model.SavedView, error)
+	// ListSavedViews returns the views saved by owner, or all views if owner is empty, most recently updated first.
+	ListSavedViews(ctx *armadacontext.Context, owner string) ([]*model.SavedView, error)
+	UpdateSavedView(ctx *armadacontext.Context, view *model.SavedView) (*model.SavedView, error)
+	DeleteSavedView(ctx *armadacontext.Context, viewId string, owner string) error
+}
+
+// savedViewDefinition is the part of a saved view stored as json.
+type savedViewDefinition struct {
+	Filters       []*model.Filter `json:"filters"`
+	Order         *model.Order    `json:"order,omitempty"`
+	ActiveJobSets bool            `json:"activeJobSets,omitempty"`
+	GroupedFields []string        `json:"groupedFields,omitempty"`
+	Columns       []string        `json:"columns,omitempty"`
+}
+
+type SqlSavedViewRepository struct {
+	db    *pgxpool.Pool
+	clock clock.Clock
+}
+
+func NewSqlSavedViewRepository(db *pgxpool.Pool) *SqlSavedViewRepository {
+	return &SqlSavedViewRepository{
+		db:    db,
+		clock: clock.RealClock{},
+	}
+}
+
+func (r *SqlSavedViewRepository) CreateSavedView(ctx *armadacontext.Context, view *model.SavedView) (*model.SavedView, error) {
+	definition, err := marshalSavedViewDefinition(view)
+	if err != nil {
+		return nil, err
+	}
+	created := *view
+	created.ViewId = uuid.NewString()
+	created.Created = r.clock.Now().UTC()
+	created.Updated = created.Created
+	_, err = r.db.Exec(ctx, `
+		INSERT INTO saved_view (view_id, name, description, owner, definition, created, updated)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		created.ViewId, created.Name, created.Description, created.Owner, definition, created.Created, created.Updated)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (r *SqlSavedViewRepository) GetSavedView(ctx *armadacontext.Context, viewId string) (*model.SavedView, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT view_id, name, description, owner, definition, created, updated
+		FROM saved_view
+		WHERE view_id = $1`, viewId)
+	if err != nil {
+		return nil, err
+	}
+	views, err := scanSavedViews(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(views) == 0 {
+		return nil, errors.Errorf("saved view with id %s not found", viewId)
+	}
+	return views[0], nil
+}
+
+func (r *SqlSavedViewRepository) ListSavedViews(ctx *armadacontext.Context, owner string) ([]*model.SavedView, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT view_id, name, description, owner, definition, created, updated
+		FROM saved_view
+		WHERE $1 = '' OR owner = $1
+		ORDER BY updated DESC, view_id`, owner)
+	if err != nil {
+		return nil, err
+	}
+	return scanSavedViews(rows)
+}
+
+func (r *SqlSavedViewRepository) UpdateSavedView(ctx *armadacontext.Context, view *model.SavedView) (*model.SavedView, error) {
+	definition, err := marshalSavedViewDefinition(view)
+	if err != nil {
+		return nil, err
+	}
+	updated := *view
+	updated.Updated = r.clock.Now().UTC()
+	err = r.db.QueryRow(ctx, `
+		UPDATE saved_view
+		SET name = $3, description = $4, definition = $5, updated = $6
+		WHERE view_id = $1 AND owner = $2
+		RETURNING created`,
+		updated.ViewId, updated.Owner, updated.Name, updated.Description, definition, updated.Updated).Scan(&updated.Created)
+	if err == pgx.ErrNoRows {
+		return nil, r.notOwnedError(ctx, updated.ViewId, updated.Owner)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (r *SqlSavedViewRepository) DeleteSavedView(ctx *armadacontext.Context, viewId string, owner string) error {
+	result, err := r.db.Exec(ctx, "DELETE FROM saved_view WHERE view_id = $1 AND owner = $2", viewId, owner)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return r.notOwnedError(ctx, viewId, owner)
+	}
+	return nil
+}
+
+// notOwnedError explains why the view with id viewId couldn't be modified by owner.
+func (r *SqlSavedViewRepository) notOwnedError(ctx *armadacontext.Context, viewId string, owner string) error {
+	view, err := r.GetSavedView(ctx, viewId)
+	if err != nil {
+		return err
+	}
+	return errors.Errorf("saved view with id %s is owned by %s, so it can't be modified by %s", viewId, view.Owner, owner)
+}
+
+func marshalSavedViewDefinition(view *model.SavedView) ([]byte, error) {
+	definition, err := json.Marshal(&savedViewDefinition{
+		Filters:       view.Filters,
+		Order:         view.Order,
+		ActiveJobSets: view.ActiveJobSets,
+		GroupedFields: view.GroupedFields,
+		Columns:       view.Columns,
+	})
+	return definition, errors.WithStack(err)
+}
+
+func scanSavedViews(rows pgx.Rows) ([]*model.SavedView, error) {
+	defer rows.Close()
+	views := []*model.SavedView{}
+	for rows.Next() {
+		var view model.SavedView
+		var definitionJson []byte
+		if err := rows.Scan(
+			&view.ViewId,
+			&view.Name,
+			&view.Description,
+			&view.Owner,
+			&definitionJson,
+			&view.Created,
+			&view.Updated,
+		); err != nil {
+			return nil, err
+		}
+		var definition savedViewDefinition
+		if err := json.Unmarshal(definitionJson, &definition); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal definition of saved view with id %s", view.ViewId)
+		}
+		view.Filters = definition.Filters
+		view.Order = definition.Order
+		view.ActiveJobSets = definition.ActiveJobSets
+		view.GroupedFields = definition.GroupedFields
+		view.Columns = definition.Columns
+		views = append(views, &view)
+	}
+	return views, rows.Err()
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+func TestSavedViews(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ctx := armadacontext.TODO()
+		fakeClock := clock.NewFakeClock(baseTime)
+		repo := NewSqlSavedViewRepository(db)
+		repo.clock = fakeClock
+
+		view, err := repo.CreateSavedView(ctx, &model.SavedView{
+			Name:  "failed gpu jobs",
+			Owner: "alice",
+			Filters: []*model.Filter{
+				{Field: "state", Match: model.MatchAnyOf, Value: []interface{}{"FAILED"}},
+				{Field: "gpu", Match: model.MatchGreaterThan, Value: float64(0)},
+			},
+			Order:         &model.Order{Field: "submitted", Direction: model.DirectionDesc},
+			GroupedFields: []string{"queue"},
+			Columns:       []string{"jobId", "queue", "gpu"},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, view.ViewId)
+		assert.Equal(t, baseTime, view.Created)
+
+		result, err := repo.GetSavedView(ctx, view.ViewId)
+		require.NoError(t, err)
+		assert.Equal(t, view, result)
+
+		fakeClock.Step(1)
+		other, err := repo.CreateSavedView(ctx, &model.SavedView{Name: "bob's jobs", Owner: "bob"})
+		require.NoError(t, err)
+
+		views, err := repo.ListSavedViews(ctx, "")
+		require.NoError(t, err)
+		assert.Equal(t, []*model.SavedView{other, view}, views)
+		views, err = repo.ListSavedViews(ctx, "alice")
+		require.NoError(t, err)
+		assert.Equal(t, []*model.SavedView{view}, views)
+
+		// Only the owner of a view may modify it
+		fakeClock.Step(1)
+		update := *view
+		update.Name = "all failed gpu jobs"
+		update.Owner = "bob"
+		_, err = repo.UpdateSavedView(ctx, &update)
+		assert.Error(t, err)
+		assert.Error(t, repo.DeleteSavedView(ctx, view.ViewId, "bob"))
+
+		update.Owner = "alice"
+		updated, err := repo.UpdateSavedView(ctx, &update)
+		require.NoError(t, err)
+		assert.Equal(t, baseTime, updated.Created)
+		assert.Equal(t, fakeClock.Now().UTC(), updated.Updated)
+		result, err = repo.GetSavedView(ctx, view.ViewId)
+		require.NoError(t, err)
+		assert.Equal(t, updated, result)
+
+		require.NoError(t, repo.DeleteSavedView(ctx, view.ViewId, "alice"))
+		_, err = repo.GetSavedView(ctx, view.ViewId)
+		assert.Error(t, err)
+		assert.Error(t, repo.DeleteSavedView(ctx, view.ViewId, "alice"))
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+)
+
+const (
+	// defaultSearchTake is the number of results returned if the caller doesn't ask for a specific number.
+	defaultSearchTake = 50
+	// maxSearchTake bounds the number of results returned by a single search.
+	maxSearchTake = 500
+)
+
+// SearchJobsRepository performs free-text search over jobs.
+type SearchJobsRepository interface {
+	// SearchJobs returns the take jobs best matching query. If queues isn't nil, only jobs of these queues are searched.
+	SearchJobs(ctx *armadacontext.Context, query string, queues []string, take int) ([]*model.SearchResult, error)
+}
+
+// SqlSearchJobsRepository searches the job id, job set, and owner of jobs, as well as the values of a configured set
+// of annotations, using the trigram indexes on these columns.
+// Jobs are ranked by their best matching field: an exact match is ranked above a prefix match,
+// which is ranked above a match that is only similar to the query, with ties broken by trigram similarity.
+type SqlSearchJobsRepository struct {
+	db             *pgxpool.Pool
+	annotationKeys []string
+}
+
+type searchRow struct {
+	jobId        string
+	matchedField string
+	score        float64
+}
+
+func NewSqlSearchJobsRepository(db *pgxpool.Pool, annotationKeys []string) *SqlSearchJobsRepository {
+	if annotationKeys == nil {
+		annotationKeys = []string{}
+	}
+	return &SqlSearchJobsRepository{
+		db:             db,
+		annotationKeys: annotationKeys,
+	}
+}
+
+func (r *SqlSearchJobsRepository) SearchJobs(ctx *armadacontext.Context, query string, queues []string, take int) ([]*model.SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New("search query must not be empty")
+	}
+	take = searchTake(take)
+
+	var searchRows []*searchRow
+	var jobRows []*jobRow
+	var runRows []*runRow
+	var annotationRows []*annotationRow
+	err := pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{
+		IsoLevel:       pgx.RepeatableRead,
+		AccessMode:     pgx.ReadWrite,
+		DeferrableMode: pgx.Deferrable,
+	}, func(tx pgx.Tx) error {
+		var err error
+		searchRows, err = r.search(ctx, tx, query, queues, take)
+		if err != nil {
+			return err
+		}
+		if len(searchRows) == 0 {
+			return nil
+		}
+
+		createTempTableQuery, tempTableName := NewQueryBuilder(NewTables()).CreateTempTable()
+		logQuery(createTempTableQuery)
+		if _, err := tx.Exec(ctx, createTempTableQuery.Sql, createTempTableQuery.Args...); err != nil {
+			return err
+		}
+		jobIds := make([]string, len(searchRows))
+		for i, row := range searchRows {
+			jobIds[i] = row.jobId
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf("INSERT INTO %s (job_id) SELECT unnest($1::text[])", tempTableName), jobIds); err != nil {
+			return err
+		}
+
+		jobRows, err = makeJobRows(ctx, tx, tempTableName)
+		if err != nil {
+			log.WithError(err).Error("failed getting job rows")
+			return err
+		}
+		runRows, err = makeRunRows(ctx, tx, tempTableName)
+		if err != nil {
+			log.WithError(err).Error("failed getting run rows")
+			return err
+		}
+		annotationRows, err = makeAnnotationRows(ctx, tx, tempTableName)
+		if err != nil {
+			log.WithError(err).Error("failed getting annotation rows")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := rowsToJobs(jobRows, runRows, annotationRows)
+	if err != nil {
+		return nil, err
+	}
+	jobsById := make(map[string]*model.Job, len(jobs))
+	for _, job := range jobs {
+		jobsById[job.JobId] = job
+	}
+	results := make([]*model.SearchResult, 0, len(searchRows))
+	for _, row := range searchRows {
+		job, ok := jobsById[row.jobId]
+		if !ok {
+			// The job was matched by an annotation but has since been pruned.
+			continue
+		}
+		results = append(results, &model.SearchResult{
+			Job:          job,
+			MatchedField: row.matchedField,
+			Score:        row.score,
+		})
+	}
+	return results, nil
+}
+
+// search returns the ids of the take jobs best matching query, best matches first.
+// Jobs are restricted to queues, unless it's nil, before the limit is applied, such that the jobs of other queues
+// don't take the place of matching jobs of these queues.
+func (r *SqlSearchJobsRepository) search(ctx *armadacontext.Context, tx pgx.Tx, query string, queues []string, take int) ([]*searchRow, error) {
+	prefixPattern := escapeLike(query) + "%"
+	restrictQueues := queues != nil
+	if queues == nil {
+		queues = []string{}
+	}
+	rows, err := tx.Query(ctx, `
+		WITH matches AS (
+			SELECT job_id, 'jobId' AS field, job_id AS value FROM job
+			WHERE (job_id ILIKE $1 OR job_id % $2) AND (NOT $5 OR queue = ANY($6))
+			UNION ALL
+			SELECT job_id, 'jobSet', jobset FROM job
+			WHERE (jobset ILIKE $1 OR jobset % $2) AND (NOT $5 OR queue = ANY($6))
+			UNION ALL
+			SELECT job_id, 'owner', owner FROM job
+			WHERE (owner ILIKE $1 OR owner % $2) AND (NOT $5 OR queue = ANY($6))
+			UNION ALL
+			SELECT job_id, key, value FROM user_annotation_lookup
+			WHERE key = ANY($3) AND (value ILIKE $1 OR value % $2) AND (NOT $5 OR queue = ANY($6))
+		), best AS (
+			SELECT DISTINCT ON (job_id)
+				job_id,
+				field,
+				CASE WHEN lower(value) = lower($2) THEN 2 WHEN value ILIKE $1 THEN 1 ELSE 0 END + similarity(value, $2) AS score
+			FROM matches
+			ORDER BY job_id, score DESC
+		)
+		SELECT job_id, field, score FROM best
+		ORDER BY score DESC, job_id
+		LIMIT $4`,
+		prefixPattern, query, r.annotationKeys, take, restrictQueues, queues)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*searchRow
+	for rows.Next() {
+		var row searchRow
+		if err := rows.Scan(&row.jobId, &row.matchedField, &row.score); err != nil {
+			return nil, err
+		}
+		result = append(result, &row)
+	}
+	return result, rows.Err()
+}
+
+func searchTake(take int) int {
+	if take <= 0 {
+		return defaultSearchTake
+	}
+	if take > maxSearchTake {
+		return maxSearchTake
+	}
+	return take
+}
+
+// escapeLike escapes the characters with special meaning in LIKE patterns, such that s is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
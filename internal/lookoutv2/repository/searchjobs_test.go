@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingesterv2/metrics"
+)
+
+func TestSearchJobs(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get(), userAnnotationPrefix, &compress.NoOpCompressor{}, true)
+		store := lookoutdb.NewLookoutDb(db, metrics.Get(), 3, 10)
+
+		exactJobSet := NewJobSimulator(converter, store).
+			Submit(queue, "training", owner, namespace, baseTime, basicJobOpts).
+			Build().
+			Job()
+		prefixJobSet := NewJobSimulator(converter, store).
+			Submit(queue, "training-run-2", owner, namespace, baseTime, basicJobOpts).
+			Build().
+			Job()
+		annotated := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{
+				Annotations: map[string]string{
+					"experiment": "training-sweep",
+					"ignored":    "training",
+				},
+			}).
+			Build().
+			Job()
+		_ = NewJobSimulator(converter, store).
+			Submit(queue, "inference", "someone-else", namespace, baseTime, basicJobOpts).
+			Build().
+			Job()
+
+		repo := NewSqlSearchJobsRepository(db, []string{"experiment"})
+
+		t.Run("ranks exact above prefix matches", func(t *testing.T) {
+			results, err := repo.SearchJobs(armadacontext.TODO(), "training", nil, 10)
+			require.NoError(t, err)
+			require.Len(t, results, 3)
+			assert.Equal(t, exactJobSet, results[0].Job)
+			assert.Equal(t, "jobSet", results[0].MatchedField)
+			assert.ElementsMatch(t, []string{prefixJobSet.JobId, annotated.JobId}, []string{results[1].Job.JobId, results[2].Job.JobId})
+			assert.Greater(t, results[0].Score, results[1].Score)
+		})
+
+		t.Run("matches annotations configured for search", func(t *testing.T) {
+			results, err := repo.SearchJobs(armadacontext.TODO(), "training-sw", nil, 10)
+			require.NoError(t, err)
+			require.NotEmpty(t, results)
+			assert.Equal(t, annotated, results[0].Job)
+			assert.Equal(t, "experiment", results[0].MatchedField)
+		})
+
+		t.Run("matches job id", func(t *testing.T) {
+			results, err := repo.SearchJobs(armadacontext.TODO(), prefixJobSet.JobId, nil, 10)
+			require.NoError(t, err)
+			require.NotEmpty(t, results)
+			assert.Equal(t, prefixJobSet, results[0].Job)
+			assert.Equal(t, "jobId", results[0].MatchedField)
+		})
+
+		t.Run("take", func(t *testing.T) {
+			results, err := repo.SearchJobs(armadacontext.TODO(), "training", nil, 1)
+			require.NoError(t, err)
+			assert.Len(t, results, 1)
+		})
+
+		t.Run("restricts to queues before take", func(t *testing.T) {
+			// Jobs of another queue that match exactly, and hence rank above the prefix match of the visible queue.
+			for i := 0; i < 3; i++ {
+				_ = NewJobSimulator(converter, store).
+					Submit("other-queue", "training-run", owner, namespace, baseTime, basicJobOpts).
+					Build().
+					Job()
+			}
+
+			results, err := repo.SearchJobs(armadacontext.TODO(), "training-run", nil, 3)
+			require.NoError(t, err)
+			require.Len(t, results, 3)
+			for _, result := range results {
+				assert.Equal(t, "other-queue", result.Job.Queue)
+			}
+
+			results, err = repo.SearchJobs(armadacontext.TODO(), "training-run", []string{queue}, 1)
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, prefixJobSet, results[0].Job)
+
+			results, err = repo.SearchJobs(armadacontext.TODO(), "training-run", []string{}, 3)
+			require.NoError(t, err)
+			assert.Empty(t, results)
+		})
+
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestSearchJobs_EmptyQuery(t *testing.T) {
+	repo := NewSqlSearchJobsRepository(nil, nil)
+	_, err := repo.SearchJobs(armadacontext.TODO(), "  ", nil, 10)
+	assert.Error(t, err)
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, `50\% of job\_set \\ x`, escapeLike(`50% of job_set \ x`))
+}
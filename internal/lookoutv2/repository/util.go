@@ -51,15 +51,17 @@ type JobOptions struct {
 }
 
 type runPatch struct {
-	runId       string
-	cluster     *string
-	exitCode    *int32
-	finished    *time.Time
-	jobRunState *string
-	node        *string
-	leased      *time.Time
-	pending     *time.Time
-	started     *time.Time
+	runId            string
+	cluster          *string
+	exitCode         *int32
+	finished         *time.Time
+	jobRunState      *string
+	node             *string
+	nodeLabels       map[string]string
+	leased           *time.Time
+	pending          *time.Time
+	started          *time.Time
+	preemptionReason *string
 }
 
 func NewJobSimulator(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb) *JobSimulator {
@@ -239,6 +241,10 @@ func (js *JobSimulator) Pending(runId string, cluster string, timestamp time.Tim
 }
 
 func (js *JobSimulator) Running(runId string, node string, timestamp time.Time) *JobSimulator {
+	return js.RunningWithNodeLabels(runId, node, nil, timestamp)
+}
+
+func (js *JobSimulator) RunningWithNodeLabels(runId string, node string, nodeLabels map[string]string, timestamp time.Time) *JobSimulator {
 	ts := timestampOrNow(timestamp)
 	runningEvent := &armadaevents.EventSequence_Event{
 		Created: &ts,
@@ -250,8 +256,9 @@ func (js *JobSimulator) Running(runId string, node string, timestamp time.Time)
 					{
 						Info: &armadaevents.KubernetesResourceInfo_PodInfo{
 							PodInfo: &armadaevents.PodInfo{
-								NodeName:  node,
-								PodNumber: 0,
+								NodeName:   node,
+								PodNumber:  0,
+								NodeLabels: nodeLabels,
 							},
 						},
 					},
@@ -268,6 +275,7 @@ func (js *JobSimulator) Running(runId string, node string, timestamp time.Time)
 		runId:       runId,
 		jobRunState: pointer.String(string(lookout.JobRunRunning)),
 		node:        &node,
+		nodeLabels:  nodeLabels,
 		started:     &ts,
 	})
 	return js
@@ -381,6 +389,24 @@ func (js *JobSimulator) Reprioritized(newPriority uint32, timestamp time.Time) *
 	return js
 }
 
+func (js *JobSimulator) Unschedulable(reason string, pool string, numNodes uint32, numExcludedNodesByReason map[string]uint32, timestamp time.Time) *JobSimulator {
+	ts := timestampOrNow(timestamp)
+	unschedulable := &armadaevents.EventSequence_Event{
+		Created: &ts,
+		Event: &armadaevents.EventSequence_Event_JobUnschedulable{
+			JobUnschedulable: &armadaevents.JobUnschedulable{
+				JobId:                    js.jobId,
+				Reason:                   reason,
+				Pool:                     pool,
+				NumNodes:                 numNodes,
+				NumExcludedNodesByReason: numExcludedNodesByReason,
+			},
+		},
+	}
+	js.events = append(js.events, unschedulable)
+	return js
+}
+
 func (js *JobSimulator) RunFailed(runId string, node string, exitCode int32, message string, timestamp time.Time) *JobSimulator {
 	ts := timestampOrNow(timestamp)
 	runFailed := &armadaevents.EventSequence_Event{
@@ -475,6 +501,39 @@ func (js *JobSimulator) Preempted(timestamp time.Time) *JobSimulator {
 	return js
 }
 
+// RunPreempted preempts the run with the provided id in favour of the job with id preemptiveJobId.
+func (js *JobSimulator) RunPreempted(runId string, preemptiveJobId string, timestamp time.Time) *JobSimulator {
+	ts := timestampOrNow(timestamp)
+	preemptiveJobIdProto, err := armadaevents.ProtoUuidFromUlidString(preemptiveJobId)
+	if err != nil {
+		log.WithError(err).Errorf("Could not convert job ID to UUID: %s", preemptiveJobId)
+	}
+
+	preempted := &armadaevents.EventSequence_Event{
+		Created: &ts,
+		Event: &armadaevents.EventSequence_Event_JobRunPreempted{
+			JobRunPreempted: &armadaevents.JobRunPreempted{
+				PreemptedJobId:  js.jobId,
+				PreemptiveJobId: preemptiveJobIdProto,
+				PreemptedRunId:  armadaevents.ProtoUuidFromUuid(uuid.MustParse(runId)),
+				PreemptiveRunId: armadaevents.ProtoUuidFromUuid(uuid.MustParse(uuid.NewString())),
+			},
+		},
+	}
+	js.events = append(js.events, preempted)
+
+	js.job.LastActiveRunId = &runId
+	js.job.LastTransitionTime = ts
+	js.job.State = string(lookout.JobPreempted)
+	updateRun(js.job, &runPatch{
+		runId:            runId,
+		finished:         &ts,
+		jobRunState:      pointer.String(string(lookout.JobRunPreempted)),
+		preemptionReason: pointer.String(fmt.Sprintf("preempted by job %s", preemptiveJobId)),
+	})
+	return js
+}
+
 func (js *JobSimulator) RunTerminated(runId string, cluster string, node string, message string, timestamp time.Time) *JobSimulator {
 	ts := timestampOrNow(timestamp)
 	terminated := &armadaevents.EventSequence_Event{
@@ -628,15 +687,17 @@ func updateRun(job *model.Job, patch *runPatch) {
 		state = *patch.jobRunState
 	}
 	job.Runs = append(job.Runs, &model.Run{
-		Cluster:     cluster,
-		ExitCode:    patch.exitCode,
-		Finished:    patch.finished,
-		JobRunState: state,
-		Node:        patch.node,
-		Leased:      patch.leased,
-		Pending:     patch.pending,
-		RunId:       patch.runId,
-		Started:     patch.started,
+		Cluster:          cluster,
+		ExitCode:         patch.exitCode,
+		Finished:         patch.finished,
+		JobRunState:      state,
+		Node:             patch.node,
+		NodeLabels:       patch.nodeLabels,
+		Leased:           patch.leased,
+		Pending:          patch.pending,
+		RunId:            patch.runId,
+		Started:          patch.started,
+		PreemptionReason: patch.preemptionReason,
 	})
 }
 
@@ -656,6 +717,9 @@ func patchRun(run *model.Run, patch *runPatch) {
 	if patch.node != nil {
 		run.Node = patch.node
 	}
+	if patch.nodeLabels != nil {
+		run.NodeLabels = patch.nodeLabels
+	}
 	if patch.leased != nil {
 		run.Leased = patch.leased
 	}
@@ -665,6 +729,9 @@ func patchRun(run *model.Run, patch *runPatch) {
 	if patch.started != nil {
 		run.Started = patch.started
 	}
+	if patch.preemptionReason != nil {
+		run.PreemptionReason = patch.preemptionReason
+	}
 }
 
 func prefixAnnotations(prefix string, annotations map[string]string) map[string]string {
@@ -0,0 +1 @@
+ALTER TABLE job_run ADD COLUMN node_labels jsonb NULL;
@@ -0,0 +1,5 @@
+-- armada:no-transaction
+-- Indexes on existing tables are created concurrently, so the ingester can keep writing while they're built.
+-- Dropping the index first cleans up any invalid index left behind by a previous attempt that failed.
+DROP INDEX CONCURRENTLY IF EXISTS idx_job_last_transition_time_seconds;
+CREATE INDEX CONCURRENTLY idx_job_last_transition_time_seconds ON job (last_transition_time_seconds);
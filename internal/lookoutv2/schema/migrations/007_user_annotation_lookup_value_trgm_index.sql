@@ -0,0 +1,8 @@
+-- armada:no-transaction
+-- The pg_trgm and btree_gin extensions are a prerequisite of this migration. Creating them requires privileges
+-- the Lookout database user may not have, in which case they must be created beforehand by an administrator;
+-- if they already exist, the statements below succeed without any privileges.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS btree_gin;
+DROP INDEX CONCURRENTLY IF EXISTS idx_user_annotation_lookup_key_value_trgm;
+CREATE INDEX CONCURRENTLY idx_user_annotation_lookup_key_value_trgm ON user_annotation_lookup USING gin (key, value gin_trgm_ops);
@@ -0,0 +1,9 @@
+-- armada:no-transaction
+-- Requires the pg_trgm extension; see 007_user_annotation_lookup_value_trgm_index.sql.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+DROP INDEX CONCURRENTLY IF EXISTS idx_job_job_id_trgm;
+CREATE INDEX CONCURRENTLY idx_job_job_id_trgm ON job USING gin (job_id gin_trgm_ops);
+DROP INDEX CONCURRENTLY IF EXISTS idx_job_jobset_trgm;
+CREATE INDEX CONCURRENTLY idx_job_jobset_trgm ON job USING gin (jobset gin_trgm_ops);
+DROP INDEX CONCURRENTLY IF EXISTS idx_job_owner_trgm;
+CREATE INDEX CONCURRENTLY idx_job_owner_trgm ON job USING gin (owner gin_trgm_ops);
@@ -0,0 +1,3 @@
+-- armada:no-transaction
+DROP INDEX CONCURRENTLY IF EXISTS idx_job_run_job_id_started;
+CREATE INDEX CONCURRENTLY idx_job_run_job_id_started ON job_run (job_id, started);
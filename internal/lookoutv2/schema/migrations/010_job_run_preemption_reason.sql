@@ -0,0 +1 @@
+ALTER TABLE job_run ADD COLUMN preemption_reason varchar(512) NULL;
@@ -0,0 +1,10 @@
+-- Highest event sequence applied to each job (with an empty run_id) and to each job run, so that events replayed from
+-- the event topic never overwrite newer state.
+CREATE TABLE IF NOT EXISTS event_watermark (
+    job_id         varchar(32) NOT NULL,
+    run_id         varchar(36) NOT NULL DEFAULT '',
+    event_sequence bigint      NOT NULL,
+    PRIMARY KEY (job_id, run_id)
+);
+
+CREATE INDEX idx_event_watermark_run_id ON event_watermark (run_id);
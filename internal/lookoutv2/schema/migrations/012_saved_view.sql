@@ -0,0 +1,13 @@
+-- Named configurations of the jobs table saved by users, which can be shared by linking to their id.
+-- The filters, ordering, grouping, and columns of each view are stored as json in definition.
+CREATE TABLE IF NOT EXISTS saved_view (
+    view_id     varchar(36)  NOT NULL PRIMARY KEY,
+    name        varchar(512) NOT NULL,
+    description text         NOT NULL,
+    owner       varchar(512) NOT NULL,
+    definition  jsonb        NOT NULL,
+    created     timestamp    NOT NULL,
+    updated     timestamp    NOT NULL
+);
+
+CREATE INDEX idx_saved_view_owner ON saved_view (owner);
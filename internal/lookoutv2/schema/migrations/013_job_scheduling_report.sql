@@ -0,0 +1,9 @@
+-- Most recent reason each job could not be scheduled, as reported by the scheduler while the job was queued.
+CREATE TABLE IF NOT EXISTS job_scheduling_report (
+    job_id                       varchar(32)  NOT NULL PRIMARY KEY,
+    reason                       text         NOT NULL,
+    pool                         varchar(512) NOT NULL,
+    num_nodes                    integer      NOT NULL,
+    num_excluded_nodes_by_reason jsonb        NOT NULL,
+    reported                     timestamp    NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE job_run ADD COLUMN failure_category varchar(63) NULL;
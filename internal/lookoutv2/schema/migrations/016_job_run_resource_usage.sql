@@ -0,0 +1,4 @@
+ALTER TABLE job_run ADD COLUMN cpu_usage_peak bigint NULL;
+ALTER TABLE job_run ADD COLUMN cpu_usage_average bigint NULL;
+ALTER TABLE job_run ADD COLUMN memory_usage_peak bigint NULL;
+ALTER TABLE job_run ADD COLUMN memory_usage_average bigint NULL;
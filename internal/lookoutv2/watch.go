@@ -0,0 +1,136 @@
+package lookoutv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/lookoutv2/conversions"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/internal/lookoutv2/repository"
+)
+
+const lastTransitionTimeField = "lastTransitionTime"
+
+// JobWatcher streams updates of the jobs matching a set of filters, by periodically querying for the jobs that
+// transitioned since the previous query. Only recently updated jobs are read, via the indexes on last transition
+// time, which is much cheaper than re-running the query of all jobs matching the filters.
+type JobWatcher struct {
+	getJobsRepo  repository.GetJobsRepository
+	pollInterval time.Duration
+	// Maximum number of updated jobs returned per poll.
+	batchSize int
+}
+
+func NewJobWatcher(getJobsRepo repository.GetJobsRepository, pollInterval time.Duration, batchSize int) *JobWatcher {
+	return &JobWatcher{
+		getJobsRepo:  getJobsRepo,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+	}
+}
+
+// jobCursor is the position of a watch: all transitions before time have been sent, as have the transitions at
+// time to the states in sent. Last transition times are filtered on with second precision, so time is truncated
+// to seconds.
+type jobCursor struct {
+	time time.Time
+	// State last sent for each job that transitioned at time.
+	sent map[string]string
+}
+
+func newJobCursor(since time.Time) *jobCursor {
+	return &jobCursor{
+		time: since.Truncate(time.Second),
+		sent: make(map[string]string),
+	}
+}
+
+// Watch calls send with the jobs matching filters that transition at or after since, every poll interval, until
+// ctx is cancelled or send returns an error. send is called even if no jobs were updated, such that idle connections
+// can be kept alive, and is also provided with the time from which watching can be resumed.
+// A job may be sent more than once for the same transition when resuming.
+func (w *JobWatcher) Watch(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	since time.Time,
+	send func(jobs []*model.Job, resumeFrom time.Time) error,
+) error {
+	cursor := newJobCursor(since)
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+	for {
+		jobs, err := w.poll(ctx, filters, activeJobSets, cursor)
+		if err != nil {
+			return err
+		}
+		if err := send(jobs, cursor.time); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll returns the jobs matching filters that transitioned since the cursor, oldest first, and advances the cursor.
+func (w *JobWatcher) poll(ctx *armadacontext.Context, filters []*model.Filter, activeJobSets bool, cursor *jobCursor) ([]*model.Job, error) {
+	query := make([]*model.Filter, 0, len(filters)+1)
+	query = append(query, filters...)
+	query = append(query, &model.Filter{
+		Field: lastTransitionTimeField,
+		Match: model.MatchGreaterThanOrEqualTo,
+		Value: cursor.time.Unix(),
+	})
+	order := &model.Order{Field: lastTransitionTimeField, Direction: model.DirectionAsc}
+	// The jobs already sent that transitioned at the time of the cursor are returned again,
+	// since jobs that transitioned at the same time are in no particular order.
+	result, err := w.getJobsRepo.GetJobs(ctx, query, activeJobSets, order, 0, len(cursor.sent)+w.batchSize)
+	if err != nil {
+		return nil, err
+	}
+	var updated []*model.Job
+	for _, job := range result.Jobs {
+		transitionTime := job.LastTransitionTime.Truncate(time.Second)
+		if transitionTime.After(cursor.time) {
+			cursor.time = transitionTime
+			cursor.sent = make(map[string]string)
+		} else if state, ok := cursor.sent[job.JobId]; ok && state == job.State {
+			continue
+		}
+		cursor.sent[job.JobId] = job.State
+		updated = append(updated, job)
+	}
+	return updated, nil
+}
+
+// writeJobsEvent writes jobs as a server-sent event with resumeFrom as id, or a comment if there are no jobs,
+// such that proxies don't close idle connections.
+func writeJobsEvent(w io.Writer, jobs []*model.Job, resumeFrom time.Time) error {
+	if len(jobs) == 0 {
+		if _, err := io.WriteString(w, ": no updates\n\n"); err != nil {
+			return errors.WithStack(err)
+		}
+	} else {
+		data, err := json.Marshal(util.Map(jobs, conversions.ToSwaggerJob))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := fmt.Fprintf(w, "id: %s\nevent: jobs\ndata: %s\n\n", resumeFrom.UTC().Format(time.RFC3339), data); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
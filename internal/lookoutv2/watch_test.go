@@ -0,0 +1,137 @@
+package lookoutv2
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookoutv2/model"
+	"github.com/armadaproject/armada/internal/lookoutv2/repository"
+)
+
+var baseTime = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// fakeGetJobsRepository returns the jobs that transitioned at or after the time filtered on, ordered by last
+// transition time.
+type fakeGetJobsRepository struct {
+	jobs []*model.Job
+	err  error
+}
+
+func (r *fakeGetJobsRepository) GetJobs(_ *armadacontext.Context, filters []*model.Filter, _ bool, order *model.Order, skip int, take int) (*repository.GetJobsResult, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if order.Field != lastTransitionTimeField || order.Direction != model.DirectionAsc {
+		return nil, errors.Errorf("unexpected order %v", order)
+	}
+	var since int64
+	for _, filter := range filters {
+		if filter.Field == lastTransitionTimeField && filter.Match == model.MatchGreaterThanOrEqualTo {
+			since = filter.Value.(int64)
+		}
+	}
+	var jobs []*model.Job
+	for _, job := range r.jobs {
+		if job.LastTransitionTime.Unix() >= since {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return jobs[i].LastTransitionTime.Unix() < jobs[j].LastTransitionTime.Unix()
+	})
+	if skip+take < len(jobs) {
+		jobs = jobs[skip : skip+take]
+	}
+	return &repository.GetJobsResult{Jobs: jobs, Count: len(jobs)}, nil
+}
+
+func (r *fakeGetJobsRepository) transition(jobId string, state string, at time.Time) {
+	for _, job := range r.jobs {
+		if job.JobId == jobId {
+			job.State = state
+			job.LastTransitionTime = at
+			return
+		}
+	}
+	r.jobs = append(r.jobs, &model.Job{JobId: jobId, State: state, LastTransitionTime: at})
+}
+
+func TestJobWatcher_Poll(t *testing.T) {
+	repo := &fakeGetJobsRepository{}
+	repo.transition("a", "QUEUED", baseTime)
+	repo.transition("b", "QUEUED", baseTime.Add(100*time.Millisecond))
+	repo.transition("c", "QUEUED", baseTime.Add(time.Second))
+	watcher := NewJobWatcher(repo, time.Second, 1)
+	cursor := newJobCursor(baseTime)
+
+	poll := func() []string {
+		jobs, err := watcher.poll(armadacontext.Background(), nil, false, cursor)
+		require.NoError(t, err)
+		var ids []string
+		for _, job := range jobs {
+			ids = append(ids, job.JobId+":"+job.State)
+		}
+		return ids
+	}
+
+	// Jobs that transitioned at the same time are sent a batch at a time.
+	assert.Equal(t, []string{"a:QUEUED"}, poll())
+	assert.Equal(t, []string{"b:QUEUED"}, poll())
+	assert.Equal(t, []string{"c:QUEUED"}, poll())
+	assert.Equal(t, baseTime.Add(time.Second), cursor.time)
+	assert.Empty(t, poll())
+
+	// Jobs are sent again when they transition, including within the same second.
+	repo.transition("c", "PENDING", baseTime.Add(time.Second+500*time.Millisecond))
+	assert.Equal(t, []string{"c:PENDING"}, poll())
+	repo.transition("a", "RUNNING", baseTime.Add(2*time.Second))
+	assert.Equal(t, []string{"a:RUNNING"}, poll())
+	assert.Empty(t, poll())
+}
+
+func TestJobWatcher_Watch(t *testing.T) {
+	repo := &fakeGetJobsRepository{}
+	repo.transition("a", "QUEUED", baseTime)
+	repo.transition("b", "QUEUED", baseTime.Add(-time.Second))
+	watcher := NewJobWatcher(repo, time.Millisecond, 10)
+
+	ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+	var sent [][]*model.Job
+	err := watcher.Watch(ctx, nil, false, baseTime, func(jobs []*model.Job, resumeFrom time.Time) error {
+		sent = append(sent, jobs)
+		assert.Equal(t, baseTime, resumeFrom)
+		if len(sent) == 2 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, sent, 2)
+	require.Len(t, sent[0], 1)
+	assert.Equal(t, "a", sent[0][0].JobId)
+	assert.Empty(t, sent[1])
+
+	repo.err = errors.New("invalid filter")
+	err = watcher.Watch(armadacontext.Background(), nil, false, baseTime, func([]*model.Job, time.Time) error {
+		return nil
+	})
+	assert.EqualError(t, err, "invalid filter")
+}
+
+func TestWriteJobsEvent(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeJobsEvent(&buf, nil, baseTime))
+	assert.Equal(t, ": no updates\n\n", buf.String())
+
+	buf.Reset()
+	job := &model.Job{JobId: "a", State: "QUEUED", Submitted: baseTime, LastTransitionTime: baseTime}
+	require.NoError(t, writeJobsEvent(&buf, []*model.Job{job}, baseTime))
+	assert.Regexp(t, `^id: 2023-01-01T12:00:00Z\nevent: jobs\ndata: \[\{.*"jobId":"a".*\}\]\n\n$`, buf.String())
+}
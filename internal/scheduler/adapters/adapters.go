@@ -2,7 +2,6 @@ package adapters
 
 import (
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 	v1 "k8s.io/api/core/v1"
 
@@ -30,18 +29,19 @@ func PodRequirementsFromPodSpec(podSpec *v1.PodSpec, priorityByPriorityClassName
 		// Ignore this error if priorityByPriorityClassName is explicitly set to nil.
 		// We assume that in this case the caller is sure the priority does not need to be set.
 		err := errors.Errorf("unknown priorityClassName %s", podSpec.PriorityClassName)
-		logging.WithStacktrace(logrus.NewEntry(logrus.New()), err).Error("failed to get priority from priorityClassName")
+		logging.WithStacktrace(logging.ForModule("scheduler"), err).Error("failed to get priority from priorityClassName")
 	}
 	preemptionPolicy := string(v1.PreemptLowerPriority)
 	if podSpec.PreemptionPolicy != nil {
 		preemptionPolicy = string(*podSpec.PreemptionPolicy)
 	}
 	return &schedulerobjects.PodRequirements{
-		NodeSelector:         podSpec.NodeSelector,
-		Affinity:             podSpec.Affinity,
-		Tolerations:          podSpec.Tolerations,
-		Priority:             priority,
-		PreemptionPolicy:     preemptionPolicy,
-		ResourceRequirements: api.SchedulingResourceRequirementsFromPodSpec(podSpec),
+		NodeSelector:           podSpec.NodeSelector,
+		Affinity:               podSpec.Affinity,
+		Tolerations:            podSpec.Tolerations,
+		Priority:               priority,
+		PreemptionPolicy:       preemptionPolicy,
+		ResourceRequirements:   api.SchedulingResourceRequirementsFromPodSpec(podSpec),
+		PersistentVolumeClaims: api.PersistentVolumeClaimRequirementsFromPodSpec(podSpec),
 	}
 }
@@ -1,7 +1,8 @@
 package adapters
 
 import (
-	"io"
+	"bytes"
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 )
@@ -99,28 +101,21 @@ func TestPodRequirementsFromPodSpecPriorityByPriorityClassName(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			// Creating backup for stderr
-			old := os.Stderr
-			r, w, _ := os.Pipe()
-			// Assigning stderr to file, w
-			os.Stderr = w
-			// Stderr from this function would be written to file w
+			// Capture the messages of the scheduler module logger.
+			var buf bytes.Buffer
+			require.NoError(t, logging.SetOutput(&buf, logging.JSONFormat))
+			defer func() { require.NoError(t, logging.SetOutput(os.Stderr, logging.TextFormat)) }()
 			scheduler := PodRequirementsFromPodSpec(&test.podspec, test.priorityByPriorityClassName)
-			// Closing file, w
-			err := w.Close()
-			require.NoError(t, err)
-			// Reading from file
-			out, _ := io.ReadAll(r)
-			// Restoring stderr
-			os.Stderr = old
 			expectedScheduler.Priority = test.priority
 			assert.Equal(t, scheduler, expectedScheduler)
-			// if loggedError is true, bytes should be written to stderr,
-			// Otherwise, no byte is expected to be written to stderr
+			// if loggedError is true, an error should be logged,
+			// Otherwise, nothing is expected to be logged
 			if test.loggedError {
-				assert.NotEqual(t, len(out), 0)
+				var entry map[string]any
+				require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+				assert.Equal(t, "error", entry["level"])
 			} else {
-				assert.Equal(t, len(out), 0)
+				assert.Empty(t, buf.String())
 			}
 		})
 	}
@@ -3,20 +3,28 @@ package scheduler
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/util/clock"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	"github.com/armadaproject/armada/internal/common/schedulers"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/internal/common/util"
+	schedulerconfig "github.com/armadaproject/armada/internal/scheduler/configuration"
 	"github.com/armadaproject/armada/internal/scheduler/database"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
@@ -42,7 +50,13 @@ type ExecutorApi struct {
 	nodeIdLabel string
 	// See scheduling schedulingConfig.
 	priorityClassNameOverride *string
-	clock                     clock.Clock
+	// Limits on the rate at which leases and cancellations are sent to executors.
+	leaseFlowControl schedulerconfig.LeaseFlowControlConfig
+	// Number of lease requests currently being processed, overall and by executor.
+	leaseRequestsMu            sync.Mutex
+	numLeaseRequests           int
+	numLeaseRequestsByExecutor map[string]int
+	clock                      clock.Clock
 }
 
 func NewExecutorApi(producer pulsar.Producer,
@@ -53,20 +67,23 @@ func NewExecutorApi(producer pulsar.Producer,
 	nodeIdLabel string,
 	priorityClassNameOverride *string,
 	maxPulsarMessageSizeBytes uint,
+	leaseFlowControl schedulerconfig.LeaseFlowControlConfig,
 ) (*ExecutorApi, error) {
 	if len(allowedPriorities) == 0 {
 		return nil, errors.New("allowedPriorities cannot be empty")
 	}
 	return &ExecutorApi{
-		producer:                  producer,
-		jobRepository:             jobRepository,
-		executorRepository:        executorRepository,
-		legacyExecutorRepository:  legacyExecutorRepository,
-		allowedPriorities:         allowedPriorities,
-		maxPulsarMessageSizeBytes: maxPulsarMessageSizeBytes,
-		nodeIdLabel:               nodeIdLabel,
-		priorityClassNameOverride: priorityClassNameOverride,
-		clock:                     clock.RealClock{},
+		producer:                   producer,
+		jobRepository:              jobRepository,
+		executorRepository:         executorRepository,
+		legacyExecutorRepository:   legacyExecutorRepository,
+		allowedPriorities:          allowedPriorities,
+		maxPulsarMessageSizeBytes:  maxPulsarMessageSizeBytes,
+		nodeIdLabel:                nodeIdLabel,
+		priorityClassNameOverride:  priorityClassNameOverride,
+		leaseFlowControl:           leaseFlowControl,
+		numLeaseRequestsByExecutor: make(map[string]int),
+		clock:                      clock.RealClock{},
 	}, nil
 }
 
@@ -83,6 +100,11 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 
 	ctx := armadacontext.WithLogField(armadacontext.FromGrpcCtx(stream.Context()), "executor", req.ExecutorId)
 
+	if err := srv.startLeaseRequest(req.ExecutorId); err != nil {
+		return err
+	}
+	defer srv.finishLeaseRequest(req.ExecutorId)
+
 	executor := srv.executorFromLeaseRequest(ctx, req)
 	if err := srv.executorRepository.StoreExecutor(ctx, executor); err != nil {
 		return err
@@ -99,7 +121,11 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 	if err != nil {
 		return err
 	}
-	newRuns, err := srv.jobRepository.FetchJobRunLeases(ctx, req.ExecutorId, uint(req.MaxJobsToLease), requestRuns)
+	maxJobsToLease := uint(req.MaxJobsToLease)
+	if limit := srv.leaseFlowControl.MaxLeasesPerRequest; limit > 0 && maxJobsToLease > limit {
+		maxJobsToLease = limit
+	}
+	newRuns, err := srv.jobRepository.FetchJobRunLeases(ctx, req.ExecutorId, maxJobsToLease, requestRuns)
 	if err != nil {
 		return err
 	}
@@ -110,16 +136,22 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 
 	// Send any runs that should be cancelled.
 	if len(runsToCancel) > 0 {
-		if err := stream.Send(&executorapi.LeaseStreamMessage{
-			Event: &executorapi.LeaseStreamMessage_CancelRuns{
-				CancelRuns: &executorapi.CancelRuns{
-					JobRunIdsToCancel: util.Map(runsToCancel, func(x uuid.UUID) *armadaevents.Uuid {
-						return armadaevents.ProtoUuidFromUuid(x)
-					}),
+		batches := [][]uuid.UUID{runsToCancel}
+		if maxLen := srv.leaseFlowControl.MaxRunIdsPerCancelMessage; maxLen > 0 {
+			batches = armadaslices.PartitionToMaxLen(runsToCancel, maxLen)
+		}
+		for _, batch := range batches {
+			if err := stream.Send(&executorapi.LeaseStreamMessage{
+				Event: &executorapi.LeaseStreamMessage_CancelRuns{
+					CancelRuns: &executorapi.CancelRuns{
+						JobRunIdsToCancel: util.Map(batch, func(x uuid.UUID) *armadaevents.Uuid {
+							return armadaevents.ProtoUuidFromUuid(x)
+						}),
+					},
 				},
-			},
-		}); err != nil {
-			return errors.WithStack(err)
+			}); err != nil {
+				return errors.WithStack(err)
+			}
 		}
 	}
 
@@ -134,11 +166,14 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 			srv.setPriorityClassName(submitMsg, *srv.priorityClassNameOverride)
 		}
 		srv.addNodeIdSelector(submitMsg, lease.Node)
+		span := traceLease(ctx, submitMsg, lease)
 
 		var groups []string
 		if len(lease.Groups) > 0 {
 			groups, err = compress.DecompressStringArray(lease.Groups, decompressor)
 			if err != nil {
+				tracing.RecordError(span, err)
+				span.End()
 				return err
 			}
 		}
@@ -154,6 +189,8 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 				},
 			},
 		})
+		tracing.RecordError(span, err)
+		span.End()
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -171,6 +208,58 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 	return nil
 }
 
+// startLeaseRequest records that a lease request from the given executor is being processed,
+// or returns an error if doing so would exceed the limits on concurrent lease requests.
+func (srv *ExecutorApi) startLeaseRequest(executorId string) error {
+	srv.leaseRequestsMu.Lock()
+	defer srv.leaseRequestsMu.Unlock()
+	if limit := srv.leaseFlowControl.MaxConcurrentRequests; limit > 0 && srv.numLeaseRequests >= limit {
+		return errors.WithStack(&armadaerrors.ErrLimitExceeded{
+			Limit:   "MaxConcurrentRequests",
+			Max:     limit,
+			Value:   srv.numLeaseRequests + 1,
+			Message: "too many concurrent lease requests; try again later",
+		})
+	}
+	if limit := srv.leaseFlowControl.MaxConcurrentRequestsPerExecutor; limit > 0 && srv.numLeaseRequestsByExecutor[executorId] >= limit {
+		return errors.WithStack(&armadaerrors.ErrLimitExceeded{
+			Limit:   "MaxConcurrentRequestsPerExecutor",
+			Max:     limit,
+			Value:   srv.numLeaseRequestsByExecutor[executorId] + 1,
+			Message: "too many concurrent lease requests from executor " + executorId + "; try again later",
+		})
+	}
+	srv.numLeaseRequests++
+	srv.numLeaseRequestsByExecutor[executorId]++
+	return nil
+}
+
+func (srv *ExecutorApi) finishLeaseRequest(executorId string) {
+	srv.leaseRequestsMu.Lock()
+	defer srv.leaseRequestsMu.Unlock()
+	srv.numLeaseRequests--
+	if srv.numLeaseRequestsByExecutor[executorId]--; srv.numLeaseRequestsByExecutor[executorId] <= 0 {
+		delete(srv.numLeaseRequestsByExecutor, executorId)
+	}
+}
+
+// traceLease starts a span for leasing the job of submitMsg, as part of the trace in which the job was submitted and
+// linked to the span of the lease request carried by ctx. The traceparent annotation of the job is replaced by that of
+// the new span, such that the executor continues the trace from the lease. Jobs submitted without trace context aren't traced.
+func traceLease(ctx *armadacontext.Context, submitMsg *armadaevents.SubmitJob, lease *database.JobRunLease) trace.Span {
+	traceparent := submitMsg.GetObjectMeta().GetAnnotations()[configuration.TraceparentAnnotation]
+	leaseCtx, span := tracing.ContinueFromTraceparent(
+		ctx,
+		"ExecutorApi.leaseJobRun",
+		traceparent,
+		trace.WithAttributes(attribute.String("runId", lease.RunID.String()), attribute.String("nodeId", lease.Node)),
+	)
+	if traceparent != "" && span.SpanContext().IsValid() {
+		submitMsg.ObjectMeta.Annotations[configuration.TraceparentAnnotation] = tracing.Traceparent(leaseCtx)
+	}
+	return span
+}
+
 func (srv *ExecutorApi) setPriorityClassName(job *armadaevents.SubmitJob, priorityClassName string) {
 	if job == nil {
 		return
@@ -9,15 +9,20 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/mocks"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	schedulerconfig "github.com/armadaproject/armada/internal/scheduler/configuration"
 	"github.com/armadaproject/armada/internal/scheduler/database"
 	schedulermocks "github.com/armadaproject/armada/internal/scheduler/mocks"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
@@ -105,8 +110,11 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 		request          *executorapi.LeaseRequest
 		runsToCancel     []uuid.UUID
 		leases           []*database.JobRunLease
+		leaseFlowControl schedulerconfig.LeaseFlowControlConfig
 		expectedExecutor *schedulerobjects.Executor
-		expectedMsgs     []*executorapi.LeaseStreamMessage
+		// Limit on the number of leases expected to be fetched; maxJobsPerCall if zero.
+		expectedMaxJobsToLease uint
+		expectedMsgs           []*executorapi.LeaseStreamMessage
 	}{
 		"lease and cancel": {
 			request:          defaultRequest,
@@ -154,6 +162,38 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				},
 			},
 		},
+		"cancellations split across messages": {
+			request:          defaultRequest,
+			runsToCancel:     []uuid.UUID{runId1, runId2},
+			leaseFlowControl: schedulerconfig.LeaseFlowControlConfig{MaxRunIdsPerCancelMessage: 1},
+			expectedExecutor: defaultExpectedExecutor,
+			expectedMsgs: []*executorapi.LeaseStreamMessage{
+				{
+					Event: &executorapi.LeaseStreamMessage_CancelRuns{CancelRuns: &executorapi.CancelRuns{
+						JobRunIdsToCancel: []*armadaevents.Uuid{armadaevents.ProtoUuidFromUuid(runId1)},
+					}},
+				},
+				{
+					Event: &executorapi.LeaseStreamMessage_CancelRuns{CancelRuns: &executorapi.CancelRuns{
+						JobRunIdsToCancel: []*armadaevents.Uuid{armadaevents.ProtoUuidFromUuid(runId2)},
+					}},
+				},
+				{
+					Event: &executorapi.LeaseStreamMessage_End{End: &executorapi.EndMarker{}},
+				},
+			},
+		},
+		"leases limited by flow control": {
+			request:                defaultRequest,
+			leaseFlowControl:       schedulerconfig.LeaseFlowControlConfig{MaxLeasesPerRequest: 10},
+			expectedExecutor:       defaultExpectedExecutor,
+			expectedMaxJobsToLease: 10,
+			expectedMsgs: []*executorapi.LeaseStreamMessage{
+				{
+					Event: &executorapi.LeaseStreamMessage_End{End: &executorapi.EndMarker{}},
+				},
+			},
+		},
 		"do nothing": {
 			request:          defaultRequest,
 			expectedExecutor: defaultExpectedExecutor,
@@ -189,7 +229,11 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				return nil
 			}).Times(1)
 			mockJobRepository.EXPECT().FindInactiveRuns(gomock.Any(), schedulermocks.SliceMatcher[uuid.UUID]{Expected: runIds}).Return(tc.runsToCancel, nil).Times(1)
-			mockJobRepository.EXPECT().FetchJobRunLeases(gomock.Any(), tc.request.ExecutorId, maxJobsPerCall, runIds).Return(tc.leases, nil).Times(1)
+			expectedMaxJobsToLease := tc.expectedMaxJobsToLease
+			if expectedMaxJobsToLease == 0 {
+				expectedMaxJobsToLease = maxJobsPerCall
+			}
+			mockJobRepository.EXPECT().FetchJobRunLeases(gomock.Any(), tc.request.ExecutorId, expectedMaxJobsToLease, runIds).Return(tc.leases, nil).Times(1)
 
 			// capture all sent messages
 			var capturedEvents []*executorapi.LeaseStreamMessage
@@ -207,6 +251,7 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				"kubernetes.io/hostname",
 				nil,
 				4*1024*1024,
+				tc.leaseFlowControl,
 			)
 			require.NoError(t, err)
 			server.clock = testClock
@@ -219,6 +264,36 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 	}
 }
 
+func TestExecutorApi_ConcurrentLeaseRequestLimits(t *testing.T) {
+	server, err := NewExecutorApi(
+		nil,
+		nil,
+		nil,
+		nil,
+		[]int32{1000, 2000},
+		"kubernetes.io/hostname",
+		nil,
+		4*1024*1024,
+		schedulerconfig.LeaseFlowControlConfig{
+			MaxConcurrentRequestsPerExecutor: 1,
+			MaxConcurrentRequests:            2,
+		},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, server.startLeaseRequest("executor-1"))
+	assert.Equal(t, codes.ResourceExhausted, armadaerrors.CodeFromError(errors.Cause(server.startLeaseRequest("executor-1"))))
+	require.NoError(t, server.startLeaseRequest("executor-2"))
+	assert.Equal(t, codes.ResourceExhausted, armadaerrors.CodeFromError(errors.Cause(server.startLeaseRequest("executor-3"))))
+
+	server.finishLeaseRequest("executor-1")
+	require.NoError(t, server.startLeaseRequest("executor-3"))
+	server.finishLeaseRequest("executor-2")
+	server.finishLeaseRequest("executor-3")
+	assert.Equal(t, 0, server.numLeaseRequests)
+	assert.Empty(t, server.numLeaseRequestsByExecutor)
+}
+
 func TestAddNodeSelector(t *testing.T) {
 	withNodeSelector := &armadaevents.PodSpecWithAvoidList{
 		PodSpec: &v1.PodSpec{
@@ -321,6 +396,9 @@ func TestExecutorApi_Publish(t *testing.T) {
 					es := &armadaevents.EventSequence{}
 					err := proto.Unmarshal(msg.Payload, es)
 					require.NoError(t, err)
+					// Published sequences are stamped with the schema version; the sequences reported aren't.
+					assert.Equal(t, eventutil.CurrentSchemaVersion, es.SchemaVersion)
+					es.SchemaVersion = 0
 					capturedEvents = append(capturedEvents, es)
 					callback(pulsarutils.NewMessageId(1), msg, nil)
 				}).AnyTimes()
@@ -334,6 +412,7 @@ func TestExecutorApi_Publish(t *testing.T) {
 				"kubernetes.io/hostname",
 				nil,
 				4*1024*1024,
+				schedulerconfig.LeaseFlowControlConfig{},
 			)
 
 			require.NoError(t, err)
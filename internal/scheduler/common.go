@@ -3,6 +3,7 @@ package scheduler
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
@@ -13,12 +14,19 @@ import (
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
 	"github.com/armadaproject/armada/internal/scheduler/interfaces"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
+// TerminationReasonIngestionLag is the termination reason of scheduling rounds
+// skipped because the ingestion lag exceeds the configured maximum.
+const TerminationReasonIngestionLag = "ingestion lag exceeds maximum"
+
 // SchedulerResult is returned by Rescheduler.Schedule().
 type SchedulerResult struct {
 	// Whether the scheduler failed to create a result for some reason
 	EmptyResult bool
+	// If non-empty, the scheduling round was skipped for this reason.
+	TerminationReason string
 	// Running jobs that should be preempted.
 	PreemptedJobs []interfaces.LegacySchedulerJob
 	// Queued jobs that should be scheduled.
@@ -29,11 +37,22 @@ type SchedulerResult struct {
 	// For each preempted job, maps the job id to the id of the node on which the job was running.
 	// For each scheduled job, maps the job id to the id of the node on which the job should be scheduled.
 	NodeIdByJobId map[string]string
+	// For each preempted job, maps the job id to why the job was preempted.
+	PreemptionByJobId map[string]Preemption
 	// The Scheduling Context. Being passed up for metrics decisions made in scheduler.go and scheduler_metrics.go.
 	// Passing a pointer as the structure is enormous
 	SchedulingContexts []*schedulercontext.SchedulingContext
 }
 
+// Preemption records why the scheduler preempted a job.
+type Preemption struct {
+	Reason armadaevents.PreemptionReason
+	// Id and queue of a job scheduled onto the node the preempted job was running on, which the job was preempted for.
+	// Empty if there's no such job, e.g., for jobs preempted to drain a node.
+	PreemptingJobId string
+	PreemptingQueue string
+}
+
 func NewSchedulerResultForTest[S ~[]T, T interfaces.LegacySchedulerJob](
 	preemptedJobs S,
 	scheduledJobs S,
@@ -176,3 +195,21 @@ func GangIdAndCardinalityFromAnnotations(annotations map[string]string) (string,
 		return gangId, gangCardinality, gangMinimumCardinality, true, nil
 	}
 }
+
+// JobDependenciesFromAnnotations returns the ids of the jobs a job depends on,
+// parsed from the value of the job dependencies annotation, e.g., "01h3w2wtdchtc80hgyp782shrv,01h3w2wtdchtc80hgyp782shrw".
+func JobDependenciesFromAnnotations(annotations map[string]string) ([]string, error) {
+	value, ok := annotations[configuration.JobDependenciesAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	jobIds := strings.Split(value, ",")
+	for i, jobId := range jobIds {
+		jobId = strings.TrimSpace(jobId)
+		if _, err := armadaevents.ProtoUuidFromUlidString(jobId); err != nil {
+			return nil, errors.WithMessagef(err, "invalid job id %q", jobId)
+		}
+		jobIds[i] = jobId
+	}
+	return jobIds, nil
+}
@@ -153,3 +153,42 @@ func BenchmarkResourceListAsWeightedMillis(b *testing.B) {
 		rl.AsWeightedMillis(weights)
 	}
 }
+
+func TestJobDependenciesFromAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		annotations   map[string]string
+		expected      []string
+		expectedError bool
+	}{
+		"no annotation": {
+			annotations: map[string]string{"foo": "bar"},
+		},
+		"single dependency": {
+			annotations: map[string]string{configuration.JobDependenciesAnnotation: "01h3w2wtdchtc80hgyp782shrv"},
+			expected:    []string{"01h3w2wtdchtc80hgyp782shrv"},
+		},
+		"multiple dependencies": {
+			annotations: map[string]string{configuration.JobDependenciesAnnotation: "01h3w2wtdchtc80hgyp782shrv, 01h3w2wtdchtc80hgyp782shrw"},
+			expected:    []string{"01h3w2wtdchtc80hgyp782shrv", "01h3w2wtdchtc80hgyp782shrw"},
+		},
+		"invalid job id": {
+			annotations:   map[string]string{configuration.JobDependenciesAnnotation: "01h3w2wtdchtc80hgyp782shrv,foo"},
+			expectedError: true,
+		},
+		"empty": {
+			annotations:   map[string]string{configuration.JobDependenciesAnnotation: ""},
+			expectedError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := JobDependenciesFromAnnotations(tc.annotations)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
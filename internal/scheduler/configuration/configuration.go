@@ -7,6 +7,8 @@ import (
 	authconfig "github.com/armadaproject/armada/internal/common/auth/configuration"
 	"github.com/armadaproject/armada/internal/common/config"
 	grpcconfig "github.com/armadaproject/armada/internal/common/grpc/configuration"
+	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
+	tracingconfig "github.com/armadaproject/armada/internal/common/tracing/configuration"
 	"github.com/armadaproject/armada/pkg/client"
 )
 
@@ -34,6 +36,11 @@ type Configuration struct {
 	Http       HttpConfig
 	// If non-nil, net/http/pprof endpoints are exposed on localhost on this port.
 	PprofPort *uint16
+	// Configuration of the diagnostics server, which exposes pprof, a goroutine dump,
+	// and a summary of the most recent scheduling rounds.
+	Diagnostics profilingconfig.DiagnosticsConfig
+	// Exporting of spans to an OpenTelemetry collector.
+	Tracing tracingconfig.TracingConfig
 	// Maximum number of strings that should be cached at any one time
 	InternedStringsCacheSize uint32 `validate:"required"`
 	// How often the scheduling cycle should run
@@ -44,16 +51,58 @@ type Configuration struct {
 	SchedulePeriod time.Duration `validate:"required"`
 	// The maximum time allowed for a job scheduling round
 	MaxSchedulingDuration time.Duration `validate:"required"`
-	// How long after a heartbeat an executor will be considered lost
-	ExecutorTimeout time.Duration `validate:"required"`
+	// How long after a heartbeat an executor will be considered lost, at which point all jobs running on it are expired.
+	// This acts as a grace period after the executor has become stale (see Scheduling.ExecutorTimeout).
+	// If zero, jobs running on lost executors are never expired.
+	ExecutorTimeout time.Duration
+	// If non-zero, scheduling rounds are skipped while the ingestion lag,
+	// i.e., the time taken for messages published to Pulsar to be written to Postgres, exceeds this value.
+	// This prevents scheduling against stale state, e.g., leasing jobs that have already been cancelled.
+	MaxIngestionLag time.Duration
+	// On shutdown, the scheduler waits up to this long for a cycle in progress to complete and publish its decisions,
+	// after which the cycle is cancelled. Leadership is only released once the scheduler has stopped.
+	GracefulShutdownTimeout time.Duration
+	// Jobs submitted to the same queue within this window and with equal scheduling requirements are reported as duplicates
+	// by the duplicate jobs report, to help find, e.g., runaway retry loops in user pipelines.
+	// If zero, duplicate job detection is disabled.
+	DuplicateJobDetectionWindow time.Duration
+	// Queued jobs that depend on a job that can't be found, i.e., which is neither being scheduled nor finished,
+	// are failed once this long has passed since they were submitted.
+	// If zero, such jobs wait for their dependencies indefinitely.
+	UnknownJobDependencyTimeout time.Duration
+	// Runs leased to an executor that the executor stops reporting, e.g., since it lost its state or the pod was deleted
+	// outside of Armada, are returned after having been missing from its reports for this long, such that they may be retried.
+	// If zero, lost runs are never returned and the drift between runs and executors isn't reported.
+	LostRunTimeout time.Duration
 	// Maximum number of rows to fetch in a given query
 	DatabaseFetchSize int `validate:"required"`
 	// Timeout to use when sending messages to pulsar
 	PulsarSendTimeout time.Duration `validate:"required"`
+	// Limits on the rate at which leases and cancellations are sent to executors.
+	LeaseFlowControl LeaseFlowControlConfig
+}
+
+// LeaseFlowControlConfig limits the load placed on the scheduler, the database, and executors by lease requests,
+// e.g., after a scheduling round that scheduled many jobs onto the same executor.
+// Runs not sent in response to one lease request are sent in response to subsequent requests.
+type LeaseFlowControlConfig struct {
+	// Maximum number of new runs sent in response to a single lease request.
+	// Applied in addition to the limit requested by the executor. If zero, there's no such limit.
+	MaxLeasesPerRequest uint
+	// Maximum number of run ids sent in each cancellation message; cancellations are split across messages accordingly.
+	// If zero, all cancellations are sent in a single message.
+	MaxRunIdsPerCancelMessage int
+	// Maximum number of lease requests processed concurrently for each executor.
+	// Further requests are rejected with codes.ResourceExhausted, which executors retry with jitter.
+	// If zero, there's no such limit.
+	MaxConcurrentRequestsPerExecutor int
+	// Maximum number of lease requests processed concurrently across all executors.
+	// Further requests are rejected with codes.ResourceExhausted. If zero, there's no such limit.
+	MaxConcurrentRequests int
 }
 
 type LeaderConfig struct {
-	// Valid modes are "standalone" or "kubernetes"
+	// Valid modes are "standalone", "kubernetes", or "postgres"
 	Mode string `validate:"required"`
 	// Name of the K8s Lock Object
 	LeaseLockName string
@@ -70,6 +119,9 @@ type LeaderConfig struct {
 	RetryPeriod time.Duration
 	// Connection details to the leader
 	LeaderConnection client.ApiConnectionDetails
+	// Key of the Postgres advisory lock held by the leader; only used in postgres mode.
+	// Must be the same for all schedulers in the same Armada deployment.
+	AdvisoryLockKey int64
 }
 
 type HttpConfig struct {
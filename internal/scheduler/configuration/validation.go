@@ -0,0 +1,20 @@
+package configuration
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	commonconfig "github.com/armadaproject/armada/internal/common/config"
+)
+
+func init() {
+	commonconfig.RegisterStructValidation(validateConfiguration, Configuration{})
+}
+
+// validateConfiguration checks that the port the scheduler API is served on is set.
+// Other components embedding grpcconfig.GrpcConfig serve their API on a port configured elsewhere.
+func validateConfiguration(sl validator.StructLevel) {
+	config := sl.Current().Interface().(Configuration)
+	if config.Grpc.Port == 0 {
+		sl.ReportError(config.Grpc.Port, "Grpc.Port", "Port", "required", "")
+	}
+}
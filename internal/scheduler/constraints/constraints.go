@@ -9,6 +9,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 )
 
@@ -19,6 +20,9 @@ const (
 	// Indicates that a queue has been assigned more than its allowed amount of resources.
 	MaximumResourcesPerQueueExceededUnschedulableReason = "maximum total resources for this queue exceeded"
 
+	// Indicates that a queue uses more resources than its allowed amount plus those lent to it by other queues.
+	BorrowedResourcesReclaimedUnschedulableReason = "resources borrowed from other queues reclaimed"
+
 	// Indicates that the scheduling rate limit has been exceeded.
 	GlobalRateLimitExceededUnschedulableReason = "global scheduling rate limit exceeded"
 	QueueRateLimitExceededUnschedulableReason  = "queue scheduling rate limit exceeded"
@@ -27,6 +31,22 @@ const (
 	GlobalRateLimitExceededByGangUnschedulableReason = "gang would exceed global scheduling rate limit"
 	QueueRateLimitExceededByGangUnschedulableReason  = "gang would exceed queue scheduling rate limit"
 
+	// Indicates that the job set of a job already has the maximum number of running jobs allowed by that job.
+	JobSetMaxRunningJobsExceededUnschedulableReason = "maximum running jobs for this job set exceeded"
+
+	// Indicates that some job this job depends on has not yet succeeded.
+	WaitingOnDependenciesUnschedulableReason = "waiting on dependencies"
+
+	// Indicates that the job set of the job has been suspended.
+	JobSetSuspendedUnschedulableReason = "job set suspended"
+
+	// Indicates that the queue of the job is draining or closed, such that none of its queued jobs are scheduled.
+	QueueDrainingUnschedulableReason = "queue draining"
+	QueueClosedUnschedulableReason   = "queue closed"
+
+	// Indicates that the queue of the job has spent its budget for the current budget period.
+	BudgetExhaustedUnschedulableReason = "budget exhausted"
+
 	// Indicates that the number of jobs in a gang exceeds the burst size.
 	// This means the gang can not be scheduled without first increasing the burst size.
 	GangExceedsGlobalBurstSizeUnschedulableReason = "gang cardinality too large: exceeds global max burst size"
@@ -62,6 +82,13 @@ type SchedulingConstraints struct {
 	PriorityClassSchedulingConstraintsByPriorityClassName map[string]PriorityClassSchedulingConstraints
 	// Limits total resources scheduled per invocation.
 	MaximumResourcesToSchedule schedulerobjects.ResourceList
+	// Queues each queue lends its unused per-queue limits to, and the inverse mapping.
+	// Empty unless quota borrowing is enabled.
+	BorrowersByLender map[string][]string
+	LendersByBorrower map[string][]string
+	// Lenders that exist, i.e., are among the queues known to the scheduler.
+	// Lenders without a queue scheduling context, i.e., without jobs queued or running, lend all of their limits.
+	existingLenders map[string]bool
 }
 
 // PriorityClassSchedulingConstraints contains scheduling constraints that apply to jobs of a specific priority class.
@@ -104,6 +131,75 @@ func SchedulingConstraintsFromSchedulingConfig(
 	}
 }
 
+// EnableQuotaBorrowing allows queues to exceed their per-queue limits by the unused limits of the queues lending to them,
+// where borrowersByLender maps each lending queue to the queues it lends to and queues are all queues known to the scheduler.
+// A queue only lends while below its fair share and without queued jobs, and only to jobs of preemptible priority classes.
+// Queues without jobs queued or running, which hence have no queue scheduling context, lend all of their limits.
+func (constraints *SchedulingConstraints) EnableQuotaBorrowing(borrowersByLender map[string][]string, queues []string) {
+	constraints.BorrowersByLender = make(map[string][]string, len(borrowersByLender))
+	constraints.LendersByBorrower = make(map[string][]string)
+	constraints.existingLenders = make(map[string]bool, len(borrowersByLender))
+	for _, queue := range queues {
+		if _, ok := borrowersByLender[queue]; ok {
+			constraints.existingLenders[queue] = true
+		}
+	}
+	for lender, borrowers := range borrowersByLender {
+		for _, borrower := range borrowers {
+			if borrower == lender {
+				continue
+			}
+			constraints.BorrowersByLender[lender] = append(constraints.BorrowersByLender[lender], borrower)
+			constraints.LendersByBorrower[borrower] = append(constraints.LendersByBorrower[borrower], lender)
+		}
+	}
+}
+
+// OvercommittedResources returns a copy of totalResources with the overcommit factors configured for pool applied.
+// Resources for which no factor is configured are returned unchanged.
+func OvercommittedResources(
+	pool string,
+	totalResources schedulerobjects.ResourceList,
+	config configuration.SchedulingConfig,
+) schedulerobjects.ResourceList {
+	overcommitFactors := config.OvercommitFactors
+	if m, ok := config.OvercommitFactorsByPool[pool]; ok {
+		// Use pool-specific config is available.
+		overcommitFactors = m
+	}
+	rv := totalResources.DeepCopy()
+	for t, f := range overcommitFactors {
+		if q, ok := rv.Resources[t]; ok {
+			rv.Set(t, ScaleQuantity(q.DeepCopy(), f))
+		}
+	}
+	return rv
+}
+
+// OvercommittedNode returns a copy of node with the overcommit factors configured for pool applied to its total resources.
+// The capacity added by overcommitting is also added to the allocatable resources at each priority,
+// such that resources consumed by non-Armada pods are accounted for at their nominal size.
+// If no factors are configured for pool, node is returned unchanged.
+func OvercommittedNode(
+	pool string,
+	node *schedulerobjects.Node,
+	config configuration.SchedulingConfig,
+) *schedulerobjects.Node {
+	overcommittedResources := OvercommittedResources(pool, node.TotalResources, config)
+	if overcommittedResources.Equal(node.TotalResources) {
+		return node
+	}
+	extra := overcommittedResources.DeepCopy()
+	extra.Sub(node.TotalResources)
+	node = node.DeepCopy()
+	node.TotalResources = overcommittedResources
+	for p, allocatable := range node.AllocatableByPriorityAndResource {
+		allocatable.Add(extra)
+		node.AllocatableByPriorityAndResource[p] = allocatable
+	}
+	return node
+}
+
 func absoluteFromRelativeLimits(totalResources schedulerobjects.ResourceList, relativeLimits map[string]float64) schedulerobjects.ResourceList {
 	absoluteLimits := schedulerobjects.NewResourceList(len(relativeLimits))
 	for t, f := range relativeLimits {
@@ -168,13 +264,138 @@ func (constraints *SchedulingConstraints) CheckConstraints(
 
 	// PriorityClassSchedulingConstraintsByPriorityClassName check.
 	if priorityClassConstraint, ok := constraints.PriorityClassSchedulingConstraintsByPriorityClassName[gctx.PriorityClassName]; ok {
-		if !qctx.AllocatedByPriorityClass[gctx.PriorityClassName].IsStrictlyLessOrEqual(priorityClassConstraint.MaximumResourcesPerQueue) {
+		if !qctx.AllocatedByPriorityClass[gctx.PriorityClassName].IsStrictlyLessOrEqual(priorityClassConstraint.MaximumResourcesPerQueue) &&
+			!constraints.isCoveredByBorrowing(sctx, qctx, priorityClassConstraint) {
 			return false, MaximumResourcesPerQueueExceededUnschedulableReason, nil
 		}
 	}
+
+	// Per-job-set running jobs check.
+	// Since gctx has already been added to sctx, the number of running jobs includes the jobs in this gang.
+	for _, jctx := range gctx.JobSchedulingContexts {
+		var maxRunningJobs int
+		var ok bool
+		if job, isJobDbJob := jctx.Job.(*jobdb.Job); isJobDbJob {
+			maxRunningJobs, ok = job.JobSetMaxRunningJobs()
+		} else {
+			var err error
+			maxRunningJobs, ok, err = jobdb.JobSetMaxRunningJobsFromAnnotations(jctx.Job.GetAnnotations())
+			if err != nil {
+				return false, fmt.Sprintf("invalid annotation %s: %s", configuration.JobSetMaxRunningJobsAnnotation, err), nil
+			}
+		}
+		if ok && qctx.RunningJobsByJobSet[jctx.Job.GetJobSet()] > maxRunningJobs {
+			return false, JobSetMaxRunningJobsExceededUnschedulableReason, nil
+		}
+	}
+	return true, "", nil
+}
+
+// CheckBorrowingConstraints checks that the resources borrowed by the queue of an evicted gang are still lent to it.
+// Otherwise, the gang isn't re-scheduled and is hence preempted, such that lenders may reclaim their quota.
+// Since gctx has already been added to sctx, the resources allocated to the queue include those of this gang.
+func (constraints *SchedulingConstraints) CheckBorrowingConstraints(
+	sctx *schedulercontext.SchedulingContext,
+	gctx *schedulercontext.GangSchedulingContext,
+) (bool, string, error) {
+	if len(constraints.LendersByBorrower[gctx.Queue]) == 0 {
+		return true, "", nil
+	}
+	qctx := sctx.QueueSchedulingContexts[gctx.Queue]
+	if qctx == nil {
+		return false, "", errors.Errorf("no QueueSchedulingContext for queue %s", gctx.Queue)
+	}
+	priorityClassConstraint, ok := constraints.PriorityClassSchedulingConstraintsByPriorityClassName[gctx.PriorityClassName]
+	if !ok || qctx.AllocatedByPriorityClass[gctx.PriorityClassName].IsStrictlyLessOrEqual(priorityClassConstraint.MaximumResourcesPerQueue) {
+		return true, "", nil
+	}
+	if !constraints.isCoveredByBorrowing(sctx, qctx, priorityClassConstraint) {
+		return false, BorrowedResourcesReclaimedUnschedulableReason, nil
+	}
 	return true, "", nil
 }
 
+// IsBorrowing returns true if jobs of the given priority class of the queue of qctx use resources lent by other queues,
+// i.e., if the queue may borrow resources and is allocated more than its per-queue limit for that priority class.
+func (constraints *SchedulingConstraints) IsBorrowing(qctx *schedulercontext.QueueSchedulingContext, priorityClassName string) bool {
+	if len(constraints.LendersByBorrower[qctx.Queue]) == 0 {
+		return false
+	}
+	priorityClassConstraint, ok := constraints.PriorityClassSchedulingConstraintsByPriorityClassName[priorityClassName]
+	if !ok {
+		return false
+	}
+	return !qctx.AllocatedInExcessOf(priorityClassName, priorityClassConstraint.MaximumResourcesPerQueue).IsZero()
+}
+
+// isCoveredByBorrowing returns true if the resources allocated to the queue of qctx in excess of its per-queue limit
+// are covered by the unused limits of its lenders. Since lenders may lend to several queues, the resources borrowed from
+// each lender by its other borrowers are subtracted from what it has to lend.
+func (constraints *SchedulingConstraints) isCoveredByBorrowing(
+	sctx *schedulercontext.SchedulingContext,
+	qctx *schedulercontext.QueueSchedulingContext,
+	priorityClassConstraint PriorityClassSchedulingConstraints,
+) bool {
+	lenders := constraints.LendersByBorrower[qctx.Queue]
+	if len(lenders) == 0 {
+		return false
+	}
+	if priorityClass, ok := sctx.PriorityClasses[priorityClassConstraint.PriorityClassName]; !ok || !priorityClass.Preemptible {
+		return false
+	}
+	name := priorityClassConstraint.PriorityClassName
+	limit := priorityClassConstraint.MaximumResourcesPerQueue
+	lent := schedulerobjects.NewResourceList(len(limit.Resources))
+	for _, lender := range lenders {
+		var available schedulerobjects.ResourceList
+		if lenderQctx := sctx.QueueSchedulingContexts[lender]; lenderQctx != nil {
+			if !isLending(sctx, lenderQctx) {
+				continue
+			}
+			available = lenderQctx.UnallocatedWithin(name, limit)
+		} else if constraints.existingLenders[lender] {
+			// The lender is idle, i.e., has no jobs queued or running, and so lends all of its limit.
+			available = limit.DeepCopy()
+		} else {
+			continue
+		}
+		for _, borrower := range constraints.BorrowersByLender[lender] {
+			if borrower == qctx.Queue {
+				continue
+			}
+			if borrowerQctx := sctx.QueueSchedulingContexts[borrower]; borrowerQctx != nil {
+				available.Sub(borrowerQctx.AllocatedInExcessOf(name, limit))
+			}
+		}
+		for t, q := range available.Resources {
+			if q.Sign() == 1 {
+				lent.AddQuantity(t, q)
+			}
+		}
+	}
+	for t, q := range qctx.AllocatedInExcessOf(name, limit).Resources {
+		if q.Cmp(lent.Get(t)) == 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// isLending returns true if the queue of qctx lends its unused quota, i.e., if it has no queued jobs and is below its fair share.
+func isLending(sctx *schedulercontext.SchedulingContext, qctx *schedulercontext.QueueSchedulingContext) bool {
+	if qctx.NumQueuedJobs > 0 {
+		return false
+	}
+	if sctx.FairnessCostProvider == nil || sctx.WeightSum == 0 {
+		return true
+	}
+	totalCost := sctx.TotalCost()
+	if totalCost == 0 {
+		return true
+	}
+	return sctx.FairnessCostProvider.CostFromQueue(qctx)/totalCost < qctx.Weight/sctx.WeightSum
+}
+
 func RequestsAreLargeEnough(totalResourceRequests, minRequest schedulerobjects.ResourceList) (bool, string) {
 	for t, minQuantity := range minRequest.Resources {
 		q := totalResourceRequests.Get(t)
@@ -5,9 +5,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/types"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 )
 
 func TestConstraints(t *testing.T) {
@@ -34,6 +38,150 @@ func TestConstraints(t *testing.T) {
 	}
 }
 
+func TestCheckBorrowingConstraints(t *testing.T) {
+	priorityClasses := map[string]types.PriorityClass{
+		"preemptible":    {Priority: 1, Preemptible: true},
+		"nonPreemptible": {Priority: 2},
+	}
+	limit := schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("10")}}
+	cpu := func(priorityClassName string, q string) schedulerobjects.QuantityByTAndResourceType[string] {
+		return schedulerobjects.QuantityByTAndResourceType[string]{
+			priorityClassName: schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse(q)}},
+		}
+	}
+	tests := map[string]struct {
+		borrowersByLender map[string][]string
+		allocatedByQueue  map[string]schedulerobjects.QuantityByTAndResourceType[string]
+		numQueuedByQueue  map[string]int
+		// Queues without jobs queued or running, which hence have no queue scheduling context.
+		idleQueues []string
+		// Queues not known to the scheduler.
+		unknownQueues     []string
+		priorityClassName string
+		expectedBorrowing bool
+		expectedReason    string
+	}{
+		"within limit": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}},
+			allocatedByQueue:  map[string]schedulerobjects.QuantityByTAndResourceType[string]{"borrower": cpu("preemptible", "10")},
+			priorityClassName: "preemptible",
+		},
+		"covered by lender": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}},
+			allocatedByQueue: map[string]schedulerobjects.QuantityByTAndResourceType[string]{
+				"borrower": cpu("preemptible", "15"),
+				"lender":   cpu("preemptible", "5"),
+			},
+			priorityClassName: "preemptible",
+			expectedBorrowing: true,
+		},
+		"exceeds unused limit of lender": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}},
+			allocatedByQueue: map[string]schedulerobjects.QuantityByTAndResourceType[string]{
+				"borrower": cpu("preemptible", "16"),
+				"lender":   cpu("preemptible", "5"),
+			},
+			priorityClassName: "preemptible",
+			expectedBorrowing: true,
+			expectedReason:    BorrowedResourcesReclaimedUnschedulableReason,
+		},
+		"lender has queued jobs": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}},
+			allocatedByQueue:  map[string]schedulerobjects.QuantityByTAndResourceType[string]{"borrower": cpu("preemptible", "11")},
+			numQueuedByQueue:  map[string]int{"lender": 1},
+			priorityClassName: "preemptible",
+			expectedBorrowing: true,
+			expectedReason:    BorrowedResourcesReclaimedUnschedulableReason,
+		},
+		"lender shared with other borrower": {
+			borrowersByLender: map[string][]string{"lender": {"borrower", "other"}},
+			allocatedByQueue: map[string]schedulerobjects.QuantityByTAndResourceType[string]{
+				"borrower": cpu("preemptible", "12"),
+				"other":    cpu("preemptible", "19"),
+			},
+			priorityClassName: "preemptible",
+			expectedBorrowing: true,
+			expectedReason:    BorrowedResourcesReclaimedUnschedulableReason,
+		},
+		"multiple lenders": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}, "other": {"borrower"}},
+			allocatedByQueue: map[string]schedulerobjects.QuantityByTAndResourceType[string]{
+				"borrower": cpu("preemptible", "25"),
+				"lender":   cpu("preemptible", "5"),
+			},
+			priorityClassName: "preemptible",
+			expectedBorrowing: true,
+		},
+		"non-preemptible": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}},
+			allocatedByQueue:  map[string]schedulerobjects.QuantityByTAndResourceType[string]{"borrower": cpu("nonPreemptible", "11")},
+			priorityClassName: "nonPreemptible",
+			expectedBorrowing: true,
+			expectedReason:    BorrowedResourcesReclaimedUnschedulableReason,
+		},
+		"idle lender": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}},
+			allocatedByQueue:  map[string]schedulerobjects.QuantityByTAndResourceType[string]{"borrower": cpu("preemptible", "20")},
+			idleQueues:        []string{"lender"},
+			priorityClassName: "preemptible",
+			expectedBorrowing: true,
+		},
+		"exceeds limit of idle lender": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}},
+			allocatedByQueue:  map[string]schedulerobjects.QuantityByTAndResourceType[string]{"borrower": cpu("preemptible", "21")},
+			idleQueues:        []string{"lender"},
+			priorityClassName: "preemptible",
+			expectedBorrowing: true,
+			expectedReason:    BorrowedResourcesReclaimedUnschedulableReason,
+		},
+		"unknown lender": {
+			borrowersByLender: map[string][]string{"lender": {"borrower"}},
+			allocatedByQueue:  map[string]schedulerobjects.QuantityByTAndResourceType[string]{"borrower": cpu("preemptible", "11")},
+			unknownQueues:     []string{"lender"},
+			priorityClassName: "preemptible",
+			expectedBorrowing: true,
+			expectedReason:    BorrowedResourcesReclaimedUnschedulableReason,
+		},
+		"not a borrower": {
+			borrowersByLender: map[string][]string{"lender": {"other"}},
+			allocatedByQueue:  map[string]schedulerobjects.QuantityByTAndResourceType[string]{"borrower": cpu("preemptible", "20")},
+			priorityClassName: "preemptible",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			constraints := SchedulingConstraints{
+				PriorityClassSchedulingConstraintsByPriorityClassName: map[string]PriorityClassSchedulingConstraints{
+					"preemptible":    {PriorityClassName: "preemptible", MaximumResourcesPerQueue: limit},
+					"nonPreemptible": {PriorityClassName: "nonPreemptible", MaximumResourcesPerQueue: limit},
+				},
+			}
+			var queues []string
+			for _, queue := range []string{"borrower", "lender", "other"} {
+				if !slices.Contains(tc.unknownQueues, queue) {
+					queues = append(queues, queue)
+				}
+			}
+			constraints.EnableQuotaBorrowing(tc.borrowersByLender, queues)
+			sctx := schedulercontext.NewSchedulingContext("executor", "pool", priorityClasses, "preemptible", nil, nil, schedulerobjects.ResourceList{})
+			for _, queue := range queues {
+				if slices.Contains(tc.idleQueues, queue) {
+					continue
+				}
+				require.NoError(t, sctx.AddQueueSchedulingContext(queue, 1, tc.allocatedByQueue[queue], nil))
+				sctx.QueueSchedulingContexts[queue].NumQueuedJobs = tc.numQueuedByQueue[queue]
+			}
+			gctx := &schedulercontext.GangSchedulingContext{Queue: "borrower", PriorityClassName: tc.priorityClassName, AllJobsEvicted: true}
+
+			assert.Equal(t, tc.expectedBorrowing, constraints.IsBorrowing(sctx.QueueSchedulingContexts["borrower"], tc.priorityClassName))
+			ok, unschedulableReason, err := constraints.CheckBorrowingConstraints(sctx, gctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReason == "", ok)
+			assert.Equal(t, tc.expectedReason, unschedulableReason)
+		})
+	}
+}
+
 func TestScaleQuantity(t *testing.T) {
 	tests := map[string]struct {
 		input    resource.Quantity
@@ -62,3 +210,109 @@ func TestScaleQuantity(t *testing.T) {
 		})
 	}
 }
+
+func TestOvercommittedResources(t *testing.T) {
+	totalResources := schedulerobjects.ResourceList{
+		Resources: map[string]resource.Quantity{
+			"cpu":    resource.MustParse("10"),
+			"memory": resource.MustParse("10Gi"),
+		},
+	}
+	tests := map[string]struct {
+		pool     string
+		config   configuration.SchedulingConfig
+		expected schedulerobjects.ResourceList
+	}{
+		"no overcommit": {
+			pool:     "pool",
+			config:   configuration.SchedulingConfig{},
+			expected: totalResources,
+		},
+		"overcommit cpu": {
+			pool: "pool",
+			config: configuration.SchedulingConfig{
+				OvercommitFactors: map[string]float64{"cpu": 1.2},
+			},
+			expected: schedulerobjects.ResourceList{
+				Resources: map[string]resource.Quantity{
+					"cpu":    resource.MustParse("12"),
+					"memory": resource.MustParse("10Gi"),
+				},
+			},
+		},
+		"pool-specific overcommit": {
+			pool: "pool",
+			config: configuration.SchedulingConfig{
+				OvercommitFactors: map[string]float64{"cpu": 1.2},
+				OvercommitFactorsByPool: map[string]map[string]float64{
+					"pool": {"memory": 1.5},
+				},
+			},
+			expected: schedulerobjects.ResourceList{
+				Resources: map[string]resource.Quantity{
+					"cpu":    resource.MustParse("10"),
+					"memory": resource.MustParse("15Gi"),
+				},
+			},
+		},
+		"overcommit factor for missing resource": {
+			pool: "pool",
+			config: configuration.SchedulingConfig{
+				OvercommitFactors: map[string]float64{"nvidia.com/gpu": 2},
+			},
+			expected: totalResources,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := OvercommittedResources(tc.pool, totalResources, tc.config)
+			assert.True(t, tc.expected.Equal(actual), "expected %s, but got %s", tc.expected.CompactString(), actual.CompactString())
+		})
+	}
+}
+
+func TestOvercommittedNode(t *testing.T) {
+	node := &schedulerobjects.Node{
+		Id: "node",
+		TotalResources: schedulerobjects.ResourceList{
+			Resources: map[string]resource.Quantity{
+				"cpu":    resource.MustParse("10"),
+				"memory": resource.MustParse("10Gi"),
+			},
+		},
+		AllocatableByPriorityAndResource: map[int32]schedulerobjects.ResourceList{
+			0: {
+				Resources: map[string]resource.Quantity{
+					"cpu":    resource.MustParse("8"),
+					"memory": resource.MustParse("10Gi"),
+				},
+			},
+		},
+	}
+	config := configuration.SchedulingConfig{
+		OvercommitFactors: map[string]float64{"cpu": 1.5},
+	}
+
+	actual := OvercommittedNode("pool", node, config)
+	expectedTotal := schedulerobjects.ResourceList{
+		Resources: map[string]resource.Quantity{
+			"cpu":    resource.MustParse("15"),
+			"memory": resource.MustParse("10Gi"),
+		},
+	}
+	assert.True(t, expectedTotal.Equal(actual.TotalResources), "expected %s, but got %s", expectedTotal.CompactString(), actual.TotalResources.CompactString())
+	// Resources consumed by non-Armada pods are accounted for at their nominal size.
+	expectedAllocatable := schedulerobjects.ResourceList{
+		Resources: map[string]resource.Quantity{
+			"cpu":    resource.MustParse("13"),
+			"memory": resource.MustParse("10Gi"),
+		},
+	}
+	assert.True(t, expectedAllocatable.Equal(actual.AllocatableByPriorityAndResource[0]), "expected %s, but got %s", expectedAllocatable.CompactString(), actual.AllocatableByPriorityAndResource[0].CompactString())
+	// The original node is left unchanged.
+	assert.NotSame(t, node, actual)
+	assert.False(t, expectedTotal.Equal(node.TotalResources))
+	assert.False(t, expectedAllocatable.Equal(node.AllocatableByPriorityAndResource[0]))
+
+	assert.Same(t, node, OvercommittedNode("pool", node, configuration.SchedulingConfig{}))
+}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/openconfig/goyang/pkg/indent"
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"golang.org/x/time/rate"
@@ -16,6 +15,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/logging"
 	armadamaps "github.com/armadaproject/armada/internal/common/maps"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/types"
@@ -55,7 +55,11 @@ type SchedulingContext struct {
 	// Per-queue scheduling contexts.
 	QueueSchedulingContexts map[string]*QueueSchedulingContext
 	// Total resources across all clusters available at the start of the scheduling cycle.
+	// Includes any capacity added by overcommitting resources.
 	TotalResources schedulerobjects.ResourceList
+	// Total resources across all clusters before overcommit factors are applied.
+	// Equal to TotalResources if no resources are overcommitted.
+	NominalResources schedulerobjects.ResourceList
 	// Resources assigned across all queues during this scheduling cycle.
 	ScheduledResources                schedulerobjects.ResourceList
 	ScheduledResourcesByPriorityClass schedulerobjects.QuantityByTAndResourceType[string]
@@ -69,14 +73,24 @@ type SchedulingContext struct {
 	// Total number of evicted jobs.
 	NumEvictedJobs int
 	// TODO(reports): Count the number of evicted gangs.
+	// Ids of nodes marked for maintenance at the start of this scheduling cycle.
+	NodesMarkedForMaintenance []string
+	// Ids of jobs preempted to drain nodes marked for maintenance, grouped by the id of the node they were preempted from.
+	DrainedJobIdsByNodeId map[string][]string
+	// Resources preempted to drain nodes marked for maintenance.
+	DrainedResources schedulerobjects.ResourceList
 	// Reason for why the scheduling round finished.
 	TerminationReason string
+	// Time spent in each phase of the scheduling round, e.g., evicting jobs to balance resources.
+	DurationByPhase map[string]time.Duration
 	// Used to efficiently generate scheduling keys.
 	SchedulingKeyGenerator *schedulerobjects.SchedulingKeyGenerator
 	// Record of job scheduling requirements known to be unfeasible.
 	// Used to immediately reject new jobs with identical reqirements.
 	// Maps to the JobSchedulingContext of a previous job attempted to schedule with the same key.
 	UnfeasibleSchedulingKeys map[schedulerobjects.SchedulingKey]*JobSchedulingContext
+	// Number of jobs rejected because their scheduling key was known to be unfeasible.
+	NumUnfeasibleSchedulingKeyHits int
 }
 
 func NewSchedulingContext(
@@ -98,9 +112,12 @@ func NewSchedulingContext(
 		Limiter:                           limiter,
 		QueueSchedulingContexts:           make(map[string]*QueueSchedulingContext),
 		TotalResources:                    totalResources.DeepCopy(),
+		NominalResources:                  totalResources.DeepCopy(),
 		ScheduledResources:                schedulerobjects.NewResourceListWithDefaultSize(),
 		ScheduledResourcesByPriorityClass: make(schedulerobjects.QuantityByTAndResourceType[string]),
 		EvictedResourcesByPriorityClass:   make(schedulerobjects.QuantityByTAndResourceType[string]),
+		DrainedJobIdsByNodeId:             make(map[string][]string),
+		DurationByPhase:                   make(map[string]time.Duration),
 		SchedulingKeyGenerator:            schedulerobjects.NewSchedulingKeyGenerator(),
 		UnfeasibleSchedulingKeys:          make(map[schedulerobjects.SchedulingKey]*JobSchedulingContext),
 	}
@@ -110,6 +127,14 @@ func (sctx *SchedulingContext) ClearUnfeasibleSchedulingKeys() {
 	sctx.UnfeasibleSchedulingKeys = make(map[schedulerobjects.SchedulingKey]*JobSchedulingContext)
 }
 
+// AddPhaseDuration records that d was spent in phase of the scheduling round.
+func (sctx *SchedulingContext) AddPhaseDuration(phase string, d time.Duration) {
+	if sctx.DurationByPhase == nil {
+		sctx.DurationByPhase = make(map[string]time.Duration)
+	}
+	sctx.DurationByPhase[phase] += d
+}
+
 func (sctx *SchedulingContext) AddQueueSchedulingContext(
 	queue string, weight float64,
 	initialAllocatedByPriorityClass schedulerobjects.QuantityByTAndResourceType[string],
@@ -141,6 +166,8 @@ func (sctx *SchedulingContext) AddQueueSchedulingContext(
 		Limiter:                           limiter,
 		Allocated:                         allocated,
 		AllocatedByPriorityClass:          initialAllocatedByPriorityClass,
+		AllocatedByUser:                   make(schedulerobjects.QuantityByTAndResourceType[string]),
+		RunningJobsByJobSet:               make(map[string]int),
 		ScheduledResourcesByPriorityClass: make(schedulerobjects.QuantityByTAndResourceType[string]),
 		EvictedResourcesByPriorityClass:   make(schedulerobjects.QuantityByTAndResourceType[string]),
 		SuccessfulJobSchedulingContexts:   make(map[string]*JobSchedulingContext),
@@ -155,12 +182,64 @@ func (sctx *SchedulingContext) String() string {
 	return sctx.ReportString(0)
 }
 
+// SchedulingContextSummary summarises a scheduling round, e.g., to be served by the diagnostics server.
+type SchedulingContextSummary struct {
+	ExecutorId                  string
+	Pool                        string
+	Started                     time.Time
+	Finished                    time.Time
+	Duration                    string
+	TerminationReason           string
+	NumQueues                   int
+	NumScheduledJobs            int
+	NumScheduledGangs           int
+	NumEvictedJobs              int
+	NumUnfeasibleSchedulingKeys int
+	TotalResources              string
+	ScheduledResources          string
+	EvictedResources            string
+	DurationByPhase             map[string]string
+}
+
+// Summary returns a summary of the scheduling round, omitting per-queue and per-job details.
+func (sctx *SchedulingContext) Summary() SchedulingContextSummary {
+	durationByPhase := make(map[string]string, len(sctx.DurationByPhase))
+	for phase, d := range sctx.DurationByPhase {
+		durationByPhase[phase] = d.String()
+	}
+	return SchedulingContextSummary{
+		ExecutorId:                  sctx.ExecutorId,
+		Pool:                        sctx.Pool,
+		Started:                     sctx.Started,
+		Finished:                    sctx.Finished,
+		Duration:                    sctx.Finished.Sub(sctx.Started).String(),
+		TerminationReason:           sctx.TerminationReason,
+		NumQueues:                   len(sctx.QueueSchedulingContexts),
+		NumScheduledJobs:            sctx.NumScheduledJobs,
+		NumScheduledGangs:           sctx.NumScheduledGangs,
+		NumEvictedJobs:              sctx.NumEvictedJobs,
+		NumUnfeasibleSchedulingKeys: len(sctx.UnfeasibleSchedulingKeys),
+		TotalResources:              sctx.TotalResources.CompactString(),
+		ScheduledResources:          sctx.ScheduledResources.CompactString(),
+		EvictedResources:            sctx.EvictedResources.CompactString(),
+		DurationByPhase:             durationByPhase,
+	}
+}
+
 // GetQueue is necessary to implement the fairness.QueueRepository interface.
 func (sctx *SchedulingContext) GetQueue(queue string) (fairness.Queue, bool) {
 	qctx, ok := sctx.QueueSchedulingContexts[queue]
 	return qctx, ok
 }
 
+// OvercommittedResources returns the capacity made available by overcommitting resources,
+// i.e., the difference between TotalResources and NominalResources.
+func (sctx *SchedulingContext) OvercommittedResources() schedulerobjects.ResourceList {
+	rv := sctx.TotalResources.DeepCopy()
+	rv.Sub(sctx.NominalResources)
+	return rv
+}
+
 // TotalCost returns the sum of the costs across all queues.
 func (sctx *SchedulingContext) TotalCost() float64 {
 	var rv float64
@@ -178,11 +257,26 @@ func (sctx *SchedulingContext) ReportString(verbosity int32) string {
 	fmt.Fprintf(w, "Duration:\t%s\n", sctx.Finished.Sub(sctx.Started))
 	fmt.Fprintf(w, "Termination reason:\t%s\n", sctx.TerminationReason)
 	fmt.Fprintf(w, "Total capacity:\t%s\n", sctx.TotalResources.CompactString())
+	fmt.Fprintf(w, "Nominal capacity:\t%s\n", sctx.NominalResources.CompactString())
+	fmt.Fprintf(w, "Overcommitted capacity:\t%s\n", sctx.OvercommittedResources().CompactString())
 	fmt.Fprintf(w, "Scheduled resources:\t%s\n", sctx.ScheduledResources.CompactString())
 	fmt.Fprintf(w, "Preempted resources:\t%s\n", sctx.EvictedResources.CompactString())
 	fmt.Fprintf(w, "Number of gangs scheduled:\t%d\n", sctx.NumScheduledGangs)
 	fmt.Fprintf(w, "Number of jobs scheduled:\t%d\n", sctx.NumScheduledJobs)
 	fmt.Fprintf(w, "Number of jobs preempted:\t%d\n", sctx.NumEvictedJobs)
+	if len(sctx.NodesMarkedForMaintenance) > 0 {
+		fmt.Fprint(w, "Drain:\n")
+		fmt.Fprintf(w, "\tNodes marked for maintenance:\t%d\n", len(sctx.NodesMarkedForMaintenance))
+		fmt.Fprintf(w, "\tDrained resources:\t%s\n", sctx.DrainedResources.CompactString())
+		fmt.Fprintf(w, "\tNumber of jobs drained:\t%d\n", sctx.NumDrainedJobs())
+		if verbosity > 0 {
+			nodeIds := maps.Keys(sctx.DrainedJobIdsByNodeId)
+			slices.Sort(nodeIds)
+			for _, nodeId := range nodeIds {
+				fmt.Fprintf(w, "\t%s:\t%v\n", nodeId, sctx.DrainedJobIdsByNodeId[nodeId])
+			}
+		}
+	}
 	scheduled := armadamaps.Filter(
 		sctx.QueueSchedulingContexts,
 		func(_ string, qctx *QueueSchedulingContext) bool {
@@ -217,6 +311,27 @@ func (sctx *SchedulingContext) ReportString(verbosity int32) string {
 	return sb.String()
 }
 
+// MarkNodeForMaintenance records that the node with the given id is marked for maintenance.
+func (sctx *SchedulingContext) MarkNodeForMaintenance(nodeId string) {
+	sctx.NodesMarkedForMaintenance = append(sctx.NodesMarkedForMaintenance, nodeId)
+}
+
+// AddDrainedJob records that job was preempted from the node with the given id to drain nodes marked for maintenance.
+// The job should also be evicted via EvictJob; this method only updates the drain-specific accounting.
+func (sctx *SchedulingContext) AddDrainedJob(nodeId string, job interfaces.LegacySchedulerJob) {
+	sctx.DrainedJobIdsByNodeId[nodeId] = append(sctx.DrainedJobIdsByNodeId[nodeId], job.GetId())
+	sctx.DrainedResources.AddV1ResourceList(job.GetResourceRequirements().Requests)
+}
+
+// NumDrainedJobs returns the number of jobs preempted to drain nodes marked for maintenance.
+func (sctx *SchedulingContext) NumDrainedJobs() int {
+	rv := 0
+	for _, jobIds := range sctx.DrainedJobIdsByNodeId {
+		rv += len(jobIds)
+	}
+	return rv
+}
+
 func (sctx *SchedulingContext) AddGangSchedulingContext(gctx *GangSchedulingContext) (bool, error) {
 	allJobsEvictedInThisRound := true
 	numberOfSuccessfulJobs := 0
@@ -329,6 +444,20 @@ func (sctx *SchedulingContext) AllocatedByQueueAndPriority() map[string]schedule
 	return rv
 }
 
+// AllocatedByQueueAndUser returns map from queue name and job owner to resources allocated.
+func (sctx *SchedulingContext) AllocatedByQueueAndUser() map[string]schedulerobjects.QuantityByTAndResourceType[string] {
+	rv := make(
+		map[string]schedulerobjects.QuantityByTAndResourceType[string],
+		len(sctx.QueueSchedulingContexts),
+	)
+	for queue, qctx := range sctx.QueueSchedulingContexts {
+		if !qctx.AllocatedByUser.IsZero() {
+			rv[queue] = qctx.AllocatedByUser.DeepCopy()
+		}
+	}
+	return rv
+}
+
 // QueueSchedulingContext captures the decisions made by the scheduler during one invocation
 // for a particular queue.
 type QueueSchedulingContext struct {
@@ -351,6 +480,17 @@ type QueueSchedulingContext struct {
 	// Total resources assigned to the queue across all clusters by priority class.
 	// Includes jobs scheduled during this invocation of the scheduler.
 	AllocatedByPriorityClass schedulerobjects.QuantityByTAndResourceType[string]
+	// Total resources assigned to the queue by job owner.
+	// Used to divide the resources assigned to the queue fairly between its users.
+	// Includes jobs scheduled during this invocation of the scheduler.
+	AllocatedByUser schedulerobjects.QuantityByTAndResourceType[string]
+	// Number of jobs of the queue running across all clusters by job set.
+	// Used to enforce per-job-set limits on the number of running jobs.
+	// Includes jobs scheduled during this invocation of the scheduler.
+	RunningJobsByJobSet map[string]int
+	// Number of jobs of the queue queued across all pools at the start of this scheduling cycle.
+	// Used for reporting and to decide whether the queue lends its unused quota to other queues.
+	NumQueuedJobs int
 	// Resources assigned to this queue during this scheduling cycle.
 	ScheduledResourcesByPriorityClass schedulerobjects.QuantityByTAndResourceType[string]
 	// Resources evicted from this queue during this scheduling cycle.
@@ -384,6 +524,38 @@ func (qctx *QueueSchedulingContext) GetWeight() float64 {
 	return qctx.Weight
 }
 
+// AllocatedInExcessOf returns, for each resource type in limit, the resources allocated to jobs of the given
+// priority class of this queue in excess of limit. If limit is the per-queue limit of the priority class,
+// these are the resources the queue borrows from other queues.
+func (qctx *QueueSchedulingContext) AllocatedInExcessOf(priorityClassName string, limit schedulerobjects.ResourceList) schedulerobjects.ResourceList {
+	allocated := qctx.AllocatedByPriorityClass[priorityClassName]
+	rv := schedulerobjects.NewResourceList(len(limit.Resources))
+	for t, l := range limit.Resources {
+		q := allocated.Get(t).DeepCopy()
+		if q.Cmp(l) == 1 {
+			q.Sub(l)
+			rv.Set(t, q)
+		}
+	}
+	return rv
+}
+
+// UnallocatedWithin returns, for each resource type in limit, the part of limit not allocated to jobs of the given
+// priority class of this queue. If limit is the per-queue limit of the priority class,
+// these are the resources the queue may lend to other queues.
+func (qctx *QueueSchedulingContext) UnallocatedWithin(priorityClassName string, limit schedulerobjects.ResourceList) schedulerobjects.ResourceList {
+	allocated := qctx.AllocatedByPriorityClass[priorityClassName]
+	rv := schedulerobjects.NewResourceList(len(limit.Resources))
+	for t, l := range limit.Resources {
+		q := l.DeepCopy()
+		if q.Cmp(allocated.Get(t)) == 1 {
+			q.Sub(allocated.Get(t))
+			rv.Set(t, q)
+		}
+	}
+	return rv
+}
+
 const maxJobIdsToPrint = 1
 
 func (qctx *QueueSchedulingContext) ReportString(verbosity int32) string {
@@ -400,6 +572,9 @@ func (qctx *QueueSchedulingContext) ReportString(verbosity int32) string {
 	if verbosity >= 0 {
 		fmt.Fprintf(w, "Total allocated resources after scheduling:\t%s\n", qctx.Allocated.CompactString())
 		fmt.Fprintf(w, "Total allocated resources after scheduling by priority class:\t%s\n", qctx.AllocatedByPriorityClass)
+		if len(qctx.AllocatedByUser) > 0 {
+			fmt.Fprintf(w, "Total allocated resources after scheduling by user:\t%s\n", qctx.AllocatedByUser)
+		}
 		fmt.Fprintf(w, "Number of jobs scheduled:\t%d\n", len(qctx.SuccessfulJobSchedulingContexts))
 		fmt.Fprintf(w, "Number of jobs preempted:\t%d\n", len(qctx.EvictedJobsById))
 		fmt.Fprintf(w, "Number of jobs that could not be scheduled:\t%d\n", len(qctx.UnsuccessfulJobSchedulingContexts))
@@ -473,6 +648,8 @@ func (qctx *QueueSchedulingContext) AddJobSchedulingContext(jctx *JobSchedulingC
 		// Since ResourcesByPriority is used to order queues by fraction of fair share.
 		qctx.Allocated.AddV1ResourceList(jctx.PodRequirements.ResourceRequirements.Requests)
 		qctx.AllocatedByPriorityClass.AddV1ResourceList(jctx.Job.GetPriorityClassName(), jctx.PodRequirements.ResourceRequirements.Requests)
+		qctx.AllocatedByUser.AddV1ResourceList(jctx.Job.GetOwner(), jctx.PodRequirements.ResourceRequirements.Requests)
+		qctx.RunningJobsByJobSet[jctx.Job.GetJobSet()]++
 
 		// Only if the job is not evicted, update ScheduledResourcesByPriority.
 		// Since ScheduledResourcesByPriority is used to control per-round scheduling constraints.
@@ -508,6 +685,11 @@ func (qctx *QueueSchedulingContext) EvictJob(job interfaces.LegacySchedulerJob)
 	}
 	qctx.Allocated.SubV1ResourceList(rl)
 	qctx.AllocatedByPriorityClass.SubV1ResourceList(job.GetPriorityClassName(), rl)
+	qctx.AllocatedByUser.SubV1ResourceList(job.GetOwner(), rl)
+	qctx.RunningJobsByJobSet[job.GetJobSet()]--
+	if qctx.RunningJobsByJobSet[job.GetJobSet()] <= 0 {
+		delete(qctx.RunningJobsByJobSet, job.GetJobSet())
+	}
 	return scheduledInThisRound, nil
 }
 
@@ -639,9 +821,22 @@ type JobSchedulingContext struct {
 	GangMinCardinality int
 	// If set, indicates this job should be failed back to the client when the gang is scheduled.
 	ShouldFail bool
+	// Pool the job was scheduled in.
+	// Empty if the job was not scheduled.
+	Pool string
+	// Weight of Pool divided by the largest weight among the pools preferred by the job,
+	// i.e., 1 if the job was scheduled in its most preferred pool.
+	// Zero if the job expresses no pool preferences.
+	PoolPreferenceSatisfaction float64
 }
 
 func (jctx *JobSchedulingContext) String() string {
+	return jctx.ReportString(0)
+}
+
+// ReportString returns a report of the outcome of trying to schedule the job,
+// where verbosity controls the detail of the report of the pod scheduling context.
+func (jctx *JobSchedulingContext) ReportString(verbosity int32) string {
 	var sb strings.Builder
 	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
 	fmt.Fprintf(w, "Time:\t%s\n", jctx.Created)
@@ -651,8 +846,22 @@ func (jctx *JobSchedulingContext) String() string {
 	} else {
 		fmt.Fprint(w, "UnschedulableReason:\tnone\n")
 	}
+	if jctx.Pool != "" {
+		fmt.Fprintf(w, "Pool:\t%s\n", jctx.Pool)
+	}
+	if jctx.PoolPreferenceSatisfaction > 0 {
+		fmt.Fprintf(w, "PoolPreferenceSatisfaction:\t%f\n", jctx.PoolPreferenceSatisfaction)
+	}
+	if jctx.PodRequirements != nil {
+		// The effective request accounts for init containers, sidecars, and pod overhead,
+		// and may hence be larger than the sum of the requests of the main containers.
+		fmt.Fprintf(
+			w, "EffectiveResourceRequests:\t%s\n",
+			schedulerobjects.ResourceListFromV1ResourceList(jctx.PodRequirements.ResourceRequirements.Requests).CompactString(),
+		)
+	}
 	if jctx.PodSchedulingContext != nil {
-		fmt.Fprint(w, jctx.PodSchedulingContext.String())
+		fmt.Fprint(w, jctx.PodSchedulingContext.ReportString(verbosity))
 	}
 	fmt.Fprintf(w, "GangMinCardinality:\t%d\n", jctx.GangMinCardinality)
 	w.Flush()
@@ -714,7 +923,7 @@ func JobSchedulingContextFromJob(priorityClasses map[string]types.PriorityClass,
 	// Requires re-phrasing nodedb in terms of gang context, as well as feeding the value extracted from the annotations downstream.
 	gangId, gangCardinality, gangMinCardinality, _, err := extractGangInfo(job.GetAnnotations())
 	if err != nil {
-		logrus.Errorf("failed to get cardinality from job %s: %s", job.GetId(), err)
+		logging.ForModule("scheduler").WithFields(logging.JobFields(job.GetQueue(), job.GetJobSet(), job.GetId())).WithError(err).Error("failed to get cardinality from job")
 		gangId = job.GetId()
 		gangCardinality = 1
 		gangMinCardinality = 1
@@ -748,13 +957,40 @@ type PodSchedulingContext struct {
 	NumNodes int
 	// Number of nodes excluded by reason.
 	NumExcludedNodesByReason map[string]int
+	// Names of a few of the nodes excluded for each reason, at most MaxSampleExcludedNodesPerReason per reason,
+	// such that specific nodes can be inspected when debugging why a job can't be scheduled.
+	// May include reasons no longer in NumExcludedNodesByReason, e.g., from attempts at other priorities.
+	SampleExcludedNodesByReason map[string][]string
 }
 
+// MaxSampleExcludedNodesPerReason is the maximum number of nodes recorded for each reason in SampleExcludedNodesByReason.
+const MaxSampleExcludedNodesPerReason = 3
+
 func (pctx *PodSchedulingContext) IsSuccessful() bool {
 	return pctx != nil && pctx.NodeId != ""
 }
 
+// AddExcludedNodeSample records nodeName as one of the nodes excluded for reason,
+// unless MaxSampleExcludedNodesPerReason nodes have already been recorded for it.
+func (pctx *PodSchedulingContext) AddExcludedNodeSample(reason string, nodeName string) {
+	if pctx.SampleExcludedNodesByReason == nil {
+		pctx.SampleExcludedNodesByReason = make(map[string][]string)
+	}
+	nodeNames := pctx.SampleExcludedNodesByReason[reason]
+	if len(nodeNames) >= MaxSampleExcludedNodesPerReason || slices.Contains(nodeNames, nodeName) {
+		return
+	}
+	pctx.SampleExcludedNodesByReason[reason] = append(nodeNames, nodeName)
+}
+
 func (pctx *PodSchedulingContext) String() string {
+	return pctx.ReportString(0)
+}
+
+// ReportString returns a report of where the pod was scheduled, or why it couldn't be.
+// If verbosity is positive, the reasons nodes were excluded for are sorted by the number of nodes excluded,
+// and each is followed by the names of a few of the nodes excluded for it.
+func (pctx *PodSchedulingContext) ReportString(verbosity int32) string {
 	var sb strings.Builder
 	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
 	if pctx.NodeId != "" {
@@ -765,11 +1001,27 @@ func (pctx *PodSchedulingContext) String() string {
 	fmt.Fprintf(w, "Number of nodes in cluster:\t%d\n", pctx.NumNodes)
 	if len(pctx.NumExcludedNodesByReason) == 0 {
 		fmt.Fprint(w, "Excluded nodes:\tnone\n")
-	} else {
+	} else if verbosity <= 0 {
 		fmt.Fprint(w, "Excluded nodes:\n")
 		for reason, count := range pctx.NumExcludedNodesByReason {
 			fmt.Fprintf(w, "\t%d:\t%s\n", count, reason)
 		}
+	} else {
+		fmt.Fprint(w, "Excluded nodes:\n")
+		reasons := maps.Keys(pctx.NumExcludedNodesByReason)
+		slices.SortFunc(reasons, func(a, b string) bool {
+			if pctx.NumExcludedNodesByReason[a] != pctx.NumExcludedNodesByReason[b] {
+				return pctx.NumExcludedNodesByReason[a] > pctx.NumExcludedNodesByReason[b]
+			}
+			return a < b
+		})
+		for _, reason := range reasons {
+			if nodeNames := pctx.SampleExcludedNodesByReason[reason]; len(nodeNames) > 0 {
+				fmt.Fprintf(w, "\t%d:\t%s (e.g., %s)\n", pctx.NumExcludedNodesByReason[reason], reason, strings.Join(nodeNames, ", "))
+			} else {
+				fmt.Fprintf(w, "\t%d:\t%s\n", pctx.NumExcludedNodesByReason[reason], reason)
+			}
+		}
 	}
 	w.Flush()
 	return sb.String()
@@ -1,11 +1,13 @@
 package context
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
@@ -78,6 +80,12 @@ func TestSchedulingContextAccounting(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobSchedulingContext_String(t *testing.T) {
+	jctx := testSmallCpuJobSchedulingContext("A", testfixtures.TestDefaultPriorityClass)
+	jctx.PodRequirements.ResourceRequirements.Requests = v1.ResourceList{"cpu": resource.MustParse("2")}
+	assert.Contains(t, jctx.String(), "EffectiveResourceRequests: {cpu: 2}")
+}
+
 func testNSmallCpuJobSchedulingContext(queue, priorityClassName string, n int) []*JobSchedulingContext {
 	rv := make([]*JobSchedulingContext, n)
 	for i := 0; i < n; i++ {
@@ -95,3 +103,22 @@ func testSmallCpuJobSchedulingContext(queue, priorityClassName string) *JobSched
 		GangMinCardinality: 1,
 	}
 }
+
+func TestPodSchedulingContext_ReportString(t *testing.T) {
+	pctx := &PodSchedulingContext{
+		NumNodes: 5,
+		NumExcludedNodesByReason: map[string]int{
+			"node does not match pod NodeSelector": 4,
+			"insufficient cpu":                     1,
+		},
+	}
+	for i := 0; i < 4; i++ {
+		pctx.AddExcludedNodeSample("node does not match pod NodeSelector", fmt.Sprintf("node-%d", i))
+	}
+	pctx.AddExcludedNodeSample("node does not match pod NodeSelector", "node-0")
+	assert.Equal(t, []string{"node-0", "node-1", "node-2"}, pctx.SampleExcludedNodesByReason["node does not match pod NodeSelector"])
+
+	assert.NotContains(t, pctx.ReportString(0), "node-0")
+	assert.Contains(t, pctx.ReportString(1), "4: node does not match pod NodeSelector (e.g., node-0, node-1, node-2)")
+	assert.Contains(t, pctx.ReportString(1), "1: insufficient cpu\n")
+}
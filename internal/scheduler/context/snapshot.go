@@ -0,0 +1,104 @@
+package context
+
+import (
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+// Snapshot returns a structured representation of sctx suitable for storing, diffing, and consumption by other tools.
+// Queues and jobs are sorted by name and id respectively, such that equal contexts produce equal snapshots.
+func (sctx *SchedulingContext) Snapshot() *schedulerobjects.SchedulingContextSnapshot {
+	queues := maps.Keys(sctx.QueueSchedulingContexts)
+	slices.Sort(queues)
+	qctxSnapshots := make([]*schedulerobjects.QueueSchedulingContextSnapshot, len(queues))
+	for i, queue := range queues {
+		qctxSnapshots[i] = sctx.QueueSchedulingContexts[queue].Snapshot()
+	}
+	return &schedulerobjects.SchedulingContextSnapshot{
+		Started:                           sctx.Started,
+		Finished:                          sctx.Finished,
+		ExecutorId:                        sctx.ExecutorId,
+		Pool:                              sctx.Pool,
+		WeightSum:                         sctx.WeightSum,
+		TotalResources:                    sctx.TotalResources.DeepCopy(),
+		ScheduledResourcesByPriorityClass: resourceListsByT(sctx.ScheduledResourcesByPriorityClass),
+		EvictedResourcesByPriorityClass:   resourceListsByT(sctx.EvictedResourcesByPriorityClass),
+		NumScheduledJobs:                  uint32(sctx.NumScheduledJobs),
+		NumScheduledGangs:                 uint32(sctx.NumScheduledGangs),
+		NumEvictedJobs:                    uint32(sctx.NumEvictedJobs),
+		TerminationReason:                 sctx.TerminationReason,
+		QueueSchedulingContexts:           qctxSnapshots,
+	}
+}
+
+// Snapshot returns a structured representation of qctx; see SchedulingContext.Snapshot.
+func (qctx *QueueSchedulingContext) Snapshot() *schedulerobjects.QueueSchedulingContextSnapshot {
+	evictedJobIds := maps.Keys(qctx.EvictedJobsById)
+	slices.Sort(evictedJobIds)
+	return &schedulerobjects.QueueSchedulingContextSnapshot{
+		Created:                           qctx.Created,
+		Queue:                             qctx.Queue,
+		Weight:                            qctx.Weight,
+		AllocatedByPriorityClass:          resourceListsByT(qctx.AllocatedByPriorityClass),
+		AllocatedByUser:                   resourceListsByT(qctx.AllocatedByUser),
+		ScheduledResourcesByPriorityClass: resourceListsByT(qctx.ScheduledResourcesByPriorityClass),
+		EvictedResourcesByPriorityClass:   resourceListsByT(qctx.EvictedResourcesByPriorityClass),
+		SuccessfulJobSchedulingContexts:   jobSchedulingContextSnapshots(qctx.SuccessfulJobSchedulingContexts),
+		UnsuccessfulJobSchedulingContexts: jobSchedulingContextSnapshots(qctx.UnsuccessfulJobSchedulingContexts),
+		EvictedJobIds:                     evictedJobIds,
+	}
+}
+
+// Snapshot returns a structured representation of jctx; see SchedulingContext.Snapshot.
+// Job details are omitted if the job spec has been cleared, e.g., by QueueSchedulingContext.ClearJobSpecs.
+func (jctx *JobSchedulingContext) Snapshot() *schedulerobjects.JobSchedulingContextSnapshot {
+	rv := &schedulerobjects.JobSchedulingContextSnapshot{
+		Created:             jctx.Created,
+		JobId:               jctx.JobId,
+		IsEvicted:           jctx.IsEvicted,
+		UnschedulableReason: jctx.UnschedulableReason,
+		GangId:              jctx.GangId,
+		GangCardinality:     uint32(jctx.GangCardinality),
+		Pool:                jctx.Pool,
+	}
+	if jctx.Job != nil {
+		rv.JobSet = jctx.Job.GetJobSet()
+		rv.PriorityClassName = jctx.Job.GetPriorityClassName()
+	}
+	if jctx.PodRequirements != nil {
+		rv.ResourceRequests = schedulerobjects.ResourceListFromV1ResourceList(jctx.PodRequirements.ResourceRequirements.Requests)
+	}
+	if pctx := jctx.PodSchedulingContext; pctx != nil {
+		numExcludedNodesByReason := make(map[string]uint32, len(pctx.NumExcludedNodesByReason))
+		for reason, n := range pctx.NumExcludedNodesByReason {
+			numExcludedNodesByReason[reason] = uint32(n)
+		}
+		rv.PodSchedulingContext = &schedulerobjects.PodSchedulingContextSnapshot{
+			NodeId:                   pctx.NodeId,
+			Score:                    int64(pctx.Score),
+			PreemptedAtPriority:      pctx.PreemptedAtPriority,
+			NumNodes:                 uint32(pctx.NumNodes),
+			NumExcludedNodesByReason: numExcludedNodesByReason,
+		}
+	}
+	return rv
+}
+
+func jobSchedulingContextSnapshots(jctxByJobId map[string]*JobSchedulingContext) []*schedulerobjects.JobSchedulingContextSnapshot {
+	jobIds := maps.Keys(jctxByJobId)
+	slices.Sort(jobIds)
+	rv := make([]*schedulerobjects.JobSchedulingContextSnapshot, len(jobIds))
+	for i, jobId := range jobIds {
+		rv[i] = jctxByJobId[jobId].Snapshot()
+	}
+	return rv
+}
+
+func resourceListsByT(a schedulerobjects.QuantityByTAndResourceType[string]) map[string]schedulerobjects.ResourceList {
+	if len(a) == 0 {
+		return nil
+	}
+	return a.DeepCopy()
+}
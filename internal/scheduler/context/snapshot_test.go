@@ -0,0 +1,78 @@
+package context
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/scheduler/fairness"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+)
+
+func TestSchedulingContextSnapshot(t *testing.T) {
+	totalResources := schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("32")}}
+	fairnessCostProvider, err := fairness.NewAssetFairness(map[string]float64{"cpu": 1})
+	require.NoError(t, err)
+	sctx := NewSchedulingContext(
+		"executor",
+		"pool",
+		testfixtures.TestPriorityClasses,
+		testfixtures.TestDefaultPriorityClass,
+		fairnessCostProvider,
+		nil,
+		totalResources,
+	)
+	sctx.Started = time.Unix(1, 0).UTC()
+	for _, queue := range []string{"B", "A"} {
+		require.NoError(t, sctx.AddQueueSchedulingContext(queue, 1, nil, nil))
+	}
+	successful := testNSmallCpuJobSchedulingContext("A", testfixtures.TestDefaultPriorityClass, 3)
+	for _, jctx := range successful {
+		jctx.PodSchedulingContext = &PodSchedulingContext{NodeId: "node", NumNodes: 2, NumExcludedNodesByReason: map[string]int{"foo": 1}}
+	}
+	_, err = sctx.AddGangSchedulingContext(NewGangSchedulingContext(successful))
+	require.NoError(t, err)
+	unsuccessful := testSmallCpuJobSchedulingContext("B", testfixtures.TestDefaultPriorityClass)
+	unsuccessful.Fail("foo")
+	_, err = sctx.AddJobSchedulingContext(unsuccessful)
+	require.NoError(t, err)
+	sctx.Finished = time.Unix(2, 0).UTC()
+
+	snapshot := sctx.Snapshot()
+	assert.Equal(t, "executor", snapshot.ExecutorId)
+	assert.Equal(t, "pool", snapshot.Pool)
+	assert.Equal(t, uint32(3), snapshot.NumScheduledJobs)
+	require.Len(t, snapshot.QueueSchedulingContexts, 2)
+	qctxA, qctxB := snapshot.QueueSchedulingContexts[0], snapshot.QueueSchedulingContexts[1]
+	assert.Equal(t, "A", qctxA.Queue)
+	assert.Equal(t, "B", qctxB.Queue)
+	require.Len(t, qctxA.SuccessfulJobSchedulingContexts, 3)
+	for i := 1; i < len(qctxA.SuccessfulJobSchedulingContexts); i++ {
+		assert.Less(t, qctxA.SuccessfulJobSchedulingContexts[i-1].JobId, qctxA.SuccessfulJobSchedulingContexts[i].JobId)
+	}
+	assert.Equal(t, "node", qctxA.SuccessfulJobSchedulingContexts[0].PodSchedulingContext.NodeId)
+	assert.Equal(t, map[string]uint32{"foo": 1}, qctxA.SuccessfulJobSchedulingContexts[0].PodSchedulingContext.NumExcludedNodesByReason)
+	require.Len(t, qctxB.UnsuccessfulJobSchedulingContexts, 1)
+	assert.Equal(t, "foo", qctxB.UnsuccessfulJobSchedulingContexts[0].UnschedulableReason)
+
+	// Equal contexts must serialise to identical bytes, such that snapshots can be diffed.
+	data, err := proto.Marshal(snapshot)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		otherData, err := proto.Marshal(sctx.Snapshot())
+		require.NoError(t, err)
+		assert.Equal(t, data, otherData)
+	}
+
+	var decoded schedulerobjects.SchedulingContextSnapshot
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+	otherData, err := proto.Marshal(&decoded)
+	require.NoError(t, err)
+	assert.Equal(t, data, otherData)
+	assert.Equal(t, sctx.Started, decoded.Started)
+}
@@ -53,6 +53,13 @@ type JobRepository interface {
 	// FetchJobRunLeases fetches new job runs for a given executor.  A maximum of maxResults rows will be returned, while run
 	// in excludedRunIds will be excluded
 	FetchJobRunLeases(ctx *armadacontext.Context, executor string, maxResults uint, excludedRunIds []uuid.UUID) ([]*JobRunLease, error)
+
+	// FetchTerminalJobs returns a map indicating whether each of the provided jobs succeeded.  Only jobs that have
+	// succeeded, failed or been cancelled are present in the map; jobs that don't exist or are still active are absent.
+	FetchTerminalJobs(ctx *armadacontext.Context, jobIds []string) (map[string]bool, error)
+
+	// FetchJobSetSuspensions returns all job sets that are currently suspended.
+	FetchJobSetSuspensions(ctx *armadacontext.Context) ([]JobSetSuspension, error)
 }
 
 // PostgresJobRepository is an implementation of JobRepository that stores its state in postgres
@@ -148,6 +155,7 @@ func (r *PostgresJobRepository) FetchJobUpdates(ctx *armadacontext.Context, jobS
 				JobID:                   row.JobID,
 				JobSet:                  row.JobSet,
 				Queue:                   row.Queue,
+				UserID:                  row.UserID,
 				Priority:                row.Priority,
 				Submitted:               row.Submitted,
 				Queued:                  row.Queued,
@@ -219,6 +227,32 @@ func (r *PostgresJobRepository) FindInactiveRuns(ctx *armadacontext.Context, run
 	return inactiveRuns, err
 }
 
+// FetchTerminalJobs returns a map indicating whether each of the provided jobs succeeded.  Only jobs that have
+// succeeded, failed or been cancelled are present in the map; jobs that don't exist or are still active are absent.
+func (r *PostgresJobRepository) FetchTerminalJobs(ctx *armadacontext.Context, jobIds []string) (map[string]bool, error) {
+	succeededByJobId := make(map[string]bool, len(jobIds))
+	queries := New(r.db)
+	for _, chunk := range armadaslices.PartitionToMaxLen(jobIds, int(r.batchSize)) {
+		rows, err := queries.SelectTerminalJobsById(ctx, chunk)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, row := range rows {
+			succeededByJobId[row.JobID] = row.Succeeded
+		}
+	}
+	return succeededByJobId, nil
+}
+
+// FetchJobSetSuspensions returns all job sets that are currently suspended.
+func (r *PostgresJobRepository) FetchJobSetSuspensions(ctx *armadacontext.Context) ([]JobSetSuspension, error) {
+	suspensions, err := New(r.db).SelectJobSetSuspensions(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return suspensions, nil
+}
+
 // FetchJobRunLeases fetches new job runs for a given executor.  A maximum of maxResults rows will be returned, while run
 // in excludedRunIds will be excluded
 func (r *PostgresJobRepository) FetchJobRunLeases(ctx *armadacontext.Context, executor string, maxResults uint, excludedRunIds []uuid.UUID) ([]*JobRunLease, error) {
@@ -269,6 +303,65 @@ func (r *PostgresJobRepository) FetchJobRunLeases(ctx *armadacontext.Context, ex
 	return newRuns, err
 }
 
+// FetchJobSetJobLabels returns the labels of the active jobs of the provided job set, i.e., of jobs that have not
+// succeeded, failed or been cancelled, keyed by job id. Queued jobs are included only if includeQueued is true and
+// leased jobs only if includeLeased is true. Jobs are loaded in batches, such that only their labels are kept in memory.
+func (r *PostgresJobRepository) FetchJobSetJobLabels(
+	ctx *armadacontext.Context, queue string, jobSet string, includeQueued bool, includeLeased bool,
+) (map[string]map[string]string, error) {
+	query := `
+			SELECT job_id, queued, submit_message, serial
+			FROM jobs
+			WHERE queue = $1
+			AND job_set = $2
+			AND serial > $3
+			AND succeeded = false
+			AND failed = false
+			AND cancelled = false
+			AND cancel_requested = false
+			AND cancel_by_jobset_requested = false
+			ORDER BY serial
+			LIMIT $4;
+`
+	decompressor := compress.NewZlibDecompressor()
+	labelsByJobId := make(map[string]map[string]string)
+	var serial int64
+	for {
+		n := 0
+		err := func() error {
+			rows, err := r.db.Query(ctx, query, queue, jobSet, serial, r.batchSize)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var jobId string
+				var queued bool
+				var submitMessage []byte
+				if err := rows.Scan(&jobId, &queued, &submitMessage, &serial); err != nil {
+					return errors.WithStack(err)
+				}
+				n++
+				if (queued && !includeQueued) || (!queued && !includeLeased) {
+					continue
+				}
+				submitJob, err := protoutil.DecompressAndUnmarshall(submitMessage, &armadaevents.SubmitJob{}, decompressor)
+				if err != nil {
+					return err
+				}
+				labelsByJobId[jobId] = submitJob.GetObjectMeta().GetLabels()
+			}
+			return errors.WithStack(rows.Err())
+		}()
+		if err != nil {
+			return nil, err
+		}
+		if n < int(r.batchSize) {
+			return labelsByJobId, nil
+		}
+	}
+}
+
 // CountReceivedPartitions returns a count of the number of partition messages present in the database corresponding
 // to the provided groupId.  This is used by the scheduler to determine if the database represents the state of
 // pulsar after a given point in time.
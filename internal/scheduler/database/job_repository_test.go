@@ -377,6 +377,112 @@ func TestFindInactiveRuns(t *testing.T) {
 	}
 }
 
+func TestFetchTerminalJobs(t *testing.T) {
+	dbJobs, _ := createTestJobs(5)
+	for i := range dbJobs {
+		dbJobs[i].Cancelled = false
+		dbJobs[i].Succeeded = false
+		dbJobs[i].Failed = false
+	}
+	dbJobs[1].Succeeded = true
+	dbJobs[2].Failed = true
+	dbJobs[3].Cancelled = true
+
+	jobIds := make([]string, 0, len(dbJobs)+1)
+	for _, job := range dbJobs {
+		jobIds = append(jobIds, job.JobID)
+	}
+	jobIds = append(jobIds, util.NewULID())
+
+	err := withJobRepository(func(repo *PostgresJobRepository) error {
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+		defer cancel()
+
+		err := database.UpsertWithTransaction(ctx, repo.db, "jobs", dbJobs)
+		require.NoError(t, err)
+
+		succeededByJobId, err := repo.FetchTerminalJobs(ctx, jobIds)
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			map[string]bool{
+				dbJobs[1].JobID: true,
+				dbJobs[2].JobID: false,
+				dbJobs[3].JobID: false,
+			},
+			succeededByJobId,
+		)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestFetchJobSetJobLabels(t *testing.T) {
+	compressor := compress.NewThreadSafeZlibCompressor(1024)
+	dbJobs, _ := createTestJobs(5)
+	for i := range dbJobs {
+		dbJobs[i].CancelRequested = false
+		dbJobs[i].Cancelled = false
+		dbJobs[i].CancelByJobsetRequested = false
+		dbJobs[i].Succeeded = false
+		dbJobs[i].Failed = false
+		dbJobs[i].SubmitMessage = protoutil.MustMarshallAndCompress(
+			&armadaevents.SubmitJob{ObjectMeta: &armadaevents.ObjectMeta{Labels: map[string]string{"index": fmt.Sprint(i)}}},
+			compressor,
+		)
+	}
+	dbJobs[0].Queued = true
+	dbJobs[1].Queued = true
+	dbJobs[2].Succeeded = true
+	dbJobs[3].JobSet = "other-jobset"
+
+	tests := map[string]struct {
+		includeQueued bool
+		includeLeased bool
+		expected      map[string]map[string]string
+	}{
+		"all": {
+			includeQueued: true,
+			includeLeased: true,
+			expected: map[string]map[string]string{
+				dbJobs[0].JobID: {"index": "0"},
+				dbJobs[1].JobID: {"index": "1"},
+				dbJobs[4].JobID: {"index": "4"},
+			},
+		},
+		"queued": {
+			includeQueued: true,
+			expected: map[string]map[string]string{
+				dbJobs[0].JobID: {"index": "0"},
+				dbJobs[1].JobID: {"index": "1"},
+			},
+		},
+		"leased": {
+			includeLeased: true,
+			expected: map[string]map[string]string{
+				dbJobs[4].JobID: {"index": "4"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := withJobRepository(func(repo *PostgresJobRepository) error {
+				ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+				defer cancel()
+
+				err := database.UpsertWithTransaction(ctx, repo.db, "jobs", dbJobs)
+				require.NoError(t, err)
+
+				labelsByJobId, err := repo.FetchJobSetJobLabels(ctx, "test-queue", "test-jobset", tc.includeQueued, tc.includeLeased)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, labelsByJobId)
+				return nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestFetchJobRunLeases(t *testing.T) {
 	const executorName = "testExecutor"
 	dbJobs, _ := createTestJobs(5)
@@ -0,0 +1,8 @@
+-- Job sets the queued jobs of which aren't scheduled until they're resumed.
+CREATE TABLE job_set_suspensions (
+    queue text NOT NULL,
+    job_set text NOT NULL,
+    reason text NOT NULL,
+    suspended timestamptz NOT NULL,
+    PRIMARY KEY (queue, job_set)
+);
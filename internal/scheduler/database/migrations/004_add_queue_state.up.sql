@@ -0,0 +1,2 @@
+-- One of open, paused, draining, or closed. Queued jobs of draining and closed queues aren't scheduled.
+ALTER TABLE queues ADD COLUMN state text NOT NULL DEFAULT 'open';
@@ -0,0 +1,7 @@
+-- Spend of each queue with a budget over the budget period starting at period_start, accrued up to accrued.
+CREATE TABLE queue_spend (
+    queue text PRIMARY KEY,
+    period_start timestamptz NOT NULL,
+    spend double precision NOT NULL,
+    accrued timestamptz NOT NULL
+);
@@ -44,6 +44,13 @@ type JobRunError struct {
 	Error []byte    `db:"error"`
 }
 
+type JobSetSuspension struct {
+	Queue     string    `db:"queue"`
+	JobSet    string    `db:"job_set"`
+	Reason    string    `db:"reason"`
+	Suspended time.Time `db:"suspended"`
+}
+
 type Marker struct {
 	GroupID     uuid.UUID `db:"group_id"`
 	PartitionID int32     `db:"partition_id"`
@@ -53,6 +60,14 @@ type Marker struct {
 type Queue struct {
 	Name   string  `db:"name"`
 	Weight float64 `db:"weight"`
+	State  string  `db:"state"`
+}
+
+type QueueSpend struct {
+	Queue       string    `db:"queue"`
+	PeriodStart time.Time `db:"period_start"`
+	Spend       float64   `db:"spend"`
+	Accrued     time.Time `db:"accrued"`
 }
 
 type Run struct {
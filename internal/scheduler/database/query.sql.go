@@ -23,6 +23,20 @@ func (q *Queries) CountGroup(ctx context.Context, groupID uuid.UUID) (int64, err
 	return count, err
 }
 
+const deleteJobSetSuspension = `-- name: DeleteJobSetSuspension :exec
+DELETE FROM job_set_suspensions WHERE queue = $1 AND job_set = $2
+`
+
+type DeleteJobSetSuspensionParams struct {
+	Queue  string `db:"queue"`
+	JobSet string `db:"job_set"`
+}
+
+func (q *Queries) DeleteJobSetSuspension(ctx context.Context, arg DeleteJobSetSuspensionParams) error {
+	_, err := q.db.Exec(ctx, deleteJobSetSuspension, arg.Queue, arg.JobSet)
+	return err
+}
+
 const deleteOldMarkers = `-- name: DeleteOldMarkers :exec
 DELETE FROM markers WHERE created < $1::timestamptz
 `
@@ -99,15 +113,6 @@ func (q *Queries) MarkJobRunsReturnedById(ctx context.Context, runIds []uuid.UUI
 	return err
 }
 
-const markJobRunsRunningById = `-- name: MarkJobRunsRunningById :exec
-UPDATE runs SET running = true WHERE run_id = ANY($1::UUID[])
-`
-
-func (q *Queries) MarkJobRunsRunningById(ctx context.Context, runIds []uuid.UUID) error {
-	_, err := q.db.Exec(ctx, markJobRunsRunningById, runIds)
-	return err
-}
-
 const markJobRunsSucceededById = `-- name: MarkJobRunsSucceededById :exec
 UPDATE runs SET succeeded = true WHERE run_id = ANY($1::UUID[])
 `
@@ -326,6 +331,35 @@ func (q *Queries) SelectExecutorUpdateTimes(ctx context.Context) ([]SelectExecut
 	return items, nil
 }
 
+const selectJobSetSuspensions = `-- name: SelectJobSetSuspensions :many
+SELECT queue, job_set, reason, suspended FROM job_set_suspensions
+`
+
+func (q *Queries) SelectJobSetSuspensions(ctx context.Context) ([]JobSetSuspension, error) {
+	rows, err := q.db.Query(ctx, selectJobSetSuspensions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []JobSetSuspension
+	for rows.Next() {
+		var i JobSetSuspension
+		if err := rows.Scan(
+			&i.Queue,
+			&i.JobSet,
+			&i.Reason,
+			&i.Suspended,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectJobsForExecutor = `-- name: SelectJobsForExecutor :many
 SELECT jr.run_id, j.queue, j.job_set, j.user_id, j.groups, j.submit_message
 FROM runs jr
@@ -522,6 +556,35 @@ func (q *Queries) SelectNewRunsForJobs(ctx context.Context, arg SelectNewRunsFor
 	return items, nil
 }
 
+const selectQueueSpend = `-- name: SelectQueueSpend :many
+SELECT queue, period_start, spend, accrued FROM queue_spend
+`
+
+func (q *Queries) SelectQueueSpend(ctx context.Context) ([]QueueSpend, error) {
+	rows, err := q.db.Query(ctx, selectQueueSpend)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueueSpend
+	for rows.Next() {
+		var i QueueSpend
+		if err := rows.Scan(
+			&i.Queue,
+			&i.PeriodStart,
+			&i.Spend,
+			&i.Accrued,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectRunErrorsById = `-- name: SelectRunErrorsById :many
 SELECT run_id, job_id, error FROM job_run_errors WHERE run_id = ANY($1::UUID[])
 `
@@ -547,8 +610,37 @@ func (q *Queries) SelectRunErrorsById(ctx context.Context, runIds []uuid.UUID) (
 	return items, nil
 }
 
+const selectTerminalJobsById = `-- name: SelectTerminalJobsById :many
+SELECT job_id, succeeded FROM jobs WHERE job_id = ANY($1::text[]) AND (succeeded = true OR failed = true OR cancelled = true)
+`
+
+type SelectTerminalJobsByIdRow struct {
+	JobID     string `db:"job_id"`
+	Succeeded bool   `db:"succeeded"`
+}
+
+func (q *Queries) SelectTerminalJobsById(ctx context.Context, jobIds []string) ([]SelectTerminalJobsByIdRow, error) {
+	rows, err := q.db.Query(ctx, selectTerminalJobsById, jobIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectTerminalJobsByIdRow
+	for rows.Next() {
+		var i SelectTerminalJobsByIdRow
+		if err := rows.Scan(&i.JobID, &i.Succeeded); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectUpdatedJobs = `-- name: SelectUpdatedJobs :many
-SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, cancel_requested, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, serial FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2
+SELECT job_id, job_set, queue, user_id, priority, submitted, queued, queued_version, cancel_requested, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, serial FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2
 `
 
 type SelectUpdatedJobsParams struct {
@@ -560,6 +652,7 @@ type SelectUpdatedJobsRow struct {
 	JobID                   string `db:"job_id"`
 	JobSet                  string `db:"job_set"`
 	Queue                   string `db:"queue"`
+	UserID                  string `db:"user_id"`
 	Priority                int64  `db:"priority"`
 	Submitted               int64  `db:"submitted"`
 	Queued                  bool   `db:"queued"`
@@ -587,6 +680,7 @@ func (q *Queries) SelectUpdatedJobs(ctx context.Context, arg SelectUpdatedJobsPa
 			&i.JobID,
 			&i.JobSet,
 			&i.Queue,
+			&i.UserID,
 			&i.Priority,
 			&i.Submitted,
 			&i.Queued,
@@ -711,3 +805,49 @@ func (q *Queries) UpsertExecutor(ctx context.Context, arg UpsertExecutorParams)
 	_, err := q.db.Exec(ctx, upsertExecutor, arg.ExecutorID, arg.LastRequest, arg.UpdateTime)
 	return err
 }
+
+const upsertJobSetSuspension = `-- name: UpsertJobSetSuspension :exec
+INSERT INTO job_set_suspensions (queue, job_set, reason, suspended)
+VALUES($1::text, $2::text, $3::text, $4::timestamptz)
+ON CONFLICT (queue, job_set) DO UPDATE SET (reason, suspended) = (excluded.reason, excluded.suspended)
+`
+
+type UpsertJobSetSuspensionParams struct {
+	Queue     string    `db:"queue"`
+	JobSet    string    `db:"job_set"`
+	Reason    string    `db:"reason"`
+	Suspended time.Time `db:"suspended"`
+}
+
+func (q *Queries) UpsertJobSetSuspension(ctx context.Context, arg UpsertJobSetSuspensionParams) error {
+	_, err := q.db.Exec(ctx, upsertJobSetSuspension,
+		arg.Queue,
+		arg.JobSet,
+		arg.Reason,
+		arg.Suspended,
+	)
+	return err
+}
+
+const upsertQueueSpend = `-- name: UpsertQueueSpend :exec
+INSERT INTO queue_spend (queue, period_start, spend, accrued)
+VALUES($1::text, $2::timestamptz, $3::double precision, $4::timestamptz)
+ON CONFLICT (queue) DO UPDATE SET (period_start, spend, accrued) = (excluded.period_start, excluded.spend, excluded.accrued)
+`
+
+type UpsertQueueSpendParams struct {
+	Queue       string    `db:"queue"`
+	PeriodStart time.Time `db:"period_start"`
+	Spend       float64   `db:"spend"`
+	Accrued     time.Time `db:"accrued"`
+}
+
+func (q *Queries) UpsertQueueSpend(ctx context.Context, arg UpsertQueueSpendParams) error {
+	_, err := q.db.Exec(ctx, upsertQueueSpend,
+		arg.Queue,
+		arg.PeriodStart,
+		arg.Spend,
+		arg.Accrued,
+	)
+	return err
+}
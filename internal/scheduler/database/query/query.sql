@@ -5,7 +5,7 @@ SELECT * FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2;
 SELECT job_id FROM jobs;
 
 -- name: SelectUpdatedJobs :many
-SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, cancel_requested, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, serial FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2;
+SELECT job_id, job_set, queue, user_id, priority, submitted, queued, queued_version, cancel_requested, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, serial FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2;
 
 -- name: UpdateJobPriorityByJobSet :exec
 UPDATE jobs SET priority = $1 WHERE job_set = $2 and queue = $3;
@@ -28,6 +28,28 @@ UPDATE jobs SET failed = true WHERE job_id = ANY(sqlc.arg(job_ids)::text[]);
 -- name: UpdateJobPriorityById :exec
 UPDATE jobs SET priority = $1 WHERE job_id = $2;
 
+-- name: SelectTerminalJobsById :many
+SELECT job_id, succeeded FROM jobs WHERE job_id = ANY(sqlc.arg(job_ids)::text[]) AND (succeeded = true OR failed = true OR cancelled = true);
+
+-- name: SelectJobSetSuspensions :many
+SELECT * FROM job_set_suspensions;
+
+-- name: UpsertJobSetSuspension :exec
+INSERT INTO job_set_suspensions (queue, job_set, reason, suspended)
+VALUES($1::text, $2::text, $3::text, $4::timestamptz)
+ON CONFLICT (queue, job_set) DO UPDATE SET (reason, suspended) = (excluded.reason, excluded.suspended);
+
+-- name: DeleteJobSetSuspension :exec
+DELETE FROM job_set_suspensions WHERE queue = $1 AND job_set = $2;
+
+-- name: SelectQueueSpend :many
+SELECT * FROM queue_spend;
+
+-- name: UpsertQueueSpend :exec
+INSERT INTO queue_spend (queue, period_start, spend, accrued)
+VALUES($1::text, $2::timestamptz, $3::double precision, $4::timestamptz)
+ON CONFLICT (queue) DO UPDATE SET (period_start, spend, accrued) = (excluded.period_start, excluded.spend, excluded.accrued);
+
 -- name: SelectNewRuns :many
 SELECT * FROM runs WHERE serial > $1 ORDER BY serial LIMIT $2;
 
@@ -49,9 +71,6 @@ UPDATE runs SET returned = true WHERE run_id = ANY(sqlc.arg(run_ids)::UUID[]);
 -- name: MarkJobRunsAttemptedById :exec
 UPDATE runs SET run_attempted = true WHERE run_id = ANY(sqlc.arg(run_ids)::UUID[]);
 
--- name: MarkJobRunsRunningById :exec
-UPDATE runs SET running = true WHERE run_id = ANY(sqlc.arg(run_ids)::UUID[]);
-
 -- name: MarkRunsCancelledByJobId :exec
 UPDATE runs SET cancelled = true WHERE job_id = ANY(sqlc.arg(job_ids)::text[]);
 
@@ -32,6 +32,7 @@ func (r *LegacyQueueRepository) GetAllQueues() ([]*Queue, error) {
 		queues[i] = &Queue{
 			Name:   legacyQueue.Name,
 			Weight: float64(legacyQueue.PriorityFactor),
+			State:  string(legacyQueue.State),
 		}
 	}
 	return queues, nil
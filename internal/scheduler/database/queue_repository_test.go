@@ -25,16 +25,19 @@ func TestLegacyQueueRepository_GetAllQueues(t *testing.T) {
 				{
 					Name:           "test-queue-2",
 					PriorityFactor: 20,
+					State:          clientQueue.StateDraining,
 				},
 			},
 			expectedQueues: []*Queue{
 				{
 					Name:   "test-queue-1",
 					Weight: 10,
+					State:  "open",
 				},
 				{
 					Name:   "test-queue-2",
 					Weight: 20,
+					State:  "draining",
 				},
 			},
 		},
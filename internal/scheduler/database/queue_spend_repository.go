@@ -0,0 +1,56 @@
+package database
+
+import (
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// QueueSpendRepository stores the spend of queues with a budget, such that it's retained across restarts of the
+// scheduler and changes of leader.
+type QueueSpendRepository interface {
+	// GetQueueSpend returns the stored spend of all queues.
+	GetQueueSpend(ctx *armadacontext.Context) ([]QueueSpend, error)
+	// StoreQueueSpend replaces the stored spend of the provided queues.
+	StoreQueueSpend(ctx *armadacontext.Context, spend []QueueSpend) error
+}
+
+// PostgresQueueSpendRepository is an implementation of QueueSpendRepository that stores its state in postgres
+type PostgresQueueSpendRepository struct {
+	// pool of database connections
+	db *pgxpool.Pool
+}
+
+func NewPostgresQueueSpendRepository(db *pgxpool.Pool) *PostgresQueueSpendRepository {
+	return &PostgresQueueSpendRepository{db: db}
+}
+
+// GetQueueSpend returns the stored spend of all queues.
+func (r *PostgresQueueSpendRepository) GetQueueSpend(ctx *armadacontext.Context) ([]QueueSpend, error) {
+	rows, err := New(r.db).SelectQueueSpend(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for i := range rows {
+		// pgx defaults to local time so we convert to utc here
+		rows[i].PeriodStart = rows[i].PeriodStart.UTC()
+		rows[i].Accrued = rows[i].Accrued.UTC()
+	}
+	return rows, nil
+}
+
+// StoreQueueSpend replaces the stored spend of the provided queues in a single transaction.
+func (r *PostgresQueueSpendRepository) StoreQueueSpend(ctx *armadacontext.Context, spend []QueueSpend) error {
+	err := pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		queries := New(tx)
+		for _, queueSpend := range spend {
+			if err := queries.UpsertQueueSpend(ctx, UpsertQueueSpendParams(queueSpend)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return errors.WithStack(err)
+}
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+func TestQueueSpendRepository_LoadAndSave(t *testing.T) {
+	t1 := time.Now().UTC().Round(1 * time.Microsecond) // postgres only stores times with micro precision
+	periodStart := time.Date(t1.Year(), t1.Month(), 1, 0, 0, 0, 0, time.UTC)
+	err := WithTestDb(func(_ *Queries, db *pgxpool.Pool) error {
+		repo := NewPostgresQueueSpendRepository(db)
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+		defer cancel()
+
+		spend, err := repo.GetQueueSpend(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, spend)
+
+		a := QueueSpend{Queue: "A", PeriodStart: periodStart, Spend: 1.5, Accrued: t1}
+		b := QueueSpend{Queue: "B", PeriodStart: periodStart, Spend: 2, Accrued: t1}
+		require.NoError(t, repo.StoreQueueSpend(ctx, []QueueSpend{a, b}))
+		spend, err = repo.GetQueueSpend(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []QueueSpend{a, b}, spend)
+
+		// Storing the spend of a queue replaces any previously stored spend.
+		a.Spend = 3
+		a.Accrued = t1.Add(time.Minute)
+		require.NoError(t, repo.StoreQueueSpend(ctx, []QueueSpend{a}))
+		spend, err = repo.GetQueueSpend(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []QueueSpend{a, b}, spend)
+		return nil
+	})
+	require.NoError(t, err)
+}
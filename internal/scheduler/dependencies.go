@@ -0,0 +1,169 @@
+package scheduler
+
+import (
+	"time"
+
+	"golang.org/x/exp/maps"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+)
+
+// DependencyIndex tracks queued jobs that depend on other jobs, as specified via the job dependencies annotation.
+// A job is eligible for scheduling only once all jobs it depends on have succeeded.
+//
+// Dependencies still in the jobDb are resolved using the jobDb.
+// The outcome of dependencies that have left the jobDb is looked up in the database and cached
+// for as long as some job waiting on that dependency remains in the index.
+// Dependencies found neither in the jobDb nor among finished jobs in the database are unknown,
+// e.g., since the id is mistyped; jobs waiting on an unknown dependency are failed once
+// unknownDependencyTimeout has passed since they were submitted, unless it's zero.
+type DependencyIndex struct {
+	jobRepository            database.JobRepository
+	unknownDependencyTimeout time.Duration
+	// Ids of the jobs each queued job is waiting on.
+	dependenciesByJobId map[string][]string
+	// Outcome of dependencies that have left the jobDb; true if the dependency succeeded.
+	succeededByJobId map[string]bool
+	clock            clock.Clock
+}
+
+// FailedDependency is a dependency that causes the jobs depending on it to fail.
+type FailedDependency struct {
+	// Id of the dependency.
+	JobId string
+	// True if the dependency is unknown, as opposed to having failed or been cancelled.
+	Unknown bool
+}
+
+func NewDependencyIndex(jobRepository database.JobRepository, unknownDependencyTimeout time.Duration) *DependencyIndex {
+	return &DependencyIndex{
+		jobRepository:            jobRepository,
+		unknownDependencyTimeout: unknownDependencyTimeout,
+		dependenciesByJobId:      make(map[string][]string),
+		succeededByJobId:         make(map[string]bool),
+		clock:                    clock.RealClock{},
+	}
+}
+
+// Observe adds newly submitted jobs with dependencies to the index.
+// Jobs that have been leased before have necessarily had their dependencies satisfied, and are ignored.
+// Jobs that have an invalid dependencies annotation are logged and otherwise ignored.
+func (idx *DependencyIndex) Observe(ctx *armadacontext.Context, jobs []*jobdb.Job) {
+	for _, job := range jobs {
+		if !job.Queued() || job.InTerminalState() || job.HasRuns() {
+			continue
+		}
+		dependencies, err := JobDependenciesFromAnnotations(job.GetAnnotations())
+		if err != nil {
+			warnings.Warnf(ctx.WithField(logging.JobIdField, job.Id()), "ignoring dependencies of job: %s", err)
+			continue
+		}
+		if len(dependencies) > 0 {
+			idx.dependenciesByJobId[job.Id()] = dependencies
+		}
+	}
+}
+
+// IsWaiting returns true if the job with the provided id is waiting for at least one of its dependencies to succeed.
+func (idx *DependencyIndex) IsWaiting(jobId string) bool {
+	if idx == nil {
+		return false
+	}
+	_, ok := idx.dependenciesByJobId[jobId]
+	return ok
+}
+
+// Resolve checks the dependencies of all jobs in the index.
+// Jobs for which all dependencies have succeeded are removed from the index, and are thus eligible for scheduling.
+// Jobs for which some dependency failed, was cancelled, or remained unknown for longer than the unknown dependency timeout
+// are also removed from the index, and are returned as a map from job id to the first such dependency.
+// Jobs that are no longer queued are removed from the index.
+func (idx *DependencyIndex) Resolve(ctx *armadacontext.Context, txn *jobdb.Txn) (map[string]FailedDependency, error) {
+	// Look up the outcome of any dependencies that are neither in the jobDb nor cached.
+	unknownJobIds := make(map[string]bool)
+	for jobId, dependencies := range idx.dependenciesByJobId {
+		if job := txn.GetById(jobId); job == nil || !job.Queued() || job.InTerminalState() {
+			delete(idx.dependenciesByJobId, jobId)
+			continue
+		}
+		for _, dependency := range dependencies {
+			if txn.GetById(dependency) != nil {
+				continue
+			}
+			if _, ok := idx.succeededByJobId[dependency]; !ok {
+				unknownJobIds[dependency] = true
+			}
+		}
+	}
+	if len(unknownJobIds) > 0 {
+		succeededByJobId, err := idx.jobRepository.FetchTerminalJobs(ctx, maps.Keys(unknownJobIds))
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(idx.succeededByJobId, succeededByJobId)
+	}
+
+	now := idx.clock.Now()
+	failedDependencyByJobId := make(map[string]FailedDependency)
+	referencedJobIds := make(map[string]bool)
+	for jobId, dependencies := range idx.dependenciesByJobId {
+		isWaiting := false
+		for _, dependency := range dependencies {
+			succeeded, failed := idx.dependencyOutcome(txn, dependency)
+			if failed {
+				failedDependencyByJobId[jobId] = FailedDependency{JobId: dependency}
+				break
+			} else if !succeeded && idx.isUnknownPastTimeout(txn, jobId, dependency, now) {
+				failedDependencyByJobId[jobId] = FailedDependency{JobId: dependency, Unknown: true}
+				break
+			} else if !succeeded {
+				isWaiting = true
+			}
+		}
+		if _, ok := failedDependencyByJobId[jobId]; ok || !isWaiting {
+			delete(idx.dependenciesByJobId, jobId)
+			continue
+		}
+		for _, dependency := range dependencies {
+			referencedJobIds[dependency] = true
+		}
+	}
+
+	// Only retain outcomes relevant to jobs still in the index.
+	maps.DeleteFunc(idx.succeededByJobId, func(jobId string, _ bool) bool {
+		return !referencedJobIds[jobId]
+	})
+	return failedDependencyByJobId, nil
+}
+
+// isUnknownPastTimeout returns true if dependency is unknown and the unknown dependency timeout has passed
+// since the job with id jobId was submitted.
+func (idx *DependencyIndex) isUnknownPastTimeout(txn *jobdb.Txn, jobId string, dependency string, now time.Time) bool {
+	if idx.unknownDependencyTimeout <= 0 || txn.GetById(dependency) != nil {
+		return false
+	}
+	if _, ok := idx.succeededByJobId[dependency]; ok {
+		return false
+	}
+	submitted := time.Unix(0, txn.GetById(jobId).Created())
+	return now.Sub(submitted) > idx.unknownDependencyTimeout
+}
+
+// dependencyOutcome returns a tuple (succeeded, failed) indicating the outcome of the job with the provided id.
+// If neither is true, the job is still active or its outcome is unknown.
+func (idx *DependencyIndex) dependencyOutcome(txn *jobdb.Txn, jobId string) (bool, bool) {
+	if job := txn.GetById(jobId); job != nil {
+		if job.Succeeded() {
+			return true, false
+		}
+		return false, job.InTerminalState()
+	}
+	if succeeded, ok := idx.succeededByJobId[jobId]; ok {
+		return succeeded, !succeeded
+	}
+	return false, false
+}
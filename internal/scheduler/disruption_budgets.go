@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/armadaproject/armada/internal/scheduler/interfaces"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+)
+
+// jobSetMaxPreemptedFraction returns a tuple (maxPreemptedFraction, hasBudget, error) for the job set of job.
+// For jobs of the jobDb, the value parsed when the job was created is used, such that the annotation isn't parsed
+// every time jobs are considered for eviction.
+func jobSetMaxPreemptedFraction(job interfaces.LegacySchedulerJob) (float64, bool, error) {
+	if job, ok := job.(*jobdb.Job); ok {
+		maxPreemptedFraction, hasBudget := job.JobSetMaxPreemptedFraction()
+		return maxPreemptedFraction, hasBudget, nil
+	}
+	return jobdb.JobSetMaxPreemptedFractionFromAnnotations(job.GetAnnotations())
+}
+
+// jobSetPreemptionHistory records the times at which jobs of job sets with a disruption budget were preempted,
+// such that preemptions from earlier scheduling rounds count against the budget until window has passed.
+type jobSetPreemptionHistory struct {
+	window time.Duration
+	// Preemption times of the jobs of each job set, in the order recorded.
+	preemptedByQueueAndJobSet map[string]map[string][]time.Time
+}
+
+func newJobSetPreemptionHistory(window time.Duration) *jobSetPreemptionHistory {
+	return &jobSetPreemptionHistory{
+		window:                    window,
+		preemptedByQueueAndJobSet: make(map[string]map[string][]time.Time),
+	}
+}
+
+// Record adds the preempted jobs of job sets with a disruption budget to the history.
+func (h *jobSetPreemptionHistory) Record(jobs []interfaces.LegacySchedulerJob, preempted time.Time) {
+	for _, job := range jobs {
+		if _, ok, _ := jobSetMaxPreemptedFraction(job); !ok {
+			continue
+		}
+		preemptedByJobSet := h.preemptedByQueueAndJobSet[job.GetQueue()]
+		if preemptedByJobSet == nil {
+			preemptedByJobSet = make(map[string][]time.Time)
+			h.preemptedByQueueAndJobSet[job.GetQueue()] = preemptedByJobSet
+		}
+		preemptedByJobSet[job.GetJobSet()] = append(preemptedByJobSet[job.GetJobSet()], preempted)
+	}
+}
+
+// Prune removes preemptions that happened window or longer before now.
+func (h *jobSetPreemptionHistory) Prune(now time.Time) {
+	for queue, preemptedByJobSet := range h.preemptedByQueueAndJobSet {
+		for jobSet, preempted := range preemptedByJobSet {
+			i := 0
+			for i < len(preempted) && now.Sub(preempted[i]) >= h.window {
+				i++
+			}
+			if i == len(preempted) {
+				delete(preemptedByJobSet, jobSet)
+			} else {
+				preemptedByJobSet[jobSet] = preempted[i:]
+			}
+		}
+		if len(preemptedByJobSet) == 0 {
+			delete(h.preemptedByQueueAndJobSet, queue)
+		}
+	}
+}
+
+// CountByQueueAndJobSet returns the number of recorded preemptions of each job set.
+func (h *jobSetPreemptionHistory) CountByQueueAndJobSet() map[string]map[string]int {
+	rv := make(map[string]map[string]int, len(h.preemptedByQueueAndJobSet))
+	for queue, preemptedByJobSet := range h.preemptedByQueueAndJobSet {
+		rv[queue] = make(map[string]int, len(preemptedByJobSet))
+		for jobSet, preempted := range preemptedByJobSet {
+			rv[queue][jobSet] = len(preempted)
+		}
+	}
+	return rv
+}
@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/interfaces"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+)
+
+func TestJobSetPreemptionHistory(t *testing.T) {
+	budgeted := testfixtures.WithAnnotationsJobs(
+		map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "0.5"},
+		testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 3),
+	)
+	unbudgeted := testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 1)
+	jobSet := budgeted[0].GetJobSet()
+
+	now := time.Now()
+	h := newJobSetPreemptionHistory(time.Minute)
+	h.Record([]interfaces.LegacySchedulerJob{budgeted[0], unbudgeted[0]}, now)
+	h.Record([]interfaces.LegacySchedulerJob{budgeted[1], budgeted[2]}, now.Add(30*time.Second))
+	assert.Equal(t, map[string]map[string]int{"A": {jobSet: 3}}, h.CountByQueueAndJobSet())
+
+	h.Prune(now.Add(time.Minute))
+	assert.Equal(t, map[string]map[string]int{"A": {jobSet: 2}}, h.CountByQueueAndJobSet())
+
+	h.Prune(now.Add(90 * time.Second))
+	assert.Empty(t, h.CountByQueueAndJobSet())
+}
+
+func TestPreemptingQueueScheduler_IsWithinJobSetDisruptionBudget(t *testing.T) {
+	jobs := testfixtures.WithAnnotationsJobs(
+		map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "0.5"},
+		testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 3),
+	)
+	unbudgeted := testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 1)[0]
+	jobSet := jobs[0].GetJobSet()
+	jobRepo := NewInMemoryJobRepository()
+	nodeIdByJobId := make(map[string]string)
+	for _, job := range append(jobs, unbudgeted) {
+		jobRepo.EnqueueMany([]*schedulercontext.JobSchedulingContext{
+			schedulercontext.JobSchedulingContextFromJob(testfixtures.TestPriorityClasses, job, GangIdAndCardinalityFromAnnotations),
+		})
+		nodeIdByJobId[job.GetId()] = "node"
+	}
+	newScheduler := func() *PreemptingQueueScheduler {
+		sctx := schedulercontext.NewSchedulingContext("executor", "pool", testfixtures.TestPriorityClasses, "", nil, nil, schedulerobjects.ResourceList{})
+		require.NoError(t, sctx.AddQueueSchedulingContext("A", 1, nil, nil))
+		sctx.QueueSchedulingContexts["A"].RunningJobsByJobSet = map[string]int{jobSet: 3}
+		return &PreemptingQueueScheduler{schedulingContext: sctx, jobRepo: jobRepo, nodeIdByJobId: nodeIdByJobId}
+	}
+	ctx := armadacontext.Background()
+
+	// Without budgets, jobs may always be evicted.
+	sch := newScheduler()
+	require.NoError(t, sch.selectJobsWithinJobSetDisruptionBudgets())
+	assert.True(t, sch.isWithinJobSetDisruptionBudget(ctx, jobs[0]))
+
+	// One job was preempted in an earlier round and three are running, such that one more job may be preempted.
+	// Only the job re-scheduled last, and hence preempted first, may be evicted.
+	sch = newScheduler()
+	sch.EnableJobSetDisruptionBudgets(map[string]map[string]int{"A": {jobSet: 1}})
+	require.NoError(t, sch.selectJobsWithinJobSetDisruptionBudgets())
+	assert.False(t, sch.isWithinJobSetDisruptionBudget(ctx, jobs[0]))
+	assert.False(t, sch.isWithinJobSetDisruptionBudget(ctx, jobs[1]))
+	assert.True(t, sch.isWithinJobSetDisruptionBudget(ctx, jobs[2]))
+
+	// Once the budget is used up by preemptions, no jobs of the job set may be evicted.
+	sch = newScheduler()
+	sch.EnableJobSetDisruptionBudgets(map[string]map[string]int{"A": {jobSet: 2}})
+	require.NoError(t, sch.selectJobsWithinJobSetDisruptionBudgets())
+	for _, job := range jobs {
+		assert.False(t, sch.isWithinJobSetDisruptionBudget(ctx, job))
+	}
+
+	// Jobs without a budget may always be evicted.
+	assert.True(t, sch.isWithinJobSetDisruptionBudget(ctx, unbudgeted))
+}
@@ -0,0 +1,200 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+// defaultMinDuplicates is the minimum number of jobs making up a group of duplicates
+// for it to be reported if none is provided in the request.
+const defaultMinDuplicates = 2
+
+// DuplicateJobDetector keeps track of recently submitted jobs to identify jobs with equal scheduling requirements
+// submitted to the same queue repeatedly, e.g., by a runaway retry loop in a user pipeline.
+// Jobs are considered duplicates if they have equal scheduling key and run the same workload,
+// i.e., their containers have equal images, commands, arguments, and environment;
+// other fields, e.g., the job set, are ignored.
+type DuplicateJobDetector struct {
+	// Only jobs submitted within this window are considered.
+	window time.Duration
+	// Recently submitted jobs, grouped by queue and duplicate key.
+	submissionsByQueueAndKey map[string]map[duplicateKey][]jobSubmission
+	// Ids of the jobs in submissionsByQueueAndKey, to avoid counting jobs more than once.
+	jobIds map[string]bool
+	clock  clock.Clock
+	mu     sync.Mutex
+}
+
+// duplicateKey is the key on which jobs are grouped to identify duplicates.
+type duplicateKey struct {
+	schedulingKey schedulerobjects.SchedulingKey
+	// Hash of the pod spec of the job, set by the scheduler ingester; see configuration.PodSpecHashAnnotation.
+	podSpecHash string
+}
+
+type jobSubmission struct {
+	jobId             string
+	jobSet            string
+	priorityClassName string
+	requests          string
+	submitted         time.Time
+}
+
+func NewDuplicateJobDetector(window time.Duration) *DuplicateJobDetector {
+	return &DuplicateJobDetector{
+		window:                   window,
+		submissionsByQueueAndKey: make(map[string]map[duplicateKey][]jobSubmission),
+		jobIds:                   make(map[string]bool),
+		clock:                    clock.RealClock{},
+	}
+}
+
+// Observe records the provided jobs. Jobs submitted before the start of the window are ignored.
+func (d *DuplicateJobDetector) Observe(jobs []*jobdb.Job) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	windowStart := d.clock.Now().Add(-d.window)
+	d.prune(windowStart)
+	for _, job := range jobs {
+		if d.jobIds[job.Id()] {
+			continue
+		}
+		submitted := time.Unix(0, job.Created())
+		if submitted.Before(windowStart) {
+			continue
+		}
+		schedulingKey, ok := job.GetSchedulingKey()
+		if !ok {
+			continue
+		}
+		key := duplicateKey{
+			schedulingKey: schedulingKey,
+			podSpecHash:   job.GetAnnotations()[configuration.PodSpecHashAnnotation],
+		}
+		submission := jobSubmission{
+			jobId:             job.Id(),
+			jobSet:            job.Jobset(),
+			priorityClassName: job.GetPriorityClassName(),
+			submitted:         submitted,
+		}
+		if req := job.PodRequirements(); req != nil {
+			submission.requests = schedulerobjects.ResourceListFromV1ResourceList(req.ResourceRequirements.Requests).CompactString()
+		}
+		submissionsByKey := d.submissionsByQueueAndKey[job.Queue()]
+		if submissionsByKey == nil {
+			submissionsByKey = make(map[duplicateKey][]jobSubmission)
+			d.submissionsByQueueAndKey[job.Queue()] = submissionsByKey
+		}
+		submissionsByKey[key] = append(submissionsByKey[key], submission)
+		d.jobIds[job.Id()] = true
+	}
+}
+
+// prune removes all jobs submitted before windowStart.
+func (d *DuplicateJobDetector) prune(windowStart time.Time) {
+	for queue, submissionsByKey := range d.submissionsByQueueAndKey {
+		for key, submissions := range submissionsByKey {
+			i := 0
+			for _, submission := range submissions {
+				if submission.submitted.Before(windowStart) {
+					delete(d.jobIds, submission.jobId)
+				} else {
+					submissions[i] = submission
+					i++
+				}
+			}
+			if i == 0 {
+				delete(submissionsByKey, key)
+			} else {
+				submissionsByKey[key] = submissions[:i]
+			}
+		}
+		if len(submissionsByKey) == 0 {
+			delete(d.submissionsByQueueAndKey, queue)
+		}
+	}
+}
+
+// GetDuplicateJobsReport is a gRPC endpoint for querying groups of duplicate jobs.
+func (d *DuplicateJobDetector) GetDuplicateJobsReport(_ context.Context, request *schedulerobjects.DuplicateJobsReportRequest) (*schedulerobjects.DuplicateJobsReport, error) {
+	if d == nil {
+		return nil, errors.New("duplicate job detection is disabled")
+	}
+	minDuplicates := int(request.GetMinDuplicates())
+	if minDuplicates <= 0 {
+		minDuplicates = defaultMinDuplicates
+	}
+	return &schedulerobjects.DuplicateJobsReport{
+		Report: d.getDuplicateJobsReportString(strings.TrimSpace(request.GetQueueName()), minDuplicates),
+	}, nil
+}
+
+func (d *DuplicateJobDetector) getDuplicateJobsReportString(queue string, minDuplicates int) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.prune(d.clock.Now().Add(-d.window))
+
+	queues := maps.Keys(d.submissionsByQueueAndKey)
+	if queue != "" {
+		queues = []string{queue}
+	}
+	slices.Sort(queues)
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
+	for _, queue := range queues {
+		groups := make([][]jobSubmission, 0)
+		for _, submissions := range d.submissionsByQueueAndKey[queue] {
+			if len(submissions) >= minDuplicates {
+				groups = append(groups, submissions)
+			}
+		}
+		if len(groups) == 0 {
+			fmt.Fprintf(w, "Queue %s: no duplicate jobs submitted within the last %s\n", queue, d.window)
+			continue
+		}
+		// Report the largest groups first.
+		slices.SortFunc(groups, func(a, b []jobSubmission) bool {
+			if len(a) != len(b) {
+				return len(a) > len(b)
+			}
+			return a[0].jobId < b[0].jobId
+		})
+		fmt.Fprintf(w, "Queue %s: %d groups of duplicate jobs submitted within the last %s\n", queue, len(groups), d.window)
+		for _, submissions := range groups {
+			first, last := submissions[0], submissions[0]
+			jobSets := make(map[string]bool)
+			for _, submission := range submissions {
+				if submission.submitted.Before(first.submitted) {
+					first = submission
+				}
+				if submission.submitted.After(last.submitted) {
+					last = submission
+				}
+				jobSets[submission.jobSet] = true
+			}
+			sortedJobSets := maps.Keys(jobSets)
+			slices.Sort(sortedJobSets)
+			fmt.Fprintf(w, "\t%d jobs:\n", len(submissions))
+			fmt.Fprintf(w, "\t\tPriority class:\t%s\n", first.priorityClassName)
+			fmt.Fprintf(w, "\t\tRequests:\t%s\n", first.requests)
+			fmt.Fprintf(w, "\t\tJob sets:\t%v\n", sortedJobSets)
+			fmt.Fprintf(w, "\t\tFirst submitted:\t%s (job %s)\n", first.submitted, first.jobId)
+			fmt.Fprintf(w, "\t\tLast submitted:\t%s (job %s)\n", last.submitted, last.jobId)
+		}
+	}
+	w.Flush()
+	return sb.String()
+}
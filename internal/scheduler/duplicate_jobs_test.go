@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+)
+
+func TestDuplicateJobDetector(t *testing.T) {
+	ctx := armadacontext.Background()
+	testClock := clock.NewFakeClock(time.Unix(0, 0).Add(time.Minute))
+	detector := NewDuplicateJobDetector(time.Hour)
+	detector.clock = testClock
+
+	duplicateJobs := testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 3)
+	otherJobs := armadaslices.Concatenate(
+		testfixtures.N16Cpu128GiJobs("A", testfixtures.PriorityClass0, 1),
+		testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 1),
+	)
+	detector.Observe(duplicateJobs)
+	detector.Observe(otherJobs)
+	// Jobs observed more than once should only be counted once.
+	detector.Observe(duplicateJobs[:1])
+
+	report, err := detector.GetDuplicateJobsReport(ctx, &schedulerobjects.DuplicateJobsReportRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, report.Report, "Queue A: 1 groups of duplicate jobs")
+	assert.Contains(t, report.Report, "3 jobs:")
+	assert.Contains(t, report.Report, "Queue B: no duplicate jobs")
+
+	report, err = detector.GetDuplicateJobsReport(ctx, &schedulerobjects.DuplicateJobsReportRequest{QueueName: "A", MinDuplicates: 4})
+	require.NoError(t, err)
+	assert.Contains(t, report.Report, "Queue A: no duplicate jobs")
+	assert.NotContains(t, report.Report, "Queue B")
+
+	// Jobs submitted before the start of the window are forgotten.
+	testClock.Step(2 * time.Hour)
+	report, err = detector.GetDuplicateJobsReport(ctx, &schedulerobjects.DuplicateJobsReportRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, strings.TrimSpace(report.Report))
+	assert.Empty(t, detector.jobIds)
+	detector.Observe(duplicateJobs)
+	assert.Empty(t, detector.submissionsByQueueAndKey)
+}
+
+func TestDuplicateJobDetector_DifferentPodSpecs(t *testing.T) {
+	ctx := armadacontext.Background()
+	testClock := clock.NewFakeClock(time.Unix(0, 0).Add(time.Minute))
+	detector := NewDuplicateJobDetector(time.Hour)
+	detector.clock = testClock
+
+	// The jobs have equal scheduling key, but run different workloads.
+	jobs := testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 4)
+	testfixtures.WithAnnotationsJobs(map[string]string{configuration.PodSpecHashAnnotation: "foo"}, jobs[:2])
+	testfixtures.WithAnnotationsJobs(map[string]string{configuration.PodSpecHashAnnotation: "bar"}, jobs[2:3])
+	detector.Observe(jobs)
+
+	report, err := detector.GetDuplicateJobsReport(ctx, &schedulerobjects.DuplicateJobsReportRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, report.Report, "Queue A: 1 groups of duplicate jobs")
+	assert.Contains(t, report.Report, "2 jobs:")
+	assert.Contains(t, report.Report, jobs[0].Id())
+	assert.NotContains(t, report.Report, jobs[2].Id())
+	assert.NotContains(t, report.Report, jobs[3].Id())
+}
+
+func TestDuplicateJobDetector_Disabled(t *testing.T) {
+	var detector *DuplicateJobDetector
+	_, err := detector.GetDuplicateJobsReport(armadacontext.Background(), &schedulerobjects.DuplicateJobsReportRequest{})
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 )
 
@@ -84,3 +85,28 @@ func (f *DominantResourceFairness) CostFromAllocationAndWeight(allocation schedu
 	}
 	return cost / weight
 }
+
+type CostFairness struct {
+	// Pool whose prices are used.
+	pool string
+	// Prices of resources.
+	costModel configuration.CostModelConfig
+}
+
+func NewCostFairness(pool string, costModel configuration.CostModelConfig) (*CostFairness, error) {
+	if len(costModel.HourlyPrices) == 0 {
+		return nil, errors.New("costModel.HourlyPrices is empty")
+	}
+	return &CostFairness{
+		pool:      pool,
+		costModel: costModel,
+	}, nil
+}
+
+func (f *CostFairness) CostFromQueue(queue Queue) float64 {
+	return f.CostFromAllocationAndWeight(queue.GetAllocation(), queue.GetWeight())
+}
+
+func (f *CostFairness) CostFromAllocationAndWeight(allocation schedulerobjects.ResourceList, weight float64) float64 {
+	return f.costModel.HourlyCost(f.pool, allocation.Resources) / weight
+}
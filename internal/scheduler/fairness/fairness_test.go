@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 )
 
@@ -271,3 +272,69 @@ func TestDominantResourceFairness(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCostFairness(t *testing.T) {
+	_, err := NewCostFairness("pool", configuration.CostModelConfig{})
+	require.Error(t, err)
+}
+
+func TestCostFairness(t *testing.T) {
+	costModel := configuration.CostModelConfig{
+		HourlyPrices: map[string]float64{
+			"cpu":    0.04,
+			"memory": 0.005,
+		},
+		PriceUnits: map[string]resource.Quantity{
+			"memory": resource.MustParse("1Gi"),
+		},
+		PoolMultipliers: map[string]float64{
+			"spot": 0.5,
+		},
+	}
+	allocation := schedulerobjects.ResourceList{
+		Resources: map[string]resource.Quantity{
+			"cpu":            resource.MustParse("10"),
+			"memory":         resource.MustParse("20Gi"),
+			"nvidia.com/gpu": resource.MustParse("1"),
+		},
+	}
+	tests := map[string]struct {
+		pool         string
+		weight       float64
+		expectedCost float64
+	}{
+		"default pool": {
+			pool:         "pool",
+			weight:       1.0,
+			expectedCost: 0.5,
+		},
+		"weighted": {
+			pool:         "pool",
+			weight:       2.0,
+			expectedCost: 0.25,
+		},
+		"pool multiplier": {
+			pool:         "spot",
+			weight:       1.0,
+			expectedCost: 0.25,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := NewCostFairness(tc.pool, costModel)
+			require.NoError(t, err)
+			assert.InDelta(
+				t,
+				tc.expectedCost,
+				f.CostFromAllocationAndWeight(allocation, tc.weight),
+				1e-9,
+			)
+			assert.InDelta(
+				t,
+				f.CostFromAllocationAndWeight(allocation, tc.weight),
+				f.CostFromQueue(MinimalQueue{allocation: allocation, weight: tc.weight}),
+				1e-9,
+			)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package scheduler
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+// GetFairnessWhatIf is a gRPC endpoint for evaluating hypothetical queue weights and quotas.
+// It recomputes the fair share of each queue and projects which resources would be preempted
+// against the most recent scheduling round in each pool, without changing any queue or preempting any job.
+func (repo *SchedulingContextRepository) GetFairnessWhatIf(_ context.Context, request *schedulerobjects.FairnessWhatIfRequest) (*schedulerobjects.FairnessWhatIfReport, error) {
+	pool := strings.TrimSpace(request.GetPool())
+	for queue, weight := range request.GetWeights() {
+		if !(weight > 0) || math.IsInf(weight, 1) {
+			return nil, &armadaerrors.ErrInvalidArgument{
+				Name:    "weights",
+				Value:   weight,
+				Message: "weight of queue " + queue + " must be positive",
+			}
+		}
+	}
+	for queue, quota := range request.GetQuotas() {
+		for t, fraction := range quota.MaximumResourceFraction {
+			if !(fraction >= 0) {
+				return nil, &armadaerrors.ErrInvalidArgument{
+					Name:    "quotas",
+					Value:   fraction,
+					Message: "quota of queue " + queue + " for resource " + t + " must not be negative",
+				}
+			}
+		}
+	}
+
+	// If there are several executors in a pool, use the most recent scheduling round across those.
+	mostRecentByPool := make(map[string]*schedulercontext.SchedulingContext)
+	for _, sctx := range repo.GetMostRecentSchedulingContextByExecutor() {
+		if pool != "" && sctx.Pool != pool {
+			continue
+		}
+		if previous := mostRecentByPool[sctx.Pool]; previous == nil || sctx.Finished.After(previous.Finished) {
+			mostRecentByPool[sctx.Pool] = sctx
+		}
+	}
+	if pool != "" && len(mostRecentByPool) == 0 {
+		return nil, &armadaerrors.ErrNotFound{
+			Type:    "pool",
+			Value:   pool,
+			Message: "no recent scheduling round for this pool",
+		}
+	}
+
+	pools := maps.Keys(mostRecentByPool)
+	slices.Sort(pools)
+	rv := &schedulerobjects.FairnessWhatIfReport{}
+	for _, pool := range pools {
+		rv.Queues = append(rv.Queues, fairnessWhatIf(mostRecentByPool[pool], request.GetWeights(), request.GetQuotas())...)
+	}
+	return rv, nil
+}
+
+// fairnessWhatIf computes the fair share and projected preemptions of each queue of sctx under the provided weights and quotas.
+//
+// The adjusted fair share of each queue is computed by water-filling: queues without queued jobs that are allocated less
+// than their fair share keep what they have, and the remainder is divided among the other queues in proportion to weight.
+// Queues above their adjusted fair share are projected to be preempted down to it if any queue with queued jobs is below theirs,
+// and all queues are projected to be preempted down to their quota. Only jobs of preemptible priority classes are preempted.
+func fairnessWhatIf(sctx *schedulercontext.SchedulingContext, weights map[string]float64, quotas map[string]schedulerobjects.QueueQuota) []*schedulerobjects.FairnessWhatIf {
+	queues := maps.Keys(sctx.QueueSchedulingContexts)
+	slices.Sort(queues)
+	rv := make([]*schedulerobjects.FairnessWhatIf, len(queues))
+	var weightSum float64
+	for i, queue := range queues {
+		qctx := sctx.QueueSchedulingContexts[queue]
+		weight, ok := weights[queue]
+		if !ok {
+			weight = qctx.Weight
+		}
+		weightSum += weight
+		rv[i] = &schedulerobjects.FairnessWhatIf{
+			QueueName:          queue,
+			Pool:               sctx.Pool,
+			Time:               sctx.Finished,
+			CurrentWeight:      qctx.Weight,
+			Weight:             weight,
+			ActualShare:        unweightedShare(sctx, qctx.Allocated),
+			Allocated:          qctx.Allocated.DeepCopy(),
+			ProjectedPreempted: schedulerobjects.NewResourceListWithDefaultSize(),
+		}
+		if sctx.WeightSum > 0 {
+			rv[i].CurrentFairShare = qctx.Weight / sctx.WeightSum
+		}
+	}
+	for _, q := range rv {
+		q.FairShare = q.Weight / weightSum
+	}
+
+	// Water-fill the shares unused by queues without queued jobs.
+	isSaturated := make(map[string]bool)
+	remaining := 1.0
+	for {
+		unsaturatedWeightSum := 0.0
+		for _, q := range rv {
+			if !isSaturated[q.QueueName] {
+				unsaturatedWeightSum += q.Weight
+			}
+		}
+		changed := false
+		for _, q := range rv {
+			if isSaturated[q.QueueName] || sctx.QueueSchedulingContexts[q.QueueName].NumQueuedJobs > 0 {
+				continue
+			}
+			if q.ActualShare < remaining*q.Weight/unsaturatedWeightSum {
+				isSaturated[q.QueueName] = true
+				q.AdjustedFairShare = q.ActualShare
+				remaining -= q.ActualShare
+				changed = true
+			}
+		}
+		if !changed {
+			for _, q := range rv {
+				if !isSaturated[q.QueueName] {
+					q.AdjustedFairShare = remaining * q.Weight / unsaturatedWeightSum
+				}
+			}
+			break
+		}
+	}
+
+	// Resources are only reclaimed from queues above their fair share if some other queue is waiting for them.
+	isContended := false
+	for _, q := range rv {
+		if sctx.QueueSchedulingContexts[q.QueueName].NumQueuedJobs > 0 && q.ActualShare < q.AdjustedFairShare {
+			isContended = true
+			break
+		}
+	}
+	for _, q := range rv {
+		qctx := sctx.QueueSchedulingContexts[q.QueueName]
+		preemptible := schedulerobjects.NewResourceListWithDefaultSize()
+		for priorityClassName, allocated := range qctx.AllocatedByPriorityClass {
+			if priorityClass, ok := sctx.PriorityClasses[priorityClassName]; ok && priorityClass.Preemptible {
+				preemptible.Add(allocated)
+			}
+		}
+		if isContended && q.ActualShare > q.AdjustedFairShare {
+			excess := q.ActualShare - q.AdjustedFairShare
+			preemptibleShare := unweightedShare(sctx, preemptible)
+			fraction := 1.0
+			if preemptibleShare > excess {
+				fraction = excess / preemptibleShare
+			}
+			for t, quantity := range preemptible.Resources {
+				q.ProjectedPreempted.Set(t, scaleQuantity(quantity, fraction))
+			}
+		}
+		quota, ok := quotas[q.QueueName]
+		if !ok {
+			continue
+		}
+		for t, maximumFraction := range quota.MaximumResourceFraction {
+			total := sctx.TotalResources.Get(t)
+			limit := scaleQuantity(total, maximumFraction)
+			overQuota := q.Allocated.Get(t)
+			overQuota.Sub(limit)
+			if available := preemptible.Get(t); overQuota.Cmp(available) == 1 {
+				overQuota = available
+			}
+			if projected := q.ProjectedPreempted.Get(t); overQuota.Cmp(projected) == 1 {
+				q.ProjectedPreempted.Set(t, overQuota)
+			}
+		}
+	}
+	return rv
+}
+
+// unweightedShare returns the fraction of the total resources of sctx that allocation makes up,
+// as computed by the fairness cost provider of sctx, or zero if sctx has no fairness cost provider.
+func unweightedShare(sctx *schedulercontext.SchedulingContext, allocation schedulerobjects.ResourceList) float64 {
+	if sctx.FairnessCostProvider == nil {
+		return 0
+	}
+	total := sctx.FairnessCostProvider.CostFromAllocationAndWeight(sctx.TotalResources, 1)
+	if total == 0 {
+		return 0
+	}
+	return sctx.FairnessCostProvider.CostFromAllocationAndWeight(allocation, 1) / total
+}
+
+func scaleQuantity(q resource.Quantity, factor float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(math.Ceil(float64(q.MilliValue())*factor)), q.Format)
+}
@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/fairness"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+)
+
+func TestGetFairnessWhatIf(t *testing.T) {
+	config := testfixtures.TestSchedulingConfig()
+	repo, err := NewSchedulingContextRepository(1024, config)
+	require.NoError(t, err)
+
+	cpu := func(q string) schedulerobjects.ResourceList {
+		return schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse(q)}}
+	}
+	totalResources := cpu("10")
+	fairnessCostProvider, err := fairness.NewDominantResourceFairness(totalResources, []string{"cpu"})
+	require.NoError(t, err)
+	sctx := schedulercontext.NewSchedulingContext(
+		"executor",
+		"pool",
+		config.Preemption.PriorityClasses,
+		config.Preemption.DefaultPriorityClass,
+		fairnessCostProvider,
+		nil,
+		totalResources,
+	)
+	// A is above its fair share, with 2 cpu that can't be preempted.
+	require.NoError(t, sctx.AddQueueSchedulingContext("A", 1, schedulerobjects.QuantityByTAndResourceType[string]{
+		testfixtures.PriorityClass0: cpu("4"),
+		testfixtures.PriorityClass3: cpu("2"),
+	}, nil))
+	// B is waiting for resources.
+	require.NoError(t, sctx.AddQueueSchedulingContext("B", 1, nil, nil))
+	sctx.QueueSchedulingContexts["B"].NumQueuedJobs = 5
+	// C has nothing to run, so its fair share is divided between A and B.
+	require.NoError(t, sctx.AddQueueSchedulingContext("C", 2, nil, nil))
+	require.NoError(t, repo.AddSchedulingContext(sctx))
+	ctx := armadacontext.Background()
+
+	report, err := repo.GetFairnessWhatIf(ctx, &schedulerobjects.FairnessWhatIfRequest{})
+	require.NoError(t, err)
+	require.Len(t, report.Queues, 3)
+	a, b, c := report.Queues[0], report.Queues[1], report.Queues[2]
+	assert.Equal(t, "A", a.QueueName)
+	assert.Equal(t, "pool", a.Pool)
+	assert.Equal(t, 0.25, a.CurrentFairShare)
+	assert.Equal(t, 0.25, a.FairShare)
+	assert.Equal(t, 0.5, a.AdjustedFairShare)
+	assert.InDelta(t, 0.6, a.ActualShare, 1e-9)
+	assert.True(t, cpu("1").Equal(a.ProjectedPreempted), a.ProjectedPreempted.CompactString())
+	assert.Equal(t, 0.5, b.AdjustedFairShare)
+	assert.True(t, b.ProjectedPreempted.IsZero())
+	assert.Equal(t, 0.5, c.FairShare)
+	assert.Equal(t, 0.0, c.AdjustedFairShare)
+
+	// Increasing the weight of B reclaims more of the resources of A, but never those that can't be preempted.
+	report, err = repo.GetFairnessWhatIf(ctx, &schedulerobjects.FairnessWhatIfRequest{Weights: map[string]float64{"B": 9}})
+	require.NoError(t, err)
+	a, b = report.Queues[0], report.Queues[1]
+	assert.Equal(t, 1.0, a.CurrentWeight)
+	assert.Equal(t, 1.0, a.Weight)
+	assert.Equal(t, 9.0, b.Weight)
+	assert.Equal(t, 0.75, b.FairShare)
+	assert.InDelta(t, 0.9, b.AdjustedFairShare, 1e-9)
+	assert.True(t, cpu("4").Equal(a.ProjectedPreempted), a.ProjectedPreempted.CompactString())
+
+	// A quota reclaims resources even if no other queue is waiting for them.
+	sctx.QueueSchedulingContexts["B"].NumQueuedJobs = 0
+	report, err = repo.GetFairnessWhatIf(ctx, &schedulerobjects.FairnessWhatIfRequest{
+		Pool: "pool",
+		Quotas: map[string]schedulerobjects.QueueQuota{
+			"A": {MaximumResourceFraction: map[string]float64{"cpu": 0.3}},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, cpu("3").Equal(report.Queues[0].ProjectedPreempted), report.Queues[0].ProjectedPreempted.CompactString())
+
+	_, err = repo.GetFairnessWhatIf(ctx, &schedulerobjects.FairnessWhatIfRequest{Weights: map[string]float64{"A": 0}})
+	assert.ErrorAs(t, err, new(*armadaerrors.ErrInvalidArgument))
+
+	_, err = repo.GetFairnessWhatIf(ctx, &schedulerobjects.FairnessWhatIfRequest{Pool: "other"})
+	assert.ErrorAs(t, err, new(*armadaerrors.ErrNotFound))
+}
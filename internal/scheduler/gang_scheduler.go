@@ -81,7 +81,12 @@ func (sch *GangScheduler) updateGangSchedulingContextOnFailure(gctx *schedulerco
 	//
 	// Only record unfeasible scheduling keys for single-job gangs.
 	// Since a gang may be unschedulable even if all its members are individually schedulable.
-	if !sch.skipUnsuccessfulSchedulingKeyCheck && gctx.Cardinality() == 1 {
+	// Jobs unschedulable because of the limit of their job set are also not recorded,
+	// since jobs with equal scheduling key may belong to other job sets.
+	// The same applies to jobs unschedulable because the resources borrowed by their queue were reclaimed.
+	if !sch.skipUnsuccessfulSchedulingKeyCheck && gctx.Cardinality() == 1 &&
+		unschedulableReason != schedulerconstraints.JobSetMaxRunningJobsExceededUnschedulableReason &&
+		unschedulableReason != schedulerconstraints.BorrowedResourcesReclaimedUnschedulableReason {
 		jctx := gctx.JobSchedulingContexts[0]
 		schedulingKey, ok := jctx.SchedulingKey()
 		if ok && schedulingKey != schedulerobjects.EmptySchedulingKey {
@@ -135,6 +140,9 @@ func (sch *GangScheduler) Schedule(ctx *armadacontext.Context, gctx *schedulerco
 		if ok, unschedulableReason, err = sch.constraints.CheckConstraints(sch.schedulingContext, gctx); err != nil || !ok {
 			return
 		}
+	} else if ok, unschedulableReason, err = sch.constraints.CheckBorrowingConstraints(sch.schedulingContext, gctx); err != nil || !ok {
+		// Evicted jobs using resources no longer lent to their queue are preempted.
+		return
 	}
 	return sch.trySchedule(ctx, gctx)
 }
@@ -14,6 +14,7 @@ type LegacySchedulerJob interface {
 	GetId() string
 	GetQueue() string
 	GetJobSet() string
+	GetOwner() string
 	GetPerQueuePriority() uint32
 	GetSubmitTime() time.Time
 	GetAnnotations() map[string]string
@@ -43,5 +44,6 @@ func SchedulingKeyFromLegacySchedulerJob(skg *schedulerobjects.SchedulingKeyGene
 		job.GetTolerations(),
 		job.GetResourceRequirements().Requests,
 		job.GetPriorityClassName(),
+		job.GetPodRequirements(nil).GetPersistentVolumeClaims(),
 	)
 }
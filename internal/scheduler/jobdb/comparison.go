@@ -98,7 +98,17 @@ func SchedulingOrderCompare(job, other *Job) int {
 	}
 
 	// Jobs higher in queue-priority come first.
-	if job.priority < other.priority {
+	// If priority aging is enabled, the priority of each job is reduced in proportion to the time since it was submitted.
+	// Since all jobs of a queue age at the same rate, the order between jobs doesn't depend on the current time.
+	if job.priorityAgingRate != 0 || other.priorityAgingRate != 0 {
+		jobPriority := job.agedPriority()
+		otherPriority := other.agedPriority()
+		if jobPriority < otherPriority {
+			return -1
+		} else if jobPriority > otherPriority {
+			return 1
+		}
+	} else if job.priority < other.priority {
 		return -1
 	} else if job.priority > other.priority {
 		return 1
@@ -131,3 +141,10 @@ func SchedulingOrderCompare(job, other *Job) int {
 	}
 	panic("We should never get here. Since we check for job id equality at the top of this function.")
 }
+
+// agedPriority returns a value that orders jobs of a queue by their priority after aging.
+// At time t, the aged priority of a job is priority - priorityAgingRate * (t - submittedTime),
+// i.e., agedPriority() - priorityAgingRate * t, where the last term is equal for all jobs of a queue.
+func (job *Job) agedPriority() float64 {
+	return float64(job.priority) + job.priorityAgingRate*float64(job.submittedTime)
+}
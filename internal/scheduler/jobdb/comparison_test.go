@@ -2,6 +2,7 @@ package jobdb
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -49,6 +50,21 @@ func TestJobPriorityComparer(t *testing.T) {
 			b:        &Job{id: "b", priority: 1, priorityClass: types.PriorityClass{Priority: 1}, submittedTime: 1},
 			expected: -1,
 		},
+		"Queued jobs are ordered by priority after aging": {
+			a:        &Job{id: "a", priority: 3, priorityClass: types.PriorityClass{Priority: 1}, submittedTime: 0, priorityAgingRate: 1 / float64(time.Hour)},
+			b:        &Job{id: "b", priority: 1, priorityClass: types.PriorityClass{Priority: 1}, submittedTime: int64(3 * time.Hour), priorityAgingRate: 1 / float64(time.Hour)},
+			expected: -1,
+		},
+		"Queued jobs are ordered by priority if aging hasn't made up for the difference in priority": {
+			a:        &Job{id: "a", priority: 3, priorityClass: types.PriorityClass{Priority: 1}, submittedTime: 0, priorityAgingRate: 1 / float64(time.Hour)},
+			b:        &Job{id: "b", priority: 1, priorityClass: types.PriorityClass{Priority: 1}, submittedTime: int64(time.Hour), priorityAgingRate: 1 / float64(time.Hour)},
+			expected: 1,
+		},
+		"Aging doesn't affect the order between priority classes": {
+			a:        &Job{id: "a", priority: 1, priorityClass: types.PriorityClass{Priority: 1}, submittedTime: 0, priorityAgingRate: 1 / float64(time.Hour)},
+			b:        &Job{id: "b", priority: 100, priorityClass: types.PriorityClass{Priority: 2}, submittedTime: int64(1000 * time.Hour), priorityAgingRate: 1 / float64(time.Hour)},
+			expected: 1,
+		},
 		"Running jobs come before queued jobs": {
 			a:        &Job{id: "a", priority: 1},
 			b:        (&Job{id: "b", priority: 2}).WithNewRun("", "", ""),
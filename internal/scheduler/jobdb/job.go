@@ -1,13 +1,18 @@
 package jobdb
 
 import (
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
 	v1 "k8s.io/api/core/v1"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	armadamaps "github.com/armadaproject/armada/internal/common/maps"
 	"github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
@@ -22,6 +27,8 @@ type Job struct {
 	// JobSet that the job belongs to.
 	// We store this as it's needed for sending job event messages.
 	jobSet string
+	// Id of the user that submitted the job.
+	owner string
 	// Per-queue priority of this job.
 	priority uint32
 	// Requested per queue priority of this job.
@@ -30,6 +37,9 @@ type Job struct {
 	// Job submission time in nanoseconds since the epoch.
 	// I.e., the value returned by time.UnixNano().
 	submittedTime int64
+	// Amount by which the priority of this job is reduced per nanosecond since it was submitted
+	// when determining the order in which jobs are scheduled. Populated automatically on job creation.
+	priorityAgingRate float64
 	// Hash of the scheduling requirements of the job.
 	schedulingKey schedulerobjects.SchedulingKey
 	// True if the job is currently queued.
@@ -41,6 +51,16 @@ type Job struct {
 	jobSchedulingInfo *schedulerobjects.JobSchedulingInfo
 	// Priority class of this job. Populated automatically on job creation.
 	priorityClass types.PriorityClass
+	// Weight by pool parsed from the pool preferences annotation of this job,
+	// or nil if the job expresses no pool preferences. Populated automatically on job creation.
+	poolPreferences map[string]float64
+	// Maximum number of running jobs of the job set of this job parsed from the job set max running jobs annotation,
+	// or zero if the job sets no limit. Populated automatically on job creation.
+	jobSetMaxRunningJobs int
+	// Maximum fraction of the jobs of the job set of this job that may be preempted parsed from the
+	// job set max preempted fraction annotation, if hasJobSetDisruptionBudget. Populated automatically on job creation.
+	jobSetMaxPreemptedFraction float64
+	hasJobSetDisruptionBudget  bool
 	// True if the user has requested this job be cancelled
 	cancelRequested bool
 	// True if the user has requested this job's jobSet be cancelled
@@ -101,6 +121,9 @@ func (job *Job) Equal(other *Job) bool {
 	if job.jobSet != other.jobSet {
 		return false
 	}
+	if job.owner != other.owner {
+		return false
+	}
 	if job.priority != other.priority {
 		return false
 	}
@@ -110,6 +133,9 @@ func (job *Job) Equal(other *Job) bool {
 	if job.submittedTime != other.submittedTime {
 		return false
 	}
+	if job.priorityAgingRate != other.priorityAgingRate {
+		return false
+	}
 	if job.schedulingKey != other.schedulingKey {
 		// We assume jobSchedulingInfo is equal if schedulingKey is equal.
 		return false
@@ -178,6 +204,24 @@ func (job *Job) GetJobSet() string {
 	return job.jobSet
 }
 
+// Owner returns the id of the user that submitted the job.
+func (job *Job) Owner() string {
+	return job.owner
+}
+
+// GetOwner returns the id of the user that submitted the job.
+// This is needed for the LegacyJob interface.
+func (job *Job) GetOwner() string {
+	return job.owner
+}
+
+// WithOwner returns a copy of the job with the owner updated.
+func (job *Job) WithOwner(owner string) *Job {
+	j := copyJob(*job)
+	j.owner = owner
+	return j
+}
+
 // Queue returns the queue this job belongs to.
 func (job *Job) Queue() string {
 	return job.queue
@@ -247,6 +291,24 @@ func (job *Job) GetAnnotations() map[string]string {
 	return nil
 }
 
+// PoolPreferences returns the weight by pool parsed from the pool preferences annotation of the job,
+// or nil if the job expresses no pool preferences.
+func (job *Job) PoolPreferences() map[string]float64 {
+	return job.poolPreferences
+}
+
+// JobSetMaxRunningJobs returns a tuple (maxRunningJobs, hasLimit), where maxRunningJobs is parsed from the
+// job set max running jobs annotation of the job.
+func (job *Job) JobSetMaxRunningJobs() (int, bool) {
+	return job.jobSetMaxRunningJobs, job.jobSetMaxRunningJobs > 0
+}
+
+// JobSetMaxPreemptedFraction returns a tuple (maxPreemptedFraction, hasBudget), where maxPreemptedFraction is parsed
+// from the job set max preempted fraction annotation of the job.
+func (job *Job) JobSetMaxPreemptedFraction() (float64, bool) {
+	return job.jobSetMaxPreemptedFraction, job.hasJobSetDisruptionBudget
+}
+
 // Needed for compatibility with interfaces.LegacySchedulerJob
 func (job *Job) GetPriorityClassName() string {
 	return job.JobSchedulingInfo().PriorityClassName
@@ -514,9 +576,105 @@ func (job *Job) WithJobSchedulingInfo(jobSchedulingInfo *schedulerobjects.JobSch
 	j := copyJob(*job)
 	j.jobSchedulingInfo = jobSchedulingInfo
 	j.ensureJobSchedulingInfoFieldsInitialised()
+	j.poolPreferences = poolPreferencesFromJob(j)
+	j.jobSetMaxRunningJobs = jobSetMaxRunningJobsFromJob(j)
+	j.jobSetMaxPreemptedFraction, j.hasJobSetDisruptionBudget = jobSetMaxPreemptedFractionFromJob(j)
 	return j
 }
 
+// poolPreferencesFromJob returns the pool preferences of job.
+// Pool preferences are validated on submission; invalid preferences are ignored.
+func poolPreferencesFromJob(job *Job) map[string]float64 {
+	weightByPool, _, err := PoolPreferencesFromAnnotations(job.GetAnnotations())
+	if err != nil {
+		return nil
+	}
+	return weightByPool
+}
+
+// PoolPreferencesFromAnnotations returns a tuple (weightByPool, hasPoolPreferences, error),
+// where weightByPool is parsed from the value of the pool preferences annotation, e.g., "on-prem=1,cloud=0.2".
+func PoolPreferencesFromAnnotations(annotations map[string]string) (map[string]float64, bool, error) {
+	value, ok := annotations[configuration.PoolPreferencesAnnotation]
+	if !ok {
+		return nil, false, nil
+	}
+	weightByPool := make(map[string]float64)
+	for _, preference := range strings.Split(value, ",") {
+		pool, weightString, ok := strings.Cut(preference, "=")
+		pool = strings.TrimSpace(pool)
+		if !ok || pool == "" {
+			return nil, false, errors.Errorf("invalid pool preference %q; expected pool=weight", preference)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(weightString), 64)
+		if err != nil {
+			return nil, false, errors.WithStack(err)
+		}
+		if math.IsNaN(weight) || math.IsInf(weight, 0) || weight <= 0 {
+			return nil, false, errors.Errorf("weight of pool %s is not a positive finite number %f", pool, weight)
+		}
+		if _, ok := weightByPool[pool]; ok {
+			return nil, false, errors.Errorf("pool %s appears more than once", pool)
+		}
+		weightByPool[pool] = weight
+	}
+	return weightByPool, true, nil
+}
+
+// jobSetMaxRunningJobsFromJob returns the maximum number of running jobs of the job set of job, or zero if there's none.
+// The annotation is validated on submission; an invalid limit is ignored.
+func jobSetMaxRunningJobsFromJob(job *Job) int {
+	maxRunningJobs, _, err := JobSetMaxRunningJobsFromAnnotations(job.GetAnnotations())
+	if err != nil {
+		return 0
+	}
+	return maxRunningJobs
+}
+
+// JobSetMaxRunningJobsFromAnnotations returns a tuple (maxRunningJobs, hasLimit, error),
+// where maxRunningJobs is parsed from the value of the job set max running jobs annotation.
+func JobSetMaxRunningJobsFromAnnotations(annotations map[string]string) (int, bool, error) {
+	value, ok := annotations[configuration.JobSetMaxRunningJobsAnnotation]
+	if !ok {
+		return 0, false, nil
+	}
+	maxRunningJobs, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false, errors.WithStack(err)
+	}
+	if maxRunningJobs <= 0 {
+		return 0, false, errors.Errorf("max running jobs is non-positive %d", maxRunningJobs)
+	}
+	return maxRunningJobs, true, nil
+}
+
+// jobSetMaxPreemptedFractionFromJob returns a tuple (maxPreemptedFraction, hasBudget) for the job set of job.
+// The annotation is validated on submission; an invalid budget is ignored.
+func jobSetMaxPreemptedFractionFromJob(job *Job) (float64, bool) {
+	maxPreemptedFraction, ok, err := JobSetMaxPreemptedFractionFromAnnotations(job.GetAnnotations())
+	if err != nil {
+		return 0, false
+	}
+	return maxPreemptedFraction, ok
+}
+
+// JobSetMaxPreemptedFractionFromAnnotations returns a tuple (maxPreemptedFraction, hasBudget, error),
+// where maxPreemptedFraction is parsed from the value of the job set max preempted fraction annotation.
+func JobSetMaxPreemptedFractionFromAnnotations(annotations map[string]string) (float64, bool, error) {
+	value, ok := annotations[configuration.JobSetMaxPreemptedFractionAnnotation]
+	if !ok {
+		return 0, false, nil
+	}
+	maxPreemptedFraction, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false, errors.WithStack(err)
+	}
+	if math.IsNaN(maxPreemptedFraction) || maxPreemptedFraction < 0 || maxPreemptedFraction > 1 {
+		return 0, false, errors.Errorf("max preempted fraction %f is not in [0, 1]", maxPreemptedFraction)
+	}
+	return maxPreemptedFraction, true, nil
+}
+
 func (job *Job) DeepCopy() *Job {
 	copiedSchedulingInfo := proto.Clone(job.JobSchedulingInfo()).(*schedulerobjects.JobSchedulingInfo)
 	j := job.WithJobSchedulingInfo(copiedSchedulingInfo)
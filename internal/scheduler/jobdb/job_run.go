@@ -22,6 +22,8 @@ type JobRun struct {
 	nodeName string
 	// True if the job has been reported as running by the executor.
 	running bool
+	// Time at which the run was reported as running by the executor, or zero if it hasn't been.
+	runningTime int64
 	// True if the job has been reported as succeeded by the executor.
 	succeeded bool
 	// True if the job has been reported as failed by the executor.
@@ -158,6 +160,19 @@ func (run *JobRun) WithRunning(running bool) *JobRun {
 	return run
 }
 
+// RunningTime returns the time at which the executor reported the job run as running,
+// or zero if it hasn't been reported as running.
+func (run *JobRun) RunningTime() int64 {
+	return run.runningTime
+}
+
+// WithRunningTime returns a copy of the job run with the running time updated.
+func (run *JobRun) WithRunningTime(runningTime int64) *JobRun {
+	run = run.DeepCopy()
+	run.runningTime = runningTime
+	return run
+}
+
 // Returned Returns true if the executor has returned the job run.
 func (run *JobRun) Returned() bool {
 	return run.returned
@@ -36,6 +36,13 @@ func TestJobRun_TestRunning(t *testing.T) {
 	assert.True(t, runningRun.Running())
 }
 
+func TestJobRun_TestRunningTime(t *testing.T) {
+	runningRun := baseJobRun.WithRunningTime(10)
+	assert.Equal(t, int64(0), baseJobRun.RunningTime())
+	assert.Equal(t, int64(10), runningRun.RunningTime())
+	assert.Equal(t, baseJobRun.Created(), runningRun.Created())
+}
+
 func TestJobRun_TestSucceeded(t *testing.T) {
 	succeededRun := baseJobRun.WithSucceeded(true)
 	assert.False(t, baseJobRun.Succeeded())
@@ -6,7 +6,9 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 )
@@ -366,3 +368,210 @@ func TestJobSchedulingInfoFieldsInitialised(t *testing.T) {
 	assert.NotNil(t, updatedJob.GetNodeSelector())
 	assert.NotNil(t, updatedJob.GetAnnotations())
 }
+
+func TestPoolPreferencesFromAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		annotations      map[string]string
+		expected         map[string]float64
+		expectedHasPrefs bool
+		expectedError    bool
+	}{
+		"no annotation": {
+			annotations: map[string]string{"foo": "bar"},
+		},
+		"single pool": {
+			annotations:      map[string]string{configuration.PoolPreferencesAnnotation: "on-prem=1"},
+			expected:         map[string]float64{"on-prem": 1},
+			expectedHasPrefs: true,
+		},
+		"multiple pools": {
+			annotations:      map[string]string{configuration.PoolPreferencesAnnotation: "on-prem=1, cloud = 0.2"},
+			expected:         map[string]float64{"on-prem": 1, "cloud": 0.2},
+			expectedHasPrefs: true,
+		},
+		"missing weight": {
+			annotations:   map[string]string{configuration.PoolPreferencesAnnotation: "on-prem"},
+			expectedError: true,
+		},
+		"non-numeric weight": {
+			annotations:   map[string]string{configuration.PoolPreferencesAnnotation: "on-prem=high"},
+			expectedError: true,
+		},
+		"non-positive weight": {
+			annotations:   map[string]string{configuration.PoolPreferencesAnnotation: "on-prem=1,cloud=0"},
+			expectedError: true,
+		},
+		"NaN weight": {
+			annotations:   map[string]string{configuration.PoolPreferencesAnnotation: "on-prem=1,cloud=NaN"},
+			expectedError: true,
+		},
+		"infinite weight": {
+			annotations:   map[string]string{configuration.PoolPreferencesAnnotation: "on-prem=Inf"},
+			expectedError: true,
+		},
+		"duplicate pool": {
+			annotations:   map[string]string{configuration.PoolPreferencesAnnotation: "on-prem=1,on-prem=0.5"},
+			expectedError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, hasPrefs, err := PoolPreferencesFromAnnotations(tc.annotations)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedHasPrefs, hasPrefs)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestJob_PoolPreferences(t *testing.T) {
+	job := jobDb.NewJob("jobId", "jobSet", "queue", 1, jobSchedulingInfo, true, 0, false, false, false, 2)
+	assert.Nil(t, job.PoolPreferences())
+
+	schedulingInfo := proto.Clone(jobSchedulingInfo).(*schedulerobjects.JobSchedulingInfo)
+	schedulingInfo.GetObjectRequirements()[0].GetPodRequirements().Annotations[configuration.PoolPreferencesAnnotation] = "on-prem=1,cloud=0.2"
+	job = job.WithJobSchedulingInfo(schedulingInfo)
+	assert.Equal(t, map[string]float64{"on-prem": 1, "cloud": 0.2}, job.PoolPreferences())
+	assert.Equal(t, map[string]float64{"on-prem": 1, "cloud": 0.2}, job.WithQueued(false).PoolPreferences())
+
+	job = jobDb.NewJob("jobId", "jobSet", "queue", 1, schedulingInfo, true, 0, false, false, false, 2)
+	assert.Equal(t, map[string]float64{"on-prem": 1, "cloud": 0.2}, job.PoolPreferences())
+}
+
+func TestJobSetMaxRunningJobsFromAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		annotations            map[string]string
+		expectedMaxRunningJobs int
+		expectedHasLimit       bool
+		expectedErr            bool
+	}{
+		"no annotation": {
+			annotations: map[string]string{},
+		},
+		"valid limit": {
+			annotations:            map[string]string{configuration.JobSetMaxRunningJobsAnnotation: "10"},
+			expectedMaxRunningJobs: 10,
+			expectedHasLimit:       true,
+		},
+		"not an integer": {
+			annotations: map[string]string{configuration.JobSetMaxRunningJobsAnnotation: "ten"},
+			expectedErr: true,
+		},
+		"non-positive limit": {
+			annotations: map[string]string{configuration.JobSetMaxRunningJobsAnnotation: "0"},
+			expectedErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			maxRunningJobs, hasLimit, err := JobSetMaxRunningJobsFromAnnotations(tc.annotations)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMaxRunningJobs, maxRunningJobs)
+			assert.Equal(t, tc.expectedHasLimit, hasLimit)
+		})
+	}
+}
+
+func TestJob_JobSetMaxRunningJobs(t *testing.T) {
+	job := jobDb.NewJob("jobId", "jobSet", "queue", 1, jobSchedulingInfo, true, 0, false, false, false, 2)
+	_, hasLimit := job.JobSetMaxRunningJobs()
+	assert.False(t, hasLimit)
+
+	schedulingInfo := proto.Clone(jobSchedulingInfo).(*schedulerobjects.JobSchedulingInfo)
+	schedulingInfo.GetObjectRequirements()[0].GetPodRequirements().Annotations[configuration.JobSetMaxRunningJobsAnnotation] = "10"
+	for _, job := range []*Job{
+		job.WithJobSchedulingInfo(schedulingInfo),
+		job.WithJobSchedulingInfo(schedulingInfo).WithQueued(false),
+		jobDb.NewJob("jobId", "jobSet", "queue", 1, schedulingInfo, true, 0, false, false, false, 2),
+	} {
+		maxRunningJobs, hasLimit := job.JobSetMaxRunningJobs()
+		assert.True(t, hasLimit)
+		assert.Equal(t, 10, maxRunningJobs)
+	}
+
+	schedulingInfo.GetObjectRequirements()[0].GetPodRequirements().Annotations[configuration.JobSetMaxRunningJobsAnnotation] = "ten"
+	_, hasLimit = job.WithJobSchedulingInfo(schedulingInfo).JobSetMaxRunningJobs()
+	assert.False(t, hasLimit)
+}
+
+func TestJobSetMaxPreemptedFractionFromAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		annotations       map[string]string
+		expectedFraction  float64
+		expectedHasBudget bool
+		expectError       bool
+	}{
+		"no annotation": {
+			annotations: map[string]string{"foo": "bar"},
+		},
+		"valid": {
+			annotations:       map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "0.25"},
+			expectedFraction:  0.25,
+			expectedHasBudget: true,
+		},
+		"zero": {
+			annotations:       map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "0"},
+			expectedHasBudget: true,
+		},
+		"not a number": {
+			annotations: map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "a quarter"},
+			expectError: true,
+		},
+		"greater than one": {
+			annotations: map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "1.5"},
+			expectError: true,
+		},
+		"negative": {
+			annotations: map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "-0.5"},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fraction, hasBudget, err := JobSetMaxPreemptedFractionFromAnnotations(tc.annotations)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedFraction, fraction)
+			assert.Equal(t, tc.expectedHasBudget, hasBudget)
+		})
+	}
+}
+
+func TestJob_JobSetMaxPreemptedFraction(t *testing.T) {
+	job := jobDb.NewJob("jobId", "jobSet", "queue", 1, jobSchedulingInfo, true, 0, false, false, false, 2)
+	_, hasBudget := job.JobSetMaxPreemptedFraction()
+	assert.False(t, hasBudget)
+
+	schedulingInfo := proto.Clone(jobSchedulingInfo).(*schedulerobjects.JobSchedulingInfo)
+	schedulingInfo.GetObjectRequirements()[0].GetPodRequirements().Annotations[configuration.JobSetMaxPreemptedFractionAnnotation] = "0.25"
+	for _, job := range []*Job{
+		job.WithJobSchedulingInfo(schedulingInfo),
+		job.WithJobSchedulingInfo(schedulingInfo).WithQueued(false),
+		jobDb.NewJob("jobId", "jobSet", "queue", 1, schedulingInfo, true, 0, false, false, false, 2),
+	} {
+		maxPreemptedFraction, hasBudget := job.JobSetMaxPreemptedFraction()
+		assert.True(t, hasBudget)
+		assert.Equal(t, 0.25, maxPreemptedFraction)
+	}
+
+	// A budget of zero prevents preempting any job of the job set.
+	schedulingInfo.GetObjectRequirements()[0].GetPodRequirements().Annotations[configuration.JobSetMaxPreemptedFractionAnnotation] = "0"
+	maxPreemptedFraction, hasBudget := job.WithJobSchedulingInfo(schedulingInfo).JobSetMaxPreemptedFraction()
+	assert.True(t, hasBudget)
+	assert.Equal(t, 0.0, maxPreemptedFraction)
+
+	schedulingInfo.GetObjectRequirements()[0].GetPodRequirements().Annotations[configuration.JobSetMaxPreemptedFractionAnnotation] = "a quarter"
+	_, hasBudget = job.WithJobSchedulingInfo(schedulingInfo).JobSetMaxPreemptedFraction()
+	assert.False(t, hasBudget)
+}
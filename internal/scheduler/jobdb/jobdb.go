@@ -3,6 +3,7 @@ package jobdb
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/benbjohnson/immutable"
 	"github.com/google/uuid"
@@ -31,6 +32,10 @@ type JobDb struct {
 	schedulingKeyGenerator *schedulerobjects.SchedulingKeyGenerator
 	copyMutex              sync.Mutex
 	writerMutex            sync.Mutex
+	// Amount by which the priority of queued jobs is reduced per hour since they were submitted.
+	priorityAgingPerHour float64
+	// Overrides priorityAgingPerHour for specific queues.
+	priorityAgingPerHourByQueue map[string]float64
 }
 
 func NewJobDb(priorityClasses map[string]types.PriorityClass, defaultPriorityClassName string) *JobDb {
@@ -62,6 +67,14 @@ func NewJobDbWithSchedulingKeyGenerator(
 	}
 }
 
+// SetPriorityAging configures the rate at which jobs gain priority the longer they've been queued,
+// such that long-waiting jobs are eventually scheduled before newer jobs of higher priority.
+// Must be called before creating any jobs, since the rate is stored on jobs on creation.
+func (jobDb *JobDb) SetPriorityAging(priorityAgingPerHour float64, priorityAgingPerHourByQueue map[string]float64) {
+	jobDb.priorityAgingPerHour = priorityAgingPerHour
+	jobDb.priorityAgingPerHourByQueue = priorityAgingPerHourByQueue
+}
+
 // NewJob creates a new scheduler job.
 // The new job is not automatically inserted into the jobDb; call jobDb.Upsert to upsert it.
 func (jobDb *JobDb) NewJob(
@@ -81,6 +94,10 @@ func (jobDb *JobDb) NewJob(
 	if !ok {
 		priorityClass = jobDb.defaultPriorityClass
 	}
+	priorityAgingPerHour, ok := jobDb.priorityAgingPerHourByQueue[queue]
+	if !ok {
+		priorityAgingPerHour = jobDb.priorityAgingPerHour
+	}
 	job := &Job{
 		id:                      jobId,
 		queue:                   queue,
@@ -90,6 +107,7 @@ func (jobDb *JobDb) NewJob(
 		queuedVersion:           queuedVersion,
 		requestedPriority:       priority,
 		submittedTime:           created,
+		priorityAgingRate:       priorityAgingPerHour / float64(time.Hour),
 		jobSchedulingInfo:       schedulingInfo,
 		priorityClass:           priorityClass,
 		cancelRequested:         cancelRequested,
@@ -99,6 +117,9 @@ func (jobDb *JobDb) NewJob(
 	}
 	job.ensureJobSchedulingInfoFieldsInitialised()
 	job.schedulingKey = interfaces.SchedulingKeyFromLegacySchedulerJob(jobDb.schedulingKeyGenerator, job)
+	job.poolPreferences = poolPreferencesFromJob(job)
+	job.jobSetMaxRunningJobs = jobSetMaxRunningJobsFromJob(job)
+	job.jobSetMaxPreemptedFraction, job.hasJobSetDisruptionBudget = jobSetMaxPreemptedFractionFromJob(job)
 	return job
 }
 
@@ -292,6 +313,15 @@ func (txn *Txn) HasQueuedJobs(queue string) bool {
 	return queuedJobs.Len() > 0
 }
 
+// NumQueuedJobs returns the number of jobs queued for the given queue.
+func (txn *Txn) NumQueuedJobs(queue string) int {
+	queuedJobs, ok := txn.jobsByQueue[queue]
+	if !ok {
+		return 0
+	}
+	return queuedJobs.Len()
+}
+
 // QueuedJobs returns true if the queue has any jobs in the running state or false otherwise
 func (txn *Txn) QueuedJobs(queue string) *immutable.SortedSetIterator[*Job] {
 	jobQueue, ok := txn.jobsByQueue[queue]
@@ -3,6 +3,7 @@ package jobdb
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
@@ -239,6 +240,28 @@ func TestJobDb_SchedulingKeyIsPopulated(t *testing.T) {
 	assert.Equal(t, interfaces.SchedulingKeyFromLegacySchedulerJob(jobDb.schedulingKeyGenerator, job), actualSchedulingKey)
 }
 
+func TestJobDb_PriorityAging(t *testing.T) {
+	jobDb := NewTestJobDb()
+	jobDb.SetPriorityAging(1, map[string]float64{"agingQueue": 2, "nonAgingQueue": 0})
+	jobSchedulingInfo := &schedulerobjects.JobSchedulingInfo{PriorityClassName: "foo"}
+
+	job := jobDb.NewJob("jobId", "jobSet", "queue", 1, jobSchedulingInfo, true, 0, false, false, false, 0)
+	assert.Equal(t, 1/float64(time.Hour), job.priorityAgingRate)
+	job = jobDb.NewJob("jobId", "jobSet", "agingQueue", 1, jobSchedulingInfo, true, 0, false, false, false, 0)
+	assert.Equal(t, 2/float64(time.Hour), job.priorityAgingRate)
+	job = jobDb.NewJob("jobId", "jobSet", "nonAgingQueue", 1, jobSchedulingInfo, true, 0, false, false, false, 0)
+	assert.Equal(t, 0.0, job.priorityAgingRate)
+
+	// A job that has been queued for long enough is scheduled before newer jobs of higher priority.
+	oldJob := jobDb.NewJob("oldJob", "jobSet", "queue", 10, jobSchedulingInfo, true, 0, false, false, false, 0)
+	newJob := jobDb.NewJob("newJob", "jobSet", "queue", 1, jobSchedulingInfo, true, 0, false, false, false, int64(24*time.Hour))
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*Job{newJob, oldJob}))
+	it := txn.QueuedJobs("queue")
+	first, _ := it.Next()
+	assert.Equal(t, "oldJob", first.Id())
+}
+
 func TestJobDb_SchedulingKey(t *testing.T) {
 	tests := map[string]struct {
 		podRequirementsA   *schedulerobjects.PodRequirements
@@ -1215,6 +1238,31 @@ func TestJobDb_SchedulingKey(t *testing.T) {
 			priorityClassNameB: "my-cool-other-priority-class",
 			equal:              false,
 		},
+		"persistent volume claim names ignored": {
+			podRequirementsA: &schedulerobjects.PodRequirements{
+				PersistentVolumeClaims: []*schedulerobjects.PersistentVolumeClaimRequirements{{Name: "a", StorageClassName: "fast"}},
+			},
+			podRequirementsB: &schedulerobjects.PodRequirements{
+				PersistentVolumeClaims: []*schedulerobjects.PersistentVolumeClaimRequirements{{Name: "b", StorageClassName: "fast"}},
+			},
+			equal: true,
+		},
+		"persistent volume claim storage classes different": {
+			podRequirementsA: &schedulerobjects.PodRequirements{
+				PersistentVolumeClaims: []*schedulerobjects.PersistentVolumeClaimRequirements{{Name: "a", StorageClassName: "fast"}},
+			},
+			podRequirementsB: &schedulerobjects.PodRequirements{
+				PersistentVolumeClaims: []*schedulerobjects.PersistentVolumeClaimRequirements{{Name: "a", StorageClassName: "slow"}},
+			},
+			equal: false,
+		},
+		"persistent volume claim added": {
+			podRequirementsA: &schedulerobjects.PodRequirements{},
+			podRequirementsB: &schedulerobjects.PodRequirements{
+				PersistentVolumeClaims: []*schedulerobjects.PersistentVolumeClaimRequirements{{Name: "a"}},
+			},
+			equal: false,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/common/util"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/fairness"
 	"github.com/armadaproject/armada/internal/scheduler/interfaces"
 )
 
@@ -217,3 +218,73 @@ func (it *MultiJobsIterator) Next() (*schedulercontext.JobSchedulingContext, err
 		return v, nil
 	}
 }
+
+// UserFairJobIterator reorders the jobs of a single queue such that jobs submitted by the user
+// with the smallest share of the resources allocated to the queue are returned first.
+// Only the next lookahead jobs of the underlying iterator are considered when reordering;
+// ties are broken by the order of the underlying iterator.
+//
+// This prevents a single user from monopolising the resources of a queue shared between several users.
+// Allocation is read from the QueueSchedulingContext on each call to Next,
+// so jobs scheduled since the previous call are taken into account.
+type UserFairJobIterator struct {
+	it                   JobIterator
+	qctx                 *schedulercontext.QueueSchedulingContext
+	fairnessCostProvider fairness.FairnessCostProvider
+	lookahead            int
+	// Jobs loaded from the underlying iterator but not yet returned, in the order they were loaded.
+	buffer []*schedulercontext.JobSchedulingContext
+	// True once the underlying iterator has been exhausted.
+	done bool
+}
+
+func NewUserFairJobIterator(
+	it JobIterator,
+	qctx *schedulercontext.QueueSchedulingContext,
+	fairnessCostProvider fairness.FairnessCostProvider,
+	lookahead int,
+) *UserFairJobIterator {
+	if lookahead < 1 {
+		lookahead = 1
+	}
+	return &UserFairJobIterator{
+		it:                   it,
+		qctx:                 qctx,
+		fairnessCostProvider: fairnessCostProvider,
+		lookahead:            lookahead,
+		buffer:               make([]*schedulercontext.JobSchedulingContext, 0, lookahead),
+	}
+}
+
+func (it *UserFairJobIterator) Next() (*schedulercontext.JobSchedulingContext, error) {
+	for !it.done && len(it.buffer) < it.lookahead {
+		if jctx, err := it.it.Next(); err != nil {
+			return nil, err
+		} else if jctx == nil {
+			it.done = true
+		} else {
+			it.buffer = append(it.buffer, jctx)
+		}
+	}
+	if len(it.buffer) == 0 {
+		return nil, nil
+	}
+	costByUser := make(map[string]float64)
+	selected := 0
+	selectedCost := 0.0
+	for i, jctx := range it.buffer {
+		user := jctx.Job.GetOwner()
+		cost, ok := costByUser[user]
+		if !ok {
+			cost = it.fairnessCostProvider.CostFromAllocationAndWeight(it.qctx.AllocatedByUser[user], 1)
+			costByUser[user] = cost
+		}
+		if i == 0 || cost < selectedCost {
+			selected = i
+			selectedCost = cost
+		}
+	}
+	jctx := it.buffer[selected]
+	it.buffer = slices.Delete(it.buffer, selected, selected+1)
+	return jctx, nil
+}
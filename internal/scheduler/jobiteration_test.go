@@ -8,10 +8,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/util"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/fairness"
 	"github.com/armadaproject/armada/internal/scheduler/interfaces"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
@@ -123,6 +126,77 @@ func TestMultiJobsIterator_TwoQueues(t *testing.T) {
 	require.Nil(t, v)
 }
 
+func TestUserFairJobIterator(t *testing.T) {
+	tests := map[string]struct {
+		lookahead int
+		// Users with running jobs in the queue.
+		runningUsers []string
+		// Expected order in which jobs are returned, as indices into the jobs submitted by alice followed by bob.
+		expectedOrder []int
+	}{
+		"interleaves users": {
+			lookahead:     6,
+			expectedOrder: []int{0, 3, 1, 4, 2, 5},
+		},
+		"accounts for running jobs": {
+			lookahead:     6,
+			runningUsers:  []string{"alice", "alice"},
+			expectedOrder: []int{3, 4, 0, 5, 1, 2},
+		},
+		"limited by lookahead": {
+			lookahead:     2,
+			expectedOrder: []int{0, 1, 3, 4, 2, 5},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fairnessCostProvider, err := fairness.NewAssetFairness(map[string]float64{"cpu": 1})
+			require.NoError(t, err)
+			sctx := schedulercontext.NewSchedulingContext(
+				"executor",
+				"pool",
+				testfixtures.TestPriorityClasses,
+				testfixtures.TestDefaultPriorityClass,
+				fairnessCostProvider,
+				nil,
+				schedulerobjects.ResourceList{},
+			)
+			require.NoError(t, sctx.AddQueueSchedulingContext(testfixtures.TestQueue, 1, nil, nil))
+			qctx := sctx.QueueSchedulingContexts[testfixtures.TestQueue]
+			for _, user := range tc.runningUsers {
+				qctx.AllocatedByUser.AddResourceList(user, schedulerobjects.ResourceList{
+					Resources: map[string]resource.Quantity{"cpu": resource.MustParse("1")},
+				})
+			}
+
+			jobs := armadaslices.Concatenate(
+				testfixtures.WithOwnerJobs("alice", testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 3)),
+				testfixtures.WithOwnerJobs("bob", testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 3)),
+			)
+			indexByJobId := make(map[string]int)
+			for i, job := range jobs {
+				indexByJobId[job.Id()] = i
+			}
+			jctxs := schedulercontext.JobSchedulingContextsFromJobs(testfixtures.TestPriorityClasses, jobs, GangIdAndCardinalityFromAnnotations)
+			it := NewUserFairJobIterator(NewInMemoryJobIterator(jctxs), qctx, fairnessCostProvider, tc.lookahead)
+
+			actualOrder := make([]int, 0)
+			for {
+				jctx, err := it.Next()
+				require.NoError(t, err)
+				if jctx == nil {
+					break
+				}
+				actualOrder = append(actualOrder, indexByJobId[jctx.JobId])
+				// Mark the job as scheduled to update the allocation of its owner.
+				_, err = qctx.AddJobSchedulingContext(jctx)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedOrder, actualOrder)
+		})
+	}
+}
+
 func TestQueuedJobsIterator_OneQueue(t *testing.T) {
 	repo := newMockJobRepository()
 	expected := make([]string, 0)
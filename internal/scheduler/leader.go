@@ -97,6 +97,11 @@ type LeaseListener interface {
 	onStoppedLeading()
 }
 
+// LeaseListenerRegistry is implemented by leader controllers that notify listeners when leadership changes.
+type LeaseListenerRegistry interface {
+	RegisterListener(LeaseListener)
+}
+
 // KubernetesLeaderController uses the Kubernetes leader election mechanism to determine who is leader.
 // This allows multiple instances of the scheduler to be run for high availability.
 //
@@ -0,0 +1,224 @@
+package scheduler
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	schedulerconfig "github.com/armadaproject/armada/internal/scheduler/configuration"
+)
+
+// AdvisoryLock is a lock that may be held by at most one process at a time.
+type AdvisoryLock interface {
+	// TryAcquire attempts to acquire the lock without blocking, or to confirm that it's still held if acquired previously.
+	// Returns true if the lock is held by this process on return.
+	TryAcquire(ctx *armadacontext.Context) (bool, error)
+	// Release releases the lock if held by this process.
+	Release(ctx *armadacontext.Context) error
+	// Holder returns the identity of the process holding the lock, or the empty string if the lock isn't held.
+	Holder(ctx *armadacontext.Context) (string, error)
+}
+
+// PostgresAdvisoryLock is an AdvisoryLock backed by a Postgres session-level advisory lock.
+// The lock is held for as long as the connection that acquired it remains open,
+// such that Postgres releases the lock immediately if the holder exits.
+type PostgresAdvisoryLock struct {
+	db *pgxpool.Pool
+	// Key of the advisory lock.
+	key int64
+	// Identity of this process; stored as the application_name of the connection holding the lock.
+	identity string
+	// Connection holding the lock; nil if the lock isn't held.
+	conn *pgxpool.Conn
+}
+
+func NewPostgresAdvisoryLock(db *pgxpool.Pool, key int64, identity string) *PostgresAdvisoryLock {
+	return &PostgresAdvisoryLock{
+		db:       db,
+		key:      key,
+		identity: identity,
+	}
+}
+
+func (l *PostgresAdvisoryLock) TryAcquire(ctx *armadacontext.Context) (bool, error) {
+	if l.conn != nil {
+		// The lock is held for as long as the connection is alive.
+		if err := l.conn.Ping(ctx); err != nil {
+			l.conn.Release()
+			l.conn = nil
+			return false, errors.WithStack(err)
+		}
+		return true, nil
+	}
+	conn, err := l.db.Acquire(ctx)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	if _, err := conn.Exec(ctx, "SELECT set_config('application_name', $1, false)", l.identity); err != nil {
+		conn.Release()
+		return false, errors.WithStack(err)
+	}
+	var acquired bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Release()
+		return false, errors.WithStack(err)
+	}
+	if !acquired {
+		conn.Release()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+func (l *PostgresAdvisoryLock) Release(ctx *armadacontext.Context) error {
+	if l.conn == nil {
+		return nil
+	}
+	defer func() {
+		l.conn.Release()
+		l.conn = nil
+	}()
+	if _, err := l.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (l *PostgresAdvisoryLock) Holder(ctx *armadacontext.Context) (string, error) {
+	// Postgres stores the high and low 32 bits of bigint advisory lock keys in classid and objid respectively.
+	var holder string
+	err := l.db.QueryRow(
+		ctx,
+		`SELECT a.application_name
+		FROM pg_locks l JOIN pg_stat_activity a ON a.pid = l.pid
+		WHERE l.locktype = 'advisory' AND l.granted AND l.objsubid = 1 AND l.classid = $1 AND l.objid = $2`,
+		uint32(uint64(l.key)>>32),
+		uint32(l.key),
+	).Scan(&holder)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	} else if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return holder, nil
+}
+
+// PostgresLeaderController uses an advisory lock, typically a Postgres advisory lock, to determine who is leader.
+// Unlike with Kubernetes leases, a Postgres advisory lock is released as soon as the connection of the leader is closed,
+// such that a standby can take over within one retry period of the leader exiting.
+type PostgresLeaderController struct {
+	lock              AdvisoryLock
+	token             atomic.Value
+	config            schedulerconfig.LeaderConfig
+	currentLeaderLock sync.Mutex
+	currentLeader     string
+	listeners         []LeaseListener
+}
+
+func NewPostgresLeaderController(config schedulerconfig.LeaderConfig, lock AdvisoryLock) *PostgresLeaderController {
+	controller := &PostgresLeaderController{
+		lock:              lock,
+		token:             atomic.Value{},
+		currentLeaderLock: sync.Mutex{},
+		config:            config,
+	}
+	controller.token.Store(InvalidLeaderToken())
+	return controller
+}
+
+func (lc *PostgresLeaderController) RegisterListener(listener LeaseListener) {
+	lc.listeners = append(lc.listeners, listener)
+}
+
+func (lc *PostgresLeaderController) GetToken() LeaderToken {
+	return lc.token.Load().(LeaderToken)
+}
+
+func (lc *PostgresLeaderController) ValidateToken(tok LeaderToken) bool {
+	if tok.leader {
+		return lc.token.Load().(LeaderToken).id == tok.id
+	}
+	return false
+}
+
+// Run starts the controller.
+// This is a blocking call that returns when the provided context is cancelled,
+// at which point the lock is released such that a standby may take over immediately.
+func (lc *PostgresLeaderController) Run(ctx *armadacontext.Context) error {
+	ticker := time.NewTicker(lc.config.RetryPeriod)
+	defer ticker.Stop()
+	for {
+		lc.tryAcquire(ctx)
+		select {
+		case <-ctx.Done():
+			lc.release()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (lc *PostgresLeaderController) tryAcquire(ctx *armadacontext.Context) {
+	wasLeader := lc.GetToken().leader
+	isLeader, err := lc.lock.TryAcquire(ctx)
+	if err != nil {
+		logging.WithStacktrace(ctx, err).Error("failed to acquire leader lock")
+	}
+	if isLeader && !wasLeader {
+		ctx.Infof("I am now leader")
+		lc.token.Store(NewLeaderToken())
+		for _, listener := range lc.listeners {
+			listener.onStartedLeading(ctx)
+		}
+	} else if !isLeader && wasLeader {
+		ctx.Infof("I am no longer leader")
+		lc.stopLeading()
+	}
+
+	leader := lc.config.PodName
+	if !isLeader {
+		if leader, err = lc.lock.Holder(ctx); err != nil {
+			logging.WithStacktrace(ctx, err).Error("failed to determine current leader")
+			return
+		}
+	}
+	lc.currentLeaderLock.Lock()
+	defer lc.currentLeaderLock.Unlock()
+	lc.currentLeader = leader
+}
+
+// release releases the lock using a fresh context, since the context passed to Run has been cancelled when this is called.
+func (lc *PostgresLeaderController) release() {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), lc.config.RenewDeadline)
+	defer cancel()
+	if err := lc.lock.Release(ctx); err != nil {
+		logging.WithStacktrace(ctx, err).Error("failed to release leader lock")
+	}
+	if lc.GetToken().leader {
+		ctx.Infof("I am no longer leader")
+		lc.stopLeading()
+	}
+}
+
+func (lc *PostgresLeaderController) stopLeading() {
+	lc.token.Store(InvalidLeaderToken())
+	for _, listener := range lc.listeners {
+		listener.onStoppedLeading()
+	}
+}
+
+func (lc *PostgresLeaderController) GetLeaderReport() LeaderReport {
+	lc.currentLeaderLock.Lock()
+	defer lc.currentLeaderLock.Unlock()
+	return LeaderReport{
+		LeaderName:             lc.currentLeader,
+		IsCurrentProcessLeader: lc.currentLeader == lc.config.PodName,
+	}
+}
@@ -0,0 +1,123 @@
+package scheduler
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	schedulerconfig "github.com/armadaproject/armada/internal/scheduler/configuration"
+)
+
+// fakeAdvisoryLock is an AdvisoryLock shared between several processes, each identified by its own handle.
+type fakeAdvisoryLock struct {
+	mu     sync.Mutex
+	holder string
+}
+
+type fakeAdvisoryLockHandle struct {
+	lock     *fakeAdvisoryLock
+	identity string
+}
+
+func (l *fakeAdvisoryLockHandle) TryAcquire(*armadacontext.Context) (bool, error) {
+	l.lock.mu.Lock()
+	defer l.lock.mu.Unlock()
+	if l.lock.holder == "" {
+		l.lock.holder = l.identity
+	}
+	return l.lock.holder == l.identity, nil
+}
+
+func (l *fakeAdvisoryLockHandle) Release(*armadacontext.Context) error {
+	l.lock.mu.Lock()
+	defer l.lock.mu.Unlock()
+	if l.lock.holder == l.identity {
+		l.lock.holder = ""
+	}
+	return nil
+}
+
+func (l *fakeAdvisoryLockHandle) Holder(*armadacontext.Context) (string, error) {
+	l.lock.mu.Lock()
+	defer l.lock.mu.Unlock()
+	return l.lock.holder, nil
+}
+
+type testLeaseListener struct {
+	mu          sync.Mutex
+	transitions []State
+}
+
+func (l *testLeaseListener) onStartedLeading(*armadacontext.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.transitions = append(l.transitions, Leader)
+}
+
+func (l *testLeaseListener) onStoppedLeading() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.transitions = append(l.transitions, NotLeader)
+}
+
+func (l *testLeaseListener) getTransitions() []State {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]State(nil), l.transitions...)
+}
+
+func TestPostgresLeaderController_Failover(t *testing.T) {
+	lock := &fakeAdvisoryLock{}
+	config := schedulerconfig.LeaderConfig{
+		PodName:       podName,
+		RetryPeriod:   10 * time.Millisecond,
+		RenewDeadline: time.Second,
+	}
+	leader := NewPostgresLeaderController(config, &fakeAdvisoryLockHandle{lock: lock, identity: podName})
+	leaderListener := &testLeaseListener{}
+	leader.RegisterListener(leaderListener)
+	config.PodName = otherPodName
+	standby := NewPostgresLeaderController(config, &fakeAdvisoryLockHandle{lock: lock, identity: otherPodName})
+	standbyListener := &testLeaseListener{}
+	standby.RegisterListener(standbyListener)
+
+	leaderCtx, cancelLeader := armadacontext.WithCancel(armadacontext.Background())
+	leaderDone := make(chan struct{})
+	go func() {
+		_ = leader.Run(leaderCtx)
+		close(leaderDone)
+	}()
+	require.Eventually(t, func() bool { return leader.GetToken().leader }, time.Second, time.Millisecond)
+
+	standbyCtx, cancelStandby := armadacontext.WithCancel(armadacontext.Background())
+	defer cancelStandby()
+	go func() {
+		_ = standby.Run(standbyCtx)
+	}()
+	require.Eventually(t, func() bool { return standby.GetLeaderReport().LeaderName == podName }, time.Second, time.Millisecond)
+	assert.False(t, standby.GetToken().leader)
+	assert.False(t, standby.GetLeaderReport().IsCurrentProcessLeader)
+	assert.True(t, leader.GetLeaderReport().IsCurrentProcessLeader)
+
+	// Stopping the leader releases the lock, such that the standby takes over.
+	token := leader.GetToken()
+	cancelLeader()
+	<-leaderDone
+	assert.False(t, leader.ValidateToken(token))
+	require.Eventually(t, func() bool { return standby.GetToken().leader }, time.Second, time.Millisecond)
+	assert.True(t, standby.GetLeaderReport().IsCurrentProcessLeader)
+
+	assert.Equal(t, []State{Leader, NotLeader}, leaderListener.getTransitions())
+	assert.Equal(t, []State{Leader}, standbyListener.getTransitions())
+}
+
+func TestScheduler_OnStartedLeadingDoesNotBlock(t *testing.T) {
+	s := &Scheduler{becameLeader: make(chan struct{}, 1)}
+	s.onStartedLeading(armadacontext.Background())
+	s.onStartedLeading(armadacontext.Background())
+	assert.Len(t, s.becameLeader, 1)
+}
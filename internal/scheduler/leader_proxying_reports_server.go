@@ -61,6 +61,90 @@ func (s *LeaderProxyingSchedulingReportsServer) GetJobReport(ctx context.Context
 	return leaderClient.GetJobReport(ctx, request)
 }
 
+func (s *LeaderProxyingSchedulingReportsServer) GetQueueEntitlement(ctx context.Context, request *schedulerobjects.QueueEntitlementRequest) (*schedulerobjects.QueueEntitlementReport, error) {
+	isCurrentProcessLeader, leaderConnection, err := s.leaderClientProvider.GetCurrentLeaderClientConnection()
+	if isCurrentProcessLeader {
+		return s.localReportsServer.GetQueueEntitlement(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	leaderClient := s.schedulerReportingClientProvider.GetSchedulerReportingClient(leaderConnection)
+	return leaderClient.GetQueueEntitlement(ctx, request)
+}
+
+func (s *LeaderProxyingSchedulingReportsServer) GetDuplicateJobsReport(ctx context.Context, request *schedulerobjects.DuplicateJobsReportRequest) (*schedulerobjects.DuplicateJobsReport, error) {
+	isCurrentProcessLeader, leaderConnection, err := s.leaderClientProvider.GetCurrentLeaderClientConnection()
+	if isCurrentProcessLeader {
+		return s.localReportsServer.GetDuplicateJobsReport(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	leaderClient := s.schedulerReportingClientProvider.GetSchedulerReportingClient(leaderConnection)
+	return leaderClient.GetDuplicateJobsReport(ctx, request)
+}
+
+func (s *LeaderProxyingSchedulingReportsServer) GetSchedulingContextSnapshots(ctx context.Context, request *schedulerobjects.SchedulingContextSnapshotRequest) (*schedulerobjects.SchedulingContextSnapshots, error) {
+	isCurrentProcessLeader, leaderConnection, err := s.leaderClientProvider.GetCurrentLeaderClientConnection()
+	if isCurrentProcessLeader {
+		return s.localReportsServer.GetSchedulingContextSnapshots(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	leaderClient := s.schedulerReportingClientProvider.GetSchedulerReportingClient(leaderConnection)
+	return leaderClient.GetSchedulingContextSnapshots(ctx, request)
+}
+
+func (s *LeaderProxyingSchedulingReportsServer) GetQueueUtilisation(ctx context.Context, request *schedulerobjects.QueueUtilisationRequest) (*schedulerobjects.QueueUtilisationReport, error) {
+	isCurrentProcessLeader, leaderConnection, err := s.leaderClientProvider.GetCurrentLeaderClientConnection()
+	if isCurrentProcessLeader {
+		return s.localReportsServer.GetQueueUtilisation(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	leaderClient := s.schedulerReportingClientProvider.GetSchedulerReportingClient(leaderConnection)
+	return leaderClient.GetQueueUtilisation(ctx, request)
+}
+
+func (s *LeaderProxyingSchedulingReportsServer) GetPendingCapacity(ctx context.Context, request *schedulerobjects.PendingCapacityRequest) (*schedulerobjects.PendingCapacityReport, error) {
+	isCurrentProcessLeader, leaderConnection, err := s.leaderClientProvider.GetCurrentLeaderClientConnection()
+	if isCurrentProcessLeader {
+		return s.localReportsServer.GetPendingCapacity(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	leaderClient := s.schedulerReportingClientProvider.GetSchedulerReportingClient(leaderConnection)
+	return leaderClient.GetPendingCapacity(ctx, request)
+}
+
+func (s *LeaderProxyingSchedulingReportsServer) GetFairnessWhatIf(ctx context.Context, request *schedulerobjects.FairnessWhatIfRequest) (*schedulerobjects.FairnessWhatIfReport, error) {
+	isCurrentProcessLeader, leaderConnection, err := s.leaderClientProvider.GetCurrentLeaderClientConnection()
+	if isCurrentProcessLeader {
+		return s.localReportsServer.GetFairnessWhatIf(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	leaderClient := s.schedulerReportingClientProvider.GetSchedulerReportingClient(leaderConnection)
+	return leaderClient.GetFairnessWhatIf(ctx, request)
+}
+
+func (s *LeaderProxyingSchedulingReportsServer) GetNodeTypes(ctx context.Context, request *schedulerobjects.NodeTypesRequest) (*schedulerobjects.NodeTypesReport, error) {
+	isCurrentProcessLeader, leaderConnection, err := s.leaderClientProvider.GetCurrentLeaderClientConnection()
+	if isCurrentProcessLeader {
+		return s.localReportsServer.GetNodeTypes(ctx, request)
+	}
+	if err != nil {
+		return nil, err
+	}
+	leaderClient := s.schedulerReportingClientProvider.GetSchedulerReportingClient(leaderConnection)
+	return leaderClient.GetNodeTypes(ctx, request)
+}
+
 type reportingClientProvider interface {
 	GetSchedulerReportingClient(conn *grpc.ClientConn) schedulerobjects.SchedulerReportingClient
 }
@@ -237,6 +237,41 @@ type GetJobReportCall struct {
 	Request *schedulerobjects.JobReportRequest
 }
 
+type GetQueueEntitlementCall struct {
+	Context context.Context
+	Request *schedulerobjects.QueueEntitlementRequest
+}
+
+type GetDuplicateJobsReportCall struct {
+	Context context.Context
+	Request *schedulerobjects.DuplicateJobsReportRequest
+}
+
+type GetSchedulingContextSnapshotsCall struct {
+	Context context.Context
+	Request *schedulerobjects.SchedulingContextSnapshotRequest
+}
+
+type GetQueueUtilisationCall struct {
+	Context context.Context
+	Request *schedulerobjects.QueueUtilisationRequest
+}
+
+type GetPendingCapacityCall struct {
+	Context context.Context
+	Request *schedulerobjects.PendingCapacityRequest
+}
+
+type GetFairnessWhatIfCall struct {
+	Context context.Context
+	Request *schedulerobjects.FairnessWhatIfRequest
+}
+
+type GetNodeTypesCall struct {
+	Context context.Context
+	Request *schedulerobjects.NodeTypesRequest
+}
+
 type FakeSchedulerReportingServer struct {
 	GetSchedulingReportCalls    []GetSchedulingReportCall
 	GetSchedulingReportResponse *schedulerobjects.SchedulingReport
@@ -246,14 +281,42 @@ type FakeSchedulerReportingServer struct {
 
 	GetJobReportCalls    []GetJobReportCall
 	GetJobReportResponse *schedulerobjects.JobReport
+
+	GetQueueEntitlementCalls    []GetQueueEntitlementCall
+	GetQueueEntitlementResponse *schedulerobjects.QueueEntitlementReport
+
+	GetDuplicateJobsReportCalls    []GetDuplicateJobsReportCall
+	GetDuplicateJobsReportResponse *schedulerobjects.DuplicateJobsReport
+
+	GetSchedulingContextSnapshotsCalls    []GetSchedulingContextSnapshotsCall
+	GetSchedulingContextSnapshotsResponse *schedulerobjects.SchedulingContextSnapshots
+
+	GetQueueUtilisationCalls    []GetQueueUtilisationCall
+	GetQueueUtilisationResponse *schedulerobjects.QueueUtilisationReport
+
+	GetPendingCapacityCalls    []GetPendingCapacityCall
+	GetPendingCapacityResponse *schedulerobjects.PendingCapacityReport
+
+	GetFairnessWhatIfCalls    []GetFairnessWhatIfCall
+	GetFairnessWhatIfResponse *schedulerobjects.FairnessWhatIfReport
+
+	GetNodeTypesCalls    []GetNodeTypesCall
+	GetNodeTypesResponse *schedulerobjects.NodeTypesReport
 	Err                  error
 }
 
 func NewFakeSchedulerReportingServer() *FakeSchedulerReportingServer {
 	return &FakeSchedulerReportingServer{
-		GetSchedulingReportCalls: []GetSchedulingReportCall{},
-		GetQueueReportCalls:      []GetQueueReportCall{},
-		GetJobReportCalls:        []GetJobReportCall{},
+		GetSchedulingReportCalls:           []GetSchedulingReportCall{},
+		GetQueueReportCalls:                []GetQueueReportCall{},
+		GetJobReportCalls:                  []GetJobReportCall{},
+		GetQueueEntitlementCalls:           []GetQueueEntitlementCall{},
+		GetDuplicateJobsReportCalls:        []GetDuplicateJobsReportCall{},
+		GetSchedulingContextSnapshotsCalls: []GetSchedulingContextSnapshotsCall{},
+		GetQueueUtilisationCalls:           []GetQueueUtilisationCall{},
+		GetPendingCapacityCalls:            []GetPendingCapacityCall{},
+		GetFairnessWhatIfCalls:             []GetFairnessWhatIfCall{},
+		GetNodeTypesCalls:                  []GetNodeTypesCall{},
 	}
 }
 
@@ -272,6 +335,41 @@ func (f *FakeSchedulerReportingServer) GetJobReport(ctx context.Context, request
 	return f.GetJobReportResponse, f.Err
 }
 
+func (f *FakeSchedulerReportingServer) GetQueueEntitlement(ctx context.Context, request *schedulerobjects.QueueEntitlementRequest) (*schedulerobjects.QueueEntitlementReport, error) {
+	f.GetQueueEntitlementCalls = append(f.GetQueueEntitlementCalls, GetQueueEntitlementCall{Context: ctx, Request: request})
+	return f.GetQueueEntitlementResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingServer) GetDuplicateJobsReport(ctx context.Context, request *schedulerobjects.DuplicateJobsReportRequest) (*schedulerobjects.DuplicateJobsReport, error) {
+	f.GetDuplicateJobsReportCalls = append(f.GetDuplicateJobsReportCalls, GetDuplicateJobsReportCall{Context: ctx, Request: request})
+	return f.GetDuplicateJobsReportResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingServer) GetSchedulingContextSnapshots(ctx context.Context, request *schedulerobjects.SchedulingContextSnapshotRequest) (*schedulerobjects.SchedulingContextSnapshots, error) {
+	f.GetSchedulingContextSnapshotsCalls = append(f.GetSchedulingContextSnapshotsCalls, GetSchedulingContextSnapshotsCall{Context: ctx, Request: request})
+	return f.GetSchedulingContextSnapshotsResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingServer) GetQueueUtilisation(ctx context.Context, request *schedulerobjects.QueueUtilisationRequest) (*schedulerobjects.QueueUtilisationReport, error) {
+	f.GetQueueUtilisationCalls = append(f.GetQueueUtilisationCalls, GetQueueUtilisationCall{Context: ctx, Request: request})
+	return f.GetQueueUtilisationResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingServer) GetPendingCapacity(ctx context.Context, request *schedulerobjects.PendingCapacityRequest) (*schedulerobjects.PendingCapacityReport, error) {
+	f.GetPendingCapacityCalls = append(f.GetPendingCapacityCalls, GetPendingCapacityCall{Context: ctx, Request: request})
+	return f.GetPendingCapacityResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingServer) GetFairnessWhatIf(ctx context.Context, request *schedulerobjects.FairnessWhatIfRequest) (*schedulerobjects.FairnessWhatIfReport, error) {
+	f.GetFairnessWhatIfCalls = append(f.GetFairnessWhatIfCalls, GetFairnessWhatIfCall{Context: ctx, Request: request})
+	return f.GetFairnessWhatIfResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingServer) GetNodeTypes(ctx context.Context, request *schedulerobjects.NodeTypesRequest) (*schedulerobjects.NodeTypesReport, error) {
+	f.GetNodeTypesCalls = append(f.GetNodeTypesCalls, GetNodeTypesCall{Context: ctx, Request: request})
+	return f.GetNodeTypesResponse, f.Err
+}
+
 type FakeSchedulerReportingClient struct {
 	GetSchedulingReportCalls    []GetSchedulingReportCall
 	GetSchedulingReportResponse *schedulerobjects.SchedulingReport
@@ -281,14 +379,42 @@ type FakeSchedulerReportingClient struct {
 
 	GetJobReportCalls    []GetJobReportCall
 	GetJobReportResponse *schedulerobjects.JobReport
+
+	GetQueueEntitlementCalls    []GetQueueEntitlementCall
+	GetQueueEntitlementResponse *schedulerobjects.QueueEntitlementReport
+
+	GetDuplicateJobsReportCalls    []GetDuplicateJobsReportCall
+	GetDuplicateJobsReportResponse *schedulerobjects.DuplicateJobsReport
+
+	GetSchedulingContextSnapshotsCalls    []GetSchedulingContextSnapshotsCall
+	GetSchedulingContextSnapshotsResponse *schedulerobjects.SchedulingContextSnapshots
+
+	GetQueueUtilisationCalls    []GetQueueUtilisationCall
+	GetQueueUtilisationResponse *schedulerobjects.QueueUtilisationReport
+
+	GetPendingCapacityCalls    []GetPendingCapacityCall
+	GetPendingCapacityResponse *schedulerobjects.PendingCapacityReport
+
+	GetFairnessWhatIfCalls    []GetFairnessWhatIfCall
+	GetFairnessWhatIfResponse *schedulerobjects.FairnessWhatIfReport
+
+	GetNodeTypesCalls    []GetNodeTypesCall
+	GetNodeTypesResponse *schedulerobjects.NodeTypesReport
 	Err                  error
 }
 
 func NewFakeSchedulerReportingClient() *FakeSchedulerReportingClient {
 	return &FakeSchedulerReportingClient{
-		GetSchedulingReportCalls: []GetSchedulingReportCall{},
-		GetQueueReportCalls:      []GetQueueReportCall{},
-		GetJobReportCalls:        []GetJobReportCall{},
+		GetSchedulingReportCalls:           []GetSchedulingReportCall{},
+		GetQueueReportCalls:                []GetQueueReportCall{},
+		GetJobReportCalls:                  []GetJobReportCall{},
+		GetQueueEntitlementCalls:           []GetQueueEntitlementCall{},
+		GetDuplicateJobsReportCalls:        []GetDuplicateJobsReportCall{},
+		GetSchedulingContextSnapshotsCalls: []GetSchedulingContextSnapshotsCall{},
+		GetQueueUtilisationCalls:           []GetQueueUtilisationCall{},
+		GetPendingCapacityCalls:            []GetPendingCapacityCall{},
+		GetFairnessWhatIfCalls:             []GetFairnessWhatIfCall{},
+		GetNodeTypesCalls:                  []GetNodeTypesCall{},
 	}
 }
 
@@ -307,6 +433,41 @@ func (f *FakeSchedulerReportingClient) GetJobReport(ctx context.Context, request
 	return f.GetJobReportResponse, f.Err
 }
 
+func (f *FakeSchedulerReportingClient) GetQueueEntitlement(ctx context.Context, request *schedulerobjects.QueueEntitlementRequest, opts ...grpc.CallOption) (*schedulerobjects.QueueEntitlementReport, error) {
+	f.GetQueueEntitlementCalls = append(f.GetQueueEntitlementCalls, GetQueueEntitlementCall{Context: ctx, Request: request})
+	return f.GetQueueEntitlementResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingClient) GetDuplicateJobsReport(ctx context.Context, request *schedulerobjects.DuplicateJobsReportRequest, opts ...grpc.CallOption) (*schedulerobjects.DuplicateJobsReport, error) {
+	f.GetDuplicateJobsReportCalls = append(f.GetDuplicateJobsReportCalls, GetDuplicateJobsReportCall{Context: ctx, Request: request})
+	return f.GetDuplicateJobsReportResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingClient) GetSchedulingContextSnapshots(ctx context.Context, request *schedulerobjects.SchedulingContextSnapshotRequest, opts ...grpc.CallOption) (*schedulerobjects.SchedulingContextSnapshots, error) {
+	f.GetSchedulingContextSnapshotsCalls = append(f.GetSchedulingContextSnapshotsCalls, GetSchedulingContextSnapshotsCall{Context: ctx, Request: request})
+	return f.GetSchedulingContextSnapshotsResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingClient) GetQueueUtilisation(ctx context.Context, request *schedulerobjects.QueueUtilisationRequest, opts ...grpc.CallOption) (*schedulerobjects.QueueUtilisationReport, error) {
+	f.GetQueueUtilisationCalls = append(f.GetQueueUtilisationCalls, GetQueueUtilisationCall{Context: ctx, Request: request})
+	return f.GetQueueUtilisationResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingClient) GetPendingCapacity(ctx context.Context, request *schedulerobjects.PendingCapacityRequest, opts ...grpc.CallOption) (*schedulerobjects.PendingCapacityReport, error) {
+	f.GetPendingCapacityCalls = append(f.GetPendingCapacityCalls, GetPendingCapacityCall{Context: ctx, Request: request})
+	return f.GetPendingCapacityResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingClient) GetFairnessWhatIf(ctx context.Context, request *schedulerobjects.FairnessWhatIfRequest, opts ...grpc.CallOption) (*schedulerobjects.FairnessWhatIfReport, error) {
+	f.GetFairnessWhatIfCalls = append(f.GetFairnessWhatIfCalls, GetFairnessWhatIfCall{Context: ctx, Request: request})
+	return f.GetFairnessWhatIfResponse, f.Err
+}
+
+func (f *FakeSchedulerReportingClient) GetNodeTypes(ctx context.Context, request *schedulerobjects.NodeTypesRequest, opts ...grpc.CallOption) (*schedulerobjects.NodeTypesReport, error) {
+	f.GetNodeTypesCalls = append(f.GetNodeTypesCalls, GetNodeTypesCall{Context: ctx, Request: request})
+	return f.GetNodeTypesResponse, f.Err
+}
+
 type FakeClientProvider struct {
 	Error                  error
 	IsCurrentProcessLeader bool
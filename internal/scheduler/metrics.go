@@ -156,7 +156,7 @@ func (c *MetricsCollector) updateQueueMetrics(ctx *armadacontext.Context) ([]pro
 		}
 		qs, ok := provider.queueStates[job.Queue()]
 		if !ok {
-			ctx.Warnf("job %s is in queue %s, but this queue does not exist; skipping", job.Id(), job.Queue())
+			warnings.Warnf(ctx.WithFields(logging.JobFields(job.Queue(), job.Jobset(), job.Id())), "job is in a queue that does not exist; skipping")
 			continue
 		}
 
@@ -183,7 +183,7 @@ func (c *MetricsCollector) updateQueueMetrics(ctx *armadacontext.Context) ([]pro
 			timeInState = currentTime.Sub(time.Unix(0, run.Created()))
 			recorder = qs.runningJobRecorder
 		} else {
-			ctx.Warnf("Job %s is marked as leased but has no runs", job.Id())
+			warnings.Warnf(ctx.WithFields(logging.JobFields(job.Queue(), job.Jobset(), job.Id())), "Job is marked as leased but has no runs")
 		}
 		recorder.RecordJobRuntime(pool, priorityClass, timeInState)
 		recorder.RecordResources(pool, priorityClass, jobResources)
@@ -189,6 +189,21 @@ func (mr *MockJobRepositoryMockRecorder) FetchJobRunLeases(arg0, arg1, arg2, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobRunLeases", reflect.TypeOf((*MockJobRepository)(nil).FetchJobRunLeases), arg0, arg1, arg2, arg3)
 }
 
+// FetchJobSetSuspensions mocks base method.
+func (m *MockJobRepository) FetchJobSetSuspensions(arg0 *armadacontext.Context) ([]database.JobSetSuspension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobSetSuspensions", arg0)
+	ret0, _ := ret[0].([]database.JobSetSuspension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobSetSuspensions indicates an expected call of FetchJobSetSuspensions.
+func (mr *MockJobRepositoryMockRecorder) FetchJobSetSuspensions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobSetSuspensions", reflect.TypeOf((*MockJobRepository)(nil).FetchJobSetSuspensions), arg0)
+}
+
 // FetchJobUpdates mocks base method.
 func (m *MockJobRepository) FetchJobUpdates(arg0 *armadacontext.Context, arg1, arg2 int64) ([]database.Job, []database.Run, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +220,21 @@ func (mr *MockJobRepositoryMockRecorder) FetchJobUpdates(arg0, arg1, arg2 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobUpdates", reflect.TypeOf((*MockJobRepository)(nil).FetchJobUpdates), arg0, arg1, arg2)
 }
 
+// FetchTerminalJobs mocks base method.
+func (m *MockJobRepository) FetchTerminalJobs(arg0 *armadacontext.Context, arg1 []string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTerminalJobs", arg0, arg1)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTerminalJobs indicates an expected call of FetchTerminalJobs.
+func (mr *MockJobRepositoryMockRecorder) FetchTerminalJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTerminalJobs", reflect.TypeOf((*MockJobRepository)(nil).FetchTerminalJobs), arg0, arg1)
+}
+
 // FindInactiveRuns mocks base method.
 func (m *MockJobRepository) FindInactiveRuns(arg0 *armadacontext.Context, arg1 []uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,127 @@
+package scheduler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+// NodeTypeReporter reports the node types of the nodes of the executors known to the scheduler,
+// such that users can write node selectors and tolerations for their jobs without access to the clusters.
+type NodeTypeReporter struct {
+	executorRepository database.ExecutorRepository
+	// Executors that haven't sent a heartbeat for this long are ignored, as by the scheduler.
+	executorTimeout time.Duration
+	// Node types are computed from the labels and taints indexed by the scheduler.
+	// If nil, all taints are indexed.
+	indexedTaints     map[string]interface{}
+	indexedNodeLabels map[string]interface{}
+}
+
+func NewNodeTypeReporter(executorRepository database.ExecutorRepository, config configuration.SchedulingConfig) *NodeTypeReporter {
+	var indexedTaints map[string]interface{}
+	if len(config.IndexedTaints) > 0 {
+		indexedTaints = make(map[string]interface{}, len(config.IndexedTaints))
+		for _, key := range config.IndexedTaints {
+			indexedTaints[key] = true
+		}
+	}
+	indexedNodeLabels := make(map[string]interface{}, len(config.IndexedNodeLabels))
+	for _, key := range config.IndexedNodeLabels {
+		indexedNodeLabels[key] = true
+	}
+	return &NodeTypeReporter{
+		executorRepository: executorRepository,
+		executorTimeout:    config.ExecutorTimeout,
+		indexedTaints:      indexedTaints,
+		indexedNodeLabels:  indexedNodeLabels,
+	}
+}
+
+// GetNodeTypes is a gRPC endpoint for querying the node types of each pool,
+// i.e., the indexed labels and taints of its nodes, how many nodes there are of each, and their resources.
+func (r *NodeTypeReporter) GetNodeTypes(grpcCtx context.Context, request *schedulerobjects.NodeTypesRequest) (*schedulerobjects.NodeTypesReport, error) {
+	if r == nil {
+		return nil, errors.New("node type reporting is disabled")
+	}
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	executors, err := r.executorRepository.GetExecutors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pool := strings.TrimSpace(request.GetPool())
+	cutoff := time.Now().Add(-r.executorTimeout)
+	nodesByPool := make(map[string][]*schedulerobjects.Node)
+	for _, executor := range executors {
+		if pool != "" && executor.Pool != pool {
+			continue
+		}
+		if !executor.LastUpdateTime.After(cutoff) {
+			continue
+		}
+		nodesByPool[executor.Pool] = append(nodesByPool[executor.Pool], executor.Nodes...)
+	}
+	if pool != "" && len(nodesByPool) == 0 {
+		return nil, &armadaerrors.ErrNotFound{
+			Type:    "pool",
+			Value:   pool,
+			Message: "no active executors in this pool",
+		}
+	}
+	rv := &schedulerobjects.NodeTypesReport{}
+	for pool, nodes := range nodesByPool {
+		rv.NodeTypes = append(rv.NodeTypes, r.nodeTypeSummaries(pool, nodes)...)
+	}
+	slices.SortFunc(rv.NodeTypes, func(a, b *schedulerobjects.NodeTypeSummary) bool {
+		if a.Pool != b.Pool {
+			return a.Pool < b.Pool
+		}
+		if a.NumNodes != b.NumNodes {
+			return a.NumNodes > b.NumNodes
+		}
+		return a.NodeTypeId < b.NodeTypeId
+	})
+	return rv, nil
+}
+
+// nodeTypeSummaries groups the provided nodes of pool by node type.
+func (r *NodeTypeReporter) nodeTypeSummaries(pool string, nodes []*schedulerobjects.Node) []*schedulerobjects.NodeTypeSummary {
+	summaryByNodeTypeId := make(map[uint64]*schedulerobjects.NodeTypeSummary)
+	var rv []*schedulerobjects.NodeTypeSummary
+	for _, node := range nodes {
+		nodeType := schedulerobjects.NewNodeType(node.GetTaints(), node.GetLabels(), r.indexedTaints, r.indexedNodeLabels)
+		summary, ok := summaryByNodeTypeId[nodeType.Id]
+		if !ok {
+			summary = &schedulerobjects.NodeTypeSummary{
+				Pool:                 pool,
+				NodeTypeId:           nodeType.Id,
+				Labels:               nodeType.Labels,
+				Taints:               nodeType.Taints,
+				LargestNodeResources: schedulerobjects.NewResourceListWithDefaultSize(),
+				TotalResources:       schedulerobjects.NewResourceListWithDefaultSize(),
+			}
+			summaryByNodeTypeId[nodeType.Id] = summary
+			rv = append(rv, summary)
+		}
+		summary.NumNodes++
+		if node.Unschedulable {
+			summary.NumUnschedulableNodes++
+		}
+		summary.TotalResources.Add(node.TotalResources)
+		for t, q := range node.TotalResources.Resources {
+			if q.Cmp(summary.LargestNodeResources.Get(t)) == 1 {
+				summary.LargestNodeResources.Set(t, q.DeepCopy())
+			}
+		}
+	}
+	return rv
+}
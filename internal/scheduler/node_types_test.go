@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	schedulermocks "github.com/armadaproject/armada/internal/scheduler/mocks"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+func TestGetNodeTypes(t *testing.T) {
+	gpuTaint := v1.Taint{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}
+	node := func(id string, cpu string, zone string, taints ...v1.Taint) *schedulerobjects.Node {
+		return &schedulerobjects.Node{
+			Id:             id,
+			Labels:         map[string]string{"zone": zone, "kubernetes.io/hostname": id},
+			Taints:         taints,
+			TotalResources: schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse(cpu)}},
+		}
+	}
+	unschedulable := node("node-4", "8", "a")
+	unschedulable.Unschedulable = true
+	executors := []*schedulerobjects.Executor{
+		{
+			Id:             "executor-1",
+			Pool:           "cpu",
+			LastUpdateTime: time.Now(),
+			Nodes:          []*schedulerobjects.Node{node("node-1", "8", "a"), node("node-2", "16", "a"), node("node-3", "8", "b")},
+		},
+		{
+			Id:             "executor-2",
+			Pool:           "cpu",
+			LastUpdateTime: time.Now(),
+			Nodes:          []*schedulerobjects.Node{unschedulable},
+		},
+		{
+			Id:             "executor-3",
+			Pool:           "gpu",
+			LastUpdateTime: time.Now(),
+			Nodes:          []*schedulerobjects.Node{node("node-5", "32", "a", gpuTaint)},
+		},
+		{
+			Id:             "stale",
+			Pool:           "stale",
+			LastUpdateTime: time.Now().Add(-time.Hour),
+			Nodes:          []*schedulerobjects.Node{node("node-6", "8", "a")},
+		},
+	}
+	ctrl := gomock.NewController(t)
+	executorRepository := schedulermocks.NewMockExecutorRepository(ctrl)
+	executorRepository.EXPECT().GetExecutors(gomock.Any()).Return(executors, nil).AnyTimes()
+	reporter := NewNodeTypeReporter(executorRepository, configuration.SchedulingConfig{
+		ExecutorTimeout:   time.Minute,
+		IndexedNodeLabels: []string{"zone"},
+	})
+
+	report, err := reporter.GetNodeTypes(context.Background(), &schedulerobjects.NodeTypesRequest{})
+	require.NoError(t, err)
+	require.Len(t, report.NodeTypes, 3)
+
+	a := report.NodeTypes[0]
+	assert.Equal(t, "cpu", a.Pool)
+	assert.Equal(t, map[string]string{"zone": "a"}, a.Labels)
+	assert.Empty(t, a.Taints)
+	assert.Equal(t, int32(3), a.NumNodes)
+	assert.Equal(t, int32(1), a.NumUnschedulableNodes)
+	assert.True(t, resource.MustParse("16").Equal(a.LargestNodeResources.Get("cpu")))
+	assert.True(t, resource.MustParse("32").Equal(a.TotalResources.Get("cpu")))
+
+	b := report.NodeTypes[1]
+	assert.Equal(t, "cpu", b.Pool)
+	assert.Equal(t, map[string]string{"zone": "b"}, b.Labels)
+	assert.Equal(t, int32(1), b.NumNodes)
+
+	gpu := report.NodeTypes[2]
+	assert.Equal(t, "gpu", gpu.Pool)
+	assert.Equal(t, []v1.Taint{gpuTaint}, gpu.Taints)
+
+	report, err = reporter.GetNodeTypes(context.Background(), &schedulerobjects.NodeTypesRequest{Pool: "gpu"})
+	require.NoError(t, err)
+	assert.Len(t, report.NodeTypes, 1)
+
+	_, err = reporter.GetNodeTypes(context.Background(), &schedulerobjects.NodeTypesRequest{Pool: "stale"})
+	assert.ErrorAs(t, err, new(*armadaerrors.ErrNotFound))
+}
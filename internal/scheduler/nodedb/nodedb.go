@@ -48,6 +48,8 @@ type Node struct {
 	// indexed taints and labels, but we need all of them when checking pod requirements.
 	Taints []v1.Taint
 	Labels map[string]string
+	// Storage classes of which volumes can be attached to this node.
+	StorageClasses []string
 
 	TotalResources schedulerobjects.ResourceList
 
@@ -70,8 +72,9 @@ func (node *Node) UnsafeCopy() *Node {
 		Name:     node.Name,
 		Executor: node.Executor,
 
-		Taints: node.Taints,
-		Labels: node.Labels,
+		Taints:         node.Taints,
+		Labels:         node.Labels,
+		StorageClasses: node.StorageClasses,
 
 		TotalResources: node.TotalResources,
 
@@ -151,8 +154,9 @@ func (nodeDb *NodeDb) create(node *schedulerobjects.Node) (*Node, error) {
 		Name:     node.Name,
 		Executor: node.Executor,
 
-		Taints: taints,
-		Labels: labels,
+		Taints:         taints,
+		Labels:         labels,
+		StorageClasses: node.StorageClasses,
 
 		TotalResources: totalResources,
 
@@ -780,7 +784,7 @@ func (nodeDb *NodeDb) selectNodeForPodWithIt(
 		if onlyCheckDynamicRequirements {
 			matches, score, reason = DynamicJobRequirementsMet(node.AllocatableByPriority[priority], jctx)
 		} else {
-			matches, score, reason, err = JobRequirementsMet(node.Taints, node.Labels, node.TotalResources, node.AllocatableByPriority[priority], jctx)
+			matches, score, reason, err = JobRequirementsMet(node.Taints, node.Labels, node.StorageClasses, node.TotalResources, node.AllocatableByPriority[priority], jctx)
 		}
 		if err != nil {
 			return nil, err
@@ -797,6 +801,7 @@ func (nodeDb *NodeDb) selectNodeForPodWithIt(
 		} else {
 			s := nodeDb.stringFromPodRequirementsNotMetReason(reason)
 			jctx.PodSchedulingContext.NumExcludedNodesByReason[s] += 1
+			jctx.PodSchedulingContext.AddExcludedNodeSample(s, node.Name)
 		}
 	}
 
@@ -849,6 +854,7 @@ func (nodeDb *NodeDb) selectNodeForJobWithFairPreemption(txn *memdb.Txn, jctx *s
 		matches, _, reason, err := JobRequirementsMet(
 			node.Taints,
 			node.Labels,
+			node.StorageClasses,
 			node.TotalResources,
 			node.AllocatableByPriority[evictedPriority],
 			jctx,
@@ -861,6 +867,7 @@ func (nodeDb *NodeDb) selectNodeForJobWithFairPreemption(txn *memdb.Txn, jctx *s
 		} else {
 			s := nodeDb.stringFromPodRequirementsNotMetReason(reason)
 			pctx.NumExcludedNodesByReason[s] += 1
+			pctx.AddExcludedNodeSample(s, node.Name)
 		}
 	}
 	if selectedNode != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/segmentio/fasthash/fnv1a"
+	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -21,6 +22,7 @@ const (
 	PodRequirementsNotMetReasonUnmatchedNodeSelector = "node does not match pod NodeAffinity"
 	PodRequirementsNotMetReasonUnknown               = "unknown"
 	PodRequirementsNotMetReasonInsufficientResources = "insufficient resources available"
+	PodRequirementsNotMetReasonNoMatchingStorage     = "no matching storage"
 )
 
 type PodRequirementsNotMetReason interface {
@@ -110,6 +112,22 @@ func (err *InsufficientResources) String() string {
 		err.Available.String() + " is available"
 }
 
+// NoMatchingStorage indicates that a volume requested by the pod can't be attached to the node.
+// All such nodes are reported under the same reason, regardless of the storage class requested.
+type NoMatchingStorage struct {
+	StorageClassName string
+}
+
+func (r *NoMatchingStorage) Sum64() uint64 {
+	h := fnv1a.Init64
+	h = fnv1a.AddString64(h, PodRequirementsNotMetReasonNoMatchingStorage)
+	return h
+}
+
+func (r *NoMatchingStorage) String() string {
+	return PodRequirementsNotMetReasonNoMatchingStorage
+}
+
 // NodeTypeJobRequirementsMet determines whether a pod can be scheduled on nodes of this NodeType.
 // If the requirements are not met, it returns the reason for why.
 // If the requirements can't be parsed, an error is returned.
@@ -133,8 +151,8 @@ func NodeTypeJobRequirementsMet(nodeType *schedulerobjects.NodeType, jctx *sched
 // - 1: Pod can be scheduled without preempting any running pods.
 // If the requirements are not met, it returns the reason why.
 // If the requirements can't be parsed, an error is returned.
-func JobRequirementsMet(taints []v1.Taint, labels map[string]string, totalResources schedulerobjects.ResourceList, allocatableResources schedulerobjects.ResourceList, jctx *schedulercontext.JobSchedulingContext) (bool, int, PodRequirementsNotMetReason, error) {
-	matches, reason, err := StaticJobRequirementsMet(taints, labels, totalResources, jctx)
+func JobRequirementsMet(taints []v1.Taint, labels map[string]string, storageClasses []string, totalResources schedulerobjects.ResourceList, allocatableResources schedulerobjects.ResourceList, jctx *schedulercontext.JobSchedulingContext) (bool, int, PodRequirementsNotMetReason, error) {
+	matches, reason, err := StaticJobRequirementsMet(taints, labels, storageClasses, totalResources, jctx)
 	if !matches || err != nil {
 		return matches, 0, reason, err
 	}
@@ -146,8 +164,8 @@ func JobRequirementsMet(taints []v1.Taint, labels map[string]string, totalResour
 }
 
 // StaticJobRequirementsMet checks if a job can be scheduled onto this node,
-// accounting for taints, node selectors, node affinity, and total resources available on the node.
-func StaticJobRequirementsMet(taints []v1.Taint, labels map[string]string, totalResources schedulerobjects.ResourceList, jctx *schedulercontext.JobSchedulingContext) (bool, PodRequirementsNotMetReason, error) {
+// accounting for taints, node selectors, node affinity, attachable storage, and total resources available on the node.
+func StaticJobRequirementsMet(taints []v1.Taint, labels map[string]string, storageClasses []string, totalResources schedulerobjects.ResourceList, jctx *schedulercontext.JobSchedulingContext) (bool, PodRequirementsNotMetReason, error) {
 	matches, reason := TolerationRequirementsMet(taints, jctx.AdditionalTolerations, jctx.PodRequirements.GetTolerations())
 	if !matches {
 		return matches, reason, nil
@@ -168,6 +186,11 @@ func StaticJobRequirementsMet(taints []v1.Taint, labels map[string]string, total
 		return matches, reason, err
 	}
 
+	matches, reason = StorageRequirementsMet(storageClasses, jctx.PodRequirements.GetPersistentVolumeClaims())
+	if !matches {
+		return matches, reason, nil
+	}
+
 	matches, reason = ResourceRequirementsMet(totalResources, jctx.PodRequirements.ResourceRequirements.Requests)
 	if !matches {
 		return matches, reason, nil
@@ -260,6 +283,17 @@ func NodeAffinityRequirementsMet(nodeLabels map[string]string, nodeSelector *v1.
 	return true, nil, nil
 }
 
+// StorageRequirementsMet checks if the volumes claimed by a pod can be attached to a node
+// on which volumes of the provided storage classes can be attached.
+func StorageRequirementsMet(storageClasses []string, claims []*schedulerobjects.PersistentVolumeClaimRequirements) (bool, PodRequirementsNotMetReason) {
+	for _, claim := range claims {
+		if !slices.Contains(storageClasses, claim.StorageClassName) {
+			return false, &NoMatchingStorage{StorageClassName: claim.StorageClassName}
+		}
+	}
+	return true, nil
+}
+
 func ResourceRequirementsMet(available schedulerobjects.ResourceList, required v1.ResourceList) (bool, PodRequirementsNotMetReason) {
 	resourceName, availableQuantity, requiredQuantity, hasGreaterResource := findGreaterQuantity(available, required)
 	if hasGreaterResource {
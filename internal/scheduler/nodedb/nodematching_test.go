@@ -264,6 +264,38 @@ func TestNodeSchedulingRequirementsMet(t *testing.T) {
 			},
 			expectSuccess: false,
 		},
+		"matched storage class": {
+			node: &schedulerobjects.Node{
+				StorageClasses: []string{"", "fast"},
+			},
+			req: &schedulerobjects.PodRequirements{
+				PersistentVolumeClaims: []*schedulerobjects.PersistentVolumeClaimRequirements{
+					{Name: "scratch", StorageClassName: "fast"},
+					{Name: "data"},
+				},
+			},
+			expectSuccess: true,
+		},
+		"unmatched storage class": {
+			node: &schedulerobjects.Node{
+				StorageClasses: []string{""},
+			},
+			req: &schedulerobjects.PodRequirements{
+				PersistentVolumeClaims: []*schedulerobjects.PersistentVolumeClaimRequirements{
+					{Name: "scratch", StorageClassName: "fast"},
+				},
+			},
+			expectSuccess: false,
+		},
+		"no storage classes": {
+			node: &schedulerobjects.Node{},
+			req: &schedulerobjects.PodRequirements{
+				PersistentVolumeClaims: []*schedulerobjects.PersistentVolumeClaimRequirements{
+					{Name: "scratch"},
+				},
+			},
+			expectSuccess: false,
+		},
 		"sufficient cpu": {
 			node: &schedulerobjects.Node{
 				AllocatableByPriorityAndResource: schedulerobjects.AllocatableByPriorityAndResourceType{
@@ -380,6 +412,7 @@ func TestNodeSchedulingRequirementsMet(t *testing.T) {
 			matches, _, reason, err := JobRequirementsMet(
 				tc.node.Taints,
 				tc.node.Labels,
+				tc.node.StorageClasses,
 				tc.node.TotalResources,
 				tc.node.AllocatableByPriorityAndResource[tc.req.Priority],
 				// TODO(albin): Define a jctx in the test case instead.
@@ -544,6 +577,24 @@ func TestNodeTypeSchedulingRequirementsMet(t *testing.T) {
 	}
 }
 
+func TestStorageRequirementsMet(t *testing.T) {
+	matches, reason := StorageRequirementsMet(
+		[]string{"fast"},
+		[]*schedulerobjects.PersistentVolumeClaimRequirements{{Name: "scratch", StorageClassName: "fast"}},
+	)
+	assert.True(t, matches)
+	assert.Nil(t, reason)
+
+	matches, reason = StorageRequirementsMet(
+		[]string{"fast"},
+		[]*schedulerobjects.PersistentVolumeClaimRequirements{{Name: "scratch", StorageClassName: "slow"}},
+	)
+	assert.False(t, matches)
+	assert.Equal(t, &NoMatchingStorage{StorageClassName: "slow"}, reason)
+	assert.Equal(t, PodRequirementsNotMetReasonNoMatchingStorage, reason.String())
+	assert.Equal(t, (&NoMatchingStorage{StorageClassName: "fast"}).Sum64(), reason.Sum64())
+}
+
 func TestInsufficientResourcesSum64(t *testing.T) {
 	tests := map[string]struct {
 		a     *InsufficientResources
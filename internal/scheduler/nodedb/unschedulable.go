@@ -24,3 +24,13 @@ func UnschedulableToleration() v1.Toleration {
 		Value: unschedulableTaintValue,
 	}
 }
+
+// IsMarkedForMaintenance returns true if nodeMaintenanceLabel is non-empty and set on a node with the provided labels.
+// Nodes marked for maintenance are treated as unschedulable and jobs running on them are drained.
+func IsMarkedForMaintenance(labels map[string]string, nodeMaintenanceLabel string) bool {
+	if nodeMaintenanceLabel == "" {
+		return false
+	}
+	_, ok := labels[nodeMaintenanceLabel]
+	return ok
+}
@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+// PendingCapacityReporter reports the queued jobs of the job database broken down by scheduling requirements,
+// such that external node provisioners, e.g., Karpenter or spot fleet controllers, can provision nodes matching them.
+type PendingCapacityReporter struct {
+	jobDb *jobdb.JobDb
+}
+
+func NewPendingCapacityReporter(jobDb *jobdb.JobDb) *PendingCapacityReporter {
+	return &PendingCapacityReporter{jobDb: jobDb}
+}
+
+// GetPendingCapacity is a gRPC endpoint for querying the queued jobs grouped by scheduling key,
+// i.e., by resource requests, node selector, affinity, tolerations, and priority class.
+func (r *PendingCapacityReporter) GetPendingCapacity(_ context.Context, request *schedulerobjects.PendingCapacityRequest) (*schedulerobjects.PendingCapacityReport, error) {
+	if r == nil {
+		return nil, errors.New("pending capacity reporting is disabled")
+	}
+	txn := r.jobDb.ReadTxn()
+	var jobs []*jobdb.Job
+	if queue := strings.TrimSpace(request.GetQueueName()); queue != "" {
+		it := txn.QueuedJobs(queue)
+		for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+			jobs = append(jobs, job)
+		}
+	} else {
+		for _, job := range txn.GetAll() {
+			if job.Queued() {
+				jobs = append(jobs, job)
+			}
+		}
+	}
+	return pendingCapacityReport(jobs), nil
+}
+
+// pendingCapacityReport groups the provided queued jobs by scheduling key.
+func pendingCapacityReport(jobs []*jobdb.Job) *schedulerobjects.PendingCapacityReport {
+	pendingByKey := make(map[schedulerobjects.SchedulingKey]*schedulerobjects.PendingCapacity)
+	queuesByKey := make(map[schedulerobjects.SchedulingKey]map[string]bool)
+	for _, job := range jobs {
+		req := job.PodRequirements()
+		if req == nil {
+			continue
+		}
+		key, _ := job.GetSchedulingKey()
+		requests := schedulerobjects.ResourceListFromV1ResourceList(req.ResourceRequirements.Requests)
+		submitted := time.Unix(0, job.Created())
+		pending, ok := pendingByKey[key]
+		if !ok {
+			pending = &schedulerobjects.PendingCapacity{
+				Requests:          requests.DeepCopy(),
+				NodeSelector:      maps.Clone(req.NodeSelector),
+				Affinity:          req.Affinity,
+				Tolerations:       slices.Clone(req.Tolerations),
+				PriorityClassName: job.GetPriorityClassName(),
+				TotalRequests:     schedulerobjects.NewResourceListWithDefaultSize(),
+				OldestSubmitTime:  submitted,
+			}
+			pendingByKey[key] = pending
+			queuesByKey[key] = make(map[string]bool)
+		}
+		pending.NumJobs++
+		pending.TotalRequests.Add(requests)
+		if submitted.Before(pending.OldestSubmitTime) {
+			pending.OldestSubmitTime = submitted
+		}
+		queuesByKey[key][job.Queue()] = true
+	}
+
+	rv := &schedulerobjects.PendingCapacityReport{}
+	for key, pending := range pendingByKey {
+		pending.Queues = maps.Keys(queuesByKey[key])
+		slices.Sort(pending.Queues)
+		rv.Pending = append(rv.Pending, pending)
+	}
+	slices.SortFunc(rv.Pending, func(a, b *schedulerobjects.PendingCapacity) bool {
+		if a.NumJobs != b.NumJobs {
+			return a.NumJobs > b.NumJobs
+		}
+		return a.OldestSubmitTime.Before(b.OldestSubmitTime)
+	})
+	return rv
+}
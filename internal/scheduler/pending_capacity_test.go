@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+)
+
+func TestGetPendingCapacity(t *testing.T) {
+	submitted := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	jobs := []*jobdb.Job{
+		testfixtures.Test1Cpu4GiJob("queue-a", testfixtures.PriorityClass0).WithQueued(true).WithCreated(submitted.Add(time.Minute).UnixNano()),
+		testfixtures.Test1Cpu4GiJob("queue-b", testfixtures.PriorityClass0).WithQueued(true).WithCreated(submitted.UnixNano()),
+		testfixtures.Test1Cpu4GiJob("queue-b", testfixtures.PriorityClass0).WithQueued(true).WithCreated(submitted.UnixNano()),
+		testfixtures.Test32Cpu256GiJob("queue-a", testfixtures.PriorityClass0).WithQueued(true).WithCreated(submitted.UnixNano()),
+		// Jobs that aren't queued don't need capacity to be provisioned.
+		testfixtures.Test32Cpu256GiJob("queue-a", testfixtures.PriorityClass0),
+	}
+	jobDb := testfixtures.NewJobDb()
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert(jobs))
+	txn.Commit()
+	reporter := NewPendingCapacityReporter(jobDb)
+	ctx := armadacontext.Background()
+
+	report, err := reporter.GetPendingCapacity(ctx, &schedulerobjects.PendingCapacityRequest{})
+	require.NoError(t, err)
+	require.Len(t, report.Pending, 2)
+	small, large := report.Pending[0], report.Pending[1]
+	assert.Equal(t, int32(3), small.NumJobs)
+	assert.Equal(t, []string{"queue-a", "queue-b"}, small.Queues)
+	assert.Equal(t, testfixtures.PriorityClass0, small.PriorityClassName)
+	assert.True(t, resource.MustParse("1").Equal(small.Requests.Get("cpu")))
+	assert.True(t, resource.MustParse("3").Equal(small.TotalRequests.Get("cpu")))
+	assert.True(t, resource.MustParse("12Gi").Equal(small.TotalRequests.Get("memory")))
+	assert.Equal(t, submitted, small.OldestSubmitTime.UTC())
+	assert.Equal(t, int32(1), large.NumJobs)
+	assert.True(t, resource.MustParse("32").Equal(large.Requests.Get("cpu")))
+
+	report, err = reporter.GetPendingCapacity(ctx, &schedulerobjects.PendingCapacityRequest{QueueName: "queue-b"})
+	require.NoError(t, err)
+	require.Len(t, report.Pending, 1)
+	assert.Equal(t, int32(2), report.Pending[0].NumJobs)
+	assert.Equal(t, []string{"queue-b"}, report.Pending[0].Queues)
+
+	var disabled *PendingCapacityReporter
+	_, err = disabled.GetPendingCapacity(ctx, &schedulerobjects.PendingCapacityRequest{})
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"math"
 	"math/rand"
 	"reflect"
 	"time"
@@ -11,7 +12,9 @@ import (
 	"golang.org/x/exp/slices"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
 	armadamaps "github.com/armadaproject/armada/internal/common/maps"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/types"
@@ -23,6 +26,7 @@ import (
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 	"github.com/armadaproject/armada/internal/scheduler/nodedb"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
 // PreemptingQueueScheduler is a scheduler that makes a unified decisions on which jobs to preempt and schedule.
@@ -49,6 +53,30 @@ type PreemptingQueueScheduler struct {
 	enableAssertions bool
 	// If true, a newer preemption strategy is used.
 	enableNewPreemptionStrategy bool
+	// Nodes with this label are considered marked for maintenance and jobs running on them are preempted.
+	// If empty, no nodes are drained.
+	nodeMaintenanceLabel string
+	// Maximum number of jobs preempted per round to drain nodes marked for maintenance.
+	// If zero, all jobs on nodes marked for maintenance are preempted.
+	maxJobsToDrainPerRound uint
+	// If true, jobs within each queue are reordered such that jobs of the user with the smallest allocation are scheduled first.
+	enableUserFairShare bool
+	// Number of jobs per queue considered when reordering jobs by user.
+	userFairShareLookahead uint
+	// Jobs that started running less than this long ago aren't evicted to balance resources between queues.
+	minimumRuntimeBeforeFairSharePreemption time.Duration
+	// Maps job ids to the time at which the current run of the job started.
+	runStartTimeByJobId map[string]time.Time
+	// If true, the jobSetMaxPreemptedFraction annotation limits the number of jobs of each job set evicted
+	// to balance resources between queues.
+	enableJobSetDisruptionBudgets bool
+	// Number of jobs of each job set preempted in recent scheduling rounds, which count against its budget.
+	recentlyPreemptedByQueueAndJobSet map[string]map[string]int
+	// Number of running jobs of each job set before any jobs were evicted in this round.
+	runningJobsByQueueAndJobSet map[string]map[string]int
+	// Ids of the running jobs of job sets with a disruption budget that may be evicted in this round.
+	// Selected by selectJobsWithinJobSetDisruptionBudgets.
+	evictableJobIds map[string]bool
 }
 
 func NewPreemptingQueueScheduler(
@@ -103,6 +131,56 @@ func (sch *PreemptingQueueScheduler) EnableNewPreemptionStrategy() {
 	sch.nodeDb.EnableNewPreemptionStrategy()
 }
 
+// EnableNodeDrain causes jobs running on nodes with the nodeMaintenanceLabel label set to be preempted,
+// in order of increasing priority and with at most maxJobsPerRound jobs preempted per round.
+// If maxJobsPerRound is zero, all such jobs are preempted.
+func (sch *PreemptingQueueScheduler) EnableNodeDrain(nodeMaintenanceLabel string, maxJobsPerRound uint) {
+	sch.nodeMaintenanceLabel = nodeMaintenanceLabel
+	sch.maxJobsToDrainPerRound = maxJobsPerRound
+}
+
+// EnableUserFairShare causes the resources allocated to each queue to be divided fairly between the users of that queue.
+// Among the next lookahead queued jobs of each queue, jobs of the user with the smallest allocation are scheduled first.
+// Evicted jobs are rescheduled in their original order, i.e., jobs are never preempted to rebalance users within a queue.
+func (sch *PreemptingQueueScheduler) EnableUserFairShare(lookahead uint) {
+	sch.enableUserFairShare = true
+	sch.userFairShareLookahead = lookahead
+}
+
+// EnableFairSharePreemptionProtection prevents jobs that started running less than minimumRuntime ago
+// from being preempted to balance resources between queues, where runStartTimeByJobId maps job ids to the time
+// at which the current run of the job started running, or to the zero time if it hasn't started running yet,
+// in which case the job is protected too. Such jobs may still be preempted by jobs of higher priority classes.
+func (sch *PreemptingQueueScheduler) EnableFairSharePreemptionProtection(minimumRuntime time.Duration, runStartTimeByJobId map[string]time.Time) {
+	sch.minimumRuntimeBeforeFairSharePreemption = minimumRuntime
+	sch.runStartTimeByJobId = runStartTimeByJobId
+}
+
+// EnableJobSetDisruptionBudgets limits the number of jobs of each job set with the jobSetMaxPreemptedFraction annotation
+// evicted to balance resources between queues, such that the jobs preempted in this round together with
+// recentlyPreemptedByQueueAndJobSet, the jobs of each job set preempted in recent rounds, make up at most the given
+// fraction of the job set. The job set is made up of its running jobs and its recently preempted jobs.
+// Jobs may still be preempted by jobs of higher priority classes, and when evicting the remaining jobs of a gang.
+//
+// Must be called after the running jobs of each job set have been added to the scheduling context.
+func (sch *PreemptingQueueScheduler) EnableJobSetDisruptionBudgets(recentlyPreemptedByQueueAndJobSet map[string]map[string]int) {
+	sch.enableJobSetDisruptionBudgets = true
+	sch.recentlyPreemptedByQueueAndJobSet = recentlyPreemptedByQueueAndJobSet
+	sch.runningJobsByQueueAndJobSet = make(map[string]map[string]int, len(sch.schedulingContext.QueueSchedulingContexts))
+	for queue, qctx := range sch.schedulingContext.QueueSchedulingContexts {
+		sch.runningJobsByQueueAndJobSet[queue] = maps.Clone(qctx.RunningJobsByJobSet)
+	}
+}
+
+// Phases of a scheduling round, for which the time spent is recorded in the scheduling context.
+const (
+	PhaseNodeDrainEviction          = "node_drain_eviction"
+	PhaseBalancingEviction          = "balancing_eviction"
+	PhaseBalancingScheduling        = "balancing_scheduling"
+	PhaseOversubscribedEviction     = "oversubscribed_eviction"
+	PhaseOversubscribedRescheduling = "oversubscribed_rescheduling"
+)
+
 // Schedule
 // - preempts jobs belonging to queues with total allocation above their fair share and
 // - schedules new jobs belonging to queues with total allocation less than their fair share.
@@ -113,12 +191,42 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 
 	preemptedJobsById := make(map[string]interfaces.LegacySchedulerJob)
 	scheduledJobsById := make(map[string]interfaces.LegacySchedulerJob)
+	preemptionReasonByJobId := make(map[string]armadaevents.PreemptionReason)
 
 	// NodeDb snapshot prior to making any changes.
 	// We compare against this snapshot after scheduling to detect changes.
 	snapshot := sch.nodeDb.Txn(false)
 
+	// Evict jobs on nodes marked for maintenance.
+	// These jobs are not re-scheduled, since nodes marked for maintenance are unschedulable,
+	// and are hence preempted.
+	if sch.nodeMaintenanceLabel != "" {
+		start := time.Now()
+		drainEvictor, err := sch.newDrainEvictor(ctx)
+		if err != nil {
+			return nil, err
+		}
+		evictorResult, _, err := sch.evict(
+			armadacontext.WithLogField(ctx, "stage", "evict for node drain"),
+			drainEvictor,
+		)
+		if err != nil {
+			return nil, err
+		}
+		sch.schedulingContext.AddPhaseDuration(PhaseNodeDrainEviction, time.Since(start))
+		for jobId, jctx := range evictorResult.EvictedJctxsByJobId {
+			preemptedJobsById[jobId] = jctx.Job
+			preemptionReasonByJobId[jobId] = armadaevents.PreemptionReason_NodeDrain
+			sch.schedulingContext.AddDrainedJob(evictorResult.NodeIdByJobId[jobId], jctx.Job)
+		}
+		maps.Copy(sch.nodeIdByJobId, evictorResult.NodeIdByJobId)
+	}
+
 	// Evict preemptible jobs.
+	start := time.Now()
+	if err := sch.selectJobsWithinJobSetDisruptionBudgets(); err != nil {
+		return nil, err
+	}
 	totalCost := sch.schedulingContext.TotalCost()
 	evictorResult, inMemoryJobRepo, err := sch.evict(
 		armadacontext.WithLogField(ctx, "stage", "evict for resource balancing"),
@@ -128,14 +236,19 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 			sch.nodeEvictionProbability,
 			func(ctx *armadacontext.Context, job interfaces.LegacySchedulerJob) bool {
 				if job.GetAnnotations() == nil {
-					ctx.Errorf("can't evict job %s: annotations not initialised", job.GetId())
+					warnings.Errorf(ctx.WithField(logging.JobIdField, job.GetId()), "can't evict job: annotations not initialised")
 					return false
 				}
 				if job.GetNodeSelector() == nil {
-					ctx.Errorf("can't evict job %s: nodeSelector not initialised", job.GetId())
+					warnings.Errorf(ctx.WithField(logging.JobIdField, job.GetId()), "can't evict job: nodeSelector not initialised")
+					return false
+				}
+				if sch.isProtectedFromFairSharePreemption(job) {
 					return false
 				}
-				if qctx, ok := sch.schedulingContext.QueueSchedulingContexts[job.GetQueue()]; ok {
+				// Queues using resources lent by other queues aren't protected by protectedFractionOfFairShare,
+				// such that jobs using borrowed resources are preempted first.
+				if qctx, ok := sch.schedulingContext.QueueSchedulingContexts[job.GetQueue()]; ok && !sch.constraints.IsBorrowing(qctx, job.GetPriorityClassName()) {
 					fairShare := qctx.Weight / sch.schedulingContext.WeightSum
 					actualShare := sch.schedulingContext.FairnessCostProvider.CostFromQueue(qctx) / totalCost
 					fractionOfFairShare := actualShare / fairShare
@@ -143,10 +256,10 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 						return false
 					}
 				}
-				if priorityClass, ok := sch.schedulingContext.PriorityClasses[job.GetPriorityClassName()]; ok {
-					return priorityClass.Preemptible
+				if priorityClass, ok := sch.schedulingContext.PriorityClasses[job.GetPriorityClassName()]; !ok || !priorityClass.Preemptible {
+					return false
 				}
-				return false
+				return sch.isWithinJobSetDisruptionBudget(ctx, job)
 			},
 			nil,
 		),
@@ -154,12 +267,15 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 	if err != nil {
 		return nil, err
 	}
+	sch.schedulingContext.AddPhaseDuration(PhaseBalancingEviction, time.Since(start))
 	for _, jctx := range evictorResult.EvictedJctxsByJobId {
 		preemptedJobsById[jctx.Job.GetId()] = jctx.Job
+		preemptionReasonByJobId[jctx.Job.GetId()] = armadaevents.PreemptionReason_FairShare
 	}
 	maps.Copy(sch.nodeIdByJobId, evictorResult.NodeIdByJobId)
 
 	// Re-schedule evicted jobs/schedule new jobs.
+	start = time.Now()
 	schedulerResult, err := sch.schedule(
 		armadacontext.WithLogField(ctx, "stage", "re-schedule after balancing eviction"),
 		inMemoryJobRepo,
@@ -168,6 +284,7 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 	if err != nil {
 		return nil, err
 	}
+	sch.schedulingContext.AddPhaseDuration(PhaseBalancingScheduling, time.Since(start))
 	for _, job := range schedulerResult.ScheduledJobs {
 		if _, ok := preemptedJobsById[job.GetId()]; ok {
 			delete(preemptedJobsById, job.GetId())
@@ -178,6 +295,7 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 	maps.Copy(sch.nodeIdByJobId, schedulerResult.NodeIdByJobId)
 
 	// Evict jobs on oversubscribed nodes.
+	start = time.Now()
 	evictorResult, inMemoryJobRepo, err = sch.evict(
 		armadacontext.WithLogField(ctx, "stage", "evict oversubscribed"),
 		NewOversubscribedEvictor(
@@ -191,6 +309,7 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 	if err != nil {
 		return nil, err
 	}
+	sch.schedulingContext.AddPhaseDuration(PhaseOversubscribedEviction, time.Since(start))
 	scheduledAndEvictedJobsById := armadamaps.FilterKeys(
 		scheduledJobsById,
 		func(jobId string) bool {
@@ -203,6 +322,7 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 			delete(scheduledJobsById, jobId)
 		} else {
 			preemptedJobsById[jobId] = jctx.Job
+			preemptionReasonByJobId[jobId] = armadaevents.PreemptionReason_Urgency
 		}
 	}
 	maps.Copy(sch.nodeIdByJobId, evictorResult.NodeIdByJobId)
@@ -212,6 +332,7 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 	if len(evictorResult.EvictedJctxsByJobId) > 0 {
 		// Since no new jobs are considered in this round, the scheduling key check brings no benefit.
 		sch.SkipUnsuccessfulSchedulingKeyCheck()
+		start = time.Now()
 		schedulerResult, err = sch.schedule(
 			armadacontext.WithLogField(ctx, "stage", "schedule after oversubscribed eviction"),
 			inMemoryJobRepo,
@@ -221,6 +342,7 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 		if err != nil {
 			return nil, err
 		}
+		sch.schedulingContext.AddPhaseDuration(PhaseOversubscribedRescheduling, time.Since(start))
 		for _, job := range schedulerResult.ScheduledJobs {
 			if _, ok := preemptedJobsById[job.GetId()]; ok {
 				delete(preemptedJobsById, job.GetId())
@@ -263,10 +385,156 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 		ScheduledJobs:      scheduledJobs,
 		FailedJobs:         schedulerResult.FailedJobs,
 		NodeIdByJobId:      sch.nodeIdByJobId,
+		PreemptionByJobId:  sch.preemptionByJobId(preemptedJobs, scheduledJobs, preemptionReasonByJobId),
 		SchedulingContexts: []*schedulercontext.SchedulingContext{sch.schedulingContext},
 	}, nil
 }
 
+// preemptionByJobId returns why each preempted job was preempted.
+// Jobs preempted for fair share or urgency are attributed to a job scheduled onto the same node, if there is one;
+// for fair share, preferably a job of another queue, and, for urgency, a job of higher priority than the preempted job.
+func (sch *PreemptingQueueScheduler) preemptionByJobId(
+	preemptedJobs []interfaces.LegacySchedulerJob,
+	scheduledJobs []interfaces.LegacySchedulerJob,
+	preemptionReasonByJobId map[string]armadaevents.PreemptionReason,
+) map[string]Preemption {
+	scheduledJobsByNodeId := make(map[string][]interfaces.LegacySchedulerJob)
+	for _, job := range scheduledJobs {
+		nodeId := sch.nodeIdByJobId[job.GetId()]
+		scheduledJobsByNodeId[nodeId] = append(scheduledJobsByNodeId[nodeId], job)
+	}
+	for _, jobs := range scheduledJobsByNodeId {
+		// Sort to ensure jobs are attributed deterministically.
+		slices.SortFunc(jobs, func(a, b interfaces.LegacySchedulerJob) bool {
+			return a.GetId() < b.GetId()
+		})
+	}
+	rv := make(map[string]Preemption, len(preemptedJobs))
+	for _, job := range preemptedJobs {
+		preemption := Preemption{Reason: preemptionReasonByJobId[job.GetId()]}
+		var preemptingJob interfaces.LegacySchedulerJob
+		for _, scheduledJob := range scheduledJobsByNodeId[sch.nodeIdByJobId[job.GetId()]] {
+			switch preemption.Reason {
+			case armadaevents.PreemptionReason_FairShare:
+				if preemptingJob == nil || (preemptingJob.GetQueue() == job.GetQueue() && scheduledJob.GetQueue() != job.GetQueue()) {
+					preemptingJob = scheduledJob
+				}
+			case armadaevents.PreemptionReason_Urgency:
+				if preemptingJob == nil && sch.priorityOf(scheduledJob) > sch.priorityOf(job) {
+					preemptingJob = scheduledJob
+				}
+			}
+		}
+		if preemptingJob != nil {
+			preemption.PreemptingJobId = preemptingJob.GetId()
+			preemption.PreemptingQueue = preemptingJob.GetQueue()
+		}
+		rv[job.GetId()] = preemption
+	}
+	return rv
+}
+
+// priorityOf returns the priority of the priority class of a job, or of the default priority class if the job's
+// priority class isn't known.
+func (sch *PreemptingQueueScheduler) priorityOf(job interfaces.LegacySchedulerJob) int32 {
+	priorityClass, ok := sch.schedulingContext.PriorityClasses[job.GetPriorityClassName()]
+	if !ok {
+		priorityClass = sch.schedulingContext.PriorityClasses[sch.schedulingContext.DefaultPriorityClass]
+	}
+	return priorityClass.Priority
+}
+
+// isProtectedFromFairSharePreemption returns true if job started running too recently to be preempted
+// for the purpose of balancing resources between queues.
+func (sch *PreemptingQueueScheduler) isProtectedFromFairSharePreemption(job interfaces.LegacySchedulerJob) bool {
+	if sch.minimumRuntimeBeforeFairSharePreemption <= 0 {
+		return false
+	}
+	started, ok := sch.runStartTimeByJobId[job.GetId()]
+	if !ok {
+		return false
+	}
+	if started.IsZero() {
+		// The job hasn't started running yet, e.g., since it's pending or pulling its image.
+		return true
+	}
+	return sch.schedulingContext.Started.Sub(started) < sch.minimumRuntimeBeforeFairSharePreemption
+}
+
+// selectJobsWithinJobSetDisruptionBudgets selects the running jobs of each job set with a disruption budget that may be
+// evicted to balance resources between queues.
+//
+// Only preempted jobs count against the budget, and evicted jobs re-scheduled in this round aren't preempted.
+// Since evicted jobs of a queue are re-scheduled in scheduling order, the jobs selected are those of the job set
+// re-scheduled last, and hence preempted first, up to the number of jobs the budget allows to be preempted.
+// If any of these are re-scheduled, evicting more jobs of the job set wouldn't lead to these being preempted either.
+func (sch *PreemptingQueueScheduler) selectJobsWithinJobSetDisruptionBudgets() error {
+	if !sch.enableJobSetDisruptionBudgets {
+		return nil
+	}
+	jobs, err := sch.jobRepo.GetExistingJobsByIds(maps.Keys(sch.nodeIdByJobId))
+	if err != nil {
+		return err
+	}
+	jobsByQueueAndJobSet := make(map[string]map[string][]interfaces.LegacySchedulerJob)
+	for _, job := range jobs {
+		if _, ok, err := jobSetMaxPreemptedFraction(job); err != nil || !ok {
+			continue
+		}
+		if sch.isProtectedFromFairSharePreemption(job) {
+			continue
+		}
+		if priorityClass, ok := sch.schedulingContext.PriorityClasses[job.GetPriorityClassName()]; !ok || !priorityClass.Preemptible {
+			continue
+		}
+		jobsByJobSet := jobsByQueueAndJobSet[job.GetQueue()]
+		if jobsByJobSet == nil {
+			jobsByJobSet = make(map[string][]interfaces.LegacySchedulerJob)
+			jobsByQueueAndJobSet[job.GetQueue()] = jobsByJobSet
+		}
+		jobsByJobSet[job.GetJobSet()] = append(jobsByJobSet[job.GetJobSet()], job)
+	}
+	sch.evictableJobIds = make(map[string]bool)
+	for queue, jobsByJobSet := range jobsByQueueAndJobSet {
+		for jobSet, jobs := range jobsByJobSet {
+			recentlyPreempted := sch.recentlyPreemptedByQueueAndJobSet[queue][jobSet]
+			running := sch.runningJobsByQueueAndJobSet[queue][jobSet]
+			// Jobs re-scheduled last first.
+			slices.SortFunc(jobs, func(a, b interfaces.LegacySchedulerJob) bool {
+				return a.SchedulingOrderCompare(b) == 1
+			})
+			selected := 0
+			for _, job := range jobs {
+				maxPreemptedFraction, _, _ := jobSetMaxPreemptedFraction(job)
+				maxPreempted := int(math.Floor(maxPreemptedFraction * float64(running+recentlyPreempted)))
+				if recentlyPreempted+selected >= maxPreempted {
+					continue
+				}
+				sch.evictableJobIds[job.GetId()] = true
+				selected++
+			}
+		}
+	}
+	return nil
+}
+
+// isWithinJobSetDisruptionBudget returns true if job may be evicted to balance resources between queues
+// without exceeding the disruption budget of its job set.
+func (sch *PreemptingQueueScheduler) isWithinJobSetDisruptionBudget(ctx *armadacontext.Context, job interfaces.LegacySchedulerJob) bool {
+	if !sch.enableJobSetDisruptionBudgets {
+		return true
+	}
+	_, ok, err := jobSetMaxPreemptedFraction(job)
+	if err != nil {
+		warnings.Errorf(ctx.WithField(logging.JobIdField, job.GetId()), "can't evict job: invalid annotation %s: %s", configuration.JobSetMaxPreemptedFractionAnnotation, err)
+		return false
+	}
+	if !ok {
+		return true
+	}
+	return sch.evictableJobIds[job.GetId()]
+}
+
 func (sch *PreemptingQueueScheduler) evict(ctx *armadacontext.Context, evictor *Evictor) (*EvictorResult, *InMemoryJobRepository, error) {
 	if evictor == nil {
 		return &EvictorResult{}, NewInMemoryJobRepository(), nil
@@ -547,7 +815,18 @@ func (sch *PreemptingQueueScheduler) schedule(ctx *armadacontext.Context, inMemo
 			if err != nil {
 				return nil, err
 			}
-			jobIteratorByQueue[qctx.Queue] = NewMultiJobsIterator(evictedIt, queueIt)
+			// Only queued jobs are reordered between users. Evicted jobs are rescheduled in their original order,
+			// such that user fair share never causes jobs to be preempted within a queue.
+			var it JobIterator = queueIt
+			if sch.enableUserFairShare {
+				it = NewUserFairJobIterator(
+					queueIt,
+					qctx,
+					sch.schedulingContext.FairnessCostProvider,
+					int(sch.userFairShareLookahead),
+				)
+			}
+			jobIteratorByQueue[qctx.Queue] = NewMultiJobsIterator(evictedIt, it)
 		}
 	}
 
@@ -738,6 +1017,74 @@ func NewNodeEvictor(
 	}
 }
 
+// newDrainEvictor returns an evictor that evicts jobs running on nodes marked for maintenance.
+// Jobs are selected in order of increasing priority, with more recently submitted jobs selected first among jobs of equal priority,
+// and at most sch.maxJobsToDrainPerRound jobs are selected (if non-zero).
+// Nodes marked for maintenance are recorded in the scheduling context.
+func (sch *PreemptingQueueScheduler) newDrainEvictor(ctx *armadacontext.Context) (*Evictor, error) {
+	it, err := nodedb.NewNodesIterator(sch.nodeDb.Txn(false))
+	if err != nil {
+		return nil, err
+	}
+	var candidates []interfaces.LegacySchedulerJob
+	nodeIdByJobId := make(map[string]string)
+	for node := it.NextNode(); node != nil; node = it.NextNode() {
+		if !nodedb.IsMarkedForMaintenance(node.Labels, sch.nodeMaintenanceLabel) {
+			continue
+		}
+		sch.schedulingContext.MarkNodeForMaintenance(node.Id)
+		jobIds := make([]string, 0, len(node.AllocatedByJobId))
+		for jobId := range node.AllocatedByJobId {
+			if _, ok := node.EvictedJobRunIds[jobId]; !ok {
+				jobIds = append(jobIds, jobId)
+			}
+		}
+		jobs, err := sch.jobRepo.GetExistingJobsByIds(jobIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range jobs {
+			nodeIdByJobId[job.GetId()] = node.Id
+		}
+		candidates = append(candidates, jobs...)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	priorityByJobId := make(map[string]int32, len(candidates))
+	for _, job := range candidates {
+		priorityByJobId[job.GetId()] = sch.priorityOf(job)
+	}
+	slices.SortFunc(candidates, func(a, b interfaces.LegacySchedulerJob) bool {
+		if priorityA, priorityB := priorityByJobId[a.GetId()], priorityByJobId[b.GetId()]; priorityA != priorityB {
+			return priorityA < priorityB
+		}
+		if submitTimeA, submitTimeB := a.GetSubmitTime(), b.GetSubmitTime(); !submitTimeA.Equal(submitTimeB) {
+			return submitTimeA.After(submitTimeB)
+		}
+		return a.GetId() < b.GetId()
+	})
+	if sch.maxJobsToDrainPerRound > 0 && uint(len(candidates)) > sch.maxJobsToDrainPerRound {
+		ctx.Infof(
+			"draining %d out of %d jobs on nodes marked for maintenance",
+			sch.maxJobsToDrainPerRound, len(candidates),
+		)
+		candidates = candidates[:sch.maxJobsToDrainPerRound]
+	}
+	jobIdsToEvict := make(map[string]bool, len(candidates))
+	nodeIdsToEvict := make(map[string]bool)
+	for _, job := range candidates {
+		jobIdsToEvict[job.GetId()] = true
+		nodeIdsToEvict[nodeIdByJobId[job.GetId()]] = true
+	}
+	return NewFilteredEvictor(
+		sch.jobRepo,
+		sch.schedulingContext.PriorityClasses,
+		nodeIdsToEvict,
+		jobIdsToEvict,
+	), nil
+}
+
 // NewFilteredEvictor returns a new evictor that evicts all jobs for which jobIdsToEvict[jobId] is true
 // on nodes for which nodeIdsToEvict[nodeId] is true.
 func NewFilteredEvictor(
@@ -804,7 +1151,7 @@ func NewOversubscribedEvictor(
 		},
 		jobFilter: func(ctx *armadacontext.Context, job interfaces.LegacySchedulerJob) bool {
 			if job.GetAnnotations() == nil {
-				ctx.Warnf("can't evict job %s: annotations not initialised", job.GetId())
+				warnings.Warnf(ctx.WithField(logging.JobIdField, job.GetId()), "can't evict job: annotations not initialised")
 				return false
 			}
 			priorityClassName := job.GetPriorityClassName()
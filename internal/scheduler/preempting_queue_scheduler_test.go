@@ -28,6 +28,7 @@ import (
 	"github.com/armadaproject/armada/internal/scheduler/nodedb"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
 func TestEvictOversubscribed(t *testing.T) {
@@ -90,6 +91,8 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 		IndicesToUnbind map[string]map[int][]int
 		// Indices of nodes that should be cordoned before scheduling.
 		NodeIndicesToCordon []int
+		// Indices of nodes that should be marked for maintenance before scheduling.
+		NodeIndicesToDrain []int
 	}
 	tests := map[string]struct {
 		SchedulingConfig configuration.SchedulingConfig
@@ -1240,6 +1243,50 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 				"B": 1,
 			},
 		},
+		"Draining preempts jobs on nodes marked for maintenance in order of increasing priority": {
+			SchedulingConfig: testfixtures.WithNodeDrainConfig(
+				"example.com/maintenance",
+				2,
+				testfixtures.TestSchedulingConfig(),
+			),
+			Nodes: testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": armadaslices.Concatenate(
+							testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass1, 2),
+							testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 2),
+						),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 3),
+					},
+				},
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass1, 1),
+					},
+					NodeIndicesToDrain: []int{0},
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(2, 3),
+						},
+					},
+				},
+				{
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(0, 1),
+						},
+					},
+				},
+				{}, // Empty round to make sure nothing changes.
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+				"B": 1,
+			},
+		},
 		"ProtectedFractionOfFairShare": {
 			SchedulingConfig: testfixtures.WithProtectedFractionOfFairShareConfig(
 				1.0,
@@ -1276,6 +1323,156 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 				"C": 1,
 			},
 		},
+		"MinimumRuntimeBeforeFairSharePreemption": {
+			SchedulingConfig: testfixtures.WithMinimumRuntimeBeforeFairSharePreemptionConfig(
+				2*time.Second,
+				testfixtures.TestSchedulingConfig(),
+			),
+			Nodes: testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 32),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 31),
+					},
+				},
+				{
+					// The jobs of A have been running for less than the minimum runtime and are hence protected.
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 32),
+					},
+				},
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 32),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"B": testfixtures.IntRange(0, 15),
+					},
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(16, 31),
+						},
+					},
+				},
+				{}, // Empty round to make sure nothing changes.
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+				"B": 1,
+			},
+		},
+		"JobSetMaxPreemptedFraction": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": append(
+							testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 16),
+							testfixtures.WithAnnotationsJobs(
+								map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "0"},
+								testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 16),
+							)...,
+						),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 31),
+					},
+				},
+				{
+					// The jobs of A with a zero budget aren't preempted, even though they were submitted last.
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 32),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"B": testfixtures.IntRange(0, 15),
+					},
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(0, 15),
+						},
+					},
+				},
+				{}, // Empty round to make sure nothing changes.
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+				"B": 1,
+			},
+		},
+		"JobSetMaxPreemptedFraction preempts the jobs scheduled last": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": testfixtures.WithAnnotationsJobs(
+							map[string]string{configuration.JobSetMaxPreemptedFractionAnnotation: "0.25"},
+							testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 32),
+						),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 31),
+					},
+				},
+				{
+					// At most a quarter of the jobs of A are preempted, and B gets the resources freed by those.
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 32),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"B": testfixtures.IntRange(0, 7),
+					},
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(24, 31),
+						},
+					},
+				},
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+				"B": 1,
+			},
+		},
+		"MinimumRuntimeBeforeFairSharePreemption doesn't prevent urgency-based preemption": {
+			SchedulingConfig: testfixtures.WithMinimumRuntimeBeforeFairSharePreemptionConfig(
+				time.Hour,
+				testfixtures.TestSchedulingConfig(),
+			),
+			Nodes: testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass2, 32),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 31),
+					},
+				},
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass3, 1),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"B": testfixtures.IntRange(0, 0),
+					},
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(31, 31),
+						},
+					},
+				},
+				{}, // Empty round to make sure nothing changes.
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+				"B": 1,
+			},
+		},
 		"ProtectedFractionOfFairShare at limit": {
 			SchedulingConfig: testfixtures.WithProtectedFractionOfFairShareConfig(
 				0.5,
@@ -1511,6 +1708,9 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 			nodeIdByJobId := make(map[string]string)
 			var jobIdsByGangId map[string]map[string]bool
 			var gangIdByJobId map[string]string
+			runStartTimeByJobId := make(map[string]time.Time)
+			runningJobsByQueueAndJobSet := make(map[string]map[string]int)
+			jobSetPreemptionHistory := newJobSetPreemptionHistory(time.Hour)
 
 			// Scheduling rate-limiters persist between rounds.
 			// We control the rate at which time passes between scheduling rounds.
@@ -1531,7 +1731,7 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 			// Run the scheduler.
 			ctx := armadacontext.Background()
 			for i, round := range tc.Rounds {
-				ctx.FieldLogger = ctx.WithField("round", i)
+				ctx := armadacontext.WithLogField(ctx, "round", i)
 				ctx.Infof("starting scheduling round %d", i)
 
 				// Enqueue jobs that should be considered in this round.
@@ -1559,6 +1759,7 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 							require.NoError(t, err)
 							err = nodeDb.Upsert(node)
 							require.NoError(t, err)
+							runningJobsByQueueAndJobSet[job.GetQueue()][job.GetJobSet()]--
 							if gangId, ok := gangIdByJobId[job.GetId()]; ok {
 								delete(gangIdByJobId, job.GetId())
 								delete(jobIdsByGangId[gangId], job.GetId())
@@ -1577,6 +1778,18 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 					require.NoError(t, err)
 				}
 
+				// Mark nodes for maintenance.
+				for _, j := range round.NodeIndicesToDrain {
+					node, err := nodeDb.GetNode(tc.Nodes[j].Id)
+					require.NoError(t, err)
+					node = node.UnsafeCopy()
+					node.Labels = maps.Clone(node.Labels)
+					node.Labels[tc.SchedulingConfig.NodeMaintenanceLabel] = "true"
+					node.Taints = append(slices.Clone(node.Taints), nodedb.UnschedulableTaint())
+					err = nodeDb.Upsert(node)
+					require.NoError(t, err)
+				}
+
 				// If not provided, set total resources equal to the aggregate over tc.Nodes.
 				if tc.TotalResources.Resources == nil {
 					tc.TotalResources = nodeDb.TotalResources()
@@ -1607,7 +1820,9 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 						limiterByQueue[queue],
 					)
 					require.NoError(t, err)
-
+					if runningJobsByJobSet := runningJobsByQueueAndJobSet[queue]; runningJobsByJobSet != nil {
+						sctx.QueueSchedulingContexts[queue].RunningJobsByJobSet = maps.Clone(runningJobsByJobSet)
+					}
 				}
 				constraints := schedulerconstraints.SchedulingConstraintsFromSchedulingConfig(
 					"pool",
@@ -1631,8 +1846,30 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 				if tc.SchedulingConfig.EnableNewPreemptionStrategy {
 					sch.EnableNewPreemptionStrategy()
 				}
+				if tc.SchedulingConfig.NodeMaintenanceLabel != "" {
+					sch.EnableNodeDrain(tc.SchedulingConfig.NodeMaintenanceLabel, tc.SchedulingConfig.MaxJobsToDrainPerRound)
+				}
+				if tc.SchedulingConfig.Preemption.MinimumRuntimeBeforeFairSharePreemption > 0 {
+					sch.EnableFairSharePreemptionProtection(tc.SchedulingConfig.Preemption.MinimumRuntimeBeforeFairSharePreemption, runStartTimeByJobId)
+				}
+				sch.EnableJobSetDisruptionBudgets(jobSetPreemptionHistory.CountByQueueAndJobSet())
 				result, err := sch.Schedule(ctx)
 				require.NoError(t, err)
+				assert.Contains(t, sctx.DurationByPhase, PhaseBalancingEviction)
+				assert.Contains(t, sctx.DurationByPhase, PhaseBalancingScheduling)
+				assert.Contains(t, sctx.DurationByPhase, PhaseOversubscribedEviction)
+				jobSetPreemptionHistory.Record(result.PreemptedJobs, sctx.Started)
+				for _, job := range result.PreemptedJobs {
+					delete(runStartTimeByJobId, job.GetId())
+					runningJobsByQueueAndJobSet[job.GetQueue()][job.GetJobSet()]--
+				}
+				for _, job := range result.ScheduledJobs {
+					runStartTimeByJobId[job.GetId()] = sctx.Started
+					if runningJobsByQueueAndJobSet[job.GetQueue()] == nil {
+						runningJobsByQueueAndJobSet[job.GetQueue()] = make(map[string]int)
+					}
+					runningJobsByQueueAndJobSet[job.GetQueue()][job.GetJobSet()]++
+				}
 				jobIdsByGangId = sch.jobIdsByGangId
 				gangIdByJobId = sch.gangIdByJobId
 
@@ -1692,6 +1929,23 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 					}
 				}
 
+				// Test that each preempted job is attributed a reason and, if any, a job scheduled onto the same node.
+				scheduledJobsById := armadaslices.GroupByFuncUnique(result.ScheduledJobs, func(job interfaces.LegacySchedulerJob) string { return job.GetId() })
+				for _, job := range result.PreemptedJobs {
+					preemption, ok := result.PreemptionByJobId[job.GetId()]
+					if !assert.True(t, ok, "no preemption reason for job %s", job.GetId()) {
+						continue
+					}
+					assert.NotEqual(t, armadaevents.PreemptionReason_UnknownPreemptionReason, preemption.Reason)
+					if preemption.PreemptingJobId != "" {
+						preemptingJob, ok := scheduledJobsById[preemption.PreemptingJobId]
+						if assert.True(t, ok, "job %s preempted by unscheduled job %s", job.GetId(), preemption.PreemptingJobId) {
+							assert.Equal(t, preemptingJob.GetQueue(), preemption.PreemptingQueue)
+							assert.Equal(t, result.NodeIdByJobId[job.GetId()], result.NodeIdByJobId[preemptingJob.GetId()])
+						}
+					}
+				}
+
 				// Expected scheduled jobs.
 				jobIdsByQueue := jobIdsByQueueFromJobs(result.ScheduledJobs)
 				scheduledQueues := armadamaps.MapValues(round.ExpectedScheduledIndices, func(v []int) bool { return true })
@@ -1878,7 +2132,7 @@ func BenchmarkPreemptingQueueScheduler(b *testing.B) {
 	for name, tc := range tests {
 		b.Run(name, func(b *testing.B) {
 			ctx := armadacontext.Background()
-			ctx.FieldLogger = logging.NullLogger
+			ctx.Logger = logging.NullLogger
 
 			jobsByQueue := make(map[string][]*jobdb.Job)
 			priorityFactorByQueue := make(map[string]float64)
@@ -35,6 +35,48 @@ func (s *ProxyingSchedulingReportsServer) GetJobReport(ctx context.Context, requ
 	return s.client.GetJobReport(ctx, request)
 }
 
+func (s *ProxyingSchedulingReportsServer) GetQueueEntitlement(ctx context.Context, request *schedulerobjects.QueueEntitlementRequest) (*schedulerobjects.QueueEntitlementReport, error) {
+	ctx, cancel := reduceTimeout(ctx)
+	defer cancel()
+	return s.client.GetQueueEntitlement(ctx, request)
+}
+
+func (s *ProxyingSchedulingReportsServer) GetDuplicateJobsReport(ctx context.Context, request *schedulerobjects.DuplicateJobsReportRequest) (*schedulerobjects.DuplicateJobsReport, error) {
+	ctx, cancel := reduceTimeout(ctx)
+	defer cancel()
+	return s.client.GetDuplicateJobsReport(ctx, request)
+}
+
+func (s *ProxyingSchedulingReportsServer) GetSchedulingContextSnapshots(ctx context.Context, request *schedulerobjects.SchedulingContextSnapshotRequest) (*schedulerobjects.SchedulingContextSnapshots, error) {
+	ctx, cancel := reduceTimeout(ctx)
+	defer cancel()
+	return s.client.GetSchedulingContextSnapshots(ctx, request)
+}
+
+func (s *ProxyingSchedulingReportsServer) GetQueueUtilisation(ctx context.Context, request *schedulerobjects.QueueUtilisationRequest) (*schedulerobjects.QueueUtilisationReport, error) {
+	ctx, cancel := reduceTimeout(ctx)
+	defer cancel()
+	return s.client.GetQueueUtilisation(ctx, request)
+}
+
+func (s *ProxyingSchedulingReportsServer) GetPendingCapacity(ctx context.Context, request *schedulerobjects.PendingCapacityRequest) (*schedulerobjects.PendingCapacityReport, error) {
+	ctx, cancel := reduceTimeout(ctx)
+	defer cancel()
+	return s.client.GetPendingCapacity(ctx, request)
+}
+
+func (s *ProxyingSchedulingReportsServer) GetFairnessWhatIf(ctx context.Context, request *schedulerobjects.FairnessWhatIfRequest) (*schedulerobjects.FairnessWhatIfReport, error) {
+	ctx, cancel := reduceTimeout(ctx)
+	defer cancel()
+	return s.client.GetFairnessWhatIf(ctx, request)
+}
+
+func (s *ProxyingSchedulingReportsServer) GetNodeTypes(ctx context.Context, request *schedulerobjects.NodeTypesRequest) (*schedulerobjects.NodeTypesReport, error) {
+	ctx, cancel := reduceTimeout(ctx)
+	defer cancel()
+	return s.client.GetNodeTypes(ctx, request)
+}
+
 // We reduce the context deadline here, to prevent our call and the caller who called us from timing out at the same time
 // This should mean our caller gets the real error message rather than a generic timeout error from client side
 func reduceTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
@@ -15,6 +15,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
@@ -85,16 +86,19 @@ func (p *PulsarPublisher) PublishMessages(ctx *armadacontext.Context, events []*
 	}
 	msgs := make([]*pulsar.ProducerMessage, len(sequences))
 	for i, sequence := range sequences {
-		bytes, err := proto.Marshal(sequence)
+		bytes, err := eventutil.MarshalEventSequence(sequence)
 		if err != nil {
 			return err
 		}
+		properties := map[string]string{
+			schedulers.PropertyName: schedulers.PulsarSchedulerAttribute,
+		}
+		// Pass the context of the trace of the scheduling cycle, such that consumers can continue the trace.
+		tracing.AddToProperties(ctx, properties)
 		msgs[i] = &pulsar.ProducerMessage{
-			Payload: bytes,
-			Key:     sequences[i].JobSetName,
-			Properties: map[string]string{
-				schedulers.PropertyName: schedulers.PulsarSchedulerAttribute,
-			},
+			Payload:    bytes,
+			Key:        sequences[i].JobSetName,
+			Properties: properties,
 		}
 	}
 
@@ -128,6 +132,12 @@ func (p *PulsarPublisher) PublishMessages(ctx *armadacontext.Context, events []*
 	return nil
 }
 
+// Close flushes any messages not yet sent to Pulsar and closes the producer.
+func (p *PulsarPublisher) Close() error {
+	defer p.producer.Close()
+	return errors.WithStack(p.producer.Flush())
+}
+
 // PublishMarkers sends one pulsar message (containing an armadaevents.PartitionMarker) to each partition
 // of the producer's Pulsar topic.
 func (p *PulsarPublisher) PublishMarkers(ctx *armadacontext.Context, groupId uuid.UUID) (uint32, error) {
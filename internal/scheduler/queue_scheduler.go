@@ -213,6 +213,7 @@ func (it *QueuedGangIterator) Peek() (*schedulercontext.GangSchedulingContext, e
 					// set the unschedulable reason and pctx equal to that of unsuccessfulJctx.
 					jctx.UnschedulableReason = unsuccessfulJctx.UnschedulableReason
 					jctx.PodSchedulingContext = unsuccessfulJctx.PodSchedulingContext
+					it.schedulingContext.NumUnfeasibleSchedulingKeyHits++
 					if _, err := it.schedulingContext.AddJobSchedulingContext(jctx); err != nil {
 						return nil, err
 					}
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"text/tabwriter"
+	"time"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/oklog/ulid"
@@ -14,12 +15,42 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	schedulerconstraints "github.com/armadaproject/armada/internal/scheduler/constraints"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 )
 
+// SchedulingReportsServer serves the reports computed from recent scheduling contexts together with the duplicate jobs,
+// pending capacity, and node type reports.
+type SchedulingReportsServer struct {
+	*SchedulingContextRepository
+	// If nil, duplicate job detection is disabled and requests for the duplicate jobs report return an error.
+	*DuplicateJobDetector
+	// If nil, requests for the pending capacity report return an error.
+	*PendingCapacityReporter
+	// If nil, requests for the node type report return an error.
+	*NodeTypeReporter
+}
+
+func NewSchedulingReportsServer(
+	schedulingContextRepository *SchedulingContextRepository,
+	duplicateJobDetector *DuplicateJobDetector,
+	pendingCapacityReporter *PendingCapacityReporter,
+	nodeTypeReporter *NodeTypeReporter,
+) *SchedulingReportsServer {
+	return &SchedulingReportsServer{
+		SchedulingContextRepository: schedulingContextRepository,
+		DuplicateJobDetector:        duplicateJobDetector,
+		PendingCapacityReporter:     pendingCapacityReporter,
+		NodeTypeReporter:            nodeTypeReporter,
+	}
+}
+
 // SchedulingContextRepository stores scheduling contexts associated with recent scheduling attempts.
 // On adding a context, a map is cloned, then mutated, and then swapped for the previous map using atomic pointers.
 // Hence, reads concurrent with writes are safe and don't need locking.
@@ -51,13 +82,16 @@ type SchedulingContextRepository struct {
 	// All executors in sorted order.
 	sortedExecutorIds atomic.Pointer[[]string]
 
+	// Used to compute the scheduling constraints reported as part of queue entitlements.
+	schedulingConfig configuration.SchedulingConfig
+
 	// Protects the fields in this struct from concurrent and dirty writes.
 	mu sync.Mutex
 }
 
 type SchedulingContextByExecutor map[string]*schedulercontext.SchedulingContext
 
-func NewSchedulingContextRepository(jobCacheSize uint) (*SchedulingContextRepository, error) {
+func NewSchedulingContextRepository(jobCacheSize uint, schedulingConfig configuration.SchedulingConfig) (*SchedulingContextRepository, error) {
 	mostRecentByExecutorByJobId, err := lru.New(int(jobCacheSize))
 	if err != nil {
 		return nil, err
@@ -65,6 +99,7 @@ func NewSchedulingContextRepository(jobCacheSize uint) (*SchedulingContextReposi
 	rv := &SchedulingContextRepository{
 		mostRecentByExecutorByJobId: mostRecentByExecutorByJobId,
 		executorIds:                 make(map[string]bool),
+		schedulingConfig:            schedulingConfig,
 	}
 
 	mostRecentByExecutor := make(SchedulingContextByExecutor)
@@ -389,7 +424,7 @@ func (sr schedulingReport) ReportString(verbosity int32) string {
 	}
 	if jctx := sr.jobSchedulingContext; jctx != nil {
 		fmt.Fprintf(w, "Scheduling report for job %s:\n", jctx.JobId)
-		fmt.Fprint(w, indent.String("\t", jctx.String()))
+		fmt.Fprint(w, indent.String("\t", jctx.ReportString(verbosity)))
 	}
 	w.Flush()
 	return sb.String()
@@ -501,6 +536,179 @@ func (repo *SchedulingContextRepository) getJobReportString(jobId string) string
 	return sb.String()
 }
 
+// GetSchedulingContextSnapshots is a gRPC endpoint for querying structured snapshots of the most recent scheduling round
+// for each executor, or for a single executor if one is provided.
+func (repo *SchedulingContextRepository) GetSchedulingContextSnapshots(_ context.Context, request *schedulerobjects.SchedulingContextSnapshotRequest) (*schedulerobjects.SchedulingContextSnapshots, error) {
+	executorId := strings.TrimSpace(request.GetExecutorId())
+	mostRecentByExecutor := repo.GetMostRecentSchedulingContextByExecutor()
+	executorIds := repo.GetSortedExecutorIds()
+	if executorId != "" {
+		if _, ok := mostRecentByExecutor[executorId]; !ok {
+			return nil, &armadaerrors.ErrNotFound{
+				Type:    "executor",
+				Value:   executorId,
+				Message: "no recent scheduling round for this executor",
+			}
+		}
+		executorIds = []string{executorId}
+	}
+	rv := &schedulerobjects.SchedulingContextSnapshots{
+		Snapshots: make([]*schedulerobjects.SchedulingContextSnapshot, 0, len(executorIds)),
+	}
+	for _, executorId := range executorIds {
+		if sctx := mostRecentByExecutor[executorId]; sctx != nil {
+			rv.Snapshots = append(rv.Snapshots, sctx.Snapshot())
+		}
+	}
+	return rv, nil
+}
+
+// GetQueueEntitlement is a gRPC endpoint for querying the entitlement of a queue,
+// computed from the most recent scheduling round that considered the queue in each pool.
+func (repo *SchedulingContextRepository) GetQueueEntitlement(_ context.Context, request *schedulerobjects.QueueEntitlementRequest) (*schedulerobjects.QueueEntitlementReport, error) {
+	queueName := strings.TrimSpace(request.GetQueueName())
+	if queueName == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    "queueName",
+			Value:   request.GetQueueName(),
+			Message: "queue name must not be empty",
+		}
+	}
+	pool := strings.TrimSpace(request.GetPool())
+
+	// If there are several executors in a pool, use the most recent scheduling round across those.
+	mostRecentByExecutor, _ := repo.GetMostRecentSchedulingContextByExecutorForQueue(queueName)
+	mostRecentByPool := make(map[string]*schedulercontext.SchedulingContext)
+	for _, sctx := range mostRecentByExecutor {
+		if pool != "" && sctx.Pool != pool {
+			continue
+		}
+		if previous := mostRecentByPool[sctx.Pool]; previous == nil || sctx.Finished.After(previous.Finished) {
+			mostRecentByPool[sctx.Pool] = sctx
+		}
+	}
+	if len(mostRecentByPool) == 0 {
+		return nil, &armadaerrors.ErrNotFound{
+			Type:    "queue",
+			Value:   queueName,
+			Message: "no recent scheduling round considered this queue",
+		}
+	}
+
+	pools := maps.Keys(mostRecentByPool)
+	slices.Sort(pools)
+	entitlements := make([]*schedulerobjects.QueueEntitlement, len(pools))
+	for i, pool := range pools {
+		entitlements[i] = repo.queueEntitlementFromSchedulingContext(mostRecentByPool[pool], queueName)
+	}
+	return &schedulerobjects.QueueEntitlementReport{Entitlements: entitlements}, nil
+}
+
+func (repo *SchedulingContextRepository) queueEntitlementFromSchedulingContext(sctx *schedulercontext.SchedulingContext, queue string) *schedulerobjects.QueueEntitlement {
+	qctx := sctx.QueueSchedulingContexts[queue]
+	constraints := schedulerconstraints.SchedulingConstraintsFromSchedulingConfig(
+		sctx.Pool,
+		sctx.TotalResources,
+		schedulerobjects.ResourceList{},
+		repo.schedulingConfig,
+	)
+	entitlement := &schedulerobjects.QueueEntitlement{
+		QueueName:                queue,
+		Pool:                     sctx.Pool,
+		Time:                     sctx.Finished,
+		Weight:                   qctx.Weight,
+		TotalResources:           sctx.TotalResources.DeepCopy(),
+		Allocated:                qctx.Allocated.DeepCopy(),
+		AllocatedByPriorityClass: make(map[string]schedulerobjects.ResourceList, len(qctx.AllocatedByPriorityClass)),
+		RemainingByPriorityClass: make(map[string]schedulerobjects.ResourceList, len(constraints.PriorityClassSchedulingConstraintsByPriorityClassName)),
+		MaximumResourcesPerRound: constraints.MaximumResourcesToSchedule.DeepCopy(),
+		GlobalRateLimit:          rateLimitStateFromLimiter(sctx.Limiter),
+		QueueRateLimit:           rateLimitStateFromLimiter(qctx.Limiter),
+	}
+	if sctx.WeightSum > 0 {
+		entitlement.FairShare = qctx.Weight / sctx.WeightSum
+	}
+	// The fraction of the pool allocated to the queue, regardless of its weight.
+	entitlement.ActualShare = unweightedShare(sctx, qctx.Allocated)
+	for priorityClassName, allocated := range qctx.AllocatedByPriorityClass {
+		entitlement.AllocatedByPriorityClass[priorityClassName] = allocated.DeepCopy()
+	}
+	for priorityClassName, priorityClassConstraints := range constraints.PriorityClassSchedulingConstraintsByPriorityClassName {
+		remaining := priorityClassConstraints.MaximumResourcesPerQueue.DeepCopy()
+		allocated := qctx.AllocatedByPriorityClass[priorityClassName]
+		for t, q := range remaining.Resources {
+			q.Sub(allocated.Get(t))
+			if q.Sign() < 0 {
+				// Queues may exceed their limit, e.g., if the limit was lowered; report no headroom in that case.
+				q = resource.Quantity{}
+			}
+			remaining.Resources[t] = q
+		}
+		entitlement.RemainingByPriorityClass[priorityClassName] = remaining
+	}
+	return entitlement
+}
+
+func rateLimitStateFromLimiter(limiter *rate.Limiter) *schedulerobjects.RateLimitState {
+	if limiter == nil {
+		return nil
+	}
+	return &schedulerobjects.RateLimitState{
+		Rate:   float64(limiter.Limit()),
+		Burst:  int32(limiter.Burst()),
+		Tokens: limiter.TokensAt(time.Now()),
+	}
+}
+
+// GetQueueUtilisation is a gRPC endpoint for querying the utilisation of each pool by each queue,
+// computed from the most recent scheduling round in each pool.
+func (repo *SchedulingContextRepository) GetQueueUtilisation(_ context.Context, request *schedulerobjects.QueueUtilisationRequest) (*schedulerobjects.QueueUtilisationReport, error) {
+	pool := strings.TrimSpace(request.GetPool())
+
+	// If there are several executors in a pool, use the most recent scheduling round across those.
+	mostRecentByPool := make(map[string]*schedulercontext.SchedulingContext)
+	for _, sctx := range repo.GetMostRecentSchedulingContextByExecutor() {
+		if pool != "" && sctx.Pool != pool {
+			continue
+		}
+		if previous := mostRecentByPool[sctx.Pool]; previous == nil || sctx.Finished.After(previous.Finished) {
+			mostRecentByPool[sctx.Pool] = sctx
+		}
+	}
+	if pool != "" && len(mostRecentByPool) == 0 {
+		return nil, &armadaerrors.ErrNotFound{
+			Type:    "pool",
+			Value:   pool,
+			Message: "no recent scheduling round for this pool",
+		}
+	}
+
+	pools := maps.Keys(mostRecentByPool)
+	slices.Sort(pools)
+	rv := &schedulerobjects.QueueUtilisationReport{}
+	for _, pool := range pools {
+		sctx := mostRecentByPool[pool]
+		queues := maps.Keys(sctx.QueueSchedulingContexts)
+		slices.Sort(queues)
+		for _, queue := range queues {
+			qctx := sctx.QueueSchedulingContexts[queue]
+			utilisation := &schedulerobjects.QueueUtilisation{
+				QueueName:     queue,
+				Pool:          pool,
+				Time:          sctx.Finished,
+				Allocated:     qctx.Allocated.DeepCopy(),
+				NumQueuedJobs: int32(qctx.NumQueuedJobs),
+			}
+			if sctx.WeightSum > 0 {
+				utilisation.FairShare = qctx.Weight / sctx.WeightSum
+			}
+			utilisation.ActualShare = unweightedShare(sctx, qctx.Allocated)
+			rv.Queues = append(rv.Queues, utilisation)
+		}
+	}
+	return rv, nil
+}
+
 func (repo *SchedulingContextRepository) GetMostRecentSchedulingContextByExecutor() SchedulingContextByExecutor {
 	return *repo.mostRecentByExecutor.Load()
 }
@@ -538,6 +746,26 @@ func (repo *SchedulingContextRepository) GetMostRecentSchedulingContextByExecuto
 	return nil, false
 }
 
+// MostRecentSchedulingContextSummaries returns, for each executor, a summary of the most recent scheduling round
+// and of the most recent round in which jobs were preempted. Intended to be registered with the diagnostics server.
+func (repo *SchedulingContextRepository) MostRecentSchedulingContextSummaries() interface{} {
+	type summaries struct {
+		MostRecent           map[string]schedulercontext.SchedulingContextSummary
+		MostRecentPreempting map[string]schedulercontext.SchedulingContextSummary
+	}
+	summarise := func(m SchedulingContextByExecutor) map[string]schedulercontext.SchedulingContextSummary {
+		rv := make(map[string]schedulercontext.SchedulingContextSummary, len(m))
+		for executorId, sctx := range m {
+			rv[executorId] = sctx.Summary()
+		}
+		return rv
+	}
+	return summaries{
+		MostRecent:           summarise(repo.GetMostRecentSchedulingContextByExecutor()),
+		MostRecentPreempting: summarise(repo.GetMostRecentPreemptingSchedulingContextByExecutor()),
+	}
+}
+
 func (repo *SchedulingContextRepository) GetSortedExecutorIds() []string {
 	return *repo.sortedExecutorIds.Load()
 }
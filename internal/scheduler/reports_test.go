@@ -7,16 +7,20 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/util"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/fairness"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
 )
 
 func TestAddGetSchedulingContext(t *testing.T) {
-	repo, err := NewSchedulingContextRepository(10)
+	repo, err := NewSchedulingContextRepository(10, testfixtures.TestSchedulingConfig())
 	require.NoError(t, err)
 
 	sctx := testSchedulingContext("foo")
@@ -157,7 +161,7 @@ func TestAddGetSchedulingContext(t *testing.T) {
 
 // Concurrently write/read to/from the repo to test that there are no panics.
 func TestTestAddGetSchedulingContextConcurrency(t *testing.T) {
-	repo, err := NewSchedulingContextRepository(10)
+	repo, err := NewSchedulingContextRepository(10, testfixtures.TestSchedulingConfig())
 	require.NoError(t, err)
 	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), time.Second)
 	defer cancel()
@@ -198,7 +202,7 @@ func TestTestAddGetSchedulingContextConcurrency(t *testing.T) {
 }
 
 func TestReportDoesNotExist(t *testing.T) {
-	repo, err := NewSchedulingContextRepository(1024)
+	repo, err := NewSchedulingContextRepository(1024, testfixtures.TestSchedulingConfig())
 	require.NoError(t, err)
 	err = repo.AddSchedulingContext(testSchedulingContext("executor-01"))
 	require.NoError(t, err)
@@ -240,6 +244,77 @@ func TestReportDoesNotExist(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetQueueEntitlement(t *testing.T) {
+	config := testfixtures.WithPerPriorityLimitsConfig(
+		map[string]map[string]float64{testfixtures.PriorityClass0: {"cpu": 0.5}},
+		testfixtures.WithRoundLimitsConfig(
+			map[string]float64{"cpu": 0.2},
+			testfixtures.TestSchedulingConfig(),
+		),
+	)
+	repo, err := NewSchedulingContextRepository(1024, config)
+	require.NoError(t, err)
+
+	totalResources := schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("10")}}
+	fairnessCostProvider, err := fairness.NewDominantResourceFairness(totalResources, []string{"cpu"})
+	require.NoError(t, err)
+	sctx := schedulercontext.NewSchedulingContext(
+		"executor",
+		"pool",
+		config.Preemption.PriorityClasses,
+		config.Preemption.DefaultPriorityClass,
+		fairnessCostProvider,
+		rate.NewLimiter(10, 100),
+		totalResources,
+	)
+	allocated := schedulerobjects.QuantityByTAndResourceType[string]{
+		testfixtures.PriorityClass0: schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("3")}},
+	}
+	require.NoError(t, sctx.AddQueueSchedulingContext("A", 1, allocated, rate.NewLimiter(1, 10)))
+	require.NoError(t, sctx.AddQueueSchedulingContext("B", 3, allocated, rate.NewLimiter(1, 10)))
+	require.NoError(t, repo.AddSchedulingContext(sctx))
+	ctx := armadacontext.Background()
+
+	report, err := repo.GetQueueEntitlement(ctx, &schedulerobjects.QueueEntitlementRequest{QueueName: "A"})
+	require.NoError(t, err)
+	require.Len(t, report.Entitlements, 1)
+	entitlement := report.Entitlements[0]
+	assert.Equal(t, "A", entitlement.QueueName)
+	assert.Equal(t, "pool", entitlement.Pool)
+	assert.Equal(t, 1.0, entitlement.Weight)
+	assert.Equal(t, 0.25, entitlement.FairShare)
+	assert.InDelta(t, 0.3, entitlement.ActualShare, 1e-9)
+	assert.True(t, totalResources.Equal(entitlement.TotalResources))
+	assert.True(t, allocated[testfixtures.PriorityClass0].Equal(entitlement.Allocated))
+	assert.True(t, schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("2")}}.Equal(entitlement.MaximumResourcesPerRound))
+	assert.True(
+		t,
+		schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("2")}}.Equal(
+			entitlement.RemainingByPriorityClass[testfixtures.PriorityClass0],
+		),
+	)
+	assert.Equal(t, 10.0, entitlement.GlobalRateLimit.Rate)
+	assert.Equal(t, int32(100), entitlement.GlobalRateLimit.Burst)
+	assert.Equal(t, 1.0, entitlement.QueueRateLimit.Rate)
+	assert.Equal(t, int32(10), entitlement.QueueRateLimit.Burst)
+
+	// The actual share is the fraction of the pool allocated to the queue, regardless of its weight.
+	report, err = repo.GetQueueEntitlement(ctx, &schedulerobjects.QueueEntitlementRequest{QueueName: "B"})
+	require.NoError(t, err)
+	require.Len(t, report.Entitlements, 1)
+	assert.Equal(t, 3.0, report.Entitlements[0].Weight)
+	assert.InDelta(t, 0.3, report.Entitlements[0].ActualShare, 1e-9)
+
+	_, err = repo.GetQueueEntitlement(ctx, &schedulerobjects.QueueEntitlementRequest{QueueName: "A", Pool: "other"})
+	assert.ErrorAs(t, err, new(*armadaerrors.ErrNotFound))
+
+	_, err = repo.GetQueueEntitlement(ctx, &schedulerobjects.QueueEntitlementRequest{QueueName: "does-not-exist"})
+	assert.ErrorAs(t, err, new(*armadaerrors.ErrNotFound))
+
+	_, err = repo.GetQueueEntitlement(ctx, &schedulerobjects.QueueEntitlementRequest{})
+	assert.ErrorAs(t, err, new(*armadaerrors.ErrInvalidArgument))
+}
+
 func withSuccessfulJobSchedulingContext(sctx *schedulercontext.SchedulingContext, queue, jobId string) *schedulercontext.SchedulingContext {
 	if sctx.QueueSchedulingContexts == nil {
 		sctx.QueueSchedulingContexts = make(map[string]*schedulercontext.QueueSchedulingContext)
@@ -312,3 +387,94 @@ func testSchedulingContext(executorId string) *schedulercontext.SchedulingContex
 	sctx.SchedulingKeyGenerator = nil
 	return sctx
 }
+
+func TestGetQueueUtilisation(t *testing.T) {
+	config := testfixtures.TestSchedulingConfig()
+	repo, err := NewSchedulingContextRepository(1024, config)
+	require.NoError(t, err)
+
+	totalResources := schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("10")}}
+	fairnessCostProvider, err := fairness.NewDominantResourceFairness(totalResources, []string{"cpu"})
+	require.NoError(t, err)
+	sctx := schedulercontext.NewSchedulingContext(
+		"executor",
+		"pool",
+		config.Preemption.PriorityClasses,
+		config.Preemption.DefaultPriorityClass,
+		fairnessCostProvider,
+		nil,
+		totalResources,
+	)
+	allocated := schedulerobjects.QuantityByTAndResourceType[string]{
+		testfixtures.PriorityClass0: schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("3")}},
+	}
+	require.NoError(t, sctx.AddQueueSchedulingContext("B", 3, nil, nil))
+	require.NoError(t, sctx.AddQueueSchedulingContext("A", 1, allocated, nil))
+	require.NoError(t, sctx.AddQueueSchedulingContext("C", 2, allocated, nil))
+	sctx.QueueSchedulingContexts["B"].NumQueuedJobs = 5
+	require.NoError(t, repo.AddSchedulingContext(sctx))
+	ctx := armadacontext.Background()
+
+	report, err := repo.GetQueueUtilisation(ctx, &schedulerobjects.QueueUtilisationRequest{})
+	require.NoError(t, err)
+	require.Len(t, report.Queues, 3)
+	a, b, c := report.Queues[0], report.Queues[1], report.Queues[2]
+	assert.Equal(t, "A", a.QueueName)
+	assert.Equal(t, "pool", a.Pool)
+	assert.Equal(t, 1.0/6, a.FairShare)
+	assert.InDelta(t, 0.3, a.ActualShare, 1e-9)
+	assert.True(t, allocated[testfixtures.PriorityClass0].Equal(a.Allocated))
+	assert.Equal(t, int32(0), a.NumQueuedJobs)
+	assert.Equal(t, "B", b.QueueName)
+	assert.Equal(t, 0.5, b.FairShare)
+	assert.Equal(t, 0.0, b.ActualShare)
+	assert.Equal(t, int32(5), b.NumQueuedJobs)
+	// The actual share is the fraction of the pool allocated to the queue, regardless of its weight.
+	assert.Equal(t, "C", c.QueueName)
+	assert.InDelta(t, 0.3, c.ActualShare, 1e-9)
+
+	report, err = repo.GetQueueUtilisation(ctx, &schedulerobjects.QueueUtilisationRequest{Pool: "pool"})
+	require.NoError(t, err)
+	assert.Len(t, report.Queues, 3)
+
+	_, err = repo.GetQueueUtilisation(ctx, &schedulerobjects.QueueUtilisationRequest{Pool: "other"})
+	assert.ErrorAs(t, err, new(*armadaerrors.ErrNotFound))
+}
+
+func TestGetSchedulingContextSnapshots(t *testing.T) {
+	repo, err := NewSchedulingContextRepository(1024, testfixtures.TestSchedulingConfig())
+	require.NoError(t, err)
+	totalResources := schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("10")}}
+	fairnessCostProvider, err := fairness.NewDominantResourceFairness(totalResources, []string{"cpu"})
+	require.NoError(t, err)
+	for _, executorId := range []string{"executor2", "executor1"} {
+		sctx := schedulercontext.NewSchedulingContext(
+			executorId,
+			"pool",
+			testfixtures.TestPriorityClasses,
+			testfixtures.TestDefaultPriorityClass,
+			fairnessCostProvider,
+			nil,
+			totalResources,
+		)
+		require.NoError(t, sctx.AddQueueSchedulingContext("A", 1, nil, nil))
+		require.NoError(t, repo.AddSchedulingContext(sctx))
+	}
+	ctx := armadacontext.Background()
+
+	snapshots, err := repo.GetSchedulingContextSnapshots(ctx, &schedulerobjects.SchedulingContextSnapshotRequest{})
+	require.NoError(t, err)
+	require.Len(t, snapshots.Snapshots, 2)
+	assert.Equal(t, "executor1", snapshots.Snapshots[0].ExecutorId)
+	assert.Equal(t, "executor2", snapshots.Snapshots[1].ExecutorId)
+	require.Len(t, snapshots.Snapshots[0].QueueSchedulingContexts, 1)
+	assert.Equal(t, "A", snapshots.Snapshots[0].QueueSchedulingContexts[0].Queue)
+
+	snapshots, err = repo.GetSchedulingContextSnapshots(ctx, &schedulerobjects.SchedulingContextSnapshotRequest{ExecutorId: "executor2"})
+	require.NoError(t, err)
+	require.Len(t, snapshots.Snapshots, 1)
+	assert.Equal(t, "executor2", snapshots.Snapshots[0].ExecutorId)
+
+	_, err = repo.GetSchedulingContextSnapshots(ctx, &schedulerobjects.SchedulingContextSnapshotRequest{ExecutorId: "does-not-exist"})
+	assert.ErrorAs(t, err, new(*armadaerrors.ErrNotFound))
+}
@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+// RunReconciler compares the runs the scheduler believes to be active with those reported by executors.
+//
+// Runs may drift in two ways:
+//   - Orphaned runs are reported by an executor but aren't active according to the scheduler.
+//     Executors are told to cancel these when they next request leases; they're counted here to detect cleanup failing.
+//   - Missing runs are active according to the scheduler, were reported by the executor they're leased to,
+//     but are no longer reported by it. A run missing from all reports by its executor for lostRunTimeout is
+//     considered lost and should be returned, such that its job may be retried. The timeout accounts for the delay
+//     between executors removing finished runs and the scheduler ingesting their final state.
+//
+// Runs the executor has never reported aren't considered missing, since executors only learn of the runs leased to
+// them when they next request leases, which may be long after the run was leased if, e.g., the executor is busy.
+// Runs reported before the scheduler started, or became leader, are only considered missing once reported again.
+type RunReconciler struct {
+	lostRunTimeout time.Duration
+	// Ids of the active runs reported by their executor at least once.
+	reportedRuns map[uuid.UUID]bool
+	// Time of the first report that each run currently missing was found to be missing from, indexed by run id.
+	missingSince map[uuid.UUID]time.Time
+}
+
+// RunDrift summarises the difference between the runs leased to an executor and those it reported.
+type RunDrift struct {
+	// Number of runs leased to the executor that it didn't report.
+	MissingRuns int
+	// Number of runs reported by the executor that aren't active.
+	OrphanedRuns int
+}
+
+func NewRunReconciler(lostRunTimeout time.Duration) *RunReconciler {
+	return &RunReconciler{
+		lostRunTimeout: lostRunTimeout,
+		reportedRuns:   make(map[uuid.UUID]bool),
+		missingSince:   make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Reconcile compares the active runs in txn with the runs reported by the provided executors.
+// Returns the jobs whose latest run is lost and the drift per executor.
+// Runs leased to executors not among those provided are ignored; these are handled by executor expiry.
+func (r *RunReconciler) Reconcile(executors []*schedulerobjects.Executor, txn *jobdb.Txn) ([]*jobdb.Job, map[string]RunDrift, error) {
+	reportedRunsByExecutor := make(map[string]map[uuid.UUID]bool, len(executors))
+	lastUpdateTimeByExecutor := make(map[string]time.Time, len(executors))
+	driftByExecutor := make(map[string]RunDrift, len(executors))
+	for _, executor := range executors {
+		runIds, err := executor.AllRuns()
+		if err != nil {
+			return nil, nil, err
+		}
+		reportedRuns := make(map[uuid.UUID]bool, len(runIds))
+		drift := RunDrift{}
+		for _, runId := range runIds {
+			reportedRuns[runId] = true
+			if job := txn.GetByRunId(runId); job == nil || job.InTerminalState() || !isActiveRun(job.LatestRun(), runId) {
+				drift.OrphanedRuns++
+			}
+		}
+		reportedRunsByExecutor[executor.Id] = reportedRuns
+		lastUpdateTimeByExecutor[executor.Id] = executor.LastUpdateTime
+		driftByExecutor[executor.Id] = drift
+	}
+
+	var lostJobs []*jobdb.Job
+	everReportedRuns := make(map[uuid.UUID]bool, len(r.reportedRuns))
+	missingSince := make(map[uuid.UUID]time.Time, len(r.missingSince))
+	for _, job := range txn.GetAll() {
+		if job.InTerminalState() || job.Queued() {
+			continue
+		}
+		run := job.LatestRun()
+		if run == nil || run.InTerminalState() {
+			continue
+		}
+		reportedRuns, ok := reportedRunsByExecutor[run.Executor()]
+		if !ok {
+			if r.reportedRuns[run.Id()] {
+				everReportedRuns[run.Id()] = true
+			}
+			continue
+		}
+		if reportedRuns[run.Id()] {
+			everReportedRuns[run.Id()] = true
+			continue
+		}
+		if !r.reportedRuns[run.Id()] {
+			// The executor may not have fetched the run yet.
+			continue
+		}
+		everReportedRuns[run.Id()] = true
+		drift := driftByExecutor[run.Executor()]
+		drift.MissingRuns++
+		driftByExecutor[run.Executor()] = drift
+
+		lastUpdateTime := lastUpdateTimeByExecutor[run.Executor()]
+		since, ok := r.missingSince[run.Id()]
+		if !ok {
+			since = lastUpdateTime
+		}
+		if lastUpdateTime.Sub(since) >= r.lostRunTimeout {
+			lostJobs = append(lostJobs, job)
+			delete(everReportedRuns, run.Id())
+		} else {
+			missingSince[run.Id()] = since
+		}
+	}
+	// Runs no longer missing are forgotten, such that they're given the full timeout should they go missing again.
+	r.missingSince = missingSince
+	// Runs no longer active are forgotten.
+	r.reportedRuns = everReportedRuns
+	return lostJobs, driftByExecutor, nil
+}
+
+func isActiveRun(run *jobdb.JobRun, runId uuid.UUID) bool {
+	return run != nil && run.Id() == runId && !run.InTerminalState()
+}
@@ -0,0 +1,151 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+)
+
+func TestRunReconciler_Reconcile(t *testing.T) {
+	const lostRunTimeout = time.Minute
+	reportedJob := testfixtures.Test1Cpu4GiJob("A", testfixtures.PriorityClass0).WithQueued(false).WithNewRun("executor", "node", "node")
+	unassignedJob := testfixtures.Test1Cpu4GiJob("A", testfixtures.PriorityClass0).WithQueued(false).WithNewRun("executor", "node", "node")
+	missingJob := testfixtures.Test1Cpu4GiJob("A", testfixtures.PriorityClass0).WithQueued(false).WithNewRun("executor", "node", "node")
+	unknownExecutorJob := testfixtures.Test1Cpu4GiJob("A", testfixtures.PriorityClass0).WithQueued(false).WithNewRun("other-executor", "node", "node")
+	queuedJob := testfixtures.Test1Cpu4GiJob("A", testfixtures.PriorityClass0)
+	orphanedRunId := uuid.New()
+
+	jobDb := testfixtures.NewJobDb()
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*jobdb.Job{reportedJob, unassignedJob, missingJob, unknownExecutorJob, queuedJob}))
+	txn.Commit()
+
+	executorAt := func(lastUpdateTime time.Time) *schedulerobjects.Executor {
+		return &schedulerobjects.Executor{
+			Id: "executor",
+			Nodes: []*schedulerobjects.Node{
+				{
+					StateByJobRunId: map[string]schedulerobjects.JobRunState{
+						reportedJob.LatestRun().Id().String(): schedulerobjects.JobRunState_RUNNING,
+						orphanedRunId.String():                schedulerobjects.JobRunState_RUNNING,
+					},
+				},
+			},
+			UnassignedJobRuns: []string{unassignedJob.LatestRun().Id().String()},
+			LastUpdateTime:    lastUpdateTime,
+		}
+	}
+	expectedDrift := map[string]RunDrift{"executor": {MissingRuns: 1, OrphanedRuns: 1}}
+
+	reconciler := NewRunReconciler(lostRunTimeout)
+	start := time.Now()
+
+	// The missing run was reported by the executor before it went missing.
+	executor := executorAt(start.Add(-lostRunTimeout))
+	executor.UnassignedJobRuns = append(executor.UnassignedJobRuns, missingJob.LatestRun().Id().String())
+	lostJobs, drift, err := reconciler.Reconcile([]*schedulerobjects.Executor{executor}, jobDb.ReadTxn())
+	require.NoError(t, err)
+	assert.Empty(t, lostJobs)
+	assert.Equal(t, map[string]RunDrift{"executor": {OrphanedRuns: 1}}, drift)
+
+	// The run is missing, but hasn't been missing for long enough to be considered lost.
+	lostJobs, drift, err = reconciler.Reconcile([]*schedulerobjects.Executor{executorAt(start)}, jobDb.ReadTxn())
+	require.NoError(t, err)
+	assert.Empty(t, lostJobs)
+	assert.Equal(t, expectedDrift, drift)
+
+	lostJobs, drift, err = reconciler.Reconcile([]*schedulerobjects.Executor{executorAt(start.Add(lostRunTimeout / 2))}, jobDb.ReadTxn())
+	require.NoError(t, err)
+	assert.Empty(t, lostJobs)
+	assert.Equal(t, expectedDrift, drift)
+
+	// Time is measured by executor reports; a stale executor doesn't cause runs to be considered lost.
+	lostJobs, _, err = reconciler.Reconcile([]*schedulerobjects.Executor{executorAt(start.Add(lostRunTimeout / 2))}, jobDb.ReadTxn())
+	require.NoError(t, err)
+	assert.Empty(t, lostJobs)
+
+	lostJobs, drift, err = reconciler.Reconcile([]*schedulerobjects.Executor{executorAt(start.Add(lostRunTimeout))}, jobDb.ReadTxn())
+	require.NoError(t, err)
+	assert.Equal(t, []*jobdb.Job{missingJob}, lostJobs)
+	assert.Equal(t, expectedDrift, drift)
+}
+
+func TestRunReconciler_ForgetsRunsNoLongerMissing(t *testing.T) {
+	const lostRunTimeout = time.Minute
+	job := testfixtures.Test1Cpu4GiJob("A", testfixtures.PriorityClass0).WithQueued(false).WithNewRun("executor", "node", "node")
+	jobDb := testfixtures.NewJobDb()
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*jobdb.Job{job}))
+	txn.Commit()
+
+	executorAt := func(lastUpdateTime time.Time, reported bool) *schedulerobjects.Executor {
+		executor := &schedulerobjects.Executor{Id: "executor", LastUpdateTime: lastUpdateTime}
+		if reported {
+			executor.UnassignedJobRuns = []string{job.LatestRun().Id().String()}
+		}
+		return executor
+	}
+
+	reconciler := NewRunReconciler(lostRunTimeout)
+	start := time.Now()
+	for i, reported := range []bool{false, true, false} {
+		lostJobs, _, err := reconciler.Reconcile(
+			[]*schedulerobjects.Executor{executorAt(start.Add(time.Duration(i)*lostRunTimeout), reported)},
+			jobDb.ReadTxn(),
+		)
+		require.NoError(t, err)
+		assert.Empty(t, lostJobs)
+	}
+}
+
+func TestRunReconciler_IgnoresRunsNotYetFetched(t *testing.T) {
+	const lostRunTimeout = time.Minute
+	job := testfixtures.Test1Cpu4GiJob("A", testfixtures.PriorityClass0).WithQueued(false).WithNewRun("executor", "node", "node")
+	jobDb := testfixtures.NewJobDb()
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*jobdb.Job{job}))
+	txn.Commit()
+
+	executorAt := func(lastUpdateTime time.Time, reported bool) *schedulerobjects.Executor {
+		executor := &schedulerobjects.Executor{Id: "executor", LastUpdateTime: lastUpdateTime}
+		if reported {
+			executor.UnassignedJobRuns = []string{job.LatestRun().Id().String()}
+		}
+		return executor
+	}
+
+	reconciler := NewRunReconciler(lostRunTimeout)
+	start := time.Now()
+
+	// The run was leased, but the executor hasn't fetched it, e.g., because it hasn't requested leases since.
+	for i := 0; i < 3; i++ {
+		lostJobs, drift, err := reconciler.Reconcile(
+			[]*schedulerobjects.Executor{executorAt(start.Add(time.Duration(i)*lostRunTimeout), false)},
+			jobDb.ReadTxn(),
+		)
+		require.NoError(t, err)
+		assert.Empty(t, lostJobs)
+		assert.Equal(t, map[string]RunDrift{"executor": {}}, drift)
+	}
+
+	// Once reported, the run is lost if it goes missing for lostRunTimeout.
+	for i, reported := range []bool{true, false, false} {
+		lostJobs, _, err := reconciler.Reconcile(
+			[]*schedulerobjects.Executor{executorAt(start.Add(time.Duration(3+i)*lostRunTimeout), reported)},
+			jobDb.ReadTxn(),
+		)
+		require.NoError(t, err)
+		if i < 2 {
+			assert.Empty(t, lostJobs)
+		} else {
+			assert.Equal(t, []*jobdb.Job{job}, lostJobs)
+		}
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/renstrom/shortuuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/stringinterner"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	"github.com/armadaproject/armada/internal/scheduler/database"
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 	"github.com/armadaproject/armada/internal/scheduler/kubernetesobjects/affinity"
@@ -24,13 +28,17 @@ import (
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
+// warnings deduplicates warnings logged for individual jobs, of which thousands may be logged per minute,
+// e.g., if many jobs are in an unexpected state.
+var warnings = logging.NewDeduplicator(time.Minute)
+
 // Scheduler is the main Armada scheduler.
 // It periodically performs the following cycle:
 // 1. Update state from postgres (via the jobRepository).
 // 2. Determine if leader and exit if not.
 // 3. Generate any necessary events resulting from the state update.
-// 4. Expire any jobs assigned to clusters that have timed out.
-// 5. Schedule jobs.
+// 4. Expire any jobs assigned to clusters that have timed out and notify of jobs on clusters that have become stale.
+// 5. Schedule jobs, unless the jobDb lags too far behind Pulsar.
 // 6. Publish any Armada events resulting from the scheduling cycle.
 type Scheduler struct {
 	// Provides job updates from Postgres.
@@ -47,8 +55,17 @@ type Scheduler struct {
 	// This is used to check if jobs are still schedulable.
 	// Useful when we are adding node anti-affinities.
 	submitChecker SubmitScheduleChecker
+	// If not nil, newly submitted jobs are recorded to detect duplicate jobs.
+	duplicateJobDetector *DuplicateJobDetector
+	// If not nil, newly submitted jobs with dependencies are held back until their dependencies have succeeded.
+	// Should be shared with the scheduling algo.
+	dependencyIndex *DependencyIndex
+	// If not nil, runs are reconciled with those reported by executors and lost runs are returned.
+	runReconciler *RunReconciler
 	// Responsible for publishing messages to Pulsar. Only the leader publishes.
 	publisher Publisher
+	// Generates events explaining why queued jobs couldn't be scheduled.
+	unschedulableJobReporter *UnschedulableJobReporter
 	// Minimum duration between scheduler cycles.
 	cyclePeriod time.Duration
 	// Minimum duration between Schedule() calls - calls that actually schedule new jobs.
@@ -57,9 +74,23 @@ type Scheduler struct {
 	maxAttemptedRuns uint
 	// The label used when setting node anti affinities.
 	nodeIdLabel string
+	// If an executor fails to report in for this amount of time, it's considered stale
+	// and an event is generated for each job running on it.
+	// If zero, no such events are generated.
+	staleExecutorTimeout time.Duration
+	// Executors that were stale at the end of the previous cycle.
+	staleExecutors map[string]bool
 	// If an executor fails to report in for this amount of time,
 	// all jobs assigne to that executor are cancelled.
+	// If zero, jobs are never cancelled for this reason.
 	executorTimeout time.Duration
+	// If non-zero, scheduling rounds are skipped while the ingestion lag exceeds this value.
+	maxIngestionLag time.Duration
+	// On shutdown, a cycle in progress is given this long to complete and publish its decisions before being cancelled.
+	gracefulShutdownTimeout time.Duration
+	// Marker messages published to Pulsar to measure the ingestion lag that have not yet been written to Postgres.
+	// Nil if there are no such messages.
+	pendingIngestionMarkers *ingestionMarkers
 	// The time the previous scheduling round ended
 	previousSchedulingRoundEnd time.Time
 	// Used for timing decisions (e.g., sleep).
@@ -71,6 +102,9 @@ type Scheduler struct {
 	jobsSerial int64
 	// Highest offset we've read from Postgres on the job runs table.
 	runsSerial int64
+	// Signalled when this scheduler becomes leader, such that it starts a cycle immediately rather than waiting for
+	// the next tick. Since standbys keep the jobDb in sync with Postgres, this makes failover take at most one cycle.
+	becameLeader chan struct{}
 	// Function that is called every time a cycle is completed. Useful for testing.
 	onCycleCompleted func()
 	// metrics set for the scheduler.
@@ -86,9 +120,15 @@ func NewScheduler(
 	publisher Publisher,
 	stringInterner *stringinterner.StringInterner,
 	submitChecker SubmitScheduleChecker,
+	duplicateJobDetector *DuplicateJobDetector,
+	dependencyIndex *DependencyIndex,
+	runReconciler *RunReconciler,
 	cyclePeriod time.Duration,
 	schedulePeriod time.Duration,
+	staleExecutorTimeout time.Duration,
 	executorTimeout time.Duration,
+	maxIngestionLag time.Duration,
+	gracefulShutdownTimeout time.Duration,
 	maxAttemptedRuns uint,
 	nodeIdLabel string,
 	schedulerMetrics *SchedulerMetrics,
@@ -99,23 +139,44 @@ func NewScheduler(
 		schedulingAlgo:             schedulingAlgo,
 		leaderController:           leaderController,
 		publisher:                  publisher,
+		unschedulableJobReporter:   NewUnschedulableJobReporter(),
 		stringInterner:             stringInterner,
 		submitChecker:              submitChecker,
+		duplicateJobDetector:       duplicateJobDetector,
+		dependencyIndex:            dependencyIndex,
+		runReconciler:              runReconciler,
 		jobDb:                      jobDb,
 		clock:                      clock.RealClock{},
 		cyclePeriod:                cyclePeriod,
 		schedulePeriod:             schedulePeriod,
 		previousSchedulingRoundEnd: time.Time{},
+		staleExecutorTimeout:       staleExecutorTimeout,
+		staleExecutors:             make(map[string]bool),
 		executorTimeout:            executorTimeout,
+		maxIngestionLag:            maxIngestionLag,
+		gracefulShutdownTimeout:    gracefulShutdownTimeout,
 		maxAttemptedRuns:           maxAttemptedRuns,
 		nodeIdLabel:                nodeIdLabel,
 		jobsSerial:                 -1,
 		runsSerial:                 -1,
 		metrics:                    schedulerMetrics,
+		becameLeader:               make(chan struct{}, 1),
 	}, nil
 }
 
+func (s *Scheduler) onStartedLeading(*armadacontext.Context) {
+	select {
+	case s.becameLeader <- struct{}{}:
+	default:
+		// A cycle is already pending.
+	}
+}
+
+func (s *Scheduler) onStoppedLeading() {}
+
 // Run enters the scheduling loop, which will continue until ctx is cancelled.
+// A cycle in progress when ctx is cancelled is allowed to complete, such that its decisions are either published in
+// full or not at all, unless it takes longer than gracefulShutdownTimeout.
 func (s *Scheduler) Run(ctx *armadacontext.Context) error {
 	ctx.Infof("starting scheduler with cycle time %s", s.cyclePeriod)
 	defer ctx.Info("scheduler stopped")
@@ -127,68 +188,101 @@ func (s *Scheduler) Run(ctx *armadacontext.Context) error {
 	}
 	ctx.Infof("JobDb initialised in %s", s.clock.Since(start))
 
+	// Cycles run with a context cancelled gracefulShutdownTimeout after ctx is.
+	cycleCtx, cancelCycles := armadacontext.WithCancel(armadacontext.WithoutCancel(ctx))
+	defer cancelCycles()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-cycleCtx.Done():
+			return
+		}
+		select {
+		case <-s.clock.After(s.gracefulShutdownTimeout):
+			ctx.Warnf("cycle didn't complete within %s of shutdown; cancelling", s.gracefulShutdownTimeout)
+			cancelCycles()
+		case <-cycleCtx.Done():
+		}
+	}()
+
 	ticker := s.clock.NewTicker(s.cyclePeriod)
 	prevLeaderToken := InvalidLeaderToken()
 	for {
+		// Check for cancellation first, since select chooses at random between ready cases.
+		if ctx.Err() != nil {
+			ctx.Infof("context cancelled; returning.")
+			return ctx.Err()
+		}
 		select {
 		case <-ctx.Done():
 			ctx.Infof("context cancelled; returning.")
 			return ctx.Err()
 		case <-ticker.C():
-			start := s.clock.Now()
-			ctx := armadacontext.WithLogField(ctx, "cycleId", shortuuid.New())
-			leaderToken := s.leaderController.GetToken()
-			fullUpdate := false
-			ctx.Infof("received leaderToken; leader status is %t", leaderToken.leader)
-
-			// If we are becoming leader then we must ensure we have caught up to all Pulsar messages
-			if leaderToken.leader && leaderToken != prevLeaderToken {
-				ctx.Infof("becoming leader")
-				syncContext, cancel := armadacontext.WithTimeout(ctx, 5*time.Minute)
-				err := s.ensureDbUpToDate(syncContext, 1*time.Second)
-				if err != nil {
-					logging.WithStacktrace(ctx, err).Error("could not become leader")
-					leaderToken = InvalidLeaderToken()
-				} else {
-					fullUpdate = true
-				}
-				cancel()
-			}
-
-			// Run a scheduler cycle.
-			//
-			// If there is an error, we can't guarantee that the scheduler-internal state is consistent with what was published
-			// (scheduling decisions may have been partially published)
-			// and we must invalidate the held leader token to trigger flushing Pulsar at the next cycle.
-			//
-			// TODO: Once the Pulsar client supports transactions, we can guarantee consistency even in case of errors.
-
-			shouldSchedule := s.clock.Now().Sub(s.previousSchedulingRoundEnd) > s.schedulePeriod
-
-			result, err := s.cycle(ctx, fullUpdate, leaderToken, shouldSchedule)
+		case <-s.becameLeader:
+			ctx.Infof("became leader; starting cycle")
+		}
+		start := s.clock.Now()
+		ctx := armadacontext.WithLogField(cycleCtx, "cycleId", shortuuid.New())
+		leaderToken := s.leaderController.GetToken()
+		fullUpdate := false
+		ctx.Infof("received leaderToken; leader status is %t", leaderToken.leader)
+
+		// If we are becoming leader then we must ensure we have caught up to all Pulsar messages
+		if leaderToken.leader && leaderToken != prevLeaderToken {
+			ctx.Infof("becoming leader")
+			syncContext, cancel := armadacontext.WithTimeout(ctx, 5*time.Minute)
+			err := s.ensureDbUpToDate(syncContext, 1*time.Second)
 			if err != nil {
-				logging.WithStacktrace(ctx, err).Error("scheduling cycle failure")
+				logging.WithStacktrace(ctx, err).Error("could not become leader")
 				leaderToken = InvalidLeaderToken()
+			} else {
+				fullUpdate = true
 			}
+			cancel()
+		}
 
-			cycleTime := s.clock.Since(start)
+		// Run a scheduler cycle.
+		//
+		// If there is an error, we can't guarantee that the scheduler-internal state is consistent with what was published
+		// (scheduling decisions may have been partially published)
+		// and we must invalidate the held leader token to trigger flushing Pulsar at the next cycle.
+		//
+		// TODO: Once the Pulsar client supports transactions, we can guarantee consistency even in case of errors.
+
+		shouldSchedule := s.clock.Now().Sub(s.previousSchedulingRoundEnd) > s.schedulePeriod
+
+		cycleCtx, span := tracing.StartSpanWithLogFields(ctx, "Scheduler.cycle")
+		span.SetAttributes(attribute.Bool("leader", leaderToken.leader), attribute.Bool("shouldSchedule", shouldSchedule))
+		result, err := s.cycle(cycleCtx, fullUpdate, leaderToken, shouldSchedule)
+		span.SetAttributes(attribute.Int("scheduledJobs", len(result.ScheduledJobs)), attribute.Int("preemptedJobs", len(result.PreemptedJobs)))
+		tracing.RecordError(span, err)
+		span.End()
+		if err != nil {
+			logging.WithStacktrace(ctx, err).Error("scheduling cycle failure")
+			leaderToken = InvalidLeaderToken()
+		}
 
-			s.metrics.ResetGaugeMetrics()
+		cycleTime := s.clock.Since(start)
 
-			if shouldSchedule && leaderToken.leader {
-				// Only the leader does real scheduling rounds.
-				s.metrics.ReportScheduleCycleTime(cycleTime)
-				s.metrics.ReportSchedulerResult(ctx, result)
-				ctx.Infof("scheduling cycle completed in %s", cycleTime)
-			} else {
-				s.metrics.ReportReconcileCycleTime(cycleTime)
-				ctx.Infof("reconciliation cycle completed in %s", cycleTime)
-			}
+		s.metrics.ResetGaugeMetrics()
 
-			prevLeaderToken = leaderToken
-			if s.onCycleCompleted != nil {
-				s.onCycleCompleted()
+		if shouldSchedule && leaderToken.leader {
+			// Only the leader does real scheduling rounds.
+			s.metrics.ReportScheduleCycleTime(cycleTime)
+			s.metrics.ReportSchedulerResult(ctx, result)
+			ctx.Infof("scheduling cycle completed in %s", cycleTime)
+		} else {
+			if !leaderToken.leader {
+				// Only the leader knows the current demand of unschedulable jobs.
+				s.metrics.ResetUnschedulableDemand()
 			}
+			s.metrics.ReportReconcileCycleTime(cycleTime)
+			ctx.Infof("reconciliation cycle completed in %s", cycleTime)
+		}
+
+		prevLeaderToken = leaderToken
+		if s.onCycleCompleted != nil {
+			s.onCycleCompleted()
 		}
 	}
 }
@@ -200,7 +294,11 @@ func (s *Scheduler) cycle(ctx *armadacontext.Context, updateAll bool, leaderToke
 	overallSchedulerResult = SchedulerResult{EmptyResult: true}
 
 	// Update job state.
-	updatedJobs, err := s.syncState(ctx)
+	syncCtx, syncSpan := tracing.StartSpanWithLogFields(ctx, "Scheduler.syncState")
+	updatedJobs, err := s.syncState(syncCtx)
+	syncSpan.SetAttributes(attribute.Int("updatedJobs", len(updatedJobs)))
+	tracing.RecordError(syncSpan, err)
+	syncSpan.End()
 	if err != nil {
 		return
 	}
@@ -216,6 +314,7 @@ func (s *Scheduler) cycle(ctx *armadacontext.Context, updateAll bool, leaderToke
 	defer txn.Abort()
 	if updateAll {
 		updatedJobs = txn.GetAll()
+		s.unschedulableJobReporter.Reset()
 	}
 
 	// Generate any events that came out of synchronising the db state.
@@ -231,6 +330,20 @@ func (s *Scheduler) cycle(ctx *armadacontext.Context, updateAll bool, leaderToke
 	}
 	events = append(events, expirationEvents...)
 
+	// Notify of jobs running on clusters that have recently become stale.
+	staleExecutorEvents, err := s.generateStaleExecutorEvents(ctx, txn)
+	if err != nil {
+		return
+	}
+	events = append(events, staleExecutorEvents...)
+
+	// Return any runs lost by the executors they're leased to.
+	lostRunEvents, err := s.returnLostRuns(ctx, txn)
+	if err != nil {
+		return
+	}
+	events = append(events, lostRunEvents...)
+
 	// Request cancel for any jobs that exceed queueTtl
 	queueTtlCancelEvents, err := s.cancelQueuedJobsIfExpired(txn)
 	if err != nil {
@@ -238,10 +351,38 @@ func (s *Scheduler) cycle(ctx *armadacontext.Context, updateAll bool, leaderToke
 	}
 	events = append(events, queueTtlCancelEvents...)
 
+	// Fail any queued jobs for which a dependency failed, was cancelled, or does not exist.
+	dependencyFailedEvents, err := s.failJobsWithFailedDependencies(ctx, txn)
+	if err != nil {
+		return
+	}
+	events = append(events, dependencyFailedEvents...)
+
+	// Skip scheduling if the jobDb lags too far behind Pulsar,
+	// since we would otherwise schedule against stale state.
+	if shouldSchedule && s.maxIngestionLag > 0 {
+		var ingestionLag time.Duration
+		ingestionLag, err = s.updateIngestionLag(ctx)
+		if err != nil {
+			return
+		}
+		if ingestionLag > s.maxIngestionLag {
+			ctx.Warnf("skipping scheduling round; ingestion lag %s exceeds maximum %s", ingestionLag, s.maxIngestionLag)
+			shouldSchedule = false
+			overallSchedulerResult.TerminationReason = TerminationReasonIngestionLag
+		}
+	}
+
 	// Schedule jobs.
 	if shouldSchedule {
 		var result *SchedulerResult
-		result, err = s.schedulingAlgo.Schedule(ctx, txn)
+		scheduleCtx, scheduleSpan := tracing.StartSpanWithLogFields(ctx, "Scheduler.schedule")
+		result, err = s.schedulingAlgo.Schedule(scheduleCtx, txn)
+		tracing.RecordError(scheduleSpan, err)
+		if err == nil {
+			traceScheduledJobs(scheduleCtx, result)
+		}
+		scheduleSpan.End()
 		if err != nil {
 			return
 		}
@@ -252,6 +393,10 @@ func (s *Scheduler) cycle(ctx *armadacontext.Context, updateAll bool, leaderToke
 			return
 		}
 		events = append(events, resultEvents...)
+		events, err = s.unschedulableJobReporter.AppendEventSequences(events, result, txn, s.clock.Now())
+		if err != nil {
+			return
+		}
 		s.previousSchedulingRoundEnd = s.clock.Now()
 
 		overallSchedulerResult = *result
@@ -262,7 +407,12 @@ func (s *Scheduler) cycle(ctx *armadacontext.Context, updateAll bool, leaderToke
 		return s.leaderController.ValidateToken(leaderToken)
 	}
 	start := s.clock.Now()
-	if err = s.publisher.PublishMessages(ctx, events, isLeader); err != nil {
+	publishCtx, publishSpan := tracing.StartSpanWithLogFields(ctx, "Scheduler.publish")
+	publishSpan.SetAttributes(attribute.Int("numEventSequences", len(events)))
+	err = s.publisher.PublishMessages(publishCtx, events, isLeader)
+	tracing.RecordError(publishSpan, err)
+	publishSpan.End()
+	if err != nil {
 		return
 	}
 	ctx.Infof("published %d events to pulsar in %s", len(events), s.clock.Since(start))
@@ -285,6 +435,7 @@ func (s *Scheduler) syncState(ctx *armadacontext.Context) ([]*jobdb.Job, error)
 	// Process jobs.
 	jobsToDelete := make([]string, 0, len(updatedJobs))
 	jobsToUpdateById := make(map[string]*jobdb.Job, len(updatedJobs))
+	newJobs := make([]*jobdb.Job, 0)
 	for _, dbJob := range updatedJobs {
 		if dbJob.InTerminalState() {
 			// Scheduler has sent a terminal message; we can safely remove the job.
@@ -299,6 +450,7 @@ func (s *Scheduler) syncState(ctx *armadacontext.Context) ([]*jobdb.Job, error)
 			if err != nil {
 				return nil, err
 			}
+			newJobs = append(newJobs, job)
 		} else {
 			// make the scheduler job look like the db job.
 			job, err = updateSchedulerJob(job, &dbJob)
@@ -309,6 +461,9 @@ func (s *Scheduler) syncState(ctx *armadacontext.Context) ([]*jobdb.Job, error)
 		jobsToUpdateById[job.Id()] = job
 	}
 
+	// Only the leader reports metrics derived from job state, such that jobs are counted once.
+	isLeader := s.leaderController.ValidateToken(s.leaderController.GetToken())
+
 	// Process runs.
 	for _, dbRun := range updatedRuns {
 		jobId := dbRun.JobID
@@ -321,7 +476,7 @@ func (s *Scheduler) syncState(ctx *armadacontext.Context) ([]*jobdb.Job, error)
 			// If the job is nil or terminal at this point then it cannot be active.
 			// In this case we can ignore the run.
 			if job == nil || job.InTerminalState() {
-				ctx.Debugf("job %s is not active; ignoring update for run %s", jobId, dbRun.RunID)
+				ctx.WithFields(logging.Fields{logging.JobIdField: jobId, logging.RunIdField: dbRun.RunID}).Debug("job is not active; ignoring update for run")
 				continue
 			}
 		}
@@ -330,6 +485,9 @@ func (s *Scheduler) syncState(ctx *armadacontext.Context) ([]*jobdb.Job, error)
 		if run == nil {
 			run = s.createSchedulerRun(&dbRun)
 		} else {
+			if isLeader && dbRun.Running && !run.Running() && dbRun.RunningTimestamp != nil && !hasStartedRunning(job) {
+				s.metrics.ReportJobRunning(job, *dbRun.RunningTimestamp)
+			}
 			// make the scheduler job look like the db job
 			run = updateSchedulerRun(run, &dbRun)
 		}
@@ -341,6 +499,12 @@ func (s *Scheduler) syncState(ctx *armadacontext.Context) ([]*jobdb.Job, error)
 	if err := txn.Upsert(jobsToUpdate); err != nil {
 		return nil, err
 	}
+	if s.duplicateJobDetector != nil {
+		s.duplicateJobDetector.Observe(newJobs)
+	}
+	if s.dependencyIndex != nil {
+		s.dependencyIndex.Observe(ctx, newJobs)
+	}
 	if err := txn.BatchDelete(jobsToDelete); err != nil {
 		return nil, err
 	}
@@ -389,6 +553,26 @@ func (s *Scheduler) addNodeAntiAffinitiesForAttemptedRunsIfSchedulable(job *jobd
 }
 
 // eventsFromSchedulerResult generates necessary EventSequences from the provided SchedulerResult.
+// traceScheduledJobs records, for each job scheduled in result, a span from its submission until it was scheduled,
+// as part of the trace in which it was submitted and linked to the span of the scheduling round carried by ctx.
+// Jobs submitted without trace context aren't traced.
+func traceScheduledJobs(ctx *armadacontext.Context, result *SchedulerResult) {
+	for _, job := range ScheduledJobsFromSchedulerResult[*jobdb.Job](result) {
+		_, span := tracing.ContinueFromTraceparent(
+			ctx,
+			"Scheduler.scheduleJob",
+			job.GetAnnotations()[configuration.TraceparentAnnotation],
+			trace.WithTimestamp(job.GetSubmitTime()),
+			trace.WithAttributes(
+				attribute.String("jobId", job.Id()),
+				attribute.String("queue", job.Queue()),
+				attribute.String("nodeId", result.NodeIdByJobId[job.Id()]),
+			),
+		)
+		span.End()
+	}
+}
+
 func (s *Scheduler) eventsFromSchedulerResult(result *SchedulerResult) ([]*armadaevents.EventSequence, error) {
 	return EventsFromSchedulerResult(result, s.clock.Now())
 }
@@ -396,7 +580,7 @@ func (s *Scheduler) eventsFromSchedulerResult(result *SchedulerResult) ([]*armad
 // EventsFromSchedulerResult generates necessary EventSequences from the provided SchedulerResult.
 func EventsFromSchedulerResult(result *SchedulerResult, time time.Time) ([]*armadaevents.EventSequence, error) {
 	eventSequences := make([]*armadaevents.EventSequence, 0, len(result.PreemptedJobs)+len(result.ScheduledJobs)+len(result.FailedJobs))
-	eventSequences, err := AppendEventSequencesFromPreemptedJobs(eventSequences, PreemptedJobsFromSchedulerResult[*jobdb.Job](result), time)
+	eventSequences, err := AppendEventSequencesFromPreemptedJobs(eventSequences, PreemptedJobsFromSchedulerResult[*jobdb.Job](result), result.PreemptionByJobId, time)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +595,14 @@ func EventsFromSchedulerResult(result *SchedulerResult, time time.Time) ([]*arma
 	return eventSequences, nil
 }
 
-func AppendEventSequencesFromPreemptedJobs(eventSequences []*armadaevents.EventSequence, jobs []*jobdb.Job, time time.Time) ([]*armadaevents.EventSequence, error) {
+// AppendEventSequencesFromPreemptedJobs appends the events marking the provided jobs as preempted.
+// preemptionByJobId records why each job was preempted; jobs not in it are preempted for an unknown reason.
+func AppendEventSequencesFromPreemptedJobs(
+	eventSequences []*armadaevents.EventSequence,
+	jobs []*jobdb.Job,
+	preemptionByJobId map[string]Preemption,
+	time time.Time,
+) ([]*armadaevents.EventSequence, error) {
 	for _, job := range jobs {
 		jobId, err := armadaevents.ProtoUuidFromUlidString(job.Id())
 		if err != nil {
@@ -421,6 +612,14 @@ func AppendEventSequencesFromPreemptedJobs(eventSequences []*armadaevents.EventS
 		if run == nil {
 			return nil, errors.Errorf("attempting to generate preempted events for job %s with no associated runs", job.Id())
 		}
+		preemption := preemptionByJobId[job.Id()]
+		var preemptiveJobId *armadaevents.Uuid
+		if preemption.PreemptingJobId != "" {
+			preemptiveJobId, err = armadaevents.ProtoUuidFromUlidString(preemption.PreemptingJobId)
+			if err != nil {
+				return nil, err
+			}
+		}
 		eventSequences = append(eventSequences, &armadaevents.EventSequence{
 			Queue:      job.Queue(),
 			JobSetName: job.Jobset(),
@@ -429,8 +628,13 @@ func AppendEventSequencesFromPreemptedJobs(eventSequences []*armadaevents.EventS
 					Created: &time,
 					Event: &armadaevents.EventSequence_Event_JobRunPreempted{
 						JobRunPreempted: &armadaevents.JobRunPreempted{
-							PreemptedRunId: armadaevents.ProtoUuidFromUuid(run.Id()),
-							PreemptedJobId: jobId,
+							PreemptedRunId:  armadaevents.ProtoUuidFromUuid(run.Id()),
+							PreemptedJobId:  jobId,
+							PreemptiveJobId: preemptiveJobId,
+							Reason:          preemption.Reason,
+							PreemptiveQueue: preemption.PreemptingQueue,
+							NodeId:          run.NodeId(),
+							NodeName:        run.NodeName(),
 						},
 					},
 				},
@@ -737,6 +941,9 @@ func (s *Scheduler) generateUpdateMessagesFromJob(job *jobdb.Job, jobRunErrors m
 // It also generates an EventSequence for each job, indicating that both the run and the job has failed
 // Note that this is different behaviour from the old scheduler which would allow expired jobs to be rerun
 func (s *Scheduler) expireJobsIfNecessary(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
+	if s.executorTimeout == 0 {
+		return nil, nil
+	}
 	heartbeatTimes, err := s.executorRepository.GetLastUpdateTimes(ctx)
 	if err != nil {
 		return nil, err
@@ -775,7 +982,7 @@ func (s *Scheduler) expireJobsIfNecessary(ctx *armadacontext.Context, txn *jobdb
 
 		run := job.LatestRun()
 		if run != nil && !job.Queued() && staleExecutors[run.Executor()] {
-			ctx.Warnf("Cancelling job %s as it is running on lost executor %s", job.Id(), run.Executor())
+			ctx.WithFields(logging.JobFields(job.Queue(), job.Jobset(), job.Id())).Warnf("Cancelling job as it is running on lost executor %s", run.Executor())
 			jobsToUpdate = append(jobsToUpdate, job.WithQueued(false).WithFailed(true).WithUpdatedRun(run.WithFailed(true)))
 
 			jobId, err := armadaevents.ProtoUuidFromUlidString(job.Id())
@@ -788,6 +995,7 @@ func (s *Scheduler) expireJobsIfNecessary(ctx *armadacontext.Context, txn *jobdb
 				Reason: &armadaevents.Error_LeaseExpired{
 					LeaseExpired: &armadaevents.LeaseExpired{},
 				},
+				FailureCategory: armadaevents.FailureCategory_NodeLost,
 			}
 			es := &armadaevents.EventSequence{
 				Queue:      job.Queue(),
@@ -823,6 +1031,154 @@ func (s *Scheduler) expireJobsIfNecessary(ctx *armadacontext.Context, txn *jobdb
 	return events, nil
 }
 
+// generateStaleExecutorEvents generates a non-terminal JobRunErrors event for each job running on an executor
+// that has become stale since the previous cycle, i.e., that hasn't heartbeated within staleExecutorTimeout.
+// Jobs running on executors that have also exceeded executorTimeout are skipped, since these are expired instead.
+func (s *Scheduler) generateStaleExecutorEvents(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
+	if s.staleExecutorTimeout == 0 {
+		return nil, nil
+	}
+	heartbeatTimes, err := s.executorRepository.GetLastUpdateTimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock.Now()
+	newlyStaleExecutors := make(map[string]time.Time)
+	for executor, heartbeat := range heartbeatTimes {
+		if !heartbeat.Before(now.Add(-s.staleExecutorTimeout)) {
+			if s.staleExecutors[executor] {
+				ctx.Infof("Executor %s has recovered", executor)
+			}
+			delete(s.staleExecutors, executor)
+			continue
+		}
+		if s.staleExecutors[executor] {
+			continue
+		}
+		s.staleExecutors[executor] = true
+		if s.executorTimeout != 0 && heartbeat.Before(now.Add(-s.executorTimeout)) {
+			continue
+		}
+		ctx.Warnf("Executor %s has not reported a heartbeat since %v; its capacity will not be considered for scheduling", executor, heartbeat)
+		newlyStaleExecutors[executor] = heartbeat
+	}
+	if len(newlyStaleExecutors) == 0 {
+		return nil, nil
+	}
+
+	events := make([]*armadaevents.EventSequence, 0)
+	for _, job := range txn.GetAll() {
+		if job.InTerminalState() || job.Queued() {
+			continue
+		}
+		run := job.LatestRun()
+		if run == nil || run.InTerminalState() {
+			continue
+		}
+		heartbeat, ok := newlyStaleExecutors[run.Executor()]
+		if !ok {
+			continue
+		}
+		jobId, err := armadaevents.ProtoUuidFromUlidString(job.Id())
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &armadaevents.EventSequence{
+			Queue:      job.Queue(),
+			JobSetName: job.Jobset(),
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: s.now(),
+					Event: &armadaevents.EventSequence_Event_JobRunErrors{
+						JobRunErrors: &armadaevents.JobRunErrors{
+							RunId: armadaevents.ProtoUuidFromUuid(run.Id()),
+							JobId: jobId,
+							Errors: []*armadaevents.Error{
+								{
+									Terminal: false,
+									Reason: &armadaevents.Error_ExecutorStale{
+										ExecutorStale: &armadaevents.ExecutorStale{
+											ExecutorId:    run.Executor(),
+											LastHeartbeat: &heartbeat,
+										},
+									},
+									FailureCategory: armadaevents.FailureCategory_NodeLost,
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	return events, nil
+}
+
+// returnLostRuns reconciles the runs in the jobDb with those reported by executors.
+// It generates a lease returned event for each run the executor it's leased to has lost, such that its job may be retried,
+// and reports the drift between the scheduler and executors.
+func (s *Scheduler) returnLostRuns(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
+	if s.runReconciler == nil {
+		return nil, nil
+	}
+	executors, err := s.executorRepository.GetExecutors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lostJobs, driftByExecutor, err := s.runReconciler.Reconcile(executors, txn)
+	if err != nil {
+		return nil, err
+	}
+	s.metrics.ReportRunDrift(driftByExecutor)
+
+	events := make([]*armadaevents.EventSequence, 0, len(lostJobs))
+	jobsToUpdate := make([]*jobdb.Job, 0, len(lostJobs))
+	for _, job := range lostJobs {
+		run := job.LatestRun()
+		ctx.WithFields(logging.JobFields(job.Queue(), job.Jobset(), job.Id())).Warnf(
+			"Returning lease of run %s as executor %s no longer reports it", run.Id(), run.Executor(),
+		)
+		s.metrics.ReportLostRun(run.Executor())
+		jobsToUpdate = append(jobsToUpdate, job.WithUpdatedRun(run.WithFailed(true).WithReturned(true)))
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(job.Id())
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &armadaevents.EventSequence{
+			Queue:      job.Queue(),
+			JobSetName: job.Jobset(),
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: s.now(),
+					Event: &armadaevents.EventSequence_Event_JobRunErrors{
+						JobRunErrors: &armadaevents.JobRunErrors{
+							RunId: armadaevents.ProtoUuidFromUuid(run.Id()),
+							JobId: jobId,
+							Errors: []*armadaevents.Error{
+								{
+									Terminal: true,
+									Reason: &armadaevents.Error_PodLeaseReturned{
+										PodLeaseReturned: &armadaevents.PodLeaseReturned{
+											Message:      fmt.Sprintf("Run was lost by executor %s", run.Executor()),
+											RunAttempted: run.Running(),
+										},
+									},
+									FailureCategory: armadaevents.FailureCategory_NodeLost,
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	if err := txn.Upsert(jobsToUpdate); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
 // cancelQueuedJobsIfExpired generates cancel request messages for any queued jobs that exceed their queueTtl.
 func (s *Scheduler) cancelQueuedJobsIfExpired(txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
 	jobsToCancel := make([]*jobdb.Job, 0)
@@ -868,6 +1224,63 @@ func (s *Scheduler) cancelQueuedJobsIfExpired(txn *jobdb.Txn) ([]*armadaevents.E
 	return events, nil
 }
 
+// failJobsWithFailedDependencies generates job errors for any queued jobs for which a dependency failed, was cancelled, or does not exist.
+func (s *Scheduler) failJobsWithFailedDependencies(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
+	if s.dependencyIndex == nil {
+		return nil, nil
+	}
+	failedDependencyByJobId, err := s.dependencyIndex.Resolve(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+	jobIds := maps.Keys(failedDependencyByJobId)
+	slices.Sort(jobIds)
+	jobsToFail := make([]*jobdb.Job, 0, len(jobIds))
+	events := make([]*armadaevents.EventSequence, 0, len(jobIds))
+	for _, jobId := range jobIds {
+		job := txn.GetById(jobId).WithQueued(false).WithFailed(true)
+		protoJobId, err := armadaevents.ProtoUuidFromUlidString(jobId)
+		if err != nil {
+			return nil, err
+		}
+		dependency := failedDependencyByJobId[jobId]
+		message := fmt.Sprintf("dependency %s failed or was cancelled", dependency.JobId)
+		if dependency.Unknown {
+			message = fmt.Sprintf("dependency %s does not exist", dependency.JobId)
+		}
+		events = append(events, &armadaevents.EventSequence{
+			Queue:      job.Queue(),
+			JobSetName: job.Jobset(),
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: s.now(),
+					Event: &armadaevents.EventSequence_Event_JobErrors{
+						JobErrors: &armadaevents.JobErrors{
+							JobId: protoJobId,
+							Errors: []*armadaevents.Error{
+								{
+									Terminal: true,
+									Reason: &armadaevents.Error_JobDependencyFailed{
+										JobDependencyFailed: &armadaevents.JobDependencyFailed{
+											DependencyJobId: dependency.JobId,
+											Message:         message,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		jobsToFail = append(jobsToFail, job)
+	}
+	if err := txn.Upsert(jobsToFail); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
 // now is a convenience function for generating a pointer to a time.Time (as required by armadaevents).
 // It exists because Go won't let you do &s.clock.Now().
 func (s *Scheduler) now() *time.Time {
@@ -942,6 +1355,46 @@ func (s *Scheduler) ensureDbUpToDate(ctx *armadacontext.Context, pollInterval ti
 	}
 }
 
+// ingestionMarkers stores information about a group of marker messages published to Pulsar.
+type ingestionMarkers struct {
+	// Id shared by all markers in the group.
+	groupId uuid.UUID
+	// Number of markers published, i.e., the number of partitions.
+	numSent uint32
+	// Time at which the markers were published.
+	sentAt time.Time
+}
+
+// updateIngestionLag returns the current ingestion lag,
+// i.e., an estimate of the time taken for messages published to Pulsar to be written to Postgres.
+// It publishes a group of marker messages to Pulsar and, on subsequent calls, checks whether these have been written to Postgres.
+// While the markers are outstanding, the lag is the time since the markers were published.
+// Once all markers have been received, the lag is zero and a new group of markers is published.
+func (s *Scheduler) updateIngestionLag(ctx *armadacontext.Context) (time.Duration, error) {
+	if markers := s.pendingIngestionMarkers; markers != nil {
+		numReceived, err := s.jobRepository.CountReceivedPartitions(ctx, markers.groupId)
+		if err != nil {
+			return 0, err
+		}
+		if numReceived < markers.numSent {
+			return s.clock.Since(markers.sentAt), nil
+		}
+		s.pendingIngestionMarkers = nil
+	}
+	groupId := uuid.New()
+	sentAt := s.clock.Now()
+	numSent, err := s.publisher.PublishMarkers(ctx, groupId)
+	if err != nil {
+		return 0, err
+	}
+	s.pendingIngestionMarkers = &ingestionMarkers{
+		groupId: groupId,
+		numSent: numSent,
+		sentAt:  sentAt,
+	}
+	return 0, nil
+}
+
 // schedulerJobFromDatabaseJob creates a new scheduler job from a database job.
 func (s *Scheduler) schedulerJobFromDatabaseJob(dbJob *database.Job) (*jobdb.Job, error) {
 	schedulingInfo := &schedulerobjects.JobSchedulingInfo{}
@@ -962,13 +1415,13 @@ func (s *Scheduler) schedulerJobFromDatabaseJob(dbJob *database.Job) (*jobdb.Job
 		dbJob.CancelByJobsetRequested,
 		dbJob.Cancelled,
 		dbJob.Submitted,
-	), nil
+	).WithOwner(s.stringInterner.Intern(dbJob.UserID)), nil
 }
 
 // createSchedulerRun creates a new scheduler job run from a database job run
 func (s *Scheduler) createSchedulerRun(dbRun *database.Run) *jobdb.JobRun {
 	nodeId := api.NodeIdFromExecutorAndNodeName(dbRun.Executor, dbRun.Node)
-	return jobdb.CreateRun(
+	run := jobdb.CreateRun(
 		dbRun.RunID,
 		dbRun.JobID,
 		dbRun.Created,
@@ -982,6 +1435,10 @@ func (s *Scheduler) createSchedulerRun(dbRun *database.Run) *jobdb.JobRun {
 		dbRun.Returned,
 		dbRun.RunAttempted,
 	)
+	if dbRun.RunningTimestamp != nil {
+		run = run.WithRunningTime(dbRun.RunningTimestamp.UnixNano())
+	}
+	return run
 }
 
 func (s *Scheduler) internJobSchedulingInfoStrings(info *schedulerobjects.JobSchedulingInfo) {
@@ -1016,9 +1473,25 @@ func updateSchedulerRun(run *jobdb.JobRun, dbRun *database.Run) *jobdb.JobRun {
 	if dbRun.RunAttempted && !run.RunAttempted() {
 		run = run.WithAttempted(true)
 	}
+	if dbRun.Running && !run.Running() {
+		run = run.WithRunning(true)
+	}
+	if dbRun.RunningTimestamp != nil && run.RunningTime() == 0 {
+		run = run.WithRunningTime(dbRun.RunningTimestamp.UnixNano())
+	}
 	return run
 }
 
+// hasStartedRunning returns true if any run of job started running.
+func hasStartedRunning(job *jobdb.Job) bool {
+	for _, run := range job.AllRuns() {
+		if run.Running() {
+			return true
+		}
+	}
+	return false
+}
+
 // updateSchedulerJob updates the scheduler job in-place to match the database job.
 func updateSchedulerJob(job *jobdb.Job, dbJob *database.Job) (*jobdb.Job, error) {
 	if dbJob.CancelRequested && !job.CancelRequested() {
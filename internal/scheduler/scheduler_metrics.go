@@ -1,14 +1,19 @@
 package scheduler
 
 import (
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
 	"github.com/armadaproject/armada/internal/scheduler/interfaces"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 )
 
 const (
@@ -31,6 +36,35 @@ type SchedulerMetrics struct {
 	fairSharePerQueue prometheus.GaugeVec
 	// Actual share of each queue.
 	actualSharePerQueue prometheus.GaugeVec
+	// Number of scheduling rounds skipped per reason.
+	skippedSchedulingRounds prometheus.CounterVec
+	// Resources scheduled per pool and priority class.
+	scheduledResources prometheus.CounterVec
+	// Resources evicted per pool and priority class.
+	evictedResources prometheus.CounterVec
+	// Number of jobs rejected because their scheduling key was known to be unfeasible, per pool.
+	unfeasibleSchedulingKeyHits prometheus.CounterVec
+	// Time spent in each phase of scheduling rounds, per pool.
+	schedulingPhaseTime prometheus.HistogramVec
+	// Number of scheduling rounds finished per pool and termination reason.
+	schedulingRoundTerminations prometheus.CounterVec
+	// Time from submission to first lease per queue and priority class.
+	timeToFirstLease prometheus.HistogramVec
+	// Time from submission to running per queue and priority class.
+	timeToRunning prometheus.HistogramVec
+	// Number of jobs that couldn't be scheduled in the last round per pool and matching node type.
+	unschedulableJobs prometheus.GaugeVec
+	// Resources requested by jobs that couldn't be scheduled in the last round per pool and matching node type.
+	unschedulableDemand prometheus.GaugeVec
+	// Number of jobs that couldn't be scheduled in the last round since no node type matched them,
+	// per pool and reason nodes were excluded for.
+	unmatchedJobs prometheus.GaugeVec
+	// Number of runs leased to each executor that it didn't report.
+	missingRuns prometheus.GaugeVec
+	// Number of runs reported by each executor that aren't active.
+	orphanedRuns prometheus.GaugeVec
+	// Number of runs returned since the executor they were leased to lost them, per executor.
+	lostRuns prometheus.CounterVec
 }
 
 func NewSchedulerMetrics(config configuration.SchedulerMetricsConfig) *SchedulerMetrics {
@@ -125,6 +159,198 @@ func NewSchedulerMetrics(config configuration.SchedulerMetricsConfig) *Scheduler
 		},
 	)
 
+	skippedSchedulingRounds := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "skipped_scheduling_rounds",
+			Help:      "Number of scheduling rounds skipped per reason.",
+		},
+		[]string{
+			"reason",
+		},
+	)
+
+	scheduledResources := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "scheduled_resources",
+			Help:      "Resources scheduled each round per pool and priority class.",
+		},
+		[]string{
+			"pool",
+			"priority_class",
+			"resource",
+		},
+	)
+
+	evictedResources := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "evicted_resources",
+			Help:      "Resources evicted each round per pool and priority class.",
+		},
+		[]string{
+			"pool",
+			"priority_class",
+			"resource",
+		},
+	)
+
+	unfeasibleSchedulingKeyHits := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "unfeasible_scheduling_key_hits",
+			Help:      "Number of jobs not considered for scheduling since a job with equal requirements was unschedulable earlier in the round.",
+		},
+		[]string{
+			"pool",
+		},
+	)
+
+	schedulingPhaseTime := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "scheduling_phase_times",
+			Help:      "Time spent in each phase of a scheduling round per pool.",
+			Buckets: prometheus.ExponentialBuckets(
+				config.ScheduleCycleTimeHistogramSettings.Start,
+				config.ScheduleCycleTimeHistogramSettings.Factor,
+				config.ScheduleCycleTimeHistogramSettings.Count),
+		},
+		[]string{
+			"pool",
+			"phase",
+		},
+	)
+
+	schedulingRoundTerminations := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "scheduling_round_terminations",
+			Help:      "Number of scheduling rounds finished per pool and termination reason.",
+		},
+		[]string{
+			"pool",
+			"reason",
+		},
+	)
+
+	queueingLatencyBuckets := prometheus.ExponentialBuckets(
+		config.QueueingLatencyHistogramSettings.Start,
+		config.QueueingLatencyHistogramSettings.Factor,
+		config.QueueingLatencyHistogramSettings.Count,
+	)
+
+	timeToFirstLease := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "time_to_first_lease_seconds",
+			Help:      "Time from submission of jobs to their first lease per queue and priority class.",
+			Buckets:   queueingLatencyBuckets,
+		},
+		[]string{
+			"queue",
+			"priority_class",
+		},
+	)
+
+	timeToRunning := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "time_to_running_seconds",
+			Help:      "Time from submission of jobs to the first of their runs starting to run per queue and priority class.",
+			Buckets:   queueingLatencyBuckets,
+		},
+		[]string{
+			"queue",
+			"priority_class",
+		},
+	)
+
+	unschedulableJobs := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "unschedulable_jobs",
+			Help:      "Number of jobs that couldn't be scheduled in the last round per pool and node type matching the jobs.",
+		},
+		[]string{
+			"pool",
+			"node_type",
+		},
+	)
+
+	unschedulableDemand := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "unschedulable_demand",
+			Help:      "Resources requested by jobs that couldn't be scheduled in the last round per pool and node type matching the jobs.",
+		},
+		[]string{
+			"pool",
+			"node_type",
+			"resource",
+		},
+	)
+
+	unmatchedJobs := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "unmatched_jobs",
+			Help:      "Number of jobs that couldn't be scheduled in the last round since no node type matched them per pool and reason nodes were excluded for.",
+		},
+		[]string{
+			"pool",
+			"reason",
+		},
+	)
+
+	missingRuns := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "missing_runs",
+			Help:      "Number of active runs leased to an executor that weren't reported by it.",
+		},
+		[]string{
+			"executor",
+		},
+	)
+
+	orphanedRuns := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "orphaned_runs",
+			Help:      "Number of runs reported by an executor that aren't active.",
+		},
+		[]string{
+			"executor",
+		},
+	)
+
+	lostRuns := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Subsystem: SUBSYSTEM,
+			Name:      "lost_runs",
+			Help:      "Number of runs returned since the executor they were leased to stopped reporting them.",
+		},
+		[]string{
+			"executor",
+		},
+	)
+
 	prometheus.MustRegister(scheduleCycleTime)
 	prometheus.MustRegister(reconcileCycleTime)
 	prometheus.MustRegister(scheduledJobs)
@@ -132,15 +358,43 @@ func NewSchedulerMetrics(config configuration.SchedulerMetricsConfig) *Scheduler
 	prometheus.MustRegister(consideredJobs)
 	prometheus.MustRegister(fairSharePerQueue)
 	prometheus.MustRegister(actualSharePerQueue)
+	prometheus.MustRegister(skippedSchedulingRounds)
+	prometheus.MustRegister(scheduledResources)
+	prometheus.MustRegister(evictedResources)
+	prometheus.MustRegister(unfeasibleSchedulingKeyHits)
+	prometheus.MustRegister(schedulingPhaseTime)
+	prometheus.MustRegister(schedulingRoundTerminations)
+	prometheus.MustRegister(timeToFirstLease)
+	prometheus.MustRegister(timeToRunning)
+	prometheus.MustRegister(unschedulableJobs)
+	prometheus.MustRegister(unschedulableDemand)
+	prometheus.MustRegister(unmatchedJobs)
+	prometheus.MustRegister(missingRuns)
+	prometheus.MustRegister(orphanedRuns)
+	prometheus.MustRegister(lostRuns)
 
 	return &SchedulerMetrics{
-		scheduleCycleTime:     scheduleCycleTime,
-		reconcileCycleTime:    reconcileCycleTime,
-		scheduledJobsPerQueue: *scheduledJobs,
-		preemptedJobsPerQueue: *preemptedJobs,
-		consideredJobs:        *consideredJobs,
-		fairSharePerQueue:     *fairSharePerQueue,
-		actualSharePerQueue:   *actualSharePerQueue,
+		scheduleCycleTime:           scheduleCycleTime,
+		reconcileCycleTime:          reconcileCycleTime,
+		scheduledJobsPerQueue:       *scheduledJobs,
+		preemptedJobsPerQueue:       *preemptedJobs,
+		consideredJobs:              *consideredJobs,
+		fairSharePerQueue:           *fairSharePerQueue,
+		actualSharePerQueue:         *actualSharePerQueue,
+		skippedSchedulingRounds:     *skippedSchedulingRounds,
+		scheduledResources:          *scheduledResources,
+		evictedResources:            *evictedResources,
+		unfeasibleSchedulingKeyHits: *unfeasibleSchedulingKeyHits,
+		schedulingPhaseTime:         *schedulingPhaseTime,
+		schedulingRoundTerminations: *schedulingRoundTerminations,
+		timeToFirstLease:            *timeToFirstLease,
+		timeToRunning:               *timeToRunning,
+		unschedulableJobs:           *unschedulableJobs,
+		unschedulableDemand:         *unschedulableDemand,
+		unmatchedJobs:               *unmatchedJobs,
+		missingRuns:                 *missingRuns,
+		orphanedRuns:                *orphanedRuns,
+		lostRuns:                    *lostRuns,
 	}
 }
 
@@ -149,6 +403,29 @@ func (metrics *SchedulerMetrics) ResetGaugeMetrics() {
 	metrics.actualSharePerQueue.Reset()
 }
 
+// ResetUnschedulableDemand resets the metrics of the demand of unschedulable jobs.
+// Unlike other gauges, these are kept between scheduling rounds and must be reset explicitly, e.g., when losing leadership,
+// since cluster autoscalers act on them.
+func (metrics *SchedulerMetrics) ResetUnschedulableDemand() {
+	metrics.unschedulableJobs.Reset()
+	metrics.unschedulableDemand.Reset()
+	metrics.unmatchedJobs.Reset()
+}
+
+// ReportRunDrift sets the number of missing and orphaned runs of each executor.
+func (metrics *SchedulerMetrics) ReportRunDrift(driftByExecutor map[string]RunDrift) {
+	metrics.missingRuns.Reset()
+	metrics.orphanedRuns.Reset()
+	for executor, drift := range driftByExecutor {
+		metrics.missingRuns.WithLabelValues(executor).Set(float64(drift.MissingRuns))
+		metrics.orphanedRuns.WithLabelValues(executor).Set(float64(drift.OrphanedRuns))
+	}
+}
+
+func (metrics *SchedulerMetrics) ReportLostRun(executor string) {
+	metrics.lostRuns.WithLabelValues(executor).Inc()
+}
+
 func (metrics *SchedulerMetrics) ReportScheduleCycleTime(cycleTime time.Duration) {
 	metrics.scheduleCycleTime.Observe(float64(cycleTime.Milliseconds()))
 }
@@ -158,6 +435,9 @@ func (metrics *SchedulerMetrics) ReportReconcileCycleTime(cycleTime time.Duratio
 }
 
 func (metrics *SchedulerMetrics) ReportSchedulerResult(ctx *armadacontext.Context, result SchedulerResult) {
+	if result.TerminationReason != "" {
+		metrics.skippedSchedulingRounds.WithLabelValues(result.TerminationReason).Inc()
+	}
 	if result.EmptyResult {
 		return // TODO: Add logging or maybe place to add failure metric?
 	}
@@ -165,11 +445,14 @@ func (metrics *SchedulerMetrics) ReportSchedulerResult(ctx *armadacontext.Contex
 	// Report the total scheduled jobs (possibly we can get these out of contexts?)
 	metrics.reportScheduledJobs(ctx, result.ScheduledJobs)
 	metrics.reportPreemptedJobs(ctx, result.PreemptedJobs)
+	metrics.reportTimeToFirstLease(result.ScheduledJobs)
 
 	// TODO: When more metrics are added, consider consolidating into a single loop over the data.
 	// Report the number of considered jobs.
 	metrics.reportNumberOfJobsConsidered(ctx, result.SchedulingContexts)
 	metrics.reportQueueShares(ctx, result.SchedulingContexts)
+	metrics.reportSchedulingContexts(result.SchedulingContexts)
+	metrics.reportUnschedulableDemand(result.SchedulingContexts)
 }
 
 func (metrics *SchedulerMetrics) reportScheduledJobs(ctx *armadacontext.Context, scheduledJobs []interfaces.LegacySchedulerJob) {
@@ -182,6 +465,27 @@ func (metrics *SchedulerMetrics) reportPreemptedJobs(ctx *armadacontext.Context,
 	observeJobAggregates(ctx, metrics.preemptedJobsPerQueue, jobAggregates)
 }
 
+// reportTimeToFirstLease reports the time from submission to lease of scheduled jobs leased for the first time.
+func (metrics *SchedulerMetrics) reportTimeToFirstLease(scheduledJobs []interfaces.LegacySchedulerJob) {
+	for _, job := range scheduledJobs {
+		jobDbJob, ok := job.(*jobdb.Job)
+		if !ok || len(jobDbJob.AllRuns()) != 1 {
+			continue
+		}
+		leaseTime := time.Unix(0, jobDbJob.LatestRun().Created())
+		metrics.timeToFirstLease.
+			WithLabelValues(jobDbJob.Queue(), jobDbJob.GetPriorityClassName()).
+			Observe(leaseTime.Sub(time.Unix(0, jobDbJob.Created())).Seconds())
+	}
+}
+
+// ReportJobRunning reports the time from submission of job to runningTime, at which the first of its runs started to run.
+func (metrics *SchedulerMetrics) ReportJobRunning(job *jobdb.Job, runningTime time.Time) {
+	metrics.timeToRunning.
+		WithLabelValues(job.Queue(), job.GetPriorityClassName()).
+		Observe(runningTime.Sub(time.Unix(0, job.Created())).Seconds())
+}
+
 type collectionKey struct {
 	queue         string
 	priorityClass string
@@ -248,7 +552,10 @@ func (metrics *SchedulerMetrics) reportQueueShares(ctx *armadacontext.Context, s
 				observer.Set(fairShare)
 			}
 
-			actualShare := schedContext.FairnessCostProvider.CostFromQueue(queueContext) / totalCost
+			actualShare := 0.0
+			if totalCost > 0 {
+				actualShare = schedContext.FairnessCostProvider.CostFromQueue(queueContext) / totalCost
+			}
 
 			observer, err = metrics.actualSharePerQueue.GetMetricWithLabelValues(queue, pool)
 			if err != nil {
@@ -259,3 +566,85 @@ func (metrics *SchedulerMetrics) reportQueueShares(ctx *armadacontext.Context, s
 		}
 	}
 }
+
+// reportSchedulingContexts reports what happened in each scheduling round,
+// i.e., the resources scheduled and evicted, how often unfeasible scheduling keys were hit,
+// the time spent in each phase, and why the round finished.
+func (metrics *SchedulerMetrics) reportSchedulingContexts(schedulingContexts []*schedulercontext.SchedulingContext) {
+	for _, schedContext := range schedulingContexts {
+		pool := schedContext.Pool
+		addResourcesByPriorityClass(metrics.scheduledResources, pool, schedContext.ScheduledResourcesByPriorityClass)
+		addResourcesByPriorityClass(metrics.evictedResources, pool, schedContext.EvictedResourcesByPriorityClass)
+		metrics.unfeasibleSchedulingKeyHits.WithLabelValues(pool).Add(float64(schedContext.NumUnfeasibleSchedulingKeyHits))
+		for phase, d := range schedContext.DurationByPhase {
+			metrics.schedulingPhaseTime.WithLabelValues(pool, phase).Observe(float64(d.Milliseconds()))
+		}
+		if schedContext.TerminationReason != "" {
+			metrics.schedulingRoundTerminations.WithLabelValues(pool, schedContext.TerminationReason).Inc()
+		}
+	}
+}
+
+func addResourcesByPriorityClass(metric prometheus.CounterVec, pool string, resourcesByPriorityClass schedulerobjects.QuantityByTAndResourceType[string]) {
+	for priorityClass, rl := range resourcesByPriorityClass {
+		for resource, quantity := range rl.Resources {
+			if value := quantity.AsApproximateFloat64(); value > 0 {
+				metric.WithLabelValues(pool, priorityClass, resource).Add(value)
+			}
+		}
+	}
+}
+
+// reportUnschedulableDemand reports the jobs that couldn't be scheduled in the last round and the resources they request,
+// per node type they match, so that cluster autoscalers can scale up the node groups Armada needs.
+// A job matching several node types is reported for each of them.
+// Jobs not considered by the node database, e.g., since scheduling them would exceed a queue limit, aren't reported,
+// since adding nodes wouldn't make them schedulable.
+func (metrics *SchedulerMetrics) reportUnschedulableDemand(schedulingContexts []*schedulercontext.SchedulingContext) {
+	metrics.ResetUnschedulableDemand()
+	for _, schedContext := range schedulingContexts {
+		pool := schedContext.Pool
+		for _, qctx := range schedContext.QueueSchedulingContexts {
+			for _, jctx := range qctx.UnsuccessfulJobSchedulingContexts {
+				pctx := jctx.PodSchedulingContext
+				if jctx.IsEvicted || pctx == nil || pctx.NodeId != "" {
+					continue
+				}
+				if len(pctx.MatchingNodeTypes) == 0 {
+					for reason, n := range pctx.NumExcludedNodesByReason {
+						if n > 0 {
+							metrics.unmatchedJobs.WithLabelValues(pool, reason).Inc()
+						}
+					}
+					continue
+				}
+				for _, nodeType := range pctx.MatchingNodeTypes {
+					nodeTypeName := nodeTypeLabelValue(nodeType)
+					metrics.unschedulableJobs.WithLabelValues(pool, nodeTypeName).Inc()
+					for resource, quantity := range jctx.PodRequirements.ResourceRequirements.Requests {
+						if value := quantity.AsApproximateFloat64(); value > 0 {
+							metrics.unschedulableDemand.WithLabelValues(pool, nodeTypeName, string(resource)).Add(value)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// nodeTypeLabelValue returns a human-readable name of the node type, made up of its labels and taints,
+// e.g., "gpu=a100,nvidia.com/gpu=true:NoSchedule", by which cluster autoscalers can identify the corresponding node group.
+func nodeTypeLabelValue(nodeType *schedulerobjects.NodeType) string {
+	parts := make([]string, 0, len(nodeType.Labels)+len(nodeType.Taints))
+	labelKeys := maps.Keys(nodeType.Labels)
+	slices.Sort(labelKeys)
+	for _, key := range labelKeys {
+		parts = append(parts, key+"="+nodeType.Labels[key])
+	}
+	taints := make([]string, len(nodeType.Taints))
+	for i, taint := range nodeType.Taints {
+		taints[i] = taint.ToString()
+	}
+	slices.Sort(taints)
+	return strings.Join(append(parts, taints...), ",")
+}
@@ -2,10 +2,20 @@ package scheduler
 
 import (
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/interfaces"
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
 )
 
@@ -31,3 +41,104 @@ func TestAggregateJobs(t *testing.T) {
 
 	assert.Equal(t, expected, actual)
 }
+
+func TestReportSchedulingContexts(t *testing.T) {
+	pool := "scheduler-metrics-test"
+	sctx := schedulercontext.NewSchedulingContext("executor", pool, testfixtures.TestPriorityClasses, testfixtures.PriorityClass0, nil, nil, schedulerobjects.ResourceList{})
+	sctx.ScheduledResourcesByPriorityClass[testfixtures.PriorityClass0] = schedulerobjects.ResourceList{
+		Resources: map[string]resource.Quantity{"cpu": resource.MustParse("1500m"), "memory": resource.MustParse("1Gi")},
+	}
+	sctx.EvictedResourcesByPriorityClass[testfixtures.PriorityClass1] = schedulerobjects.ResourceList{
+		Resources: map[string]resource.Quantity{"cpu": resource.MustParse("1")},
+	}
+	sctx.NumUnfeasibleSchedulingKeyHits = 3
+	sctx.AddPhaseDuration(PhaseBalancingEviction, 10*time.Millisecond)
+	sctx.AddPhaseDuration(PhaseBalancingEviction, 5*time.Millisecond)
+	sctx.TerminationReason = "no remaining candidate jobs"
+
+	schedulerMetrics.reportSchedulingContexts([]*schedulercontext.SchedulingContext{sctx})
+
+	assert.Equal(t, 1.5, testutil.ToFloat64(schedulerMetrics.scheduledResources.WithLabelValues(pool, testfixtures.PriorityClass0, "cpu")))
+	assert.Equal(t, float64(1<<30), testutil.ToFloat64(schedulerMetrics.scheduledResources.WithLabelValues(pool, testfixtures.PriorityClass0, "memory")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(schedulerMetrics.evictedResources.WithLabelValues(pool, testfixtures.PriorityClass1, "cpu")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(schedulerMetrics.unfeasibleSchedulingKeyHits.WithLabelValues(pool)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(schedulerMetrics.schedulingRoundTerminations.WithLabelValues(pool, "no remaining candidate jobs")))
+	assert.Equal(t, 1, testutil.CollectAndCount(schedulerMetrics.schedulingPhaseTime.MustCurryWith(prometheus.Labels{"pool": pool})))
+}
+
+func TestReportUnschedulableDemand(t *testing.T) {
+	pool := "unschedulable-demand-test"
+	cpuNodeType := &schedulerobjects.NodeType{Labels: map[string]string{"zone": "a"}}
+	gpuNodeType := &schedulerobjects.NodeType{
+		Labels: map[string]string{"zone": "a", "gpu": "a100"},
+		Taints: []v1.Taint{{Key: "nvidia.com/gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}},
+	}
+	jctx := func(pctx *schedulercontext.PodSchedulingContext) *schedulercontext.JobSchedulingContext {
+		return &schedulercontext.JobSchedulingContext{
+			PodRequirements: &schedulerobjects.PodRequirements{
+				ResourceRequirements: v1.ResourceRequirements{
+					Requests: v1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("1Gi")},
+				},
+			},
+			PodSchedulingContext: pctx,
+		}
+	}
+	sctx := &schedulercontext.SchedulingContext{
+		Pool: pool,
+		QueueSchedulingContexts: map[string]*schedulercontext.QueueSchedulingContext{
+			"queue": {
+				UnsuccessfulJobSchedulingContexts: map[string]*schedulercontext.JobSchedulingContext{
+					"matchesBoth": jctx(&schedulercontext.PodSchedulingContext{
+						MatchingNodeTypes: []*schedulerobjects.NodeType{cpuNodeType, gpuNodeType},
+					}),
+					"matchesCpu": jctx(&schedulercontext.PodSchedulingContext{
+						MatchingNodeTypes: []*schedulerobjects.NodeType{cpuNodeType},
+					}),
+					"matchesNone": jctx(&schedulercontext.PodSchedulingContext{
+						NumExcludedNodesByReason: map[string]int{"node selector not met": 3},
+					}),
+					// Not considered by the node database.
+					"overQueueLimit": jctx(nil),
+				},
+			},
+		},
+	}
+
+	schedulerMetrics.reportUnschedulableDemand([]*schedulercontext.SchedulingContext{sctx})
+
+	assert.Equal(t, "zone=a", nodeTypeLabelValue(cpuNodeType))
+	assert.Equal(t, "gpu=a100,zone=a,nvidia.com/gpu=true:NoSchedule", nodeTypeLabelValue(gpuNodeType))
+	assert.Equal(t, 2.0, testutil.ToFloat64(schedulerMetrics.unschedulableJobs.WithLabelValues(pool, "zone=a")))
+	assert.Equal(t, 4.0, testutil.ToFloat64(schedulerMetrics.unschedulableDemand.WithLabelValues(pool, "zone=a", "cpu")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(schedulerMetrics.unschedulableJobs.WithLabelValues(pool, nodeTypeLabelValue(gpuNodeType))))
+	assert.Equal(t, float64(1<<30), testutil.ToFloat64(schedulerMetrics.unschedulableDemand.WithLabelValues(pool, nodeTypeLabelValue(gpuNodeType), "memory")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(schedulerMetrics.unmatchedJobs.WithLabelValues(pool, "node selector not met")))
+
+	// Demand is replaced each round.
+	schedulerMetrics.reportUnschedulableDemand(nil)
+	assert.Equal(t, 0, testutil.CollectAndCount(schedulerMetrics.unschedulableDemand))
+}
+
+func TestReportQueueingLatencies(t *testing.T) {
+	queue := "scheduler-metrics-test"
+	submitted := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := testfixtures.Test1Cpu4GiJob(queue, testfixtures.PriorityClass0).WithCreated(submitted.UnixNano())
+	leasedJob := job.WithNewRun("executor", "node", "node")
+	releasedJob := leasedJob.WithNewRun("executor", "node", "node")
+
+	// Only jobs leased for the first time are reported.
+	schedulerMetrics.reportTimeToFirstLease([]interfaces.LegacySchedulerJob{leasedJob, releasedJob})
+	count, _ := histogramSample(t, schedulerMetrics.timeToFirstLease, queue, testfixtures.PriorityClass0)
+	assert.Equal(t, uint64(1), count)
+
+	schedulerMetrics.ReportJobRunning(leasedJob, submitted.Add(time.Minute))
+	count, sum := histogramSample(t, schedulerMetrics.timeToRunning, queue, testfixtures.PriorityClass0)
+	assert.Equal(t, uint64(1), count)
+	assert.Equal(t, 60.0, sum)
+}
+
+func histogramSample(t *testing.T, metric prometheus.HistogramVec, labelValues ...string) (uint64, float64) {
+	m := &dto.Metric{}
+	require.NoError(t, metric.WithLabelValues(labelValues...).(prometheus.Histogram).Write(m))
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+}
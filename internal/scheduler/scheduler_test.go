@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"testing"
@@ -103,6 +104,11 @@ var (
 			Factor: 1.1,
 			Count:  100,
 		},
+		QueueingLatencyHistogramSettings: configuration.HistogramConfig{
+			Start:  1,
+			Factor: 1.5,
+			Count:  30,
+		},
 	})
 )
 
@@ -624,9 +630,15 @@ func TestScheduler_TestCycle(t *testing.T) {
 				publisher,
 				stringInterner,
 				submitChecker,
+				nil,
+				nil,
+				nil,
 				1*time.Second,
 				5*time.Second,
+				0,
 				clusterTimeout,
+				0,
+				0,
 				maxNumberOfAttempts,
 				nodeIdLabel,
 				schedulerMetrics,
@@ -791,9 +803,15 @@ func TestRun(t *testing.T) {
 		publisher,
 		stringInterner,
 		submitChecker,
+		nil,
+		nil,
+		nil,
 		1*time.Second,
 		15*time.Second,
+		0,
 		1*time.Hour,
+		0,
+		0,
 		maxNumberOfAttempts,
 		nodeIdLabel,
 		schedulerMetrics)
@@ -841,6 +859,434 @@ func TestRun(t *testing.T) {
 	cancel()
 }
 
+// Test that a cycle in progress on shutdown completes and publishes its decisions.
+func TestRun_CompletesCycleInProgressOnShutdown(t *testing.T) {
+	jobRepo := testJobRepository{numReceivedPartitions: 100}
+	testClock := clock.NewFakeClock(time.Now())
+	scheduleStarted := make(chan struct{})
+	finishSchedule := make(chan struct{})
+	var scheduleCtxErr error
+	schedulingAlgo := &testSchedulingAlgo{
+		onSchedule: func(ctx *armadacontext.Context) {
+			close(scheduleStarted)
+			<-finishSchedule
+			scheduleCtxErr = ctx.Err()
+		},
+	}
+	publisher := &testPublisher{}
+	stringInterner, err := stringinterner.New(100)
+	require.NoError(t, err)
+
+	sched, err := NewScheduler(
+		testfixtures.NewJobDb(),
+		&jobRepo,
+		&testExecutorRepository{},
+		schedulingAlgo,
+		NewStandaloneLeaderController(),
+		publisher,
+		stringInterner,
+		&testSubmitChecker{checkSuccess: true},
+		nil,
+		nil,
+		nil,
+		1*time.Second,
+		5*time.Second,
+		0,
+		1*time.Hour,
+		0,
+		1*time.Hour,
+		maxNumberOfAttempts,
+		nodeIdLabel,
+		schedulerMetrics)
+	require.NoError(t, err)
+	sched.clock = testClock
+
+	jobId := util.NewULID()
+	jobRepo.updatedJobs = []database.Job{{JobID: jobId, Queue: "testQueue", Queued: true}}
+	schedulingAlgo.jobsToSchedule = []string{jobId}
+
+	ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+	runErr := make(chan error)
+	go func() {
+		runErr <- sched.Run(ctx)
+	}()
+	require.Eventually(t, func() bool { return testClock.HasWaiters() }, time.Second, time.Millisecond)
+	testClock.Step(10 * time.Second)
+
+	// Shut down while the cycle is scheduling.
+	<-scheduleStarted
+	cancel()
+	close(finishSchedule)
+
+	assert.ErrorIs(t, <-runErr, context.Canceled)
+	assert.NoError(t, scheduleCtxErr)
+	assert.Equal(t, 1, len(publisher.events))
+}
+
+func TestScheduler_SkipsSchedulingIfIngestionLagExceedsMaximum(t *testing.T) {
+	jobRepo := &testJobRepository{}
+	testClock := clock.NewFakeClock(time.Now())
+	schedulingAlgo := &testSchedulingAlgo{}
+	stringInterner, err := stringinterner.New(100)
+	require.NoError(t, err)
+	sched, err := NewScheduler(
+		testfixtures.NewJobDb(),
+		jobRepo,
+		&testExecutorRepository{},
+		schedulingAlgo,
+		NewStandaloneLeaderController(),
+		&testPublisher{},
+		stringInterner,
+		&testSubmitChecker{checkSuccess: true},
+		nil,
+		nil,
+		nil,
+		1*time.Second,
+		5*time.Second,
+		0,
+		1*time.Hour,
+		10*time.Second,
+		0,
+		maxNumberOfAttempts,
+		nodeIdLabel,
+		schedulerMetrics,
+	)
+	require.NoError(t, err)
+	sched.clock = testClock
+	ctx := armadacontext.Background()
+
+	// No markers have been published yet; schedule and publish markers.
+	result, err := sched.cycle(ctx, false, sched.leaderController.GetToken(), true)
+	require.NoError(t, err)
+	assert.Empty(t, result.TerminationReason)
+	assert.Equal(t, 1, schedulingAlgo.numberOfScheduleCalls)
+
+	// Markers are outstanding but within the maximum lag.
+	testClock.Step(5 * time.Second)
+	result, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), true)
+	require.NoError(t, err)
+	assert.Empty(t, result.TerminationReason)
+	assert.Equal(t, 2, schedulingAlgo.numberOfScheduleCalls)
+
+	// Markers are outstanding and exceed the maximum lag; skip scheduling.
+	testClock.Step(10 * time.Second)
+	result, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), true)
+	require.NoError(t, err)
+	assert.Equal(t, TerminationReasonIngestionLag, result.TerminationReason)
+	assert.Equal(t, 2, schedulingAlgo.numberOfScheduleCalls)
+
+	// All markers have been received; schedule again.
+	jobRepo.numReceivedPartitions = 100
+	result, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), true)
+	require.NoError(t, err)
+	assert.Empty(t, result.TerminationReason)
+	assert.Equal(t, 3, schedulingAlgo.numberOfScheduleCalls)
+}
+
+func TestScheduler_FailsJobsWithFailedDependencies(t *testing.T) {
+	succeededJobId := util.NewULID()
+	failedJobId := util.NewULID()
+	jobRepo := &testJobRepository{succeededByJobId: map[string]bool{succeededJobId: true, failedJobId: false}}
+	stringInterner, err := stringinterner.New(100)
+	require.NoError(t, err)
+	dependencyIndex := NewDependencyIndex(jobRepo, 0)
+	sched, err := NewScheduler(
+		testfixtures.NewJobDb(),
+		jobRepo,
+		&testExecutorRepository{},
+		&testSchedulingAlgo{},
+		NewStandaloneLeaderController(),
+		&testPublisher{},
+		stringInterner,
+		&testSubmitChecker{checkSuccess: true},
+		nil,
+		dependencyIndex,
+		nil,
+		1*time.Second,
+		5*time.Second,
+		0,
+		1*time.Hour,
+		0,
+		0,
+		maxNumberOfAttempts,
+		nodeIdLabel,
+		schedulerMetrics,
+	)
+	require.NoError(t, err)
+	ctx := armadacontext.Background()
+
+	jobs := testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 4)
+	for i, job := range jobs {
+		jobs[i] = job.WithQueued(true)
+	}
+	queuedDependency, waitingJob, satisfiedJob, failingJob := jobs[0], jobs[1], jobs[2], jobs[3]
+	testfixtures.WithDependenciesJobs([]*jobdb.Job{queuedDependency}, []*jobdb.Job{waitingJob})
+	testfixtures.WithAnnotationsJobs(map[string]string{configuration.JobDependenciesAnnotation: succeededJobId}, []*jobdb.Job{satisfiedJob})
+	testfixtures.WithAnnotationsJobs(map[string]string{configuration.JobDependenciesAnnotation: succeededJobId + "," + failedJobId}, []*jobdb.Job{failingJob})
+	txn := sched.jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert(jobs))
+	dependencyIndex.Observe(ctx, jobs)
+	assert.False(t, dependencyIndex.IsWaiting(queuedDependency.Id()))
+	assert.True(t, dependencyIndex.IsWaiting(satisfiedJob.Id()))
+
+	events, err := sched.failJobsWithFailedDependencies(ctx, txn)
+	require.NoError(t, err)
+	assert.True(t, dependencyIndex.IsWaiting(waitingJob.Id()))
+	assert.False(t, dependencyIndex.IsWaiting(satisfiedJob.Id()))
+	assert.False(t, dependencyIndex.IsWaiting(failingJob.Id()))
+	assert.True(t, txn.GetById(failingJob.Id()).Failed())
+	require.Len(t, events, 1)
+	require.Len(t, events[0].Events, 1)
+	jobErrors := events[0].Events[0].GetJobErrors()
+	require.NotNil(t, jobErrors)
+	expectedJobId, err := armadaevents.ProtoUuidFromUlidString(failingJob.Id())
+	require.NoError(t, err)
+	assert.Equal(t, expectedJobId, jobErrors.JobId)
+	require.Len(t, jobErrors.Errors, 1)
+	assert.True(t, jobErrors.Errors[0].Terminal)
+	assert.Equal(t, failedJobId, jobErrors.Errors[0].GetJobDependencyFailed().GetDependencyJobId())
+
+	// Once the queued dependency succeeds, the waiting job becomes eligible for scheduling.
+	require.NoError(t, txn.Upsert([]*jobdb.Job{queuedDependency.WithQueued(false).WithSucceeded(true)}))
+	events, err = sched.failJobsWithFailedDependencies(ctx, txn)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.False(t, dependencyIndex.IsWaiting(waitingJob.Id()))
+}
+
+func TestScheduler_FailsJobsWithUnknownDependencies(t *testing.T) {
+	succeededJobId := util.NewULID()
+	unknownJobId := util.NewULID()
+	jobRepo := &testJobRepository{succeededByJobId: map[string]bool{succeededJobId: true}}
+	stringInterner, err := stringinterner.New(100)
+	require.NoError(t, err)
+	testClock := clock.NewFakeClock(time.Unix(0, 0))
+	dependencyIndex := NewDependencyIndex(jobRepo, 10*time.Minute)
+	dependencyIndex.clock = testClock
+	sched, err := NewScheduler(
+		testfixtures.NewJobDb(),
+		jobRepo,
+		&testExecutorRepository{},
+		&testSchedulingAlgo{},
+		NewStandaloneLeaderController(),
+		&testPublisher{},
+		stringInterner,
+		&testSubmitChecker{checkSuccess: true},
+		nil,
+		dependencyIndex,
+		nil,
+		1*time.Second,
+		5*time.Second,
+		0,
+		1*time.Hour,
+		0,
+		0,
+		maxNumberOfAttempts,
+		nodeIdLabel,
+		schedulerMetrics,
+	)
+	require.NoError(t, err)
+	ctx := armadacontext.Background()
+
+	jobs := testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 3)
+	for i, job := range jobs {
+		jobs[i] = job.WithQueued(true)
+	}
+	queuedDependency, waitingJob, unknownDependencyJob := jobs[0], jobs[1], jobs[2]
+	testfixtures.WithDependenciesJobs([]*jobdb.Job{queuedDependency}, []*jobdb.Job{waitingJob})
+	testfixtures.WithAnnotationsJobs(map[string]string{configuration.JobDependenciesAnnotation: succeededJobId + "," + unknownJobId}, []*jobdb.Job{unknownDependencyJob})
+	txn := sched.jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert(jobs))
+	dependencyIndex.Observe(ctx, jobs)
+
+	// Unknown dependencies are waited on until the timeout has passed since the job was submitted.
+	events, err := sched.failJobsWithFailedDependencies(ctx, txn)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.True(t, dependencyIndex.IsWaiting(waitingJob.Id()))
+	assert.True(t, dependencyIndex.IsWaiting(unknownDependencyJob.Id()))
+
+	testClock.Step(time.Hour)
+	events, err = sched.failJobsWithFailedDependencies(ctx, txn)
+	require.NoError(t, err)
+	// Dependencies in the jobDb aren't unknown, however long they take.
+	assert.True(t, dependencyIndex.IsWaiting(waitingJob.Id()))
+	assert.False(t, dependencyIndex.IsWaiting(unknownDependencyJob.Id()))
+	assert.True(t, txn.GetById(unknownDependencyJob.Id()).Failed())
+	require.Len(t, events, 1)
+	require.Len(t, events[0].Events, 1)
+	jobErrors := events[0].Events[0].GetJobErrors()
+	require.NotNil(t, jobErrors)
+	require.Len(t, jobErrors.Errors, 1)
+	assert.True(t, jobErrors.Errors[0].Terminal)
+	assert.Equal(t, unknownJobId, jobErrors.Errors[0].GetJobDependencyFailed().GetDependencyJobId())
+	assert.Contains(t, jobErrors.Errors[0].GetJobDependencyFailed().GetMessage(), "does not exist")
+}
+
+func TestScheduler_GeneratesEventsForJobsOnStaleExecutors(t *testing.T) {
+	testClock := clock.NewFakeClock(time.Now())
+	clusterRepo := &testExecutorRepository{
+		updateTimes: map[string]time.Time{"testExecutor": testClock.Now()},
+	}
+	publisher := &testPublisher{}
+	stringInterner, err := stringinterner.New(100)
+	require.NoError(t, err)
+	sched, err := NewScheduler(
+		testfixtures.NewJobDb(),
+		&testJobRepository{},
+		clusterRepo,
+		&testSchedulingAlgo{},
+		NewStandaloneLeaderController(),
+		publisher,
+		stringInterner,
+		&testSubmitChecker{checkSuccess: true},
+		nil,
+		nil,
+		nil,
+		1*time.Second,
+		5*time.Second,
+		10*time.Minute,
+		1*time.Hour,
+		0,
+		0,
+		maxNumberOfAttempts,
+		nodeIdLabel,
+		schedulerMetrics,
+	)
+	require.NoError(t, err)
+	sched.clock = testClock
+	txn := sched.jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*jobdb.Job{leasedJob}))
+	txn.Commit()
+	ctx := armadacontext.Background()
+
+	staleExecutorErrors := func() []*armadaevents.Error {
+		var rv []*armadaevents.Error
+		for _, sequence := range publisher.events {
+			for _, event := range sequence.Events {
+				for _, runError := range event.GetJobRunErrors().GetErrors() {
+					if runError.GetExecutorStale() != nil {
+						rv = append(rv, runError)
+					}
+				}
+			}
+		}
+		return rv
+	}
+
+	// The executor is not stale.
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	assert.Empty(t, staleExecutorErrors())
+
+	// The executor becomes stale; a non-terminal error is published for the job running on it.
+	testClock.Step(20 * time.Minute)
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	if errs := staleExecutorErrors(); assert.Len(t, errs, 1) {
+		assert.False(t, errs[0].Terminal)
+		assert.Equal(t, "testExecutor", errs[0].GetExecutorStale().ExecutorId)
+	}
+
+	// The executor is still stale; no further errors are published.
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	assert.Empty(t, staleExecutorErrors())
+
+	// The executor recovers and then becomes stale again.
+	clusterRepo.updateTimes["testExecutor"] = testClock.Now()
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	assert.Empty(t, staleExecutorErrors())
+	testClock.Step(20 * time.Minute)
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	assert.Len(t, staleExecutorErrors(), 1)
+}
+
+func TestScheduler_ReturnsLostRuns(t *testing.T) {
+	testClock := clock.NewFakeClock(time.Now())
+	executor := &schedulerobjects.Executor{Id: "testExecutor", LastUpdateTime: testClock.Now()}
+	clusterRepo := &testExecutorRepository{
+		updateTimes: map[string]time.Time{"testExecutor": testClock.Now()},
+		executors:   []*schedulerobjects.Executor{executor},
+	}
+	publisher := &testPublisher{}
+	stringInterner, err := stringinterner.New(100)
+	require.NoError(t, err)
+	sched, err := NewScheduler(
+		testfixtures.NewJobDb(),
+		&testJobRepository{},
+		clusterRepo,
+		&testSchedulingAlgo{},
+		NewStandaloneLeaderController(),
+		publisher,
+		stringInterner,
+		&testSubmitChecker{checkSuccess: true},
+		nil,
+		nil,
+		NewRunReconciler(10*time.Minute),
+		1*time.Second,
+		5*time.Second,
+		0,
+		0,
+		0,
+		0,
+		maxNumberOfAttempts,
+		nodeIdLabel,
+		schedulerMetrics,
+	)
+	require.NoError(t, err)
+	sched.clock = testClock
+	txn := sched.jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*jobdb.Job{leasedJob}))
+	txn.Commit()
+	ctx := armadacontext.Background()
+
+	leaseReturnedErrors := func() []*armadaevents.Error {
+		var rv []*armadaevents.Error
+		for _, sequence := range publisher.events {
+			for _, event := range sequence.Events {
+				for _, runError := range event.GetJobRunErrors().GetErrors() {
+					if runError.GetPodLeaseReturned() != nil {
+						rv = append(rv, runError)
+					}
+				}
+			}
+		}
+		return rv
+	}
+
+	// The executor reports the run once it has fetched it.
+	executor.UnassignedJobRuns = []string{leasedJob.LatestRun().Id().String()}
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	assert.Empty(t, leaseReturnedErrors())
+
+	// The executor no longer reports the run, but hasn't done so for long enough for it to be considered lost.
+	executor.UnassignedJobRuns = nil
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	assert.Empty(t, leaseReturnedErrors())
+
+	// The run has been missing for longer than the timeout; its lease is returned.
+	executor.LastUpdateTime = testClock.Now().Add(10 * time.Minute)
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	if errs := leaseReturnedErrors(); assert.Len(t, errs, 1) {
+		assert.True(t, errs[0].Terminal)
+		assert.Equal(t, armadaevents.FailureCategory_NodeLost, errs[0].FailureCategory)
+	}
+	run := sched.jobDb.ReadTxn().GetById(leasedJob.Id()).LatestRun()
+	assert.True(t, run.Failed())
+	assert.True(t, run.Returned())
+
+	// The run is no longer active; its lease isn't returned again.
+	_, err = sched.cycle(ctx, false, sched.leaderController.GetToken(), false)
+	require.NoError(t, err)
+	assert.Empty(t, leaseReturnedErrors())
+}
+
 func TestScheduler_TestSyncState(t *testing.T) {
 	tests := map[string]struct {
 		initialJobs         []*jobdb.Job   // jobs in the jobdb at the start of the cycle
@@ -1002,9 +1448,15 @@ func TestScheduler_TestSyncState(t *testing.T) {
 				publisher,
 				stringInterner,
 				nil,
+				nil,
+				nil,
+				nil,
 				1*time.Second,
 				5*time.Second,
+				0,
 				1*time.Hour,
+				0,
+				0,
 				maxNumberOfAttempts,
 				nodeIdLabel,
 				schedulerMetrics,
@@ -1064,6 +1516,7 @@ type testJobRepository struct {
 	errors                map[uuid.UUID]*armadaevents.Error
 	shouldError           bool
 	numReceivedPartitions uint32
+	succeededByJobId      map[string]bool
 }
 
 func (t *testJobRepository) FindInactiveRuns(ctx *armadacontext.Context, runIds []uuid.UUID) ([]uuid.UUID, error) {
@@ -1076,6 +1529,24 @@ func (t *testJobRepository) FetchJobRunLeases(ctx *armadacontext.Context, execut
 	panic("implement me")
 }
 
+func (t *testJobRepository) FetchTerminalJobs(ctx *armadacontext.Context, jobIds []string) (map[string]bool, error) {
+	if t.shouldError {
+		return nil, errors.New("error fetching terminal jobs")
+	}
+	rv := make(map[string]bool)
+	for _, jobId := range jobIds {
+		if succeeded, ok := t.succeededByJobId[jobId]; ok {
+			rv[jobId] = succeeded
+		}
+	}
+	return rv, nil
+}
+
+func (t *testJobRepository) FetchJobSetSuspensions(ctx *armadacontext.Context) ([]database.JobSetSuspension, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (t *testJobRepository) FetchJobUpdates(ctx *armadacontext.Context, jobSerial int64, jobRunSerial int64) ([]database.Job, []database.Run, error) {
 	if t.shouldError {
 		return nil, nil, errors.New("error fetchiung job updates")
@@ -1099,11 +1570,15 @@ func (t *testJobRepository) CountReceivedPartitions(ctx *armadacontext.Context,
 
 type testExecutorRepository struct {
 	updateTimes map[string]time.Time
+	executors   []*schedulerobjects.Executor
 	shouldError bool
 }
 
 func (t testExecutorRepository) GetExecutors(ctx *armadacontext.Context) ([]*schedulerobjects.Executor, error) {
-	panic("not implemented")
+	if t.executors == nil {
+		panic("not implemented")
+	}
+	return t.executors, nil
 }
 
 func (t testExecutorRepository) GetLastUpdateTimes(ctx *armadacontext.Context) (map[string]time.Time, error) {
@@ -1123,9 +1598,14 @@ type testSchedulingAlgo struct {
 	jobsToSchedule        []string
 	jobsToFail            []string
 	shouldError           bool
+	// If not nil, called at the start of each call to Schedule.
+	onSchedule func(ctx *armadacontext.Context)
 }
 
 func (t *testSchedulingAlgo) Schedule(ctx *armadacontext.Context, txn *jobdb.Txn) (*SchedulerResult, error) {
+	if t.onSchedule != nil {
+		t.onSchedule(ctx)
+	}
 	t.numberOfScheduleCalls++
 	if t.shouldError {
 		return nil, errors.New("error scheduling jobs")
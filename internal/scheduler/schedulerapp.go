@@ -11,6 +11,7 @@ import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/go-redis/redis"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/client-go/kubernetes"
@@ -22,6 +23,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth"
 	dbcommon "github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	grpcCommon "github.com/armadaproject/armada/internal/common/grpc"
 	"github.com/armadaproject/armada/internal/common/health"
 	"github.com/armadaproject/armada/internal/common/logging"
@@ -29,6 +31,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	"github.com/armadaproject/armada/internal/common/serve"
 	"github.com/armadaproject/armada/internal/common/stringinterner"
+	"github.com/armadaproject/armada/internal/common/tracing"
 	schedulerconfig "github.com/armadaproject/armada/internal/scheduler/configuration"
 	"github.com/armadaproject/armada/internal/scheduler/database"
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
@@ -38,7 +41,7 @@ import (
 
 // Run sets up a Scheduler application and runs it until a SIGTERM is received
 func Run(config schedulerconfig.Configuration) error {
-	g, ctx := armadacontext.ErrGroup(app.CreateContextWithShutdown())
+	g, ctx := armadacontext.ErrGroup(armadacontext.New(app.CreateContextWithShutdown(), logging.ForModule("scheduler")))
 
 	//////////////////////////////////////////////////////////////////////////
 	// Profiling
@@ -92,6 +95,9 @@ func Run(config schedulerconfig.Configuration) error {
 	// Pulsar
 	//////////////////////////////////////////////////////////////////////////
 	ctx.Infof("Setting up Pulsar connectivity")
+	if err := eventutil.SetWriteSchemaVersion(config.Pulsar.EventSchemaWriteVersion); err != nil {
+		return err
+	}
 	pulsarClient, err := pulsarutils.NewPulsarClient(&config.Pulsar)
 	if err != nil {
 		return errors.WithMessage(err, "Error creating pulsar client")
@@ -107,15 +113,26 @@ func Run(config schedulerconfig.Configuration) error {
 	if err != nil {
 		return errors.WithMessage(err, "error creating pulsar publisher")
 	}
+	defer func() {
+		if err := pulsarPublisher.Close(); err != nil {
+			logging.
+				WithStacktrace(ctx, err).
+				Warnf("Pulsar publisher didn't close down cleanly")
+		}
+	}()
 
 	//////////////////////////////////////////////////////////////////////////
 	// Leader Election
 	//////////////////////////////////////////////////////////////////////////
-	leaderController, err := createLeaderController(ctx, config.Leader)
+	leaderController, err := createLeaderController(ctx, config.Leader, db)
 	if err != nil {
 		return errors.WithMessage(err, "error creating leader controller")
 	}
-	services = append(services, func() error { return leaderController.Run(ctx) })
+	// Leadership is released only once the scheduler has stopped,
+	// such that no other scheduler takes over while a cycle in progress on shutdown is publishing its decisions.
+	leaderCtx, stopLeaderController := armadacontext.WithCancel(armadacontext.WithoutCancel(ctx))
+	defer stopLeaderController()
+	services = append(services, func() error { return leaderController.Run(leaderCtx) })
 
 	//////////////////////////////////////////////////////////////////////////
 	// Executor Api
@@ -136,7 +153,7 @@ func Run(config schedulerconfig.Configuration) error {
 	if err != nil {
 		return errors.WithMessage(err, "error creating auth services")
 	}
-	grpcServer := grpcCommon.CreateGrpcServer(config.Grpc.KeepaliveParams, config.Grpc.KeepaliveEnforcementPolicy, authServices, config.Grpc.Tls)
+	grpcServer := grpcCommon.CreateGrpcServer(config.Grpc.KeepaliveParams, config.Grpc.KeepaliveEnforcementPolicy, authServices, config.Grpc.Tls, logging.ForModule("scheduler"))
 	defer grpcServer.GracefulStop()
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Grpc.Port))
 	if err != nil {
@@ -152,6 +169,7 @@ func Run(config schedulerconfig.Configuration) error {
 		config.Scheduling.Preemption.NodeIdLabel,
 		config.Scheduling.Preemption.PriorityClassNameOverride,
 		config.Pulsar.MaxAllowedMessageSize,
+		config.LeaseFlowControl,
 	)
 	if err != nil {
 		return errors.WithMessage(err, "error creating executorApi")
@@ -184,29 +202,63 @@ func Run(config schedulerconfig.Configuration) error {
 		return errors.WithMessage(err, "error creating submit checker")
 	}
 
-	schedulingContextRepository, err := NewSchedulingContextRepository(config.Scheduling.MaxJobSchedulingContextsPerExecutor)
+	schedulingContextRepository, err := NewSchedulingContextRepository(config.Scheduling.MaxJobSchedulingContextsPerExecutor, config.Scheduling)
 	if err != nil {
 		return errors.WithMessage(err, "error creating scheduling context repository")
 	}
 
+	diagnosticsServer := profiling.NewDiagnosticsServer(config.Diagnostics)
+	diagnosticsServer.SetConfig(config)
+	diagnosticsServer.AddState("schedulingContexts", schedulingContextRepository.MostRecentSchedulingContextSummaries)
+	services = append(services, func() error { return diagnosticsServer.Run(ctx) })
+
+	// Spans are only exported if an OpenTelemetry collector is configured.
+	runTracing, err := tracing.Setup(ctx, config.Tracing, "armada-scheduler")
+	if err != nil {
+		return errors.WithMessage(err, "error setting up tracing")
+	}
+	services = append(services, runTracing)
+
+	jobDb := jobdb.NewJobDb(
+		config.Scheduling.Preemption.PriorityClasses,
+		config.Scheduling.Preemption.DefaultPriorityClass,
+	)
+	jobDb.SetPriorityAging(config.Scheduling.JobPriorityAgingPerHour, config.Scheduling.JobPriorityAgingPerHourByQueue)
+
 	leaderClientConnectionProvider := NewLeaderConnectionProvider(leaderController, config.Leader)
-	schedulingReportServer := NewLeaderProxyingSchedulingReportsServer(schedulingContextRepository, leaderClientConnectionProvider)
+	var duplicateJobDetector *DuplicateJobDetector
+	if config.DuplicateJobDetectionWindow > 0 {
+		duplicateJobDetector = NewDuplicateJobDetector(config.DuplicateJobDetectionWindow)
+	}
+	schedulingReportServer := NewLeaderProxyingSchedulingReportsServer(
+		NewSchedulingReportsServer(
+			schedulingContextRepository,
+			duplicateJobDetector,
+			NewPendingCapacityReporter(jobDb),
+			NewNodeTypeReporter(executorRepository, config.Scheduling),
+		),
+		leaderClientConnectionProvider,
+	)
 	schedulerobjects.RegisterSchedulerReportingServer(grpcServer, schedulingReportServer)
 
+	dependencyIndex := NewDependencyIndex(jobRepository, config.UnknownJobDependencyTimeout)
+	var runReconciler *RunReconciler
+	if config.LostRunTimeout > 0 {
+		runReconciler = NewRunReconciler(config.LostRunTimeout)
+	}
 	schedulingAlgo, err := NewFairSchedulingAlgo(
 		config.Scheduling,
 		config.MaxSchedulingDuration,
 		executorRepository,
 		queueRepository,
 		schedulingContextRepository,
+		dependencyIndex,
+		NewJobSetSuspensions(jobRepository),
+		database.NewPostgresQueueSpendRepository(db),
 	)
 	if err != nil {
 		return errors.WithMessage(err, "error creating scheduling algo")
 	}
-	jobDb := jobdb.NewJobDb(
-		config.Scheduling.Preemption.PriorityClasses,
-		config.Scheduling.Preemption.DefaultPriorityClass,
-	)
 	scheduler, err := NewScheduler(
 		jobDb,
 		jobRepository,
@@ -216,9 +268,15 @@ func Run(config schedulerconfig.Configuration) error {
 		pulsarPublisher,
 		stringInterner,
 		submitChecker,
+		duplicateJobDetector,
+		dependencyIndex,
+		runReconciler,
 		config.CyclePeriod,
 		config.SchedulePeriod,
+		config.Scheduling.ExecutorTimeout,
 		config.ExecutorTimeout,
+		config.MaxIngestionLag,
+		config.GracefulShutdownTimeout,
 		config.Scheduling.MaxRetries+1,
 		config.Scheduling.Preemption.NodeIdLabel,
 		NewSchedulerMetrics(config.Metrics.Metrics),
@@ -226,7 +284,16 @@ func Run(config schedulerconfig.Configuration) error {
 	if err != nil {
 		return errors.WithMessage(err, "error creating scheduler")
 	}
-	services = append(services, func() error { return scheduler.Run(ctx) })
+	if registry, ok := leaderController.(LeaseListenerRegistry); ok {
+		// Start a cycle as soon as this scheduler becomes leader rather than waiting for the next tick.
+		registry.RegisterListener(scheduler)
+	}
+	services = append(services, func() error {
+		defer stopLeaderController()
+		err := scheduler.Run(ctx)
+		ctx.Infof("Most recent scheduling report at shutdown:\n%s", schedulingContextRepository.getSchedulingReportString(0))
+		return err
+	})
 
 	//////////////////////////////////////////////////////////////////////////
 	// Metrics
@@ -257,7 +324,7 @@ func Run(config schedulerconfig.Configuration) error {
 	return g.Wait()
 }
 
-func createLeaderController(ctx *armadacontext.Context, config schedulerconfig.LeaderConfig) (LeaderController, error) {
+func createLeaderController(ctx *armadacontext.Context, config schedulerconfig.LeaderConfig, db *pgxpool.Pool) (LeaderController, error) {
 	switch mode := strings.ToLower(config.Mode); mode {
 	case "standalone":
 		ctx.Infof("Scheduler will run in standalone mode")
@@ -277,6 +344,13 @@ func createLeaderController(ctx *armadacontext.Context, config schedulerconfig.L
 		leaderController.RegisterListener(leaderStatusMetrics)
 		prometheus.MustRegister(leaderStatusMetrics)
 		return leaderController, nil
+	case "postgres":
+		ctx.Infof("Scheduler will run postgres mode")
+		leaderController := NewPostgresLeaderController(config, NewPostgresAdvisoryLock(db, config.AdvisoryLockKey, config.PodName))
+		leaderStatusMetrics := NewLeaderStatusMetricsCollector(config.PodName)
+		leaderController.RegisterListener(leaderStatusMetrics)
+		prometheus.MustRegister(leaderStatusMetrics)
+		return leaderController, nil
 	default:
 		return nil, errors.Errorf("%s is not a value leader mode", config.Mode)
 	}
@@ -64,6 +64,7 @@ func (skg *SchedulingKeyGenerator) Key(
 	tolerations []v1.Toleration,
 	requests v1.ResourceList,
 	priorityClassName string,
+	persistentVolumeClaims []*PersistentVolumeClaimRequirements,
 ) SchedulingKey {
 	skg.Mutex.Lock()
 	defer skg.Mutex.Unlock()
@@ -75,6 +76,7 @@ func (skg *SchedulingKeyGenerator) Key(
 		tolerations,
 		requests,
 		priorityClassName,
+		persistentVolumeClaims,
 	)
 	return highwayhash.Sum(skg.buffer, skg.key)
 }
@@ -111,12 +113,33 @@ func (skg *PodRequirementsSerialiser) AppendRequirements(
 	tolerations []v1.Toleration,
 	requests v1.ResourceList,
 	priorityClassName string,
+	persistentVolumeClaims []*PersistentVolumeClaimRequirements,
 ) []byte {
 	out = skg.AppendNodeSelector(out, nodeSelector)
 	out = skg.AppendAffinity(out, affinity)
 	out = skg.AppendTolerations(out, tolerations)
 	out = skg.AppendResourceList(out, requests)
 	out = append(out, []byte(priorityClassName)...)
+	out = skg.AppendPersistentVolumeClaims(out, persistentVolumeClaims)
+	return out
+}
+
+// AppendPersistentVolumeClaims writes the storage classes of the provided claims into the hash.
+// Claim names aren't included, since they don't affect which nodes a pod can be scheduled on.
+func (skg *PodRequirementsSerialiser) AppendPersistentVolumeClaims(out []byte, persistentVolumeClaims []*PersistentVolumeClaimRequirements) []byte {
+	if len(persistentVolumeClaims) == 0 {
+		return out
+	}
+	skg.stringBuffer = skg.stringBuffer[0:0]
+	for _, claim := range persistentVolumeClaims {
+		skg.stringBuffer = append(skg.stringBuffer, claim.StorageClassName)
+	}
+	slices.Sort(skg.stringBuffer)
+	out = append(out, []byte("&")...)
+	for _, storageClassName := range skg.stringBuffer {
+		out = append(out, []byte(storageClassName)...)
+		out = append(out, []byte("$")...)
+	}
 	return out
 }
 
@@ -146,6 +146,7 @@ func benchmarkPodRequirementsSerialiser(b *testing.B, jobSchedulingInfo *JobSche
 			req.Tolerations,
 			req.ResourceRequirements.Requests,
 			jobSchedulingInfo.PriorityClassName,
+			req.PersistentVolumeClaims,
 		)
 	}
 }
@@ -169,6 +170,7 @@ func benchmarkSchedulingKey(b *testing.B, jobSchedulingInfo *JobSchedulingInfo)
 			req.Tolerations,
 			req.ResourceRequirements.Requests,
 			jobSchedulingInfo.PriorityClassName,
+			req.PersistentVolumeClaims,
 		)
 	}
 }
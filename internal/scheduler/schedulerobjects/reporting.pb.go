@@ -5,21 +5,28 @@ package schedulerobjects
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -117,7 +124,6 @@ func (m *MostRecentForJob) GetJobId() string {
 
 type SchedulingReportRequest struct {
 	// Types that are valid to be assigned to Filter:
-	//
 	//	*SchedulingReportRequest_MostRecentForQueue
 	//	*SchedulingReportRequest_MostRecentForJob
 	Filter    isSchedulingReportRequest_Filter `protobuf_oneof:"filter"`
@@ -437,660 +443,7186 @@ func (m *JobReport) GetReport() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MostRecentForQueue)(nil), "schedulerobjects.MostRecentForQueue")
-	proto.RegisterType((*MostRecentForJob)(nil), "schedulerobjects.MostRecentForJob")
-	proto.RegisterType((*SchedulingReportRequest)(nil), "schedulerobjects.SchedulingReportRequest")
-	proto.RegisterType((*SchedulingReport)(nil), "schedulerobjects.SchedulingReport")
-	proto.RegisterType((*QueueReportRequest)(nil), "schedulerobjects.QueueReportRequest")
-	proto.RegisterType((*QueueReport)(nil), "schedulerobjects.QueueReport")
-	proto.RegisterType((*JobReportRequest)(nil), "schedulerobjects.JobReportRequest")
-	proto.RegisterType((*JobReport)(nil), "schedulerobjects.JobReport")
+type QueueEntitlementRequest struct {
+	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queueName,omitempty"`
+	// If empty, the entitlement of the queue in each pool is returned.
+	Pool string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("internal/scheduler/schedulerobjects/reporting.proto", fileDescriptor_131a439a3ff6540b)
+func (m *QueueEntitlementRequest) Reset()         { *m = QueueEntitlementRequest{} }
+func (m *QueueEntitlementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueEntitlementRequest) ProtoMessage()    {}
+func (*QueueEntitlementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{8}
 }
-
-var fileDescriptor_131a439a3ff6540b = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x5f, 0x6b, 0xd3, 0x50,
-	0x14, 0x6f, 0x2a, 0x2b, 0xf6, 0x4c, 0x34, 0xdc, 0x2a, 0x1b, 0x55, 0x93, 0x12, 0x7c, 0x98, 0x32,
-	0x5a, 0xd8, 0x50, 0x10, 0x61, 0x48, 0x04, 0xab, 0xc5, 0x3f, 0x98, 0xe1, 0x8b, 0x20, 0x21, 0xb7,
-	0x39, 0xeb, 0x52, 0x9a, 0x9c, 0xee, 0xe6, 0x46, 0x18, 0x3e, 0xfa, 0x05, 0xfc, 0x0c, 0x7e, 0x1a,
-	0x1f, 0x7c, 0xd8, 0xa3, 0x4f, 0x41, 0xda, 0xb7, 0x7c, 0x0a, 0x59, 0xda, 0xb5, 0xf9, 0x33, 0xb7,
-	0xd5, 0xb7, 0xe4, 0x77, 0xcf, 0x3d, 0xbf, 0xdf, 0x39, 0xbf, 0x73, 0x2e, 0xec, 0x7a, 0x81, 0x44,
-	0x11, 0x38, 0xa3, 0x4e, 0xd8, 0x3f, 0x44, 0x37, 0x1a, 0xa1, 0x58, 0x7e, 0x11, 0x1f, 0x62, 0x5f,
-	0x86, 0x1d, 0x81, 0x63, 0x12, 0xd2, 0x0b, 0x06, 0xed, 0xb1, 0x20, 0x49, 0x4c, 0x2d, 0x46, 0x18,
-	0x6f, 0x80, 0xbd, 0xa5, 0x50, 0x5a, 0xd8, 0xc7, 0x40, 0xbe, 0x24, 0xf1, 0x21, 0xc2, 0x08, 0xd9,
-	0x13, 0x80, 0xa3, 0xd3, 0x0f, 0x3b, 0x70, 0x7c, 0xdc, 0x54, 0x5a, 0xca, 0x56, 0xdd, 0xdc, 0x48,
-	0x62, 0xbd, 0x91, 0xa2, 0xef, 0x1c, 0x1f, 0xb7, 0xc9, 0xf7, 0x24, 0xfa, 0x63, 0x79, 0x6c, 0xd5,
-	0x17, 0xa0, 0xb1, 0x07, 0x6a, 0x2e, 0x5b, 0x8f, 0x38, 0x7b, 0x04, 0xb5, 0x21, 0x71, 0xdb, 0x73,
-	0xe7, 0x79, 0x1a, 0x49, 0xac, 0xdf, 0x1a, 0x12, 0x7f, 0xed, 0x66, 0x72, 0xac, 0xa5, 0x80, 0xf1,
-	0xab, 0x0a, 0x1b, 0xfb, 0x33, 0x89, 0x5e, 0x30, 0xb0, 0x52, 0xf5, 0x16, 0x1e, 0x45, 0x18, 0x4a,
-	0xf6, 0x15, 0xee, 0xf8, 0x14, 0x4a, 0x5b, 0xa4, 0xc9, 0xed, 0x03, 0x12, 0x76, 0x4a, 0x9c, 0xa6,
-	0x5d, 0xdf, 0x79, 0xd0, 0x2e, 0xd6, 0xd6, 0x2e, 0x17, 0x66, 0xb6, 0x92, 0x58, 0xbf, 0xe7, 0x97,
-	0xf0, 0xa5, 0x92, 0x57, 0x15, 0x8b, 0x95, 0xcf, 0x59, 0x08, 0x8d, 0x22, 0xf9, 0x90, 0xf8, 0x66,
-	0x35, 0xa5, 0x36, 0x2e, 0xa1, 0xee, 0x11, 0x37, 0xb5, 0x24, 0xd6, 0x9b, 0x7e, 0x01, 0xcd, 0xd1,
-	0xaa, 0xc5, 0x53, 0xf6, 0x18, 0xea, 0x5f, 0x50, 0x70, 0x0a, 0x3d, 0x79, 0xbc, 0x79, 0xad, 0xa5,
-	0x6c, 0xad, 0xcd, 0x4c, 0x58, 0x80, 0x59, 0x13, 0x16, 0xa0, 0x79, 0x1d, 0x6a, 0x07, 0xde, 0x48,
-	0xa2, 0x30, 0x9e, 0x83, 0x5a, 0xec, 0x26, 0xdb, 0x86, 0xda, 0x6c, 0x2a, 0xe6, 0x76, 0xdc, 0x4e,
-	0x62, 0x5d, 0x9d, 0x21, 0x99, 0x74, 0xf3, 0x18, 0xe3, 0x9b, 0x02, 0x2c, 0xed, 0x40, 0xde, 0x8b,
-	0xff, 0x9c, 0x8f, 0x7c, 0x45, 0xd5, 0xab, 0x56, 0x64, 0x3c, 0x83, 0xf5, 0x8c, 0x88, 0x15, 0x4b,
-	0xd8, 0x03, 0xb5, 0x47, 0x3c, 0xaf, 0x7f, 0x95, 0x99, 0x7c, 0x0a, 0xf5, 0xc5, 0xfd, 0xd5, 0xa8,
-	0x77, 0x7e, 0x54, 0x81, 0xed, 0x9f, 0x8d, 0x86, 0x75, 0xb6, 0x8b, 0xcc, 0x85, 0x46, 0x17, 0x65,
-	0xc9, 0x99, 0x87, 0xe5, 0x31, 0xfa, 0xc7, 0x2e, 0x34, 0x8d, 0xcb, 0x43, 0xd9, 0x47, 0xb8, 0xd9,
-	0x45, 0x99, 0xed, 0xdb, 0x39, 0x2b, 0x52, 0xf6, 0xb6, 0x79, 0xff, 0xc2, 0x28, 0xf6, 0x1e, 0x6e,
-	0x74, 0x51, 0x2e, 0x3b, 0x72, 0x8e, 0x94, 0x62, 0xbb, 0x9b, 0x77, 0x2f, 0x88, 0x31, 0x3f, 0xff,
-	0x9c, 0x68, 0xca, 0xc9, 0x44, 0x53, 0xfe, 0x4c, 0x34, 0xe5, 0xfb, 0x54, 0xab, 0x9c, 0x4c, 0xb5,
-	0xca, 0xef, 0xa9, 0x56, 0xf9, 0xf4, 0x62, 0xe0, 0xc9, 0xc3, 0x88, 0xb7, 0xfb, 0xe4, 0x77, 0x1c,
-	0xe1, 0x3b, 0xae, 0x33, 0x16, 0x74, 0x7a, 0x7d, 0xfe, 0xd7, 0xb9, 0xc2, 0x13, 0xc8, 0x6b, 0xe9,
-	0xcb, 0xb7, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x09, 0x81, 0x96, 0x21, 0x30, 0x05, 0x00, 0x00,
+func (m *QueueEntitlementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueEntitlementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueEntitlementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueEntitlementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueEntitlementRequest.Merge(m, src)
+}
+func (m *QueueEntitlementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueEntitlementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueEntitlementRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueueEntitlementRequest proto.InternalMessageInfo
 
-// SchedulerReportingClient is the client API for SchedulerReporting service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SchedulerReportingClient interface {
-	// Return the most recent scheduling report for each executor.
-	GetSchedulingReport(ctx context.Context, in *SchedulingReportRequest, opts ...grpc.CallOption) (*SchedulingReport, error)
-	// Return the most recent report scheduling for each executor for the given queue.
-	GetQueueReport(ctx context.Context, in *QueueReportRequest, opts ...grpc.CallOption) (*QueueReport, error)
-	// Return the most recent scheduling report for each executor for the given job.
-	GetJobReport(ctx context.Context, in *JobReportRequest, opts ...grpc.CallOption) (*JobReport, error)
+func (m *QueueEntitlementRequest) GetQueueName() string {
+	if m != nil {
+		return m.QueueName
+	}
+	return ""
 }
 
-type schedulerReportingClient struct {
-	cc *grpc.ClientConn
+func (m *QueueEntitlementRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
 }
 
-func NewSchedulerReportingClient(cc *grpc.ClientConn) SchedulerReportingClient {
-	return &schedulerReportingClient{cc}
+type RateLimitState struct {
+	// Maximum sustained number of jobs that can be scheduled per second.
+	Rate float64 `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Maximum number of jobs that can be scheduled at once.
+	Burst int32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Number of jobs that can currently be scheduled without exceeding the rate limit.
+	Tokens float64 `protobuf:"fixed64,3,opt,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-func (c *schedulerReportingClient) GetSchedulingReport(ctx context.Context, in *SchedulingReportRequest, opts ...grpc.CallOption) (*SchedulingReport, error) {
-	out := new(SchedulingReport)
-	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetSchedulingReport", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RateLimitState) Reset()         { *m = RateLimitState{} }
+func (m *RateLimitState) String() string { return proto.CompactTextString(m) }
+func (*RateLimitState) ProtoMessage()    {}
+func (*RateLimitState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{9}
+}
+func (m *RateLimitState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RateLimitState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitState.Merge(m, src)
+}
+func (m *RateLimitState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitState.DiscardUnknown(m)
 }
 
-func (c *schedulerReportingClient) GetQueueReport(ctx context.Context, in *QueueReportRequest, opts ...grpc.CallOption) (*QueueReport, error) {
-	out := new(QueueReport)
-	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetQueueReport", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitState proto.InternalMessageInfo
+
+func (m *RateLimitState) GetRate() float64 {
+	if m != nil {
+		return m.Rate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *schedulerReportingClient) GetJobReport(ctx context.Context, in *JobReportRequest, opts ...grpc.CallOption) (*JobReport, error) {
-	out := new(JobReport)
-	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetJobReport", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RateLimitState) GetBurst() int32 {
+	if m != nil {
+		return m.Burst
 	}
-	return out, nil
+	return 0
 }
 
-// SchedulerReportingServer is the server API for SchedulerReporting service.
-type SchedulerReportingServer interface {
-	// Return the most recent scheduling report for each executor.
-	GetSchedulingReport(context.Context, *SchedulingReportRequest) (*SchedulingReport, error)
-	// Return the most recent report scheduling for each executor for the given queue.
-	GetQueueReport(context.Context, *QueueReportRequest) (*QueueReport, error)
-	// Return the most recent scheduling report for each executor for the given job.
-	GetJobReport(context.Context, *JobReportRequest) (*JobReport, error)
+func (m *RateLimitState) GetTokens() float64 {
+	if m != nil {
+		return m.Tokens
+	}
+	return 0
 }
 
-// UnimplementedSchedulerReportingServer can be embedded to have forward compatible implementations.
-type UnimplementedSchedulerReportingServer struct {
+// Entitlement of a queue in a particular pool, computed from the most recent scheduling round that considered the queue.
+type QueueEntitlement struct {
+	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queueName,omitempty"`
+	Pool      string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Time at which the scheduling round the entitlement is computed from finished.
+	Time   time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Weight float64   `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Fraction of the pool the queue is entitled to, i.e., its weight divided by the sum of weights of all active queues.
+	FairShare float64 `protobuf:"fixed64,5,opt,name=fair_share,json=fairShare,proto3" json:"fairShare,omitempty"`
+	// Fraction of the pool allocated to the queue, as computed by the fairness cost provider.
+	ActualShare float64 `protobuf:"fixed64,6,opt,name=actual_share,json=actualShare,proto3" json:"actualShare,omitempty"`
+	// Total resources of the pool.
+	TotalResources ResourceList `protobuf:"bytes,7,opt,name=total_resources,json=totalResources,proto3" json:"totalResources"`
+	// Resources allocated to the queue.
+	Allocated ResourceList `protobuf:"bytes,8,opt,name=allocated,proto3" json:"allocated"`
+	// Resources allocated to the queue by priority class.
+	AllocatedByPriorityClass map[string]ResourceList `protobuf:"bytes,9,rep,name=allocated_by_priority_class,json=allocatedByPriorityClass,proto3" json:"allocatedByPriorityClass" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Resources that may be allocated to the queue for each priority class before reaching its per-queue limit.
+	RemainingByPriorityClass map[string]ResourceList `protobuf:"bytes,10,rep,name=remaining_by_priority_class,json=remainingByPriorityClass,proto3" json:"remainingByPriorityClass" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Maximum resources that may be scheduled across all queues in a single scheduling round.
+	MaximumResourcesPerRound ResourceList    `protobuf:"bytes,11,opt,name=maximum_resources_per_round,json=maximumResourcesPerRound,proto3" json:"maximumResourcesPerRound"`
+	GlobalRateLimit          *RateLimitState `protobuf:"bytes,12,opt,name=global_rate_limit,json=globalRateLimit,proto3" json:"globalRateLimit,omitempty"`
+	QueueRateLimit           *RateLimitState `protobuf:"bytes,13,opt,name=queue_rate_limit,json=queueRateLimit,proto3" json:"queueRateLimit,omitempty"`
 }
 
-func (*UnimplementedSchedulerReportingServer) GetSchedulingReport(ctx context.Context, req *SchedulingReportRequest) (*SchedulingReport, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulingReport not implemented")
+func (m *QueueEntitlement) Reset()         { *m = QueueEntitlement{} }
+func (m *QueueEntitlement) String() string { return proto.CompactTextString(m) }
+func (*QueueEntitlement) ProtoMessage()    {}
+func (*QueueEntitlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{10}
 }
-func (*UnimplementedSchedulerReportingServer) GetQueueReport(ctx context.Context, req *QueueReportRequest) (*QueueReport, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueueReport not implemented")
+func (m *QueueEntitlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedSchedulerReportingServer) GetJobReport(ctx context.Context, req *JobReportRequest) (*JobReport, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobReport not implemented")
+func (m *QueueEntitlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueEntitlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterSchedulerReportingServer(s *grpc.Server, srv SchedulerReportingServer) {
-	s.RegisterService(&_SchedulerReporting_serviceDesc, srv)
+func (m *QueueEntitlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueEntitlement.Merge(m, src)
+}
+func (m *QueueEntitlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueEntitlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueEntitlement.DiscardUnknown(m)
 }
 
-func _SchedulerReporting_GetSchedulingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SchedulingReportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerReportingServer).GetSchedulingReport(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerobjects.SchedulerReporting/GetSchedulingReport",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerReportingServer).GetSchedulingReport(ctx, req.(*SchedulingReportRequest))
+var xxx_messageInfo_QueueEntitlement proto.InternalMessageInfo
+
+func (m *QueueEntitlement) GetQueueName() string {
+	if m != nil {
+		return m.QueueName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _SchedulerReporting_GetQueueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueReportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerReportingServer).GetQueueReport(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerobjects.SchedulerReporting/GetQueueReport",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerReportingServer).GetQueueReport(ctx, req.(*QueueReportRequest))
+func (m *QueueEntitlement) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _SchedulerReporting_GetJobReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobReportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueueEntitlement) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if interceptor == nil {
-		return srv.(SchedulerReportingServer).GetJobReport(ctx, in)
+	return time.Time{}
+}
+
+func (m *QueueEntitlement) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerobjects.SchedulerReporting/GetJobReport",
+	return 0
+}
+
+func (m *QueueEntitlement) GetFairShare() float64 {
+	if m != nil {
+		return m.FairShare
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerReportingServer).GetJobReport(ctx, req.(*JobReportRequest))
+	return 0
+}
+
+func (m *QueueEntitlement) GetActualShare() float64 {
+	if m != nil {
+		return m.ActualShare
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _SchedulerReporting_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "schedulerobjects.SchedulerReporting",
-	HandlerType: (*SchedulerReportingServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetSchedulingReport",
-			Handler:    _SchedulerReporting_GetSchedulingReport_Handler,
-		},
-		{
-			MethodName: "GetQueueReport",
-			Handler:    _SchedulerReporting_GetQueueReport_Handler,
-		},
-		{
-			MethodName: "GetJobReport",
-			Handler:    _SchedulerReporting_GetJobReport_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "internal/scheduler/schedulerobjects/reporting.proto",
+func (m *QueueEntitlement) GetTotalResources() ResourceList {
+	if m != nil {
+		return m.TotalResources
+	}
+	return ResourceList{}
 }
 
-func (m *MostRecentForQueue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueEntitlement) GetAllocated() ResourceList {
+	if m != nil {
+		return m.Allocated
 	}
-	return dAtA[:n], nil
+	return ResourceList{}
 }
 
-func (m *MostRecentForQueue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueEntitlement) GetAllocatedByPriorityClass() map[string]ResourceList {
+	if m != nil {
+		return m.AllocatedByPriorityClass
+	}
+	return nil
 }
 
-func (m *MostRecentForQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueueName) > 0 {
-		i -= len(m.QueueName)
-		copy(dAtA[i:], m.QueueName)
-		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueueEntitlement) GetRemainingByPriorityClass() map[string]ResourceList {
+	if m != nil {
+		return m.RemainingByPriorityClass
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MostRecentForJob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueEntitlement) GetMaximumResourcesPerRound() ResourceList {
+	if m != nil {
+		return m.MaximumResourcesPerRound
 	}
-	return dAtA[:n], nil
+	return ResourceList{}
 }
 
-func (m *MostRecentForJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueEntitlement) GetGlobalRateLimit() *RateLimitState {
+	if m != nil {
+		return m.GlobalRateLimit
+	}
+	return nil
 }
 
-func (m *MostRecentForJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintReporting(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueueEntitlement) GetQueueRateLimit() *RateLimitState {
+	if m != nil {
+		return m.QueueRateLimit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SchedulingReportRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueueEntitlementReport struct {
+	Entitlements []*QueueEntitlement `protobuf:"bytes,1,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
 }
 
-func (m *SchedulingReportRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueEntitlementReport) Reset()         { *m = QueueEntitlementReport{} }
+func (m *QueueEntitlementReport) String() string { return proto.CompactTextString(m) }
+func (*QueueEntitlementReport) ProtoMessage()    {}
+func (*QueueEntitlementReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{11}
 }
-
-func (m *SchedulingReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Verbosity != 0 {
-		i = encodeVarintReporting(dAtA, i, uint64(m.Verbosity))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Filter != nil {
-		{
-			size := m.Filter.Size()
-			i -= size
-			if _, err := m.Filter.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *QueueEntitlementReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueEntitlementReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueEntitlementReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueueEntitlementReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueEntitlementReport.Merge(m, src)
+}
+func (m *QueueEntitlementReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueEntitlementReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueEntitlementReport.DiscardUnknown(m)
 }
 
-func (m *SchedulingReportRequest_MostRecentForQueue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueueEntitlementReport proto.InternalMessageInfo
+
+func (m *QueueEntitlementReport) GetEntitlements() []*QueueEntitlement {
+	if m != nil {
+		return m.Entitlements
+	}
+	return nil
 }
 
-func (m *SchedulingReportRequest_MostRecentForQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MostRecentForQueue != nil {
-		{
-			size, err := m.MostRecentForQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReporting(dAtA, i, uint64(size))
+type DuplicateJobsReportRequest struct {
+	// If empty, duplicate jobs are reported for all queues.
+	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queueName,omitempty"`
+	// Minimum number of jobs that must make up a group of duplicates for it to be reported.
+	// Defaults to 2 if not positive.
+	MinDuplicates int32 `protobuf:"varint,2,opt,name=min_duplicates,json=minDuplicates,proto3" json:"minDuplicates,omitempty"`
+}
+
+func (m *DuplicateJobsReportRequest) Reset()         { *m = DuplicateJobsReportRequest{} }
+func (m *DuplicateJobsReportRequest) String() string { return proto.CompactTextString(m) }
+func (*DuplicateJobsReportRequest) ProtoMessage()    {}
+func (*DuplicateJobsReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{12}
+}
+func (m *DuplicateJobsReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateJobsReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateJobsReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *SchedulingReportRequest_MostRecentForJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DuplicateJobsReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateJobsReportRequest.Merge(m, src)
+}
+func (m *DuplicateJobsReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateJobsReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateJobsReportRequest.DiscardUnknown(m)
 }
 
-func (m *SchedulingReportRequest_MostRecentForJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MostRecentForJob != nil {
-		{
-			size, err := m.MostRecentForJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReporting(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_DuplicateJobsReportRequest proto.InternalMessageInfo
+
+func (m *DuplicateJobsReportRequest) GetQueueName() string {
+	if m != nil {
+		return m.QueueName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *SchedulingReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *DuplicateJobsReportRequest) GetMinDuplicates() int32 {
+	if m != nil {
+		return m.MinDuplicates
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SchedulingReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DuplicateJobsReport struct {
+	Report string `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (m *SchedulingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Report) > 0 {
-		i -= len(m.Report)
-		copy(dAtA[i:], m.Report)
-		i = encodeVarintReporting(dAtA, i, uint64(len(m.Report)))
-		i--
-		dAtA[i] = 0xa
+func (m *DuplicateJobsReport) Reset()         { *m = DuplicateJobsReport{} }
+func (m *DuplicateJobsReport) String() string { return proto.CompactTextString(m) }
+func (*DuplicateJobsReport) ProtoMessage()    {}
+func (*DuplicateJobsReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{13}
+}
+func (m *DuplicateJobsReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateJobsReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateJobsReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DuplicateJobsReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateJobsReport.Merge(m, src)
+}
+func (m *DuplicateJobsReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateJobsReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateJobsReport.DiscardUnknown(m)
 }
 
-func (m *QueueReportRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DuplicateJobsReport proto.InternalMessageInfo
+
+func (m *DuplicateJobsReport) GetReport() string {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueueReportRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SchedulingContextSnapshotRequest struct {
+	// If empty, snapshots are returned for all executors.
+	ExecutorId string `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executorId,omitempty"`
 }
 
-func (m *QueueReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Verbosity != 0 {
-		i = encodeVarintReporting(dAtA, i, uint64(m.Verbosity))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.QueueName) > 0 {
-		i -= len(m.QueueName)
-		copy(dAtA[i:], m.QueueName)
-		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
-		i--
-		dAtA[i] = 0xa
+func (m *SchedulingContextSnapshotRequest) Reset()         { *m = SchedulingContextSnapshotRequest{} }
+func (m *SchedulingContextSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*SchedulingContextSnapshotRequest) ProtoMessage()    {}
+func (*SchedulingContextSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{14}
+}
+func (m *SchedulingContextSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulingContextSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulingContextSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SchedulingContextSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulingContextSnapshotRequest.Merge(m, src)
+}
+func (m *SchedulingContextSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulingContextSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulingContextSnapshotRequest.DiscardUnknown(m)
 }
 
-func (m *QueueReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SchedulingContextSnapshotRequest proto.InternalMessageInfo
+
+func (m *SchedulingContextSnapshotRequest) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueueReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SchedulingContextSnapshots struct {
+	// Snapshot of the most recent scheduling round for each executor, sorted by executor id.
+	Snapshots []*SchedulingContextSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
 
-func (m *QueueReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Report) > 0 {
-		i -= len(m.Report)
-		copy(dAtA[i:], m.Report)
-		i = encodeVarintReporting(dAtA, i, uint64(len(m.Report)))
-		i--
-		dAtA[i] = 0xa
+func (m *SchedulingContextSnapshots) Reset()         { *m = SchedulingContextSnapshots{} }
+func (m *SchedulingContextSnapshots) String() string { return proto.CompactTextString(m) }
+func (*SchedulingContextSnapshots) ProtoMessage()    {}
+func (*SchedulingContextSnapshots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{15}
+}
+func (m *SchedulingContextSnapshots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulingContextSnapshots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulingContextSnapshots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SchedulingContextSnapshots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulingContextSnapshots.Merge(m, src)
+}
+func (m *SchedulingContextSnapshots) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulingContextSnapshots) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulingContextSnapshots.DiscardUnknown(m)
 }
 
-func (m *JobReportRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SchedulingContextSnapshots proto.InternalMessageInfo
+
+func (m *SchedulingContextSnapshots) GetSnapshots() []*SchedulingContextSnapshot {
+	if m != nil {
+		return m.Snapshots
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobReportRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueueUtilisationRequest struct {
+	// If empty, utilisation is returned for all pools.
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
-func (m *JobReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintReporting(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueueUtilisationRequest) Reset()         { *m = QueueUtilisationRequest{} }
+func (m *QueueUtilisationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueUtilisationRequest) ProtoMessage()    {}
+func (*QueueUtilisationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{16}
+}
+func (m *QueueUtilisationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueUtilisationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueUtilisationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueueUtilisationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueUtilisationRequest.Merge(m, src)
+}
+func (m *QueueUtilisationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueUtilisationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueUtilisationRequest.DiscardUnknown(m)
 }
 
-func (m *JobReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueueUtilisationRequest proto.InternalMessageInfo
+
+func (m *QueueUtilisationRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Utilisation of a pool by a queue, computed from the most recent scheduling round in the pool.
+type QueueUtilisation struct {
+	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queueName,omitempty"`
+	Pool      string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Time at which the scheduling round the utilisation is computed from finished.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Fraction of the pool the queue is entitled to.
+	FairShare float64 `protobuf:"fixed64,4,opt,name=fair_share,json=fairShare,proto3" json:"fairShare,omitempty"`
+	// Fraction of the pool allocated to the queue, as computed by the fairness cost provider.
+	ActualShare float64 `protobuf:"fixed64,5,opt,name=actual_share,json=actualShare,proto3" json:"actualShare,omitempty"`
+	// Resources allocated to the queue.
+	Allocated ResourceList `protobuf:"bytes,6,opt,name=allocated,proto3" json:"allocated"`
+	// Number of jobs of the queue queued across all pools when the scheduling round started.
+	NumQueuedJobs int32 `protobuf:"varint,7,opt,name=num_queued_jobs,json=numQueuedJobs,proto3" json:"numQueuedJobs,omitempty"`
 }
 
-func (m *JobReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Report) > 0 {
-		i -= len(m.Report)
-		copy(dAtA[i:], m.Report)
-		i = encodeVarintReporting(dAtA, i, uint64(len(m.Report)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueueUtilisation) Reset()         { *m = QueueUtilisation{} }
+func (m *QueueUtilisation) String() string { return proto.CompactTextString(m) }
+func (*QueueUtilisation) ProtoMessage()    {}
+func (*QueueUtilisation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{17}
+}
+func (m *QueueUtilisation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueUtilisation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueUtilisation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueueUtilisation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueUtilisation.Merge(m, src)
+}
+func (m *QueueUtilisation) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueUtilisation) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueUtilisation.DiscardUnknown(m)
 }
 
-func encodeVarintReporting(dAtA []byte, offset int, v uint64) int {
-	offset -= sovReporting(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueueUtilisation proto.InternalMessageInfo
+
+func (m *QueueUtilisation) GetQueueName() string {
+	if m != nil {
+		return m.QueueName
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MostRecentForQueue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.QueueName)
-	if l > 0 {
-		n += 1 + l + sovReporting(uint64(l))
+
+func (m *QueueUtilisation) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return n
+	return ""
 }
 
-func (m *MostRecentForJob) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueUtilisation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovReporting(uint64(l))
+	return time.Time{}
+}
+
+func (m *QueueUtilisation) GetFairShare() float64 {
+	if m != nil {
+		return m.FairShare
 	}
-	return n
+	return 0
 }
 
-func (m *SchedulingReportRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueUtilisation) GetActualShare() float64 {
+	if m != nil {
+		return m.ActualShare
 	}
-	var l int
-	_ = l
-	if m.Filter != nil {
-		n += m.Filter.Size()
+	return 0
+}
+
+func (m *QueueUtilisation) GetAllocated() ResourceList {
+	if m != nil {
+		return m.Allocated
 	}
-	if m.Verbosity != 0 {
-		n += 1 + sovReporting(uint64(m.Verbosity))
+	return ResourceList{}
+}
+
+func (m *QueueUtilisation) GetNumQueuedJobs() int32 {
+	if m != nil {
+		return m.NumQueuedJobs
 	}
-	return n
+	return 0
 }
 
-func (m *SchedulingReportRequest_MostRecentForQueue) Size() (n int) {
-	if m == nil {
-		return 0
+type QueueUtilisationReport struct {
+	// Utilisation of each queue active in the most recent scheduling round of each pool, sorted by pool and queue.
+	Queues []*QueueUtilisation `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+}
+
+func (m *QueueUtilisationReport) Reset()         { *m = QueueUtilisationReport{} }
+func (m *QueueUtilisationReport) String() string { return proto.CompactTextString(m) }
+func (*QueueUtilisationReport) ProtoMessage()    {}
+func (*QueueUtilisationReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{18}
+}
+func (m *QueueUtilisationReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueUtilisationReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueUtilisationReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.MostRecentForQueue != nil {
-		l = m.MostRecentForQueue.Size()
-		n += 1 + l + sovReporting(uint64(l))
+}
+func (m *QueueUtilisationReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueUtilisationReport.Merge(m, src)
+}
+func (m *QueueUtilisationReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueUtilisationReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueUtilisationReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueUtilisationReport proto.InternalMessageInfo
+
+func (m *QueueUtilisationReport) GetQueues() []*QueueUtilisation {
+	if m != nil {
+		return m.Queues
 	}
-	return n
+	return nil
 }
-func (m *SchedulingReportRequest_MostRecentForJob) Size() (n int) {
-	if m == nil {
-		return 0
+
+type PendingCapacityRequest struct {
+	// If empty, the backlog of all queues is returned.
+	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queueName,omitempty"`
+}
+
+func (m *PendingCapacityRequest) Reset()         { *m = PendingCapacityRequest{} }
+func (m *PendingCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingCapacityRequest) ProtoMessage()    {}
+func (*PendingCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{19}
+}
+func (m *PendingCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.MostRecentForJob != nil {
-		l = m.MostRecentForJob.Size()
-		n += 1 + l + sovReporting(uint64(l))
+}
+func (m *PendingCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCapacityRequest.Merge(m, src)
+}
+func (m *PendingCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCapacityRequest proto.InternalMessageInfo
+
+func (m *PendingCapacityRequest) GetQueueName() string {
+	if m != nil {
+		return m.QueueName
 	}
-	return n
+	return ""
 }
-func (m *SchedulingReport) Size() (n int) {
-	if m == nil {
-		return 0
+
+// Queued jobs with equal scheduling requirements, i.e., equal resource requests, node constraints, and priority class.
+type PendingCapacity struct {
+	// Resources requested by each job.
+	Requests ResourceList `protobuf:"bytes,1,opt,name=requests,proto3" json:"requests"`
+	// Labels nodes must have to run the jobs.
+	NodeSelector map[string]string `protobuf:"bytes,2,rep,name=node_selector,json=nodeSelector,proto3" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Affinity     *v1.Affinity      `protobuf:"bytes,3,opt,name=affinity,proto3" json:"affinity,omitempty"`
+	// Taints of nodes the jobs tolerate.
+	Tolerations       []v1.Toleration `protobuf:"bytes,4,rep,name=tolerations,proto3" json:"tolerations"`
+	PriorityClassName string          `protobuf:"bytes,5,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priorityClassName,omitempty"`
+	NumJobs           int32           `protobuf:"varint,6,opt,name=num_jobs,json=numJobs,proto3" json:"numJobs,omitempty"`
+	// Resources requested by all jobs, i.e., requests multiplied by num_jobs.
+	TotalRequests ResourceList `protobuf:"bytes,7,opt,name=total_requests,json=totalRequests,proto3" json:"totalRequests"`
+	// Time at which the job queued for the longest was submitted.
+	OldestSubmitTime time.Time `protobuf:"bytes,8,opt,name=oldest_submit_time,json=oldestSubmitTime,proto3,stdtime" json:"oldestSubmitTime"`
+	// Queues the jobs belong to, sorted.
+	Queues []string `protobuf:"bytes,9,rep,name=queues,proto3" json:"queues,omitempty"`
+}
+
+func (m *PendingCapacity) Reset()         { *m = PendingCapacity{} }
+func (m *PendingCapacity) String() string { return proto.CompactTextString(m) }
+func (*PendingCapacity) ProtoMessage()    {}
+func (*PendingCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{20}
+}
+func (m *PendingCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Report)
-	if l > 0 {
-		n += 1 + l + sovReporting(uint64(l))
+}
+func (m *PendingCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCapacity.Merge(m, src)
+}
+func (m *PendingCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCapacity proto.InternalMessageInfo
+
+func (m *PendingCapacity) GetRequests() ResourceList {
+	if m != nil {
+		return m.Requests
 	}
-	return n
+	return ResourceList{}
 }
 
-func (m *QueueReportRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingCapacity) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
 	}
-	var l int
-	_ = l
-	l = len(m.QueueName)
-	if l > 0 {
-		n += 1 + l + sovReporting(uint64(l))
+	return nil
+}
+
+func (m *PendingCapacity) GetAffinity() *v1.Affinity {
+	if m != nil {
+		return m.Affinity
 	}
-	if m.Verbosity != 0 {
-		n += 1 + sovReporting(uint64(m.Verbosity))
+	return nil
+}
+
+func (m *PendingCapacity) GetTolerations() []v1.Toleration {
+	if m != nil {
+		return m.Tolerations
 	}
-	return n
+	return nil
 }
 
-func (m *QueueReport) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingCapacity) GetPriorityClassName() string {
+	if m != nil {
+		return m.PriorityClassName
 	}
-	var l int
-	_ = l
-	l = len(m.Report)
-	if l > 0 {
-		n += 1 + l + sovReporting(uint64(l))
+	return ""
+}
+
+func (m *PendingCapacity) GetNumJobs() int32 {
+	if m != nil {
+		return m.NumJobs
 	}
-	return n
+	return 0
+}
+
+func (m *PendingCapacity) GetTotalRequests() ResourceList {
+	if m != nil {
+		return m.TotalRequests
+	}
+	return ResourceList{}
+}
+
+func (m *PendingCapacity) GetOldestSubmitTime() time.Time {
+	if m != nil {
+		return m.OldestSubmitTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingCapacity) GetQueues() []string {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
+type PendingCapacityReport struct {
+	// Backlog broken down by scheduling requirements, sorted by number of jobs in descending order.
+	Pending []*PendingCapacity `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *PendingCapacityReport) Reset()         { *m = PendingCapacityReport{} }
+func (m *PendingCapacityReport) String() string { return proto.CompactTextString(m) }
+func (*PendingCapacityReport) ProtoMessage()    {}
+func (*PendingCapacityReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{21}
+}
+func (m *PendingCapacityReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCapacityReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCapacityReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCapacityReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCapacityReport.Merge(m, src)
+}
+func (m *PendingCapacityReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCapacityReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCapacityReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCapacityReport proto.InternalMessageInfo
+
+func (m *PendingCapacityReport) GetPending() []*PendingCapacity {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+// Hypothetical per-queue limit, like the per-queue limits of priority classes.
+type QueueQuota struct {
+	// Maximum fraction of each resource of the pool the queue may be allocated, e.g., {"cpu": 0.25}.
+	MaximumResourceFraction map[string]float64 `protobuf:"bytes,1,rep,name=maximum_resource_fraction,json=maximumResourceFraction,proto3" json:"maximumResourceFraction,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (m *QueueQuota) Reset()         { *m = QueueQuota{} }
+func (m *QueueQuota) String() string { return proto.CompactTextString(m) }
+func (*QueueQuota) ProtoMessage()    {}
+func (*QueueQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{22}
+}
+func (m *QueueQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueQuota.Merge(m, src)
+}
+func (m *QueueQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueQuota proto.InternalMessageInfo
+
+func (m *QueueQuota) GetMaximumResourceFraction() map[string]float64 {
+	if m != nil {
+		return m.MaximumResourceFraction
+	}
+	return nil
+}
+
+type FairnessWhatIfRequest struct {
+	// If empty, the analysis is returned for all pools.
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Hypothetical weight of each queue. Queues not listed keep their current weight.
+	Weights map[string]float64 `protobuf:"bytes,2,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Hypothetical quota of each queue. Queues not listed are unlimited.
+	Quotas map[string]QueueQuota `protobuf:"bytes,3,rep,name=quotas,proto3" json:"quotas" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *FairnessWhatIfRequest) Reset()         { *m = FairnessWhatIfRequest{} }
+func (m *FairnessWhatIfRequest) String() string { return proto.CompactTextString(m) }
+func (*FairnessWhatIfRequest) ProtoMessage()    {}
+func (*FairnessWhatIfRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{23}
+}
+func (m *FairnessWhatIfRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FairnessWhatIfRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FairnessWhatIfRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FairnessWhatIfRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FairnessWhatIfRequest.Merge(m, src)
+}
+func (m *FairnessWhatIfRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FairnessWhatIfRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FairnessWhatIfRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FairnessWhatIfRequest proto.InternalMessageInfo
+
+func (m *FairnessWhatIfRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *FairnessWhatIfRequest) GetWeights() map[string]float64 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *FairnessWhatIfRequest) GetQuotas() map[string]QueueQuota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+// Fair share and projected preemptions of a queue in a particular pool under hypothetical weights and quotas,
+// computed from the most recent scheduling round in the pool.
+type FairnessWhatIf struct {
+	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queueName,omitempty"`
+	Pool      string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Time at which the scheduling round the analysis is computed from finished.
+	Time          time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	CurrentWeight float64   `protobuf:"fixed64,4,opt,name=current_weight,json=currentWeight,proto3" json:"currentWeight,omitempty"`
+	Weight        float64   `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Fraction of the pool the queue is entitled to with its current and hypothetical weight, respectively.
+	CurrentFairShare float64 `protobuf:"fixed64,6,opt,name=current_fair_share,json=currentFairShare,proto3" json:"currentFairShare,omitempty"`
+	FairShare        float64 `protobuf:"fixed64,7,opt,name=fair_share,json=fairShare,proto3" json:"fairShare,omitempty"`
+	// Fair share after redistributing the share queues without queued jobs don't use to the other queues.
+	AdjustedFairShare float64 `protobuf:"fixed64,8,opt,name=adjusted_fair_share,json=adjustedFairShare,proto3" json:"adjustedFairShare,omitempty"`
+	// Fraction of the pool allocated to the queue, as computed by the fairness model without weighting.
+	ActualShare float64 `protobuf:"fixed64,9,opt,name=actual_share,json=actualShare,proto3" json:"actualShare,omitempty"`
+	// Resources allocated to the queue.
+	Allocated ResourceList `protobuf:"bytes,10,opt,name=allocated,proto3" json:"allocated"`
+	// Resources of preemptible priority classes that would be preempted, since they exceed the adjusted fair share
+	// of the queue while other queues with queued jobs are below theirs, or since they exceed the quota of the queue.
+	ProjectedPreempted ResourceList `protobuf:"bytes,11,opt,name=projected_preempted,json=projectedPreempted,proto3" json:"projectedPreempted"`
+}
+
+func (m *FairnessWhatIf) Reset()         { *m = FairnessWhatIf{} }
+func (m *FairnessWhatIf) String() string { return proto.CompactTextString(m) }
+func (*FairnessWhatIf) ProtoMessage()    {}
+func (*FairnessWhatIf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{24}
+}
+func (m *FairnessWhatIf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FairnessWhatIf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FairnessWhatIf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FairnessWhatIf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FairnessWhatIf.Merge(m, src)
+}
+func (m *FairnessWhatIf) XXX_Size() int {
+	return m.Size()
+}
+func (m *FairnessWhatIf) XXX_DiscardUnknown() {
+	xxx_messageInfo_FairnessWhatIf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FairnessWhatIf proto.InternalMessageInfo
+
+func (m *FairnessWhatIf) GetQueueName() string {
+	if m != nil {
+		return m.QueueName
+	}
+	return ""
+}
+
+func (m *FairnessWhatIf) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *FairnessWhatIf) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *FairnessWhatIf) GetCurrentWeight() float64 {
+	if m != nil {
+		return m.CurrentWeight
+	}
+	return 0
+}
+
+func (m *FairnessWhatIf) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *FairnessWhatIf) GetCurrentFairShare() float64 {
+	if m != nil {
+		return m.CurrentFairShare
+	}
+	return 0
+}
+
+func (m *FairnessWhatIf) GetFairShare() float64 {
+	if m != nil {
+		return m.FairShare
+	}
+	return 0
+}
+
+func (m *FairnessWhatIf) GetAdjustedFairShare() float64 {
+	if m != nil {
+		return m.AdjustedFairShare
+	}
+	return 0
+}
+
+func (m *FairnessWhatIf) GetActualShare() float64 {
+	if m != nil {
+		return m.ActualShare
+	}
+	return 0
+}
+
+func (m *FairnessWhatIf) GetAllocated() ResourceList {
+	if m != nil {
+		return m.Allocated
+	}
+	return ResourceList{}
+}
+
+func (m *FairnessWhatIf) GetProjectedPreempted() ResourceList {
+	if m != nil {
+		return m.ProjectedPreempted
+	}
+	return ResourceList{}
+}
+
+type FairnessWhatIfReport struct {
+	// Analysis of each queue active in the most recent scheduling round of each pool, sorted by pool and queue.
+	Queues []*FairnessWhatIf `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+}
+
+func (m *FairnessWhatIfReport) Reset()         { *m = FairnessWhatIfReport{} }
+func (m *FairnessWhatIfReport) String() string { return proto.CompactTextString(m) }
+func (*FairnessWhatIfReport) ProtoMessage()    {}
+func (*FairnessWhatIfReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{25}
+}
+func (m *FairnessWhatIfReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FairnessWhatIfReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FairnessWhatIfReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FairnessWhatIfReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FairnessWhatIfReport.Merge(m, src)
+}
+func (m *FairnessWhatIfReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *FairnessWhatIfReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_FairnessWhatIfReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FairnessWhatIfReport proto.InternalMessageInfo
+
+func (m *FairnessWhatIfReport) GetQueues() []*FairnessWhatIf {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
+type NodeTypesRequest struct {
+	// If empty, the node types of all pools are returned.
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (m *NodeTypesRequest) Reset()         { *m = NodeTypesRequest{} }
+func (m *NodeTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeTypesRequest) ProtoMessage()    {}
+func (*NodeTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{26}
+}
+func (m *NodeTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTypesRequest.Merge(m, src)
+}
+func (m *NodeTypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTypesRequest proto.InternalMessageInfo
+
+func (m *NodeTypesRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+// Nodes of a pool with equal indexed labels and taints, i.e., that the scheduler considers equal when matching jobs to nodes.
+type NodeTypeSummary struct {
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Id of the node type, see NodeType.
+	NodeTypeId uint64 `protobuf:"varint,2,opt,name=node_type_id,json=nodeTypeId,proto3" json:"nodeTypeId,omitempty"`
+	// Labels of the nodes that are indexed by the scheduler.
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Taints of the nodes that are indexed by the scheduler.
+	Taints   []v1.Taint `protobuf:"bytes,4,rep,name=taints,proto3" json:"taints"`
+	NumNodes int32      `protobuf:"varint,5,opt,name=num_nodes,json=numNodes,proto3" json:"numNodes,omitempty"`
+	// Number of nodes no new jobs are scheduled onto, e.g., since they're cordoned.
+	NumUnschedulableNodes int32 `protobuf:"varint,6,opt,name=num_unschedulable_nodes,json=numUnschedulableNodes,proto3" json:"numUnschedulableNodes,omitempty"`
+	// Most of each resource any single node has, i.e., an upper bound on what a single job may request to fit on these nodes.
+	LargestNodeResources ResourceList `protobuf:"bytes,7,opt,name=largest_node_resources,json=largestNodeResources,proto3" json:"largestNodeResources"`
+	// Resources of all nodes.
+	TotalResources ResourceList `protobuf:"bytes,8,opt,name=total_resources,json=totalResources,proto3" json:"totalResources"`
+}
+
+func (m *NodeTypeSummary) Reset()         { *m = NodeTypeSummary{} }
+func (m *NodeTypeSummary) String() string { return proto.CompactTextString(m) }
+func (*NodeTypeSummary) ProtoMessage()    {}
+func (*NodeTypeSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{27}
+}
+func (m *NodeTypeSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeTypeSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeTypeSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeTypeSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTypeSummary.Merge(m, src)
+}
+func (m *NodeTypeSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeTypeSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTypeSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTypeSummary proto.InternalMessageInfo
+
+func (m *NodeTypeSummary) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *NodeTypeSummary) GetNodeTypeId() uint64 {
+	if m != nil {
+		return m.NodeTypeId
+	}
+	return 0
+}
+
+func (m *NodeTypeSummary) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *NodeTypeSummary) GetTaints() []v1.Taint {
+	if m != nil {
+		return m.Taints
+	}
+	return nil
+}
+
+func (m *NodeTypeSummary) GetNumNodes() int32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *NodeTypeSummary) GetNumUnschedulableNodes() int32 {
+	if m != nil {
+		return m.NumUnschedulableNodes
+	}
+	return 0
+}
+
+func (m *NodeTypeSummary) GetLargestNodeResources() ResourceList {
+	if m != nil {
+		return m.LargestNodeResources
+	}
+	return ResourceList{}
+}
+
+func (m *NodeTypeSummary) GetTotalResources() ResourceList {
+	if m != nil {
+		return m.TotalResources
+	}
+	return ResourceList{}
+}
+
+type NodeTypesReport struct {
+	// Node types of each pool, sorted by pool and number of nodes in descending order.
+	NodeTypes []*NodeTypeSummary `protobuf:"bytes,1,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
+}
+
+func (m *NodeTypesReport) Reset()         { *m = NodeTypesReport{} }
+func (m *NodeTypesReport) String() string { return proto.CompactTextString(m) }
+func (*NodeTypesReport) ProtoMessage()    {}
+func (*NodeTypesReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_131a439a3ff6540b, []int{28}
+}
+func (m *NodeTypesReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeTypesReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeTypesReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeTypesReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTypesReport.Merge(m, src)
+}
+func (m *NodeTypesReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeTypesReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTypesReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTypesReport proto.InternalMessageInfo
+
+func (m *NodeTypesReport) GetNodeTypes() []*NodeTypeSummary {
+	if m != nil {
+		return m.NodeTypes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MostRecentForQueue)(nil), "schedulerobjects.MostRecentForQueue")
+	proto.RegisterType((*MostRecentForJob)(nil), "schedulerobjects.MostRecentForJob")
+	proto.RegisterType((*SchedulingReportRequest)(nil), "schedulerobjects.SchedulingReportRequest")
+	proto.RegisterType((*SchedulingReport)(nil), "schedulerobjects.SchedulingReport")
+	proto.RegisterType((*QueueReportRequest)(nil), "schedulerobjects.QueueReportRequest")
+	proto.RegisterType((*QueueReport)(nil), "schedulerobjects.QueueReport")
+	proto.RegisterType((*JobReportRequest)(nil), "schedulerobjects.JobReportRequest")
+	proto.RegisterType((*JobReport)(nil), "schedulerobjects.JobReport")
+	proto.RegisterType((*QueueEntitlementRequest)(nil), "schedulerobjects.QueueEntitlementRequest")
+	proto.RegisterType((*RateLimitState)(nil), "schedulerobjects.RateLimitState")
+	proto.RegisterType((*QueueEntitlement)(nil), "schedulerobjects.QueueEntitlement")
+	proto.RegisterMapType((map[string]ResourceList)(nil), "schedulerobjects.QueueEntitlement.AllocatedByPriorityClassEntry")
+	proto.RegisterMapType((map[string]ResourceList)(nil), "schedulerobjects.QueueEntitlement.RemainingByPriorityClassEntry")
+	proto.RegisterType((*QueueEntitlementReport)(nil), "schedulerobjects.QueueEntitlementReport")
+	proto.RegisterType((*DuplicateJobsReportRequest)(nil), "schedulerobjects.DuplicateJobsReportRequest")
+	proto.RegisterType((*DuplicateJobsReport)(nil), "schedulerobjects.DuplicateJobsReport")
+	proto.RegisterType((*SchedulingContextSnapshotRequest)(nil), "schedulerobjects.SchedulingContextSnapshotRequest")
+	proto.RegisterType((*SchedulingContextSnapshots)(nil), "schedulerobjects.SchedulingContextSnapshots")
+	proto.RegisterType((*QueueUtilisationRequest)(nil), "schedulerobjects.QueueUtilisationRequest")
+	proto.RegisterType((*QueueUtilisation)(nil), "schedulerobjects.QueueUtilisation")
+	proto.RegisterType((*QueueUtilisationReport)(nil), "schedulerobjects.QueueUtilisationReport")
+	proto.RegisterType((*PendingCapacityRequest)(nil), "schedulerobjects.PendingCapacityRequest")
+	proto.RegisterType((*PendingCapacity)(nil), "schedulerobjects.PendingCapacity")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PendingCapacity.NodeSelectorEntry")
+	proto.RegisterType((*PendingCapacityReport)(nil), "schedulerobjects.PendingCapacityReport")
+	proto.RegisterType((*QueueQuota)(nil), "schedulerobjects.QueueQuota")
+	proto.RegisterMapType((map[string]float64)(nil), "schedulerobjects.QueueQuota.MaximumResourceFractionEntry")
+	proto.RegisterType((*FairnessWhatIfRequest)(nil), "schedulerobjects.FairnessWhatIfRequest")
+	proto.RegisterMapType((map[string]QueueQuota)(nil), "schedulerobjects.FairnessWhatIfRequest.QuotasEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "schedulerobjects.FairnessWhatIfRequest.WeightsEntry")
+	proto.RegisterType((*FairnessWhatIf)(nil), "schedulerobjects.FairnessWhatIf")
+	proto.RegisterType((*FairnessWhatIfReport)(nil), "schedulerobjects.FairnessWhatIfReport")
+	proto.RegisterType((*NodeTypesRequest)(nil), "schedulerobjects.NodeTypesRequest")
+	proto.RegisterType((*NodeTypeSummary)(nil), "schedulerobjects.NodeTypeSummary")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.NodeTypeSummary.LabelsEntry")
+	proto.RegisterType((*NodeTypesReport)(nil), "schedulerobjects.NodeTypesReport")
+}
+
+func init() {
+	proto.RegisterFile("internal/scheduler/schedulerobjects/reporting.proto", fileDescriptor_131a439a3ff6540b)
+}
+
+var fileDescriptor_131a439a3ff6540b = []byte{
+	// 2324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5f, 0x6f, 0xdb, 0xd6,
+	0x15, 0x0f, 0x6d, 0xcb, 0xb1, 0x8e, 0x62, 0x5b, 0xbe, 0x8e, 0x1d, 0x45, 0x71, 0x4c, 0x57, 0xfd,
+	0x97, 0x6c, 0xad, 0xbc, 0x3a, 0xdb, 0xd0, 0xa4, 0x43, 0x91, 0x28, 0x6b, 0xbc, 0x04, 0x6e, 0xe2,
+	0xd2, 0x09, 0x82, 0xad, 0x2b, 0x08, 0x52, 0xba, 0x96, 0x69, 0x93, 0xbc, 0x2a, 0x79, 0x99, 0x45,
+	0xd8, 0x80, 0x6e, 0xd8, 0x17, 0xe8, 0xd0, 0x97, 0x3d, 0x0c, 0x18, 0xf6, 0x4d, 0xf6, 0xd8, 0x87,
+	0x3d, 0xf4, 0x69, 0xd8, 0x93, 0x3a, 0x24, 0x2f, 0x83, 0xbe, 0xc0, 0x5e, 0x87, 0xfb, 0x87, 0xd4,
+	0x25, 0x29, 0x59, 0x52, 0x9a, 0x60, 0xe8, 0x93, 0xc5, 0x73, 0xcf, 0xbf, 0x7b, 0xce, 0xb9, 0xe7,
+	0xfe, 0x78, 0x68, 0xb8, 0xe6, 0xf8, 0x14, 0x07, 0xbe, 0xe5, 0x6e, 0x87, 0xcd, 0x23, 0xdc, 0x8a,
+	0x5c, 0x1c, 0x0c, 0x7e, 0x11, 0xfb, 0x18, 0x37, 0x69, 0xb8, 0x1d, 0xe0, 0x0e, 0x09, 0xa8, 0xe3,
+	0xb7, 0xeb, 0x9d, 0x80, 0x50, 0x82, 0xca, 0x59, 0x8e, 0xaa, 0xde, 0x26, 0xa4, 0xed, 0xe2, 0x6d,
+	0xbe, 0x6e, 0x47, 0x87, 0xdb, 0xd4, 0xf1, 0x70, 0x48, 0x2d, 0xaf, 0x23, 0x44, 0xaa, 0xef, 0xb6,
+	0x1d, 0x7a, 0x14, 0xd9, 0xf5, 0x26, 0xf1, 0xb6, 0xdb, 0xa4, 0x4d, 0x06, 0x9c, 0xec, 0x89, 0x3f,
+	0xf0, 0x5f, 0x92, 0xfd, 0xc6, 0x24, 0x6e, 0x65, 0x09, 0x52, 0xf6, 0x83, 0x29, 0x64, 0x1d, 0xbf,
+	0xdd, 0x24, 0x3e, 0xc5, 0x4f, 0xa9, 0x14, 0xae, 0x9d, 0xbc, 0x1f, 0xd6, 0x1d, 0xb2, 0x6d, 0x75,
+	0x9c, 0xed, 0x26, 0x09, 0xf0, 0xf6, 0x93, 0xf7, 0xb6, 0xdb, 0xd8, 0xc7, 0x81, 0x45, 0x71, 0x4b,
+	0xf0, 0xd4, 0xf6, 0x00, 0x7d, 0x4c, 0x42, 0x6a, 0xe0, 0x26, 0xf6, 0xe9, 0x1d, 0x12, 0x7c, 0x12,
+	0xe1, 0x08, 0xa3, 0x9f, 0x02, 0x7c, 0xce, 0x7e, 0x98, 0xbe, 0xe5, 0xe1, 0x8a, 0xb6, 0xa5, 0x5d,
+	0x29, 0x36, 0x2e, 0xf4, 0x7b, 0xfa, 0x2a, 0xa7, 0xde, 0xb7, 0x3c, 0xfc, 0x0e, 0xf1, 0x1c, 0x8a,
+	0xbd, 0x0e, 0xed, 0x1a, 0xc5, 0x84, 0x58, 0xfb, 0x10, 0xca, 0x29, 0x6d, 0xf7, 0x88, 0x8d, 0x7e,
+	0x00, 0xf3, 0xc7, 0xc4, 0x36, 0x9d, 0x96, 0xd4, 0xb3, 0xda, 0xef, 0xe9, 0xcb, 0xc7, 0xc4, 0xbe,
+	0xdb, 0x52, 0x74, 0x14, 0x38, 0xa1, 0xf6, 0x8f, 0x19, 0xb8, 0x70, 0x90, 0xec, 0xc6, 0xe0, 0xa9,
+	0x32, 0xf0, 0xe7, 0x11, 0x0e, 0x29, 0xfa, 0x2d, 0xac, 0x79, 0x24, 0xa4, 0x66, 0xc0, 0x95, 0x9b,
+	0x87, 0x24, 0x30, 0xb9, 0x61, 0xae, 0xb6, 0xb4, 0xf3, 0x46, 0x3d, 0x17, 0xc2, 0xfc, 0xc6, 0x1a,
+	0x5b, 0xfd, 0x9e, 0xbe, 0xe1, 0xe5, 0xe8, 0x03, 0x4f, 0x7e, 0x71, 0xc6, 0x40, 0xf9, 0x75, 0x14,
+	0xc2, 0x6a, 0xd6, 0xf8, 0x31, 0xb1, 0x2b, 0x33, 0xdc, 0x74, 0x6d, 0x8c, 0xe9, 0x7b, 0xc4, 0x6e,
+	0x6c, 0xf6, 0x7b, 0x7a, 0xd5, 0xcb, 0x50, 0x53, 0x66, 0xcb, 0xd9, 0x55, 0xf4, 0x13, 0x28, 0x3e,
+	0xc1, 0x81, 0x4d, 0x42, 0x87, 0x76, 0x2b, 0xb3, 0x5b, 0xda, 0x95, 0x82, 0x48, 0x42, 0x42, 0x54,
+	0x93, 0x90, 0x10, 0x1b, 0x0b, 0x30, 0x7f, 0xe8, 0xb8, 0x14, 0x07, 0xb5, 0x9b, 0x50, 0xce, 0x46,
+	0x13, 0xbd, 0x03, 0xf3, 0xe2, 0x08, 0xc8, 0x74, 0x9c, 0xef, 0xf7, 0xf4, 0xb2, 0xa0, 0x28, 0xea,
+	0x24, 0x4f, 0xed, 0x8f, 0x1a, 0x20, 0x1e, 0x81, 0x74, 0x2e, 0x5e, 0xb0, 0x3e, 0xd2, 0x3b, 0x9a,
+	0x99, 0x74, 0x47, 0xb5, 0x0f, 0xa0, 0xa4, 0x38, 0x31, 0xe5, 0x16, 0x3e, 0x84, 0xf2, 0x3d, 0x62,
+	0xa7, 0xfd, 0x9f, 0xa6, 0x26, 0xaf, 0x43, 0x31, 0x91, 0x9f, 0xd2, 0x74, 0x17, 0x2e, 0x70, 0xbf,
+	0x3f, 0xf2, 0xa9, 0x43, 0x5d, 0xec, 0x61, 0xff, 0x3b, 0x47, 0xf0, 0x2d, 0x98, 0xeb, 0x10, 0xe2,
+	0xf2, 0xe0, 0x15, 0x1b, 0xa8, 0xdf, 0xd3, 0x97, 0xd8, 0xb3, 0xc2, 0xcc, 0xd7, 0x6b, 0x7f, 0xd2,
+	0x60, 0xc9, 0xb0, 0x28, 0xde, 0x73, 0x3c, 0x87, 0x1e, 0x50, 0x8b, 0x72, 0x51, 0x76, 0xf2, 0xb9,
+	0x31, 0x4d, 0x88, 0xb2, 0x67, 0x55, 0x94, 0x3d, 0xa3, 0xab, 0x50, 0xb0, 0xa3, 0x20, 0xa4, 0x32,
+	0x41, 0x3c, 0x36, 0x9c, 0xa0, 0xc6, 0x86, 0x13, 0x58, 0x38, 0x28, 0x39, 0xc1, 0x7e, 0xc8, 0xcb,
+	0x53, 0x13, 0xe1, 0x10, 0x14, 0x35, 0x1c, 0x82, 0x52, 0xfb, 0x67, 0x09, 0xca, 0xd9, 0x78, 0xbc,
+	0xea, 0x40, 0xa0, 0x9b, 0x30, 0xc7, 0xfa, 0x37, 0x77, 0xb0, 0xb4, 0x53, 0xad, 0x8b, 0xe6, 0x5e,
+	0x8f, 0x5b, 0x76, 0xfd, 0x61, 0xdc, 0xdc, 0x1b, 0xe5, 0xaf, 0x7b, 0xfa, 0x99, 0x7e, 0x4f, 0xe7,
+	0xfc, 0x5f, 0x7e, 0xab, 0x6b, 0x06, 0xff, 0xc5, 0x36, 0xf9, 0x1b, 0xec, 0xb4, 0x8f, 0x68, 0x65,
+	0x6e, 0xb0, 0x49, 0x41, 0x51, 0x37, 0x29, 0x28, 0x6c, 0x3f, 0x87, 0x96, 0x13, 0x98, 0xe1, 0x91,
+	0x15, 0xe0, 0x4a, 0x81, 0x4b, 0xf0, 0xfd, 0x30, 0xea, 0x01, 0x23, 0xaa, 0xfb, 0x49, 0x88, 0xe8,
+	0x67, 0x70, 0xce, 0x6a, 0xd2, 0xc8, 0x72, 0xa5, 0xe4, 0x3c, 0x97, 0xbc, 0xd8, 0xef, 0xe9, 0x6b,
+	0x82, 0x9e, 0x95, 0x2d, 0x29, 0x64, 0x64, 0xc2, 0x32, 0x25, 0xd4, 0x72, 0xcd, 0x00, 0x87, 0x24,
+	0x0a, 0x9a, 0x38, 0xac, 0x9c, 0xe5, 0x1b, 0xde, 0xcc, 0xf7, 0x26, 0x43, 0xb2, 0xec, 0x39, 0x21,
+	0x6d, 0xac, 0xcb, 0x4d, 0x2f, 0x71, 0xf1, 0x78, 0x29, 0x34, 0x32, 0xcf, 0xe8, 0x01, 0x14, 0x2d,
+	0xd7, 0x25, 0x4d, 0x76, 0x75, 0x54, 0x16, 0x26, 0x52, 0xbd, 0x22, 0x55, 0x0f, 0x04, 0x8d, 0xc1,
+	0x4f, 0xf4, 0x37, 0x0d, 0x2e, 0x25, 0x4f, 0xa6, 0xdd, 0x35, 0x3b, 0x81, 0x43, 0x02, 0x87, 0x76,
+	0xcd, 0xa6, 0x6b, 0x85, 0x61, 0xa5, 0xb8, 0x35, 0x7b, 0xa5, 0xb4, 0x73, 0x33, 0x6f, 0x23, 0x5b,
+	0x41, 0xf5, 0x5b, 0xb1, 0x96, 0x46, 0x77, 0x5f, 0xea, 0xb8, 0xcd, 0x54, 0x7c, 0xe4, 0xd3, 0xa0,
+	0xdb, 0xd8, 0x92, 0x5e, 0x54, 0xac, 0x11, 0x6c, 0xc6, 0xc8, 0x15, 0xee, 0x63, 0x80, 0x3d, 0xcb,
+	0xf1, 0x1d, 0xbf, 0x3d, 0xc4, 0x47, 0x98, 0xd8, 0x47, 0x23, 0xd6, 0x72, 0xba, 0x8f, 0xc1, 0x08,
+	0x36, 0x63, 0xe4, 0x0a, 0xfa, 0x02, 0x2e, 0x79, 0xd6, 0x53, 0xc7, 0x8b, 0xbc, 0x41, 0xee, 0xcd,
+	0x0e, 0x0e, 0xcc, 0x80, 0x44, 0x7e, 0xab, 0x52, 0x9a, 0x28, 0x55, 0x89, 0x03, 0x52, 0x55, 0x92,
+	0xf7, 0x7d, 0x1c, 0x18, 0x4c, 0x8f, 0x31, 0x72, 0x05, 0x9d, 0xc0, 0x4a, 0xdb, 0x25, 0x36, 0xab,
+	0x3d, 0x8b, 0x62, 0xd3, 0x65, 0x0d, 0xa7, 0x72, 0x8e, 0x9b, 0xdd, 0x1a, 0x62, 0x36, 0xd5, 0x93,
+	0x1a, 0x97, 0xfb, 0x3d, 0xfd, 0xa2, 0x10, 0x4f, 0x56, 0x94, 0x1a, 0x5f, 0xce, 0x2c, 0xa1, 0x23,
+	0x28, 0x8b, 0x6e, 0xa1, 0xd8, 0x5a, 0x9c, 0xd0, 0xd6, 0x06, 0xdb, 0x20, 0x97, 0x1e, 0x66, 0x6a,
+	0x29, 0xbd, 0x52, 0xfd, 0x4a, 0x83, 0xcb, 0xa7, 0x56, 0x16, 0x7a, 0x1d, 0x66, 0x4f, 0x70, 0x57,
+	0xb6, 0xac, 0x95, 0x7e, 0x4f, 0x5f, 0x3c, 0xc1, 0xea, 0x05, 0xc6, 0x56, 0xd1, 0x5d, 0x28, 0x3c,
+	0xb1, 0xdc, 0x08, 0x57, 0x66, 0x26, 0x4a, 0x04, 0x6f, 0xb6, 0x5c, 0x40, 0x6d, 0xb6, 0x9c, 0x70,
+	0x63, 0xe6, 0x7d, 0x8d, 0x7b, 0x75, 0x6a, 0x2d, 0xfd, 0x3f, 0xbc, 0xaa, 0xfd, 0x0e, 0xd6, 0xf3,
+	0xf7, 0x1c, 0xbf, 0x2f, 0x6d, 0x38, 0x87, 0x07, 0xc4, 0xb0, 0xa2, 0x6d, 0xcd, 0x0e, 0x07, 0x4c,
+	0x59, 0xf9, 0x46, 0xb5, 0xdf, 0xd3, 0xd7, 0x55, 0x59, 0xc5, 0x74, 0x4a, 0x67, 0xed, 0xcf, 0x1a,
+	0x54, 0x7f, 0x1e, 0x75, 0x5c, 0x87, 0xa5, 0xea, 0x1e, 0xb1, 0xc3, 0x97, 0x83, 0x55, 0x1a, 0xb0,
+	0xe4, 0x39, 0xbe, 0xd9, 0x8a, 0x35, 0x87, 0xf2, 0x3e, 0xbc, 0xd4, 0xef, 0xe9, 0x17, 0x3c, 0xc7,
+	0x4f, 0x4c, 0xaa, 0x9e, 0x2d, 0xa6, 0x16, 0x6a, 0xb7, 0x61, 0x75, 0x88, 0x67, 0x53, 0xa2, 0x88,
+	0xcf, 0x60, 0x6b, 0x80, 0xe2, 0x6e, 0x0b, 0x84, 0x7f, 0xe0, 0x5b, 0x9d, 0xf0, 0x88, 0x24, 0x9b,
+	0xbc, 0x0e, 0x25, 0xfc, 0x14, 0x37, 0x23, 0x4a, 0x82, 0x01, 0xaa, 0xa9, 0xf4, 0x7b, 0xfa, 0xf9,
+	0x98, 0x9c, 0x82, 0x36, 0x30, 0xa0, 0xd6, 0x7e, 0xaf, 0x41, 0x75, 0xa4, 0xfe, 0x10, 0xd9, 0x50,
+	0x0c, 0xe3, 0x07, 0x99, 0xbe, 0x1f, 0xe6, 0xd3, 0x37, 0x52, 0x81, 0x08, 0x75, 0xa2, 0x41, 0x0d,
+	0x75, 0x42, 0xac, 0xdd, 0x92, 0x38, 0xe9, 0x11, 0x75, 0x5c, 0x27, 0xb4, 0xa8, 0x43, 0xfc, 0x78,
+	0x63, 0xf1, 0x35, 0xaf, 0x8d, 0xc1, 0x3b, 0x7f, 0x9f, 0x85, 0x72, 0x56, 0xc7, 0xf7, 0x00, 0x5b,
+	0xa4, 0xd1, 0xc2, 0xdc, 0x0b, 0xa3, 0x85, 0xc2, 0x54, 0x68, 0x21, 0x75, 0x99, 0xcf, 0xbf, 0x84,
+	0xcb, 0xfc, 0x36, 0x2c, 0xfb, 0x91, 0x27, 0xde, 0xc7, 0x5a, 0xec, 0xcd, 0x48, 0xc0, 0x0f, 0x79,
+	0x58, 0xfc, 0xc8, 0xe3, 0xa9, 0x69, 0xb1, 0x23, 0xa0, 0x1e, 0x96, 0xd4, 0x42, 0xed, 0x18, 0xd6,
+	0xb3, 0x19, 0x94, 0xe7, 0x65, 0x1f, 0xe6, 0xb9, 0xea, 0x71, 0xfd, 0x43, 0x91, 0x14, 0x67, 0x4a,
+	0x48, 0xa9, 0x67, 0x4a, 0x50, 0x6a, 0xfb, 0xb0, 0xbe, 0x8f, 0xfd, 0x16, 0xab, 0x57, 0xab, 0x63,
+	0x35, 0x1d, 0xda, 0xfd, 0x8e, 0xed, 0xa2, 0xd6, 0x9b, 0x87, 0xe5, 0x8c, 0x4a, 0xb4, 0x07, 0x0b,
+	0x81, 0x50, 0x1b, 0x56, 0xb4, 0x89, 0xc2, 0x1c, 0xd7, 0x49, 0x22, 0x67, 0x24, 0xbf, 0x10, 0x85,
+	0x45, 0x9f, 0xb4, 0xb0, 0x19, 0x62, 0x17, 0x37, 0x29, 0x09, 0x2a, 0x33, 0x3c, 0x18, 0xd7, 0xf2,
+	0x2a, 0x33, 0x7e, 0xd4, 0xef, 0x93, 0x16, 0x3e, 0x90, 0x52, 0x02, 0x71, 0xf0, 0xee, 0xea, 0x2b,
+	0x64, 0xb5, 0xbb, 0xaa, 0x74, 0xb4, 0x0f, 0x0b, 0xd6, 0xe1, 0xa1, 0xe3, 0xc7, 0xef, 0xa0, 0xa5,
+	0x9d, 0x8d, 0xba, 0x98, 0x2b, 0xd4, 0xad, 0x8e, 0x53, 0x6f, 0x92, 0x00, 0xd7, 0x9f, 0xbc, 0x57,
+	0xbf, 0x25, 0x79, 0x1a, 0xeb, 0xfd, 0x9e, 0x8e, 0x62, 0x09, 0x45, 0x6b, 0xa2, 0x05, 0x3d, 0x82,
+	0x12, 0x25, 0x2e, 0x0e, 0x78, 0x9e, 0xc2, 0xca, 0x1c, 0xdf, 0xc5, 0xe6, 0x30, 0xa5, 0x0f, 0x13,
+	0xb6, 0xc6, 0xaa, 0x0c, 0x8c, 0x2a, 0x6a, 0xa8, 0x0f, 0xe8, 0x01, 0xac, 0xa6, 0xe1, 0x99, 0xc8,
+	0x60, 0x81, 0x67, 0x50, 0xef, 0xf7, 0xf4, 0x4b, 0x1d, 0xf5, 0xb6, 0xcc, 0x64, 0x72, 0x25, 0xb7,
+	0x88, 0x7e, 0x04, 0x0b, 0xac, 0xa8, 0x79, 0x35, 0xcf, 0xf3, 0x6a, 0x5e, 0xeb, 0xf7, 0xf4, 0x15,
+	0x3f, 0xf2, 0x32, 0x75, 0x7c, 0x56, 0x92, 0xd0, 0xaf, 0x61, 0x29, 0x46, 0xe1, 0x32, 0xeb, 0x93,
+	0x81, 0xf0, 0x35, 0xb9, 0xb9, 0x45, 0x09, 0xba, 0x65, 0xea, 0xd3, 0x8f, 0xe8, 0x10, 0x10, 0x71,
+	0x5b, 0x38, 0xa4, 0x66, 0x18, 0xd9, 0x9e, 0x43, 0x4d, 0xde, 0x7b, 0x16, 0xc6, 0xf6, 0x9e, 0x0d,
+	0xa9, 0xbd, 0x2c, 0xa4, 0x0f, 0xb8, 0xf0, 0xc3, 0xb8, 0x0f, 0xe5, 0xa8, 0xec, 0x76, 0x92, 0xa7,
+	0x8d, 0x61, 0xf0, 0xe2, 0xe9, 0x27, 0xa9, 0xda, 0x86, 0x95, 0x5c, 0x79, 0x4d, 0x06, 0x42, 0xae,
+	0xaa, 0x20, 0xa4, 0x38, 0x16, 0x64, 0x9c, 0xc0, 0x5a, 0xee, 0xc8, 0xf2, 0xee, 0x60, 0xc0, 0xd9,
+	0x8e, 0x58, 0x90, 0xed, 0xe1, 0xb5, 0xb1, 0x27, 0x42, 0x64, 0x52, 0x4a, 0xa9, 0x99, 0x94, 0xa4,
+	0xda, 0x5f, 0x67, 0x00, 0x78, 0x4b, 0xf9, 0x24, 0x22, 0xd4, 0x42, 0x7f, 0xd1, 0xe0, 0x62, 0x16,
+	0x65, 0x9b, 0x87, 0x81, 0xd5, 0x64, 0xa5, 0x27, 0xad, 0x5e, 0x1f, 0xd1, 0x94, 0xb8, 0x86, 0xfa,
+	0xc7, 0x69, 0xf8, 0x7c, 0x47, 0xca, 0x8a, 0xd3, 0xf8, 0x66, 0xbf, 0xa7, 0xbf, 0xe6, 0x0d, 0xe7,
+	0x50, 0xbc, 0xbb, 0x30, 0x82, 0xa5, 0x1a, 0xc0, 0xc6, 0x69, 0xfa, 0x5f, 0x20, 0x1d, 0xda, 0xd8,
+	0x74, 0xfc, 0x67, 0x16, 0xd6, 0xee, 0x58, 0x4e, 0xe0, 0xe3, 0x30, 0x7c, 0x7c, 0x64, 0xd1, 0xbb,
+	0x87, 0x53, 0x5e, 0xd9, 0x08, 0xc3, 0x59, 0xf1, 0xce, 0x1c, 0xca, 0x4e, 0xf6, 0xe3, 0x7c, 0x04,
+	0x87, 0x5a, 0xa8, 0x3f, 0x16, 0x62, 0x22, 0x78, 0x3c, 0x95, 0x52, 0x91, 0x9a, 0x4a, 0x49, 0x42,
+	0x9f, 0xb2, 0x72, 0x26, 0xd4, 0x62, 0x33, 0x8a, 0x11, 0xfd, 0x72, 0xb8, 0x15, 0x9e, 0x38, 0x69,
+	0x64, 0x49, 0x9e, 0x21, 0xa9, 0xca, 0x90, 0x7f, 0xab, 0x36, 0x9c, 0x53, 0x9d, 0x79, 0x15, 0x91,
+	0xae, 0x7e, 0xc1, 0xa6, 0x5f, 0x89, 0x2b, 0x93, 0x99, 0xd8, 0x4d, 0x03, 0xfc, 0x8d, 0xd3, 0x6a,
+	0x73, 0x6c, 0xaa, 0xff, 0x5b, 0x80, 0xa5, 0x74, 0x88, 0xbe, 0x07, 0xc8, 0xaa, 0x01, 0x4b, 0xcd,
+	0x28, 0x08, 0xd8, 0xb4, 0x36, 0x35, 0xbd, 0xe1, 0x88, 0x44, 0xae, 0x3c, 0xce, 0x0e, 0x71, 0x16,
+	0x53, 0x0b, 0xca, 0xe4, 0xa7, 0x30, 0xc1, 0xe4, 0x67, 0x0f, 0x50, 0x6c, 0x51, 0xc1, 0x74, 0x62,
+	0x8e, 0xc3, 0xc7, 0xbf, 0x72, 0xf5, 0xce, 0x10, 0x68, 0x57, 0xce, 0xae, 0x65, 0x90, 0xe1, 0xd9,
+	0x89, 0x91, 0xe1, 0x03, 0x58, 0xb5, 0x5a, 0xc7, 0x51, 0xc8, 0xa6, 0x2a, 0x8a, 0x82, 0x05, 0xae,
+	0x80, 0x5f, 0x83, 0xf1, 0xf2, 0x30, 0x3f, 0x56, 0x72, 0x8b, 0x39, 0xa8, 0x59, 0x7c, 0x71, 0xa8,
+	0x09, 0x2f, 0x01, 0x6a, 0x9e, 0xb0, 0x6b, 0x9e, 0x30, 0x31, 0xdc, 0x32, 0x3b, 0x01, 0x66, 0x36,
+	0xf1, 0xa4, 0x73, 0x8e, 0xaa, 0x54, 0x8d, 0x12, 0x15, 0xfb, 0xb1, 0x06, 0x63, 0x08, 0xad, 0x76,
+	0x08, 0xe7, 0xb3, 0xbd, 0x81, 0x5f, 0x39, 0xf7, 0x33, 0x80, 0x74, 0x6b, 0x5c, 0x4f, 0x19, 0x03,
+	0x47, 0x6f, 0x40, 0x99, 0x5d, 0xa2, 0x0f, 0xbb, 0x1d, 0x1c, 0x4e, 0xfb, 0xe6, 0xf3, 0x6d, 0x01,
+	0x96, 0x63, 0xe1, 0x83, 0xc8, 0xf3, 0xac, 0xa0, 0x3b, 0x71, 0x0b, 0xbe, 0x01, 0x1c, 0xec, 0x99,
+	0xb4, 0xdb, 0xc1, 0xec, 0xbd, 0x91, 0x1d, 0xcb, 0x39, 0xf1, 0xde, 0xe8, 0x4b, 0x95, 0xe9, 0xf7,
+	0xc6, 0x01, 0x95, 0xf5, 0x55, 0xd7, 0xb2, 0xb1, 0x1b, 0xf7, 0xd5, 0x77, 0xf3, 0x31, 0xc8, 0xb8,
+	0x55, 0xdf, 0xe3, 0xfc, 0xa2, 0xa3, 0xf2, 0x80, 0x08, 0x05, 0x6a, 0x40, 0x04, 0x05, 0xdd, 0x82,
+	0x79, 0x6a, 0x39, 0x3e, 0x8d, 0xe1, 0xe1, 0xc5, 0xa1, 0xf0, 0x90, 0x71, 0x0c, 0x5a, 0xb3, 0x10,
+	0x30, 0xe4, 0x5f, 0x74, 0x0d, 0x8a, 0x0c, 0xbe, 0x31, 0x8f, 0x43, 0x7e, 0x7e, 0x0b, 0x02, 0x9b,
+	0xfa, 0x91, 0xc7, 0xfc, 0x52, 0xad, 0x2e, 0xc4, 0x34, 0xf4, 0x29, 0xb0, 0xb7, 0x15, 0x33, 0xf2,
+	0xe5, 0x5e, 0x2c, 0xdb, 0xc5, 0x52, 0x85, 0x80, 0x80, 0xaf, 0xf7, 0x7b, 0xba, 0xee, 0x47, 0xde,
+	0x23, 0x95, 0x23, 0xab, 0x6f, 0x6d, 0x28, 0x03, 0x0a, 0x60, 0xdd, 0xb5, 0x82, 0x36, 0x43, 0x70,
+	0x3c, 0xea, 0xd3, 0xce, 0x6a, 0x63, 0x20, 0x77, 0x5e, 0x6a, 0x61, 0x5a, 0x07, 0x13, 0xdb, 0xa1,
+	0xd4, 0x61, 0x83, 0xe1, 0x85, 0x97, 0x39, 0x18, 0xae, 0x5a, 0x50, 0x52, 0xd2, 0xfa, 0x4a, 0x90,
+	0x9f, 0x3b, 0x28, 0xf0, 0x78, 0x82, 0xf2, 0x4b, 0x80, 0xa4, 0x70, 0xc3, 0xd1, 0xb0, 0x2f, 0x53,
+	0x80, 0xa2, 0x81, 0xc6, 0x35, 0x9c, 0x1a, 0x46, 0x24, 0xc4, 0x9d, 0xaf, 0x16, 0x00, 0x1d, 0xc4,
+	0x8a, 0x8c, 0xf8, 0x6b, 0x31, 0x6a, 0xc1, 0xea, 0x2e, 0xa6, 0xb9, 0xcf, 0x69, 0x57, 0x4f, 0x9b,
+	0x85, 0xa4, 0x06, 0x51, 0xd5, 0xda, 0x78, 0x56, 0xf4, 0x08, 0x96, 0x76, 0x31, 0x55, 0x3f, 0x76,
+	0xbd, 0x31, 0xe2, 0xea, 0x4e, 0xeb, 0xbe, 0x7c, 0x2a, 0x17, 0x7a, 0x00, 0xe7, 0x76, 0x31, 0x1d,
+	0x7c, 0xc6, 0x1a, 0xe2, 0x4a, 0xf6, 0x1b, 0x59, 0xf5, 0xd2, 0x29, 0x3c, 0xe8, 0x98, 0x47, 0x23,
+	0xf7, 0x31, 0xe7, 0xea, 0xf8, 0xc1, 0x5e, 0xac, 0xfe, 0xca, 0x24, 0xac, 0xdc, 0x96, 0x07, 0xeb,
+	0xbb, 0x98, 0x0e, 0x9d, 0xa3, 0xe5, 0x75, 0x8c, 0x1e, 0x04, 0x56, 0xdf, 0x9c, 0x88, 0x1b, 0xfd,
+	0x41, 0x83, 0xcb, 0xa9, 0x4c, 0xe7, 0x46, 0x62, 0x3b, 0x53, 0xcc, 0xbf, 0x62, 0xe3, 0xef, 0x4c,
+	0x21, 0x13, 0xaa, 0xe1, 0x55, 0xe7, 0x59, 0x57, 0xc7, 0xcf, 0x3d, 0xc6, 0x85, 0x37, 0x3f, 0x5c,
+	0x69, 0x03, 0xda, 0xc5, 0x34, 0x3b, 0xba, 0xb8, 0x32, 0xf6, 0x1d, 0x2a, 0xb6, 0xf4, 0xf6, 0x04,
+	0x9c, 0xdc, 0x50, 0x0b, 0x56, 0x76, 0x31, 0xcd, 0x00, 0xc9, 0xb7, 0x27, 0x44, 0xe3, 0xd5, 0xb7,
+	0xc6, 0x33, 0xca, 0x13, 0xc4, 0x4a, 0x3d, 0xe9, 0x17, 0xc3, 0x4a, 0x3d, 0x7b, 0xd5, 0x56, 0x5f,
+	0x3b, 0x95, 0x87, 0xa9, 0x6d, 0x7c, 0xf6, 0xf5, 0xb3, 0x4d, 0xed, 0x9b, 0x67, 0x9b, 0xda, 0xbf,
+	0x9f, 0x6d, 0x6a, 0x5f, 0x3e, 0xdf, 0x3c, 0xf3, 0xcd, 0xf3, 0xcd, 0x33, 0xff, 0x7a, 0xbe, 0x79,
+	0xe6, 0x57, 0xb7, 0x95, 0xff, 0x06, 0xb1, 0x02, 0xcf, 0x6a, 0x59, 0x12, 0x48, 0xc8, 0xa7, 0xed,
+	0x09, 0xfe, 0x85, 0xc3, 0x9e, 0xe7, 0xc8, 0xf6, 0xda, 0xff, 0x06, 0x00, 0xe8, 0x09, 0x2a, 0xfe,
+	0xc3, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SchedulerReportingClient is the client API for SchedulerReporting service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SchedulerReportingClient interface {
+	// Return the most recent scheduling report for each executor.
+	GetSchedulingReport(ctx context.Context, in *SchedulingReportRequest, opts ...grpc.CallOption) (*SchedulingReport, error)
+	// Return the most recent report scheduling for each executor for the given queue.
+	GetQueueReport(ctx context.Context, in *QueueReportRequest, opts ...grpc.CallOption) (*QueueReport, error)
+	// Return the most recent scheduling report for each executor for the given job.
+	GetJobReport(ctx context.Context, in *JobReportRequest, opts ...grpc.CallOption) (*JobReport, error)
+	// Return the entitlement of the given queue, i.e., its fair share, usage, and remaining headroom, in each pool.
+	GetQueueEntitlement(ctx context.Context, in *QueueEntitlementRequest, opts ...grpc.CallOption) (*QueueEntitlementReport, error)
+	// Return groups of jobs with equal scheduling requirements submitted to the same queue within a recent window.
+	GetDuplicateJobsReport(ctx context.Context, in *DuplicateJobsReportRequest, opts ...grpc.CallOption) (*DuplicateJobsReport, error)
+	// Return a structured snapshot of the most recent scheduling round for each executor.
+	GetSchedulingContextSnapshots(ctx context.Context, in *SchedulingContextSnapshotRequest, opts ...grpc.CallOption) (*SchedulingContextSnapshots, error)
+	// Return the allocation, fair share, and number of queued jobs of each active queue in each pool.
+	GetQueueUtilisation(ctx context.Context, in *QueueUtilisationRequest, opts ...grpc.CallOption) (*QueueUtilisationReport, error)
+	// Return the queued jobs broken down by resource requests and node constraints,
+	// such that external node provisioners can provision nodes matching them.
+	GetPendingCapacity(ctx context.Context, in *PendingCapacityRequest, opts ...grpc.CallOption) (*PendingCapacityReport, error)
+	// Return the fair shares and projected preemptions of the queues under hypothetical weights and quotas,
+	// computed from the most recent scheduling round without changing anything.
+	GetFairnessWhatIf(ctx context.Context, in *FairnessWhatIfRequest, opts ...grpc.CallOption) (*FairnessWhatIfReport, error)
+	// Return the node types of the nodes of the executors known to the scheduler, such that users can see
+	// which labels, taints, and resources their jobs may select for without access to the clusters.
+	GetNodeTypes(ctx context.Context, in *NodeTypesRequest, opts ...grpc.CallOption) (*NodeTypesReport, error)
+}
+
+type schedulerReportingClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSchedulerReportingClient(cc *grpc.ClientConn) SchedulerReportingClient {
+	return &schedulerReportingClient{cc}
+}
+
+func (c *schedulerReportingClient) GetSchedulingReport(ctx context.Context, in *SchedulingReportRequest, opts ...grpc.CallOption) (*SchedulingReport, error) {
+	out := new(SchedulingReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetSchedulingReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetQueueReport(ctx context.Context, in *QueueReportRequest, opts ...grpc.CallOption) (*QueueReport, error) {
+	out := new(QueueReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetQueueReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetJobReport(ctx context.Context, in *JobReportRequest, opts ...grpc.CallOption) (*JobReport, error) {
+	out := new(JobReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetJobReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetQueueEntitlement(ctx context.Context, in *QueueEntitlementRequest, opts ...grpc.CallOption) (*QueueEntitlementReport, error) {
+	out := new(QueueEntitlementReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetQueueEntitlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetDuplicateJobsReport(ctx context.Context, in *DuplicateJobsReportRequest, opts ...grpc.CallOption) (*DuplicateJobsReport, error) {
+	out := new(DuplicateJobsReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetDuplicateJobsReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetSchedulingContextSnapshots(ctx context.Context, in *SchedulingContextSnapshotRequest, opts ...grpc.CallOption) (*SchedulingContextSnapshots, error) {
+	out := new(SchedulingContextSnapshots)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetSchedulingContextSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetQueueUtilisation(ctx context.Context, in *QueueUtilisationRequest, opts ...grpc.CallOption) (*QueueUtilisationReport, error) {
+	out := new(QueueUtilisationReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetQueueUtilisation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetPendingCapacity(ctx context.Context, in *PendingCapacityRequest, opts ...grpc.CallOption) (*PendingCapacityReport, error) {
+	out := new(PendingCapacityReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetPendingCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetFairnessWhatIf(ctx context.Context, in *FairnessWhatIfRequest, opts ...grpc.CallOption) (*FairnessWhatIfReport, error) {
+	out := new(FairnessWhatIfReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetFairnessWhatIf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetNodeTypes(ctx context.Context, in *NodeTypesRequest, opts ...grpc.CallOption) (*NodeTypesReport, error) {
+	out := new(NodeTypesReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetNodeTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulerReportingServer is the server API for SchedulerReporting service.
+type SchedulerReportingServer interface {
+	// Return the most recent scheduling report for each executor.
+	GetSchedulingReport(context.Context, *SchedulingReportRequest) (*SchedulingReport, error)
+	// Return the most recent report scheduling for each executor for the given queue.
+	GetQueueReport(context.Context, *QueueReportRequest) (*QueueReport, error)
+	// Return the most recent scheduling report for each executor for the given job.
+	GetJobReport(context.Context, *JobReportRequest) (*JobReport, error)
+	// Return the entitlement of the given queue, i.e., its fair share, usage, and remaining headroom, in each pool.
+	GetQueueEntitlement(context.Context, *QueueEntitlementRequest) (*QueueEntitlementReport, error)
+	// Return groups of jobs with equal scheduling requirements submitted to the same queue within a recent window.
+	GetDuplicateJobsReport(context.Context, *DuplicateJobsReportRequest) (*DuplicateJobsReport, error)
+	// Return a structured snapshot of the most recent scheduling round for each executor.
+	GetSchedulingContextSnapshots(context.Context, *SchedulingContextSnapshotRequest) (*SchedulingContextSnapshots, error)
+	// Return the allocation, fair share, and number of queued jobs of each active queue in each pool.
+	GetQueueUtilisation(context.Context, *QueueUtilisationRequest) (*QueueUtilisationReport, error)
+	// Return the queued jobs broken down by resource requests and node constraints,
+	// such that external node provisioners can provision nodes matching them.
+	GetPendingCapacity(context.Context, *PendingCapacityRequest) (*PendingCapacityReport, error)
+	// Return the fair shares and projected preemptions of the queues under hypothetical weights and quotas,
+	// computed from the most recent scheduling round without changing anything.
+	GetFairnessWhatIf(context.Context, *FairnessWhatIfRequest) (*FairnessWhatIfReport, error)
+	// Return the node types of the nodes of the executors known to the scheduler, such that users can see
+	// which labels, taints, and resources their jobs may select for without access to the clusters.
+	GetNodeTypes(context.Context, *NodeTypesRequest) (*NodeTypesReport, error)
+}
+
+// UnimplementedSchedulerReportingServer can be embedded to have forward compatible implementations.
+type UnimplementedSchedulerReportingServer struct {
+}
+
+func (*UnimplementedSchedulerReportingServer) GetSchedulingReport(ctx context.Context, req *SchedulingReportRequest) (*SchedulingReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulingReport not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetQueueReport(ctx context.Context, req *QueueReportRequest) (*QueueReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueReport not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetJobReport(ctx context.Context, req *JobReportRequest) (*JobReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobReport not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetQueueEntitlement(ctx context.Context, req *QueueEntitlementRequest) (*QueueEntitlementReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueEntitlement not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetDuplicateJobsReport(ctx context.Context, req *DuplicateJobsReportRequest) (*DuplicateJobsReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDuplicateJobsReport not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetSchedulingContextSnapshots(ctx context.Context, req *SchedulingContextSnapshotRequest) (*SchedulingContextSnapshots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulingContextSnapshots not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetQueueUtilisation(ctx context.Context, req *QueueUtilisationRequest) (*QueueUtilisationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueUtilisation not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetPendingCapacity(ctx context.Context, req *PendingCapacityRequest) (*PendingCapacityReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingCapacity not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetFairnessWhatIf(ctx context.Context, req *FairnessWhatIfRequest) (*FairnessWhatIfReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFairnessWhatIf not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetNodeTypes(ctx context.Context, req *NodeTypesRequest) (*NodeTypesReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeTypes not implemented")
+}
+
+func RegisterSchedulerReportingServer(s *grpc.Server, srv SchedulerReportingServer) {
+	s.RegisterService(&_SchedulerReporting_serviceDesc, srv)
+}
+
+func _SchedulerReporting_GetSchedulingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetSchedulingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetSchedulingReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetSchedulingReport(ctx, req.(*SchedulingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetQueueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetQueueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetQueueReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetQueueReport(ctx, req.(*QueueReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetJobReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetJobReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetJobReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetJobReport(ctx, req.(*JobReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetQueueEntitlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueEntitlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetQueueEntitlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetQueueEntitlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetQueueEntitlement(ctx, req.(*QueueEntitlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetDuplicateJobsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateJobsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetDuplicateJobsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetDuplicateJobsReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetDuplicateJobsReport(ctx, req.(*DuplicateJobsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetSchedulingContextSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulingContextSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetSchedulingContextSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetSchedulingContextSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetSchedulingContextSnapshots(ctx, req.(*SchedulingContextSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetQueueUtilisation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueUtilisationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetQueueUtilisation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetQueueUtilisation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetQueueUtilisation(ctx, req.(*QueueUtilisationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetPendingCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetPendingCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetPendingCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetPendingCapacity(ctx, req.(*PendingCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetFairnessWhatIf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FairnessWhatIfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetFairnessWhatIf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetFairnessWhatIf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetFairnessWhatIf(ctx, req.(*FairnessWhatIfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetNodeTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetNodeTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetNodeTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetNodeTypes(ctx, req.(*NodeTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SchedulerReporting_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "schedulerobjects.SchedulerReporting",
+	HandlerType: (*SchedulerReportingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchedulingReport",
+			Handler:    _SchedulerReporting_GetSchedulingReport_Handler,
+		},
+		{
+			MethodName: "GetQueueReport",
+			Handler:    _SchedulerReporting_GetQueueReport_Handler,
+		},
+		{
+			MethodName: "GetJobReport",
+			Handler:    _SchedulerReporting_GetJobReport_Handler,
+		},
+		{
+			MethodName: "GetQueueEntitlement",
+			Handler:    _SchedulerReporting_GetQueueEntitlement_Handler,
+		},
+		{
+			MethodName: "GetDuplicateJobsReport",
+			Handler:    _SchedulerReporting_GetDuplicateJobsReport_Handler,
+		},
+		{
+			MethodName: "GetSchedulingContextSnapshots",
+			Handler:    _SchedulerReporting_GetSchedulingContextSnapshots_Handler,
+		},
+		{
+			MethodName: "GetQueueUtilisation",
+			Handler:    _SchedulerReporting_GetQueueUtilisation_Handler,
+		},
+		{
+			MethodName: "GetPendingCapacity",
+			Handler:    _SchedulerReporting_GetPendingCapacity_Handler,
+		},
+		{
+			MethodName: "GetFairnessWhatIf",
+			Handler:    _SchedulerReporting_GetFairnessWhatIf_Handler,
+		},
+		{
+			MethodName: "GetNodeTypes",
+			Handler:    _SchedulerReporting_GetNodeTypes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/scheduler/schedulerobjects/reporting.proto",
+}
+
+func (m *MostRecentForQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MostRecentForQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MostRecentForQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MostRecentForJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MostRecentForJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MostRecentForJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verbosity != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.Verbosity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Filter != nil {
+		{
+			size := m.Filter.Size()
+			i -= size
+			if _, err := m.Filter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingReportRequest_MostRecentForQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingReportRequest_MostRecentForQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MostRecentForQueue != nil {
+		{
+			size, err := m.MostRecentForQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SchedulingReportRequest_MostRecentForJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingReportRequest_MostRecentForJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MostRecentForJob != nil {
+		{
+			size, err := m.MostRecentForJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SchedulingReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verbosity != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.Verbosity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueEntitlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueEntitlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueEntitlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tokens != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Tokens))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Burst != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.Burst))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Rate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rate))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueEntitlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueEntitlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueEntitlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueueRateLimit != nil {
+		{
+			size, err := m.QueueRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.GlobalRateLimit != nil {
+		{
+			size, err := m.GlobalRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size, err := m.MaximumResourcesPerRound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.RemainingByPriorityClass) > 0 {
+		for k := range m.RemainingByPriorityClass {
+			v := m.RemainingByPriorityClass[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AllocatedByPriorityClass) > 0 {
+		for k := range m.AllocatedByPriorityClass {
+			v := m.AllocatedByPriorityClass[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Allocated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.TotalResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ActualShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ActualShare))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.FairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FairShare))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Weight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Weight))))
+		i--
+		dAtA[i] = 0x21
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintReporting(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueEntitlementReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueEntitlementReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueEntitlementReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entitlements) > 0 {
+		for iNdEx := len(m.Entitlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entitlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DuplicateJobsReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateJobsReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateJobsReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinDuplicates != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.MinDuplicates))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DuplicateJobsReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateJobsReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateJobsReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingContextSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingContextSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingContextSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingContextSnapshots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingContextSnapshots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingContextSnapshots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueUtilisationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueUtilisationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueUtilisationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueUtilisation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueUtilisation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueUtilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumQueuedJobs != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.NumQueuedJobs))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Allocated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ActualShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ActualShare))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.FairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FairShare))))
+		i--
+		dAtA[i] = 0x21
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintReporting(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueUtilisationReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueUtilisationReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueUtilisationReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Queues[iNdEx])
+			copy(dAtA[i:], m.Queues[iNdEx])
+			i = encodeVarintReporting(dAtA, i, uint64(len(m.Queues[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OldestSubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.OldestSubmitTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintReporting(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.TotalRequests.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.NumJobs != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.NumJobs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PriorityClassName) > 0 {
+		i -= len(m.PriorityClassName)
+		copy(dAtA[i:], m.PriorityClassName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.PriorityClassName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Affinity != nil {
+		{
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Requests.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCapacityReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCapacityReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCapacityReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaximumResourceFraction) > 0 {
+		for k := range m.MaximumResourceFraction {
+			v := m.MaximumResourceFraction[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FairnessWhatIfRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FairnessWhatIfRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FairnessWhatIfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for k := range m.Quotas {
+			v := m.Quotas[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Weights) > 0 {
+		for k := range m.Weights {
+			v := m.Weights[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FairnessWhatIf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FairnessWhatIf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FairnessWhatIf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProjectedPreempted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.Allocated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.ActualShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ActualShare))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.AdjustedFairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AdjustedFairShare))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.FairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FairShare))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.CurrentFairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CurrentFairShare))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Weight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Weight))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.CurrentWeight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CurrentWeight))))
+		i--
+		dAtA[i] = 0x21
+	}
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintReporting(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FairnessWhatIfReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FairnessWhatIfReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FairnessWhatIfReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeTypeSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeTypeSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeTypeSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.LargestNodeResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.NumUnschedulableNodes != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.NumUnschedulableNodes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumNodes != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.NumNodes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Taints) > 0 {
+		for iNdEx := len(m.Taints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Taints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NodeTypeId != 0 {
+		i = encodeVarintReporting(dAtA, i, uint64(m.NodeTypeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeTypesReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeTypesReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeTypesReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeTypes) > 0 {
+		for iNdEx := len(m.NodeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReporting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReporting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MostRecentForQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *MostRecentForJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulingReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != nil {
+		n += m.Filter.Size()
+	}
+	if m.Verbosity != 0 {
+		n += 1 + sovReporting(uint64(m.Verbosity))
+	}
+	return n
+}
+
+func (m *SchedulingReportRequest_MostRecentForQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MostRecentForQueue != nil {
+		l = m.MostRecentForQueue.Size()
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+func (m *SchedulingReportRequest_MostRecentForJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MostRecentForJob != nil {
+		l = m.MostRecentForJob.Size()
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+func (m *SchedulingReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	if m.Verbosity != 0 {
+		n += 1 + sovReporting(uint64(m.Verbosity))
+	}
+	return n
+}
+
+func (m *QueueReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
 }
 
 func (m *JobReportRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovReporting(uint64(l))
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueEntitlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rate != 0 {
+		n += 9
+	}
+	if m.Burst != 0 {
+		n += 1 + sovReporting(uint64(m.Burst))
+	}
+	if m.Tokens != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *QueueEntitlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovReporting(uint64(l))
+	if m.Weight != 0 {
+		n += 9
+	}
+	if m.FairShare != 0 {
+		n += 9
+	}
+	if m.ActualShare != 0 {
+		n += 9
+	}
+	l = m.TotalResources.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	l = m.Allocated.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	if len(m.AllocatedByPriorityClass) > 0 {
+		for k, v := range m.AllocatedByPriorityClass {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovReporting(uint64(len(k))) + 1 + l + sovReporting(uint64(l))
+			n += mapEntrySize + 1 + sovReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RemainingByPriorityClass) > 0 {
+		for k, v := range m.RemainingByPriorityClass {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovReporting(uint64(len(k))) + 1 + l + sovReporting(uint64(l))
+			n += mapEntrySize + 1 + sovReporting(uint64(mapEntrySize))
+		}
+	}
+	l = m.MaximumResourcesPerRound.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	if m.GlobalRateLimit != nil {
+		l = m.GlobalRateLimit.Size()
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	if m.QueueRateLimit != nil {
+		l = m.QueueRateLimit.Size()
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueEntitlementReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entitlements) > 0 {
+		for _, e := range m.Entitlements {
+			l = e.Size()
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DuplicateJobsReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	if m.MinDuplicates != 0 {
+		n += 1 + sovReporting(uint64(m.MinDuplicates))
+	}
+	return n
+}
+
+func (m *DuplicateJobsReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulingContextSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulingContextSnapshots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueUtilisationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueUtilisation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovReporting(uint64(l))
+	if m.FairShare != 0 {
+		n += 9
+	}
+	if m.ActualShare != 0 {
+		n += 9
+	}
+	l = m.Allocated.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	if m.NumQueuedJobs != 0 {
+		n += 1 + sovReporting(uint64(m.NumQueuedJobs))
+	}
+	return n
+}
+
+func (m *QueueUtilisationReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Requests.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovReporting(uint64(len(k))) + 1 + len(v) + sovReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovReporting(uint64(mapEntrySize))
+		}
+	}
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	l = len(m.PriorityClassName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	if m.NumJobs != 0 {
+		n += 1 + sovReporting(uint64(m.NumJobs))
+	}
+	l = m.TotalRequests.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.OldestSubmitTime)
+	n += 1 + l + sovReporting(uint64(l))
+	if len(m.Queues) > 0 {
+		for _, s := range m.Queues {
+			l = len(s)
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingCapacityReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaximumResourceFraction) > 0 {
+		for k, v := range m.MaximumResourceFraction {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovReporting(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovReporting(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *FairnessWhatIfRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	if len(m.Weights) > 0 {
+		for k, v := range m.Weights {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovReporting(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Quotas) > 0 {
+		for k, v := range m.Quotas {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovReporting(uint64(len(k))) + 1 + l + sovReporting(uint64(l))
+			n += mapEntrySize + 1 + sovReporting(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *FairnessWhatIf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovReporting(uint64(l))
+	if m.CurrentWeight != 0 {
+		n += 9
+	}
+	if m.Weight != 0 {
+		n += 9
+	}
+	if m.CurrentFairShare != 0 {
+		n += 9
+	}
+	if m.FairShare != 0 {
+		n += 9
+	}
+	if m.AdjustedFairShare != 0 {
+		n += 9
+	}
+	if m.ActualShare != 0 {
+		n += 9
+	}
+	l = m.Allocated.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	l = m.ProjectedPreempted.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	return n
+}
+
+func (m *FairnessWhatIfReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeTypeSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovReporting(uint64(l))
+	}
+	if m.NodeTypeId != 0 {
+		n += 1 + sovReporting(uint64(m.NodeTypeId))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovReporting(uint64(len(k))) + 1 + len(v) + sovReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Taints) > 0 {
+		for _, e := range m.Taints {
+			l = e.Size()
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	if m.NumNodes != 0 {
+		n += 1 + sovReporting(uint64(m.NumNodes))
+	}
+	if m.NumUnschedulableNodes != 0 {
+		n += 1 + sovReporting(uint64(m.NumUnschedulableNodes))
+	}
+	l = m.LargestNodeResources.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	l = m.TotalResources.Size()
+	n += 1 + l + sovReporting(uint64(l))
+	return n
+}
+
+func (m *NodeTypesReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeTypes) > 0 {
+		for _, e := range m.NodeTypes {
+			l = e.Size()
+			n += 1 + l + sovReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReporting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReporting(x uint64) (n int) {
+	return sovReporting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MostRecentForQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MostRecentForQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MostRecentForJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MostRecentForJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentForQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MostRecentForQueue{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Filter = &SchedulingReportRequest_MostRecentForQueue{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentForJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MostRecentForJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Filter = &SchedulingReportRequest_MostRecentForJob{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
+			}
+			m.Verbosity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Verbosity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Report = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
+			}
+			m.Verbosity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Verbosity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Report = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Report = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueEntitlementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueEntitlementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueEntitlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rate = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
+			}
+			m.Burst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burst |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Tokens = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueEntitlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueEntitlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueEntitlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Weight = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FairShare = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ActualShare = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedByPriorityClass", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocatedByPriorityClass == nil {
+				m.AllocatedByPriorityClass = make(map[string]ResourceList)
+			}
+			var mapkey string
+			mapvalue := &ResourceList{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ResourceList{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllocatedByPriorityClass[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingByPriorityClass", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemainingByPriorityClass == nil {
+				m.RemainingByPriorityClass = make(map[string]ResourceList)
+			}
+			var mapkey string
+			mapvalue := &ResourceList{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ResourceList{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RemainingByPriorityClass[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumResourcesPerRound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumResourcesPerRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalRateLimit == nil {
+				m.GlobalRateLimit = &RateLimitState{}
+			}
+			if err := m.GlobalRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueueRateLimit == nil {
+				m.QueueRateLimit = &RateLimitState{}
+			}
+			if err := m.QueueRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueEntitlementReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueEntitlementReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueEntitlementReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entitlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entitlements = append(m.Entitlements, &QueueEntitlement{})
+			if err := m.Entitlements[len(m.Entitlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DuplicateJobsReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateJobsReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateJobsReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDuplicates", wireType)
+			}
+			m.MinDuplicates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDuplicates |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DuplicateJobsReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateJobsReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateJobsReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Report = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingContextSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingContextSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingContextSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingContextSnapshots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingContextSnapshots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingContextSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, &SchedulingContextSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueUtilisationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueUtilisationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueUtilisationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueUtilisation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueUtilisation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueUtilisation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FairShare = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ActualShare = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumQueuedJobs", wireType)
+			}
+			m.NumQueuedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumQueuedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueUtilisationReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueUtilisationReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueUtilisationReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &QueueUtilisation{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Requests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumJobs", wireType)
+			}
+			m.NumJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestSubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OldestSubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *JobReport) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingCapacityReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCapacityReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCapacityReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &PendingCapacity{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Report)
-	if l > 0 {
-		n += 1 + l + sovReporting(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueueQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumResourceFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaximumResourceFraction == nil {
+				m.MaximumResourceFraction = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MaximumResourceFraction[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovReporting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozReporting(x uint64) (n int) {
-	return sovReporting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
+func (m *FairnessWhatIfRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1100,30 +7632,168 @@ func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowReporting
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FairnessWhatIfRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FairnessWhatIfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Weights == nil {
+				m.Weights = make(map[string]float64)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MostRecentForQueue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MostRecentForQueue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Weights[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReporting
@@ -1133,23 +7803,120 @@ func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReporting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReporting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueName = string(dAtA[iNdEx:postIndex])
+			if m.Quotas == nil {
+				m.Quotas = make(map[string]QueueQuota)
+			}
+			var mapkey string
+			mapvalue := &QueueQuota{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &QueueQuota{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Quotas[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1172,7 +7939,7 @@ func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
+func (m *FairnessWhatIf) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1195,15 +7962,15 @@ func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MostRecentForJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: FairnessWhatIf: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MostRecentForJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FairnessWhatIf: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1231,61 +7998,142 @@ func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.QueueName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipReporting(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthReporting
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowReporting
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingReportRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CurrentWeight = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Weight = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CurrentFairShare = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FairShare = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustedFairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AdjustedFairShare = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ActualShare = float64(math.Float64frombits(v))
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentForQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1312,15 +8160,96 @@ func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MostRecentForQueue{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Filter = &SchedulingReportRequest_MostRecentForQueue{v}
 			iNdEx = postIndex
-		case 2:
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedPreempted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedPreempted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FairnessWhatIfReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FairnessWhatIfReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FairnessWhatIfReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentForJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1347,31 +8276,11 @@ func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MostRecentForJob{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Queues = append(m.Queues, &FairnessWhatIf{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Filter = &SchedulingReportRequest_MostRecentForJob{v}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
-			}
-			m.Verbosity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReporting
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Verbosity |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReporting(dAtA[iNdEx:])
@@ -1393,7 +8302,7 @@ func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
+func (m *NodeTypesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1416,15 +8325,15 @@ func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeTypesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1452,7 +8361,7 @@ func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1475,7 +8384,7 @@ func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
+func (m *NodeTypeSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,15 +8407,15 @@ func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueReportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeTypeSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeTypeSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1534,13 +8443,13 @@ func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueName = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypeId", wireType)
 			}
-			m.Verbosity = 0
+			m.NodeTypeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReporting
@@ -1550,66 +8459,143 @@ func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Verbosity |= int32(b&0x7F) << shift
+				m.NodeTypeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipReporting(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthReporting
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueueReport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowReporting
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueueReport: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueReport: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Taints", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReporting
@@ -1619,79 +8605,102 @@ func (m *QueueReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReporting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReporting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipReporting(dAtA[iNdEx:])
-			if err != nil {
+			m.Taints = append(m.Taints, v1.Taint{})
+			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthReporting
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReportRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowReporting
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUnschedulableNodes", wireType)
 			}
-			if iNdEx >= l {
+			m.NumUnschedulableNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUnschedulableNodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargestNodeResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporting
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LargestNodeResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobReportRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalResources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReporting
@@ -1701,23 +8710,24 @@ func (m *JobReportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReporting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReporting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1740,7 +8750,7 @@ func (m *JobReportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReport) Unmarshal(dAtA []byte) error {
+func (m *NodeTypesReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,17 +8773,17 @@ func (m *JobReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeTypesReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeTypesReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReporting
@@ -1783,23 +8793,25 @@ func (m *JobReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReporting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReporting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = string(dAtA[iNdEx:postIndex])
+			m.NodeTypes = append(m.NodeTypes, &NodeTypeSummary{})
+			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12,6 +12,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "k8s.io/api/core/v1"
@@ -203,6 +204,9 @@ type Node struct {
 	// This should only be used for metrics
 	// This is the type the node should be reported as. It is simple a label to categorise the group the node belongs to
 	ReportingNodeType string `protobuf:"bytes,17,opt,name=reporting_node_type,json=reportingNodeType,proto3" json:"reportingNodeType,omitempty"`
+	// Storage classes of which volumes can be attached to this node.
+	// The empty string denotes the default storage class of the cluster.
+	StorageClasses []string `protobuf:"bytes,20,rep,name=storage_classes,json=storageClasses,proto3" json:"storageClasses,omitempty"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
@@ -371,6 +375,13 @@ func (m *Node) GetReportingNodeType() string {
 	return ""
 }
 
+func (m *Node) GetStorageClasses() []string {
+	if m != nil {
+		return m.StorageClasses
+	}
+	return nil
+}
+
 // NodeType represents a particular combination of taints and labels.
 // The scheduler groups nodes by node type. When assigning pods to nodes,
 // the scheduler only considers nodes with a NodeType for which the taints and labels match.
@@ -606,16 +617,12 @@ func (m *ResourceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *ResourceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ResourceList.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
+	return b[:n], nil
 }
 func (m *ResourceList) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_ResourceList.Merge(m, src)
@@ -842,6 +849,8 @@ type PodRequirements struct {
 	PreemptionPolicy string `protobuf:"bytes,5,opt,name=preemptionPolicy,proto3" json:"preemptionPolicy,omitempty"`
 	// Sum of the resource requirements for all containers that make up this pod.
 	ResourceRequirements v1.ResourceRequirements `protobuf:"bytes,6,opt,name=resourceRequirements,proto3" json:"resourceRequirements"`
+	// Persistent volume claims the pod needs to be provisioned before it can start.
+	PersistentVolumeClaims []*PersistentVolumeClaimRequirements `protobuf:"bytes,9,rep,name=persistentVolumeClaims,proto3" json:"persistentVolumeClaims,omitempty"`
 }
 
 func (m *PodRequirements) Reset()         { *m = PodRequirements{} }
@@ -926,6 +935,68 @@ func (m *PodRequirements) GetResourceRequirements() v1.ResourceRequirements {
 	return v1.ResourceRequirements{}
 }
 
+func (m *PodRequirements) GetPersistentVolumeClaims() []*PersistentVolumeClaimRequirements {
+	if m != nil {
+		return m.PersistentVolumeClaims
+	}
+	return nil
+}
+
+// Storage requested by a pod via a persistent volume claim.
+type PersistentVolumeClaimRequirements struct {
+	// Name of the pod volume backed by the claim.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Storage class of the claim. The empty string denotes the default storage class of the cluster.
+	StorageClassName string `protobuf:"bytes,2,opt,name=storageClassName,proto3" json:"storageClassName,omitempty"`
+}
+
+func (m *PersistentVolumeClaimRequirements) Reset()         { *m = PersistentVolumeClaimRequirements{} }
+func (m *PersistentVolumeClaimRequirements) String() string { return proto.CompactTextString(m) }
+func (*PersistentVolumeClaimRequirements) ProtoMessage()    {}
+func (*PersistentVolumeClaimRequirements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97dadc5fbd620721, []int{9}
+}
+func (m *PersistentVolumeClaimRequirements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersistentVolumeClaimRequirements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PersistentVolumeClaimRequirements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PersistentVolumeClaimRequirements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistentVolumeClaimRequirements.Merge(m, src)
+}
+func (m *PersistentVolumeClaimRequirements) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersistentVolumeClaimRequirements) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistentVolumeClaimRequirements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistentVolumeClaimRequirements proto.InternalMessageInfo
+
+func (m *PersistentVolumeClaimRequirements) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PersistentVolumeClaimRequirements) GetStorageClassName() string {
+	if m != nil {
+		return m.StorageClassName
+	}
+	return ""
+}
+
 // Used to store details about pulsar scheduler jobs in Redis
 // Can be removed once we deprecate the legacy scheduler
 type PulsarSchedulerJobDetails struct {
@@ -938,7 +1009,7 @@ func (m *PulsarSchedulerJobDetails) Reset()         { *m = PulsarSchedulerJobDet
 func (m *PulsarSchedulerJobDetails) String() string { return proto.CompactTextString(m) }
 func (*PulsarSchedulerJobDetails) ProtoMessage()    {}
 func (*PulsarSchedulerJobDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97dadc5fbd620721, []int{9}
+	return fileDescriptor_97dadc5fbd620721, []int{10}
 }
 func (m *PulsarSchedulerJobDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,6 +1086,7 @@ func init() {
 	proto.RegisterType((*PodRequirements)(nil), "schedulerobjects.PodRequirements")
 	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PodRequirements.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PodRequirements.NodeSelectorEntry")
+	proto.RegisterType((*PersistentVolumeClaimRequirements)(nil), "schedulerobjects.PersistentVolumeClaimRequirements")
 	proto.RegisterType((*PulsarSchedulerJobDetails)(nil), "schedulerobjects.PulsarSchedulerJobDetails")
 }
 
@@ -1023,145 +1095,150 @@ func init() {
 }
 
 var fileDescriptor_97dadc5fbd620721 = []byte{
-	// 2197 bytes of a gzipped FileDescriptorProto
+	// 2288 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x55, 0x2b, 0x52, 0x14, 0x39, 0x94, 0x25, 0x6a, 0xe4, 0x8f, 0x15, 0x63, 0x73, 0x19, 0xc6, 0x0d,
-	0x94, 0xc6, 0x21, 0x1b, 0xa5, 0x40, 0x0d, 0xb7, 0x17, 0xd1, 0x52, 0x6b, 0x2a, 0x36, 0x25, 0x2f,
-	0xa5, 0x16, 0x2d, 0xd0, 0x2c, 0x96, 0xdc, 0x11, 0xbd, 0xd1, 0x72, 0x86, 0xde, 0x9d, 0x55, 0xc3,
-	0x9c, 0xdb, 0x43, 0x11, 0x20, 0x0d, 0x8a, 0x7e, 0x04, 0x28, 0xd0, 0x22, 0xb7, 0xfc, 0x82, 0xf6,
-	0xd0, 0x3f, 0xe0, 0x63, 0x8e, 0x3d, 0x31, 0x85, 0x7d, 0xe3, 0xb5, 0x7f, 0xa0, 0x98, 0x99, 0x5d,
-	0xee, 0x70, 0x97, 0x14, 0xe5, 0xa4, 0xae, 0x4e, 0xe4, 0xbc, 0xef, 0x79, 0xef, 0xcd, 0x9b, 0xf7,
-	0x66, 0xc1, 0x3d, 0x1b, 0x53, 0xe4, 0x62, 0xd3, 0xa9, 0x79, 0x9d, 0x27, 0xc8, 0xf2, 0x1d, 0xe4,
-	0x46, 0xff, 0x48, 0xfb, 0x43, 0xd4, 0xa1, 0x5e, 0x02, 0x50, 0xed, 0xbb, 0x84, 0x12, 0x58, 0x88,
-	0xc3, 0x8b, 0x5a, 0x97, 0x90, 0xae, 0x83, 0x6a, 0x1c, 0xdf, 0xf6, 0x4f, 0x6a, 0xd4, 0xee, 0x21,
-	0x8f, 0x9a, 0xbd, 0xbe, 0x60, 0x29, 0x56, 0x4e, 0xef, 0x7a, 0x55, 0x9b, 0xd4, 0xcc, 0xbe, 0x5d,
-	0xeb, 0x10, 0x17, 0xd5, 0xce, 0xde, 0xad, 0x75, 0x11, 0x46, 0xae, 0x49, 0x91, 0x15, 0xd0, 0x7c,
-	0x3f, 0xa2, 0xe9, 0x99, 0x9d, 0x27, 0x36, 0x46, 0xee, 0xa0, 0xd6, 0x3f, 0xed, 0x72, 0x26, 0x17,
-	0x79, 0xc4, 0x77, 0x3b, 0x28, 0xc1, 0xf5, 0x4e, 0xd7, 0xa6, 0x4f, 0xfc, 0x76, 0xb5, 0x43, 0x7a,
-	0xb5, 0x2e, 0xe9, 0x92, 0xc8, 0x06, 0xb6, 0xe2, 0x0b, 0xfe, 0x4f, 0x90, 0x57, 0xbe, 0x4c, 0x81,
-	0xec, 0xde, 0x47, 0xa8, 0xe3, 0x53, 0xe2, 0xc2, 0x32, 0x58, 0xb4, 0x2d, 0x55, 0x29, 0x2b, 0x5b,
-	0xb9, 0x7a, 0x61, 0x34, 0xd4, 0x56, 0x6c, 0xeb, 0x0e, 0xe9, 0xd9, 0x14, 0xf5, 0xfa, 0x74, 0xa0,
-	0x2f, 0xda, 0x16, 0x7c, 0x13, 0xa4, 0xfb, 0x84, 0x38, 0xea, 0x22, 0xa7, 0x81, 0xa3, 0xa1, 0xb6,
-	0xca, 0xd6, 0x12, 0x15, 0xc7, 0xc3, 0x1d, 0xb0, 0x84, 0x89, 0x85, 0x3c, 0x35, 0x55, 0x4e, 0x6d,
-	0xe5, 0xb7, 0xaf, 0x57, 0x13, 0xae, 0x6b, 0x12, 0x0b, 0xd5, 0x37, 0x46, 0x43, 0x6d, 0x8d, 0x13,
-	0x4a, 0x12, 0x04, 0x27, 0xfc, 0x00, 0xac, 0xf6, 0x6c, 0x6c, 0xf7, 0xfc, 0xde, 0x3e, 0x69, 0xb7,
-	0xec, 0x8f, 0x91, 0x9a, 0x2e, 0x2b, 0x5b, 0xf9, 0xed, 0x52, 0x52, 0x96, 0x1e, 0x38, 0xe3, 0xa1,
-	0xed, 0xd1, 0xfa, 0xf5, 0x67, 0x43, 0x6d, 0x81, 0x19, 0x36, 0xc9, 0xad, 0xc7, 0xd6, 0x4c, 0xbe,
-	0x63, 0x7a, 0xf4, 0xb8, 0x6f, 0x99, 0x14, 0x1d, 0xd9, 0x3d, 0xa4, 0x2e, 0x71, 0xf9, 0xc5, 0xaa,
-	0x08, 0x5e, 0x35, 0x74, 0x5c, 0xf5, 0x28, 0x0c, 0x5e, 0xbd, 0x18, 0xca, 0x9e, 0xe4, 0xfc, 0xec,
-	0x6b, 0x4d, 0xd1, 0x63, 0x30, 0x78, 0x00, 0x36, 0x7c, 0x6c, 0x7a, 0x9e, 0xdd, 0xc5, 0xc8, 0x32,
-	0x3e, 0x24, 0x6d, 0xc3, 0xf5, 0xb1, 0xa7, 0xe6, 0xca, 0xa9, 0xad, 0x5c, 0x5d, 0x1b, 0x0d, 0xb5,
-	0xd7, 0x22, 0xf4, 0x3e, 0x69, 0xeb, 0x3e, 0x96, 0x9d, 0xb0, 0x9e, 0x40, 0x56, 0xbe, 0xbc, 0x0e,
-	0xd2, 0xcc, 0x6b, 0x17, 0x0b, 0x13, 0x36, 0x7b, 0x48, 0x5d, 0x89, 0xc2, 0xc4, 0xd6, 0x72, 0x98,
-	0xd8, 0x1a, 0x6e, 0x83, 0x2c, 0x0a, 0x82, 0xaf, 0x6e, 0x70, 0xda, 0xeb, 0xa3, 0xa1, 0x06, 0x43,
-	0x98, 0x44, 0x3f, 0xa6, 0x83, 0x77, 0x01, 0x60, 0x01, 0xda, 0x6d, 0xbf, 0x8f, 0x06, 0x9e, 0x0a,
-	0xcb, 0xa9, 0xad, 0x95, 0xba, 0x3a, 0x1a, 0x6a, 0x57, 0x23, 0xa8, 0xc4, 0x27, 0xd1, 0xc2, 0x47,
-	0x20, 0xc7, 0x7c, 0x64, 0x78, 0x08, 0x61, 0x9e, 0x41, 0xe7, 0x3b, 0xfb, 0x6a, 0xe0, 0xec, 0x2c,
-	0x63, 0x6a, 0x21, 0x84, 0xb9, 0x9b, 0xc7, 0x2b, 0x78, 0x00, 0x72, 0x4c, 0xb8, 0x41, 0x07, 0x7d,
-	0xa4, 0xa6, 0x02, 0x71, 0x53, 0xf3, 0xec, 0x68, 0xd0, 0x47, 0x62, 0x67, 0x38, 0x58, 0xc9, 0x3b,
-	0x0b, 0x61, 0xf0, 0x1e, 0x58, 0x19, 0x0b, 0x34, 0x6c, 0x8b, 0xe7, 0x5b, 0x3a, 0xda, 0x1b, 0xa3,
-	0x69, 0x58, 0xf1, 0xbd, 0x09, 0x28, 0xdc, 0x01, 0x19, 0x6a, 0xda, 0x98, 0x7a, 0xea, 0x12, 0xcf,
-	0xf8, 0xcd, 0xaa, 0x38, 0xbd, 0x55, 0xb3, 0x6f, 0x57, 0xd9, 0x09, 0xaf, 0x9e, 0xbd, 0x5b, 0x3d,
-	0x62, 0x14, 0xf5, 0xd5, 0x60, 0x5f, 0x01, 0x83, 0x1e, 0xfc, 0xc2, 0x43, 0x90, 0x71, 0xcc, 0x36,
-	0x72, 0x3c, 0x35, 0xc3, 0x45, 0x54, 0xa6, 0x6f, 0xa6, 0xfa, 0x90, 0x13, 0xed, 0x61, 0xea, 0x0e,
-	0xea, 0x57, 0x47, 0x43, 0xad, 0x20, 0xb8, 0x24, 0xc3, 0x02, 0x39, 0xd0, 0x00, 0x6b, 0x94, 0x50,
-	0xd3, 0x31, 0xc2, 0x6a, 0xe1, 0xa9, 0xcb, 0x2f, 0x77, 0x86, 0x38, 0x7b, 0x88, 0xf2, 0xf4, 0xd8,
-	0x1a, 0xfe, 0x5d, 0x01, 0xb7, 0x4d, 0xc7, 0x21, 0x1d, 0x93, 0x9a, 0x6d, 0x07, 0x19, 0xed, 0x81,
-	0xd1, 0x77, 0x6d, 0xe2, 0xda, 0x74, 0x60, 0x98, 0xd8, 0x1a, 0xeb, 0x55, 0xb3, 0x7c, 0x47, 0x3f,
-	0x9a, 0xb1, 0xa3, 0x9d, 0x48, 0x44, 0x7d, 0x70, 0x18, 0x08, 0xd8, 0xc1, 0x56, 0xa8, 0x48, 0xec,
-	0x75, 0x2b, 0x30, 0xaa, 0x6c, 0xce, 0x21, 0xd7, 0xe7, 0x52, 0x40, 0x17, 0x6c, 0x78, 0xd4, 0xa4,
-	0xdc, 0xe2, 0xe0, 0x68, 0xb2, 0x88, 0xe7, 0xb8, 0x99, 0x6f, 0xcf, 0x30, 0xb3, 0xc5, 0x38, 0xea,
-	0x03, 0x71, 0x1e, 0x1b, 0x96, 0xb0, 0xea, 0x46, 0x60, 0xd5, 0x9a, 0x37, 0x89, 0xd5, 0xe3, 0x00,
-	0xe8, 0x83, 0x8d, 0xc0, 0x2e, 0x64, 0x85, 0x7a, 0x6d, 0x4b, 0x05, 0x5c, 0xe7, 0x9d, 0xf3, 0x5d,
-	0x83, 0x2c, 0x2e, 0x28, 0x54, 0xaa, 0x06, 0x4a, 0x0b, 0x66, 0x0c, 0xad, 0x27, 0x20, 0x90, 0x02,
-	0x38, 0xa1, 0xf6, 0xa9, 0x8f, 0x7c, 0xa4, 0xe6, 0x2f, 0xaa, 0xf5, 0x31, 0x23, 0x9f, 0xad, 0x95,
-	0xa3, 0xf5, 0x04, 0x84, 0x6d, 0x16, 0x9d, 0xd9, 0x1d, 0x1a, 0x95, 0x3e, 0xc3, 0xb6, 0x3c, 0x75,
-	0xf5, 0x5c, 0xb5, 0x7b, 0x82, 0x23, 0xf4, 0x98, 0x17, 0x53, 0x8b, 0x62, 0x68, 0x3d, 0x01, 0x81,
-	0x5f, 0x28, 0xa0, 0x84, 0x09, 0x36, 0x4c, 0xb7, 0x67, 0x5a, 0xa6, 0x11, 0x6d, 0x3c, 0x3a, 0x01,
-	0x57, 0xb8, 0x09, 0x3f, 0x98, 0x61, 0x42, 0x93, 0xe0, 0x1d, 0xce, 0x3b, 0x76, 0xc1, 0x38, 0xdb,
-	0x85, 0x35, 0x6f, 0x04, 0xd6, 0xbc, 0x86, 0x67, 0x53, 0xea, 0xe7, 0x21, 0xe1, 0x0e, 0xb8, 0xe2,
-	0xe3, 0x40, 0x3b, 0xcb, 0x50, 0x75, 0xad, 0xac, 0x6c, 0x65, 0xeb, 0xaf, 0x8d, 0x86, 0xda, 0x8d,
-	0x09, 0x84, 0x74, 0xa2, 0x27, 0x39, 0xe0, 0x27, 0x0a, 0xb8, 0x11, 0xee, 0xc8, 0xf0, 0x3d, 0xb3,
-	0x8b, 0xa2, 0xc8, 0x16, 0xf8, 0xfe, 0xbe, 0x37, 0x63, 0x7f, 0xa1, 0x19, 0xc7, 0x8c, 0x69, 0x22,
-	0xba, 0x95, 0xd1, 0x50, 0x2b, 0xb9, 0x53, 0xd0, 0x92, 0x19, 0x57, 0xa7, 0xe1, 0xd9, 0x4d, 0xe7,
-	0xa2, 0x3e, 0x71, 0xa9, 0x8d, 0xbb, 0x46, 0x54, 0x92, 0xd7, 0xf9, 0x85, 0xc2, 0x6f, 0xba, 0x31,
-	0xba, 0x99, 0xac, 0xbf, 0xeb, 0x09, 0x64, 0xd1, 0x04, 0x79, 0xa9, 0xc8, 0xc1, 0x37, 0x40, 0xea,
-	0x14, 0x0d, 0x82, 0x0b, 0x6f, 0x7d, 0x34, 0xd4, 0xae, 0x9c, 0xa2, 0x81, 0x24, 0x81, 0x61, 0xe1,
-	0x5b, 0x60, 0xe9, 0xcc, 0x74, 0x7c, 0x14, 0xb4, 0x26, 0xbc, 0xb3, 0xe0, 0x00, 0xb9, 0xb3, 0xe0,
-	0x80, 0x7b, 0x8b, 0x77, 0x95, 0xe2, 0x5f, 0x14, 0xf0, 0x9d, 0x0b, 0x95, 0x1d, 0x59, 0xfb, 0xd2,
-	0x4c, 0xed, 0x0d, 0x59, 0xfb, 0xfc, 0xfa, 0x3a, 0xcf, 0xba, 0xdf, 0x2a, 0xe0, 0xea, 0xb4, 0x6a,
-	0x73, 0x31, 0x57, 0x3c, 0x90, 0x8d, 0x59, 0xdd, 0xbe, 0x95, 0x34, 0x46, 0x08, 0x15, 0x1a, 0xe6,
-	0xd9, 0xf2, 0x89, 0x02, 0xae, 0x4d, 0xad, 0x42, 0x17, 0x33, 0xe6, 0x7f, 0xec, 0x99, 0x98, 0x35,
-	0x51, 0xfe, 0x5e, 0x8a, 0x35, 0xa7, 0xe0, 0xda, 0xd4, 0x9a, 0xf5, 0x0d, 0x52, 0x36, 0x3b, 0x57,
-	0xd9, 0x9f, 0x14, 0x50, 0x9e, 0x57, 0x9e, 0x2e, 0x25, 0x5b, 0x7f, 0xa7, 0x80, 0xcd, 0x99, 0x75,
-	0xe5, 0x32, 0xe2, 0x52, 0xf9, 0x6b, 0x1a, 0x64, 0xc3, 0x6a, 0xc2, 0xda, 0xe5, 0x86, 0x68, 0x97,
-	0xd3, 0xa2, 0x5d, 0x9e, 0x68, 0xe2, 0x16, 0x27, 0x9a, 0xb7, 0xc5, 0x6f, 0xda, 0xbc, 0x1d, 0x8d,
-	0x9b, 0x37, 0x31, 0xf1, 0xbc, 0x39, 0xbb, 0x13, 0x7d, 0x89, 0x06, 0xee, 0xd7, 0x0a, 0x80, 0x3e,
-	0xf6, 0x10, 0x6d, 0x60, 0x0b, 0x7d, 0x84, 0x2c, 0xc1, 0xa9, 0xa6, 0xb9, 0x8a, 0xed, 0x73, 0x54,
-	0x1c, 0x27, 0x98, 0x84, 0xba, 0xf2, 0x68, 0xa8, 0xdd, 0x4c, 0x4a, 0x94, 0x54, 0x4f, 0xd1, 0xf7,
-	0xff, 0xa8, 0xc7, 0x3d, 0x70, 0x63, 0x86, 0xcd, 0xaf, 0x42, 0x5d, 0xe5, 0x59, 0x06, 0x6c, 0xf2,
-	0x1c, 0xbd, 0xef, 0xf8, 0x1e, 0x45, 0xee, 0x44, 0xfa, 0xc2, 0x06, 0x58, 0xee, 0xb8, 0x88, 0x9d,
-	0x2e, 0xae, 0xf5, 0xfc, 0x31, 0x65, 0x23, 0xc8, 0x88, 0x90, 0x85, 0x4f, 0x29, 0xe1, 0x82, 0xd9,
-	0x25, 0xae, 0x65, 0xc9, 0xae, 0xa7, 0xb1, 0x5b, 0x55, 0x50, 0xb0, 0xc1, 0x2a, 0x1c, 0xb2, 0x1a,
-	0x16, 0x1f, 0x68, 0x72, 0x62, 0xf8, 0x88, 0xa0, 0xf2, 0xf0, 0x11, 0x41, 0xe1, 0x1f, 0x15, 0x76,
-	0x03, 0x07, 0x75, 0x20, 0xba, 0xca, 0x82, 0x3c, 0xd9, 0x4d, 0xe6, 0xc9, 0xcc, 0xad, 0x8f, 0x8f,
-	0x99, 0x24, 0x46, 0x64, 0xce, 0xad, 0x60, 0x9b, 0x53, 0x15, 0x29, 0xfa, 0x34, 0x30, 0xfc, 0x87,
-	0x02, 0x6e, 0x4e, 0x81, 0xdf, 0x77, 0x4c, 0xcf, 0x6b, 0x9a, 0x7c, 0xe2, 0x66, 0x06, 0x3e, 0xfa,
-	0x96, 0x06, 0x8e, 0xe5, 0x09, 0x4b, 0x6f, 0x07, 0x96, 0x9e, 0xab, 0x5a, 0x3f, 0x17, 0x5b, 0xfc,
-	0x54, 0x01, 0xea, 0x2c, 0x57, 0x5c, 0x4a, 0x8d, 0xfd, 0xb3, 0x02, 0x5e, 0x9f, 0xbb, 0xf5, 0x4b,
-	0xa9, 0xb5, 0xff, 0x4c, 0x81, 0xe2, 0xb4, 0x48, 0xe9, 0xbc, 0xad, 0x1b, 0xbf, 0x18, 0x29, 0x73,
-	0x5e, 0x8c, 0xa4, 0x33, 0xb7, 0xf8, 0x2d, 0xcf, 0xdc, 0xa7, 0x0a, 0x28, 0x48, 0xd1, 0xe5, 0xb9,
-	0x14, 0x94, 0xe5, 0x7a, 0x72, 0xb3, 0xb3, 0x6d, 0x97, 0x73, 0x4d, 0x6a, 0x94, 0x4b, 0xa3, 0xa1,
-	0x56, 0x8c, 0xcb, 0x97, 0xf6, 0x93, 0xd0, 0x5d, 0xfc, 0x5c, 0x01, 0xd7, 0xa6, 0xca, 0xba, 0x58,
-	0xc0, 0x7e, 0x3a, 0x19, 0xb0, 0xb7, 0x5f, 0xe2, 0xb8, 0xcc, 0x8d, 0xde, 0x6f, 0x16, 0xc1, 0x8a,
-	0x1c, 0x6e, 0xf8, 0x01, 0xc8, 0x45, 0xb3, 0x92, 0xc2, 0x9d, 0xf6, 0xce, 0xf9, 0x19, 0x52, 0x8d,
-	0x4d, 0x48, 0xeb, 0x41, 0x70, 0x22, 0x39, 0x7a, 0xf4, 0xb7, 0xf8, 0x07, 0x05, 0xac, 0xce, 0xee,
-	0x59, 0x66, 0x3b, 0xe1, 0xe7, 0x93, 0x4e, 0xa8, 0x4a, 0x57, 0xf4, 0xf8, 0x75, 0xb4, 0xda, 0x3f,
-	0xed, 0xf2, 0x3b, 0x3b, 0x54, 0x57, 0x7d, 0xec, 0x9b, 0x98, 0xda, 0x74, 0x30, 0xd7, 0x0f, 0x5f,
-	0x2f, 0x81, 0xf5, 0x7d, 0xd2, 0x6e, 0x89, 0x8d, 0xda, 0xb8, 0xdb, 0xc0, 0x27, 0x04, 0x6e, 0x83,
-	0xac, 0x63, 0x9f, 0x20, 0x6a, 0xf7, 0x10, 0x37, 0xef, 0x8a, 0x78, 0x45, 0x0a, 0x61, 0xf2, 0x2b,
-	0x52, 0x08, 0x83, 0xf7, 0xc0, 0x8a, 0x49, 0x8d, 0x1e, 0xf1, 0xa8, 0x41, 0x70, 0x27, 0x6c, 0xee,
-	0x78, 0x21, 0x37, 0xe9, 0x23, 0xe2, 0xd1, 0x03, 0xdc, 0x91, 0x39, 0x41, 0x04, 0x85, 0x3f, 0x04,
-	0xf9, 0xbe, 0x8b, 0x18, 0xdc, 0x66, 0x83, 0x61, 0x8a, 0xb3, 0x6e, 0x8e, 0x86, 0xda, 0x35, 0x09,
-	0x2c, 0xf1, 0xca, 0xd4, 0xf0, 0x01, 0x28, 0x74, 0x08, 0xee, 0xf8, 0xae, 0x8b, 0x70, 0x67, 0x60,
-	0x78, 0xe6, 0x89, 0x78, 0x32, 0xcd, 0xd6, 0x6f, 0x8d, 0x86, 0xda, 0xa6, 0x84, 0x6b, 0x99, 0x27,
-	0xb2, 0x94, 0xb5, 0x18, 0x8a, 0x0d, 0x74, 0xe3, 0x67, 0x9c, 0x0e, 0xab, 0x30, 0x06, 0x7f, 0x4d,
-	0xcc, 0x44, 0x03, 0x5d, 0x3f, 0x5e, 0x7f, 0xe4, 0x81, 0x2e, 0x81, 0x84, 0x2d, 0x90, 0xf7, 0xfc,
-	0x76, 0xcf, 0xa6, 0x06, 0x77, 0xe5, 0xf2, 0xdc, 0x03, 0x1e, 0x3e, 0x40, 0x01, 0xc1, 0x36, 0x7e,
-	0x64, 0x95, 0xd6, 0x2c, 0x38, 0xa1, 0x26, 0x35, 0x1b, 0x05, 0x27, 0x84, 0xc9, 0xc1, 0x09, 0x61,
-	0xf0, 0x57, 0x60, 0x43, 0xa4, 0xb0, 0xe1, 0xa2, 0xa7, 0xbe, 0xed, 0xa2, 0x1e, 0x8a, 0xde, 0xec,
-	0x6e, 0x27, 0xf3, 0xfc, 0x80, 0xff, 0xea, 0x12, 0xad, 0x68, 0xa1, 0x48, 0x02, 0x2e, 0xb7, 0x50,
-	0x49, 0x2c, 0xac, 0x81, 0xe5, 0x33, 0xe4, 0x7a, 0x36, 0xc1, 0x6a, 0x8e, 0xdb, 0x7a, 0x6d, 0x34,
-	0xd4, 0xd6, 0x03, 0x90, 0xc4, 0x1b, 0x52, 0xc1, 0x06, 0x58, 0xe7, 0x6d, 0x81, 0x41, 0xa9, 0x63,
-	0x78, 0xa8, 0x43, 0xb0, 0xe5, 0xa9, 0xa0, 0xac, 0x6c, 0xa5, 0x44, 0x38, 0x39, 0xf2, 0x88, 0x3a,
-	0x2d, 0x81, 0x92, 0xc3, 0x19, 0x43, 0xdd, 0x4b, 0x7f, 0xfe, 0x85, 0xa6, 0x54, 0x7e, 0xaf, 0x00,
-	0x98, 0xdc, 0x0e, 0x74, 0xc0, 0x5a, 0x9f, 0x58, 0x32, 0x28, 0xe8, 0x79, 0x5e, 0x4f, 0x7a, 0xe3,
-	0x70, 0x92, 0x50, 0x18, 0x12, 0xe3, 0x8e, 0x0c, 0x79, 0xb0, 0xa0, 0xc7, 0x45, 0xd7, 0x57, 0xc1,
-	0x8a, 0xec, 0xf8, 0xca, 0x7f, 0x32, 0x60, 0x2d, 0x26, 0x15, 0x7a, 0xe2, 0x19, 0xb6, 0x85, 0x1c,
-	0xd4, 0xa1, 0xc4, 0x0d, 0x8a, 0xd0, 0x7b, 0x73, 0xcd, 0xe1, 0xdd, 0x6f, 0xc8, 0x25, 0x4a, 0x51,
-	0x71, 0x34, 0xd4, 0xae, 0xcb, 0xc2, 0x24, 0x37, 0x4d, 0x28, 0x81, 0x87, 0x20, 0x6b, 0x9e, 0x9c,
-	0xd8, 0x98, 0x25, 0x93, 0xa8, 0x30, 0x37, 0xa7, 0x0d, 0x01, 0x3b, 0x01, 0x8d, 0x48, 0xb5, 0x90,
-	0x43, 0x4e, 0xb5, 0x10, 0x06, 0x8f, 0x41, 0x9e, 0x12, 0x07, 0xb9, 0x26, 0xb5, 0x09, 0x0e, 0xc7,
-	0x82, 0xd2, 0xd4, 0xc9, 0x62, 0x4c, 0x36, 0xbe, 0xd8, 0x64, 0x56, 0x5d, 0x5e, 0x40, 0x02, 0xf2,
-	0x26, 0xc6, 0x84, 0x06, 0x62, 0x97, 0x67, 0x8d, 0x02, 0x71, 0xe7, 0xec, 0x44, 0x4c, 0xc2, 0x37,
-	0xbc, 0xac, 0x48, 0xa2, 0xe4, 0xb2, 0x22, 0x81, 0x27, 0x8e, 0x59, 0x9a, 0xb7, 0x3c, 0xf3, 0x8f,
-	0xd9, 0x3e, 0x28, 0x84, 0x95, 0x89, 0xe0, 0x43, 0xe2, 0xd8, 0x9d, 0x01, 0xff, 0xba, 0x92, 0x13,
-	0x97, 0x67, 0x1c, 0x27, 0x5f, 0x9e, 0x71, 0x1c, 0xfc, 0x18, 0x8c, 0x5f, 0x9d, 0x26, 0xb2, 0x34,
-	0xc3, 0xa3, 0xb4, 0x35, 0xcd, 0xa1, 0xfa, 0x14, 0xfa, 0xfa, 0xcd, 0xc0, 0xb5, 0x53, 0xa5, 0xe9,
-	0x53, 0xa1, 0xc5, 0x2e, 0x58, 0x4f, 0x24, 0xd5, 0x2b, 0x19, 0x7f, 0x4e, 0x40, 0x21, 0x1e, 0xa0,
-	0x57, 0xa1, 0x67, 0x3f, 0x9d, 0xcd, 0x16, 0x72, 0x95, 0xbf, 0x29, 0x60, 0xf3, 0xd0, 0x77, 0x3c,
-	0xd3, 0x6d, 0x85, 0x69, 0xb3, 0x4f, 0xda, 0xbb, 0x88, 0x9a, 0xb6, 0xe3, 0x31, 0x91, 0xfc, 0x91,
-	0x27, 0xd0, 0xcc, 0x45, 0x72, 0x80, 0x2c, 0x52, 0xbc, 0x2d, 0xbf, 0x05, 0x96, 0x1e, 0xc7, 0xa7,
-	0x9b, 0x78, 0x3b, 0x24, 0x28, 0xe0, 0x1d, 0x90, 0x61, 0xf7, 0x2b, 0xa2, 0xc1, 0x64, 0xc3, 0x07,
-	0x5f, 0x01, 0x91, 0x07, 0x5f, 0x01, 0xf9, 0xee, 0x01, 0xc8, 0x4b, 0x6f, 0x54, 0x30, 0x0f, 0x96,
-	0x8f, 0x9b, 0xef, 0x37, 0x0f, 0x7e, 0xd6, 0x2c, 0x2c, 0xb0, 0xc5, 0xe1, 0x5e, 0x73, 0xb7, 0xd1,
-	0xfc, 0x49, 0x41, 0x61, 0x0b, 0xfd, 0xb8, 0xd9, 0x64, 0x8b, 0x45, 0x78, 0x05, 0xe4, 0x5a, 0xc7,
-	0xf7, 0xef, 0xef, 0xed, 0xed, 0xee, 0xed, 0x16, 0x52, 0x10, 0x80, 0xcc, 0x8f, 0x77, 0x1a, 0x0f,
-	0xf7, 0x76, 0x0b, 0xe9, 0xfa, 0x2f, 0x9f, 0x3d, 0x2f, 0x29, 0x5f, 0x3d, 0x2f, 0x29, 0xff, 0x7e,
-	0x5e, 0x52, 0x3e, 0x7b, 0x51, 0x5a, 0xf8, 0xea, 0x45, 0x69, 0xe1, 0x5f, 0x2f, 0x4a, 0x0b, 0xbf,
-	0xb8, 0x2f, 0x7d, 0x30, 0x15, 0xcf, 0xc6, 0x7d, 0x97, 0xb0, 0x33, 0x14, 0xac, 0x6a, 0x17, 0xf8,
-	0x32, 0xdc, 0xce, 0xf0, 0x3b, 0xec, 0xbd, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xd0, 0x23,
-	0xda, 0x47, 0x1e, 0x00, 0x00,
+	0x55, 0x4b, 0x52, 0x12, 0x39, 0x92, 0x25, 0x6a, 0x24, 0xcb, 0x2b, 0xda, 0xe6, 0x32, 0x8c, 0x1b,
+	0xa8, 0x8d, 0x43, 0x36, 0x4e, 0x81, 0x1a, 0x6e, 0x2f, 0xa2, 0xa5, 0xd6, 0x54, 0x6c, 0x4a, 0x5e,
+	0x49, 0x29, 0x5a, 0xa0, 0x59, 0x2c, 0xb9, 0x23, 0x7a, 0xa3, 0xe5, 0x0c, 0xbd, 0x3b, 0xeb, 0x86,
+	0x39, 0x17, 0x28, 0x0a, 0x03, 0x69, 0x5a, 0xf4, 0x23, 0x40, 0x81, 0x16, 0xb9, 0xf5, 0x17, 0xb4,
+	0x87, 0xfe, 0x01, 0x1f, 0x73, 0xec, 0x89, 0x29, 0xec, 0x1b, 0x2f, 0xfd, 0x0b, 0xc5, 0xcc, 0xec,
+	0x72, 0x87, 0xbb, 0x4b, 0x51, 0x4e, 0xea, 0xfa, 0x24, 0xcd, 0xfb, 0xfe, 0x9a, 0xb7, 0xf3, 0x1e,
+	0xc1, 0x1d, 0x1b, 0x53, 0xe4, 0x62, 0xd3, 0xa9, 0x7b, 0x9d, 0x47, 0xc8, 0xf2, 0x1d, 0xe4, 0x46,
+	0xff, 0x91, 0xf6, 0x47, 0xa8, 0x43, 0xbd, 0x04, 0xa0, 0xd6, 0x77, 0x09, 0x25, 0xb0, 0x18, 0x87,
+	0x97, 0xb4, 0x2e, 0x21, 0x5d, 0x07, 0xd5, 0x39, 0xbe, 0xed, 0x9f, 0xd6, 0xa9, 0xdd, 0x43, 0x1e,
+	0x35, 0x7b, 0x7d, 0xc1, 0x52, 0xaa, 0x9e, 0xdd, 0xf6, 0x6a, 0x36, 0xa9, 0x9b, 0x7d, 0xbb, 0xde,
+	0x21, 0x2e, 0xaa, 0x3f, 0x79, 0xb7, 0xde, 0x45, 0x18, 0xb9, 0x26, 0x45, 0x56, 0x40, 0xf3, 0xbd,
+	0x88, 0xa6, 0x67, 0x76, 0x1e, 0xd9, 0x18, 0xb9, 0x83, 0x7a, 0xff, 0xac, 0xcb, 0x99, 0x5c, 0xe4,
+	0x11, 0xdf, 0xed, 0xa0, 0x04, 0xd7, 0x3b, 0x5d, 0x9b, 0x3e, 0xf2, 0xdb, 0xb5, 0x0e, 0xe9, 0xd5,
+	0xbb, 0xa4, 0x4b, 0x22, 0x1b, 0xd8, 0x89, 0x1f, 0xf8, 0x7f, 0x82, 0xbc, 0xfa, 0xb7, 0x2c, 0xc8,
+	0xef, 0x7d, 0x8c, 0x3a, 0x3e, 0x25, 0x2e, 0xac, 0x80, 0x8c, 0x6d, 0xa9, 0x4a, 0x45, 0xd9, 0x2e,
+	0x34, 0x8a, 0xa3, 0xa1, 0xb6, 0x6c, 0x5b, 0x37, 0x49, 0xcf, 0xa6, 0xa8, 0xd7, 0xa7, 0x03, 0x3d,
+	0x63, 0x5b, 0xf0, 0x2d, 0x90, 0xeb, 0x13, 0xe2, 0xa8, 0x19, 0x4e, 0x03, 0x47, 0x43, 0x6d, 0x85,
+	0x9d, 0x25, 0x2a, 0x8e, 0x87, 0x3b, 0x60, 0x1e, 0x13, 0x0b, 0x79, 0x6a, 0xb6, 0x92, 0xdd, 0x5e,
+	0xba, 0xb5, 0x59, 0x4b, 0x84, 0xae, 0x45, 0x2c, 0xd4, 0x58, 0x1f, 0x0d, 0xb5, 0x55, 0x4e, 0x28,
+	0x49, 0x10, 0x9c, 0xf0, 0x43, 0xb0, 0xd2, 0xb3, 0xb1, 0xdd, 0xf3, 0x7b, 0xfb, 0xa4, 0x7d, 0x64,
+	0x7f, 0x82, 0xd4, 0x5c, 0x45, 0xd9, 0x5e, 0xba, 0x55, 0x4e, 0xca, 0xd2, 0x83, 0x60, 0xdc, 0xb7,
+	0x3d, 0xda, 0xd8, 0x7c, 0x36, 0xd4, 0xe6, 0x98, 0x61, 0x93, 0xdc, 0x7a, 0xec, 0xcc, 0xe4, 0x3b,
+	0xa6, 0x47, 0x4f, 0xfa, 0x96, 0x49, 0xd1, 0xb1, 0xdd, 0x43, 0xea, 0x3c, 0x97, 0x5f, 0xaa, 0x89,
+	0xe4, 0xd5, 0xc2, 0xc0, 0xd5, 0x8e, 0xc3, 0xe4, 0x35, 0x4a, 0xa1, 0xec, 0x49, 0xce, 0xcf, 0xbe,
+	0xd2, 0x14, 0x3d, 0x06, 0x83, 0x07, 0x60, 0xdd, 0xc7, 0xa6, 0xe7, 0xd9, 0x5d, 0x8c, 0x2c, 0xe3,
+	0x23, 0xd2, 0x36, 0x5c, 0x1f, 0x7b, 0x6a, 0xa1, 0x92, 0xdd, 0x2e, 0x34, 0xb4, 0xd1, 0x50, 0xbb,
+	0x1a, 0xa1, 0xf7, 0x49, 0x5b, 0xf7, 0xb1, 0x1c, 0x84, 0xb5, 0x04, 0xb2, 0xfa, 0x9f, 0x4d, 0x90,
+	0x63, 0x51, 0xbb, 0x58, 0x9a, 0xb0, 0xd9, 0x43, 0xea, 0x72, 0x94, 0x26, 0x76, 0x96, 0xd3, 0xc4,
+	0xce, 0xf0, 0x16, 0xc8, 0xa3, 0x20, 0xf9, 0xea, 0x3a, 0xa7, 0xdd, 0x1c, 0x0d, 0x35, 0x18, 0xc2,
+	0x24, 0xfa, 0x31, 0x1d, 0xbc, 0x0d, 0x00, 0x4b, 0xd0, 0x6e, 0xfb, 0x7d, 0x34, 0xf0, 0x54, 0x58,
+	0xc9, 0x6e, 0x2f, 0x37, 0xd4, 0xd1, 0x50, 0xdb, 0x88, 0xa0, 0x12, 0x9f, 0x44, 0x0b, 0x1f, 0x80,
+	0x02, 0x8b, 0x91, 0xe1, 0x21, 0x84, 0xd5, 0xcc, 0xcc, 0x60, 0x6f, 0x04, 0xc1, 0xce, 0x33, 0xa6,
+	0x23, 0x84, 0x30, 0x0f, 0xf3, 0xf8, 0x04, 0x0f, 0x40, 0x81, 0x09, 0x37, 0xe8, 0xa0, 0x8f, 0xd4,
+	0x6c, 0x20, 0x2e, 0xb5, 0xce, 0x8e, 0x07, 0x7d, 0x24, 0x3c, 0xc3, 0xc1, 0x49, 0xf6, 0x2c, 0x84,
+	0xc1, 0x3b, 0x60, 0x79, 0x2c, 0xd0, 0xb0, 0x2d, 0x5e, 0x6f, 0xb9, 0xc8, 0x37, 0x46, 0xd3, 0xb4,
+	0xe2, 0xbe, 0x09, 0x28, 0xdc, 0x01, 0x0b, 0xd4, 0xb4, 0x31, 0xf5, 0xd4, 0x79, 0x5e, 0xf1, 0x5b,
+	0x35, 0x71, 0x7b, 0x6b, 0x66, 0xdf, 0xae, 0xb1, 0x1b, 0x5e, 0x7b, 0xf2, 0x6e, 0xed, 0x98, 0x51,
+	0x34, 0x56, 0x02, 0xbf, 0x02, 0x06, 0x3d, 0xf8, 0x0b, 0x0f, 0xc1, 0x82, 0x63, 0xb6, 0x91, 0xe3,
+	0xa9, 0x0b, 0x5c, 0x44, 0x35, 0xdd, 0x99, 0xda, 0x7d, 0x4e, 0xb4, 0x87, 0xa9, 0x3b, 0x68, 0x6c,
+	0x8c, 0x86, 0x5a, 0x51, 0x70, 0x49, 0x86, 0x05, 0x72, 0xa0, 0x01, 0x56, 0x29, 0xa1, 0xa6, 0x63,
+	0x84, 0xdd, 0xc2, 0x53, 0x17, 0x5f, 0xee, 0x0e, 0x71, 0xf6, 0x10, 0xe5, 0xe9, 0xb1, 0x33, 0xfc,
+	0xbb, 0x02, 0x6e, 0x98, 0x8e, 0x43, 0x3a, 0x26, 0x35, 0xdb, 0x0e, 0x32, 0xda, 0x03, 0xa3, 0xef,
+	0xda, 0xc4, 0xb5, 0xe9, 0xc0, 0x30, 0xb1, 0x35, 0xd6, 0xab, 0xe6, 0xb9, 0x47, 0x3f, 0x9c, 0xe2,
+	0xd1, 0x4e, 0x24, 0xa2, 0x31, 0x38, 0x0c, 0x04, 0xec, 0x60, 0x2b, 0x54, 0x24, 0x7c, 0xdd, 0x0e,
+	0x8c, 0xaa, 0x98, 0x33, 0xc8, 0xf5, 0x99, 0x14, 0xd0, 0x05, 0xeb, 0x1e, 0x35, 0x29, 0xb7, 0x38,
+	0xb8, 0x9a, 0x2c, 0xe3, 0x05, 0x6e, 0xe6, 0xdb, 0x53, 0xcc, 0x3c, 0x62, 0x1c, 0x8d, 0x81, 0xb8,
+	0x8f, 0x4d, 0x4b, 0x58, 0x75, 0x25, 0xb0, 0x6a, 0xd5, 0x9b, 0xc4, 0xea, 0x71, 0x00, 0xf4, 0xc1,
+	0x7a, 0x60, 0x17, 0xb2, 0x42, 0xbd, 0xb6, 0xa5, 0x02, 0xae, 0xf3, 0xe6, 0xf9, 0xa1, 0x41, 0x16,
+	0x17, 0x14, 0x2a, 0x55, 0x03, 0xa5, 0x45, 0x33, 0x86, 0xd6, 0x13, 0x10, 0x48, 0x01, 0x9c, 0x50,
+	0xfb, 0xd8, 0x47, 0x3e, 0x52, 0x97, 0x2e, 0xaa, 0xf5, 0x21, 0x23, 0x9f, 0xae, 0x95, 0xa3, 0xf5,
+	0x04, 0x84, 0x39, 0x8b, 0x9e, 0xd8, 0x1d, 0x1a, 0xb5, 0x3e, 0xc3, 0xb6, 0x3c, 0x75, 0xe5, 0x5c,
+	0xb5, 0x7b, 0x82, 0x23, 0x8c, 0x98, 0x17, 0x53, 0x8b, 0x62, 0x68, 0x3d, 0x01, 0x81, 0x5f, 0x28,
+	0xa0, 0x8c, 0x09, 0x36, 0x4c, 0xb7, 0x67, 0x5a, 0xa6, 0x11, 0x39, 0x1e, 0xdd, 0x80, 0x4b, 0xdc,
+	0x84, 0xef, 0x4f, 0x31, 0xa1, 0x45, 0xf0, 0x0e, 0xe7, 0x1d, 0x87, 0x60, 0x5c, 0xed, 0xc2, 0x9a,
+	0x37, 0x03, 0x6b, 0xae, 0xe2, 0xe9, 0x94, 0xfa, 0x79, 0x48, 0xb8, 0x03, 0x2e, 0xf9, 0x38, 0xd0,
+	0xce, 0x2a, 0x54, 0x5d, 0xad, 0x28, 0xdb, 0xf9, 0xc6, 0xd5, 0xd1, 0x50, 0xbb, 0x32, 0x81, 0x90,
+	0x6e, 0xf4, 0x24, 0x07, 0x7c, 0xaa, 0x80, 0x2b, 0xa1, 0x47, 0x86, 0xef, 0x99, 0x5d, 0x14, 0x65,
+	0xb6, 0xc8, 0xfd, 0xfb, 0xee, 0x14, 0xff, 0x42, 0x33, 0x4e, 0x18, 0xd3, 0x44, 0x76, 0xab, 0xa3,
+	0xa1, 0x56, 0x76, 0x53, 0xd0, 0x92, 0x19, 0x1b, 0x69, 0x78, 0xf6, 0xa5, 0x73, 0x51, 0x9f, 0xb8,
+	0xd4, 0xc6, 0x5d, 0x23, 0x6a, 0xc9, 0x6b, 0x15, 0x25, 0xfc, 0xd2, 0x8d, 0xd1, 0xad, 0x64, 0xff,
+	0x5d, 0x4b, 0x20, 0xe1, 0x1e, 0x58, 0xf5, 0x28, 0x71, 0x99, 0x5b, 0x1d, 0xc7, 0xf4, 0x3c, 0xe4,
+	0xa9, 0x1b, 0xfc, 0xb3, 0x79, 0x6d, 0x34, 0xd4, 0xd4, 0x00, 0x75, 0x57, 0x60, 0x24, 0x49, 0x2b,
+	0x93, 0x98, 0x92, 0x09, 0x96, 0xa4, 0x5e, 0x09, 0xdf, 0x04, 0xd9, 0x33, 0x34, 0x08, 0xbe, 0x9b,
+	0x6b, 0xa3, 0xa1, 0x76, 0xe9, 0x0c, 0x0d, 0x24, 0x76, 0x86, 0x85, 0xdf, 0x06, 0xf3, 0x4f, 0x4c,
+	0xc7, 0x47, 0xc1, 0x0b, 0x87, 0x3f, 0x50, 0x38, 0x40, 0x7e, 0xa0, 0x70, 0xc0, 0x9d, 0xcc, 0x6d,
+	0xa5, 0xf4, 0x67, 0x05, 0x7c, 0xeb, 0x42, 0xdd, 0x4b, 0xd6, 0x3e, 0x3f, 0x55, 0x7b, 0x53, 0xd6,
+	0x3e, 0xbb, 0x4d, 0xcf, 0xb2, 0xee, 0xd7, 0x0a, 0xd8, 0x48, 0x6b, 0x5a, 0x17, 0x0b, 0xc5, 0x3d,
+	0xd9, 0x98, 0x95, 0x5b, 0xd7, 0x93, 0xc6, 0x08, 0xa1, 0x42, 0xc3, 0x2c, 0x5b, 0x9e, 0x2a, 0xe0,
+	0x72, 0x6a, 0x33, 0xbb, 0x98, 0x31, 0xff, 0xe3, 0xc8, 0xc4, 0xac, 0x89, 0xae, 0xc1, 0x6b, 0xb1,
+	0xe6, 0x0c, 0x5c, 0x4e, 0x6d, 0x7d, 0x5f, 0xa3, 0x64, 0xf3, 0x33, 0x95, 0xfd, 0x51, 0x01, 0x95,
+	0x59, 0x5d, 0xee, 0xb5, 0x54, 0xeb, 0x6f, 0x14, 0xb0, 0x35, 0xb5, 0x3d, 0xbd, 0x8e, 0xbc, 0x54,
+	0xff, 0x92, 0x03, 0xf9, 0x71, 0x53, 0xaa, 0x80, 0x4c, 0x53, 0xbc, 0xba, 0x73, 0xe2, 0xd5, 0x3d,
+	0xf1, 0x16, 0xcc, 0x4c, 0xbc, 0x01, 0x33, 0x5f, 0xf7, 0x0d, 0x78, 0x3c, 0x7e, 0x03, 0x8a, 0xc1,
+	0xe9, 0xad, 0xe9, 0x0f, 0xda, 0x97, 0x78, 0x07, 0xfe, 0x52, 0x01, 0xd0, 0xc7, 0x1e, 0xa2, 0x4d,
+	0x6c, 0xa1, 0x8f, 0x91, 0x25, 0x38, 0xd5, 0x1c, 0x57, 0x71, 0xeb, 0x1c, 0x15, 0x27, 0x09, 0x26,
+	0xa1, 0xae, 0x32, 0x1a, 0x6a, 0xd7, 0x92, 0x12, 0x25, 0xd5, 0x29, 0xfa, 0xfe, 0x1f, 0xfd, 0xb8,
+	0x07, 0xae, 0x4c, 0xb1, 0xf9, 0x55, 0xa8, 0xab, 0x3e, 0x5b, 0x00, 0x5b, 0xbc, 0x46, 0xef, 0x3a,
+	0xbe, 0x47, 0x91, 0x3b, 0x51, 0xbe, 0xb0, 0x09, 0x16, 0x3b, 0x2e, 0x62, 0xb7, 0x4b, 0x55, 0x82,
+	0xf1, 0x64, 0xfa, 0xb4, 0xb3, 0x1e, 0x54, 0x44, 0xc8, 0xc2, 0x87, 0x9d, 0xf0, 0xc0, 0xec, 0x12,
+	0x5f, 0x77, 0xc9, 0xae, 0xc7, 0xb1, 0x8f, 0xb3, 0xa0, 0x60, 0xf3, 0x59, 0x38, 0xab, 0x35, 0x2d,
+	0x3e, 0x17, 0x15, 0xc4, 0x0c, 0x13, 0x41, 0x25, 0x26, 0x89, 0x16, 0xfe, 0x41, 0x61, 0x1f, 0xf2,
+	0xa0, 0x0f, 0x44, 0x9f, 0xb2, 0xa0, 0x4e, 0x76, 0x93, 0x75, 0x32, 0xd5, 0xf5, 0x9a, 0x9e, 0x14,
+	0x23, 0x2a, 0xe7, 0x7a, 0xe0, 0x66, 0xaa, 0x22, 0x45, 0x4f, 0x03, 0xc3, 0x7f, 0x28, 0xe0, 0x5a,
+	0x0a, 0x9c, 0x7f, 0xe6, 0x5b, 0x26, 0x1f, 0xdc, 0x99, 0x81, 0x0f, 0xbe, 0xa1, 0x81, 0x63, 0x79,
+	0xc2, 0xd2, 0x1b, 0x81, 0xa5, 0xe7, 0xaa, 0xd6, 0xcf, 0xc5, 0x96, 0x3e, 0x55, 0x80, 0x3a, 0x2d,
+	0x14, 0xaf, 0xa5, 0xc7, 0xfe, 0x49, 0x01, 0x6f, 0xcc, 0x74, 0xfd, 0xb5, 0xf4, 0xda, 0x7f, 0x66,
+	0x41, 0x29, 0x2d, 0x53, 0x3a, 0x7f, 0x1d, 0x8e, 0x17, 0x4f, 0xca, 0x8c, 0xc5, 0x93, 0x74, 0xe7,
+	0x32, 0xdf, 0xf0, 0xce, 0x7d, 0xaa, 0x80, 0xa2, 0x94, 0x5d, 0x5e, 0x4b, 0x41, 0x5b, 0x6e, 0x24,
+	0x9d, 0x9d, 0x6e, 0x7b, 0x4d, 0x8f, 0x09, 0x11, 0xf5, 0x55, 0x1e, 0x0d, 0xb5, 0x52, 0x5c, 0xbe,
+	0xe4, 0x4f, 0x42, 0x77, 0xe9, 0x73, 0x05, 0x5c, 0x4e, 0x95, 0x75, 0xb1, 0x84, 0x7d, 0x30, 0x99,
+	0xb0, 0xb7, 0x5f, 0xe2, 0xba, 0xcc, 0xcc, 0xde, 0xd3, 0x0c, 0x58, 0x96, 0xd3, 0x0d, 0x3f, 0x04,
+	0x85, 0x68, 0xe4, 0x52, 0x78, 0xd0, 0xde, 0x39, 0xbf, 0x42, 0x6a, 0xb1, 0x41, 0x6b, 0x2d, 0x48,
+	0x4e, 0x24, 0x47, 0x8f, 0xfe, 0x2d, 0xfd, 0x5e, 0x01, 0x2b, 0xd3, 0xdf, 0x2c, 0xd3, 0x83, 0xf0,
+	0xd3, 0xc9, 0x20, 0xd4, 0xa4, 0x4f, 0xf4, 0x78, 0xc9, 0x5a, 0xeb, 0x9f, 0x75, 0x19, 0xa0, 0x16,
+	0xaa, 0xab, 0x3d, 0xf4, 0x4d, 0x4c, 0x6d, 0x3a, 0x98, 0x15, 0x87, 0x3b, 0xb9, 0xcf, 0xbf, 0xd0,
+	0x94, 0xea, 0x57, 0xf3, 0x60, 0x8d, 0xad, 0x19, 0x85, 0xbb, 0x36, 0xee, 0x36, 0xf1, 0x29, 0x61,
+	0xcb, 0x36, 0xc7, 0x3e, 0x45, 0x94, 0xad, 0x1a, 0x99, 0x91, 0x97, 0xc4, 0x4a, 0x2a, 0x84, 0xc9,
+	0x2b, 0xa9, 0x10, 0xc6, 0x56, 0x52, 0x26, 0x35, 0x7a, 0xc4, 0xa3, 0x06, 0xc1, 0x9d, 0xf0, 0x89,
+	0xc7, 0xdb, 0xb9, 0x49, 0x1f, 0x10, 0x8f, 0x1e, 0xe0, 0x8e, 0xcc, 0x09, 0x22, 0x28, 0xfc, 0x01,
+	0x58, 0xea, 0xbb, 0x88, 0xc1, 0x6d, 0x36, 0x65, 0x66, 0x39, 0xeb, 0xd6, 0x68, 0xa8, 0x5d, 0x96,
+	0xc0, 0x12, 0xaf, 0x4c, 0x0d, 0xef, 0x81, 0x62, 0x87, 0xe0, 0x8e, 0xef, 0xba, 0x08, 0x77, 0x06,
+	0x86, 0x67, 0x9e, 0x8a, 0xfd, 0x6b, 0xbe, 0x71, 0x7d, 0x34, 0xd4, 0xb6, 0x24, 0xdc, 0x91, 0x79,
+	0x2a, 0x4b, 0x59, 0x8d, 0xa1, 0xd8, 0x74, 0x38, 0xde, 0x09, 0xf1, 0x69, 0xce, 0xe0, 0xab, 0xc9,
+	0x85, 0x68, 0x3a, 0xec, 0xc7, 0xbb, 0x90, 0x3c, 0x1d, 0x26, 0x90, 0xf0, 0x08, 0x2c, 0x79, 0x7e,
+	0xbb, 0x67, 0x53, 0x83, 0x87, 0x72, 0x71, 0xe6, 0x35, 0x0f, 0xb7, 0x59, 0x40, 0xb0, 0x8d, 0x37,
+	0xb6, 0xd2, 0x99, 0x25, 0x27, 0xd4, 0xa4, 0xe6, 0xa3, 0xe4, 0x84, 0x30, 0x39, 0x39, 0x21, 0x0c,
+	0xfe, 0x02, 0xac, 0x8b, 0x42, 0x36, 0x5c, 0xf4, 0xd8, 0xb7, 0x5d, 0xd4, 0x43, 0xd1, 0x02, 0xf0,
+	0x46, 0xb2, 0xda, 0x0f, 0xf8, 0x5f, 0x5d, 0xa2, 0x15, 0x0f, 0x29, 0x92, 0x80, 0xcb, 0x0f, 0xa9,
+	0x24, 0x16, 0xd6, 0xc1, 0xe2, 0x13, 0xe4, 0x7a, 0x36, 0xc1, 0x6a, 0x81, 0xdb, 0x7a, 0x79, 0x34,
+	0xd4, 0xd6, 0x02, 0x90, 0xc4, 0x1b, 0x52, 0xc1, 0x26, 0x58, 0xe3, 0x8f, 0x03, 0x83, 0x52, 0xc7,
+	0xf0, 0x50, 0x87, 0x60, 0xcb, 0x53, 0x41, 0x45, 0xd9, 0xce, 0x8a, 0x74, 0x72, 0xe4, 0x31, 0x75,
+	0x8e, 0x04, 0x4a, 0x4e, 0x67, 0x0c, 0x15, 0x54, 0xf8, 0xef, 0x14, 0x00, 0x93, 0xee, 0x40, 0x07,
+	0xac, 0xf6, 0x89, 0x25, 0x83, 0x82, 0x97, 0xcf, 0x1b, 0xc9, 0x68, 0x1c, 0x4e, 0x12, 0x0a, 0x43,
+	0x62, 0xdc, 0x91, 0x21, 0xf7, 0xe6, 0xf4, 0xb8, 0xe8, 0xc6, 0x0a, 0x58, 0x96, 0x03, 0x5f, 0xfd,
+	0x55, 0x1e, 0xac, 0xc6, 0xa4, 0x42, 0x4f, 0xec, 0x74, 0x8f, 0x90, 0x83, 0x3a, 0x6c, 0xcb, 0x2d,
+	0x5a, 0xd1, 0x7b, 0x33, 0xcd, 0xa9, 0xb5, 0x24, 0x2e, 0xd1, 0x90, 0x4a, 0xa3, 0xa1, 0xb6, 0x29,
+	0x0b, 0x93, 0xc2, 0x34, 0xa1, 0x04, 0x1e, 0x82, 0xbc, 0x79, 0x7a, 0x6a, 0x63, 0x56, 0x4c, 0xa2,
+	0xcf, 0x5c, 0x4b, 0x1b, 0x05, 0x76, 0x02, 0x1a, 0x51, 0x6a, 0x21, 0x87, 0x5c, 0x6a, 0x21, 0x0c,
+	0x9e, 0x80, 0x25, 0x4a, 0x1c, 0xe4, 0x9a, 0xd4, 0x26, 0x38, 0x1c, 0x0e, 0xca, 0xa9, 0xf3, 0xc5,
+	0x98, 0x6c, 0xfc, 0x79, 0x93, 0x59, 0x75, 0xf9, 0x00, 0x09, 0x58, 0x32, 0x31, 0x26, 0x34, 0x10,
+	0xbb, 0x38, 0x6d, 0x20, 0x88, 0x07, 0x67, 0x27, 0x62, 0x12, 0xb1, 0xe1, 0x6d, 0x45, 0x12, 0x25,
+	0xb7, 0x15, 0x09, 0x3c, 0x71, 0xcd, 0x72, 0xfc, 0xe1, 0x33, 0xfb, 0x9a, 0xed, 0x83, 0x62, 0xd8,
+	0x99, 0x08, 0x3e, 0x24, 0x8e, 0xdd, 0x19, 0xf0, 0x9f, 0x6a, 0x0a, 0xe2, 0x13, 0x1a, 0xc7, 0xc9,
+	0x9f, 0xd0, 0x38, 0x0e, 0x7e, 0x02, 0xc6, 0x2b, 0xac, 0x89, 0x2a, 0x5d, 0xe0, 0x59, 0xda, 0x4e,
+	0x0b, 0xa8, 0x9e, 0x42, 0xdf, 0xb8, 0x16, 0x84, 0x36, 0x55, 0x9a, 0x9e, 0x0a, 0x85, 0xbf, 0x55,
+	0xc0, 0x66, 0x9f, 0x5d, 0x48, 0x8f, 0x22, 0x4c, 0x3f, 0x20, 0x8e, 0xdf, 0x63, 0xab, 0x2a, 0xbb,
+	0xe7, 0xa9, 0x85, 0xa9, 0x55, 0x99, 0x46, 0x3f, 0x61, 0xc9, 0x0d, 0xb6, 0x11, 0x4f, 0x17, 0x2b,
+	0x45, 0x62, 0x8a, 0xe2, 0x52, 0x17, 0xac, 0x25, 0x0a, 0xfd, 0x95, 0x0c, 0x66, 0xa7, 0xa0, 0x18,
+	0x2f, 0x9a, 0x57, 0xa1, 0x67, 0x3f, 0x97, 0xcf, 0x17, 0x0b, 0x55, 0xf6, 0xcc, 0x9d, 0x19, 0xba,
+	0xf1, 0xaf, 0x64, 0xca, 0x8c, 0x5f, 0xc9, 0xf6, 0x41, 0x51, 0xde, 0x2c, 0xf2, 0x91, 0x23, 0x13,
+	0x15, 0x60, 0x1c, 0x27, 0x17, 0x60, 0x1c, 0x57, 0xfd, 0xab, 0x02, 0xb6, 0x0e, 0x7d, 0xc7, 0x33,
+	0xdd, 0xa3, 0x30, 0xd7, 0xfb, 0xa4, 0xbd, 0x8b, 0xa8, 0x69, 0x3b, 0x1e, 0x73, 0x96, 0x2f, 0xc6,
+	0x54, 0x25, 0x72, 0x96, 0x03, 0x64, 0x67, 0x39, 0x80, 0x91, 0x3e, 0x8c, 0x4f, 0x84, 0xf1, 0x27,
+	0xa4, 0xa0, 0x80, 0x37, 0xc1, 0x02, 0x7b, 0x8d, 0x20, 0x1a, 0x4c, 0x83, 0x7c, 0x59, 0x20, 0x20,
+	0xf2, 0xb2, 0x40, 0x40, 0xbe, 0x73, 0x00, 0x96, 0xa4, 0xbd, 0x1e, 0x5c, 0x02, 0x8b, 0x27, 0xad,
+	0xf7, 0x5b, 0x07, 0x3f, 0x69, 0x15, 0xe7, 0xd8, 0xe1, 0x70, 0xaf, 0xb5, 0xdb, 0x6c, 0xfd, 0xb8,
+	0xa8, 0xb0, 0x83, 0x7e, 0xd2, 0x6a, 0xb1, 0x43, 0x06, 0x5e, 0x02, 0x85, 0xa3, 0x93, 0xbb, 0x77,
+	0xf7, 0xf6, 0x76, 0xf7, 0x76, 0x8b, 0x59, 0x08, 0xc0, 0xc2, 0x8f, 0x76, 0x9a, 0xf7, 0xf7, 0x76,
+	0x8b, 0xb9, 0xc6, 0xcf, 0x9f, 0x3d, 0x2f, 0x2b, 0x5f, 0x3e, 0x2f, 0x2b, 0xff, 0x7e, 0x5e, 0x56,
+	0x3e, 0x7b, 0x51, 0x9e, 0xfb, 0xf2, 0x45, 0x79, 0xee, 0x5f, 0x2f, 0xca, 0x73, 0x3f, 0xbb, 0x2b,
+	0xfd, 0x56, 0x2d, 0x36, 0xf6, 0x7d, 0x97, 0xb0, 0xc2, 0x0f, 0x4e, 0xf5, 0x0b, 0xfc, 0x28, 0xdf,
+	0x5e, 0xe0, 0x5f, 0xfc, 0xf7, 0xfe, 0x3b, 0x00, 0x76, 0x2b, 0x3c, 0x28, 0xc2, 0x1f, 0x00, 0x00,
 }
 
 func (m *Executor) Marshal() (dAtA []byte, err error) {
@@ -1262,6 +1339,17 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageClasses) > 0 {
+		for iNdEx := len(m.StorageClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageClasses[iNdEx])
+			copy(dAtA[i:], m.StorageClasses[iNdEx])
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.StorageClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
 	if len(m.Executor) > 0 {
 		i -= len(m.Executor)
 		copy(dAtA[i:], m.Executor)
@@ -1801,8 +1889,13 @@ func (m *ResourceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Resources) > 0 {
+		keysForResources := make([]string, 0, len(m.Resources))
 		for k := range m.Resources {
-			v := m.Resources[k]
+			keysForResources = append(keysForResources, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForResources)
+		for iNdEx := len(keysForResources) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Resources[string(keysForResources[iNdEx])]
 			baseI := i
 			{
 				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
@@ -1814,9 +1907,9 @@ func (m *ResourceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(k)))
+			i -= len(keysForResources[iNdEx])
+			copy(dAtA[i:], keysForResources[iNdEx])
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(keysForResources[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = encodeVarintSchedulerobjects(dAtA, i, uint64(baseI-i))
@@ -2002,6 +2095,20 @@ func (m *PodRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PersistentVolumeClaims) > 0 {
+		for iNdEx := len(m.PersistentVolumeClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersistentVolumeClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerobjects(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Annotations) > 0 {
 		for k := range m.Annotations {
 			v := m.Annotations[k]
@@ -2091,6 +2198,43 @@ func (m *PodRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PersistentVolumeClaimRequirements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersistentVolumeClaimRequirements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersistentVolumeClaimRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageClassName) > 0 {
+		i -= len(m.StorageClassName)
+		copy(dAtA[i:], m.StorageClassName)
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.StorageClassName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PulsarSchedulerJobDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2300,6 +2444,12 @@ func (m *Node) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovSchedulerobjects(uint64(l))
 	}
+	if len(m.StorageClasses) > 0 {
+		for _, s := range m.StorageClasses {
+			l = len(s)
+			n += 2 + l + sovSchedulerobjects(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2527,6 +2677,29 @@ func (m *PodRequirements) Size() (n int) {
 			n += mapEntrySize + 1 + sovSchedulerobjects(uint64(mapEntrySize))
 		}
 	}
+	if len(m.PersistentVolumeClaims) > 0 {
+		for _, e := range m.PersistentVolumeClaims {
+			l = e.Size()
+			n += 1 + l + sovSchedulerobjects(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PersistentVolumeClaimRequirements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	l = len(m.StorageClassName)
+	if l > 0 {
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
 	return n
 }
 
@@ -4139,6 +4312,38 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			}
 			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageClasses = append(m.StorageClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
@@ -6138,6 +6343,154 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, &PersistentVolumeClaimRequirements{})
+			if err := m.PersistentVolumeClaims[len(m.PersistentVolumeClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PersistentVolumeClaimRequirements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerobjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersistentVolumeClaimRequirements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersistentVolumeClaimRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
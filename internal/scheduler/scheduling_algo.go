@@ -2,13 +2,13 @@ package scheduler
 
 import (
 	"context"
+	"math"
 	"math/rand"
 	"time"
 
 	"github.com/benbjohnson/immutable"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"golang.org/x/time/rate"
@@ -27,6 +27,7 @@ import (
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 	"github.com/armadaproject/armada/internal/scheduler/nodedb"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	clientqueue "github.com/armadaproject/armada/pkg/client/queue"
 )
 
 // SchedulingAlgo is the interface between the Pulsar-backed scheduler and the
@@ -43,6 +44,15 @@ type FairSchedulingAlgo struct {
 	executorRepository          database.ExecutorRepository
 	queueRepository             database.QueueRepository
 	schedulingContextRepository *SchedulingContextRepository
+	// If not nil, jobs waiting on dependencies are not scheduled.
+	// Should be shared with the Scheduler, which resolves dependencies before each scheduling round.
+	dependencyIndex *DependencyIndex
+	// If not nil, queued jobs of suspended job sets are not scheduled.
+	jobSetSuspensions *JobSetSuspensions
+	// Recent preemptions of jobs of job sets with a disruption budget.
+	jobSetPreemptionHistory *jobSetPreemptionHistory
+	// Spend of queues with a budget over the current budget period.
+	queueSpend *queueSpend
 	// Global job scheduling rate-limiter.
 	limiter *rate.Limiter
 	// Per-queue job scheduling rate-limiters.
@@ -52,6 +62,9 @@ type FairSchedulingAlgo struct {
 	// Order in which to schedule executor groups.
 	// Executors are grouped by either id (i.e., individually) or by pool.
 	executorGroupsToSchedule []string
+	// Time at which scheduling last started on each pool, considering all executors in that pool.
+	// Used to decide when jobs with pool preferences may be scheduled onto less-preferred pools.
+	lastScheduledByPool map[string]time.Time
 	// Function that is called every time an executor is scheduled. Useful for testing.
 	onExecutorScheduled func(executor *schedulerobjects.Executor)
 	// rand and clock injected here for repeatable testing.
@@ -65,6 +78,9 @@ func NewFairSchedulingAlgo(
 	executorRepository database.ExecutorRepository,
 	queueRepository database.QueueRepository,
 	schedulingContextRepository *SchedulingContextRepository,
+	dependencyIndex *DependencyIndex,
+	jobSetSuspensions *JobSetSuspensions,
+	queueSpendRepository database.QueueSpendRepository,
 ) (*FairSchedulingAlgo, error) {
 	if _, ok := config.Preemption.PriorityClasses[config.Preemption.DefaultPriorityClass]; !ok {
 		return nil, errors.Errorf("default priority class %s is missing from priority class mapping %v", config.Preemption.DefaultPriorityClass, config.Preemption.PriorityClasses)
@@ -74,9 +90,14 @@ func NewFairSchedulingAlgo(
 		executorRepository:          executorRepository,
 		queueRepository:             queueRepository,
 		schedulingContextRepository: schedulingContextRepository,
+		dependencyIndex:             dependencyIndex,
+		jobSetSuspensions:           jobSetSuspensions,
+		jobSetPreemptionHistory:     newJobSetPreemptionHistory(config.Preemption.JobSetDisruptionBudgetWindow),
+		queueSpend:                  newQueueSpend(config.CostModel, config.MonthlyBudgetByQueue, queueSpendRepository),
 		limiter:                     rate.NewLimiter(rate.Limit(config.MaximumSchedulingRate), config.MaximumSchedulingBurst),
 		limiterByQueue:              make(map[string]*rate.Limiter),
 		maxSchedulingDuration:       maxSchedulingDuration,
+		lastScheduledByPool:         make(map[string]time.Time),
 		rand:                        util.NewThreadsafeRand(time.Now().UnixNano()),
 		clock:                       clock.RealClock{},
 		onExecutorScheduled:         func(executor *schedulerobjects.Executor) {},
@@ -98,6 +119,7 @@ func (l *FairSchedulingAlgo) Schedule(
 	}
 	overallSchedulerResult := &SchedulerResult{
 		NodeIdByJobId:      make(map[string]string),
+		PreemptionByJobId:  make(map[string]Preemption),
 		SchedulingContexts: make([]*schedulercontext.SchedulingContext, 0, 0),
 		FailedJobs:         make([]interfaces.LegacySchedulerJob, 0),
 	}
@@ -108,10 +130,17 @@ func (l *FairSchedulingAlgo) Schedule(
 		return overallSchedulerResult, nil
 	}
 
+	if err := l.jobSetSuspensions.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	l.jobSetPreemptionHistory.Prune(l.clock.Now())
 	fsctx, err := l.newFairSchedulingAlgoContext(ctx, txn)
 	if err != nil {
 		return nil, err
 	}
+	if err := l.queueSpend.Accrue(ctx, l.clock.Now(), fsctx.allocationByPoolAndQueueAndPriorityClass); err != nil {
+		return nil, err
+	}
 
 	executorGroups := l.groupExecutors(fsctx.executors)
 	if len(l.executorGroupsToSchedule) == 0 {
@@ -146,6 +175,7 @@ func (l *FairSchedulingAlgo) Schedule(
 			"scheduling on executor group %s with capacity %s",
 			executorGroupLabel, fsctx.totalCapacityByPool[pool].CompactString(),
 		)
+		started := l.clock.Now()
 		schedulerResult, sctx, err := l.scheduleOnExecutors(
 			ctx,
 			fsctx,
@@ -181,6 +211,7 @@ func (l *FairSchedulingAlgo) Schedule(
 		if err := txn.Upsert(failedJobs); err != nil {
 			return nil, err
 		}
+		l.jobSetPreemptionHistory.Record(schedulerResult.PreemptedJobs, l.clock.Now())
 
 		// Aggregate changes across executors.
 		overallSchedulerResult.PreemptedJobs = append(overallSchedulerResult.PreemptedJobs, schedulerResult.PreemptedJobs...)
@@ -188,9 +219,22 @@ func (l *FairSchedulingAlgo) Schedule(
 		overallSchedulerResult.FailedJobs = append(overallSchedulerResult.FailedJobs, schedulerResult.FailedJobs...)
 		overallSchedulerResult.SchedulingContexts = append(overallSchedulerResult.SchedulingContexts, schedulerResult.SchedulingContexts...)
 		maps.Copy(overallSchedulerResult.NodeIdByJobId, schedulerResult.NodeIdByJobId)
+		maps.Copy(overallSchedulerResult.PreemptionByJobId, schedulerResult.PreemptionByJobId)
 
 		// Update fsctx.
 		fsctx.allocationByPoolAndQueueAndPriorityClass[pool] = sctx.AllocatedByQueueAndPriority()
+		fsctx.allocationByPoolAndQueueAndUser[pool] = sctx.AllocatedByQueueAndUser()
+		for queue, qctx := range sctx.QueueSchedulingContexts {
+			fsctx.runningJobsByQueueAndJobSet[queue] = maps.Clone(qctx.RunningJobsByJobSet)
+		}
+
+		// A pool has been considered once all executor groups in that pool have been scheduled.
+		if slices.IndexFunc(l.executorGroupsToSchedule, func(label string) bool {
+			executorGroup := executorGroups[label]
+			return len(executorGroup) > 0 && executorGroup[0].Pool == pool
+		}) == -1 {
+			l.lastScheduledByPool[pool] = started
+		}
 
 		for _, executor := range executorGroup {
 			l.onExecutorScheduled(executor)
@@ -231,6 +275,7 @@ func (it *JobQueueIteratorAdapter) Next() (interfaces.LegacySchedulerJob, error)
 
 type fairSchedulingAlgoContext struct {
 	priorityFactorByQueue                    map[string]float64
+	unschedulableReasonByQueue               map[string]string
 	isActiveByQueueName                      map[string]bool
 	totalCapacityByPool                      schedulerobjects.QuantityByTAndResourceType[string]
 	jobsByExecutorId                         map[string][]*jobdb.Job
@@ -238,6 +283,9 @@ type fairSchedulingAlgoContext struct {
 	jobIdsByGangId                           map[string]map[string]bool
 	gangIdByJobId                            map[string]string
 	allocationByPoolAndQueueAndPriorityClass map[string]map[string]schedulerobjects.QuantityByTAndResourceType[string]
+	allocationByPoolAndQueueAndUser          map[string]map[string]schedulerobjects.QuantityByTAndResourceType[string]
+	runningJobsByQueueAndJobSet              map[string]map[string]int
+	runStartTimeByJobId                      map[string]time.Time
 	executors                                []*schedulerobjects.Executor
 	txn                                      *jobdb.Txn
 }
@@ -247,15 +295,22 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 	if err != nil {
 		return nil, err
 	}
-	executors = l.filterStaleExecutors(executors)
+	executors = l.filterStaleExecutors(ctx, executors)
 
 	queues, err := l.queueRepository.GetAllQueues()
 	if err != nil {
 		return nil, err
 	}
 	priorityFactorByQueue := make(map[string]float64)
+	unschedulableReasonByQueue := make(map[string]string)
 	for _, queue := range queues {
 		priorityFactorByQueue[queue.Name] = queue.Weight
+		switch clientqueue.State(queue.State) {
+		case clientqueue.StateDraining:
+			unschedulableReasonByQueue[queue.Name] = schedulerconstraints.QueueDrainingUnschedulableReason
+		case clientqueue.StateClosed:
+			unschedulableReasonByQueue[queue.Name] = schedulerconstraints.QueueClosedUnschedulableReason
+		}
 	}
 
 	// Get the total capacity available across executors.
@@ -272,6 +327,8 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 	nodeIdByJobId := make(map[string]string)
 	jobIdsByGangId := make(map[string]map[string]bool)
 	gangIdByJobId := make(map[string]string)
+	runningJobsByQueueAndJobSet := make(map[string]map[string]int)
+	runStartTimeByJobId := make(map[string]time.Time)
 	for _, job := range txn.GetAll() {
 		isActiveByQueueName[job.Queue()] = true
 		if job.Queued() {
@@ -294,6 +351,18 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 		}
 		jobsByExecutorId[executorId] = append(jobsByExecutorId[executorId], job)
 		nodeIdByJobId[job.Id()] = nodeId
+		// Runs not yet reported as running are recorded with the zero time, such that they're protected once enabled.
+		var runStartTime time.Time
+		if runningTime := run.RunningTime(); runningTime != 0 {
+			runStartTime = time.Unix(0, runningTime)
+		}
+		runStartTimeByJobId[job.Id()] = runStartTime
+		runningJobsByJobSet := runningJobsByQueueAndJobSet[job.Queue()]
+		if runningJobsByJobSet == nil {
+			runningJobsByJobSet = make(map[string]int)
+			runningJobsByQueueAndJobSet[job.Queue()] = runningJobsByJobSet
+		}
+		runningJobsByJobSet[job.Jobset()]++
 		gangId, _, _, isGangJob, err := GangIdAndCardinalityFromLegacySchedulerJob(job)
 		if err != nil {
 			return nil, err
@@ -312,12 +381,16 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 	// Used to calculate fair share.
 	totalAllocationByPoolAndQueue := l.aggregateAllocationByPoolAndQueueAndPriorityClass(executors, jobsByExecutorId)
 
+	// Used to calculate fair share between the users of each queue.
+	totalAllocationByPoolAndQueueAndUser := l.aggregateAllocationByPoolAndQueueAndUser(executors, jobsByExecutorId)
+
 	// Filter out any executor that isn't acknowledging jobs in a timely fashion
 	// Note that we do this after aggregating allocation across clusters for fair share.
 	executors = l.filterLaggingExecutors(ctx, executors, jobsByExecutorId)
 
 	return &fairSchedulingAlgoContext{
 		priorityFactorByQueue:                    priorityFactorByQueue,
+		unschedulableReasonByQueue:               unschedulableReasonByQueue,
 		isActiveByQueueName:                      isActiveByQueueName,
 		totalCapacityByPool:                      totalCapacityByPool,
 		jobsByExecutorId:                         jobsByExecutorId,
@@ -325,6 +398,9 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 		jobIdsByGangId:                           jobIdsByGangId,
 		gangIdByJobId:                            gangIdByJobId,
 		allocationByPoolAndQueueAndPriorityClass: totalAllocationByPoolAndQueue,
+		allocationByPoolAndQueueAndUser:          totalAllocationByPoolAndQueueAndUser,
+		runningJobsByQueueAndJobSet:              runningJobsByQueueAndJobSet,
+		runStartTimeByJobId:                      runStartTimeByJobId,
 		executors:                                executors,
 		txn:                                      txn,
 	}, nil
@@ -349,7 +425,7 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 		return nil, nil, err
 	}
 	for _, executor := range executors {
-		if err := l.addExecutorToNodeDb(nodeDb, fsctx.jobsByExecutorId[executor.Id], executor.Nodes); err != nil {
+		if err := l.addExecutorToNodeDb(ctx, nodeDb, pool, fsctx.jobsByExecutorId[executor.Id], executor.Nodes); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -360,7 +436,8 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 	if len(executors) == 1 {
 		executorId = executors[0].Id
 	}
-	totalResources := fsctx.totalCapacityByPool[pool]
+	nominalResources := fsctx.totalCapacityByPool[pool]
+	totalResources := schedulerconstraints.OvercommittedResources(pool, nominalResources, l.schedulingConfig)
 	var fairnessCostProvider fairness.FairnessCostProvider
 	if l.schedulingConfig.FairnessModel == configuration.DominantResourceFairness {
 		fairnessCostProvider, err = fairness.NewDominantResourceFairness(
@@ -370,6 +447,11 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 		if err != nil {
 			return nil, nil, err
 		}
+	} else if l.schedulingConfig.FairnessModel == configuration.CostFairness {
+		fairnessCostProvider, err = fairness.NewCostFairness(pool, l.schedulingConfig.CostModel)
+		if err != nil {
+			return nil, nil, err
+		}
 	} else {
 		fairnessCostProvider, err = fairness.NewAssetFairness(l.schedulingConfig.ResourceScarcity)
 		if err != nil {
@@ -385,6 +467,7 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 		l.limiter,
 		totalResources,
 	)
+	sctx.NominalResources = nominalResources.DeepCopy()
 	for queue, priorityFactor := range fsctx.priorityFactorByQueue {
 		if !fsctx.isActiveByQueueName[queue] {
 			// To ensure fair share is computed only from active queues, i.e., queues with jobs queued or running.
@@ -410,20 +493,56 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 		if err := sctx.AddQueueSchedulingContext(queue, weight, allocatedByPriorityClass, queueLimiter); err != nil {
 			return nil, nil, err
 		}
+		if allocatedByQueueAndUser := fsctx.allocationByPoolAndQueueAndUser[pool]; allocatedByQueueAndUser != nil {
+			if allocatedByUser := allocatedByQueueAndUser[queue]; allocatedByUser != nil {
+				sctx.QueueSchedulingContexts[queue].AllocatedByUser = allocatedByUser.DeepCopy()
+			}
+		}
+		if runningJobsByJobSet := fsctx.runningJobsByQueueAndJobSet[queue]; runningJobsByJobSet != nil {
+			sctx.QueueSchedulingContexts[queue].RunningJobsByJobSet = maps.Clone(runningJobsByJobSet)
+		}
+		sctx.QueueSchedulingContexts[queue].NumQueuedJobs = fsctx.txn.NumQueuedJobs(queue)
 	}
 	constraints := schedulerconstraints.SchedulingConstraintsFromSchedulingConfig(
 		pool,
-		fsctx.totalCapacityByPool[pool],
+		totalResources,
 		minimumJobSize,
 		l.schedulingConfig,
 	)
+	if len(l.schedulingConfig.QuotaBorrowersByLender) > 0 {
+		constraints.EnableQuotaBorrowing(l.schedulingConfig.QuotaBorrowersByLender, maps.Keys(fsctx.priorityFactorByQueue))
+	}
+	jobRepo := NewSchedulerJobRepositoryAdapter(fsctx.txn)
+	waitingJobsById := make(map[string]*jobdb.Job)
+	suspendedJobsById := make(map[string]*jobdb.Job)
+	queueStateJobsById := make(map[string]*jobdb.Job)
+	budgetExhaustedJobsById := make(map[string]*jobdb.Job)
+	jobRepo.filter = func(job *jobdb.Job) bool {
+		if _, ok := fsctx.unschedulableReasonByQueue[job.Queue()]; ok {
+			queueStateJobsById[job.Id()] = job
+			return false
+		}
+		if l.queueSpend.IsExhausted(job.Queue()) && !l.isBudgetExempt(job) {
+			budgetExhaustedJobsById[job.Id()] = job
+			return false
+		}
+		if l.dependencyIndex.IsWaiting(job.Id()) {
+			waitingJobsById[job.Id()] = job
+			return false
+		}
+		if l.jobSetSuspensions.IsSuspended(job.Queue(), job.Jobset()) {
+			suspendedJobsById[job.Id()] = job
+			return false
+		}
+		return l.isEligibleForPool(fsctx, job, pool)
+	}
 	scheduler := NewPreemptingQueueScheduler(
 		sctx,
 		constraints,
 		l.schedulingConfig.Preemption.NodeEvictionProbability,
 		l.schedulingConfig.Preemption.NodeOversubscriptionEvictionProbability,
 		l.schedulingConfig.Preemption.ProtectedFractionOfFairShare,
-		NewSchedulerJobRepositoryAdapter(fsctx.txn),
+		jobRepo,
 		nodeDb,
 		fsctx.nodeIdByJobId,
 		fsctx.jobIdsByGangId,
@@ -438,10 +557,59 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 	if l.schedulingConfig.EnableNewPreemptionStrategy {
 		scheduler.EnableNewPreemptionStrategy()
 	}
+	if l.schedulingConfig.NodeMaintenanceLabel != "" {
+		scheduler.EnableNodeDrain(l.schedulingConfig.NodeMaintenanceLabel, l.schedulingConfig.MaxJobsToDrainPerRound)
+	}
+	if l.schedulingConfig.EnableUserFairShare {
+		scheduler.EnableUserFairShare(l.schedulingConfig.UserFairShareLookahead)
+	}
+	if l.schedulingConfig.Preemption.MinimumRuntimeBeforeFairSharePreemption > 0 {
+		scheduler.EnableFairSharePreemptionProtection(
+			l.schedulingConfig.Preemption.MinimumRuntimeBeforeFairSharePreemption,
+			fsctx.runStartTimeByJobId,
+		)
+	}
+	scheduler.EnableJobSetDisruptionBudgets(l.jobSetPreemptionHistory.CountByQueueAndJobSet())
 	result, err := scheduler.Schedule(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
+	// Record jobs waiting on dependencies, of suspended job sets, of draining or closed queues,
+	// or of queues that have exhausted their budget as unschedulable, such that the reason is surfaced in scheduling reports.
+	for _, job := range waitingJobsById {
+		jctx := schedulercontext.JobSchedulingContextFromJob(sctx.PriorityClasses, job, GangIdAndCardinalityFromAnnotations)
+		jctx.Fail(schedulerconstraints.WaitingOnDependenciesUnschedulableReason)
+		if _, err := sctx.AddJobSchedulingContext(jctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, job := range suspendedJobsById {
+		jctx := schedulercontext.JobSchedulingContextFromJob(sctx.PriorityClasses, job, GangIdAndCardinalityFromAnnotations)
+		jctx.Fail(schedulerconstraints.JobSetSuspendedUnschedulableReason)
+		if _, err := sctx.AddJobSchedulingContext(jctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, job := range queueStateJobsById {
+		jctx := schedulercontext.JobSchedulingContextFromJob(sctx.PriorityClasses, job, GangIdAndCardinalityFromAnnotations)
+		jctx.Fail(fsctx.unschedulableReasonByQueue[job.Queue()])
+		if _, err := sctx.AddJobSchedulingContext(jctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, job := range budgetExhaustedJobsById {
+		jctx := schedulercontext.JobSchedulingContextFromJob(sctx.PriorityClasses, job, GangIdAndCardinalityFromAnnotations)
+		jctx.Fail(schedulerconstraints.BudgetExhaustedUnschedulableReason)
+		if _, err := sctx.AddJobSchedulingContext(jctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, qctx := range sctx.QueueSchedulingContexts {
+		for _, jctx := range qctx.SuccessfulJobSchedulingContexts {
+			jctx.Pool = pool
+			jctx.PoolPreferenceSatisfaction = poolPreferenceSatisfaction(jctx.Job.(*jobdb.Job), pool)
+		}
+	}
 	for i, job := range result.PreemptedJobs {
 		jobDbJob := job.(*jobdb.Job)
 		if run := jobDbJob.LatestRun(); run != nil {
@@ -475,6 +643,8 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 // TODO: Pass JobDb into the scheduler instead of using this shim to convert to a JobRepo.
 type SchedulerJobRepositoryAdapter struct {
 	txn *jobdb.Txn
+	// If set, only queued jobs for which filter returns true are returned by GetQueueJobIds.
+	filter func(*jobdb.Job) bool
 }
 
 func NewSchedulerJobRepositoryAdapter(txn *jobdb.Txn) *SchedulerJobRepositoryAdapter {
@@ -489,6 +659,9 @@ func (repo *SchedulerJobRepositoryAdapter) GetQueueJobIds(queue string) ([]strin
 	rv := make([]string, 0)
 	it := repo.txn.QueuedJobs(queue)
 	for v, _ := it.Next(); v != nil; v, _ = it.Next() {
+		if repo.filter != nil && !repo.filter(v) {
+			continue
+		}
 		rv = append(rv, v.Id())
 	}
 	return rv, nil
@@ -506,8 +679,61 @@ func (repo *SchedulerJobRepositoryAdapter) GetExistingJobsByIds(ids []string) ([
 	return rv, nil
 }
 
+// isBudgetExempt returns true if job is of a priority class scheduled even once its queue has exhausted its budget.
+func (l *FairSchedulingAlgo) isBudgetExempt(job *jobdb.Job) bool {
+	priorityClassName := job.GetPriorityClassName()
+	if priorityClassName == "" {
+		priorityClassName = l.schedulingConfig.Preemption.DefaultPriorityClass
+	}
+	return slices.Contains(l.schedulingConfig.BudgetExemptPriorityClasses, priorityClassName)
+}
+
+// isEligibleForPool returns true if job may be scheduled onto pool.
+// Jobs without pool preferences may be scheduled onto any pool.
+// Jobs with pool preferences may only be scheduled onto pools they list,
+// and only once each pool with higher weight has been scheduled since the job was submitted (or has no executors).
+func (l *FairSchedulingAlgo) isEligibleForPool(fsctx *fairSchedulingAlgoContext, job *jobdb.Job, pool string) bool {
+	weightByPool := job.PoolPreferences()
+	if weightByPool == nil {
+		return true
+	}
+	weight, ok := weightByPool[pool]
+	if !ok {
+		return false
+	}
+	submitted := time.Unix(0, job.Created())
+	for otherPool, otherWeight := range weightByPool {
+		if otherWeight <= weight {
+			continue
+		}
+		if _, ok := fsctx.totalCapacityByPool[otherPool]; !ok {
+			// No executors in this pool; nothing to wait for.
+			continue
+		}
+		if lastScheduled, ok := l.lastScheduledByPool[otherPool]; !ok || lastScheduled.Before(submitted) {
+			return false
+		}
+	}
+	return true
+}
+
+// poolPreferenceSatisfaction returns the weight of pool divided by the largest weight among the pools preferred by job,
+// or zero if the job expresses no pool preferences.
+func poolPreferenceSatisfaction(job *jobdb.Job, pool string) float64 {
+	weightByPool := job.PoolPreferences()
+	if weightByPool == nil {
+		return 0
+	}
+	maxWeight := 0.0
+	for _, weight := range weightByPool {
+		maxWeight = math.Max(maxWeight, weight)
+	}
+	return weightByPool[pool] / maxWeight
+}
+
 // addExecutorToNodeDb adds all the nodes and jobs associated with a particular executor to the nodeDb.
-func (l *FairSchedulingAlgo) addExecutorToNodeDb(nodeDb *nodedb.NodeDb, jobs []*jobdb.Job, nodes []*schedulerobjects.Node) error {
+// The resources of each node are scaled by the overcommit factors configured for pool.
+func (l *FairSchedulingAlgo) addExecutorToNodeDb(ctx *armadacontext.Context, nodeDb *nodedb.NodeDb, pool string, jobs []*jobdb.Job, nodes []*schedulerobjects.Node) error {
 	txn := nodeDb.Txn(true)
 	defer txn.Abort()
 	nodesById := armadaslices.GroupByFuncUnique(
@@ -521,15 +747,20 @@ func (l *FairSchedulingAlgo) addExecutorToNodeDb(nodeDb *nodedb.NodeDb, jobs []*
 		}
 		nodeId := job.LatestRun().NodeId()
 		if _, ok := nodesById[nodeId]; !ok {
-			logrus.Errorf(
-				"job %s assigned to node %s on executor %s, but no such node found",
-				job.Id(), nodeId, job.LatestRun().Executor(),
+			ctx.WithFields(logging.JobFields(job.Queue(), job.Jobset(), job.Id())).Errorf(
+				"job assigned to node %s on executor %s, but no such node found",
+				nodeId, job.LatestRun().Executor(),
 			)
 			continue
 		}
 		jobsByNodeId[nodeId] = append(jobsByNodeId[nodeId], job)
 	}
 	for _, node := range nodes {
+		if !node.Unschedulable && nodedb.IsMarkedForMaintenance(node.Labels, l.schedulingConfig.NodeMaintenanceLabel) {
+			node = node.DeepCopy()
+			node.Unschedulable = true
+		}
+		node = schedulerconstraints.OvercommittedNode(pool, node, l.schedulingConfig)
 		if err := nodeDb.CreateAndInsertWithJobDbJobsWithTxn(txn, jobsByNodeId[node.Id], node); err != nil {
 			return err
 		}
@@ -540,14 +771,14 @@ func (l *FairSchedulingAlgo) addExecutorToNodeDb(nodeDb *nodedb.NodeDb, jobs []*
 
 // filterStaleExecutors returns all executors which have sent a lease request within the duration given by l.schedulingConfig.ExecutorTimeout.
 // This ensures that we don't continue to assign jobs to executors that are no longer active.
-func (l *FairSchedulingAlgo) filterStaleExecutors(executors []*schedulerobjects.Executor) []*schedulerobjects.Executor {
+func (l *FairSchedulingAlgo) filterStaleExecutors(ctx *armadacontext.Context, executors []*schedulerobjects.Executor) []*schedulerobjects.Executor {
 	activeExecutors := make([]*schedulerobjects.Executor, 0, len(executors))
 	cutoff := l.clock.Now().Add(-l.schedulingConfig.ExecutorTimeout)
 	for _, executor := range executors {
 		if executor.LastUpdateTime.After(cutoff) {
 			activeExecutors = append(activeExecutors, executor)
 		} else {
-			logrus.Debugf("Ignoring executor %s because it hasn't heartbeated since %s", executor.Id, executor.LastUpdateTime)
+			ctx.Debugf("Ignoring executor %s because it hasn't heartbeated since %s", executor.Id, executor.LastUpdateTime)
 		}
 	}
 	return activeExecutors
@@ -622,3 +853,28 @@ func (l *FairSchedulingAlgo) aggregateAllocationByPoolAndQueueAndPriorityClass(
 	}
 	return rv
 }
+
+// aggregateAllocationByPoolAndQueueAndUser returns the resources allocated to running jobs by pool, queue, and job owner.
+func (l *FairSchedulingAlgo) aggregateAllocationByPoolAndQueueAndUser(
+	executors []*schedulerobjects.Executor,
+	jobsByExecutorId map[string][]*jobdb.Job,
+) map[string]map[string]schedulerobjects.QuantityByTAndResourceType[string] {
+	rv := make(map[string]map[string]schedulerobjects.QuantityByTAndResourceType[string])
+	for _, executor := range executors {
+		allocationByQueue := rv[executor.Pool]
+		if allocationByQueue == nil {
+			allocationByQueue = make(map[string]schedulerobjects.QuantityByTAndResourceType[string])
+			rv[executor.Pool] = allocationByQueue
+		}
+		for _, job := range jobsByExecutorId[executor.Id] {
+			queue := job.Queue()
+			allocation := allocationByQueue[queue]
+			if allocation == nil {
+				allocation = make(schedulerobjects.QuantityByTAndResourceType[string])
+				allocationByQueue[queue] = allocation
+			}
+			allocation.AddV1ResourceList(job.Owner(), job.GetResourceRequirements().Requests)
+		}
+	}
+	return rv
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
@@ -28,6 +29,14 @@ func TestSchedule(t *testing.T) {
 		jobs         []*jobdb.Job
 		acknowledged bool
 	}
+	// The last two jobs wait on the first, which is queued.
+	dependentJobs := testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 4)
+	testfixtures.WithDependenciesJobs(dependentJobs[:1], dependentJobs[2:])
+	// The last two jobs are in a suspended job set.
+	suspendedJobs := testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 4)
+	for i := 2; i < len(suspendedJobs); i++ {
+		suspendedJobs[i] = suspendedJobs[i].WithJobset("suspended")
+	}
 	tests := map[string]struct {
 		schedulingConfig configuration.SchedulingConfig
 
@@ -35,6 +44,9 @@ func TestSchedule(t *testing.T) {
 		queues     []*database.Queue
 		queuedJobs []*jobdb.Job
 
+		// Job sets whose queued jobs shouldn't be scheduled.
+		suspendedJobSets []database.JobSetSuspension
+
 		// Already scheduled jobs. Specifically,
 		// [executorIndex][nodeIndex] = jobs scheduled onto this executor and node,
 		// where executorIndex refers to the index of executors, and nodeIndex the index of the node on that executor.
@@ -106,6 +118,18 @@ func TestSchedule(t *testing.T) {
 			},
 			expectedScheduledIndices: testfixtures.IntRange(0, 31),
 		},
+		"overcommit allows scheduling past node capacity": {
+			schedulingConfig: testfixtures.WithOvercommitFactorsConfig(
+				map[string]float64{"cpu": 1.5},
+				testfixtures.TestSchedulingConfig(),
+			),
+			executors: []*schedulerobjects.Executor{
+				testfixtures.Test1Node32CoreExecutor("executor1"),
+			},
+			queues:                   []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs:               testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 64),
+			expectedScheduledIndices: testfixtures.IntRange(0, 47),
+		},
 		"one executor full": {
 			schedulingConfig: testfixtures.TestSchedulingConfig(),
 			executors: []*schedulerobjects.Executor{
@@ -338,6 +362,159 @@ func TestSchedule(t *testing.T) {
 			},
 			expectedScheduledIndices: []int{0},
 		},
+		"pool preferences": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				testfixtures.WithPoolExecutor("on-prem", testfixtures.Test1Node32CoreExecutor("executor1")),
+				testfixtures.WithPoolExecutor("cloud", testfixtures.Test1Node32CoreExecutor("executor2")),
+			},
+			queues: []*database.Queue{testfixtures.TestDbQueue()},
+			// Cloud is scheduled first, before on-prem has been considered; hence, nothing is scheduled there.
+			queuedJobs:               testfixtures.WithPoolPreferencesJobs("on-prem=1,cloud=0.2", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10)),
+			expectedScheduledIndices: []int{0, 1},
+		},
+		"pool preferences overflow if preferred pool has no executors": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				testfixtures.WithPoolExecutor("cloud", testfixtures.Test1Node32CoreExecutor("executor1")),
+			},
+			queues:                   []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs:               testfixtures.WithPoolPreferencesJobs("on-prem=1,cloud=0.2", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10)),
+			expectedScheduledIndices: []int{0, 1},
+		},
+		"pool preferences exclude unlisted pools": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				testfixtures.WithPoolExecutor("cloud", testfixtures.Test1Node32CoreExecutor("executor1")),
+			},
+			queues:     []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs: testfixtures.WithPoolPreferencesJobs("on-prem=1", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10)),
+		},
+		"user fair share": {
+			schedulingConfig: testfixtures.WithUserFairShareConfig(8, testfixtures.TestSchedulingConfig()),
+			executors:        []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues:           []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs: armadaslices.Concatenate(
+				testfixtures.WithOwnerJobs("alice", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 4)),
+				testfixtures.WithOwnerJobs("bob", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 4)),
+			),
+			expectedScheduledIndices: []int{0, 4},
+		},
+		"user fair share doesn't preempt jobs within a queue": {
+			schedulingConfig: testfixtures.WithUserFairShareConfig(8, testfixtures.TestSchedulingConfig()),
+			executors:        []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues:           []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs:       testfixtures.WithOwnerJobs("bob", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 2)),
+			scheduledJobsByExecutorIndexAndNodeIndex: map[int]map[int]scheduledJobs{
+				0: {
+					0: scheduledJobs{
+						jobs:         testfixtures.WithOwnerJobs("alice", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 2)),
+						acknowledged: true,
+					},
+				},
+			},
+		},
+		"user fair share accounts for running jobs": {
+			schedulingConfig: testfixtures.WithUserFairShareConfig(8, testfixtures.TestSchedulingConfig()),
+			executors:        []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues:           []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs: armadaslices.Concatenate(
+				testfixtures.WithOwnerJobs("alice", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 2)),
+				testfixtures.WithOwnerJobs("bob", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 2)),
+			),
+			scheduledJobsByExecutorIndexAndNodeIndex: map[int]map[int]scheduledJobs{
+				0: {
+					0: scheduledJobs{
+						jobs:         testfixtures.WithOwnerJobs("alice", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 1)),
+						acknowledged: true,
+					},
+				},
+			},
+			expectedScheduledIndices: []int{2},
+		},
+		"user fair share disabled": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors:        []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues:           []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs: armadaslices.Concatenate(
+				testfixtures.WithOwnerJobs("alice", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 4)),
+				testfixtures.WithOwnerJobs("bob", testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 4)),
+			),
+			expectedScheduledIndices: []int{0, 1},
+		},
+		"job set max running jobs": {
+			schedulingConfig:         testfixtures.TestSchedulingConfig(),
+			executors:                []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues:                   []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs:               testfixtures.WithJobSetMaxRunningJobsJobs(3, testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10)),
+			expectedScheduledIndices: []int{0, 1, 2},
+		},
+		"job set max running jobs accounts for running jobs": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				testfixtures.Test1Node32CoreExecutor("executor1"),
+				testfixtures.Test1Node32CoreExecutor("executor2"),
+			},
+			queues:     []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs: testfixtures.WithJobSetMaxRunningJobsJobs(3, testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10)),
+			scheduledJobsByExecutorIndexAndNodeIndex: map[int]map[int]scheduledJobs{
+				0: {
+					0: scheduledJobs{
+						jobs:         testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 2),
+						acknowledged: true,
+					},
+				},
+			},
+			expectedScheduledIndices: []int{0},
+		},
+		"job dependencies": {
+			schedulingConfig:         testfixtures.TestSchedulingConfig(),
+			executors:                []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues:                   []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs:               dependentJobs,
+			expectedScheduledIndices: []int{0, 1},
+		},
+		"suspended job sets": {
+			schedulingConfig:         testfixtures.TestSchedulingConfig(),
+			executors:                []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues:                   []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs:               suspendedJobs,
+			suspendedJobSets:         []database.JobSetSuspension{{Queue: testfixtures.TestQueue, JobSet: "suspended"}},
+			expectedScheduledIndices: []int{0, 1},
+		},
+		"draining and closed queues": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors:        []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues: []*database.Queue{
+				testfixtures.TestDbQueue(),
+				{Name: "draining", Weight: 100, State: "draining"},
+				{Name: "closed", Weight: 100, State: "closed"},
+			},
+			queuedJobs: armadaslices.Concatenate(
+				testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 2),
+				testfixtures.N1Cpu4GiJobs("draining", testfixtures.PriorityClass3, 2),
+				testfixtures.N1Cpu4GiJobs("closed", testfixtures.PriorityClass3, 2),
+			),
+			expectedScheduledIndices: []int{0, 1},
+		},
+		"queue with exhausted budget": {
+			schedulingConfig: testfixtures.WithMonthlyBudgetsConfig(
+				map[string]float64{"broke": 0},
+				[]string{testfixtures.PriorityClass0},
+				testfixtures.TestSchedulingConfig(),
+			),
+			executors: []*schedulerobjects.Executor{testfixtures.Test1Node32CoreExecutor("executor1")},
+			queues: []*database.Queue{
+				testfixtures.TestDbQueue(),
+				{Name: "broke", Weight: 100},
+			},
+			queuedJobs: armadaslices.Concatenate(
+				testfixtures.N1Cpu4GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 2),
+				testfixtures.N1Cpu4GiJobs("broke", testfixtures.PriorityClass3, 2),
+				testfixtures.N1Cpu4GiJobs("broke", testfixtures.PriorityClass0, 1),
+			),
+			expectedScheduledIndices: []int{0, 1, 4},
+		},
 		"UnifiedSchedulingByPool": {
 			schedulingConfig: testfixtures.WithUnifiedSchedulingByPoolConfig(testfixtures.TestSchedulingConfig()),
 			executors: []*schedulerobjects.Executor{
@@ -368,15 +545,21 @@ func TestSchedule(t *testing.T) {
 			mockExecutorRepo.EXPECT().GetExecutors(ctx).Return(tc.executors, nil).AnyTimes()
 			mockQueueRepo := schedulermocks.NewMockQueueRepository(ctrl)
 			mockQueueRepo.EXPECT().GetAllQueues().Return(tc.queues, nil).AnyTimes()
+			mockJobRepo := schedulermocks.NewMockJobRepository(ctrl)
+			mockJobRepo.EXPECT().FetchJobSetSuspensions(gomock.Any()).Return(tc.suspendedJobSets, nil).AnyTimes()
 
-			schedulingContextRepo, err := NewSchedulingContextRepository(1024)
+			schedulingContextRepo, err := NewSchedulingContextRepository(1024, testfixtures.TestSchedulingConfig())
 			require.NoError(t, err)
+			dependencyIndex := NewDependencyIndex(nil, 0)
 			sch, err := NewFairSchedulingAlgo(
 				tc.schedulingConfig,
 				0,
 				mockExecutorRepo,
 				mockQueueRepo,
 				schedulingContextRepo,
+				dependencyIndex,
+				NewJobSetSuspensions(mockJobRepo),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -391,6 +574,7 @@ func TestSchedule(t *testing.T) {
 				jobsToUpsert = append(jobsToUpsert, job)
 				queueIndexByJobId[job.Id()] = i
 			}
+			dependencyIndex.Observe(ctx, jobsToUpsert)
 
 			// Add scheduled jobs to the jobDb. Bind acknowledged jobs to nodes.
 			executorIndexByJobId := make(map[string]int)
@@ -509,6 +693,107 @@ func TestSchedule(t *testing.T) {
 	}
 }
 
+func TestSchedule_PoolPreferences(t *testing.T) {
+	ctx := armadacontext.Background()
+	executors := []*schedulerobjects.Executor{
+		testfixtures.WithPoolExecutor("on-prem", testfixtures.Test1Node32CoreExecutor("executor1")),
+		testfixtures.WithPoolExecutor("cloud", testfixtures.Test1Node32CoreExecutor("executor2")),
+	}
+	ctrl := gomock.NewController(t)
+	mockExecutorRepo := schedulermocks.NewMockExecutorRepository(ctrl)
+	mockExecutorRepo.EXPECT().GetExecutors(ctx).Return(executors, nil).AnyTimes()
+	mockQueueRepo := schedulermocks.NewMockQueueRepository(ctrl)
+	mockQueueRepo.EXPECT().GetAllQueues().Return([]*database.Queue{testfixtures.TestDbQueue()}, nil).AnyTimes()
+	sch, err := NewFairSchedulingAlgo(testfixtures.TestSchedulingConfig(), 0, mockExecutorRepo, mockQueueRepo, nil, nil, nil, nil)
+	require.NoError(t, err)
+	sch.clock = clock.NewFakeClock(testfixtures.BaseTime)
+
+	jobs := testfixtures.WithPoolPreferencesJobs(
+		"on-prem=1,cloud=0.2",
+		testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 4),
+	)
+	for i, job := range jobs {
+		jobs[i] = job.WithQueued(true)
+	}
+	txn := testfixtures.NewJobDb().WriteTxn()
+	require.NoError(t, txn.Upsert(jobs))
+
+	// Executor groups are scheduled in reverse lexicographical order, i.e., cloud is scheduled before on-prem.
+	// Hence, jobs are scheduled onto on-prem in the first round, and overflow to cloud only in the second round.
+	expectedSatisfactionByPool := []map[string]float64{{"on-prem": 1}, {"cloud": 0.2}}
+	for round, expected := range expectedSatisfactionByPool {
+		schedulerResult, err := sch.Schedule(ctx, txn)
+		require.NoError(t, err)
+		assert.Len(t, schedulerResult.ScheduledJobs, 2, "round %d", round)
+		actual := make(map[string]float64)
+		for _, sctx := range schedulerResult.SchedulingContexts {
+			for _, qctx := range sctx.QueueSchedulingContexts {
+				for _, jctx := range qctx.SuccessfulJobSchedulingContexts {
+					actual[jctx.Pool] = jctx.PoolPreferenceSatisfaction
+				}
+			}
+		}
+		assert.Equal(t, expected, actual, "round %d", round)
+	}
+}
+
+func TestSchedule_MinimumRuntimeBeforeFairSharePreemption(t *testing.T) {
+	now := time.Now()
+	tests := map[string]struct {
+		// Time at which the running jobs of queue A started running, or the zero time if they haven't yet.
+		runningTime       time.Time
+		expectedPreempted int
+	}{
+		"not yet running": {
+			runningTime: time.Time{},
+		},
+		"leased long ago but started running recently": {
+			runningTime: now.Add(-time.Minute),
+		},
+		"started running long ago": {
+			runningTime:       now.Add(-30 * time.Minute),
+			expectedPreempted: 16,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := armadacontext.Background()
+			executor := testfixtures.WithLastUpdateTimeExecutor(now, testfixtures.Test1Node32CoreExecutor("executor1"))
+			node := executor.Nodes[0]
+			ctrl := gomock.NewController(t)
+			mockExecutorRepo := schedulermocks.NewMockExecutorRepository(ctrl)
+			mockExecutorRepo.EXPECT().GetExecutors(ctx).Return([]*schedulerobjects.Executor{executor}, nil).AnyTimes()
+			mockQueueRepo := schedulermocks.NewMockQueueRepository(ctrl)
+			mockQueueRepo.EXPECT().GetAllQueues().Return([]*database.Queue{{Name: "A", Weight: 100}, {Name: "B", Weight: 100}}, nil).AnyTimes()
+			schedulingConfig := testfixtures.WithMinimumRuntimeBeforeFairSharePreemptionConfig(10*time.Minute, testfixtures.TestSchedulingConfig())
+			sch, err := NewFairSchedulingAlgo(schedulingConfig, 0, mockExecutorRepo, mockQueueRepo, nil, nil, nil, nil)
+			require.NoError(t, err)
+			sch.clock = clock.NewFakeClock(now)
+
+			// The jobs of A were leased an hour ago, i.e., longer than the minimum runtime.
+			var jobs []*jobdb.Job
+			for _, job := range testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 32) {
+				run := jobdb.CreateRun(uuid.New(), job.Id(), now.Add(-time.Hour).UnixNano(), executor.Id, node.Id, node.Name, false, false, false, false, false, false)
+				if !tc.runningTime.IsZero() {
+					run = run.WithRunning(true).WithRunningTime(tc.runningTime.UnixNano())
+				}
+				node.StateByJobRunId[run.Id().String()] = schedulerobjects.JobRunState_RUNNING
+				jobs = append(jobs, job.WithQueued(false).WithUpdatedRun(run))
+			}
+			for _, job := range testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 32) {
+				jobs = append(jobs, job.WithQueued(true))
+			}
+			txn := testfixtures.NewJobDb().WriteTxn()
+			require.NoError(t, txn.Upsert(jobs))
+
+			schedulerResult, err := sch.Schedule(ctx, txn)
+			require.NoError(t, err)
+			assert.Len(t, schedulerResult.PreemptedJobs, tc.expectedPreempted)
+			assert.Len(t, schedulerResult.ScheduledJobs, tc.expectedPreempted)
+		})
+	}
+}
+
 func BenchmarkNodeDbConstruction(b *testing.B) {
 	for e := 1; e <= 4; e++ {
 		numNodes := int(math.Pow10(e))
@@ -531,6 +816,9 @@ func BenchmarkNodeDbConstruction(b *testing.B) {
 					nil,
 					nil,
 					nil,
+					nil,
+					nil,
+					nil,
 				)
 				require.NoError(b, err)
 				b.StartTimer()
@@ -543,7 +831,7 @@ func BenchmarkNodeDbConstruction(b *testing.B) {
 					schedulingConfig.IndexedNodeLabels,
 				)
 				require.NoError(b, err)
-				err = algo.addExecutorToNodeDb(nodeDb, jobs, nodes)
+				err = algo.addExecutorToNodeDb(armadacontext.Background(), nodeDb, testfixtures.TestPool, jobs, nodes)
 				require.NoError(b, err)
 			}
 		})
@@ -101,6 +101,7 @@ func NewSimulator(clusterSpec *ClusterSpec, workloadSpec *WorkloadSpec, scheduli
 		schedulingConfig.Preemption.PriorityClasses,
 		schedulingConfig.Preemption.DefaultPriorityClass,
 	)
+	jobDb.SetPriorityAging(schedulingConfig.JobPriorityAgingPerHour, schedulingConfig.JobPriorityAgingPerHourByQueue)
 	randomSeed := workloadSpec.RandomSeed
 	if randomSeed == 0 {
 		// Seed the RNG using the local time if no explic random seed is provided.
@@ -240,6 +241,8 @@ func (s *Simulator) setupClusters() error {
 							TotalResources:                   nodeTemplate.TotalResources.DeepCopy(),
 							AllocatableByPriorityAndResource: allocatableByPriorityAndResource,
 						}
+						totalResourcesForPool.Add(node.TotalResources)
+						node = schedulerconstraints.OvercommittedNode(pool.Name, node, s.schedulingConfig)
 						txn := nodeDb.Txn(true)
 						if err := nodeDb.CreateAndInsertWithApiJobsWithTxn(txn, nil, node); err != nil {
 							txn.Abort()
@@ -251,7 +254,6 @@ func (s *Simulator) setupClusters() error {
 				}
 			}
 			s.nodeDbByPoolAndExecutorGroup[pool.Name] = append(s.nodeDbByPoolAndExecutorGroup[pool.Name], nodeDb)
-			totalResourcesForPool.Add(nodeDb.TotalResources())
 		}
 		s.totalResourcesByPool[pool.Name] = totalResourcesForPool
 	}
@@ -378,7 +380,7 @@ func (s *Simulator) pushScheduleEvent(time time.Time) {
 
 func (s *Simulator) handleSimulatorEvent(ctx *armadacontext.Context, event Event) error {
 	s.time = event.time
-	ctx = armadacontext.New(ctx.Context, ctx.FieldLogger.WithField("simulated time", event.time))
+	ctx = armadacontext.WithLogField(ctx, "simulated time", event.time)
 	switch e := event.eventSequenceOrScheduleEvent.(type) {
 	case *armadaevents.EventSequence:
 		if err := s.handleEventSequence(ctx, e); err != nil {
@@ -411,7 +413,8 @@ func (s *Simulator) handleScheduleEvent(ctx *armadacontext.Context) error {
 			if err := nodeDb.Reset(); err != nil {
 				return err
 			}
-			totalResources := s.totalResourcesByPool[pool.Name]
+			nominalResources := s.totalResourcesByPool[pool.Name]
+			totalResources := schedulerconstraints.OvercommittedResources(pool.Name, nominalResources, s.schedulingConfig)
 			fairnessCostProvider, err := fairness.NewDominantResourceFairness(
 				totalResources,
 				s.schedulingConfig.DominantResourceFairnessResourcesToConsider,
@@ -428,6 +431,7 @@ func (s *Simulator) handleScheduleEvent(ctx *armadacontext.Context) error {
 				s.limiter,
 				totalResources,
 			)
+			sctx.NominalResources = nominalResources.DeepCopy()
 
 			sctx.Started = s.time
 			for _, queue := range s.WorkloadSpec.Queues {
@@ -475,10 +479,7 @@ func (s *Simulator) handleScheduleEvent(ctx *armadacontext.Context) error {
 			}
 			schedulerCtx := ctx
 			if s.SuppressSchedulerLogs {
-				schedulerCtx = &armadacontext.Context{
-					Context:     ctx.Context,
-					FieldLogger: logging.NullLogger,
-				}
+				schedulerCtx = armadacontext.New(ctx.Context, logging.NullLogger)
 			}
 			result, err := sch.Schedule(schedulerCtx)
 			if err != nil {
@@ -546,7 +547,7 @@ func (s *Simulator) handleScheduleEvent(ctx *armadacontext.Context) error {
 
 			// Generate eventSequences.
 			// TODO: Add time taken to run the scheduler to s.time.
-			eventSequences, err = scheduler.AppendEventSequencesFromPreemptedJobs(eventSequences, preemptedJobs, s.time)
+			eventSequences, err = scheduler.AppendEventSequencesFromPreemptedJobs(eventSequences, preemptedJobs, result.PreemptionByJobId, s.time)
 			if err != nil {
 				return err
 			}
@@ -652,7 +653,7 @@ func (s *Simulator) handleSubmitJob(txn *jobdb.Txn, e *armadaevents.SubmitJob, t
 		false,
 		false,
 		s.logicalJobCreatedTimestamp.Add(1),
-	)
+	).WithOwner(eventSequence.UserId)
 	if err := txn.Upsert([]*jobdb.Job{job}); err != nil {
 		return nil, false, err
 	}
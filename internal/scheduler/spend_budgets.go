@@ -0,0 +1,125 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+// queueSpend accrues the spend of queues with a budget over the current budget period, i.e., calendar month (UTC),
+// such that the jobs of queues that have exhausted their budget can be excluded from scheduling.
+type queueSpend struct {
+	costModel     configuration.CostModelConfig
+	budgetByQueue map[string]float64
+	// If not nil, spend is loaded from and stored in this repository each time it's accrued,
+	// such that spend is retained across restarts of the scheduler and changes of leader.
+	repository   database.QueueSpendRepository
+	spendByQueue map[string]*accruedSpend
+}
+
+// accruedSpend is the spend of a queue over the budget period starting at periodStart, accrued up to accrued.
+type accruedSpend struct {
+	periodStart time.Time
+	accrued     time.Time
+	spend       float64
+}
+
+func newQueueSpend(costModel configuration.CostModelConfig, budgetByQueue map[string]float64, repository database.QueueSpendRepository) *queueSpend {
+	return &queueSpend{
+		costModel:     costModel,
+		budgetByQueue: budgetByQueue,
+		repository:    repository,
+		spendByQueue:  make(map[string]*accruedSpend),
+	}
+}
+
+// budgetPeriodStart returns the start of the budget period t falls in.
+func budgetPeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Accrue adds to the spend of each queue with a budget the price of its allocation for the time since its spend was
+// last accrued, assuming the allocation has been constant since. Spend is reset at the start of each budget period.
+// Spend is accrued from the first time a queue is seen; stored spend is accrued up to now even if it was last accrued
+// by another scheduler, e.g., before a restart, since jobs keep running while no scheduler is leader.
+func (s *queueSpend) Accrue(
+	ctx *armadacontext.Context,
+	now time.Time,
+	allocationByPoolAndQueueAndPriorityClass map[string]map[string]schedulerobjects.QuantityByTAndResourceType[string],
+) error {
+	if len(s.budgetByQueue) == 0 {
+		return nil
+	}
+	if s.repository != nil {
+		stored, err := s.repository.GetQueueSpend(ctx)
+		if err != nil {
+			return err
+		}
+		s.spendByQueue = make(map[string]*accruedSpend, len(stored))
+		for _, queueSpend := range stored {
+			s.spendByQueue[queueSpend.Queue] = &accruedSpend{
+				periodStart: queueSpend.PeriodStart,
+				accrued:     queueSpend.Accrued,
+				spend:       queueSpend.Spend,
+			}
+		}
+	}
+
+	hourlyCostByQueue := make(map[string]float64, len(s.budgetByQueue))
+	for pool, allocationByQueueAndPriorityClass := range allocationByPoolAndQueueAndPriorityClass {
+		for queue, allocation := range allocationByQueueAndPriorityClass {
+			if _, ok := s.budgetByQueue[queue]; !ok {
+				continue
+			}
+			hourlyCostByQueue[queue] += s.costModel.HourlyCost(pool, allocation.AggregateByResource().Resources)
+		}
+	}
+	periodStart := budgetPeriodStart(now)
+	for queue := range s.budgetByQueue {
+		spend, ok := s.spendByQueue[queue]
+		if !ok {
+			s.spendByQueue[queue] = &accruedSpend{periodStart: periodStart, accrued: now}
+			continue
+		}
+		if !periodStart.Equal(spend.periodStart) {
+			spend.periodStart = periodStart
+			spend.spend = 0
+			if spend.accrued.Before(periodStart) {
+				spend.accrued = periodStart
+			}
+		}
+		if hours := now.Sub(spend.accrued).Hours(); hours > 0 {
+			spend.spend += hourlyCostByQueue[queue] * hours
+			spend.accrued = now
+		}
+	}
+
+	if s.repository != nil {
+		stored := make([]database.QueueSpend, 0, len(s.budgetByQueue))
+		for queue := range s.budgetByQueue {
+			spend := s.spendByQueue[queue]
+			stored = append(stored, database.QueueSpend{
+				Queue:       queue,
+				PeriodStart: spend.periodStart,
+				Spend:       spend.spend,
+				Accrued:     spend.accrued,
+			})
+		}
+		return s.repository.StoreQueueSpend(ctx, stored)
+	}
+	return nil
+}
+
+// IsExhausted returns true if queue has a budget and has spent at least that much over the current budget period.
+func (s *queueSpend) IsExhausted(queue string) bool {
+	budget, ok := s.budgetByQueue[queue]
+	if !ok {
+		return false
+	}
+	spend, ok := s.spendByQueue[queue]
+	return ok && spend.spend >= budget
+}
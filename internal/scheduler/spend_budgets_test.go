@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+func TestQueueSpend(t *testing.T) {
+	costModel := configuration.CostModelConfig{HourlyPrices: map[string]float64{"cpu": 1}}
+	spend := newQueueSpend(costModel, map[string]float64{"A": 10}, nil)
+	allocation := map[string]map[string]schedulerobjects.QuantityByTAndResourceType[string]{
+		"pool": {
+			"A": schedulerobjects.QuantityByTAndResourceType[string]{
+				"priority-0": schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("2")}},
+				"priority-1": schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("2")}},
+			},
+			"B": schedulerobjects.QuantityByTAndResourceType[string]{
+				"priority-0": schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("100")}},
+			},
+		},
+	}
+	start := time.Date(2024, time.January, 31, 21, 0, 0, 0, time.UTC)
+
+	// The first call only marks the time from which spend is accrued.
+	require.NoError(t, spend.Accrue(armadacontext.Background(), start, allocation))
+	assert.False(t, spend.IsExhausted("A"))
+
+	// 4 cpu for 2 hours.
+	require.NoError(t, spend.Accrue(armadacontext.Background(), start.Add(2*time.Hour), allocation))
+	assert.Equal(t, 8.0, spend.spendByQueue["A"].spend)
+	assert.False(t, spend.IsExhausted("A"))
+
+	// Queues without a budget are never exhausted.
+	assert.False(t, spend.IsExhausted("B"))
+
+	// 4 cpu for another 30 minutes.
+	require.NoError(t, spend.Accrue(armadacontext.Background(), start.Add(150*time.Minute), allocation))
+	assert.True(t, spend.IsExhausted("A"))
+
+	// Spend is reset at the start of the month, counting only the time since.
+	require.NoError(t, spend.Accrue(armadacontext.Background(), start.Add(4*time.Hour), allocation))
+	assert.Equal(t, 4.0, spend.spendByQueue["A"].spend)
+	assert.False(t, spend.IsExhausted("A"))
+}
+
+func TestQueueSpend_RetainedAcrossRestarts(t *testing.T) {
+	costModel := configuration.CostModelConfig{HourlyPrices: map[string]float64{"cpu": 1}}
+	budgetByQueue := map[string]float64{"A": 10}
+	allocation := map[string]map[string]schedulerobjects.QuantityByTAndResourceType[string]{
+		"pool": {
+			"A": schedulerobjects.QuantityByTAndResourceType[string]{
+				"priority-0": schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("4")}},
+			},
+		},
+	}
+	start := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	ctx := armadacontext.Background()
+	repository := &inMemoryQueueSpendRepository{}
+
+	spend := newQueueSpend(costModel, budgetByQueue, repository)
+	require.NoError(t, spend.Accrue(ctx, start, allocation))
+	require.NoError(t, spend.Accrue(ctx, start.Add(time.Hour), allocation))
+	assert.Equal(t, 4.0, spend.spendByQueue["A"].spend)
+
+	// A new scheduler, e.g., after a restart or change of leader, continues from the stored spend,
+	// including the time since it was last accrued.
+	spend = newQueueSpend(costModel, budgetByQueue, repository)
+	require.NoError(t, spend.Accrue(ctx, start.Add(3*time.Hour), allocation))
+	assert.Equal(t, 12.0, spend.spendByQueue["A"].spend)
+	assert.True(t, spend.IsExhausted("A"))
+}
+
+type inMemoryQueueSpendRepository struct {
+	spend []database.QueueSpend
+}
+
+func (r *inMemoryQueueSpendRepository) GetQueueSpend(_ *armadacontext.Context) ([]database.QueueSpend, error) {
+	return r.spend, nil
+}
+
+func (r *inMemoryQueueSpendRepository) StoreQueueSpend(_ *armadacontext.Context, spend []database.QueueSpend) error {
+	r.spend = spend
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
@@ -56,7 +57,9 @@ type SubmitChecker struct {
 	mu                        sync.Mutex
 	schedulingKeyGenerator    *schedulerobjects.SchedulingKeyGenerator
 	jobSchedulingResultsCache *lru.Cache
-	ExecutorUpdateFrequency   time.Duration
+	// Results of CheckApiJobFeasibility by scheduling key.
+	feasibilityResultsCache *lru.Cache
+	ExecutorUpdateFrequency time.Duration
 }
 
 func NewSubmitChecker(
@@ -68,6 +71,10 @@ func NewSubmitChecker(
 	if err != nil {
 		panic(errors.WithStack(err))
 	}
+	feasibilityResultsCache, err := lru.New(maxJobSchedulingResults)
+	if err != nil {
+		panic(errors.WithStack(err))
+	}
 	return &SubmitChecker{
 		executorTimeout:           executorTimeout,
 		priorityClasses:           schedulingConfig.Preemption.PriorityClasses,
@@ -81,6 +88,7 @@ func NewSubmitChecker(
 		clock:                     clock.RealClock{},
 		schedulingKeyGenerator:    schedulerobjects.NewSchedulingKeyGenerator(),
 		jobSchedulingResultsCache: jobSchedulingResultsCache,
+		feasibilityResultsCache:   feasibilityResultsCache,
 		ExecutorUpdateFrequency:   schedulingConfig.ExecutorUpdateFrequency,
 	}
 }
@@ -132,6 +140,7 @@ func (srv *SubmitChecker) updateExecutors(ctx *armadacontext.Context) {
 	// Create a new schedulingKeyGenerator to get a new initial state.
 	srv.schedulingKeyGenerator = schedulerobjects.NewSchedulingKeyGenerator()
 	srv.jobSchedulingResultsCache.Purge()
+	srv.feasibilityResultsCache.Purge()
 }
 
 func (srv *SubmitChecker) CheckApiJobs(jobs []*api.Job) (bool, string) {
@@ -262,6 +271,96 @@ func (srv *SubmitChecker) getSchedulingResult(jctxs []*schedulercontext.JobSched
 	return schedulingResult{isSchedulable: isSchedulable, reason: sb.String()}
 }
 
+// CheckApiJobFeasibility returns false if job can't be scheduled onto any node of any executor even if all nodes were empty,
+// together with why, e.g., a taint isn't tolerated or the job requests more resources than the largest matching node has.
+// Unlike CheckApiJobs, it ignores the resources allocated to other jobs and whether the gang of job can be scheduled.
+// If no executors are known, it's not possible to tell and job is considered feasible.
+func (srv *SubmitChecker) CheckApiJobFeasibility(job *api.Job) (bool, string) {
+	jctx := schedulercontext.JobSchedulingContextsFromJobs(srv.priorityClasses, []*api.Job{job}, GangIdAndCardinalityFromAnnotations)[0]
+	schedulingKey, ok := jctx.Job.GetSchedulingKey()
+	if !ok {
+		srv.mu.Lock()
+		schedulingKey = interfaces.SchedulingKeyFromLegacySchedulerJob(srv.schedulingKeyGenerator, jctx.Job)
+		srv.mu.Unlock()
+	}
+	if obj, ok := srv.feasibilityResultsCache.Get(schedulingKey); ok {
+		result := obj.(schedulingResult)
+		return result.isSchedulable, result.reason
+	}
+	result := srv.getFeasibilityResult(jctx)
+	srv.feasibilityResultsCache.Add(schedulingKey, result)
+	return result.isSchedulable, result.reason
+}
+
+// getFeasibilityResult checks the static requirements of jctx, i.e., taints, node selectors, affinity, storage, and total resources,
+// against each node of each executor. If no node matches, the reason is a summary of why nodes were excluded.
+func (srv *SubmitChecker) getFeasibilityResult(jctx *schedulercontext.JobSchedulingContext) schedulingResult {
+	srv.mu.Lock()
+	executorById := maps.Clone(srv.executorById)
+	srv.mu.Unlock()
+	executorById = srv.filterStaleExecutors(executorById)
+	if len(executorById) == 0 {
+		return schedulingResult{isSchedulable: true}
+	}
+
+	numExcludedNodesByReason := make(map[string]int)
+	// Insufficient resources are reported by resource, with the most available on any node otherwise matching.
+	insufficientByResourceName := make(map[string]*nodedb.InsufficientResources)
+	numInsufficientByResourceName := make(map[string]int)
+	for _, executor := range executorById {
+		it, err := nodedb.NewNodesIterator(executor.nodeDb.Txn(false))
+		if err != nil {
+			return schedulingResult{isSchedulable: true}
+		}
+		for node := it.NextNode(); node != nil; node = it.NextNode() {
+			matches, reason, err := nodedb.StaticJobRequirementsMet(node.Taints, node.Labels, node.StorageClasses, node.TotalResources, jctx)
+			if err != nil {
+				return schedulingResult{isSchedulable: false, reason: err.Error()}
+			}
+			if matches {
+				return schedulingResult{isSchedulable: true}
+			}
+			if insufficient, ok := reason.(*nodedb.InsufficientResources); ok {
+				numInsufficientByResourceName[insufficient.ResourceName]++
+				if previous, ok := insufficientByResourceName[insufficient.ResourceName]; !ok || insufficient.Available.Cmp(previous.Available) == 1 {
+					insufficientByResourceName[insufficient.ResourceName] = insufficient
+				}
+			} else if reason != nil {
+				numExcludedNodesByReason[reason.String()]++
+			} else {
+				numExcludedNodesByReason[nodedb.PodRequirementsNotMetReasonUnknown]++
+			}
+		}
+	}
+	for resourceName, insufficient := range insufficientByResourceName {
+		s := fmt.Sprintf(
+			"pod requires %s %s, but at most %s is available on any node",
+			insufficient.Required.String(), resourceName, insufficient.Available.String(),
+		)
+		numExcludedNodesByReason[s] += numInsufficientByResourceName[resourceName]
+	}
+	if len(numExcludedNodesByReason) == 0 {
+		return schedulingResult{isSchedulable: false, reason: "no nodes available"}
+	}
+
+	reasons := maps.Keys(numExcludedNodesByReason)
+	slices.SortFunc(reasons, func(a, b string) bool {
+		if numExcludedNodesByReason[a] != numExcludedNodesByReason[b] {
+			return numExcludedNodesByReason[a] > numExcludedNodesByReason[b]
+		}
+		return a < b
+	})
+	var sb strings.Builder
+	sb.WriteString("no node matches the job: ")
+	for i, reason := range reasons {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(fmt.Sprintf("%s (%d nodes)", reason, numExcludedNodesByReason[reason]))
+	}
+	return schedulingResult{isSchedulable: false, reason: sb.String()}
+}
+
 func (srv *SubmitChecker) filterStaleExecutors(executorsById map[string]minimalExecutor) map[string]minimalExecutor {
 	rv := make(map[string]minimalExecutor)
 	for id, executor := range executorsById {
@@ -5,12 +5,12 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 	schedulermocks "github.com/armadaproject/armada/internal/scheduler/mocks"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
@@ -83,7 +83,7 @@ func TestSubmitChecker_CheckJobDbJobs(t *testing.T) {
 			if !tc.expectPass {
 				assert.NotEqual(t, "", reason)
 			}
-			logrus.Info(reason)
+			logging.Info(reason)
 		})
 	}
 }
@@ -188,7 +188,63 @@ func TestSubmitChecker_TestCheckApiJobs(t *testing.T) {
 			if !tc.expectPass {
 				assert.NotEqual(t, "", msg)
 			}
-			logrus.Info(msg)
+			logging.Info(msg)
+		})
+	}
+}
+
+func TestSubmitChecker_CheckApiJobFeasibility(t *testing.T) {
+	defaultTimeout := 15 * time.Minute
+	testfixtures.BaseTime = time.Now().UTC()
+	expiredTime := testfixtures.BaseTime.Add(-defaultTimeout).Add(-1 * time.Second)
+
+	tests := map[string]struct {
+		executors      []*schedulerobjects.Executor
+		job            *api.Job
+		expectFeasible bool
+		expectedReason string
+	}{
+		"job fits": {
+			executors:      []*schedulerobjects.Executor{testfixtures.TestExecutor(testfixtures.BaseTime)},
+			job:            testfixtures.Test1CoreCpuApiJob(),
+			expectFeasible: true,
+		},
+		"job larger than any node": {
+			executors:      []*schedulerobjects.Executor{testfixtures.TestExecutor(testfixtures.BaseTime)},
+			job:            testfixtures.Test100CoreCpuApiJob(),
+			expectFeasible: false,
+			expectedReason: "no node matches the job: pod requires 100 cpu, but at most 9 is available on any node (3 nodes)",
+		},
+		"job selects missing label": {
+			executors:      []*schedulerobjects.Executor{testfixtures.TestExecutor(testfixtures.BaseTime)},
+			job:            testfixtures.Test1CoreCpuApiJobWithNodeSelector(map[string]string{"foo": "bar"}),
+			expectFeasible: false,
+			expectedReason: "no node matches the job: node does not match pod NodeSelector: label foo not set (3 nodes)",
+		},
+		"only expired executors": {
+			executors:      []*schedulerobjects.Executor{testfixtures.TestExecutor(expiredTime)},
+			job:            testfixtures.Test100CoreCpuApiJob(),
+			expectFeasible: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+			defer cancel()
+
+			ctrl := gomock.NewController(t)
+			mockExecutorRepo := schedulermocks.NewMockExecutorRepository(ctrl)
+			mockExecutorRepo.EXPECT().GetExecutors(ctx).Return(tc.executors, nil).AnyTimes()
+			submitCheck := NewSubmitChecker(defaultTimeout, testfixtures.TestSchedulingConfig(), mockExecutorRepo)
+			submitCheck.clock = clock.NewFakeClock(testfixtures.BaseTime)
+			submitCheck.updateExecutors(ctx)
+
+			// Check twice to exercise the cache.
+			for i := 0; i < 2; i++ {
+				feasible, reason := submitCheck.CheckApiJobFeasibility(tc.job)
+				assert.Equal(t, tc.expectFeasible, feasible)
+				assert.Equal(t, tc.expectedReason, reason)
+			}
 		})
 	}
 }
@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/scheduler/database"
+)
+
+// JobSetSuspensions tracks the job sets that have been suspended via SuspendJobSet.
+// Queued jobs of suspended job sets aren't scheduled until the job set is resumed.
+//
+// Suspended job sets are read from the database at the start of each scheduling round,
+// since there are typically few of them.
+type JobSetSuspensions struct {
+	jobRepository database.JobRepository
+	// Suspended job sets of each queue.
+	suspendedByQueue map[string]map[string]bool
+}
+
+func NewJobSetSuspensions(jobRepository database.JobRepository) *JobSetSuspensions {
+	return &JobSetSuspensions{
+		jobRepository:    jobRepository,
+		suspendedByQueue: make(map[string]map[string]bool),
+	}
+}
+
+// Refresh reads the job sets that are currently suspended from the database.
+func (s *JobSetSuspensions) Refresh(ctx *armadacontext.Context) error {
+	if s == nil {
+		return nil
+	}
+	suspensions, err := s.jobRepository.FetchJobSetSuspensions(ctx)
+	if err != nil {
+		return err
+	}
+	suspendedByQueue := make(map[string]map[string]bool)
+	for _, suspension := range suspensions {
+		if suspendedByQueue[suspension.Queue] == nil {
+			suspendedByQueue[suspension.Queue] = make(map[string]bool)
+		}
+		suspendedByQueue[suspension.Queue][suspension.JobSet] = true
+	}
+	s.suspendedByQueue = suspendedByQueue
+	return nil
+}
+
+// IsSuspended returns true if the provided job set has been suspended.
+func (s *JobSetSuspensions) IsSuspended(queue string, jobSet string) bool {
+	if s == nil {
+		return false
+	}
+	return s.suspendedByQueue[queue][jobSet]
+}
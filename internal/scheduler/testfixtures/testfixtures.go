@@ -4,6 +4,7 @@ package testfixtures
 import (
 	"fmt"
 	"math"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -130,11 +131,27 @@ func WithMaxUnacknowledgedJobsPerExecutorConfig(v uint, config configuration.Sch
 	return config
 }
 
+func WithOvercommitFactorsConfig(factors map[string]float64, config configuration.SchedulingConfig) configuration.SchedulingConfig {
+	config.OvercommitFactors = factors
+	return config
+}
+
 func WithProtectedFractionOfFairShareConfig(v float64, config configuration.SchedulingConfig) configuration.SchedulingConfig {
 	config.Preemption.ProtectedFractionOfFairShare = v
 	return config
 }
 
+func WithMinimumRuntimeBeforeFairSharePreemptionConfig(d time.Duration, config configuration.SchedulingConfig) configuration.SchedulingConfig {
+	config.Preemption.MinimumRuntimeBeforeFairSharePreemption = d
+	return config
+}
+
+func WithNodeDrainConfig(nodeMaintenanceLabel string, maxJobsToDrainPerRound uint, config configuration.SchedulingConfig) configuration.SchedulingConfig {
+	config.NodeMaintenanceLabel = nodeMaintenanceLabel
+	config.MaxJobsToDrainPerRound = maxJobsToDrainPerRound
+	return config
+}
+
 func WithDominantResourceFairnessConfig(config configuration.SchedulingConfig) configuration.SchedulingConfig {
 	config.FairnessModel = configuration.DominantResourceFairness
 	return config
@@ -258,6 +275,25 @@ func WithPriorityJobs(priority uint32, jobs []*jobdb.Job) []*jobdb.Job {
 	return jobs
 }
 
+func WithOwnerJobs(owner string, jobs []*jobdb.Job) []*jobdb.Job {
+	for i, job := range jobs {
+		jobs[i] = job.WithOwner(owner)
+	}
+	return jobs
+}
+
+func WithUserFairShareConfig(lookahead uint, config configuration.SchedulingConfig) configuration.SchedulingConfig {
+	config.EnableUserFairShare = true
+	config.UserFairShareLookahead = lookahead
+	return config
+}
+
+func WithMonthlyBudgetsConfig(budgetByQueue map[string]float64, exemptPriorityClasses []string, config configuration.SchedulingConfig) configuration.SchedulingConfig {
+	config.MonthlyBudgetByQueue = budgetByQueue
+	config.BudgetExemptPriorityClasses = exemptPriorityClasses
+	return config
+}
+
 func WithNodeUniformityLabelAnnotationJobs(label string, jobs []*jobdb.Job) []*jobdb.Job {
 	for _, job := range jobs {
 		req := job.PodRequirements()
@@ -360,13 +396,15 @@ func WithGangAnnotationsAndMinCardinalityJobs(minimumCardinality int, jobs []*jo
 }
 
 func WithAnnotationsJobs(annotations map[string]string, jobs []*jobdb.Job) []*jobdb.Job {
-	for _, job := range jobs {
+	for i, job := range jobs {
 		for _, req := range job.JobSchedulingInfo().GetObjectRequirements() {
 			if req.GetPodRequirements().Annotations == nil {
 				req.GetPodRequirements().Annotations = make(map[string]string)
 			}
 			maps.Copy(req.GetPodRequirements().Annotations, annotations)
 		}
+		// Re-derive fields parsed from annotations on job creation.
+		jobs[i] = job.WithJobSchedulingInfo(job.JobSchedulingInfo())
 	}
 	return jobs
 }
@@ -756,6 +794,27 @@ func WithLastUpdateTimeExecutor(lastUpdateTime time.Time, executor *schedulerobj
 	return executor
 }
 
+func WithPoolExecutor(pool string, executor *schedulerobjects.Executor) *schedulerobjects.Executor {
+	executor.Pool = pool
+	return executor
+}
+
+func WithPoolPreferencesJobs(poolPreferences string, jobs []*jobdb.Job) []*jobdb.Job {
+	return WithAnnotationsJobs(map[string]string{configuration.PoolPreferencesAnnotation: poolPreferences}, jobs)
+}
+
+func WithJobSetMaxRunningJobsJobs(maxRunningJobs int, jobs []*jobdb.Job) []*jobdb.Job {
+	return WithAnnotationsJobs(map[string]string{configuration.JobSetMaxRunningJobsAnnotation: fmt.Sprintf("%d", maxRunningJobs)}, jobs)
+}
+
+func WithDependenciesJobs(dependencies []*jobdb.Job, jobs []*jobdb.Job) []*jobdb.Job {
+	jobIds := make([]string, len(dependencies))
+	for i, job := range dependencies {
+		jobIds[i] = job.Id()
+	}
+	return WithAnnotationsJobs(map[string]string{configuration.JobDependenciesAnnotation: strings.Join(jobIds, ",")}, jobs)
+}
+
 func Test1Node32CoreExecutor(executorId string) *schedulerobjects.Executor {
 	node := Test32CpuNode(TestPriorities)
 	node.Name = fmt.Sprintf("%s-node", executorId)
@@ -0,0 +1,115 @@
+package scheduler
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// UnschedulableJobReporter generates JobUnschedulable events for queued jobs the scheduler failed to schedule,
+// such that users can see why their jobs are pending.
+//
+// To limit the number of events, one is only generated for a job if the reasons it couldn't be scheduled differ from
+// those last reported for it; the number of nodes excluded for each reason changes too frequently to be considered.
+type UnschedulableJobReporter struct {
+	// Summary of the reasons last reported for each job that's still queued.
+	reportedByJobId map[string]string
+}
+
+func NewUnschedulableJobReporter() *UnschedulableJobReporter {
+	return &UnschedulableJobReporter{
+		reportedByJobId: make(map[string]string),
+	}
+}
+
+// Reset forgets what has been reported, such that reasons are reported again for all jobs,
+// e.g., since another scheduler may have reported different reasons while this one wasn't leader.
+func (r *UnschedulableJobReporter) Reset() {
+	r.reportedByJobId = make(map[string]string)
+}
+
+// AppendEventSequences appends JobUnschedulable events for the jobs that could not be scheduled in result,
+// and whose reasons differ from those last reported.
+func (r *UnschedulableJobReporter) AppendEventSequences(
+	eventSequences []*armadaevents.EventSequence,
+	result *SchedulerResult,
+	txn *jobdb.Txn,
+	time time.Time,
+) ([]*armadaevents.EventSequence, error) {
+	// Jobs no longer queued have been scheduled or have finished, so any reason reported for them is stale.
+	for jobId := range r.reportedByJobId {
+		if job := txn.GetById(jobId); job == nil || !job.Queued() {
+			delete(r.reportedByJobId, jobId)
+		}
+	}
+
+	// Jobs may be attempted in several pools; the reason given by the last pool tried is reported.
+	jctxByJobId := make(map[string]*schedulercontext.JobSchedulingContext)
+	poolByJobId := make(map[string]string)
+	for _, sctx := range result.SchedulingContexts {
+		for _, qctx := range sctx.QueueSchedulingContexts {
+			for jobId, jctx := range qctx.UnsuccessfulJobSchedulingContexts {
+				if jctx.IsEvicted {
+					continue
+				}
+				jctxByJobId[jobId] = jctx
+				poolByJobId[jobId] = sctx.Pool
+			}
+		}
+	}
+
+	jobIds := maps.Keys(jctxByJobId)
+	slices.Sort(jobIds)
+	for _, jobId := range jobIds {
+		job := txn.GetById(jobId)
+		if job == nil || !job.Queued() {
+			continue
+		}
+		jctx := jctxByJobId[jobId]
+		event := &armadaevents.JobUnschedulable{
+			Reason: jctx.UnschedulableReason,
+			Pool:   poolByJobId[jobId],
+		}
+		if pctx := jctx.PodSchedulingContext; pctx != nil {
+			event.NumNodes = uint32(pctx.NumNodes)
+			event.NumExcludedNodesByReason = make(map[string]uint32, len(pctx.NumExcludedNodesByReason))
+			for reason, n := range pctx.NumExcludedNodesByReason {
+				event.NumExcludedNodesByReason[reason] = uint32(n)
+			}
+		}
+		summary := unschedulableSummary(event)
+		if r.reportedByJobId[jobId] == summary {
+			continue
+		}
+		protoJobId, err := armadaevents.ProtoUuidFromUlidString(jobId)
+		if err != nil {
+			return nil, err
+		}
+		event.JobId = protoJobId
+		eventSequences = append(eventSequences, &armadaevents.EventSequence{
+			Queue:      job.Queue(),
+			JobSetName: job.Jobset(),
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: &time,
+					Event:   &armadaevents.EventSequence_Event_JobUnschedulable{JobUnschedulable: event},
+				},
+			},
+		})
+		r.reportedByJobId[jobId] = summary
+	}
+	return eventSequences, nil
+}
+
+// unschedulableSummary returns a string that's equal for two events if and only if they give the same reasons.
+func unschedulableSummary(event *armadaevents.JobUnschedulable) string {
+	excludedReasons := maps.Keys(event.NumExcludedNodesByReason)
+	slices.Sort(excludedReasons)
+	return event.Pool + "\n" + event.Reason + "\n" + strings.Join(excludedReasons, "\n")
+}
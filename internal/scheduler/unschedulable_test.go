@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestUnschedulableJobReporter(t *testing.T) {
+	now := time.Now()
+	resultWithReason := func(reason string, numExcludedNodesByReason map[string]int) *SchedulerResult {
+		return &SchedulerResult{
+			SchedulingContexts: []*schedulercontext.SchedulingContext{{
+				Pool: "pool",
+				QueueSchedulingContexts: map[string]*schedulercontext.QueueSchedulingContext{
+					queuedJob.Queue(): {
+						UnsuccessfulJobSchedulingContexts: map[string]*schedulercontext.JobSchedulingContext{
+							queuedJob.Id(): {
+								JobId:               queuedJob.Id(),
+								UnschedulableReason: reason,
+								PodSchedulingContext: &schedulercontext.PodSchedulingContext{
+									NumNodes:                 10,
+									NumExcludedNodesByReason: numExcludedNodesByReason,
+								},
+							},
+						},
+					},
+				},
+			}},
+		}
+	}
+	reportedReasons := func(eventSequences []*armadaevents.EventSequence) []string {
+		var reasons []string
+		for _, sequence := range eventSequences {
+			for _, event := range sequence.Events {
+				reasons = append(reasons, event.GetJobUnschedulable().Reason)
+			}
+		}
+		return reasons
+	}
+
+	jobDb := testfixtures.NewJobDb()
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*jobdb.Job{queuedJob}))
+	reporter := NewUnschedulableJobReporter()
+
+	eventSequences, err := reporter.AppendEventSequences(nil, resultWithReason("job does not fit on any node", map[string]int{"insufficient cpu": 10}), txn, now)
+	require.NoError(t, err)
+	require.Len(t, eventSequences, 1)
+	assert.Equal(t, queuedJob.Queue(), eventSequences[0].Queue)
+	assert.Equal(t, queuedJob.Jobset(), eventSequences[0].JobSetName)
+	expectedJobId, err := armadaevents.ProtoUuidFromUlidString(queuedJob.Id())
+	require.NoError(t, err)
+	assert.Equal(t, &armadaevents.JobUnschedulable{
+		JobId:                    expectedJobId,
+		Reason:                   "job does not fit on any node",
+		Pool:                     "pool",
+		NumNodes:                 10,
+		NumExcludedNodesByReason: map[string]uint32{"insufficient cpu": 10},
+	}, eventSequences[0].Events[0].GetJobUnschedulable())
+
+	// Changes in the number of nodes excluded aren't reported, but changes in the reasons are
+	eventSequences, err = reporter.AppendEventSequences(nil, resultWithReason("job does not fit on any node", map[string]int{"insufficient cpu": 9}), txn, now)
+	require.NoError(t, err)
+	assert.Empty(t, eventSequences)
+	eventSequences, err = reporter.AppendEventSequences(nil, resultWithReason("job does not fit on any node", map[string]int{"insufficient memory": 9}), txn, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"job does not fit on any node"}, reportedReasons(eventSequences))
+	eventSequences, err = reporter.AppendEventSequences(nil, resultWithReason("job set suspended", nil), txn, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"job set suspended"}, reportedReasons(eventSequences))
+
+	// Once reset, the last reason is reported again
+	reporter.Reset()
+	eventSequences, err = reporter.AppendEventSequences(nil, resultWithReason("job set suspended", nil), txn, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"job set suspended"}, reportedReasons(eventSequences))
+
+	// Nothing is reported for jobs no longer queued, and they're forgotten such that they're reported again if requeued
+	require.NoError(t, txn.Upsert([]*jobdb.Job{queuedJob.WithQueued(false)}))
+	eventSequences, err = reporter.AppendEventSequences(nil, resultWithReason("job set suspended", nil), txn, now)
+	require.NoError(t, err)
+	assert.Empty(t, eventSequences)
+	require.NoError(t, txn.Upsert([]*jobdb.Job{queuedJob}))
+	eventSequences, err = reporter.AppendEventSequences(nil, resultWithReason("job set suspended", nil), txn, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"job set suspended"}, reportedReasons(eventSequences))
+}
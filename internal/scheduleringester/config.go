@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
 	"github.com/armadaproject/armada/internal/common/types"
 )
 
@@ -28,4 +29,6 @@ type Configuration struct {
 	PulsarBackoffTime time.Duration
 	// If non-nil, net/http/pprof endpoints are exposed on localhost on this port.
 	PprofPort *uint16
+	// Configuration of the diagnostics server, which exposes pprof, a goroutine dump, and the ingestion lag.
+	Diagnostics profilingconfig.DiagnosticsConfig
 }
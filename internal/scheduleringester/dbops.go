@@ -1,6 +1,8 @@
 package scheduleringester
 
 import (
+	"time"
+
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/google/uuid"
 	"golang.org/x/exp/maps"
@@ -34,6 +36,13 @@ type JobSetCancelAction struct {
 	cancelLeased bool
 }
 
+// JobSetSuspension is the suspension state a job set is set to; a job set that isn't suspended has been resumed.
+type JobSetSuspension struct {
+	suspended bool
+	reason    string
+	time      time.Time
+}
+
 type JobSetKey struct {
 	queue  string
 	jobSet string
@@ -118,6 +127,7 @@ type (
 	InsertRuns                 map[uuid.UUID]*JobRunDetails
 	UpdateJobSetPriorities     map[JobSetKey]int64
 	MarkJobSetsCancelRequested map[JobSetKey]*JobSetCancelAction
+	UpdateJobSetSuspensions    map[JobSetKey]*JobSetSuspension
 	MarkJobsCancelRequested    map[string]bool
 	MarkJobsCancelled          map[string]bool
 	MarkJobsSucceeded          map[string]bool
@@ -127,7 +137,7 @@ type (
 	UpdateJobQueuedState       map[string]*JobQueuedStateUpdate
 	MarkRunsSucceeded          map[uuid.UUID]bool
 	MarkRunsFailed             map[uuid.UUID]*JobRunFailed
-	MarkRunsRunning            map[uuid.UUID]bool
+	MarkRunsRunning            map[uuid.UUID]time.Time
 	InsertJobRunErrors         map[uuid.UUID]*schedulerdb.JobRunError
 	InsertPartitionMarker      struct {
 		markers []*schedulerdb.Marker
@@ -164,6 +174,10 @@ func (a MarkJobSetsCancelRequested) Merge(b DbOperation) bool {
 	return mergeInMap(a, b)
 }
 
+func (a UpdateJobSetSuspensions) Merge(b DbOperation) bool {
+	return mergeInMap(a, b)
+}
+
 func (a MarkJobsCancelRequested) Merge(b DbOperation) bool {
 	return mergeInMap(a, b)
 }
@@ -302,6 +316,12 @@ func (a MarkJobSetsCancelRequested) CanBeAppliedBefore(b DbOperation) bool {
 	return !definesJobInSet(a, b) && !definesRunInSet(a, b)
 }
 
+func (a UpdateJobSetSuspensions) CanBeAppliedBefore(b DbOperation) bool {
+	// Suspensions are stored separately from jobs and runs, so only the order of suspensions matters.
+	_, isUpdateJobSetSuspensions := b.(UpdateJobSetSuspensions)
+	return !isUpdateJobSetSuspensions
+}
+
 func (a MarkJobsCancelRequested) CanBeAppliedBefore(b DbOperation) bool {
 	return !definesJob(a, b) && !definesRunForJob(a, b)
 }
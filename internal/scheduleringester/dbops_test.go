@@ -3,6 +3,7 @@ package scheduleringester
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -155,6 +156,13 @@ func TestDbOperationOptimisation(t *testing.T) {
 			MarkJobsCancelRequested{jobIds[1]: true},                                                                                                 // 4
 			MarkJobsCancelRequested{jobIds[2]: true},                                                                                                 // 4
 		}},
+		"UpdateJobSetSuspensions": {N: 2, Ops: []DbOperation{
+			InsertJobs{jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1"}},              // 1
+			UpdateJobSetSuspensions{JobSetKey{queue: testQueueName, jobSet: "set1"}: &JobSetSuspension{suspended: true}}, // 2
+			InsertJobs{jobIds[1]: &schedulerdb.Job{JobID: jobIds[1], Queue: testQueueName, JobSet: "set1"}},              // 1
+			UpdateJobSetSuspensions{JobSetKey{queue: testQueueName, jobSet: "set1"}: &JobSetSuspension{}},                // 2
+			InsertJobs{jobIds[2]: &schedulerdb.Job{JobID: jobIds[2], Queue: testQueueName, JobSet: "set1"}},              // 1
+		}},
 		"MarkJobsSucceeded": {N: 2, Ops: []DbOperation{
 			InsertJobs{jobIds[0]: &schedulerdb.Job{JobID: jobIds[0]}}, // 1
 			MarkJobsSucceeded{jobIds[0]: true},                        // 2
@@ -197,10 +205,10 @@ func TestDbOperationOptimisation(t *testing.T) {
 		"MarkRunsRunning": {N: 3, Ops: []DbOperation{
 			InsertJobs{jobIds[0]: &schedulerdb.Job{JobID: jobIds[0]}},                                                                // 1
 			InsertRuns{runIds[0]: &JobRunDetails{queue: testQueueName, dbRun: &schedulerdb.Run{JobID: jobIds[0], RunID: runIds[0]}}}, // 2
-			MarkRunsRunning{runIds[0]: true},                          // 3
+			MarkRunsRunning{runIds[0]: time.Time{}},                   // 3
 			InsertJobs{jobIds[1]: &schedulerdb.Job{JobID: jobIds[1]}}, // 3
 			InsertRuns{runIds[1]: &JobRunDetails{queue: testQueueName, dbRun: &schedulerdb.Run{JobID: jobIds[0], RunID: runIds[1]}}}, // 3
-			MarkRunsRunning{runIds[1]: true},                          // 3
+			MarkRunsRunning{runIds[1]: time.Time{}},                   // 3
 			InsertJobs{jobIds[2]: &schedulerdb.Job{JobID: jobIds[2]}}, // 3
 		}},
 		"InsertPartitionMarker": {N: 2, Ops: []DbOperation{
@@ -5,7 +5,6 @@ import (
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/app"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -58,7 +57,19 @@ func Run(config Configuration) {
 		config.Metrics,
 		svcMetrics,
 	)
-	if err := ingester.Run(app.CreateContextWithShutdown()); err != nil {
+	ctx := armadacontext.New(app.CreateContextWithShutdown(), logging.ForModule("scheduleringester"))
+
+	// Expose diagnostics if enabled.
+	diagnosticsServer := profiling.NewDiagnosticsServer(config.Diagnostics)
+	diagnosticsServer.SetConfig(config)
+	diagnosticsServer.AddState("ingestion", ingester.DiagnosticsState)
+	go func() {
+		if err := diagnosticsServer.Run(ctx); err != nil {
+			logging.WithStacktrace(ctx, err).Error("diagnostics server failure")
+		}
+	}()
+
+	if err := ingester.Run(ctx); err != nil {
 		panic(errors.WithMessage(err, "Error running ingestion pipeline"))
 	}
 }
@@ -1,18 +1,23 @@
 package scheduleringester
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
+	v1 "k8s.io/api/core/v1"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/ingest"
 	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/logging"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	"github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/scheduler/adapters"
@@ -21,6 +26,8 @@ import (
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
+var log = logging.ForModule("scheduleringester")
+
 type eventSequenceCommon struct {
 	queue  string
 	jobset string
@@ -78,9 +85,9 @@ func (c *InstructionConverter) dbOperationsFromEventSequence(es *armadaevents.Ev
 		case *armadaevents.EventSequence_Event_SubmitJob:
 			operationsFromEvent, err = c.handleSubmitJob(event.GetSubmitJob(), eventTime, meta)
 		case *armadaevents.EventSequence_Event_JobRunLeased:
-			operationsFromEvent, err = c.handleJobRunLeased(event.GetJobRunLeased(), meta)
+			operationsFromEvent, err = c.handleJobRunLeased(event.GetJobRunLeased(), eventTime, meta)
 		case *armadaevents.EventSequence_Event_JobRunRunning:
-			operationsFromEvent, err = c.handleJobRunRunning(event.GetJobRunRunning())
+			operationsFromEvent, err = c.handleJobRunRunning(event.GetJobRunRunning(), eventTime)
 		case *armadaevents.EventSequence_Event_JobRunSucceeded:
 			operationsFromEvent, err = c.handleJobRunSucceeded(event.GetJobRunSucceeded())
 		case *armadaevents.EventSequence_Event_JobRunErrors:
@@ -99,6 +106,10 @@ func (c *InstructionConverter) dbOperationsFromEventSequence(es *armadaevents.Ev
 			operationsFromEvent, err = c.handleCancelJobSet(event.GetCancelJobSet(), meta)
 		case *armadaevents.EventSequence_Event_CancelledJob:
 			operationsFromEvent, err = c.handleCancelledJob(event.GetCancelledJob())
+		case *armadaevents.EventSequence_Event_SuspendJobSet:
+			operationsFromEvent, err = c.handleSuspendJobSet(event.GetSuspendJobSet(), eventTime, meta)
+		case *armadaevents.EventSequence_Event_ResumeJobSet:
+			operationsFromEvent, err = c.handleResumeJobSet(meta)
 		case *armadaevents.EventSequence_Event_JobRequeued:
 			operationsFromEvent, err = c.handleJobRequeued(event.GetJobRequeued())
 		case *armadaevents.EventSequence_Event_PartitionMarker:
@@ -108,7 +119,9 @@ func (c *InstructionConverter) dbOperationsFromEventSequence(es *armadaevents.Ev
 			*armadaevents.EventSequence_Event_ResourceUtilisation,
 			*armadaevents.EventSequence_Event_StandaloneIngressInfo,
 			*armadaevents.EventSequence_Event_JobRunPreempted,
-			*armadaevents.EventSequence_Event_JobRunAssigned:
+			*armadaevents.EventSequence_Event_JobRunAssigned,
+			*armadaevents.EventSequence_Event_JobUnschedulable,
+			*armadaevents.EventSequence_Event_JobRunArtifacts:
 			// These events can all be safely ignored
 			log.Debugf("Ignoring event type %T", event)
 		default:
@@ -131,7 +144,7 @@ func (c *InstructionConverter) handleSubmitJob(job *armadaevents.SubmitJob, subm
 		return nil, err
 	}
 	if job.IsDuplicate {
-		log.Debugf("job %s is a duplicate, ignoring", jobId)
+		log.WithField(logging.JobIdField, jobId).Debug("job is a duplicate, ignoring")
 		return nil, nil
 	}
 
@@ -177,7 +190,7 @@ func (c *InstructionConverter) handleSubmitJob(job *armadaevents.SubmitJob, subm
 	}}}, nil
 }
 
-func (c *InstructionConverter) handleJobRunLeased(jobRunLeased *armadaevents.JobRunLeased, meta eventSequenceCommon) ([]DbOperation, error) {
+func (c *InstructionConverter) handleJobRunLeased(jobRunLeased *armadaevents.JobRunLeased, leaseTime time.Time, meta eventSequenceCommon) ([]DbOperation, error) {
 	runId := armadaevents.UuidFromProtoUuid(jobRunLeased.GetRunId())
 	jobId, err := armadaevents.UlidStringFromProtoUuid(jobRunLeased.GetJobId())
 	if err != nil {
@@ -187,11 +200,12 @@ func (c *InstructionConverter) handleJobRunLeased(jobRunLeased *armadaevents.Job
 		InsertRuns{runId: &JobRunDetails{
 			queue: meta.queue,
 			dbRun: &schedulerdb.Run{
-				RunID:    runId,
-				JobID:    jobId,
-				JobSet:   meta.jobset,
-				Executor: jobRunLeased.GetExecutorId(),
-				Node:     jobRunLeased.GetNodeId(),
+				RunID:           runId,
+				JobID:           jobId,
+				JobSet:          meta.jobset,
+				Executor:        jobRunLeased.GetExecutorId(),
+				Node:            jobRunLeased.GetNodeId(),
+				LeasedTimestamp: &leaseTime,
 			},
 		}},
 		UpdateJobQueuedState{jobId: &JobQueuedStateUpdate{
@@ -222,9 +236,9 @@ func (c *InstructionConverter) handleJobRequeued(jobRequeued *armadaevents.JobRe
 	}, nil
 }
 
-func (c *InstructionConverter) handleJobRunRunning(jobRunRunning *armadaevents.JobRunRunning) ([]DbOperation, error) {
+func (c *InstructionConverter) handleJobRunRunning(jobRunRunning *armadaevents.JobRunRunning, runningTime time.Time) ([]DbOperation, error) {
 	runId := armadaevents.UuidFromProtoUuid(jobRunRunning.GetRunId())
-	return []DbOperation{MarkRunsRunning{runId: true}}, nil
+	return []DbOperation{MarkRunsRunning{runId: runningTime}}, nil
 }
 
 func (c *InstructionConverter) handleJobRunSucceeded(jobRunSucceeded *armadaevents.JobRunSucceeded) ([]DbOperation, error) {
@@ -333,6 +347,28 @@ func (c *InstructionConverter) handleCancelJobSet(cancelJobSet *armadaevents.Can
 	}}, nil
 }
 
+func (c *InstructionConverter) handleSuspendJobSet(suspendJobSet *armadaevents.SuspendJobSet, suspendTime time.Time, meta eventSequenceCommon) ([]DbOperation, error) {
+	return []DbOperation{UpdateJobSetSuspensions{
+		JobSetKey{
+			queue:  meta.queue,
+			jobSet: meta.jobset,
+		}: &JobSetSuspension{
+			suspended: true,
+			reason:    suspendJobSet.Reason,
+			time:      suspendTime,
+		},
+	}}, nil
+}
+
+func (c *InstructionConverter) handleResumeJobSet(meta eventSequenceCommon) ([]DbOperation, error) {
+	return []DbOperation{UpdateJobSetSuspensions{
+		JobSetKey{
+			queue:  meta.queue,
+			jobSet: meta.jobset,
+		}: &JobSetSuspension{},
+	}}, nil
+}
+
 func (c *InstructionConverter) handleCancelledJob(cancelledJob *armadaevents.CancelledJob) ([]DbOperation, error) {
 	jobId, err := armadaevents.UlidStringFromProtoUuid(cancelledJob.GetJobId())
 	if err != nil {
@@ -389,6 +425,10 @@ func SchedulingInfoFromSubmitJob(submitJob *armadaevents.SubmitJob, submitTime t
 			}
 			maps.Copy(podRequirements.Annotations, submitJob.MainObject.ObjectMeta.Annotations)
 		}
+		if podRequirements.Annotations == nil {
+			podRequirements.Annotations = make(map[string]string, 1)
+		}
+		podRequirements.Annotations[configuration.PodSpecHashAnnotation] = PodSpecHash(podSpec)
 		schedulingInfo.ObjectRequirements = append(
 			schedulingInfo.ObjectRequirements,
 			&schedulerobjects.ObjectRequirements{
@@ -402,3 +442,19 @@ func SchedulingInfoFromSubmitJob(submitJob *armadaevents.SubmitJob, submitTime t
 	}
 	return schedulingInfo, nil
 }
+
+// PodSpecHash returns a hash of the images, commands, arguments, and environment of the containers of podSpec,
+// used to tell apart jobs with equal scheduling requirements that run different workloads.
+func PodSpecHash(podSpec *v1.PodSpec) string {
+	h := sha1.New()
+	for _, containers := range [][]v1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for _, container := range containers {
+			fmt.Fprintf(h, "%q %q %q\n", container.Image, container.Command, container.Args)
+			for _, env := range container.Env {
+				fmt.Fprintf(h, "%q=%q\n", env.Name, env.Value)
+			}
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -9,6 +9,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/ingest/metrics"
 	f "github.com/armadaproject/armada/internal/common/ingest/testfixtures"
@@ -53,11 +54,12 @@ func TestConvertSequence(t *testing.T) {
 			events: []*armadaevents.EventSequence_Event{f.Leased},
 			expected: []DbOperation{
 				InsertRuns{f.RunIdUuid: &JobRunDetails{queue: f.Queue, dbRun: &schedulerdb.Run{
-					RunID:    f.RunIdUuid,
-					JobID:    f.JobIdString,
-					JobSet:   f.JobSetName,
-					Executor: f.ExecutorId,
-					Node:     f.NodeName,
+					RunID:           f.RunIdUuid,
+					JobID:           f.JobIdString,
+					JobSet:          f.JobSetName,
+					Executor:        f.ExecutorId,
+					Node:            f.NodeName,
+					LeasedTimestamp: &f.BaseTime,
 				}}},
 				UpdateJobQueuedState{f.JobIdString: &JobQueuedStateUpdate{
 					Queued:             false,
@@ -67,7 +69,7 @@ func TestConvertSequence(t *testing.T) {
 		},
 		"job run running": {
 			events:   []*armadaevents.EventSequence_Event{f.Running},
-			expected: []DbOperation{MarkRunsRunning{f.RunIdUuid: true}},
+			expected: []DbOperation{MarkRunsRunning{f.RunIdUuid: f.BaseTime}},
 		},
 		"job run succeeded": {
 			events:   []*armadaevents.EventSequence_Event{f.JobRunSucceeded},
@@ -168,6 +170,28 @@ func TestConvertSequence(t *testing.T) {
 				}},
 			},
 		},
+		"SuspendJobSet": {
+			events: []*armadaevents.EventSequence_Event{{
+				Created: &f.BaseTime,
+				Event: &armadaevents.EventSequence_Event_SuspendJobSet{
+					SuspendJobSet: &armadaevents.SuspendJobSet{Reason: "waiting for data"},
+				},
+			}},
+			expected: []DbOperation{
+				UpdateJobSetSuspensions{JobSetKey{queue: f.Queue, jobSet: f.JobSetName}: &JobSetSuspension{suspended: true, reason: "waiting for data", time: f.BaseTime}},
+			},
+		},
+		"ResumeJobSet": {
+			events: []*armadaevents.EventSequence_Event{{
+				Created: &f.BaseTime,
+				Event: &armadaevents.EventSequence_Event_ResumeJobSet{
+					ResumeJobSet: &armadaevents.ResumeJobSet{},
+				},
+			}},
+			expected: []DbOperation{
+				UpdateJobSetSuspensions{JobSetKey{queue: f.Queue, jobSet: f.JobSetName}: &JobSetSuspension{}},
+			},
+		},
 		"PositionMarker": {
 			events: []*armadaevents.EventSequence_Event{f.PartitionMarker},
 			expected: []DbOperation{
@@ -184,14 +208,14 @@ func TestConvertSequence(t *testing.T) {
 			events: []*armadaevents.EventSequence_Event{f.JobSetCancelRequested, f.Running, f.JobSucceeded},
 			expected: []DbOperation{
 				MarkJobSetsCancelRequested{JobSetKey{queue: f.Queue, jobSet: f.JobSetName}: &JobSetCancelAction{cancelQueued: true, cancelLeased: true}},
-				MarkRunsRunning{f.RunIdUuid: true},
+				MarkRunsRunning{f.RunIdUuid: f.BaseTime},
 				MarkJobsSucceeded{f.JobIdString: true},
 			},
 		},
 		"ignored events": {
 			events: []*armadaevents.EventSequence_Event{f.Running, f.JobPreempted, f.JobSucceeded},
 			expected: []DbOperation{
-				MarkRunsRunning{f.RunIdUuid: true},
+				MarkRunsRunning{f.RunIdUuid: f.BaseTime},
 				MarkJobsSucceeded{f.JobIdString: true},
 			},
 		},
@@ -270,6 +294,45 @@ func assertErrorMessagesEqual(t *testing.T, expectedBytes []byte, actualBytes []
 	assert.Equal(t, expectedError, actualError)
 }
 
+func TestPodSpecHash(t *testing.T) {
+	podSpec := &v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Image: "alpine:3.18",
+				Args:  []string{"sleep", "10"},
+				Env:   []v1.EnvVar{{Name: "FOO", Value: "bar"}},
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{"cpu": resource.MustParse("1")},
+				},
+			},
+		},
+	}
+	hash := PodSpecHash(podSpec)
+
+	// Fields other than the image, command, arguments, and environment don't affect the hash.
+	other := podSpec.DeepCopy()
+	other.Containers[0].Resources.Requests["cpu"] = resource.MustParse("2")
+	other.PriorityClassName = "other"
+	assert.Equal(t, hash, PodSpecHash(other))
+
+	other = podSpec.DeepCopy()
+	other.Containers[0].Image = "alpine:3.19"
+	assert.NotEqual(t, hash, PodSpecHash(other))
+
+	other = podSpec.DeepCopy()
+	other.Containers[0].Args = []string{"sleep", "20"}
+	assert.NotEqual(t, hash, PodSpecHash(other))
+
+	other = podSpec.DeepCopy()
+	other.Containers[0].Env[0].Value = "baz"
+	assert.NotEqual(t, hash, PodSpecHash(other))
+
+	// Moving a container between init and regular containers changes the hash.
+	other = podSpec.DeepCopy()
+	other.InitContainers, other.Containers = other.Containers, nil
+	assert.NotEqual(t, hash, PodSpecHash(other))
+}
+
 func getExpectedSubmitMessageSchedulingInfo(t *testing.T) *schedulerobjects.JobSchedulingInfo {
 	expectedSubmitSchedulingInfo := &schedulerobjects.JobSchedulingInfo{
 		Lifetime:          0,
@@ -288,6 +351,9 @@ func getExpectedSubmitMessageSchedulingInfo(t *testing.T) *schedulerobjects.JobS
 						Tolerations:      f.Tolerations,
 						PreemptionPolicy: "PreemptLowerPriority",
 						Priority:         f.PriorityClassValue,
+						Annotations: map[string]string{
+							configuration.PodSpecHashAnnotation: PodSpecHash(f.Submit.GetSubmitJob().GetMainObject().GetPodSpec().GetPodSpec()),
+						},
 						ResourceRequirements: v1.ResourceRequirements{
 							Limits: map[v1.ResourceName]resource.Quantity{
 								"memory": resource.MustParse("64Mi"),
@@ -0,0 +1,43 @@
+package scheduleringester
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/eventlog"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/schedulers"
+)
+
+// Replay replays the event log into the scheduler database, processing events in the same way as Run.
+func Replay(ctx *armadacontext.Context, config Configuration, opts eventlog.ReplayOptions) error {
+	svcMetrics := metrics.NewMetrics(metrics.ArmadaEventIngesterMetricsPrefix + "armada_scheduler_ingester_replay_")
+	db, err := database.OpenPgxPool(config.Postgres)
+	if err != nil {
+		return errors.WithMessage(err, "Error opening connection to postgres")
+	}
+	defer db.Close()
+	schedulerDb := NewSchedulerDb(db, svcMetrics, 100*time.Millisecond, 60*time.Second, 5*time.Second)
+
+	compressor, err := compress.NewZlibCompressor(1024)
+	if err != nil {
+		return errors.WithMessage(err, "Error creating compressor")
+	}
+	converter := NewInstructionConverter(svcMetrics, config.PriorityClasses, compressor)
+
+	return eventlog.ReplayFromPulsar(
+		ctx,
+		config.Pulsar,
+		opts,
+		func(msg eventlog.Message) bool {
+			s, _ := schedulers.SchedulerFromProperties(msg.Properties())
+			return s == schedulers.Pulsar || s == schedulers.All
+		},
+		ingest.ReplayProcessor[*DbOperationsWithMessageIds](converter, schedulerDb),
+	)
+}
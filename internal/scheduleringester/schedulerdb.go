@@ -172,6 +172,32 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 				return errors.WithStack(err)
 			}
 		}
+	case UpdateJobSetSuspensions:
+		for jobSetInfo, suspension := range o {
+			var err error
+			if suspension.suspended {
+				err = queries.UpsertJobSetSuspension(
+					ctx,
+					schedulerdb.UpsertJobSetSuspensionParams{
+						Queue:     jobSetInfo.queue,
+						JobSet:    jobSetInfo.jobSet,
+						Reason:    suspension.reason,
+						Suspended: suspension.time,
+					},
+				)
+			} else {
+				err = queries.DeleteJobSetSuspension(
+					ctx,
+					schedulerdb.DeleteJobSetSuspensionParams{
+						Queue:  jobSetInfo.queue,
+						JobSet: jobSetInfo.jobSet,
+					},
+				)
+			}
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
 	case MarkJobsCancelRequested:
 		jobIds := maps.Keys(o)
 		err := queries.MarkJobsCancelRequestedById(ctx, jobIds)
@@ -243,8 +269,14 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 			return errors.WithStack(err)
 		}
 	case MarkRunsRunning:
-		runIds := maps.Keys(o)
-		err := queries.MarkJobRunsRunningById(ctx, runIds)
+		markRunningSqlStatement := "update runs set running = true, running_timestamp = $1 where run_id = $2"
+
+		batch := &pgx.Batch{}
+		for runId, runningTime := range o {
+			batch.Queue(markRunningSqlStatement, runningTime, runId)
+		}
+
+		err := execBatch(ctx, tx, batch)
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -248,8 +248,8 @@ func TestWriteOps(t *testing.T) {
 				runIds[3]: &JobRunDetails{queue: testQueueName, dbRun: &schedulerdb.Run{JobID: jobIds[3], RunID: runIds[3]}},
 			},
 			MarkRunsRunning{
-				runIds[0]: true,
-				runIds[1]: true,
+				runIds[0]: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+				runIds[1]: time.Date(2023, 1, 1, 12, 0, 1, 0, time.UTC),
 			},
 		}},
 		"Insert PositionMarkers": {Ops: []DbOperation{
@@ -591,8 +591,11 @@ func assertOpSuccess(t *testing.T, schedulerDb *SchedulerDb, serials map[string]
 		}
 		numChanged := 0
 		for _, run := range runs {
-			if _, ok := expected[run.RunID]; ok {
+			if runningTime, ok := expected[run.RunID]; ok {
 				assert.True(t, run.Running)
+				if assert.NotNil(t, run.RunningTimestamp) {
+					assert.True(t, runningTime.Equal(*run.RunningTimestamp))
+				}
 				numChanged++
 			}
 		}
@@ -125,6 +125,39 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job-set/resource-recommendation\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"GetResourceRecommendation recommends resource requests for the jobs of a job set from the peak usage of its past jobs.\",\n" +
+		"        \"operationId\": \"GetResourceRecommendation\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiResourceRecommendationRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiResourceRecommendation\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job-set/{queue}/{id}\": {\n" +
 		"      \"post\": {\n" +
 		"        \"produces\": [\n" +
@@ -245,6 +278,72 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/reprioritizeByFilter\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"ReprioritizeJobsByFilter reprioritises all jobs in a job set matching a label selector.\",\n" +
+		"        \"operationId\": \"ReprioritizeJobsByFilter\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobReprioritizeByFilterRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobReprioritizeResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/resubmit\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"ResubmitJobs submits a copy of each of the given finished jobs to the same job set.\",\n" +
+		"        \"operationId\": \"ResubmitJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobResubmitRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSubmitResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/submit\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -277,6 +376,39 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/validate\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"ValidateJobs validates and defaults the jobs of a submit request in the same way as SubmitJobs,\\nincluding checking that each job could be scheduled, but doesn't submit them.\",\n" +
+		"        \"operationId\": \"ValidateJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSubmitRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobValidateResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/jobset/cancel\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -307,6 +439,68 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/jobset/resume\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"ResumeJobSet allows the queued jobs of a suspended job set to be scheduled again.\",\n" +
+		"        \"operationId\": \"ResumeJobSet\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetResumeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/jobset/suspend\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"SuspendJobSet stops the queued jobs of a job set from being scheduled until the job set is resumed.\",\n" +
+		"        \"operationId\": \"SuspendJobSet\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetSuspendRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/queue\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -487,6 +681,28 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiArtifact\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"description\": \"An object uploaded to object storage.\",\n" +
+		"      \"properties\": {\n" +
+		"        \"containerName\": {\n" +
+		"          \"description\": \"Name of the container the artifact was collected from.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"description\": \"Name of the artifact, e.g., \\\"log\\\".\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"sizeBytes\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"url\": {\n" +
+		"          \"description\": \"URL of the uploaded object.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiBatchQueueCreateResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -559,6 +775,9 @@ func SwaggerJsonTemplate() string {
 		"    \"apiEventMessage\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"artifacts\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobArtifactsEvent\"\n" +
+		"        },\n" +
 		"        \"cancelled\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobCancelledEvent\"\n" +
 		"        },\n" +
@@ -636,6 +855,20 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiFailureCategory\": {\n" +
+		"      \"description\": \"- UnknownFailureCategory: The failure couldn't be categorised.\\n - OOMKilled: A container was killed for exceeding its memory limit.\\n - ImagePull: The image of a container couldn't be pulled.\\n - NodeLost: The node the job was running on was lost, e.g., because it failed, was removed, or evicted the job.\\n - UserError: A container exited with a non-zero exit code.\\n - Deadline: The job exceeded its deadline.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"Fixed taxonomy of why a job failed.\",\n" +
+		"      \"default\": \"UnknownFailureCategory\",\n" +
+		"      \"enum\": [\n" +
+		"        \"UnknownFailureCategory\",\n" +
+		"        \"OOMKilled\",\n" +
+		"        \"ImagePull\",\n" +
+		"        \"NodeLost\",\n" +
+		"        \"UserError\",\n" +
+		"        \"Deadline\"\n" +
+		"      ]\n" +
+		"    },\n" +
 		"    \"apiIngressConfig\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -781,6 +1014,41 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobArtifactsEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"description\": \"Reported once the artifacts of a job, e.g., the logs of its containers, have been uploaded to object storage.\",\n" +
+		"      \"properties\": {\n" +
+		"        \"artifacts\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiArtifact\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobCancelRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -900,6 +1168,10 @@ func SwaggerJsonTemplate() string {
 		"            \"format\": \"int32\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"failureCategory\": {\n" +
+		"          \"description\": \"Category of the failure, for aggregating failures and deciding whether to retry the job.\",\n" +
+		"          \"$ref\": \"#/definitions/apiFailureCategory\"\n" +
+		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1106,15 +1378,27 @@ func SwaggerJsonTemplate() string {
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"nodeName\": {\n" +
+		"          \"description\": \"Node the job was running on when preempted.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"preemptiveJobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"preemptiveRunId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"preemptiveQueue\": {\n" +
+		"          \"description\": \"Queue of the job that caused the preemption, if any.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"description\": \"Why the job was preempted.\",\n" +
+		"          \"$ref\": \"#/definitions/apiPreemptionReason\"\n" +
+		"        },\n" +
 		"        \"runId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
@@ -1138,6 +1422,29 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobReprioritizeByFilterRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"filter\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSetFilter\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"labelSelector\": {\n" +
+		"          \"description\": \"Kubernetes label selector jobs must match to be reprioritised, e.g., \\\"team=ml,stage in (train,eval)\\\".\\nAll jobs in the job set are reprioritised if empty.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobReprioritizeRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -1222,6 +1529,25 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobResubmitRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"description\": \"Ids of terminal jobs in the job set to resubmit.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobRunningEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -1241,6 +1567,13 @@ func SwaggerJsonTemplate() string {
 		"        \"kubernetesId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"nodeLabels\": {\n" +
+		"          \"description\": \"Snapshot of the labels of the node the job is running on, restricted to those the executor is configured to report.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"nodeName\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1332,6 +1665,33 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobSetResumeRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetSuspendRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobState\": {\n" +
 		"      \"type\": \"string\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -1342,13 +1702,18 @@ func SwaggerJsonTemplate() string {
 		"        \"RUNNING\",\n" +
 		"        \"SUCCEEDED\",\n" +
 		"        \"FAILED\",\n" +
-		"        \"UNKNOWN\"\n" +
+		"        \"UNKNOWN\",\n" +
+		"        \"CANCELLED\"\n" +
 		"      ]\n" +
 		"    },\n" +
 		"    \"apiJobSubmitRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"idempotencyKey\": {\n" +
+		"          \"description\": \"Optional key identifying this request, such that retrying it, e.g., after a timeout, doesn't submit the jobs again.\\nIf a request with the same key was already submitted to the same queue, the response to that request is returned\\nand no jobs are submitted.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"jobRequestItems\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -1372,9 +1737,21 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"arraySize\": {\n" +
+		"          \"description\": \"If greater than zero, this item is submitted as an array job made up of array_size tasks.\\nEach task is a separate job, which can tell its index from the ARMADA_ARRAY_INDEX environment variable.\\nIf client_id is set, the client id of each task is client_id suffixed with \\\"-\\u003cindex\\u003e\\\".\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
 		"        \"clientId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"dependsOn\": {\n" +
+		"          \"description\": \"Jobs that must succeed before this job may be scheduled, given either by job id\\nor by the client_id of a job earlier in the same request.\\nIf any of these jobs fails or is cancelled, this job fails without being scheduled.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"ingress\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -1414,6 +1791,10 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"retryPolicy\": {\n" +
+		"          \"description\": \"If set, the job is resubmitted automatically if it fails, as specified by the policy.\",\n" +
+		"          \"$ref\": \"#/definitions/apiRetryPolicy\"\n" +
+		"        },\n" +
 		"        \"scheduler\": {\n" +
 		"          \"description\": \"Indicates which scheduler should manage this job.\\nIf empty, the default scheduler is used.\",\n" +
 		"          \"type\": \"string\"\n" +
@@ -1441,11 +1822,38 @@ func SwaggerJsonTemplate() string {
 		"    \"apiJobSubmitResponseItem\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"arrayId\": {\n" +
+		"          \"description\": \"For array jobs, the id of the array and the ids of its tasks, ordered by index; job_id is empty.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"arrayJobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"error\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"multiPodJobId\": {\n" +
+		"          \"description\": \"For multi-pod jobs, the id of the multi-pod job and the ids of the jobs running its pods, ordered by pod;\\njob_id is the id of the job running the first pod.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podJobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"warnings\": {\n" +
+		"          \"description\": \"Reasons the job may never be scheduled, if it was accepted regardless.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -1617,6 +2025,13 @@ func SwaggerJsonTemplate() string {
 		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"averageResources\": {\n" +
+		"          \"description\": \"Average usage sampled since the job started running.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"clusterId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1657,6 +2072,55 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobValidateResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"errors\": {\n" +
+		"          \"description\": \"Reasons submitting the request would fail that don't relate to a particular job.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobResponseItems\": {\n" +
+		"          \"description\": \"Diagnostics for each item of the request, in the same order as the items.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobValidateResponseItem\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"valid\": {\n" +
+		"          \"description\": \"True if submitting the request would succeed.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobValidateResponseItem\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"errors\": {\n" +
+		"          \"description\": \"Reasons submitting the job would fail; empty if the job is valid.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiPreemptionReason\": {\n" +
+		"      \"description\": \"- UnknownPreemptionReason: The reason is unknown, e.g., because the job was preempted by an older version of the scheduler.\\n - FairShare: Preempted to balance resources between queues according to their fair share.\\n - Urgency: Preempted to make room for jobs of a higher priority class, i.e., a more urgent job.\\n - NodeDrain: Preempted because the node it was running on was marked for maintenance and is being drained.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"Reason for the scheduler preempting a job.\",\n" +
+		"      \"default\": \"UnknownPreemptionReason\",\n" +
+		"      \"enum\": [\n" +
+		"        \"UnknownPreemptionReason\",\n" +
+		"        \"FairShare\",\n" +
+		"        \"Urgency\",\n" +
+		"        \"NodeDrain\"\n" +
+		"      ]\n" +
+		"    },\n" +
 		"    \"apiQueue\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -1687,6 +2151,9 @@ func SwaggerJsonTemplate() string {
 		"            \"format\": \"double\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"state\": {\n" +
+		"          \"$ref\": \"#/definitions/apiQueueState\"\n" +
+		"        },\n" +
 		"        \"userOwners\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -1733,6 +2200,18 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiQueueState\": {\n" +
+		"      \"description\": \"- OPEN: Jobs can be submitted to the queue and are scheduled.\\n - PAUSED: No new jobs can be submitted to the queue, but jobs already queued are scheduled.\\n - DRAINING: Jobs can be submitted to the queue, but no jobs are scheduled; running jobs run to completion.\\n - CLOSED: No new jobs can be submitted to the queue and no jobs are scheduled.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"State of a queue, which determines whether jobs can be submitted to and scheduled from it.\\nswagger:model\",\n" +
+		"      \"default\": \"OPEN\",\n" +
+		"      \"enum\": [\n" +
+		"        \"OPEN\",\n" +
+		"        \"PAUSED\",\n" +
+		"        \"DRAINING\",\n" +
+		"        \"CLOSED\"\n" +
+		"      ]\n" +
+		"    },\n" +
 		"    \"apiQueueUpdateResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -1744,6 +2223,83 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiResourceRecommendation\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"currentRequests\": {\n" +
+		"          \"description\": \"Largest request of each resource among the past jobs considered, for comparison.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"numJobs\": {\n" +
+		"          \"description\": \"Number of past jobs that reported their usage.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"requests\": {\n" +
+		"          \"description\": \"Recommended request of each resource requested by past jobs and for which their usage was reported.\\nEmpty if fewer past jobs reported their usage than the server requires to make a recommendation.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiResourceRecommendationRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"percentile\": {\n" +
+		"          \"description\": \"Percentile of the peak usage of past jobs to recommend, e.g., 95. The server's default is used if zero.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"templateName\": {\n" +
+		"          \"description\": \"If provided, only jobs of the job set expanded from the job template of this name are considered.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiRetryPolicy\": {\n" +
+		"      \"description\": \"Policy for automatically resubmitting failed jobs. Each retry is submitted as a new job in the same job set.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"backoffSeconds\": {\n" +
+		"          \"description\": \"Time to wait before the first retry, in seconds. The wait is doubled for each subsequent retry.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"maxAttempts\": {\n" +
+		"          \"description\": \"Maximum number of times the job is run, including the first attempt. The job isn't retried if at most one.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"retryOnExitCodes\": {\n" +
+		"          \"description\": \"If non-empty, the job is only retried if it failed because a container exited with one of these exit codes.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"retryOnFailureCategories\": {\n" +
+		"          \"description\": \"If non-empty, the job is only retried if it failed for a reason in one of these categories.\\nIf both this and retry_on_exit_codes are provided, the job is retried if either matches.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiFailureCategory\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiServiceConfig\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -83,6 +83,38 @@ func SwaggerJsonTemplate() string {
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
+		"    },\n" +
+		"    \"/v1/binoculars/logs/search\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Binoculars\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"SearchLogs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/binocularsLogSearchRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/binocularsLogSearchResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
 		"    }\n" +
 		"  },\n" +
 		"  \"definitions\": {\n" +
@@ -141,6 +173,86 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"binocularsLogSearchLine\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"container\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"line\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"match\": {\n" +
+		"          \"description\": \"False for lines only included as context of a matching line.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"timestamp\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"binocularsLogSearchRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"container\": {\n" +
+		"          \"description\": \"If non-empty, only the log of this container of each pod is searched. Otherwise, all containers are searched.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"contextLines\": {\n" +
+		"          \"description\": \"Number of lines before and after each matching line to include.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"includeGang\": {\n" +
+		"          \"description\": \"If true, the logs of all jobs of the gang the job is a member of are searched as well.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"pattern\": {\n" +
+		"          \"description\": \"RE2 regular expression lines must match. If empty, all lines match.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNamespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"sinceTime\": {\n" +
+		"          \"description\": \"If non-empty, only lines logged at or after this RFC3339 time are searched.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"untilTime\": {\n" +
+		"          \"description\": \"If non-empty, only lines logged at or before this RFC3339 time are searched.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"binocularsLogSearchResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"lines\": {\n" +
+		"          \"description\": \"Matching lines and their context across all pods searched, ordered by time.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/binocularsLogSearchLine\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"truncated\": {\n" +
+		"          \"description\": \"True if there were more lines than could be returned, in which case the latest lines were dropped.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"protobufAny\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -205,6 +205,254 @@ func (m *LogLine) GetLine() string {
 	return ""
 }
 
+// swagger:model
+type LogSearchRequest struct {
+	JobId        string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	PodNamespace string `protobuf:"bytes,2,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+	// If true, the logs of all jobs of the gang the job is a member of are searched as well.
+	IncludeGang bool `protobuf:"varint,3,opt,name=include_gang,json=includeGang,proto3" json:"includeGang,omitempty"`
+	// If non-empty, only the log of this container of each pod is searched. Otherwise, all containers are searched.
+	Container string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	// RE2 regular expression lines must match. If empty, all lines match.
+	Pattern string `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Number of lines before and after each matching line to include.
+	ContextLines int32 `protobuf:"varint,6,opt,name=context_lines,json=contextLines,proto3" json:"contextLines,omitempty"`
+	// If non-empty, only lines logged at or after this RFC3339 time are searched.
+	SinceTime string `protobuf:"bytes,7,opt,name=since_time,json=sinceTime,proto3" json:"sinceTime,omitempty"`
+	// If non-empty, only lines logged at or before this RFC3339 time are searched.
+	UntilTime string `protobuf:"bytes,8,opt,name=until_time,json=untilTime,proto3" json:"untilTime,omitempty"`
+}
+
+func (m *LogSearchRequest) Reset()         { *m = LogSearchRequest{} }
+func (m *LogSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*LogSearchRequest) ProtoMessage()    {}
+func (*LogSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f2fc8093f6f091f, []int{3}
+}
+func (m *LogSearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogSearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSearchRequest.Merge(m, src)
+}
+func (m *LogSearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSearchRequest proto.InternalMessageInfo
+
+func (m *LogSearchRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *LogSearchRequest) GetPodNamespace() string {
+	if m != nil {
+		return m.PodNamespace
+	}
+	return ""
+}
+
+func (m *LogSearchRequest) GetIncludeGang() bool {
+	if m != nil {
+		return m.IncludeGang
+	}
+	return false
+}
+
+func (m *LogSearchRequest) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *LogSearchRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *LogSearchRequest) GetContextLines() int32 {
+	if m != nil {
+		return m.ContextLines
+	}
+	return 0
+}
+
+func (m *LogSearchRequest) GetSinceTime() string {
+	if m != nil {
+		return m.SinceTime
+	}
+	return ""
+}
+
+func (m *LogSearchRequest) GetUntilTime() string {
+	if m != nil {
+		return m.UntilTime
+	}
+	return ""
+}
+
+// swagger:model
+type LogSearchResponse struct {
+	// Matching lines and their context across all pods searched, ordered by time.
+	Lines []*LogSearchLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	// True if there were more lines than could be returned, in which case the latest lines were dropped.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *LogSearchResponse) Reset()         { *m = LogSearchResponse{} }
+func (m *LogSearchResponse) String() string { return proto.CompactTextString(m) }
+func (*LogSearchResponse) ProtoMessage()    {}
+func (*LogSearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f2fc8093f6f091f, []int{4}
+}
+func (m *LogSearchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogSearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogSearchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogSearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSearchResponse.Merge(m, src)
+}
+func (m *LogSearchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogSearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSearchResponse proto.InternalMessageInfo
+
+func (m *LogSearchResponse) GetLines() []*LogSearchLine {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *LogSearchResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// swagger:model
+type LogSearchLine struct {
+	JobId     string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	PodNumber int32  `protobuf:"varint,2,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	Container string `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	Timestamp string `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Line      string `protobuf:"bytes,5,opt,name=line,proto3" json:"line,omitempty"`
+	// False for lines only included as context of a matching line.
+	Match bool `protobuf:"varint,6,opt,name=match,proto3" json:"match,omitempty"`
+}
+
+func (m *LogSearchLine) Reset()         { *m = LogSearchLine{} }
+func (m *LogSearchLine) String() string { return proto.CompactTextString(m) }
+func (*LogSearchLine) ProtoMessage()    {}
+func (*LogSearchLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f2fc8093f6f091f, []int{5}
+}
+func (m *LogSearchLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogSearchLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogSearchLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogSearchLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSearchLine.Merge(m, src)
+}
+func (m *LogSearchLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogSearchLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSearchLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSearchLine proto.InternalMessageInfo
+
+func (m *LogSearchLine) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *LogSearchLine) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
+}
+
+func (m *LogSearchLine) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *LogSearchLine) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *LogSearchLine) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
+
+func (m *LogSearchLine) GetMatch() bool {
+	if m != nil {
+		return m.Match
+	}
+	return false
+}
+
 // swagger:model
 type CordonRequest struct {
 	NodeName string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
@@ -214,7 +462,7 @@ func (m *CordonRequest) Reset()         { *m = CordonRequest{} }
 func (m *CordonRequest) String() string { return proto.CompactTextString(m) }
 func (*CordonRequest) ProtoMessage()    {}
 func (*CordonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f2fc8093f6f091f, []int{3}
+	return fileDescriptor_3f2fc8093f6f091f, []int{6}
 }
 func (m *CordonRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,6 +502,9 @@ func init() {
 	proto.RegisterType((*LogRequest)(nil), "binoculars.LogRequest")
 	proto.RegisterType((*LogResponse)(nil), "binoculars.LogResponse")
 	proto.RegisterType((*LogLine)(nil), "binoculars.LogLine")
+	proto.RegisterType((*LogSearchRequest)(nil), "binoculars.LogSearchRequest")
+	proto.RegisterType((*LogSearchResponse)(nil), "binoculars.LogSearchResponse")
+	proto.RegisterType((*LogSearchLine)(nil), "binoculars.LogSearchLine")
 	proto.RegisterType((*CordonRequest)(nil), "binoculars.CordonRequest")
 }
 
@@ -262,45 +513,62 @@ func init() {
 }
 
 var fileDescriptor_3f2fc8093f6f091f = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xdd, 0x6a, 0xd4, 0x40,
-	0x14, 0xc7, 0x9b, 0xed, 0x87, 0xee, 0xac, 0x55, 0x9c, 0xea, 0x36, 0x46, 0xdd, 0xac, 0x11, 0x64,
-	0x29, 0x32, 0xa1, 0x5b, 0x94, 0xd2, 0x1b, 0x69, 0x54, 0xa4, 0xb0, 0x68, 0x29, 0xe2, 0x85, 0x28,
-	0x4b, 0x3e, 0xc6, 0xe9, 0xb4, 0xc9, 0x9c, 0x31, 0xc9, 0x16, 0xbc, 0xf5, 0x09, 0x04, 0xdf, 0xc2,
-	0xd7, 0xf0, 0x42, 0x2f, 0x0b, 0xde, 0x78, 0x15, 0xa4, 0xf5, 0x2a, 0x4f, 0x21, 0x99, 0x64, 0xb7,
-	0x63, 0x7b, 0x97, 0xf9, 0x9d, 0xf3, 0x3f, 0x67, 0xce, 0xff, 0x4c, 0xd0, 0x7d, 0x79, 0xc8, 0x5c,
-	0x5f, 0x72, 0x37, 0xe0, 0x02, 0xc2, 0x49, 0xec, 0xa7, 0x99, 0xf6, 0x49, 0x64, 0x0a, 0x39, 0x60,
-	0x74, 0x46, 0x2c, 0xe7, 0x70, 0x33, 0x23, 0x1c, 0x94, 0x26, 0x84, 0x94, 0xba, 0x47, 0xeb, 0x2e,
-	0xa3, 0x82, 0xa6, 0x7e, 0x4e, 0xa3, 0x3a, 0xdf, 0xba, 0xc3, 0x00, 0x58, 0x4c, 0x55, 0x8e, 0x2f,
-	0x04, 0xe4, 0x7e, 0xce, 0x41, 0x34, 0xd5, 0xac, 0xdb, 0x4d, 0x54, 0x9d, 0x82, 0xc9, 0x07, 0x97,
-	0x26, 0x32, 0xff, 0x54, 0x07, 0x9d, 0x1f, 0x2d, 0x84, 0x46, 0xc0, 0xf6, 0xe8, 0xc7, 0x09, 0xcd,
-	0x72, 0xbc, 0x86, 0x96, 0x0e, 0x20, 0x18, 0xf3, 0xc8, 0x34, 0xfa, 0xc6, 0xa0, 0xed, 0xad, 0x94,
-	0x85, 0x7d, 0xed, 0x00, 0x82, 0x9d, 0xe8, 0x21, 0x24, 0x3c, 0x57, 0xca, 0xbd, 0x45, 0x05, 0xf0,
-	0x63, 0x84, 0x24, 0x44, 0x63, 0x31, 0x49, 0x02, 0x9a, 0x9a, 0xad, 0xbe, 0x31, 0x58, 0xf4, 0x56,
-	0xcb, 0xc2, 0x5e, 0x91, 0x10, 0xbd, 0x54, 0x50, 0xd3, 0xb4, 0x67, 0x10, 0x3f, 0x41, 0xcb, 0x4a,
-	0xe7, 0x27, 0x34, 0x93, 0x7e, 0x48, 0xcd, 0x79, 0xd5, 0xca, 0x2a, 0x0b, 0xbb, 0x5b, 0x65, 0x4d,
-	0xb9, 0xa6, 0xbe, 0xa2, 0xf3, 0xaa, 0x71, 0xc6, 0x45, 0x48, 0xc7, 0x39, 0x4f, 0xa8, 0xb9, 0xa0,
-	0xd4, 0xaa, 0xb1, 0xa2, 0xaf, 0x79, 0xa2, 0x4b, 0xdb, 0x33, 0x88, 0xdf, 0xa1, 0x4e, 0x0c, 0x6c,
-	0x0c, 0x52, 0xb9, 0x63, 0x2e, 0xf6, 0x8d, 0x41, 0x67, 0x78, 0x8f, 0xd4, 0x06, 0x13, 0x5f, 0x72,
-	0x52, 0x19, 0x4c, 0x8e, 0xd6, 0xc9, 0x2e, 0x44, 0x23, 0x60, 0xaf, 0xea, 0x44, 0xcf, 0x2c, 0x0b,
-	0xfb, 0x46, 0x3c, 0x3b, 0x6b, 0xc5, 0xd1, 0x19, 0x75, 0x5e, 0xa0, 0x8e, 0x32, 0x32, 0x93, 0x20,
-	0x32, 0x8a, 0x37, 0xd1, 0x7c, 0x0c, 0xcc, 0x34, 0xfa, 0xf3, 0x83, 0xce, 0x70, 0x85, 0x68, 0x3b,
-	0x1e, 0x01, 0x1b, 0x71, 0x41, 0xbd, 0xeb, 0x65, 0x61, 0x2f, 0xc7, 0xc0, 0xb4, 0x7a, 0x95, 0xc4,
-	0xd9, 0x47, 0x97, 0x9a, 0x14, 0xfc, 0x08, 0xb5, 0xab, 0x19, 0xb3, 0xdc, 0x4f, 0x64, 0xb3, 0x11,
-	0x35, 0xe8, 0x0c, 0xea, 0x83, 0xce, 0x20, 0x7e, 0x80, 0x16, 0x62, 0x2e, 0xa8, 0xda, 0x49, 0xdb,
-	0xc3, 0x65, 0x61, 0x5f, 0xad, 0xce, 0x5a, 0xb2, 0x8a, 0x3b, 0xcf, 0xd0, 0xf2, 0x53, 0x48, 0x23,
-	0x10, 0xd3, 0xf5, 0x6f, 0xa0, 0xb6, 0x80, 0x88, 0xaa, 0xdd, 0x34, 0xfd, 0xba, 0x65, 0x61, 0xe3,
-	0x0a, 0x56, 0xfe, 0x6b, 0x15, 0x2e, 0x4f, 0xd9, 0xf0, 0xbb, 0x81, 0x90, 0x37, 0x1b, 0x0f, 0xbf,
-	0x41, 0x0b, 0x23, 0x60, 0x19, 0xee, 0x9e, 0x9b, 0xb9, 0xe9, 0x61, 0xad, 0x5e, 0xe0, 0xb5, 0x63,
-	0xce, 0xdd, 0xcf, 0xbf, 0xfe, 0x7e, 0x6d, 0xad, 0x3a, 0xb8, 0x7a, 0xe2, 0xda, 0xef, 0x11, 0x03,
-	0xdb, 0x32, 0xd6, 0xf0, 0x7b, 0xb4, 0x54, 0x5f, 0x16, 0xdf, 0xd2, 0x2b, 0xfc, 0x37, 0x80, 0xd5,
-	0x25, 0xf5, 0x63, 0x27, 0xd3, 0xc7, 0x4e, 0x9e, 0x57, 0xb7, 0x75, 0xfa, 0xaa, 0xb6, 0xe5, 0xdc,
-	0x3c, 0x57, 0x3b, 0x54, 0xea, 0x2d, 0x63, 0xcd, 0x13, 0x3f, 0x4f, 0x7a, 0xc6, 0xf1, 0x49, 0xcf,
-	0xf8, 0x73, 0xd2, 0x33, 0xbe, 0x9c, 0xf6, 0xe6, 0x8e, 0x4f, 0x7b, 0x73, 0xbf, 0x4f, 0x7b, 0x73,
-	0x6f, 0x87, 0x8c, 0xe7, 0xfb, 0x93, 0x80, 0x84, 0x90, 0xb8, 0x7e, 0x9a, 0xf8, 0x91, 0x2f, 0x53,
-	0x38, 0xa0, 0x61, 0xde, 0x9c, 0xdc, 0x8b, 0xbf, 0xf4, 0xb7, 0x96, 0xbd, 0xad, 0x62, 0xbb, 0x75,
-	0x26, 0xd9, 0x01, 0xb2, 0x2d, 0x39, 0x39, 0xb3, 0x29, 0x58, 0x52, 0x37, 0xdc, 0xf8, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x60, 0x12, 0x4c, 0xad, 0x11, 0x04, 0x00, 0x00,
+	// 869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xd7, 0xc9, 0x66, 0x37, 0x99, 0xec, 0x02, 0xeb, 0x65, 0x77, 0xdd, 0xd0, 0xc6, 0xc1,
+	0x08, 0xb4, 0xac, 0x90, 0xad, 0x6e, 0x45, 0x55, 0x55, 0x48, 0xa8, 0xa6, 0xa8, 0xaa, 0x14, 0x41,
+	0x55, 0x10, 0x07, 0x04, 0x8a, 0x26, 0xf6, 0xe0, 0x9d, 0xad, 0x3d, 0xcf, 0xd8, 0x93, 0x0a, 0xae,
+	0x7c, 0x82, 0x4a, 0x7c, 0x0b, 0xce, 0xf0, 0x19, 0xe0, 0x58, 0x09, 0x09, 0x71, 0xb2, 0xd0, 0x2e,
+	0x27, 0x7f, 0x0a, 0x34, 0xcf, 0x8e, 0x33, 0x49, 0x00, 0x75, 0x39, 0x70, 0xcb, 0xfc, 0xe6, 0xfd,
+	0xe7, 0xe5, 0xbd, 0xf7, 0x1f, 0x0f, 0x79, 0x23, 0x7d, 0x12, 0x79, 0x34, 0xe5, 0xde, 0x94, 0x0b,
+	0x08, 0x66, 0x31, 0xcd, 0x72, 0xed, 0xa7, 0x9b, 0x66, 0x20, 0xc1, 0x24, 0x0b, 0x32, 0x70, 0x9e,
+	0xdc, 0xc9, 0x5d, 0x0e, 0xa8, 0x09, 0x20, 0x63, 0xde, 0xd3, 0x9b, 0x5e, 0xc4, 0x04, 0xcb, 0xa8,
+	0x64, 0x61, 0x15, 0x3f, 0xb8, 0x1e, 0x01, 0x44, 0x31, 0xc3, 0x18, 0x2a, 0x04, 0x48, 0x2a, 0x39,
+	0x88, 0xfa, 0xb4, 0xc1, 0x6b, 0xf5, 0x2e, 0xae, 0xa6, 0xb3, 0xaf, 0x3c, 0x96, 0xa4, 0xf2, 0xdb,
+	0x6a, 0xd3, 0xf9, 0xb9, 0x45, 0xc8, 0x18, 0xa2, 0xc7, 0xec, 0xeb, 0x19, 0xcb, 0xa5, 0x79, 0x42,
+	0xb6, 0xce, 0x61, 0x3a, 0xe1, 0xa1, 0x65, 0x8c, 0x8c, 0xe3, 0x9e, 0xbf, 0x5f, 0x16, 0xf6, 0xcb,
+	0xe7, 0x30, 0x7d, 0x18, 0xbe, 0x03, 0x09, 0x97, 0xa8, 0x7c, 0xdc, 0x41, 0x60, 0xde, 0x26, 0x24,
+	0x85, 0x70, 0x22, 0x66, 0xc9, 0x94, 0x65, 0x56, 0x6b, 0x64, 0x1c, 0x77, 0xfc, 0xa3, 0xb2, 0xb0,
+	0xf7, 0x53, 0x08, 0x3f, 0x42, 0xa8, 0x69, 0x7a, 0x0d, 0x34, 0xdf, 0x27, 0xbb, 0xa8, 0xa3, 0x09,
+	0xcb, 0x53, 0x1a, 0x30, 0xab, 0x8d, 0xa9, 0x06, 0x65, 0x61, 0x1f, 0xaa, 0xa8, 0x39, 0xd7, 0xd4,
+	0x3b, 0x3a, 0x57, 0x89, 0x73, 0x2e, 0x02, 0x36, 0x91, 0x3c, 0x61, 0xd6, 0x26, 0xaa, 0x31, 0x31,
+	0xd2, 0x4f, 0x79, 0xa2, 0x4b, 0x7b, 0x0d, 0x34, 0xbf, 0x20, 0xfd, 0x18, 0xa2, 0x09, 0xa4, 0xd8,
+	0x1d, 0xab, 0x33, 0x32, 0x8e, 0xfb, 0xa7, 0xaf, 0xbb, 0x55, 0x83, 0x5d, 0x9a, 0x72, 0x57, 0x35,
+	0xd8, 0x7d, 0x7a, 0xd3, 0x7d, 0x04, 0xe1, 0x18, 0xa2, 0x8f, 0xab, 0x40, 0xdf, 0x2a, 0x0b, 0xfb,
+	0xd5, 0xb8, 0x59, 0x6b, 0x87, 0x93, 0x05, 0x75, 0x1e, 0x90, 0x3e, 0x36, 0x32, 0x4f, 0x41, 0xe4,
+	0xcc, 0xbc, 0x43, 0xda, 0x31, 0x44, 0x96, 0x31, 0x6a, 0x1f, 0xf7, 0x4f, 0xf7, 0x5d, 0x6d, 0xc6,
+	0x63, 0x88, 0xc6, 0x5c, 0x30, 0x7f, 0xaf, 0x2c, 0xec, 0xdd, 0x18, 0x22, 0xed, 0x3c, 0x25, 0x71,
+	0xce, 0xc8, 0x76, 0x1d, 0x62, 0xbe, 0x4b, 0x7a, 0xaa, 0xc6, 0x5c, 0xd2, 0x24, 0xb5, 0x8c, 0x45,
+	0xa1, 0x0d, 0xd4, 0x0b, 0x6d, 0xa0, 0xf9, 0x16, 0xd9, 0x8c, 0xb9, 0x60, 0x38, 0x93, 0x9e, 0x6f,
+	0x96, 0x85, 0xfd, 0x92, 0x5a, 0x6b, 0xc1, 0xb8, 0xef, 0xfc, 0xd6, 0x26, 0xaf, 0x8c, 0x21, 0xfa,
+	0x84, 0xd1, 0x2c, 0x38, 0xfb, 0x2f, 0x16, 0x58, 0x1b, 0x65, 0xeb, 0x8a, 0xa3, 0x7c, 0x8f, 0xec,
+	0x70, 0x11, 0xc4, 0xb3, 0x90, 0x4d, 0x22, 0x2a, 0x22, 0xb4, 0x42, 0xd7, 0xbf, 0x56, 0x16, 0xf6,
+	0x41, 0xcd, 0x1f, 0x50, 0xa1, 0x77, 0xa8, 0xaf, 0x61, 0xd5, 0x9e, 0x00, 0x84, 0xa4, 0x5c, 0xb0,
+	0x4c, 0xf7, 0x41, 0x03, 0xf5, 0xf6, 0x34, 0xd0, 0xf4, 0xc8, 0x76, 0x4a, 0xa5, 0x64, 0x99, 0x40,
+	0x0f, 0xf4, 0xfc, 0x83, 0xb2, 0xb0, 0xf7, 0x6a, 0xa4, 0x49, 0xe6, 0x51, 0xaa, 0x4c, 0xa5, 0x66,
+	0xdf, 0xc8, 0x89, 0xea, 0x5b, 0x6e, 0x6d, 0xa1, 0xd9, 0xb1, 0xcc, 0x7a, 0x43, 0x8d, 0x4b, 0x77,
+	0xc6, 0x8e, 0xce, 0x57, 0x1c, 0xbb, 0xfd, 0xc2, 0x8e, 0xbd, 0x4d, 0xc8, 0x4c, 0x48, 0x1e, 0x57,
+	0xba, 0xee, 0x42, 0x87, 0x74, 0x55, 0xd7, 0x40, 0xe7, 0x99, 0x41, 0xf6, 0xb4, 0xc1, 0xd6, 0x96,
+	0xbc, 0x4f, 0x3a, 0xd5, 0xdf, 0xaf, 0x4c, 0x79, 0x6d, 0xc5, 0x94, 0x55, 0x34, 0x5a, 0x13, 0x67,
+	0x1e, 0xaf, 0x94, 0x54, 0x89, 0xd1, 0x93, 0xd9, 0x4c, 0x04, 0xea, 0xfb, 0x83, 0xf3, 0xee, 0xd6,
+	0x9e, 0x9c, 0xc3, 0x25, 0x4f, 0xce, 0xa1, 0xf3, 0x63, 0x8b, 0xec, 0x2e, 0x25, 0xf9, 0x5f, 0xbe,
+	0x35, 0x4b, 0x0e, 0x69, 0xbf, 0xb0, 0x43, 0x96, 0xee, 0xdd, 0xe6, 0x95, 0xef, 0x5d, 0xe7, 0xdf,
+	0xef, 0x9d, 0xf9, 0x36, 0xe9, 0x24, 0x54, 0x06, 0x67, 0xe8, 0xa3, 0x6e, 0x55, 0x38, 0x02, 0xbd,
+	0x70, 0x04, 0xce, 0x7d, 0xb2, 0xfb, 0x01, 0x64, 0x21, 0x88, 0xf9, 0xf5, 0xbc, 0x45, 0x7a, 0x02,
+	0x42, 0x86, 0x77, 0xae, 0x6e, 0xdc, 0x61, 0x59, 0xd8, 0xa6, 0x82, 0xea, 0x5e, 0x69, 0x47, 0x74,
+	0xe7, 0xec, 0xf4, 0xa7, 0x16, 0x21, 0x7e, 0x33, 0x6c, 0xf3, 0x33, 0xb2, 0x39, 0x86, 0x28, 0x37,
+	0x0f, 0x57, 0x1c, 0x50, 0xe7, 0x18, 0x1c, 0xad, 0xf1, 0xca, 0x41, 0xce, 0x8d, 0xef, 0x7e, 0xfd,
+	0xf3, 0xfb, 0xd6, 0x91, 0x63, 0xaa, 0x57, 0x68, 0x11, 0xe3, 0xc5, 0x10, 0xdd, 0x35, 0x4e, 0xcc,
+	0x94, 0x90, 0x7a, 0xbe, 0xea, 0xf4, 0xeb, 0x7f, 0xeb, 0xaf, 0x79, 0x8e, 0x1b, 0xff, 0xb0, 0x5b,
+	0x67, 0x7a, 0x13, 0x33, 0xd9, 0xce, 0x60, 0x3d, 0x53, 0xee, 0xe5, 0x18, 0xab, 0x32, 0x7e, 0x49,
+	0xb6, 0xaa, 0xf6, 0x98, 0x4b, 0x6e, 0x5e, 0x6a, 0xd9, 0xe0, 0xd0, 0xad, 0x5e, 0x40, 0x77, 0xfe,
+	0x02, 0xba, 0x1f, 0xaa, 0xfe, 0x38, 0x23, 0xcc, 0x31, 0x70, 0x0e, 0x56, 0x72, 0x04, 0xa8, 0xbe,
+	0x6b, 0x9c, 0xf8, 0xe2, 0x97, 0x8b, 0xa1, 0xf1, 0xfc, 0x62, 0x68, 0xfc, 0x71, 0x31, 0x34, 0x9e,
+	0x5d, 0x0e, 0x37, 0x9e, 0x5f, 0x0e, 0x37, 0x7e, 0xbf, 0x1c, 0x6e, 0x7c, 0x7e, 0x1a, 0x71, 0x79,
+	0x36, 0x9b, 0xba, 0x01, 0x24, 0x1e, 0xcd, 0x12, 0x1a, 0xd2, 0x34, 0x83, 0x73, 0x16, 0xc8, 0x7a,
+	0xe5, 0xad, 0xbf, 0xf3, 0x3f, 0xb4, 0xec, 0x7b, 0xb8, 0xf7, 0xa8, 0x8a, 0x74, 0x1f, 0x82, 0x7b,
+	0x2f, 0xe5, 0xee, 0x62, 0x30, 0xd3, 0x2d, 0xfc, 0x87, 0xb7, 0xfe, 0x1a, 0x00, 0x72, 0xf5, 0xde,
+	0x46, 0x26, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -316,6 +584,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type BinocularsClient interface {
 	Logs(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*LogResponse, error)
+	SearchLogs(ctx context.Context, in *LogSearchRequest, opts ...grpc.CallOption) (*LogSearchResponse, error)
 	Cordon(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
@@ -336,6 +605,15 @@ func (c *binocularsClient) Logs(ctx context.Context, in *LogRequest, opts ...grp
 	return out, nil
 }
 
+func (c *binocularsClient) SearchLogs(ctx context.Context, in *LogSearchRequest, opts ...grpc.CallOption) (*LogSearchResponse, error) {
+	out := new(LogSearchResponse)
+	err := c.cc.Invoke(ctx, "/binoculars.Binoculars/SearchLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *binocularsClient) Cordon(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/binoculars.Binoculars/Cordon", in, out, opts...)
@@ -348,6 +626,7 @@ func (c *binocularsClient) Cordon(ctx context.Context, in *CordonRequest, opts .
 // BinocularsServer is the server API for Binoculars service.
 type BinocularsServer interface {
 	Logs(context.Context, *LogRequest) (*LogResponse, error)
+	SearchLogs(context.Context, *LogSearchRequest) (*LogSearchResponse, error)
 	Cordon(context.Context, *CordonRequest) (*types.Empty, error)
 }
 
@@ -358,6 +637,9 @@ type UnimplementedBinocularsServer struct {
 func (*UnimplementedBinocularsServer) Logs(ctx context.Context, req *LogRequest) (*LogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logs not implemented")
 }
+func (*UnimplementedBinocularsServer) SearchLogs(ctx context.Context, req *LogSearchRequest) (*LogSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLogs not implemented")
+}
 func (*UnimplementedBinocularsServer) Cordon(ctx context.Context, req *CordonRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cordon not implemented")
 }
@@ -384,6 +666,24 @@ func _Binoculars_Logs_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Binoculars_SearchLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinocularsServer).SearchLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binoculars.Binoculars/SearchLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinocularsServer).SearchLogs(ctx, req.(*LogSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Binoculars_Cordon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CordonRequest)
 	if err := dec(in); err != nil {
@@ -410,6 +710,10 @@ var _Binoculars_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Logs",
 			Handler:    _Binoculars_Logs_Handler,
 		},
+		{
+			MethodName: "SearchLogs",
+			Handler:    _Binoculars_SearchLogs_Handler,
+		},
 		{
 			MethodName: "Cordon",
 			Handler:    _Binoculars_Cordon_Handler,
@@ -554,7 +858,7 @@ func (m *LogLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CordonRequest) Marshal() (dAtA []byte, err error) {
+func (m *LogSearchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -564,86 +868,364 @@ func (m *CordonRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CordonRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogSearchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CordonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LogSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.NodeName)))
+	if len(m.UntilTime) > 0 {
+		i -= len(m.UntilTime)
+		copy(dAtA[i:], m.UntilTime)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.UntilTime)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintBinoculars(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBinoculars(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.SinceTime) > 0 {
+		i -= len(m.SinceTime)
+		copy(dAtA[i:], m.SinceTime)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.SinceTime)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *LogRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ContextLines != 0 {
+		i = encodeVarintBinoculars(dAtA, i, uint64(m.ContextLines))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovBinoculars(uint64(l))
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovBinoculars(uint64(m.PodNumber))
+	if len(m.Container) > 0 {
+		i -= len(m.Container)
+		copy(dAtA[i:], m.Container)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.Container)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovBinoculars(uint64(l))
+	if m.IncludeGang {
+		i--
+		if m.IncludeGang {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.SinceTime)
-	if l > 0 {
-		n += 1 + l + sovBinoculars(uint64(l))
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LogOptions != nil {
-		l = m.LogOptions.Size()
-		n += 1 + l + sovBinoculars(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LogResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Log) > 0 {
-		for _, e := range m.Log {
-			l = e.Size()
-			n += 1 + l + sovBinoculars(uint64(l))
-		}
+func (m *LogSearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LogLine) Size() (n int) {
-	if m == nil {
+func (m *LogSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBinoculars(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogSearchLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogSearchLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogSearchLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Match {
+		i--
+		if m.Match {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Line) > 0 {
+		i -= len(m.Line)
+		copy(dAtA[i:], m.Line)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.Line)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Container) > 0 {
+		i -= len(m.Container)
+		copy(dAtA[i:], m.Container)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.Container)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintBinoculars(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CordonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CordonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CordonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBinoculars(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBinoculars(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovBinoculars(uint64(m.PodNumber))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	l = len(m.SinceTime)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	if m.LogOptions != nil {
+		l = m.LogOptions.Size()
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	return n
+}
+
+func (m *LogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		for _, e := range m.Log {
+			l = e.Size()
+			n += 1 + l + sovBinoculars(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LogLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	l = len(m.Line)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	return n
+}
+
+func (m *LogSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	if m.IncludeGang {
+		n += 2
+	}
+	l = len(m.Container)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	if m.ContextLines != 0 {
+		n += 1 + sovBinoculars(uint64(m.ContextLines))
+	}
+	l = len(m.SinceTime)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	l = len(m.UntilTime)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	return n
+}
+
+func (m *LogSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lines) > 0 {
+		for _, e := range m.Lines {
+			l = e.Size()
+			n += 1 + l + sovBinoculars(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *LogSearchLine) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovBinoculars(uint64(m.PodNumber))
+	}
+	l = len(m.Container)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
 	l = len(m.Timestamp)
 	if l > 0 {
 		n += 1 + l + sovBinoculars(uint64(l))
@@ -652,29 +1234,431 @@ func (m *LogLine) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBinoculars(uint64(l))
 	}
-	return n
-}
+	if m.Match {
+		n += 2
+	}
+	return n
+}
+
+func (m *CordonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	return n
+}
+
+func sovBinoculars(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBinoculars(x uint64) (n int) {
+	return sovBinoculars(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBinoculars
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SinceTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogOptions == nil {
+				m.LogOptions = &v1.PodLogOptions{}
+			}
+			if err := m.LogOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBinoculars(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBinoculars
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = append(m.Log, &LogLine{})
+			if err := m.Log[len(m.Log)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBinoculars(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBinoculars
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Line = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBinoculars(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *CordonRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovBinoculars(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBinoculars(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBinoculars(x uint64) (n int) {
-	return sovBinoculars(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *LogRequest) Unmarshal(dAtA []byte) error {
+func (m *LogSearchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -697,10 +1681,10 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogSearchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -736,10 +1720,10 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
 			}
-			m.PodNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBinoculars
@@ -749,14 +1733,47 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeGang", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeGang = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -784,11 +1801,11 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -816,13 +1833,32 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SinceTime = string(dAtA[iNdEx:postIndex])
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextLines", wireType)
+			}
+			m.ContextLines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextLines |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBinoculars
@@ -832,27 +1868,55 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBinoculars
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBinoculars
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogOptions == nil {
-				m.LogOptions = &v1.PodLogOptions{}
+			m.SinceTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilTime", wireType)
 			}
-			if err := m.LogOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.UntilTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -875,7 +1939,7 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogResponse) Unmarshal(dAtA []byte) error {
+func (m *LogSearchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -898,15 +1962,15 @@ func (m *LogResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogSearchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -933,11 +1997,31 @@ func (m *LogResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Log = append(m.Log, &LogLine{})
-			if err := m.Log[len(m.Log)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Lines = append(m.Lines, &LogSearchLine{})
+			if err := m.Lines[len(m.Lines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinoculars(dAtA[iNdEx:])
@@ -959,7 +2043,7 @@ func (m *LogResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogLine) Unmarshal(dAtA []byte) error {
+func (m *LogSearchLine) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -982,13 +2066,96 @@ func (m *LogLine) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogLine: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogSearchLine: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogLine: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogSearchLine: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
@@ -1020,7 +2187,7 @@ func (m *LogLine) Unmarshal(dAtA []byte) error {
 			}
 			m.Timestamp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
@@ -1052,6 +2219,26 @@ func (m *LogLine) Unmarshal(dAtA []byte) error {
 			}
 			m.Line = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Match = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinoculars(dAtA[iNdEx:])
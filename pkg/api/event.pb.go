@@ -69,6 +69,42 @@ func (Cause) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7758595c3bb8cf56, []int{0}
 }
 
+// Reason for the scheduler preempting a job.
+type PreemptionReason int32
+
+const (
+	// The reason is unknown, e.g., because the job was preempted by an older version of the scheduler.
+	PreemptionReason_UnknownPreemptionReason PreemptionReason = 0
+	// Preempted to balance resources between queues according to their fair share.
+	PreemptionReason_FairShare PreemptionReason = 1
+	// Preempted to make room for jobs of a higher priority class, i.e., a more urgent job.
+	PreemptionReason_Urgency PreemptionReason = 2
+	// Preempted because the node it was running on was marked for maintenance and is being drained.
+	PreemptionReason_NodeDrain PreemptionReason = 3
+)
+
+var PreemptionReason_name = map[int32]string{
+	0: "UnknownPreemptionReason",
+	1: "FairShare",
+	2: "Urgency",
+	3: "NodeDrain",
+}
+
+var PreemptionReason_value = map[string]int32{
+	"UnknownPreemptionReason": 0,
+	"FairShare":               1,
+	"Urgency":                 2,
+	"NodeDrain":               3,
+}
+
+func (x PreemptionReason) String() string {
+	return proto.EnumName(PreemptionReason_name, int32(x))
+}
+
+func (PreemptionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{1}
+}
+
 type JobSubmittedEvent struct {
 	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -653,6 +689,8 @@ type JobRunningEvent struct {
 	PodNumber    int32     `protobuf:"varint,8,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
 	PodName      string    `protobuf:"bytes,9,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
 	PodNamespace string    `protobuf:"bytes,10,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+	// Snapshot of the labels of the node the job is running on, restricted to those the executor is configured to report.
+	NodeLabels map[string]string `protobuf:"bytes,11,rep,name=node_labels,json=nodeLabels,proto3" json:"nodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *JobRunningEvent) Reset()      { *m = JobRunningEvent{} }
@@ -757,6 +795,13 @@ func (m *JobRunningEvent) GetPodNamespace() string {
 	return ""
 }
 
+func (m *JobRunningEvent) GetNodeLabels() map[string]string {
+	if m != nil {
+		return m.NodeLabels
+	}
+	return nil
+}
+
 type JobIngressInfoEvent struct {
 	JobId            string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId         string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -880,6 +925,177 @@ func (m *JobIngressInfoEvent) GetIngressAddresses() map[int32]string {
 	return nil
 }
 
+// Reported once the artifacts of a job, e.g., the logs of its containers, have been uploaded to object storage.
+type JobArtifactsEvent struct {
+	JobId        string      `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId     string      `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue        string      `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created      time.Time   `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId    string      `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	KubernetesId string      `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	PodNumber    int32       `protobuf:"varint,7,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	Artifacts    []*Artifact `protobuf:"bytes,8,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+}
+
+func (m *JobArtifactsEvent) Reset()      { *m = JobArtifactsEvent{} }
+func (*JobArtifactsEvent) ProtoMessage() {}
+func (*JobArtifactsEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{9}
+}
+func (m *JobArtifactsEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobArtifactsEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobArtifactsEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobArtifactsEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobArtifactsEvent.Merge(m, src)
+}
+func (m *JobArtifactsEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobArtifactsEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobArtifactsEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobArtifactsEvent proto.InternalMessageInfo
+
+func (m *JobArtifactsEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobArtifactsEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobArtifactsEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobArtifactsEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobArtifactsEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *JobArtifactsEvent) GetKubernetesId() string {
+	if m != nil {
+		return m.KubernetesId
+	}
+	return ""
+}
+
+func (m *JobArtifactsEvent) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
+}
+
+func (m *JobArtifactsEvent) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+// An object uploaded to object storage.
+type Artifact struct {
+	// Name of the artifact, e.g., "log".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of the container the artifact was collected from.
+	ContainerName string `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"containerName,omitempty"`
+	// URL of the uploaded object.
+	Url       string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	SizeBytes int64  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+}
+
+func (m *Artifact) Reset()      { *m = Artifact{} }
+func (*Artifact) ProtoMessage() {}
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{10}
+}
+func (m *Artifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Artifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Artifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Artifact.Merge(m, src)
+}
+func (m *Artifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *Artifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_Artifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Artifact proto.InternalMessageInfo
+
+func (m *Artifact) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Artifact) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *Artifact) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Artifact) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
 type JobUnableToScheduleEvent struct {
 	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -897,7 +1113,7 @@ type JobUnableToScheduleEvent struct {
 func (m *JobUnableToScheduleEvent) Reset()      { *m = JobUnableToScheduleEvent{} }
 func (*JobUnableToScheduleEvent) ProtoMessage() {}
 func (*JobUnableToScheduleEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{9}
+	return fileDescriptor_7758595c3bb8cf56, []int{11}
 }
 func (m *JobUnableToScheduleEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,12 +1234,14 @@ type JobFailedEvent struct {
 	PodNamespace      string             `protobuf:"bytes,14,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
 	ContainerStatuses []*ContainerStatus `protobuf:"bytes,11,rep,name=container_statuses,json=containerStatuses,proto3" json:"containerStatuses,omitempty"`
 	Cause             Cause              `protobuf:"varint,12,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
+	// Category of the failure, for aggregating failures and deciding whether to retry the job.
+	FailureCategory FailureCategory `protobuf:"varint,15,opt,name=failure_category,json=failureCategory,proto3,enum=api.FailureCategory" json:"failureCategory,omitempty"`
 }
 
 func (m *JobFailedEvent) Reset()      { *m = JobFailedEvent{} }
 func (*JobFailedEvent) ProtoMessage() {}
 func (*JobFailedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{10}
+	return fileDescriptor_7758595c3bb8cf56, []int{12}
 }
 func (m *JobFailedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,6 +1369,13 @@ func (m *JobFailedEvent) GetCause() Cause {
 	return Cause_Error
 }
 
+func (m *JobFailedEvent) GetFailureCategory() FailureCategory {
+	if m != nil {
+		return m.FailureCategory
+	}
+	return FailureCategory_UnknownFailureCategory
+}
+
 type JobPreemptedEvent struct {
 	JobId           string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId        string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -1160,12 +1385,18 @@ type JobPreemptedEvent struct {
 	RunId           string    `protobuf:"bytes,6,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
 	PreemptiveJobId string    `protobuf:"bytes,7,opt,name=preemptive_job_id,json=preemptiveJobId,proto3" json:"preemptiveJobId,omitempty"`
 	PreemptiveRunId string    `protobuf:"bytes,8,opt,name=preemptive_run_id,json=preemptiveRunId,proto3" json:"preemptiveRunId,omitempty"`
+	// Why the job was preempted.
+	Reason PreemptionReason `protobuf:"varint,9,opt,name=reason,proto3,enum=api.PreemptionReason" json:"reason,omitempty"`
+	// Queue of the job that caused the preemption, if any.
+	PreemptiveQueue string `protobuf:"bytes,10,opt,name=preemptive_queue,json=preemptiveQueue,proto3" json:"preemptiveQueue,omitempty"`
+	// Node the job was running on when preempted.
+	NodeName string `protobuf:"bytes,11,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
 }
 
 func (m *JobPreemptedEvent) Reset()      { *m = JobPreemptedEvent{} }
 func (*JobPreemptedEvent) ProtoMessage() {}
 func (*JobPreemptedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{11}
+	return fileDescriptor_7758595c3bb8cf56, []int{13}
 }
 func (m *JobPreemptedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,6 +1481,27 @@ func (m *JobPreemptedEvent) GetPreemptiveRunId() string {
 	return ""
 }
 
+func (m *JobPreemptedEvent) GetReason() PreemptionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return PreemptionReason_UnknownPreemptionReason
+}
+
+func (m *JobPreemptedEvent) GetPreemptiveQueue() string {
+	if m != nil {
+		return m.PreemptiveQueue
+	}
+	return ""
+}
+
+func (m *JobPreemptedEvent) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
 // Only used internally by Armada
 type JobFailedEventCompressed struct {
 	Event []byte `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -1258,7 +1510,7 @@ type JobFailedEventCompressed struct {
 func (m *JobFailedEventCompressed) Reset()      { *m = JobFailedEventCompressed{} }
 func (*JobFailedEventCompressed) ProtoMessage() {}
 func (*JobFailedEventCompressed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{12}
+	return fileDescriptor_7758595c3bb8cf56, []int{14}
 }
 func (m *JobFailedEventCompressed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1562,7 @@ type JobSucceededEvent struct {
 func (m *JobSucceededEvent) Reset()      { *m = JobSucceededEvent{} }
 func (*JobSucceededEvent) ProtoMessage() {}
 func (*JobSucceededEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{13}
+	return fileDescriptor_7758595c3bb8cf56, []int{15}
 }
 func (m *JobSucceededEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1422,12 +1674,14 @@ type JobUtilisationEvent struct {
 	PodName               string                       `protobuf:"bytes,10,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
 	PodNamespace          string                       `protobuf:"bytes,11,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
 	TotalCumulativeUsage  map[string]resource.Quantity `protobuf:"bytes,12,rep,name=total_cumulative_usage,json=totalCumulativeUsage,proto3" json:"totalCumulativeUsage" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Average usage sampled since the job started running.
+	AverageResources map[string]resource.Quantity `protobuf:"bytes,13,rep,name=average_resources,json=averageResources,proto3" json:"averageResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *JobUtilisationEvent) Reset()      { *m = JobUtilisationEvent{} }
 func (*JobUtilisationEvent) ProtoMessage() {}
 func (*JobUtilisationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{14}
+	return fileDescriptor_7758595c3bb8cf56, []int{16}
 }
 func (m *JobUtilisationEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1540,6 +1794,13 @@ func (m *JobUtilisationEvent) GetTotalCumulativeUsage() map[string]resource.Quan
 	return nil
 }
 
+func (m *JobUtilisationEvent) GetAverageResources() map[string]resource.Quantity {
+	if m != nil {
+		return m.AverageResources
+	}
+	return nil
+}
+
 type JobReprioritizingEvent struct {
 	JobId       string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId    string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -1552,7 +1813,7 @@ type JobReprioritizingEvent struct {
 func (m *JobReprioritizingEvent) Reset()      { *m = JobReprioritizingEvent{} }
 func (*JobReprioritizingEvent) ProtoMessage() {}
 func (*JobReprioritizingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{15}
+	return fileDescriptor_7758595c3bb8cf56, []int{17}
 }
 func (m *JobReprioritizingEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1896,7 @@ type JobReprioritizedEvent struct {
 func (m *JobReprioritizedEvent) Reset()      { *m = JobReprioritizedEvent{} }
 func (*JobReprioritizedEvent) ProtoMessage() {}
 func (*JobReprioritizedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{16}
+	return fileDescriptor_7758595c3bb8cf56, []int{18}
 }
 func (m *JobReprioritizedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1718,7 +1979,7 @@ type JobCancellingEvent struct {
 func (m *JobCancellingEvent) Reset()      { *m = JobCancellingEvent{} }
 func (*JobCancellingEvent) ProtoMessage() {}
 func (*JobCancellingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{17}
+	return fileDescriptor_7758595c3bb8cf56, []int{19}
 }
 func (m *JobCancellingEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1801,7 +2062,7 @@ type JobCancelledEvent struct {
 func (m *JobCancelledEvent) Reset()      { *m = JobCancelledEvent{} }
 func (*JobCancelledEvent) ProtoMessage() {}
 func (*JobCancelledEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{18}
+	return fileDescriptor_7758595c3bb8cf56, []int{20}
 }
 func (m *JobCancelledEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1888,7 +2149,7 @@ type JobTerminatedEvent struct {
 func (m *JobTerminatedEvent) Reset()      { *m = JobTerminatedEvent{} }
 func (*JobTerminatedEvent) ProtoMessage() {}
 func (*JobTerminatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{19}
+	return fileDescriptor_7758595c3bb8cf56, []int{21}
 }
 func (m *JobTerminatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2260,7 @@ type JobUpdatedEvent struct {
 func (m *JobUpdatedEvent) Reset()      { *m = JobUpdatedEvent{} }
 func (*JobUpdatedEvent) ProtoMessage() {}
 func (*JobUpdatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{20}
+	return fileDescriptor_7758595c3bb8cf56, []int{22}
 }
 func (m *JobUpdatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2093,13 +2354,14 @@ type EventMessage struct {
 	//	*EventMessage_Updated
 	//	*EventMessage_FailedCompressed
 	//	*EventMessage_Preempted
+	//	*EventMessage_Artifacts
 	Events isEventMessage_Events `protobuf_oneof:"events"`
 }
 
 func (m *EventMessage) Reset()      { *m = EventMessage{} }
 func (*EventMessage) ProtoMessage() {}
 func (*EventMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{21}
+	return fileDescriptor_7758595c3bb8cf56, []int{23}
 }
 func (m *EventMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2197,6 +2459,9 @@ type EventMessage_FailedCompressed struct {
 type EventMessage_Preempted struct {
 	Preempted *JobPreemptedEvent `protobuf:"bytes,21,opt,name=preempted,proto3,oneof" json:"preempted,omitempty"`
 }
+type EventMessage_Artifacts struct {
+	Artifacts *JobArtifactsEvent `protobuf:"bytes,22,opt,name=artifacts,proto3,oneof" json:"artifacts,omitempty"`
+}
 
 func (*EventMessage_Submitted) isEventMessage_Events()        {}
 func (*EventMessage_Queued) isEventMessage_Events()           {}
@@ -2219,6 +2484,7 @@ func (*EventMessage_Reprioritizing) isEventMessage_Events()   {}
 func (*EventMessage_Updated) isEventMessage_Events()          {}
 func (*EventMessage_FailedCompressed) isEventMessage_Events() {}
 func (*EventMessage_Preempted) isEventMessage_Events()        {}
+func (*EventMessage_Artifacts) isEventMessage_Events()        {}
 
 func (m *EventMessage) GetEvents() isEventMessage_Events {
 	if m != nil {
@@ -2374,6 +2640,13 @@ func (m *EventMessage) GetPreempted() *JobPreemptedEvent {
 	return nil
 }
 
+func (m *EventMessage) GetArtifacts() *JobArtifactsEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Artifacts); ok {
+		return x.Artifacts
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2398,6 +2671,7 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessage_Updated)(nil),
 		(*EventMessage_FailedCompressed)(nil),
 		(*EventMessage_Preempted)(nil),
+		(*EventMessage_Artifacts)(nil),
 	}
 }
 
@@ -2412,7 +2686,7 @@ type ContainerStatus struct {
 func (m *ContainerStatus) Reset()      { *m = ContainerStatus{} }
 func (*ContainerStatus) ProtoMessage() {}
 func (*ContainerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{22}
+	return fileDescriptor_7758595c3bb8cf56, []int{24}
 }
 func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2483,7 +2757,7 @@ type EventList struct {
 func (m *EventList) Reset()      { *m = EventList{} }
 func (*EventList) ProtoMessage() {}
 func (*EventList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{23}
+	return fileDescriptor_7758595c3bb8cf56, []int{25}
 }
 func (m *EventList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2528,7 +2802,7 @@ type EventStreamMessage struct {
 func (m *EventStreamMessage) Reset()      { *m = EventStreamMessage{} }
 func (*EventStreamMessage) ProtoMessage() {}
 func (*EventStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{24}
+	return fileDescriptor_7758595c3bb8cf56, []int{26}
 }
 func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2585,7 +2859,7 @@ type JobSetRequest struct {
 func (m *JobSetRequest) Reset()      { *m = JobSetRequest{} }
 func (*JobSetRequest) ProtoMessage() {}
 func (*JobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{25}
+	return fileDescriptor_7758595c3bb8cf56, []int{27}
 }
 func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2674,7 +2948,7 @@ type WatchRequest struct {
 func (m *WatchRequest) Reset()      { *m = WatchRequest{} }
 func (*WatchRequest) ProtoMessage() {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{26}
+	return fileDescriptor_7758595c3bb8cf56, []int{28}
 }
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2738,218 +3012,574 @@ func (m *WatchRequest) GetForceNew() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterEnum("api.Cause", Cause_name, Cause_value)
-	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
-	proto.RegisterType((*JobQueuedEvent)(nil), "api.JobQueuedEvent")
-	proto.RegisterType((*JobDuplicateFoundEvent)(nil), "api.JobDuplicateFoundEvent")
-	proto.RegisterType((*JobLeasedEvent)(nil), "api.JobLeasedEvent")
-	proto.RegisterType((*JobLeaseReturnedEvent)(nil), "api.JobLeaseReturnedEvent")
-	proto.RegisterType((*JobLeaseExpiredEvent)(nil), "api.JobLeaseExpiredEvent")
-	proto.RegisterType((*JobPendingEvent)(nil), "api.JobPendingEvent")
-	proto.RegisterType((*JobRunningEvent)(nil), "api.JobRunningEvent")
-	proto.RegisterType((*JobIngressInfoEvent)(nil), "api.JobIngressInfoEvent")
-	proto.RegisterMapType((map[int32]string)(nil), "api.JobIngressInfoEvent.IngressAddressesEntry")
-	proto.RegisterType((*JobUnableToScheduleEvent)(nil), "api.JobUnableToScheduleEvent")
-	proto.RegisterType((*JobFailedEvent)(nil), "api.JobFailedEvent")
-	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
-	proto.RegisterType((*JobPreemptedEvent)(nil), "api.JobPreemptedEvent")
-	proto.RegisterType((*JobFailedEventCompressed)(nil), "api.JobFailedEventCompressed")
-	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
-	proto.RegisterType((*JobUtilisationEvent)(nil), "api.JobUtilisationEvent")
-	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.MaxResourcesForPeriodEntry")
-	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.TotalCumulativeUsageEntry")
-	proto.RegisterType((*JobReprioritizingEvent)(nil), "api.JobReprioritizingEvent")
-	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
-	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
-	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
-	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
-	proto.RegisterType((*JobUpdatedEvent)(nil), "api.JobUpdatedEvent")
-	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
-	proto.RegisterType((*ContainerStatus)(nil), "api.ContainerStatus")
-	proto.RegisterType((*EventList)(nil), "api.EventList")
-	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
-	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
-	proto.RegisterType((*WatchRequest)(nil), "api.WatchRequest")
+// swagger:model
+type WatchJobSetRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	// Sequence of the last transition received, such that only later transitions are returned.
+	// If empty, all transitions of the job set are returned.
+	FromSequence string `protobuf:"bytes,3,opt,name=from_sequence,json=fromSequence,proto3" json:"fromSequence,omitempty"`
 }
 
-func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
-
-var fileDescriptor_7758595c3bb8cf56 = []byte{
-	// 2578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xd7, 0x52, 0xe2, 0xd7, 0x48, 0xa2, 0xa4, 0xd1, 0x87, 0xd7, 0xb4, 0x2d, 0x0a, 0x0c, 0xf0,
-	0x8f, 0x62, 0xc4, 0x64, 0xfe, 0x72, 0x52, 0x18, 0x46, 0xd0, 0xc0, 0x94, 0xe5, 0x44, 0x82, 0x1d,
-	0x3b, 0x94, 0x8d, 0xb4, 0x45, 0x00, 0x66, 0xb9, 0x3b, 0xa2, 0x56, 0x22, 0x77, 0x36, 0xbb, 0xb3,
-	0xb6, 0x15, 0x23, 0x40, 0xd1, 0xa2, 0x45, 0x2e, 0x45, 0x53, 0xb4, 0xf7, 0xe4, 0xdc, 0x5e, 0x7a,
-	0xe9, 0xb5, 0x87, 0xa2, 0x87, 0xf4, 0xe6, 0xa2, 0x28, 0x90, 0x13, 0xdb, 0xda, 0x09, 0x50, 0xf0,
-	0xd0, 0x7b, 0x6f, 0xc5, 0xbc, 0x99, 0x25, 0x67, 0x56, 0x14, 0x24, 0x2b, 0x4d, 0x6a, 0x08, 0xba,
-	0x24, 0xe6, 0xef, 0xcd, 0x7b, 0xf3, 0xf6, 0xcd, 0xef, 0xcd, 0xbc, 0xf9, 0x10, 0x9a, 0xf5, 0x77,
-	0x5b, 0x55, 0xcb, 0x77, 0xab, 0xe4, 0x3e, 0xf1, 0x58, 0xc5, 0x0f, 0x28, 0xa3, 0x78, 0xd4, 0xf2,
-	0xdd, 0x62, 0xa9, 0x45, 0x69, 0xab, 0x4d, 0xaa, 0x00, 0x35, 0xa3, 0xad, 0x2a, 0x73, 0x3b, 0x24,
-	0x64, 0x56, 0xc7, 0x17, 0xad, 0x8a, 0x7d, 0xd5, 0x0f, 0x22, 0x12, 0x11, 0x09, 0xce, 0xc5, 0xe0,
-	0x36, 0xb1, 0xda, 0x6c, 0x5b, 0xa2, 0xe7, 0x92, 0xb6, 0x48, 0xc7, 0x67, 0x7b, 0x52, 0x78, 0xa9,
-	0xe5, 0xb2, 0xed, 0xa8, 0x59, 0xb1, 0x69, 0xa7, 0xda, 0xa2, 0x2d, 0x3a, 0x68, 0xc5, 0x7f, 0xc1,
-	0x0f, 0xf8, 0x97, 0x6c, 0x7e, 0x5e, 0xda, 0xe2, 0x9d, 0x58, 0x9e, 0x47, 0x99, 0xc5, 0x5c, 0xea,
-	0x85, 0x52, 0xfa, 0xea, 0xee, 0x95, 0xb0, 0xe2, 0x52, 0x2e, 0xed, 0x58, 0xf6, 0xb6, 0xeb, 0x91,
-	0x60, 0xaf, 0x1a, 0xfb, 0x14, 0x90, 0x90, 0x46, 0x81, 0x4d, 0xaa, 0x2d, 0xe2, 0x91, 0xc0, 0x62,
-	0xc4, 0x11, 0x5a, 0xe5, 0x5f, 0xa5, 0xd0, 0xcc, 0x06, 0x6d, 0x6e, 0x46, 0xcd, 0x8e, 0xcb, 0x18,
-	0x71, 0xd6, 0x78, 0x30, 0xf0, 0x45, 0x94, 0xd9, 0xa1, 0xcd, 0x86, 0xeb, 0x98, 0xc6, 0x92, 0xb1,
-	0x9c, 0xaf, 0xcd, 0xf6, 0xba, 0xa5, 0xa9, 0x1d, 0xda, 0x5c, 0x77, 0x5e, 0xa6, 0x1d, 0x97, 0xc1,
-	0x37, 0xd4, 0xd3, 0x00, 0xe0, 0x57, 0x11, 0xe2, 0x6d, 0x43, 0xc2, 0x78, 0xfb, 0x14, 0xb4, 0x5f,
-	0xe8, 0x75, 0x4b, 0x78, 0x87, 0x36, 0x37, 0x09, 0xd3, 0x54, 0x72, 0x31, 0x86, 0x5f, 0x42, 0x69,
-	0x08, 0x9e, 0x39, 0x3a, 0xe8, 0x00, 0x00, 0xb5, 0x03, 0x00, 0xf0, 0x3a, 0xca, 0xda, 0x01, 0xe1,
-	0x3e, 0x9b, 0x63, 0x4b, 0xc6, 0xf2, 0xf8, 0x4a, 0xb1, 0x22, 0x02, 0x51, 0x89, 0xc3, 0x55, 0xb9,
-	0x1b, 0x0f, 0x50, 0x6d, 0xf6, 0xf3, 0x6e, 0x69, 0xa4, 0xd7, 0x2d, 0xc5, 0x2a, 0x9f, 0xfc, 0xad,
-	0x64, 0xd4, 0xe3, 0x1f, 0xf8, 0x45, 0x34, 0xba, 0x43, 0x9b, 0x66, 0x1a, 0xcc, 0xe4, 0x2a, 0x96,
-	0xef, 0x56, 0x36, 0x68, 0xb3, 0x36, 0x2e, 0x95, 0xb8, 0xb0, 0xce, 0xff, 0x53, 0xfe, 0xa7, 0x81,
-	0x0a, 0x1b, 0xb4, 0xf9, 0x0e, 0x77, 0xe0, 0x64, 0xc7, 0xa4, 0xfc, 0xbb, 0x14, 0x5a, 0xd8, 0xa0,
-	0xcd, 0xeb, 0x91, 0xdf, 0x76, 0x6d, 0x8b, 0x91, 0x1b, 0x34, 0xf2, 0x4e, 0x38, 0x0d, 0x56, 0xd1,
-	0x14, 0x0d, 0xdc, 0x96, 0xeb, 0x59, 0xed, 0x86, 0xfc, 0xc0, 0x34, 0xf4, 0x7f, 0xae, 0xd7, 0x2d,
-	0x9d, 0x89, 0x45, 0x1b, 0x89, 0x0f, 0x9d, 0xd4, 0x04, 0xe5, 0xcf, 0x52, 0x40, 0x91, 0x9b, 0xc4,
-	0x0a, 0x4f, 0x7a, 0xda, 0x7c, 0x07, 0x21, 0xbb, 0x1d, 0x85, 0x8c, 0x04, 0x83, 0x50, 0x9d, 0xe9,
-	0x75, 0x4b, 0xb3, 0x12, 0xd5, 0x9c, 0xcd, 0xf7, 0xc1, 0xf2, 0xcf, 0xc7, 0xd0, 0x7c, 0x1c, 0xa2,
-	0x3a, 0x61, 0x51, 0xe0, 0x9d, 0x46, 0x6a, 0x68, 0xa4, 0xf0, 0xcb, 0x28, 0x13, 0x10, 0x2b, 0xa4,
-	0x9e, 0x99, 0x01, 0x9d, 0xb9, 0x5e, 0xb7, 0x34, 0x2d, 0x10, 0x45, 0x41, 0xb6, 0xc1, 0x6f, 0xa0,
-	0xc9, 0xdd, 0xa8, 0x49, 0x02, 0x8f, 0x30, 0x12, 0xf2, 0x8e, 0xb2, 0xa0, 0x54, 0xec, 0x75, 0x4b,
-	0x0b, 0x03, 0x81, 0xd6, 0xd7, 0x84, 0x8a, 0x73, 0x37, 0x7d, 0xea, 0x34, 0xbc, 0xa8, 0xd3, 0x24,
-	0x81, 0x99, 0x5b, 0x32, 0x96, 0xd3, 0xc2, 0x4d, 0x9f, 0x3a, 0x6f, 0x03, 0xa8, 0xba, 0xd9, 0x07,
-	0x79, 0xc7, 0x41, 0xe4, 0x35, 0x2c, 0x06, 0x22, 0xe2, 0x98, 0xf9, 0x25, 0x63, 0x39, 0x27, 0x3a,
-	0x0e, 0x22, 0xef, 0x5a, 0x8c, 0xab, 0x1d, 0xab, 0x78, 0xf9, 0x5f, 0x06, 0x9a, 0x8b, 0x19, 0xb1,
-	0xf6, 0xd0, 0x77, 0x83, 0x93, 0x3e, 0xbb, 0xfe, 0x6c, 0x0c, 0x4d, 0x6d, 0xd0, 0xe6, 0x1d, 0xe2,
-	0x39, 0xae, 0xd7, 0x3a, 0x25, 0xff, 0x30, 0xf2, 0xef, 0xa3, 0x73, 0xe6, 0x6b, 0xd1, 0x39, 0x7b,
-	0x64, 0x3a, 0xbf, 0x82, 0x72, 0xa0, 0x67, 0x75, 0x08, 0x24, 0x41, 0xbe, 0x36, 0xdf, 0xeb, 0x96,
-	0x66, 0x78, 0x03, 0xab, 0xa3, 0xc6, 0x2a, 0x2b, 0x21, 0xee, 0x6a, 0xac, 0x11, 0xfa, 0x96, 0x4d,
-	0x20, 0x01, 0xa4, 0xab, 0xb2, 0x0d, 0xe0, 0xaa, 0xab, 0x2a, 0x5e, 0xfe, 0x83, 0xe0, 0x43, 0x3d,
-	0xf2, 0xbc, 0x53, 0x3e, 0x7c, 0x53, 0x7c, 0xb8, 0x8c, 0xf2, 0x1e, 0x75, 0x88, 0x18, 0xd8, 0xec,
-	0x20, 0x46, 0x1c, 0x4c, 0x8c, 0x6c, 0x2e, 0xc6, 0x8e, 0x3d, 0x27, 0xaa, 0x24, 0xca, 0x1f, 0x8f,
-	0x44, 0xe8, 0x19, 0x49, 0xf4, 0xdb, 0x0c, 0x9a, 0xe5, 0x45, 0x88, 0xd7, 0x0a, 0x48, 0x18, 0xae,
-	0x7b, 0x5b, 0xf4, 0x94, 0x48, 0x27, 0x8b, 0x48, 0xe8, 0x78, 0x44, 0x1a, 0x7f, 0x36, 0x22, 0xe1,
-	0x47, 0x68, 0xc6, 0x15, 0x24, 0x6a, 0x58, 0x8e, 0xc3, 0xff, 0x4f, 0x42, 0x33, 0xbf, 0x34, 0xba,
-	0x3c, 0xbe, 0x52, 0x89, 0x77, 0x47, 0x49, 0x96, 0x55, 0x24, 0x70, 0x2d, 0x56, 0x58, 0xf3, 0x58,
-	0xb0, 0x57, 0x5b, 0xec, 0x75, 0x4b, 0x45, 0x37, 0x21, 0x52, 0x3a, 0x9e, 0x4e, 0xca, 0x8a, 0xbb,
-	0x68, 0x7e, 0xa8, 0x29, 0xfc, 0x02, 0x1a, 0xdd, 0x25, 0x7b, 0xc0, 0xe1, 0x74, 0x6d, 0xa6, 0xd7,
-	0x2d, 0x4d, 0xee, 0x92, 0x3d, 0xc5, 0x14, 0x97, 0x72, 0x26, 0xde, 0xb7, 0xda, 0x11, 0x91, 0xd4,
-	0x05, 0x26, 0x02, 0xa0, 0x32, 0x11, 0x80, 0xab, 0xa9, 0x2b, 0x46, 0xf9, 0xdf, 0x63, 0xc8, 0xdc,
-	0xa0, 0xcd, 0x7b, 0x9e, 0xd5, 0x6c, 0x93, 0xbb, 0x74, 0xd3, 0xde, 0x26, 0x4e, 0xd4, 0x26, 0xa7,
-	0x79, 0xf3, 0x1c, 0x54, 0xa3, 0x5a, 0x96, 0xe5, 0x8e, 0x95, 0x65, 0xf9, 0xe7, 0x38, 0xcb, 0xca,
-	0x8f, 0xb3, 0xb0, 0x53, 0xbc, 0x61, 0xb9, 0xed, 0xd3, 0xfd, 0xcf, 0x7f, 0x83, 0x71, 0xef, 0x21,
-	0x44, 0x1e, 0xba, 0xac, 0x61, 0x53, 0x87, 0x84, 0x66, 0x16, 0xe6, 0xab, 0x72, 0x3c, 0x5f, 0x29,
-	0x61, 0xae, 0xac, 0x3d, 0x74, 0xd9, 0x2a, 0x6f, 0x24, 0xe6, 0xa8, 0xb3, 0xdc, 0x13, 0x12, 0x63,
-	0x03, 0xc3, 0xa6, 0x51, 0xcf, 0xf7, 0xe1, 0xfd, 0x7c, 0xce, 0x7d, 0x1d, 0x3e, 0xe7, 0x8f, 0xc5,
-	0x67, 0x74, 0x2c, 0x3e, 0x4f, 0x1e, 0x8f, 0xcf, 0x85, 0x67, 0x5c, 0x35, 0x1c, 0x84, 0x6d, 0xea,
-	0x31, 0xcb, 0xf5, 0x48, 0xd0, 0x08, 0x99, 0xc5, 0x22, 0xbe, 0x6c, 0x8c, 0xc3, 0x30, 0xcc, 0xc1,
-	0x30, 0xac, 0xc6, 0xe2, 0x4d, 0x90, 0xd6, 0x4a, 0xbd, 0x6e, 0xe9, 0x9c, 0xad, 0x83, 0xda, 0xea,
-	0x30, 0xb3, 0x4f, 0x88, 0x5f, 0x43, 0x69, 0xdb, 0x8a, 0x42, 0x62, 0x4e, 0x2c, 0x19, 0xcb, 0x85,
-	0x15, 0x24, 0x0c, 0x73, 0x44, 0x90, 0x19, 0x84, 0x2a, 0x99, 0x01, 0x28, 0x3a, 0xa8, 0xa0, 0x8f,
-	0xba, 0xba, 0x9c, 0xe4, 0x8f, 0xb6, 0x9c, 0xa4, 0x0f, 0x5d, 0x4e, 0xbe, 0x1a, 0x85, 0x63, 0xd3,
-	0x3b, 0x01, 0x11, 0x1b, 0xdb, 0xd3, 0xac, 0x1e, 0x96, 0xd5, 0x17, 0x51, 0x26, 0x88, 0xbc, 0x41,
-	0xe1, 0x05, 0xee, 0x06, 0x91, 0xa7, 0xc7, 0x03, 0x00, 0xbc, 0x8e, 0x66, 0x7c, 0x11, 0x4d, 0xf7,
-	0x3e, 0x89, 0x4f, 0xe5, 0xc4, 0x4a, 0x72, 0xa1, 0xd7, 0x2d, 0x9d, 0x1d, 0x08, 0x93, 0xe7, 0x72,
-	0x53, 0x09, 0x51, 0xc2, 0x94, 0xf4, 0x20, 0x37, 0xcc, 0x54, 0x3d, 0xe1, 0xcb, 0x54, 0x42, 0x54,
-	0x5e, 0x83, 0xaa, 0x41, 0x99, 0x52, 0x56, 0x69, 0xc7, 0x87, 0x5a, 0x05, 0xc6, 0x02, 0xae, 0x0e,
-	0x60, 0xb0, 0x27, 0xc4, 0xc7, 0x01, 0xa0, 0x7e, 0x1c, 0x00, 0xe5, 0x3f, 0x8e, 0xc9, 0x53, 0x76,
-	0xdb, 0x26, 0xc4, 0x39, 0xa5, 0xcb, 0xe9, 0xbe, 0xef, 0x58, 0xfb, 0xbe, 0x4f, 0xf3, 0xb0, 0xef,
-	0xbb, 0xc7, 0xdc, 0xb6, 0x1b, 0xc2, 0xe5, 0xcf, 0x29, 0x91, 0xbe, 0x11, 0x22, 0x7d, 0x6c, 0xa0,
-	0xf9, 0x5b, 0xd6, 0xc3, 0xba, 0xbc, 0x35, 0x0b, 0x6f, 0xd0, 0xe0, 0x0e, 0x09, 0x5c, 0xea, 0xc8,
-	0x62, 0xe3, 0x72, 0x5c, 0x6c, 0x24, 0x87, 0xa2, 0x32, 0x54, 0x4b, 0x54, 0x1f, 0x17, 0xe4, 0xb7,
-	0x0e, 0xb7, 0x5c, 0x1f, 0x0e, 0x9f, 0xf4, 0xe2, 0x18, 0xff, 0xd4, 0x40, 0x0b, 0x8c, 0x32, 0xab,
-	0xdd, 0xb0, 0xa3, 0x4e, 0xd4, 0xb6, 0x60, 0xce, 0x8e, 0x42, 0xab, 0xc5, 0x17, 0x7e, 0x1e, 0xeb,
-	0x95, 0x03, 0x63, 0x7d, 0x97, 0xab, 0xad, 0xf6, 0xb5, 0xee, 0x71, 0x25, 0x11, 0xea, 0xf3, 0x32,
-	0xd4, 0x73, 0x6c, 0x48, 0x93, 0xfa, 0x50, 0xb4, 0xf8, 0x99, 0x81, 0x8a, 0x07, 0x8f, 0xde, 0xd1,
-	0xaa, 0x88, 0xef, 0xab, 0x55, 0x04, 0xdf, 0x43, 0x8b, 0x3b, 0xd9, 0x8a, 0x7a, 0x27, 0x5b, 0xf1,
-	0x77, 0x5b, 0xf0, 0x49, 0xf1, 0x9d, 0x6c, 0xe5, 0x9d, 0xc8, 0xf2, 0x98, 0xcb, 0xf6, 0x0e, 0xab,
-	0x3a, 0x8a, 0x9f, 0x1a, 0xe8, 0xec, 0x81, 0x1f, 0xfd, 0x3c, 0x78, 0x58, 0xfe, 0x4a, 0x5c, 0x26,
-	0xd6, 0x89, 0x1f, 0xb8, 0x34, 0x70, 0x99, 0xfb, 0xe1, 0x89, 0x3f, 0xe5, 0x7c, 0x1d, 0x4d, 0x78,
-	0xe4, 0x41, 0x43, 0x7e, 0xf0, 0x1e, 0x4c, 0x53, 0x06, 0x6c, 0x35, 0xe6, 0x3d, 0xf2, 0xe0, 0x8e,
-	0x84, 0x15, 0x17, 0xc6, 0x15, 0x18, 0xbf, 0x86, 0xf2, 0x01, 0xf9, 0x20, 0x22, 0x21, 0xa3, 0x81,
-	0x9c, 0xa6, 0x20, 0x51, 0xfb, 0xa0, 0x9a, 0xa8, 0x7d, 0xb0, 0xfc, 0x65, 0x0a, 0x6e, 0xd6, 0x94,
-	0x38, 0x9f, 0xf4, 0xa2, 0xe2, 0x7f, 0x12, 0xe6, 0x3f, 0xa7, 0x10, 0xde, 0xa0, 0xcd, 0x55, 0xcb,
-	0xb3, 0x49, 0xbb, 0x7d, 0xe2, 0xa9, 0xac, 0x45, 0x29, 0x7d, 0xd4, 0x28, 0x3d, 0xdb, 0xe6, 0xbd,
-	0xfc, 0x58, 0xbc, 0x38, 0x91, 0x31, 0x3d, 0xe9, 0xb4, 0xfd, 0x56, 0x42, 0xfa, 0xfb, 0x31, 0xa0,
-	0xe9, 0x5d, 0x12, 0x74, 0x5c, 0xcf, 0x3a, 0xdd, 0x8e, 0x3e, 0xcf, 0xf7, 0x8c, 0xdf, 0xce, 0x56,
-	0x41, 0x21, 0x50, 0xee, 0x08, 0x04, 0xfa, 0x53, 0x0a, 0x6e, 0x25, 0xef, 0xf9, 0xce, 0xc9, 0x67,
-	0xcf, 0x31, 0x33, 0x52, 0x3e, 0x1d, 0xcb, 0x1c, 0xfa, 0x74, 0xec, 0x37, 0x05, 0x34, 0x01, 0x11,
-	0xbc, 0x45, 0x42, 0x5e, 0x9c, 0xe1, 0xdb, 0x28, 0x1f, 0xc6, 0xcf, 0xeb, 0x20, 0x96, 0xe3, 0x2b,
-	0x0b, 0xb1, 0xbe, 0xfe, 0xee, 0x4e, 0x38, 0xd2, 0x6f, 0x3c, 0x70, 0xe4, 0xad, 0x91, 0xfa, 0xc0,
-	0x06, 0x5e, 0x45, 0x19, 0x88, 0x8a, 0x23, 0x8b, 0xb8, 0xd9, 0xd8, 0x9a, 0xf2, 0x5c, 0x4d, 0x0c,
-	0xb8, 0x68, 0xa6, 0xd9, 0x91, 0xaa, 0xd8, 0x41, 0x53, 0x4e, 0xfc, 0xe4, 0xab, 0xb1, 0x45, 0x23,
-	0xcf, 0x31, 0xa7, 0xc1, 0xda, 0xb9, 0xd8, 0xda, 0x90, 0x17, 0x61, 0xb5, 0xf3, 0xbd, 0x6e, 0xc9,
-	0x74, 0x34, 0x81, 0x66, 0xbd, 0xa0, 0xcb, 0xb8, 0xab, 0x6d, 0x78, 0x20, 0x05, 0x63, 0xac, 0xb8,
-	0xaa, 0x3c, 0x9b, 0x12, 0xae, 0x8a, 0x66, 0xba, 0xab, 0x02, 0xc3, 0xef, 0xa3, 0x02, 0xfc, 0xab,
-	0x11, 0xc8, 0x37, 0x44, 0x7d, 0x0e, 0xa8, 0xc6, 0xb4, 0x07, 0x46, 0xe2, 0x25, 0x57, 0x5b, 0xc5,
-	0x35, 0xd3, 0x93, 0x9a, 0x08, 0xbf, 0x87, 0x04, 0xd0, 0x20, 0xe2, 0x4d, 0x8a, 0x7c, 0x21, 0x78,
-	0x56, 0xeb, 0x40, 0x7d, 0xaf, 0x22, 0x32, 0xb1, 0xad, 0xc0, 0x9a, 0xf9, 0x09, 0x55, 0x82, 0xdf,
-	0x44, 0x59, 0x5f, 0xbc, 0xff, 0x90, 0xf4, 0x99, 0x8b, 0xed, 0xaa, 0xcf, 0x42, 0xe4, 0x9c, 0x20,
-	0x10, 0xcd, 0x5a, 0xac, 0xcd, 0x0d, 0x05, 0xe2, 0xe1, 0x00, 0x4c, 0x3e, 0x8a, 0x21, 0xf5, 0x3d,
-	0x81, 0x30, 0x24, 0x1b, 0xea, 0x86, 0x24, 0x88, 0x3b, 0x08, 0x47, 0x70, 0x13, 0xd6, 0x60, 0xb4,
-	0x11, 0xca, 0xbb, 0x30, 0x98, 0x29, 0xc6, 0x57, 0x2e, 0xf4, 0xf7, 0x5b, 0xc3, 0xee, 0xca, 0xc4,
-	0x3d, 0x5f, 0x94, 0x10, 0x69, 0xbd, 0x4c, 0x27, 0xa5, 0x9c, 0x05, 0x5b, 0x70, 0x84, 0x06, 0xb3,
-	0x9f, 0xc2, 0x02, 0xe5, 0x60, 0x4d, 0xb0, 0x40, 0x34, 0xd3, 0x59, 0x20, 0x30, 0x91, 0x46, 0xf2,
-	0xfc, 0x0c, 0xa6, 0x43, 0x2d, 0x8d, 0xd4, 0x83, 0xb5, 0x38, 0x8d, 0x24, 0x96, 0x4c, 0x23, 0x09,
-	0xe3, 0x06, 0x9a, 0x0c, 0xd4, 0xfa, 0x19, 0xb6, 0xae, 0x0a, 0xab, 0xf6, 0x17, 0xd7, 0x82, 0x55,
-	0x9a, 0x92, 0xce, 0x2a, 0x4d, 0x84, 0x37, 0x11, 0xb2, 0xfb, 0x95, 0x23, 0x1c, 0x63, 0x8f, 0xaf,
-	0x9c, 0x89, 0xad, 0x27, 0x6a, 0xca, 0x9a, 0xc9, 0xb7, 0xab, 0x83, 0xe6, 0x9a, 0x5d, 0xc5, 0x0c,
-	0x0f, 0x83, 0x1d, 0x97, 0x4e, 0x70, 0xe0, 0xaf, 0x84, 0x41, 0xaf, 0xa9, 0xe4, 0x9a, 0x18, 0x63,
-	0x7a, 0x18, 0xfa, 0x30, 0xf7, 0x92, 0xf5, 0x0b, 0x07, 0xb8, 0x0b, 0x50, 0xbc, 0x4c, 0x94, 0x14,
-	0xc2, 0xcb, 0x41, 0x73, 0xdd, 0xcb, 0x01, 0x8e, 0xdf, 0x45, 0xe3, 0xd1, 0x60, 0xbb, 0x6e, 0x4e,
-	0x81, 0x55, 0xf3, 0xa0, 0x9d, 0xbc, 0x28, 0xe3, 0x15, 0x05, 0xcd, 0xae, 0x6a, 0x09, 0x7f, 0x0f,
-	0x4d, 0xc4, 0x37, 0xd6, 0xae, 0xb7, 0x45, 0xcd, 0x19, 0xdd, 0x72, 0xf2, 0xb2, 0x5a, 0x58, 0x76,
-	0x07, 0xa8, 0x6e, 0x59, 0x11, 0x60, 0x1b, 0x15, 0x02, 0x6d, 0xdb, 0x6a, 0x62, 0x7d, 0x3e, 0x1c,
-	0xb2, 0xa9, 0x15, 0xf3, 0xa1, 0xae, 0xa6, 0xcf, 0x87, 0xba, 0x8c, 0x67, 0x70, 0x24, 0x16, 0x59,
-	0x73, 0x56, 0xcf, 0x60, 0x75, 0xed, 0x15, 0x19, 0x2c, 0x1b, 0xea, 0x19, 0x2c, 0x41, 0xbc, 0x8b,
-	0x64, 0xae, 0x0c, 0x0e, 0xa4, 0xcd, 0x39, 0x3d, 0x7f, 0x87, 0x9e, 0x5a, 0x8b, 0xfc, 0x4d, 0xaa,
-	0xea, 0xf9, 0x9b, 0x94, 0x72, 0xce, 0xf9, 0xf1, 0x4d, 0x87, 0x39, 0xaf, 0x73, 0x4e, 0xbf, 0x02,
-	0x91, 0xe5, 0x50, 0x8c, 0xe9, 0x9c, 0xeb, 0xc3, 0xb5, 0x1c, 0xca, 0xc0, 0xc1, 0x78, 0x58, 0xfe,
-	0x71, 0x0a, 0x4d, 0x25, 0x6e, 0x8b, 0xf0, 0xff, 0xa1, 0x31, 0x28, 0x95, 0x44, 0xdd, 0x81, 0x7b,
-	0xdd, 0x52, 0xc1, 0xd3, 0xeb, 0x24, 0x90, 0xe3, 0x15, 0x94, 0x8b, 0x6f, 0xed, 0xe4, 0xb5, 0x0d,
-	0xd4, 0x1c, 0x31, 0xa6, 0xd6, 0x1c, 0x31, 0x86, 0xab, 0x28, 0xdb, 0x11, 0xeb, 0xb2, 0xac, 0x3a,
-	0x20, 0xd4, 0x12, 0x52, 0x2b, 0x31, 0x09, 0x29, 0x85, 0xd4, 0xd8, 0x11, 0x6e, 0x26, 0xfb, 0x97,
-	0x56, 0xe9, 0x67, 0xb9, 0xb4, 0x2a, 0xdf, 0x44, 0x79, 0x08, 0xdf, 0x4d, 0x37, 0x64, 0xf8, 0x8d,
-	0x38, 0x38, 0xa6, 0x01, 0x07, 0x60, 0x33, 0x60, 0x44, 0x2d, 0x29, 0x84, 0x13, 0xa2, 0x91, 0xea,
-	0x84, 0x8c, 0xe9, 0x87, 0x08, 0x43, 0xeb, 0x4d, 0x16, 0x10, 0xab, 0x13, 0x97, 0x21, 0x4b, 0x28,
-	0xd5, 0xaf, 0xe5, 0xa6, 0x7b, 0xdd, 0xd2, 0x84, 0xab, 0x56, 0x65, 0x29, 0xd7, 0xc1, 0xb5, 0x41,
-	0x6c, 0x44, 0x61, 0x31, 0xa4, 0xe7, 0x43, 0xc2, 0x55, 0xfe, 0xc9, 0x28, 0x9a, 0xdc, 0x80, 0x02,
-	0xaf, 0x2e, 0x4a, 0xa7, 0x23, 0xf4, 0xfb, 0x12, 0x4a, 0x3f, 0xb0, 0x98, 0xbd, 0x0d, 0xbd, 0xe6,
-	0x44, 0xa0, 0x00, 0x50, 0x03, 0x05, 0x00, 0x5e, 0x45, 0x53, 0x5b, 0x01, 0xed, 0x34, 0x64, 0x77,
-	0xbc, 0xda, 0x1c, 0x1d, 0xbc, 0xdc, 0xe6, 0x22, 0xe9, 0xa8, 0xfe, 0x72, 0x5b, 0x13, 0x0c, 0xea,
-	0xce, 0xb1, 0x43, 0xeb, 0xce, 0xeb, 0xa8, 0x40, 0x82, 0x80, 0x06, 0xeb, 0x5b, 0xb7, 0xdc, 0x30,
-	0xe4, 0x93, 0x42, 0x1a, 0x7c, 0x84, 0xbc, 0xd7, 0x25, 0x8a, 0x72, 0x42, 0x07, 0xbf, 0x8e, 0x26,
-	0xb6, 0x68, 0x60, 0x93, 0x46, 0x9b, 0xb4, 0x2c, 0x7b, 0x0f, 0xaa, 0x80, 0x9c, 0x98, 0x9a, 0x00,
-	0xbf, 0x09, 0xb0, 0x7a, 0x76, 0xa1, 0xc0, 0xf8, 0x32, 0xca, 0x0b, 0x6d, 0x8f, 0x3c, 0x80, 0x75,
-	0x3f, 0x27, 0x78, 0x0e, 0xe0, 0xdb, 0xe4, 0x81, 0xca, 0xf3, 0x18, 0x2b, 0xff, 0x22, 0x85, 0x26,
-	0xde, 0xe5, 0x21, 0x8b, 0x87, 0xa1, 0xff, 0xd1, 0xc6, 0xa1, 0x1f, 0x7d, 0xbc, 0x6a, 0xfe, 0x12,
-	0xca, 0xc2, 0xd0, 0xf4, 0x87, 0x44, 0x2c, 0xe8, 0x01, 0xed, 0x68, 0x0a, 0x19, 0x81, 0xec, 0x8b,
-	0xc9, 0xd8, 0xf1, 0x63, 0x92, 0x3e, 0x5a, 0x4c, 0x2e, 0x7e, 0x17, 0xa5, 0x21, 0x15, 0x71, 0x1e,
-	0xa5, 0xd7, 0xf8, 0x08, 0x4d, 0x8f, 0xe0, 0x71, 0x94, 0x5d, 0xbb, 0xef, 0xda, 0x8c, 0x38, 0xd3,
-	0x06, 0xce, 0xa2, 0xd1, 0xdb, 0xb7, 0x6f, 0x4d, 0xa7, 0xf0, 0x1c, 0x9a, 0xbe, 0x4e, 0x2c, 0xa7,
-	0xed, 0x7a, 0x64, 0xed, 0xa1, 0x28, 0x17, 0xa6, 0x47, 0x57, 0xfe, 0x9a, 0x42, 0x69, 0xb1, 0x37,
-	0xba, 0x82, 0x0a, 0x75, 0xe2, 0xd3, 0x80, 0xdd, 0x8a, 0xda, 0xcc, 0xf5, 0xdb, 0x04, 0x17, 0x06,
-	0xa9, 0xc2, 0x93, 0xb8, 0xb8, 0xb0, 0x6f, 0x7f, 0xb2, 0xc6, 0xbd, 0xc1, 0x97, 0x51, 0x46, 0x68,
-	0xe2, 0xfd, 0xc9, 0x75, 0xa0, 0x12, 0x41, 0x53, 0x6f, 0x12, 0x26, 0xd2, 0x0a, 0x14, 0x42, 0x8c,
-	0xfb, 0xa5, 0x4f, 0x3f, 0xd3, 0x8a, 0x67, 0x06, 0x16, 0xb5, 0xd4, 0x2f, 0xbf, 0xf0, 0xa3, 0xbf,
-	0x7c, 0xf9, 0xcb, 0xd4, 0x85, 0xb2, 0x59, 0xbd, 0xff, 0xff, 0xd5, 0x1d, 0xda, 0xbc, 0x14, 0x12,
-	0x56, 0x7d, 0x04, 0x83, 0xfd, 0x51, 0xf5, 0x91, 0xeb, 0x7c, 0x74, 0xd5, 0xb8, 0xf8, 0x8a, 0x81,
-	0xaf, 0xa2, 0x34, 0x50, 0x46, 0xba, 0xa6, 0xd2, 0xe7, 0x60, 0xdb, 0xa3, 0x1f, 0xa7, 0x0c, 0xd0,
-	0xcd, 0xbc, 0x05, 0x7f, 0xf7, 0x84, 0x0f, 0xf8, 0x88, 0xa2, 0x58, 0xa3, 0x45, 0xa3, 0xd5, 0x6d,
-	0x62, 0xef, 0xd6, 0x49, 0xe8, 0x53, 0x2f, 0x24, 0xb5, 0xf7, 0xbf, 0xf8, 0xc7, 0xe2, 0xc8, 0x0f,
-	0x9f, 0x2c, 0x1a, 0x9f, 0x3f, 0x59, 0x34, 0x1e, 0x3f, 0x59, 0x34, 0xfe, 0xfe, 0x64, 0xd1, 0xf8,
-	0xe4, 0xe9, 0xe2, 0xc8, 0xe3, 0xa7, 0x8b, 0x23, 0x5f, 0x3c, 0x5d, 0x1c, 0xf9, 0xc1, 0x8b, 0xca,
-	0x1f, 0x4a, 0x59, 0x41, 0xc7, 0x72, 0x2c, 0x3f, 0xa0, 0x3b, 0xc4, 0x66, 0xf2, 0x57, 0xfc, 0x77,
-	0x4e, 0xbf, 0x4e, 0xcd, 0x5d, 0x03, 0xe0, 0x8e, 0x10, 0x57, 0xd6, 0x69, 0xe5, 0x9a, 0xef, 0x36,
-	0x33, 0xe0, 0xcb, 0xe5, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xec, 0x60, 0x34, 0x80, 0xf4, 0x35,
-	0x00, 0x00,
+func (m *WatchJobSetRequest) Reset()      { *m = WatchJobSetRequest{} }
+func (*WatchJobSetRequest) ProtoMessage() {}
+func (*WatchJobSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{29}
+}
+func (m *WatchJobSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchJobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchJobSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchJobSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobSetRequest.Merge(m, src)
+}
+func (m *WatchJobSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchJobSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobSetRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_WatchJobSetRequest proto.InternalMessageInfo
 
-// EventClient is the client API for Event service.
+func (m *WatchJobSetRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *WatchJobSetRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *WatchJobSetRequest) GetFromSequence() string {
+	if m != nil {
+		return m.FromSequence
+	}
+	return ""
+}
+
+// swagger:model
+type JobStateTransition struct {
+	JobId    string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	// State the job transitioned to.
+	State   JobState  `protobuf:"varint,4,opt,name=state,proto3,enum=api.JobState" json:"state,omitempty"`
+	Created time.Time `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created"`
+	// Cluster the job is leased to, if any.
+	ClusterId string `protobuf:"bytes,6,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	// Position of the transition in the event log, from which watching can be resumed.
+	Sequence string `protobuf:"bytes,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Why the job failed or was cancelled, if it was.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Statuses of the containers of a failed job, including their exit codes.
+	ContainerStatuses []*ContainerStatus `protobuf:"bytes,9,rep,name=container_statuses,json=containerStatuses,proto3" json:"containerStatuses,omitempty"`
+}
+
+func (m *JobStateTransition) Reset()      { *m = JobStateTransition{} }
+func (*JobStateTransition) ProtoMessage() {}
+func (*JobStateTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{30}
+}
+func (m *JobStateTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobStateTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobStateTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobStateTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStateTransition.Merge(m, src)
+}
+func (m *JobStateTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobStateTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStateTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobStateTransition proto.InternalMessageInfo
+
+func (m *JobStateTransition) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobStateTransition) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobStateTransition) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobStateTransition) GetState() JobState {
+	if m != nil {
+		return m.State
+	}
+	return JobState_QUEUED
+}
+
+func (m *JobStateTransition) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobStateTransition) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *JobStateTransition) GetSequence() string {
+	if m != nil {
+		return m.Sequence
+	}
+	return ""
+}
+
+func (m *JobStateTransition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobStateTransition) GetContainerStatuses() []*ContainerStatus {
+	if m != nil {
+		return m.ContainerStatuses
+	}
+	return nil
+}
+
+// swagger:model
+type JobRunDetailsRequest struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *JobRunDetailsRequest) Reset()      { *m = JobRunDetailsRequest{} }
+func (*JobRunDetailsRequest) ProtoMessage() {}
+func (*JobRunDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{31}
+}
+func (m *JobRunDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRunDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunDetailsRequest.Merge(m, src)
+}
+func (m *JobRunDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRunDetailsRequest proto.InternalMessageInfo
+
+func (m *JobRunDetailsRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+// swagger:model
+type JobRunDetails struct {
+	JobId    string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Current state of the job.
+	State JobState `protobuf:"varint,4,opt,name=state,proto3,enum=api.JobState" json:"state,omitempty"`
+	// Cluster, namespace and pod number of the latest run of the job. The cluster is empty if the job has never been
+	// leased to a cluster.
+	ClusterId    string `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	PodNamespace string `protobuf:"bytes,6,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+	PodNumber    int32  `protobuf:"varint,7,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+}
+
+func (m *JobRunDetails) Reset()      { *m = JobRunDetails{} }
+func (*JobRunDetails) ProtoMessage() {}
+func (*JobRunDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{32}
+}
+func (m *JobRunDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRunDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunDetails.Merge(m, src)
+}
+func (m *JobRunDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRunDetails proto.InternalMessageInfo
+
+func (m *JobRunDetails) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobRunDetails) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobRunDetails) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobRunDetails) GetState() JobState {
+	if m != nil {
+		return m.State
+	}
+	return JobState_QUEUED
+}
+
+func (m *JobRunDetails) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *JobRunDetails) GetPodNamespace() string {
+	if m != nil {
+		return m.PodNamespace
+	}
+	return ""
+}
+
+func (m *JobRunDetails) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("api.Cause", Cause_name, Cause_value)
+	proto.RegisterEnum("api.PreemptionReason", PreemptionReason_name, PreemptionReason_value)
+	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
+	proto.RegisterType((*JobQueuedEvent)(nil), "api.JobQueuedEvent")
+	proto.RegisterType((*JobDuplicateFoundEvent)(nil), "api.JobDuplicateFoundEvent")
+	proto.RegisterType((*JobLeasedEvent)(nil), "api.JobLeasedEvent")
+	proto.RegisterType((*JobLeaseReturnedEvent)(nil), "api.JobLeaseReturnedEvent")
+	proto.RegisterType((*JobLeaseExpiredEvent)(nil), "api.JobLeaseExpiredEvent")
+	proto.RegisterType((*JobPendingEvent)(nil), "api.JobPendingEvent")
+	proto.RegisterType((*JobRunningEvent)(nil), "api.JobRunningEvent")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobRunningEvent.NodeLabelsEntry")
+	proto.RegisterType((*JobIngressInfoEvent)(nil), "api.JobIngressInfoEvent")
+	proto.RegisterMapType((map[int32]string)(nil), "api.JobIngressInfoEvent.IngressAddressesEntry")
+	proto.RegisterType((*JobArtifactsEvent)(nil), "api.JobArtifactsEvent")
+	proto.RegisterType((*Artifact)(nil), "api.Artifact")
+	proto.RegisterType((*JobUnableToScheduleEvent)(nil), "api.JobUnableToScheduleEvent")
+	proto.RegisterType((*JobFailedEvent)(nil), "api.JobFailedEvent")
+	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
+	proto.RegisterType((*JobPreemptedEvent)(nil), "api.JobPreemptedEvent")
+	proto.RegisterType((*JobFailedEventCompressed)(nil), "api.JobFailedEventCompressed")
+	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
+	proto.RegisterType((*JobUtilisationEvent)(nil), "api.JobUtilisationEvent")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.AverageResourcesEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.MaxResourcesForPeriodEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.TotalCumulativeUsageEntry")
+	proto.RegisterType((*JobReprioritizingEvent)(nil), "api.JobReprioritizingEvent")
+	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
+	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
+	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
+	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
+	proto.RegisterType((*JobUpdatedEvent)(nil), "api.JobUpdatedEvent")
+	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
+	proto.RegisterType((*ContainerStatus)(nil), "api.ContainerStatus")
+	proto.RegisterType((*EventList)(nil), "api.EventList")
+	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
+	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
+	proto.RegisterType((*WatchRequest)(nil), "api.WatchRequest")
+	proto.RegisterType((*WatchJobSetRequest)(nil), "api.WatchJobSetRequest")
+	proto.RegisterType((*JobStateTransition)(nil), "api.JobStateTransition")
+	proto.RegisterType((*JobRunDetailsRequest)(nil), "api.JobRunDetailsRequest")
+	proto.RegisterType((*JobRunDetails)(nil), "api.JobRunDetails")
+}
+
+func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
+
+var fileDescriptor_7758595c3bb8cf56 = []byte{
+	// 3106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x22, 0x45, 0x0e, 0x25, 0x8a, 0x1a, 0x4b, 0xf6, 0x9a, 0x8e, 0x45, 0x83, 0x29,
+	0x1a, 0xc7, 0x48, 0xa8, 0x54, 0x4e, 0x0a, 0xc3, 0x28, 0x1a, 0x98, 0xb2, 0x9c, 0x58, 0xb0, 0x13,
+	0x87, 0xb2, 0x91, 0x36, 0x08, 0xca, 0x2c, 0x77, 0x47, 0xd4, 0x5a, 0xcb, 0x1d, 0x66, 0x7f, 0x6c,
+	0x2b, 0x41, 0x80, 0xa2, 0x45, 0xdb, 0xa0, 0x40, 0xd1, 0x14, 0xed, 0xad, 0x87, 0xe6, 0x54, 0xa0,
+	0x3d, 0xe5, 0xd0, 0xf6, 0xd8, 0x53, 0x0f, 0xe9, 0x2d, 0x45, 0x2e, 0x01, 0x0a, 0xb0, 0xad, 0x93,
+	0x02, 0x05, 0x0f, 0xbd, 0x17, 0xe8, 0xa1, 0x98, 0x37, 0xb3, 0xbb, 0x33, 0x2b, 0x0a, 0xfa, 0xb1,
+	0x53, 0x08, 0x02, 0x2f, 0x89, 0xf5, 0xbd, 0x79, 0x6f, 0xde, 0xbe, 0xf9, 0x66, 0xf6, 0xed, 0x9b,
+	0x27, 0xa1, 0x13, 0xfd, 0xad, 0xee, 0x92, 0xd1, 0xb7, 0x97, 0xc8, 0x3d, 0xe2, 0x06, 0x8d, 0xbe,
+	0x47, 0x03, 0x8a, 0xb3, 0x46, 0xdf, 0xae, 0xd6, 0xba, 0x94, 0x76, 0x1d, 0xb2, 0x04, 0x50, 0x27,
+	0xdc, 0x58, 0x0a, 0xec, 0x1e, 0xf1, 0x03, 0xa3, 0xd7, 0xe7, 0xa3, 0xaa, 0xb1, 0xea, 0xdb, 0x21,
+	0x09, 0x89, 0x00, 0xe7, 0x23, 0x70, 0x93, 0x18, 0x4e, 0xb0, 0x99, 0x46, 0xfd, 0xb0, 0xd3, 0xb3,
+	0xc5, 0x34, 0xd5, 0x33, 0xe9, 0x19, 0x48, 0xaf, 0x1f, 0x6c, 0x0b, 0xe1, 0xb3, 0x5d, 0x3b, 0xd8,
+	0x0c, 0x3b, 0x0d, 0x93, 0xf6, 0x96, 0xba, 0xb4, 0x4b, 0x93, 0x51, 0xec, 0x27, 0xf8, 0x01, 0xfe,
+	0x25, 0x86, 0x3f, 0x21, 0x6c, 0xb1, 0x49, 0x0c, 0xd7, 0xa5, 0x81, 0x11, 0xd8, 0xd4, 0xf5, 0x85,
+	0xf4, 0xf9, 0xad, 0x4b, 0x7e, 0xc3, 0xa6, 0x4c, 0xda, 0x33, 0xcc, 0x4d, 0xdb, 0x25, 0xde, 0xf6,
+	0x52, 0xe4, 0x93, 0x47, 0x7c, 0x1a, 0x7a, 0x26, 0x59, 0xea, 0x12, 0x97, 0x78, 0x46, 0x40, 0x2c,
+	0xae, 0x55, 0xff, 0x45, 0x06, 0xcd, 0xad, 0xd1, 0xce, 0x3a, 0xf8, 0x1c, 0x10, 0x6b, 0x95, 0x85,
+	0x08, 0x5f, 0x40, 0xf9, 0xbb, 0xb4, 0xd3, 0xb6, 0x2d, 0x5d, 0x3b, 0xa7, 0x9d, 0x2f, 0x36, 0x4f,
+	0x0c, 0x07, 0xb5, 0xd9, 0xbb, 0xb4, 0x73, 0xdd, 0x7a, 0x86, 0xf6, 0xec, 0x00, 0x9e, 0xa1, 0x95,
+	0x03, 0x00, 0x3f, 0x8f, 0x10, 0x1b, 0xeb, 0x93, 0x80, 0x8d, 0xcf, 0xc0, 0xf8, 0x93, 0xc3, 0x41,
+	0x0d, 0xdf, 0xa5, 0x9d, 0x75, 0x12, 0x28, 0x2a, 0x85, 0x08, 0xc3, 0x4f, 0xa3, 0x1c, 0x84, 0x54,
+	0xcf, 0x26, 0x13, 0x00, 0x20, 0x4f, 0x00, 0x00, 0xbe, 0x8e, 0xa6, 0x4c, 0x8f, 0x30, 0x9f, 0xf5,
+	0xc9, 0x73, 0xda, 0xf9, 0xd2, 0x72, 0xb5, 0xc1, 0x03, 0xd1, 0x88, 0xc2, 0xd5, 0xb8, 0x1d, 0x2d,
+	0x5b, 0xf3, 0xc4, 0xc7, 0x83, 0xda, 0xc4, 0x70, 0x50, 0x8b, 0x54, 0x3e, 0xf8, 0x5b, 0x4d, 0x6b,
+	0x45, 0x3f, 0xe0, 0xa7, 0x50, 0xf6, 0x2e, 0xed, 0xe8, 0x39, 0x30, 0x53, 0x68, 0x18, 0x7d, 0xbb,
+	0xb1, 0x46, 0x3b, 0xcd, 0x92, 0x50, 0x62, 0xc2, 0x16, 0xfb, 0x4f, 0xfd, 0x5f, 0x1a, 0x2a, 0xaf,
+	0xd1, 0xce, 0x6b, 0xcc, 0x81, 0xe3, 0x1d, 0x93, 0xfa, 0xef, 0x33, 0xe8, 0xe4, 0x1a, 0xed, 0x5c,
+	0x0d, 0xfb, 0x8e, 0x6d, 0x1a, 0x01, 0xb9, 0x46, 0x43, 0xf7, 0x98, 0xd3, 0x60, 0x05, 0xcd, 0x52,
+	0xcf, 0xee, 0xda, 0xae, 0xe1, 0xb4, 0xc5, 0x03, 0xe6, 0x60, 0xfe, 0x33, 0xc3, 0x41, 0xed, 0x54,
+	0x24, 0x5a, 0x4b, 0x3d, 0xe8, 0x8c, 0x22, 0xa8, 0x7f, 0x98, 0x01, 0x8a, 0xdc, 0x20, 0x86, 0x7f,
+	0xdc, 0xb7, 0xcd, 0xd7, 0x11, 0x32, 0x9d, 0xd0, 0x0f, 0x88, 0x97, 0x84, 0xea, 0xd4, 0x70, 0x50,
+	0x3b, 0x21, 0x50, 0xc5, 0xd9, 0x62, 0x0c, 0xd6, 0x7f, 0x3a, 0x89, 0x16, 0xa2, 0x10, 0xb5, 0x48,
+	0x10, 0x7a, 0xee, 0x38, 0x52, 0x23, 0x23, 0x85, 0x9f, 0x41, 0x79, 0x8f, 0x18, 0x3e, 0x75, 0xf5,
+	0x3c, 0xe8, 0xcc, 0x0f, 0x07, 0xb5, 0x0a, 0x47, 0x24, 0x05, 0x31, 0x06, 0xbf, 0x88, 0x66, 0xb6,
+	0xc2, 0x0e, 0xf1, 0x5c, 0x12, 0x10, 0x9f, 0x4d, 0x34, 0x05, 0x4a, 0xd5, 0xe1, 0xa0, 0x76, 0x32,
+	0x11, 0x28, 0x73, 0x4d, 0xcb, 0x38, 0x73, 0xb3, 0x4f, 0xad, 0xb6, 0x1b, 0xf6, 0x3a, 0xc4, 0xd3,
+	0x0b, 0xe7, 0xb4, 0xf3, 0x39, 0xee, 0x66, 0x9f, 0x5a, 0xaf, 0x00, 0x28, 0xbb, 0x19, 0x83, 0x6c,
+	0x62, 0x2f, 0x74, 0xdb, 0x46, 0x00, 0x22, 0x62, 0xe9, 0xc5, 0x73, 0xda, 0xf9, 0x02, 0x9f, 0xd8,
+	0x0b, 0xdd, 0x2b, 0x11, 0x2e, 0x4f, 0x2c, 0xe3, 0xf5, 0x7f, 0x6b, 0x68, 0x3e, 0x62, 0xc4, 0xea,
+	0x83, 0xbe, 0xed, 0x1d, 0xf7, 0xd3, 0xf5, 0x27, 0x93, 0x68, 0x76, 0x8d, 0x76, 0x6e, 0x11, 0xd7,
+	0xb2, 0xdd, 0xee, 0x98, 0xfc, 0xa3, 0xc8, 0xbf, 0x83, 0xce, 0xf9, 0x47, 0xa2, 0xf3, 0xd4, 0xbe,
+	0xe9, 0xfc, 0x1c, 0x2a, 0x80, 0x9e, 0xd1, 0x23, 0xb0, 0x09, 0x8a, 0xcd, 0x85, 0xe1, 0xa0, 0x36,
+	0xc7, 0x06, 0x18, 0x3d, 0x39, 0x56, 0x53, 0x02, 0x62, 0xae, 0x46, 0x1a, 0x7e, 0xdf, 0x30, 0x89,
+	0x5e, 0x4c, 0x5c, 0x15, 0x63, 0x00, 0x97, 0x5d, 0x95, 0xf1, 0xfa, 0x8f, 0xf3, 0xc0, 0x87, 0x56,
+	0xe8, 0xba, 0x63, 0x3e, 0x7c, 0x59, 0x7c, 0xb8, 0x88, 0x8a, 0x2e, 0xb5, 0x08, 0x5f, 0xd8, 0xa9,
+	0x24, 0x46, 0x0c, 0x4c, 0xad, 0x6c, 0x21, 0xc2, 0x0e, 0x7d, 0x26, 0xca, 0x24, 0x2a, 0x1e, 0x8e,
+	0x44, 0xe8, 0x60, 0x24, 0xc2, 0x6d, 0x54, 0x82, 0xe7, 0x73, 0x8c, 0x0e, 0x71, 0x7c, 0xbd, 0x74,
+	0x2e, 0x7b, 0xbe, 0xb4, 0xfc, 0x95, 0x28, 0x9d, 0x95, 0xb9, 0xd5, 0x78, 0x85, 0x5a, 0xe4, 0x06,
+	0x0c, 0x5b, 0x75, 0x03, 0x6f, 0xbb, 0xa9, 0x0f, 0x07, 0xb5, 0x79, 0x37, 0x06, 0xa5, 0x29, 0x50,
+	0x82, 0x56, 0x09, 0x9a, 0x4d, 0x29, 0xe2, 0x27, 0x51, 0x76, 0x8b, 0x6c, 0x0b, 0x86, 0xce, 0x0d,
+	0x07, 0xb5, 0x99, 0x2d, 0xb2, 0x2d, 0xa9, 0x33, 0x29, 0xe3, 0xd9, 0x3d, 0xc3, 0x09, 0x89, 0x9e,
+	0x49, 0x78, 0x06, 0x80, 0xcc, 0x33, 0x00, 0x2e, 0x67, 0x2e, 0x69, 0xf5, 0x8f, 0xf2, 0xe8, 0x04,
+	0x4b, 0xa6, 0xdc, 0xae, 0x47, 0x7c, 0xff, 0xba, 0xbb, 0x41, 0xc7, 0x1b, 0xe2, 0x78, 0x6d, 0x08,
+	0x74, 0xb8, 0x0d, 0x51, 0x3a, 0xe0, 0x86, 0x78, 0x17, 0xcd, 0xd9, 0x9c, 0x44, 0x6d, 0xc3, 0xb2,
+	0xd8, 0xff, 0x89, 0xaf, 0x17, 0x61, 0x5b, 0x34, 0xa2, 0x6d, 0x91, 0x66, 0x59, 0x43, 0x00, 0x57,
+	0x22, 0x05, 0xbe, 0x41, 0x16, 0x87, 0x83, 0x5a, 0xd5, 0x4e, 0x89, 0xa4, 0x89, 0x2b, 0x69, 0x59,
+	0x75, 0x0b, 0x2d, 0x8c, 0x34, 0x25, 0x6f, 0x99, 0xdc, 0xe3, 0xda, 0x32, 0x0f, 0xb3, 0xf0, 0xbd,
+	0x7e, 0xc5, 0x0b, 0xec, 0x0d, 0xc3, 0x0c, 0xfc, 0xf1, 0x86, 0x39, 0x52, 0x19, 0xc5, 0x55, 0x54,
+	0x34, 0xa2, 0xa5, 0xd1, 0x0b, 0x40, 0xc0, 0x19, 0x20, 0x60, 0xb4, 0x60, 0xdc, 0x4a, 0x3c, 0x46,
+	0xb6, 0x12, 0x83, 0xf5, 0x4f, 0x35, 0x54, 0x88, 0x14, 0xf0, 0x57, 0xd1, 0x24, 0x6c, 0x25, 0xbe,
+	0xb2, 0x78, 0x38, 0xa8, 0x95, 0x5d, 0x75, 0x1f, 0x81, 0x1c, 0x37, 0x51, 0xd9, 0xa4, 0x6e, 0x60,
+	0xb0, 0xc2, 0x0f, 0xdf, 0x7c, 0x99, 0xe4, 0x9b, 0x36, 0x96, 0xa4, 0xb6, 0xe0, 0x8c, 0x22, 0x60,
+	0x8c, 0x0d, 0x3d, 0x47, 0xac, 0x31, 0x30, 0x36, 0xf4, 0x1c, 0x99, 0xb1, 0xa1, 0xe7, 0xb0, 0xd8,
+	0xf8, 0xf6, 0x3b, 0xa4, 0xdd, 0xd9, 0x0e, 0x88, 0x0f, 0x4b, 0x9c, 0xe5, 0x4f, 0xc5, 0xd0, 0x26,
+	0x03, 0xe5, 0xa7, 0x8a, 0xc1, 0xfa, 0x7f, 0x26, 0x91, 0xbe, 0x46, 0x3b, 0x77, 0x5c, 0xa3, 0xe3,
+	0x90, 0xdb, 0x74, 0xdd, 0xdc, 0x24, 0x56, 0xe8, 0x90, 0x31, 0x83, 0x8f, 0xc0, 0x07, 0xa1, 0xf2,
+	0x82, 0x28, 0x1c, 0xea, 0x05, 0x51, 0x3c, 0xc2, 0x2f, 0x88, 0xfa, 0x47, 0x05, 0x28, 0xd6, 0x5c,
+	0x33, 0x6c, 0x67, 0x5c, 0x82, 0x78, 0x1c, 0x8c, 0x7b, 0x13, 0x21, 0xf2, 0xc0, 0x0e, 0xda, 0x26,
+	0xb5, 0x88, 0xaf, 0x4f, 0xc1, 0x49, 0x57, 0x8f, 0x5e, 0xb5, 0x52, 0x98, 0x1b, 0xab, 0x0f, 0xec,
+	0x60, 0x85, 0x5a, 0xe2, 0x9d, 0xd8, 0x3c, 0xcd, 0x3c, 0x21, 0x11, 0x96, 0x18, 0xd6, 0xb5, 0x56,
+	0x31, 0x86, 0x77, 0xf2, 0xb9, 0xf0, 0x28, 0x7c, 0x2e, 0x1e, 0x8a, 0xcf, 0xe8, 0x50, 0x7c, 0x9e,
+	0x39, 0x1c, 0x9f, 0xcb, 0x07, 0x4c, 0x78, 0x2c, 0x84, 0x93, 0xc3, 0xde, 0x0f, 0x8c, 0x20, 0xf4,
+	0x49, 0xf4, 0x21, 0x30, 0x0f, 0xcb, 0xb0, 0x12, 0x89, 0xd7, 0x41, 0xda, 0xac, 0x0d, 0x07, 0xb5,
+	0x33, 0xa6, 0x0a, 0x2a, 0x27, 0xf5, 0xdc, 0x0e, 0x21, 0x7e, 0x01, 0xe5, 0x4c, 0x23, 0xf4, 0x89,
+	0x3e, 0x7d, 0x4e, 0x3b, 0x5f, 0x5e, 0x46, 0xdc, 0x30, 0x43, 0x38, 0x99, 0x41, 0x28, 0x93, 0x19,
+	0x00, 0xfc, 0x1d, 0x54, 0xd9, 0x30, 0x6c, 0x27, 0xf4, 0x48, 0xdb, 0x34, 0x02, 0xd2, 0xa5, 0xde,
+	0xb6, 0x3e, 0x0b, 0x16, 0xb8, 0x6b, 0xd7, 0xb8, 0x70, 0x45, 0xc8, 0x9a, 0x67, 0x87, 0x83, 0xda,
+	0xe9, 0x0d, 0x15, 0x94, 0xac, 0xce, 0xa6, 0x44, 0x55, 0x0b, 0x95, 0x55, 0x56, 0x1d, 0xe2, 0xe3,
+	0x24, 0xb7, 0x67, 0xa6, 0xf5, 0x9b, 0x1c, 0x64, 0x5a, 0xb7, 0x3c, 0x42, 0xa0, 0x76, 0x35, 0x3e,
+	0x35, 0x46, 0x9d, 0x1a, 0x17, 0x50, 0x9e, 0x55, 0x04, 0xe3, 0x14, 0x0b, 0xdc, 0xf5, 0x42, 0x57,
+	0x8d, 0x07, 0x00, 0xf8, 0x3a, 0x9a, 0xeb, 0xf3, 0x68, 0xda, 0xf7, 0x48, 0x54, 0x78, 0xe7, 0x6f,
+	0x2a, 0xa0, 0x40, 0x22, 0x4c, 0x97, 0xde, 0x67, 0x53, 0xa2, 0x94, 0x29, 0xe1, 0x41, 0x61, 0x94,
+	0xa9, 0x56, 0xe8, 0xee, 0x66, 0x0a, 0x44, 0x78, 0x25, 0x3e, 0xf7, 0x8a, 0xc0, 0xd1, 0x05, 0xe0,
+	0xa8, 0x58, 0x76, 0x9b, 0xba, 0x2d, 0x10, 0xee, 0x71, 0x1c, 0xbe, 0x8c, 0x2a, 0x92, 0x3f, 0x7c,
+	0xfd, 0xd0, 0x28, 0x77, 0x5e, 0x4b, 0xad, 0xe4, 0x6c, 0x4a, 0xa4, 0x9e, 0x5c, 0xa5, 0xfd, 0x9d,
+	0x5c, 0xf5, 0x55, 0xc8, 0xac, 0xa4, 0x63, 0x77, 0x85, 0xf6, 0xfa, 0xf0, 0x29, 0x02, 0x7c, 0x82,
+	0x7b, 0x4f, 0x20, 0xec, 0x34, 0x5f, 0x20, 0x00, 0xe4, 0x05, 0x02, 0xa0, 0xfe, 0xa7, 0x49, 0x71,
+	0x19, 0x68, 0x9a, 0x84, 0x58, 0x63, 0xca, 0x8f, 0xcb, 0x53, 0x87, 0x29, 0x4f, 0xd5, 0x7f, 0x57,
+	0x82, 0xb2, 0xce, 0x9d, 0xc0, 0x76, 0x6c, 0x1f, 0xee, 0xa8, 0xc7, 0x44, 0xfa, 0x52, 0x88, 0xf4,
+	0xbe, 0x86, 0x16, 0x6e, 0x1a, 0x0f, 0x5a, 0xe2, 0x72, 0xdf, 0xbf, 0x46, 0xbd, 0x5b, 0xc4, 0xb3,
+	0xa9, 0x25, 0x12, 0xb2, 0x8b, 0x51, 0x42, 0x96, 0x5e, 0x8a, 0xc6, 0x48, 0x2d, 0x9e, 0xa1, 0x9d,
+	0x15, 0xcf, 0x3a, 0xda, 0x72, 0x6b, 0x34, 0x7c, 0xdc, 0x3f, 0x20, 0xf0, 0x0f, 0x35, 0x74, 0x32,
+	0xa0, 0x81, 0xe1, 0xb4, 0xcd, 0xb0, 0x17, 0x3a, 0x06, 0x9c, 0xf3, 0xa1, 0x6f, 0x74, 0x59, 0x72,
+	0xc4, 0x62, 0xbd, 0xbc, 0x6b, 0xac, 0x6f, 0x33, 0xb5, 0x95, 0x58, 0xeb, 0x0e, 0x53, 0xe2, 0xa1,
+	0x7e, 0x42, 0x84, 0x7a, 0x3e, 0x18, 0x31, 0xa4, 0x35, 0x12, 0xc5, 0x21, 0x9a, 0x33, 0xee, 0x11,
+	0xcf, 0xe8, 0x92, 0x76, 0xd4, 0xd4, 0xe1, 0xeb, 0x33, 0x6a, 0xa9, 0x6b, 0x87, 0x0b, 0x57, 0xb8,
+	0x46, 0xbc, 0x6e, 0x7c, 0x7a, 0x5d, 0x4c, 0x5f, 0x31, 0x52, 0xe2, 0xd6, 0x0e, 0xa4, 0xfa, 0xa1,
+	0x86, 0xaa, 0xbb, 0x93, 0x66, 0x7f, 0x09, 0xd8, 0xb7, 0xe5, 0x04, 0x8c, 0xb9, 0xcb, 0x3b, 0x56,
+	0x1a, 0x72, 0xc7, 0x4a, 0xa3, 0xbf, 0xd5, 0x85, 0xc7, 0x88, 0x1e, 0xae, 0xf1, 0x5a, 0x68, 0xb8,
+	0x81, 0x1d, 0x6c, 0xef, 0x95, 0xb0, 0x55, 0x7f, 0xa5, 0xa1, 0xd3, 0xbb, 0xc6, 0xfa, 0x48, 0x78,
+	0xf8, 0x4b, 0x0d, 0x2d, 0x8c, 0x5c, 0x8a, 0xa3, 0xe0, 0x5d, 0xfd, 0x9f, 0xbc, 0x11, 0xa4, 0x45,
+	0xfa, 0x9e, 0x4d, 0x3d, 0x3b, 0xb0, 0xdf, 0x39, 0xf6, 0x37, 0x54, 0xdf, 0x40, 0xd3, 0x2e, 0xb9,
+	0xdf, 0x16, 0x0f, 0xbc, 0x0d, 0x67, 0xb7, 0x06, 0xdf, 0xa8, 0x0b, 0x2e, 0xb9, 0x7f, 0x4b, 0xc0,
+	0x92, 0x0b, 0x25, 0x09, 0xc6, 0x2f, 0xa0, 0xa2, 0x47, 0xde, 0x0e, 0x89, 0x1f, 0x50, 0x4f, 0x9c,
+	0xdd, 0x70, 0x7a, 0xc5, 0xa0, 0x7c, 0x7a, 0xc5, 0x60, 0xfd, 0x8b, 0x0c, 0x5a, 0x50, 0xe3, 0x4c,
+	0xac, 0x71, 0x98, 0x1f, 0x7b, 0x98, 0xff, 0x92, 0x41, 0x78, 0x8d, 0x76, 0x56, 0x0c, 0xd7, 0x24,
+	0x8e, 0x73, 0xec, 0xa9, 0xac, 0x44, 0x29, 0xb7, 0xdf, 0x28, 0x1d, 0xac, 0xea, 0x53, 0xff, 0x84,
+	0x77, 0x0b, 0x8a, 0x98, 0x12, 0x6b, 0x1c, 0xd2, 0x47, 0x0e, 0xe9, 0x1f, 0x27, 0x81, 0xa6, 0xb7,
+	0x89, 0xd7, 0xb3, 0x5d, 0x63, 0x5c, 0x67, 0x38, 0xca, 0x3d, 0x22, 0xff, 0xa7, 0xeb, 0xfd, 0x84,
+	0x40, 0x85, 0x7d, 0x10, 0xe8, 0xcf, 0x19, 0xe8, 0x28, 0xb9, 0xd3, 0xb7, 0x8c, 0x60, 0xbc, 0x23,
+	0x47, 0xee, 0x48, 0xd1, 0xf6, 0x9b, 0xdf, 0xb3, 0xed, 0xf7, 0xbf, 0x65, 0x34, 0x0d, 0x11, 0xbc,
+	0x49, 0x7c, 0xc8, 0xb6, 0x5f, 0x45, 0x45, 0x3f, 0x6a, 0x8d, 0x86, 0x58, 0x96, 0x96, 0x4f, 0x46,
+	0xfa, 0x6a, 0xcf, 0x34, 0x77, 0x24, 0x1e, 0x9c, 0x38, 0xf2, 0xf2, 0x44, 0x2b, 0xb1, 0xc1, 0xaa,
+	0x4d, 0x10, 0x15, 0x4b, 0x24, 0x71, 0x27, 0x22, 0x6b, 0x52, 0xab, 0x31, 0x5f, 0x70, 0x3e, 0x4c,
+	0xb1, 0x23, 0x54, 0xb1, 0x85, 0x66, 0xad, 0xa8, 0x5d, 0xb7, 0xbd, 0xc1, 0xfa, 0x75, 0xf5, 0x0a,
+	0x58, 0x3b, 0x13, 0x59, 0x1b, 0xd1, 0xcd, 0xdb, 0x7c, 0x62, 0x38, 0xa8, 0xe9, 0x96, 0x22, 0x50,
+	0xac, 0x97, 0x55, 0x19, 0x73, 0xd5, 0x81, 0xe6, 0x56, 0x3d, 0xab, 0xba, 0x2a, 0xb5, 0xbc, 0x72,
+	0x57, 0xf9, 0x30, 0xd5, 0x55, 0x8e, 0xe1, 0xb7, 0x50, 0x19, 0xfe, 0xd5, 0xf6, 0x44, 0xff, 0x67,
+	0xcc, 0x01, 0xd9, 0x98, 0xd2, 0x1c, 0xca, 0x6f, 0x2c, 0x1d, 0x19, 0x57, 0x4c, 0xcf, 0x28, 0x22,
+	0xfc, 0x26, 0xe2, 0x40, 0x9b, 0xf0, 0x7e, 0x42, 0xd1, 0xdd, 0x7d, 0x5a, 0x99, 0x40, 0xee, 0x35,
+	0xe4, 0x3b, 0xd1, 0x91, 0x60, 0xc5, 0xfc, 0xb4, 0x2c, 0xc1, 0x2f, 0xa1, 0xa9, 0x3e, 0xef, 0xdd,
+	0x13, 0xf4, 0x99, 0x8f, 0xec, 0xca, 0x2d, 0x7d, 0xe2, 0x4c, 0xe0, 0x88, 0x62, 0x2d, 0xd2, 0x66,
+	0x86, 0x3c, 0xde, 0x98, 0xa3, 0x4f, 0xa9, 0x86, 0xe4, 0x7e, 0x1d, 0x6e, 0x48, 0x0c, 0x54, 0x0d,
+	0x09, 0x10, 0xf7, 0x10, 0x0e, 0xe1, 0x0a, 0xb5, 0x1d, 0xd0, 0xb6, 0x2f, 0x2e, 0x51, 0xe1, 0xa4,
+	0x28, 0x2d, 0x9f, 0x8d, 0xbf, 0x00, 0x47, 0x5d, 0xb2, 0xf2, 0xde, 0x86, 0x30, 0x25, 0x52, 0x66,
+	0xa9, 0xa4, 0xa5, 0x8c, 0x05, 0x1b, 0x50, 0x57, 0xd4, 0x8b, 0x2a, 0x0b, 0xa4, 0x6a, 0x23, 0x67,
+	0x01, 0x1f, 0xa6, 0xb2, 0x80, 0x63, 0x7c, 0x1b, 0x89, 0xa2, 0xa2, 0x8e, 0xd2, 0xdb, 0x48, 0xae,
+	0x36, 0x46, 0xdb, 0x48, 0x60, 0xe9, 0x6d, 0x24, 0x60, 0xdc, 0x46, 0x33, 0x9e, 0x9c, 0x3f, 0xeb,
+	0x25, 0x95, 0x55, 0x3b, 0x93, 0x6b, 0xce, 0x2a, 0x45, 0x49, 0x65, 0x95, 0x22, 0xc2, 0xeb, 0x08,
+	0x99, 0x71, 0xe6, 0x08, 0xf7, 0x1f, 0xa5, 0xe5, 0x53, 0x91, 0xf5, 0x54, 0x4e, 0xc9, 0x9b, 0xaa,
+	0x92, 0xe1, 0x8a, 0x5d, 0xc9, 0x0c, 0x0b, 0x83, 0xf8, 0x89, 0x58, 0xfa, 0x8c, 0x1a, 0x06, 0x35,
+	0xa7, 0x12, 0xef, 0xc4, 0x08, 0x53, 0xc3, 0x10, 0xc3, 0xcc, 0xcb, 0x20, 0x4e, 0x1c, 0xf4, 0xb2,
+	0xea, 0x65, 0x2a, 0xa5, 0xe0, 0x5e, 0x26, 0xc3, 0x55, 0x2f, 0x13, 0x1c, 0xbf, 0x8e, 0x4a, 0x61,
+	0x52, 0x40, 0x80, 0x9b, 0x9b, 0xd2, 0xb2, 0xbe, 0x5b, 0x6d, 0x81, 0xa7, 0xf1, 0x92, 0x82, 0x62,
+	0x57, 0xb6, 0x84, 0xbf, 0x85, 0xa6, 0xa3, 0x2e, 0x1d, 0xdb, 0xdd, 0xa0, 0xfa, 0x9c, 0x6a, 0x39,
+	0xdd, 0xa0, 0xc3, 0x2d, 0xdb, 0x09, 0xaa, 0x5a, 0x96, 0x04, 0xd8, 0x44, 0x65, 0x4f, 0xf9, 0x6c,
+	0xd5, 0xb1, 0x7a, 0x1e, 0x8e, 0xf8, 0xa8, 0xe5, 0xe7, 0xa1, 0xaa, 0xa6, 0x9e, 0x87, 0xaa, 0x8c,
+	0xed, 0xe0, 0x90, 0xbf, 0x64, 0xf5, 0x13, 0xea, 0x0e, 0x96, 0xdf, 0xbd, 0x7c, 0x07, 0x8b, 0x81,
+	0xea, 0x0e, 0x16, 0x20, 0xde, 0x42, 0x62, 0xaf, 0x24, 0x55, 0x7a, 0x7d, 0x5e, 0xdd, 0xbf, 0x23,
+	0x4b, 0xf9, 0x7c, 0xff, 0xa6, 0x55, 0xd5, 0xfd, 0x9b, 0x96, 0x32, 0xce, 0xf5, 0xa3, 0x2b, 0x2c,
+	0x7d, 0x41, 0xe5, 0x9c, 0x7a, 0xb7, 0x25, 0xd2, 0xa1, 0x08, 0x53, 0x39, 0x17, 0xc3, 0xcc, 0x60,
+	0xd2, 0xe2, 0x72, 0x52, 0x35, 0xa8, 0xb6, 0x25, 0xed, 0xda, 0xeb, 0xc2, 0x0c, 0xc6, 0x70, 0xb3,
+	0x80, 0xf2, 0x70, 0xfd, 0xe0, 0xd7, 0xbf, 0x9f, 0x41, 0xb3, 0xa9, 0x7b, 0xcb, 0x7d, 0xb7, 0xbf,
+	0x2c, 0xa3, 0x42, 0x74, 0x7f, 0x2c, 0x2e, 0xf8, 0x20, 0x89, 0x89, 0x30, 0x39, 0x89, 0x89, 0x30,
+	0xbc, 0x84, 0xa6, 0x7a, 0xfc, 0x45, 0x2f, 0xd2, 0x18, 0x58, 0x3b, 0x01, 0xc9, 0xa9, 0x9d, 0x80,
+	0xa4, 0xcc, 0x6c, 0x72, 0x1f, 0x77, 0xe4, 0xf1, 0xf5, 0x69, 0xee, 0x20, 0xd7, 0xa7, 0xf5, 0x1b,
+	0xa8, 0x08, 0xe1, 0xbb, 0x61, 0xfb, 0x01, 0x7e, 0x31, 0x0a, 0x8e, 0xae, 0x41, 0x8d, 0x6f, 0x0e,
+	0x8c, 0xc8, 0x39, 0x0a, 0x77, 0x82, 0x0f, 0x92, 0x9d, 0x10, 0x31, 0x7d, 0x07, 0x61, 0x18, 0xbd,
+	0x1e, 0x78, 0xc4, 0xe8, 0x09, 0x1d, 0x7c, 0x0e, 0x65, 0xe2, 0xe4, 0xb0, 0x32, 0x1c, 0xd4, 0xa6,
+	0x6d, 0x39, 0xcd, 0xcb, 0xd8, 0x16, 0x6e, 0x26, 0xb1, 0xe1, 0x99, 0xca, 0x88, 0x99, 0xf7, 0x08,
+	0x57, 0xfd, 0x07, 0x59, 0x34, 0xb3, 0x06, 0x19, 0x63, 0x8b, 0xe7, 0x62, 0xfb, 0x98, 0xf7, 0x69,
+	0x94, 0xbb, 0x6f, 0x04, 0xe6, 0x26, 0xcc, 0x5a, 0xe0, 0x81, 0x02, 0x40, 0x0e, 0x14, 0x00, 0xec,
+	0xd7, 0x78, 0x36, 0x3c, 0xda, 0x6b, 0x8b, 0xe9, 0x58, 0xfa, 0x9a, 0x4d, 0x5a, 0x9e, 0x98, 0x48,
+	0x38, 0xaa, 0xfe, 0x1a, 0x8f, 0x22, 0x48, 0x12, 0xd9, 0xc9, 0x3d, 0x13, 0xd9, 0xab, 0xa8, 0x4c,
+	0x3c, 0x8f, 0x7a, 0xd7, 0x37, 0x6e, 0xda, 0xbe, 0xcf, 0x4e, 0x99, 0x1c, 0xf8, 0x08, 0x07, 0x89,
+	0x2a, 0x91, 0x94, 0x53, 0x3a, 0xac, 0x18, 0xb2, 0x41, 0x3d, 0x93, 0xb4, 0x1d, 0xd2, 0x35, 0xcc,
+	0x6d, 0x48, 0x2b, 0x0a, 0xfc, 0xac, 0x03, 0xfc, 0x06, 0xc0, 0x72, 0x31, 0x44, 0x82, 0x59, 0x9d,
+	0x9d, 0x6b, 0xbb, 0xe4, 0x3e, 0x24, 0x12, 0x05, 0xce, 0x73, 0x00, 0x5f, 0x21, 0xf7, 0x65, 0x9e,
+	0x47, 0x58, 0xfd, 0x67, 0x19, 0x34, 0xfd, 0x3a, 0x0b, 0x59, 0xb4, 0x0c, 0xf1, 0x43, 0x6b, 0x7b,
+	0x3e, 0xf4, 0xe1, 0x3e, 0x0f, 0x9e, 0x45, 0x53, 0xb0, 0x34, 0xf1, 0x92, 0xf0, 0x0c, 0xc1, 0xa3,
+	0x3d, 0x45, 0x21, 0xcf, 0x91, 0x1d, 0x31, 0x99, 0x3c, 0x7c, 0x4c, 0x72, 0xfb, 0x8c, 0xc9, 0x1f,
+	0x34, 0x84, 0x21, 0x26, 0x2a, 0x41, 0xbf, 0xf4, 0xc8, 0xbc, 0x88, 0x80, 0x80, 0x6d, 0x9f, 0x4d,
+	0xe8, 0x9a, 0xd1, 0xc9, 0x03, 0x39, 0x29, 0x13, 0xac, 0x0b, 0x5c, 0xfe, 0x3a, 0x94, 0xf1, 0xfa,
+	0xaf, 0x79, 0xc1, 0x80, 0x1d, 0x8f, 0xe4, 0xb6, 0x67, 0xb8, 0xbe, 0x0d, 0x2f, 0xd7, 0x23, 0xf5,
+	0xc9, 0x77, 0x09, 0xe5, 0x7c, 0xe6, 0x1f, 0x2c, 0x64, 0x59, 0xb4, 0x40, 0x46, 0x4e, 0x73, 0x4d,
+	0x90, 0xcb, 0x9a, 0x00, 0xc8, 0x1f, 0x8b, 0xb9, 0xc7, 0x5a, 0x6a, 0xc8, 0xef, 0xbb, 0xd4, 0xb0,
+	0x8c, 0x0a, 0xf1, 0xe2, 0x48, 0xb7, 0xb3, 0xfe, 0xce, 0x85, 0x89, 0xc7, 0x1d, 0xec, 0x93, 0x7d,
+	0x97, 0xee, 0x9d, 0xe2, 0xe3, 0xed, 0xde, 0xa9, 0x37, 0xe1, 0x57, 0xad, 0x5a, 0xa1, 0x7b, 0x95,
+	0x04, 0x86, 0xed, 0xf8, 0x11, 0xc5, 0x0f, 0xc0, 0x94, 0xfa, 0x8f, 0xf8, 0x09, 0x9e, 0x18, 0x39,
+	0x2e, 0x3c, 0x7b, 0x84, 0x3a, 0x94, 0x5a, 0xdc, 0xc9, 0x1f, 0xb0, 0xb8, 0x73, 0xc8, 0x3a, 0xd4,
+	0x85, 0x6f, 0xa2, 0x1c, 0xa4, 0x0e, 0xb8, 0x88, 0x72, 0xab, 0xec, 0x8d, 0x52, 0x99, 0xc0, 0x25,
+	0x34, 0xb5, 0x7a, 0xcf, 0x36, 0x03, 0x62, 0x55, 0x34, 0x3c, 0x85, 0xb2, 0xaf, 0xbe, 0x7a, 0xb3,
+	0x92, 0xc1, 0xf3, 0xa8, 0x72, 0x95, 0x18, 0x96, 0x63, 0xbb, 0x64, 0xf5, 0x01, 0xff, 0x5e, 0xaa,
+	0x64, 0x2f, 0xbc, 0x81, 0x2a, 0xe9, 0x9e, 0x16, 0x7c, 0x06, 0x9d, 0xba, 0xe3, 0x6e, 0xb9, 0xf4,
+	0xbe, 0x9b, 0x16, 0x55, 0x26, 0xf0, 0x0c, 0x2a, 0x5e, 0x33, 0x6c, 0x6f, 0x7d, 0xd3, 0xf0, 0x48,
+	0x45, 0x63, 0x73, 0xdd, 0xf1, 0xba, 0xc4, 0x35, 0xb7, 0x2b, 0x19, 0x26, 0x63, 0xbf, 0x1f, 0x72,
+	0xd5, 0x33, 0x6c, 0xb7, 0x92, 0x5d, 0xfe, 0x6b, 0x16, 0xe5, 0x78, 0xe1, 0xe9, 0x12, 0x2a, 0xb7,
+	0x48, 0x9f, 0x7a, 0xc1, 0xcd, 0xd0, 0x09, 0xec, 0xbe, 0x43, 0x70, 0x39, 0x49, 0x1b, 0x58, 0x42,
+	0x53, 0x3d, 0xb9, 0x63, 0x3f, 0xaf, 0xb2, 0x47, 0xc5, 0x17, 0x51, 0x9e, 0x6b, 0xe2, 0x9d, 0x89,
+	0xc6, 0xae, 0x4a, 0x04, 0xcd, 0xbe, 0x44, 0x02, 0x7e, 0x82, 0x83, 0x82, 0x8f, 0x71, 0xcc, 0x81,
+	0xf8, 0x50, 0xaf, 0x9e, 0x4a, 0x2c, 0x2a, 0x69, 0x50, 0xfd, 0xc9, 0xef, 0x7d, 0xfa, 0xc5, 0xcf,
+	0x33, 0x67, 0xeb, 0xfa, 0xd2, 0xbd, 0xaf, 0x2d, 0xdd, 0xa5, 0x9d, 0x67, 0x7d, 0x12, 0x2c, 0xbd,
+	0x0b, 0xdc, 0x7a, 0x6f, 0xe9, 0x5d, 0xdb, 0x7a, 0xef, 0xb2, 0x76, 0xe1, 0x39, 0x0d, 0x5f, 0x46,
+	0x39, 0x78, 0x55, 0x08, 0xd7, 0xe4, 0x57, 0xe9, 0xee, 0xb6, 0xb3, 0xef, 0x67, 0xb4, 0xe7, 0x34,
+	0x7c, 0x05, 0x95, 0xa4, 0xd7, 0x0c, 0x3e, 0x95, 0x58, 0x18, 0xe5, 0xe3, 0xce, 0x83, 0x1d, 0x4c,
+	0x54, 0xf8, 0x53, 0x4a, 0xdb, 0xf0, 0xb4, 0x54, 0x3d, 0x50, 0xf7, 0x77, 0x15, 0xef, 0x14, 0xe1,
+	0xcb, 0x28, 0xff, 0x32, 0xfc, 0xb1, 0x02, 0xbc, 0x4b, 0x28, 0xab, 0xfc, 0x33, 0x8c, 0x0f, 0x5a,
+	0xd9, 0x24, 0xe6, 0x56, 0x8b, 0xf8, 0x7d, 0xea, 0xfa, 0xa4, 0xf9, 0xd6, 0x67, 0xff, 0x58, 0x9c,
+	0xf8, 0xee, 0xc3, 0x45, 0xed, 0xe3, 0x87, 0x8b, 0xda, 0x27, 0x0f, 0x17, 0xb5, 0xbf, 0x3f, 0x5c,
+	0xd4, 0x3e, 0xf8, 0x7c, 0x71, 0xe2, 0x93, 0xcf, 0x17, 0x27, 0x3e, 0xfb, 0x7c, 0x71, 0xe2, 0x8d,
+	0xa7, 0xa4, 0xbf, 0x63, 0x60, 0x78, 0x3d, 0xc3, 0x32, 0xfa, 0x1e, 0xbd, 0x4b, 0xcc, 0x40, 0xfc,
+	0x14, 0xfd, 0x19, 0x82, 0xdf, 0x66, 0xe6, 0xaf, 0x00, 0x70, 0x8b, 0x8b, 0x1b, 0xd7, 0x69, 0xe3,
+	0x4a, 0xdf, 0xee, 0xe4, 0xc1, 0x97, 0x8b, 0xff, 0x1b, 0x00, 0x6a, 0xc6, 0x3d, 0x10, 0xa9, 0x41,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventClient is the client API for Event service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type EventClient interface {
@@ -2957,6 +3587,10 @@ type EventClient interface {
 	Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error)
 	GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error)
 	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Event_WatchClient, error)
+	// WatchJobSet streams the state transitions of the jobs in a job set as they occur, until the client disconnects.
+	WatchJobSet(ctx context.Context, in *WatchJobSetRequest, opts ...grpc.CallOption) (Event_WatchJobSetClient, error)
+	// GetJobRunDetails returns the state of a job and where its latest run was placed, derived from the events of its job set.
+	GetJobRunDetails(ctx context.Context, in *JobRunDetailsRequest, opts ...grpc.CallOption) (*JobRunDetails, error)
 	Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
@@ -3051,6 +3685,47 @@ func (x *eventWatchClient) Recv() (*EventStreamMessage, error) {
 	return m, nil
 }
 
+func (c *eventClient) WatchJobSet(ctx context.Context, in *WatchJobSetRequest, opts ...grpc.CallOption) (Event_WatchJobSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[2], "/api.Event/WatchJobSet", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventWatchJobSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Event_WatchJobSetClient interface {
+	Recv() (*JobStateTransition, error)
+	grpc.ClientStream
+}
+
+type eventWatchJobSetClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventWatchJobSetClient) Recv() (*JobStateTransition, error) {
+	m := new(JobStateTransition)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventClient) GetJobRunDetails(ctx context.Context, in *JobRunDetailsRequest, opts ...grpc.CallOption) (*JobRunDetails, error) {
+	out := new(JobRunDetails)
+	err := c.cc.Invoke(ctx, "/api.Event/GetJobRunDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	out := new(HealthCheckResponse)
 	err := c.cc.Invoke(ctx, "/api.Event/Health", in, out, opts...)
@@ -3066,6 +3741,10 @@ type EventServer interface {
 	Report(context.Context, *EventMessage) (*types.Empty, error)
 	GetJobSetEvents(*JobSetRequest, Event_GetJobSetEventsServer) error
 	Watch(*WatchRequest, Event_WatchServer) error
+	// WatchJobSet streams the state transitions of the jobs in a job set as they occur, until the client disconnects.
+	WatchJobSet(*WatchJobSetRequest, Event_WatchJobSetServer) error
+	// GetJobRunDetails returns the state of a job and where its latest run was placed, derived from the events of its job set.
+	GetJobRunDetails(context.Context, *JobRunDetailsRequest) (*JobRunDetails, error)
 	Health(context.Context, *types.Empty) (*HealthCheckResponse, error)
 }
 
@@ -3085,6 +3764,12 @@ func (*UnimplementedEventServer) GetJobSetEvents(req *JobSetRequest, srv Event_G
 func (*UnimplementedEventServer) Watch(req *WatchRequest, srv Event_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
+func (*UnimplementedEventServer) WatchJobSet(req *WatchJobSetRequest, srv Event_WatchJobSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobSet not implemented")
+}
+func (*UnimplementedEventServer) GetJobRunDetails(ctx context.Context, req *JobRunDetailsRequest) (*JobRunDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRunDetails not implemented")
+}
 func (*UnimplementedEventServer) Health(ctx context.Context, req *types.Empty) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
 }
@@ -3171,6 +3856,45 @@ func (x *eventWatchServer) Send(m *EventStreamMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Event_WatchJobSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServer).WatchJobSet(m, &eventWatchJobSetServer{stream})
+}
+
+type Event_WatchJobSetServer interface {
+	Send(*JobStateTransition) error
+	grpc.ServerStream
+}
+
+type eventWatchJobSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventWatchJobSetServer) Send(m *JobStateTransition) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Event_GetJobRunDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRunDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).GetJobRunDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/GetJobRunDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).GetJobRunDetails(ctx, req.(*JobRunDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Event_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Empty)
 	if err := dec(in); err != nil {
@@ -3201,6 +3925,10 @@ var _Event_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Report",
 			Handler:    _Event_Report_Handler,
 		},
+		{
+			MethodName: "GetJobRunDetails",
+			Handler:    _Event_GetJobRunDetails_Handler,
+		},
 		{
 			MethodName: "Health",
 			Handler:    _Event_Health_Handler,
@@ -3217,6 +3945,11 @@ var _Event_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Event_Watch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchJobSet",
+			Handler:       _Event_WatchJobSet_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pkg/api/event.proto",
 }
@@ -3698,6 +4431,25 @@ func (m *JobRunningEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NodeLabels) > 0 {
+		for k := range m.NodeLabels {
+			v := m.NodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.PodNamespace) > 0 {
 		i -= len(m.PodNamespace)
 		copy(dAtA[i:], m.PodNamespace)
@@ -3879,6 +4631,140 @@ func (m *JobIngressInfoEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobArtifactsEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobArtifactsEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobArtifactsEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Artifacts) > 0 {
+		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintEvent(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Artifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Artifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContainerName) > 0 {
+		i -= len(m.ContainerName)
+		copy(dAtA[i:], m.ContainerName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContainerName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JobUnableToScheduleEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3946,12 +4832,12 @@ func (m *JobUnableToScheduleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x2a
 	}
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err11 != nil {
-		return 0, err11
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n11
-	i = encodeVarintEvent(dAtA, i, uint64(n11))
+	i -= n12
+	i = encodeVarintEvent(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -3998,6 +4884,11 @@ func (m *JobFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FailureCategory != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.FailureCategory))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.PodNamespace) > 0 {
 		i -= len(m.PodNamespace)
 		copy(dAtA[i:], m.PodNamespace)
@@ -4081,12 +4972,12 @@ func (m *JobFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintEvent(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintEvent(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4133,6 +5024,25 @@ func (m *JobPreemptedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PreemptiveQueue) > 0 {
+		i -= len(m.PreemptiveQueue)
+		copy(dAtA[i:], m.PreemptiveQueue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreemptiveQueue)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.PreemptiveRunId) > 0 {
 		i -= len(m.PreemptiveRunId)
 		copy(dAtA[i:], m.PreemptiveRunId)
@@ -4161,12 +5071,12 @@ func (m *JobPreemptedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err13 != nil {
-		return 0, err13
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n13
-	i = encodeVarintEvent(dAtA, i, uint64(n13))
+	i -= n14
+	i = encodeVarintEvent(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4283,12 +5193,12 @@ func (m *JobSucceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err14 != nil {
-		return 0, err14
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n14
-	i = encodeVarintEvent(dAtA, i, uint64(n14))
+	i -= n15
+	i = encodeVarintEvent(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4335,6 +5245,30 @@ func (m *JobUtilisationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AverageResources) > 0 {
+		for k := range m.AverageResources {
+			v := m.AverageResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.TotalCumulativeUsage) > 0 {
 		for k := range m.TotalCumulativeUsage {
 			v := m.TotalCumulativeUsage[k]
@@ -4423,12 +5357,12 @@ func (m *JobUtilisationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err17 != nil {
-		return 0, err17
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n17
-	i = encodeVarintEvent(dAtA, i, uint64(n17))
+	i -= n19
+	i = encodeVarintEvent(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4488,12 +5422,12 @@ func (m *JobReprioritizingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x29
 	}
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err18 != nil {
-		return 0, err18
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err20 != nil {
+		return 0, err20
 	}
-	i -= n18
-	i = encodeVarintEvent(dAtA, i, uint64(n18))
+	i -= n20
+	i = encodeVarintEvent(dAtA, i, uint64(n20))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4553,12 +5487,12 @@ func (m *JobReprioritizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x29
 	}
-	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err19 != nil {
-		return 0, err19
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err21 != nil {
+		return 0, err21
 	}
-	i -= n19
-	i = encodeVarintEvent(dAtA, i, uint64(n19))
+	i -= n21
+	i = encodeVarintEvent(dAtA, i, uint64(n21))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4619,12 +5553,12 @@ func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err20 != nil {
-		return 0, err20
-	}
-	i -= n20
-	i = encodeVarintEvent(dAtA, i, uint64(n20))
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintEvent(dAtA, i, uint64(n22))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4685,12 +5619,12 @@ func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err21 != nil {
-		return 0, err21
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err23 != nil {
+		return 0, err23
 	}
-	i -= n21
-	i = encodeVarintEvent(dAtA, i, uint64(n21))
+	i -= n23
+	i = encodeVarintEvent(dAtA, i, uint64(n23))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4777,12 +5711,12 @@ func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err22 != nil {
-		return 0, err22
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err24 != nil {
+		return 0, err24
 	}
-	i -= n22
-	i = encodeVarintEvent(dAtA, i, uint64(n22))
+	i -= n24
+	i = encodeVarintEvent(dAtA, i, uint64(n24))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4846,12 +5780,12 @@ func (m *JobUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err24 != nil {
-		return 0, err24
+	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err26 != nil {
+		return 0, err26
 	}
-	i -= n24
-	i = encodeVarintEvent(dAtA, i, uint64(n24))
+	i -= n26
+	i = encodeVarintEvent(dAtA, i, uint64(n26))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -5363,6 +6297,29 @@ func (m *EventMessage_Preempted) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessage_Artifacts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Artifacts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Artifacts != nil {
+		{
+			size, err := m.Artifacts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5644,6 +6601,240 @@ func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchJobSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchJobSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchJobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromSequence) > 0 {
+		i -= len(m.FromSequence)
+		copy(dAtA[i:], m.FromSequence)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromSequence)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobStateTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobStateTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobStateTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerStatuses) > 0 {
+		for iNdEx := len(m.ContainerStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContainerStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Sequence) > 0 {
+		i -= len(m.Sequence)
+		copy(dAtA[i:], m.Sequence)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sequence)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err50 != nil {
+		return 0, err50
+	}
+	i -= n50
+	i = encodeVarintEvent(dAtA, i, uint64(n50))
+	i--
+	dAtA[i] = 0x2a
+	if m.State != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRunDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRunDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -5906,6 +7097,14 @@ func (m *JobRunningEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if len(m.NodeLabels) > 0 {
+		for k, v := range m.NodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -5963,6 +7162,70 @@ func (m *JobIngressInfoEvent) Size() (n int) {
 	return n
 }
 
+func (m *JobArtifactsEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Artifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ContainerName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovEvent(uint64(m.SizeBytes))
+	}
+	return n
+}
+
 func (m *JobUnableToScheduleEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6077,6 +7340,9 @@ func (m *JobFailedEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.FailureCategory != 0 {
+		n += 1 + sovEvent(uint64(m.FailureCategory))
+	}
 	return n
 }
 
@@ -6116,6 +7382,17 @@ func (m *JobPreemptedEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	l = len(m.PreemptiveQueue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -6239,6 +7516,15 @@ func (m *JobUtilisationEvent) Size() (n int) {
 			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
 		}
 	}
+	if len(m.AverageResources) > 0 {
+		for k, v := range m.AverageResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -6703,6 +7989,18 @@ func (m *EventMessage_Preempted) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessage_Artifacts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Artifacts != nil {
+		l = m.Artifacts.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
 func (m *ContainerStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6822,6 +8120,119 @@ func (m *WatchRequest) Size() (n int) {
 	return n
 }
 
+func (m *WatchJobSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FromSequence)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobStateTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovEvent(uint64(m.State))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Sequence)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.ContainerStatuses) > 0 {
+		for _, e := range m.ContainerStatuses {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobRunDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRunDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovEvent(uint64(m.State))
+	}
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6936,6 +8347,16 @@ func (this *JobRunningEvent) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForNodeLabels := make([]string, 0, len(this.NodeLabels))
+	for k, _ := range this.NodeLabels {
+		keysForNodeLabels = append(keysForNodeLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeLabels)
+	mapStringForNodeLabels := "map[string]string{"
+	for _, k := range keysForNodeLabels {
+		mapStringForNodeLabels += fmt.Sprintf("%v: %v,", k, this.NodeLabels[k])
+	}
+	mapStringForNodeLabels += "}"
 	s := strings.Join([]string{`&JobRunningEvent{`,
 		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
 		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
@@ -6947,6 +8368,7 @@ func (this *JobRunningEvent) String() string {
 		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
 		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
 		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`NodeLabels:` + mapStringForNodeLabels + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6981,6 +8403,41 @@ func (this *JobIngressInfoEvent) String() string {
 	}, "")
 	return s
 }
+func (this *JobArtifactsEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArtifacts := "[]*Artifact{"
+	for _, f := range this.Artifacts {
+		repeatedStringForArtifacts += strings.Replace(f.String(), "Artifact", "Artifact", 1) + ","
+	}
+	repeatedStringForArtifacts += "}"
+	s := strings.Join([]string{`&JobArtifactsEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`Artifacts:` + repeatedStringForArtifacts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Artifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Artifact{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ContainerName:` + fmt.Sprintf("%v", this.ContainerName) + `,`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`SizeBytes:` + fmt.Sprintf("%v", this.SizeBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *JobUnableToScheduleEvent) String() string {
 	if this == nil {
 		return "nil"
@@ -7035,6 +8492,7 @@ func (this *JobFailedEvent) String() string {
 		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
 		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
 		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`FailureCategory:` + fmt.Sprintf("%v", this.FailureCategory) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7052,6 +8510,9 @@ func (this *JobPreemptedEvent) String() string {
 		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
 		`PreemptiveJobId:` + fmt.Sprintf("%v", this.PreemptiveJobId) + `,`,
 		`PreemptiveRunId:` + fmt.Sprintf("%v", this.PreemptiveRunId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`PreemptiveQueue:` + fmt.Sprintf("%v", this.PreemptiveQueue) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7109,6 +8570,16 @@ func (this *JobUtilisationEvent) String() string {
 		mapStringForTotalCumulativeUsage += fmt.Sprintf("%v: %v,", k, this.TotalCumulativeUsage[k])
 	}
 	mapStringForTotalCumulativeUsage += "}"
+	keysForAverageResources := make([]string, 0, len(this.AverageResources))
+	for k, _ := range this.AverageResources {
+		keysForAverageResources = append(keysForAverageResources, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAverageResources)
+	mapStringForAverageResources := "map[string]resource.Quantity{"
+	for _, k := range keysForAverageResources {
+		mapStringForAverageResources += fmt.Sprintf("%v: %v,", k, this.AverageResources[k])
+	}
+	mapStringForAverageResources += "}"
 	s := strings.Join([]string{`&JobUtilisationEvent{`,
 		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
 		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
@@ -7122,6 +8593,7 @@ func (this *JobUtilisationEvent) String() string {
 		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
 		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
 		`TotalCumulativeUsage:` + mapStringForTotalCumulativeUsage + `,`,
+		`AverageResources:` + mapStringForAverageResources + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7440,6 +8912,16 @@ func (this *EventMessage_Preempted) String() string {
 	}, "")
 	return s
 }
+func (this *EventMessage_Artifacts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Artifacts{`,
+		`Artifacts:` + strings.Replace(fmt.Sprintf("%v", this.Artifacts), "JobArtifactsEvent", "JobArtifactsEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ContainerStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -7510,39 +8992,100 @@ func (this *WatchRequest) String() string {
 	}, "")
 	return s
 }
-func valueToStringEvent(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *WatchJobSetRequest) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := strings.Join([]string{`&WatchJobSetRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`FromSequence:` + fmt.Sprintf("%v", this.FromSequence) + `,`,
+		`}`,
+	}, "")
+	return s
 }
-func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmittedEvent: wiretype end group for non-group")
-		}
+func (this *JobStateTransition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainerStatuses := "[]*ContainerStatus{"
+	for _, f := range this.ContainerStatuses {
+		repeatedStringForContainerStatuses += strings.Replace(f.String(), "ContainerStatus", "ContainerStatus", 1) + ","
+	}
+	repeatedStringForContainerStatuses += "}"
+	s := strings.Join([]string{`&JobStateTransition{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Sequence:` + fmt.Sprintf("%v", this.Sequence) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ContainerStatuses:` + repeatedStringForContainerStatuses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRunDetailsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobRunDetailsRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRunDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobRunDetails{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEvent(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmittedEvent: wiretype end group for non-group")
+		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: JobSubmittedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
@@ -9487,6 +11030,133 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeLabels == nil {
+				m.NodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -9979,7 +11649,7 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
+func (m *JobArtifactsEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10002,10 +11672,10 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobUnableToScheduleEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobArtifactsEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobUnableToScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobArtifactsEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10171,7 +11841,7 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10199,13 +11869,13 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			var stringLen uint64
+			m.PodNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10215,29 +11885,16 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PodNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10247,46 +11904,111 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.Artifacts = append(m.Artifacts, &Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10314,11 +12036,11 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.ContainerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10346,8 +12068,27 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -10369,7 +12110,7 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10392,10 +12133,10 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUnableToScheduleEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUnableToScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10592,119 +12333,6 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExitCodes == nil {
-				m.ExitCodes = make(map[string]int32)
-			}
-			var mapkey string
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ExitCodes[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
 			}
@@ -10736,7 +12364,7 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.KubernetesId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
@@ -10768,7 +12396,7 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
@@ -10787,11 +12415,11 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 11:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStatuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10801,31 +12429,29 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerStatuses = append(m.ContainerStatuses, &ContainerStatus{})
-			if err := m.ContainerStatuses[len(m.ContainerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
 			}
-			m.Cause = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10835,65 +12461,14 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cause |= Cause(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -10925,7 +12500,7 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10948,10 +12523,10 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobPreemptedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobFailedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobPreemptedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11117,7 +12692,7 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11145,13 +12720,13 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveJobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11161,27 +12736,108 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreemptiveJobId = string(dAtA[iNdEx:postIndex])
+			if m.ExitCodes == nil {
+				m.ExitCodes = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExitCodes[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveRunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11209,63 +12865,64 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreemptiveRunId = string(dAtA[iNdEx:postIndex])
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobFailedEventCompressed) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobFailedEventCompressed: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobFailedEventCompressed: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStatuses", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11275,26 +12932,128 @@ func (m *JobFailedEventCompressed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Event = append(m.Event[:0], dAtA[iNdEx:postIndex]...)
-			if m.Event == nil {
-				m.Event = []byte{}
+			m.ContainerStatuses = append(m.ContainerStatuses, &ContainerStatus{})
+			if err := m.ContainerStatuses[len(m.ContainerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= Cause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCategory", wireType)
+			}
+			m.FailureCategory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCategory |= FailureCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -11316,7 +13075,7 @@ func (m *JobFailedEventCompressed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
+func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11339,10 +13098,10 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSucceededEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobPreemptedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSucceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobPreemptedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11508,7 +13267,7 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11536,11 +13295,11 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveJobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11568,13 +13327,13 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.PreemptiveJobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveRunId", wireType)
 			}
-			m.PodNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11584,14 +13343,46 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreemptiveRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= PreemptionReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveQueue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11619,11 +13410,11 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.PreemptiveQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11651,7 +13442,7 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11674,7 +13465,7 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
+func (m *JobFailedEventCompressed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11697,10 +13488,94 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobUtilisationEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobFailedEventCompressed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobUtilisationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobFailedEventCompressed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = append(m.Event[:0], dAtA[iNdEx:postIndex]...)
+			if m.Event == nil {
+				m.Event = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSucceededEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSucceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11898,9 +13773,9 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11910,124 +13785,78 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxResourcesForPeriod == nil {
-				m.MaxResourcesForPeriod = make(map[string]resource.Quantity)
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.MaxResourcesForPeriod[mapkey] = *mapvalue
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12055,13 +13884,63 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PodNumber = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobUtilisationEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobUtilisationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12071,14 +13950,27 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12106,11 +13998,11 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12138,11 +14030,11 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCumulativeUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12169,16 +14061,113 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalCumulativeUsage == nil {
-				m.TotalCumulativeUsage = make(map[string]resource.Quantity)
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxResourcesForPeriod == nil {
+				m.MaxResourcesForPeriod = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
 						return ErrIntOverflowEvent
 					}
 					if iNdEx >= l {
@@ -12267,61 +14256,11 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.TotalCumulativeUsage[mapkey] = *mapvalue
+			m.MaxResourcesForPeriod[mapkey] = *mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizingEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12349,13 +14288,13 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			var stringLen uint64
+			m.PodNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12365,27 +14304,14 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PodNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12413,13 +14339,13 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12429,41 +14355,29 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCumulativeUsage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12473,46 +14387,272 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requestor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.TotalCumulativeUsage == nil {
+				m.TotalCumulativeUsage = make(map[string]resource.Quantity)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalCumulativeUsage[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AverageResources == nil {
+				m.AverageResources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AverageResources[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12535,10 +14675,10 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizingEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12734,7 +14874,7 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12757,10 +14897,10 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12893,7 +15033,229 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
 			var stringLen uint64
@@ -14170,7 +16532,950 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobSucceededEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Succeeded{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobReprioritizedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Reprioritized{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobCancellingEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Cancelling{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobCancelledEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Cancelled{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobTerminatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Terminated{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobUtilisationEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Utilisation{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateFound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobDuplicateFoundEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_DuplicateFound{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobIngressInfoEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_IngressInfo{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritizing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobReprioritizingEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Reprioritizing{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobUpdatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Updated{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCompressed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobFailedEventCompressed{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_FailedCompressed{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobPreemptedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Preempted{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobArtifactsEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Artifacts{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= Cause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &EventMessage{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &EventMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Watch = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14180,32 +17485,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSucceededEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Succeeded{v}
+			m.FromMessageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14215,32 +17517,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobReprioritizedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Reprioritized{v}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorIfMissing", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14250,32 +17549,37 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ErrorIfMissing = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceLegacy", wireType)
 			}
-			v := &JobCancellingEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Events = &EventMessage_Cancelling{v}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			m.ForceLegacy = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceNew", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14285,32 +17589,67 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
+			m.ForceNew = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobCancelledEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			m.Events = &EventMessage_Cancelled{v}
-			iNdEx = postIndex
-		case 14:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14320,32 +17659,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobTerminatedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Terminated{v}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14355,32 +17691,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobUtilisationEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Utilisation{v}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateFound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14390,32 +17723,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobDuplicateFoundEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_DuplicateFound{v}
+			m.FromId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IngressInfo", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceLegacy", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14425,32 +17755,17 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobIngressInfoEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_IngressInfo{v}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritizing", wireType)
+			m.ForceLegacy = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceNew", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14460,32 +17775,67 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
+			m.ForceNew = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchJobSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobReprioritizingEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Events = &EventMessage_Reprioritizing{v}
-			iNdEx = postIndex
-		case 19:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchJobSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14495,32 +17845,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobUpdatedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Updated{v}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedCompressed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14530,32 +17877,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobFailedEventCompressed{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_FailedCompressed{v}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preempted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14565,26 +17909,23 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobPreemptedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Preempted{v}
+			m.FromSequence = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14607,7 +17948,7 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
+func (m *JobStateTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14630,15 +17971,15 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobStateTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobStateTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14666,13 +18007,13 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			m.ExitCode = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14682,14 +18023,27 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExitCode |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14717,11 +18071,63 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14749,13 +18155,13 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.Cause = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -14765,64 +18171,59 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cause |= Cause(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			m.Sequence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14849,8 +18250,8 @@ func (m *EventList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &EventMessage{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContainerStatuses = append(m.ContainerStatuses, &ContainerStatus{})
+			if err := m.ContainerStatuses[len(m.ContainerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14875,7 +18276,7 @@ func (m *EventList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
+func (m *JobRunDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14898,15 +18299,15 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14934,43 +18335,7 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Message == nil {
-				m.Message = &EventMessage{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14993,7 +18358,7 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
+func (m *JobRunDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15016,15 +18381,15 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15052,31 +18417,11 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Watch = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15104,9 +18449,9 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromMessageId = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
@@ -15138,121 +18483,11 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorIfMissing", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ErrorIfMissing = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceLegacy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceLegacy = bool(v != 0)
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceNew", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceNew = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WatchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -15262,27 +18497,14 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15310,11 +18532,11 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15342,33 +18564,13 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromId = string(dAtA[iNdEx:postIndex])
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceLegacy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceLegacy = bool(v != 0)
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceNew", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			var v int
+			m.PodNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -15378,12 +18580,11 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PodNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceNew = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -75,6 +75,8 @@ func UnwrapEvent(message *EventMessage) (Event, error) {
 		return event.Updated, nil
 	case *EventMessage_Preempted:
 		return event.Preempted, nil
+	case *EventMessage_Artifacts:
+		return event.Artifacts, nil
 	}
 	return nil, errors.Errorf("unknown event type: %s", reflect.TypeOf(message.Events))
 }
@@ -201,6 +203,12 @@ func Wrap(event Event) (*EventMessage, error) {
 				Preempted: typed,
 			},
 		}, nil
+	case *JobArtifactsEvent:
+		return &EventMessage{
+			Events: &EventMessage_Artifacts{
+				Artifacts: typed,
+			},
+		}, nil
 	}
 	return nil, errors.Errorf("unknown event type: %s", reflect.TypeOf(event))
 }
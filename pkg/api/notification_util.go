@@ -0,0 +1,41 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	notificationEventPrefix = "NOTIFICATION_"
+	webhookKindPrefix       = "WEBHOOK_"
+)
+
+// ShortName returns the name of the event without its prefix, e.g., "JOB_FAILED",
+// as used in the notifications sent to webhooks and by armadactl.
+func (x NotificationEvent) ShortName() string {
+	return strings.TrimPrefix(x.String(), notificationEventPrefix)
+}
+
+// ParseNotificationEvent returns the event with the provided name, as returned by ShortName. Names are case-insensitive.
+func ParseNotificationEvent(name string) (NotificationEvent, error) {
+	value, ok := NotificationEvent_value[notificationEventPrefix+strings.ToUpper(name)]
+	if !ok {
+		return 0, errors.Errorf("unknown notification event %s", name)
+	}
+	return NotificationEvent(value), nil
+}
+
+// ShortName returns the name of the webhook kind without its prefix, e.g., "SLACK".
+func (x WebhookKind) ShortName() string {
+	return strings.TrimPrefix(x.String(), webhookKindPrefix)
+}
+
+// ParseWebhookKind returns the webhook kind with the provided name, as returned by ShortName. Names are case-insensitive.
+func ParseWebhookKind(name string) (WebhookKind, error) {
+	value, ok := WebhookKind_value[webhookKindPrefix+strings.ToUpper(name)]
+	if !ok {
+		return 0, errors.Errorf("unknown webhook kind %s", name)
+	}
+	return WebhookKind(value), nil
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNotificationEvent(t *testing.T) {
+	for _, event := range []NotificationEvent{
+		NotificationEvent_NOTIFICATION_JOB_FAILED,
+		NotificationEvent_NOTIFICATION_JOB_SUCCEEDED,
+		NotificationEvent_NOTIFICATION_JOB_CANCELLED,
+		NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED,
+	} {
+		parsed, err := ParseNotificationEvent(event.ShortName())
+		require.NoError(t, err)
+		assert.Equal(t, event, parsed)
+	}
+	parsed, err := ParseNotificationEvent("job_set_completed")
+	require.NoError(t, err)
+	assert.Equal(t, NotificationEvent_NOTIFICATION_JOB_SET_COMPLETED, parsed)
+	_, err = ParseNotificationEvent("job_running")
+	assert.Error(t, err)
+}
+
+func TestParseWebhookKind(t *testing.T) {
+	kind, err := ParseWebhookKind("slack")
+	require.NoError(t, err)
+	assert.Equal(t, WebhookKind_WEBHOOK_SLACK, kind)
+	kind, err = ParseWebhookKind(WebhookKind_WEBHOOK_GENERIC.ShortName())
+	require.NoError(t, err)
+	assert.Equal(t, WebhookKind_WEBHOOK_GENERIC, kind)
+	_, err = ParseWebhookKind("teams")
+	assert.Error(t, err)
+}
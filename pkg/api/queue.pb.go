@@ -407,6 +407,9 @@ type NodeInfo struct {
 	// This should only be used for metrics
 	// This is the type the node should be reported as. It is simple a label to categorise the group the node belongs to
 	NodeType string `protobuf:"bytes,12,opt,name=node_type,json=nodeType,proto3" json:"nodeType,omitempty"`
+	// Storage classes of which volumes can be attached to the node.
+	// The empty string denotes the default storage class of the cluster.
+	StorageClasses []string `protobuf:"bytes,13,rep,name=storage_classes,json=storageClasses,proto3" json:"storageClasses,omitempty"`
 }
 
 func (m *NodeInfo) Reset()      { *m = NodeInfo{} }
@@ -525,6 +528,13 @@ func (m *NodeInfo) GetNodeType() string {
 	return ""
 }
 
+func (m *NodeInfo) GetStorageClasses() []string {
+	if m != nil {
+		return m.StorageClasses
+	}
+	return nil
+}
+
 // The Armada scheduler must account for taints, labels, and available resources.
 // These together make up the NodeType of a particular node.
 // Nodes with equal NodeType are considered as equivalent for scheduling and accounting.
@@ -1300,163 +1310,164 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/queue.proto", fileDescriptor_d92c0c680df9617a) }
 
 var fileDescriptor_d92c0c680df9617a = []byte{
-	// 2489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xd7, 0xf0, 0xb1, 0x5c, 0x16, 0xdf, 0xcd, 0xd7, 0x70, 0x29, 0xef, 0xae, 0xd7, 0xf8, 0x64,
-	0xfa, 0x8b, 0xb4, 0xb4, 0x68, 0x39, 0x50, 0x72, 0x88, 0xc1, 0x95, 0x14, 0x67, 0x65, 0xd9, 0x92,
-	0x87, 0xb4, 0x81, 0x18, 0x06, 0x46, 0xb3, 0x3b, 0xad, 0x55, 0x93, 0xbb, 0xd3, 0xe3, 0x79, 0x50,
-	0x58, 0x9d, 0x02, 0x27, 0x01, 0x82, 0x20, 0x07, 0x1f, 0x02, 0x24, 0x36, 0x10, 0xe4, 0x18, 0x20,
-	0x40, 0xfe, 0x85, 0x9c, 0x7d, 0xf4, 0x2d, 0xbe, 0x64, 0x9d, 0x48, 0x97, 0x60, 0x8f, 0x39, 0xe6,
-	0x10, 0x04, 0xfd, 0x98, 0xd9, 0x9e, 0xd9, 0x59, 0x92, 0x8e, 0x28, 0x81, 0x87, 0x9c, 0xc8, 0xae,
-	0xaa, 0xae, 0xaa, 0xae, 0xee, 0xfe, 0x55, 0x55, 0xcf, 0xc2, 0xb2, 0x7b, 0xd8, 0xda, 0xb6, 0x5c,
-	0xb2, 0xfd, 0x49, 0x88, 0x43, 0x5c, 0x75, 0x3d, 0x1a, 0x50, 0x34, 0x6e, 0xb9, 0xa4, 0x50, 0x6a,
-	0x51, 0xda, 0x6a, 0xe3, 0x6d, 0x4e, 0x6a, 0x84, 0x0f, 0xb6, 0x03, 0xd2, 0xc1, 0x7e, 0x60, 0x75,
-	0x5c, 0x21, 0x55, 0xa8, 0x1c, 0x5e, 0xf7, 0xab, 0x84, 0xf2, 0xd9, 0x4d, 0xea, 0xe1, 0xed, 0xa3,
-	0xab, 0xdb, 0x2d, 0xec, 0x60, 0xcf, 0x0a, 0xb0, 0x2d, 0x65, 0xb6, 0x14, 0x19, 0x07, 0x07, 0x8f,
-	0xa8, 0x77, 0x48, 0x9c, 0x56, 0x96, 0xe4, 0xb5, 0x81, 0x64, 0xc7, 0x6a, 0x3e, 0x24, 0x0e, 0xf6,
-	0xba, 0xdb, 0x91, 0x73, 0x1e, 0xf6, 0x69, 0xe8, 0x35, 0xf1, 0xd0, 0xac, 0x2b, 0x2d, 0x12, 0x3c,
-	0x0c, 0x1b, 0xd5, 0x26, 0xed, 0x6c, 0xb7, 0x68, 0x8b, 0x0e, 0xbc, 0x65, 0x23, 0x3e, 0xe0, 0xff,
-	0x49, 0xf1, 0xcd, 0xf4, 0x9a, 0x70, 0xc7, 0x0d, 0xba, 0x92, 0xb9, 0x12, 0x59, 0xf3, 0xc3, 0x46,
-	0x87, 0x04, 0x82, 0x5a, 0xf9, 0x66, 0x01, 0xc6, 0x6f, 0xd3, 0x06, 0x2a, 0xc3, 0x18, 0xb1, 0x75,
-	0xad, 0xac, 0x6d, 0x4d, 0xd7, 0x16, 0xfb, 0xbd, 0xd2, 0x2c, 0xb1, 0x2f, 0xd3, 0x0e, 0x09, 0xb8,
-	0x06, 0x63, 0x8c, 0xd8, 0xe8, 0x0d, 0x98, 0x6e, 0xb6, 0x09, 0x76, 0x02, 0x93, 0xd8, 0xfa, 0x1c,
-	0x17, 0x5c, 0xeb, 0xf7, 0x4a, 0x48, 0x10, 0xeb, 0xaa, 0x78, 0x3e, 0xa2, 0xa1, 0x6b, 0x00, 0x07,
-	0xb4, 0x61, 0xfa, 0x98, 0xcf, 0x1a, 0x1b, 0xcc, 0x3a, 0xa0, 0x8d, 0x3d, 0x9c, 0x9a, 0x15, 0xd1,
-	0xd0, 0x6b, 0x30, 0xc9, 0xf7, 0x4b, 0x1f, 0xe7, 0x13, 0x96, 0xfb, 0xbd, 0xd2, 0x02, 0x27, 0x28,
-	0xd2, 0x42, 0x02, 0xbd, 0x09, 0xd3, 0x8e, 0xd5, 0xc1, 0xbe, 0x6b, 0x35, 0xb1, 0x3e, 0xc5, 0xc5,
-	0xd7, 0xfb, 0xbd, 0xd2, 0x72, 0x4c, 0x54, 0xa6, 0x0c, 0x24, 0x51, 0x0d, 0x72, 0x6d, 0xab, 0x81,
-	0xdb, 0xbe, 0x3e, 0x5d, 0x1e, 0xdf, 0x9a, 0xd9, 0x59, 0xa9, 0x5a, 0x2e, 0xa9, 0xde, 0xa6, 0x8d,
-	0xea, 0x1d, 0x4e, 0xbe, 0xe5, 0x04, 0x5e, 0xb7, 0xb6, 0xd2, 0xef, 0x95, 0x16, 0x85, 0x9c, 0xa2,
-	0x46, 0xce, 0x44, 0x1f, 0xc2, 0x8c, 0xe5, 0x38, 0x34, 0xb0, 0x02, 0x42, 0x1d, 0x5f, 0x07, 0xae,
-	0x68, 0x23, 0x56, 0xb4, 0x3b, 0xe0, 0x09, 0x6d, 0x1b, 0xfd, 0x5e, 0x69, 0x55, 0x99, 0xa1, 0xa8,
-	0x54, 0x15, 0xa1, 0x23, 0x58, 0xf1, 0xf0, 0x27, 0x21, 0xf1, 0xb0, 0x6d, 0x3a, 0xd4, 0xc6, 0xa6,
-	0xf4, 0x74, 0x86, 0x1b, 0x28, 0xc7, 0x06, 0x0c, 0x29, 0xf4, 0x1e, 0xb5, 0xb1, 0xea, 0x75, 0xa5,
-	0xdf, 0x2b, 0x5d, 0xf4, 0x86, 0x98, 0x03, 0x73, 0xba, 0x66, 0xa0, 0x61, 0x3e, 0x8b, 0x3a, 0x7d,
-	0xe4, 0x60, 0x4f, 0xcf, 0x0f, 0xa2, 0xce, 0x09, 0x6a, 0xd4, 0x39, 0x01, 0x61, 0xd8, 0xe4, 0xe1,
-	0x37, 0xf9, 0xd0, 0x7f, 0x48, 0x5c, 0x33, 0xf4, 0xb1, 0x67, 0xb6, 0x3c, 0x1a, 0xba, 0xbe, 0xbe,
-	0x50, 0x1e, 0xdf, 0x9a, 0xae, 0x5d, 0xea, 0xf7, 0x4a, 0x15, 0x2e, 0x76, 0x37, 0x92, 0xfa, 0xc0,
-	0xc7, 0xde, 0xdb, 0x5c, 0x46, 0xd1, 0xa9, 0x8f, 0x92, 0x41, 0x3f, 0xd3, 0xe0, 0x52, 0x93, 0x76,
-	0x5c, 0x0f, 0xfb, 0x3e, 0xb6, 0xcd, 0xe3, 0x4c, 0x2e, 0x97, 0xb5, 0xad, 0xd9, 0xda, 0xeb, 0xfd,
-	0x5e, 0xe9, 0xf2, 0x60, 0xc6, 0xfb, 0x27, 0x1b, 0xaf, 0x9c, 0x2c, 0x8d, 0x76, 0x20, 0xef, 0x7a,
-	0x84, 0x7a, 0x24, 0xe8, 0xea, 0x13, 0x65, 0x6d, 0x4b, 0x13, 0x47, 0x38, 0xa2, 0xa9, 0x47, 0x38,
-	0xa2, 0xa1, 0xbb, 0x90, 0x77, 0xa9, 0x6d, 0xfa, 0x2e, 0x6e, 0xea, 0x93, 0x65, 0x6d, 0x6b, 0x66,
-	0x67, 0xb3, 0x2a, 0x20, 0x80, 0xef, 0x1f, 0x03, 0x94, 0xea, 0xd1, 0xd5, 0xea, 0x3d, 0x6a, 0xef,
-	0xb9, 0xb8, 0xc9, 0xcf, 0xec, 0x92, 0x2b, 0x06, 0x89, 0x8d, 0x9a, 0x92, 0x44, 0x74, 0x0f, 0xa6,
-	0x23, 0x85, 0xbe, 0x3e, 0xcb, 0x8f, 0xc2, 0xb1, 0x1a, 0x85, 0x8b, 0x62, 0xe0, 0x27, 0x5c, 0x94,
-	0x34, 0xf4, 0x85, 0x06, 0x65, 0xbf, 0xf9, 0x10, 0xdb, 0x61, 0x9b, 0x38, 0x2d, 0x33, 0x02, 0x21,
-	0x53, 0x1e, 0x8d, 0x0e, 0x76, 0x02, 0x5f, 0x5f, 0xe5, 0xbe, 0x6f, 0x65, 0x59, 0x32, 0xe4, 0x04,
-	0x43, 0x91, 0xaf, 0x5d, 0xfa, 0xb2, 0x57, 0xba, 0xd0, 0xef, 0x95, 0x8a, 0x03, 0xcd, 0x59, 0x72,
-	0xc6, 0x09, 0x7c, 0x54, 0x87, 0xa9, 0xa6, 0x87, 0x19, 0x14, 0xea, 0x39, 0xee, 0x42, 0xa1, 0x2a,
-	0xc0, 0xad, 0x1a, 0x81, 0x5b, 0x75, 0x3f, 0x02, 0xec, 0xda, 0xb2, 0x34, 0x1a, 0x4d, 0xf9, 0xec,
-	0x9b, 0x92, 0x66, 0x44, 0x03, 0x74, 0x03, 0xa6, 0x88, 0xd3, 0x62, 0x7b, 0xac, 0xcf, 0xf3, 0xb8,
-	0x21, 0xbe, 0x8c, 0xba, 0xa0, 0xdd, 0xa0, 0xce, 0x03, 0xd2, 0xaa, 0xad, 0xb2, 0x0d, 0x90, 0x62,
-	0x4a, 0xb4, 0xa2, 0x99, 0xe8, 0x87, 0x90, 0xf7, 0xb1, 0x77, 0x44, 0x9a, 0xd8, 0xd7, 0x17, 0x15,
-	0x2d, 0x7b, 0x82, 0x28, 0xb5, 0xf0, 0xa0, 0x47, 0x72, 0x6a, 0xd0, 0x23, 0x1a, 0xfa, 0x18, 0x66,
-	0x0e, 0xaf, 0xfb, 0x66, 0xe4, 0xd0, 0x12, 0x57, 0xf5, 0xb2, 0x1a, 0xde, 0x41, 0x1e, 0x61, 0x41,
-	0x96, 0x5e, 0xd6, 0xf4, 0x7e, 0xaf, 0xb4, 0x72, 0x78, 0xdd, 0xaf, 0x0f, 0xb9, 0x08, 0x03, 0x2a,
-	0x83, 0x24, 0xa6, 0x5d, 0x5a, 0xd3, 0xd1, 0xe8, 0x63, 0x22, 0xfd, 0x8e, 0xf5, 0xca, 0x71, 0x4a,
-	0xaf, 0xa4, 0x32, 0x94, 0x95, 0xfb, 0x85, 0x3d, 0x7d, 0x65, 0x80, 0xb2, 0x31, 0x51, 0x45, 0xd9,
-	0x98, 0x88, 0xea, 0xb0, 0x24, 0xee, 0x6c, 0x10, 0xb4, 0x4d, 0x1f, 0x37, 0xa9, 0x63, 0xfb, 0xfa,
-	0x5a, 0x59, 0xdb, 0x1a, 0xaf, 0xbd, 0xd4, 0xef, 0x95, 0x36, 0x38, 0x73, 0x3f, 0x68, 0xef, 0x09,
-	0x96, 0xa2, 0x64, 0x21, 0xc5, 0x2a, 0x58, 0x30, 0xa3, 0x60, 0x1c, 0x7a, 0x05, 0xc6, 0x0f, 0x71,
-	0x57, 0xe6, 0xab, 0xa5, 0x7e, 0xaf, 0x34, 0x77, 0x88, 0xd5, 0x8b, 0xc8, 0xb8, 0x0c, 0xd0, 0x8e,
-	0xac, 0x76, 0x88, 0x65, 0xde, 0xe1, 0x80, 0xc6, 0x09, 0x2a, 0xa0, 0x71, 0xc2, 0xf7, 0xc7, 0xae,
-	0x6b, 0x85, 0x07, 0xb0, 0x98, 0xc6, 0xec, 0xe7, 0x62, 0xa7, 0x03, 0xeb, 0x23, 0xa0, 0xfb, 0x79,
-	0x98, 0xab, 0xfc, 0x35, 0x07, 0xab, 0x7b, 0x81, 0x87, 0xad, 0x0e, 0x71, 0x5a, 0x77, 0xb0, 0xe5,
-	0xf3, 0x8b, 0x86, 0xfd, 0x00, 0x7d, 0x17, 0xa0, 0xd9, 0x0e, 0xfd, 0x00, 0x7b, 0x66, 0x9c, 0xfb,
-	0xf9, 0xb6, 0x4a, 0x6a, 0x22, 0x3b, 0x4f, 0xc7, 0x44, 0x74, 0x09, 0x26, 0x5c, 0x4a, 0xdb, 0xd2,
-	0x3e, 0xea, 0xf7, 0x4a, 0xf3, 0x6c, 0xac, 0x08, 0x73, 0x3e, 0xfa, 0x08, 0xa6, 0x23, 0x50, 0xf1,
-	0xf5, 0x71, 0x7e, 0x16, 0x5f, 0x13, 0x97, 0x26, 0xcb, 0x9d, 0x18, 0x4f, 0x64, 0x1a, 0x5b, 0x92,
-	0x97, 0x7a, 0xa0, 0xc3, 0x18, 0xfc, 0x8b, 0x08, 0xac, 0x46, 0xbe, 0xb7, 0x99, 0x12, 0xdb, 0xf4,
-	0xb0, 0x4b, 0xbd, 0x80, 0x03, 0xf4, 0xcc, 0x8e, 0xce, 0xed, 0xdc, 0x10, 0x12, 0xdc, 0x8a, 0x6d,
-	0x70, 0x7e, 0x6d, 0x53, 0xaa, 0x8d, 0x16, 0xa9, 0x32, 0x8d, 0x2c, 0x22, 0x72, 0x61, 0xb1, 0x43,
-	0x1c, 0xd2, 0x09, 0x3b, 0x26, 0xaf, 0x65, 0xc8, 0x63, 0xac, 0x4f, 0xf2, 0xd5, 0x54, 0x8f, 0x59,
-	0xcd, 0xbb, 0x62, 0xca, 0x6d, 0xda, 0xd8, 0x23, 0x8f, 0xb1, 0x58, 0xd2, 0x9a, 0xb4, 0x3d, 0xdf,
-	0x49, 0x30, 0x8d, 0xd4, 0x18, 0xed, 0xc0, 0x24, 0x4b, 0xfc, 0xbe, 0x9e, 0xe3, 0x66, 0xe6, 0xb8,
-	0x19, 0x76, 0x56, 0xea, 0xce, 0x03, 0x5a, 0x9b, 0x93, 0x5a, 0x84, 0x8c, 0x21, 0xfe, 0xa0, 0x9b,
-	0x30, 0x6f, 0xe0, 0x26, 0x26, 0x47, 0xd8, 0xbe, 0x4d, 0x1b, 0x75, 0xdb, 0xd7, 0xa7, 0x78, 0x16,
-	0xbe, 0xd8, 0xef, 0x95, 0xf4, 0x24, 0x47, 0xd9, 0xa8, 0xd4, 0x9c, 0xc2, 0xaf, 0x35, 0xa6, 0x46,
-	0xdd, 0x87, 0xd3, 0x9d, 0xc9, 0x1f, 0xab, 0x67, 0x92, 0x05, 0x66, 0x00, 0x39, 0x71, 0xb9, 0x5b,
-	0x75, 0x0f, 0x5b, 0x7c, 0x25, 0xd1, 0x2e, 0x56, 0xdf, 0x0f, 0x2d, 0x27, 0x20, 0x41, 0xf7, 0xc4,
-	0x2b, 0xf3, 0xb9, 0x06, 0xcb, 0x19, 0x01, 0x3d, 0x0f, 0xbe, 0x55, 0xfe, 0xb2, 0x04, 0xf9, 0x68,
-	0x6f, 0xd8, 0xd5, 0x60, 0x45, 0xa6, 0xf4, 0x88, 0x5f, 0x0d, 0x36, 0x56, 0xaf, 0x06, 0x1b, 0xa3,
-	0x5d, 0xc8, 0x05, 0x16, 0x61, 0x09, 0x76, 0x4c, 0x96, 0x8d, 0x19, 0x18, 0xbd, 0xcf, 0x24, 0x6a,
-	0xf3, 0x72, 0xbb, 0xe5, 0x04, 0x43, 0xfe, 0x45, 0x6f, 0xc7, 0x25, 0xec, 0xb8, 0x52, 0x79, 0x46,
-	0x9e, 0x7c, 0x8b, 0x3a, 0xf6, 0x31, 0xac, 0x5a, 0xed, 0x36, 0x6d, 0x5a, 0x81, 0xd5, 0x68, 0x63,
-	0x73, 0x70, 0x65, 0x27, 0xb8, 0xde, 0x57, 0x93, 0x7a, 0x77, 0x07, 0xa2, 0xa9, 0x0b, 0x7b, 0x51,
-	0x3a, 0xba, 0x62, 0x65, 0x88, 0x18, 0x99, 0x54, 0xe4, 0xc1, 0xb2, 0x75, 0x64, 0x91, 0x76, 0xca,
-	0xb2, 0xb8, 0x5e, 0xff, 0x97, 0xb2, 0x1c, 0x09, 0xa6, 0xec, 0x16, 0xa4, 0x5d, 0x64, 0x0d, 0x09,
-	0x18, 0x19, 0x34, 0xd4, 0x80, 0x85, 0x80, 0x06, 0x56, 0x5b, 0xb1, 0x97, 0x93, 0x69, 0x38, 0x61,
-	0x6f, 0x9f, 0x09, 0xa5, 0x6c, 0xc5, 0x37, 0x38, 0x48, 0x30, 0x8d, 0xd4, 0x98, 0xaf, 0x4b, 0xac,
-	0x97, 0x23, 0x53, 0x64, 0x67, 0x2a, 0x73, 0x5d, 0x91, 0xe0, 0xc8, 0x75, 0x0d, 0x09, 0x18, 0x19,
-	0x34, 0x74, 0x1f, 0x16, 0xbd, 0xd0, 0x31, 0x89, 0xed, 0x9b, 0x8d, 0xae, 0xe9, 0x07, 0x56, 0x80,
-	0xf5, 0xbc, 0xd2, 0x33, 0xc4, 0x06, 0x8d, 0xd0, 0xa9, 0xdb, 0x7e, 0xad, 0xbb, 0xc7, 0x44, 0x84,
-	0xad, 0x55, 0x69, 0x6b, 0xce, 0x53, 0x79, 0x46, 0x72, 0x88, 0x7e, 0xab, 0x41, 0xd1, 0xa1, 0x8e,
-	0x69, 0x79, 0x1d, 0xcb, 0xb6, 0xcc, 0xac, 0x15, 0x4e, 0x2b, 0xc0, 0x18, 0x1b, 0x7c, 0x8f, 0x3a,
-	0xbb, 0x7c, 0xca, 0xa8, 0xa5, 0xbe, 0x22, 0xcd, 0x6f, 0x3a, 0xa3, 0x25, 0x8d, 0xe3, 0x98, 0x68,
-	0x17, 0xe6, 0x42, 0x47, 0x56, 0x1e, 0x6c, 0xbb, 0x75, 0x28, 0x6b, 0x5b, 0xf9, 0xda, 0x66, 0xbf,
-	0x57, 0x5a, 0x4f, 0x30, 0x94, 0x0b, 0x90, 0x9c, 0x81, 0x3e, 0xd5, 0x60, 0x3d, 0x2e, 0x82, 0x43,
-	0xdf, 0x6a, 0x61, 0x16, 0x47, 0xd1, 0x88, 0xce, 0x64, 0x5d, 0x85, 0xc8, 0xfa, 0x07, 0x4c, 0xb6,
-	0xd6, 0xe5, 0xfd, 0xc3, 0xa0, 0x05, 0x2b, 0x7a, 0x19, 0x6c, 0xc5, 0xfa, 0x4a, 0x16, 0x9f, 0x75,
-	0xd9, 0xbc, 0xe7, 0x0b, 0xba, 0x2e, 0xd6, 0x67, 0x07, 0xfd, 0x32, 0x23, 0xee, 0x77, 0x5d, 0x55,
-	0x41, 0x3e, 0xa2, 0xbd, 0x88, 0xe2, 0xe8, 0xf7, 0x1a, 0x6c, 0x8c, 0xbc, 0xfa, 0xe7, 0x22, 0x47,
-	0xfc, 0x4e, 0x83, 0xf5, 0x11, 0x10, 0x71, 0x6e, 0x72, 0x58, 0x06, 0xa4, 0x9c, 0x0b, 0xdf, 0x7e,
-	0xca, 0x62, 0x97, 0x7d, 0x37, 0x55, 0xff, 0x26, 0x47, 0xfa, 0xf7, 0x56, 0xd2, 0x3f, 0xf1, 0x9c,
-	0x72, 0x83, 0x76, 0xdc, 0x30, 0x88, 0xf7, 0xe2, 0x44, 0x2f, 0x1e, 0x01, 0x1a, 0x86, 0xa6, 0xd3,
-	0xc5, 0xe7, 0xba, 0x6a, 0x7f, 0x5e, 0x56, 0x4c, 0xac, 0x54, 0x60, 0x7a, 0x4e, 0x34, 0xfc, 0x2b,
-	0x0d, 0xca, 0x27, 0x61, 0xd4, 0x0b, 0x8c, 0xc3, 0xcf, 0x35, 0xd8, 0x18, 0x89, 0x2d, 0xa7, 0x8b,
-	0xc7, 0x59, 0xf8, 0x51, 0xf9, 0xcd, 0x84, 0xa8, 0x6c, 0x18, 0xc6, 0x28, 0x15, 0x8b, 0xf6, 0xec,
-	0x15, 0xcb, 0x58, 0xaa, 0x62, 0x61, 0x16, 0xce, 0xa2, 0x62, 0x19, 0x4f, 0xc1, 0x34, 0xd7, 0x7b,
-	0xa6, 0x15, 0xcb, 0xff, 0xb0, 0x96, 0x9d, 0x8c, 0x3f, 0x4d, 0xc0, 0xa6, 0x6c, 0xae, 0xf6, 0xe2,
-	0x77, 0x1c, 0x96, 0x13, 0x65, 0xcb, 0xf4, 0xac, 0x9d, 0xe5, 0xd4, 0x09, 0x9d, 0xe5, 0x1e, 0xcc,
-	0x88, 0x76, 0xcf, 0x0c, 0x48, 0x27, 0x5a, 0xe4, 0x71, 0x2f, 0x44, 0x51, 0xdd, 0x06, 0x62, 0x1a,
-	0x63, 0xf0, 0x47, 0x22, 0x65, 0x8c, 0x6e, 0x01, 0xc4, 0xa9, 0x37, 0x2a, 0x41, 0xe7, 0x12, 0x47,
-	0x49, 0x3e, 0x2d, 0xcb, 0x91, 0x9f, 0x78, 0x5a, 0x8e, 0x88, 0xe8, 0x28, 0xa3, 0x5d, 0x14, 0xf5,
-	0xe5, 0x35, 0xb5, 0x29, 0xcd, 0x8a, 0xdb, 0xb3, 0x34, 0x8d, 0xe7, 0xba, 0x47, 0xfa, 0xe7, 0x04,
-	0x2c, 0x71, 0x08, 0x4b, 0x34, 0xd6, 0xa7, 0x6d, 0x96, 0x28, 0x2c, 0xc6, 0x57, 0x5c, 0x76, 0xfb,
-	0x12, 0x41, 0xbe, 0xc3, 0xfd, 0x19, 0xd2, 0x3c, 0x78, 0x4a, 0x10, 0x54, 0x11, 0xc8, 0x75, 0x19,
-	0xc8, 0x05, 0x2f, 0xc9, 0x35, 0xd2, 0x04, 0xf4, 0xb9, 0x06, 0x17, 0xd3, 0x16, 0x59, 0x2d, 0x18,
-	0xbf, 0x02, 0x0b, 0x9c, 0x79, 0xf3, 0x74, 0xd6, 0x6b, 0xdd, 0x7b, 0x72, 0x9e, 0xf0, 0xe3, 0x65,
-	0xe9, 0xc7, 0x86, 0x37, 0x4a, 0xce, 0x18, 0xcd, 0x2a, 0x7c, 0xa1, 0xc1, 0x4a, 0xd6, 0xf2, 0xce,
-	0x45, 0x1d, 0xf1, 0x4b, 0x0d, 0x8a, 0xc7, 0xaf, 0xfe, 0xc5, 0xa5, 0xd1, 0xca, 0x3f, 0x34, 0x58,
-	0xce, 0x78, 0x01, 0xfa, 0xaf, 0xc1, 0xe9, 0xb9, 0x80, 0xce, 0x4d, 0xc8, 0xf1, 0x0e, 0x23, 0xca,
-	0x5d, 0x6b, 0xd9, 0x67, 0x4a, 0x24, 0x44, 0x21, 0xa9, 0x26, 0x44, 0x41, 0xa9, 0xfc, 0x5b, 0x83,
-	0x85, 0x54, 0x78, 0xd0, 0xbe, 0xfa, 0xfa, 0x26, 0x72, 0xf6, 0x2b, 0x59, 0x71, 0xfc, 0x56, 0xef,
-	0x6e, 0xe7, 0xf4, 0x81, 0xa8, 0xf2, 0x67, 0x0d, 0x66, 0xe3, 0xc7, 0x54, 0xe2, 0xb4, 0xd0, 0x3b,
-	0xa9, 0xd7, 0x91, 0x97, 0x62, 0x20, 0x8f, 0x44, 0x4e, 0x5f, 0x6f, 0xbc, 0x80, 0x9c, 0x5f, 0xf9,
-	0x1e, 0xe4, 0x6f, 0xd3, 0x06, 0xdf, 0x72, 0x74, 0x05, 0xc6, 0x0f, 0x68, 0x43, 0xee, 0x59, 0x3e,
-	0x2a, 0x65, 0x85, 0xa5, 0x03, 0xda, 0x50, 0x2d, 0x1d, 0xd0, 0x46, 0xe5, 0x0f, 0x1a, 0x2c, 0xc5,
-	0x6f, 0x90, 0xc3, 0x4a, 0xb4, 0xd3, 0x28, 0x41, 0xdb, 0x30, 0xe5, 0xf0, 0xc4, 0xe1, 0x73, 0x87,
-	0xe7, 0xc4, 0x07, 0x11, 0x49, 0x52, 0x3f, 0x88, 0x48, 0x12, 0xda, 0x81, 0xbc, 0x13, 0x76, 0x76,
-	0x9b, 0x87, 0xd8, 0xe6, 0x9f, 0x69, 0xe7, 0x64, 0x9f, 0x2a, 0x69, 0x89, 0x3e, 0x55, 0xd2, 0x2a,
-	0x57, 0x20, 0x57, 0xb7, 0xef, 0x10, 0x3f, 0x60, 0x21, 0x24, 0xb6, 0x38, 0x96, 0x32, 0x84, 0x24,
-	0xf1, 0x2e, 0xc9, 0xb8, 0x15, 0x17, 0x96, 0x0c, 0xec, 0xe0, 0x47, 0x67, 0xf2, 0x68, 0x2d, 0x2d,
-	0x8e, 0x1d, 0x6b, 0xf1, 0x17, 0x93, 0x80, 0x0c, 0x1c, 0x84, 0x9e, 0x73, 0x26, 0x36, 0xff, 0x1f,
-	0x72, 0xac, 0x04, 0x88, 0xbf, 0x7c, 0xf3, 0x43, 0x70, 0x40, 0x1b, 0x09, 0xf9, 0x49, 0x4e, 0x40,
-	0xf7, 0x61, 0xc9, 0x3a, 0xa2, 0x24, 0xf9, 0xc9, 0x57, 0x3c, 0x66, 0xaf, 0xf2, 0xdd, 0xbb, 0xeb,
-	0xd9, 0xd8, 0xc3, 0xf6, 0x5e, 0xe0, 0x11, 0xa7, 0xf5, 0xae, 0xe5, 0x8a, 0x4f, 0x28, 0x7c, 0x4e,
-	0xd6, 0x47, 0x5e, 0x63, 0x21, 0xc5, 0x42, 0x97, 0x21, 0xe7, 0x61, 0xcb, 0xa7, 0x0e, 0xff, 0x20,
-	0x39, 0x2d, 0xce, 0xbc, 0xa0, 0xa8, 0x67, 0x5e, 0x50, 0xd0, 0x5b, 0x30, 0x77, 0x18, 0x36, 0xb0,
-	0xe7, 0xe0, 0x00, 0xfb, 0x6c, 0x09, 0x39, 0x3e, 0xa9, 0xd0, 0xef, 0x95, 0xd6, 0x06, 0x8c, 0xc4,
-	0x4a, 0x66, 0x55, 0x3a, 0xaa, 0xc3, 0x12, 0x5b, 0xbc, 0x17, 0x3a, 0xa6, 0x15, 0x70, 0x09, 0x6c,
-	0xf3, 0xc2, 0x2e, 0x2f, 0x3c, 0x3f, 0xa0, 0x0d, 0x23, 0x74, 0x76, 0x23, 0x96, 0xea, 0x79, 0x8a,
-	0x85, 0x3e, 0xd5, 0x60, 0x39, 0xf0, 0x2c, 0x76, 0x86, 0x4c, 0xf5, 0x93, 0xbb, 0x78, 0xdd, 0xda,
-	0xe6, 0xe1, 0x19, 0xde, 0xb6, 0xea, 0xbe, 0x98, 0x32, 0xf4, 0x21, 0xbe, 0xdc, 0xef, 0x95, 0x2e,
-	0x06, 0x43, 0x4c, 0xc5, 0x03, 0x34, 0xcc, 0x2d, 0x74, 0x60, 0x7d, 0x84, 0xc2, 0xe7, 0x02, 0x08,
-	0x36, 0x20, 0xb1, 0xd5, 0xef, 0xe0, 0xee, 0x87, 0x8c, 0x7a, 0xcf, 0x22, 0xde, 0x59, 0x5b, 0xaa,
-	0x7c, 0x0c, 0x8b, 0xe9, 0x73, 0x85, 0x7e, 0x04, 0x53, 0xd8, 0x09, 0x3c, 0x12, 0xa7, 0x8d, 0xf5,
-	0xe8, 0x33, 0x47, 0xca, 0x1b, 0x81, 0x11, 0x52, 0x56, 0xc5, 0x08, 0x49, 0xda, 0xf9, 0x97, 0x06,
-	0x0b, 0xbb, 0xad, 0x96, 0x87, 0x5b, 0xac, 0x9f, 0x16, 0x0f, 0x5c, 0x77, 0xf8, 0xba, 0x94, 0x0f,
-	0x26, 0x1c, 0x4d, 0x0a, 0xa3, 0xbf, 0xa4, 0x14, 0xd6, 0x92, 0xbc, 0x08, 0xe1, 0xb6, 0xb4, 0xd7,
-	0x35, 0x74, 0x15, 0x60, 0x00, 0x11, 0x68, 0x4d, 0x9e, 0x84, 0x14, 0x66, 0x14, 0x66, 0xc4, 0x07,
-	0x5f, 0x01, 0x3d, 0x3f, 0x80, 0x19, 0xe5, 0xac, 0xa0, 0xf5, 0x11, 0xa7, 0xa7, 0xb0, 0x36, 0x94,
-	0xd9, 0x6f, 0xb1, 0xd5, 0xa1, 0x4b, 0xcc, 0x24, 0xcb, 0xc9, 0x37, 0xa9, 0x83, 0x91, 0xaa, 0x3a,
-	0x61, 0xa7, 0x76, 0xff, 0xeb, 0xbf, 0x17, 0x2f, 0xfc, 0xe4, 0x49, 0x51, 0xfb, 0xf2, 0x49, 0x51,
-	0xfb, 0xea, 0x49, 0x51, 0xfb, 0xdb, 0x93, 0xa2, 0xf6, 0xd9, 0xd3, 0xe2, 0x85, 0xaf, 0x9e, 0x16,
-	0x2f, 0x7c, 0xfd, 0xb4, 0x78, 0xe1, 0xa3, 0x57, 0x95, 0x5f, 0xf7, 0x88, 0x27, 0x55, 0xd7, 0xa3,
-	0x07, 0xb8, 0x19, 0xc8, 0x51, 0xf4, 0xfb, 0xa0, 0x3f, 0x8e, 0xad, 0x88, 0xa7, 0x89, 0x7b, 0x82,
-	0x5d, 0xad, 0xd3, 0xea, 0xae, 0x4b, 0x1a, 0x39, 0xee, 0xd9, 0x1b, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0xdc, 0x5e, 0xd7, 0xc8, 0xe5, 0x24, 0x00, 0x00,
+	// 2504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x8a, 0xfa, 0x20, 0x9f, 0xbe, 0x47, 0x5f, 0x2b, 0xca, 0x21, 0x19, 0x06, 0x75, 0x94,
+	0x36, 0xa6, 0x62, 0xc5, 0x29, 0xdc, 0x1e, 0x1a, 0x88, 0xb6, 0x9b, 0xca, 0x71, 0x62, 0x67, 0xa5,
+	0x18, 0x68, 0x10, 0x60, 0xbd, 0xe4, 0x8e, 0xe9, 0x91, 0xc8, 0x9d, 0xcd, 0xee, 0x52, 0x06, 0x7d,
+	0x2a, 0xfa, 0x01, 0x14, 0x45, 0x0f, 0x39, 0x14, 0x68, 0x13, 0xa0, 0xed, 0xb1, 0x40, 0x81, 0xfe,
+	0x0b, 0x3d, 0xe7, 0x98, 0x63, 0x2e, 0x65, 0x5a, 0xfb, 0x52, 0xf0, 0xd8, 0x63, 0x0f, 0x45, 0x31,
+	0x1f, 0xbb, 0x3b, 0xbb, 0x5c, 0x4a, 0x4a, 0x2d, 0x1b, 0x3a, 0xf4, 0x24, 0xed, 0x7b, 0x6f, 0xde,
+	0x7b, 0xf3, 0x66, 0xe6, 0xf7, 0xde, 0xbc, 0x21, 0x2c, 0xb9, 0x87, 0xad, 0x2d, 0xcb, 0x25, 0x5b,
+	0x9f, 0x74, 0x71, 0x17, 0xd7, 0x5c, 0x8f, 0x06, 0x14, 0xe5, 0x2c, 0x97, 0x14, 0xcb, 0x2d, 0x4a,
+	0x5b, 0x6d, 0xbc, 0xc5, 0x49, 0x8d, 0xee, 0x83, 0xad, 0x80, 0x74, 0xb0, 0x1f, 0x58, 0x1d, 0x57,
+	0x48, 0x15, 0xab, 0x87, 0xd7, 0xfc, 0x1a, 0xa1, 0x7c, 0x74, 0x93, 0x7a, 0x78, 0xeb, 0xe8, 0xca,
+	0x56, 0x0b, 0x3b, 0xd8, 0xb3, 0x02, 0x6c, 0x4b, 0x99, 0x4d, 0x45, 0xc6, 0xc1, 0xc1, 0x23, 0xea,
+	0x1d, 0x12, 0xa7, 0x95, 0x25, 0x79, 0x35, 0x96, 0xec, 0x58, 0xcd, 0x87, 0xc4, 0xc1, 0x5e, 0x6f,
+	0x2b, 0x74, 0xce, 0xc3, 0x3e, 0xed, 0x7a, 0x4d, 0x3c, 0x34, 0xea, 0x72, 0x8b, 0x04, 0x0f, 0xbb,
+	0x8d, 0x5a, 0x93, 0x76, 0xb6, 0x5a, 0xb4, 0x45, 0x63, 0x6f, 0xd9, 0x17, 0xff, 0xe0, 0xff, 0x49,
+	0xf1, 0x8d, 0xf4, 0x9c, 0x70, 0xc7, 0x0d, 0x7a, 0x92, 0xb9, 0x1c, 0x5a, 0xf3, 0xbb, 0x8d, 0x0e,
+	0x09, 0x04, 0xb5, 0xfa, 0xf5, 0x3c, 0xe4, 0x6e, 0xd1, 0x06, 0xaa, 0xc0, 0x18, 0xb1, 0x75, 0xad,
+	0xa2, 0x6d, 0x16, 0xea, 0x0b, 0x83, 0x7e, 0x79, 0x86, 0xd8, 0xaf, 0xd3, 0x0e, 0x09, 0xb8, 0x06,
+	0x63, 0x8c, 0xd8, 0xe8, 0x4d, 0x28, 0x34, 0xdb, 0x04, 0x3b, 0x81, 0x49, 0x6c, 0x7d, 0x96, 0x0b,
+	0xae, 0x0e, 0xfa, 0x65, 0x24, 0x88, 0xbb, 0xaa, 0x78, 0x3e, 0xa4, 0xa1, 0xab, 0x00, 0x07, 0xb4,
+	0x61, 0xfa, 0x98, 0x8f, 0x1a, 0x8b, 0x47, 0x1d, 0xd0, 0xc6, 0x1e, 0x4e, 0x8d, 0x0a, 0x69, 0xe8,
+	0x35, 0x98, 0xe0, 0xeb, 0xa5, 0xe7, 0xf8, 0x80, 0xa5, 0x41, 0xbf, 0x3c, 0xcf, 0x09, 0x8a, 0xb4,
+	0x90, 0x40, 0x6f, 0x41, 0xc1, 0xb1, 0x3a, 0xd8, 0x77, 0xad, 0x26, 0xd6, 0xa7, 0xb8, 0xf8, 0xda,
+	0xa0, 0x5f, 0x5e, 0x8a, 0x88, 0xca, 0x90, 0x58, 0x12, 0xd5, 0x61, 0xb2, 0x6d, 0x35, 0x70, 0xdb,
+	0xd7, 0x0b, 0x95, 0xdc, 0xe6, 0xf4, 0xf6, 0x72, 0xcd, 0x72, 0x49, 0xed, 0x16, 0x6d, 0xd4, 0x6e,
+	0x73, 0xf2, 0x4d, 0x27, 0xf0, 0x7a, 0xf5, 0xe5, 0x41, 0xbf, 0xbc, 0x20, 0xe4, 0x14, 0x35, 0x72,
+	0x24, 0xba, 0x07, 0xd3, 0x96, 0xe3, 0xd0, 0xc0, 0x0a, 0x08, 0x75, 0x7c, 0x1d, 0xb8, 0xa2, 0xf5,
+	0x48, 0xd1, 0x4e, 0xcc, 0x13, 0xda, 0xd6, 0x07, 0xfd, 0xf2, 0x8a, 0x32, 0x42, 0x51, 0xa9, 0x2a,
+	0x42, 0x47, 0xb0, 0xec, 0xe1, 0x4f, 0xba, 0xc4, 0xc3, 0xb6, 0xe9, 0x50, 0x1b, 0x9b, 0xd2, 0xd3,
+	0x69, 0x6e, 0xa0, 0x12, 0x19, 0x30, 0xa4, 0xd0, 0xfb, 0xd4, 0xc6, 0xaa, 0xd7, 0xd5, 0x41, 0xbf,
+	0x7c, 0xd1, 0x1b, 0x62, 0xc6, 0xe6, 0x74, 0xcd, 0x40, 0xc3, 0x7c, 0x16, 0x75, 0xfa, 0xc8, 0xc1,
+	0x9e, 0x9e, 0x8f, 0xa3, 0xce, 0x09, 0x6a, 0xd4, 0x39, 0x01, 0x61, 0xd8, 0xe0, 0xe1, 0x37, 0xf9,
+	0xa7, 0xff, 0x90, 0xb8, 0x66, 0xd7, 0xc7, 0x9e, 0xd9, 0xf2, 0x68, 0xd7, 0xf5, 0xf5, 0xf9, 0x4a,
+	0x6e, 0xb3, 0x50, 0xbf, 0x34, 0xe8, 0x97, 0xab, 0x5c, 0xec, 0x4e, 0x28, 0xf5, 0xa1, 0x8f, 0xbd,
+	0x77, 0xb8, 0x8c, 0xa2, 0x53, 0x1f, 0x25, 0x83, 0x7e, 0xae, 0xc1, 0xa5, 0x26, 0xed, 0xb8, 0x1e,
+	0xf6, 0x7d, 0x6c, 0x9b, 0xc7, 0x99, 0x5c, 0xaa, 0x68, 0x9b, 0x33, 0xf5, 0x37, 0x06, 0xfd, 0xf2,
+	0xeb, 0xf1, 0x88, 0x0f, 0x4e, 0x36, 0x5e, 0x3d, 0x59, 0x1a, 0x6d, 0x43, 0xde, 0xf5, 0x08, 0xf5,
+	0x48, 0xd0, 0xd3, 0xc7, 0x2b, 0xda, 0xa6, 0x26, 0xb6, 0x70, 0x48, 0x53, 0xb7, 0x70, 0x48, 0x43,
+	0x77, 0x20, 0xef, 0x52, 0xdb, 0xf4, 0x5d, 0xdc, 0xd4, 0x27, 0x2a, 0xda, 0xe6, 0xf4, 0xf6, 0x46,
+	0x4d, 0x40, 0x00, 0x5f, 0x3f, 0x06, 0x28, 0xb5, 0xa3, 0x2b, 0xb5, 0xbb, 0xd4, 0xde, 0x73, 0x71,
+	0x93, 0xef, 0xd9, 0x45, 0x57, 0x7c, 0x24, 0x16, 0x6a, 0x4a, 0x12, 0xd1, 0x5d, 0x28, 0x84, 0x0a,
+	0x7d, 0x7d, 0xa6, 0x92, 0x3b, 0x49, 0xa3, 0x70, 0x51, 0x7c, 0xf8, 0x09, 0x17, 0x25, 0x0d, 0x7d,
+	0xae, 0x41, 0xc5, 0x6f, 0x3e, 0xc4, 0x76, 0xb7, 0x4d, 0x9c, 0x96, 0x19, 0x82, 0x90, 0x29, 0xb7,
+	0x46, 0x07, 0x3b, 0x81, 0xaf, 0xaf, 0x70, 0xdf, 0x37, 0xb3, 0x2c, 0x19, 0x72, 0x80, 0xa1, 0xc8,
+	0xd7, 0x2f, 0x7d, 0xd1, 0x2f, 0x5f, 0x18, 0xf4, 0xcb, 0xa5, 0x58, 0x73, 0x96, 0x9c, 0x71, 0x02,
+	0x1f, 0xed, 0xc2, 0x54, 0xd3, 0xc3, 0x0c, 0x0a, 0xf5, 0x49, 0xee, 0x42, 0xb1, 0x26, 0xc0, 0xad,
+	0x16, 0x82, 0x5b, 0x6d, 0x3f, 0x04, 0xec, 0xfa, 0x92, 0x34, 0x1a, 0x0e, 0xf9, 0xf4, 0xeb, 0xb2,
+	0x66, 0x84, 0x1f, 0xe8, 0x3a, 0x4c, 0x11, 0xa7, 0xc5, 0xd6, 0x58, 0x9f, 0xe3, 0x71, 0x43, 0x7c,
+	0x1a, 0xbb, 0x82, 0x76, 0x9d, 0x3a, 0x0f, 0x48, 0xab, 0xbe, 0xc2, 0x16, 0x40, 0x8a, 0x29, 0xd1,
+	0x0a, 0x47, 0xa2, 0x1f, 0x42, 0xde, 0xc7, 0xde, 0x11, 0x69, 0x62, 0x5f, 0x5f, 0x50, 0xb4, 0xec,
+	0x09, 0xa2, 0xd4, 0xc2, 0x83, 0x1e, 0xca, 0xa9, 0x41, 0x0f, 0x69, 0xe8, 0x63, 0x98, 0x3e, 0xbc,
+	0xe6, 0x9b, 0xa1, 0x43, 0x8b, 0x5c, 0xd5, 0xcb, 0x6a, 0x78, 0xe3, 0x3c, 0xc2, 0x82, 0x2c, 0xbd,
+	0xac, 0xeb, 0x83, 0x7e, 0x79, 0xf9, 0xf0, 0x9a, 0xbf, 0x3b, 0xe4, 0x22, 0xc4, 0x54, 0x74, 0x4f,
+	0x68, 0x97, 0xd6, 0x74, 0x34, 0x7a, 0x9b, 0x48, 0xbf, 0x23, 0xbd, 0xf2, 0x3b, 0xa5, 0x57, 0x52,
+	0x19, 0xca, 0xca, 0xf5, 0xc2, 0x9e, 0xbe, 0x1c, 0xa3, 0x6c, 0x44, 0x54, 0x51, 0x36, 0x22, 0xa2,
+	0x5d, 0x58, 0x14, 0x67, 0x36, 0x08, 0xda, 0xa6, 0x8f, 0x9b, 0xd4, 0xb1, 0x7d, 0x7d, 0xb5, 0xa2,
+	0x6d, 0xe6, 0xea, 0x2f, 0x0d, 0xfa, 0xe5, 0x75, 0xce, 0xdc, 0x0f, 0xda, 0x7b, 0x82, 0xa5, 0x28,
+	0x99, 0x4f, 0xb1, 0x8a, 0x16, 0x4c, 0x2b, 0x18, 0x87, 0x5e, 0x81, 0xdc, 0x21, 0xee, 0xc9, 0x7c,
+	0xb5, 0x38, 0xe8, 0x97, 0x67, 0x0f, 0xb1, 0x7a, 0x10, 0x19, 0x97, 0x01, 0xda, 0x91, 0xd5, 0xee,
+	0x62, 0x7d, 0x2c, 0x06, 0x34, 0x4e, 0x50, 0x01, 0x8d, 0x13, 0xbe, 0x3f, 0x76, 0x4d, 0x2b, 0x3e,
+	0x80, 0x85, 0x34, 0x66, 0x3f, 0x17, 0x3b, 0x1d, 0x58, 0x1b, 0x01, 0xdd, 0xcf, 0xc3, 0x5c, 0xf5,
+	0x6f, 0x93, 0xb0, 0xb2, 0x17, 0x78, 0xd8, 0xea, 0x10, 0xa7, 0x75, 0x1b, 0x5b, 0x3e, 0x3f, 0x68,
+	0xd8, 0x0f, 0xd0, 0x77, 0x01, 0x9a, 0xed, 0xae, 0x1f, 0x60, 0xcf, 0x8c, 0x72, 0x3f, 0x5f, 0x56,
+	0x49, 0x4d, 0x64, 0xe7, 0x42, 0x44, 0x44, 0x97, 0x60, 0xdc, 0xa5, 0xb4, 0x2d, 0xed, 0xa3, 0x41,
+	0xbf, 0x3c, 0xc7, 0xbe, 0x15, 0x61, 0xce, 0x47, 0x1f, 0x41, 0x21, 0x04, 0x15, 0x5f, 0xcf, 0xf1,
+	0xbd, 0xf8, 0x9a, 0x38, 0x34, 0x59, 0xee, 0x44, 0x78, 0x22, 0xd3, 0xd8, 0xa2, 0x3c, 0xd4, 0xb1,
+	0x0e, 0x23, 0xfe, 0x17, 0x11, 0x58, 0x09, 0x7d, 0x6f, 0x33, 0x25, 0xb6, 0xe9, 0x61, 0x97, 0x7a,
+	0x01, 0x07, 0xe8, 0xe9, 0x6d, 0x9d, 0xdb, 0xb9, 0x2e, 0x24, 0xb8, 0x15, 0xdb, 0xe0, 0xfc, 0xfa,
+	0x86, 0x54, 0xbb, 0xd4, 0x1c, 0x66, 0x1a, 0x59, 0x44, 0xe4, 0xc2, 0x42, 0x87, 0x38, 0xa4, 0xd3,
+	0xed, 0x98, 0xbc, 0x96, 0x21, 0x8f, 0xb1, 0x3e, 0xc1, 0x67, 0x53, 0x3b, 0x66, 0x36, 0xef, 0x89,
+	0x21, 0xb7, 0x68, 0x63, 0x8f, 0x3c, 0xc6, 0x62, 0x4a, 0xab, 0xd2, 0xf6, 0x5c, 0x27, 0xc1, 0x34,
+	0x52, 0xdf, 0x68, 0x1b, 0x26, 0x58, 0xe2, 0xf7, 0xf5, 0x49, 0x6e, 0x66, 0x96, 0x9b, 0x61, 0x7b,
+	0x65, 0xd7, 0x79, 0x40, 0xeb, 0xb3, 0x52, 0x8b, 0x90, 0x31, 0xc4, 0x1f, 0x74, 0x03, 0xe6, 0x0c,
+	0xdc, 0xc4, 0xe4, 0x08, 0xdb, 0xb7, 0x68, 0x63, 0xd7, 0xf6, 0xf5, 0x29, 0x9e, 0x85, 0x2f, 0x0e,
+	0xfa, 0x65, 0x3d, 0xc9, 0x51, 0x16, 0x2a, 0x35, 0xa6, 0xf8, 0x1b, 0x8d, 0xa9, 0x51, 0xd7, 0xe1,
+	0x74, 0x7b, 0xf2, 0xc7, 0xea, 0x9e, 0x64, 0x81, 0x89, 0x21, 0x27, 0x2a, 0x77, 0x6b, 0xee, 0x61,
+	0x8b, 0xcf, 0x24, 0x5c, 0xc5, 0xda, 0x07, 0x5d, 0xcb, 0x09, 0x48, 0xd0, 0x3b, 0xf1, 0xc8, 0x7c,
+	0xa6, 0xc1, 0x52, 0x46, 0x40, 0xcf, 0x83, 0x6f, 0xd5, 0x3f, 0x20, 0xc8, 0x87, 0x6b, 0xc3, 0x8e,
+	0x06, 0x2b, 0x32, 0x75, 0x2d, 0x3e, 0x1a, 0xec, 0x5b, 0x3d, 0x1a, 0xec, 0x1b, 0xed, 0xc0, 0x64,
+	0x60, 0x11, 0x96, 0x60, 0xc7, 0x64, 0xd9, 0x98, 0x81, 0xd1, 0xfb, 0x4c, 0xa2, 0x3e, 0x27, 0x97,
+	0x5b, 0x0e, 0x30, 0xe4, 0x5f, 0xf4, 0x4e, 0x54, 0xc2, 0xe6, 0x94, 0xca, 0x33, 0xf4, 0xe4, 0x1b,
+	0xd4, 0xb1, 0x8f, 0x61, 0xc5, 0x6a, 0xb7, 0x69, 0xd3, 0x0a, 0xac, 0x46, 0x1b, 0x9b, 0xf1, 0x91,
+	0x1d, 0xe7, 0x7a, 0x5f, 0x4d, 0xea, 0xdd, 0x89, 0x45, 0x53, 0x07, 0xf6, 0xa2, 0x74, 0x74, 0xd9,
+	0xca, 0x10, 0x31, 0x32, 0xa9, 0xc8, 0x83, 0x25, 0xeb, 0xc8, 0x22, 0xed, 0x94, 0x65, 0x71, 0xbc,
+	0xbe, 0x95, 0xb2, 0x1c, 0x0a, 0xa6, 0xec, 0x16, 0xa5, 0x5d, 0x64, 0x0d, 0x09, 0x18, 0x19, 0x34,
+	0xd4, 0x80, 0xf9, 0x80, 0x06, 0x56, 0x5b, 0xb1, 0x37, 0x29, 0xd3, 0x70, 0xc2, 0xde, 0x3e, 0x13,
+	0x4a, 0xd9, 0x8a, 0x4e, 0x70, 0x90, 0x60, 0x1a, 0xa9, 0x6f, 0x3e, 0x2f, 0x31, 0x5f, 0x8e, 0x4c,
+	0xa1, 0x9d, 0xa9, 0xcc, 0x79, 0x85, 0x82, 0x23, 0xe7, 0x35, 0x24, 0x60, 0x64, 0xd0, 0xd0, 0x7d,
+	0x58, 0xf0, 0xba, 0x8e, 0x49, 0x6c, 0xdf, 0x6c, 0xf4, 0x4c, 0x3f, 0xb0, 0x02, 0xac, 0xe7, 0x95,
+	0x3b, 0x43, 0x64, 0xd0, 0xe8, 0x3a, 0xbb, 0xb6, 0x5f, 0xef, 0xed, 0x31, 0x11, 0x61, 0x6b, 0x45,
+	0xda, 0x9a, 0xf5, 0x54, 0x9e, 0x91, 0xfc, 0x44, 0xbf, 0xd3, 0xa0, 0xe4, 0x50, 0xc7, 0xb4, 0xbc,
+	0x8e, 0x65, 0x5b, 0x66, 0xd6, 0x0c, 0x0b, 0x0a, 0x30, 0x46, 0x06, 0xdf, 0xa7, 0xce, 0x0e, 0x1f,
+	0x32, 0x6a, 0xaa, 0xaf, 0x48, 0xf3, 0x1b, 0xce, 0x68, 0x49, 0xe3, 0x38, 0x26, 0xda, 0x81, 0xd9,
+	0xae, 0x23, 0x2b, 0x0f, 0xb6, 0xdc, 0x3a, 0x54, 0xb4, 0xcd, 0x7c, 0x7d, 0x63, 0xd0, 0x2f, 0xaf,
+	0x25, 0x18, 0xca, 0x01, 0x48, 0x8e, 0x40, 0x3f, 0xd5, 0x60, 0x2d, 0x2a, 0x82, 0xbb, 0xbe, 0xd5,
+	0xc2, 0x2c, 0x8e, 0xe2, 0x22, 0x3a, 0x9d, 0x75, 0x14, 0x42, 0xeb, 0x1f, 0x32, 0xd9, 0x7a, 0x8f,
+	0xdf, 0x1f, 0xe2, 0x2b, 0x58, 0xc9, 0xcb, 0x60, 0x2b, 0xd6, 0x97, 0xb3, 0xf8, 0xec, 0x96, 0xcd,
+	0xef, 0x7c, 0x41, 0xcf, 0xc5, 0xfa, 0x4c, 0x7c, 0x5f, 0x66, 0xc4, 0xfd, 0x9e, 0xab, 0x2a, 0xc8,
+	0x87, 0x34, 0x74, 0x13, 0xe6, 0xfd, 0x80, 0x7a, 0xcc, 0xe3, 0x66, 0xdb, 0xf2, 0x7d, 0xec, 0xeb,
+	0xb3, 0x31, 0xf8, 0x4b, 0xd6, 0x75, 0xc1, 0x51, 0xc1, 0x3f, 0xc9, 0x79, 0x11, 0x35, 0xd6, 0x1f,
+	0x35, 0x58, 0x1f, 0x89, 0x20, 0xe7, 0x22, 0xd5, 0xfc, 0x5e, 0x83, 0xb5, 0x11, 0x48, 0x73, 0x6e,
+	0x52, 0x61, 0x06, 0x32, 0x9d, 0x0b, 0xdf, 0x7e, 0xc6, 0x62, 0x97, 0x7d, 0xc4, 0x55, 0xff, 0x26,
+	0x46, 0xfa, 0xf7, 0x76, 0xd2, 0x3f, 0xd1, 0x95, 0xb9, 0x4e, 0x3b, 0x6e, 0x37, 0x88, 0xd6, 0xe2,
+	0x44, 0x2f, 0x1e, 0x01, 0x1a, 0x46, 0xb8, 0xd3, 0xc5, 0xe7, 0x9a, 0x6a, 0x7f, 0x4e, 0x16, 0x5e,
+	0xac, 0xe2, 0x60, 0x7a, 0x4e, 0x34, 0xfc, 0x6b, 0x0d, 0x2a, 0x27, 0x41, 0xdd, 0x0b, 0x8c, 0xc3,
+	0x2f, 0x34, 0x58, 0x1f, 0x09, 0x51, 0xa7, 0x8b, 0xc7, 0x59, 0xf8, 0x51, 0xfd, 0xed, 0xb8, 0x28,
+	0x90, 0x38, 0x54, 0xc5, 0x85, 0x8f, 0xf6, 0xec, 0x85, 0xcf, 0x58, 0xaa, 0xf0, 0x61, 0x16, 0xce,
+	0xa2, 0xf0, 0xc9, 0xa5, 0xd0, 0x9e, 0xeb, 0x3d, 0xd3, 0xc2, 0xe7, 0xff, 0x58, 0xcb, 0x76, 0xc6,
+	0x5f, 0xc6, 0x61, 0x43, 0xde, 0xd1, 0xf6, 0xa2, 0x76, 0x10, 0x4b, 0xad, 0xf2, 0xe6, 0xf5, 0xac,
+	0x17, 0xd4, 0xa9, 0x13, 0x2e, 0xa8, 0x7b, 0x30, 0x2d, 0x6e, 0x8d, 0x66, 0x40, 0x3a, 0xe1, 0x24,
+	0x8f, 0x6b, 0x34, 0x85, 0xe5, 0x1f, 0x88, 0x61, 0x8c, 0xc1, 0x7b, 0x4d, 0xca, 0x37, 0xba, 0x09,
+	0x10, 0x65, 0xf0, 0xb0, 0x92, 0x9d, 0x4d, 0x6c, 0x25, 0x31, 0x87, 0x30, 0x7b, 0xab, 0x3b, 0xb3,
+	0x10, 0x11, 0xd1, 0x51, 0xc6, 0xad, 0x53, 0x94, 0xa9, 0x57, 0xd5, 0xbb, 0x6d, 0x56, 0xdc, 0x9e,
+	0xe5, 0xee, 0x79, 0xae, 0xaf, 0x5a, 0xff, 0x1a, 0x87, 0x45, 0x0e, 0x61, 0x89, 0xfb, 0xf9, 0x69,
+	0xef, 0x5c, 0x14, 0x16, 0xa2, 0x23, 0x2e, 0x9b, 0x06, 0x12, 0x41, 0xbe, 0xc3, 0xfd, 0x19, 0xd2,
+	0x1c, 0x77, 0x24, 0x04, 0x55, 0x04, 0x72, 0x4d, 0x06, 0x72, 0xde, 0x4b, 0x72, 0x8d, 0x34, 0x01,
+	0x7d, 0xa6, 0xc1, 0xc5, 0xb4, 0x45, 0x56, 0x52, 0x46, 0xcd, 0x64, 0x81, 0x33, 0x6f, 0x9d, 0xce,
+	0x7a, 0xbd, 0x77, 0x57, 0x8e, 0x13, 0x7e, 0xbc, 0x2c, 0xfd, 0x58, 0xf7, 0x46, 0xc9, 0x19, 0xa3,
+	0x59, 0xc5, 0xcf, 0x35, 0x58, 0xce, 0x9a, 0xde, 0xb9, 0xa8, 0x23, 0x7e, 0xa5, 0x41, 0xe9, 0xf8,
+	0xd9, 0xbf, 0xb8, 0x34, 0x5a, 0xfd, 0xa7, 0x06, 0x4b, 0x19, 0x8d, 0xa4, 0xff, 0x19, 0x9c, 0x9e,
+	0x0b, 0xe8, 0xdc, 0x80, 0x49, 0x7e, 0x51, 0x09, 0x73, 0xd7, 0x6a, 0xf6, 0x9e, 0x12, 0x09, 0x51,
+	0x48, 0xaa, 0x09, 0x51, 0x50, 0xaa, 0xff, 0xd1, 0x60, 0x3e, 0x15, 0x1e, 0xb4, 0xaf, 0x36, 0xf1,
+	0x44, 0xce, 0x7e, 0x25, 0x2b, 0x8e, 0xdf, 0xa8, 0x7d, 0x77, 0x4e, 0xfb, 0x4c, 0xd5, 0xbf, 0x6a,
+	0x30, 0x13, 0xf5, 0x64, 0x89, 0xd3, 0x42, 0xef, 0xa6, 0x9a, 0x2c, 0x2f, 0x45, 0x40, 0x1e, 0x8a,
+	0x9c, 0xbe, 0xde, 0x78, 0x01, 0x39, 0xbf, 0xfa, 0x3d, 0xc8, 0xdf, 0xa2, 0x0d, 0xbe, 0xe4, 0xe8,
+	0x32, 0xe4, 0x0e, 0x68, 0x43, 0xae, 0x59, 0x3e, 0x2c, 0x65, 0x85, 0xa5, 0x03, 0xda, 0x50, 0x2d,
+	0x1d, 0xd0, 0x46, 0xf5, 0x4f, 0x1a, 0x2c, 0x46, 0xad, 0xcc, 0x61, 0x25, 0xda, 0x69, 0x94, 0xa0,
+	0x2d, 0x98, 0x72, 0x78, 0xe2, 0xf0, 0xb9, 0xc3, 0xb3, 0xe2, 0x5d, 0x45, 0x92, 0xd4, 0x77, 0x15,
+	0x49, 0x62, 0x6f, 0x6b, 0x4e, 0xb7, 0xb3, 0xd3, 0x3c, 0xc4, 0x36, 0x7f, 0xed, 0x9d, 0x95, 0xd7,
+	0x5d, 0x49, 0x4b, 0x5c, 0x77, 0x25, 0xad, 0x7a, 0x19, 0x26, 0x77, 0xed, 0xdb, 0xc4, 0x0f, 0x58,
+	0x08, 0x89, 0x2d, 0xb6, 0xa5, 0x0c, 0x21, 0x49, 0xb4, 0x37, 0x19, 0xb7, 0xea, 0xc2, 0xa2, 0x81,
+	0x1d, 0xfc, 0xe8, 0x4c, 0x7a, 0xdf, 0xd2, 0xe2, 0xd8, 0xb1, 0x16, 0x7f, 0x39, 0x01, 0xc8, 0xc0,
+	0x41, 0xd7, 0x73, 0xce, 0xc4, 0xe6, 0xb7, 0x61, 0x92, 0x95, 0x00, 0xc4, 0x56, 0x37, 0xc1, 0x01,
+	0x6d, 0x24, 0xe4, 0x27, 0x38, 0x01, 0xdd, 0x87, 0x45, 0xeb, 0x88, 0x92, 0xe4, 0xcb, 0xb1, 0xe8,
+	0x89, 0xaf, 0xf0, 0xd5, 0xbb, 0xe3, 0xd9, 0xd8, 0xc3, 0xf6, 0x5e, 0xe0, 0x11, 0xa7, 0xf5, 0x9e,
+	0xe5, 0x8a, 0x97, 0x18, 0x3e, 0x26, 0xeb, 0xad, 0xd8, 0x98, 0x4f, 0xb1, 0xd0, 0xeb, 0x30, 0xe9,
+	0x61, 0xcb, 0xa7, 0x0e, 0x7f, 0xd7, 0x2c, 0x88, 0x3d, 0x2f, 0x28, 0xea, 0x9e, 0x17, 0x14, 0xf4,
+	0x36, 0xcc, 0x1e, 0x76, 0x1b, 0xd8, 0x73, 0x70, 0x80, 0x7d, 0x93, 0x88, 0xd7, 0xbc, 0x42, 0xbd,
+	0x38, 0xe8, 0x97, 0x57, 0x63, 0x46, 0x62, 0x26, 0x33, 0x2a, 0x9d, 0xbd, 0x21, 0xb1, 0xc9, 0xb3,
+	0xce, 0x96, 0x15, 0x70, 0x09, 0x6c, 0xf3, 0xc2, 0x2e, 0x2f, 0x3c, 0x3f, 0xa0, 0x0d, 0xa3, 0xeb,
+	0xec, 0x84, 0x2c, 0xd5, 0xf3, 0x14, 0x8b, 0x35, 0x78, 0x96, 0x02, 0xcf, 0x62, 0x7b, 0xc8, 0x54,
+	0x5f, 0xee, 0x45, 0x93, 0x6c, 0x8b, 0x87, 0x67, 0x78, 0xd9, 0x6a, 0xfb, 0x62, 0xc8, 0xd0, 0x7b,
+	0x7e, 0x85, 0xbd, 0xb3, 0x07, 0x43, 0x4c, 0xc5, 0x03, 0x34, 0xcc, 0x65, 0xaf, 0x3f, 0x23, 0x14,
+	0x3e, 0x17, 0x40, 0xb0, 0x01, 0x89, 0xa5, 0x7e, 0x17, 0xf7, 0xee, 0x31, 0xea, 0x5d, 0x8b, 0x78,
+	0x67, 0x6d, 0xa9, 0xfa, 0x31, 0x2c, 0xa4, 0xf7, 0x15, 0xfa, 0x11, 0x4c, 0x61, 0x27, 0xf0, 0x48,
+	0x94, 0x36, 0xd6, 0xc2, 0xd7, 0x92, 0x94, 0x37, 0x02, 0x23, 0xa4, 0xac, 0x8a, 0x11, 0x92, 0xb4,
+	0xfd, 0x6f, 0x0d, 0xe6, 0x77, 0x5a, 0x2d, 0x0f, 0xb7, 0xd8, 0x7d, 0x5a, 0xf4, 0xc9, 0x6e, 0x03,
+	0x8a, 0xc0, 0x8a, 0xaf, 0x16, 0x47, 0x93, 0xe2, 0xe8, 0x07, 0x99, 0xe2, 0x6a, 0x92, 0x17, 0x22,
+	0xdc, 0xa6, 0xf6, 0x86, 0x86, 0xae, 0x00, 0xc4, 0x10, 0x81, 0x56, 0xe5, 0x4e, 0x48, 0x61, 0x46,
+	0x71, 0x9a, 0xd3, 0x25, 0xf4, 0xfc, 0x00, 0xa6, 0x95, 0xbd, 0x82, 0xd6, 0x46, 0xec, 0x9e, 0xe2,
+	0xea, 0x50, 0x66, 0xbf, 0xc9, 0x66, 0x87, 0x2e, 0x01, 0x88, 0x9c, 0x7c, 0x83, 0x3a, 0x18, 0xa9,
+	0xaa, 0x13, 0x76, 0xea, 0xf7, 0xbf, 0xfa, 0x47, 0xe9, 0xc2, 0x4f, 0x9e, 0x94, 0xb4, 0x2f, 0x9e,
+	0x94, 0xb4, 0x2f, 0x9f, 0x94, 0xb4, 0xbf, 0x3f, 0x29, 0x69, 0x9f, 0x3e, 0x2d, 0x5d, 0xf8, 0xf2,
+	0x69, 0xe9, 0xc2, 0x57, 0x4f, 0x4b, 0x17, 0x3e, 0x7a, 0x55, 0xf9, 0x91, 0x90, 0xe8, 0xcc, 0xba,
+	0x1e, 0x3d, 0xc0, 0xcd, 0x40, 0x7e, 0x85, 0x3f, 0x33, 0xfa, 0xf3, 0xd8, 0xb2, 0x68, 0x4d, 0xdc,
+	0x15, 0xec, 0xda, 0x2e, 0xad, 0xed, 0xb8, 0xa4, 0x31, 0xc9, 0x3d, 0x7b, 0xf3, 0xbf, 0x03, 0x00,
+	0x89, 0xf0, 0xd0, 0xd4, 0x2c, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2088,6 +2099,15 @@ func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageClasses) > 0 {
+		for iNdEx := len(m.StorageClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageClasses[iNdEx])
+			copy(dAtA[i:], m.StorageClasses[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.StorageClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.NodeType) > 0 {
 		i -= len(m.NodeType)
 		copy(dAtA[i:], m.NodeType)
@@ -3278,6 +3298,12 @@ func (m *NodeInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
+	if len(m.StorageClasses) > 0 {
+		for _, s := range m.StorageClasses {
+			l = len(s)
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3809,6 +3835,7 @@ func (this *NodeInfo) String() string {
 		`Unschedulable:` + fmt.Sprintf("%v", this.Unschedulable) + `,`,
 		`ResourceUsageByQueue:` + mapStringForResourceUsageByQueue + `,`,
 		`NodeType:` + fmt.Sprintf("%v", this.NodeType) + `,`,
+		`StorageClasses:` + fmt.Sprintf("%v", this.StorageClasses) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6703,6 +6730,38 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.NodeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageClasses = append(m.StorageClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
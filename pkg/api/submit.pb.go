@@ -22,6 +22,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -35,6 +36,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Fixed taxonomy of why a job failed.
+type FailureCategory int32
+
+const (
+	// The failure couldn't be categorised.
+	FailureCategory_UnknownFailureCategory FailureCategory = 0
+	// A container was killed for exceeding its memory limit.
+	FailureCategory_OOMKilled FailureCategory = 1
+	// The image of a container couldn't be pulled.
+	FailureCategory_ImagePull FailureCategory = 2
+	// The node the job was running on was lost, e.g., because it failed, was removed, or evicted the job.
+	FailureCategory_NodeLost FailureCategory = 3
+	// A container exited with a non-zero exit code.
+	FailureCategory_UserError FailureCategory = 4
+	// The job exceeded its deadline.
+	FailureCategory_Deadline FailureCategory = 5
+)
+
+var FailureCategory_name = map[int32]string{
+	0: "UnknownFailureCategory",
+	1: "OOMKilled",
+	2: "ImagePull",
+	3: "NodeLost",
+	4: "UserError",
+	5: "Deadline",
+}
+
+var FailureCategory_value = map[string]int32{
+	"UnknownFailureCategory": 0,
+	"OOMKilled":              1,
+	"ImagePull":              2,
+	"NodeLost":               3,
+	"UserError":              4,
+	"Deadline":               5,
+}
+
+func (x FailureCategory) String() string {
+	return proto.EnumName(FailureCategory_name, int32(x))
+}
+
+func (FailureCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{0}
+}
+
 // Ingress type is being kept here to maintain backwards compatibility for a while.
 type IngressType int32
 
@@ -55,7 +100,7 @@ func (x IngressType) String() string {
 }
 
 func (IngressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{0}
+	return fileDescriptor_e998bacb27df16c1, []int{1}
 }
 
 type ServiceType int32
@@ -80,7 +125,7 @@ func (x ServiceType) String() string {
 }
 
 func (ServiceType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{1}
+	return fileDescriptor_e998bacb27df16c1, []int{2}
 }
 
 // swagger:model
@@ -93,6 +138,7 @@ const (
 	JobState_SUCCEEDED JobState = 3
 	JobState_FAILED    JobState = 4
 	JobState_UNKNOWN   JobState = 5
+	JobState_CANCELLED JobState = 6
 )
 
 var JobState_name = map[int32]string{
@@ -102,6 +148,7 @@ var JobState_name = map[int32]string{
 	3: "SUCCEEDED",
 	4: "FAILED",
 	5: "UNKNOWN",
+	6: "CANCELLED",
 }
 
 var JobState_value = map[string]int32{
@@ -111,6 +158,7 @@ var JobState_value = map[string]int32{
 	"SUCCEEDED": 3,
 	"FAILED":    4,
 	"UNKNOWN":   5,
+	"CANCELLED": 6,
 }
 
 func (x JobState) String() string {
@@ -118,7 +166,44 @@ func (x JobState) String() string {
 }
 
 func (JobState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{2}
+	return fileDescriptor_e998bacb27df16c1, []int{3}
+}
+
+// State of a queue, which determines whether jobs can be submitted to and scheduled from it.
+// swagger:model
+type QueueState int32
+
+const (
+	// Jobs can be submitted to the queue and are scheduled.
+	QueueState_OPEN QueueState = 0
+	// No new jobs can be submitted to the queue, but jobs already queued are scheduled.
+	QueueState_PAUSED QueueState = 1
+	// Jobs can be submitted to the queue, but no jobs are scheduled; running jobs run to completion.
+	QueueState_DRAINING QueueState = 2
+	// No new jobs can be submitted to the queue and no jobs are scheduled.
+	QueueState_CLOSED QueueState = 3
+)
+
+var QueueState_name = map[int32]string{
+	0: "OPEN",
+	1: "PAUSED",
+	2: "DRAINING",
+	3: "CLOSED",
+}
+
+var QueueState_value = map[string]int32{
+	"OPEN":     0,
+	"PAUSED":   1,
+	"DRAINING": 2,
+	"CLOSED":   3,
+}
+
+func (x QueueState) String() string {
+	return proto.EnumName(QueueState_name, int32(x))
+}
+
+func (QueueState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{4}
 }
 
 type JobSubmitRequestItem struct {
@@ -137,6 +222,16 @@ type JobSubmitRequestItem struct {
 	Scheduler string `protobuf:"bytes,11,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
 	// Queuing TTL for this job in seconds. If this job queues for more than this duration it will be cancelled. Zero indicates an infinite lifetime.
 	QueueTtlSeconds int64 `protobuf:"varint,12,opt,name=queue_ttl_seconds,json=queueTtlSeconds,proto3" json:"queueTtlSeconds,omitempty"`
+	// Jobs that must succeed before this job may be scheduled, given either by job id
+	// or by the client_id of a job earlier in the same request.
+	// If any of these jobs fails or is cancelled, this job fails without being scheduled.
+	DependsOn []string `protobuf:"bytes,13,rep,name=depends_on,json=dependsOn,proto3" json:"dependsOn,omitempty"`
+	// If greater than zero, this item is submitted as an array job made up of array_size tasks.
+	// Each task is a separate job, which can tell its index from the ARMADA_ARRAY_INDEX environment variable.
+	// If client_id is set, the client id of each task is client_id suffixed with "-<index>".
+	ArraySize uint32 `protobuf:"varint,14,opt,name=array_size,json=arraySize,proto3" json:"arraySize,omitempty"`
+	// If set, the job is resubmitted automatically if it fails, as specified by the policy.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,15,opt,name=retry_policy,json=retryPolicy,proto3" json:"retryPolicy,omitempty"`
 }
 
 func (m *JobSubmitRequestItem) Reset()      { *m = JobSubmitRequestItem{} }
@@ -257,6 +352,100 @@ func (m *JobSubmitRequestItem) GetQueueTtlSeconds() int64 {
 	return 0
 }
 
+func (m *JobSubmitRequestItem) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetArraySize() uint32 {
+	if m != nil {
+		return m.ArraySize
+	}
+	return 0
+}
+
+func (m *JobSubmitRequestItem) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+// Policy for automatically resubmitting failed jobs. Each retry is submitted as a new job in the same job set.
+type RetryPolicy struct {
+	// Maximum number of times the job is run, including the first attempt. The job isn't retried if at most one.
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	// Time to wait before the first retry, in seconds. The wait is doubled for each subsequent retry.
+	BackoffSeconds uint32 `protobuf:"varint,2,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoffSeconds,omitempty"`
+	// If non-empty, the job is only retried if it failed because a container exited with one of these exit codes.
+	RetryOnExitCodes []int32 `protobuf:"varint,3,rep,packed,name=retry_on_exit_codes,json=retryOnExitCodes,proto3" json:"retryOnExitCodes,omitempty"`
+	// If non-empty, the job is only retried if it failed for a reason in one of these categories.
+	// If both this and retry_on_exit_codes are provided, the job is retried if either matches.
+	RetryOnFailureCategories []FailureCategory `protobuf:"varint,4,rep,packed,name=retry_on_failure_categories,json=retryOnFailureCategories,proto3,enum=api.FailureCategory" json:"retryOnFailureCategories,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()      { *m = RetryPolicy{} }
+func (*RetryPolicy) ProtoMessage() {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffSeconds() uint32 {
+	if m != nil {
+		return m.BackoffSeconds
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetRetryOnExitCodes() []int32 {
+	if m != nil {
+		return m.RetryOnExitCodes
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetRetryOnFailureCategories() []FailureCategory {
+	if m != nil {
+		return m.RetryOnFailureCategories
+	}
+	return nil
+}
+
 type IngressConfig struct {
 	Type         IngressType       `protobuf:"varint,1,opt,name=type,proto3,enum=api.IngressType" json:"type,omitempty"` // Deprecated: Do not use.
 	Ports        []uint32          `protobuf:"varint,2,rep,packed,name=ports,proto3" json:"ports,omitempty"`
@@ -269,7 +458,7 @@ type IngressConfig struct {
 func (m *IngressConfig) Reset()      { *m = IngressConfig{} }
 func (*IngressConfig) ProtoMessage() {}
 func (*IngressConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{1}
+	return fileDescriptor_e998bacb27df16c1, []int{2}
 }
 func (m *IngressConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +538,7 @@ type ServiceConfig struct {
 func (m *ServiceConfig) Reset()      { *m = ServiceConfig{} }
 func (*ServiceConfig) ProtoMessage() {}
 func (*ServiceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{2}
+	return fileDescriptor_e998bacb27df16c1, []int{3}
 }
 func (m *ServiceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,12 +586,16 @@ type JobSubmitRequest struct {
 	Queue           string                  `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 	JobSetId        string                  `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	JobRequestItems []*JobSubmitRequestItem `protobuf:"bytes,3,rep,name=job_request_items,json=jobRequestItems,proto3" json:"jobRequestItems,omitempty"`
+	// Optional key identifying this request, such that retrying it, e.g., after a timeout, doesn't submit the jobs again.
+	// If a request with the same key was already submitted to the same queue, the response to that request is returned
+	// and no jobs are submitted.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *JobSubmitRequest) Reset()      { *m = JobSubmitRequest{} }
 func (*JobSubmitRequest) ProtoMessage() {}
 func (*JobSubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{3}
+	return fileDescriptor_e998bacb27df16c1, []int{4}
 }
 func (m *JobSubmitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,6 +645,13 @@ func (m *JobSubmitRequest) GetJobRequestItems() []*JobSubmitRequestItem {
 	return nil
 }
 
+func (m *JobSubmitRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // swagger:model
 type JobCancelRequest struct {
 	JobId    string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
@@ -464,7 +664,7 @@ type JobCancelRequest struct {
 func (m *JobCancelRequest) Reset()      { *m = JobCancelRequest{} }
 func (*JobCancelRequest) ProtoMessage() {}
 func (*JobCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{4}
+	return fileDescriptor_e998bacb27df16c1, []int{5}
 }
 func (m *JobCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +739,7 @@ type JobSetCancelRequest struct {
 func (m *JobSetCancelRequest) Reset()      { *m = JobSetCancelRequest{} }
 func (*JobSetCancelRequest) ProtoMessage() {}
 func (*JobSetCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{5}
+	return fileDescriptor_e998bacb27df16c1, []int{6}
 }
 func (m *JobSetCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +804,7 @@ type JobSetFilter struct {
 func (m *JobSetFilter) Reset()      { *m = JobSetFilter{} }
 func (*JobSetFilter) ProtoMessage() {}
 func (*JobSetFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{6}
+	return fileDescriptor_e998bacb27df16c1, []int{7}
 }
 func (m *JobSetFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +851,7 @@ type JobReprioritizeRequest struct {
 func (m *JobReprioritizeRequest) Reset()      { *m = JobReprioritizeRequest{} }
 func (*JobReprioritizeRequest) ProtoMessage() {}
 func (*JobReprioritizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{7}
+	return fileDescriptor_e998bacb27df16c1, []int{8}
 }
 func (m *JobReprioritizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,21 +909,28 @@ func (m *JobReprioritizeRequest) GetNewPriority() float64 {
 }
 
 // swagger:model
-type JobReprioritizeResponse struct {
-	ReprioritizationResults map[string]string `protobuf:"bytes,1,rep,name=reprioritization_results,json=reprioritizationResults,proto3" json:"reprioritizationResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (m *JobReprioritizeResponse) Reset()      { *m = JobReprioritizeResponse{} }
-func (*JobReprioritizeResponse) ProtoMessage() {}
-func (*JobReprioritizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{8}
+type JobReprioritizeByFilterRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	// Kubernetes label selector jobs must match to be reprioritised, e.g., "team=ml,stage in (train,eval)".
+	// All jobs in the job set are reprioritised if empty.
+	LabelSelector string `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"labelSelector,omitempty"`
+	// If set, only jobs in one of the given states are reprioritised.
+	Filter      *JobSetFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	NewPriority float64       `protobuf:"fixed64,5,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
+}
+
+func (m *JobReprioritizeByFilterRequest) Reset()      { *m = JobReprioritizeByFilterRequest{} }
+func (*JobReprioritizeByFilterRequest) ProtoMessage() {}
+func (*JobReprioritizeByFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{9}
 }
-func (m *JobReprioritizeResponse) XXX_Unmarshal(b []byte) error {
+func (m *JobReprioritizeByFilterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobReprioritizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobReprioritizeByFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobReprioritizeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobReprioritizeByFilterRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -733,41 +940,72 @@ func (m *JobReprioritizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *JobReprioritizeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobReprioritizeResponse.Merge(m, src)
+func (m *JobReprioritizeByFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReprioritizeByFilterRequest.Merge(m, src)
 }
-func (m *JobReprioritizeResponse) XXX_Size() int {
+func (m *JobReprioritizeByFilterRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobReprioritizeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobReprioritizeResponse.DiscardUnknown(m)
+func (m *JobReprioritizeByFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReprioritizeByFilterRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobReprioritizeResponse proto.InternalMessageInfo
+var xxx_messageInfo_JobReprioritizeByFilterRequest proto.InternalMessageInfo
 
-func (m *JobReprioritizeResponse) GetReprioritizationResults() map[string]string {
+func (m *JobReprioritizeByFilterRequest) GetQueue() string {
 	if m != nil {
-		return m.ReprioritizationResults
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobReprioritizeByFilterRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobReprioritizeByFilterRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *JobReprioritizeByFilterRequest) GetFilter() *JobSetFilter {
+	if m != nil {
+		return m.Filter
 	}
 	return nil
 }
 
-type JobSubmitResponseItem struct {
-	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+func (m *JobReprioritizeByFilterRequest) GetNewPriority() float64 {
+	if m != nil {
+		return m.NewPriority
+	}
+	return 0
 }
 
-func (m *JobSubmitResponseItem) Reset()      { *m = JobSubmitResponseItem{} }
-func (*JobSubmitResponseItem) ProtoMessage() {}
-func (*JobSubmitResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{9}
+// swagger:model
+type JobResubmitRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	// Ids of terminal jobs in the job set to resubmit.
+	JobIds []string `protobuf:"bytes,3,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
 }
-func (m *JobSubmitResponseItem) XXX_Unmarshal(b []byte) error {
+
+func (m *JobResubmitRequest) Reset()      { *m = JobResubmitRequest{} }
+func (*JobResubmitRequest) ProtoMessage() {}
+func (*JobResubmitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{10}
+}
+func (m *JobResubmitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSubmitResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobResubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSubmitResponseItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobResubmitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -777,48 +1015,60 @@ func (m *JobSubmitResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *JobSubmitResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSubmitResponseItem.Merge(m, src)
+func (m *JobResubmitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResubmitRequest.Merge(m, src)
 }
-func (m *JobSubmitResponseItem) XXX_Size() int {
+func (m *JobResubmitRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSubmitResponseItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSubmitResponseItem.DiscardUnknown(m)
+func (m *JobResubmitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResubmitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSubmitResponseItem proto.InternalMessageInfo
+var xxx_messageInfo_JobResubmitRequest proto.InternalMessageInfo
 
-func (m *JobSubmitResponseItem) GetJobId() string {
+func (m *JobResubmitRequest) GetQueue() string {
 	if m != nil {
-		return m.JobId
+		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobSubmitResponseItem) GetError() string {
+func (m *JobResubmitRequest) GetJobSetId() string {
 	if m != nil {
-		return m.Error
+		return m.JobSetId
 	}
 	return ""
 }
 
-// swagger:model
-type JobSubmitResponse struct {
-	JobResponseItems []*JobSubmitResponseItem `protobuf:"bytes,1,rep,name=job_response_items,json=jobResponseItems,proto3" json:"jobResponseItems,omitempty"`
+func (m *JobResubmitRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
+	}
+	return nil
 }
 
-func (m *JobSubmitResponse) Reset()      { *m = JobSubmitResponse{} }
-func (*JobSubmitResponse) ProtoMessage() {}
-func (*JobSubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{10}
+// swagger:model
+type ResourceRecommendationRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	// If provided, only jobs of the job set expanded from the job template of this name are considered.
+	TemplateName string `protobuf:"bytes,3,opt,name=template_name,json=templateName,proto3" json:"templateName,omitempty"`
+	// Percentile of the peak usage of past jobs to recommend, e.g., 95. The server's default is used if zero.
+	Percentile float64 `protobuf:"fixed64,4,opt,name=percentile,proto3" json:"percentile,omitempty"`
+}
+
+func (m *ResourceRecommendationRequest) Reset()      { *m = ResourceRecommendationRequest{} }
+func (*ResourceRecommendationRequest) ProtoMessage() {}
+func (*ResourceRecommendationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{11}
 }
-func (m *JobSubmitResponse) XXX_Unmarshal(b []byte) error {
+func (m *ResourceRecommendationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceRecommendationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSubmitResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResourceRecommendationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -828,46 +1078,68 @@ func (m *JobSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *JobSubmitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSubmitResponse.Merge(m, src)
+func (m *ResourceRecommendationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRecommendationRequest.Merge(m, src)
 }
-func (m *JobSubmitResponse) XXX_Size() int {
+func (m *ResourceRecommendationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSubmitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSubmitResponse.DiscardUnknown(m)
+func (m *ResourceRecommendationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRecommendationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSubmitResponse proto.InternalMessageInfo
+var xxx_messageInfo_ResourceRecommendationRequest proto.InternalMessageInfo
 
-func (m *JobSubmitResponse) GetJobResponseItems() []*JobSubmitResponseItem {
+func (m *ResourceRecommendationRequest) GetQueue() string {
 	if m != nil {
-		return m.JobResponseItems
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-// swagger:model
-type Queue struct {
-	Name           string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PriorityFactor float64              `protobuf:"fixed64,2,opt,name=priority_factor,json=priorityFactor,proto3" json:"priorityFactor,omitempty"`
-	UserOwners     []string             `protobuf:"bytes,3,rep,name=user_owners,json=userOwners,proto3" json:"userOwners,omitempty"`
-	GroupOwners    []string             `protobuf:"bytes,4,rep,name=group_owners,json=groupOwners,proto3" json:"groupOwners,omitempty"`
-	ResourceLimits map[string]float64   `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	Permissions    []*Queue_Permissions `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
+func (m *ResourceRecommendationRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-func (m *Queue) Reset()      { *m = Queue{} }
-func (*Queue) ProtoMessage() {}
-func (*Queue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{11}
+func (m *ResourceRecommendationRequest) GetTemplateName() string {
+	if m != nil {
+		return m.TemplateName
+	}
+	return ""
 }
-func (m *Queue) XXX_Unmarshal(b []byte) error {
+
+func (m *ResourceRecommendationRequest) GetPercentile() float64 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+// swagger:model
+type ResourceRecommendation struct {
+	// Recommended request of each resource requested by past jobs and for which their usage was reported.
+	// Empty if fewer past jobs reported their usage than the server requires to make a recommendation.
+	Requests map[string]resource.Quantity `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Largest request of each resource among the past jobs considered, for comparison.
+	CurrentRequests map[string]resource.Quantity `protobuf:"bytes,2,rep,name=current_requests,json=currentRequests,proto3" json:"currentRequests" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Number of past jobs that reported their usage.
+	NumJobs int32 `protobuf:"varint,3,opt,name=num_jobs,json=numJobs,proto3" json:"numJobs,omitempty"`
+}
+
+func (m *ResourceRecommendation) Reset()      { *m = ResourceRecommendation{} }
+func (*ResourceRecommendation) ProtoMessage() {}
+func (*ResourceRecommendation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{12}
+}
+func (m *ResourceRecommendation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Queue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceRecommendation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Queue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResourceRecommendation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -877,76 +1149,56 @@ func (m *Queue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Queue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Queue.Merge(m, src)
+func (m *ResourceRecommendation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRecommendation.Merge(m, src)
 }
-func (m *Queue) XXX_Size() int {
+func (m *ResourceRecommendation) XXX_Size() int {
 	return m.Size()
 }
-func (m *Queue) XXX_DiscardUnknown() {
-	xxx_messageInfo_Queue.DiscardUnknown(m)
+func (m *ResourceRecommendation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRecommendation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Queue proto.InternalMessageInfo
+var xxx_messageInfo_ResourceRecommendation proto.InternalMessageInfo
 
-func (m *Queue) GetName() string {
+func (m *ResourceRecommendation) GetRequests() map[string]resource.Quantity {
 	if m != nil {
-		return m.Name
+		return m.Requests
 	}
-	return ""
+	return nil
 }
 
-func (m *Queue) GetPriorityFactor() float64 {
+func (m *ResourceRecommendation) GetCurrentRequests() map[string]resource.Quantity {
 	if m != nil {
-		return m.PriorityFactor
+		return m.CurrentRequests
 	}
-	return 0
+	return nil
 }
 
-func (m *Queue) GetUserOwners() []string {
+func (m *ResourceRecommendation) GetNumJobs() int32 {
 	if m != nil {
-		return m.UserOwners
+		return m.NumJobs
 	}
-	return nil
+	return 0
 }
 
-func (m *Queue) GetGroupOwners() []string {
-	if m != nil {
-		return m.GroupOwners
-	}
-	return nil
-}
-
-func (m *Queue) GetResourceLimits() map[string]float64 {
-	if m != nil {
-		return m.ResourceLimits
-	}
-	return nil
-}
-
-func (m *Queue) GetPermissions() []*Queue_Permissions {
-	if m != nil {
-		return m.Permissions
-	}
-	return nil
+type JobSetSuspendRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-type Queue_Permissions struct {
-	Subjects []*Queue_Permissions_Subject `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
-	Verbs    []string                     `protobuf:"bytes,2,rep,name=verbs,proto3" json:"verbs,omitempty"`
-}
-
-func (m *Queue_Permissions) Reset()      { *m = Queue_Permissions{} }
-func (*Queue_Permissions) ProtoMessage() {}
-func (*Queue_Permissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{11, 0}
+func (m *JobSetSuspendRequest) Reset()      { *m = JobSetSuspendRequest{} }
+func (*JobSetSuspendRequest) ProtoMessage() {}
+func (*JobSetSuspendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{13}
 }
-func (m *Queue_Permissions) XXX_Unmarshal(b []byte) error {
+func (m *JobSetSuspendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Queue_Permissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSetSuspendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Queue_Permissions.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSetSuspendRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -956,48 +1208,55 @@ func (m *Queue_Permissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *Queue_Permissions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Queue_Permissions.Merge(m, src)
+func (m *JobSetSuspendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetSuspendRequest.Merge(m, src)
 }
-func (m *Queue_Permissions) XXX_Size() int {
+func (m *JobSetSuspendRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Queue_Permissions) XXX_DiscardUnknown() {
-	xxx_messageInfo_Queue_Permissions.DiscardUnknown(m)
+func (m *JobSetSuspendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetSuspendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Queue_Permissions proto.InternalMessageInfo
+var xxx_messageInfo_JobSetSuspendRequest proto.InternalMessageInfo
 
-func (m *Queue_Permissions) GetSubjects() []*Queue_Permissions_Subject {
+func (m *JobSetSuspendRequest) GetQueue() string {
 	if m != nil {
-		return m.Subjects
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-func (m *Queue_Permissions) GetVerbs() []string {
+func (m *JobSetSuspendRequest) GetJobSetId() string {
 	if m != nil {
-		return m.Verbs
+		return m.JobSetId
 	}
-	return nil
+	return ""
 }
 
-type Queue_Permissions_Subject struct {
-	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobSetSuspendRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *Queue_Permissions_Subject) Reset()      { *m = Queue_Permissions_Subject{} }
-func (*Queue_Permissions_Subject) ProtoMessage() {}
-func (*Queue_Permissions_Subject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{11, 0, 0}
+type JobSetResumeRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 }
-func (m *Queue_Permissions_Subject) XXX_Unmarshal(b []byte) error {
+
+func (m *JobSetResumeRequest) Reset()      { *m = JobSetResumeRequest{} }
+func (*JobSetResumeRequest) ProtoMessage() {}
+func (*JobSetResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{14}
+}
+func (m *JobSetResumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Queue_Permissions_Subject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSetResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Queue_Permissions_Subject.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSetResumeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1007,48 +1266,48 @@ func (m *Queue_Permissions_Subject) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *Queue_Permissions_Subject) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Queue_Permissions_Subject.Merge(m, src)
+func (m *JobSetResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetResumeRequest.Merge(m, src)
 }
-func (m *Queue_Permissions_Subject) XXX_Size() int {
+func (m *JobSetResumeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Queue_Permissions_Subject) XXX_DiscardUnknown() {
-	xxx_messageInfo_Queue_Permissions_Subject.DiscardUnknown(m)
+func (m *JobSetResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetResumeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Queue_Permissions_Subject proto.InternalMessageInfo
+var xxx_messageInfo_JobSetResumeRequest proto.InternalMessageInfo
 
-func (m *Queue_Permissions_Subject) GetKind() string {
+func (m *JobSetResumeRequest) GetQueue() string {
 	if m != nil {
-		return m.Kind
+		return m.Queue
 	}
 	return ""
 }
 
-func (m *Queue_Permissions_Subject) GetName() string {
+func (m *JobSetResumeRequest) GetJobSetId() string {
 	if m != nil {
-		return m.Name
+		return m.JobSetId
 	}
 	return ""
 }
 
 // swagger:model
-type QueueList struct {
-	Queues []*Queue `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+type JobReprioritizeResponse struct {
+	ReprioritizationResults map[string]string `protobuf:"bytes,1,rep,name=reprioritization_results,json=reprioritizationResults,proto3" json:"reprioritizationResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *QueueList) Reset()      { *m = QueueList{} }
-func (*QueueList) ProtoMessage() {}
-func (*QueueList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{12}
+func (m *JobReprioritizeResponse) Reset()      { *m = JobReprioritizeResponse{} }
+func (*JobReprioritizeResponse) ProtoMessage() {}
+func (*JobReprioritizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{15}
 }
-func (m *QueueList) XXX_Unmarshal(b []byte) error {
+func (m *JobReprioritizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobReprioritizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobReprioritizeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1058,41 +1317,50 @@ func (m *QueueList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueueList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueList.Merge(m, src)
+func (m *JobReprioritizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReprioritizeResponse.Merge(m, src)
 }
-func (m *QueueList) XXX_Size() int {
+func (m *JobReprioritizeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueList) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueList.DiscardUnknown(m)
+func (m *JobReprioritizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReprioritizeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueList proto.InternalMessageInfo
+var xxx_messageInfo_JobReprioritizeResponse proto.InternalMessageInfo
 
-func (m *QueueList) GetQueues() []*Queue {
+func (m *JobReprioritizeResponse) GetReprioritizationResults() map[string]string {
 	if m != nil {
-		return m.Queues
+		return m.ReprioritizationResults
 	}
 	return nil
 }
 
-// swagger:model
-type CancellationResult struct {
-	CancelledIds []string `protobuf:"bytes,1,rep,name=cancelled_ids,json=cancelledIds,proto3" json:"cancelledIds,omitempty"`
+type JobSubmitResponseItem struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// For array jobs, the id of the array and the ids of its tasks, ordered by index; job_id is empty.
+	ArrayId     string   `protobuf:"bytes,3,opt,name=array_id,json=arrayId,proto3" json:"arrayId,omitempty"`
+	ArrayJobIds []string `protobuf:"bytes,4,rep,name=array_job_ids,json=arrayJobIds,proto3" json:"arrayJobIds,omitempty"`
+	// For multi-pod jobs, the id of the multi-pod job and the ids of the jobs running its pods, ordered by pod;
+	// job_id is the id of the job running the first pod.
+	MultiPodJobId string   `protobuf:"bytes,5,opt,name=multi_pod_job_id,json=multiPodJobId,proto3" json:"multiPodJobId,omitempty"`
+	PodJobIds     []string `protobuf:"bytes,6,rep,name=pod_job_ids,json=podJobIds,proto3" json:"podJobIds,omitempty"`
+	// Reasons the job may never be scheduled, if it was accepted regardless.
+	Warnings []string `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (m *CancellationResult) Reset()      { *m = CancellationResult{} }
-func (*CancellationResult) ProtoMessage() {}
-func (*CancellationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13}
+func (m *JobSubmitResponseItem) Reset()      { *m = JobSubmitResponseItem{} }
+func (*JobSubmitResponseItem) ProtoMessage() {}
+func (*JobSubmitResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{16}
 }
-func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
+func (m *JobSubmitResponseItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancellationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSubmitResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancellationResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSubmitResponseItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1102,41 +1370,83 @@ func (m *CancellationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CancellationResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancellationResult.Merge(m, src)
+func (m *JobSubmitResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSubmitResponseItem.Merge(m, src)
 }
-func (m *CancellationResult) XXX_Size() int {
+func (m *JobSubmitResponseItem) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancellationResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancellationResult.DiscardUnknown(m)
+func (m *JobSubmitResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSubmitResponseItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancellationResult proto.InternalMessageInfo
+var xxx_messageInfo_JobSubmitResponseItem proto.InternalMessageInfo
 
-func (m *CancellationResult) GetCancelledIds() []string {
+func (m *JobSubmitResponseItem) GetJobId() string {
 	if m != nil {
-		return m.CancelledIds
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobSubmitResponseItem) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *JobSubmitResponseItem) GetArrayId() string {
+	if m != nil {
+		return m.ArrayId
+	}
+	return ""
+}
+
+func (m *JobSubmitResponseItem) GetArrayJobIds() []string {
+	if m != nil {
+		return m.ArrayJobIds
 	}
 	return nil
 }
 
-//swagger:model
-type QueueGetRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobSubmitResponseItem) GetMultiPodJobId() string {
+	if m != nil {
+		return m.MultiPodJobId
+	}
+	return ""
 }
 
-func (m *QueueGetRequest) Reset()      { *m = QueueGetRequest{} }
-func (*QueueGetRequest) ProtoMessage() {}
-func (*QueueGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{14}
+func (m *JobSubmitResponseItem) GetPodJobIds() []string {
+	if m != nil {
+		return m.PodJobIds
+	}
+	return nil
 }
-func (m *QueueGetRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *JobSubmitResponseItem) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+// swagger:model
+type JobSubmitResponse struct {
+	JobResponseItems []*JobSubmitResponseItem `protobuf:"bytes,1,rep,name=job_response_items,json=jobResponseItems,proto3" json:"jobResponseItems,omitempty"`
+}
+
+func (m *JobSubmitResponse) Reset()      { *m = JobSubmitResponse{} }
+func (*JobSubmitResponse) ProtoMessage() {}
+func (*JobSubmitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{17}
+}
+func (m *JobSubmitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueGetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSubmitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1146,41 +1456,42 @@ func (m *QueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *QueueGetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueGetRequest.Merge(m, src)
+func (m *JobSubmitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSubmitResponse.Merge(m, src)
 }
-func (m *QueueGetRequest) XXX_Size() int {
+func (m *JobSubmitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueGetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueGetRequest.DiscardUnknown(m)
+func (m *JobSubmitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSubmitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueGetRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobSubmitResponse proto.InternalMessageInfo
 
-func (m *QueueGetRequest) GetName() string {
+func (m *JobSubmitResponse) GetJobResponseItems() []*JobSubmitResponseItem {
 	if m != nil {
-		return m.Name
+		return m.JobResponseItems
 	}
-	return ""
+	return nil
 }
 
-//swagger:model
-type StreamingQueueGetRequest struct {
-	Num uint32 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+// swagger:model
+type JobValidateResponseItem struct {
+	// Reasons submitting the job would fail; empty if the job is valid.
+	Errors []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (m *StreamingQueueGetRequest) Reset()      { *m = StreamingQueueGetRequest{} }
-func (*StreamingQueueGetRequest) ProtoMessage() {}
-func (*StreamingQueueGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{15}
+func (m *JobValidateResponseItem) Reset()      { *m = JobValidateResponseItem{} }
+func (*JobValidateResponseItem) ProtoMessage() {}
+func (*JobValidateResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{18}
 }
-func (m *StreamingQueueGetRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobValidateResponseItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamingQueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobValidateResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamingQueueGetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobValidateResponseItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1190,41 +1501,46 @@ func (m *StreamingQueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *StreamingQueueGetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamingQueueGetRequest.Merge(m, src)
+func (m *JobValidateResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobValidateResponseItem.Merge(m, src)
 }
-func (m *StreamingQueueGetRequest) XXX_Size() int {
+func (m *JobValidateResponseItem) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamingQueueGetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamingQueueGetRequest.DiscardUnknown(m)
+func (m *JobValidateResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobValidateResponseItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamingQueueGetRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobValidateResponseItem proto.InternalMessageInfo
 
-func (m *StreamingQueueGetRequest) GetNum() uint32 {
+func (m *JobValidateResponseItem) GetErrors() []string {
 	if m != nil {
-		return m.Num
+		return m.Errors
 	}
-	return 0
-}
-
-//swagger:model
-type QueueInfoRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	return nil
 }
 
-func (m *QueueInfoRequest) Reset()      { *m = QueueInfoRequest{} }
-func (*QueueInfoRequest) ProtoMessage() {}
-func (*QueueInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{16}
+// swagger:model
+type JobValidateResponse struct {
+	// True if submitting the request would succeed.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Reasons submitting the request would fail that don't relate to a particular job.
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Diagnostics for each item of the request, in the same order as the items.
+	JobResponseItems []*JobValidateResponseItem `protobuf:"bytes,3,rep,name=job_response_items,json=jobResponseItems,proto3" json:"jobResponseItems,omitempty"`
+}
+
+func (m *JobValidateResponse) Reset()      { *m = JobValidateResponse{} }
+func (*JobValidateResponse) ProtoMessage() {}
+func (*JobValidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{19}
 }
-func (m *QueueInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobValidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobValidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobValidateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1234,41 +1550,61 @@ func (m *QueueInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueueInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueInfoRequest.Merge(m, src)
+func (m *JobValidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobValidateResponse.Merge(m, src)
 }
-func (m *QueueInfoRequest) XXX_Size() int {
+func (m *JobValidateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueInfoRequest.DiscardUnknown(m)
+func (m *JobValidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobValidateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobValidateResponse proto.InternalMessageInfo
 
-func (m *QueueInfoRequest) GetName() string {
+func (m *JobValidateResponse) GetValid() bool {
 	if m != nil {
-		return m.Name
+		return m.Valid
 	}
-	return ""
+	return false
 }
 
-//swagger:model
-type QueueDeleteRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobValidateResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
 }
 
-func (m *QueueDeleteRequest) Reset()      { *m = QueueDeleteRequest{} }
-func (*QueueDeleteRequest) ProtoMessage() {}
-func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{17}
+func (m *JobValidateResponse) GetJobResponseItems() []*JobValidateResponseItem {
+	if m != nil {
+		return m.JobResponseItems
+	}
+	return nil
 }
-func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
+
+// swagger:model
+type Queue struct {
+	Name           string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PriorityFactor float64              `protobuf:"fixed64,2,opt,name=priority_factor,json=priorityFactor,proto3" json:"priorityFactor,omitempty"`
+	UserOwners     []string             `protobuf:"bytes,3,rep,name=user_owners,json=userOwners,proto3" json:"userOwners,omitempty"`
+	GroupOwners    []string             `protobuf:"bytes,4,rep,name=group_owners,json=groupOwners,proto3" json:"groupOwners,omitempty"`
+	ResourceLimits map[string]float64   `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Permissions    []*Queue_Permissions `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	State          QueueState           `protobuf:"varint,7,opt,name=state,proto3,enum=api.QueueState" json:"state,omitempty"`
+}
+
+func (m *Queue) Reset()      { *m = Queue{} }
+func (*Queue) ProtoMessage() {}
+func (*Queue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{20}
+}
+func (m *Queue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Queue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Queue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1278,94 +1614,83 @@ func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
+func (m *Queue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Queue.Merge(m, src)
 }
-func (m *QueueDeleteRequest) XXX_Size() int {
+func (m *Queue) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
+func (m *Queue) XXX_DiscardUnknown() {
+	xxx_messageInfo_Queue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_Queue proto.InternalMessageInfo
 
-func (m *QueueDeleteRequest) GetName() string {
+func (m *Queue) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-//swagger:model
-type QueueInfo struct {
-	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ActiveJobSets []*JobSetInfo `protobuf:"bytes,2,rep,name=active_job_sets,json=activeJobSets,proto3" json:"activeJobSets,omitempty"`
+func (m *Queue) GetPriorityFactor() float64 {
+	if m != nil {
+		return m.PriorityFactor
+	}
+	return 0
 }
 
-func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
-func (*QueueInfo) ProtoMessage() {}
-func (*QueueInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{18}
-}
-func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueueInfo.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Queue) GetUserOwners() []string {
+	if m != nil {
+		return m.UserOwners
 	}
-}
-func (m *QueueInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueInfo.Merge(m, src)
-}
-func (m *QueueInfo) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueueInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueInfo.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_QueueInfo proto.InternalMessageInfo
+func (m *Queue) GetGroupOwners() []string {
+	if m != nil {
+		return m.GroupOwners
+	}
+	return nil
+}
 
-func (m *QueueInfo) GetName() string {
+func (m *Queue) GetResourceLimits() map[string]float64 {
 	if m != nil {
-		return m.Name
+		return m.ResourceLimits
 	}
-	return ""
+	return nil
 }
 
-func (m *QueueInfo) GetActiveJobSets() []*JobSetInfo {
+func (m *Queue) GetPermissions() []*Queue_Permissions {
 	if m != nil {
-		return m.ActiveJobSets
+		return m.Permissions
 	}
 	return nil
 }
 
-type JobSetInfo struct {
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
-	LeasedJobs int32  `protobuf:"varint,3,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
+func (m *Queue) GetState() QueueState {
+	if m != nil {
+		return m.State
+	}
+	return QueueState_OPEN
 }
 
-func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
-func (*JobSetInfo) ProtoMessage() {}
-func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{19}
+type Queue_Permissions struct {
+	Subjects []*Queue_Permissions_Subject `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Verbs    []string                     `protobuf:"bytes,2,rep,name=verbs,proto3" json:"verbs,omitempty"`
 }
-func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *Queue_Permissions) Reset()      { *m = Queue_Permissions{} }
+func (*Queue_Permissions) ProtoMessage() {}
+func (*Queue_Permissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{20, 0}
+}
+func (m *Queue_Permissions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Queue_Permissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Queue_Permissions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1375,55 +1700,48 @@ func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobSetInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSetInfo.Merge(m, src)
+func (m *Queue_Permissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Queue_Permissions.Merge(m, src)
 }
-func (m *JobSetInfo) XXX_Size() int {
+func (m *Queue_Permissions) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSetInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
+func (m *Queue_Permissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Queue_Permissions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
-
-func (m *JobSetInfo) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_Queue_Permissions proto.InternalMessageInfo
 
-func (m *JobSetInfo) GetQueuedJobs() int32 {
+func (m *Queue_Permissions) GetSubjects() []*Queue_Permissions_Subject {
 	if m != nil {
-		return m.QueuedJobs
+		return m.Subjects
 	}
-	return 0
+	return nil
 }
 
-func (m *JobSetInfo) GetLeasedJobs() int32 {
+func (m *Queue_Permissions) GetVerbs() []string {
 	if m != nil {
-		return m.LeasedJobs
+		return m.Verbs
 	}
-	return 0
+	return nil
 }
 
-type QueueUpdateResponse struct {
-	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+type Queue_Permissions_Subject struct {
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueueUpdateResponse) Reset()      { *m = QueueUpdateResponse{} }
-func (*QueueUpdateResponse) ProtoMessage() {}
-func (*QueueUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{20}
+func (m *Queue_Permissions_Subject) Reset()      { *m = Queue_Permissions_Subject{} }
+func (*Queue_Permissions_Subject) ProtoMessage() {}
+func (*Queue_Permissions_Subject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{20, 0, 0}
 }
-func (m *QueueUpdateResponse) XXX_Unmarshal(b []byte) error {
+func (m *Queue_Permissions_Subject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Queue_Permissions_Subject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueUpdateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Queue_Permissions_Subject.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1433,47 +1751,48 @@ func (m *QueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueUpdateResponse.Merge(m, src)
+func (m *Queue_Permissions_Subject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Queue_Permissions_Subject.Merge(m, src)
 }
-func (m *QueueUpdateResponse) XXX_Size() int {
+func (m *Queue_Permissions_Subject) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueUpdateResponse.DiscardUnknown(m)
+func (m *Queue_Permissions_Subject) XXX_DiscardUnknown() {
+	xxx_messageInfo_Queue_Permissions_Subject.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_Queue_Permissions_Subject proto.InternalMessageInfo
 
-func (m *QueueUpdateResponse) GetQueue() *Queue {
+func (m *Queue_Permissions_Subject) GetKind() string {
 	if m != nil {
-		return m.Queue
+		return m.Kind
 	}
-	return nil
+	return ""
 }
 
-func (m *QueueUpdateResponse) GetError() string {
+func (m *Queue_Permissions_Subject) GetName() string {
 	if m != nil {
-		return m.Error
+		return m.Name
 	}
 	return ""
 }
 
-type BatchQueueUpdateResponse struct {
-	FailedQueues []*QueueUpdateResponse `protobuf:"bytes,1,rep,name=failed_queues,json=failedQueues,proto3" json:"failedQueues,omitempty"`
+// swagger:model
+type QueueList struct {
+	Queues []*Queue `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
 }
 
-func (m *BatchQueueUpdateResponse) Reset()      { *m = BatchQueueUpdateResponse{} }
-func (*BatchQueueUpdateResponse) ProtoMessage() {}
-func (*BatchQueueUpdateResponse) Descriptor() ([]byte, []int) {
+func (m *QueueList) Reset()      { *m = QueueList{} }
+func (*QueueList) ProtoMessage() {}
+func (*QueueList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{21}
 }
-func (m *BatchQueueUpdateResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueueList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchQueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueueList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchQueueUpdateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueueList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1483,41 +1802,41 @@ func (m *BatchQueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *BatchQueueUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchQueueUpdateResponse.Merge(m, src)
+func (m *QueueList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueList.Merge(m, src)
 }
-func (m *BatchQueueUpdateResponse) XXX_Size() int {
+func (m *QueueList) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchQueueUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchQueueUpdateResponse.DiscardUnknown(m)
+func (m *QueueList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchQueueUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueueList proto.InternalMessageInfo
 
-func (m *BatchQueueUpdateResponse) GetFailedQueues() []*QueueUpdateResponse {
+func (m *QueueList) GetQueues() []*Queue {
 	if m != nil {
-		return m.FailedQueues
+		return m.Queues
 	}
 	return nil
 }
 
-type QueueCreateResponse struct {
-	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+// swagger:model
+type CancellationResult struct {
+	CancelledIds []string `protobuf:"bytes,1,rep,name=cancelled_ids,json=cancelledIds,proto3" json:"cancelledIds,omitempty"`
 }
 
-func (m *QueueCreateResponse) Reset()      { *m = QueueCreateResponse{} }
-func (*QueueCreateResponse) ProtoMessage() {}
-func (*QueueCreateResponse) Descriptor() ([]byte, []int) {
+func (m *CancellationResult) Reset()      { *m = CancellationResult{} }
+func (*CancellationResult) ProtoMessage() {}
+func (*CancellationResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{22}
 }
-func (m *QueueCreateResponse) XXX_Unmarshal(b []byte) error {
+func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CancellationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueCreateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CancellationResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1527,47 +1846,85 @@ func (m *QueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueCreateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueCreateResponse.Merge(m, src)
+func (m *CancellationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancellationResult.Merge(m, src)
 }
-func (m *QueueCreateResponse) XXX_Size() int {
+func (m *CancellationResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueCreateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueCreateResponse.DiscardUnknown(m)
+func (m *CancellationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancellationResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueCreateResponse proto.InternalMessageInfo
+var xxx_messageInfo_CancellationResult proto.InternalMessageInfo
 
-func (m *QueueCreateResponse) GetQueue() *Queue {
+func (m *CancellationResult) GetCancelledIds() []string {
 	if m != nil {
-		return m.Queue
+		return m.CancelledIds
 	}
 	return nil
 }
 
-func (m *QueueCreateResponse) GetError() string {
+//swagger:model
+type QueueGetRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueueGetRequest) Reset()      { *m = QueueGetRequest{} }
+func (*QueueGetRequest) ProtoMessage() {}
+func (*QueueGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{23}
+}
+func (m *QueueGetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueGetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueGetRequest.Merge(m, src)
+}
+func (m *QueueGetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueGetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueGetRequest proto.InternalMessageInfo
+
+func (m *QueueGetRequest) GetName() string {
 	if m != nil {
-		return m.Error
+		return m.Name
 	}
 	return ""
 }
 
-type BatchQueueCreateResponse struct {
-	FailedQueues []*QueueCreateResponse `protobuf:"bytes,1,rep,name=failed_queues,json=failedQueues,proto3" json:"failedQueues,omitempty"`
+//swagger:model
+type StreamingQueueGetRequest struct {
+	Num uint32 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (m *BatchQueueCreateResponse) Reset()      { *m = BatchQueueCreateResponse{} }
-func (*BatchQueueCreateResponse) ProtoMessage() {}
-func (*BatchQueueCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{23}
+func (m *StreamingQueueGetRequest) Reset()      { *m = StreamingQueueGetRequest{} }
+func (*StreamingQueueGetRequest) ProtoMessage() {}
+func (*StreamingQueueGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{24}
 }
-func (m *BatchQueueCreateResponse) XXX_Unmarshal(b []byte) error {
+func (m *StreamingQueueGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchQueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamingQueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchQueueCreateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamingQueueGetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1577,40 +1934,41 @@ func (m *BatchQueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *BatchQueueCreateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchQueueCreateResponse.Merge(m, src)
+func (m *StreamingQueueGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamingQueueGetRequest.Merge(m, src)
 }
-func (m *BatchQueueCreateResponse) XXX_Size() int {
+func (m *StreamingQueueGetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchQueueCreateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchQueueCreateResponse.DiscardUnknown(m)
+func (m *StreamingQueueGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamingQueueGetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchQueueCreateResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamingQueueGetRequest proto.InternalMessageInfo
 
-func (m *BatchQueueCreateResponse) GetFailedQueues() []*QueueCreateResponse {
+func (m *StreamingQueueGetRequest) GetNum() uint32 {
 	if m != nil {
-		return m.FailedQueues
+		return m.Num
 	}
-	return nil
+	return 0
 }
 
-// Indicates the end of streams
-type EndMarker struct {
+//swagger:model
+type QueueInfoRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *EndMarker) Reset()      { *m = EndMarker{} }
-func (*EndMarker) ProtoMessage() {}
-func (*EndMarker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{24}
+func (m *QueueInfoRequest) Reset()      { *m = QueueInfoRequest{} }
+func (*QueueInfoRequest) ProtoMessage() {}
+func (*QueueInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{25}
 }
-func (m *EndMarker) XXX_Unmarshal(b []byte) error {
+func (m *QueueInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueueInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndMarker.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueueInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1620,36 +1978,41 @@ func (m *EndMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EndMarker) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndMarker.Merge(m, src)
+func (m *QueueInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueInfoRequest.Merge(m, src)
 }
-func (m *EndMarker) XXX_Size() int {
+func (m *QueueInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EndMarker) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndMarker.DiscardUnknown(m)
+func (m *QueueInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EndMarker proto.InternalMessageInfo
+var xxx_messageInfo_QueueInfoRequest proto.InternalMessageInfo
 
-type StreamingQueueMessage struct {
-	// Types that are valid to be assigned to Event:
-	//	*StreamingQueueMessage_Queue
-	//	*StreamingQueueMessage_End
-	Event isStreamingQueueMessage_Event `protobuf_oneof:"event"`
+func (m *QueueInfoRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *StreamingQueueMessage) Reset()      { *m = StreamingQueueMessage{} }
-func (*StreamingQueueMessage) ProtoMessage() {}
-func (*StreamingQueueMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{25}
+//swagger:model
+type QueueDeleteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
-func (m *StreamingQueueMessage) XXX_Unmarshal(b []byte) error {
+
+func (m *QueueDeleteRequest) Reset()      { *m = QueueDeleteRequest{} }
+func (*QueueDeleteRequest) ProtoMessage() {}
+func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{26}
+}
+func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamingQueueMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamingQueueMessage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1659,1360 +2022,1484 @@ func (m *StreamingQueueMessage) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *StreamingQueueMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamingQueueMessage.Merge(m, src)
+func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
 }
-func (m *StreamingQueueMessage) XXX_Size() int {
+func (m *QueueDeleteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamingQueueMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamingQueueMessage.DiscardUnknown(m)
+func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamingQueueMessage proto.InternalMessageInfo
+var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
 
-type isStreamingQueueMessage_Event interface {
-	isStreamingQueueMessage_Event()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *QueueDeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type StreamingQueueMessage_Queue struct {
-	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3,oneof" json:"queue,omitempty"`
+//swagger:model
+type QueueInfo struct {
+	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ActiveJobSets []*JobSetInfo `protobuf:"bytes,2,rep,name=active_job_sets,json=activeJobSets,proto3" json:"activeJobSets,omitempty"`
 }
-type StreamingQueueMessage_End struct {
-	End *EndMarker `protobuf:"bytes,2,opt,name=end,proto3,oneof" json:"end,omitempty"`
+
+func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
+func (*QueueInfo) ProtoMessage() {}
+func (*QueueInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{27}
+}
+func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueInfo.Merge(m, src)
+}
+func (m *QueueInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueInfo.DiscardUnknown(m)
 }
 
-func (*StreamingQueueMessage_Queue) isStreamingQueueMessage_Event() {}
-func (*StreamingQueueMessage_End) isStreamingQueueMessage_Event()   {}
+var xxx_messageInfo_QueueInfo proto.InternalMessageInfo
 
-func (m *StreamingQueueMessage) GetEvent() isStreamingQueueMessage_Event {
+func (m *QueueInfo) GetName() string {
 	if m != nil {
-		return m.Event
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamingQueueMessage) GetQueue() *Queue {
-	if x, ok := m.GetEvent().(*StreamingQueueMessage_Queue); ok {
-		return x.Queue
+func (m *QueueInfo) GetActiveJobSets() []*JobSetInfo {
+	if m != nil {
+		return m.ActiveJobSets
 	}
 	return nil
 }
 
-func (m *StreamingQueueMessage) GetEnd() *EndMarker {
-	if x, ok := m.GetEvent().(*StreamingQueueMessage_End); ok {
-		return x.End
-	}
-	return nil
+type JobSetInfo struct {
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
+	LeasedJobs int32  `protobuf:"varint,3,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*StreamingQueueMessage) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*StreamingQueueMessage_Queue)(nil),
-		(*StreamingQueueMessage_End)(nil),
+func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
+func (*JobSetInfo) ProtoMessage() {}
+func (*JobSetInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{28}
+}
+func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
-
-func init() {
-	proto.RegisterEnum("api.IngressType", IngressType_name, IngressType_value)
-	proto.RegisterEnum("api.ServiceType", ServiceType_name, ServiceType_value)
-	proto.RegisterEnum("api.JobState", JobState_name, JobState_value)
-	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
-	proto.RegisterType((*IngressConfig)(nil), "api.IngressConfig")
-	proto.RegisterMapType((map[string]string)(nil), "api.IngressConfig.AnnotationsEntry")
-	proto.RegisterType((*ServiceConfig)(nil), "api.ServiceConfig")
-	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
-	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
-	proto.RegisterType((*JobSetCancelRequest)(nil), "api.JobSetCancelRequest")
-	proto.RegisterType((*JobSetFilter)(nil), "api.JobSetFilter")
-	proto.RegisterType((*JobReprioritizeRequest)(nil), "api.JobReprioritizeRequest")
-	proto.RegisterType((*JobReprioritizeResponse)(nil), "api.JobReprioritizeResponse")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobReprioritizeResponse.ReprioritizationResultsEntry")
-	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
-	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
-	proto.RegisterType((*Queue)(nil), "api.Queue")
-	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
-	proto.RegisterType((*Queue_Permissions)(nil), "api.Queue.Permissions")
-	proto.RegisterType((*Queue_Permissions_Subject)(nil), "api.Queue.Permissions.Subject")
-	proto.RegisterType((*QueueList)(nil), "api.QueueList")
-	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
-	proto.RegisterType((*QueueGetRequest)(nil), "api.QueueGetRequest")
-	proto.RegisterType((*StreamingQueueGetRequest)(nil), "api.StreamingQueueGetRequest")
-	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
-	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
-	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
-	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
-	proto.RegisterType((*QueueUpdateResponse)(nil), "api.QueueUpdateResponse")
-	proto.RegisterType((*BatchQueueUpdateResponse)(nil), "api.BatchQueueUpdateResponse")
-	proto.RegisterType((*QueueCreateResponse)(nil), "api.QueueCreateResponse")
-	proto.RegisterType((*BatchQueueCreateResponse)(nil), "api.BatchQueueCreateResponse")
-	proto.RegisterType((*EndMarker)(nil), "api.EndMarker")
-	proto.RegisterType((*StreamingQueueMessage)(nil), "api.StreamingQueueMessage")
-}
-
-func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
-
-var fileDescriptor_e998bacb27df16c1 = []byte{
-	// 2324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1b, 0xd7,
-	0x11, 0xd7, 0x8a, 0x12, 0x25, 0x0e, 0xf5, 0x41, 0x3d, 0x7d, 0xad, 0xd6, 0x0a, 0xa9, 0x6e, 0x9a,
-	0x56, 0x16, 0x12, 0xb2, 0x56, 0x6a, 0xd4, 0x76, 0x03, 0x18, 0xa6, 0x44, 0xdb, 0x72, 0x1c, 0x59,
-	0x96, 0xac, 0x26, 0xe9, 0xa1, 0xcc, 0x92, 0x3b, 0xa2, 0x56, 0x22, 0x77, 0xe9, 0xfd, 0x90, 0xe1,
-	0x16, 0x01, 0x82, 0x1e, 0x5a, 0xf4, 0x16, 0xa0, 0xc7, 0xfe, 0x07, 0xe9, 0x3f, 0xd2, 0x63, 0x80,
-	0x5e, 0xd2, 0x0b, 0xd1, 0xda, 0xfd, 0x00, 0x78, 0xeb, 0xbd, 0x87, 0xe2, 0xcd, 0xdb, 0xe5, 0xbe,
-	0x25, 0x29, 0x5b, 0x32, 0xe0, 0xf6, 0xa6, 0xf7, 0x7b, 0x33, 0xbf, 0x99, 0x79, 0x6f, 0xde, 0xcc,
-	0x2c, 0x05, 0x0b, 0xed, 0xd3, 0x46, 0xc9, 0x68, 0x5b, 0x25, 0x2f, 0xa8, 0xb5, 0x2c, 0xbf, 0xd8,
-	0x76, 0x1d, 0xdf, 0x61, 0x29, 0xa3, 0x6d, 0x69, 0x57, 0x1a, 0x8e, 0xd3, 0x68, 0x62, 0x89, 0xa0,
-	0x5a, 0x70, 0x54, 0xc2, 0x56, 0xdb, 0x7f, 0x2e, 0x24, 0x34, 0xfd, 0xf4, 0x86, 0x57, 0xb4, 0x1c,
-	0x52, 0xad, 0x3b, 0x2e, 0x96, 0xce, 0xae, 0x95, 0x1a, 0x68, 0xa3, 0x6b, 0xf8, 0x68, 0x86, 0x32,
-	0xab, 0x21, 0x01, 0x97, 0x31, 0x6c, 0xdb, 0xf1, 0x0d, 0xdf, 0x72, 0x6c, 0x2f, 0xdc, 0xfd, 0xa0,
-	0x61, 0xf9, 0xc7, 0x41, 0xad, 0x58, 0x77, 0x5a, 0xa5, 0x86, 0xd3, 0x70, 0x62, 0x3b, 0x7c, 0x45,
-	0x0b, 0xfa, 0x2b, 0x14, 0xef, 0x39, 0x7a, 0x8c, 0x46, 0xd3, 0x3f, 0x16, 0xa8, 0xde, 0xcd, 0xc0,
-	0xc2, 0x03, 0xa7, 0x76, 0x40, 0xce, 0xef, 0xe3, 0xd3, 0x00, 0x3d, 0x7f, 0xc7, 0xc7, 0x16, 0xdb,
-	0x84, 0xc9, 0xb6, 0x6b, 0x39, 0xae, 0xe5, 0x3f, 0x57, 0x95, 0x35, 0x65, 0x5d, 0x29, 0x2f, 0x75,
-	0x3b, 0x05, 0x16, 0x61, 0xef, 0x3b, 0x2d, 0xcb, 0xa7, 0x78, 0xf6, 0x7b, 0x72, 0xec, 0x3a, 0x64,
-	0x6c, 0xa3, 0x85, 0x5e, 0xdb, 0xa8, 0xa3, 0x9a, 0x5a, 0x53, 0xd6, 0x33, 0xe5, 0xe5, 0x6e, 0xa7,
-	0x30, 0xdf, 0x03, 0x25, 0xad, 0x58, 0x92, 0x7d, 0x08, 0x99, 0x7a, 0xd3, 0x42, 0xdb, 0xaf, 0x5a,
-	0xa6, 0x3a, 0x49, 0x6a, 0x64, 0x4b, 0x80, 0x3b, 0xa6, 0x6c, 0x2b, 0xc2, 0xd8, 0x01, 0xa4, 0x9b,
-	0x46, 0x0d, 0x9b, 0x9e, 0x3a, 0xb6, 0x96, 0x5a, 0xcf, 0x6e, 0xbe, 0x57, 0x34, 0xda, 0x56, 0x71,
-	0x58, 0x28, 0xc5, 0x87, 0x24, 0x57, 0xb1, 0x7d, 0xf7, 0x79, 0x79, 0xa1, 0xdb, 0x29, 0xe4, 0x84,
-	0xa2, 0x44, 0x1b, 0x52, 0xb1, 0x06, 0x64, 0xa5, 0x73, 0x56, 0xc7, 0x89, 0x79, 0xe3, 0x7c, 0xe6,
-	0x3b, 0xb1, 0xb0, 0xa0, 0x5f, 0xe9, 0x76, 0x0a, 0x8b, 0x12, 0x85, 0x64, 0x43, 0x66, 0x66, 0xbf,
-	0x55, 0x60, 0xc1, 0xc5, 0xa7, 0x81, 0xe5, 0xa2, 0x59, 0xb5, 0x1d, 0x13, 0xab, 0x61, 0x30, 0x69,
-	0x32, 0x79, 0xed, 0x7c, 0x93, 0xfb, 0xa1, 0xd6, 0xae, 0x63, 0xa2, 0x1c, 0x98, 0xde, 0xed, 0x14,
-	0x56, 0xdd, 0x81, 0xcd, 0xd8, 0x01, 0x55, 0xd9, 0x67, 0x83, 0xfb, 0xec, 0x11, 0x4c, 0xb6, 0x1d,
-	0xb3, 0xea, 0xb5, 0xb1, 0xae, 0x8e, 0xae, 0x29, 0xeb, 0xd9, 0xcd, 0x2b, 0x45, 0x91, 0x9a, 0xe4,
-	0x03, 0x4f, 0xcd, 0xe2, 0xd9, 0xb5, 0xe2, 0x9e, 0x63, 0x1e, 0xb4, 0xb1, 0x4e, 0xf7, 0x39, 0xd7,
-	0x16, 0x8b, 0x04, 0xf7, 0x44, 0x08, 0xb2, 0x3d, 0xc8, 0x44, 0x84, 0x9e, 0x3a, 0x41, 0xe1, 0xbc,
-	0x92, 0x51, 0xa4, 0x95, 0x58, 0x78, 0x89, 0xb4, 0x0a, 0x31, 0xb6, 0x05, 0x13, 0x96, 0xdd, 0x70,
-	0xd1, 0xf3, 0xd4, 0x0c, 0xf1, 0x31, 0x22, 0xda, 0x11, 0xd8, 0x96, 0x63, 0x1f, 0x59, 0x8d, 0xf2,
-	0x22, 0x77, 0x2c, 0x14, 0x93, 0x58, 0x22, 0x4d, 0x76, 0x17, 0x26, 0x3d, 0x74, 0xcf, 0xac, 0x3a,
-	0x7a, 0x2a, 0x48, 0x2c, 0x07, 0x02, 0x0c, 0x59, 0xc8, 0x99, 0x48, 0x4e, 0x76, 0x26, 0xc2, 0x78,
-	0x8e, 0x7b, 0xf5, 0x63, 0x34, 0x83, 0x26, 0xba, 0x6a, 0x36, 0xce, 0xf1, 0x1e, 0x28, 0xe7, 0x78,
-	0x0f, 0x64, 0x3b, 0x30, 0xf7, 0x34, 0xc0, 0x00, 0xab, 0xbe, 0xdf, 0xac, 0x7a, 0x58, 0x77, 0x6c,
-	0xd3, 0x53, 0xa7, 0xd6, 0x94, 0xf5, 0x54, 0xf9, 0x9d, 0x6e, 0xa7, 0xb0, 0x42, 0x9b, 0x4f, 0xfc,
-	0xe6, 0x81, 0xd8, 0x92, 0x48, 0x66, 0xfb, 0xb6, 0x34, 0x03, 0xb2, 0xd2, 0xc5, 0xb3, 0x77, 0x21,
-	0x75, 0x8a, 0xe2, 0x8d, 0x66, 0xca, 0x73, 0xdd, 0x4e, 0x61, 0xfa, 0x14, 0xe5, 0xe7, 0xc9, 0x77,
-	0xd9, 0x55, 0x18, 0x3f, 0x33, 0x9a, 0x01, 0xd2, 0x15, 0x67, 0xca, 0xf3, 0xdd, 0x4e, 0x61, 0x96,
-	0x00, 0x49, 0x50, 0x48, 0xdc, 0x1a, 0xbd, 0xa1, 0x68, 0x47, 0x90, 0xeb, 0x4f, 0xed, 0xb7, 0x62,
-	0xa7, 0x05, 0xcb, 0xe7, 0xe4, 0xf3, 0xdb, 0x30, 0xa7, 0xff, 0x3b, 0x05, 0xd3, 0x89, 0xac, 0x61,
-	0xb7, 0x60, 0xcc, 0x7f, 0xde, 0x46, 0x32, 0x33, 0xb3, 0x99, 0x93, 0xf3, 0xea, 0xc9, 0xf3, 0x36,
-	0x52, 0xb9, 0x98, 0xe1, 0x12, 0x89, 0x5c, 0x27, 0x1d, 0x6e, 0xbc, 0xed, 0xb8, 0xbe, 0xa7, 0x8e,
-	0xae, 0xa5, 0xd6, 0xa7, 0x85, 0x71, 0x02, 0x64, 0xe3, 0x04, 0xb0, 0x2f, 0x92, 0x75, 0x25, 0x45,
-	0xf9, 0xf7, 0xee, 0x60, 0x16, 0xbf, 0x79, 0x41, 0xb9, 0x09, 0x59, 0xbf, 0xe9, 0x55, 0xd1, 0x36,
-	0x6a, 0x4d, 0x34, 0xd5, 0xb1, 0x35, 0x65, 0x7d, 0xb2, 0xac, 0x76, 0x3b, 0x85, 0x05, 0x9f, 0x9f,
-	0x28, 0xa1, 0x92, 0x2e, 0xc4, 0x28, 0x95, 0x5f, 0x74, 0xfd, 0x2a, 0x2f, 0xc8, 0xea, 0xb8, 0x54,
-	0x7e, 0xd1, 0xf5, 0x77, 0x8d, 0x16, 0x26, 0xca, 0x6f, 0x88, 0xb1, 0xdb, 0x30, 0x1d, 0x78, 0x58,
-	0xad, 0x37, 0x03, 0xcf, 0x47, 0x77, 0x67, 0x4f, 0x4d, 0x93, 0x45, 0xad, 0xdb, 0x29, 0x2c, 0x05,
-	0x1e, 0x6e, 0x45, 0xb8, 0xa4, 0x3c, 0x25, 0xe3, 0xff, 0xab, 0x14, 0xd3, 0x7d, 0x98, 0x4e, 0x3c,
-	0x71, 0x76, 0x63, 0xc8, 0x95, 0x87, 0x12, 0x74, 0xe5, 0x6c, 0xf0, 0xca, 0x2f, 0x7d, 0xe1, 0xfa,
-	0x5f, 0x14, 0xc8, 0xf5, 0x97, 0x6f, 0xae, 0x4f, 0x6f, 0x39, 0x0c, 0x90, 0xf4, 0x09, 0x90, 0xf5,
-	0x09, 0x60, 0x3f, 0x06, 0x38, 0x71, 0x6a, 0x55, 0x0f, 0xa9, 0x27, 0x8e, 0xc6, 0x97, 0x72, 0xe2,
-	0xd4, 0x0e, 0xb0, 0xaf, 0x27, 0x46, 0x18, 0x33, 0x61, 0x8e, 0x6b, 0xb9, 0xc2, 0x5e, 0x95, 0x0b,
-	0x44, 0xc9, 0xb6, 0x72, 0x6e, 0x47, 0x11, 0xf5, 0xe7, 0xc4, 0xa9, 0x49, 0x58, 0xa2, 0xfe, 0xf4,
-	0x6d, 0xe9, 0xff, 0x11, 0xb1, 0x6d, 0x19, 0x76, 0x1d, 0x9b, 0x51, 0x6c, 0x1b, 0x90, 0xe6, 0xa6,
-	0x2d, 0x53, 0x0e, 0xee, 0xc4, 0xa9, 0x25, 0x3c, 0x1d, 0x27, 0xe0, 0x0d, 0x83, 0xeb, 0x9d, 0x5e,
-	0xea, 0xb5, 0xa7, 0xf7, 0x01, 0x4c, 0x08, 0x67, 0xc4, 0x70, 0x90, 0x11, 0x5d, 0x9f, 0x8c, 0x27,
-	0xba, 0xbe, 0x40, 0xd8, 0xfb, 0x90, 0x76, 0xd1, 0xf0, 0x1c, 0x3b, 0xcc, 0x7e, 0x92, 0x16, 0x88,
-	0x2c, 0x2d, 0x10, 0xfd, 0x1f, 0x0a, 0xcc, 0x3f, 0x20, 0xa7, 0x92, 0x27, 0x90, 0x8c, 0x4a, 0xb9,
-	0x6c, 0x54, 0xa3, 0xaf, 0x8d, 0xea, 0x36, 0xa4, 0x8f, 0xac, 0xa6, 0x8f, 0x2e, 0x9d, 0x40, 0x76,
-	0x73, 0xae, 0x77, 0xa5, 0xe8, 0xdf, 0xa5, 0x0d, 0xe1, 0xb9, 0x10, 0x92, 0x3d, 0x17, 0x88, 0x14,
-	0xe7, 0xd8, 0x05, 0xe2, 0xfc, 0x18, 0xa6, 0x64, 0x6e, 0xf6, 0x53, 0x48, 0x7b, 0xbe, 0xe1, 0xa3,
-	0xa7, 0x2a, 0x6b, 0xa9, 0xf5, 0x99, 0xcd, 0xe9, 0x9e, 0x79, 0x8e, 0x0a, 0x32, 0x21, 0x20, 0x93,
-	0x09, 0x44, 0xff, 0xa7, 0x02, 0x4b, 0x0f, 0x78, 0x1e, 0x85, 0xb3, 0xa2, 0xf5, 0x4b, 0x8c, 0xce,
-	0x4d, 0xba, 0x2c, 0xe5, 0x02, 0x97, 0xf5, 0xd6, 0x93, 0xe7, 0x23, 0x98, 0xb2, 0xf1, 0x59, 0xb5,
-	0x37, 0xfc, 0x8e, 0xd1, 0xf0, 0x4b, 0x75, 0xd8, 0xc6, 0x67, 0x7b, 0x83, 0xf3, 0x6f, 0x56, 0x82,
-	0xf5, 0x3f, 0x8e, 0xc2, 0xf2, 0x40, 0xa0, 0x5e, 0xdb, 0xb1, 0x3d, 0x64, 0x7f, 0x50, 0x40, 0x75,
-	0xe3, 0x0d, 0xaa, 0x7c, 0x55, 0x17, 0xbd, 0xa0, 0xe9, 0x8b, 0xd8, 0xb3, 0x9b, 0x37, 0xa3, 0x43,
-	0x1d, 0x46, 0x50, 0xdc, 0xef, 0x53, 0xde, 0x17, 0xba, 0xa2, 0x53, 0xbc, 0xd7, 0xed, 0x14, 0xbe,
-	0xe7, 0x0e, 0x97, 0x90, 0xbc, 0x5d, 0x3e, 0x47, 0x44, 0x73, 0x61, 0xf5, 0x55, 0xfc, 0x6f, 0xa5,
-	0x38, 0xdb, 0xb0, 0x28, 0x95, 0x24, 0x11, 0x25, 0x7d, 0x7d, 0x5c, 0xa6, 0x9c, 0x5c, 0x85, 0x71,
-	0x74, 0x5d, 0xc7, 0x95, 0x6d, 0x12, 0x20, 0x8b, 0x12, 0xa0, 0x7f, 0x09, 0x73, 0x03, 0xf6, 0xd8,
-	0x31, 0x30, 0x51, 0x35, 0xc5, 0x3a, 0x2c, 0x9b, 0xe2, 0x3e, 0xb4, 0xfe, 0xb2, 0x19, 0xfb, 0x58,
-	0xce, 0x77, 0x3b, 0x05, 0x8d, 0x8a, 0x63, 0x0c, 0xca, 0x27, 0x9d, 0xeb, 0xdf, 0xd3, 0xbf, 0x4a,
-	0xc3, 0xf8, 0x63, 0x4a, 0xb2, 0x1f, 0xc0, 0x18, 0xb5, 0x5b, 0x11, 0x1d, 0xb5, 0x1c, 0x3b, 0xd9,
-	0x6a, 0x69, 0x9f, 0x55, 0x60, 0x36, 0x4a, 0xc4, 0xea, 0x91, 0x51, 0xf7, 0xc3, 0x28, 0x95, 0xf2,
-	0x6a, 0xb7, 0x53, 0x50, 0xa3, 0xad, 0xbb, 0xb4, 0x23, 0x29, 0xcf, 0x24, 0x77, 0xf8, 0x74, 0x10,
-	0x78, 0xe8, 0x56, 0x9d, 0x67, 0x36, 0xba, 0xa2, 0x25, 0x64, 0xc4, 0x74, 0xc0, 0xe1, 0x47, 0x84,
-	0xca, 0xd3, 0x41, 0x8c, 0xf2, 0xe7, 0xd0, 0x70, 0x9d, 0xa0, 0x1d, 0xe9, 0x8a, 0x82, 0x4a, 0xcf,
-	0x81, 0xf0, 0x01, 0xe5, 0xac, 0x04, 0x33, 0x84, 0x59, 0x17, 0x3d, 0x27, 0x70, 0xeb, 0x58, 0x6d,
-	0x5a, 0x2d, 0xcb, 0x8f, 0x3e, 0xaa, 0xf2, 0x74, 0xb0, 0x74, 0x18, 0xc5, 0xfd, 0x50, 0xe2, 0x21,
-	0x09, 0x88, 0x6c, 0xa6, 0xf8, 0xdc, 0xc4, 0x86, 0x1c, 0x5f, 0x72, 0x87, 0x1d, 0x40, 0xb6, 0x8d,
-	0x6e, 0xcb, 0xf2, 0x3c, 0x9a, 0xaf, 0xc4, 0x47, 0xd4, 0x92, 0x64, 0x62, 0x2f, 0xde, 0x15, 0xbe,
-	0x4b, 0xe2, 0xb2, 0xef, 0x12, 0xac, 0xfd, 0x4b, 0x81, 0xac, 0xa4, 0xc7, 0xf6, 0x61, 0xd2, 0x0b,
-	0x6a, 0x27, 0x58, 0xef, 0xbd, 0xd6, 0xfc, 0x70, 0x0b, 0xc5, 0x03, 0x21, 0x16, 0x7e, 0x4d, 0x84,
-	0x3a, 0x89, 0xaf, 0x89, 0x10, 0xa3, 0xf7, 0x82, 0x6e, 0x4d, 0x8c, 0x14, 0xd1, 0x7b, 0xe1, 0x40,
-	0xe2, 0xbd, 0x70, 0x40, 0xfb, 0x1c, 0x26, 0x42, 0x5e, 0x9e, 0x3d, 0xa7, 0x96, 0x6d, 0xca, 0xd9,
-	0xc3, 0xd7, 0x72, 0xf6, 0xf0, 0x75, 0x2f, 0xcb, 0x46, 0x5f, 0x9d, 0x65, 0x9a, 0x05, 0xf3, 0x43,
-	0xee, 0xe0, 0x0d, 0x5e, 0xbc, 0xf2, 0xda, 0x17, 0x5f, 0x81, 0x0c, 0x9d, 0xd7, 0x43, 0xcb, 0xf3,
-	0xd9, 0x0d, 0x48, 0x53, 0xcd, 0x8d, 0xce, 0x13, 0xe2, 0xf3, 0x14, 0x5d, 0x40, 0xec, 0xca, 0x5d,
-	0x40, 0x20, 0xfa, 0x21, 0x30, 0xd1, 0x7d, 0x9b, 0x52, 0xa1, 0xe2, 0x43, 0x69, 0x5d, 0xa0, 0x68,
-	0x4a, 0x0d, 0x85, 0x86, 0xd2, 0xde, 0x46, 0xb2, 0xad, 0x4c, 0xc9, 0xb8, 0x7e, 0x13, 0x66, 0xc9,
-	0xfa, 0x3d, 0xec, 0x0d, 0x6d, 0x17, 0x7c, 0xa9, 0xfa, 0x6d, 0x50, 0x0f, 0x7c, 0x17, 0x8d, 0x96,
-	0x65, 0x37, 0xfa, 0x39, 0xde, 0x85, 0x94, 0x1d, 0xb4, 0x88, 0x62, 0x5a, 0x1c, 0xa4, 0x1d, 0xb4,
-	0xe4, 0x83, 0xb4, 0x83, 0x96, 0x7e, 0x0b, 0x72, 0xa4, 0xb7, 0x63, 0x1f, 0x39, 0x97, 0x35, 0xfe,
-	0x11, 0x30, 0xd2, 0xdd, 0xc6, 0x26, 0xfa, 0x78, 0x59, 0xed, 0xdf, 0x29, 0xe1, 0xa5, 0x70, 0xd3,
-	0x17, 0x2e, 0x4d, 0x4f, 0x60, 0xd6, 0xa8, 0xfb, 0xd6, 0x19, 0x56, 0xc3, 0x7e, 0x2c, 0x92, 0x38,
-	0xbb, 0x39, 0x2b, 0xcd, 0x25, 0x9c, 0xb1, 0x7c, 0xa5, 0xdb, 0x29, 0x2c, 0x0b, 0x59, 0x81, 0xca,
-	0x17, 0x30, 0x9d, 0xd8, 0xd0, 0xbf, 0x51, 0x00, 0x62, 0xd5, 0x0b, 0x3b, 0x73, 0x13, 0xb2, 0x94,
-	0x19, 0x26, 0x77, 0xc6, 0xa3, 0x5c, 0x1c, 0x17, 0x05, 0x4e, 0xc0, 0x0f, 0x9c, 0xc4, 0x93, 0x82,
-	0x18, 0xe5, 0xaa, 0x4d, 0x34, 0xbc, 0x48, 0x35, 0x15, 0xab, 0x0a, 0xb8, 0x5f, 0x35, 0x46, 0xf5,
-	0x67, 0x30, 0x4f, 0xe7, 0x76, 0xd8, 0x36, 0x0d, 0x3f, 0xee, 0xf3, 0xd7, 0xe5, 0x39, 0x3f, 0x99,
-	0xd5, 0xaf, 0x1a, 0x3c, 0x2e, 0xd1, 0xc7, 0x02, 0x50, 0xcb, 0x86, 0x5f, 0x3f, 0x1e, 0x66, 0xfd,
-	0x73, 0x98, 0x3e, 0x32, 0x2c, 0xfe, 0x02, 0x12, 0x6f, 0x4b, 0x8d, 0xbd, 0x48, 0x2a, 0x88, 0xe7,
-	0x21, 0x54, 0x1e, 0xf7, 0xbf, 0xb7, 0x29, 0x19, 0xef, 0xc5, 0xbb, 0xe5, 0xe2, 0xff, 0x31, 0xde,
-	0x3e, 0xeb, 0xaf, 0x8f, 0x37, 0xa9, 0x70, 0x89, 0x78, 0xb3, 0x90, 0xa9, 0xd8, 0xe6, 0x27, 0x86,
-	0x7b, 0x8a, 0xae, 0xfe, 0xb5, 0x02, 0x8b, 0xc9, 0x17, 0xfe, 0x09, 0x7a, 0x9e, 0xd1, 0x40, 0xf6,
-	0x93, 0xcb, 0xc5, 0x7f, 0x7f, 0x24, 0x3a, 0x81, 0xeb, 0x90, 0x42, 0xdb, 0x0c, 0x7f, 0x76, 0x9b,
-	0x21, 0xb5, 0x9e, 0x3d, 0x51, 0x27, 0x50, 0xae, 0xea, 0xf7, 0x47, 0xf6, 0xb9, 0x7c, 0x79, 0x02,
-	0xc6, 0xf1, 0x0c, 0x6d, 0x7f, 0x43, 0x83, 0xac, 0xf4, 0x63, 0x05, 0xcb, 0xc2, 0x44, 0xb8, 0xcc,
-	0x8d, 0x6c, 0x5c, 0x85, 0xac, 0xf4, 0x55, 0xcb, 0xa6, 0x60, 0x72, 0xd7, 0x31, 0x71, 0xcf, 0x71,
-	0xfd, 0xdc, 0x08, 0x5f, 0xdd, 0x47, 0xc3, 0x6c, 0x72, 0x51, 0x65, 0xe3, 0x33, 0x98, 0x8c, 0xc6,
-	0x78, 0x06, 0x90, 0x7e, 0x7c, 0x58, 0x39, 0xac, 0x6c, 0xe7, 0x46, 0x38, 0xdf, 0x5e, 0x65, 0x77,
-	0x7b, 0x67, 0xf7, 0x5e, 0x4e, 0xe1, 0x8b, 0xfd, 0xc3, 0xdd, 0x5d, 0xbe, 0x18, 0x65, 0xd3, 0x90,
-	0x39, 0x38, 0xdc, 0xda, 0xaa, 0x54, 0xb6, 0x2b, 0xdb, 0xb9, 0x14, 0x57, 0xba, 0x7b, 0x67, 0xe7,
-	0x61, 0x65, 0x3b, 0x37, 0xc6, 0xe5, 0x0e, 0x77, 0x3f, 0xde, 0x7d, 0xf4, 0xe9, 0x6e, 0x6e, 0x7c,
-	0xf3, 0x37, 0x19, 0x48, 0x8b, 0xc9, 0x89, 0xfd, 0x0c, 0x40, 0xfc, 0x45, 0x8f, 0x6e, 0x71, 0xe8,
-	0xe7, 0xa8, 0xb6, 0x34, 0x7c, 0xdc, 0xd2, 0x57, 0x7e, 0xfd, 0xe7, 0xbf, 0xff, 0x7e, 0x74, 0x5e,
-	0x9f, 0x29, 0x9d, 0x5d, 0x2b, 0x9d, 0x38, 0xb5, 0xf0, 0xc7, 0xf6, 0x5b, 0xca, 0x06, 0xfb, 0x14,
-	0x40, 0x74, 0x82, 0x24, 0x6f, 0xe2, 0xdb, 0x4c, 0x5b, 0x26, 0x78, 0xb0, 0x63, 0x0c, 0x12, 0x8b,
-	0x76, 0xc0, 0x89, 0x7f, 0x01, 0x53, 0x3d, 0xe2, 0x03, 0xf4, 0x99, 0x2a, 0x95, 0xb5, 0x24, 0xfb,
-	0x52, 0x51, 0xfc, 0x4e, 0x5f, 0x8c, 0x7e, 0x80, 0x2f, 0x56, 0xf8, 0x75, 0xe9, 0xab, 0x44, 0xbe,
-	0xa4, 0xcf, 0x85, 0xe4, 0x1e, 0xfa, 0x12, 0xbf, 0x0d, 0x39, 0x79, 0xc8, 0x27, 0xf7, 0xaf, 0x0c,
-	0x1f, 0xff, 0x85, 0x99, 0xd5, 0x57, 0x7d, 0x1b, 0xe8, 0x05, 0x32, 0xb6, 0xa2, 0x2f, 0x44, 0x91,
-	0x48, 0x73, 0x3e, 0x72, 0x7b, 0xf7, 0x20, 0x2b, 0x1e, 0x82, 0x98, 0x40, 0xa5, 0x2c, 0x3d, 0x37,
-	0x80, 0x05, 0xe2, 0x9c, 0xd1, 0x33, 0x9c, 0x93, 0x52, 0x96, 0x13, 0xd5, 0x61, 0x4a, 0x22, 0xf2,
-	0xd8, 0x4c, 0xcc, 0xc4, 0xbb, 0xba, 0xf6, 0x0e, 0xad, 0xcf, 0x7b, 0xaf, 0xfa, 0xf7, 0x89, 0x34,
-	0xaf, 0xaf, 0x70, 0xd2, 0x1a, 0x97, 0x42, 0xb3, 0x54, 0x27, 0x99, 0xf0, 0x05, 0x73, 0x23, 0xbb,
-	0x90, 0x15, 0x65, 0xea, 0xe2, 0xde, 0x5e, 0x21, 0xe2, 0x45, 0x2d, 0xd7, 0xf3, 0xb6, 0xf4, 0x2b,
-	0xde, 0x1c, 0xbe, 0x0c, 0x9d, 0x96, 0xf8, 0x5e, 0xef, 0x74, 0xb2, 0x46, 0x46, 0x4e, 0x6b, 0x09,
-	0xa7, 0x03, 0x92, 0x91, 0x9c, 0xfe, 0x0c, 0xb2, 0xa2, 0x03, 0x0b, 0xa7, 0x97, 0x63, 0x1b, 0x89,
-	0xc6, 0x7c, 0x6e, 0x04, 0x2a, 0x59, 0x61, 0x1b, 0x03, 0x11, 0xb0, 0xbb, 0x30, 0x79, 0x0f, 0x7d,
-	0x41, 0xbb, 0x10, 0xd3, 0xc6, 0x33, 0x86, 0x26, 0x9d, 0x50, 0xc4, 0xc3, 0x06, 0x79, 0x4c, 0xc8,
-	0x44, 0x3c, 0x1e, 0x13, 0x31, 0x9f, 0x37, 0xb5, 0x68, 0xda, 0x90, 0xed, 0xb0, 0xe4, 0xe9, 0x1a,
-	0x59, 0x58, 0x60, 0x4c, 0x3e, 0x0f, 0x71, 0x10, 0x3f, 0x52, 0xd8, 0x13, 0x98, 0x8a, 0xac, 0x50,
-	0x17, 0x5f, 0x8c, 0x7d, 0x93, 0xa6, 0x1b, 0x6d, 0x26, 0x09, 0xeb, 0xef, 0x10, 0xe9, 0x32, 0x5b,
-	0xec, 0x77, 0xbb, 0x64, 0x71, 0x96, 0x5b, 0x90, 0xbe, 0x4f, 0xff, 0xba, 0x62, 0xe7, 0x9c, 0x9f,
-	0x26, 0x9e, 0xa8, 0x10, 0xda, 0x3a, 0xc6, 0xfa, 0x69, 0xaf, 0xe6, 0x7f, 0xf1, 0xdd, 0xdf, 0xf2,
-	0x23, 0x5f, 0xbd, 0xc8, 0x2b, 0x7f, 0x7a, 0x91, 0x57, 0xbe, 0x7d, 0x91, 0x57, 0xfe, 0xfa, 0x22,
-	0xaf, 0x7c, 0xfd, 0x32, 0x3f, 0xf2, 0xed, 0xcb, 0xfc, 0xc8, 0x77, 0x2f, 0xf3, 0x23, 0x3f, 0xff,
-	0xa1, 0xf4, 0xdf, 0x34, 0xc3, 0x6d, 0x19, 0xa6, 0xd1, 0x76, 0x1d, 0x3e, 0x6d, 0x87, 0xab, 0x52,
-	0xf8, 0xef, 0xb3, 0x6f, 0x46, 0x17, 0xee, 0x10, 0xb0, 0x27, 0xb6, 0x8b, 0x3b, 0x4e, 0xf1, 0x4e,
-	0xdb, 0xaa, 0xa5, 0xc9, 0x97, 0x0f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xc7, 0x3a, 0xe5,
-	0x10, 0x1c, 0x00, 0x00,
+func (m *JobSetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetInfo.Merge(m, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// SubmitClient is the client API for Submit service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SubmitClient interface {
-	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
-	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
-	CancelJobSet(ctx context.Context, in *JobSetCancelRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
-	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error)
-	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error)
-	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error)
-	GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (Submit_GetQueuesClient, error)
-	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
-	Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+func (m *JobSetInfo) XXX_Size() int {
+	return m.Size()
 }
-
-type submitClient struct {
-	cc *grpc.ClientConn
+func (m *JobSetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
 }
 
-func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
-	return &submitClient{cc}
-}
+var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
 
-func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
-	out := new(JobSubmitResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSetInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
-	out := new(CancellationResult)
-	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSetInfo) GetQueuedJobs() int32 {
+	if m != nil {
+		return m.QueuedJobs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *submitClient) CancelJobSet(ctx context.Context, in *JobSetCancelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSetInfo) GetLeasedJobs() int32 {
+	if m != nil {
+		return m.LeasedJobs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
-	out := new(JobReprioritizeResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueueUpdateResponse struct {
+	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueueUpdateResponse) Reset()      { *m = QueueUpdateResponse{} }
+func (*QueueUpdateResponse) ProtoMessage() {}
+func (*QueueUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{29}
 }
-
-func (c *submitClient) CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error) {
-	out := new(BatchQueueCreateResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueues", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueueUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *submitClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueueUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueUpdateResponse.Merge(m, src)
+}
+func (m *QueueUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueUpdateResponse.DiscardUnknown(m)
 }
 
-func (c *submitClient) UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error) {
-	out := new(BatchQueueUpdateResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueues", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueueUpdateResponse proto.InternalMessageInfo
+
+func (m *QueueUpdateResponse) GetQueue() *Queue {
+	if m != nil {
+		return m.Queue
 	}
-	return out, nil
+	return nil
 }
 
-func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueueUpdateResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *submitClient) GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error) {
-	out := new(Queue)
-	err := c.cc.Invoke(ctx, "/api.Submit/GetQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BatchQueueUpdateResponse struct {
+	FailedQueues []*QueueUpdateResponse `protobuf:"bytes,1,rep,name=failed_queues,json=failedQueues,proto3" json:"failedQueues,omitempty"`
 }
 
-func (c *submitClient) GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (Submit_GetQueuesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Submit_serviceDesc.Streams[0], "/api.Submit/GetQueues", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &submitGetQueuesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *BatchQueueUpdateResponse) Reset()      { *m = BatchQueueUpdateResponse{} }
+func (*BatchQueueUpdateResponse) ProtoMessage() {}
+func (*BatchQueueUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{30}
+}
+func (m *BatchQueueUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchQueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueueUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type Submit_GetQueuesClient interface {
-	Recv() (*StreamingQueueMessage, error)
-	grpc.ClientStream
+func (m *BatchQueueUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueueUpdateResponse.Merge(m, src)
 }
-
-type submitGetQueuesClient struct {
-	grpc.ClientStream
+func (m *BatchQueueUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchQueueUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueueUpdateResponse.DiscardUnknown(m)
 }
 
-func (x *submitGetQueuesClient) Recv() (*StreamingQueueMessage, error) {
-	m := new(StreamingQueueMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_BatchQueueUpdateResponse proto.InternalMessageInfo
+
+func (m *BatchQueueUpdateResponse) GetFailedQueues() []*QueueUpdateResponse {
+	if m != nil {
+		return m.FailedQueues
 	}
-	return m, nil
+	return nil
 }
 
-func (c *submitClient) GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error) {
-	out := new(QueueInfo)
-	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueueCreateResponse struct {
+	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QueueCreateResponse) Reset()      { *m = QueueCreateResponse{} }
+func (*QueueCreateResponse) ProtoMessage() {}
+func (*QueueCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{31}
+}
+func (m *QueueCreateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueCreateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueueCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueCreateResponse.Merge(m, src)
+}
+func (m *QueueCreateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueCreateResponse.DiscardUnknown(m)
 }
 
-func (c *submitClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/Health", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueueCreateResponse proto.InternalMessageInfo
+
+func (m *QueueCreateResponse) GetQueue() *Queue {
+	if m != nil {
+		return m.Queue
 	}
-	return out, nil
+	return nil
 }
 
-// SubmitServer is the server API for Submit service.
-type SubmitServer interface {
-	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
-	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
-	CancelJobSet(context.Context, *JobSetCancelRequest) (*types.Empty, error)
-	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
-	CreateQueue(context.Context, *Queue) (*types.Empty, error)
-	CreateQueues(context.Context, *QueueList) (*BatchQueueCreateResponse, error)
-	UpdateQueue(context.Context, *Queue) (*types.Empty, error)
-	UpdateQueues(context.Context, *QueueList) (*BatchQueueUpdateResponse, error)
-	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
-	GetQueue(context.Context, *QueueGetRequest) (*Queue, error)
-	GetQueues(*StreamingQueueGetRequest, Submit_GetQueuesServer) error
-	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
-	Health(context.Context, *types.Empty) (*HealthCheckResponse, error)
+func (m *QueueCreateResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
-type UnimplementedSubmitServer struct {
+type BatchQueueCreateResponse struct {
+	FailedQueues []*QueueCreateResponse `protobuf:"bytes,1,rep,name=failed_queues,json=failedQueues,proto3" json:"failedQueues,omitempty"`
 }
 
-func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
+func (m *BatchQueueCreateResponse) Reset()      { *m = BatchQueueCreateResponse{} }
+func (*BatchQueueCreateResponse) ProtoMessage() {}
+func (*BatchQueueCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{32}
 }
-func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+func (m *BatchQueueCreateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedSubmitServer) CancelJobSet(ctx context.Context, req *JobSetCancelRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelJobSet not implemented")
+func (m *BatchQueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueueCreateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
+func (m *BatchQueueCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueueCreateResponse.Merge(m, src)
 }
-func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+func (m *BatchQueueCreateResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedSubmitServer) CreateQueues(ctx context.Context, req *QueueList) (*BatchQueueCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateQueues not implemented")
+func (m *BatchQueueCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueueCreateResponse.DiscardUnknown(m)
 }
-func (*UnimplementedSubmitServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+
+var xxx_messageInfo_BatchQueueCreateResponse proto.InternalMessageInfo
+
+func (m *BatchQueueCreateResponse) GetFailedQueues() []*QueueCreateResponse {
+	if m != nil {
+		return m.FailedQueues
+	}
+	return nil
 }
-func (*UnimplementedSubmitServer) UpdateQueues(ctx context.Context, req *QueueList) (*BatchQueueUpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueues not implemented")
+
+// Indicates the end of streams
+type EndMarker struct {
 }
-func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+
+func (m *EndMarker) Reset()      { *m = EndMarker{} }
+func (*EndMarker) ProtoMessage() {}
+func (*EndMarker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{33}
 }
-func (*UnimplementedSubmitServer) GetQueue(ctx context.Context, req *QueueGetRequest) (*Queue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
+func (m *EndMarker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedSubmitServer) GetQueues(req *StreamingQueueGetRequest, srv Submit_GetQueuesServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetQueues not implemented")
+func (m *EndMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndMarker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedSubmitServer) GetQueueInfo(ctx context.Context, req *QueueInfoRequest) (*QueueInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueueInfo not implemented")
+func (m *EndMarker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndMarker.Merge(m, src)
 }
-func (*UnimplementedSubmitServer) Health(ctx context.Context, req *types.Empty) (*HealthCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+func (m *EndMarker) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndMarker) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndMarker.DiscardUnknown(m)
 }
 
-func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
-	s.RegisterService(&_Submit_serviceDesc, srv)
+var xxx_messageInfo_EndMarker proto.InternalMessageInfo
+
+type StreamingQueueMessage struct {
+	// Types that are valid to be assigned to Event:
+	//	*StreamingQueueMessage_Queue
+	//	*StreamingQueueMessage_End
+	Event isStreamingQueueMessage_Event `protobuf_oneof:"event"`
 }
 
-func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobSubmitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).SubmitJobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/SubmitJobs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
+func (m *StreamingQueueMessage) Reset()      { *m = StreamingQueueMessage{} }
+func (*StreamingQueueMessage) ProtoMessage() {}
+func (*StreamingQueueMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{34}
+}
+func (m *StreamingQueueMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamingQueueMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamingQueueMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *StreamingQueueMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamingQueueMessage.Merge(m, src)
+}
+func (m *StreamingQueueMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamingQueueMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamingQueueMessage.DiscardUnknown(m)
 }
 
-func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CancelJobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CancelJobs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_StreamingQueueMessage proto.InternalMessageInfo
+
+type isStreamingQueueMessage_Event interface {
+	isStreamingQueueMessage_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func _Submit_CancelJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobSetCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CancelJobSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CancelJobSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CancelJobSet(ctx, req.(*JobSetCancelRequest))
+type StreamingQueueMessage_Queue struct {
+	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3,oneof" json:"queue,omitempty"`
+}
+type StreamingQueueMessage_End struct {
+	End *EndMarker `protobuf:"bytes,2,opt,name=end,proto3,oneof" json:"end,omitempty"`
+}
+
+func (*StreamingQueueMessage_Queue) isStreamingQueueMessage_Event() {}
+func (*StreamingQueueMessage_End) isStreamingQueueMessage_Event()   {}
+
+func (m *StreamingQueueMessage) GetEvent() isStreamingQueueMessage_Event {
+	if m != nil {
+		return m.Event
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobReprioritizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).ReprioritizeJobs(ctx, in)
+func (m *StreamingQueueMessage) GetQueue() *Queue {
+	if x, ok := m.GetEvent().(*StreamingQueueMessage_Queue); ok {
+		return x.Queue
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/ReprioritizeJobs",
+	return nil
+}
+
+func (m *StreamingQueueMessage) GetEnd() *EndMarker {
+	if x, ok := m.GetEvent().(*StreamingQueueMessage_End); ok {
+		return x.End
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).ReprioritizeJobs(ctx, req.(*JobReprioritizeRequest))
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamingQueueMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamingQueueMessage_Queue)(nil),
+		(*StreamingQueueMessage_End)(nil),
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterEnum("api.FailureCategory", FailureCategory_name, FailureCategory_value)
+	proto.RegisterEnum("api.IngressType", IngressType_name, IngressType_value)
+	proto.RegisterEnum("api.ServiceType", ServiceType_name, ServiceType_value)
+	proto.RegisterEnum("api.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("api.QueueState", QueueState_name, QueueState_value)
+	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
+	proto.RegisterType((*RetryPolicy)(nil), "api.RetryPolicy")
+	proto.RegisterType((*IngressConfig)(nil), "api.IngressConfig")
+	proto.RegisterMapType((map[string]string)(nil), "api.IngressConfig.AnnotationsEntry")
+	proto.RegisterType((*ServiceConfig)(nil), "api.ServiceConfig")
+	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
+	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
+	proto.RegisterType((*JobSetCancelRequest)(nil), "api.JobSetCancelRequest")
+	proto.RegisterType((*JobSetFilter)(nil), "api.JobSetFilter")
+	proto.RegisterType((*JobReprioritizeRequest)(nil), "api.JobReprioritizeRequest")
+	proto.RegisterType((*JobReprioritizeByFilterRequest)(nil), "api.JobReprioritizeByFilterRequest")
+	proto.RegisterType((*JobResubmitRequest)(nil), "api.JobResubmitRequest")
+	proto.RegisterType((*ResourceRecommendationRequest)(nil), "api.ResourceRecommendationRequest")
+	proto.RegisterType((*ResourceRecommendation)(nil), "api.ResourceRecommendation")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ResourceRecommendation.CurrentRequestsEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ResourceRecommendation.RequestsEntry")
+	proto.RegisterType((*JobSetSuspendRequest)(nil), "api.JobSetSuspendRequest")
+	proto.RegisterType((*JobSetResumeRequest)(nil), "api.JobSetResumeRequest")
+	proto.RegisterType((*JobReprioritizeResponse)(nil), "api.JobReprioritizeResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobReprioritizeResponse.ReprioritizationResultsEntry")
+	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
+	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
+	proto.RegisterType((*JobValidateResponseItem)(nil), "api.JobValidateResponseItem")
+	proto.RegisterType((*JobValidateResponse)(nil), "api.JobValidateResponse")
+	proto.RegisterType((*Queue)(nil), "api.Queue")
+	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
+	proto.RegisterType((*Queue_Permissions)(nil), "api.Queue.Permissions")
+	proto.RegisterType((*Queue_Permissions_Subject)(nil), "api.Queue.Permissions.Subject")
+	proto.RegisterType((*QueueList)(nil), "api.QueueList")
+	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
+	proto.RegisterType((*QueueGetRequest)(nil), "api.QueueGetRequest")
+	proto.RegisterType((*StreamingQueueGetRequest)(nil), "api.StreamingQueueGetRequest")
+	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
+	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
+	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
+	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
+	proto.RegisterType((*QueueUpdateResponse)(nil), "api.QueueUpdateResponse")
+	proto.RegisterType((*BatchQueueUpdateResponse)(nil), "api.BatchQueueUpdateResponse")
+	proto.RegisterType((*QueueCreateResponse)(nil), "api.QueueCreateResponse")
+	proto.RegisterType((*BatchQueueCreateResponse)(nil), "api.BatchQueueCreateResponse")
+	proto.RegisterType((*EndMarker)(nil), "api.EndMarker")
+	proto.RegisterType((*StreamingQueueMessage)(nil), "api.StreamingQueueMessage")
+}
+
+func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
+
+var fileDescriptor_e998bacb27df16c1 = []byte{
+	// 3356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0x47,
+	0x96, 0x57, 0x93, 0x12, 0x25, 0x3d, 0x8a, 0x12, 0x5d, 0xd6, 0x47, 0x8b, 0x96, 0x45, 0xa5, 0xbd,
+	0x49, 0x64, 0x21, 0xa6, 0x12, 0x25, 0xd9, 0xd8, 0x5e, 0x2f, 0x0c, 0x7d, 0xd0, 0xb6, 0xfc, 0x21,
+	0xc9, 0xa2, 0x95, 0x6c, 0x16, 0x8b, 0x65, 0x9a, 0xdd, 0x25, 0xaa, 0x25, 0xb2, 0x9b, 0xe9, 0x6e,
+	0xca, 0x56, 0x82, 0x00, 0x8b, 0x3d, 0xec, 0x62, 0x81, 0x3d, 0x04, 0x93, 0xdb, 0x0c, 0x06, 0x98,
+	0xc3, 0xcc, 0x25, 0xf3, 0x2f, 0xcc, 0x71, 0x0e, 0x39, 0xcc, 0x21, 0xc0, 0x5c, 0x82, 0x39, 0x10,
+	0x33, 0xce, 0x7c, 0x00, 0xbc, 0xcd, 0x79, 0xe6, 0x30, 0xa8, 0x57, 0xd5, 0xdd, 0xd5, 0x24, 0xf5,
+	0x65, 0xc0, 0x9e, 0xb9, 0xa9, 0x7f, 0xef, 0xfb, 0xd5, 0xab, 0x7a, 0xaf, 0x8a, 0x82, 0xf1, 0xc6,
+	0x41, 0x75, 0x51, 0x6f, 0x58, 0x8b, 0x5e, 0xb3, 0x52, 0xb7, 0xfc, 0x42, 0xc3, 0x75, 0x7c, 0x87,
+	0x24, 0xf5, 0x86, 0x95, 0xbb, 0x54, 0x75, 0x9c, 0x6a, 0x8d, 0x2e, 0x22, 0x54, 0x69, 0xee, 0x2e,
+	0xd2, 0x7a, 0xc3, 0x3f, 0xe2, 0x1c, 0x39, 0xed, 0xe0, 0xba, 0x57, 0xb0, 0x1c, 0x14, 0x35, 0x1c,
+	0x97, 0x2e, 0x1e, 0xbe, 0xb3, 0x58, 0xa5, 0x36, 0x75, 0x75, 0x9f, 0x9a, 0x82, 0xe7, 0xbd, 0x88,
+	0xa7, 0xae, 0x1b, 0x7b, 0x96, 0x4d, 0xdd, 0xa3, 0xc5, 0xc0, 0x9e, 0x4b, 0x3d, 0xa7, 0xe9, 0x1a,
+	0xb4, 0x4b, 0x6a, 0x46, 0x98, 0x65, 0x4c, 0xba, 0x6d, 0x3b, 0xbe, 0xee, 0x5b, 0x8e, 0xed, 0x09,
+	0xea, 0xb5, 0xaa, 0xe5, 0xef, 0x35, 0x2b, 0x05, 0xc3, 0xa9, 0x2f, 0x56, 0x9d, 0xaa, 0x13, 0x79,
+	0xc7, 0xbe, 0xf0, 0x03, 0xff, 0x12, 0xec, 0x61, 0x78, 0x7b, 0x54, 0xaf, 0xf9, 0x7b, 0x1c, 0xd5,
+	0xbe, 0x4e, 0xc3, 0xf8, 0x7d, 0xa7, 0x52, 0xc2, 0x90, 0xb7, 0xe9, 0xa7, 0x4d, 0xea, 0xf9, 0xeb,
+	0x3e, 0xad, 0x93, 0x25, 0x18, 0x6a, 0xb8, 0x96, 0xe3, 0x5a, 0xfe, 0x91, 0xaa, 0xcc, 0x29, 0xf3,
+	0xca, 0xca, 0x64, 0xbb, 0x95, 0x27, 0x01, 0xf6, 0x96, 0x53, 0xb7, 0x7c, 0xcc, 0xc2, 0x76, 0xc8,
+	0x47, 0xde, 0x87, 0x61, 0x5b, 0xaf, 0x53, 0xaf, 0xa1, 0x1b, 0x54, 0x4d, 0xce, 0x29, 0xf3, 0xc3,
+	0x2b, 0x53, 0xed, 0x56, 0xfe, 0x62, 0x08, 0x4a, 0x52, 0x11, 0x27, 0x79, 0x17, 0x86, 0x8d, 0x9a,
+	0x45, 0x6d, 0xbf, 0x6c, 0x99, 0xea, 0x10, 0x8a, 0xa1, 0x2d, 0x0e, 0xae, 0x9b, 0xb2, 0xad, 0x00,
+	0x23, 0x25, 0x48, 0xd5, 0xf4, 0x0a, 0xad, 0x79, 0x6a, 0xff, 0x5c, 0x72, 0x3e, 0xbd, 0xf4, 0x7a,
+	0x41, 0x6f, 0x58, 0x85, 0x5e, 0xa1, 0x14, 0x1e, 0x22, 0x5f, 0xd1, 0xf6, 0xdd, 0xa3, 0x95, 0xf1,
+	0x76, 0x2b, 0x9f, 0xe5, 0x82, 0x92, 0x5a, 0xa1, 0x8a, 0x54, 0x21, 0x2d, 0xe5, 0x59, 0x1d, 0x40,
+	0xcd, 0x0b, 0xc7, 0x6b, 0x5e, 0x8e, 0x98, 0xb9, 0xfa, 0xe9, 0x76, 0x2b, 0x3f, 0x21, 0xa9, 0x90,
+	0x6c, 0xc8, 0x9a, 0xc9, 0xff, 0x2a, 0x30, 0xee, 0xd2, 0x4f, 0x9b, 0x96, 0x4b, 0xcd, 0xb2, 0xed,
+	0x98, 0xb4, 0x2c, 0x82, 0x49, 0xa1, 0xc9, 0x77, 0x8e, 0x37, 0xb9, 0x2d, 0xa4, 0x36, 0x1c, 0x93,
+	0xca, 0x81, 0x69, 0xed, 0x56, 0x7e, 0xc6, 0xed, 0x22, 0x46, 0x0e, 0xa8, 0xca, 0x36, 0xe9, 0xa6,
+	0x93, 0x4d, 0x18, 0x6a, 0x38, 0x66, 0xd9, 0x6b, 0x50, 0x43, 0x4d, 0xcc, 0x29, 0xf3, 0xe9, 0xa5,
+	0x4b, 0x05, 0x5e, 0xac, 0xe8, 0x03, 0x2b, 0xe8, 0xc2, 0xe1, 0x3b, 0x85, 0x2d, 0xc7, 0x2c, 0x35,
+	0xa8, 0x81, 0xeb, 0x79, 0xa1, 0xc1, 0x3f, 0x62, 0xba, 0x07, 0x05, 0x48, 0xb6, 0x60, 0x38, 0x50,
+	0xe8, 0xa9, 0x83, 0x73, 0xc9, 0xd3, 0x34, 0xf2, 0xb2, 0xe2, 0x1f, 0x5e, 0xac, 0xac, 0x04, 0x46,
+	0x56, 0x61, 0xd0, 0xb2, 0xab, 0x2e, 0xf5, 0x3c, 0x75, 0x18, 0xf5, 0x11, 0x54, 0xb4, 0xce, 0xb1,
+	0x55, 0xc7, 0xde, 0xb5, 0xaa, 0x2b, 0x13, 0xcc, 0x31, 0xc1, 0x26, 0x69, 0x09, 0x24, 0xc9, 0x1d,
+	0x18, 0xf2, 0xa8, 0x7b, 0x68, 0x19, 0xd4, 0x53, 0x41, 0xd2, 0x52, 0xe2, 0xa0, 0xd0, 0x82, 0xce,
+	0x04, 0x7c, 0xb2, 0x33, 0x01, 0xc6, 0x6a, 0xdc, 0x33, 0xf6, 0xa8, 0xd9, 0xac, 0x51, 0x57, 0x4d,
+	0x47, 0x35, 0x1e, 0x82, 0x72, 0x8d, 0x87, 0x20, 0x59, 0x87, 0x0b, 0x9f, 0x36, 0x69, 0x93, 0x96,
+	0x7d, 0xbf, 0x56, 0xf6, 0xa8, 0xe1, 0xd8, 0xa6, 0xa7, 0x8e, 0xcc, 0x29, 0xf3, 0xc9, 0x95, 0xcb,
+	0xed, 0x56, 0x7e, 0x1a, 0x89, 0x4f, 0xfc, 0x5a, 0x89, 0x93, 0x24, 0x25, 0x63, 0x1d, 0x24, 0xf2,
+	0xcf, 0x00, 0x26, 0x6d, 0x50, 0xdb, 0xf4, 0xca, 0x8e, 0xad, 0x66, 0xe6, 0x92, 0x81, 0x0b, 0x02,
+	0xdd, 0xb4, 0x65, 0x17, 0x42, 0x90, 0xc9, 0xe9, 0xae, 0xab, 0x1f, 0x95, 0x3d, 0xeb, 0x33, 0xaa,
+	0x8e, 0xce, 0x29, 0xf3, 0x19, 0x2e, 0x87, 0x68, 0xc9, 0xfa, 0x2c, 0xb6, 0x3d, 0x43, 0x90, 0x6c,
+	0xc0, 0x88, 0x4b, 0x7d, 0xf7, 0xa8, 0xdc, 0x70, 0x6a, 0x96, 0x71, 0xa4, 0x8e, 0x61, 0x95, 0x64,
+	0x31, 0x7b, 0xdb, 0x8c, 0xb0, 0x85, 0x38, 0xaf, 0x7d, 0x37, 0x02, 0xe4, 0xda, 0x97, 0xe0, 0x9c,
+	0x0e, 0x69, 0xa9, 0x70, 0xc9, 0x15, 0x48, 0x1e, 0x50, 0x7e, 0xc6, 0x0c, 0xaf, 0x5c, 0x68, 0xb7,
+	0xf2, 0x99, 0x03, 0x2a, 0xcb, 0x32, 0x2a, 0xb9, 0x0a, 0x03, 0x87, 0x7a, 0xad, 0x49, 0xb1, 0x44,
+	0x87, 0x57, 0x2e, 0xb6, 0x5b, 0xf9, 0x31, 0x04, 0x24, 0x46, 0xce, 0x71, 0x33, 0x71, 0x5d, 0xc9,
+	0xed, 0x42, 0xb6, 0x73, 0x6b, 0xbe, 0x14, 0x3b, 0x75, 0x98, 0x3a, 0x66, 0x3f, 0xbe, 0x0c, 0x73,
+	0x5a, 0x2b, 0x01, 0x69, 0x29, 0xe3, 0xe4, 0x16, 0x8c, 0xd4, 0xf5, 0x67, 0x65, 0xdd, 0x47, 0x56,
+	0x0f, 0x8d, 0x65, 0xf8, 0x3a, 0xd4, 0xf5, 0x67, 0xcb, 0x02, 0x96, 0xd7, 0x41, 0x82, 0x49, 0x11,
+	0xc6, 0x2a, 0xba, 0x71, 0xe0, 0xec, 0xee, 0x86, 0x05, 0x99, 0x40, 0x05, 0x33, 0xed, 0x56, 0x5e,
+	0x15, 0xa4, 0xee, 0x7a, 0x1c, 0x8d, 0x53, 0xc8, 0x23, 0xb8, 0xc8, 0xcb, 0xc3, 0xb1, 0xcb, 0xf4,
+	0x99, 0xe5, 0x97, 0x0d, 0xc7, 0xa4, 0x9e, 0x9a, 0x9c, 0x4b, 0xce, 0x0f, 0xac, 0xcc, 0xb6, 0x5b,
+	0xf9, 0x1c, 0x92, 0x37, 0xed, 0xe2, 0x33, 0xcb, 0x5f, 0x65, 0x34, 0x49, 0x59, 0xb6, 0x93, 0x46,
+	0x3e, 0x87, 0x4b, 0xa1, 0xba, 0x5d, 0xdd, 0xaa, 0x35, 0x5d, 0x5a, 0x36, 0x74, 0x9f, 0x56, 0x1d,
+	0xd7, 0xa2, 0xfc, 0xb0, 0x1f, 0x5d, 0x1a, 0xc7, 0xe2, 0xbb, 0xc3, 0xc9, 0xab, 0x9c, 0x7a, 0xb4,
+	0xf2, 0x46, 0xbb, 0x95, 0xd7, 0x84, 0xc2, 0x38, 0xcd, 0x8a, 0x19, 0x55, 0x8f, 0xe3, 0xd1, 0xfe,
+	0x9c, 0x84, 0x4c, 0xec, 0x58, 0x21, 0x37, 0xa1, 0xdf, 0x3f, 0x6a, 0x50, 0x4c, 0xed, 0xa8, 0x28,
+	0x7a, 0xc1, 0xf1, 0xe4, 0xa8, 0x41, 0xb1, 0x9f, 0x8c, 0x32, 0x8e, 0xd8, 0x61, 0x88, 0x32, 0x6c,
+	0x75, 0x1b, 0x8e, 0xeb, 0xb3, 0xb4, 0x26, 0xe7, 0x33, 0x7c, 0x75, 0x11, 0x90, 0x57, 0x17, 0x01,
+	0xf2, 0x49, 0xbc, 0xf1, 0x24, 0xf1, 0x80, 0xba, 0xd2, 0x7d, 0xcc, 0xbd, 0x78, 0xc7, 0xb9, 0x01,
+	0x69, 0xbf, 0xe6, 0x95, 0xa9, 0xad, 0x57, 0x6a, 0xd4, 0x54, 0xfb, 0xe7, 0x94, 0xf9, 0xa1, 0x15,
+	0xb5, 0xdd, 0xca, 0x8f, 0xfb, 0xac, 0x64, 0x11, 0x95, 0x64, 0x21, 0x42, 0xb1, 0x3f, 0x53, 0xd7,
+	0x2f, 0xb3, 0x8e, 0xad, 0x0e, 0x48, 0xfd, 0x99, 0xba, 0xfe, 0x86, 0x5e, 0xa7, 0xb1, 0xfe, 0x2c,
+	0x30, 0x72, 0x1b, 0x32, 0x4d, 0x8f, 0x96, 0x8d, 0x5a, 0xd3, 0xf3, 0xa9, 0xbb, 0xbe, 0xa5, 0xa6,
+	0xd0, 0x62, 0xae, 0xdd, 0xca, 0x4f, 0x36, 0x3d, 0xba, 0x1a, 0xe0, 0x92, 0xf0, 0x88, 0x8c, 0xbf,
+	0xaa, 0x3d, 0xac, 0xf9, 0x90, 0x89, 0xf5, 0x00, 0x72, 0xbd, 0xc7, 0x92, 0x0b, 0x0e, 0x5c, 0x72,
+	0xd2, 0xbd, 0xe4, 0xe7, 0x5e, 0x70, 0xed, 0x27, 0x09, 0xc8, 0x76, 0xf6, 0x77, 0x26, 0x8f, 0x87,
+	0xbd, 0x08, 0x10, 0xe5, 0x11, 0x90, 0xe5, 0x11, 0x20, 0xef, 0x01, 0xec, 0x3b, 0x95, 0xb2, 0x47,
+	0x71, 0x68, 0x4a, 0x44, 0x8b, 0xb2, 0xef, 0x54, 0x4a, 0xb4, 0x63, 0x68, 0x0a, 0x30, 0x62, 0xc2,
+	0x05, 0x26, 0xe5, 0x72, 0x7b, 0x65, 0xc6, 0x10, 0x14, 0xdb, 0xf4, 0xb1, 0x23, 0x07, 0x6f, 0x50,
+	0xfb, 0x4e, 0x45, 0xc2, 0x62, 0x0d, 0xaa, 0x83, 0xc4, 0x0e, 0x16, 0xcb, 0xa4, 0xf5, 0x86, 0xe3,
+	0x53, 0xdb, 0x38, 0x2a, 0xb3, 0x15, 0xeb, 0x47, 0x07, 0xf1, 0x60, 0x91, 0x48, 0x0f, 0x62, 0x8b,
+	0x37, 0x1a, 0xa7, 0x68, 0x7f, 0x55, 0x30, 0x45, 0xab, 0xba, 0x6d, 0xd0, 0x5a, 0x90, 0xa2, 0x05,
+	0x48, 0xb1, 0x08, 0x2c, 0x53, 0xce, 0xd1, 0xbe, 0x53, 0x89, 0x05, 0x3c, 0x80, 0xc0, 0x0b, 0xe6,
+	0x28, 0x5c, 0x84, 0xe4, 0xa9, 0x8b, 0x70, 0x0d, 0x06, 0xb9, 0x33, 0xfc, 0x5c, 0x1a, 0xe6, 0xd3,
+	0x25, 0x1a, 0x8f, 0x4d, 0x97, 0x1c, 0x21, 0x6f, 0x41, 0xca, 0xa5, 0xba, 0xe7, 0xd8, 0x62, 0x13,
+	0x21, 0x37, 0x47, 0x64, 0x6e, 0x8e, 0x68, 0x7f, 0x50, 0xe0, 0xe2, 0x7d, 0x74, 0x2a, 0x9e, 0x81,
+	0x78, 0x54, 0xca, 0x79, 0xa3, 0x4a, 0x9c, 0x1a, 0xd5, 0x6d, 0x48, 0xed, 0x5a, 0x35, 0x9f, 0xba,
+	0x98, 0x81, 0xf4, 0xd2, 0x85, 0xb0, 0x32, 0xa8, 0x7f, 0x07, 0x09, 0xdc, 0x73, 0xce, 0x24, 0x7b,
+	0xce, 0x11, 0x29, 0xce, 0xfe, 0x33, 0xc4, 0xf9, 0x00, 0x46, 0x64, 0xdd, 0xe4, 0x5f, 0x20, 0xe5,
+	0xf9, 0xba, 0x4f, 0x59, 0x3b, 0x63, 0x67, 0x7d, 0x26, 0x34, 0xcf, 0x50, 0xae, 0x8c, 0x33, 0xc8,
+	0xca, 0x38, 0xa2, 0xfd, 0x51, 0x81, 0xc9, 0xfb, 0xac, 0x1c, 0xc5, 0x9d, 0xc4, 0xfa, 0x8c, 0x06,
+	0x79, 0x93, 0x16, 0x4b, 0x39, 0xc3, 0x62, 0xbd, 0xf4, 0xe2, 0xb9, 0x05, 0x23, 0x36, 0x7d, 0x5a,
+	0x0e, 0x2f, 0x59, 0xfd, 0x78, 0xc9, 0xc2, 0xe3, 0xdc, 0xa6, 0x4f, 0xb7, 0xba, 0xef, 0x59, 0x69,
+	0x09, 0xd6, 0x7e, 0x99, 0x80, 0xd9, 0x8e, 0x40, 0x57, 0x8e, 0x78, 0x06, 0x5f, 0xd9, 0x69, 0xb2,
+	0x02, 0xa3, 0x78, 0x6b, 0x29, 0x7b, 0xb4, 0x46, 0x0d, 0xdf, 0x71, 0x45, 0xd4, 0x97, 0xda, 0xad,
+	0xfc, 0x14, 0x52, 0x4a, 0x82, 0x20, 0x89, 0x67, 0x62, 0x04, 0xa9, 0xd8, 0xfa, 0x5f, 0xac, 0xd8,
+	0x3a, 0xd3, 0x38, 0x70, 0xae, 0x34, 0xfe, 0x54, 0x01, 0x82, 0x69, 0xf4, 0x5e, 0xed, 0x41, 0x2c,
+	0x15, 0x63, 0xf2, 0xf4, 0x62, 0xd4, 0xfe, 0xa2, 0xc0, 0xe5, 0x6d, 0xf1, 0x4a, 0xb0, 0x4d, 0x0d,
+	0xa7, 0x5e, 0xa7, 0xb6, 0x89, 0x8d, 0xf1, 0x95, 0x79, 0x7c, 0x1b, 0x32, 0x0c, 0xab, 0xe9, 0x3e,
+	0xe5, 0x83, 0x00, 0x5f, 0x6b, 0xec, 0xe7, 0x01, 0xa1, 0x63, 0x18, 0x18, 0x91, 0x71, 0x72, 0x1d,
+	0xa0, 0x41, 0x5d, 0x83, 0xda, 0xbe, 0x55, 0xa3, 0xa2, 0xda, 0x71, 0xfe, 0x88, 0x50, 0x49, 0x56,
+	0xe2, 0xd5, 0x7e, 0xd5, 0x0f, 0x93, 0xbd, 0xa3, 0x27, 0x1f, 0xc1, 0x90, 0x68, 0x66, 0x7c, 0x57,
+	0xa7, 0x97, 0xae, 0x8a, 0x7b, 0x49, 0x2f, 0xf6, 0x82, 0xc8, 0x96, 0x18, 0x9d, 0xb2, 0xdf, 0xb4,
+	0xf2, 0x7d, 0xed, 0x56, 0x3e, 0x54, 0xb1, 0x1d, 0xfe, 0x45, 0x7c, 0xc8, 0x1a, 0x4d, 0xd7, 0x65,
+	0x8f, 0x12, 0xa1, 0x81, 0x04, 0x1a, 0x78, 0xfb, 0x24, 0x03, 0xab, 0x5c, 0x26, 0x6e, 0x67, 0x4a,
+	0xd8, 0x19, 0x33, 0xe2, 0xd4, 0xed, 0x4e, 0x80, 0xbc, 0x0d, 0x43, 0x76, 0xb3, 0x5e, 0xde, 0x77,
+	0x2a, 0x1e, 0xe6, 0x77, 0x80, 0x5f, 0x6b, 0xed, 0x66, 0xfd, 0xbe, 0x53, 0x89, 0x5d, 0x6b, 0x05,
+	0x94, 0xfb, 0x4a, 0x81, 0x4c, 0xcc, 0xda, 0xd9, 0x66, 0xa4, 0x8f, 0xe5, 0x19, 0x29, 0xbd, 0x54,
+	0x90, 0x2e, 0xe8, 0xe1, 0xfb, 0x54, 0xa1, 0x71, 0x50, 0xc5, 0x58, 0x83, 0xf7, 0xa9, 0xc2, 0xe3,
+	0xa6, 0x6e, 0xfb, 0x96, 0x7f, 0x74, 0xea, 0xbd, 0xe8, 0x87, 0x0a, 0x8c, 0xf7, 0x4a, 0xc5, 0x3f,
+	0x82, 0x73, 0xda, 0xcf, 0x14, 0xfe, 0xe4, 0x45, 0xfd, 0x52, 0xd3, 0x63, 0x97, 0xe3, 0x57, 0xb6,
+	0x87, 0xa2, 0xc6, 0x98, 0x3c, 0x43, 0x63, 0x3c, 0x0c, 0xfa, 0x3f, 0x3b, 0x9d, 0xea, 0xf4, 0x55,
+	0x79, 0xa9, 0xfd, 0x3c, 0x01, 0x53, 0x5d, 0x3d, 0xd4, 0x6b, 0x38, 0xb6, 0x47, 0xc9, 0x8f, 0x14,
+	0x50, 0xdd, 0x88, 0x80, 0x45, 0x5f, 0x76, 0xa9, 0xd7, 0xac, 0x85, 0x1b, 0xf0, 0x46, 0x70, 0x82,
+	0xf7, 0x52, 0x50, 0xd8, 0xee, 0x10, 0xde, 0xe6, 0xb2, 0x7c, 0xa3, 0xbc, 0xde, 0x6e, 0xe5, 0x5f,
+	0x73, 0x7b, 0x73, 0x48, 0xae, 0x4e, 0x1d, 0xc3, 0x92, 0x73, 0x61, 0xe6, 0x24, 0xfd, 0x2f, 0xe5,
+	0xfa, 0xf0, 0x83, 0x24, 0x4c, 0x48, 0x53, 0x33, 0x0f, 0x13, 0x5f, 0x50, 0xcf, 0x33, 0xaa, 0x5e,
+	0x85, 0x01, 0xea, 0xba, 0x8e, 0x2b, 0x1b, 0x45, 0x40, 0x66, 0x45, 0x80, 0x9d, 0x11, 0xfc, 0x19,
+	0xc7, 0x32, 0x45, 0x19, 0xe1, 0x19, 0x81, 0x58, 0x4c, 0xf5, 0xa0, 0x80, 0xc8, 0xbf, 0x42, 0x86,
+	0x4b, 0xc4, 0x87, 0x55, 0x7e, 0x73, 0x64, 0x84, 0xfb, 0x9d, 0x7d, 0x27, 0x2d, 0xc1, 0x64, 0x0d,
+	0xb2, 0xf5, 0x66, 0xcd, 0xb7, 0xca, 0xec, 0x59, 0x4f, 0x44, 0x34, 0x10, 0x35, 0x7a, 0xa4, 0x6d,
+	0x39, 0xe6, 0xfd, 0x8e, 0xc8, 0x32, 0x31, 0x02, 0xf9, 0x00, 0xd2, 0x91, 0x3c, 0x7f, 0xe7, 0x14,
+	0xcf, 0x56, 0x0d, 0xc7, 0xec, 0x72, 0x60, 0x38, 0x04, 0xd9, 0x43, 0xf4, 0x53, 0xdd, 0xb5, 0x2d,
+	0xbb, 0xca, 0x9f, 0x13, 0x45, 0x09, 0x07, 0x98, 0x5c, 0xc2, 0x01, 0xa6, 0x7d, 0x01, 0x17, 0xba,
+	0xd6, 0x84, 0xec, 0x01, 0xe1, 0x97, 0x1f, 0xfe, 0x2d, 0x6e, 0x3f, 0xbc, 0x68, 0x73, 0x9d, 0xb7,
+	0x9f, 0x68, 0x1d, 0xf9, 0x1b, 0x06, 0xde, 0x71, 0x22, 0x30, 0xf6, 0x86, 0xd1, 0x49, 0xd3, 0xee,
+	0xe2, 0x06, 0xfa, 0x50, 0xaf, 0x59, 0xa6, 0xee, 0xd3, 0x58, 0x51, 0xbc, 0x05, 0x29, 0x5c, 0xc6,
+	0xd8, 0x10, 0xca, 0x11, 0xf9, 0x08, 0xe0, 0x88, 0xf6, 0x1b, 0x7e, 0x07, 0xe8, 0xd4, 0x24, 0x6a,
+	0x54, 0x54, 0xd6, 0x50, 0x58, 0xa3, 0x96, 0xd9, 0x51, 0xa3, 0x96, 0x29, 0x19, 0x4c, 0x9c, 0x6e,
+	0x90, 0xec, 0xf7, 0xcc, 0x11, 0xbf, 0x21, 0xce, 0x04, 0x39, 0xea, 0x15, 0xd8, 0x0b, 0x64, 0xe9,
+	0x17, 0x29, 0x18, 0x78, 0x8c, 0xe7, 0xd4, 0x1b, 0xd0, 0x8f, 0x23, 0x05, 0xdf, 0x27, 0x78, 0xbf,
+	0xb6, 0xe3, 0xa3, 0x04, 0xd2, 0xd9, 0xc5, 0x32, 0x98, 0xf3, 0xca, 0xbb, 0xba, 0xe1, 0x8b, 0xfd,
+	0xa2, 0xf0, 0x8b, 0x65, 0x40, 0xba, 0xa3, 0x77, 0x8c, 0x9c, 0xa3, 0x71, 0x0a, 0x7b, 0x0a, 0x69,
+	0x7a, 0xd4, 0x2d, 0x3b, 0x4f, 0x6d, 0xea, 0x06, 0x03, 0x18, 0x8e, 0x22, 0x0c, 0xde, 0x44, 0x54,
+	0x12, 0x87, 0x08, 0x65, 0xd3, 0x66, 0xd5, 0x75, 0x9a, 0x8d, 0x40, 0x56, 0xda, 0x49, 0x88, 0x77,
+	0x09, 0xa7, 0x25, 0x98, 0x50, 0x18, 0x0b, 0xda, 0x55, 0xb9, 0x66, 0xd5, 0x2d, 0x3f, 0xf8, 0x89,
+	0x61, 0x16, 0x53, 0x8b, 0xc9, 0x08, 0x27, 0x8b, 0x87, 0xc8, 0xc0, 0x0f, 0x46, 0x8c, 0xcf, 0x8d,
+	0x11, 0xe4, 0xf8, 0xe2, 0x14, 0x52, 0x82, 0x74, 0x83, 0xba, 0x75, 0xcb, 0xf3, 0xf0, 0x31, 0x89,
+	0xff, 0xa4, 0x30, 0x29, 0x99, 0xd8, 0x8a, 0xa8, 0xdc, 0x77, 0x89, 0x5d, 0xf6, 0x5d, 0x82, 0xc9,
+	0x4d, 0x18, 0xc0, 0x3b, 0x96, 0x3a, 0x88, 0xcf, 0x22, 0x63, 0x91, 0x3a, 0x7e, 0x2f, 0xc3, 0x1a,
+	0x44, 0x0e, 0xb9, 0x06, 0x11, 0xc8, 0xfd, 0x49, 0x81, 0xb4, 0x64, 0x93, 0x6c, 0xc3, 0x90, 0xd7,
+	0xac, 0xec, 0x53, 0x23, 0x6c, 0x1a, 0xb3, 0xbd, 0xbd, 0x2b, 0x94, 0x38, 0x9b, 0x78, 0x97, 0x17,
+	0x32, 0xb1, 0x77, 0x79, 0x81, 0xe1, 0x96, 0xa0, 0x6e, 0x25, 0x28, 0x73, 0xbe, 0x25, 0x18, 0x10,
+	0xdb, 0x12, 0x0c, 0xc8, 0x7d, 0x0c, 0x83, 0x42, 0x2f, 0xab, 0xbc, 0x03, 0xcb, 0x36, 0xe5, 0xca,
+	0x63, 0xdf, 0x72, 0xe5, 0xb1, 0xef, 0xb0, 0x42, 0x13, 0x27, 0x57, 0x68, 0xce, 0x82, 0x8b, 0x3d,
+	0xd6, 0xef, 0x05, 0x1a, 0x8f, 0x72, 0x6a, 0xe3, 0x29, 0xc2, 0x30, 0xe6, 0xeb, 0xa1, 0xe5, 0xf9,
+	0xe4, 0x3a, 0xa4, 0xb0, 0xe5, 0x07, 0xf9, 0x84, 0x28, 0x9f, 0x7c, 0xc7, 0x73, 0xaa, 0xbc, 0xe3,
+	0x39, 0xa2, 0xed, 0x00, 0xe1, 0xef, 0x0b, 0x35, 0xa9, 0x5f, 0xb2, 0x69, 0xdf, 0xe0, 0x28, 0x35,
+	0xa5, 0x2b, 0x33, 0x4e, 0xfb, 0x21, 0x21, 0x7e, 0x64, 0x8f, 0xc8, 0xb8, 0x76, 0x03, 0xc6, 0xd0,
+	0xfa, 0x5d, 0x1a, 0x5e, 0xaa, 0xce, 0xb8, 0xcb, 0xb5, 0xdb, 0xa0, 0x96, 0x7c, 0x97, 0xea, 0x75,
+	0xcb, 0xae, 0x76, 0xea, 0xb8, 0x02, 0x49, 0xbb, 0x59, 0x17, 0x0f, 0xdd, 0x98, 0x48, 0xbb, 0x59,
+	0x97, 0x13, 0x69, 0x37, 0xeb, 0xda, 0x4d, 0xc8, 0xa2, 0xdc, 0xba, 0xbd, 0xeb, 0x9c, 0xd7, 0xf8,
+	0x2d, 0x20, 0x28, 0xbb, 0x46, 0x6b, 0xd4, 0xa7, 0xe7, 0x95, 0xfe, 0x3f, 0x05, 0x86, 0x43, 0xd3,
+	0x67, 0x3e, 0xd6, 0x9e, 0xc0, 0x98, 0x6e, 0xf8, 0xd6, 0x21, 0x2d, 0x8b, 0x69, 0x2d, 0xb8, 0x6a,
+	0x8c, 0x49, 0x97, 0x61, 0xa6, 0x91, 0x37, 0x5c, 0xce, 0xcb, 0x51, 0x79, 0x01, 0x32, 0x31, 0x82,
+	0xf6, 0xb5, 0x02, 0x10, 0x89, 0x9e, 0xd9, 0x99, 0x1b, 0x90, 0xc6, 0xca, 0x30, 0xf9, 0x2d, 0x24,
+	0x81, 0xb7, 0x10, 0x3c, 0x1c, 0x39, 0xdc, 0x71, 0x11, 0x81, 0x08, 0x65, 0xa2, 0x35, 0xaa, 0x7b,
+	0x81, 0x68, 0x32, 0x12, 0xe5, 0x70, 0xa7, 0x68, 0x84, 0x6a, 0x4f, 0xe1, 0x22, 0xe6, 0x6d, 0xa7,
+	0x11, 0xeb, 0x73, 0xef, 0xcb, 0xb3, 0x6e, 0xbc, 0xaa, 0x4f, 0x9a, 0x7b, 0xcf, 0x3e, 0x4d, 0x69,
+	0x4d, 0x50, 0x57, 0x74, 0xdf, 0xd8, 0xeb, 0x65, 0xfd, 0x63, 0xc8, 0xb0, 0x5f, 0x20, 0xa8, 0x59,
+	0x8e, 0xed, 0x2d, 0x35, 0xf2, 0x22, 0x2e, 0xc0, 0xb7, 0x07, 0x17, 0x79, 0xdc, 0xb9, 0xdf, 0x46,
+	0x64, 0x3c, 0x8c, 0x77, 0xd5, 0xa5, 0x7f, 0xc7, 0x78, 0x3b, 0xac, 0x9f, 0x1e, 0x6f, 0x5c, 0xe0,
+	0x1c, 0xf1, 0xa6, 0x61, 0xb8, 0x68, 0x9b, 0x8f, 0x74, 0xf7, 0x80, 0xba, 0xda, 0x97, 0x0a, 0x4c,
+	0xc4, 0x77, 0xf8, 0x23, 0xea, 0x79, 0x7a, 0x95, 0x92, 0x0f, 0xce, 0x17, 0xff, 0xbd, 0xbe, 0x20,
+	0x03, 0xef, 0x43, 0x92, 0xda, 0xa6, 0xb8, 0x30, 0x8e, 0xa2, 0x58, 0x68, 0x8f, 0x9f, 0x13, 0x54,
+	0x3e, 0xd5, 0xef, 0xf5, 0x6d, 0x33, 0xfe, 0x95, 0x41, 0x18, 0xa0, 0x87, 0xd4, 0xf6, 0x17, 0x0e,
+	0x61, 0xac, 0xe3, 0xd7, 0x24, 0x92, 0x83, 0xc9, 0x1d, 0xfb, 0xc0, 0x76, 0x9e, 0x76, 0xfc, 0x4e,
+	0x74, 0x94, 0xed, 0x23, 0x19, 0x18, 0xde, 0xdc, 0x7c, 0xf4, 0xc0, 0x62, 0xa7, 0x5d, 0x56, 0x61,
+	0x9f, 0xeb, 0x75, 0xbd, 0x4a, 0xb7, 0x9a, 0xb5, 0x5a, 0x36, 0x41, 0x46, 0x60, 0x08, 0x7f, 0x0d,
+	0x74, 0x3c, 0x3f, 0x9b, 0x64, 0xc4, 0x1d, 0x8f, 0xba, 0x45, 0x96, 0xfe, 0x6c, 0x3f, 0x23, 0xae,
+	0x51, 0xdd, 0xac, 0x59, 0x36, 0xcd, 0x0e, 0x2c, 0xe4, 0x20, 0x2d, 0xfd, 0x9a, 0x44, 0xd2, 0x30,
+	0x28, 0x3e, 0xb3, 0x7d, 0x0b, 0x57, 0x21, 0x2d, 0xfd, 0xec, 0x10, 0x68, 0xdd, 0x72, 0x5c, 0x3f,
+	0xdb, 0xc7, 0xbe, 0xee, 0x31, 0x35, 0x8c, 0x55, 0x59, 0xa8, 0xc2, 0x50, 0xf0, 0x40, 0x4a, 0x00,
+	0x52, 0x8f, 0x77, 0x8a, 0x3b, 0xc5, 0xb5, 0x6c, 0x1f, 0xd3, 0xb7, 0x55, 0xdc, 0x58, 0x5b, 0xdf,
+	0xb8, 0x9b, 0x55, 0xd8, 0xc7, 0xf6, 0xce, 0xc6, 0x06, 0xfb, 0x48, 0x30, 0xaf, 0x4a, 0x3b, 0xab,
+	0xab, 0xc5, 0xe2, 0x5a, 0x71, 0x2d, 0x9b, 0x64, 0x42, 0x77, 0x96, 0xd7, 0x1f, 0x16, 0xd7, 0xb2,
+	0xfd, 0x8c, 0x6f, 0x67, 0xe3, 0xc1, 0xc6, 0xe6, 0x47, 0x1b, 0xd9, 0x01, 0xc6, 0xb7, 0xba, 0xbc,
+	0xb1, 0x5a, 0x7c, 0xc8, 0x68, 0xa9, 0x85, 0x5b, 0x00, 0x51, 0xcf, 0x27, 0x43, 0xd0, 0xbf, 0xb9,
+	0x55, 0xdc, 0xc8, 0xf6, 0x31, 0xf9, 0xad, 0xe5, 0x9d, 0x52, 0x71, 0x2d, 0xab, 0x60, 0x84, 0xdb,
+	0xcb, 0xeb, 0xc2, 0x10, 0x40, 0x6a, 0xf5, 0xe1, 0x26, 0xa3, 0x24, 0x97, 0x7e, 0x3c, 0x0a, 0x29,
+	0x3e, 0x60, 0x93, 0x0f, 0x01, 0xf8, 0x5f, 0x78, 0x72, 0x4c, 0xf4, 0xfc, 0xf1, 0x21, 0x37, 0xd9,
+	0x7b, 0x2a, 0xd7, 0xa6, 0xff, 0xfb, 0xd7, 0xbf, 0xff, 0x2a, 0x71, 0x51, 0x1b, 0x65, 0xff, 0x6a,
+	0xb3, 0xef, 0x54, 0xc4, 0x7f, 0xec, 0xdc, 0x54, 0x16, 0xc8, 0x7f, 0xc0, 0x48, 0x30, 0x9e, 0x9e,
+	0xa4, 0x59, 0x3d, 0x6e, 0x96, 0xd5, 0x2e, 0xa1, 0xee, 0x09, 0x2d, 0x1b, 0xe8, 0x3e, 0x14, 0x1c,
+	0x4c, 0xfb, 0x47, 0x00, 0xbc, 0x59, 0xc6, 0x75, 0xc7, 0x1e, 0xe8, 0x73, 0x53, 0x08, 0x77, 0x37,
+	0xd5, 0x6e, 0xb7, 0x79, 0xc7, 0x64, 0x8a, 0xff, 0x13, 0x46, 0x42, 0xc5, 0x25, 0xea, 0x13, 0x55,
+	0x3a, 0xf9, 0xe3, 0xda, 0x27, 0x0b, 0xfc, 0x9f, 0x82, 0x0a, 0xc1, 0x7f, 0xfb, 0x14, 0x8a, 0xac,
+	0xa2, 0xb5, 0x19, 0x54, 0x3e, 0xa9, 0x5d, 0x10, 0xca, 0x3d, 0xea, 0x4b, 0xfa, 0x75, 0xc8, 0x88,
+	0xc7, 0x0e, 0x61, 0x60, 0x5a, 0x32, 0x10, 0x7f, 0x06, 0x39, 0xd6, 0xc2, 0x65, 0xb4, 0x30, 0x75,
+	0x53, 0x59, 0xd0, 0x88, 0x64, 0xc4, 0xe3, 0xd2, 0x2c, 0x04, 0xfe, 0x50, 0xd1, 0x23, 0x84, 0xd8,
+	0x0b, 0xc6, 0x69, 0x21, 0x30, 0x03, 0x72, 0x14, 0x2e, 0x0a, 0x13, 0x1b, 0xb2, 0xf2, 0x8b, 0x02,
+	0xae, 0xc0, 0xa5, 0xde, 0x6f, 0x0d, 0xdc, 0xcc, 0xcc, 0x49, 0x0f, 0x11, 0x5a, 0x1e, 0x8d, 0x4d,
+	0x6b, 0xe3, 0xc1, 0x62, 0x48, 0x8f, 0x0a, 0xb8, 0xd6, 0xff, 0xa3, 0x80, 0xda, 0x69, 0x30, 0x78,
+	0x62, 0x27, 0x57, 0x7a, 0xe9, 0xee, 0x78, 0x80, 0x3f, 0xc5, 0x81, 0x37, 0xd1, 0x81, 0xd7, 0xb4,
+	0x99, 0x5e, 0x0e, 0x04, 0xaa, 0x44, 0x49, 0x07, 0xef, 0xd3, 0x18, 0xf4, 0x54, 0xa4, 0xd6, 0x3b,
+	0xd3, 0x76, 0xe9, 0x2a, 0x69, 0x97, 0x46, 0x1b, 0xe6, 0xff, 0x15, 0x98, 0xc6, 0x01, 0xab, 0xe7,
+	0xfb, 0xaa, 0x76, 0xc2, 0x63, 0x67, 0x60, 0xf6, 0xd2, 0x09, 0x3c, 0x5a, 0x01, 0x6d, 0xcf, 0x6b,
+	0x57, 0x84, 0xed, 0x6b, 0x62, 0x45, 0x91, 0xf7, 0x9a, 0x1b, 0x63, 0x66, 0xee, 0xdc, 0x85, 0x34,
+	0x6f, 0x32, 0xfc, 0x66, 0x28, 0x75, 0x80, 0x63, 0xcb, 0x66, 0x1c, 0x4d, 0x8c, 0x6a, 0xc3, 0xcc,
+	0x04, 0xb6, 0x03, 0xa6, 0xc8, 0x80, 0x11, 0x49, 0x91, 0x47, 0x46, 0x23, 0x4d, 0x6c, 0x62, 0xce,
+	0x5d, 0xc6, 0xef, 0xe3, 0x7a, 0xa1, 0xf6, 0x4f, 0xa8, 0x74, 0x96, 0xd5, 0xe2, 0x34, 0xd3, 0x5b,
+	0x61, 0x8c, 0xd4, 0x5c, 0x34, 0x90, 0x4d, 0x34, 0x48, 0xb2, 0x01, 0x69, 0x3e, 0x02, 0x9c, 0xdd,
+	0x5b, 0xb1, 0x18, 0xb9, 0x6c, 0xe8, 0xed, 0xe2, 0xe7, 0x6c, 0xf0, 0xfa, 0x42, 0x38, 0x2d, 0xe9,
+	0x3b, 0xdd, 0xe9, 0xf8, 0xfc, 0x11, 0x38, 0x9d, 0x8b, 0x79, 0xdc, 0x6c, 0x98, 0x91, 0xc7, 0xcc,
+	0xc8, 0xbf, 0x41, 0x9a, 0x4f, 0xb7, 0xdc, 0xe9, 0xa9, 0xc8, 0x46, 0x6c, 0xe8, 0x3d, 0x36, 0x02,
+	0x15, 0xad, 0x90, 0x85, 0xae, 0x08, 0xd8, 0xff, 0x58, 0xdd, 0xa5, 0x3e, 0x57, 0x3b, 0x1e, 0xa9,
+	0x8d, 0xe6, 0xf7, 0x9c, 0x94, 0xa1, 0x40, 0x0f, 0xe9, 0xd6, 0x63, 0xc2, 0x70, 0xa0, 0xc7, 0x23,
+	0x3c, 0xe6, 0xe3, 0x6e, 0x04, 0xb9, 0x5c, 0x0f, 0xb2, 0x18, 0x27, 0xb4, 0x1c, 0x5a, 0x18, 0x27,
+	0x44, 0xce, 0x07, 0x4f, 0xc4, 0xdb, 0x0a, 0x79, 0x02, 0x23, 0x81, 0x15, 0x9c, 0x90, 0x27, 0x22,
+	0xdf, 0xa4, 0x9b, 0x43, 0x6e, 0x34, 0x0e, 0x07, 0xc7, 0x20, 0x99, 0xe8, 0x74, 0x7b, 0xd1, 0x62,
+	0x5a, 0x6e, 0x42, 0xea, 0x1e, 0xfe, 0x83, 0x25, 0x39, 0x26, 0x7f, 0xa2, 0xf7, 0x70, 0xa6, 0xd5,
+	0x3d, 0x6a, 0x1c, 0x84, 0xf3, 0xd4, 0x27, 0xdf, 0xfd, 0x6e, 0xb6, 0xef, 0xbf, 0x9e, 0xcf, 0x2a,
+	0xdf, 0x3c, 0x9f, 0x55, 0xbe, 0x7d, 0x3e, 0xab, 0xfc, 0xf6, 0xf9, 0xac, 0xf2, 0xe5, 0xf7, 0xb3,
+	0x7d, 0xdf, 0x7e, 0x3f, 0xdb, 0xf7, 0xdd, 0xf7, 0xb3, 0x7d, 0xff, 0xfe, 0xa6, 0xf4, 0x3f, 0x9f,
+	0xba, 0x5b, 0xd7, 0x4d, 0xbd, 0xe1, 0x3a, 0xec, 0x26, 0x2b, 0xbe, 0x82, 0xff, 0x29, 0xfd, 0x3a,
+	0x31, 0xbe, 0x8c, 0xc0, 0x16, 0x27, 0x17, 0xd6, 0x9d, 0xc2, 0x72, 0xc3, 0xaa, 0xa4, 0xd0, 0x97,
+	0x77, 0xff, 0x36, 0x00, 0xe0, 0x1e, 0xb1, 0xbc, 0xec, 0x2a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SubmitClient is the client API for Submit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SubmitClient interface {
+	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
+	// ValidateJobs validates and defaults the jobs of a submit request in the same way as SubmitJobs,
+	// including checking that each job could be scheduled, but doesn't submit them.
+	ValidateJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobValidateResponse, error)
+	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
+	CancelJobSet(ctx context.Context, in *JobSetCancelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// SuspendJobSet stops the queued jobs of a job set from being scheduled until the job set is resumed.
+	SuspendJobSet(ctx context.Context, in *JobSetSuspendRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ResumeJobSet allows the queued jobs of a suspended job set to be scheduled again.
+	ResumeJobSet(ctx context.Context, in *JobSetResumeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
+	// ReprioritizeJobsByFilter reprioritises all jobs in a job set matching a label selector.
+	ReprioritizeJobsByFilter(ctx context.Context, in *JobReprioritizeByFilterRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
+	// ResubmitJobs submits a copy of each of the given finished jobs to the same job set.
+	ResubmitJobs(ctx context.Context, in *JobResubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
+	// GetResourceRecommendation recommends resource requests for the jobs of a job set from the peak usage of its past jobs.
+	GetResourceRecommendation(ctx context.Context, in *ResourceRecommendationRequest, opts ...grpc.CallOption) (*ResourceRecommendation, error)
+	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error)
+	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error)
+	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error)
+	GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (Submit_GetQueuesClient, error)
+	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
+	Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+}
+
+type submitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
+	return &submitClient{cc}
+}
+
+func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
+	out := new(JobSubmitResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CreateQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CreateQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Submit_CreateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueList)
-	if err := dec(in); err != nil {
+func (c *submitClient) ValidateJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobValidateResponse, error) {
+	out := new(JobValidateResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ValidateJobs", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CreateQueues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CreateQueues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CreateQueues(ctx, req.(*QueueList))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Submit_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
+func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
+	out := new(CancellationResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).UpdateQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/UpdateQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).UpdateQueue(ctx, req.(*Queue))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Submit_UpdateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueList)
-	if err := dec(in); err != nil {
+func (c *submitClient) CancelJobSet(ctx context.Context, in *JobSetCancelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobSet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).UpdateQueues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/UpdateQueues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).UpdateQueues(ctx, req.(*QueueList))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueDeleteRequest)
-	if err := dec(in); err != nil {
+func (c *submitClient) SuspendJobSet(ctx context.Context, in *JobSetSuspendRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/SuspendJobSet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).DeleteQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/DeleteQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Submit_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueGetRequest)
-	if err := dec(in); err != nil {
+func (c *submitClient) ResumeJobSet(ctx context.Context, in *JobSetResumeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/ResumeJobSet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).GetQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/GetQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).GetQueue(ctx, req.(*QueueGetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Submit_GetQueues_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamingQueueGetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
+	out := new(JobReprioritizeResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(SubmitServer).GetQueues(m, &submitGetQueuesServer{stream})
+	return out, nil
 }
 
-type Submit_GetQueuesServer interface {
-	Send(*StreamingQueueMessage) error
-	grpc.ServerStream
+func (c *submitClient) ReprioritizeJobsByFilter(ctx context.Context, in *JobReprioritizeByFilterRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
+	out := new(JobReprioritizeResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobsByFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type submitGetQueuesServer struct {
-	grpc.ServerStream
+func (c *submitClient) ResubmitJobs(ctx context.Context, in *JobResubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
+	out := new(JobSubmitResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ResubmitJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *submitGetQueuesServer) Send(m *StreamingQueueMessage) error {
-	return x.ServerStream.SendMsg(m)
+func (c *submitClient) GetResourceRecommendation(ctx context.Context, in *ResourceRecommendationRequest, opts ...grpc.CallOption) (*ResourceRecommendation, error) {
+	out := new(ResourceRecommendation)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetResourceRecommendation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueInfoRequest)
-	if err := dec(in); err != nil {
+func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).GetQueueInfo(ctx, in)
+	return out, nil
+}
+
+func (c *submitClient) CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error) {
+	out := new(BatchQueueCreateResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/GetQueueInfo",
+	return out, nil
+}
+
+func (c *submitClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+	return out, nil
+}
+
+func (c *submitClient) UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error) {
+	out := new(BatchQueueUpdateResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Submit_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SubmitServer).Health(ctx, in)
+	return out, nil
+}
+
+func (c *submitClient) GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error) {
+	out := new(Queue)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/Health",
+	return out, nil
+}
+
+func (c *submitClient) GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (Submit_GetQueuesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Submit_serviceDesc.Streams[0], "/api.Submit/GetQueues", opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).Health(ctx, req.(*types.Empty))
+	x := &submitGetQueuesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-var _Submit_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Submit",
-	HandlerType: (*SubmitServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitJobs",
-			Handler:    _Submit_SubmitJobs_Handler,
-		},
-		{
-			MethodName: "CancelJobs",
-			Handler:    _Submit_CancelJobs_Handler,
-		},
-		{
-			MethodName: "CancelJobSet",
-			Handler:    _Submit_CancelJobSet_Handler,
-		},
-		{
-			MethodName: "ReprioritizeJobs",
-			Handler:    _Submit_ReprioritizeJobs_Handler,
-		},
-		{
-			MethodName: "CreateQueue",
-			Handler:    _Submit_CreateQueue_Handler,
-		},
-		{
-			MethodName: "CreateQueues",
-			Handler:    _Submit_CreateQueues_Handler,
-		},
-		{
-			MethodName: "UpdateQueue",
-			Handler:    _Submit_UpdateQueue_Handler,
-		},
-		{
-			MethodName: "UpdateQueues",
-			Handler:    _Submit_UpdateQueues_Handler,
-		},
-		{
-			MethodName: "DeleteQueue",
-			Handler:    _Submit_DeleteQueue_Handler,
-		},
-		{
-			MethodName: "GetQueue",
-			Handler:    _Submit_GetQueue_Handler,
-		},
-		{
-			MethodName: "GetQueueInfo",
-			Handler:    _Submit_GetQueueInfo_Handler,
-		},
-		{
-			MethodName: "Health",
-			Handler:    _Submit_Health_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetQueues",
-			Handler:       _Submit_GetQueues_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pkg/api/submit.proto",
+type Submit_GetQueuesClient interface {
+	Recv() (*StreamingQueueMessage, error)
+	grpc.ClientStream
 }
 
-func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type submitGetQueuesClient struct {
+	grpc.ClientStream
+}
+
+func (x *submitGetQueuesClient) Recv() (*StreamingQueueMessage, error) {
+	m := new(StreamingQueueMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *submitClient) GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error) {
+	out := new(QueueInfo)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueueTtlSeconds != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.QueueTtlSeconds))
-		i--
-		dAtA[i] = 0x60
+// SubmitServer is the server API for Submit service.
+type SubmitServer interface {
+	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
+	// ValidateJobs validates and defaults the jobs of a submit request in the same way as SubmitJobs,
+	// including checking that each job could be scheduled, but doesn't submit them.
+	ValidateJobs(context.Context, *JobSubmitRequest) (*JobValidateResponse, error)
+	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
+	CancelJobSet(context.Context, *JobSetCancelRequest) (*types.Empty, error)
+	// SuspendJobSet stops the queued jobs of a job set from being scheduled until the job set is resumed.
+	SuspendJobSet(context.Context, *JobSetSuspendRequest) (*types.Empty, error)
+	// ResumeJobSet allows the queued jobs of a suspended job set to be scheduled again.
+	ResumeJobSet(context.Context, *JobSetResumeRequest) (*types.Empty, error)
+	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
+	// ReprioritizeJobsByFilter reprioritises all jobs in a job set matching a label selector.
+	ReprioritizeJobsByFilter(context.Context, *JobReprioritizeByFilterRequest) (*JobReprioritizeResponse, error)
+	// ResubmitJobs submits a copy of each of the given finished jobs to the same job set.
+	ResubmitJobs(context.Context, *JobResubmitRequest) (*JobSubmitResponse, error)
+	// GetResourceRecommendation recommends resource requests for the jobs of a job set from the peak usage of its past jobs.
+	GetResourceRecommendation(context.Context, *ResourceRecommendationRequest) (*ResourceRecommendation, error)
+	CreateQueue(context.Context, *Queue) (*types.Empty, error)
+	CreateQueues(context.Context, *QueueList) (*BatchQueueCreateResponse, error)
+	UpdateQueue(context.Context, *Queue) (*types.Empty, error)
+	UpdateQueues(context.Context, *QueueList) (*BatchQueueUpdateResponse, error)
+	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
+	GetQueue(context.Context, *QueueGetRequest) (*Queue, error)
+	GetQueues(*StreamingQueueGetRequest, Submit_GetQueuesServer) error
+	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
+	Health(context.Context, *types.Empty) (*HealthCheckResponse, error)
+}
+
+// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
+type UnimplementedSubmitServer struct {
+}
+
+func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
+}
+func (*UnimplementedSubmitServer) ValidateJobs(ctx context.Context, req *JobSubmitRequest) (*JobValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobSet(ctx context.Context, req *JobSetCancelRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobSet not implemented")
+}
+func (*UnimplementedSubmitServer) SuspendJobSet(ctx context.Context, req *JobSetSuspendRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendJobSet not implemented")
+}
+func (*UnimplementedSubmitServer) ResumeJobSet(ctx context.Context, req *JobSetResumeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJobSet not implemented")
+}
+func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
+}
+func (*UnimplementedSubmitServer) ReprioritizeJobsByFilter(ctx context.Context, req *JobReprioritizeByFilterRequest) (*JobReprioritizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobsByFilter not implemented")
+}
+func (*UnimplementedSubmitServer) ResubmitJobs(ctx context.Context, req *JobResubmitRequest) (*JobSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitJobs not implemented")
+}
+func (*UnimplementedSubmitServer) GetResourceRecommendation(ctx context.Context, req *ResourceRecommendationRequest) (*ResourceRecommendation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceRecommendation not implemented")
+}
+func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) CreateQueues(ctx context.Context, req *QueueList) (*BatchQueueCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueues not implemented")
+}
+func (*UnimplementedSubmitServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) UpdateQueues(ctx context.Context, req *QueueList) (*BatchQueueUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueues not implemented")
+}
+func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueue(ctx context.Context, req *QueueGetRequest) (*Queue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueues(req *StreamingQueueGetRequest, srv Submit_GetQueuesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetQueues not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueueInfo(ctx context.Context, req *QueueInfoRequest) (*QueueInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueInfo not implemented")
+}
+func (*UnimplementedSubmitServer) Health(ctx context.Context, req *types.Empty) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+
+func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
+	s.RegisterService(&_Submit_serviceDesc, srv)
+}
+
+func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Scheduler) > 0 {
-		i -= len(m.Scheduler)
-		copy(dAtA[i:], m.Scheduler)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Scheduler)))
-		i--
-		dAtA[i] = 0x5a
+	if interceptor == nil {
+		return srv.(SubmitServer).SubmitJobs(ctx, in)
 	}
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SubmitJobs",
 	}
-	if len(m.Ingress) > 0 {
-		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ingress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ValidateJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PodSpecs) > 0 {
-		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).ValidateJobs(ctx, in)
 	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k := range m.RequiredNodeLabels {
-			v := m.RequiredNodeLabels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ValidateJobs",
 	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ValidateJobs(ctx, req.(*JobSubmitRequest))
 	}
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobs(ctx, in)
 	}
-	if m.PodSpec != nil {
-		{
-			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobs",
 	}
-	if m.Priority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
-		i--
-		dAtA[i] = 0x9
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IngressConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_CancelJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetCancelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IngressConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *IngressConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UseClusterIP {
-		i--
-		if m.UseClusterIP {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobSet(ctx, in)
 	}
-	if len(m.CertName) > 0 {
-		i -= len(m.CertName)
-		copy(dAtA[i:], m.CertName)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.CertName)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobSet",
 	}
-	if m.TlsEnabled {
-		i--
-		if m.TlsEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobSet(ctx, req.(*JobSetCancelRequest))
 	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_SuspendJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetSuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Ports) > 0 {
-		dAtA3 := make([]byte, len(m.Ports)*10)
-		var j2 int
-		for _, num := range m.Ports {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintSubmit(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(SubmitServer).SuspendJobSet(ctx, in)
 	}
-	if m.Type != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SuspendJobSet",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SuspendJobSet(ctx, req.(*JobSetSuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ServiceConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_ResumeJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetResumeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ServiceConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ports) > 0 {
-		dAtA5 := make([]byte, len(m.Ports)*10)
-		var j4 int
-		for _, num := range m.Ports {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintSubmit(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(SubmitServer).ResumeJobSet(ctx, in)
 	}
-	if m.Type != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ResumeJobSet",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ResumeJobSet(ctx, req.(*JobSetResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReprioritizeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobRequestItems) > 0 {
-		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, in)
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReprioritizeJobs",
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, req.(*JobReprioritizeRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_ReprioritizeJobsByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReprioritizeByFilterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(SubmitServer).ReprioritizeJobsByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReprioritizeJobsByFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReprioritizeJobsByFilter(ctx, req.(*JobReprioritizeByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.JobIds) > 0 {
-		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobIds[iNdEx])
-			copy(dAtA[i:], m.JobIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func _Submit_ResubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobResubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(SubmitServer).ResubmitJobs(ctx, in)
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ResubmitJobs",
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ResubmitJobs(ctx, req.(*JobResubmitRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSetCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_GetResourceRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceRecommendationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JobSetCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(SubmitServer).GetResourceRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetResourceRecommendation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetResourceRecommendation(ctx, req.(*ResourceRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSetCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
+func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Filter != nil {
-		{
-			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateQueue(ctx, in)
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateQueue",
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSetFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_CreateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateQueues(ctx, req.(*QueueList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSetFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Submit_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).UpdateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UpdateQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UpdateQueue(ctx, req.(*Queue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.States) > 0 {
-		dAtA8 := make([]byte, len(m.States)*10)
-		var j7 int
-		for _, num := range m.States {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintSubmit(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0xa
+func _Submit_UpdateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(SubmitServer).UpdateQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UpdateQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UpdateQueues(ctx, req.(*QueueList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueDeleteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SubmitServer).DeleteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/DeleteQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Submit_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueue(ctx, req.(*QueueGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewPriority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
-		i--
-		dAtA[i] = 0x21
+func _Submit_GetQueues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamingQueueGetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return srv.(SubmitServer).GetQueues(m, &submitGetQueuesServer{stream})
+}
+
+type Submit_GetQueuesServer interface {
+	Send(*StreamingQueueMessage) error
+	grpc.ServerStream
+}
+
+type submitGetQueuesServer struct {
+	grpc.ServerStream
+}
+
+func (x *submitGetQueuesServer) Send(m *StreamingQueueMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueueInfo(ctx, in)
 	}
-	if len(m.JobIds) > 0 {
-		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobIds[iNdEx])
-			copy(dAtA[i:], m.JobIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueueInfo",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
+func _Submit_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).Health(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Submit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Submit",
+	HandlerType: (*SubmitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitJobs",
+			Handler:    _Submit_SubmitJobs_Handler,
+		},
+		{
+			MethodName: "ValidateJobs",
+			Handler:    _Submit_ValidateJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobs",
+			Handler:    _Submit_CancelJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobSet",
+			Handler:    _Submit_CancelJobSet_Handler,
+		},
+		{
+			MethodName: "SuspendJobSet",
+			Handler:    _Submit_SuspendJobSet_Handler,
+		},
+		{
+			MethodName: "ResumeJobSet",
+			Handler:    _Submit_ResumeJobSet_Handler,
+		},
+		{
+			MethodName: "ReprioritizeJobs",
+			Handler:    _Submit_ReprioritizeJobs_Handler,
+		},
+		{
+			MethodName: "ReprioritizeJobsByFilter",
+			Handler:    _Submit_ReprioritizeJobsByFilter_Handler,
+		},
+		{
+			MethodName: "ResubmitJobs",
+			Handler:    _Submit_ResubmitJobs_Handler,
+		},
+		{
+			MethodName: "GetResourceRecommendation",
+			Handler:    _Submit_GetResourceRecommendation_Handler,
+		},
+		{
+			MethodName: "CreateQueue",
+			Handler:    _Submit_CreateQueue_Handler,
+		},
+		{
+			MethodName: "CreateQueues",
+			Handler:    _Submit_CreateQueues_Handler,
+		},
+		{
+			MethodName: "UpdateQueue",
+			Handler:    _Submit_UpdateQueue_Handler,
+		},
+		{
+			MethodName: "UpdateQueues",
+			Handler:    _Submit_UpdateQueues_Handler,
+		},
+		{
+			MethodName: "DeleteQueue",
+			Handler:    _Submit_DeleteQueue_Handler,
+		},
+		{
+			MethodName: "GetQueue",
+			Handler:    _Submit_GetQueue_Handler,
+		},
+		{
+			MethodName: "GetQueueInfo",
+			Handler:    _Submit_GetQueueInfo_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _Submit_Health_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetQueues",
+			Handler:       _Submit_GetQueues_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/api/submit.proto",
+}
+
+func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3022,99 +3509,72 @@ func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobReprioritizeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobReprioritizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReprioritizationResults) > 0 {
-		for k := range m.ReprioritizationResults {
-			v := m.ReprioritizationResults[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ArraySize != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.ArraySize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
 			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x6a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+	if m.QueueTtlSeconds != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.QueueTtlSeconds))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x60
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+	if len(m.Scheduler) > 0 {
+		i -= len(m.Scheduler)
+		copy(dAtA[i:], m.Scheduler)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Scheduler)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x5a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Ingress) > 0 {
+		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Ingress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3122,36 +3582,20 @@ func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintSubmit(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x4a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Queue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PodSpecs) > 0 {
+		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3159,17 +3603,37 @@ func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintSubmit(dAtA, i, uint64(size))
 			}
 			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k := range m.RequiredNodeLabels {
+			v := m.RequiredNodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
 			dAtA[i] = 0x32
 		}
 	}
-	if len(m.ResourceLimits) > 0 {
-		for k := range m.ResourceLimits {
-			v := m.ResourceLimits[k]
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
 			baseI := i
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
 			i--
-			dAtA[i] = 0x11
+			dAtA[i] = 0x12
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
@@ -3180,41 +3644,54 @@ func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.GroupOwners) > 0 {
-		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupOwners[iNdEx])
-			copy(dAtA[i:], m.GroupOwners[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x22
 		}
 	}
-	if len(m.UserOwners) > 0 {
-		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UserOwners[iNdEx])
-			copy(dAtA[i:], m.UserOwners[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PriorityFactor != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x11
+		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if m.Priority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Queue_Permissions) Marshal() (dAtA []byte, err error) {
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3224,43 +3701,67 @@ func (m *Queue_Permissions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Queue_Permissions) MarshalTo(dAtA []byte) (int, error) {
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Queue_Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Verbs) > 0 {
-		for iNdEx := len(m.Verbs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Verbs[iNdEx])
-			copy(dAtA[i:], m.Verbs[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Verbs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if len(m.RetryOnFailureCategories) > 0 {
+		dAtA4 := make([]byte, len(m.RetryOnFailureCategories)*10)
+		var j3 int
+		for _, num := range m.RetryOnFailureCategories {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSubmit(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Subjects) > 0 {
-		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
+	if len(m.RetryOnExitCodes) > 0 {
+		dAtA6 := make([]byte, len(m.RetryOnExitCodes)*10)
+		var j5 int
+		for _, num1 := range m.RetryOnExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
 			}
-			i--
-			dAtA[i] = 0xa
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintSubmit(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BackoffSeconds != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.BackoffSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Queue_Permissions_Subject) Marshal() (dAtA []byte, err error) {
+func (m *IngressConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3270,34 +3771,89 @@ func (m *Queue_Permissions_Subject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Queue_Permissions_Subject) MarshalTo(dAtA []byte) (int, error) {
+func (m *IngressConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Queue_Permissions_Subject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IngressConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if m.UseClusterIP {
+		i--
+		if m.UseClusterIP {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CertName) > 0 {
+		i -= len(m.CertName)
+		copy(dAtA[i:], m.CertName)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.CertName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TlsEnabled {
+		i--
+		if m.TlsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ports) > 0 {
+		dAtA8 := make([]byte, len(m.Ports)*10)
+		var j7 int
+		for _, num := range m.Ports {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintSubmit(dAtA, i, uint64(j7))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Kind)))
+	if m.Type != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueList) Marshal() (dAtA []byte, err error) {
+func (m *ServiceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3307,34 +3863,43 @@ func (m *QueueList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Queues) > 0 {
-		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
+	if len(m.Ports) > 0 {
+		dAtA10 := make([]byte, len(m.Ports)*10)
+		var j9 int
+		for _, num := range m.Ports {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
 			}
-			i--
-			dAtA[i] = 0xa
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintSubmit(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
+func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3344,29 +3909,55 @@ func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CancelledIds) > 0 {
-		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CancelledIds[iNdEx])
-			copy(dAtA[i:], m.CancelledIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JobRequestItems) > 0 {
+		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueGetRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3376,27 +3967,57 @@ func (m *QueueGetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamingQueueGetRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobSetCancelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3406,25 +4027,53 @@ func (m *StreamingQueueGetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StreamingQueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetCancelRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Num != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.Num))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobSetFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3434,27 +4083,38 @@ func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetFilter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if len(m.States) > 0 {
+		dAtA13 := make([]byte, len(m.States)*10)
+		var j12 int
+		for _, num := range m.States {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintSubmit(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobReprioritizeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3464,27 +4124,49 @@ func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x21
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
+func (m *JobReprioritizeByFilterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3494,41 +4176,59 @@ func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobReprioritizeByFilterRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobReprioritizeByFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ActiveJobSets) > 0 {
-		for iNdEx := len(m.ActiveJobSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActiveJobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if len(m.LabelSelector) > 0 {
+		i -= len(m.LabelSelector)
+		copy(dAtA[i:], m.LabelSelector)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.LabelSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+func (m *JobResubmitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3538,37 +4238,43 @@ func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobResubmitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobResubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LeasedJobs != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
-		i--
-		dAtA[i] = 0x18
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.QueuedJobs != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueUpdateResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResourceRecommendationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3578,39 +4284,47 @@ func (m *QueueUpdateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceRecommendationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResourceRecommendationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+	if m.Percentile != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Percentile))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.TemplateName) > 0 {
+		i -= len(m.TemplateName)
+		copy(dAtA[i:], m.TemplateName)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.TemplateName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Queue != nil {
-		{
-			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchQueueUpdateResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResourceRecommendation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3620,20 +4334,51 @@ func (m *BatchQueueUpdateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchQueueUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceRecommendation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchQueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResourceRecommendation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FailedQueues) > 0 {
-		for iNdEx := len(m.FailedQueues) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NumJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.NumJobs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CurrentRequests) > 0 {
+		for k := range m.CurrentRequests {
+			v := m.CurrentRequests[k]
+			baseI := i
 			{
-				size, err := m.FailedQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Requests) > 0 {
+		for k := range m.Requests {
+			v := m.Requests[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3641,13 +4386,21 @@ func (m *BatchQueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 				i = encodeVarintSubmit(dAtA, i, uint64(size))
 			}
 			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
 			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueCreateResponse) Marshal() (dAtA []byte, err error) {
+func (m *JobSetSuspendRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3657,39 +4410,41 @@ func (m *QueueCreateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetSuspendRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetSuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Queue != nil {
-		{
-			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchQueueCreateResponse) Marshal() (dAtA []byte, err error) {
+func (m *JobSetResumeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3699,34 +4454,34 @@ func (m *BatchQueueCreateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchQueueCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetResumeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchQueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FailedQueues) > 0 {
-		for iNdEx := len(m.FailedQueues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FailedQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EndMarker) Marshal() (dAtA []byte, err error) {
+func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3736,20 +4491,39 @@ func (m *EndMarker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndMarker) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobReprioritizeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobReprioritizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReprioritizationResults) > 0 {
+		for k := range m.ReprioritizationResults {
+			v := m.ReprioritizationResults[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamingQueueMessage) Marshal() (dAtA []byte, err error) {
+func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3759,1160 +4533,4585 @@ func (m *StreamingQueueMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StreamingQueueMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
-		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Warnings[iNdEx])
+			copy(dAtA[i:], m.Warnings[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Warnings[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PodJobIds) > 0 {
+		for iNdEx := len(m.PodJobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PodJobIds[iNdEx])
+			copy(dAtA[i:], m.PodJobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.PodJobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MultiPodJobId) > 0 {
+		i -= len(m.MultiPodJobId)
+		copy(dAtA[i:], m.MultiPodJobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.MultiPodJobId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ArrayJobIds) > 0 {
+		for iNdEx := len(m.ArrayJobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ArrayJobIds[iNdEx])
+			copy(dAtA[i:], m.ArrayJobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ArrayJobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
+	if len(m.ArrayId) > 0 {
+		i -= len(m.ArrayId)
+		copy(dAtA[i:], m.ArrayId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ArrayId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamingQueueMessage_Queue) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueMessage_Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Queue != nil {
-		{
-			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobResponseItems) > 0 {
+		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *StreamingQueueMessage_End) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *JobValidateResponseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobValidateResponseItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueMessage_End) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobValidateResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.End != nil {
-		{
-			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSubmit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+
+func (m *JobValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *JobSubmitRequestItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Priority != 0 {
-		n += 9
-	}
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k, v := range m.RequiredNodeLabels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.PodSpecs) > 0 {
-		for _, e := range m.PodSpecs {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.JobResponseItems) > 0 {
+		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Ingress) > 0 {
-		for _, e := range m.Ingress {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Scheduler)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.QueueTtlSeconds != 0 {
-		n += 1 + sovSubmit(uint64(m.QueueTtlSeconds))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IngressConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Queue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovSubmit(uint64(m.Type))
+	if m.State != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Ports) > 0 {
-		l = 0
-		for _, e := range m.Ports {
-			l += sovSubmit(uint64(e))
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
-		n += 1 + sovSubmit(uint64(l)) + l
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+	if len(m.ResourceLimits) > 0 {
+		for k := range m.ResourceLimits {
+			v := m.ResourceLimits[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.TlsEnabled {
-		n += 2
+	if len(m.GroupOwners) > 0 {
+		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupOwners[iNdEx])
+			copy(dAtA[i:], m.GroupOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.CertName)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.UserOwners) > 0 {
+		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UserOwners[iNdEx])
+			copy(dAtA[i:], m.UserOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.UseClusterIP {
-		n += 2
+	if m.PriorityFactor != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
+		i--
+		dAtA[i] = 0x11
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ServiceConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Queue_Permissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue_Permissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Queue_Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovSubmit(uint64(m.Type))
+	if len(m.Verbs) > 0 {
+		for iNdEx := len(m.Verbs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Verbs[iNdEx])
+			copy(dAtA[i:], m.Verbs[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Verbs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Ports) > 0 {
-		l = 0
-		for _, e := range m.Ports {
-			l += sovSubmit(uint64(e))
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		n += 1 + sovSubmit(uint64(l)) + l
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSubmitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Queue_Permissions_Subject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue_Permissions_Subject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Queue_Permissions_Subject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.JobRequestItems) > 0 {
-		for _, e := range m.JobRequestItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.JobIds) > 0 {
-		for _, s := range m.JobIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.CancelledIds) > 0 {
+		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelledIds[iNdEx])
+			copy(dAtA[i:], m.CancelledIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.States) > 0 {
-		l = 0
-		for _, e := range m.States {
-			l += sovSubmit(uint64(e))
-		}
-		n += 1 + sovSubmit(uint64(l)) + l
+func (m *QueueGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobReprioritizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.JobIds) > 0 {
-		for _, s := range m.JobIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.NewPriority != 0 {
-		n += 9
-	}
-	return n
+func (m *QueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobReprioritizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ReprioritizationResults) > 0 {
-		for k, v := range m.ReprioritizationResults {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSubmitResponseItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *StreamingQueueGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobSubmitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for _, e := range m.JobResponseItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	return n
+func (m *StreamingQueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Queue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StreamingQueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.PriorityFactor != 0 {
-		n += 9
-	}
-	if len(m.UserOwners) > 0 {
-		for _, s := range m.UserOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.GroupOwners) > 0 {
-		for _, s := range m.GroupOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.ResourceLimits) > 0 {
-		for k, v := range m.ResourceLimits {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Permissions) > 0 {
-		for _, e := range m.Permissions {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if m.Num != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Num))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Queue_Permissions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Subjects) > 0 {
-		for _, e := range m.Subjects {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.Verbs) > 0 {
-		for _, s := range m.Verbs {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Queue_Permissions_Subject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	return n
+func (m *QueueInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Queues) > 0 {
-		for _, e := range m.Queues {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CancellationResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CancelledIds) > 0 {
-		for _, s := range m.CancelledIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueueGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	return n
+func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Num != 0 {
-		n += 1 + sovSubmit(uint64(m.Num))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueueInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueueDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	return n
+func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
 	if len(m.ActiveJobSets) > 0 {
-		for _, e := range m.ActiveJobSets {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+		for iNdEx := len(m.ActiveJobSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveJobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if m.LeasedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.QueuedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.QueuedJobs))
+		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.LeasedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueueUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Queue != nil {
-		l = m.Queue.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *QueueUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BatchQueueUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FailedQueues) > 0 {
-		for _, e := range m.FailedQueues {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	return n
+func (m *QueueUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Queue != nil {
-		l = m.Queue.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if m.Queue != nil {
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchQueueCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchQueueUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchQueueUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.FailedQueues) > 0 {
-		for _, e := range m.FailedQueues {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+		for iNdEx := len(m.FailedQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EndMarker) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StreamingQueueMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		n += m.Event.Size()
-	}
-	return n
+func (m *QueueCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueMessage_Queue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Queue != nil {
-		l = m.Queue.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Queue != nil {
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *StreamingQueueMessage_End) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BatchQueueCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchQueueCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.End != nil {
-		l = m.End.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.FailedQueues) > 0 {
+		for iNdEx := len(m.FailedQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovSubmit(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EndMarker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozSubmit(x uint64) (n int) {
-	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EndMarker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobSubmitRequestItem) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EndMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamingQueueMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForPodSpecs := "[]*PodSpec{"
-	for _, f := range this.PodSpecs {
-		repeatedStringForPodSpecs += strings.Replace(fmt.Sprintf("%v", f), "PodSpec", "v1.PodSpec", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *StreamingQueueMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamingQueueMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	repeatedStringForPodSpecs += "}"
-	repeatedStringForIngress := "[]*IngressConfig{"
-	for _, f := range this.Ingress {
-		repeatedStringForIngress += strings.Replace(f.String(), "IngressConfig", "IngressConfig", 1) + ","
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamingQueueMessage_Queue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamingQueueMessage_Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Queue != nil {
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	repeatedStringForIngress += "}"
-	repeatedStringForServices := "[]*ServiceConfig{"
-	for _, f := range this.Services {
-		repeatedStringForServices += strings.Replace(f.String(), "ServiceConfig", "ServiceConfig", 1) + ","
+	return len(dAtA) - i, nil
+}
+func (m *StreamingQueueMessage_End) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamingQueueMessage_End) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	repeatedStringForServices += "}"
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k, _ := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	return len(dAtA) - i, nil
+}
+func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubmit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSubmitRequestItem) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k, _ := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 9
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	keysForRequiredNodeLabels := make([]string, 0, len(this.RequiredNodeLabels))
-	for k, _ := range this.RequiredNodeLabels {
-		keysForRequiredNodeLabels = append(keysForRequiredNodeLabels, k)
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredNodeLabels)
-	mapStringForRequiredNodeLabels := "map[string]string{"
-	for _, k := range keysForRequiredNodeLabels {
-		mapStringForRequiredNodeLabels += fmt.Sprintf("%v: %v,", k, this.RequiredNodeLabels[k])
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
 	}
-	mapStringForRequiredNodeLabels += "}"
-	s := strings.Join([]string{`&JobSubmitRequestItem{`,
-		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
-		`PodSpec:` + strings.Replace(fmt.Sprintf("%v", this.PodSpec), "PodSpec", "v1.PodSpec", 1) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`RequiredNodeLabels:` + mapStringForRequiredNodeLabels + `,`,
-		`PodSpecs:` + repeatedStringForPodSpecs + `,`,
-		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
-		`Ingress:` + repeatedStringForIngress + `,`,
-		`Services:` + repeatedStringForServices + `,`,
-		`Scheduler:` + fmt.Sprintf("%v", this.Scheduler) + `,`,
-		`QueueTtlSeconds:` + fmt.Sprintf("%v", this.QueueTtlSeconds) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *IngressConfig) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
 	}
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k, _ := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	if len(m.RequiredNodeLabels) > 0 {
+		for k, v := range m.RequiredNodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if len(m.PodSpecs) > 0 {
+		for _, e := range m.PodSpecs {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&IngressConfig{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Ports:` + fmt.Sprintf("%v", this.Ports) + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`TlsEnabled:` + fmt.Sprintf("%v", this.TlsEnabled) + `,`,
-		`CertName:` + fmt.Sprintf("%v", this.CertName) + `,`,
-		`UseClusterIP:` + fmt.Sprintf("%v", this.UseClusterIP) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ServiceConfig) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	s := strings.Join([]string{`&ServiceConfig{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Ports:` + fmt.Sprintf("%v", this.Ports) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobSubmitRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Ingress) > 0 {
+		for _, e := range m.Ingress {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
 	}
-	repeatedStringForJobRequestItems := "[]*JobSubmitRequestItem{"
-	for _, f := range this.JobRequestItems {
-		repeatedStringForJobRequestItems += strings.Replace(f.String(), "JobSubmitRequestItem", "JobSubmitRequestItem", 1) + ","
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
 	}
-	repeatedStringForJobRequestItems += "}"
-	s := strings.Join([]string{`&JobSubmitRequest{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`JobRequestItems:` + repeatedStringForJobRequestItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobCancelRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Scheduler)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	s := strings.Join([]string{`&JobCancelRequest{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobSetCancelRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.QueueTtlSeconds != 0 {
+		n += 1 + sovSubmit(uint64(m.QueueTtlSeconds))
 	}
-	s := strings.Join([]string{`&JobSetCancelRequest{`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Filter:` + strings.Replace(this.Filter.String(), "JobSetFilter", "JobSetFilter", 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobSetFilter) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&JobSetFilter{`,
-		`States:` + fmt.Sprintf("%v", this.States) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobReprioritizeRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ArraySize != 0 {
+		n += 1 + sovSubmit(uint64(m.ArraySize))
 	}
-	s := strings.Join([]string{`&JobReprioritizeRequest{`,
-		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobReprioritizeResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	keysForReprioritizationResults := make([]string, 0, len(this.ReprioritizationResults))
-	for k, _ := range this.ReprioritizationResults {
-		keysForReprioritizationResults = append(keysForReprioritizationResults, k)
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForReprioritizationResults)
-	mapStringForReprioritizationResults := "map[string]string{"
-	for _, k := range keysForReprioritizationResults {
-		mapStringForReprioritizationResults += fmt.Sprintf("%v: %v,", k, this.ReprioritizationResults[k])
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovSubmit(uint64(m.MaxAttempts))
 	}
-	mapStringForReprioritizationResults += "}"
-	s := strings.Join([]string{`&JobReprioritizeResponse{`,
-		`ReprioritizationResults:` + mapStringForReprioritizationResults + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobSubmitResponseItem) String() string {
-	if this == nil {
-		return "nil"
+	if m.BackoffSeconds != 0 {
+		n += 1 + sovSubmit(uint64(m.BackoffSeconds))
 	}
-	s := strings.Join([]string{`&JobSubmitResponseItem{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobSubmitResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.RetryOnExitCodes) > 0 {
+		l = 0
+		for _, e := range m.RetryOnExitCodes {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
 	}
-	repeatedStringForJobResponseItems := "[]*JobSubmitResponseItem{"
-	for _, f := range this.JobResponseItems {
-		repeatedStringForJobResponseItems += strings.Replace(f.String(), "JobSubmitResponseItem", "JobSubmitResponseItem", 1) + ","
+	if len(m.RetryOnFailureCategories) > 0 {
+		l = 0
+		for _, e := range m.RetryOnFailureCategories {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
 	}
-	repeatedStringForJobResponseItems += "}"
-	s := strings.Join([]string{`&JobSubmitResponse{`,
-		`JobResponseItems:` + repeatedStringForJobResponseItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Queue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *IngressConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPermissions := "[]*Queue_Permissions{"
-	for _, f := range this.Permissions {
-		repeatedStringForPermissions += strings.Replace(fmt.Sprintf("%v", f), "Queue_Permissions", "Queue_Permissions", 1) + ","
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSubmit(uint64(m.Type))
 	}
-	repeatedStringForPermissions += "}"
-	keysForResourceLimits := make([]string, 0, len(this.ResourceLimits))
-	for k, _ := range this.ResourceLimits {
-		keysForResourceLimits = append(keysForResourceLimits, k)
+	if len(m.Ports) > 0 {
+		l = 0
+		for _, e := range m.Ports {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceLimits)
-	mapStringForResourceLimits := "map[string]float64{"
-	for _, k := range keysForResourceLimits {
-		mapStringForResourceLimits += fmt.Sprintf("%v: %v,", k, this.ResourceLimits[k])
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
 	}
-	mapStringForResourceLimits += "}"
-	s := strings.Join([]string{`&Queue{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`PriorityFactor:` + fmt.Sprintf("%v", this.PriorityFactor) + `,`,
-		`UserOwners:` + fmt.Sprintf("%v", this.UserOwners) + `,`,
-		`GroupOwners:` + fmt.Sprintf("%v", this.GroupOwners) + `,`,
-		`ResourceLimits:` + mapStringForResourceLimits + `,`,
-		`Permissions:` + repeatedStringForPermissions + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Queue_Permissions) String() string {
-	if this == nil {
-		return "nil"
+	if m.TlsEnabled {
+		n += 2
 	}
-	repeatedStringForSubjects := "[]*Queue_Permissions_Subject{"
-	for _, f := range this.Subjects {
-		repeatedStringForSubjects += strings.Replace(fmt.Sprintf("%v", f), "Queue_Permissions_Subject", "Queue_Permissions_Subject", 1) + ","
+	l = len(m.CertName)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	repeatedStringForSubjects += "}"
-	s := strings.Join([]string{`&Queue_Permissions{`,
-		`Subjects:` + repeatedStringForSubjects + `,`,
-		`Verbs:` + fmt.Sprintf("%v", this.Verbs) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Queue_Permissions_Subject) String() string {
-	if this == nil {
-		return "nil"
+	if m.UseClusterIP {
+		n += 2
 	}
-	s := strings.Join([]string{`&Queue_Permissions_Subject{`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *QueueList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForQueues := "[]*Queue{"
-	for _, f := range this.Queues {
-		repeatedStringForQueues += strings.Replace(f.String(), "Queue", "Queue", 1) + ","
+
+func (m *ServiceConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForQueues += "}"
-	s := strings.Join([]string{`&QueueList{`,
-		`Queues:` + repeatedStringForQueues + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CancellationResult) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSubmit(uint64(m.Type))
 	}
-	s := strings.Join([]string{`&CancellationResult{`,
-		`CancelledIds:` + fmt.Sprintf("%v", this.CancelledIds) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueGetRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Ports) > 0 {
+		l = 0
+		for _, e := range m.Ports {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
 	}
-	s := strings.Join([]string{`&QueueGetRequest{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *StreamingQueueGetRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StreamingQueueGetRequest{`,
-		`Num:` + fmt.Sprintf("%v", this.Num) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueInfoRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	s := strings.Join([]string{`&QueueInfoRequest{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueDeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	s := strings.Join([]string{`&QueueDeleteRequest{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueInfo) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.JobRequestItems) > 0 {
+		for _, e := range m.JobRequestItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
 	}
-	repeatedStringForActiveJobSets := "[]*JobSetInfo{"
-	for _, f := range this.ActiveJobSets {
-		repeatedStringForActiveJobSets += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	repeatedStringForActiveJobSets += "}"
-	s := strings.Join([]string{`&QueueInfo{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ActiveJobSets:` + repeatedStringForActiveJobSets + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *JobSetInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&JobSetInfo{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`QueuedJobs:` + fmt.Sprintf("%v", this.QueuedJobs) + `,`,
-		`LeasedJobs:` + fmt.Sprintf("%v", this.LeasedJobs) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueUpdateResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	s := strings.Join([]string{`&QueueUpdateResponse{`,
-		`Queue:` + strings.Replace(this.Queue.String(), "Queue", "Queue", 1) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BatchQueueUpdateResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	repeatedStringForFailedQueues := "[]*QueueUpdateResponse{"
-	for _, f := range this.FailedQueues {
-		repeatedStringForFailedQueues += strings.Replace(f.String(), "QueueUpdateResponse", "QueueUpdateResponse", 1) + ","
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	repeatedStringForFailedQueues += "}"
-	s := strings.Join([]string{`&BatchQueueUpdateResponse{`,
-		`FailedQueues:` + repeatedStringForFailedQueues + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueCreateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&QueueCreateResponse{`,
-		`Queue:` + strings.Replace(this.Queue.String(), "Queue", "Queue", 1) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
 }
-func (this *BatchQueueCreateResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobSetCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForFailedQueues := "[]*QueueCreateResponse{"
-	for _, f := range this.FailedQueues {
-		repeatedStringForFailedQueues += strings.Replace(f.String(), "QueueCreateResponse", "QueueCreateResponse", 1) + ","
+	var l int
+	_ = l
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
 	}
-	repeatedStringForFailedQueues += "}"
-	s := strings.Join([]string{`&BatchQueueCreateResponse{`,
-		`FailedQueues:` + repeatedStringForFailedQueues + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
 }
-func (this *EndMarker) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobSetFilter) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&EndMarker{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
+	}
+	return n
 }
-func (this *StreamingQueueMessage) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobReprioritizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StreamingQueueMessage{`,
-		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	return n
 }
-func (this *StreamingQueueMessage_Queue) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobReprioritizeByFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StreamingQueueMessage_Queue{`,
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.LabelSelector)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *JobResubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceRecommendationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.TemplateName)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Percentile != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ResourceRecommendation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for k, v := range m.Requests {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.CurrentRequests) > 0 {
+		for k, v := range m.CurrentRequests {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.NumJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.NumJobs))
+	}
+	return n
+}
+
+func (m *JobSetSuspendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetResumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReprioritizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReprioritizationResults) > 0 {
+		for k, v := range m.ReprioritizationResults {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobSubmitResponseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.ArrayId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ArrayJobIds) > 0 {
+		for _, s := range m.ArrayJobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.MultiPodJobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.PodJobIds) > 0 {
+		for _, s := range m.PodJobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobResponseItems) > 0 {
+		for _, e := range m.JobResponseItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobValidateResponseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.JobResponseItems) > 0 {
+		for _, e := range m.JobResponseItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Queue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.PriorityFactor != 0 {
+		n += 9
+	}
+	if len(m.UserOwners) > 0 {
+		for _, s := range m.UserOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for _, s := range m.GroupOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k, v := range m.ResourceLimits {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.State != 0 {
+		n += 1 + sovSubmit(uint64(m.State))
+	}
+	return n
+}
+
+func (m *Queue_Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Verbs) > 0 {
+		for _, s := range m.Verbs {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Queue_Permissions_Subject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancellationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for _, s := range m.CancelledIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamingQueueGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Num != 0 {
+		n += 1 + sovSubmit(uint64(m.Num))
+	}
+	return n
+}
+
+func (m *QueueInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ActiveJobSets) > 0 {
+		for _, e := range m.ActiveJobSets {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.QueuedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.QueuedJobs))
+	}
+	if m.LeasedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	}
+	return n
+}
+
+func (m *QueueUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchQueueUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedQueues) > 0 {
+		for _, e := range m.FailedQueues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchQueueCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedQueues) > 0 {
+		for _, e := range m.FailedQueues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndMarker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StreamingQueueMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *StreamingQueueMessage_Queue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+func (m *StreamingQueueMessage_End) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func sovSubmit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubmit(x uint64) (n int) {
+	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *JobSubmitRequestItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPodSpecs := "[]*PodSpec{"
+	for _, f := range this.PodSpecs {
+		repeatedStringForPodSpecs += strings.Replace(fmt.Sprintf("%v", f), "PodSpec", "v1.PodSpec", 1) + ","
+	}
+	repeatedStringForPodSpecs += "}"
+	repeatedStringForIngress := "[]*IngressConfig{"
+	for _, f := range this.Ingress {
+		repeatedStringForIngress += strings.Replace(f.String(), "IngressConfig", "IngressConfig", 1) + ","
+	}
+	repeatedStringForIngress += "}"
+	repeatedStringForServices := "[]*ServiceConfig{"
+	for _, f := range this.Services {
+		repeatedStringForServices += strings.Replace(f.String(), "ServiceConfig", "ServiceConfig", 1) + ","
+	}
+	repeatedStringForServices += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k, _ := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	keysForRequiredNodeLabels := make([]string, 0, len(this.RequiredNodeLabels))
+	for k, _ := range this.RequiredNodeLabels {
+		keysForRequiredNodeLabels = append(keysForRequiredNodeLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredNodeLabels)
+	mapStringForRequiredNodeLabels := "map[string]string{"
+	for _, k := range keysForRequiredNodeLabels {
+		mapStringForRequiredNodeLabels += fmt.Sprintf("%v: %v,", k, this.RequiredNodeLabels[k])
+	}
+	mapStringForRequiredNodeLabels += "}"
+	s := strings.Join([]string{`&JobSubmitRequestItem{`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`PodSpec:` + strings.Replace(fmt.Sprintf("%v", this.PodSpec), "PodSpec", "v1.PodSpec", 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`RequiredNodeLabels:` + mapStringForRequiredNodeLabels + `,`,
+		`PodSpecs:` + repeatedStringForPodSpecs + `,`,
+		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
+		`Ingress:` + repeatedStringForIngress + `,`,
+		`Services:` + repeatedStringForServices + `,`,
+		`Scheduler:` + fmt.Sprintf("%v", this.Scheduler) + `,`,
+		`QueueTtlSeconds:` + fmt.Sprintf("%v", this.QueueTtlSeconds) + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
+		`ArraySize:` + fmt.Sprintf("%v", this.ArraySize) + `,`,
+		`RetryPolicy:` + strings.Replace(this.RetryPolicy.String(), "RetryPolicy", "RetryPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryPolicy{`,
+		`MaxAttempts:` + fmt.Sprintf("%v", this.MaxAttempts) + `,`,
+		`BackoffSeconds:` + fmt.Sprintf("%v", this.BackoffSeconds) + `,`,
+		`RetryOnExitCodes:` + fmt.Sprintf("%v", this.RetryOnExitCodes) + `,`,
+		`RetryOnFailureCategories:` + fmt.Sprintf("%v", this.RetryOnFailureCategories) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IngressConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k, _ := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&IngressConfig{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Ports:` + fmt.Sprintf("%v", this.Ports) + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`TlsEnabled:` + fmt.Sprintf("%v", this.TlsEnabled) + `,`,
+		`CertName:` + fmt.Sprintf("%v", this.CertName) + `,`,
+		`UseClusterIP:` + fmt.Sprintf("%v", this.UseClusterIP) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceConfig{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Ports:` + fmt.Sprintf("%v", this.Ports) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobRequestItems := "[]*JobSubmitRequestItem{"
+	for _, f := range this.JobRequestItems {
+		repeatedStringForJobRequestItems += strings.Replace(f.String(), "JobSubmitRequestItem", "JobSubmitRequestItem", 1) + ","
+	}
+	repeatedStringForJobRequestItems += "}"
+	s := strings.Join([]string{`&JobSubmitRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`JobRequestItems:` + repeatedStringForJobRequestItems + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetCancelRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetCancelRequest{`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Filter:` + strings.Replace(this.Filter.String(), "JobSetFilter", "JobSetFilter", 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetFilter{`,
+		`States:` + fmt.Sprintf("%v", this.States) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReprioritizeRequest{`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizeByFilterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReprioritizeByFilterRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`Filter:` + strings.Replace(this.Filter.String(), "JobSetFilter", "JobSetFilter", 1) + `,`,
+		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobResubmitRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobResubmitRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceRecommendationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceRecommendationRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`Percentile:` + fmt.Sprintf("%v", this.Percentile) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceRecommendation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRequests := make([]string, 0, len(this.Requests))
+	for k, _ := range this.Requests {
+		keysForRequests = append(keysForRequests, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequests)
+	mapStringForRequests := "map[string]resource.Quantity{"
+	for _, k := range keysForRequests {
+		mapStringForRequests += fmt.Sprintf("%v: %v,", k, this.Requests[k])
+	}
+	mapStringForRequests += "}"
+	keysForCurrentRequests := make([]string, 0, len(this.CurrentRequests))
+	for k, _ := range this.CurrentRequests {
+		keysForCurrentRequests = append(keysForCurrentRequests, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCurrentRequests)
+	mapStringForCurrentRequests := "map[string]resource.Quantity{"
+	for _, k := range keysForCurrentRequests {
+		mapStringForCurrentRequests += fmt.Sprintf("%v: %v,", k, this.CurrentRequests[k])
+	}
+	mapStringForCurrentRequests += "}"
+	s := strings.Join([]string{`&ResourceRecommendation{`,
+		`Requests:` + mapStringForRequests + `,`,
+		`CurrentRequests:` + mapStringForCurrentRequests + `,`,
+		`NumJobs:` + fmt.Sprintf("%v", this.NumJobs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetSuspendRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetSuspendRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetResumeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetResumeRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForReprioritizationResults := make([]string, 0, len(this.ReprioritizationResults))
+	for k, _ := range this.ReprioritizationResults {
+		keysForReprioritizationResults = append(keysForReprioritizationResults, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForReprioritizationResults)
+	mapStringForReprioritizationResults := "map[string]string{"
+	for _, k := range keysForReprioritizationResults {
+		mapStringForReprioritizationResults += fmt.Sprintf("%v: %v,", k, this.ReprioritizationResults[k])
+	}
+	mapStringForReprioritizationResults += "}"
+	s := strings.Join([]string{`&JobReprioritizeResponse{`,
+		`ReprioritizationResults:` + mapStringForReprioritizationResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitResponseItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSubmitResponseItem{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`ArrayId:` + fmt.Sprintf("%v", this.ArrayId) + `,`,
+		`ArrayJobIds:` + fmt.Sprintf("%v", this.ArrayJobIds) + `,`,
+		`MultiPodJobId:` + fmt.Sprintf("%v", this.MultiPodJobId) + `,`,
+		`PodJobIds:` + fmt.Sprintf("%v", this.PodJobIds) + `,`,
+		`Warnings:` + fmt.Sprintf("%v", this.Warnings) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobResponseItems := "[]*JobSubmitResponseItem{"
+	for _, f := range this.JobResponseItems {
+		repeatedStringForJobResponseItems += strings.Replace(f.String(), "JobSubmitResponseItem", "JobSubmitResponseItem", 1) + ","
+	}
+	repeatedStringForJobResponseItems += "}"
+	s := strings.Join([]string{`&JobSubmitResponse{`,
+		`JobResponseItems:` + repeatedStringForJobResponseItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobValidateResponseItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobValidateResponseItem{`,
+		`Errors:` + fmt.Sprintf("%v", this.Errors) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobValidateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobResponseItems := "[]*JobValidateResponseItem{"
+	for _, f := range this.JobResponseItems {
+		repeatedStringForJobResponseItems += strings.Replace(f.String(), "JobValidateResponseItem", "JobValidateResponseItem", 1) + ","
+	}
+	repeatedStringForJobResponseItems += "}"
+	s := strings.Join([]string{`&JobValidateResponse{`,
+		`Valid:` + fmt.Sprintf("%v", this.Valid) + `,`,
+		`Errors:` + fmt.Sprintf("%v", this.Errors) + `,`,
+		`JobResponseItems:` + repeatedStringForJobResponseItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Queue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPermissions := "[]*Queue_Permissions{"
+	for _, f := range this.Permissions {
+		repeatedStringForPermissions += strings.Replace(fmt.Sprintf("%v", f), "Queue_Permissions", "Queue_Permissions", 1) + ","
+	}
+	repeatedStringForPermissions += "}"
+	keysForResourceLimits := make([]string, 0, len(this.ResourceLimits))
+	for k, _ := range this.ResourceLimits {
+		keysForResourceLimits = append(keysForResourceLimits, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceLimits)
+	mapStringForResourceLimits := "map[string]float64{"
+	for _, k := range keysForResourceLimits {
+		mapStringForResourceLimits += fmt.Sprintf("%v: %v,", k, this.ResourceLimits[k])
+	}
+	mapStringForResourceLimits += "}"
+	s := strings.Join([]string{`&Queue{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`PriorityFactor:` + fmt.Sprintf("%v", this.PriorityFactor) + `,`,
+		`UserOwners:` + fmt.Sprintf("%v", this.UserOwners) + `,`,
+		`GroupOwners:` + fmt.Sprintf("%v", this.GroupOwners) + `,`,
+		`ResourceLimits:` + mapStringForResourceLimits + `,`,
+		`Permissions:` + repeatedStringForPermissions + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Queue_Permissions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubjects := "[]*Queue_Permissions_Subject{"
+	for _, f := range this.Subjects {
+		repeatedStringForSubjects += strings.Replace(fmt.Sprintf("%v", f), "Queue_Permissions_Subject", "Queue_Permissions_Subject", 1) + ","
+	}
+	repeatedStringForSubjects += "}"
+	s := strings.Join([]string{`&Queue_Permissions{`,
+		`Subjects:` + repeatedStringForSubjects + `,`,
+		`Verbs:` + fmt.Sprintf("%v", this.Verbs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Queue_Permissions_Subject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Queue_Permissions_Subject{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*Queue{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(f.String(), "Queue", "Queue", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&QueueList{`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancellationResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancellationResult{`,
+		`CancelledIds:` + fmt.Sprintf("%v", this.CancelledIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueGetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueGetRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamingQueueGetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamingQueueGetRequest{`,
+		`Num:` + fmt.Sprintf("%v", this.Num) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueInfoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueInfoRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueDeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueDeleteRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActiveJobSets := "[]*JobSetInfo{"
+	for _, f := range this.ActiveJobSets {
+		repeatedStringForActiveJobSets += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	}
+	repeatedStringForActiveJobSets += "}"
+	s := strings.Join([]string{`&QueueInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ActiveJobSets:` + repeatedStringForActiveJobSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`QueuedJobs:` + fmt.Sprintf("%v", this.QueuedJobs) + `,`,
+		`LeasedJobs:` + fmt.Sprintf("%v", this.LeasedJobs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueUpdateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueUpdateResponse{`,
+		`Queue:` + strings.Replace(this.Queue.String(), "Queue", "Queue", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchQueueUpdateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFailedQueues := "[]*QueueUpdateResponse{"
+	for _, f := range this.FailedQueues {
+		repeatedStringForFailedQueues += strings.Replace(f.String(), "QueueUpdateResponse", "QueueUpdateResponse", 1) + ","
+	}
+	repeatedStringForFailedQueues += "}"
+	s := strings.Join([]string{`&BatchQueueUpdateResponse{`,
+		`FailedQueues:` + repeatedStringForFailedQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueCreateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueCreateResponse{`,
+		`Queue:` + strings.Replace(this.Queue.String(), "Queue", "Queue", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchQueueCreateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFailedQueues := "[]*QueueCreateResponse{"
+	for _, f := range this.FailedQueues {
+		repeatedStringForFailedQueues += strings.Replace(f.String(), "QueueCreateResponse", "QueueCreateResponse", 1) + ","
+	}
+	repeatedStringForFailedQueues += "}"
+	s := strings.Join([]string{`&BatchQueueCreateResponse{`,
+		`FailedQueues:` + repeatedStringForFailedQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndMarker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndMarker{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamingQueueMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamingQueueMessage{`,
+		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamingQueueMessage_Queue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamingQueueMessage_Queue{`,
 		`Queue:` + strings.Replace(fmt.Sprintf("%v", this.Queue), "Queue", "Queue", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StreamingQueueMessage_End) String() string {
-	if this == nil {
-		return "nil"
+func (this *StreamingQueueMessage_End) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamingQueueMessage_End{`,
+		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "EndMarker", "EndMarker", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSubmit(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Priority = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v1.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredNodeLabels == nil {
+				m.RequiredNodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredNodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
+			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, &IngressConfig{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceConfig{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheduler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueTtlSeconds", wireType)
+			}
+			m.QueueTtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueTtlSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArraySize", wireType)
+			}
+			m.ArraySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArraySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffSeconds", wireType)
+			}
+			m.BackoffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryOnExitCodes = append(m.RetryOnExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetryOnExitCodes) == 0 {
+					m.RetryOnExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryOnExitCodes = append(m.RetryOnExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOnExitCodes", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v FailureCategory
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= FailureCategory(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryOnFailureCategories = append(m.RetryOnFailureCategories, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RetryOnFailureCategories) == 0 {
+					m.RetryOnFailureCategories = make([]FailureCategory, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v FailureCategory
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= FailureCategory(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryOnFailureCategories = append(m.RetryOnFailureCategories, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOnFailureCategories", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngressConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngressConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngressConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= IngressType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TlsEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseClusterIP", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseClusterIP = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ServiceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
+			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &JobSetFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&StreamingQueueMessage_End{`,
-		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "EndMarker", "EndMarker", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringSubmit(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4935,15 +9134,111 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -4951,12 +9246,62 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Priority = float64(math.Float64frombits(v))
-		case 2:
+			m.NewPriority = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizeByFilterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizeByFilterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizeByFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -4966,31 +9311,27 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodSpec == nil {
-				m.PodSpec = &v1.PodSpec{}
-			}
-			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5018,13 +9359,13 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5034,122 +9375,27 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5176,109 +9422,79 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Filter == nil {
+				m.Filter = &JobSetFilter{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobResubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobResubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobResubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5288,124 +9504,29 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredNodeLabels == nil {
-				m.RequiredNodeLabels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.RequiredNodeLabels[mapkey] = mapvalue
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5415,29 +9536,27 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
-			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5465,13 +9584,63 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceRecommendationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceRecommendationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceRecommendationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5481,31 +9650,29 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, &IngressConfig{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5515,29 +9682,27 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &ServiceConfig{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5565,27 +9730,19 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scheduler = string(dAtA[iNdEx:postIndex])
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueTtlSeconds", wireType)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
 			}
-			m.QueueTtlSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueueTtlSeconds |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Percentile = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -5607,7 +9764,7 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IngressConfig) Unmarshal(dAtA []byte) error {
+func (m *ResourceRecommendation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5630,110 +9787,15 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IngressConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRecommendation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IngressConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRecommendation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= IngressType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ports = append(m.Ports, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ports) == 0 {
-					m.Ports = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ports = append(m.Ports, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5760,11 +9822,11 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Requests == nil {
+				m.Requests = make(map[string]resource.Quantity)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -5813,7 +9875,7 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSubmit
@@ -5823,24 +9885,26 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -5856,33 +9920,13 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Requests[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TlsEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TlsEnabled = bool(v != 0)
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRequests", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5892,116 +9936,29 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseClusterIP", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseClusterIP = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= ServiceType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.CurrentRequests == nil {
+				m.CurrentRequests = make(map[string]resource.Quantity)
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint32
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowSubmit
@@ -6011,51 +9968,43 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= uint32(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.Ports = append(m.Ports, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
 					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
 					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ports) == 0 {
-					m.Ports = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSubmit
@@ -6065,15 +10014,61 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint32(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Ports = append(m.Ports, v)
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CurrentRequests[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumJobs", wireType)
+			}
+			m.NumJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -6096,7 +10091,7 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
+func (m *JobSetSuspendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6119,10 +10114,10 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetSuspendRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetSuspendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6191,9 +10186,9 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -6203,25 +10198,23 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
-			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6244,7 +10237,7 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *JobSetResumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6267,15 +10260,15 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetResumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6303,7 +10296,7 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6337,43 +10330,61 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritizationResults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -6383,55 +10394,118 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if m.ReprioritizationResults == nil {
+				m.ReprioritizationResults = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ReprioritizationResults[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6454,7 +10528,7 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6477,15 +10551,15 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6513,11 +10587,11 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6545,13 +10619,13 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -6561,31 +10635,27 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &JobSetFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ArrayId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayJobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6607,186 +10677,17 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthSubmit
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v JobState
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= JobState(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.States = append(m.States, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.States) == 0 {
-					m.States = make([]JobState, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v JobState
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= JobState(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.States = append(m.States, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ArrayJobIds = append(m.ArrayJobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiPodJobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6814,11 +10715,11 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			m.MultiPodJobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodJobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6846,11 +10747,11 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.PodJobIds = append(m.PodJobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6878,19 +10779,8 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -6912,7 +10802,7 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
+func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6935,15 +10825,15 @@ func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritizationResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6970,103 +10860,10 @@ func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReprioritizationResults == nil {
-				m.ReprioritizationResults = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
+			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ReprioritizationResults[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7089,7 +10886,7 @@ func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
+func (m *JobValidateResponseItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7112,47 +10909,15 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobValidateResponseItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobValidateResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7180,7 +10945,7 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7203,7 +10968,7 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
+func (m *JobValidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7226,13 +10991,65 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobValidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
 			}
@@ -7261,7 +11078,7 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
+			m.JobResponseItems = append(m.JobResponseItems, &JobValidateResponseItem{})
 			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -7563,6 +11380,25 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= QueueState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
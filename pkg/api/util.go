@@ -7,7 +7,6 @@ import (
 	time "time"
 
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 	v1 "k8s.io/api/core/v1"
 
@@ -27,6 +26,8 @@ func (s JobState) IsTerminal() bool {
 		return true
 	case JobState_FAILED:
 		return true
+	case JobState_CANCELLED:
+		return true
 	}
 	return false
 }
@@ -83,6 +84,7 @@ func NewNodeFromNodeInfo(nodeInfo *NodeInfo, executor string, allowedPriorities
 		Unschedulable:                    nodeInfo.Unschedulable,
 		ResourceUsageByQueue:             resourceUsageByQueue,
 		ReportingNodeType:                nodeInfo.NodeType,
+		StorageClasses:                   nodeInfo.StorageClasses,
 	}, nil
 }
 
@@ -131,7 +133,7 @@ func (job *Job) GetPodRequirements(priorityClasses map[string]types.PriorityClas
 		// Ignore this error if priorityByPriorityClassName is explicitly set to nil.
 		// We assume that in this case the caller is sure the priority does not need to be set.
 		err := errors.Errorf("unknown priorityClassName %s", podSpec.PriorityClassName)
-		logging.WithStacktrace(logrus.NewEntry(logrus.New()), err).Error("failed to get priority from priorityClassName")
+		logging.WithStacktrace(logging.StandardLogger(), err).Error("failed to get priority from priorityClassName")
 	}
 
 	preemptionPolicy := string(v1.PreemptLowerPriority)
@@ -140,14 +142,33 @@ func (job *Job) GetPodRequirements(priorityClasses map[string]types.PriorityClas
 	}
 
 	return &schedulerobjects.PodRequirements{
-		NodeSelector:         podSpec.NodeSelector,
-		Affinity:             podSpec.Affinity,
-		Tolerations:          podSpec.Tolerations,
-		Annotations:          maps.Clone(job.Annotations),
-		Priority:             priority,
-		PreemptionPolicy:     preemptionPolicy,
-		ResourceRequirements: job.GetResourceRequirements(),
+		NodeSelector:           podSpec.NodeSelector,
+		Affinity:               podSpec.Affinity,
+		Tolerations:            podSpec.Tolerations,
+		Annotations:            maps.Clone(job.Annotations),
+		Priority:               priority,
+		PreemptionPolicy:       preemptionPolicy,
+		ResourceRequirements:   job.GetResourceRequirements(),
+		PersistentVolumeClaims: PersistentVolumeClaimRequirementsFromPodSpec(podSpec),
+	}
+}
+
+// PersistentVolumeClaimRequirementsFromPodSpec returns the persistent volume claims created for a pod,
+// i.e., those of its generic ephemeral volumes, for which storage must be provisioned on the node the pod is bound to.
+// Volumes referring to existing claims aren't included, since their storage class isn't known until the pod is created.
+func PersistentVolumeClaimRequirementsFromPodSpec(podSpec *v1.PodSpec) []*schedulerobjects.PersistentVolumeClaimRequirements {
+	var rv []*schedulerobjects.PersistentVolumeClaimRequirements
+	for _, volume := range podSpec.Volumes {
+		if volume.Ephemeral == nil || volume.Ephemeral.VolumeClaimTemplate == nil {
+			continue
+		}
+		claim := &schedulerobjects.PersistentVolumeClaimRequirements{Name: volume.Name}
+		if storageClassName := volume.Ephemeral.VolumeClaimTemplate.Spec.StorageClassName; storageClassName != nil {
+			claim.StorageClassName = *storageClassName
+		}
+		rv = append(rv, claim)
 	}
+	return rv
 }
 
 // SchedulingResourceRequirementsFromPodSpec returns resource requests and limits necessary for scheduling a pod.
@@ -158,9 +179,10 @@ func (job *Job) GetPodRequirements(priorityClasses map[string]types.PriorityClas
 //	sum across all containers,
 //	max over all init containers,
 //
-// )
+// ) + pod overhead
 //
-// This is because containers run in parallel, whereas initContainers run serially.
+// This is because containers, including any sidecars, run in parallel, whereas initContainers run serially.
+// The pod overhead, e.g., of a sandboxed runtime, is accounted for by Kubernetes in addition to the containers.
 func SchedulingResourceRequirementsFromPodSpec(podSpec *v1.PodSpec) v1.ResourceRequirements {
 	rv := v1.ResourceRequirements{
 		Requests: make(v1.ResourceList),
@@ -190,6 +212,14 @@ func SchedulingResourceRequirementsFromPodSpec(podSpec *v1.PodSpec) v1.ResourceR
 			}
 		}
 	}
+	for t, overhead := range podSpec.Overhead {
+		q := rv.Requests[t]
+		q.Add(overhead)
+		rv.Requests[t] = q
+		q = rv.Limits[t]
+		q.Add(overhead)
+		rv.Limits[t] = q
+	}
 	return rv
 }
 
@@ -404,6 +434,8 @@ func JobIdFromApiEvent(msg *EventMessage) string {
 		return e.Updated.JobId
 	case *EventMessage_Preempted:
 		return e.Preempted.JobId
+	case *EventMessage_Artifacts:
+		return e.Artifacts.JobId
 	}
 	return ""
 }
@@ -450,6 +482,8 @@ func JobSetIdFromApiEvent(msg *EventMessage) string {
 		return e.Updated.JobSetId
 	case *EventMessage_Preempted:
 		return e.Preempted.JobSetId
+	case *EventMessage_Artifacts:
+		return e.Artifacts.JobSetId
 	}
 	return ""
 }
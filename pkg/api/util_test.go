@@ -31,6 +31,13 @@ var (
 	TestPriorities           = []int32{0, 1, 2, 3}
 )
 
+func TestJobState_IsTerminal(t *testing.T) {
+	for state := range JobState_name {
+		expected := JobState(state) == JobState_SUCCEEDED || JobState(state) == JobState_FAILED || JobState(state) == JobState_CANCELLED
+		assert.Equal(t, expected, JobState(state).IsTerminal(), JobState(state).String())
+	}
+}
+
 func TestSchedulingResourceRequirementsFromPodSpec(t *testing.T) {
 	tests := map[string]struct {
 		input    *v1.PodSpec
@@ -246,6 +253,44 @@ func TestSchedulingResourceRequirementsFromPodSpec(t *testing.T) {
 				},
 			},
 		},
+		"containers, initContainers, and overhead": {
+			input: &v1.PodSpec{
+				Containers: []v1.Container{
+					{
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{"cpu": QuantityWithMilliValue(2), "memory": QuantityWithMilliValue(2)},
+							Limits:   v1.ResourceList{"cpu": QuantityWithMilliValue(2), "memory": QuantityWithMilliValue(2)},
+						},
+					},
+					// Sidecar.
+					{
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{"cpu": QuantityWithMilliValue(1), "memory": QuantityWithMilliValue(1)},
+							Limits:   v1.ResourceList{"cpu": QuantityWithMilliValue(1), "memory": QuantityWithMilliValue(1)},
+						},
+					},
+				},
+				InitContainers: []v1.Container{
+					{
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{"cpu": QuantityWithMilliValue(5), "memory": QuantityWithMilliValue(1)},
+							Limits:   v1.ResourceList{"cpu": QuantityWithMilliValue(5), "memory": QuantityWithMilliValue(1)},
+						},
+					},
+				},
+				Overhead: v1.ResourceList{"cpu": QuantityWithMilliValue(1), "memory": QuantityWithMilliValue(1)},
+			},
+			expected: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					"cpu":    QuantityWithMilliValue(6),
+					"memory": QuantityWithMilliValue(4),
+				},
+				Limits: v1.ResourceList{
+					"cpu":    QuantityWithMilliValue(6),
+					"memory": QuantityWithMilliValue(4),
+				},
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -442,6 +487,51 @@ func TestJobGetPodRequirements(t *testing.T) {
 	}
 }
 
+func TestPersistentVolumeClaimRequirementsFromPodSpec(t *testing.T) {
+	fast := "fast"
+	podSpec := &v1.PodSpec{
+		Volumes: []v1.Volume{
+			{
+				Name: "scratch",
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						VolumeClaimTemplate: &v1.PersistentVolumeClaimTemplate{
+							Spec: v1.PersistentVolumeClaimSpec{StorageClassName: &fast},
+						},
+					},
+				},
+			},
+			{
+				Name: "default",
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						VolumeClaimTemplate: &v1.PersistentVolumeClaimTemplate{},
+					},
+				},
+			},
+			{
+				Name: "existing",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "existing"},
+				},
+			},
+			{
+				Name:         "empty",
+				VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+			},
+		},
+	}
+	assert.Equal(
+		t,
+		[]*schedulerobjects.PersistentVolumeClaimRequirements{
+			{Name: "scratch", StorageClassName: "fast"},
+			{Name: "default"},
+		},
+		PersistentVolumeClaimRequirementsFromPodSpec(podSpec),
+	)
+	assert.Nil(t, PersistentVolumeClaimRequirementsFromPodSpec(&v1.PodSpec{}))
+}
+
 func TestPriorityFromPodSpec(t *testing.T) {
 	tests := map[string]struct {
 		podSpec          *v1.PodSpec
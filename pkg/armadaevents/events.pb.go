@@ -62,6 +62,50 @@ func (JobState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6aab92ca59e015f8, []int{0}
 }
 
+// Fixed taxonomy of why a job or job run failed.
+type FailureCategory int32
+
+const (
+	// The failure couldn't be categorised.
+	FailureCategory_UnknownFailureCategory FailureCategory = 0
+	// A container was killed for exceeding its memory limit.
+	FailureCategory_OOMKilled FailureCategory = 1
+	// The image of a container couldn't be pulled.
+	FailureCategory_ImagePull FailureCategory = 2
+	// The node the job was running on was lost, e.g., because it failed, was removed, or evicted the job.
+	FailureCategory_NodeLost FailureCategory = 3
+	// A container exited with a non-zero exit code.
+	FailureCategory_UserError FailureCategory = 4
+	// The job exceeded its deadline.
+	FailureCategory_Deadline FailureCategory = 5
+)
+
+var FailureCategory_name = map[int32]string{
+	0: "UnknownFailureCategory",
+	1: "OOMKilled",
+	2: "ImagePull",
+	3: "NodeLost",
+	4: "UserError",
+	5: "Deadline",
+}
+
+var FailureCategory_value = map[string]int32{
+	"UnknownFailureCategory": 0,
+	"OOMKilled":              1,
+	"ImagePull":              2,
+	"NodeLost":               3,
+	"UserError":              4,
+	"Deadline":               5,
+}
+
+func (x FailureCategory) String() string {
+	return proto.EnumName(FailureCategory_name, int32(x))
+}
+
+func (FailureCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{1}
+}
+
 // Reason reported by Kubernetes.
 type KubernetesReason int32
 
@@ -91,7 +135,43 @@ func (x KubernetesReason) String() string {
 }
 
 func (KubernetesReason) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{1}
+	return fileDescriptor_6aab92ca59e015f8, []int{2}
+}
+
+// Reason for the scheduler preempting a job run.
+type PreemptionReason int32
+
+const (
+	// The reason is unknown, e.g., because the job run was preempted by an older version of the scheduler.
+	PreemptionReason_UnknownPreemptionReason PreemptionReason = 0
+	// Preempted to balance resources between queues according to their fair share.
+	PreemptionReason_FairShare PreemptionReason = 1
+	// Preempted to make room for jobs of a higher priority class, i.e., a more urgent job.
+	PreemptionReason_Urgency PreemptionReason = 2
+	// Preempted because the node it was running on was marked for maintenance and is being drained.
+	PreemptionReason_NodeDrain PreemptionReason = 3
+)
+
+var PreemptionReason_name = map[int32]string{
+	0: "UnknownPreemptionReason",
+	1: "FairShare",
+	2: "Urgency",
+	3: "NodeDrain",
+}
+
+var PreemptionReason_value = map[string]int32{
+	"UnknownPreemptionReason": 0,
+	"FairShare":               1,
+	"Urgency":                 2,
+	"NodeDrain":               3,
+}
+
+func (x PreemptionReason) String() string {
+	return proto.EnumName(PreemptionReason_name, int32(x))
+}
+
+func (PreemptionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{3}
 }
 
 // Message representing a sequence of state transitions.
@@ -109,6 +189,9 @@ type EventSequence struct {
 	Groups []string `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
 	// For efficiency, we bundle several events (i.e., state transitions) in a single log message.
 	Events []*EventSequence_Event `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	// Version of the schema the sequence was produced with, or 0 for sequences produced before versioning was introduced.
+	// Consumers translate sequences of older versions to the version they understand; see eventutil.MigrateEventSequence.
+	SchemaVersion uint32 `protobuf:"varint,6,opt,name=schema_version,json=schemaVersion,proto3" json:"schemaVersion,omitempty"`
 }
 
 func (m *EventSequence) Reset()         { *m = EventSequence{} }
@@ -179,6 +262,13 @@ func (m *EventSequence) GetEvents() []*EventSequence_Event {
 	return nil
 }
 
+func (m *EventSequence) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
 // List of possible events, i.e., state transitions.
 type EventSequence_Event struct {
 	Created *time.Time `protobuf:"bytes,18,opt,name=created,proto3,stdtime" json:"created,omitempty"`
@@ -204,6 +294,10 @@ type EventSequence_Event struct {
 	//	*EventSequence_Event_PartitionMarker
 	//	*EventSequence_Event_JobRunPreemptionRequested
 	//	*EventSequence_Event_JobRequeued
+	//	*EventSequence_Event_SuspendJobSet
+	//	*EventSequence_Event_ResumeJobSet
+	//	*EventSequence_Event_JobUnschedulable
+	//	*EventSequence_Event_JobRunArtifacts
 	Event isEventSequence_Event_Event `protobuf_oneof:"event"`
 }
 
@@ -309,6 +403,18 @@ type EventSequence_Event_JobRunPreemptionRequested struct {
 type EventSequence_Event_JobRequeued struct {
 	JobRequeued *JobRequeued `protobuf:"bytes,22,opt,name=jobRequeued,proto3,oneof" json:"jobRequeued,omitempty"`
 }
+type EventSequence_Event_SuspendJobSet struct {
+	SuspendJobSet *SuspendJobSet `protobuf:"bytes,23,opt,name=suspendJobSet,proto3,oneof" json:"suspendJobSet,omitempty"`
+}
+type EventSequence_Event_ResumeJobSet struct {
+	ResumeJobSet *ResumeJobSet `protobuf:"bytes,24,opt,name=resumeJobSet,proto3,oneof" json:"resumeJobSet,omitempty"`
+}
+type EventSequence_Event_JobUnschedulable struct {
+	JobUnschedulable *JobUnschedulable `protobuf:"bytes,25,opt,name=jobUnschedulable,proto3,oneof" json:"jobUnschedulable,omitempty"`
+}
+type EventSequence_Event_JobRunArtifacts struct {
+	JobRunArtifacts *JobRunArtifacts `protobuf:"bytes,26,opt,name=jobRunArtifacts,proto3,oneof" json:"jobRunArtifacts,omitempty"`
+}
 
 func (*EventSequence_Event_SubmitJob) isEventSequence_Event_Event()                 {}
 func (*EventSequence_Event_ReprioritiseJob) isEventSequence_Event_Event()           {}
@@ -331,6 +437,10 @@ func (*EventSequence_Event_JobRunPreempted) isEventSequence_Event_Event()
 func (*EventSequence_Event_PartitionMarker) isEventSequence_Event_Event()           {}
 func (*EventSequence_Event_JobRunPreemptionRequested) isEventSequence_Event_Event() {}
 func (*EventSequence_Event_JobRequeued) isEventSequence_Event_Event()               {}
+func (*EventSequence_Event_SuspendJobSet) isEventSequence_Event_Event()             {}
+func (*EventSequence_Event_ResumeJobSet) isEventSequence_Event_Event()              {}
+func (*EventSequence_Event_JobUnschedulable) isEventSequence_Event_Event()          {}
+func (*EventSequence_Event_JobRunArtifacts) isEventSequence_Event_Event()           {}
 
 func (m *EventSequence_Event) GetEvent() isEventSequence_Event_Event {
 	if m != nil {
@@ -493,6 +603,34 @@ func (m *EventSequence_Event) GetJobRequeued() *JobRequeued {
 	return nil
 }
 
+func (m *EventSequence_Event) GetSuspendJobSet() *SuspendJobSet {
+	if x, ok := m.GetEvent().(*EventSequence_Event_SuspendJobSet); ok {
+		return x.SuspendJobSet
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetResumeJobSet() *ResumeJobSet {
+	if x, ok := m.GetEvent().(*EventSequence_Event_ResumeJobSet); ok {
+		return x.ResumeJobSet
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobUnschedulable() *JobUnschedulable {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobUnschedulable); ok {
+		return x.JobUnschedulable
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobRunArtifacts() *JobRunArtifacts {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobRunArtifacts); ok {
+		return x.JobRunArtifacts
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventSequence_Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -517,6 +655,10 @@ func (*EventSequence_Event) XXX_OneofWrappers() []interface{} {
 		(*EventSequence_Event_PartitionMarker)(nil),
 		(*EventSequence_Event_JobRunPreemptionRequested)(nil),
 		(*EventSequence_Event_JobRequeued)(nil),
+		(*EventSequence_Event_SuspendJobSet)(nil),
+		(*EventSequence_Event_ResumeJobSet)(nil),
+		(*EventSequence_Event_JobUnschedulable)(nil),
+		(*EventSequence_Event_JobRunArtifacts)(nil),
 	}
 }
 
@@ -527,6 +669,8 @@ type ResourceUtilisation struct {
 	ResourceInfo          *KubernetesResourceInfo      `protobuf:"bytes,3,opt,name=resource_info,json=resourceInfo,proto3" json:"resourceInfo,omitempty"`
 	MaxResourcesForPeriod map[string]resource.Quantity `protobuf:"bytes,4,rep,name=max_resources_for_period,json=maxResourcesForPeriod,proto3" json:"maxResourcesForPeriod" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	TotalCumulativeUsage  map[string]resource.Quantity `protobuf:"bytes,5,rep,name=total_cumulative_usage,json=totalCumulativeUsage,proto3" json:"totalCumulativeUsage" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Average usage sampled since the job started running.
+	AverageResources map[string]resource.Quantity `protobuf:"bytes,6,rep,name=average_resources,json=averageResources,proto3" json:"averageResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ResourceUtilisation) Reset()         { *m = ResourceUtilisation{} }
@@ -597,6 +741,13 @@ func (m *ResourceUtilisation) GetTotalCumulativeUsage() map[string]resource.Quan
 	return nil
 }
 
+func (m *ResourceUtilisation) GetAverageResources() map[string]resource.Quantity {
+	if m != nil {
+		return m.AverageResources
+	}
+	return nil
+}
+
 // A UUID, encoded in accordance with section 4.1.2 of RFC 4122
 // (technically equivalent to ITU-T Rec. X.667 and ISO/IEC 9834-8).
 // As of March 2022, this seems to be the most efficient way to include UUIDs in proto messages; see
@@ -1284,6 +1435,88 @@ func (m *JobRequeued) GetUpdateSequenceNumber() int32 {
 	return 0
 }
 
+// Generated by the scheduler for a queued job it tried but failed to schedule, to explain why the job is pending.
+// To limit the number of such messages, one is only generated if the reasons differ from those last reported for the job.
+type JobUnschedulable struct {
+	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Why the job could not be scheduled, e.g., "job does not fit on any node".
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Pool in which the scheduler last tried to schedule the job.
+	Pool string `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Number of nodes considered for the job; zero if the job was rejected before considering any nodes.
+	NumNodes uint32 `protobuf:"varint,4,opt,name=num_nodes,json=numNodes,proto3" json:"numNodes,omitempty"`
+	// Number of nodes the job could not be scheduled on, by the reason why.
+	NumExcludedNodesByReason map[string]uint32 `protobuf:"bytes,5,rep,name=num_excluded_nodes_by_reason,json=numExcludedNodesByReason,proto3" json:"numExcludedNodesByReason,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *JobUnschedulable) Reset()         { *m = JobUnschedulable{} }
+func (m *JobUnschedulable) String() string { return proto.CompactTextString(m) }
+func (*JobUnschedulable) ProtoMessage()    {}
+func (*JobUnschedulable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{10}
+}
+func (m *JobUnschedulable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobUnschedulable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobUnschedulable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobUnschedulable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUnschedulable.Merge(m, src)
+}
+func (m *JobUnschedulable) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobUnschedulable) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUnschedulable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobUnschedulable proto.InternalMessageInfo
+
+func (m *JobUnschedulable) GetJobId() *Uuid {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+func (m *JobUnschedulable) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobUnschedulable) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *JobUnschedulable) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *JobUnschedulable) GetNumExcludedNodesByReason() map[string]uint32 {
+	if m != nil {
+		return m.NumExcludedNodesByReason
+	}
+	return nil
+}
+
 // Set the priority of all jobs part of a job set.
 // This sets the priority of all jobs in the job set currently in the queued state.
 type ReprioritiseJobSet struct {
@@ -1294,7 +1527,7 @@ func (m *ReprioritiseJobSet) Reset()         { *m = ReprioritiseJobSet{} }
 func (m *ReprioritiseJobSet) String() string { return proto.CompactTextString(m) }
 func (*ReprioritiseJobSet) ProtoMessage()    {}
 func (*ReprioritiseJobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{10}
+	return fileDescriptor_6aab92ca59e015f8, []int{11}
 }
 func (m *ReprioritiseJobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1574,7 @@ func (m *ReprioritisedJob) Reset()         { *m = ReprioritisedJob{} }
 func (m *ReprioritisedJob) String() string { return proto.CompactTextString(m) }
 func (*ReprioritisedJob) ProtoMessage()    {}
 func (*ReprioritisedJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{11}
+	return fileDescriptor_6aab92ca59e015f8, []int{12}
 }
 func (m *ReprioritisedJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1629,7 @@ func (m *CancelJob) Reset()         { *m = CancelJob{} }
 func (m *CancelJob) String() string { return proto.CompactTextString(m) }
 func (*CancelJob) ProtoMessage()    {}
 func (*CancelJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{12}
+	return fileDescriptor_6aab92ca59e015f8, []int{13}
 }
 func (m *CancelJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,7 +1682,7 @@ func (m *JobSetFilter) Reset()         { *m = JobSetFilter{} }
 func (m *JobSetFilter) String() string { return proto.CompactTextString(m) }
 func (*JobSetFilter) ProtoMessage()    {}
 func (*JobSetFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{13}
+	return fileDescriptor_6aab92ca59e015f8, []int{14}
 }
 func (m *JobSetFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1496,7 +1729,7 @@ func (m *CancelJobSet) Reset()         { *m = CancelJobSet{} }
 func (m *CancelJobSet) String() string { return proto.CompactTextString(m) }
 func (*CancelJobSet) ProtoMessage()    {}
 func (*CancelJobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{14}
+	return fileDescriptor_6aab92ca59e015f8, []int{15}
 }
 func (m *CancelJobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1539,6 +1772,89 @@ func (m *CancelJobSet) GetReason() string {
 	return ""
 }
 
+// Request to suspend a job set, i.e., to stop scheduling its queued jobs until the job set is resumed.
+// Jobs already leased or running are unaffected.
+type SuspendJobSet struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *SuspendJobSet) Reset()         { *m = SuspendJobSet{} }
+func (m *SuspendJobSet) String() string { return proto.CompactTextString(m) }
+func (*SuspendJobSet) ProtoMessage()    {}
+func (*SuspendJobSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{16}
+}
+func (m *SuspendJobSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuspendJobSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuspendJobSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuspendJobSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendJobSet.Merge(m, src)
+}
+func (m *SuspendJobSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuspendJobSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendJobSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendJobSet proto.InternalMessageInfo
+
+func (m *SuspendJobSet) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Request to resume a suspended job set, such that its queued jobs may be scheduled again.
+type ResumeJobSet struct {
+}
+
+func (m *ResumeJobSet) Reset()         { *m = ResumeJobSet{} }
+func (m *ResumeJobSet) String() string { return proto.CompactTextString(m) }
+func (*ResumeJobSet) ProtoMessage()    {}
+func (*ResumeJobSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{17}
+}
+func (m *ResumeJobSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeJobSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeJobSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeJobSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeJobSet.Merge(m, src)
+}
+func (m *ResumeJobSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeJobSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeJobSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeJobSet proto.InternalMessageInfo
+
 // Generated by the scheduler in response to CancelJob and CancelJobSet.
 // One such message is generated per job that was cancelled.
 type CancelledJob struct {
@@ -1550,7 +1866,7 @@ func (m *CancelledJob) Reset()         { *m = CancelledJob{} }
 func (m *CancelledJob) String() string { return proto.CompactTextString(m) }
 func (*CancelledJob) ProtoMessage()    {}
 func (*CancelledJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{15}
+	return fileDescriptor_6aab92ca59e015f8, []int{18}
 }
 func (m *CancelledJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1605,7 +1921,7 @@ func (m *JobSucceeded) Reset()         { *m = JobSucceeded{} }
 func (m *JobSucceeded) String() string { return proto.CompactTextString(m) }
 func (*JobSucceeded) ProtoMessage()    {}
 func (*JobSucceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{16}
+	return fileDescriptor_6aab92ca59e015f8, []int{19}
 }
 func (m *JobSucceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1979,7 @@ func (m *JobRunLeased) Reset()         { *m = JobRunLeased{} }
 func (m *JobRunLeased) String() string { return proto.CompactTextString(m) }
 func (*JobRunLeased) ProtoMessage()    {}
 func (*JobRunLeased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{17}
+	return fileDescriptor_6aab92ca59e015f8, []int{20}
 }
 func (m *JobRunLeased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1741,7 +2057,7 @@ func (m *JobRunAssigned) Reset()         { *m = JobRunAssigned{} }
 func (m *JobRunAssigned) String() string { return proto.CompactTextString(m) }
 func (*JobRunAssigned) ProtoMessage()    {}
 func (*JobRunAssigned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{18}
+	return fileDescriptor_6aab92ca59e015f8, []int{21}
 }
 func (m *JobRunAssigned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1804,7 +2120,7 @@ func (m *JobRunRunning) Reset()         { *m = JobRunRunning{} }
 func (m *JobRunRunning) String() string { return proto.CompactTextString(m) }
 func (*JobRunRunning) ProtoMessage()    {}
 func (*JobRunRunning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{19}
+	return fileDescriptor_6aab92ca59e015f8, []int{22}
 }
 func (m *JobRunRunning) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,7 +2183,7 @@ func (m *KubernetesResourceInfo) Reset()         { *m = KubernetesResourceInfo{}
 func (m *KubernetesResourceInfo) String() string { return proto.CompactTextString(m) }
 func (*KubernetesResourceInfo) ProtoMessage()    {}
 func (*KubernetesResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{20}
+	return fileDescriptor_6aab92ca59e015f8, []int{23}
 }
 func (m *KubernetesResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1952,13 +2268,15 @@ func (*KubernetesResourceInfo) XXX_OneofWrappers() []interface{} {
 type PodInfo struct {
 	NodeName  string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
 	PodNumber int32  `protobuf:"varint,2,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	// Snapshot of the labels of the node the pod is running on, restricted to those the executor is configured to report.
+	NodeLabels map[string]string `protobuf:"bytes,3,rep,name=node_labels,json=nodeLabels,proto3" json:"nodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *PodInfo) Reset()         { *m = PodInfo{} }
 func (m *PodInfo) String() string { return proto.CompactTextString(m) }
 func (*PodInfo) ProtoMessage()    {}
 func (*PodInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{21}
+	return fileDescriptor_6aab92ca59e015f8, []int{24}
 }
 func (m *PodInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2001,6 +2319,13 @@ func (m *PodInfo) GetPodNumber() int32 {
 	return 0
 }
 
+func (m *PodInfo) GetNodeLabels() map[string]string {
+	if m != nil {
+		return m.NodeLabels
+	}
+	return nil
+}
+
 // Runtime information of an ingress.
 type IngressInfo struct {
 	// TODO: Why a node name?
@@ -2013,7 +2338,7 @@ func (m *IngressInfo) Reset()         { *m = IngressInfo{} }
 func (m *IngressInfo) String() string { return proto.CompactTextString(m) }
 func (*IngressInfo) ProtoMessage()    {}
 func (*IngressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{22}
+	return fileDescriptor_6aab92ca59e015f8, []int{25}
 }
 func (m *IngressInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2076,7 +2401,7 @@ func (m *StandaloneIngressInfo) Reset()         { *m = StandaloneIngressInfo{} }
 func (m *StandaloneIngressInfo) String() string { return proto.CompactTextString(m) }
 func (*StandaloneIngressInfo) ProtoMessage()    {}
 func (*StandaloneIngressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{23}
+	return fileDescriptor_6aab92ca59e015f8, []int{26}
 }
 func (m *StandaloneIngressInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2161,28 +2486,28 @@ func (m *StandaloneIngressInfo) GetPodNamespace() string {
 	return ""
 }
 
-// Indicates that the job finished successfully (i.e., in the expected manner).
-type JobRunSucceeded struct {
+// Generated by the executor once it has uploaded the artifacts of a job run, e.g., the logs of its containers, to object storage.
+type JobRunArtifacts struct {
 	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
 	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// Runtime information, e.g., which node the job is running on, its IP address etc,
-	// for each resource created for the job run.
-	// TODO: remove this once we have fixed the external api
-	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,3,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
+	// ObjectMeta of the pod the artifacts were collected from.
+	ObjectMeta *ObjectMeta `protobuf:"bytes,3,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	PodNumber  int32       `protobuf:"varint,4,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	Artifacts  []*Artifact `protobuf:"bytes,5,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
 }
 
-func (m *JobRunSucceeded) Reset()         { *m = JobRunSucceeded{} }
-func (m *JobRunSucceeded) String() string { return proto.CompactTextString(m) }
-func (*JobRunSucceeded) ProtoMessage()    {}
-func (*JobRunSucceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{24}
+func (m *JobRunArtifacts) Reset()         { *m = JobRunArtifacts{} }
+func (m *JobRunArtifacts) String() string { return proto.CompactTextString(m) }
+func (*JobRunArtifacts) ProtoMessage()    {}
+func (*JobRunArtifacts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{27}
 }
-func (m *JobRunSucceeded) XXX_Unmarshal(b []byte) error {
+func (m *JobRunArtifacts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobRunArtifacts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunSucceeded.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobRunArtifacts.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2192,60 +2517,76 @@ func (m *JobRunSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *JobRunSucceeded) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunSucceeded.Merge(m, src)
+func (m *JobRunArtifacts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunArtifacts.Merge(m, src)
 }
-func (m *JobRunSucceeded) XXX_Size() int {
+func (m *JobRunArtifacts) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunSucceeded) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunSucceeded.DiscardUnknown(m)
+func (m *JobRunArtifacts) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunArtifacts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunSucceeded proto.InternalMessageInfo
+var xxx_messageInfo_JobRunArtifacts proto.InternalMessageInfo
 
-func (m *JobRunSucceeded) GetRunId() *Uuid {
+func (m *JobRunArtifacts) GetRunId() *Uuid {
 	if m != nil {
 		return m.RunId
 	}
 	return nil
 }
 
-func (m *JobRunSucceeded) GetJobId() *Uuid {
+func (m *JobRunArtifacts) GetJobId() *Uuid {
 	if m != nil {
 		return m.JobId
 	}
 	return nil
 }
 
-func (m *JobRunSucceeded) GetResourceInfos() []*KubernetesResourceInfo {
+func (m *JobRunArtifacts) GetObjectMeta() *ObjectMeta {
 	if m != nil {
-		return m.ResourceInfos
+		return m.ObjectMeta
 	}
 	return nil
 }
 
-// Message containing a set of errors associated with a particular job.
-// Contains a flag, which if set to true indicates that the job has failed.
-// Otherwise, this message represents a set of errors from which the system has recovered.
-type JobErrors struct {
-	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// A set of errors that occurred within some context.
-	Errors []*Error `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+func (m *JobRunArtifacts) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
 }
 
-func (m *JobErrors) Reset()         { *m = JobErrors{} }
-func (m *JobErrors) String() string { return proto.CompactTextString(m) }
-func (*JobErrors) ProtoMessage()    {}
-func (*JobErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{25}
+func (m *JobRunArtifacts) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
 }
-func (m *JobErrors) XXX_Unmarshal(b []byte) error {
+
+// An object uploaded to object storage.
+type Artifact struct {
+	// Name of the artifact, e.g., "log".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of the container the artifact was collected from.
+	ContainerName string `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"containerName,omitempty"`
+	// URL of the uploaded object.
+	Url       string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	SizeBytes int64  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+}
+
+func (m *Artifact) Reset()         { *m = Artifact{} }
+func (m *Artifact) String() string { return proto.CompactTextString(m) }
+func (*Artifact) ProtoMessage()    {}
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{28}
+}
+func (m *Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobErrors.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Artifact.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2255,26 +2596,160 @@ func (m *JobErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobErrors) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobErrors.Merge(m, src)
+func (m *Artifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Artifact.Merge(m, src)
 }
-func (m *JobErrors) XXX_Size() int {
+func (m *Artifact) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobErrors) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobErrors.DiscardUnknown(m)
+func (m *Artifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_Artifact.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobErrors proto.InternalMessageInfo
+var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
-func (m *JobErrors) GetJobId() *Uuid {
+func (m *Artifact) GetName() string {
 	if m != nil {
-		return m.JobId
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *JobErrors) GetErrors() []*Error {
+func (m *Artifact) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *Artifact) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Artifact) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+// Indicates that the job finished successfully (i.e., in the expected manner).
+type JobRunSucceeded struct {
+	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Runtime information, e.g., which node the job is running on, its IP address etc,
+	// for each resource created for the job run.
+	// TODO: remove this once we have fixed the external api
+	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,3,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
+}
+
+func (m *JobRunSucceeded) Reset()         { *m = JobRunSucceeded{} }
+func (m *JobRunSucceeded) String() string { return proto.CompactTextString(m) }
+func (*JobRunSucceeded) ProtoMessage()    {}
+func (*JobRunSucceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{29}
+}
+func (m *JobRunSucceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunSucceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRunSucceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunSucceeded.Merge(m, src)
+}
+func (m *JobRunSucceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunSucceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunSucceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRunSucceeded proto.InternalMessageInfo
+
+func (m *JobRunSucceeded) GetRunId() *Uuid {
+	if m != nil {
+		return m.RunId
+	}
+	return nil
+}
+
+func (m *JobRunSucceeded) GetJobId() *Uuid {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+func (m *JobRunSucceeded) GetResourceInfos() []*KubernetesResourceInfo {
+	if m != nil {
+		return m.ResourceInfos
+	}
+	return nil
+}
+
+// Message containing a set of errors associated with a particular job.
+// Contains a flag, which if set to true indicates that the job has failed.
+// Otherwise, this message represents a set of errors from which the system has recovered.
+type JobErrors struct {
+	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// A set of errors that occurred within some context.
+	Errors []*Error `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *JobErrors) Reset()         { *m = JobErrors{} }
+func (m *JobErrors) String() string { return proto.CompactTextString(m) }
+func (*JobErrors) ProtoMessage()    {}
+func (*JobErrors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{30}
+}
+func (m *JobErrors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobErrors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobErrors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobErrors.Merge(m, src)
+}
+func (m *JobErrors) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobErrors) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobErrors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobErrors proto.InternalMessageInfo
+
+func (m *JobErrors) GetJobId() *Uuid {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+func (m *JobErrors) GetErrors() []*Error {
 	if m != nil {
 		return m.Errors
 	}
@@ -2299,7 +2774,7 @@ func (m *JobRunErrors) Reset()         { *m = JobRunErrors{} }
 func (m *JobRunErrors) String() string { return proto.CompactTextString(m) }
 func (*JobRunErrors) ProtoMessage()    {}
 func (*JobRunErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{26}
+	return fileDescriptor_6aab92ca59e015f8, []int{31}
 }
 func (m *JobRunErrors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2374,14 +2849,19 @@ type Error struct {
 	//	*Error_PodTerminated
 	//	*Error_JobRunPreemptedError
 	//	*Error_GangJobUnschedulable
+	//	*Error_ExecutorStale
+	//	*Error_JobDependencyFailed
 	Reason isError_Reason `protobuf_oneof:"reason"`
+	// Category of the failure, for aggregating failures and deciding whether to retry a job.
+	// Set by the component detecting the failure; events written by older components leave it unset.
+	FailureCategory FailureCategory `protobuf:"varint,15,opt,name=failure_category,json=failureCategory,proto3,enum=armadaevents.FailureCategory" json:"failureCategory,omitempty"`
 }
 
 func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{27}
+	return fileDescriptor_6aab92ca59e015f8, []int{32}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2449,6 +2929,12 @@ type Error_JobRunPreemptedError struct {
 type Error_GangJobUnschedulable struct {
 	GangJobUnschedulable *GangJobUnschedulable `protobuf:"bytes,12,opt,name=gangJobUnschedulable,proto3,oneof" json:"gangJobUnschedulable,omitempty"`
 }
+type Error_ExecutorStale struct {
+	ExecutorStale *ExecutorStale `protobuf:"bytes,13,opt,name=executorStale,proto3,oneof" json:"executorStale,omitempty"`
+}
+type Error_JobDependencyFailed struct {
+	JobDependencyFailed *JobDependencyFailed `protobuf:"bytes,14,opt,name=jobDependencyFailed,proto3,oneof" json:"jobDependencyFailed,omitempty"`
+}
 
 func (*Error_KubernetesError) isError_Reason()      {}
 func (*Error_ContainerError) isError_Reason()       {}
@@ -2461,6 +2947,8 @@ func (*Error_PodLeaseReturned) isError_Reason()     {}
 func (*Error_PodTerminated) isError_Reason()        {}
 func (*Error_JobRunPreemptedError) isError_Reason() {}
 func (*Error_GangJobUnschedulable) isError_Reason() {}
+func (*Error_ExecutorStale) isError_Reason()        {}
+func (*Error_JobDependencyFailed) isError_Reason()  {}
 
 func (m *Error) GetReason() isError_Reason {
 	if m != nil {
@@ -2553,6 +3041,27 @@ func (m *Error) GetGangJobUnschedulable() *GangJobUnschedulable {
 	return nil
 }
 
+func (m *Error) GetExecutorStale() *ExecutorStale {
+	if x, ok := m.GetReason().(*Error_ExecutorStale); ok {
+		return x.ExecutorStale
+	}
+	return nil
+}
+
+func (m *Error) GetJobDependencyFailed() *JobDependencyFailed {
+	if x, ok := m.GetReason().(*Error_JobDependencyFailed); ok {
+		return x.JobDependencyFailed
+	}
+	return nil
+}
+
+func (m *Error) GetFailureCategory() FailureCategory {
+	if m != nil {
+		return m.FailureCategory
+	}
+	return FailureCategory_UnknownFailureCategory
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Error) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2567,6 +3076,8 @@ func (*Error) XXX_OneofWrappers() []interface{} {
 		(*Error_PodTerminated)(nil),
 		(*Error_JobRunPreemptedError)(nil),
 		(*Error_GangJobUnschedulable)(nil),
+		(*Error_ExecutorStale)(nil),
+		(*Error_JobDependencyFailed)(nil),
 	}
 }
 
@@ -2582,7 +3093,7 @@ func (m *KubernetesError) Reset()         { *m = KubernetesError{} }
 func (m *KubernetesError) String() string { return proto.CompactTextString(m) }
 func (*KubernetesError) ProtoMessage()    {}
 func (*KubernetesError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{28}
+	return fileDescriptor_6aab92ca59e015f8, []int{33}
 }
 func (m *KubernetesError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2640,7 +3151,7 @@ func (m *PodError) Reset()         { *m = PodError{} }
 func (m *PodError) String() string { return proto.CompactTextString(m) }
 func (*PodError) ProtoMessage()    {}
 func (*PodError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{29}
+	return fileDescriptor_6aab92ca59e015f8, []int{34}
 }
 func (m *PodError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2728,7 +3239,7 @@ func (m *ContainerError) Reset()         { *m = ContainerError{} }
 func (m *ContainerError) String() string { return proto.CompactTextString(m) }
 func (*ContainerError) ProtoMessage()    {}
 func (*ContainerError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{30}
+	return fileDescriptor_6aab92ca59e015f8, []int{35}
 }
 func (m *ContainerError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2804,7 +3315,7 @@ func (m *PodLeaseReturned) Reset()         { *m = PodLeaseReturned{} }
 func (m *PodLeaseReturned) String() string { return proto.CompactTextString(m) }
 func (*PodLeaseReturned) ProtoMessage()    {}
 func (*PodLeaseReturned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{31}
+	return fileDescriptor_6aab92ca59e015f8, []int{36}
 }
 func (m *PodLeaseReturned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2875,7 +3386,7 @@ func (m *PodTerminated) Reset()         { *m = PodTerminated{} }
 func (m *PodTerminated) String() string { return proto.CompactTextString(m) }
 func (*PodTerminated) ProtoMessage()    {}
 func (*PodTerminated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{32}
+	return fileDescriptor_6aab92ca59e015f8, []int{37}
 }
 func (m *PodTerminated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2939,7 +3450,7 @@ func (m *ExecutorError) Reset()         { *m = ExecutorError{} }
 func (m *ExecutorError) String() string { return proto.CompactTextString(m) }
 func (*ExecutorError) ProtoMessage()    {}
 func (*ExecutorError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{33}
+	return fileDescriptor_6aab92ca59e015f8, []int{38}
 }
 func (m *ExecutorError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2980,7 +3491,7 @@ func (m *PodUnschedulable) Reset()         { *m = PodUnschedulable{} }
 func (m *PodUnschedulable) String() string { return proto.CompactTextString(m) }
 func (*PodUnschedulable) ProtoMessage()    {}
 func (*PodUnschedulable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{34}
+	return fileDescriptor_6aab92ca59e015f8, []int{39}
 }
 func (m *PodUnschedulable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3044,7 +3555,7 @@ func (m *LeaseExpired) Reset()         { *m = LeaseExpired{} }
 func (m *LeaseExpired) String() string { return proto.CompactTextString(m) }
 func (*LeaseExpired) ProtoMessage()    {}
 func (*LeaseExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{35}
+	return fileDescriptor_6aab92ca59e015f8, []int{40}
 }
 func (m *LeaseExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3073,6 +3584,61 @@ func (m *LeaseExpired) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LeaseExpired proto.InternalMessageInfo
 
+// Indicates that the executor a job run is assigned to has not reported within the configured timeout,
+// such that its capacity is no longer considered for scheduling.
+// Published as a non-terminal error; the run may be expired later if the executor doesn't recover.
+type ExecutorStale struct {
+	ExecutorId    string     `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executorId,omitempty"`
+	LastHeartbeat *time.Time `protobuf:"bytes,2,opt,name=last_heartbeat,json=lastHeartbeat,proto3,stdtime" json:"lastHeartbeat,omitempty"`
+}
+
+func (m *ExecutorStale) Reset()         { *m = ExecutorStale{} }
+func (m *ExecutorStale) String() string { return proto.CompactTextString(m) }
+func (*ExecutorStale) ProtoMessage()    {}
+func (*ExecutorStale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{41}
+}
+func (m *ExecutorStale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorStale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorStale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorStale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorStale.Merge(m, src)
+}
+func (m *ExecutorStale) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorStale) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorStale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorStale proto.InternalMessageInfo
+
+func (m *ExecutorStale) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
+	}
+	return ""
+}
+
+func (m *ExecutorStale) GetLastHeartbeat() *time.Time {
+	if m != nil {
+		return m.LastHeartbeat
+	}
+	return nil
+}
+
 type MaxRunsExceeded struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
@@ -3081,7 +3647,7 @@ func (m *MaxRunsExceeded) Reset()         { *m = MaxRunsExceeded{} }
 func (m *MaxRunsExceeded) String() string { return proto.CompactTextString(m) }
 func (*MaxRunsExceeded) ProtoMessage()    {}
 func (*MaxRunsExceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{36}
+	return fileDescriptor_6aab92ca59e015f8, []int{42}
 }
 func (m *MaxRunsExceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3690,7 @@ func (m *JobRunPreemptedError) Reset()         { *m = JobRunPreemptedError{} }
 func (m *JobRunPreemptedError) String() string { return proto.CompactTextString(m) }
 func (*JobRunPreemptedError) ProtoMessage()    {}
 func (*JobRunPreemptedError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{37}
+	return fileDescriptor_6aab92ca59e015f8, []int{43}
 }
 func (m *JobRunPreemptedError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3161,7 +3727,7 @@ func (m *GangJobUnschedulable) Reset()         { *m = GangJobUnschedulable{} }
 func (m *GangJobUnschedulable) String() string { return proto.CompactTextString(m) }
 func (*GangJobUnschedulable) ProtoMessage()    {}
 func (*GangJobUnschedulable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{38}
+	return fileDescriptor_6aab92ca59e015f8, []int{44}
 }
 func (m *GangJobUnschedulable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3197,6 +3763,60 @@ func (m *GangJobUnschedulable) GetMessage() string {
 	return ""
 }
 
+// Generated by the scheduler for a queued job if a job it depends on fails or is cancelled.
+type JobDependencyFailed struct {
+	// Id of the dependency that did not succeed.
+	DependencyJobId string `protobuf:"bytes,1,opt,name=dependency_job_id,json=dependencyJobId,proto3" json:"dependencyJobId,omitempty"`
+	Message         string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *JobDependencyFailed) Reset()         { *m = JobDependencyFailed{} }
+func (m *JobDependencyFailed) String() string { return proto.CompactTextString(m) }
+func (*JobDependencyFailed) ProtoMessage()    {}
+func (*JobDependencyFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{45}
+}
+func (m *JobDependencyFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobDependencyFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobDependencyFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobDependencyFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobDependencyFailed.Merge(m, src)
+}
+func (m *JobDependencyFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobDependencyFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobDependencyFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobDependencyFailed proto.InternalMessageInfo
+
+func (m *JobDependencyFailed) GetDependencyJobId() string {
+	if m != nil {
+		return m.DependencyJobId
+	}
+	return ""
+}
+
+func (m *JobDependencyFailed) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 // Generated by the scheduler whenever it detects a SubmitJob message that includes a previously used deduplication id
 // (i.e., when it detects a duplicate job submission).
 type JobDuplicateDetected struct {
@@ -3208,7 +3828,7 @@ func (m *JobDuplicateDetected) Reset()         { *m = JobDuplicateDetected{} }
 func (m *JobDuplicateDetected) String() string { return proto.CompactTextString(m) }
 func (*JobDuplicateDetected) ProtoMessage()    {}
 func (*JobDuplicateDetected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{39}
+	return fileDescriptor_6aab92ca59e015f8, []int{46}
 }
 func (m *JobDuplicateDetected) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3261,13 +3881,20 @@ type JobRunPreempted struct {
 	PreemptiveJobId *Uuid `protobuf:"bytes,3,opt,name=preemptive_job_id,json=preemptiveJobId,proto3" json:"preemptiveJobId,omitempty"`
 	// Uuid of the job run that caused the preemption.
 	PreemptiveRunId *Uuid `protobuf:"bytes,4,opt,name=preemptive_run_id,json=preemptiveRunId,proto3" json:"preemptiveRunId,omitempty"`
+	// Why the scheduler preempted the job run.
+	Reason PreemptionReason `protobuf:"varint,5,opt,name=reason,proto3,enum=armadaevents.PreemptionReason" json:"reason,omitempty"`
+	// Queue of the job that caused the preemption, if any.
+	PreemptiveQueue string `protobuf:"bytes,6,opt,name=preemptive_queue,json=preemptiveQueue,proto3" json:"preemptiveQueue,omitempty"`
+	// Id and name of the node the preempted job run was running on.
+	NodeId   string `protobuf:"bytes,7,opt,name=node_id,json=nodeId,proto3" json:"nodeId,omitempty"`
+	NodeName string `protobuf:"bytes,8,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
 }
 
 func (m *JobRunPreempted) Reset()         { *m = JobRunPreempted{} }
 func (m *JobRunPreempted) String() string { return proto.CompactTextString(m) }
 func (*JobRunPreempted) ProtoMessage()    {}
 func (*JobRunPreempted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{40}
+	return fileDescriptor_6aab92ca59e015f8, []int{47}
 }
 func (m *JobRunPreempted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3324,6 +3951,34 @@ func (m *JobRunPreempted) GetPreemptiveRunId() *Uuid {
 	return nil
 }
 
+func (m *JobRunPreempted) GetReason() PreemptionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return PreemptionReason_UnknownPreemptionReason
+}
+
+func (m *JobRunPreempted) GetPreemptiveQueue() string {
+	if m != nil {
+		return m.PreemptiveQueue
+	}
+	return ""
+}
+
+func (m *JobRunPreempted) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *JobRunPreempted) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
 // Message used internally by Armada to see if messages can be propagated through a pulsar partition
 type PartitionMarker struct {
 	// group id ties together multiple messages across different partitions
@@ -3336,7 +3991,7 @@ func (m *PartitionMarker) Reset()         { *m = PartitionMarker{} }
 func (m *PartitionMarker) String() string { return proto.CompactTextString(m) }
 func (*PartitionMarker) ProtoMessage()    {}
 func (*PartitionMarker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{41}
+	return fileDescriptor_6aab92ca59e015f8, []int{48}
 }
 func (m *PartitionMarker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3389,7 +4044,7 @@ func (m *JobRunPreemptionRequested) Reset()         { *m = JobRunPreemptionReque
 func (m *JobRunPreemptionRequested) String() string { return proto.CompactTextString(m) }
 func (*JobRunPreemptionRequested) ProtoMessage()    {}
 func (*JobRunPreemptionRequested) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{42}
+	return fileDescriptor_6aab92ca59e015f8, []int{49}
 }
 func (m *JobRunPreemptionRequested) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3434,10 +4089,13 @@ func (m *JobRunPreemptionRequested) GetJobId() *Uuid {
 
 func init() {
 	proto.RegisterEnum("armadaevents.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("armadaevents.FailureCategory", FailureCategory_name, FailureCategory_value)
 	proto.RegisterEnum("armadaevents.KubernetesReason", KubernetesReason_name, KubernetesReason_value)
+	proto.RegisterEnum("armadaevents.PreemptionReason", PreemptionReason_name, PreemptionReason_value)
 	proto.RegisterType((*EventSequence)(nil), "armadaevents.EventSequence")
 	proto.RegisterType((*EventSequence_Event)(nil), "armadaevents.EventSequence.Event")
 	proto.RegisterType((*ResourceUtilisation)(nil), "armadaevents.ResourceUtilisation")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "armadaevents.ResourceUtilisation.AverageResourcesEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "armadaevents.ResourceUtilisation.MaxResourcesForPeriodEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "armadaevents.ResourceUtilisation.TotalCumulativeUsageEntry")
 	proto.RegisterType((*Uuid)(nil), "armadaevents.Uuid")
@@ -3450,11 +4108,15 @@ func init() {
 	proto.RegisterType((*PodSpecWithAvoidList)(nil), "armadaevents.PodSpecWithAvoidList")
 	proto.RegisterType((*ReprioritiseJob)(nil), "armadaevents.ReprioritiseJob")
 	proto.RegisterType((*JobRequeued)(nil), "armadaevents.JobRequeued")
+	proto.RegisterType((*JobUnschedulable)(nil), "armadaevents.JobUnschedulable")
+	proto.RegisterMapType((map[string]uint32)(nil), "armadaevents.JobUnschedulable.NumExcludedNodesByReasonEntry")
 	proto.RegisterType((*ReprioritiseJobSet)(nil), "armadaevents.ReprioritiseJobSet")
 	proto.RegisterType((*ReprioritisedJob)(nil), "armadaevents.ReprioritisedJob")
 	proto.RegisterType((*CancelJob)(nil), "armadaevents.CancelJob")
 	proto.RegisterType((*JobSetFilter)(nil), "armadaevents.JobSetFilter")
 	proto.RegisterType((*CancelJobSet)(nil), "armadaevents.CancelJobSet")
+	proto.RegisterType((*SuspendJobSet)(nil), "armadaevents.SuspendJobSet")
+	proto.RegisterType((*ResumeJobSet)(nil), "armadaevents.ResumeJobSet")
 	proto.RegisterType((*CancelledJob)(nil), "armadaevents.CancelledJob")
 	proto.RegisterType((*JobSucceeded)(nil), "armadaevents.JobSucceeded")
 	proto.RegisterType((*JobRunLeased)(nil), "armadaevents.JobRunLeased")
@@ -3462,10 +4124,13 @@ func init() {
 	proto.RegisterType((*JobRunRunning)(nil), "armadaevents.JobRunRunning")
 	proto.RegisterType((*KubernetesResourceInfo)(nil), "armadaevents.KubernetesResourceInfo")
 	proto.RegisterType((*PodInfo)(nil), "armadaevents.PodInfo")
+	proto.RegisterMapType((map[string]string)(nil), "armadaevents.PodInfo.NodeLabelsEntry")
 	proto.RegisterType((*IngressInfo)(nil), "armadaevents.IngressInfo")
 	proto.RegisterMapType((map[int32]string)(nil), "armadaevents.IngressInfo.IngressAddressesEntry")
 	proto.RegisterType((*StandaloneIngressInfo)(nil), "armadaevents.StandaloneIngressInfo")
 	proto.RegisterMapType((map[int32]string)(nil), "armadaevents.StandaloneIngressInfo.IngressAddressesEntry")
+	proto.RegisterType((*JobRunArtifacts)(nil), "armadaevents.JobRunArtifacts")
+	proto.RegisterType((*Artifact)(nil), "armadaevents.Artifact")
 	proto.RegisterType((*JobRunSucceeded)(nil), "armadaevents.JobRunSucceeded")
 	proto.RegisterType((*JobErrors)(nil), "armadaevents.JobErrors")
 	proto.RegisterType((*JobRunErrors)(nil), "armadaevents.JobRunErrors")
@@ -3478,9 +4143,11 @@ func init() {
 	proto.RegisterType((*ExecutorError)(nil), "armadaevents.ExecutorError")
 	proto.RegisterType((*PodUnschedulable)(nil), "armadaevents.PodUnschedulable")
 	proto.RegisterType((*LeaseExpired)(nil), "armadaevents.LeaseExpired")
+	proto.RegisterType((*ExecutorStale)(nil), "armadaevents.ExecutorStale")
 	proto.RegisterType((*MaxRunsExceeded)(nil), "armadaevents.MaxRunsExceeded")
 	proto.RegisterType((*JobRunPreemptedError)(nil), "armadaevents.JobRunPreemptedError")
 	proto.RegisterType((*GangJobUnschedulable)(nil), "armadaevents.GangJobUnschedulable")
+	proto.RegisterType((*JobDependencyFailed)(nil), "armadaevents.JobDependencyFailed")
 	proto.RegisterType((*JobDuplicateDetected)(nil), "armadaevents.JobDuplicateDetected")
 	proto.RegisterType((*JobRunPreempted)(nil), "armadaevents.JobRunPreempted")
 	proto.RegisterType((*PartitionMarker)(nil), "armadaevents.PartitionMarker")
@@ -3490,226 +4157,273 @@ func init() {
 func init() { proto.RegisterFile("pkg/armadaevents/events.proto", fileDescriptor_6aab92ca59e015f8) }
 
 var fileDescriptor_6aab92ca59e015f8 = []byte{
-	// 3498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x4b, 0x6c, 0x1b, 0xd7,
-	0xb9, 0xf6, 0x90, 0x12, 0x1f, 0x3f, 0x25, 0x91, 0x3e, 0x96, 0x15, 0x5a, 0xb1, 0x45, 0x67, 0x9c,
-	0x7b, 0xe3, 0x04, 0x09, 0x99, 0x38, 0x0f, 0xe4, 0x71, 0x91, 0x40, 0xb4, 0x15, 0x3f, 0x62, 0xd9,
-	0x0e, 0x65, 0xe7, 0xfa, 0x06, 0xb9, 0x60, 0x86, 0x9c, 0x23, 0x6a, 0xac, 0xe1, 0xcc, 0x64, 0x1e,
-	0xb2, 0x04, 0x64, 0x71, 0xef, 0xc5, 0xbd, 0xb9, 0xbb, 0xd4, 0x40, 0xbb, 0x28, 0xd0, 0x45, 0xba,
-	0x6d, 0x80, 0xae, 0xbb, 0xee, 0xaa, 0x59, 0x14, 0x45, 0xda, 0x55, 0x57, 0x6c, 0x91, 0xa0, 0x8b,
-	0x72, 0xd1, 0x75, 0xdb, 0x4d, 0x8b, 0xf3, 0x9a, 0x39, 0x67, 0x38, 0xb4, 0xe5, 0x57, 0x9d, 0xc2,
-	0x2b, 0x69, 0xbe, 0xff, 0x39, 0xe7, 0xf1, 0xcf, 0xff, 0xff, 0xe7, 0x10, 0x8e, 0x79, 0xdb, 0x83,
-	0x96, 0xe1, 0x0f, 0x0d, 0xd3, 0xc0, 0x3b, 0xd8, 0x09, 0x83, 0x16, 0xfb, 0xd3, 0xf4, 0x7c, 0x37,
-	0x74, 0xd1, 0x9c, 0x4c, 0x5a, 0xd6, 0xb7, 0x5f, 0x0f, 0x9a, 0x96, 0xdb, 0x32, 0x3c, 0xab, 0xd5,
-	0x77, 0x7d, 0xdc, 0xda, 0x79, 0xa9, 0x35, 0xc0, 0x0e, 0xf6, 0x8d, 0x10, 0x9b, 0x4c, 0x62, 0xf9,
-	0xa4, 0xc4, 0xe3, 0xe0, 0xf0, 0xa6, 0xeb, 0x6f, 0x5b, 0xce, 0x20, 0x8b, 0xb3, 0x31, 0x70, 0xdd,
-	0x81, 0x8d, 0x5b, 0xf4, 0xa9, 0x17, 0x6d, 0xb6, 0x42, 0x6b, 0x88, 0x83, 0xd0, 0x18, 0x7a, 0x9c,
-	0xe1, 0x95, 0x44, 0xd5, 0xd0, 0xe8, 0x6f, 0x59, 0x0e, 0xf6, 0xf7, 0x5a, 0xd4, 0x5f, 0xcf, 0x6a,
-	0xf9, 0x38, 0x70, 0x23, 0xbf, 0x8f, 0x27, 0xd4, 0xbe, 0x30, 0xb0, 0xc2, 0xad, 0xa8, 0xd7, 0xec,
-	0xbb, 0xc3, 0xd6, 0xc0, 0x1d, 0xb8, 0x89, 0x7e, 0xf2, 0x44, 0x1f, 0xe8, 0x7f, 0x9c, 0xfd, 0x4d,
-	0xcb, 0x09, 0xb1, 0xef, 0x18, 0x76, 0x2b, 0xe8, 0x6f, 0x61, 0x33, 0xb2, 0xb1, 0x9f, 0xfc, 0xe7,
-	0xf6, 0x6e, 0xe0, 0x7e, 0x18, 0x4c, 0x00, 0x4c, 0x56, 0xbf, 0xb5, 0x08, 0xf3, 0x6b, 0x64, 0x68,
-	0x36, 0xf0, 0x27, 0x11, 0x76, 0xfa, 0x18, 0x3d, 0x0b, 0xb3, 0x9f, 0x44, 0x38, 0xc2, 0x75, 0xed,
-	0xb8, 0x76, 0xb2, 0xdc, 0x3e, 0x34, 0x1e, 0x35, 0xaa, 0x14, 0x78, 0xde, 0x1d, 0x5a, 0x21, 0x1e,
-	0x7a, 0xe1, 0x5e, 0x87, 0x71, 0xa0, 0x37, 0x61, 0xee, 0x86, 0xdb, 0xeb, 0x06, 0x38, 0xec, 0x3a,
-	0xc6, 0x10, 0xd7, 0x73, 0x54, 0xa2, 0x3e, 0x1e, 0x35, 0x16, 0x6f, 0xb8, 0xbd, 0x0d, 0x1c, 0x5e,
-	0x32, 0x86, 0xb2, 0x18, 0x24, 0x28, 0x7a, 0x01, 0x8a, 0x51, 0x80, 0xfd, 0xae, 0x65, 0xd6, 0xf3,
-	0x54, 0x6c, 0x71, 0x3c, 0x6a, 0xd4, 0x08, 0x74, 0xde, 0x94, 0x44, 0x0a, 0x0c, 0x41, 0xcf, 0x43,
-	0x61, 0xe0, 0xbb, 0x91, 0x17, 0xd4, 0x67, 0x8e, 0xe7, 0x05, 0x37, 0x43, 0x64, 0x6e, 0x86, 0xa0,
-	0xcb, 0x50, 0x60, 0xf3, 0x5d, 0x9f, 0x3d, 0x9e, 0x3f, 0x59, 0x39, 0xf5, 0x54, 0x53, 0x5e, 0x04,
-	0x4d, 0xe5, 0x85, 0xd9, 0x13, 0x53, 0xc8, 0xe8, 0xb2, 0x42, 0xbe, 0x6c, 0xfe, 0x78, 0x10, 0x66,
-	0x29, 0x1f, 0xba, 0x0c, 0xc5, 0xbe, 0x8f, 0xc9, 0x64, 0xd5, 0xd1, 0x71, 0xed, 0x64, 0xe5, 0xd4,
-	0x72, 0x93, 0x2d, 0x82, 0xa6, 0x98, 0xa4, 0xe6, 0x55, 0xb1, 0x08, 0xda, 0x47, 0xc6, 0xa3, 0xc6,
-	0x41, 0xce, 0x9e, 0x68, 0xbd, 0xf5, 0xbb, 0x86, 0xd6, 0x11, 0x5a, 0xd0, 0x15, 0x28, 0x07, 0x51,
-	0x6f, 0x68, 0x85, 0x17, 0xdc, 0x1e, 0x1d, 0xf3, 0xca, 0xa9, 0x27, 0x54, 0x77, 0x37, 0x04, 0xb9,
-	0xfd, 0xc4, 0x78, 0xd4, 0x38, 0x14, 0x73, 0x27, 0x1a, 0xcf, 0x1d, 0xe8, 0x24, 0x4a, 0xd0, 0x16,
-	0x54, 0x7d, 0xec, 0xf9, 0x96, 0xeb, 0x5b, 0xa1, 0x15, 0x60, 0xa2, 0x37, 0x47, 0xf5, 0x1e, 0x53,
-	0xf5, 0x76, 0x54, 0xa6, 0xf6, 0xb1, 0xf1, 0xa8, 0x71, 0x24, 0x25, 0xa9, 0xd8, 0x48, 0xab, 0x45,
-	0x21, 0xa0, 0x14, 0xb4, 0x81, 0x43, 0x3a, 0x9f, 0x95, 0x53, 0xc7, 0x6f, 0x6b, 0x6c, 0x03, 0x87,
-	0xed, 0xe3, 0xe3, 0x51, 0xe3, 0xe8, 0xa4, 0xbc, 0x62, 0x32, 0x43, 0x3f, 0xb2, 0xa1, 0x26, 0xa3,
-	0x26, 0x79, 0xc1, 0x19, 0x6a, 0x73, 0x65, 0xba, 0x4d, 0xc2, 0xd5, 0x5e, 0x19, 0x8f, 0x1a, 0xcb,
-	0x69, 0x59, 0xc5, 0xde, 0x84, 0x66, 0x32, 0x3f, 0x7d, 0xc3, 0xe9, 0x63, 0x9b, 0x98, 0x99, 0xcd,
-	0x9a, 0x9f, 0xd3, 0x82, 0xcc, 0xe6, 0x27, 0xe6, 0x56, 0xe7, 0x27, 0x86, 0xd1, 0x47, 0x30, 0x17,
-	0x3f, 0x90, 0xf1, 0x2a, 0xf0, 0x75, 0x94, 0xad, 0x94, 0x8c, 0xd4, 0xf2, 0x78, 0xd4, 0x58, 0x92,
-	0x65, 0x14, 0xd5, 0x8a, 0xb6, 0x44, 0xbb, 0xcd, 0x46, 0xa6, 0x38, 0x5d, 0x3b, 0xe3, 0x90, 0xb5,
-	0xdb, 0x93, 0x23, 0xa2, 0x68, 0x23, 0xda, 0xc9, 0x26, 0x8e, 0xfa, 0x7d, 0x8c, 0x4d, 0x6c, 0xd6,
-	0x4b, 0x59, 0xda, 0x2f, 0x48, 0x1c, 0x4c, 0xbb, 0x2c, 0xa3, 0x6a, 0x97, 0x29, 0x64, 0xac, 0x6f,
-	0xb8, 0xbd, 0x35, 0xdf, 0x77, 0xfd, 0xa0, 0x5e, 0xce, 0x1a, 0xeb, 0x0b, 0x82, 0xcc, 0xc6, 0x3a,
-	0xe6, 0x56, 0xc7, 0x3a, 0x86, 0xb9, 0xbf, 0x9d, 0xc8, 0xb9, 0x88, 0x8d, 0x00, 0x9b, 0x75, 0x98,
-	0xe2, 0x6f, 0xcc, 0x11, 0xfb, 0x1b, 0x23, 0x13, 0xfe, 0xc6, 0x14, 0x64, 0xc2, 0x02, 0x7b, 0x5e,
-	0x0d, 0x02, 0x6b, 0xe0, 0x60, 0xb3, 0x5e, 0xa1, 0xfa, 0x8f, 0x66, 0xe9, 0x17, 0x3c, 0xed, 0xa3,
-	0xe3, 0x51, 0xa3, 0xae, 0xca, 0x29, 0x36, 0x52, 0x3a, 0xd1, 0xc7, 0x30, 0xcf, 0x90, 0x4e, 0xe4,
-	0x38, 0x96, 0x33, 0xa8, 0xcf, 0x51, 0x23, 0x4f, 0x66, 0x19, 0xe1, 0x2c, 0xed, 0x27, 0xc7, 0xa3,
-	0xc6, 0x13, 0x8a, 0x94, 0x62, 0x42, 0x55, 0x48, 0x22, 0x06, 0x03, 0x92, 0x89, 0x9d, 0xcf, 0x8a,
-	0x18, 0x17, 0x54, 0x26, 0x16, 0x31, 0x52, 0x92, 0x6a, 0xc4, 0x48, 0x11, 0x93, 0xf9, 0xe0, 0x93,
-	0xbc, 0x30, 0x7d, 0x3e, 0xf8, 0x3c, 0x4b, 0xf3, 0x91, 0x31, 0xd5, 0x8a, 0x36, 0xf4, 0x29, 0x90,
-	0x0f, 0xcf, 0x99, 0xc8, 0xb3, 0xad, 0xbe, 0x11, 0xe2, 0x33, 0x38, 0xc4, 0x7d, 0x12, 0xa9, 0xab,
-	0xd4, 0x8a, 0x3e, 0x61, 0x65, 0x82, 0xb3, 0xad, 0x8f, 0x47, 0x8d, 0x95, 0x2c, 0x1d, 0x8a, 0xd5,
-	0x4c, 0x2b, 0xe8, 0xbf, 0x34, 0x38, 0x1c, 0x84, 0x86, 0x63, 0x1a, 0xb6, 0xeb, 0xe0, 0xf3, 0xce,
-	0xc0, 0xc7, 0x41, 0x70, 0xde, 0xd9, 0x74, 0xeb, 0x35, 0x6a, 0xff, 0x44, 0x2a, 0xac, 0x67, 0xb1,
-	0xb6, 0x4f, 0x8c, 0x47, 0x8d, 0x46, 0xa6, 0x16, 0xc5, 0x83, 0x6c, 0x43, 0x68, 0x17, 0x0e, 0x89,
-	0xac, 0xe2, 0x5a, 0x68, 0xd9, 0x56, 0x60, 0x84, 0x96, 0xeb, 0xd4, 0x0f, 0x52, 0xfb, 0x4f, 0xa5,
-	0xa3, 0xe3, 0x04, 0x63, 0xfb, 0xa9, 0xf1, 0xa8, 0x71, 0x2c, 0x43, 0x83, 0x62, 0x3b, 0xcb, 0x44,
-	0xb2, 0x84, 0xae, 0xf8, 0x98, 0x30, 0x62, 0xb3, 0x7e, 0x68, 0xfa, 0x12, 0x8a, 0x99, 0xe4, 0x25,
-	0x14, 0x83, 0x59, 0x4b, 0x28, 0x26, 0x12, 0x4b, 0x9e, 0xe1, 0x87, 0x16, 0x31, 0xbb, 0x6e, 0xf8,
-	0xdb, 0xd8, 0xaf, 0x2f, 0x66, 0x59, 0xba, 0xa2, 0x32, 0x31, 0x4b, 0x29, 0x49, 0xd5, 0x52, 0x8a,
-	0x88, 0x6e, 0x69, 0xa0, 0xba, 0x66, 0xb9, 0x4e, 0x87, 0xa4, 0x0d, 0x01, 0x79, 0xbd, 0xc3, 0xd4,
-	0xe8, 0x33, 0xb7, 0x79, 0x3d, 0x99, 0xbd, 0xfd, 0xcc, 0x78, 0xd4, 0x38, 0x31, 0x55, 0x9b, 0xe2,
-	0xc8, 0x74, 0xa3, 0xe8, 0x3a, 0x54, 0x08, 0x11, 0xd3, 0x04, 0xcc, 0xac, 0x2f, 0x51, 0x1f, 0x8e,
-	0x4c, 0xfa, 0xc0, 0x19, 0x68, 0x06, 0x72, 0x58, 0x92, 0x50, 0xec, 0xc8, 0xaa, 0xda, 0x45, 0x98,
-	0xa5, 0xf2, 0xfa, 0xb8, 0x00, 0x87, 0x32, 0xd6, 0x06, 0x7a, 0x1b, 0x0a, 0x7e, 0xe4, 0x90, 0x84,
-	0x8d, 0x65, 0x29, 0x48, 0xb5, 0x7a, 0x2d, 0xb2, 0x4c, 0x96, 0x2d, 0xfa, 0x91, 0xa3, 0xe4, 0x70,
-	0xb3, 0x14, 0x20, 0xf2, 0x24, 0x5b, 0xb4, 0x4c, 0x9e, 0x8d, 0x4c, 0x95, 0xbf, 0xe1, 0xf6, 0x54,
-	0x79, 0x0a, 0x20, 0x0c, 0xf3, 0x62, 0xe1, 0x75, 0x2d, 0xb2, 0xab, 0x58, 0x9e, 0xf1, 0xb4, 0xaa,
-	0xe6, 0xbd, 0xa8, 0x87, 0x7d, 0x07, 0x87, 0x38, 0x10, 0xef, 0x40, 0xb7, 0x15, 0x8d, 0x22, 0xbe,
-	0x84, 0x48, 0xfa, 0xe7, 0x64, 0x1c, 0xfd, 0x40, 0x83, 0xfa, 0xd0, 0xd8, 0xed, 0x0a, 0x30, 0xe8,
-	0x6e, 0xba, 0x7e, 0xd7, 0xc3, 0xbe, 0xe5, 0x9a, 0x34, 0xf9, 0xac, 0x9c, 0xfa, 0xb7, 0x3b, 0x6e,
-	0xa4, 0xe6, 0xba, 0xb1, 0x2b, 0xe0, 0xe0, 0x5d, 0xd7, 0xbf, 0x42, 0xc5, 0xd7, 0x9c, 0xd0, 0xdf,
-	0x6b, 0x1f, 0xfb, 0x6a, 0xd4, 0x38, 0x40, 0xa6, 0x65, 0x98, 0xc5, 0xd3, 0xc9, 0x86, 0xd1, 0xf7,
-	0x34, 0x58, 0x0a, 0xdd, 0xd0, 0xb0, 0xbb, 0xfd, 0x68, 0x18, 0xd9, 0x46, 0x68, 0xed, 0xe0, 0x6e,
-	0x14, 0x18, 0x03, 0xcc, 0x73, 0xdc, 0xb7, 0xee, 0xec, 0xd4, 0x55, 0x22, 0x7f, 0x3a, 0x16, 0xbf,
-	0x46, 0xa4, 0x99, 0x4f, 0x47, 0xb9, 0x4f, 0x8b, 0x61, 0x06, 0x4b, 0x27, 0x13, 0x5d, 0xfe, 0xb1,
-	0x06, 0xcb, 0xd3, 0x5f, 0x13, 0x9d, 0x80, 0xfc, 0x36, 0xde, 0xe3, 0x55, 0xc4, 0xc1, 0xf1, 0xa8,
-	0x31, 0xbf, 0x8d, 0xf7, 0xa4, 0x51, 0x27, 0x54, 0xf4, 0x1f, 0x30, 0xbb, 0x63, 0xd8, 0x11, 0xe6,
-	0x4b, 0xa2, 0xd9, 0x64, 0xf5, 0x52, 0x53, 0xae, 0x97, 0x9a, 0xde, 0xf6, 0x80, 0x00, 0x4d, 0x31,
-	0x23, 0xcd, 0xf7, 0x23, 0xc3, 0x09, 0xad, 0x70, 0x8f, 0x2d, 0x17, 0xaa, 0x40, 0x5e, 0x2e, 0x14,
-	0x78, 0x33, 0xf7, 0xba, 0xb6, 0xfc, 0x85, 0x06, 0x47, 0xa6, 0xbe, 0xf4, 0x77, 0xc1, 0x43, 0xbd,
-	0x0b, 0x33, 0x64, 0xe1, 0x93, 0xfa, 0x66, 0xcb, 0x1a, 0x6c, 0xbd, 0xf6, 0x0a, 0x75, 0xa7, 0xc0,
-	0xca, 0x11, 0x86, 0xc8, 0xe5, 0x08, 0x43, 0x48, 0x8d, 0x66, 0xbb, 0x37, 0x5f, 0x7b, 0x85, 0x3a,
-	0x55, 0x60, 0x46, 0x28, 0x20, 0x1b, 0xa1, 0x80, 0xfe, 0xb7, 0x02, 0x94, 0xe3, 0x02, 0x42, 0xda,
-	0x83, 0xda, 0x3d, 0xed, 0xc1, 0x73, 0x50, 0x33, 0xb1, 0xc9, 0xbf, 0x7c, 0x96, 0xeb, 0x88, 0xdd,
-	0x5c, 0x66, 0xd1, 0x55, 0xa1, 0x29, 0xf2, 0xd5, 0x14, 0x09, 0x9d, 0x82, 0x12, 0x4f, 0xb4, 0xf7,
-	0xe8, 0x46, 0x9e, 0x6f, 0x2f, 0x8d, 0x47, 0x0d, 0x24, 0x30, 0x49, 0x34, 0xe6, 0x43, 0x1d, 0x00,
-	0x56, 0xbd, 0xae, 0xe3, 0xd0, 0xe0, 0x29, 0x7f, 0x5d, 0x7d, 0x83, 0xcb, 0x31, 0x9d, 0xd5, 0xa1,
-	0x09, 0xbf, 0x5c, 0x87, 0x26, 0x28, 0xfa, 0x08, 0x60, 0x68, 0x58, 0x0e, 0x93, 0xe3, 0xf9, 0xbd,
-	0x3e, 0x2d, 0xa4, 0xac, 0xc7, 0x9c, 0x4c, 0x7b, 0x22, 0x29, 0x6b, 0x4f, 0x50, 0x52, 0x2d, 0xf2,
-	0x7a, 0xbb, 0x5e, 0xa0, 0xbb, 0x74, 0x65, 0x9a, 0x6a, 0xae, 0xf6, 0x30, 0xa9, 0x18, 0xb9, 0x88,
-	0xa4, 0x53, 0x68, 0x21, 0xc3, 0x66, 0x5b, 0x9b, 0x38, 0xb4, 0x86, 0x98, 0x66, 0xf6, 0x7c, 0xd8,
-	0x04, 0x26, 0x0f, 0x9b, 0xc0, 0xd0, 0xeb, 0x00, 0x46, 0xb8, 0xee, 0x06, 0xe1, 0x65, 0xa7, 0x8f,
-	0x69, 0xc6, 0x5e, 0x62, 0xee, 0x27, 0xa8, 0xec, 0x7e, 0x82, 0xa2, 0xb7, 0xa0, 0xe2, 0xf1, 0x8f,
-	0x50, 0xcf, 0xc6, 0x34, 0x23, 0x2f, 0xb1, 0x4f, 0x8a, 0x04, 0x4b, 0xb2, 0x32, 0x37, 0x3a, 0x0b,
-	0xd5, 0xbe, 0xeb, 0xf4, 0x23, 0xdf, 0xc7, 0x4e, 0x7f, 0x6f, 0xc3, 0xd8, 0xc4, 0x34, 0xfb, 0x2e,
-	0xb1, 0xa5, 0x92, 0x22, 0xc9, 0x4b, 0x25, 0x45, 0x42, 0xaf, 0x42, 0x39, 0xee, 0x5e, 0xd0, 0x04,
-	0xbb, 0xcc, 0x0b, 0x61, 0x01, 0x4a, 0xc2, 0x09, 0x27, 0x71, 0xde, 0x0a, 0xe2, 0x2c, 0x8d, 0x26,
-	0xcd, 0xdc, 0x79, 0x09, 0x96, 0x9d, 0x97, 0x60, 0x74, 0x1e, 0x0e, 0xd2, 0xef, 0x62, 0x37, 0x0c,
-	0xed, 0x6e, 0x80, 0xfb, 0xae, 0x63, 0x06, 0x34, 0x27, 0xce, 0x33, 0xf7, 0x29, 0xf1, 0x6a, 0x68,
-	0x6f, 0x30, 0x92, 0xec, 0x7e, 0x8a, 0xa4, 0xff, 0x52, 0x83, 0xc5, 0xac, 0x25, 0x94, 0x5a, 0xce,
-	0xda, 0x03, 0x59, 0xce, 0x1f, 0x40, 0xc9, 0x73, 0xcd, 0x6e, 0xe0, 0xe1, 0x3e, 0x8f, 0x58, 0xa9,
-	0xc5, 0x7c, 0xc5, 0x35, 0x37, 0x3c, 0xdc, 0xff, 0x77, 0x2b, 0xdc, 0x5a, 0xdd, 0x71, 0x2d, 0xf3,
-	0xa2, 0x15, 0xf0, 0x55, 0xe7, 0x31, 0x8a, 0x92, 0x21, 0x14, 0x39, 0xd8, 0x2e, 0x41, 0x81, 0x59,
-	0xd1, 0x7f, 0x95, 0x87, 0x5a, 0x7a, 0xd9, 0xfe, 0x33, 0xbd, 0x0a, 0xba, 0x0e, 0x45, 0x8b, 0xa5,
-	0xcc, 0x3c, 0x83, 0xf8, 0x17, 0x29, 0xa6, 0x37, 0x93, 0x86, 0x5f, 0x73, 0xe7, 0xa5, 0x26, 0xcf,
-	0xad, 0xe9, 0x10, 0x50, 0xcd, 0x5c, 0x52, 0xd5, 0xcc, 0x41, 0xd4, 0x81, 0x62, 0x80, 0xfd, 0x1d,
-	0xab, 0x8f, 0x79, 0x70, 0x6a, 0xc8, 0x9a, 0xfb, 0xae, 0x8f, 0x89, 0xce, 0x0d, 0xc6, 0x92, 0xe8,
-	0xe4, 0x32, 0xaa, 0x4e, 0x0e, 0xa2, 0x0f, 0xa0, 0xdc, 0x77, 0x9d, 0x4d, 0x6b, 0xb0, 0x6e, 0x78,
-	0x3c, 0x3c, 0x1d, 0xcb, 0xd2, 0x7a, 0x5a, 0x30, 0xf1, 0x26, 0x84, 0x78, 0x4c, 0x35, 0x21, 0x62,
-	0xae, 0x64, 0x42, 0xff, 0x34, 0x03, 0x90, 0x4c, 0x0e, 0x7a, 0x03, 0x2a, 0x78, 0x17, 0xf7, 0xa3,
-	0xd0, 0xf5, 0xc5, 0x77, 0x82, 0xf7, 0xf4, 0x04, 0xac, 0x04, 0x76, 0x48, 0x50, 0xb2, 0x51, 0x1d,
-	0x63, 0x88, 0x03, 0xcf, 0xe8, 0x8b, 0x66, 0x20, 0x75, 0x26, 0x06, 0xe5, 0x8d, 0x1a, 0x83, 0xe8,
-	0x5f, 0x61, 0x86, 0xb6, 0x0f, 0x59, 0x1f, 0x10, 0x8d, 0x47, 0x8d, 0x05, 0x47, 0x6d, 0x1c, 0x52,
-	0x3a, 0x7a, 0x07, 0xe6, 0xb7, 0xe3, 0x85, 0x47, 0x7c, 0x9b, 0xa1, 0x02, 0x34, 0xb5, 0x4b, 0x08,
-	0x8a, 0x77, 0x73, 0x32, 0x8e, 0x36, 0xa1, 0x62, 0x38, 0x8e, 0x1b, 0xd2, 0x6f, 0x90, 0xe8, 0x0d,
-	0x3e, 0x3b, 0x6d, 0x99, 0x36, 0x57, 0x13, 0x5e, 0x96, 0x25, 0xd1, 0xe0, 0x21, 0x69, 0x90, 0x83,
-	0x87, 0x04, 0xa3, 0x0e, 0x14, 0x6c, 0xa3, 0x87, 0x6d, 0x11, 0xf4, 0x9f, 0x9e, 0x6a, 0xe2, 0x22,
-	0x65, 0x63, 0xda, 0xe9, 0x27, 0x9f, 0xc9, 0xc9, 0x9f, 0x7c, 0x86, 0x2c, 0x6f, 0x42, 0x2d, 0xed,
-	0xcf, 0xfe, 0x12, 0x98, 0x67, 0xe5, 0x04, 0xa6, 0x7c, 0xc7, 0x94, 0xc9, 0x80, 0x8a, 0xe4, 0xd4,
-	0xc3, 0x30, 0xa1, 0xff, 0x44, 0x83, 0xc5, 0xac, 0xbd, 0x8b, 0xd6, 0xa5, 0x1d, 0xaf, 0xf1, 0x1e,
-	0x47, 0xc6, 0x52, 0xe7, 0xb2, 0x53, 0xb6, 0x7a, 0xb2, 0xd1, 0xdb, 0xb0, 0xe0, 0xb8, 0x26, 0xee,
-	0x1a, 0xc4, 0x80, 0x6d, 0x05, 0x61, 0x3d, 0x47, 0x7b, 0xc7, 0xb4, 0x37, 0x42, 0x28, 0xab, 0x82,
-	0x20, 0x49, 0xcf, 0x2b, 0x04, 0xfd, 0xff, 0x34, 0xa8, 0xa6, 0x5a, 0x97, 0xf7, 0x9d, 0x44, 0xc9,
-	0xa9, 0x4f, 0x6e, 0x7f, 0xa9, 0x8f, 0xfe, 0xfd, 0x1c, 0x54, 0xa4, 0xba, 0xee, 0xbe, 0x7d, 0xb8,
-	0x01, 0x55, 0xfe, 0xa5, 0xb4, 0x9c, 0x01, 0x2b, 0xa7, 0x72, 0xbc, 0x49, 0x31, 0x71, 0x52, 0x70,
-	0xc1, 0xed, 0x6d, 0xc4, 0xbc, 0xb4, 0x9a, 0xa2, 0x1d, 0xac, 0x40, 0xc1, 0x24, 0x13, 0x0b, 0x2a,
-	0x05, 0x5d, 0x87, 0xa5, 0xc8, 0x33, 0x8d, 0x10, 0x77, 0x03, 0xde, 0x73, 0xef, 0x3a, 0xd1, 0xb0,
-	0x87, 0x7d, 0xba, 0xe3, 0x67, 0x59, 0xcf, 0x85, 0x71, 0x88, 0xa6, 0xfc, 0x25, 0x4a, 0x97, 0x74,
-	0x2e, 0x66, 0xd1, 0xf5, 0x73, 0x80, 0x26, 0xfb, 0xca, 0xca, 0xf8, 0x6a, 0xfb, 0x1c, 0xdf, 0xcf,
-	0x34, 0xa8, 0xa5, 0xdb, 0xc5, 0x8f, 0x64, 0xa2, 0xf7, 0xa0, 0x1c, 0xb7, 0x7e, 0xef, 0xdb, 0x81,
-	0xe7, 0xa1, 0xe0, 0x63, 0x23, 0x70, 0x1d, 0xbe, 0x33, 0x69, 0x88, 0x61, 0x88, 0x1c, 0x62, 0x18,
-	0xa2, 0x5f, 0x85, 0x39, 0x36, 0x82, 0xef, 0x5a, 0x76, 0x88, 0x7d, 0x74, 0x06, 0x0a, 0x41, 0x68,
-	0x84, 0x38, 0xa8, 0x6b, 0xc7, 0xf3, 0x27, 0x17, 0x4e, 0x2d, 0x4d, 0x76, 0x79, 0x09, 0x99, 0x69,
-	0x65, 0x9c, 0xb2, 0x56, 0x86, 0xe8, 0xff, 0xa3, 0xc1, 0x9c, 0xdc, 0xcc, 0x7e, 0x30, 0x6a, 0xef,
-	0xf2, 0xd5, 0x3e, 0x15, 0x3e, 0xd8, 0x0f, 0x66, 0x66, 0xef, 0xce, 0xfa, 0xcf, 0x34, 0x36, 0xb2,
-	0x71, 0x17, 0xf4, 0x7e, 0xcd, 0x0f, 0x92, 0x56, 0x08, 0xd9, 0x61, 0x01, 0x0d, 0x6c, 0xfb, 0x6d,
-	0x85, 0xd0, 0xf0, 0xa7, 0x88, 0xcb, 0xe1, 0x4f, 0x21, 0xe8, 0xbf, 0xc9, 0x51, 0xcf, 0x93, 0x8e,
-	0xf7, 0xa3, 0x6e, 0x02, 0xa5, 0xb2, 0x93, 0xfc, 0x5d, 0x64, 0x27, 0x2f, 0x40, 0x91, 0x7e, 0x0e,
-	0xe2, 0xc4, 0x81, 0x4e, 0x1a, 0x81, 0xd4, 0x13, 0x47, 0x86, 0xdc, 0x26, 0x6a, 0xcd, 0xde, 0x67,
-	0xd4, 0xfa, 0x8b, 0x06, 0x0b, 0xea, 0x91, 0xc0, 0x23, 0x1f, 0xd6, 0x89, 0x05, 0x95, 0x7f, 0x48,
-	0x0b, 0xea, 0xcf, 0x1a, 0xcc, 0x2b, 0x27, 0x15, 0x8f, 0xcf, 0xab, 0xff, 0x30, 0x07, 0x4b, 0xd9,
-	0x6a, 0x1e, 0x4a, 0xf9, 0x74, 0x0e, 0x48, 0x22, 0x74, 0x3e, 0xf9, 0xb2, 0x1f, 0x9e, 0xa8, 0x9e,
-	0xe8, 0x2b, 0x88, 0x2c, 0x6a, 0xe2, 0x88, 0x41, 0x88, 0xa3, 0xeb, 0x50, 0xb1, 0xa4, 0xc3, 0x8c,
-	0x7c, 0x56, 0xcf, 0x59, 0x3e, 0xc2, 0x60, 0x35, 0xf6, 0x94, 0x83, 0x0b, 0x59, 0x55, 0xbb, 0x00,
-	0x33, 0x24, 0xf5, 0xd0, 0x77, 0xa0, 0xc8, 0xdd, 0x41, 0x2f, 0x43, 0x99, 0xee, 0x52, 0x5a, 0x11,
-	0xb0, 0xb4, 0x93, 0x7e, 0x34, 0x09, 0x98, 0xba, 0x4e, 0x50, 0x12, 0x18, 0x7a, 0x0d, 0x80, 0x24,
-	0x8e, 0x7c, 0x7f, 0xe6, 0xe8, 0xfe, 0xa4, 0x95, 0x87, 0xe7, 0x9a, 0x13, 0x9b, 0xb2, 0x1c, 0x83,
-	0xfa, 0x4f, 0x73, 0x50, 0x91, 0x8f, 0x4f, 0xee, 0xc9, 0xf8, 0xa7, 0x20, 0xaa, 0xc2, 0xae, 0x61,
-	0x9a, 0xe4, 0x2f, 0x16, 0x01, 0xb9, 0x35, 0x75, 0x90, 0xc4, 0xff, 0xab, 0x42, 0x82, 0xd5, 0x00,
-	0xf4, 0x80, 0xda, 0x4a, 0x91, 0x24, 0xab, 0xb5, 0x34, 0x6d, 0x79, 0x1b, 0x0e, 0x67, 0xaa, 0x92,
-	0x33, 0xf7, 0xd9, 0x07, 0x95, 0xb9, 0xff, 0x7c, 0x16, 0x0e, 0x67, 0x1e, 0x5b, 0x3d, 0xf2, 0x5d,
-	0xac, 0xee, 0xa0, 0xfc, 0x03, 0xd9, 0x41, 0x9f, 0x69, 0x59, 0x33, 0xcb, 0x8e, 0x00, 0xde, 0xd8,
-	0xc7, 0x59, 0xde, 0x83, 0x9a, 0x63, 0x75, 0x59, 0xce, 0xde, 0xd3, 0x9e, 0x28, 0xec, 0x77, 0x4f,
-	0xa0, 0x17, 0x59, 0x11, 0x46, 0x6d, 0x15, 0xa9, 0x2d, 0x11, 0x21, 0x52, 0xa6, 0x8a, 0x1c, 0x22,
-	0x75, 0xb9, 0x90, 0x60, 0xa5, 0x7f, 0x29, 0xa9, 0xcb, 0x39, 0x4f, 0xba, 0xfa, 0x9f, 0x93, 0xf1,
-	0x7f, 0xec, 0x1a, 0xfe, 0xab, 0x06, 0xd5, 0xd4, 0x39, 0xf6, 0xe3, 0xf3, 0x0d, 0xfa, 0x5c, 0x83,
-	0x72, 0x7c, 0x85, 0xe2, 0xbe, 0xd3, 0xd0, 0x55, 0x28, 0x60, 0x76, 0x8c, 0xcf, 0xc2, 0xdd, 0xa1,
-	0xd4, 0x35, 0x2b, 0x42, 0xe3, 0x17, 0xab, 0x52, 0x27, 0xf7, 0x1d, 0x2e, 0xa8, 0xff, 0x5a, 0x13,
-	0x09, 0x66, 0xe2, 0xd3, 0x23, 0x9d, 0x8a, 0xe4, 0x9d, 0xf2, 0xf7, 0xfa, 0x4e, 0xbf, 0x28, 0xc3,
-	0x2c, 0xe5, 0x23, 0x05, 0x60, 0x88, 0xfd, 0xa1, 0xe5, 0x18, 0x36, 0x7d, 0x9d, 0x12, 0xdb, 0xb7,
-	0x02, 0x93, 0xf7, 0xad, 0xc0, 0xd0, 0x16, 0x54, 0x93, 0xa6, 0x15, 0x55, 0x93, 0x7d, 0x7b, 0xeb,
-	0x3d, 0x95, 0x89, 0xb5, 0xa5, 0x53, 0x92, 0xea, 0xf1, 0x76, 0x8a, 0x88, 0x4c, 0x58, 0xe8, 0xbb,
-	0x4e, 0x68, 0x58, 0x0e, 0xf6, 0x99, 0xa1, 0x7c, 0xd6, 0xed, 0x95, 0xd3, 0x0a, 0x0f, 0xab, 0xfd,
-	0x55, 0x39, 0xf5, 0xf6, 0x8a, 0x4a, 0x43, 0x1f, 0xc3, 0xbc, 0x48, 0xc2, 0x99, 0x91, 0x99, 0xac,
-	0xdb, 0x2b, 0x6b, 0x32, 0x0b, 0x5b, 0xd2, 0x8a, 0x94, 0x7a, 0x7b, 0x45, 0x21, 0x21, 0x1b, 0x6a,
-	0x9e, 0x6b, 0x5e, 0x73, 0x78, 0xdb, 0xc1, 0xe8, 0xd9, 0x98, 0x77, 0x4a, 0x57, 0x26, 0x52, 0x1e,
-	0x85, 0x8b, 0x85, 0xe2, 0xb4, 0xac, 0x7a, 0x1f, 0x2c, 0x4d, 0x45, 0x1f, 0xc1, 0x9c, 0x4d, 0x6a,
-	0xa1, 0xb5, 0x5d, 0xcf, 0xf2, 0xb1, 0x99, 0x7d, 0x7b, 0xeb, 0xa2, 0xc4, 0xc1, 0x02, 0xa1, 0x2c,
-	0xa3, 0xde, 0x60, 0x91, 0x29, 0x64, 0xf6, 0x87, 0xc6, 0x6e, 0x27, 0x72, 0x82, 0xb5, 0x5d, 0x7e,
-	0x13, 0xa7, 0x98, 0x35, 0xfb, 0xeb, 0x2a, 0x13, 0x9b, 0xfd, 0x94, 0xa4, 0x3a, 0xfb, 0x29, 0x22,
-	0xba, 0x48, 0xe3, 0x3c, 0x9b, 0x12, 0x76, 0x8b, 0x6b, 0x69, 0x62, 0xb4, 0xd8, 0x6c, 0xb0, 0xa6,
-	0x05, 0x7f, 0x52, 0x94, 0xc6, 0x1a, 0xf8, 0x1c, 0xd0, 0xd7, 0xee, 0xe0, 0x30, 0xf2, 0x1d, 0x6c,
-	0xf2, 0x0b, 0x5c, 0x93, 0x73, 0xa0, 0x70, 0xc5, 0x73, 0xa0, 0xa0, 0x13, 0x73, 0xa0, 0x50, 0xc9,
-	0x9a, 0xf2, 0x5c, 0xf3, 0x2a, 0xdb, 0x32, 0x61, 0x7c, 0xad, 0xeb, 0xc9, 0x09, 0x53, 0x09, 0x0b,
-	0x5b, 0x53, 0x8a, 0x94, 0xba, 0xa6, 0x14, 0x12, 0xbf, 0x49, 0x24, 0xdf, 0x3b, 0x61, 0x23, 0x55,
-	0x99, 0x72, 0x93, 0x68, 0x82, 0x33, 0xbe, 0x49, 0x34, 0x41, 0x99, 0xb8, 0x49, 0x34, 0xc1, 0x41,
-	0xac, 0x0f, 0x0c, 0x67, 0x70, 0xc1, 0xed, 0xa9, 0xab, 0x7a, 0x2e, 0xcb, 0xfa, 0xd9, 0x0c, 0x4e,
-	0x66, 0x3d, 0x4b, 0x87, 0x6a, 0x3d, 0x8b, 0xa3, 0x5d, 0x12, 0xcd, 0x0d, 0xfd, 0x0b, 0x0d, 0xaa,
-	0xa9, 0x38, 0x83, 0xde, 0x86, 0xf8, 0xbe, 0xc4, 0xd5, 0x3d, 0x4f, 0xa4, 0xc9, 0xca, 0xfd, 0x0a,
-	0x82, 0x67, 0xdd, 0xaf, 0x20, 0x38, 0xba, 0x08, 0x10, 0x7f, 0x93, 0x6e, 0x17, 0xa4, 0x69, 0x8e,
-	0x96, 0x70, 0xca, 0x39, 0x5a, 0x82, 0xea, 0x5f, 0xe7, 0xa1, 0x24, 0x16, 0xea, 0x43, 0x29, 0xa3,
-	0x5a, 0x50, 0x1c, 0xe2, 0x80, 0xde, 0xb3, 0xc8, 0x25, 0xd9, 0x10, 0x87, 0xe4, 0x6c, 0x88, 0x43,
-	0x6a, 0xb2, 0x96, 0xbf, 0xa7, 0x64, 0x6d, 0x66, 0xdf, 0xc9, 0x1a, 0xa6, 0x67, 0xac, 0x52, 0xb8,
-	0x15, 0xa7, 0x1a, 0xb7, 0x8f, 0xe1, 0xe2, 0x04, 0x56, 0x16, 0x4c, 0x9d, 0xc0, 0xca, 0x24, 0xb4,
-	0x0d, 0x07, 0xa5, 0x93, 0x17, 0xde, 0xf9, 0x22, 0x81, 0x6f, 0x61, 0xfa, 0x81, 0x76, 0x87, 0x72,
-	0xb1, 0xed, 0xbd, 0x9d, 0x42, 0xe5, 0x6c, 0x37, 0x4d, 0xd3, 0xff, 0x90, 0x83, 0x05, 0xd5, 0xdf,
-	0x87, 0x32, 0xb1, 0x2f, 0x43, 0x19, 0xef, 0x5a, 0x61, 0xb7, 0xef, 0x9a, 0x98, 0x97, 0x8c, 0x74,
-	0x9e, 0x08, 0x78, 0xda, 0x35, 0x95, 0x79, 0x12, 0x98, 0xbc, 0x1a, 0xf2, 0xfb, 0x5a, 0x0d, 0x49,
-	0xa3, 0x70, 0xe6, 0xce, 0x8d, 0xc2, 0xec, 0x71, 0x2e, 0x3f, 0xa4, 0x71, 0xbe, 0x95, 0x83, 0x5a,
-	0x3a, 0x1a, 0x7f, 0x37, 0xb6, 0x90, 0xba, 0x1b, 0xf2, 0xfb, 0xde, 0x0d, 0xef, 0xc0, 0x3c, 0xc9,
-	0x1d, 0x8d, 0x30, 0xe4, 0x37, 0x10, 0x67, 0x68, 0xce, 0xc5, 0x62, 0x53, 0xe4, 0xac, 0x0a, 0x5c,
-	0x89, 0x4d, 0x12, 0xae, 0xff, 0x77, 0x0e, 0xe6, 0x95, 0xaf, 0xc6, 0xe3, 0x17, 0x52, 0xf4, 0x2a,
-	0xcc, 0x2b, 0xc9, 0x98, 0xfe, 0xbf, 0x6c, 0x9d, 0xa8, 0x59, 0xd0, 0xe3, 0x37, 0x2e, 0x0b, 0x30,
-	0x27, 0x67, 0x75, 0x7a, 0x1b, 0xaa, 0xa9, 0x24, 0x4c, 0x7e, 0x01, 0x6d, 0x3f, 0x2f, 0xa0, 0x2f,
-	0xc1, 0x62, 0x56, 0xee, 0xa0, 0x9f, 0x85, 0xc5, 0xac, 0xaf, 0xfa, 0xdd, 0x1b, 0xf8, 0x52, 0xa3,
-	0x16, 0x26, 0xef, 0x2a, 0x9f, 0x03, 0x70, 0xf0, 0xcd, 0xee, 0x1d, 0xcb, 0x3f, 0x36, 0x9e, 0xf8,
-	0xe6, 0x85, 0x54, 0xb5, 0x54, 0x12, 0x18, 0xd1, 0xe4, 0xda, 0x66, 0xf7, 0x8e, 0x45, 0x17, 0xd5,
-	0xe4, 0xda, 0xe6, 0x84, 0x26, 0x81, 0xe9, 0xff, 0x9f, 0x17, 0x95, 0x79, 0x72, 0xd9, 0xf7, 0x43,
-	0xa8, 0x79, 0xe2, 0xe1, 0xce, 0xde, 0xd2, 0xda, 0x24, 0xe6, 0x4f, 0x5b, 0x5a, 0x50, 0x29, 0xaa,
-	0x6e, 0x5e, 0x74, 0xe6, 0xf6, 0xa9, 0xbb, 0x93, 0xaa, 0x3e, 0x17, 0x54, 0x0a, 0xfa, 0x4f, 0x38,
-	0x28, 0xee, 0x42, 0xed, 0x60, 0xe1, 0x78, 0x7e, 0xaa, 0x72, 0x76, 0x37, 0x39, 0x16, 0x48, 0x7b,
-	0x5e, 0x4d, 0x91, 0x52, 0xea, 0xb9, 0xef, 0x33, 0xfb, 0x55, 0x9f, 0x76, 0xbe, 0x9a, 0x22, 0xe9,
-	0x9f, 0x6b, 0x50, 0x4d, 0x5d, 0x9f, 0x46, 0x67, 0xa0, 0x44, 0x7f, 0x5d, 0x75, 0xfb, 0x19, 0xa0,
-	0x0b, 0x92, 0xf2, 0x29, 0x16, 0x8a, 0x1c, 0x42, 0xaf, 0x42, 0x39, 0xbe, 0x65, 0xcd, 0xcf, 0x44,
-	0xd9, 0xe6, 0x13, 0xa0, 0xb2, 0xf9, 0x04, 0xa8, 0xff, 0x48, 0x83, 0x23, 0x53, 0xaf, 0x56, 0x3f,
-	0xea, 0x9e, 0xc1, 0x73, 0x2f, 0x42, 0x49, 0x9c, 0x5a, 0x22, 0x80, 0xc2, 0xfb, 0xd7, 0xd6, 0xae,
-	0xad, 0x9d, 0xa9, 0x1d, 0x40, 0x15, 0x28, 0x5e, 0x59, 0xbb, 0x74, 0xe6, 0xfc, 0xa5, 0xb3, 0x35,
-	0x8d, 0x3c, 0x74, 0xae, 0x5d, 0xba, 0x44, 0x1e, 0x72, 0xcf, 0x5d, 0x94, 0xef, 0x50, 0xb1, 0xef,
-	0x31, 0x9a, 0x83, 0xd2, 0xaa, 0xe7, 0xd1, 0x00, 0xc0, 0x64, 0xd7, 0x76, 0x2c, 0xb2, 0x57, 0x6b,
-	0x1a, 0x2a, 0x42, 0xfe, 0xf2, 0xe5, 0xf5, 0x5a, 0x0e, 0x2d, 0x42, 0xed, 0x0c, 0x36, 0x4c, 0xdb,
-	0x72, 0xb0, 0x88, 0x3a, 0xb5, 0x7c, 0xfb, 0xc6, 0x57, 0xdf, 0xac, 0x68, 0x5f, 0x7f, 0xb3, 0xa2,
-	0xfd, 0xfe, 0x9b, 0x15, 0xed, 0xd6, 0xb7, 0x2b, 0x07, 0xbe, 0xfe, 0x76, 0xe5, 0xc0, 0x6f, 0xbf,
-	0x5d, 0x39, 0xf0, 0xe1, 0x8b, 0xd2, 0x2f, 0x09, 0xd9, 0x3b, 0x79, 0xbe, 0x4b, 0x02, 0x2e, 0x7f,
-	0x6a, 0xa5, 0x7f, 0x3b, 0xf9, 0x65, 0xee, 0xd8, 0x2a, 0x7d, 0xbc, 0xc2, 0xf8, 0x9a, 0xe7, 0xdd,
-	0x26, 0x03, 0xe8, 0xcf, 0xdf, 0x82, 0x5e, 0x81, 0xfe, 0xcc, 0xed, 0xe5, 0xbf, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x2c, 0x75, 0x86, 0x00, 0x76, 0x39, 0x00, 0x00,
+	// 4246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4b, 0x70, 0x1b, 0x47,
+	0x76, 0x1a, 0x80, 0x00, 0x88, 0x47, 0x82, 0x80, 0x5a, 0x24, 0x35, 0xa2, 0x25, 0x82, 0x0b, 0xef,
+	0x47, 0x76, 0xd9, 0xa0, 0x57, 0x76, 0x1c, 0xaf, 0x37, 0xd9, 0x2d, 0x42, 0xa2, 0x2c, 0xc9, 0xa2,
+	0x44, 0x83, 0xa2, 0xe3, 0xb8, 0x36, 0x85, 0x1d, 0x60, 0x9a, 0xe0, 0x88, 0x83, 0x19, 0xec, 0x7c,
+	0x28, 0x31, 0xe5, 0x4a, 0x65, 0xf3, 0xd9, 0xca, 0x21, 0x95, 0x38, 0x95, 0x1c, 0x52, 0xb5, 0x87,
+	0xcd, 0x21, 0x97, 0x6c, 0x55, 0x72, 0xcd, 0x31, 0x95, 0xdb, 0x1e, 0x52, 0x29, 0x27, 0xb9, 0xe4,
+	0x84, 0xa4, 0xec, 0xca, 0x05, 0xb5, 0x95, 0x73, 0x92, 0x4b, 0x52, 0xfd, 0x9b, 0xe9, 0x6e, 0x0c,
+	0x48, 0xea, 0x67, 0x39, 0xeb, 0x93, 0x34, 0xef, 0xdb, 0x9f, 0xd7, 0xaf, 0xdf, 0x7b, 0xfd, 0x40,
+	0xb8, 0x34, 0x3c, 0xe8, 0xaf, 0x5b, 0xc1, 0xc0, 0xb2, 0x2d, 0x7c, 0x88, 0xbd, 0x28, 0x5c, 0x67,
+	0xff, 0x34, 0x87, 0x81, 0x1f, 0xf9, 0x68, 0x5e, 0x46, 0xad, 0x34, 0x0e, 0xde, 0x0a, 0x9b, 0x8e,
+	0xbf, 0x6e, 0x0d, 0x9d, 0xf5, 0x9e, 0x1f, 0xe0, 0xf5, 0xc3, 0x6f, 0xae, 0xf7, 0xb1, 0x87, 0x03,
+	0x2b, 0xc2, 0x36, 0xe3, 0x58, 0xb9, 0x2c, 0xd1, 0x78, 0x38, 0x7a, 0xe0, 0x07, 0x07, 0x8e, 0xd7,
+	0xcf, 0xa2, 0xac, 0xf7, 0x7d, 0xbf, 0xef, 0xe2, 0x75, 0xfa, 0xd5, 0x8d, 0xf7, 0xd6, 0x23, 0x67,
+	0x80, 0xc3, 0xc8, 0x1a, 0x0c, 0x39, 0xc1, 0x1b, 0xa9, 0xa8, 0x81, 0xd5, 0xdb, 0x77, 0x3c, 0x1c,
+	0x1c, 0xad, 0xd3, 0xf1, 0x0e, 0x9d, 0xf5, 0x00, 0x87, 0x7e, 0x1c, 0xf4, 0xf0, 0x84, 0xd8, 0x57,
+	0xfb, 0x4e, 0xb4, 0x1f, 0x77, 0x9b, 0x3d, 0x7f, 0xb0, 0xde, 0xf7, 0xfb, 0x7e, 0x2a, 0x9f, 0x7c,
+	0xd1, 0x0f, 0xfa, 0x3f, 0x4e, 0xfe, 0xb6, 0xe3, 0x45, 0x38, 0xf0, 0x2c, 0x77, 0x3d, 0xec, 0xed,
+	0x63, 0x3b, 0x76, 0x71, 0x90, 0xfe, 0xcf, 0xef, 0xde, 0xc7, 0xbd, 0x28, 0x9c, 0x00, 0x30, 0xde,
+	0xc6, 0xcf, 0xcf, 0x43, 0x65, 0x93, 0x2c, 0xcd, 0x0e, 0xfe, 0x41, 0x8c, 0xbd, 0x1e, 0x46, 0x2f,
+	0x41, 0xe1, 0x07, 0x31, 0x8e, 0xb1, 0x69, 0xac, 0x19, 0x97, 0xcb, 0xad, 0x73, 0xe3, 0x51, 0xbd,
+	0x4a, 0x01, 0xaf, 0xf8, 0x03, 0x27, 0xc2, 0x83, 0x61, 0x74, 0xd4, 0x66, 0x14, 0xe8, 0x6d, 0x98,
+	0xbf, 0xef, 0x77, 0x3b, 0x21, 0x8e, 0x3a, 0x9e, 0x35, 0xc0, 0x66, 0x8e, 0x72, 0x98, 0xe3, 0x51,
+	0x7d, 0xf1, 0xbe, 0xdf, 0xdd, 0xc1, 0xd1, 0x1d, 0x6b, 0x20, 0xb3, 0x41, 0x0a, 0x45, 0xaf, 0x42,
+	0x29, 0x0e, 0x71, 0xd0, 0x71, 0x6c, 0x33, 0x4f, 0xd9, 0x16, 0xc7, 0xa3, 0x7a, 0x8d, 0x80, 0x6e,
+	0xda, 0x12, 0x4b, 0x91, 0x41, 0xd0, 0x2b, 0x50, 0xec, 0x07, 0x7e, 0x3c, 0x0c, 0xcd, 0x99, 0xb5,
+	0xbc, 0xa0, 0x66, 0x10, 0x99, 0x9a, 0x41, 0xd0, 0x5d, 0x28, 0xb2, 0xfd, 0x36, 0x0b, 0x6b, 0xf9,
+	0xcb, 0x73, 0x57, 0xbe, 0xd2, 0x94, 0x8d, 0xa0, 0xa9, 0x4c, 0x98, 0x7d, 0x31, 0x81, 0x0c, 0x2f,
+	0x0b, 0x64, 0x10, 0xd4, 0x82, 0x05, 0xb2, 0x80, 0x03, 0xab, 0x73, 0x88, 0x83, 0xd0, 0xf1, 0x3d,
+	0xb3, 0xb8, 0x66, 0x5c, 0xae, 0xb4, 0x5e, 0x18, 0x8f, 0xea, 0xe7, 0x19, 0xe6, 0x7d, 0x86, 0x90,
+	0x98, 0x2b, 0x0a, 0x62, 0xe5, 0x87, 0x4b, 0x50, 0xa0, 0xba, 0xd0, 0x5d, 0x28, 0xf5, 0x02, 0x4c,
+	0x36, 0xdc, 0x44, 0x6b, 0xc6, 0xe5, 0xb9, 0x2b, 0x2b, 0x4d, 0x66, 0x48, 0x4d, 0xb1, 0xd1, 0xcd,
+	0x7b, 0xc2, 0x90, 0x5a, 0x17, 0xc6, 0xa3, 0xfa, 0x59, 0x4e, 0x9e, 0x0a, 0xff, 0xf8, 0xdf, 0xea,
+	0x46, 0x5b, 0x48, 0x41, 0xdb, 0x50, 0x0e, 0xe3, 0xee, 0xc0, 0x89, 0x6e, 0xf9, 0x5d, 0xba, 0x6f,
+	0x73, 0x57, 0xce, 0xab, 0x53, 0xde, 0x11, 0xe8, 0xd6, 0xf9, 0xf1, 0xa8, 0x7e, 0x2e, 0xa1, 0x4e,
+	0x25, 0xde, 0x38, 0xd3, 0x4e, 0x85, 0xa0, 0x7d, 0xa8, 0x06, 0x78, 0x18, 0x38, 0x7e, 0xe0, 0x44,
+	0x4e, 0x88, 0x89, 0xdc, 0x1c, 0x95, 0x7b, 0x49, 0x95, 0xdb, 0x56, 0x89, 0x5a, 0x97, 0xc6, 0xa3,
+	0xfa, 0x05, 0x8d, 0x53, 0xd1, 0xa1, 0x8b, 0x45, 0x11, 0x20, 0x0d, 0xb4, 0x83, 0x23, 0x6a, 0x13,
+	0x73, 0x57, 0xd6, 0x8e, 0x55, 0xb6, 0x83, 0xa3, 0xd6, 0xda, 0x78, 0x54, 0xbf, 0x38, 0xc9, 0xaf,
+	0xa8, 0xcc, 0x90, 0x8f, 0x5c, 0xa8, 0xc9, 0x50, 0x9b, 0x4c, 0x70, 0x86, 0xea, 0x5c, 0x9d, 0xae,
+	0x93, 0x50, 0xb5, 0x56, 0xc7, 0xa3, 0xfa, 0x8a, 0xce, 0xab, 0xe8, 0x9b, 0x90, 0x4c, 0xf6, 0xa7,
+	0x67, 0x79, 0x3d, 0xec, 0x12, 0x35, 0x85, 0xac, 0xfd, 0xb9, 0x2a, 0xd0, 0x6c, 0x7f, 0x12, 0x6a,
+	0x75, 0x7f, 0x12, 0x30, 0xfa, 0x1e, 0xcc, 0x27, 0x1f, 0x64, 0xbd, 0x8a, 0xdc, 0x8e, 0xb2, 0x85,
+	0x92, 0x95, 0x5a, 0x19, 0x8f, 0xea, 0xcb, 0x32, 0x8f, 0x22, 0x5a, 0x91, 0x96, 0x4a, 0x77, 0xd9,
+	0xca, 0x94, 0xa6, 0x4b, 0x67, 0x14, 0xb2, 0x74, 0x77, 0x72, 0x45, 0x14, 0x69, 0x44, 0x3a, 0x71,
+	0x04, 0x71, 0xaf, 0x87, 0xb1, 0x8d, 0x6d, 0x73, 0x36, 0x4b, 0xfa, 0x2d, 0x89, 0x82, 0x49, 0x97,
+	0x79, 0x54, 0xe9, 0x32, 0x86, 0xac, 0xf5, 0x7d, 0xbf, 0xbb, 0x19, 0x04, 0x7e, 0x10, 0x9a, 0xe5,
+	0xac, 0xb5, 0xbe, 0x25, 0xd0, 0x6c, 0xad, 0x13, 0x6a, 0x75, 0xad, 0x13, 0x30, 0x1f, 0x6f, 0x3b,
+	0xf6, 0x6e, 0x63, 0x2b, 0xc4, 0xb6, 0x09, 0x53, 0xc6, 0x9b, 0x50, 0x24, 0xe3, 0x4d, 0x20, 0x13,
+	0xe3, 0x4d, 0x30, 0xc8, 0x86, 0x05, 0xf6, 0xbd, 0x11, 0x86, 0x4e, 0xdf, 0xc3, 0xb6, 0x39, 0x47,
+	0xe5, 0x5f, 0xcc, 0x92, 0x2f, 0x68, 0x5a, 0x17, 0xc7, 0xa3, 0xba, 0xa9, 0xf2, 0x29, 0x3a, 0x34,
+	0x99, 0xe8, 0xfb, 0x50, 0x61, 0x90, 0x76, 0xec, 0x79, 0x8e, 0xd7, 0x37, 0xe7, 0xa9, 0x92, 0x17,
+	0xb2, 0x94, 0x70, 0x12, 0xe6, 0xdc, 0x14, 0x2e, 0x45, 0x85, 0x2a, 0x90, 0x78, 0x0c, 0x06, 0x48,
+	0x37, 0xb6, 0x92, 0xe5, 0x31, 0x6e, 0xa9, 0x44, 0xcc, 0x63, 0x68, 0x9c, 0xaa, 0xc7, 0xd0, 0x90,
+	0xe9, 0x7e, 0xf0, 0x4d, 0x5e, 0x98, 0xbe, 0x1f, 0x7c, 0x9f, 0xa5, 0xfd, 0xc8, 0xd8, 0x6a, 0x45,
+	0x1a, 0xfa, 0x08, 0xc8, 0xe5, 0x75, 0x2d, 0x1e, 0xba, 0x4e, 0xcf, 0x8a, 0xf0, 0x35, 0x1c, 0xe1,
+	0x1e, 0xf1, 0xd4, 0x55, 0xaa, 0xa5, 0x31, 0xa1, 0x65, 0x82, 0xb2, 0xd5, 0x18, 0x8f, 0xea, 0xab,
+	0x59, 0x32, 0x14, 0xad, 0x99, 0x5a, 0xd0, 0x6f, 0x1b, 0xb0, 0x14, 0x46, 0x96, 0x67, 0x5b, 0xae,
+	0xef, 0xe1, 0x9b, 0x5e, 0x3f, 0xc0, 0x61, 0x78, 0xd3, 0xdb, 0xf3, 0xcd, 0x1a, 0xd5, 0xff, 0xa2,
+	0xe6, 0xd6, 0xb3, 0x48, 0x5b, 0x2f, 0x8e, 0x47, 0xf5, 0x7a, 0xa6, 0x14, 0x65, 0x04, 0xd9, 0x8a,
+	0xd0, 0x43, 0x38, 0x27, 0x22, 0x93, 0xdd, 0xc8, 0x71, 0x9d, 0xd0, 0x8a, 0xc8, 0x85, 0x77, 0x76,
+	0xcd, 0x98, 0xbc, 0x49, 0xdb, 0x93, 0x84, 0xad, 0xaf, 0x8c, 0x47, 0xf5, 0x4b, 0x19, 0x12, 0x14,
+	0xdd, 0x59, 0x2a, 0x52, 0x13, 0xda, 0x0e, 0x30, 0x21, 0xc4, 0xb6, 0x79, 0x6e, 0xba, 0x09, 0x25,
+	0x44, 0xb2, 0x09, 0x25, 0xc0, 0x2c, 0x13, 0x4a, 0x90, 0x44, 0xd3, 0xd0, 0x0a, 0x22, 0x87, 0xa8,
+	0xdd, 0xb2, 0x82, 0x03, 0x1c, 0x98, 0x8b, 0x59, 0x9a, 0xb6, 0x55, 0x22, 0xa6, 0x49, 0xe3, 0x54,
+	0x35, 0x69, 0x48, 0xf4, 0xb1, 0x01, 0xea, 0xd0, 0x1c, 0xdf, 0x6b, 0x93, 0xd0, 0x23, 0x24, 0xd3,
+	0x5b, 0xa2, 0x4a, 0xbf, 0x71, 0xcc, 0xf4, 0x64, 0xf2, 0xd6, 0x37, 0xc6, 0xa3, 0xfa, 0x8b, 0x53,
+	0xa5, 0x29, 0x03, 0x99, 0xae, 0x14, 0x7d, 0x00, 0x73, 0x04, 0x89, 0x69, 0x10, 0x67, 0x9b, 0xcb,
+	0x74, 0x0c, 0x17, 0x26, 0xc7, 0xc0, 0x09, 0x68, 0x04, 0xb2, 0x24, 0x71, 0x28, 0x7a, 0x64, 0x51,
+	0xc4, 0xcb, 0x84, 0x71, 0x38, 0xc4, 0x9e, 0xcd, 0xaf, 0xa5, 0xf3, 0x59, 0x5e, 0x66, 0x47, 0x26,
+	0xe1, 0x21, 0x94, 0x0c, 0x52, 0xbd, 0x8c, 0x82, 0x22, 0x67, 0x3f, 0xc0, 0x61, 0x3c, 0x10, 0x71,
+	0x82, 0x99, 0x75, 0xf6, 0xdb, 0x12, 0x05, 0x3b, 0xfb, 0x32, 0x8f, 0x7a, 0xf6, 0x65, 0x0c, 0x89,
+	0x0a, 0xee, 0xfb, 0xdd, 0x5d, 0x8f, 0x07, 0xcb, 0x56, 0xd7, 0xc5, 0xe6, 0x85, 0xac, 0xa8, 0xe0,
+	0x96, 0x46, 0xc5, 0xa2, 0x02, 0x9d, 0x57, 0x8d, 0x0a, 0x74, 0x6c, 0x6a, 0xee, 0x1b, 0x41, 0xe4,
+	0xec, 0x59, 0xbd, 0x28, 0x34, 0x57, 0xa6, 0x9b, 0x7b, 0x42, 0x24, 0x9b, 0x7b, 0x02, 0xcc, 0x32,
+	0xf7, 0x94, 0xa3, 0x04, 0x05, 0x2a, 0xab, 0xf1, 0x07, 0x65, 0x38, 0x97, 0x71, 0x66, 0xd1, 0x77,
+	0xa0, 0x18, 0xc4, 0x1e, 0x09, 0xc6, 0x59, 0xf4, 0x88, 0xd4, 0x11, 0xec, 0xc6, 0x8e, 0xcd, 0x32,
+	0x81, 0x20, 0xf6, 0x94, 0xf8, 0xbc, 0x40, 0x01, 0x84, 0x9f, 0x64, 0x02, 0x8e, 0x6d, 0xe6, 0x8e,
+	0xe7, 0xbf, 0xef, 0x77, 0x55, 0x7e, 0x0a, 0x40, 0x18, 0x2a, 0xc2, 0x21, 0x74, 0x1c, 0xe2, 0xed,
+	0x58, 0xfc, 0xf7, 0x55, 0x55, 0xcc, 0xbb, 0x71, 0x17, 0x07, 0x1e, 0x8e, 0x70, 0x28, 0xe6, 0x40,
+	0xdd, 0x9d, 0xd8, 0xe1, 0x04, 0x22, 0xc9, 0x9f, 0x97, 0xe1, 0xe8, 0xcf, 0x0c, 0x30, 0x07, 0xd6,
+	0xc3, 0x8e, 0x00, 0x86, 0x9d, 0x3d, 0x3f, 0xe8, 0x0c, 0x71, 0xe0, 0xf8, 0x36, 0x4d, 0x2c, 0xe6,
+	0xae, 0xfc, 0xca, 0x89, 0x0e, 0xae, 0xb9, 0x65, 0x3d, 0x14, 0xe0, 0xf0, 0xba, 0x1f, 0x6c, 0x53,
+	0xf6, 0x4d, 0x2f, 0x0a, 0x8e, 0x5a, 0x97, 0x7e, 0x36, 0xaa, 0x9f, 0x21, 0xc7, 0x65, 0x90, 0x45,
+	0xd3, 0xce, 0x06, 0xa3, 0x3f, 0x36, 0x60, 0x39, 0xf2, 0x23, 0xcb, 0xed, 0xf4, 0xe2, 0x41, 0xec,
+	0x5a, 0x91, 0x73, 0x88, 0x3b, 0x71, 0x68, 0xf5, 0x31, 0xcf, 0x5f, 0xbe, 0x7d, 0xf2, 0xa0, 0xee,
+	0x11, 0xfe, 0xab, 0x09, 0xfb, 0x2e, 0xe1, 0x66, 0x63, 0xba, 0xc8, 0xc7, 0xb4, 0x18, 0x65, 0x90,
+	0xb4, 0x33, 0xa1, 0xe8, 0xb7, 0xe0, 0xac, 0x75, 0x88, 0x03, 0xab, 0x8f, 0xd3, 0xb5, 0x32, 0x8b,
+	0x74, 0x2c, 0xbf, 0x7c, 0xf2, 0x58, 0x36, 0x18, 0x6b, 0x32, 0x53, 0x36, 0x0e, 0x93, 0x8f, 0xa3,
+	0x66, 0x69, 0xe8, 0xf6, 0x04, 0x64, 0xe5, 0x2f, 0x0c, 0x58, 0x99, 0xbe, 0xcc, 0xe8, 0x45, 0xc8,
+	0x1f, 0xe0, 0x23, 0x9e, 0xa1, 0x9e, 0x1d, 0x8f, 0xea, 0x95, 0x03, 0x7c, 0x24, 0xed, 0x3a, 0xc1,
+	0xa2, 0x5f, 0x87, 0xc2, 0xa1, 0xe5, 0xc6, 0x98, 0x9b, 0x64, 0xb3, 0xc9, 0x72, 0xf1, 0xa6, 0x9c,
+	0x8b, 0x37, 0x87, 0x07, 0x7d, 0x02, 0x68, 0x8a, 0x59, 0x36, 0xdf, 0x8b, 0x2d, 0x2f, 0x72, 0xa2,
+	0x23, 0x66, 0xae, 0x54, 0x80, 0x6c, 0xae, 0x14, 0xf0, 0x76, 0xee, 0x2d, 0x63, 0xe5, 0x27, 0x06,
+	0x5c, 0x98, 0xba, 0xe8, 0x5f, 0x88, 0x11, 0xfe, 0xd8, 0x80, 0xa5, 0xcc, 0xad, 0xf8, 0x22, 0x8c,
+	0xae, 0xd1, 0x81, 0x19, 0xe2, 0x16, 0x48, 0x66, 0xbf, 0xef, 0xf4, 0xf7, 0xdf, 0x7c, 0x83, 0x0e,
+	0xa7, 0xc8, 0x12, 0x71, 0x06, 0x91, 0x13, 0x71, 0x06, 0x21, 0xd5, 0x09, 0xd7, 0x7f, 0xf0, 0xe6,
+	0x1b, 0x74, 0x50, 0x45, 0xa6, 0x84, 0x02, 0x64, 0x25, 0x14, 0xd0, 0xf8, 0xdf, 0x22, 0x94, 0x93,
+	0xb4, 0x57, 0xf2, 0x50, 0xc6, 0x63, 0x79, 0xa8, 0x1b, 0x50, 0xb3, 0xb1, 0xcd, 0xe3, 0x35, 0xc7,
+	0xf7, 0x84, 0xaf, 0x2b, 0x33, 0x77, 0xac, 0xe0, 0x14, 0xfe, 0xaa, 0x86, 0x42, 0x57, 0x60, 0x96,
+	0xa7, 0x87, 0x47, 0xd4, 0xcd, 0x55, 0x5a, 0xcb, 0xe3, 0x51, 0x1d, 0x09, 0x98, 0xc4, 0x9a, 0xd0,
+	0xa1, 0x36, 0x00, 0xab, 0xdb, 0x6c, 0xe1, 0xc8, 0xe2, 0x89, 0xaa, 0xa9, 0xce, 0xe0, 0x6e, 0x82,
+	0x67, 0x15, 0x98, 0x94, 0x5e, 0x92, 0x28, 0x49, 0x41, 0xdf, 0x03, 0x18, 0x58, 0x8e, 0xc7, 0xf8,
+	0xcc, 0x42, 0x56, 0x78, 0x9b, 0x3a, 0xdc, 0xad, 0x84, 0x92, 0x49, 0x4f, 0x39, 0x65, 0xe9, 0x29,
+	0x94, 0xd4, 0x38, 0x98, 0x2e, 0xe1, 0x37, 0x56, 0xa7, 0x89, 0xe6, 0x62, 0x97, 0x48, 0x9d, 0x83,
+	0xb3, 0x48, 0x32, 0x85, 0x14, 0xb2, 0x6c, 0xae, 0xb3, 0x87, 0x23, 0x67, 0x80, 0xcd, 0x52, 0xba,
+	0x6c, 0x02, 0x26, 0x2f, 0x9b, 0x80, 0xa1, 0xb7, 0x00, 0xac, 0x68, 0xcb, 0x0f, 0xa3, 0xbb, 0x5e,
+	0x0f, 0xd3, 0x3c, 0x73, 0x96, 0x0d, 0x3f, 0x85, 0xca, 0xc3, 0x4f, 0xa1, 0xe8, 0xdb, 0x30, 0x37,
+	0xe4, 0xa1, 0x13, 0x09, 0x02, 0xca, 0x94, 0x95, 0x06, 0x42, 0x12, 0x58, 0xe2, 0x95, 0xa9, 0xd1,
+	0x3b, 0x50, 0xed, 0xf9, 0x5e, 0x2f, 0x0e, 0x02, 0xec, 0xf5, 0x8e, 0x76, 0xac, 0x3d, 0x4c, 0x73,
+	0xc6, 0x59, 0x66, 0x2a, 0x1a, 0x4a, 0x36, 0x15, 0x0d, 0x85, 0x7e, 0x09, 0xca, 0x49, 0xdd, 0x8e,
+	0xa6, 0x85, 0x65, 0x5e, 0xbe, 0x11, 0x40, 0x89, 0x39, 0xa5, 0x24, 0x83, 0x77, 0xc2, 0x24, 0xb7,
+	0x30, 0xe7, 0xd3, 0xc1, 0x4b, 0x60, 0x79, 0xf0, 0x12, 0x18, 0xdd, 0x84, 0xb3, 0x34, 0x9a, 0xeb,
+	0x44, 0x91, 0xdb, 0x09, 0x71, 0xcf, 0xf7, 0xec, 0x90, 0x66, 0x72, 0x79, 0x36, 0x7c, 0x8a, 0xbc,
+	0x17, 0xb9, 0x3b, 0x0c, 0x25, 0x0f, 0x5f, 0x43, 0x35, 0xfe, 0xc1, 0x80, 0xc5, 0x2c, 0x13, 0xd2,
+	0xcc, 0xd9, 0x78, 0x2a, 0xe6, 0xfc, 0x3e, 0xcc, 0x0e, 0x7d, 0xbb, 0x13, 0x0e, 0x71, 0xcf, 0xcc,
+	0x65, 0x19, 0xf3, 0xb6, 0x6f, 0xef, 0x0c, 0x71, 0xef, 0xd7, 0x9c, 0x68, 0x7f, 0xe3, 0xd0, 0x77,
+	0xec, 0xdb, 0x4e, 0xc8, 0xad, 0x6e, 0xc8, 0x30, 0x4a, 0x0c, 0x55, 0xe2, 0xc0, 0xd6, 0x2c, 0x14,
+	0x99, 0x96, 0xc6, 0x3f, 0xe6, 0xa1, 0xa6, 0x9b, 0xed, 0xff, 0xa7, 0xa9, 0xa0, 0x0f, 0xa0, 0xe4,
+	0xb0, 0x44, 0x8f, 0xc7, 0x57, 0x5f, 0x93, 0x7c, 0x7a, 0x33, 0x2d, 0x75, 0x37, 0x0f, 0xbf, 0xd9,
+	0xe4, 0x19, 0x21, 0x5d, 0x02, 0x2a, 0x99, 0x73, 0xaa, 0x92, 0x39, 0x10, 0xb5, 0xa1, 0x14, 0xe2,
+	0xe0, 0xd0, 0xe9, 0x61, 0xee, 0x9c, 0xea, 0xb2, 0xe4, 0x9e, 0x1f, 0x60, 0x22, 0x73, 0x87, 0x91,
+	0xa4, 0x32, 0x39, 0x8f, 0x2a, 0x93, 0x03, 0xd1, 0xfb, 0x50, 0xee, 0xf9, 0xde, 0x9e, 0xd3, 0xdf,
+	0xb2, 0x86, 0xdc, 0x3d, 0x5d, 0xca, 0x92, 0x7a, 0x55, 0x10, 0xf1, 0xd2, 0x99, 0xf8, 0xd4, 0x4a,
+	0x67, 0x09, 0x55, 0xba, 0xa1, 0xff, 0x39, 0x03, 0x90, 0x6e, 0x0e, 0xfa, 0x16, 0xcc, 0xe1, 0x87,
+	0xb8, 0x17, 0x47, 0x7e, 0x20, 0xee, 0x09, 0x5e, 0xcd, 0x16, 0x60, 0xc5, 0xb1, 0x43, 0x0a, 0x25,
+	0x07, 0xd5, 0xb3, 0x06, 0x38, 0x1c, 0x5a, 0x3d, 0x51, 0x06, 0xa7, 0x83, 0x49, 0x80, 0xf2, 0x41,
+	0x4d, 0x80, 0xe8, 0xeb, 0x30, 0x43, 0x3e, 0x78, 0x05, 0x1c, 0x8d, 0x47, 0xf5, 0x05, 0x4f, 0x2d,
+	0x99, 0x53, 0x3c, 0xfa, 0x2e, 0x54, 0x0e, 0x12, 0xc3, 0x23, 0x63, 0x9b, 0xa1, 0x0c, 0x34, 0xf0,
+	0x4d, 0x11, 0xca, 0xe8, 0xe6, 0x65, 0x38, 0xda, 0x83, 0x39, 0xcb, 0xf3, 0xfc, 0x88, 0xde, 0x41,
+	0xa2, 0x2a, 0xfe, 0xd2, 0x34, 0x33, 0x6d, 0x6e, 0xa4, 0xb4, 0x2c, 0x76, 0xa3, 0xce, 0x43, 0x92,
+	0x20, 0x3b, 0x0f, 0x09, 0x8c, 0xda, 0x50, 0x74, 0xad, 0x2e, 0x76, 0x85, 0xd3, 0xff, 0xea, 0x54,
+	0x15, 0xb7, 0x29, 0x19, 0x93, 0x4e, 0xaf, 0x7c, 0xc6, 0x27, 0x5f, 0xf9, 0x0c, 0xb2, 0xb2, 0x07,
+	0x35, 0x7d, 0x3c, 0xa7, 0x0b, 0x60, 0x5e, 0x92, 0x03, 0x98, 0xf2, 0x89, 0xe1, 0x92, 0x05, 0x73,
+	0xd2, 0xa0, 0x9e, 0x85, 0x8a, 0xc6, 0x5f, 0x19, 0xb0, 0x98, 0x75, 0x76, 0xd1, 0x96, 0x74, 0xe2,
+	0x0d, 0x9e, 0x33, 0x67, 0x98, 0x3a, 0xe7, 0x9d, 0x72, 0xd4, 0xd3, 0x83, 0xde, 0x82, 0x05, 0xcf,
+	0xb7, 0x71, 0xc7, 0x22, 0x0a, 0x5c, 0x27, 0x8c, 0xcc, 0x1c, 0x7d, 0x35, 0xa1, 0xb9, 0x36, 0xc1,
+	0x6c, 0x08, 0x84, 0xfc, 0x5c, 0xa1, 0x20, 0x1a, 0xbf, 0x6f, 0x40, 0x55, 0x2b, 0xb8, 0x3f, 0x71,
+	0x10, 0x25, 0x87, 0x3e, 0xb9, 0xd3, 0x85, 0x3e, 0x8d, 0x3f, 0xcd, 0xc1, 0x9c, 0x54, 0x8d, 0x78,
+	0xe2, 0x31, 0xdc, 0x87, 0x2a, 0xbf, 0x29, 0x1d, 0xaf, 0xcf, 0x92, 0xcd, 0x1c, 0x2f, 0xad, 0x4d,
+	0xbc, 0x91, 0x91, 0xb2, 0x40, 0x42, 0x4b, 0x73, 0x4d, 0x5a, 0x77, 0x0d, 0x15, 0x98, 0xa4, 0x62,
+	0x41, 0xc5, 0xa0, 0x0f, 0x60, 0x39, 0x1e, 0xda, 0x56, 0x84, 0x3b, 0x21, 0x7f, 0x6d, 0xea, 0x78,
+	0xf1, 0xa0, 0x8b, 0x03, 0x7a, 0xe2, 0x0b, 0xac, 0x52, 0xc8, 0x28, 0xc4, 0x73, 0xd4, 0x1d, 0x8a,
+	0x97, 0x64, 0x2e, 0x66, 0xe1, 0x1b, 0xff, 0x9d, 0x87, 0x9a, 0x5e, 0x84, 0x78, 0xe2, 0xa5, 0x79,
+	0x05, 0x8a, 0x01, 0xb6, 0x42, 0xdf, 0xe3, 0xe6, 0x4c, 0xcf, 0x25, 0x83, 0xc8, 0xe7, 0x92, 0x41,
+	0x88, 0xf3, 0x1a, 0xfa, 0xbe, 0x2b, 0x3b, 0x2f, 0xf2, 0x2d, 0x3b, 0x2f, 0xf2, 0x8d, 0x5e, 0x87,
+	0xb2, 0x17, 0x0f, 0x3a, 0xc4, 0xba, 0x42, 0xea, 0xb8, 0xf8, 0xae, 0x7b, 0xf1, 0xe0, 0x0e, 0x81,
+	0xc9, 0xbb, 0x2e, 0x60, 0xe8, 0x2f, 0x0d, 0xb8, 0x48, 0xb8, 0xf0, 0xc3, 0x9e, 0x1b, 0xdb, 0xd8,
+	0x66, 0xec, 0x9d, 0xee, 0x51, 0x87, 0x8f, 0xb0, 0x90, 0x95, 0xad, 0xeb, 0x2b, 0xd2, 0xbc, 0x13,
+	0x0f, 0x36, 0xb9, 0x04, 0x2a, 0xb7, 0x75, 0xd4, 0xa6, 0xec, 0xcc, 0xef, 0x7c, 0x7d, 0x3c, 0xaa,
+	0x37, 0xbc, 0x29, 0x24, 0xd2, 0xb0, 0xcc, 0x69, 0x34, 0x2b, 0x21, 0x5c, 0x3a, 0x56, 0xc5, 0x63,
+	0x78, 0x91, 0xca, 0x89, 0x5e, 0xe4, 0x06, 0xa0, 0xc9, 0x97, 0x30, 0xe5, 0x6c, 0x19, 0xa7, 0x3c,
+	0x5b, 0x3f, 0x32, 0xa0, 0xa6, 0x3f, 0x70, 0x3d, 0x97, 0x43, 0x7e, 0x04, 0xe5, 0xe4, 0xb1, 0xea,
+	0xf3, 0x35, 0xe3, 0xc6, 0x3d, 0x98, 0x67, 0x2b, 0x78, 0xdd, 0x71, 0x23, 0x1c, 0xa0, 0x6b, 0x50,
+	0x0c, 0x23, 0x2b, 0xc2, 0xa1, 0x69, 0xac, 0xe5, 0x2f, 0x2f, 0x5c, 0x59, 0x9e, 0x7c, 0x97, 0x22,
+	0x68, 0x26, 0x95, 0x51, 0xca, 0x52, 0x19, 0xa4, 0xf1, 0x3b, 0x06, 0xcc, 0xcb, 0xcf, 0x6f, 0x4f,
+	0x47, 0xec, 0x23, 0x4e, 0xed, 0x57, 0xa1, 0xa2, 0xd4, 0x5a, 0x25, 0x76, 0xe3, 0x14, 0xec, 0x0b,
+	0x30, 0x2f, 0x57, 0x52, 0x1b, 0x1f, 0x89, 0x29, 0xb9, 0x4f, 0xc7, 0x50, 0x1e, 0x6d, 0x32, 0x7f,
+	0x6b, 0xb0, 0x8d, 0x4a, 0x9e, 0x81, 0x9e, 0x54, 0x7d, 0x3f, 0xad, 0x39, 0x12, 0x67, 0x1d, 0x9a,
+	0xb9, 0xac, 0x90, 0x65, 0x4a, 0xcd, 0x91, 0xde, 0xa4, 0x0a, 0xbb, 0x7c, 0x93, 0x2a, 0x88, 0xc6,
+	0x3f, 0xe7, 0xe8, 0xc8, 0xd3, 0x27, 0xbf, 0xe7, 0x5d, 0x6d, 0xd5, 0x02, 0xdd, 0xfc, 0x23, 0x04,
+	0xba, 0xaf, 0x42, 0x89, 0x46, 0x16, 0x49, 0x0c, 0x4a, 0x37, 0x8d, 0x80, 0x14, 0x96, 0x22, 0x83,
+	0x1c, 0x73, 0x01, 0x16, 0x9e, 0xf4, 0x02, 0x34, 0x60, 0x41, 0x7d, 0x13, 0x7d, 0xee, 0xcb, 0x3a,
+	0x61, 0x50, 0xf9, 0x67, 0x64, 0x50, 0xff, 0x65, 0x40, 0x45, 0x79, 0xaa, 0xfd, 0xf2, 0x4c, 0xfd,
+	0xcf, 0x73, 0xb0, 0x9c, 0x2d, 0xe6, 0x99, 0x64, 0xe2, 0x37, 0x80, 0xc4, 0xd4, 0x37, 0xd3, 0x20,
+	0x71, 0x69, 0x22, 0x11, 0xa7, 0x53, 0x10, 0x01, 0xf9, 0xc4, 0x1b, 0xab, 0x60, 0x27, 0x8f, 0x6e,
+	0x8e, 0xf4, 0x9a, 0x9b, 0xcf, 0x7a, 0x74, 0x93, 0xdf, 0x70, 0x59, 0xb9, 0x66, 0xca, 0xcb, 0xad,
+	0x2c, 0xaa, 0x55, 0x84, 0x19, 0x12, 0xc5, 0x36, 0xfe, 0x2e, 0x07, 0x25, 0x3e, 0x1e, 0x1a, 0x73,
+	0x91, 0x63, 0x4a, 0xb3, 0x4b, 0xe6, 0xeb, 0x59, 0xcc, 0xe5, 0xdb, 0x58, 0x6b, 0xca, 0x9a, 0x15,
+	0x30, 0xf4, 0x26, 0x00, 0x49, 0x42, 0xf8, 0x01, 0xcd, 0xd1, 0x03, 0x4a, 0xb3, 0xd8, 0xa1, 0x6f,
+	0x4f, 0x9c, 0xca, 0x72, 0x02, 0x44, 0xdf, 0x87, 0x39, 0xaa, 0x8c, 0x67, 0x7e, 0x6c, 0xeb, 0xbf,
+	0x96, 0xb9, 0x50, 0x4d, 0x12, 0x22, 0xc9, 0xa9, 0x1f, 0xdd, 0x06, 0x2f, 0x01, 0xca, 0xdb, 0x90,
+	0x42, 0x57, 0x30, 0x54, 0x35, 0xc6, 0x67, 0x92, 0x9e, 0xfd, 0x75, 0x0e, 0xe6, 0xe4, 0x97, 0xf0,
+	0xc7, 0x5a, 0xc5, 0x8f, 0x40, 0x94, 0x4a, 0x3a, 0x96, 0x6d, 0x93, 0x7f, 0xb1, 0xb8, 0x5a, 0xd6,
+	0xa7, 0x6e, 0xb7, 0xf8, 0xff, 0x86, 0xe0, 0x60, 0xab, 0x43, 0x5f, 0x15, 0x1d, 0x0d, 0x25, 0x69,
+	0xad, 0xe9, 0xb8, 0x95, 0x03, 0x58, 0xca, 0x14, 0x25, 0xaf, 0x57, 0xe1, 0x69, 0xad, 0xd7, 0xdf,
+	0x17, 0x60, 0x29, 0xb3, 0x03, 0xe1, 0xb9, 0xfb, 0x23, 0xd5, 0x17, 0xe4, 0x9f, 0x8a, 0x2f, 0xf8,
+	0x91, 0x91, 0xb5, 0xb3, 0xec, 0xd5, 0xf0, 0x5b, 0xa7, 0x68, 0xcb, 0x78, 0x5a, 0x7b, 0xac, 0x9a,
+	0x65, 0xe1, 0xb1, 0x0e, 0x77, 0xf1, 0xd4, 0x87, 0xfb, 0x35, 0x56, 0x99, 0xf0, 0x2c, 0x5e, 0x76,
+	0x2f, 0x27, 0xbe, 0x4e, 0x53, 0x55, 0xe2, 0x20, 0x52, 0xac, 0x12, 0x1c, 0xac, 0x1e, 0x36, 0x9b,
+	0x16, 0xab, 0x38, 0x8d, 0x5e, 0x12, 0x9b, 0x97, 0xe1, 0x9f, 0xaf, 0x0d, 0xff, 0x3c, 0x07, 0x55,
+	0xed, 0x81, 0xfd, 0x17, 0xd2, 0x7a, 0xd5, 0xfd, 0x9f, 0x39, 0xf5, 0xfe, 0x6f, 0x41, 0xd9, 0x4a,
+	0xda, 0x13, 0x58, 0xd2, 0xad, 0xa5, 0x2e, 0x62, 0xdd, 0x98, 0x38, 0x6b, 0xb2, 0x23, 0xa1, 0x9d,
+	0x4a, 0x68, 0xfc, 0x8b, 0x01, 0xb3, 0x82, 0x21, 0x29, 0x7f, 0x1a, 0x27, 0x94, 0x3f, 0x5b, 0xb0,
+	0xd0, 0xf3, 0xbd, 0xc8, 0x72, 0x3c, 0x1c, 0xc8, 0x9d, 0xc6, 0x34, 0x70, 0x48, 0x30, 0x9a, 0x3d,
+	0x56, 0x14, 0x04, 0xb1, 0x9d, 0x38, 0x10, 0xc5, 0x0a, 0x6a, 0x3b, 0x71, 0x20, 0xd7, 0x2a, 0x08,
+	0x96, 0x2c, 0x52, 0xe8, 0xfc, 0x26, 0xee, 0x74, 0x8f, 0x22, 0x5e, 0xab, 0xc8, 0xb3, 0x59, 0x11,
+	0x68, 0xeb, 0x48, 0xcd, 0xc9, 0xca, 0x09, 0xb0, 0xf1, 0x3f, 0x86, 0x30, 0x22, 0x25, 0x3d, 0xf9,
+	0x72, 0x84, 0x64, 0x7f, 0x64, 0x40, 0x39, 0x69, 0xa9, 0x7c, 0xe2, 0xac, 0x6c, 0x03, 0x8a, 0x98,
+	0x4a, 0xe2, 0x77, 0xe6, 0x39, 0xad, 0x75, 0x9b, 0xe0, 0x78, 0xb3, 0xb6, 0xd6, 0xc9, 0xd7, 0xe6,
+	0x8c, 0x8d, 0x7f, 0x32, 0x44, 0xbe, 0x95, 0x8e, 0xe9, 0xb9, 0x6e, 0x45, 0x3a, 0xa7, 0xfc, 0xe3,
+	0xce, 0xe9, 0x0f, 0xe7, 0xa1, 0x40, 0xe9, 0x48, 0x79, 0x25, 0xc2, 0xc1, 0xc0, 0xf1, 0x2c, 0x97,
+	0x4e, 0x67, 0x96, 0x39, 0x7f, 0x01, 0x93, 0x9d, 0xbf, 0x80, 0x91, 0x4e, 0xa3, 0xf4, 0x39, 0x80,
+	0x8a, 0xc9, 0xee, 0xe6, 0x7e, 0x57, 0x25, 0x62, 0x0f, 0x7e, 0x1a, 0xa7, 0xda, 0x69, 0xa4, 0x21,
+	0x49, 0x37, 0x6b, 0x72, 0xee, 0x98, 0xa2, 0x7c, 0x56, 0x37, 0xeb, 0x55, 0x85, 0x86, 0x55, 0x55,
+	0x55, 0x3e, 0xb5, 0x9b, 0x55, 0xc5, 0x91, 0x3e, 0x33, 0x91, 0x93, 0x32, 0x25, 0x33, 0x59, 0x7d,
+	0x66, 0x9b, 0x32, 0x09, 0x33, 0x69, 0x85, 0x4b, 0xed, 0x33, 0x53, 0x50, 0xa4, 0x13, 0x6c, 0xe8,
+	0xdb, 0x6a, 0x27, 0x58, 0x21, 0xab, 0x13, 0x6c, 0x5b, 0xa3, 0x62, 0xf7, 0xb9, 0xce, 0xab, 0x76,
+	0x82, 0xe9, 0x58, 0xd2, 0xd5, 0xe6, 0x62, 0x2b, 0xc4, 0x9b, 0x0f, 0x87, 0x4e, 0x80, 0xed, 0xec,
+	0x6e, 0xee, 0xdb, 0x12, 0x05, 0xbb, 0x4d, 0x65, 0x1e, 0xb5, 0xab, 0x4d, 0xc6, 0x90, 0xdd, 0x27,
+	0x7d, 0x47, 0xb1, 0x17, 0x6e, 0x3e, 0xe4, 0x9d, 0xb9, 0xa5, 0xac, 0xdd, 0xdf, 0x52, 0x89, 0xd8,
+	0xee, 0x6b, 0x9c, 0xea, 0xee, 0x6b, 0x48, 0x74, 0x9b, 0x06, 0x0b, 0x6c, 0x4b, 0x58, 0x57, 0xf7,
+	0xf2, 0xc4, 0x6a, 0xb1, 0xdd, 0x60, 0x25, 0x41, 0xfe, 0xa5, 0x08, 0x4d, 0x24, 0xf0, 0x3d, 0xa0,
+	0xd3, 0x6e, 0xe3, 0x28, 0x0e, 0x3c, 0x6c, 0x9b, 0xe5, 0x29, 0x7b, 0xa0, 0x50, 0x25, 0x7b, 0xa0,
+	0x40, 0x27, 0xf6, 0x40, 0xc1, 0x12, 0x9b, 0x1a, 0xfa, 0xf6, 0x3d, 0x76, 0x64, 0xa2, 0xa4, 0xcd,
+	0xfb, 0x85, 0x09, 0x55, 0x29, 0x09, 0xb3, 0x29, 0x85, 0x4b, 0xb5, 0x29, 0x05, 0xc5, 0x3b, 0x8b,
+	0xe5, 0x3e, 0x54, 0xb6, 0x52, 0x73, 0x53, 0x3a, 0x8b, 0x27, 0x28, 0x93, 0xce, 0xe2, 0x09, 0xcc,
+	0x44, 0x67, 0xf1, 0x04, 0x05, 0xd1, 0xde, 0xb7, 0xbc, 0xbe, 0x5e, 0x22, 0x37, 0xe7, 0xb3, 0xb4,
+	0xbf, 0x93, 0x41, 0xc9, 0xb4, 0x67, 0xc9, 0x50, 0xb5, 0x67, 0x51, 0xc8, 0x27, 0x76, 0x27, 0xb2,
+	0x5c, 0x6c, 0x56, 0xb2, 0x56, 0x77, 0x53, 0x26, 0x51, 0x4f, 0x2c, 0x05, 0x65, 0x9f, 0x58, 0x8a,
+	0x22, 0x6d, 0xcb, 0xa4, 0xa3, 0x1a, 0x0f, 0xb1, 0x67, 0x93, 0x06, 0x8a, 0xeb, 0x96, 0xe3, 0x62,
+	0xdb, 0x5c, 0xc8, 0x6a, 0x5b, 0xbe, 0x35, 0x49, 0xc8, 0xda, 0x96, 0x33, 0x24, 0xa8, 0x6d, 0xcb,
+	0x19, 0x04, 0xa8, 0x0f, 0xb5, 0x3d, 0xcb, 0x71, 0xe3, 0x00, 0x77, 0x7a, 0x56, 0x84, 0xfb, 0x7e,
+	0x70, 0x44, 0xbb, 0xc5, 0x17, 0xf4, 0x03, 0x76, 0x9d, 0x51, 0x5d, 0xe5, 0x44, 0xec, 0x80, 0xed,
+	0xa9, 0x40, 0xb9, 0x9f, 0x42, 0x43, 0x91, 0x97, 0x6b, 0x5e, 0x0c, 0xfd, 0x89, 0x01, 0x55, 0xcd,
+	0x59, 0xa3, 0xef, 0x40, 0xd2, 0xec, 0x78, 0xef, 0x68, 0x28, 0xa2, 0x2a, 0xa5, 0x39, 0x92, 0xc0,
+	0xb3, 0x9a, 0x23, 0x09, 0x1c, 0xdd, 0x06, 0x48, 0x2e, 0xf6, 0xe3, 0x6e, 0x3a, 0x1a, 0x6f, 0xa6,
+	0x94, 0x72, 0xbc, 0x99, 0x42, 0x1b, 0x9f, 0xe4, 0x61, 0x56, 0x9c, 0xf6, 0x67, 0x52, 0x9a, 0x59,
+	0x87, 0xd2, 0x00, 0x87, 0xb4, 0x49, 0x32, 0x97, 0xe6, 0x25, 0x1c, 0x24, 0xe7, 0x25, 0x1c, 0xa4,
+	0xa6, 0x4d, 0xf9, 0xc7, 0x4a, 0x9b, 0x4e, 0x1f, 0x36, 0x63, 0xa8, 0xaa, 0x77, 0x96, 0x08, 0x9e,
+	0x8f, 0xbf, 0x08, 0x45, 0x83, 0x90, 0xcc, 0xa8, 0x35, 0x08, 0xc9, 0x28, 0x74, 0x00, 0x67, 0xa5,
+	0xc6, 0x00, 0x5e, 0x4d, 0x2f, 0x52, 0xdb, 0x5b, 0x9d, 0x1e, 0xe8, 0x11, 0x2a, 0xe6, 0x23, 0x0f,
+	0x34, 0xa8, 0x9c, 0x77, 0xea, 0xb8, 0xc6, 0x7f, 0xe4, 0x60, 0x41, 0x1d, 0xef, 0x33, 0xd9, 0xd8,
+	0xd7, 0xa1, 0x8c, 0x1f, 0x3a, 0x51, 0xa7, 0xe7, 0xdb, 0x98, 0x57, 0xa1, 0xe8, 0x3e, 0x11, 0xe0,
+	0x55, 0xdf, 0x56, 0xf6, 0x49, 0xc0, 0x64, 0x6b, 0xc8, 0x9f, 0xca, 0x1a, 0xd2, 0xc7, 0x87, 0x99,
+	0x53, 0xbc, 0x75, 0x66, 0xae, 0x73, 0xf9, 0x19, 0xad, 0xf3, 0xc7, 0x39, 0xa8, 0xe9, 0x57, 0xda,
+	0x17, 0xe3, 0x08, 0xa9, 0xa7, 0x21, 0x7f, 0xea, 0xd3, 0xf0, 0x5d, 0xa8, 0x90, 0x00, 0xdc, 0x8a,
+	0x22, 0xfe, 0xb3, 0x8e, 0x19, 0x1a, 0xb8, 0x32, 0xdf, 0x14, 0x7b, 0x1b, 0x02, 0xae, 0xf8, 0x26,
+	0x09, 0xde, 0xf8, 0x61, 0x0e, 0x2a, 0xca, 0xd5, 0xfb, 0xe5, 0x73, 0x29, 0x8d, 0x2a, 0x54, 0x94,
+	0x88, 0xb6, 0xf1, 0x7b, 0xcc, 0x4e, 0xd4, 0x8b, 0xf6, 0xcb, 0xb7, 0x2e, 0x0b, 0x30, 0x2f, 0x87,
+	0xc6, 0x8d, 0xbf, 0x31, 0xd2, 0x85, 0x62, 0xa1, 0xc1, 0x13, 0x34, 0x76, 0x75, 0x61, 0xc1, 0xb5,
+	0xc2, 0xa8, 0xb3, 0x8f, 0xad, 0x20, 0xea, 0x62, 0x2b, 0x32, 0x73, 0x27, 0xfe, 0x62, 0xb7, 0x4e,
+	0xe2, 0x16, 0xc2, 0x75, 0x43, 0x30, 0x69, 0xbf, 0xdb, 0xad, 0x28, 0xc8, 0x46, 0x0b, 0xaa, 0x5a,
+	0xe8, 0x2d, 0xaf, 0xb8, 0x71, 0x9a, 0x15, 0x6f, 0x2c, 0xc3, 0x62, 0x56, 0xc4, 0xd8, 0x78, 0x07,
+	0x16, 0xb3, 0x62, 0xb9, 0x47, 0x57, 0xf0, 0x27, 0x06, 0x9c, 0xcb, 0x08, 0x9b, 0x48, 0xbb, 0xa8,
+	0x9d, 0xc0, 0x3a, 0x52, 0xea, 0x9f, 0x34, 0x46, 0x0b, 0xe4, 0x2d, 0x2d, 0x39, 0xae, 0x6a, 0xa8,
+	0x47, 0x36, 0xb3, 0xc6, 0x4f, 0x0d, 0x3a, 0xeb, 0xc9, 0x5f, 0xd1, 0xdd, 0x00, 0xf0, 0xf0, 0x83,
+	0xce, 0x89, 0x85, 0x08, 0x66, 0x94, 0xf8, 0x81, 0x3e, 0xb4, 0x59, 0x01, 0x23, 0x92, 0x7c, 0xd7,
+	0xee, 0x9c, 0x98, 0xfe, 0x53, 0x49, 0xbe, 0x6b, 0x4f, 0x48, 0x12, 0xb0, 0xc6, 0xef, 0x16, 0xa0,
+	0xaa, 0x6d, 0x11, 0xfa, 0x10, 0x6a, 0x43, 0xf1, 0x71, 0xf2, 0x68, 0x69, 0x96, 0x9c, 0xd0, 0xeb,
+	0x9a, 0x16, 0x54, 0x8c, 0x2a, 0x9b, 0x97, 0x3f, 0x72, 0xa7, 0x94, 0xdd, 0x8e, 0xbd, 0x29, 0xb2,
+	0x29, 0x06, 0xfd, 0x06, 0x9c, 0xe5, 0x10, 0xf2, 0x4b, 0x15, 0x3e, 0xf0, 0xfc, 0x54, 0xe1, 0xec,
+	0x57, 0x73, 0x09, 0xc3, 0x84, 0x21, 0x68, 0x28, 0x4d, 0x3c, 0x1f, 0xfb, 0xcc, 0x69, 0xc5, 0xeb,
+	0x83, 0xaf, 0x6a, 0x28, 0x74, 0x3b, 0xb9, 0xfa, 0x0b, 0x59, 0x37, 0xb8, 0xfc, 0x93, 0x39, 0x7a,
+	0x83, 0x1f, 0x1f, 0x1a, 0xdc, 0x80, 0x9a, 0x34, 0x58, 0xf6, 0xa7, 0x13, 0x8a, 0xa9, 0xfd, 0xa7,
+	0xb8, 0xf7, 0xb4, 0x3f, 0xa2, 0x50, 0xd5, 0x50, 0xf2, 0xdb, 0x7a, 0xe9, 0x14, 0x6f, 0xeb, 0x8a,
+	0x93, 0x9d, 0x3d, 0x9d, 0x93, 0x25, 0xc5, 0xba, 0xaa, 0xf6, 0xa3, 0x46, 0x74, 0x0d, 0x66, 0xe9,
+	0xdf, 0x4d, 0x38, 0xde, 0xfa, 0xe8, 0x61, 0xa4, 0x74, 0xca, 0x68, 0x4a, 0x1c, 0x44, 0x5a, 0x60,
+	0x93, 0xdf, 0x3e, 0xf2, 0xbe, 0x1f, 0xe6, 0xbd, 0x05, 0x50, 0xf1, 0xde, 0x02, 0xd8, 0xf8, 0xb1,
+	0x01, 0x17, 0xa6, 0xfe, 0xe0, 0xf1, 0x79, 0x57, 0xee, 0x5e, 0x7e, 0x0d, 0x66, 0x45, 0x67, 0x0e,
+	0x02, 0x28, 0xbe, 0xb7, 0xbb, 0xb9, 0xbb, 0x79, 0xad, 0x76, 0x06, 0xcd, 0x41, 0x69, 0x7b, 0xf3,
+	0xce, 0xb5, 0x9b, 0x77, 0xde, 0xa9, 0x19, 0xe4, 0xa3, 0xbd, 0x7b, 0xe7, 0x0e, 0xf9, 0xc8, 0xbd,
+	0x7c, 0x08, 0x55, 0x2d, 0xcd, 0x43, 0x2b, 0xb0, 0xbc, 0xeb, 0x1d, 0x78, 0xfe, 0x03, 0x4f, 0xc3,
+	0xd4, 0xce, 0xa0, 0x0a, 0x94, 0xef, 0xde, 0xdd, 0x7a, 0xd7, 0x21, 0x3d, 0x35, 0x35, 0x83, 0x7c,
+	0xde, 0x1c, 0x58, 0x7d, 0xbc, 0x1d, 0xbb, 0x6e, 0x2d, 0x87, 0xe6, 0x61, 0x96, 0xbe, 0x7b, 0xfa,
+	0x61, 0x54, 0xcb, 0x13, 0xe4, 0x6e, 0xc8, 0x23, 0xef, 0xda, 0x0c, 0x41, 0x5e, 0xc3, 0x96, 0xed,
+	0x3a, 0x1e, 0xae, 0x15, 0x5e, 0xbe, 0x2d, 0xf7, 0xa6, 0x33, 0xc3, 0x25, 0x14, 0x1b, 0xc3, 0x21,
+	0xa3, 0xa7, 0x63, 0xde, 0x3c, 0x74, 0x88, 0x7f, 0xac, 0x19, 0xa8, 0x04, 0xf9, 0xbb, 0x77, 0xb7,
+	0x6a, 0x39, 0xb4, 0x08, 0x35, 0x21, 0x45, 0xdc, 0x3e, 0xb5, 0xfc, 0xcb, 0x1f, 0x42, 0x4d, 0x3f,
+	0x06, 0xe8, 0x05, 0x38, 0xcf, 0xa7, 0xa1, 0xa3, 0xd8, 0x3c, 0xae, 0x5b, 0x4e, 0xb0, 0xb3, 0x6f,
+	0x05, 0x98, 0x2d, 0xc9, 0x6e, 0xd0, 0x27, 0xae, 0xbd, 0x96, 0x23, 0x38, 0x32, 0x8b, 0x6b, 0x81,
+	0xe5, 0x78, 0xb5, 0x7c, 0xeb, 0xfe, 0xcf, 0x3e, 0x5d, 0x35, 0x3e, 0xf9, 0x74, 0xd5, 0xf8, 0xf7,
+	0x4f, 0x57, 0x8d, 0x8f, 0x3f, 0x5b, 0x3d, 0xf3, 0xc9, 0x67, 0xab, 0x67, 0xfe, 0xf5, 0xb3, 0xd5,
+	0x33, 0x1f, 0xbe, 0x26, 0xfd, 0xdd, 0x13, 0xb6, 0x4f, 0xc3, 0xc0, 0x27, 0x51, 0x08, 0xff, 0x5a,
+	0xd7, 0xff, 0xd2, 0xcb, 0x4f, 0x73, 0x97, 0x36, 0xe8, 0xe7, 0x36, 0xa3, 0x6b, 0xde, 0xf4, 0x9b,
+	0x0c, 0x40, 0xff, 0xd0, 0x46, 0xd8, 0x2d, 0xd2, 0xeb, 0xf9, 0xf5, 0xff, 0x1b, 0x00, 0x76, 0xf2,
+	0xcb, 0xfb, 0x24, 0x46, 0x00, 0x00,
 }
 
 func (m *EventSequence) Marshal() (dAtA []byte, err error) {
@@ -3732,6 +4446,11 @@ func (m *EventSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SchemaVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SchemaVersion))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4276,6 +4995,98 @@ func (m *EventSequence_Event_JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventSequence_Event_SuspendJobSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_SuspendJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SuspendJobSet != nil {
+		{
+			size, err := m.SuspendJobSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_ResumeJobSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_ResumeJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResumeJobSet != nil {
+		{
+			size, err := m.ResumeJobSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobUnschedulable != nil {
+		{
+			size, err := m.JobUnschedulable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobRunArtifacts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobRunArtifacts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobRunArtifacts != nil {
+		{
+			size, err := m.JobRunArtifacts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ResourceUtilisation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4296,6 +5107,30 @@ func (m *ResourceUtilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AverageResources) > 0 {
+		for k := range m.AverageResources {
+			v := m.AverageResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.TotalCumulativeUsage) > 0 {
 		for k := range m.TotalCumulativeUsage {
 			v := m.TotalCumulativeUsage[k]
@@ -4978,6 +5813,77 @@ func (m *JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobUnschedulable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NumExcludedNodesByReason) > 0 {
+		for k := range m.NumExcludedNodesByReason {
+			v := m.NumExcludedNodesByReason[k]
+			baseI := i
+			i = encodeVarintEvents(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NumNodes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumNodes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ReprioritiseJobSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5109,20 +6015,20 @@ func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.States) > 0 {
-		dAtA45 := make([]byte, len(m.States)*10)
-		var j44 int
+		dAtA51 := make([]byte, len(m.States)*10)
+		var j50 int
 		for _, num := range m.States {
 			for num >= 1<<7 {
-				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j44++
+				j50++
 			}
-			dAtA45[j44] = uint8(num)
-			j44++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j44
-		copy(dAtA[i:], dAtA45[:j44])
-		i = encodeVarintEvents(dAtA, i, uint64(j44))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintEvents(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5157,26 +6063,79 @@ func (m *CancelJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.States) > 0 {
-		dAtA47 := make([]byte, len(m.States)*10)
-		var j46 int
+		dAtA53 := make([]byte, len(m.States)*10)
+		var j52 int
 		for _, num := range m.States {
 			for num >= 1<<7 {
-				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j46++
+				j52++
 			}
-			dAtA47[j46] = uint8(num)
-			j46++
+			dAtA53[j52] = uint8(num)
+			j52++
 		}
-		i -= j46
-		copy(dAtA[i:], dAtA47[:j46])
-		i = encodeVarintEvents(dAtA, i, uint64(j46))
+		i -= j52
+		copy(dAtA[i:], dAtA53[:j52])
+		i = encodeVarintEvents(dAtA, i, uint64(j52))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuspendJobSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspendJobSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspendJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *ResumeJobSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeJobSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *CancelledJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5562,6 +6521,25 @@ func (m *PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NodeLabels) > 0 {
+		for k := range m.NodeLabels {
+			v := m.NodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.PodNumber != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
 		i--
@@ -5726,7 +6704,7 @@ func (m *StandaloneIngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobRunSucceeded) Marshal() (dAtA []byte, err error) {
+func (m *JobRunArtifacts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5736,20 +6714,20 @@ func (m *JobRunSucceeded) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobRunSucceeded) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobRunArtifacts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobRunArtifacts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ResourceInfos) > 0 {
-		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Artifacts) > 0 {
+		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5757,8 +6735,25 @@ func (m *JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.JobId != nil {
 		{
@@ -5787,7 +6782,7 @@ func (m *JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobErrors) Marshal() (dAtA []byte, err error) {
+func (m *Artifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5797,26 +6792,136 @@ func (m *JobErrors) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobErrors) MarshalTo(dAtA []byte) (int, error) {
+func (m *Artifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Errors) > 0 {
-		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
+	if m.SizeBytes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContainerName) > 0 {
+		i -= len(m.ContainerName)
+		copy(dAtA[i:], m.ContainerName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContainerName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRunSucceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunSucceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceInfos) > 0 {
+		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RunId != nil {
+		{
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobErrors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobErrors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
 		}
@@ -5917,6 +7022,11 @@ func (m *Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FailureCategory != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FailureCategory))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.Reason != nil {
 		{
 			size := m.Reason.Size()
@@ -6170,6 +7280,48 @@ func (m *Error_GangJobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Error_ExecutorStale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_ExecutorStale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExecutorStale != nil {
+		{
+			size, err := m.ExecutorStale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Error_JobDependencyFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_JobDependencyFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobDependencyFailed != nil {
+		{
+			size, err := m.JobDependencyFailed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
 func (m *KubernetesError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6555,6 +7707,46 @@ func (m *LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutorStale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorStale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorStale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeartbeat != nil {
+		n95, err95 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeat, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeat):])
+		if err95 != nil {
+			return 0, err95
+		}
+		i -= n95
+		i = encodeVarintEvents(dAtA, i, uint64(n95))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MaxRunsExceeded) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6638,6 +7830,43 @@ func (m *GangJobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobDependencyFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobDependencyFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobDependencyFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DependencyJobId) > 0 {
+		i -= len(m.DependencyJobId)
+		copy(dAtA[i:], m.DependencyJobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DependencyJobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JobDuplicateDetected) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6705,6 +7934,32 @@ func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PreemptiveQueue) > 0 {
+		i -= len(m.PreemptiveQueue)
+		copy(dAtA[i:], m.PreemptiveQueue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreemptiveQueue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.PreemptiveRunId != nil {
 		{
 			size, err := m.PreemptiveRunId.MarshalToSizedBuffer(dAtA[:i])
@@ -6884,6 +8139,9 @@ func (m *EventSequence) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovEvents(uint64(m.SchemaVersion))
+	}
 	return n
 }
 
@@ -7155,34 +8413,82 @@ func (m *EventSequence_Event_JobRequeued) Size() (n int) {
 	}
 	return n
 }
-func (m *ResourceUtilisation) Size() (n int) {
+func (m *EventSequence_Event_SuspendJobSet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.SuspendJobSet != nil {
+		l = m.SuspendJobSet.Size()
+		n += 2 + l + sovEvents(uint64(l))
 	}
-	if m.ResourceInfo != nil {
-		l = m.ResourceInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+func (m *EventSequence_Event_ResumeJobSet) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.MaxResourcesForPeriod) > 0 {
-		for k, v := range m.MaxResourcesForPeriod {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
-			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
-		}
+	var l int
+	_ = l
+	if m.ResumeJobSet != nil {
+		l = m.ResumeJobSet.Size()
+		n += 2 + l + sovEvents(uint64(l))
 	}
-	if len(m.TotalCumulativeUsage) > 0 {
+	return n
+}
+func (m *EventSequence_Event_JobUnschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobUnschedulable != nil {
+		l = m.JobUnschedulable.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunArtifacts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunArtifacts != nil {
+		l = m.JobRunArtifacts.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *ResourceUtilisation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ResourceInfo != nil {
+		l = m.ResourceInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.MaxResourcesForPeriod) > 0 {
+		for k, v := range m.MaxResourcesForPeriod {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TotalCumulativeUsage) > 0 {
 		for k, v := range m.TotalCumulativeUsage {
 			_ = k
 			_ = v
@@ -7191,6 +8497,15 @@ func (m *ResourceUtilisation) Size() (n int) {
 			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
 		}
 	}
+	if len(m.AverageResources) > 0 {
+		for k, v := range m.AverageResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -7453,6 +8768,38 @@ func (m *JobRequeued) Size() (n int) {
 	return n
 }
 
+func (m *JobUnschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NumNodes != 0 {
+		n += 1 + sovEvents(uint64(m.NumNodes))
+	}
+	if len(m.NumExcludedNodesByReason) > 0 {
+		for k, v := range m.NumExcludedNodesByReason {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + sovEvents(uint64(v))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *ReprioritiseJobSet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7534,6 +8881,28 @@ func (m *CancelJobSet) Size() (n int) {
 	return n
 }
 
+func (m *SuspendJobSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeJobSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *CancelledJob) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7697,6 +9066,14 @@ func (m *PodInfo) Size() (n int) {
 	if m.PodNumber != 0 {
 		n += 1 + sovEvents(uint64(m.PodNumber))
 	}
+	if len(m.NodeLabels) > 0 {
+		for k, v := range m.NodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -7765,6 +9142,60 @@ func (m *StandaloneIngressInfo) Size() (n int) {
 	return n
 }
 
+func (m *JobRunArtifacts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvents(uint64(m.PodNumber))
+	}
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Artifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContainerName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovEvents(uint64(m.SizeBytes))
+	}
+	return n
+}
+
 func (m *JobRunSucceeded) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7842,6 +9273,9 @@ func (m *Error) Size() (n int) {
 	if m.Reason != nil {
 		n += m.Reason.Size()
 	}
+	if m.FailureCategory != 0 {
+		n += 1 + sovEvents(uint64(m.FailureCategory))
+	}
 	return n
 }
 
@@ -7977,6 +9411,30 @@ func (m *Error_GangJobUnschedulable) Size() (n int) {
 	}
 	return n
 }
+func (m *Error_ExecutorStale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutorStale != nil {
+		l = m.ExecutorStale.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_JobDependencyFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobDependencyFailed != nil {
+		l = m.JobDependencyFailed.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *KubernetesError) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8143,6 +9601,23 @@ func (m *LeaseExpired) Size() (n int) {
 	return n
 }
 
+func (m *ExecutorStale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.LastHeartbeat != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeat)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *MaxRunsExceeded) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8178,6 +9653,23 @@ func (m *GangJobUnschedulable) Size() (n int) {
 	return n
 }
 
+func (m *JobDependencyFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DependencyJobId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *JobDuplicateDetected) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8217,6 +9709,21 @@ func (m *JobRunPreempted) Size() (n int) {
 		l = m.PreemptiveRunId.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Reason != 0 {
+		n += 1 + sovEvents(uint64(m.Reason))
+	}
+	l = len(m.PreemptiveQueue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -8450,6 +9957,25 @@ func (m *EventSequence) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -9271,17 +10797,157 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &EventSequence_Event_JobRequeued{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendJobSet", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SuspendJobSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_SuspendJobSet{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeJobSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResumeJobSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_ResumeJobSet{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobUnschedulable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobUnschedulable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobUnschedulable{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunArtifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunArtifacts{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunArtifacts{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -9687,6 +11353,135 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 			}
 			m.TotalCumulativeUsage[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AverageResources == nil {
+				m.AverageResources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AverageResources[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11314,7 +13109,7 @@ func (m *JobRequeued) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
+func (m *JobUnschedulable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11337,17 +13132,17 @@ func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReprioritiseJobSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUnschedulable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReprioritiseJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUnschedulable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			m.Priority = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11357,18 +13152,642 @@ func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+			}
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumExcludedNodesByReason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumExcludedNodesByReason == nil {
+				m.NumExcludedNodesByReason = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NumExcludedNodesByReason[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReprioritiseJobSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReprioritiseJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReprioritisedJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReprioritisedJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if (iNdEx + skippy) > l {
@@ -11383,7 +13802,7 @@ func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
+func (m *CancelJobSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11406,17 +13825,86 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReprioritisedJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelJobSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReprioritisedJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11426,33 +13914,79 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobId == nil {
-				m.JobId = &Uuid{}
-			}
-			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Priority = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuspendJobSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspendJobSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspendJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11462,11 +13996,24 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11488,7 +14035,7 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelJob) Unmarshal(dAtA []byte) error {
+func (m *ResumeJobSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11511,10 +14058,60 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeJobSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelledJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelledJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelledJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11606,7 +14203,7 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+func (m *JobSucceeded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11629,205 +14226,53 @@ func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSucceeded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSucceeded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v JobState
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= JobState(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.States = append(m.States, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.States) == 0 {
-					m.States = make([]JobState, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v JobState
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= JobState(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.States = append(m.States, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CancelJobSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CancelJobSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v JobState
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= JobState(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.States = append(m.States, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.States) == 0 {
-					m.States = make([]JobState, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v JobState
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= JobState(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.States = append(m.States, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11837,23 +14282,25 @@ func (m *CancelJobSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ResourceInfos = append(m.ResourceInfos, &KubernetesResourceInfo{})
+			if err := m.ResourceInfos[len(m.ResourceInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11876,7 +14323,7 @@ func (m *CancelJobSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelledJob) Unmarshal(dAtA []byte) error {
+func (m *JobRunLeased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11899,13 +14346,49 @@ func (m *CancelledJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelledJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunLeased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelledJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunLeased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunId == nil {
+				m.RunId = &Uuid{}
+			}
+			if err := m.RunId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
@@ -11941,9 +14424,9 @@ func (m *CancelledJob) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11971,8 +14454,59 @@ func (m *CancelledJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateSequenceNumber", wireType)
+			}
+			m.UpdateSequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateSequenceNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11994,7 +14528,7 @@ func (m *CancelledJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSucceeded) Unmarshal(dAtA []byte) error {
+func (m *JobRunAssigned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12017,13 +14551,49 @@ func (m *JobSucceeded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSucceeded: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunAssigned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSucceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunAssigned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunId == nil {
+				m.RunId = &Uuid{}
+			}
+			if err := m.RunId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
@@ -12059,7 +14629,7 @@ func (m *JobSucceeded) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfos", wireType)
 			}
@@ -12114,7 +14684,7 @@ func (m *JobSucceeded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunLeased) Unmarshal(dAtA []byte) error {
+func (m *JobRunRunning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12137,10 +14707,10 @@ func (m *JobRunLeased) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunLeased: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunRunning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunLeased: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunRunning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12217,9 +14787,9 @@ func (m *JobRunLeased) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12229,75 +14799,26 @@ func (m *JobRunLeased) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ResourceInfos = append(m.ResourceInfos, &KubernetesResourceInfo{})
+			if err := m.ResourceInfos[len(m.ResourceInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateSequenceNumber", wireType)
-			}
-			m.UpdateSequenceNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdateSequenceNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -12319,7 +14840,7 @@ func (m *JobRunLeased) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunAssigned) Unmarshal(dAtA []byte) error {
+func (m *KubernetesResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12342,15 +14863,15 @@ func (m *JobRunAssigned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunAssigned: wiretype end group for non-group")
+			return fmt.Errorf("proto: KubernetesResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunAssigned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KubernetesResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12377,16 +14898,16 @@ func (m *JobRunAssigned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunId == nil {
-				m.RunId = &Uuid{}
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &ObjectMeta{}
 			}
-			if err := m.RunId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12413,16 +14934,15 @@ func (m *JobRunAssigned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobId == nil {
-				m.JobId = &Uuid{}
-			}
-			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PodInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Info = &KubernetesResourceInfo_PodInfo{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12449,10 +14969,11 @@ func (m *JobRunAssigned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceInfos = append(m.ResourceInfos, &KubernetesResourceInfo{})
-			if err := m.ResourceInfos[len(m.ResourceInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &IngressInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Info = &KubernetesResourceInfo_IngressInfo{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12475,7 +14996,7 @@ func (m *JobRunAssigned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunRunning) Unmarshal(dAtA []byte) error {
+func (m *PodInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12498,53 +15019,17 @@ func (m *JobRunRunning) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunRunning: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunRunning: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RunId == nil {
-				m.RunId = &Uuid{}
-			}
-			if err := m.RunId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12554,31 +15039,46 @@ func (m *JobRunRunning) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobId == nil {
-				m.JobId = &Uuid{}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLabels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12605,10 +15105,103 @@ func (m *JobRunRunning) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceInfos = append(m.ResourceInfos, &KubernetesResourceInfo{})
-			if err := m.ResourceInfos[len(m.ResourceInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.NodeLabels == nil {
+				m.NodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.NodeLabels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12631,7 +15224,7 @@ func (m *JobRunRunning) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubernetesResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *IngressInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12654,17 +15247,17 @@ func (m *KubernetesResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubernetesResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: IngressInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubernetesResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IngressInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12674,31 +15267,27 @@ func (m *KubernetesResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectMeta == nil {
-				m.ObjectMeta = &ObjectMeta{}
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressAddresses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12725,46 +15314,89 @@ func (m *KubernetesResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PodInfo{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Info = &KubernetesResourceInfo_PodInfo{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IngressInfo", wireType)
+			if m.IngressAddresses == nil {
+				m.IngressAddresses = make(map[int32]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &IngressInfo{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Info = &KubernetesResourceInfo_IngressInfo{v}
+			m.IngressAddresses[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12787,7 +15419,7 @@ func (m *KubernetesResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodInfo) Unmarshal(dAtA []byte) error {
+func (m *StandaloneIngressInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12810,17 +15442,17 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StandaloneIngressInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StandaloneIngressInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12830,29 +15462,33 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			if m.RunId == nil {
+				m.RunId = &Uuid{}
+			}
+			if err := m.RunId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			m.PodNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12862,66 +15498,33 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IngressInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IngressInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IngressInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12931,25 +15534,29 @@ func (m *IngressInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &ObjectMeta{}
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IngressAddresses", wireType)
 			}
@@ -13062,6 +15669,121 @@ func (m *IngressInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.IngressAddresses[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -13083,7 +15805,7 @@ func (m *IngressInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StandaloneIngressInfo) Unmarshal(dAtA []byte) error {
+func (m *JobRunArtifacts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13106,10 +15828,10 @@ func (m *StandaloneIngressInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StandaloneIngressInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunArtifacts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StandaloneIngressInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13219,10 +15941,29 @@ func (m *StandaloneIngressInfo) Unmarshal(dAtA []byte) error {
 			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IngressAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13249,93 +15990,64 @@ func (m *StandaloneIngressInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IngressAddresses == nil {
-				m.IngressAddresses = make(map[int32]string)
-			}
-			var mapkey int32
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthEvents
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvents(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Artifacts = append(m.Artifacts, &Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IngressAddresses[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13363,30 +16075,11 @@ func (m *StandaloneIngressInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
-			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13414,11 +16107,11 @@ func (m *StandaloneIngressInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.ContainerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13446,8 +16139,27 @@ func (m *StandaloneIngressInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -14294,15 +17006,85 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunPreemptedError{}
+			v := &JobRunPreemptedError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Reason = &Error_JobRunPreemptedError{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GangJobUnschedulable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GangJobUnschedulable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Reason = &Error_GangJobUnschedulable{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorStale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecutorStale{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Reason = &Error_JobRunPreemptedError{v}
+			m.Reason = &Error_ExecutorStale{v}
 			iNdEx = postIndex
-		case 12:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GangJobUnschedulable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobDependencyFailed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14329,12 +17111,31 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GangJobUnschedulable{}
+			v := &JobDependencyFailed{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Reason = &Error_GangJobUnschedulable{v}
+			m.Reason = &Error_JobDependencyFailed{v}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCategory", wireType)
+			}
+			m.FailureCategory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCategory |= FailureCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -15352,14 +18153,283 @@ func (m *PodUnschedulable) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorStale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorStale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorStale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHeartbeat == nil {
+				m.LastHeartbeat = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeat, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxRunsExceeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxRunsExceeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxRunsExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15387,27 +18457,8 @@ func (m *PodUnschedulable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
-			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -15429,7 +18480,7 @@ func (m *PodUnschedulable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseExpired) Unmarshal(dAtA []byte) error {
+func (m *JobRunPreemptedError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15452,10 +18503,10 @@ func (m *LeaseExpired) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseExpired: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunPreemptedError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunPreemptedError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15479,7 +18530,7 @@ func (m *LeaseExpired) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaxRunsExceeded) Unmarshal(dAtA []byte) error {
+func (m *GangJobUnschedulable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15502,10 +18553,10 @@ func (m *MaxRunsExceeded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaxRunsExceeded: wiretype end group for non-group")
+			return fmt.Errorf("proto: GangJobUnschedulable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaxRunsExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GangJobUnschedulable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15561,7 +18612,7 @@ func (m *MaxRunsExceeded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunPreemptedError) Unmarshal(dAtA []byte) error {
+func (m *JobDependencyFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15584,63 +18635,45 @@ func (m *JobRunPreemptedError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunPreemptedError: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobDependencyFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunPreemptedError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobDependencyFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyJobId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GangJobUnschedulable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GangJobUnschedulable: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GangJobUnschedulable: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DependencyJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
@@ -15988,6 +19021,121 @@ func (m *JobRunPreempted) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= PreemptionReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreemptiveQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
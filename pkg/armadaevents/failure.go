@@ -0,0 +1,66 @@
+package armadaevents
+
+// FailureCategoryOf returns the category of the failure represented by err.
+// If the category isn't set, e.g., because the error was reported by an older component, it's inferred from the reason.
+func FailureCategoryOf(err *Error) FailureCategory {
+	if err == nil {
+		return FailureCategory_UnknownFailureCategory
+	}
+	if err.FailureCategory != FailureCategory_UnknownFailureCategory {
+		return err.FailureCategory
+	}
+	switch reason := err.Reason.(type) {
+	case *Error_PodError:
+		if category := failureCategoryFromKubernetesReason(reason.PodError.KubernetesReason); category != FailureCategory_UnknownFailureCategory {
+			return category
+		}
+		for _, containerError := range reason.PodError.ContainerErrors {
+			if category := failureCategoryFromContainerError(containerError); category != FailureCategory_UnknownFailureCategory {
+				return category
+			}
+		}
+	case *Error_ContainerError:
+		return failureCategoryFromContainerError(reason.ContainerError)
+	case *Error_LeaseExpired, *Error_ExecutorStale:
+		return FailureCategory_NodeLost
+	}
+	return FailureCategory_UnknownFailureCategory
+}
+
+// FailureCategoryFromErrors returns the category of the first of errs whose category is known,
+// or UnknownFailureCategory if there's no such error.
+func FailureCategoryFromErrors(errs []*Error) FailureCategory {
+	for _, err := range errs {
+		if category := FailureCategoryOf(err); category != FailureCategory_UnknownFailureCategory {
+			return category
+		}
+	}
+	return FailureCategory_UnknownFailureCategory
+}
+
+func failureCategoryFromContainerError(containerError *ContainerError) FailureCategory {
+	if containerError == nil {
+		return FailureCategory_UnknownFailureCategory
+	}
+	if category := failureCategoryFromKubernetesReason(containerError.KubernetesReason); category != FailureCategory_UnknownFailureCategory {
+		return category
+	}
+	if containerError.ExitCode != 0 {
+		return FailureCategory_UserError
+	}
+	return FailureCategory_UnknownFailureCategory
+}
+
+func failureCategoryFromKubernetesReason(reason KubernetesReason) FailureCategory {
+	switch reason {
+	case KubernetesReason_OOM:
+		return FailureCategory_OOMKilled
+	case KubernetesReason_DeadlineExceeded:
+		return FailureCategory_Deadline
+	case KubernetesReason_Evicted:
+		return FailureCategory_NodeLost
+	default:
+		// AppError is the zero value, so it doesn't tell whether the application failed.
+		return FailureCategory_UnknownFailureCategory
+	}
+}
@@ -0,0 +1,78 @@
+package armadaevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailureCategoryOf(t *testing.T) {
+	tests := map[string]struct {
+		err      *Error
+		expected FailureCategory
+	}{
+		"nil": {
+			err:      nil,
+			expected: FailureCategory_UnknownFailureCategory,
+		},
+		"set explicitly": {
+			err: &Error{
+				FailureCategory: FailureCategory_ImagePull,
+				Reason:          &Error_PodError{PodError: &PodError{KubernetesReason: KubernetesReason_OOM}},
+			},
+			expected: FailureCategory_ImagePull,
+		},
+		"pod oom": {
+			err:      &Error{Reason: &Error_PodError{PodError: &PodError{KubernetesReason: KubernetesReason_OOM}}},
+			expected: FailureCategory_OOMKilled,
+		},
+		"pod deadline exceeded": {
+			err:      &Error{Reason: &Error_PodError{PodError: &PodError{KubernetesReason: KubernetesReason_DeadlineExceeded}}},
+			expected: FailureCategory_Deadline,
+		},
+		"pod evicted": {
+			err:      &Error{Reason: &Error_PodError{PodError: &PodError{KubernetesReason: KubernetesReason_Evicted}}},
+			expected: FailureCategory_NodeLost,
+		},
+		"container exited with non-zero exit code": {
+			err: &Error{Reason: &Error_PodError{PodError: &PodError{
+				ContainerErrors: []*ContainerError{{ExitCode: 0}, {ExitCode: 1}},
+			}}},
+			expected: FailureCategory_UserError,
+		},
+		"container oom": {
+			err: &Error{Reason: &Error_PodError{PodError: &PodError{
+				ContainerErrors: []*ContainerError{{ExitCode: 137, KubernetesReason: KubernetesReason_OOM}},
+			}}},
+			expected: FailureCategory_OOMKilled,
+		},
+		"lease expired": {
+			err:      &Error{Reason: &Error_LeaseExpired{LeaseExpired: &LeaseExpired{}}},
+			expected: FailureCategory_NodeLost,
+		},
+		"executor stale": {
+			err:      &Error{Reason: &Error_ExecutorStale{ExecutorStale: &ExecutorStale{}}},
+			expected: FailureCategory_NodeLost,
+		},
+		"max runs exceeded": {
+			err:      &Error{Reason: &Error_MaxRunsExceeded{MaxRunsExceeded: &MaxRunsExceeded{}}},
+			expected: FailureCategory_UnknownFailureCategory,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, FailureCategoryOf(tc.err))
+		})
+	}
+}
+
+func TestFailureCategoryFromErrors(t *testing.T) {
+	errs := []*Error{
+		{Reason: &Error_MaxRunsExceeded{MaxRunsExceeded: &MaxRunsExceeded{}}},
+		{Reason: &Error_LeaseExpired{LeaseExpired: &LeaseExpired{}}},
+		{FailureCategory: FailureCategory_OOMKilled},
+	}
+	assert.Equal(t, FailureCategory_NodeLost, FailureCategoryFromErrors(errs))
+	assert.Equal(t, FailureCategory_UnknownFailureCategory, FailureCategoryFromErrors(errs[:1]))
+	assert.Equal(t, FailureCategory_UnknownFailureCategory, FailureCategoryFromErrors(nil))
+}
@@ -40,6 +40,8 @@ func JobIdFromEvent(event *EventSequence_Event) (*Uuid, error) {
 		return e.JobRunPreempted.PreemptedJobId, nil
 	case *EventSequence_Event_JobRequeued:
 		return e.JobRequeued.JobId, nil
+	case *EventSequence_Event_JobRunArtifacts:
+		return e.JobRunArtifacts.JobId, nil
 	default:
 		err := errors.WithStack(&armadaerrors.ErrInvalidArgument{
 			Name:    "event.Event",
@@ -12,8 +12,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/sirupsen/logrus"
-
 	"github.com/armadaproject/armada/internal/common/logging"
 
 	openId "github.com/coreos/go-oidc"
@@ -29,7 +27,7 @@ type PKCEDetails struct {
 
 func AuthenticatePkce(config PKCEDetails) (*TokenCredentials, error) {
 	ctx := context.Background()
-	log := logrus.StandardLogger().WithField("auth", "AuthenticatePkce")
+	log := logging.WithField("auth", "AuthenticatePkce")
 
 	result := make(chan *oauth2.Token)
 	errorResult := make(chan error)
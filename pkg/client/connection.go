@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/clientinfo"
 	"github.com/armadaproject/armada/pkg/client/auth/exec"
 	"github.com/armadaproject/armada/pkg/client/auth/kerberos"
 	"github.com/armadaproject/armada/pkg/client/auth/kubernetes"
@@ -47,6 +48,15 @@ type ApiConnectionDetails struct {
 	KerberosAuth                kerberos.ClientConfig
 	ForceNoTls                  bool
 	ExecAuth                    exec.CommandDetails
+	// Client identification sent with each request, used by the server for attribution and metrics.
+	// Name defaults to "armada-go-client" and Version to the version of the Armada module this client is built from.
+	// SubmissionSource identifies the system on behalf of which requests are made, e.g., a workflow engine.
+	ClientName       string
+	ClientVersion    string
+	SubmissionSource string
+	// Address of the Binoculars service of each executor cluster, in which {CLUSTER_ID} is replaced by the id of the
+	// cluster, e.g., "{CLUSTER_ID}.binoculars.example.com:50051". Binoculars is used to read the logs of jobs.
+	BinocularsUrlPattern string
 }
 
 type ConnectionDetails func() *ApiConnectionDetails
@@ -67,8 +77,15 @@ func CreateApiConnectionWithCallOptions(
 
 	callOptions := append(additionalDefaultCallOptions, grpc.WaitForReady(true), grpc.UseCompressor(gzip.Name))
 	defaultCallOptions := grpc.WithDefaultCallOptions(callOptions...)
-	unuaryInterceptors := grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...))
-	streamInterceptors := grpc.WithChainStreamInterceptor(grpc_retry.StreamClientInterceptor(retryOpts...))
+	info := config.clientInfo()
+	unuaryInterceptors := grpc.WithChainUnaryInterceptor(
+		clientinfo.UnaryClientInterceptor(info),
+		grpc_retry.UnaryClientInterceptor(retryOpts...),
+	)
+	streamInterceptors := grpc.WithChainStreamInterceptor(
+		clientinfo.StreamClientInterceptor(info),
+		grpc_retry.StreamClientInterceptor(retryOpts...),
+	)
 	dialOpts := append(additionalDialOptions,
 		defaultCallOptions,
 		unuaryInterceptors,
@@ -97,6 +114,21 @@ func CreateApiConnectionWithCallOptions(
 	return grpc.Dial(config.ArmadaUrl, dialOpts...)
 }
 
+func (a *ApiConnectionDetails) clientInfo() clientinfo.ClientInfo {
+	info := clientinfo.ClientInfo{
+		Name:    a.ClientName,
+		Version: a.ClientVersion,
+		Source:  a.SubmissionSource,
+	}
+	if info.Name == "" {
+		info.Name = clientinfo.DefaultName
+		if info.Version == "" {
+			info.Version = clientinfo.ModuleVersion()
+		}
+	}
+	return info
+}
+
 func perRpcCredentials(config *ApiConnectionDetails) (credentials.PerRPCCredentials, error) {
 	if config.BasicAuth.Username != "" {
 		return &config.BasicAuth, nil
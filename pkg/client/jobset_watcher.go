@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// ErrStopWatching may be returned by a TransitionHandler to stop a JobSetWatcher without error.
+var ErrStopWatching = errors.New("stop watching")
+
+// TransitionHandler is called by a JobSetWatcher with a state transition of a job.
+// If it returns an error, the watcher stops and, unless the error is ErrStopWatching, returns it.
+type TransitionHandler func(transition *api.JobStateTransition) error
+
+// JobSetWatcher calls handlers with the state transitions of the jobs in a job set as they occur.
+// If the connection to the server is lost, the watcher reconnects, with exponential backoff, and resumes from the
+// last transition handled, such that each transition is handled once. Sequence returns the position of that
+// transition, from which a new watcher can resume, e.g., after the process watching has restarted.
+type JobSetWatcher struct {
+	client   api.EventClient
+	queue    string
+	jobSetId string
+	// Time waited before reconnecting after the connection to the server is lost,
+	// doubled on each consecutive failure to reconnect up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Sequence of the last transition handled.
+	sequence string
+	// Last state handled of each job that hasn't finished, such that only changes of state are handled.
+	states        map[string]api.JobState
+	handlers      map[api.JobState][]TransitionHandler
+	otherHandlers []TransitionHandler
+}
+
+// NewJobSetWatcher returns a watcher of the jobs in a job set. If fromSequence isn't empty, only transitions after the
+// transition at that sequence are handled; otherwise, all transitions of the job set are.
+func NewJobSetWatcher(client api.EventClient, queue string, jobSetId string, fromSequence string) *JobSetWatcher {
+	return &JobSetWatcher{
+		client:     client,
+		queue:      queue,
+		jobSetId:   jobSetId,
+		MinBackoff: time.Second,
+		MaxBackoff: 30 * time.Second,
+		sequence:   fromSequence,
+		states:     make(map[string]api.JobState),
+		handlers:   make(map[api.JobState][]TransitionHandler),
+	}
+}
+
+// OnTransition registers a handler called with every transition, after any handlers registered for its state.
+func (w *JobSetWatcher) OnTransition(handler TransitionHandler) *JobSetWatcher {
+	w.otherHandlers = append(w.otherHandlers, handler)
+	return w
+}
+
+// OnQueued registers a handler called when a job is queued, which happens on submission and when it's requeued.
+func (w *JobSetWatcher) OnQueued(handler TransitionHandler) *JobSetWatcher {
+	return w.on(api.JobState_QUEUED, handler)
+}
+
+// OnPending registers a handler called when a job is leased to a cluster and is waiting for its pod to start.
+func (w *JobSetWatcher) OnPending(handler TransitionHandler) *JobSetWatcher {
+	return w.on(api.JobState_PENDING, handler)
+}
+
+// OnRunning registers a handler called when the pod of a job starts running.
+func (w *JobSetWatcher) OnRunning(handler TransitionHandler) *JobSetWatcher {
+	return w.on(api.JobState_RUNNING, handler)
+}
+
+// OnSucceeded registers a handler called when a job succeeds.
+func (w *JobSetWatcher) OnSucceeded(handler TransitionHandler) *JobSetWatcher {
+	return w.on(api.JobState_SUCCEEDED, handler)
+}
+
+// OnFailed registers a handler called when a job fails.
+func (w *JobSetWatcher) OnFailed(handler TransitionHandler) *JobSetWatcher {
+	return w.on(api.JobState_FAILED, handler)
+}
+
+// OnCancelled registers a handler called when a job is cancelled.
+func (w *JobSetWatcher) OnCancelled(handler TransitionHandler) *JobSetWatcher {
+	return w.on(api.JobState_CANCELLED, handler)
+}
+
+func (w *JobSetWatcher) on(state api.JobState, handler TransitionHandler) *JobSetWatcher {
+	w.handlers[state] = append(w.handlers[state], handler)
+	return w
+}
+
+// Sequence returns the sequence of the last transition handled, or the sequence the watcher was created with if none.
+// A transition a handler returned an error for isn't considered handled.
+func (w *JobSetWatcher) Sequence() string {
+	return w.sequence
+}
+
+// Run handles transitions until ctx is cancelled, a handler returns an error, or the server rejects the request,
+// e.g., since the job set doesn't exist or the user isn't allowed to watch it. Returns nil if a handler returned
+// ErrStopWatching and ctx.Err() if ctx was cancelled.
+func (w *JobSetWatcher) Run(ctx context.Context) error {
+	backoff := w.MinBackoff
+	for {
+		handled, err := w.watch(ctx)
+		var handlerErr *transitionHandlerError
+		if errors.As(err, &handlerErr) {
+			if errors.Is(handlerErr.err, ErrStopWatching) {
+				return nil
+			}
+			return handlerErr.err
+		} else if ctx.Err() != nil {
+			return ctx.Err()
+		} else if err != nil && !isRetryableWatchError(err) {
+			return err
+		}
+
+		if handled {
+			backoff = w.MinBackoff
+		}
+		log.WithError(err).Warnf("lost connection watching job set %s of queue %s; reconnecting in %s", w.jobSetId, w.queue, backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > w.MaxBackoff {
+			backoff = w.MaxBackoff
+		}
+	}
+}
+
+// watch handles transitions received over a single stream, until the stream or a handler fails.
+// Returns whether any transition was received.
+func (w *JobSetWatcher) watch(ctx context.Context) (bool, error) {
+	stream, err := w.client.WatchJobSet(ctx, &api.WatchJobSetRequest{
+		Queue:        w.queue,
+		JobSetId:     w.jobSetId,
+		FromSequence: w.sequence,
+	})
+	if err != nil {
+		return false, err
+	}
+	received := false
+	for {
+		transition, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		if err := w.handle(transition); err != nil {
+			return received, &transitionHandlerError{err: err}
+		}
+	}
+}
+
+func (w *JobSetWatcher) handle(transition *api.JobStateTransition) error {
+	// The server only deduplicates transitions sent over the same stream.
+	if state, ok := w.states[transition.JobId]; !ok || state != transition.State {
+		for _, handler := range w.handlers[transition.State] {
+			if err := handler(transition); err != nil {
+				return err
+			}
+		}
+		for _, handler := range w.otherHandlers {
+			if err := handler(transition); err != nil {
+				return err
+			}
+		}
+	}
+	if transition.State.IsTerminal() {
+		delete(w.states, transition.JobId)
+	} else {
+		w.states[transition.JobId] = transition.State
+	}
+	w.sequence = transition.Sequence
+	return nil
+}
+
+// transitionHandlerError wraps errors returned by handlers, to distinguish them from errors watching.
+type transitionHandlerError struct {
+	err error
+}
+
+func (e *transitionHandlerError) Error() string {
+	return e.err.Error()
+}
+
+// isRetryableWatchError returns false if err indicates that watching would fail again after reconnecting.
+func isRetryableWatchError(err error) bool {
+	switch status.Code(err) {
+	case codes.NotFound, codes.PermissionDenied, codes.InvalidArgument, codes.Unauthenticated:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestJobSetWatcher_ResumesAfterLostConnection(t *testing.T) {
+	client := &fakeWatchJobSetClient{
+		streams: []*fakeWatchJobSetStream{
+			{
+				transitions: []*api.JobStateTransition{
+					transition("job1", api.JobState_QUEUED, "1"),
+					transition("job1", api.JobState_RUNNING, "2"),
+				},
+				err: status.Error(codes.Unavailable, "connection lost"),
+			},
+			{
+				transitions: []*api.JobStateTransition{
+					// Resent since the server only deduplicates transitions sent over the same stream.
+					transition("job1", api.JobState_RUNNING, "3"),
+					transition("job1", api.JobState_SUCCEEDED, "4"),
+				},
+				err: io.EOF,
+			},
+			{
+				err: status.Error(codes.NotFound, "job set not found"),
+			},
+		},
+	}
+
+	var running, succeeded, all []string
+	watcher := NewJobSetWatcher(client, "queue", "jobSet", "").
+		OnRunning(func(transition *api.JobStateTransition) error {
+			running = append(running, transition.Sequence)
+			return nil
+		}).
+		OnSucceeded(func(transition *api.JobStateTransition) error {
+			succeeded = append(succeeded, transition.Sequence)
+			return nil
+		}).
+		OnTransition(func(transition *api.JobStateTransition) error {
+			all = append(all, transition.Sequence)
+			return nil
+		})
+	watcher.MinBackoff = time.Millisecond
+
+	err := watcher.Run(context.Background())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, []string{"2"}, running)
+	assert.Equal(t, []string{"4"}, succeeded)
+	assert.Equal(t, []string{"1", "2", "4"}, all)
+	assert.Equal(t, "4", watcher.Sequence())
+	assert.Equal(t, []string{"", "2", "4"}, client.fromSequences)
+}
+
+func TestJobSetWatcher_StopsWhenHandlerFails(t *testing.T) {
+	client := &fakeWatchJobSetClient{
+		streams: []*fakeWatchJobSetStream{
+			{
+				transitions: []*api.JobStateTransition{
+					transition("job1", api.JobState_QUEUED, "1"),
+					transition("job1", api.JobState_FAILED, "2"),
+				},
+				err: io.EOF,
+			},
+		},
+	}
+
+	handlerErr := errors.New("handler failed")
+	watcher := NewJobSetWatcher(client, "queue", "jobSet", "").OnFailed(func(*api.JobStateTransition) error {
+		return handlerErr
+	})
+	err := watcher.Run(context.Background())
+	assert.Equal(t, handlerErr, err)
+	assert.Equal(t, "1", watcher.Sequence())
+
+	client.streams = []*fakeWatchJobSetStream{
+		{
+			transitions: []*api.JobStateTransition{transition("job1", api.JobState_FAILED, "2")},
+			err:         io.EOF,
+		},
+	}
+	watcher = NewJobSetWatcher(client, "queue", "jobSet", "1").OnFailed(func(*api.JobStateTransition) error {
+		return ErrStopWatching
+	})
+	err = watcher.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "1", watcher.Sequence())
+}
+
+func TestJobSetWatcher_StopsWhenContextCancelled(t *testing.T) {
+	client := &fakeWatchJobSetClient{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := NewJobSetWatcher(client, "queue", "jobSet", "").Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func transition(jobId string, state api.JobState, sequence string) *api.JobStateTransition {
+	return &api.JobStateTransition{JobId: jobId, State: state, Sequence: sequence}
+}
+
+// fakeWatchJobSetClient returns streams in order; once they've all been returned, streams fail with Unavailable.
+type fakeWatchJobSetClient struct {
+	api.EventClient
+	streams       []*fakeWatchJobSetStream
+	fromSequences []string
+}
+
+func (c *fakeWatchJobSetClient) WatchJobSet(ctx context.Context, in *api.WatchJobSetRequest, _ ...grpc.CallOption) (api.Event_WatchJobSetClient, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	c.fromSequences = append(c.fromSequences, in.FromSequence)
+	if len(c.streams) == 0 {
+		return nil, status.Error(codes.Unavailable, "no more streams")
+	}
+	stream := c.streams[0]
+	c.streams = c.streams[1:]
+	return stream, nil
+}
+
+type fakeWatchJobSetStream struct {
+	grpc.ClientStream
+	transitions []*api.JobStateTransition
+	err         error
+}
+
+func (s *fakeWatchJobSetStream) Recv() (*api.JobStateTransition, error) {
+	if len(s.transitions) == 0 {
+		return nil, s.err
+	}
+	transition := s.transitions[0]
+	s.transitions = s.transitions[1:]
+	return transition, nil
+}
@@ -6,7 +6,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/sirupsen/logrus"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -231,7 +230,7 @@ func (apiLoadTester ArmadaLoadTester) monitorJobsUntilCompletion(
 	jobIds chan string,
 	eventChannel chan api.Event,
 ) []string {
-	log := logrus.StandardLogger().WithField("Armada", "LoadTester")
+	log := logging.WithField("Armada", "LoadTester")
 	var submittedIds []string = nil
 	go func() {
 		ids := []string{}
@@ -281,7 +280,7 @@ func createJobSubmitRequestItems(jobDescs []*domain.JobSubmissionDescription) []
 }
 
 func (apiLoadTester ArmadaLoadTester) cancelRemainingJobs(queue string, jobSetId string) {
-	log := logrus.StandardLogger().WithField("Armada", "LoadTester")
+	log := logging.WithField("Armada", "LoadTester")
 
 	err := WithSubmitClient(apiLoadTester.apiConnectionDetails, func(client api.SubmitClient) error {
 		timeout, _ := common.ContextWithDefaultTimeout()
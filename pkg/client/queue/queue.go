@@ -11,6 +11,7 @@ type Queue struct {
 	Permissions    []Permissions  `json:"permissions"`
 	PriorityFactor PriorityFactor `json:"priorityFactor"`
 	ResourceLimits ResourceLimits `json:"resourceLimits"`
+	State          State          `json:"state"`
 }
 
 // NewQueue returnes new Queue using the in parameter. Error is returned if
@@ -35,6 +36,11 @@ func NewQueue(in *api.Queue) (Queue, error) {
 		permissions = append(permissions, NewPermissionsFromOwners(in.UserOwners, in.GroupOwners))
 	}
 
+	state, err := NewStateFromAPI(in.State)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map state. %s", err)
+	}
+
 	for index, permission := range in.Permissions {
 		perm, err := NewPermissions(permission)
 		if err != nil {
@@ -49,6 +55,7 @@ func NewQueue(in *api.Queue) (Queue, error) {
 		PriorityFactor: priorityFactor,
 		ResourceLimits: resourceLimits,
 		Permissions:    permissions,
+		State:          state,
 	}, nil
 }
 
@@ -59,6 +66,7 @@ func (q Queue) ToAPI() *api.Queue {
 		// Kind:           q.Kind,
 		PriorityFactor: float64(q.PriorityFactor),
 		ResourceLimits: map[string]float64{},
+		State:          q.State.ToAPI(),
 	}
 
 	for resourceName, resourceLimit := range q.ResourceLimits {
@@ -0,0 +1,113 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// State determines whether jobs can be submitted to and scheduled from a queue.
+type State string
+
+const (
+	// Jobs can be submitted to the queue and are scheduled.
+	StateOpen State = "open"
+	// No new jobs can be submitted to the queue, but jobs already queued are scheduled.
+	StatePaused State = "paused"
+	// Jobs can be submitted to the queue, but no jobs are scheduled.
+	StateDraining State = "draining"
+	// No new jobs can be submitted to the queue and no jobs are scheduled.
+	StateClosed State = "closed"
+)
+
+// NewState returns State from input string. If input string doesn't match one of
+// allowed state values ["open", "paused", "draining", "closed"], an error is returned.
+func NewState(in string) (State, error) {
+	switch state := State(in); state {
+	case StateOpen, StatePaused, StateDraining, StateClosed:
+		return state, nil
+	default:
+		return "", fmt.Errorf("invalid queue state: %s", in)
+	}
+}
+
+// NewStateFromAPI returns the State corresponding to in.
+func NewStateFromAPI(in api.QueueState) (State, error) {
+	switch in {
+	case api.QueueState_OPEN:
+		return StateOpen, nil
+	case api.QueueState_PAUSED:
+		return StatePaused, nil
+	case api.QueueState_DRAINING:
+		return StateDraining, nil
+	case api.QueueState_CLOSED:
+		return StateClosed, nil
+	default:
+		return "", fmt.Errorf("invalid queue state: %d", in)
+	}
+}
+
+// ToAPI transforms State to api.QueueState. The empty state is open.
+func (state State) ToAPI() api.QueueState {
+	switch state {
+	case StatePaused:
+		return api.QueueState_PAUSED
+	case StateDraining:
+		return api.QueueState_DRAINING
+	case StateClosed:
+		return api.QueueState_CLOSED
+	default:
+		return api.QueueState_OPEN
+	}
+}
+
+// UnmarshalJSON is implementation of https://pkg.go.dev/encoding/json#Unmarshaler interface.
+// A missing or empty state is open.
+func (state *State) UnmarshalJSON(data []byte) error {
+	queueState := ""
+	if err := json.Unmarshal(data, &queueState); err != nil {
+		return err
+	}
+	if queueState == "" {
+		*state = StateOpen
+		return nil
+	}
+
+	out, err := NewState(queueState)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal queue state: %s", err)
+	}
+
+	*state = out
+	return nil
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (state State) Generate(rand *rand.Rand, size int) reflect.Value {
+	values := AllStates()
+	return reflect.ValueOf(values[rand.Intn(len(values))])
+}
+
+// AllStates returns all State values
+func AllStates() []State {
+	return []State{
+		StateOpen,
+		StatePaused,
+		StateDraining,
+		StateClosed,
+	}
+}
+
+// AcceptsSubmissions returns true if jobs can be submitted to a queue in this state.
+func (state State) AcceptsSubmissions() bool {
+	return state != StatePaused && state != StateClosed
+}
+
+// Schedulable returns true if jobs of a queue in this state may be scheduled.
+func (state State) Schedulable() bool {
+	return state != StateDraining && state != StateClosed
+}
@@ -0,0 +1,49 @@
+package queue
+
+import (
+	"encoding/json"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState(t *testing.T) {
+	testCase := func(state State) bool {
+		out, err := NewStateFromAPI(state.ToAPI())
+		if err != nil {
+			t.Error(err)
+			return false
+		}
+		return out == state
+	}
+
+	if err := quick.Check(testCase, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStateUnmarshalJSON(t *testing.T) {
+	var state State
+	assert.NoError(t, json.Unmarshal([]byte(`"draining"`), &state))
+	assert.Equal(t, StateDraining, state)
+
+	assert.NoError(t, json.Unmarshal([]byte(`""`), &state))
+	assert.Equal(t, StateOpen, state)
+
+	assert.Error(t, json.Unmarshal([]byte(`"frozen"`), &state))
+}
+
+func TestStateAcceptsSubmissions(t *testing.T) {
+	assert.True(t, StateOpen.AcceptsSubmissions())
+	assert.False(t, StatePaused.AcceptsSubmissions())
+	assert.True(t, StateDraining.AcceptsSubmissions())
+	assert.False(t, StateClosed.AcceptsSubmissions())
+}
+
+func TestStateSchedulable(t *testing.T) {
+	assert.True(t, StateOpen.Schedulable())
+	assert.True(t, StatePaused.Schedulable())
+	assert.False(t, StateDraining.Schedulable())
+	assert.False(t, StateClosed.Schedulable())
+}
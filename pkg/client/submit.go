@@ -38,6 +38,43 @@ func SubmitJobs(submitClient api.SubmitClient, request *api.JobSubmitRequest) (*
 	return submitClient.SubmitJobs(ctx, request)
 }
 
+// ValidateJobs validates the jobs of request as if they were submitted, without submitting them.
+func ValidateJobs(submitClient api.SubmitClient, request *api.JobSubmitRequest) (*api.JobValidateResponse, error) {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	return submitClient.ValidateJobs(ctx, request)
+}
+
+// ResubmitJobs submits a copy of each of the finished jobs identified by request to the job set they belong to.
+func ResubmitJobs(submitClient api.SubmitClient, request *api.JobResubmitRequest) (*api.JobSubmitResponse, error) {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	return submitClient.ResubmitJobs(ctx, request)
+}
+
+// SuspendJobSet stops the queued jobs of the job set identified by request from being scheduled until it's resumed.
+func SuspendJobSet(submitClient api.SubmitClient, request *api.JobSetSuspendRequest) error {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	_, err := submitClient.SuspendJobSet(ctx, request)
+	return err
+}
+
+// ResumeJobSet allows the queued jobs of the suspended job set identified by request to be scheduled again.
+func ResumeJobSet(submitClient api.SubmitClient, request *api.JobSetResumeRequest) error {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	_, err := submitClient.ResumeJobSet(ctx, request)
+	return err
+}
+
+// GetResourceRecommendation returns the resource requests recommended for the jobs of the job set identified by request.
+func GetResourceRecommendation(submitClient api.SubmitClient, request *api.ResourceRecommendationRequest) (*api.ResourceRecommendation, error) {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	return submitClient.GetResourceRecommendation(ctx, request)
+}
+
 func CreateChunkedSubmitRequests(queue string, jobSetId string, jobs []*api.JobSubmitRequestItem) []*api.JobSubmitRequest {
 	requests := make([]*api.JobSubmitRequest, 0, 10)
 
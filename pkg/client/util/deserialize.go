@@ -1,6 +1,7 @@
 package util
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 
@@ -18,3 +19,12 @@ func BindJsonOrYaml(filePath string, obj interface{}) error {
 	}
 	return nil
 }
+
+// BindJsonOrYamlData is like BindJsonOrYaml, but decodes data instead of the contents of a file.
+func BindJsonOrYamlData(data []byte, obj interface{}) error {
+	err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 128).Decode(obj)
+	if err != nil {
+		return fmt.Errorf("Failed to parse data because: %v", err)
+	}
+	return nil
+}
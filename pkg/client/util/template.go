@@ -0,0 +1,131 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+// noValue is what text/template renders missing values as; it's removed from rendered templates, as Helm does.
+const noValue = "<no value>"
+
+// templateFuncs are the functions available in templates, in addition to the text/template builtins.
+// They're a subset of those available in Helm charts, so that templates are familiar to Helm users.
+var templateFuncs = template.FuncMap{
+	"default": func(defaultValue interface{}, value interface{}) interface{} {
+		if value == nil || value == "" || value == false {
+			return defaultValue
+		}
+		return value
+	},
+	"required": func(message string, value interface{}) (interface{}, error) {
+		if value == nil || value == "" {
+			return nil, fmt.Errorf("%s", message)
+		}
+		return value, nil
+	},
+	"quote": func(value interface{}) string {
+		return fmt.Sprintf("%q", fmt.Sprint(value))
+	},
+	"toYaml": func(value interface{}) (string, error) {
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(data), "\n"), nil
+	},
+	"toJson": func(value interface{}) (string, error) {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	},
+	"indent": Indent,
+	"nindent": func(spaces int, s string) string {
+		return "\n" + Indent(spaces, s)
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"trim":  strings.TrimSpace,
+}
+
+// Indent indents each line of s by the given number of spaces.
+func Indent(spaces int, s string) string {
+	padding := strings.Repeat(" ", spaces)
+	return padding + strings.ReplaceAll(s, "\n", "\n"+padding)
+}
+
+// RenderTemplate renders the Go template in the file at filePath, with values available as .Values.
+func RenderTemplate(filePath string, values map[string]interface{}) ([]byte, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Failed opening file %s due to %s", filePath, err)
+	}
+	tmpl, err := template.New(filePath).Funcs(templateFuncs).Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse template %s because: %v", filePath, err)
+	}
+	var rendered bytes.Buffer
+	err = tmpl.Execute(&rendered, map[string]interface{}{"Values": values})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to render template %s because: %v", filePath, err)
+	}
+	return bytes.ReplaceAll(rendered.Bytes(), []byte(noValue), nil), nil
+}
+
+// LoadTemplateValues returns the values defined by the YAML files at valuesFilePaths, merged in order, overridden by
+// setValues. Each of setValues is of the form key=value, where key is a dot-separated path, e.g., "resources.cpu=2",
+// and value is parsed as YAML, such that numbers and booleans aren't rendered as strings.
+func LoadTemplateValues(valuesFilePaths []string, setValues []string) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	for _, filePath := range valuesFilePaths {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("Failed opening file %s due to %s", filePath, err)
+		}
+		var fileValues map[string]interface{}
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return nil, fmt.Errorf("Failed to parse file %s because: %v", filePath, err)
+		}
+		mergeValues(values, fileValues)
+	}
+	for _, setValue := range setValues {
+		key, rawValue, ok := strings.Cut(setValue, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("Invalid value %s: expected key=value", setValue)
+		}
+		var value interface{}
+		if err := yaml.Unmarshal([]byte(rawValue), &value); err != nil {
+			value = rawValue
+		}
+		mergeValues(values, nestValue(strings.Split(key, "."), value))
+	}
+	return values, nil
+}
+
+// mergeValues merges src into dst; nested maps are merged recursively and any other value in src replaces that in dst.
+func mergeValues(dst map[string]interface{}, src map[string]interface{}) {
+	for key, srcValue := range src {
+		srcMap, srcIsMap := srcValue.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeValues(dstMap, srcMap)
+		} else {
+			dst[key] = srcValue
+		}
+	}
+}
+
+// nestValue returns a map in which value is at the given path.
+func nestValue(path []string, value interface{}) map[string]interface{} {
+	if len(path) == 1 {
+		return map[string]interface{}{path[0]: value}
+	}
+	return map[string]interface{}{path[0]: nestValue(path[1:], value)}
+}
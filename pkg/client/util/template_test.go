@@ -0,0 +1,58 @@
+package util
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/client/domain"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	values, err := LoadTemplateValues(
+		[]string{filepath.Join("testdata", "values.yaml")},
+		[]string{"jobSetId=job-set-1", "duration=60", "resources.cpu=1"},
+	)
+	require.NoError(t, err)
+
+	data, err := RenderTemplate(filepath.Join("testdata", "jobs-template.yaml"), values)
+	require.NoError(t, err)
+
+	submitFile := &domain.JobSubmitFile{}
+	err = BindJsonOrYamlData(data, submitFile)
+	require.NoError(t, err)
+	assert.Equal(t, getExpectedJobSubmitFile(t), submitFile)
+}
+
+func TestRenderTemplate_MissingRequiredValue(t *testing.T) {
+	values, err := LoadTemplateValues([]string{filepath.Join("testdata", "values.yaml")}, nil)
+	require.NoError(t, err)
+
+	_, err = RenderTemplate(filepath.Join("testdata", "jobs-template.yaml"), values)
+	assert.ErrorContains(t, err, "jobSetId is required")
+}
+
+func TestLoadTemplateValues(t *testing.T) {
+	values, err := LoadTemplateValues(
+		[]string{filepath.Join("testdata", "values.yaml")},
+		[]string{"resources.cpu=4", "resources.gpu=1", "debug=true", "name=job=1"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"queue":    "test",
+		"image":    "alpine:latest",
+		"duration": float64(30),
+		"resources": map[string]interface{}{
+			"memory": "1Gi",
+			"cpu":    float64(4),
+			"gpu":    float64(1),
+		},
+		"debug": true,
+		"name":  "job=1",
+	}, values)
+
+	_, err = LoadTemplateValues(nil, []string{"invalid"})
+	assert.Error(t, err)
+}
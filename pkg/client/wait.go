@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// WaitForJobSetOptions controls which jobs WaitForJobSet waits for and how it reports progress.
+type WaitForJobSetOptions struct {
+	// Ids of the jobs to wait for. If empty, WaitForJobSet waits until all jobs of the job set seen so far have
+	// finished; since jobs are seen in the order they were submitted, jobs submitted after all earlier jobs of the job
+	// set finished may then be missed, so callers that know the ids of the jobs they submitted should set them.
+	JobIds []string
+	// If not nil, called with the summary of the jobs waited for each time one of them changes state.
+	// The summary must not be modified or retained.
+	OnProgress func(summary *JobSetSummary)
+}
+
+// JobSetSummary is the number of jobs of a job set in each state and the outcome of each job that has finished.
+type JobSetSummary struct {
+	Queue     string
+	JobSetId  string
+	Queued    int
+	Pending   int
+	Running   int
+	Succeeded int
+	Failed    int
+	Cancelled int
+	// The last transition of each job, by job id.
+	Jobs map[string]*JobResult
+}
+
+// JobResult is the state of a job and, if it failed or was cancelled, why.
+type JobResult struct {
+	JobId     string
+	State     api.JobState
+	ClusterId string
+	Reason    string
+	// Exit code of each container of a failed job, by container name.
+	ExitCodes map[string]int32
+}
+
+// Finished returns true if all jobs of the summary have finished.
+func (s *JobSetSummary) Finished() bool {
+	return len(s.Jobs) > 0 && s.Queued == 0 && s.Pending == 0 && s.Running == 0
+}
+
+// AllSucceeded returns true if all jobs of the summary have succeeded.
+func (s *JobSetSummary) AllSucceeded() bool {
+	return len(s.Jobs) > 0 && s.Succeeded == len(s.Jobs)
+}
+
+func (s *JobSetSummary) count(state api.JobState) *int {
+	switch state {
+	case api.JobState_QUEUED:
+		return &s.Queued
+	case api.JobState_PENDING:
+		return &s.Pending
+	case api.JobState_RUNNING:
+		return &s.Running
+	case api.JobState_SUCCEEDED:
+		return &s.Succeeded
+	case api.JobState_FAILED:
+		return &s.Failed
+	case api.JobState_CANCELLED:
+		return &s.Cancelled
+	}
+	return nil
+}
+
+func (s *JobSetSummary) update(transition *api.JobStateTransition) {
+	if previous, ok := s.Jobs[transition.JobId]; ok {
+		if count := s.count(previous.State); count != nil {
+			*count--
+		}
+	}
+	if count := s.count(transition.State); count != nil {
+		*count++
+	}
+	result := &JobResult{
+		JobId:     transition.JobId,
+		State:     transition.State,
+		ClusterId: transition.ClusterId,
+		Reason:    transition.Reason,
+	}
+	if len(transition.ContainerStatuses) > 0 {
+		result.ExitCodes = make(map[string]int32, len(transition.ContainerStatuses))
+		for _, containerStatus := range transition.ContainerStatuses {
+			result.ExitCodes[containerStatus.Name] = containerStatus.ExitCode
+		}
+	}
+	s.Jobs[transition.JobId] = result
+}
+
+// WaitForJobSet blocks until the jobs of a job set have finished, and returns a summary of their outcomes.
+// If ctx is cancelled or watching the job set fails first, the summary so far is returned along with the error.
+func WaitForJobSet(ctx context.Context, client api.EventClient, queue string, jobSetId string, opts WaitForJobSetOptions) (*JobSetSummary, error) {
+	summary := &JobSetSummary{
+		Queue:    queue,
+		JobSetId: jobSetId,
+		Jobs:     make(map[string]*JobResult),
+	}
+	jobIds := util.StringListToSet(opts.JobIds)
+	// Jobs waited for that haven't been seen yet.
+	unseen := len(jobIds)
+
+	watcher := NewJobSetWatcher(client, queue, jobSetId, "").OnTransition(func(transition *api.JobStateTransition) error {
+		if len(jobIds) > 0 && !jobIds[transition.JobId] {
+			return nil
+		}
+		if _, ok := summary.Jobs[transition.JobId]; !ok && unseen > 0 {
+			unseen--
+		}
+		summary.update(transition)
+		if opts.OnProgress != nil {
+			opts.OnProgress(summary)
+		}
+		if unseen == 0 && summary.Finished() {
+			return ErrStopWatching
+		}
+		return nil
+	})
+	if err := watcher.Run(ctx); err != nil {
+		return summary, err
+	}
+	return summary, nil
+}
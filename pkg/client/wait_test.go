@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestWaitForJobSet(t *testing.T) {
+	failed := transition("job2", api.JobState_FAILED, "5")
+	failed.Reason = "oom"
+	failed.ContainerStatuses = []*api.ContainerStatus{{Name: "main", ExitCode: 137}}
+	client := &fakeWatchJobSetClient{
+		streams: []*fakeWatchJobSetStream{
+			{
+				transitions: []*api.JobStateTransition{
+					transition("job1", api.JobState_QUEUED, "1"),
+					transition("job2", api.JobState_QUEUED, "2"),
+					transition("job3", api.JobState_QUEUED, "3"),
+					transition("job1", api.JobState_SUCCEEDED, "4"),
+					failed,
+					transition("job3", api.JobState_SUCCEEDED, "6"),
+				},
+				err: io.EOF,
+			},
+		},
+	}
+
+	var progress []int
+	summary, err := WaitForJobSet(context.Background(), client, "queue", "jobSet", WaitForJobSetOptions{
+		JobIds: []string{"job1", "job2"},
+		OnProgress: func(summary *JobSetSummary) {
+			progress = append(progress, summary.Queued)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 1, 0}, progress)
+	assert.Equal(t, 1, summary.Succeeded)
+	assert.Equal(t, 1, summary.Failed)
+	assert.True(t, summary.Finished())
+	assert.False(t, summary.AllSucceeded())
+	assert.Equal(t, &JobResult{
+		JobId:     "job2",
+		State:     api.JobState_FAILED,
+		Reason:    "oom",
+		ExitCodes: map[string]int32{"main": 137},
+	}, summary.Jobs["job2"])
+	assert.NotContains(t, summary.Jobs, "job3")
+}
+
+func TestWaitForJobSet_WaitsForJobsNotSeenYet(t *testing.T) {
+	client := &fakeWatchJobSetClient{
+		streams: []*fakeWatchJobSetStream{
+			{
+				transitions: []*api.JobStateTransition{
+					transition("job1", api.JobState_QUEUED, "1"),
+					transition("job1", api.JobState_SUCCEEDED, "2"),
+					transition("job2", api.JobState_QUEUED, "3"),
+					transition("job2", api.JobState_CANCELLED, "4"),
+				},
+				err: io.EOF,
+			},
+		},
+	}
+
+	summary, err := WaitForJobSet(context.Background(), client, "queue", "jobSet", WaitForJobSetOptions{JobIds: []string{"job1", "job2"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Succeeded)
+	assert.Equal(t, 1, summary.Cancelled)
+	assert.Len(t, summary.Jobs, 2)
+}
@@ -124,6 +124,26 @@ func WatchJobSetWithJobIdsFilter(
 	}
 }
 
+// WatchJobSetTransitions calls onTransition with the state transitions of the jobs in a job set as they occur,
+// until onTransition returns true or the context is cancelled. If the connection to the server is lost,
+// watching resumes from the last transition received.
+func WatchJobSetTransitions(
+	client api.EventClient,
+	queue, jobSetId string,
+	context context.Context,
+	onTransition func(*api.JobStateTransition) bool,
+) {
+	watcher := NewJobSetWatcher(client, queue, jobSetId, "").OnTransition(func(transition *api.JobStateTransition) error {
+		if onTransition(transition) {
+			return ErrStopWatching
+		}
+		return nil
+	})
+	if err := watcher.Run(context); err != nil && context.Err() == nil {
+		log.Error(err)
+	}
+}
+
 func isTransportClosingError(e error) bool {
 	if err, ok := status.FromError(e); ok {
 		switch err.Code() {
@@ -1,10 +1,14 @@
 package client
 
 import (
+	"strings"
+
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/binoculars"
 )
 
 func WithConnection(apiConnectionDetails *ApiConnectionDetails, action func(*grpc.ClientConn) error) error {
@@ -30,9 +34,30 @@ func WithEventClient(apiConnectionDetails *ApiConnectionDetails, action func(api
 	})
 }
 
+func WithNotificationsClient(apiConnectionDetails *ApiConnectionDetails, action func(api.NotificationsClient) error) error {
+	return WithConnection(apiConnectionDetails, func(cc *grpc.ClientConn) error {
+		client := api.NewNotificationsClient(cc)
+		return action(client)
+	})
+}
+
 func WithSchedulerReportingClient(apiConnectionDetails *ApiConnectionDetails, action func(schedulerobjects.SchedulerReportingClient) error) error {
 	return WithConnection(apiConnectionDetails, func(cc *grpc.ClientConn) error {
 		client := schedulerobjects.NewSchedulerReportingClient(cc)
 		return action(client)
 	})
 }
+
+// WithBinocularsClient connects to the Binoculars service of the given executor cluster, at the address given by
+// BinocularsUrlPattern, using the same credentials as for the Armada server.
+func WithBinocularsClient(apiConnectionDetails *ApiConnectionDetails, clusterId string, action func(binoculars.BinocularsClient) error) error {
+	if apiConnectionDetails.BinocularsUrlPattern == "" {
+		return errors.New("binocularsUrlPattern must be configured to connect to Binoculars")
+	}
+	binocularsConnectionDetails := *apiConnectionDetails
+	binocularsConnectionDetails.ArmadaUrl = strings.ReplaceAll(apiConnectionDetails.BinocularsUrlPattern, "{CLUSTER_ID}", clusterId)
+	return WithConnection(&binocularsConnectionDetails, func(cc *grpc.ClientConn) error {
+		client := binoculars.NewBinocularsClient(cc)
+		return action(client)
+	})
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/armadaproject/armada/pkg/api"
 )
@@ -47,6 +49,16 @@ func (s *PerformanceTestEventServer) Watch(req *api.WatchRequest, stream api.Eve
 	return s.GetJobSetEvents(request, stream)
 }
 
+// WatchJobSet isn't used by the job service, so the fake doesn't simulate job state transitions.
+func (s *PerformanceTestEventServer) WatchJobSet(req *api.WatchJobSetRequest, stream api.Event_WatchJobSetServer) error {
+	return status.Error(codes.Unimplemented, "WatchJobSet is not supported by the fake event server")
+}
+
+// GetJobRunDetails isn't used by the job service either.
+func (s *PerformanceTestEventServer) GetJobRunDetails(ctx context.Context, req *api.JobRunDetailsRequest) (*api.JobRunDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "GetJobRunDetails is not supported by the fake event server")
+}
+
 type scriptedMessage struct {
 	Delay       time.Duration
 	MessageFunc func(*api.JobSetRequest) *api.EventMessage